@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// UploadInstanceArchive stores an instance archive on the server under
+// name, for AdminService.ImportInstance to restore. Like audio uploads,
+// seekable bodies are retried.
+func (c *Client) UploadInstanceArchive(ctx context.Context, name string, r io.Reader) (int64, error) {
+	var payload struct {
+		SizeBytes int64 `json:"sizeBytes"`
+	}
+	if err := c.sendAuthorized(ctx, http.MethodPut, "/api/admin/archives/"+url.PathEscape(name), "application/gzip", r, &payload); err != nil {
+		return 0, err
+	}
+	return payload.SizeBytes, nil
+}
+
+// DownloadInstanceArchive copies the instance archive called name, as made
+// by AdminService.ExportInstance, to w.
+func (c *Client) DownloadInstanceArchive(ctx context.Context, name string, w io.Writer) (int64, error) {
+	token, err := c.currentToken(ctx)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api/admin/archives/"+url.PathEscape(name), nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return 0, decodeHTTPError(resp)
+	}
+	return io.Copy(w, resp.Body)
+}
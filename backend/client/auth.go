@@ -0,0 +1,225 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// refreshSkew is how long before expiry a token is replaced proactively.
+const refreshSkew = time.Minute
+
+// ErrNoCredentials is returned when a token is needed but the client has
+// neither a token nor credentials to obtain one.
+var ErrNoCredentials = errors.New("client: not logged in")
+
+// User is the account returned by Login.
+type User struct {
+	ID        int64  `json:"id"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Role      string `json:"role"`
+}
+
+type LoginResult struct {
+	Token string `json:"token"`
+	User  User   `json:"user"`
+}
+
+// HTTPError is returned by the plain HTTP endpoints, which report failures
+// as {"error": "..."} rather than Connect errors.
+type HTTPError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("secretary: %s (HTTP %d)", e.Message, e.StatusCode)
+}
+
+type authState struct {
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+	email     string
+	password  string
+}
+
+func (a *authState) setToken(token string) {
+	a.token = token
+	a.expiresAt = tokenExpiry(token)
+}
+
+// Login exchanges credentials for a token and remembers both, so the client
+// can log in again on its own once the token expires.
+func (c *Client) Login(ctx context.Context, email string, password string) (*LoginResult, error) {
+	result, err := c.login(ctx, email, password)
+	if err != nil {
+		return nil, err
+	}
+	c.auth.mu.Lock()
+	c.auth.email = email
+	c.auth.password = password
+	c.auth.setToken(result.Token)
+	c.auth.mu.Unlock()
+	return result, nil
+}
+
+// Token returns the current bearer token, which may be empty.
+func (c *Client) Token() string {
+	c.auth.mu.Lock()
+	defer c.auth.mu.Unlock()
+	return c.auth.token
+}
+
+// SetToken replaces the bearer token.
+func (c *Client) SetToken(token string) {
+	c.auth.mu.Lock()
+	defer c.auth.mu.Unlock()
+	c.auth.setToken(token)
+}
+
+func (c *Client) login(ctx context.Context, email string, password string) (*LoginResult, error) {
+	body, err := json.Marshal(map[string]string{"email": email, "password": password})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/login", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	var result LoginResult
+	if err := c.doJSON(req, &result); err != nil {
+		return nil, err
+	}
+	if result.Token == "" {
+		return nil, errors.New("client: login response did not include a token")
+	}
+	return &result, nil
+}
+
+// currentToken returns a usable token, logging in first when there is none
+// or the current one is about to expire.
+func (c *Client) currentToken(ctx context.Context) (string, error) {
+	c.auth.mu.Lock()
+	defer c.auth.mu.Unlock()
+	expiring := !c.auth.expiresAt.IsZero() && time.Until(c.auth.expiresAt) < refreshSkew
+	if c.auth.token != "" && (!expiring || c.auth.email == "") {
+		return c.auth.token, nil
+	}
+	if c.auth.email == "" {
+		return "", ErrNoCredentials
+	}
+	return c.reloginLocked(ctx)
+}
+
+// refreshToken logs in again after the server rejected stale. It returns
+// false when there are no credentials to do so.
+func (c *Client) refreshToken(ctx context.Context, stale string) (bool, error) {
+	c.auth.mu.Lock()
+	defer c.auth.mu.Unlock()
+	if c.auth.email == "" {
+		return false, nil
+	}
+	if c.auth.token != stale {
+		// Another request already refreshed it.
+		return true, nil
+	}
+	_, err := c.reloginLocked(ctx)
+	return err == nil, err
+}
+
+func (c *Client) reloginLocked(ctx context.Context) (string, error) {
+	result, err := c.login(ctx, c.auth.email, c.auth.password)
+	if err != nil {
+		return "", err
+	}
+	c.auth.setToken(result.Token)
+	return c.auth.token, nil
+}
+
+func (c *Client) authInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			token, err := c.currentToken(ctx)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeUnauthenticated, err)
+			}
+			req.Header().Set("Authorization", "Bearer "+token)
+			res, err := next(ctx, req)
+			if connect.CodeOf(err) != connect.CodeUnauthenticated {
+				return res, err
+			}
+			refreshed, refreshErr := c.refreshToken(ctx, token)
+			if refreshErr != nil || !refreshed {
+				return res, err
+			}
+			req.Header().Set("Authorization", "Bearer "+c.Token())
+			return next(ctx, req)
+		}
+	}
+}
+
+func (c *Client) doJSON(req *http.Request, out any) error {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return decodeHTTPError(resp)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("client: decode response: %w", err)
+	}
+	return nil
+}
+
+func decodeHTTPError(resp *http.Response) error {
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	var payload struct {
+		Error string `json:"error"`
+	}
+	message := strings.TrimSpace(string(data))
+	if json.Unmarshal(data, &payload) == nil && payload.Error != "" {
+		message = payload.Error
+	}
+	if message == "" {
+		message = http.StatusText(resp.StatusCode)
+	}
+	return &HTTPError{StatusCode: resp.StatusCode, Message: message}
+}
+
+// tokenExpiry reads the exp claim without verifying the signature; the
+// server remains the authority, this only decides when to refresh early.
+func tokenExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if json.Unmarshal(payload, &claims) != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
@@ -0,0 +1,139 @@
+// Package client is the Go SDK for the Secretary API. It wraps the generated
+// Connect clients with login, token refresh and retries, and adds helpers for
+// the plain HTTP endpoints such as audio upload, so bots and scripts don't
+// have to reimplement that plumbing.
+//
+//	c := client.New("https://secretary.example.com")
+//	if _, err := c.Login(ctx, email, password); err != nil {
+//		return err
+//	}
+//	res, err := c.Todos.ListTodos(ctx, connect.NewRequest(&secretaryv1.ListTodosRequest{}))
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+)
+
+// Client talks to one Secretary server. It is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	retry      RetryPolicy
+	auth       authState
+	timezone   string
+
+	Recordings    secretaryv1connect.RecordingsServiceClient
+	Todos         secretaryv1connect.TodosServiceClient
+	Users         secretaryv1connect.UsersServiceClient
+	Workspaces    secretaryv1connect.WorkspacesServiceClient
+	Documents     secretaryv1connect.DocumentsServiceClient
+	Activities    secretaryv1connect.ActivitiesServiceClient
+	AI            secretaryv1connect.AIServiceClient
+	Outcomes      secretaryv1connect.OutcomesServiceClient
+	Activity      secretaryv1connect.ActivityServiceClient
+	Annotations   secretaryv1connect.AnnotationsServiceClient
+	Attachments   secretaryv1connect.AttachmentsServiceClient
+	Prompts       secretaryv1connect.PromptTemplatesServiceClient
+	Notifications secretaryv1connect.NotificationsServiceClient
+	Settings      secretaryv1connect.SettingsServiceClient
+	Quarantine    secretaryv1connect.QuarantineServiceClient
+	Keywords      secretaryv1connect.KeywordAlertsServiceClient
+	Admin         secretaryv1connect.AdminServiceClient
+	Jobs          secretaryv1connect.JobsServiceClient
+}
+
+// Option customizes a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used for every request.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithToken starts the client with an existing bearer token, for example one
+// saved from an earlier Login.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.auth.setToken(token)
+	}
+}
+
+// WithCredentials lets the client log in lazily and log in again whenever
+// its token expires or is rejected.
+func WithCredentials(email string, password string) Option {
+	return func(c *Client) {
+		c.auth.email = email
+		c.auth.password = password
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// WithTimezone asks the server to write response timestamps in the named
+// IANA time zone instead of UTC.
+func WithTimezone(name string) Option {
+	return func(c *Client) {
+		c.timezone = name
+	}
+}
+
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: http.DefaultClient,
+		retry:      DefaultRetryPolicy(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	// Retries wrap auth so every attempt picks up a refreshed token.
+	interceptors := connect.WithInterceptors(c.retryInterceptor(), c.authInterceptor(), c.timezoneInterceptor())
+	c.Recordings = secretaryv1connect.NewRecordingsServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Todos = secretaryv1connect.NewTodosServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Users = secretaryv1connect.NewUsersServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Workspaces = secretaryv1connect.NewWorkspacesServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Documents = secretaryv1connect.NewDocumentsServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Activities = secretaryv1connect.NewActivitiesServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.AI = secretaryv1connect.NewAIServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Outcomes = secretaryv1connect.NewOutcomesServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Activity = secretaryv1connect.NewActivityServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Annotations = secretaryv1connect.NewAnnotationsServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Attachments = secretaryv1connect.NewAttachmentsServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Prompts = secretaryv1connect.NewPromptTemplatesServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Notifications = secretaryv1connect.NewNotificationsServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Settings = secretaryv1connect.NewSettingsServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Quarantine = secretaryv1connect.NewQuarantineServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Keywords = secretaryv1connect.NewKeywordAlertsServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Admin = secretaryv1connect.NewAdminServiceClient(c.httpClient, c.baseURL, interceptors)
+	c.Jobs = secretaryv1connect.NewJobsServiceClient(c.httpClient, c.baseURL, interceptors)
+	return c
+}
+
+func (c *Client) timezoneInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if c.timezone != "" {
+				req.Header().Set("X-Timezone", c.timezone)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// BaseURL returns the server URL the client was created with.
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+type stubUsers struct {
+	secretaryv1connect.UnimplementedUsersServiceHandler
+	validToken  atomic.Value
+	unavailable atomic.Int32
+}
+
+func (s *stubUsers) ListUsers(_ context.Context, req *connect.Request[secretaryv1.ListUsersRequest]) (*connect.Response[secretaryv1.ListUsersResponse], error) {
+	if req.Header().Get("Authorization") != "Bearer "+s.validToken.Load().(string) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid token"))
+	}
+	if s.unavailable.Add(-1) >= 0 {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("try again"))
+	}
+	return connect.NewResponse(&secretaryv1.ListUsersResponse{Users: []*secretaryv1.User{{Id: 1, FirstName: "Ada"}}}), nil
+}
+
+func newStubServer(t *testing.T) (*httptest.Server, *stubUsers, *atomic.Int32) {
+	t.Helper()
+	users := &stubUsers{}
+	users.validToken.Store("token-1")
+	var logins atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/login", func(w http.ResponseWriter, r *http.Request) {
+		var req struct{ Email, Password string }
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid credentials"}`))
+			return
+		}
+		logins.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"token": users.validToken.Load().(string),
+			"user":  map[string]any{"id": 1, "firstName": "Ada", "role": "admin"},
+		})
+	})
+	mux.HandleFunc("/api/recordings/upload", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+users.validToken.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid token"}`))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"recording": map[string]any{"id": 9, "name": r.URL.Query().Get("name") + "|" + r.Header.Get("Content-Type")},
+			"sizeBytes": len(body),
+		})
+	})
+	path, handler := secretaryv1connect.NewUsersServiceHandler(users)
+	mux.Handle(path, handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, users, &logins
+}
+
+func TestClientRefreshesRejectedToken(t *testing.T) {
+	server, users, logins := newStubServer(t)
+	c := New(server.URL)
+
+	if _, err := c.Login(context.Background(), "ada@example.com", "wrong"); err == nil {
+		t.Fatalf("expected bad password to fail")
+	} else if httpErr, ok := err.(*HTTPError); !ok || httpErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected HTTPError 401, got %v", err)
+	}
+	if _, err := c.Login(context.Background(), "ada@example.com", "secret"); err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	// The server rotates its token; the client should log in again once.
+	users.validToken.Store("token-2")
+	res, err := c.Users.ListUsers(context.Background(), connect.NewRequest(&secretaryv1.ListUsersRequest{}))
+	if err != nil {
+		t.Fatalf("list users: %v", err)
+	}
+	if len(res.Msg.Users) != 1 || c.Token() != "token-2" || logins.Load() != 2 {
+		t.Fatalf("expected refreshed token, got token=%q logins=%d", c.Token(), logins.Load())
+	}
+}
+
+func TestClientRetriesUnavailable(t *testing.T) {
+	server, users, _ := newStubServer(t)
+	users.unavailable.Store(2)
+	c := New(server.URL, WithToken("token-1"), WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}))
+
+	if _, err := c.Users.ListUsers(context.Background(), connect.NewRequest(&secretaryv1.ListUsersRequest{})); err != nil {
+		t.Fatalf("expected retries to succeed: %v", err)
+	}
+
+	users.unavailable.Store(5)
+	_, err := c.Users.ListUsers(context.Background(), connect.NewRequest(&secretaryv1.ListUsersRequest{}))
+	if connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("expected unavailable after exhausting retries, got %v", err)
+	}
+}
+
+func TestRetryableResourceExhausted(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"2"}}}
+	rateLimited := apierr.Wrap(apierr.NewProviderError("openai", resp, nil), "failed to summarize")
+	if connect.CodeOf(rateLimited) != connect.CodeResourceExhausted || !retryable(rateLimited) {
+		t.Fatalf("expected a rate limit with a retry delay to be retried: %v", rateLimited)
+	}
+	quota := apierr.QuotaExceeded("transcription quota exceeded", "transcription_minutes", "user", 60, 60)
+	if retryable(quota) {
+		t.Fatal("retried an exceeded quota")
+	}
+	if retryable(connect.NewError(connect.CodeResourceExhausted, errors.New("message is too large"))) {
+		t.Fatal("retried an oversized request")
+	}
+}
+
+func TestClientRequiresCredentials(t *testing.T) {
+	server, _, _ := newStubServer(t)
+	c := New(server.URL)
+	_, err := c.Users.ListUsers(context.Background(), connect.NewRequest(&secretaryv1.ListUsersRequest{}))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated || !errors.Is(err, ErrNoCredentials) {
+		t.Fatalf("expected ErrNoCredentials, got %v", err)
+	}
+}
+
+func TestUploadAudio(t *testing.T) {
+	server, users, logins := newStubServer(t)
+	c := New(server.URL, WithToken("stale"), WithCredentials("ada@example.com", "secret"))
+	users.validToken.Store("token-3")
+
+	uploaded, err := c.UploadAudio(context.Background(), strings.NewReader("RIFFdata"), UploadOptions{Name: "Standup", Filename: "standup.wav"})
+	if err != nil {
+		t.Fatalf("upload: %v", err)
+	}
+	if uploaded.ID != 9 || uploaded.SizeBytes != 8 || !strings.HasPrefix(uploaded.Name, "Standup|audio/") {
+		t.Fatalf("unexpected upload result %+v", uploaded)
+	}
+	if logins.Load() != 1 {
+		t.Fatalf("expected one re-login, got %d", logins.Load())
+	}
+}
+
+func TestPaginateFollowsTokens(t *testing.T) {
+	pages := map[string]Page[int]{
+		"":  {Items: []int{1, 2}, NextPageToken: "b"},
+		"b": {Items: []int{3}},
+	}
+	var got []int
+	for item, err := range Paginate(context.Background(), func(_ context.Context, token string) (Page[int], error) {
+		return pages[token], nil
+	}) {
+		if err != nil {
+			t.Fatalf("paginate: %v", err)
+		}
+		got = append(got, item)
+	}
+	if len(got) != 3 || got[2] != 3 {
+		t.Fatalf("unexpected items %v", got)
+	}
+}
+
+func TestTokenExpiry(t *testing.T) {
+	// {"exp":1700000000}
+	token := "eyJhbGciOiJIUzI1NiJ9.eyJleHAiOjE3MDAwMDAwMDB9.sig"
+	if got := tokenExpiry(token); !got.Equal(time.Unix(1700000000, 0)) {
+		t.Fatalf("unexpected expiry %v", got)
+	}
+	if !tokenExpiry("opaque").IsZero() {
+		t.Fatalf("expected zero expiry for non-JWT token")
+	}
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"iter"
+
+	"connectrpc.com/connect"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+)
+
+// Page is one page of list results. An empty NextPageToken ends iteration.
+type Page[T any] struct {
+	Items         []T
+	NextPageToken string
+}
+
+// Paginate turns a page fetcher into an iterator over every item. Iteration
+// stops at the first error, which is yielded with a zero item.
+func Paginate[T any](ctx context.Context, fetch func(ctx context.Context, pageToken string) (Page[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		token := ""
+		for {
+			page, err := fetch(ctx, token)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if page.NextPageToken == "" || page.NextPageToken == token {
+				return
+			}
+			token = page.NextPageToken
+		}
+	}
+}
+
+// AllTodos iterates over the todos matching req. The list RPCs return every
+// row in one response today, so this is a single page; callers written
+// against the iterator keep working when server-side paging is added.
+func (c *Client) AllTodos(ctx context.Context, req *secretaryv1.ListTodosRequest) iter.Seq2[*secretaryv1.Todo, error] {
+	return Paginate(ctx, func(ctx context.Context, _ string) (Page[*secretaryv1.Todo], error) {
+		res, err := c.Todos.ListTodos(ctx, connect.NewRequest(req))
+		if err != nil {
+			return Page[*secretaryv1.Todo]{}, err
+		}
+		return Page[*secretaryv1.Todo]{Items: res.Msg.GetTodos()}, nil
+	})
+}
+
+// AllRecordings iterates over the recordings matching req, newest first. A
+// nil req lists every recording.
+func (c *Client) AllRecordings(ctx context.Context, req *secretaryv1.ListRecordingsRequest) iter.Seq2[*secretaryv1.Recording, error] {
+	if req == nil {
+		req = &secretaryv1.ListRecordingsRequest{}
+	}
+	return Paginate(ctx, func(ctx context.Context, _ string) (Page[*secretaryv1.Recording], error) {
+		res, err := c.Recordings.ListRecordings(ctx, connect.NewRequest(req))
+		if err != nil {
+			return Page[*secretaryv1.Recording]{}, err
+		}
+		return Page[*secretaryv1.Recording]{Items: res.Msg.GetRecordings()}, nil
+	})
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// LiveRecording is an in-progress recording that accepts audio chunks.
+type LiveRecording struct {
+	ID            int64  `json:"recordingId"`
+	Name          string `json:"name"`
+	CreatedAt     string `json:"createdAt"`
+	MaxChunkBytes int    `json:"maxChunkBytes"`
+}
+
+// LiveRecordingOptions describes the raw audio format of a live recording.
+// Chunks must be signed 16-bit little-endian PCM with interleaved channels.
+type LiveRecordingOptions struct {
+	Name       string
+	SampleRate int
+	Channels   int
+}
+
+// FinalizedRecording is returned once a live recording has been assembled.
+type FinalizedRecording struct {
+	ID              int64
+	DurationSeconds int32
+	SizeBytes       int64
+}
+
+// StartLiveRecording creates a recording that audio can be streamed into.
+func (c *Client) StartLiveRecording(ctx context.Context, opts LiveRecordingOptions) (*LiveRecording, error) {
+	body, err := jsonBody(map[string]any{
+		"name":       opts.Name,
+		"sampleRate": opts.SampleRate,
+		"channels":   opts.Channels,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var live LiveRecording
+	if err := c.sendAuthorized(ctx, http.MethodPost, "/api/recordings/live", "application/json", body, &live); err != nil {
+		return nil, err
+	}
+	return &live, nil
+}
+
+// UploadLiveChunk stores chunk number seq (starting at 0). Resending the
+// same sequence number replaces the earlier upload.
+func (c *Client) UploadLiveChunk(ctx context.Context, recordingID int64, seq int, pcm []byte) error {
+	endpoint := fmt.Sprintf("/api/recordings/live/%d/chunks/%d", recordingID, seq)
+	return c.sendAuthorized(ctx, http.MethodPut, endpoint, "application/octet-stream", bytes.NewReader(pcm), nil)
+}
+
+// FinalizeLiveRecording stitches chunks 0..chunkCount-1 into the recording's
+// audio. It fails if any chunk is missing, so callers can resend and retry.
+func (c *Client) FinalizeLiveRecording(ctx context.Context, recordingID int64, chunkCount int) (*FinalizedRecording, error) {
+	body, err := jsonBody(map[string]any{"chunkCount": chunkCount})
+	if err != nil {
+		return nil, err
+	}
+	var payload struct {
+		Recording struct {
+			ID       int64 `json:"id"`
+			Duration int32 `json:"duration"`
+		} `json:"recording"`
+		SizeBytes int64 `json:"sizeBytes"`
+	}
+	endpoint := fmt.Sprintf("/api/recordings/live/%d/finalize", recordingID)
+	if err := c.sendAuthorized(ctx, http.MethodPost, endpoint, "application/json", body, &payload); err != nil {
+		return nil, err
+	}
+	return &FinalizedRecording{
+		ID:              payload.Recording.ID,
+		DurationSeconds: payload.Recording.Duration,
+		SizeBytes:       payload.SizeBytes,
+	}, nil
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+// RetryPolicy controls how transient failures are retried. MaxAttempts
+// counts the first call, so 1 disables retries.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+	}
+}
+
+// maxServerRetryDelay caps a server-suggested delay so a misbehaving
+// upstream can't stall callers indefinitely.
+const maxServerRetryDelay = 30 * time.Second
+
+// retryable reports whether the request most likely never took effect, so
+// repeating it is safe even for mutations. Aborted is returned for
+// transaction conflicts, which the server has already rolled back.
+// ResourceExhausted is only retried when the server says when to, as it
+// does for rate limits; quotas and oversized requests fail the same way
+// every time.
+func retryable(err error) bool {
+	switch connect.CodeOf(err) {
+	case connect.CodeUnavailable, connect.CodeAborted:
+		return true
+	case connect.CodeResourceExhausted:
+		_, ok := apierr.RetryAfter(err)
+		return ok
+	default:
+		return false
+	}
+}
+
+// backoff returns the wait before the given retry (1-based), with full
+// jitter to avoid synchronized retries from many clients.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < retry && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(int64(delay)) + 1)
+}
+
+func (c *Client) retryInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			attempts := max(c.retry.MaxAttempts, 1)
+			for attempt := 1; ; attempt++ {
+				res, err := next(ctx, req)
+				if err == nil || attempt >= attempts || !retryable(err) {
+					return res, err
+				}
+				delay := c.retry.backoff(attempt)
+				if suggested, ok := apierr.RetryAfter(err); ok {
+					delay = min(suggested, maxServerRetryDelay)
+				}
+				if waitErr := sleep(ctx, delay); waitErr != nil {
+					return res, err
+				}
+			}
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
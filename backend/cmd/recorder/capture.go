@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// defaultCaptureDevice returns the ffmpeg input that usually means "the
+// default microphone" on each platform. To include system audio, point
+// -input at an aggregate/loopback device (BlackHole on macOS, a PulseAudio
+// monitor source on Linux).
+func defaultCaptureDevice() (format string, input string) {
+	switch runtime.GOOS {
+	case "darwin":
+		return "avfoundation", ":0"
+	case "linux":
+		return "pulse", "default"
+	case "windows":
+		return "dshow", ""
+	default:
+		return "", ""
+	}
+}
+
+type capture struct {
+	io.Reader
+	ctx context.Context
+	cmd *exec.Cmd
+}
+
+// startCapture runs ffmpeg writing raw s16le PCM to stdout. Cancelling ctx
+// asks ffmpeg to stop gracefully so it flushes buffered audio before exiting.
+func startCapture(ctx context.Context, ffmpeg string, format string, input string, sampleRate int, channels int) (*capture, error) {
+	args := []string{"-hide_banner", "-loglevel", "error", "-nostdin"}
+	if format != "" {
+		args = append(args, "-f", format)
+	}
+	args = append(args,
+		"-i", input,
+		"-ac", strconv.Itoa(channels),
+		"-ar", strconv.Itoa(sampleRate),
+		"-acodec", "pcm_s16le",
+		"-f", "s16le",
+		"pipe:1",
+	)
+	cmd := exec.CommandContext(ctx, ffmpeg, args...)
+	cmd.Stderr = os.Stderr
+	if runtime.GOOS != "windows" {
+		cmd.Cancel = func() error {
+			return cmd.Process.Signal(os.Interrupt)
+		}
+	}
+	cmd.WaitDelay = 5 * time.Second
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("start ffmpeg: %w", err)
+	}
+	return &capture{Reader: stdout, ctx: ctx, cmd: cmd}, nil
+}
+
+// Wait reaps ffmpeg. ffmpeg exits non-zero when interrupted, so an exit
+// after we cancelled the capture is not an error.
+func (c *capture) Wait() error {
+	err := c.cmd.Wait()
+	if err != nil && c.ctx.Err() == nil {
+		return fmt.Errorf("ffmpeg: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// Command recorder captures system or microphone audio with ffmpeg and
+// streams it to the server's live ingestion endpoint in fixed-size chunks,
+// finalizing the recording when capture stops (Ctrl-C or -max-duration).
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/mvult/secretary/backend/client"
+)
+
+type options struct {
+	server      string
+	token       string
+	email       string
+	password    string
+	name        string
+	ffmpeg      string
+	format      string
+	input       string
+	sampleRate  int
+	channels    int
+	chunk       time.Duration
+	maxDuration time.Duration
+}
+
+func main() {
+	defaultFormat, defaultInput := defaultCaptureDevice()
+	opts := options{}
+	flag.StringVar(&opts.server, "server", os.Getenv("SECRETARY_URL"), "server URL")
+	flag.StringVar(&opts.token, "token", os.Getenv("SECRETARY_TOKEN"), "bearer token")
+	flag.StringVar(&opts.email, "email", os.Getenv("SECRETARY_EMAIL"), "log in with this email instead of a token")
+	flag.StringVar(&opts.password, "password", os.Getenv("SECRETARY_PASSWORD"), "password for -email")
+	flag.StringVar(&opts.name, "name", "", "recording name (default: Recording <date time>)")
+	flag.StringVar(&opts.ffmpeg, "ffmpeg", "ffmpeg", "path to the ffmpeg binary")
+	flag.StringVar(&opts.format, "format", defaultFormat, "ffmpeg input format (avfoundation, pulse, alsa, dshow, ...)")
+	flag.StringVar(&opts.input, "input", defaultInput, "ffmpeg input device, e.g. an aggregate device that mixes mic and system audio")
+	flag.IntVar(&opts.sampleRate, "sample-rate", 16000, "capture sample rate in Hz")
+	flag.IntVar(&opts.channels, "channels", 1, "number of capture channels")
+	flag.DurationVar(&opts.chunk, "chunk", 10*time.Second, "audio length per uploaded chunk")
+	flag.DurationVar(&opts.maxDuration, "max-duration", 0, "stop automatically after this long (0 = until interrupted)")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, opts); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, opts options) error {
+	if opts.server == "" {
+		return errors.New("-server (or SECRETARY_URL) is required")
+	}
+	if opts.token == "" && opts.email == "" {
+		return errors.New("pass -token or -email/-password")
+	}
+	if opts.input == "" {
+		return errors.New("-input is required on this platform")
+	}
+	if opts.chunk < time.Second {
+		return errors.New("-chunk must be at least 1s")
+	}
+	if opts.name == "" {
+		opts.name = "Recording " + time.Now().Format("2006-01-02 15:04")
+	}
+
+	var clientOpts []client.Option
+	if opts.token != "" {
+		clientOpts = append(clientOpts, client.WithToken(opts.token))
+	}
+	if opts.email != "" {
+		clientOpts = append(clientOpts, client.WithCredentials(opts.email, opts.password))
+	}
+	c := client.New(opts.server, clientOpts...)
+
+	// Uploads and finalization must outlive the capture context so that
+	// Ctrl-C stops recording without abandoning audio already captured.
+	uploadCtx := context.WithoutCancel(ctx)
+	live, err := c.StartLiveRecording(uploadCtx, client.LiveRecordingOptions{
+		Name:       opts.name,
+		SampleRate: opts.sampleRate,
+		Channels:   opts.channels,
+	})
+	if err != nil {
+		return fmt.Errorf("start recording: %w", err)
+	}
+	log.Printf("recording %d started (%s); press Ctrl-C to stop", live.ID, live.Name)
+
+	captureCtx := ctx
+	if opts.maxDuration > 0 {
+		var cancel context.CancelFunc
+		captureCtx, cancel = context.WithTimeout(ctx, opts.maxDuration)
+		defer cancel()
+	}
+	capture, err := startCapture(captureCtx, opts.ffmpeg, opts.format, opts.input, opts.sampleRate, opts.channels)
+	if err != nil {
+		return err
+	}
+
+	chunkBytes := chunkSize(opts.chunk, opts.sampleRate, opts.channels, live.MaxChunkBytes)
+	streamer := &streamer{
+		sink:        c,
+		recordingID: live.ID,
+		chunkBytes:  chunkBytes,
+		frameBytes:  opts.channels * 2,
+		logf:        log.Printf,
+	}
+	chunks, streamErr := streamer.stream(uploadCtx, capture)
+	if captureErr := capture.Wait(); captureErr != nil && streamErr == nil && chunks == 0 {
+		return captureErr
+	}
+	if streamErr != nil {
+		return fmt.Errorf("recording %d: %w", live.ID, streamErr)
+	}
+	if chunks == 0 {
+		return fmt.Errorf("recording %d: no audio was captured", live.ID)
+	}
+
+	finalized, err := c.FinalizeLiveRecording(uploadCtx, live.ID, chunks)
+	if err != nil {
+		return fmt.Errorf("finalize recording %d: %w", live.ID, err)
+	}
+	log.Printf("recording %d saved: %ds, %d bytes", finalized.ID, finalized.DurationSeconds, finalized.SizeBytes)
+	return nil
+}
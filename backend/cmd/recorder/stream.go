@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	chunkUploadAttempts = 5
+	chunkRetryDelay     = 2 * time.Second
+)
+
+type chunkSink interface {
+	UploadLiveChunk(ctx context.Context, recordingID int64, seq int, pcm []byte) error
+}
+
+// streamer cuts captured PCM into fixed-size chunks and uploads them in
+// order. Capture keeps reading while an upload is in flight, so a slow
+// network only grows the queue instead of dropping audio.
+type streamer struct {
+	sink        chunkSink
+	recordingID int64
+	chunkBytes  int
+	frameBytes  int
+	logf        func(format string, args ...any)
+}
+
+// stream reads r until EOF and returns the number of chunks uploaded.
+func (s *streamer) stream(ctx context.Context, r io.Reader) (int, error) {
+	queue := make(chan []byte, 64)
+	done := make(chan error, 1)
+	uploaded := 0
+	go func() {
+		var err error
+		for chunk := range queue {
+			if err != nil {
+				continue
+			}
+			if err = s.upload(ctx, uploaded, chunk); err == nil {
+				uploaded++
+			}
+		}
+		done <- err
+	}()
+
+	var readErr error
+	for {
+		buf := make([]byte, s.chunkBytes)
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			// Drop a trailing partial sample frame; the server rejects it.
+			if n -= n % s.frameBytes; n > 0 {
+				queue <- buf[:n]
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			readErr = fmt.Errorf("read audio: %w", err)
+			break
+		}
+	}
+	close(queue)
+	if err := <-done; err != nil {
+		return uploaded, err
+	}
+	return uploaded, readErr
+}
+
+func (s *streamer) upload(ctx context.Context, seq int, chunk []byte) error {
+	var err error
+	for attempt := 1; attempt <= chunkUploadAttempts; attempt++ {
+		if err = s.sink.UploadLiveChunk(ctx, s.recordingID, seq, chunk); err == nil {
+			return nil
+		}
+		if s.logf != nil {
+			s.logf("chunk %d upload failed (attempt %d/%d): %v", seq, attempt, chunkUploadAttempts, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(chunkRetryDelay * time.Duration(attempt)):
+		}
+	}
+	return fmt.Errorf("upload chunk %d: %w", seq, err)
+}
+
+// chunkSize converts a chunk duration into bytes of s16le PCM, capped at the
+// server limit and rounded down to whole sample frames.
+func chunkSize(d time.Duration, sampleRate int, channels int, limit int) int {
+	frameBytes := channels * 2
+	size := int(d.Seconds()*float64(sampleRate)) * frameBytes
+	if limit > 0 && size > limit {
+		size = limit - limit%frameBytes
+	}
+	return max(size, frameBytes)
+}
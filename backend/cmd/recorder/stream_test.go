@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeSink struct {
+	chunks   map[int][]byte
+	failures int
+}
+
+func (f *fakeSink) UploadLiveChunk(_ context.Context, _ int64, seq int, pcm []byte) error {
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("temporary failure")
+	}
+	f.chunks[seq] = append([]byte(nil), pcm...)
+	return nil
+}
+
+func TestStreamerChunksInOrder(t *testing.T) {
+	sink := &fakeSink{chunks: map[int][]byte{}}
+	s := &streamer{sink: sink, recordingID: 1, chunkBytes: 8, frameBytes: 4}
+
+	// 21 bytes: two full chunks, then 5 bytes of which one frame survives.
+	data := bytes.Repeat([]byte{1}, 21)
+	count, err := s.stream(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("stream: %v", err)
+	}
+	if count != 3 || len(sink.chunks[0]) != 8 || len(sink.chunks[1]) != 8 || len(sink.chunks[2]) != 4 {
+		t.Fatalf("unexpected chunks: count=%d sizes=%d,%d,%d", count, len(sink.chunks[0]), len(sink.chunks[1]), len(sink.chunks[2]))
+	}
+}
+
+func TestStreamerGivesUpAfterRetries(t *testing.T) {
+	sink := &fakeSink{chunks: map[int][]byte{}, failures: chunkUploadAttempts}
+	s := &streamer{sink: sink, recordingID: 1, chunkBytes: 4, frameBytes: 2}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := s.stream(ctx, bytes.NewReader([]byte{1, 2, 3, 4})); err == nil {
+		t.Fatalf("expected upload failure")
+	}
+}
+
+func TestChunkSize(t *testing.T) {
+	if got := chunkSize(10*time.Second, 16000, 1, 0); got != 320000 {
+		t.Fatalf("expected 320000 bytes, got %d", got)
+	}
+	if got := chunkSize(time.Minute, 48000, 2, 1<<20+2); got != 1<<20 {
+		t.Fatalf("expected cap at frame-aligned limit, got %d", got)
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// cliConfig is persisted by login so later commands don't need flags.
+type cliConfig struct {
+	Server string `json:"server"`
+	Token  string `json:"token"`
+	UserID int64  `json:"userId"`
+	Email  string `json:"email"`
+}
+
+func cliConfigPath() (string, error) {
+	if path := os.Getenv("SECRETARYCTL_CONFIG"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "secretaryctl", "config.json"), nil
+}
+
+func loadCLIConfig() (cliConfig, error) {
+	var cfg cliConfig
+	path, err := cliConfigPath()
+	if err != nil {
+		return cfg, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, err
+	}
+	return cfg, json.Unmarshal(data, &cfg)
+}
+
+func saveCLIConfig(cfg cliConfig) error {
+	path, err := cliConfigPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	// The file holds a bearer token, so keep it private.
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
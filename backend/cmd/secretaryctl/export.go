@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"connectrpc.com/connect"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func newExportCommand(opts *globalOptions) *cobra.Command {
+	var outPath string
+	var userID int64
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export recordings, their minutes, todos and workspaces as JSON",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			sess, err := opts.session()
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			if userID == 0 {
+				userID = sess.config.UserID
+			}
+
+			var recordings, minutes, todos []proto.Message
+			for recording, err := range sess.client.AllRecordings(ctx, nil) {
+				if err != nil {
+					return err
+				}
+				full, err := sess.client.Recordings.GetRecording(ctx, connect.NewRequest(&secretaryv1.GetRecordingRequest{Id: recording.Id}))
+				if err != nil {
+					return err
+				}
+				recordings = append(recordings, full.Msg.Recording)
+				latest, err := sess.client.Recordings.GetMinutes(ctx, connect.NewRequest(&secretaryv1.GetMinutesRequest{RecordingId: recording.Id}))
+				if connect.CodeOf(err) == connect.CodeNotFound {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				minutes = append(minutes, latest.Msg.Minutes)
+			}
+			for todo, err := range sess.client.AllTodos(ctx, &secretaryv1.ListTodosRequest{UserId: userID}) {
+				if err != nil {
+					return err
+				}
+				todos = append(todos, todo)
+			}
+			workspaces, err := sess.client.Workspaces.ListWorkspaces(ctx, connect.NewRequest(&secretaryv1.ListWorkspacesRequest{}))
+			if err != nil {
+				return err
+			}
+
+			exportedAt := time.Now().UTC()
+			if loc, err := time.LoadLocation(opts.timezone); err == nil {
+				exportedAt = exportedAt.In(loc)
+			}
+			export := struct {
+				ExportedAt string            `json:"exportedAt"`
+				Server     string            `json:"server"`
+				UserID     int64             `json:"userId"`
+				Recordings []json.RawMessage `json:"recordings"`
+				Minutes    []json.RawMessage `json:"minutes"`
+				Todos      []json.RawMessage `json:"todos"`
+				Workspaces []json.RawMessage `json:"workspaces"`
+			}{
+				ExportedAt: exportedAt.Format(time.RFC3339),
+				Server:     sess.client.BaseURL(),
+				UserID:     userID,
+			}
+			if export.Recordings, err = marshalMessages(recordings); err != nil {
+				return err
+			}
+			if export.Minutes, err = marshalMessages(minutes); err != nil {
+				return err
+			}
+			if export.Todos, err = marshalMessages(todos); err != nil {
+				return err
+			}
+			var workspaceMessages []proto.Message
+			for _, workspace := range workspaces.Msg.GetWorkspaces() {
+				workspaceMessages = append(workspaceMessages, workspace)
+			}
+			if export.Workspaces, err = marshalMessages(workspaceMessages); err != nil {
+				return err
+			}
+
+			var out io.Writer = cmd.OutOrStdout()
+			if outPath != "" && outPath != "-" {
+				f, err := os.Create(outPath)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+			encoder := json.NewEncoder(out)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(export)
+		},
+	}
+	cmd.Flags().StringVarP(&outPath, "out", "f", "-", "output file, or - for stdout")
+	cmd.Flags().Int64Var(&userID, "user-id", 0, "whose todos to export (default: the logged-in user)")
+	return cmd
+}
+
+func marshalMessages(messages []proto.Message) ([]json.RawMessage, error) {
+	raw := make([]json.RawMessage, 0, len(messages))
+	for _, message := range messages {
+		data, err := protojson.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		raw = append(raw, data)
+	}
+	return raw, nil
+}
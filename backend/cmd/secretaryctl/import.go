@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/mvult/secretary/backend/client"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/storage"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var defaultImportExtensions = []string{".mp3", ".wav", ".m4a", ".ogg", ".opus", ".webm", ".flac", ".mp4"}
+
+// importFile is an audio file found in the archive being imported.
+type importFile struct {
+	// Path is relative to the archive's root, slash-separated.
+	Path string
+	Size int64
+	open func(ctx context.Context) (io.ReadCloser, error)
+}
+
+// importResult is what happened to one file.
+type importResult struct {
+	Path        string `json:"path"`
+	RecordingID int64  `json:"recordingId,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+func newRecordingsImportCommand(opts *globalOptions) *cobra.Command {
+	var concurrency int
+	var extensions []string
+	var settingsJSON string
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "import SOURCE",
+		Short: "Upload every audio file in a directory or S3 prefix as a new recording",
+		Long: `Upload every audio file in a directory or S3 prefix as a new recording.
+
+SOURCE is a local directory or s3://bucket/prefix. Buckets are reached with
+the server's storage variables: S3_REGION, S3_ENDPOINT, S3_ACCESS_KEY_ID,
+S3_SECRET_ACCESS_KEY, S3_SESSION_TOKEN and S3_PATH_STYLE. Each file becomes
+a recording named after its path without the extension, and is queued for
+transcription like any upload. Progress goes to stderr; the files that
+failed can be imported again on their own.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			asJSON, err := opts.jsonOutput()
+			if err != nil {
+				return err
+			}
+			if concurrency < 1 {
+				return fmt.Errorf("--concurrency must be at least 1, got %d", concurrency)
+			}
+			var settings *secretaryv1.ProcessingSettings
+			if settingsJSON != "" {
+				settings = &secretaryv1.ProcessingSettings{}
+				if err := protojson.Unmarshal([]byte(settingsJSON), settings); err != nil {
+					return fmt.Errorf("invalid --settings: %w", err)
+				}
+			}
+			files, err := listImportFiles(cmd.Context(), args[0], extensions)
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				return fmt.Errorf("no audio files with extensions %s in %s", strings.Join(extensions, ", "), args[0])
+			}
+			if dryRun {
+				for _, f := range files {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s\t%d bytes\n", f.Path, f.Size)
+				}
+				return nil
+			}
+
+			sess, err := opts.session()
+			if err != nil {
+				return err
+			}
+			upload := func(ctx context.Context, f importFile) (int64, error) {
+				body, err := f.open(ctx)
+				if err != nil {
+					return 0, err
+				}
+				defer body.Close()
+				uploaded, err := sess.client.UploadAudio(ctx, body, client.UploadOptions{
+					Name:     strings.TrimSuffix(f.Path, path.Ext(f.Path)),
+					Filename: path.Base(f.Path),
+					Settings: settings,
+				})
+				if err != nil {
+					return 0, err
+				}
+				return uploaded.ID, nil
+			}
+			results := runImport(cmd.Context(), files, concurrency, upload, cmd.ErrOrStderr())
+
+			failed := 0
+			for _, r := range results {
+				if r.Error != "" {
+					failed++
+				}
+			}
+			if asJSON {
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				if err := encoder.Encode(results); err != nil {
+					return err
+				}
+			} else {
+				tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+				fmt.Fprintln(tw, "PATH\tRECORDING\tERROR")
+				for _, r := range results {
+					id := "-"
+					if r.RecordingID != 0 {
+						id = fmt.Sprint(r.RecordingID)
+					}
+					fmt.Fprintf(tw, "%s\t%s\t%s\n", r.Path, id, r.Error)
+				}
+				if err := tw.Flush(); err != nil {
+					return err
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d files failed to import", failed, len(files))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().IntVar(&concurrency, "concurrency", 4, "files uploaded at once")
+	cmd.Flags().StringSliceVar(&extensions, "ext", defaultImportExtensions, "extensions of the files to import")
+	cmd.Flags().StringVar(&settingsJSON, "settings", "", `processing settings for every recording as JSON, e.g. {"language": "es"}`)
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only list the files that would be imported")
+	return cmd
+}
+
+// runImport uploads files, at most concurrency at a time, reporting each
+// one to progress as it finishes. Results are in the order of files.
+func runImport(ctx context.Context, files []importFile, concurrency int, upload func(context.Context, importFile) (int64, error), progress io.Writer) []importResult {
+	results := make([]importResult, len(files))
+	var mu sync.Mutex
+	done := 0
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	for i, f := range files {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() { <-slots; wg.Done() }()
+			result := importResult{Path: f.Path}
+			if err := ctx.Err(); err != nil {
+				result.Error = err.Error()
+			} else if id, err := upload(ctx, f); err != nil {
+				result.Error = err.Error()
+			} else {
+				result.RecordingID = id
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			results[i] = result
+			done++
+			if result.Error != "" {
+				fmt.Fprintf(progress, "[%d/%d] %s: %s\n", done, len(files), f.Path, result.Error)
+			} else {
+				fmt.Fprintf(progress, "[%d/%d] %s: recording %d\n", done, len(files), f.Path, result.RecordingID)
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// listImportFiles finds the files with the given extensions under source,
+// sorted by path.
+func listImportFiles(ctx context.Context, source string, extensions []string) ([]importFile, error) {
+	wanted := func(name string) bool {
+		return slices.ContainsFunc(extensions, func(ext string) bool {
+			return strings.EqualFold(path.Ext(name), "."+strings.TrimPrefix(ext, "."))
+		})
+	}
+	if rest, ok := strings.CutPrefix(source, "s3://"); ok {
+		return listBucketFiles(ctx, rest, wanted)
+	}
+
+	var files []importFile
+	err := filepath.WalkDir(source, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() || !wanted(entry.Name()) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(source, name)
+		if err != nil {
+			return err
+		}
+		files = append(files, importFile{
+			Path: filepath.ToSlash(rel),
+			Size: info.Size(),
+			// Files are seekable, so the client retries their uploads.
+			open: func(context.Context) (io.ReadCloser, error) { return os.Open(name) },
+		})
+		return nil
+	})
+	return files, err
+}
+
+// listBucketFiles lists "bucket/prefix" with the S3 credentials in the
+// environment.
+func listBucketFiles(ctx context.Context, location string, wanted func(string) bool) ([]importFile, error) {
+	bucket, prefix, _ := strings.Cut(location, "/")
+	if bucket == "" {
+		return nil, errors.New("S3 source must look like s3://bucket/prefix")
+	}
+	store, err := storage.NewS3(storage.S3Config{
+		Bucket:          bucket,
+		Region:          os.Getenv("S3_REGION"),
+		Endpoint:        os.Getenv("S3_ENDPOINT"),
+		AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("S3_SESSION_TOKEN"),
+		PathStyle:       os.Getenv("S3_PATH_STYLE") == "true",
+	})
+	if err != nil {
+		return nil, err
+	}
+	objects, err := store.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	var files []importFile
+	for _, object := range objects {
+		if !wanted(object.Key) {
+			continue
+		}
+		key := object.Key
+		rel := strings.TrimPrefix(strings.TrimPrefix(key, prefix), "/")
+		if rel == "" {
+			rel = path.Base(key)
+		}
+		files = append(files, importFile{
+			Path: rel,
+			Size: object.Size,
+			open: func(ctx context.Context) (io.ReadCloser, error) { return store.Open(ctx, key) },
+		})
+	}
+	return files, nil
+}
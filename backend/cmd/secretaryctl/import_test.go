@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestListImportFilesFiltersByExtension(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"2023/board.MP3", "2023/notes.txt", "standup.wav", "2024/q1/kickoff.m4a"} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files, err := listImportFiles(context.Background(), dir, []string{"mp3", ".wav", ".m4a"})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	var paths []string
+	for _, f := range files {
+		paths = append(paths, f.Path)
+	}
+	if got := strings.Join(paths, ","); got != "2023/board.MP3,2024/q1/kickoff.m4a,standup.wav" {
+		t.Fatalf("paths = %s", got)
+	}
+	body, err := files[2].open(context.Background())
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	body.Close()
+}
+
+func TestRunImportLimitsConcurrencyAndReportsFailures(t *testing.T) {
+	var files []importFile
+	for _, name := range []string{"a.mp3", "b.mp3", "c.mp3", "d.mp3", "e.mp3"} {
+		files = append(files, importFile{Path: name})
+	}
+	var running, most atomic.Int32
+	upload := func(_ context.Context, f importFile) (int64, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := most.Load()
+			if n <= m || most.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		if f.Path == "c.mp3" {
+			return 0, errors.New("quota exceeded")
+		}
+		return int64(f.Path[0]), nil
+	}
+	var progress bytes.Buffer
+	results := runImport(context.Background(), files, 2, upload, &progress)
+
+	if most.Load() > 2 {
+		t.Fatalf("%d uploads ran at once, want at most 2", most.Load())
+	}
+	if results[2].Error != "quota exceeded" || results[4].RecordingID != 'e' || results[4].Path != "e.mp3" {
+		t.Fatalf("results = %+v", results)
+	}
+	if lines := strings.Count(progress.String(), "\n"); lines != 5 || !strings.Contains(progress.String(), "[5/5]") {
+		t.Fatalf("progress = %q", progress.String())
+	}
+}
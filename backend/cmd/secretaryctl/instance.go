@@ -0,0 +1,112 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/spf13/cobra"
+)
+
+func newInstanceCommand(opts *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instance",
+		Short: "Move the whole instance to another server, or restore it from a backup (admins)",
+	}
+	cmd.AddCommand(newInstanceExportCommand(opts), newInstanceImportCommand(opts))
+	return cmd
+}
+
+func newInstanceExportCommand(opts *globalOptions) *cobra.Command {
+	var outPath string
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Archive every table and stored file and download the archive",
+		Long: `Archive every table and stored file and download the archive.
+
+The archive stays in the server's storage too, under archives/, until it is
+deleted there. Encrypted transcripts and audio stay encrypted: the server
+it is imported into needs the same master key.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			sess, err := opts.session()
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			resp, err := sess.client.Admin.ExportInstance(ctx, connect.NewRequest(&secretaryv1.ExportInstanceRequest{}))
+			if err != nil {
+				return err
+			}
+			archive := resp.Msg.Archive
+			if outPath == "" {
+				outPath = archive.Name
+			}
+			f, err := os.Create(outPath)
+			if err != nil {
+				return err
+			}
+			if _, err := sess.client.DownloadInstanceArchive(ctx, archive.Name, f); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			rows := int64(0)
+			for _, table := range archive.Tables {
+				rows += table.Rows
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Wrote %s (%d bytes): %d rows in %d tables, %d files\n", outPath, archive.SizeBytes, rows, len(archive.Tables), archive.MediaCount)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&outPath, "file", "f", "", "where to write the archive (default: its name, in the current directory)")
+	return cmd
+}
+
+func newInstanceImportCommand(opts *globalOptions) *cobra.Command {
+	var confirmed bool
+	cmd := &cobra.Command{
+		Use:   "import FILE",
+		Short: "Replace all data on the server with an archive's",
+		Long: `Replace all data on the server with an archive's.
+
+Every user, recording and setting on the server is replaced, so --yes is
+required. The server must run the same version as the one that exported
+the archive, or a newer one. Sign in again afterwards with an account from
+the archive, and change JWT_SECRET unless the server was new.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !confirmed {
+				return errors.New("importing replaces all data on the server; pass --yes to go ahead")
+			}
+			sess, err := opts.session()
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			name := filepath.Base(args[0])
+			if _, err := sess.client.UploadInstanceArchive(ctx, name, f); err != nil {
+				return err
+			}
+			resp, err := sess.client.Admin.ImportInstance(ctx, connect.NewRequest(&secretaryv1.ImportInstanceRequest{Name: name, ReplaceAllData: true}))
+			if err != nil {
+				return err
+			}
+			archive := resp.Msg.Archive
+			fmt.Fprintf(cmd.OutOrStdout(), "Imported %s, exported %s: %d tables, %d files\n", name, archive.CreatedAt, len(archive.Tables), archive.MediaCount)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&confirmed, "yes", false, "replace all data on the server")
+	return cmd
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+)
+
+func newJobsCommand(opts *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jobs",
+		Short: "Watch transcription and summarization, and retry or cancel it (admins)",
+	}
+	cmd.AddCommand(
+		newJobsListCommand(opts),
+		newJobsShowCommand(opts),
+		newJobsRetryCommand(opts),
+		newJobsCancelCommand(opts),
+		newJobsPurgeCommand(opts),
+	)
+	return cmd
+}
+
+func newJobsListCommand(opts *globalOptions) *cobra.Command {
+	var states []string
+	var req secretaryv1.ListJobsRequest
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List queued, running and failed jobs, longest in their state first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			asJSON, err := opts.jsonOutput()
+			if err != nil {
+				return err
+			}
+			for _, value := range states {
+				state, err := parseJobState(value)
+				if err != nil {
+					return err
+				}
+				req.States = append(req.States, state)
+			}
+			sess, err := opts.session()
+			if err != nil {
+				return err
+			}
+			resp, err := sess.client.Jobs.ListJobs(cmd.Context(), connect.NewRequest(&req))
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				jobs := make([]proto.Message, len(resp.Msg.Jobs))
+				for i, job := range resp.Msg.Jobs {
+					jobs[i] = job
+				}
+				return writeProtoJSON(cmd.OutOrStdout(), jobs)
+			}
+			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			fmt.Fprintln(tw, "RECORDING\tSTATE\tSTAGE\tATTEMPTS\tSINCE\tNAME\tERROR")
+			for _, job := range resp.Msg.Jobs {
+				fmt.Fprintf(tw, "%d\t%s\t%s\t%d\t%s\t%s\t%s\n", job.RecordingId, jobStateName(job.State), stageName(job.Stage), job.Attempts, job.UpdatedAt, job.RecordingName, job.Error)
+			}
+			return tw.Flush()
+		},
+	}
+	cmd.Flags().StringSliceVar(&states, "state", nil, "only jobs in these states: queued, running, failed")
+	cmd.Flags().Int32Var(&req.Limit, "limit", 0, "at most this many jobs (default 100)")
+	return cmd
+}
+
+func newJobsShowCommand(opts *globalOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show RECORDING_ID",
+		Short: "Show a job and every attempt at it, with their errors",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			asJSON, err := opts.jsonOutput()
+			if err != nil {
+				return err
+			}
+			id, err := parseRecordingID(args[0])
+			if err != nil {
+				return err
+			}
+			sess, err := opts.session()
+			if err != nil {
+				return err
+			}
+			resp, err := sess.client.Jobs.GetJob(cmd.Context(), connect.NewRequest(&secretaryv1.GetJobRequest{RecordingId: id}))
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				return writeProtoJSON(cmd.OutOrStdout(), []proto.Message{resp.Msg})
+			}
+			job := resp.Msg.Job
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Recording %d %q: %s in %s since %s\n", job.RecordingId, job.RecordingName, jobStateName(job.State), stageName(job.Stage), job.UpdatedAt)
+			if job.Error != "" {
+				fmt.Fprintf(out, "Error: %s\n", job.Error)
+			}
+			tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(tw, "STAGE\tATTEMPT\tSTATUS\tSTARTED\tFINISHED\tERROR")
+			for _, a := range resp.Msg.Attempts {
+				status := strings.ToLower(strings.TrimPrefix(a.Status.String(), "PROCESSING_ATTEMPT_STATUS_"))
+				fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\t%s\n", stageName(a.Stage), a.Attempt, status, a.StartedAt, a.FinishedAt, a.Error)
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+func newJobsRetryCommand(opts *globalOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "retry RECORDING_ID",
+		Short: "Queue a failed job again at the stage it failed in",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseRecordingID(args[0])
+			if err != nil {
+				return err
+			}
+			sess, err := opts.session()
+			if err != nil {
+				return err
+			}
+			resp, err := sess.client.Jobs.RetryJob(cmd.Context(), connect.NewRequest(&secretaryv1.RetryJobRequest{RecordingId: id}))
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Queued %s of recording %d, attempt %d\n", stageName(resp.Msg.Job.Stage), id, resp.Msg.Job.Attempts)
+			return nil
+		},
+	}
+}
+
+func newJobsCancelCommand(opts *globalOptions) *cobra.Command {
+	var reason string
+	cmd := &cobra.Command{
+		Use:   "cancel RECORDING_ID",
+		Short: "Fail a queued or running job",
+		Long: `Fail a queued or running job.
+
+A worker already running the job isn't stopped. If it still reports a
+result, the recording moves on as if it had been retried.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseRecordingID(args[0])
+			if err != nil {
+				return err
+			}
+			sess, err := opts.session()
+			if err != nil {
+				return err
+			}
+			if _, err := sess.client.Jobs.CancelJob(cmd.Context(), connect.NewRequest(&secretaryv1.CancelJobRequest{RecordingId: id, Reason: reason})); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Cancelled processing of recording %d\n", id)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&reason, "reason", "", "why, kept as the job's error")
+	return cmd
+}
+
+func newJobsPurgeCommand(opts *globalOptions) *cobra.Command {
+	var req secretaryv1.PurgeFailedJobsRequest
+	cmd := &cobra.Command{
+		Use:   "purge [RECORDING_ID]",
+		Short: "Take failed jobs off the list; the recordings stay failed",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				id, err := parseRecordingID(args[0])
+				if err != nil {
+					return err
+				}
+				req.RecordingId = id
+			}
+			sess, err := opts.session()
+			if err != nil {
+				return err
+			}
+			resp, err := sess.client.Jobs.PurgeFailedJobs(cmd.Context(), connect.NewRequest(&req))
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Purged %d failed jobs\n", resp.Msg.Purged)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&req.FailedBefore, "before", "", "only jobs that failed before this RFC3339 time")
+	return cmd
+}
+
+func parseJobState(value string) (secretaryv1.JobState, error) {
+	name := "JOB_STATE_" + strings.ToUpper(strings.TrimSpace(value))
+	state, ok := secretaryv1.JobState_value[name]
+	if !ok || state == 0 {
+		return 0, fmt.Errorf("unknown job state %q", value)
+	}
+	return secretaryv1.JobState(state), nil
+}
+
+func jobStateName(state secretaryv1.JobState) string {
+	return strings.ToLower(strings.TrimPrefix(state.String(), "JOB_STATE_"))
+}
+
+func stageName(stage secretaryv1.ProcessingStage) string {
+	return strings.ToLower(strings.TrimPrefix(stage.String(), "PROCESSING_STAGE_"))
+}
+
+func parseRecordingID(value string) (int64, error) {
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid recording ID %q", value)
+	}
+	return id, nil
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"testing"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+)
+
+func TestParseJobState(t *testing.T) {
+	state, err := parseJobState(" Failed ")
+	if err != nil || state != secretaryv1.JobState_JOB_STATE_FAILED {
+		t.Fatalf("expected failed, got %v (%v)", state, err)
+	}
+	if jobStateName(state) != "failed" {
+		t.Fatalf("unexpected state name %q", jobStateName(state))
+	}
+	for _, value := range []string{"", "unspecified", "done"} {
+		if _, err := parseJobState(value); err == nil {
+			t.Fatalf("expected %q to be rejected", value)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mvult/secretary/backend/client"
+	"github.com/spf13/cobra"
+)
+
+func newLoginCommand(opts *globalOptions) *cobra.Command {
+	var email string
+	var passwordStdin bool
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Log in and save the token for later commands",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := loadCLIConfig()
+			if err != nil {
+				return err
+			}
+			if opts.server != "" {
+				cfg.Server = opts.server
+			}
+			if cfg.Server == "" {
+				return errors.New("pass --server the first time you log in")
+			}
+			if email == "" {
+				return errors.New("--email is required")
+			}
+
+			password := os.Getenv("SECRETARY_PASSWORD")
+			if passwordStdin || password == "" {
+				if !passwordStdin {
+					fmt.Fprint(cmd.ErrOrStderr(), "Password: ")
+				}
+				line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+				if err != nil && line == "" {
+					return fmt.Errorf("read password: %w", err)
+				}
+				password = strings.TrimRight(line, "\r\n")
+			}
+
+			result, err := client.New(cfg.Server).Login(cmd.Context(), email, password)
+			if err != nil {
+				return err
+			}
+			cfg.Token = result.Token
+			cfg.UserID = result.User.ID
+			cfg.Email = email
+			if err := saveCLIConfig(cfg); err != nil {
+				return fmt.Errorf("save config: %w", err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Logged in to %s as %s %s (user %d)\n", cfg.Server, result.User.FirstName, result.User.LastName, result.User.ID)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&email, "email", "", "account email")
+	cmd.Flags().BoolVar(&passwordStdin, "password-stdin", false, "read the password from stdin without prompting")
+	return cmd
+}
@@ -0,0 +1,15 @@
+// Command secretaryctl is a command-line client for the Secretary API,
+// intended for admins and automation.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
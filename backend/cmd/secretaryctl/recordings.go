@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/mvult/secretary/backend/client"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+func newRecordingsCommand(opts *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recordings",
+		Short: "List, upload and import recordings",
+	}
+	cmd.AddCommand(newRecordingsListCommand(opts), newRecordingsUploadCommand(opts), newRecordingsImportCommand(opts))
+	return cmd
+}
+
+func newRecordingsListCommand(opts *globalOptions) *cobra.Command {
+	var req secretaryv1.ListRecordingsRequest
+	var audio string
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List recordings, newest first",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			asJSON, err := opts.jsonOutput()
+			if err != nil {
+				return err
+			}
+			sess, err := opts.session()
+			if err != nil {
+				return err
+			}
+			switch audio {
+			case "":
+			case "yes":
+				req.HasAudio = proto.Bool(true)
+			case "no":
+				req.HasAudio = proto.Bool(false)
+			default:
+				return fmt.Errorf("--audio must be yes or no, got %q", audio)
+			}
+
+			var recordings []proto.Message
+			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			if !asJSON {
+				fmt.Fprintln(tw, "ID\tCREATED\tDURATION\tNAME")
+			}
+			for recording, err := range sess.client.AllRecordings(cmd.Context(), &req) {
+				if err != nil {
+					return err
+				}
+				if asJSON {
+					recordings = append(recordings, recording)
+					continue
+				}
+				fmt.Fprintf(tw, "%d\t%s\t%ds\t%s\n", recording.Id, recording.CreatedAt, recording.Duration, recording.Name)
+			}
+			if asJSON {
+				return writeProtoJSON(cmd.OutOrStdout(), recordings)
+			}
+			return tw.Flush()
+		},
+	}
+	cmd.Flags().Int64Var(&req.ParticipantId, "participant-id", 0, "only recordings this user spoke in")
+	cmd.Flags().StringVar(&req.CreatedAfter, "after", "", "only recordings created at or after this RFC3339 time")
+	cmd.Flags().StringVar(&req.CreatedBefore, "before", "", "only recordings created before this RFC3339 time")
+	cmd.Flags().StringVar(&audio, "audio", "", "only recordings with (yes) or without (no) audio")
+	cmd.Flags().StringVar(&req.Query, "query", "", "only recordings whose name, summary or transcript contains this text")
+	return cmd
+}
+
+func newRecordingsUploadCommand(opts *globalOptions) *cobra.Command {
+	var name, contentType string
+	cmd := &cobra.Command{
+		Use:   "upload FILE",
+		Short: "Upload an audio file as a new recording",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sess, err := opts.session()
+			if err != nil {
+				return err
+			}
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if name == "" {
+				name = strings.TrimSuffix(filepath.Base(args[0]), filepath.Ext(args[0]))
+			}
+
+			uploaded, err := sess.client.UploadAudio(cmd.Context(), f, client.UploadOptions{
+				Name:        name,
+				Filename:    filepath.Base(args[0]),
+				ContentType: contentType,
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Uploaded recording %d (%q, %d bytes)\n", uploaded.ID, uploaded.Name, uploaded.SizeBytes)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&name, "name", "", "recording name (default: file name)")
+	cmd.Flags().StringVar(&contentType, "content-type", "", "audio MIME type (default: from the file extension)")
+	return cmd
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/mvult/secretary/backend/client"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+type globalOptions struct {
+	server   string
+	token    string
+	output   string
+	timezone string
+}
+
+func newRootCommand() *cobra.Command {
+	opts := &globalOptions{}
+	root := &cobra.Command{
+		Use:           "secretaryctl",
+		Short:         "Command-line client for the Secretary API",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	root.PersistentFlags().StringVar(&opts.server, "server", os.Getenv("SECRETARY_URL"), "server URL (default from SECRETARY_URL or the saved login)")
+	root.PersistentFlags().StringVar(&opts.token, "token", os.Getenv("SECRETARY_TOKEN"), "bearer token (default from SECRETARY_TOKEN or the saved login)")
+	root.PersistentFlags().StringVarP(&opts.output, "output", "o", "table", "output format: table or json")
+	root.PersistentFlags().StringVar(&opts.timezone, "timezone", os.Getenv("SECRETARY_TIMEZONE"), "IANA time zone for timestamps, e.g. Europe/Madrid (default UTC)")
+
+	root.AddCommand(
+		newLoginCommand(opts),
+		newTodosCommand(opts),
+		newRecordingsCommand(opts),
+		newExportCommand(opts),
+		newInstanceCommand(opts),
+		newJobsCommand(opts),
+	)
+	return root
+}
+
+// session is the resolved server, credentials and client for a command.
+type session struct {
+	client *client.Client
+	config cliConfig
+}
+
+func (o *globalOptions) session() (*session, error) {
+	cfg, err := loadCLIConfig()
+	if err != nil {
+		return nil, err
+	}
+	if o.server != "" {
+		cfg.Server = o.server
+	}
+	if o.token != "" {
+		cfg.Token = o.token
+	}
+	if cfg.Server == "" {
+		return nil, errors.New("no server configured; pass --server or run secretaryctl login")
+	}
+	if cfg.Token == "" {
+		return nil, errors.New("not logged in; run secretaryctl login or pass --token")
+	}
+	if _, err := time.LoadLocation(o.timezone); err != nil {
+		return nil, fmt.Errorf("invalid --timezone: %w", err)
+	}
+	return &session{client: client.New(cfg.Server, client.WithToken(cfg.Token), client.WithTimezone(o.timezone)), config: cfg}, nil
+}
+
+func (o *globalOptions) jsonOutput() (bool, error) {
+	switch o.output {
+	case "json":
+		return true, nil
+	case "table", "":
+		return false, nil
+	default:
+		return false, fmt.Errorf("unknown output format %q", o.output)
+	}
+}
+
+func writeProtoJSON(w io.Writer, messages []proto.Message) error {
+	raw, err := marshalMessages(messages)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(raw)
+}
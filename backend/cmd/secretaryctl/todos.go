@@ -0,0 +1,181 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTodosCommand(opts *globalOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "todos",
+		Short: "List and create todos",
+	}
+	cmd.AddCommand(newTodosListCommand(opts), newTodosCreateCommand(opts))
+	return cmd
+}
+
+func newTodosListCommand(opts *globalOptions) *cobra.Command {
+	var userID, recordingID int64
+	var statuses []string
+	var query, sort string
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List todos for a user or recording",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			asJSON, err := opts.jsonOutput()
+			if err != nil {
+				return err
+			}
+			sess, err := opts.session()
+			if err != nil {
+				return err
+			}
+			req := &secretaryv1.ListTodosRequest{UserId: userID}
+			if recordingID > 0 {
+				req.RecordingId = &recordingID
+			} else if req.UserId == 0 {
+				req.UserId = sess.config.UserID
+			}
+			for _, status := range statuses {
+				value, err := parseTodoStatus(status)
+				if err != nil {
+					return err
+				}
+				req.Statuses = append(req.Statuses, value)
+			}
+			req.Query = query
+			if req.Sort, err = parseTodoSort(sort); err != nil {
+				return err
+			}
+
+			var todos []proto.Message
+			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			if !asJSON {
+				fmt.Fprintln(tw, "ID\tSTATUS\tDUE\tNAME")
+			}
+			for todo, err := range sess.client.AllTodos(cmd.Context(), req) {
+				if err != nil {
+					return err
+				}
+				if asJSON {
+					todos = append(todos, todo)
+					continue
+				}
+				fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", todo.Id, todoStatusName(todo.Status), todo.DueAt, todo.Name)
+			}
+			if asJSON {
+				return writeProtoJSON(cmd.OutOrStdout(), todos)
+			}
+			return tw.Flush()
+		},
+	}
+	cmd.Flags().Int64Var(&userID, "user-id", 0, "owner of the todos (default: the logged-in user)")
+	cmd.Flags().Int64Var(&recordingID, "recording-id", 0, "list todos created from this recording instead")
+	cmd.Flags().StringSliceVar(&statuses, "status", nil, "only show todos with these statuses (todo, doing, done, blocked, skipped)")
+	cmd.Flags().StringVar(&query, "query", "", "only show todos whose name or description contains this text")
+	cmd.Flags().StringVar(&sort, "sort", "created", "sort order: created, created-asc, due, due-desc, updated, priority")
+	return cmd
+}
+
+func newTodosCreateCommand(opts *globalOptions) *cobra.Command {
+	var req secretaryv1.CreateTodoRequest
+	var status, due, priority string
+	cmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create a todo",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			asJSON, err := opts.jsonOutput()
+			if err != nil {
+				return err
+			}
+			sess, err := opts.session()
+			if err != nil {
+				return err
+			}
+			req.Name = args[0]
+			if req.UserId == 0 {
+				req.UserId = sess.config.UserID
+			}
+			if req.Status, err = parseTodoStatus(status); err != nil {
+				return err
+			}
+			if priority != "" {
+				if req.Priority, err = parseTodoPriority(priority); err != nil {
+					return err
+				}
+			}
+			if due != "" {
+				dueAt, err := time.Parse(time.RFC3339, due)
+				if err != nil {
+					return errors.New("--due must be an RFC3339 timestamp")
+				}
+				req.DueAt = dueAt.UTC().Format(time.RFC3339)
+			}
+
+			res, err := sess.client.Todos.CreateTodo(cmd.Context(), connect.NewRequest(&req))
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				return writeProtoJSON(cmd.OutOrStdout(), []proto.Message{res.Msg.Todo})
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Created todo %d\n", res.Msg.Todo.GetId())
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&req.Desc, "desc", "", "description")
+	cmd.Flags().StringVar(&status, "status", "todo", "initial status")
+	cmd.Flags().Int64Var(&req.UserId, "user-id", 0, "owner (default: the logged-in user)")
+	cmd.Flags().Int64Var(&req.CreatedAtRecordingId, "recording-id", 0, "recording the todo came from")
+	cmd.Flags().StringVar(&due, "due", "", "due time as RFC3339, e.g. 2026-10-20T15:00:00Z")
+	cmd.Flags().StringVar(&priority, "priority", "", "priority: low, medium or high")
+	return cmd
+}
+
+func parseTodoStatus(value string) (secretaryv1.TodoStatus, error) {
+	name := "TODO_STATUS_" + strings.ToUpper(strings.TrimSpace(value))
+	status, ok := secretaryv1.TodoStatus_value[name]
+	if !ok || status == 0 {
+		return 0, fmt.Errorf("unknown todo status %q", value)
+	}
+	return secretaryv1.TodoStatus(status), nil
+}
+
+func parseTodoPriority(value string) (secretaryv1.TodoPriority, error) {
+	name := "TODO_PRIORITY_" + strings.ToUpper(strings.TrimSpace(value))
+	priority, ok := secretaryv1.TodoPriority_value[name]
+	if !ok || priority == 0 {
+		return 0, fmt.Errorf("unknown todo priority %q", value)
+	}
+	return secretaryv1.TodoPriority(priority), nil
+}
+
+var todoSorts = map[string]secretaryv1.TodoSort{
+	"created":     secretaryv1.TodoSort_TODO_SORT_CREATED_AT_DESC,
+	"created-asc": secretaryv1.TodoSort_TODO_SORT_CREATED_AT_ASC,
+	"due":         secretaryv1.TodoSort_TODO_SORT_DUE_AT_ASC,
+	"due-desc":    secretaryv1.TodoSort_TODO_SORT_DUE_AT_DESC,
+	"updated":     secretaryv1.TodoSort_TODO_SORT_UPDATED_AT_DESC,
+	"priority":    secretaryv1.TodoSort_TODO_SORT_PRIORITY_DESC,
+}
+
+func parseTodoSort(value string) (secretaryv1.TodoSort, error) {
+	sort, ok := todoSorts[strings.ToLower(strings.TrimSpace(value))]
+	if !ok {
+		return 0, fmt.Errorf("unknown sort %q", value)
+	}
+	return sort, nil
+}
+
+func todoStatusName(status secretaryv1.TodoStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "TODO_STATUS_"))
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"testing"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+)
+
+func TestParseTodoStatus(t *testing.T) {
+	status, err := parseTodoStatus(" Doing ")
+	if err != nil || status != secretaryv1.TodoStatus_TODO_STATUS_DOING {
+		t.Fatalf("expected doing, got %v (%v)", status, err)
+	}
+	if todoStatusName(status) != "doing" {
+		t.Fatalf("unexpected status name %q", todoStatusName(status))
+	}
+	for _, value := range []string{"", "unspecified", "finished"} {
+		if _, err := parseTodoStatus(value); err == nil {
+			t.Fatalf("expected %q to be rejected", value)
+		}
+	}
+}
+
+func TestParseTodoSort(t *testing.T) {
+	sort, err := parseTodoSort("Due")
+	if err != nil || sort != secretaryv1.TodoSort_TODO_SORT_DUE_AT_ASC {
+		t.Fatalf("expected due ascending, got %v (%v)", sort, err)
+	}
+	if _, err := parseTodoSort("assignee"); err == nil {
+		t.Fatal("expected unknown sort to be rejected")
+	}
+}
+
+func TestParseTodoPriority(t *testing.T) {
+	priority, err := parseTodoPriority("High")
+	if err != nil || priority != secretaryv1.TodoPriority_TODO_PRIORITY_HIGH {
+		t.Fatalf("expected high, got %v (%v)", priority, err)
+	}
+	if _, err := parseTodoPriority("unspecified"); err == nil {
+		t.Fatal("expected unspecified priority to be rejected")
+	}
+}
+
+func TestCLIConfigRoundTrip(t *testing.T) {
+	t.Setenv("SECRETARYCTL_CONFIG", t.TempDir()+"/config.json")
+	if err := saveCLIConfig(cliConfig{Server: "http://localhost:8080", Token: "abc", UserID: 3}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	cfg, err := loadCLIConfig()
+	if err != nil || cfg.Token != "abc" || cfg.UserID != 3 {
+		t.Fatalf("unexpected config %+v (%v)", cfg, err)
+	}
+}
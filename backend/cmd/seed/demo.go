@@ -0,0 +1,102 @@
+package main
+
+import "time"
+
+type demoUser struct {
+	FirstName string
+	LastName  string
+	Role      string
+	Email     string
+}
+
+type demoRecording struct {
+	Name    string
+	Age     time.Duration
+	Minutes int32
+	Summary string
+	// Speakers maps the transcript's speaker numbers to indexes in
+	// demoUsers.
+	Speakers   map[int32]int
+	Transcript string
+}
+
+type demoTodo struct {
+	Name   string
+	Desc   string
+	Status string
+	// Owner indexes demoUsers; Recording indexes demoRecordings, or -1
+	// for a todo created outside a meeting.
+	Owner     int
+	Recording int
+	Due       time.Duration
+}
+
+const day = 24 * time.Hour
+
+var demoUsers = []demoUser{
+	{FirstName: "Ada", LastName: "Admin", Role: "admin", Email: "ada@example.com"},
+	{FirstName: "Ben", LastName: "Okafor", Role: "member", Email: "ben@example.com"},
+	{FirstName: "Chloe", LastName: "Martin", Role: "member", Email: "chloe@example.com"},
+}
+
+var demoRecordings = []demoRecording{
+	{
+		Name:    "Weekly planning",
+		Age:     6 * day,
+		Minutes: 32,
+		Summary: "Agreed on the Q3 launch scope, moved the billing migration to next sprint and assigned owners for the onboarding redesign.",
+		Speakers: map[int32]int{
+			1: 0,
+			2: 1,
+			3: 2,
+		},
+		Transcript: `Speaker 1: Morning everyone. Let's start with the launch scope for Q3.
+Speaker 2: I think we should keep the billing migration out of it. It's still blocked on the tax provider.
+Speaker 3: Agreed. The onboarding redesign is ready to go though, the prototypes tested well.
+Speaker 1: Then billing moves to next sprint. Chloe, can you own the onboarding rollout?
+Speaker 3: Yes. I'll need the copy finalized by Thursday.
+Speaker 2: I can draft the copy and send it over tomorrow.
+Speaker 1: Great. Let's also book a review with support before we ship.`,
+	},
+	{
+		Name:    "Customer call: Northwind",
+		Age:     3 * day,
+		Minutes: 45,
+		Summary: "Northwind wants SSO before expanding seats; they reported slow exports on large workspaces. Follow-up demo scheduled.",
+		Speakers: map[int32]int{
+			1: 1,
+			2: 0,
+		},
+		Transcript: `Speaker 1: Thanks for making time. You mentioned exports were slow last week?
+Speaker 2: Yes, anything over a few thousand rows takes minutes. It's blocking our finance team.
+Speaker 1: Understood. We're looking at streaming exports, I'll share a timeline.
+Speaker 2: The other thing is single sign-on. We can't add the remaining seats without it.
+Speaker 1: SSO is on the roadmap for this quarter. Can we schedule a demo once it's in staging?
+Speaker 2: That works. Send me a few slots.`,
+	},
+	{
+		Name:    "Design review",
+		Age:     1 * day,
+		Minutes: 25,
+		Summary: "Reviewed the new dashboard layout. Keep the recording list dense, add participant avatars, revisit empty states.",
+		Speakers: map[int32]int{
+			1: 2,
+			2: 0,
+		},
+		Transcript: `Speaker 1: Here's the new dashboard. Recordings are denser, and each row shows who attended.
+Speaker 2: I like the density. The avatars help a lot when scanning.
+Speaker 1: The empty state still feels bare. I want to add a short guide for first-time users.
+Speaker 2: Good idea. Keep it dismissible.`,
+	},
+}
+
+var demoTodos = []demoTodo{
+	{Name: "Finalize onboarding copy", Desc: "Draft and send the onboarding copy to Chloe.", Status: "doing", Owner: 1, Recording: 0, Due: 1 * day},
+	{Name: "Roll out onboarding redesign", Desc: "Coordinate the release once copy is final.", Status: "todo", Owner: 2, Recording: 0, Due: 5 * day},
+	{Name: "Book support review before launch", Status: "todo", Owner: 0, Recording: 0, Due: 3 * day},
+	{Name: "Move billing migration to next sprint", Status: "done", Owner: 0, Recording: 0},
+	{Name: "Share streaming export timeline with Northwind", Status: "todo", Owner: 1, Recording: 1, Due: 2 * day},
+	{Name: "Schedule SSO demo with Northwind", Desc: "Waiting on SSO reaching staging.", Status: "blocked", Owner: 1, Recording: 1},
+	{Name: "Add first-run guide to dashboard empty state", Status: "todo", Owner: 2, Recording: 2, Due: 7 * day},
+	{Name: "Renew the team's design tool licenses", Status: "skipped", Owner: 0, Recording: -1},
+}
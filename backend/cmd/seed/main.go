@@ -0,0 +1,200 @@
+// Command seed fills an empty database with demo users, recordings with
+// transcripts, todos and a shared workspace, for local development and
+// product demos. It refuses to touch a database that already has users
+// unless -force is given.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+
+	dbconn "github.com/mvult/secretary/backend/internal/db"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/password"
+)
+
+var speakerLine = regexp.MustCompile(`(?m)^Speaker (\d+): (.+)$`)
+
+type summary struct {
+	Users      int
+	Recordings int
+	Todos      int
+}
+
+func main() {
+	password := flag.String("password", "demo", "password for every demo user")
+	force := flag.Bool("force", false, "seed even if the database already has users")
+	flag.Parse()
+
+	_ = godotenv.Load()
+	ctx := context.Background()
+	pool, err := dbconn.Open(ctx, os.Getenv("DATABASE_URL"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer pool.Close()
+
+	result, err := seed(ctx, pool, *password, *force, time.Now())
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("seeded %d users, %d recordings and %d todos\n", result.Users, result.Recordings, result.Todos)
+	for _, user := range demoUsers {
+		fmt.Printf("  %s / %s (%s)\n", user.Email, *password, user.Role)
+	}
+}
+
+// seed writes the demo data in one transaction so a failure leaves the
+// database untouched.
+func seed(ctx context.Context, pool *pgxpool.Pool, demoPassword string, force bool, now time.Time) (summary, error) {
+	queries := db.New(pool)
+	existing, err := queries.CountUsers(ctx)
+	if err != nil {
+		return summary{}, fmt.Errorf("count users: %w", err)
+	}
+	if existing > 0 && !force {
+		return summary{}, errors.New("database already has users; rerun with -force to add the demo data anyway")
+	}
+	hash, err := password.Hash(demoPassword, password.DefaultParams)
+	if err != nil {
+		return summary{}, err
+	}
+
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return summary{}, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := queries.WithTx(tx)
+
+	workspace, err := qtx.CreateWorkspace(ctx, "Demo team")
+	if err != nil {
+		return summary{}, fmt.Errorf("create workspace: %w", err)
+	}
+	userIDs := make([]int32, len(demoUsers))
+	created := 0
+	for i, user := range demoUsers {
+		// A forced reseed reuses demo users instead of duplicating their
+		// email addresses, which login looks up.
+		existing, err := qtx.GetUserByEmail(ctx, text(user.Email))
+		switch {
+		case err == nil:
+			userIDs[i] = existing.ID
+		case errors.Is(err, pgx.ErrNoRows):
+			userIDs[i], err = qtx.CreateUser(ctx, db.CreateUserParams{
+				FirstName:    user.FirstName,
+				LastName:     text(user.LastName),
+				Role:         text(user.Role),
+				Email:        text(user.Email),
+				PasswordHash: text(hash),
+			})
+			if err != nil {
+				return summary{}, fmt.Errorf("create user %s: %w", user.Email, err)
+			}
+			created++
+		default:
+			return summary{}, fmt.Errorf("look up user %s: %w", user.Email, err)
+		}
+		role := "member"
+		if i == 0 {
+			role = "owner"
+		}
+		if err := qtx.AddWorkspaceUser(ctx, db.AddWorkspaceUserParams{WorkspaceID: workspace.ID, UserID: userIDs[i], Role: text(role)}); err != nil {
+			return summary{}, fmt.Errorf("add %s to workspace: %w", user.Email, err)
+		}
+	}
+
+	recordingIDs := make([]int32, len(demoRecordings))
+	for i, rec := range demoRecordings {
+		id, err := qtx.CreateRecording(ctx, db.CreateRecordingParams{
+			CreatedAt:  pgtype.Timestamptz{Time: now.Add(-rec.Age), Valid: true},
+			Name:       text(rec.Name),
+			Transcript: text(rec.Transcript),
+			Summary:    text(rec.Summary),
+			Duration:   pgtype.Int4{Int32: rec.Minutes * 60, Valid: true},
+			Status:     "ready",
+		})
+		if err != nil {
+			return summary{}, fmt.Errorf("create recording %q: %w", rec.Name, err)
+		}
+		recordingIDs[i] = id
+		words := wordsBySpeaker(rec.Transcript)
+		for speaker, user := range rec.Speakers {
+			if err := qtx.AddRecordingParticipant(ctx, db.AddRecordingParticipantParams{
+				RecordingID: id,
+				SpeakerID:   speaker,
+				UserID:      userIDs[user],
+				WordsSpoken: pgtype.Int4{Int32: words[speaker], Valid: true},
+			}); err != nil {
+				return summary{}, fmt.Errorf("add participant to %q: %w", rec.Name, err)
+			}
+		}
+	}
+
+	for _, todo := range demoTodos {
+		arg := db.CreateTodoParams{
+			Name:   todo.Name,
+			Desc:   pgtype.Text{String: todo.Desc, Valid: todo.Desc != ""},
+			Status: text(todo.Status),
+			UserID: pgtype.Int4{Int32: userIDs[todo.Owner], Valid: true},
+		}
+		if todo.Recording >= 0 {
+			arg.CreatedAtRecordingID = pgtype.Int4{Int32: recordingIDs[todo.Recording], Valid: true}
+		}
+		if todo.Due > 0 {
+			arg.DueAt = pgtype.Timestamptz{Time: now.Add(todo.Due), Valid: true}
+		}
+		row, err := qtx.CreateTodo(ctx, arg)
+		if err != nil {
+			return summary{}, fmt.Errorf("create todo %q: %w", todo.Name, err)
+		}
+		if err := qtx.CreateTodoHistory(ctx, db.CreateTodoHistoryParams{
+			TodoID:               row.ID,
+			ActorUserID:          row.UserID,
+			ChangeType:           "create",
+			Name:                 text(row.Name),
+			Desc:                 row.Desc,
+			Status:               row.Status,
+			UserID:               row.UserID,
+			CreatedAtRecordingID: row.CreatedAtRecordingID,
+			UpdatedAtRecordingID: row.UpdatedAtRecordingID,
+		}); err != nil {
+			return summary{}, fmt.Errorf("record history for %q: %w", todo.Name, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return summary{}, err
+	}
+	return summary{Users: created, Recordings: len(demoRecordings), Todos: len(demoTodos)}, nil
+}
+
+// wordsBySpeaker counts the words on each "Speaker N:" line.
+func wordsBySpeaker(transcript string) map[int32]int32 {
+	counts := map[int32]int32{}
+	for _, match := range speakerLine.FindAllStringSubmatch(transcript, -1) {
+		speaker, err := strconv.Atoi(match[1])
+		if err != nil {
+			continue
+		}
+		counts[int32(speaker)] += int32(len(strings.Fields(match[2])))
+	}
+	return counts
+}
+
+func text(value string) pgtype.Text {
+	return pgtype.Text{String: value, Valid: true}
+}
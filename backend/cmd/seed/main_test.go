@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/testdb"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testdb.Main(m))
+}
+
+func TestWordsBySpeaker(t *testing.T) {
+	counts := wordsBySpeaker("Speaker 1: Morning everyone.\nSpeaker 2: Hi there, Ada.\nnot a speaker line\nSpeaker 1: Let's start.")
+	if counts[1] != 4 || counts[2] != 3 || len(counts) != 2 {
+		t.Fatalf("unexpected counts %v", counts)
+	}
+}
+
+func TestSeed(t *testing.T) {
+	ctx := context.Background()
+	pool := testdb.New(t)
+
+	result, err := seed(ctx, pool, "demo", false, time.Now())
+	if err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	if result.Users != len(demoUsers) || result.Recordings != len(demoRecordings) || result.Todos != len(demoTodos) {
+		t.Fatalf("unexpected summary %+v", result)
+	}
+	queries := db.New(pool)
+	recordings, err := queries.ListRecordings(ctx, db.ListRecordingsParams{})
+	if err != nil || len(recordings) != len(demoRecordings) {
+		t.Fatalf("expected %d recordings, got %d (%v)", len(demoRecordings), len(recordings), err)
+	}
+	participants, err := queries.ListRecordingParticipants(ctx, recordings[0].ID)
+	if err != nil || len(participants) == 0 {
+		t.Fatalf("expected participants on %q, got %d (%v)", recordings[0].Name.String, len(participants), err)
+	}
+
+	if _, err := seed(ctx, pool, "demo", false, time.Now()); err == nil {
+		t.Fatal("expected a second seed without -force to be refused")
+	}
+	result, err = seed(ctx, pool, "demo", true, time.Now())
+	if err != nil {
+		t.Fatalf("forced seed: %v", err)
+	}
+	if users, _ := queries.CountUsers(ctx); result.Users != 0 || users != int64(len(demoUsers)) {
+		t.Fatalf("expected a forced seed to reuse the demo users, created %d, total %d", result.Users, users)
+	}
+}
@@ -0,0 +1,526 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/db"
+	"github.com/mvult/secretary/backend/internal/envelope"
+	"github.com/mvult/secretary/backend/internal/errtrack"
+	"github.com/mvult/secretary/backend/internal/mail"
+	"github.com/mvult/secretary/backend/internal/password"
+	"github.com/mvult/secretary/backend/internal/push"
+	"github.com/mvult/secretary/backend/internal/schedule"
+	"github.com/mvult/secretary/backend/internal/server"
+	"github.com/mvult/secretary/backend/internal/storage"
+	"github.com/mvult/secretary/backend/internal/trackers"
+	"github.com/mvult/secretary/backend/internal/wiki"
+)
+
+type config struct {
+	Addr               string
+	DatabaseURL        string
+	ReplicaURLs        []string
+	RedisURL           string
+	JWTSecret          string
+	TokenTTL           time.Duration
+	CORS               server.CORSConfig
+	OpenAIAPIKey       string
+	OpenAIBaseURL      string
+	OpenAIModel        string
+	AISkillsDir        string
+	WhatsAppSessionDB  string
+	AudioStorageDir    string
+	S3                 storage.S3Config
+	ClamAVAddress      string
+	ScanAPIURL         string
+	ScanAPIToken       string
+	FFmpegPath         string
+	TLS                tlsSettings
+	Timeouts           server.TimeoutConfig
+	BodyLimits         server.BodyLimits
+	ShutdownTimeout    time.Duration
+	SkipMigrationCheck bool
+	DBPool             db.PoolOptions
+	SlowQuery          time.Duration
+	MetricsToken       string
+	Providers          []providerSettings
+	Quotas             server.UsageQuotas
+	SMTP               mail.SMTPConfig
+	PublicURL          string
+	GitHub             trackers.GitHubConfig
+	Linear             trackers.LinearConfig
+	Jira               trackers.JiraConfig
+	Schedules          server.ScheduleConfig
+	MasterKey          envelope.MasterKey
+	OldMasterKeys      []envelope.MasterKey
+	KeyRotation        time.Duration
+	DataKeyMaxAge      time.Duration
+	Notion             wiki.NotionConfig
+	Confluence         wiki.ConfluenceConfig
+	WikiAutoPublish    []string
+	SlackWebhookURL    string
+	TeamsWebhookURL    string
+	FCM                push.FCMConfig
+	APNs               push.APNsConfig
+	Sentry             errtrack.SentryConfig
+	AuditSigningKey    []byte
+	ScimToken          string
+	IPAllowlist        server.IPAllowlistConfig
+	PasswordHashing    password.Params
+	PasswordPolicy     password.Policy
+}
+
+// loadConfig reads the server configuration from the environment. It
+// collects every problem instead of stopping at the first so the self-test
+// can report them together.
+func loadConfig() (config, error) {
+	var problems []error
+	cfg := config{
+		Addr:              ":8080",
+		DatabaseURL:       os.Getenv("DATABASE_URL"),
+		ReplicaURLs:       splitList(os.Getenv("DATABASE_REPLICA_URL")),
+		RedisURL:          os.Getenv("REDIS_URL"),
+		JWTSecret:         os.Getenv("JWT_SECRET"),
+		TokenTTL:          time.Duration(24*30*6) * time.Hour,
+		CORS:              server.DefaultCORSConfig(),
+		OpenAIAPIKey:      os.Getenv("OPENAI_API_KEY"),
+		OpenAIBaseURL:     os.Getenv("OPENAI_BASE_URL"),
+		OpenAIModel:       os.Getenv("OPENAI_MODEL"),
+		AISkillsDir:       os.Getenv("AI_SKILLS_DIR"),
+		WhatsAppSessionDB: os.Getenv("WHATSAPP_SESSION_DB"),
+		AudioStorageDir:   "var/audio",
+		FFmpegPath:        "ffmpeg",
+		TLS: tlsSettings{
+			CertFile:         os.Getenv("TLS_CERT_FILE"),
+			KeyFile:          os.Getenv("TLS_KEY_FILE"),
+			AutocertDomains:  splitList(os.Getenv("TLS_AUTOCERT_DOMAINS")),
+			AutocertCacheDir: "var/autocert",
+			AutocertEmail:    os.Getenv("TLS_AUTOCERT_EMAIL"),
+			RedirectAddr:     os.Getenv("HTTP_REDIRECT_ADDR"),
+			HSTSMaxAge:       365 * 24 * time.Hour,
+		},
+		Timeouts:           server.DefaultTimeoutConfig(),
+		BodyLimits:         server.DefaultBodyLimits(),
+		ShutdownTimeout:    5 * time.Second,
+		SkipMigrationCheck: os.Getenv("SKIP_MIGRATION_CHECK") == "true",
+		SlowQuery:          500 * time.Millisecond,
+		MetricsToken:       os.Getenv("METRICS_TOKEN"),
+		SMTP: mail.SMTPConfig{
+			Addr:     os.Getenv("SMTP_ADDR"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("MAIL_FROM"),
+		},
+		PublicURL: os.Getenv("PUBLIC_URL"),
+		GitHub: trackers.GitHubConfig{
+			BaseURL:       os.Getenv("GITHUB_API_URL"),
+			Token:         os.Getenv("GITHUB_TOKEN"),
+			Repo:          os.Getenv("GITHUB_REPO"),
+			WebhookSecret: os.Getenv("GITHUB_WEBHOOK_SECRET"),
+		},
+		Linear: trackers.LinearConfig{
+			APIKey:        os.Getenv("LINEAR_API_KEY"),
+			TeamID:        os.Getenv("LINEAR_TEAM_ID"),
+			WebhookSecret: os.Getenv("LINEAR_WEBHOOK_SECRET"),
+		},
+		Jira: trackers.JiraConfig{
+			BaseURL:       os.Getenv("JIRA_BASE_URL"),
+			Email:         os.Getenv("JIRA_EMAIL"),
+			APIToken:      os.Getenv("JIRA_API_TOKEN"),
+			ProjectKey:    os.Getenv("JIRA_PROJECT_KEY"),
+			IssueType:     os.Getenv("JIRA_ISSUE_TYPE"),
+			WebhookSecret: os.Getenv("JIRA_WEBHOOK_SECRET"),
+		},
+		S3: storage.S3Config{
+			Bucket:          os.Getenv("S3_BUCKET"),
+			Region:          os.Getenv("S3_REGION"),
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("S3_SESSION_TOKEN"),
+			PathStyle:       os.Getenv("S3_PATH_STYLE") == "true",
+		},
+		ClamAVAddress: os.Getenv("CLAMAV_ADDRESS"),
+		ScanAPIURL:    os.Getenv("SCAN_API_URL"),
+		ScanAPIToken:  os.Getenv("SCAN_API_TOKEN"),
+		Schedules: server.ScheduleConfig{
+			RetentionPurge:  "@every 1h",
+			TrackerSync:     "@every 10m",
+			DailyDigest:     "0 7 * * *",
+			WeeklyDigest:    "0 7 * * 1",
+			SnoozeWake:      "@every 5m",
+			RetentionDryRun: os.Getenv("RETENTION_DRY_RUN") == "true",
+			BackupRetain:    7,
+		},
+		KeyRotation:   time.Hour,
+		DataKeyMaxAge: 90 * 24 * time.Hour,
+		Notion: wiki.NotionConfig{
+			BaseURL:       os.Getenv("NOTION_API_URL"),
+			Token:         os.Getenv("NOTION_TOKEN"),
+			DatabaseID:    os.Getenv("NOTION_DATABASE_ID"),
+			TitleProperty: os.Getenv("NOTION_TITLE_PROPERTY"),
+			DateProperty:  os.Getenv("NOTION_DATE_PROPERTY"),
+			URLProperty:   os.Getenv("NOTION_URL_PROPERTY"),
+		},
+		Confluence: wiki.ConfluenceConfig{
+			BaseURL:      os.Getenv("CONFLUENCE_URL"),
+			Email:        os.Getenv("CONFLUENCE_EMAIL"),
+			APIToken:     os.Getenv("CONFLUENCE_API_TOKEN"),
+			SpaceKey:     os.Getenv("CONFLUENCE_SPACE_KEY"),
+			ParentPageID: os.Getenv("CONFLUENCE_PARENT_PAGE_ID"),
+		},
+		WikiAutoPublish: splitList(os.Getenv("WIKI_AUTO_PUBLISH")),
+		SlackWebhookURL: os.Getenv("SLACK_WEBHOOK_URL"),
+		TeamsWebhookURL: os.Getenv("TEAMS_WEBHOOK_URL"),
+		APNs: push.APNsConfig{
+			KeyID:   os.Getenv("APNS_KEY_ID"),
+			TeamID:  os.Getenv("APNS_TEAM_ID"),
+			Topic:   os.Getenv("APNS_TOPIC"),
+			Sandbox: os.Getenv("APNS_SANDBOX") == "true",
+		},
+		Sentry: errtrack.SentryConfig{
+			DSN:         os.Getenv("SENTRY_DSN"),
+			Environment: os.Getenv("SENTRY_ENVIRONMENT"),
+			Release:     os.Getenv("SENTRY_RELEASE"),
+		},
+		AuditSigningKey: []byte(os.Getenv("AUDIT_SIGNING_KEY")),
+		ScimToken:       os.Getenv("SCIM_TOKEN"),
+		IPAllowlist: server.IPAllowlistConfig{
+			BypassToken: os.Getenv("IP_ALLOWLIST_BYPASS_TOKEN"),
+		},
+		PasswordHashing: password.DefaultParams,
+		PasswordPolicy:  password.DefaultPolicy,
+	}
+	if n := len(cfg.AuditSigningKey); n > 0 && n < 32 {
+		problems = append(problems, errors.New("AUDIT_SIGNING_KEY must be at least 32 characters"))
+	}
+	// The token lets its holder create admins, so it has to be hard to guess.
+	if n := len(cfg.ScimToken); n > 0 && n < 32 {
+		problems = append(problems, errors.New("SCIM_TOKEN must be at least 32 characters"))
+	}
+	if n := len(cfg.IPAllowlist.BypassToken); n > 0 && n < 32 {
+		problems = append(problems, errors.New("IP_ALLOWLIST_BYPASS_TOKEN must be at least 32 characters"))
+	}
+	problems = append(problems, parsePasswordHashing(&cfg.PasswordHashing), parseCount("PASSWORD_MIN_LENGTH", &cfg.PasswordPolicy.MinLength))
+	cfg.PasswordPolicy.AllowEmail = os.Getenv("PASSWORD_ALLOW_EMAIL") == "true"
+	if os.Getenv("PASSWORD_BREACH_CHECK") == "true" {
+		cfg.PasswordPolicy.Breaches = password.HIBP{BaseURL: os.Getenv("PASSWORD_BREACH_API_URL")}
+	}
+	for _, entry := range splitList(os.Getenv("TRUSTED_PROXIES")) {
+		prefix, err := parsePrefix(entry)
+		if err != nil {
+			problems = append(problems, fmt.Errorf("TRUSTED_PROXIES: %q is not an IP address or CIDR range", entry))
+			continue
+		}
+		cfg.IPAllowlist.TrustedProxies = append(cfg.IPAllowlist.TrustedProxies, prefix)
+	}
+	if v := os.Getenv("ADDR"); v != "" {
+		cfg.Addr = v
+	}
+	if v := os.Getenv("AUDIO_STORAGE_DIR"); v != "" {
+		cfg.AudioStorageDir = v
+	}
+	if v := os.Getenv("FFMPEG_PATH"); v != "" {
+		cfg.FFmpegPath = v
+	}
+	if cfg.DatabaseURL == "" {
+		problems = append(problems, errors.New("DATABASE_URL is required"))
+	}
+	if cfg.SMTP.Addr != "" && cfg.SMTP.From == "" {
+		problems = append(problems, errors.New("MAIL_FROM is required when SMTP_ADDR is set"))
+	}
+	if cfg.GitHub.Token != "" && cfg.GitHub.Repo == "" {
+		problems = append(problems, errors.New("GITHUB_REPO is required when GITHUB_TOKEN is set"))
+	}
+	if cfg.Linear.APIKey != "" && cfg.Linear.TeamID == "" {
+		problems = append(problems, errors.New("LINEAR_TEAM_ID is required when LINEAR_API_KEY is set"))
+	}
+	if cfg.Jira.APIToken != "" && (cfg.Jira.BaseURL == "" || cfg.Jira.Email == "" || cfg.Jira.ProjectKey == "") {
+		problems = append(problems, errors.New("JIRA_BASE_URL, JIRA_EMAIL and JIRA_PROJECT_KEY are required when JIRA_API_TOKEN is set"))
+	}
+	if cfg.Notion.Token != "" && cfg.Notion.DatabaseID == "" {
+		problems = append(problems, errors.New("NOTION_DATABASE_ID is required when NOTION_TOKEN is set"))
+	}
+	if cfg.Confluence.APIToken != "" && (cfg.Confluence.BaseURL == "" || cfg.Confluence.Email == "" || cfg.Confluence.SpaceKey == "") {
+		problems = append(problems, errors.New("CONFLUENCE_URL, CONFLUENCE_EMAIL and CONFLUENCE_SPACE_KEY are required when CONFLUENCE_API_TOKEN is set"))
+	}
+	if cfg.ClamAVAddress != "" && cfg.ScanAPIURL != "" {
+		problems = append(problems, errors.New("set only one of CLAMAV_ADDRESS and SCAN_API_URL"))
+	}
+	if cfg.SlackWebhookURL != "" && !strings.HasPrefix(cfg.SlackWebhookURL, "https://") {
+		problems = append(problems, errors.New("SLACK_WEBHOOK_URL must be an https URL"))
+	}
+	if cfg.TeamsWebhookURL != "" && !strings.HasPrefix(cfg.TeamsWebhookURL, "https://") {
+		problems = append(problems, errors.New("TEAMS_WEBHOOK_URL must be an https URL"))
+	}
+	if cfg.Sentry.DSN != "" {
+		if _, err := errtrack.NewSentry(cfg.Sentry); err != nil {
+			problems = append(problems, fmt.Errorf("SENTRY_DSN: %w", err))
+		}
+	}
+	if path := os.Getenv("FCM_CREDENTIALS_FILE"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			problems = append(problems, fmt.Errorf("FCM_CREDENTIALS_FILE: %w", err))
+		}
+		cfg.FCM.CredentialsJSON = data
+	}
+	if path := os.Getenv("APNS_KEY_FILE"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			problems = append(problems, fmt.Errorf("APNS_KEY_FILE: %w", err))
+		}
+		cfg.APNs.PrivateKey = data
+		if cfg.APNs.KeyID == "" || cfg.APNs.TeamID == "" || cfg.APNs.Topic == "" {
+			problems = append(problems, errors.New("APNS_KEY_ID, APNS_TEAM_ID and APNS_TOPIC are required when APNS_KEY_FILE is set"))
+		}
+	}
+	if v := os.Getenv("ENCRYPTION_MASTER_KEY"); v != "" {
+		master, err := envelope.ParseMasterKey(v)
+		if err != nil {
+			problems = append(problems, fmt.Errorf("ENCRYPTION_MASTER_KEY: %w", err))
+		} else {
+			cfg.MasterKey = master
+		}
+	}
+	// Previous master keys only unwrap data keys during a master key
+	// rotation; they are rewrapped with the new key at startup.
+	for _, v := range splitList(os.Getenv("ENCRYPTION_PREVIOUS_MASTER_KEYS")) {
+		master, err := envelope.ParseMasterKey(v)
+		if err != nil {
+			problems = append(problems, fmt.Errorf("ENCRYPTION_PREVIOUS_MASTER_KEYS: %w", err))
+			continue
+		}
+		cfg.OldMasterKeys = append(cfg.OldMasterKeys, master)
+	}
+	if len(cfg.OldMasterKeys) > 0 && cfg.MasterKey == nil {
+		problems = append(problems, errors.New("ENCRYPTION_MASTER_KEY is required when ENCRYPTION_PREVIOUS_MASTER_KEYS is set"))
+	}
+	for _, name := range cfg.WikiAutoPublish {
+		configured := map[string]bool{wiki.Notion: cfg.Notion.Token != "", wiki.Confluence: cfg.Confluence.APIToken != ""}
+		if !configured[name] {
+			problems = append(problems, fmt.Errorf("WIKI_AUTO_PUBLISH: %q is not a configured wiki (notion or confluence)", name))
+		}
+	}
+	if cfg.JWTSecret == "" {
+		problems = append(problems, errors.New("JWT_SECRET is required"))
+	}
+	if v := os.Getenv("JWT_TTL_HOURS"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed <= 0 {
+			problems = append(problems, errors.New("JWT_TTL_HOURS must be a positive integer"))
+		} else {
+			cfg.TokenTTL = time.Duration(parsed) * time.Hour
+		}
+	}
+	if v := os.Getenv("TLS_AUTOCERT_CACHE_DIR"); v != "" {
+		cfg.TLS.AutocertCacheDir = v
+	}
+	problems = append(problems,
+		parseDuration("HSTS_MAX_AGE_SECONDS", time.Second, &cfg.TLS.HSTSMaxAge),
+		parseDuration("REQUEST_TIMEOUT_SECONDS", time.Second, &cfg.Timeouts.Default),
+		parseDuration("REQUEST_MAX_TIMEOUT_SECONDS", time.Second, &cfg.Timeouts.Max),
+		parseDuration("LONG_REQUEST_TIMEOUT_SECONDS", time.Second, &cfg.Timeouts.LongRunning),
+		parseDuration("SHUTDOWN_TIMEOUT_SECONDS", time.Second, &cfg.ShutdownTimeout),
+		parseQuota("LOGIN_BODY_LIMIT_KB", 1<<10, &cfg.BodyLimits.Login),
+		parseQuota("API_BODY_LIMIT_MB", 1<<20, &cfg.BodyLimits.API),
+		parseQuota("UPLOAD_BODY_LIMIT_MB", 1<<20, &cfg.BodyLimits.Upload),
+		parseConnCount("DB_MAX_CONNS", &cfg.DBPool.MaxConns),
+		parseConnCount("DB_MIN_CONNS", &cfg.DBPool.MinConns),
+		parseDuration("DB_MAX_CONN_LIFETIME_SECONDS", time.Second, &cfg.DBPool.MaxConnLifetime),
+		parseDuration("DB_MAX_CONN_IDLE_SECONDS", time.Second, &cfg.DBPool.MaxConnIdleTime),
+		parseDuration("DB_HEALTH_CHECK_SECONDS", time.Second, &cfg.DBPool.HealthCheckPeriod),
+		parseDuration("DB_SLOW_QUERY_MS", time.Millisecond, &cfg.SlowQuery),
+		parseSchedule("TRACKER_SYNC_SCHEDULE", "TRACKER_POLL_SECONDS", &cfg.Schedules.TrackerSync),
+		parseSchedule("RETENTION_PURGE_SCHEDULE", "RETENTION_PURGE_SECONDS", &cfg.Schedules.RetentionPurge),
+		parseSchedule("BACKUP_SCHEDULE", "BACKUP_INTERVAL_SECONDS", &cfg.Schedules.Backup),
+		parseSchedule("DAILY_DIGEST_SCHEDULE", "", &cfg.Schedules.DailyDigest),
+		parseSchedule("WEEKLY_DIGEST_SCHEDULE", "", &cfg.Schedules.WeeklyDigest),
+		parseSchedule("SNOOZE_WAKE_SCHEDULE", "", &cfg.Schedules.SnoozeWake),
+		parseCount("BACKUP_RETAIN", &cfg.Schedules.BackupRetain),
+		parseDuration("KEY_ROTATION_SECONDS", time.Second, &cfg.KeyRotation),
+		parseDuration("DATA_KEY_MAX_AGE_DAYS", 24*time.Hour, &cfg.DataKeyMaxAge),
+		// Storage quotas can be given in MB or GB; GB wins when both are set.
+		parseQuota("QUOTA_TRANSCRIPTION_MINUTES", 60, &cfg.Quotas.Organization.TranscriptionSeconds),
+		parseQuota("QUOTA_LLM_TOKENS", 1, &cfg.Quotas.Organization.LLMTokens),
+		parseQuota("QUOTA_STORAGE_MB", 1<<20, &cfg.Quotas.Organization.StorageBytes),
+		parseQuota("QUOTA_STORAGE_GB", 1<<30, &cfg.Quotas.Organization.StorageBytes),
+		parseQuota("QUOTA_RECORDINGS", 1, &cfg.Quotas.Organization.Recordings),
+		parseQuota("QUOTA_USER_TRANSCRIPTION_MINUTES", 60, &cfg.Quotas.PerUser.TranscriptionSeconds),
+		parseQuota("QUOTA_USER_LLM_TOKENS", 1, &cfg.Quotas.PerUser.LLMTokens),
+		parseQuota("QUOTA_USER_STORAGE_MB", 1<<20, &cfg.Quotas.PerUser.StorageBytes),
+		parseQuota("QUOTA_USER_STORAGE_GB", 1<<30, &cfg.Quotas.PerUser.StorageBytes),
+		parseQuota("QUOTA_USER_RECORDINGS", 1, &cfg.Quotas.PerUser.Recordings),
+	)
+	providerSettings, providerProblems := loadProviders(&cfg)
+	cfg.Providers = providerSettings
+	problems = append(problems, providerProblems...)
+	if err := cfg.TLS.validate(); err != nil {
+		problems = append(problems, err)
+	}
+	if v, ok := os.LookupEnv("CORS_API_ORIGINS"); ok {
+		cfg.CORS.APIOrigins = splitList(v)
+	}
+	if v, ok := os.LookupEnv("CORS_PUBLIC_ORIGINS"); ok {
+		cfg.CORS.PublicOrigins = splitList(v)
+	}
+	if v, ok := os.LookupEnv("CORS_STATIC_ORIGINS"); ok {
+		cfg.CORS.StaticOrigins = splitList(v)
+	}
+	if v, ok := os.LookupEnv("CORS_HEALTH_ORIGINS"); ok {
+		cfg.CORS.HealthOrigins = splitList(v)
+	}
+	return cfg, errors.Join(problems...)
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if trimmed := strings.TrimSpace(item); trimmed != "" {
+			items = append(items, trimmed)
+		}
+	}
+	return items
+}
+
+// parsePrefix reads a CIDR range, or a single address as a range of one.
+func parsePrefix(value string) (netip.Prefix, error) {
+	if strings.Contains(value, "/") {
+		return netip.ParsePrefix(value)
+	}
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// parsePasswordHashing reads the argon2id costs for new password hashes.
+// Unset variables keep the defaults.
+func parsePasswordHashing(target *password.Params) error {
+	params := *target
+	for _, v := range []struct {
+		name   string
+		target *uint32
+	}{
+		{"PASSWORD_ARGON2_MEMORY_KB", &params.Memory},
+		{"PASSWORD_ARGON2_ITERATIONS", &params.Iterations},
+	} {
+		if s := os.Getenv(v.name); s != "" {
+			parsed, err := strconv.ParseUint(s, 10, 32)
+			if err != nil {
+				return errors.New(v.name + " must be a positive integer")
+			}
+			*v.target = uint32(parsed)
+		}
+	}
+	if s := os.Getenv("PASSWORD_ARGON2_PARALLELISM"); s != "" {
+		parsed, err := strconv.ParseUint(s, 10, 8)
+		if err != nil {
+			return errors.New("PASSWORD_ARGON2_PARALLELISM must be an integer from 1 to 255")
+		}
+		params.Parallelism = uint8(parsed)
+	}
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("PASSWORD_ARGON2_*: %w", err)
+	}
+	*target = params
+	return nil
+}
+
+// parseDuration reads a non-negative count of unit from the named
+// environment variable into target, leaving target unchanged when unset.
+// Zero disables the corresponding limit.
+func parseDuration(name string, unit time.Duration, target *time.Duration) error {
+	v := os.Getenv(name)
+	if v == "" {
+		return nil
+	}
+	parsed, err := strconv.Atoi(v)
+	if err != nil || parsed < 0 {
+		return errors.New(name + " must be a non-negative integer")
+	}
+	*target = time.Duration(parsed) * unit
+	return nil
+}
+
+// parseSchedule reads when a scheduled task runs from the named
+// environment variable, a cron expression, or "off". Unset, it falls back
+// on the older interval variable in seconds, where 0 is off, and then on
+// the default.
+func parseSchedule(name, secondsName string, target *string) error {
+	if v := strings.TrimSpace(os.Getenv(name)); v != "" {
+		if v == "off" {
+			*target = ""
+			return nil
+		}
+		if _, err := schedule.Parse(v); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		*target = v
+		return nil
+	}
+	if secondsName == "" || os.Getenv(secondsName) == "" {
+		return nil
+	}
+	var interval time.Duration
+	if err := parseDuration(secondsName, time.Second, &interval); err != nil {
+		return err
+	}
+	*target = ""
+	if interval > 0 {
+		*target = "@every " + interval.String()
+	}
+	return nil
+}
+
+// parseConnCount reads a pool size from the named environment variable.
+// Unset keeps the DSN or pgxpool default.
+func parseConnCount(name string, target *int32) error {
+	v := os.Getenv(name)
+	if v == "" {
+		return nil
+	}
+	parsed, err := strconv.ParseInt(v, 10, 32)
+	if err != nil || parsed <= 0 {
+		return errors.New(name + " must be a positive integer")
+	}
+	*target = int32(parsed)
+	return nil
+}
+
+// parseCount reads a positive count from the named environment variable.
+// Unset keeps the default.
+func parseCount(name string, target *int) error {
+	v := os.Getenv(name)
+	if v == "" {
+		return nil
+	}
+	parsed, err := strconv.Atoi(v)
+	if err != nil || parsed <= 0 {
+		return errors.New(name + " must be a positive integer")
+	}
+	*target = parsed
+	return nil
+}
+
+// parseQuota reads a usage limit counted in units of scale from the named
+// environment variable. Unset or zero leaves the metric unlimited.
+func parseQuota(name string, scale int64, target *int64) error {
+	v := os.Getenv(name)
+	if v == "" {
+		return nil
+	}
+	parsed, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || parsed < 0 {
+		return errors.New(name + " must be a non-negative integer")
+	}
+	*target = parsed * scale
+	return nil
+}
@@ -2,84 +2,337 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
-	"strconv"
 	"syscall"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
 	"github.com/mvult/secretary/backend/internal/db"
+	"github.com/mvult/secretary/backend/internal/errtrack"
+	"github.com/mvult/secretary/backend/internal/mail"
+	"github.com/mvult/secretary/backend/internal/notify"
+	"github.com/mvult/secretary/backend/internal/providers"
+	"github.com/mvult/secretary/backend/internal/push"
+	"github.com/mvult/secretary/backend/internal/scan"
 	"github.com/mvult/secretary/backend/internal/server"
+	"github.com/mvult/secretary/backend/internal/shared"
+	"github.com/mvult/secretary/backend/internal/storage"
+	"github.com/mvult/secretary/backend/internal/trackers"
+	"github.com/mvult/secretary/backend/internal/wiki"
 )
 
+// replicaCheckInterval is how often read replicas are pinged to decide
+// whether they stay in rotation.
+const replicaCheckInterval = 10 * time.Second
+
+// settingsRefreshInterval bounds how long a settings change, or a user's
+// deactivation, saved through another instance takes to reach this one.
+const settingsRefreshInterval = time.Minute
+
+// uploadSweepInterval is how often unconfirmed direct uploads are cleaned
+// up.
+const uploadSweepInterval = time.Hour
+
+// audioScanInterval is how often audio the malware scanner couldn't reach
+// is tried again. New uploads are scanned right away.
+const audioScanInterval = 5 * time.Minute
+
+// keywordMatchInterval is how often transcripts are matched against watch
+// keywords in case a wake-up was missed. Recordings are matched as soon as
+// they are ready.
+const keywordMatchInterval = 10 * time.Minute
+
 func main() {
+	selfTest := flag.Bool("selftest", false, "validate config and dependencies, print a report, and exit")
+	flag.Parse()
+
 	if err := godotenv.Load(); err != nil {
 		// It's not an error if .env doesn't exist, we might be in production using real env vars.
 		// But let's log it just in case.
 		log.Println("No .env file found, using system environment variables")
 	}
 
-	addr := ":8080"
-	if v := os.Getenv("ADDR"); v != "" {
-		addr = v
-	}
-
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
-	pool, err := db.Open(ctx, os.Getenv("DATABASE_URL"))
+	if *selfTest {
+		os.Exit(runSelfTest(ctx, os.Stdout))
+	}
+
+	secretStore, secretsRefresh, err := secretManagers()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := secretStore.ResolveEnv(ctx); err != nil {
+		log.Fatal(err)
+	}
+	cfg, err := loadConfig()
 	if err != nil {
 		log.Fatal(err)
 	}
-	defer pool.Close()
 
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		log.Fatal("JWT_SECRET is required")
+	queryStats := db.NewQueryStats(cfg.SlowQuery)
+	poolOptions := cfg.DBPool
+	poolOptions.Stats = queryStats
+	primaryOptions := poolOptions
+	primaryOptions.DSN = func() string { return os.Getenv("DATABASE_URL") }
+	pool, err := db.OpenWithOptions(ctx, cfg.DatabaseURL, primaryOptions)
+	if err != nil {
+		log.Fatal(err)
 	}
-	ttlHours := 24 * 30 * 6
-	if v := os.Getenv("JWT_TTL_HOURS"); v != "" {
-		parsed, err := strconv.Atoi(v)
-		if err != nil || parsed <= 0 {
-			log.Fatal("JWT_TTL_HOURS must be a positive integer")
+	defer pool.Close()
+
+	var replicas []*pgxpool.Pool
+	for _, replicaURL := range cfg.ReplicaURLs {
+		replica, err := db.OpenWithOptions(ctx, replicaURL, poolOptions)
+		if err != nil {
+			log.Fatal(err)
 		}
-		ttlHours = parsed
+		replicas = append(replicas, replica)
+	}
+	readRouter := db.NewReadRouter(pool, replicas...)
+	defer readRouter.Close()
+	if len(replicas) > 0 {
+		log.Printf("routing reads to %d replica(s)", len(replicas))
+		go readRouter.Watch(ctx, replicaCheckInterval)
 	}
 
-	srv := server.New(pool, []byte(jwtSecret), time.Duration(ttlHours)*time.Hour)
+	srv := server.New(pool, []byte(cfg.JWTSecret), cfg.TokenTTL)
+	watchSecrets(ctx, secretStore, secretsRefresh, srv)
+	srv.ConfigureReadReplicas(readRouter)
+	srv.ConfigureCORS(cfg.CORS)
+	srv.ConfigureTimeouts(cfg.Timeouts)
+	srv.ConfigureBodyLimits(cfg.BodyLimits)
+	srv.ConfigureMetrics(queryStats, cfg.MetricsToken)
+	if cfg.Sentry.DSN != "" {
+		tracker, err := errtrack.NewSentry(cfg.Sentry)
+		if err != nil {
+			log.Fatal(err)
+		}
+		srv.ConfigureErrorTracking(tracker)
+	}
+	srv.ConfigureAuditSigning(cfg.AuditSigningKey)
+	srv.ConfigureSCIM(cfg.ScimToken)
+	srv.ConfigureIPAllowlist(cfg.IPAllowlist)
+	srv.ConfigurePasswordHashing(cfg.PasswordHashing)
+	srv.ConfigurePasswordPolicy(cfg.PasswordPolicy)
+	srv.ConfigureQuotas(cfg.Quotas)
+	if cfg.SMTP.Addr != "" {
+		srv.ConfigureMail(mail.NewSMTP(cfg.SMTP), cfg.PublicURL)
+	} else {
+		srv.ConfigureMail(mail.LogSender{}, cfg.PublicURL)
+	}
+	registry := newProviderRegistry(cfg.Providers, providers.NewDBRecorder(pool))
+	if cfg.RedisURL != "" {
+		// Without Redis each process keeps its own caches and rate limits,
+		// which still works, so a Redis that can't be reached doesn't stop
+		// the server.
+		if state, err := shared.Open(ctx, cfg.RedisURL); err != nil {
+			log.Printf("%v; keeping caches and rate limits in this process", err)
+		} else {
+			defer state.Close()
+			registry.ShareLimits(state)
+			if err := srv.ConfigureSharedState(ctx, state); err != nil {
+				log.Printf("redis: %v; keeping caches in this process", err)
+			} else {
+				log.Printf("sharing caches and rate limits through redis")
+			}
+		}
+	}
+	srv.ConfigureProviders(registry)
+	audioStore, err := audioStorage(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := checkStartup(ctx, cfg, pool, audioStore); err != nil {
+		log.Fatalf("startup checks failed:\n%v", err)
+	}
+	srv.ConfigureStorage(audioStore)
+	srv.StartLeaderElection(ctx)
+	srv.StartUploadSweep(ctx, uploadSweepInterval)
+	if scanner := fileScanner(cfg); scanner != nil {
+		srv.ConfigureScanner(scanner)
+		srv.StartAudioScan(ctx, audioScanInterval)
+	}
+	if cfg.MasterKey != nil {
+		if err := srv.ConfigureEncryption(ctx, cfg.MasterKey, cfg.OldMasterKeys...); err != nil {
+			log.Fatalf("encryption: %v", err)
+		}
+		srv.StartKeyRotation(ctx, cfg.KeyRotation, cfg.DataKeyMaxAge)
+	}
+	srv.ConfigureAudioCutter(server.FFmpegCutter{Path: cfg.FFmpegPath})
+	srv.ConfigureTrackers(issueTrackers(cfg)...)
+	srv.ConfigureWikis(wikiTargets(cfg), cfg.WikiAutoPublish)
+	srv.ConfigureNotifications(notificationChannels(cfg)...)
+	senders, err := pushSenders(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	srv.ConfigurePush(senders...)
+	if err := srv.LoadSettings(ctx); err != nil {
+		log.Fatalf("load settings: %v", err)
+	}
+	srv.StartSettingsRefresh(ctx, settingsRefreshInterval)
+	if err := srv.LoadDeactivatedUsers(ctx); err != nil {
+		log.Fatalf("load deactivated users: %v", err)
+	}
+	srv.StartDeactivatedUsersRefresh(ctx, settingsRefreshInterval)
+	if err := srv.LoadRevokedSessions(ctx); err != nil {
+		log.Fatalf("load revoked sessions: %v", err)
+	}
+	srv.StartRevokedSessionsRefresh(ctx, settingsRefreshInterval)
+	srv.StartScheduler(ctx, cfg.Schedules)
+	srv.StartKeywordAlerts(ctx, keywordMatchInterval)
 	if err := srv.ConfigureAI(
-		os.Getenv("OPENAI_API_KEY"),
-		os.Getenv("OPENAI_BASE_URL"),
-		os.Getenv("OPENAI_MODEL"),
-		os.Getenv("AI_SKILLS_DIR"),
+		cfg.OpenAIAPIKey,
+		cfg.OpenAIBaseURL,
+		cfg.OpenAIModel,
+		cfg.AISkillsDir,
 		0,
 		0,
 	); err != nil {
 		log.Printf("ai disabled: %v", err)
 	}
-	if err := srv.StartWhatsApp(ctx, os.Getenv("WHATSAPP_SESSION_DB")); err != nil {
+	if err := srv.StartWhatsApp(ctx, cfg.WhatsAppSessionDB); err != nil {
 		log.Printf("whatsapp disabled: %v", err)
 	}
 	httpServer := &http.Server{
-		Addr:              addr,
+		Addr:              cfg.Addr,
 		Handler:           srv,
 		ReadHeaderTimeout: 5 * time.Second,
+		Protocols:         serverProtocols(cfg.TLS.enabled()),
 	}
+	servers := []*http.Server{httpServer}
 
-	log.Printf("listening on %s", addr)
-	go func() {
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+	if cfg.TLS.enabled() {
+		tlsSrv, err := newTLSServer(cfg.TLS)
+		if err != nil {
 			log.Fatal(err)
 		}
-	}()
+		httpServer.TLSConfig = tlsSrv.config
+		httpServer.Handler = withHSTS(srv, cfg.TLS.HSTSMaxAge)
+		if cfg.TLS.RedirectAddr != "" {
+			redirectServer := &http.Server{
+				Addr:              cfg.TLS.RedirectAddr,
+				Handler:           tlsSrv.challenge(redirectToHTTPS(cfg.Addr)),
+				ReadHeaderTimeout: 5 * time.Second,
+			}
+			servers = append(servers, redirectServer)
+			log.Printf("redirecting http on %s to https", cfg.TLS.RedirectAddr)
+			go func() {
+				if err := redirectServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+					log.Fatal(err)
+				}
+			}()
+		}
+		log.Printf("listening with tls on %s", cfg.Addr)
+		go func() {
+			if err := httpServer.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+				log.Fatal(err)
+			}
+		}()
+	} else {
+		log.Printf("listening on %s", cfg.Addr)
+		go func() {
+			if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatal(err)
+			}
+		}()
+	}
 
 	<-ctx.Done()
-	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	log.Printf("shutting down; waiting up to %s for requests in flight", cfg.ShutdownTimeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
 	defer cancel()
-	if err := httpServer.Shutdown(shutdownCtx); err != nil {
-		log.Printf("shutdown error: %v", err)
+	for _, s := range servers {
+		if err := s.Shutdown(shutdownCtx); err != nil {
+			log.Printf("shutdown error: %v; closing remaining connections", err)
+			s.Close()
+		}
+	}
+}
+
+// audioStorage returns the S3-compatible bucket when one is configured and
+// the local directory otherwise.
+func audioStorage(cfg config) (storage.Store, error) {
+	if cfg.S3.Bucket != "" {
+		return storage.NewS3(cfg.S3)
+	}
+	return storage.NewLocal(cfg.AudioStorageDir)
+}
+
+// fileScanner returns the configured malware scanner, or nil without one.
+func fileScanner(cfg config) scan.Scanner {
+	switch {
+	case cfg.ClamAVAddress != "":
+		return scan.NewClamAV(cfg.ClamAVAddress)
+	case cfg.ScanAPIURL != "":
+		return &scan.HTTP{URL: cfg.ScanAPIURL, Token: cfg.ScanAPIToken}
+	}
+	return nil
+}
+
+// issueTrackers returns the trackers that have credentials configured.
+func issueTrackers(cfg config) []trackers.Tracker {
+	var list []trackers.Tracker
+	if cfg.GitHub.Token != "" {
+		list = append(list, trackers.NewGitHub(cfg.GitHub))
+	}
+	if cfg.Linear.APIKey != "" {
+		list = append(list, trackers.NewLinear(cfg.Linear))
+	}
+	if cfg.Jira.APIToken != "" {
+		list = append(list, trackers.NewJira(cfg.Jira))
+	}
+	return list
+}
+
+// notificationChannels returns the chat channels that have a webhook
+// configured.
+func notificationChannels(cfg config) []notify.Channel {
+	var list []notify.Channel
+	if cfg.SlackWebhookURL != "" {
+		list = append(list, notify.NewSlack(cfg.SlackWebhookURL))
+	}
+	if cfg.TeamsWebhookURL != "" {
+		list = append(list, notify.NewTeams(cfg.TeamsWebhookURL))
+	}
+	return list
+}
+
+// pushSenders returns the push services that have credentials configured.
+func pushSenders(cfg config) ([]push.Sender, error) {
+	var list []push.Sender
+	if len(cfg.FCM.CredentialsJSON) > 0 {
+		sender, err := push.NewFCM(cfg.FCM)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, sender)
+	}
+	if len(cfg.APNs.PrivateKey) > 0 {
+		sender, err := push.NewAPNs(cfg.APNs)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, sender)
+	}
+	return list, nil
+}
+
+// wikiTargets returns the wikis that have credentials configured.
+func wikiTargets(cfg config) []wiki.Target {
+	var list []wiki.Target
+	if cfg.Notion.Token != "" {
+		list = append(list, wiki.NewNotion(cfg.Notion))
+	}
+	if cfg.Confluence.APIToken != "" {
+		list = append(list, wiki.NewConfluence(cfg.Confluence))
 	}
+	return list
 }
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mvult/secretary/backend/internal/providers"
+)
+
+// providerSettings configure one OpenAI-compatible provider.
+type providerSettings struct {
+	Client  providers.OpenAIConfig
+	Options providers.Options
+	Kinds   []string
+}
+
+// loadProviders reads PROVIDERS, a comma-separated list of provider names in
+// priority order, and the PROVIDER_<NAME>_* variables for each. Without
+// PROVIDERS, OPENAI_API_KEY configures a single provider named openai.
+func loadProviders(cfg *config) ([]providerSettings, []error) {
+	names := splitList(os.Getenv("PROVIDERS"))
+	if len(names) == 0 {
+		if strings.TrimSpace(cfg.OpenAIAPIKey) == "" {
+			return nil, nil
+		}
+		return []providerSettings{{
+			Client:  providers.OpenAIConfig{Name: "openai", BaseURL: cfg.OpenAIBaseURL, APIKey: cfg.OpenAIAPIKey, Model: cfg.OpenAIModel},
+			Options: providers.Options{Name: "openai"},
+			Kinds:   []string{providers.KindTranscription, providers.KindSummarization},
+		}}, nil
+	}
+
+	var settings []providerSettings
+	var problems []error
+	for priority, name := range names {
+		prefix := "PROVIDER_" + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(name)) + "_"
+		env := func(key string) string { return strings.TrimSpace(os.Getenv(prefix + key)) }
+		s := providerSettings{
+			Client: providers.OpenAIConfig{
+				Name:               name,
+				BaseURL:            env("BASE_URL"),
+				APIKey:             env("API_KEY"),
+				Model:              env("MODEL"),
+				TranscriptionModel: env("TRANSCRIPTION_MODEL"),
+			},
+			Options: providers.Options{Name: name, Priority: priority},
+			Kinds:   splitList(env("KINDS")),
+		}
+		if s.Client.APIKey == "" {
+			problems = append(problems, errors.New(prefix+"API_KEY is required"))
+		}
+		if len(s.Kinds) == 0 {
+			s.Kinds = []string{providers.KindTranscription, providers.KindSummarization}
+		}
+		for _, kind := range s.Kinds {
+			if kind != providers.KindTranscription && kind != providers.KindSummarization {
+				problems = append(problems, fmt.Errorf("%sKINDS: unknown kind %q", prefix, kind))
+			}
+		}
+		if v := env("RATE_PER_MINUTE"); v != "" {
+			parsed, err := strconv.Atoi(v)
+			if err != nil || parsed < 0 {
+				problems = append(problems, errors.New(prefix+"RATE_PER_MINUTE must be a non-negative integer"))
+			}
+			s.Options.RatePerMinute = parsed
+		}
+		problems = append(problems,
+			parsePrice(prefix+"INPUT_USD_PER_MTOK", &s.Options.Pricing.InputPerMillionTokens),
+			parsePrice(prefix+"OUTPUT_USD_PER_MTOK", &s.Options.Pricing.OutputPerMillionTokens),
+			parsePrice(prefix+"AUDIO_USD_PER_MINUTE", &s.Options.Pricing.AudioPerMinute),
+		)
+		settings = append(settings, s)
+	}
+	return settings, problems
+}
+
+func parsePrice(name string, target *float64) error {
+	v := os.Getenv(name)
+	if v == "" {
+		return nil
+	}
+	parsed, err := strconv.ParseFloat(v, 64)
+	if err != nil || parsed < 0 {
+		return errors.New(name + " must be a non-negative number")
+	}
+	*target = parsed
+	return nil
+}
+
+// newProviderRegistry registers every configured provider for the kinds of
+// work it handles.
+func newProviderRegistry(settings []providerSettings, recorder providers.UsageRecorder) *providers.Registry {
+	registry := providers.NewRegistry(recorder)
+	for _, s := range settings {
+		client := providers.NewOpenAI(s.Client)
+		for _, kind := range s.Kinds {
+			opts := s.Options
+			opts.Model = client.ModelFor(kind)
+			switch kind {
+			case providers.KindTranscription:
+				registry.AddTranscriber(client, opts)
+			case providers.KindSummarization:
+				registry.AddSummarizer(client, opts)
+			}
+		}
+	}
+	return registry
+}
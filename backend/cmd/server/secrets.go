@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/secrets"
+	"github.com/mvult/secretary/backend/internal/server"
+)
+
+// secretManagers builds the resolver for settings kept in a secret
+// manager, with every manager configured in the environment, and returns
+// how often resolved secrets are fetched again to pick up rotations.
+func secretManagers() (*secrets.Resolver, time.Duration, error) {
+	refresh := 5 * time.Minute
+	if err := parseDuration("SECRETS_REFRESH_SECONDS", time.Second, &refresh); err != nil {
+		return nil, 0, err
+	}
+	resolver := secrets.NewResolver(refresh)
+	if addr := os.Getenv("VAULT_ADDR"); addr != "" {
+		vault, err := secrets.NewVault(secrets.VaultConfig{
+			Addr:      addr,
+			Token:     os.Getenv("VAULT_TOKEN"),
+			Namespace: os.Getenv("VAULT_NAMESPACE"),
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		resolver.Register(secrets.Vault, vault)
+	}
+	if os.Getenv("AWS_ACCESS_KEY_ID") != "" {
+		region := os.Getenv("AWS_REGION")
+		if region == "" {
+			region = os.Getenv("AWS_DEFAULT_REGION")
+		}
+		aws, err := secrets.NewAWS(secrets.AWSConfig{
+			Region:          region,
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+			Endpoint:        os.Getenv("AWS_SECRETS_MANAGER_ENDPOINT"),
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		resolver.Register(secrets.AWS, aws)
+	}
+	// Without a service account key Google Cloud falls back to the
+	// metadata server, so it is always available to references.
+	var gcpConfig secrets.GCPConfig
+	if path := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, 0, fmt.Errorf("GOOGLE_APPLICATION_CREDENTIALS: %w", err)
+		}
+		gcpConfig.CredentialsJSON = data
+	}
+	gcp, err := secrets.NewGCP(gcpConfig)
+	if err != nil {
+		return nil, 0, err
+	}
+	resolver.Register(secrets.GCP, gcp)
+	return resolver, refresh, nil
+}
+
+// watchSecrets applies rotated secrets that can change while the server
+// runs. Database credentials need no hook: new connections read them from
+// the environment. Other settings are logged and apply on restart.
+func watchSecrets(ctx context.Context, resolver *secrets.Resolver, interval time.Duration, srv *server.Server) {
+	resolver.OnChange("JWT_SECRET", func(value string) {
+		srv.RotateJWTSecret([]byte(value))
+		log.Print("secrets: JWT_SECRET was rotated")
+	})
+	for _, name := range []string{"DATABASE_URL", "PGUSER", "PGPASSWORD"} {
+		resolver.OnChange(name, func(string) {
+			log.Printf("secrets: %s was rotated; new database connections use it", name)
+		})
+	}
+	resolver.Start(ctx, interval)
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/db"
+	"github.com/mvult/secretary/backend/internal/scan"
+	"github.com/mvult/secretary/backend/internal/server"
+	"github.com/mvult/secretary/backend/internal/shared"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+const selfTestTimeout = 10 * time.Second
+
+type selfTestResult struct {
+	Name   string
+	Status string
+	Detail string
+}
+
+// runSelfTest checks config and every external dependency the server needs
+// at boot, writes a report, and returns the process exit code.
+func runSelfTest(ctx context.Context, out io.Writer) int {
+	var results []selfTestResult
+	record := func(name string, err error, okDetail string) {
+		if err != nil {
+			results = append(results, selfTestResult{Name: name, Status: "FAIL", Detail: strings.ReplaceAll(err.Error(), "\n", "; ")})
+			return
+		}
+		results = append(results, selfTestResult{Name: name, Status: "ok", Detail: okDetail})
+	}
+	skip := func(name string, detail string) {
+		results = append(results, selfTestResult{Name: name, Status: "skip", Detail: detail})
+	}
+
+	resolver, _, err := secretManagers()
+	if err == nil {
+		err = resolver.ResolveEnv(ctx)
+	}
+	switch {
+	case err != nil:
+		record("secrets", err, "")
+	case len(resolver.Names()) == 0:
+		skip("secrets", "no settings reference a secret manager")
+	default:
+		record("secrets", nil, "resolved "+strings.Join(resolver.Names(), ", "))
+	}
+
+	cfg, err := loadConfig()
+	record("config", err, "required variables present")
+
+	if cfg.DatabaseURL == "" {
+		skip("database", "DATABASE_URL not set")
+	} else {
+		_, err := checkDatabase(ctx, cfg.DatabaseURL, cfg.DBPool)
+		record("database", err, "connected and queried")
+		switch {
+		case err != nil:
+		case cfg.SkipMigrationCheck:
+			skip("migrations", "SKIP_MIGRATION_CHECK set")
+		default:
+			detail, err := checkDatabaseMigrations(ctx, cfg.DatabaseURL, cfg.DBPool)
+			record("migrations", err, detail)
+		}
+	}
+	for i, replicaURL := range cfg.ReplicaURLs {
+		standby, err := checkDatabase(ctx, replicaURL, cfg.DBPool)
+		detail := "connected, streaming standby"
+		if !standby {
+			detail = "connected, but not in recovery; reads will not be offloaded from a standby"
+		}
+		record(fmt.Sprintf("database replica %d", i+1), err, detail)
+	}
+
+	if cfg.RedisURL == "" {
+		skip("redis", "REDIS_URL not set; caches and rate limits are kept per process")
+	} else {
+		checkCtx, cancel := context.WithTimeout(ctx, selfTestTimeout)
+		state, err := shared.Open(checkCtx, cfg.RedisURL)
+		if err == nil {
+			state.Close()
+		}
+		record("redis", err, "connected")
+		cancel()
+	}
+
+	if cfg.S3.Bucket != "" {
+		record("audio storage", checkBucket(ctx, cfg.S3), "bucket "+cfg.S3.Bucket+" is writable")
+	} else if err := os.MkdirAll(cfg.AudioStorageDir, 0o755); err != nil {
+		record("audio storage", err, "")
+	} else {
+		record("audio storage", checkWritableDir(cfg.AudioStorageDir), cfg.AudioStorageDir+" is writable")
+	}
+	if scanner := fileScanner(cfg); scanner == nil {
+		skip("malware scanner", "CLAMAV_ADDRESS and SCAN_API_URL not set; uploads are stored unscanned")
+	} else {
+		record("malware scanner", checkScanner(ctx, scanner), "scanned a probe file")
+	}
+	if cfg.Sentry.DSN == "" {
+		skip("error tracking", "SENTRY_DSN not set; failures are only logged")
+	} else {
+		record("error tracking", nil, "failures are sent to Sentry")
+	}
+	if len(cfg.AuditSigningKey) == 0 {
+		skip("audit signing", "AUDIT_SIGNING_KEY not set; audit entries are hashed but not signed")
+	} else {
+		record("audit signing", nil, "audit entries are signed")
+	}
+	if cfg.ScimToken == "" {
+		skip("scim", "SCIM_TOKEN not set; identity providers can't provision users")
+	} else {
+		record("scim", nil, "identity providers can provision users at /scim/v2")
+	}
+	if cfg.IPAllowlist.BypassToken == "" {
+		skip("ip allowlist bypass", "IP_ALLOWLIST_BYPASS_TOKEN not set; an admin locked out by the allowlist needs database access to get back in")
+	} else {
+		record("ip allowlist bypass", nil, "admins can bypass the allowlist with the X-IP-Allowlist-Bypass header")
+	}
+	if cfg.PasswordPolicy.Breaches == nil {
+		skip("password breach check", "PASSWORD_BREACH_CHECK not set; new passwords aren't checked against known breaches")
+	} else {
+		checkCtx, cancel := context.WithTimeout(ctx, selfTestTimeout)
+		_, err := cfg.PasswordPolicy.Breaches.Breached(checkCtx, "secretary self-test")
+		record("password breach check", err, "the Pwned Passwords API answered")
+		cancel()
+	}
+	if ffmpeg, err := exec.LookPath(cfg.FFmpegPath); err != nil {
+		skip("ffmpeg", cfg.FFmpegPath+" not found, clips cannot be cut")
+	} else {
+		record("ffmpeg", nil, ffmpeg+" found")
+	}
+
+	switch {
+	case cfg.TLS.CertFile != "" && cfg.TLS.KeyFile != "":
+		expiry, err := certificateExpiry(cfg.TLS.CertFile, cfg.TLS.KeyFile)
+		if err == nil && time.Now().After(expiry) {
+			err = fmt.Errorf("certificate expired %s", expiry.Format(time.RFC3339))
+		}
+		record("tls", err, "certificate valid until "+expiry.Format(time.RFC3339))
+	case len(cfg.TLS.AutocertDomains) > 0:
+		if err := os.MkdirAll(cfg.TLS.AutocertCacheDir, 0o700); err != nil {
+			record("tls", err, "")
+		} else {
+			record("tls", checkWritableDir(cfg.TLS.AutocertCacheDir), "autocert for "+strings.Join(cfg.TLS.AutocertDomains, ", "))
+		}
+	default:
+		skip("tls", "not configured, serving plain HTTP")
+	}
+
+	if cfg.WhatsAppSessionDB == "" {
+		skip("whatsapp session storage", "WHATSAPP_SESSION_DB not set")
+	} else {
+		record("whatsapp session storage", checkWritableDir(filepath.Dir(cfg.WhatsAppSessionDB)), filepath.Dir(cfg.WhatsAppSessionDB)+" is writable")
+	}
+
+	if cfg.AISkillsDir == "" {
+		skip("ai skills directory", "AI_SKILLS_DIR not set")
+	} else {
+		info, err := os.Stat(cfg.AISkillsDir)
+		if err == nil && !info.IsDir() {
+			err = fmt.Errorf("%s is not a directory", cfg.AISkillsDir)
+		}
+		record("ai skills directory", err, cfg.AISkillsDir)
+	}
+
+	if strings.TrimSpace(cfg.OpenAIAPIKey) == "" {
+		skip("ai provider", "OPENAI_API_KEY not set; AI features will be disabled")
+	} else {
+		checkCtx, cancel := context.WithTimeout(ctx, selfTestTimeout)
+		record("ai provider", server.CheckOpenAIKey(checkCtx, cfg.OpenAIAPIKey, cfg.OpenAIBaseURL), "api key accepted")
+		cancel()
+	}
+
+	if os.Getenv("PROVIDERS") != "" {
+		for _, p := range cfg.Providers {
+			checkCtx, cancel := context.WithTimeout(ctx, selfTestTimeout)
+			record("provider "+p.Client.Name, server.CheckOpenAIKey(checkCtx, p.Client.APIKey, p.Client.BaseURL), "api key accepted for "+strings.Join(p.Kinds, ", "))
+			cancel()
+		}
+	}
+
+	record("spa bundle", server.CheckSPABundle(), "embedded index.html present")
+
+	return writeSelfTestReport(out, results)
+}
+
+func writeSelfTestReport(out io.Writer, results []selfTestResult) int {
+	width := 0
+	for _, result := range results {
+		width = max(width, len(result.Name))
+	}
+	failed := 0
+	fmt.Fprintln(out, "secretary self-test")
+	for _, result := range results {
+		if result.Status == "FAIL" {
+			failed++
+		}
+		fmt.Fprintf(out, "  %-4s  %-*s  %s\n", result.Status, width, result.Name, result.Detail)
+	}
+	if failed > 0 {
+		fmt.Fprintf(out, "%d check(s) failed\n", failed)
+		return 1
+	}
+	fmt.Fprintln(out, "all checks passed")
+	return 0
+}
+
+func checkWritableDir(dir string) error {
+	f, err := os.CreateTemp(dir, ".selftest-*")
+	if err != nil {
+		return fmt.Errorf("%s is not writable: %w", dir, err)
+	}
+	name := f.Name()
+	f.Close()
+	return os.Remove(name)
+}
+
+// checkBucket writes and deletes a probe object.
+func checkBucket(ctx context.Context, cfg storage.S3Config) error {
+	store, err := storage.NewS3(cfg)
+	if err != nil {
+		return err
+	}
+	return checkStore(ctx, store)
+}
+
+func checkScanner(ctx context.Context, scanner scan.Scanner) error {
+	ctx, cancel := context.WithTimeout(ctx, selfTestTimeout)
+	defer cancel()
+	verdict, err := scanner.Scan(ctx, strings.NewReader("selftest probe"))
+	if err == nil && verdict.Infected {
+		err = fmt.Errorf("a harmless probe was reported as %s", verdict.Signature)
+	}
+	return err
+}
+
+func checkDatabaseMigrations(ctx context.Context, dsn string, opts db.PoolOptions) (string, error) {
+	pool, err := db.OpenWithOptions(ctx, dsn, opts)
+	if err != nil {
+		return "", err
+	}
+	defer pool.Close()
+	return checkMigrations(ctx, pool)
+}
+
+// checkDatabase connects to dsn, runs a query and reports whether the
+// server is a standby.
+func checkDatabase(ctx context.Context, dsn string, opts db.PoolOptions) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, selfTestTimeout)
+	defer cancel()
+	pool, err := db.OpenWithOptions(ctx, dsn, opts)
+	if err != nil {
+		return false, err
+	}
+	defer pool.Close()
+	var standby bool
+	err = pool.QueryRow(ctx, "SELECT pg_is_in_recovery()").Scan(&standby)
+	return standby, err
+}
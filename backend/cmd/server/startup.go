@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mvult/secretary/backend/internal/storage"
+	"github.com/mvult/secretary/backend/migrations"
+)
+
+// startupCheckTimeout bounds each check run before the server listens, so
+// an unreachable dependency stops the process instead of hanging it.
+const startupCheckTimeout = 10 * time.Second
+
+// migrateHint is what an operator runs to bring the schema up to date.
+const migrateHint = "run `atlas migrate apply --env neon` from backend/"
+
+// appliedMigration is a row of atlas's revision table.
+type appliedMigration struct {
+	Version string
+	Applied int
+	Total   int
+	Error   string
+}
+
+// checkStartup verifies the database is reachable and migrated and the
+// audio store is writable. It reports every problem at once, each with
+// what to do about it.
+func checkStartup(ctx context.Context, cfg config, pool *pgxpool.Pool, store storage.Store) error {
+	var problems []error
+	if err := checkPool(ctx, pool); err != nil {
+		problems = append(problems, err)
+	} else if cfg.SkipMigrationCheck {
+		log.Printf("startup: SKIP_MIGRATION_CHECK is set; not comparing the schema with this build's migrations")
+	} else if _, err := checkMigrations(ctx, pool); err != nil {
+		problems = append(problems, err)
+	}
+	if err := checkStore(ctx, store); err != nil {
+		where := "AUDIO_STORAGE_DIR " + cfg.AudioStorageDir
+		if cfg.S3.Bucket != "" {
+			where = "S3 bucket " + cfg.S3.Bucket
+		}
+		problems = append(problems, fmt.Errorf("audio storage: %w; check that %s exists and the server may write to it", err, where))
+	}
+	return errors.Join(problems...)
+}
+
+func checkPool(ctx context.Context, pool *pgxpool.Pool) error {
+	ctx, cancel := context.WithTimeout(ctx, startupCheckTimeout)
+	defer cancel()
+	if err := pool.Ping(ctx); err != nil {
+		c := pool.Config().ConnConfig
+		return fmt.Errorf("database: cannot reach %s:%d/%s as %s: %w; check DATABASE_URL and that postgres accepts connections", c.Host, c.Port, c.Database, c.User, err)
+	}
+	return nil
+}
+
+// checkMigrations compares atlas's revision table with the migrations
+// built into the server and describes the result.
+func checkMigrations(ctx context.Context, pool *pgxpool.Pool) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, startupCheckTimeout)
+	defer cancel()
+	rows, err := pool.Query(ctx, `SELECT version, applied, total, coalesce(error, '') FROM atlas_schema_revisions ORDER BY version`)
+	if err != nil {
+		return "", migrationQueryError(err)
+	}
+	var applied []appliedMigration
+	for rows.Next() {
+		var m appliedMigration
+		if err := rows.Scan(&m.Version, &m.Applied, &m.Total, &m.Error); err != nil {
+			rows.Close()
+			return "", migrationQueryError(err)
+		}
+		applied = append(applied, m)
+	}
+	if err := rows.Err(); err != nil {
+		return "", migrationQueryError(err)
+	}
+	return compareMigrations(migrations.Versions(), applied)
+}
+
+func migrationQueryError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "42P01" {
+		return fmt.Errorf("migrations: the database has no atlas_schema_revisions table, so it was never migrated; %s", migrateHint)
+	}
+	return fmt.Errorf("migrations: reading atlas_schema_revisions: %w", err)
+}
+
+// compareMigrations reports a migration that failed part way and any this
+// build has that the database lacks. A database ahead of the build is fine:
+// during a rolling deploy the old processes run against the new schema.
+func compareMigrations(known []string, applied []appliedMigration) (string, error) {
+	done := make(map[string]bool, len(applied))
+	for _, m := range applied {
+		if m.Error != "" || m.Applied < m.Total {
+			return "", fmt.Errorf("migrations: %s stopped after %d of %d statements: %s; fix the database by hand, then %s", m.Version, m.Applied, m.Total, m.Error, migrateHint)
+		}
+		done[m.Version] = true
+	}
+	var pending []string
+	for _, version := range known {
+		if !done[version] {
+			pending = append(pending, version)
+		}
+	}
+	if len(pending) > 0 {
+		return "", fmt.Errorf("migrations: %d not applied (%s); %s", len(pending), strings.Join(pending, ", "), migrateHint)
+	}
+	detail := fmt.Sprintf("%d applied, latest %s", len(known), known[len(known)-1])
+	newer := 0
+	for _, m := range applied {
+		if !slices.Contains(known, m.Version) {
+			newer++
+		}
+	}
+	if newer > 0 {
+		detail += fmt.Sprintf("; the database has %d newer", newer)
+	}
+	return detail, nil
+}
+
+// checkStore writes and deletes a probe object.
+func checkStore(ctx context.Context, store storage.Store) error {
+	ctx, cancel := context.WithTimeout(ctx, startupCheckTimeout)
+	defer cancel()
+	if _, err := store.Put(ctx, "selftest/probe", strings.NewReader("ok")); err != nil {
+		return err
+	}
+	return store.Delete(ctx, "selftest/probe")
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mvult/secretary/backend/migrations"
+)
+
+func TestCompareMigrations(t *testing.T) {
+	known := []string{"001", "002", "20260126052726"}
+	done := func(versions ...string) []appliedMigration {
+		var applied []appliedMigration
+		for _, v := range versions {
+			applied = append(applied, appliedMigration{Version: v, Applied: 3, Total: 3})
+		}
+		return applied
+	}
+	cases := []struct {
+		name    string
+		applied []appliedMigration
+		want    string
+	}{
+		{"up to date", done("001", "002", "20260126052726"), "3 applied, latest 20260126052726"},
+		{"database ahead", done("001", "002", "20260126052726", "20270101000000"), "the database has 1 newer"},
+		{"pending", done("001"), "2 not applied (002, 20260126052726)"},
+		{"failed", append(done("001"), appliedMigration{Version: "002", Applied: 1, Total: 3, Error: "column exists"}), "002 stopped after 1 of 3 statements: column exists"},
+	}
+	for _, tc := range cases {
+		detail, err := compareMigrations(known, tc.applied)
+		got := detail
+		if err != nil {
+			got = err.Error()
+		}
+		if !strings.Contains(got, tc.want) {
+			t.Errorf("%s: got %q, want it to mention %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	versions := migrations.Versions()
+	if len(versions) < 3 || versions[0] != "001" || versions[1] != "002" {
+		t.Fatalf("versions = %v", versions)
+	}
+	for i := 1; i < len(versions); i++ {
+		if versions[i] <= versions[i-1] {
+			t.Fatalf("versions out of order: %s after %s", versions[i], versions[i-1])
+		}
+	}
+}
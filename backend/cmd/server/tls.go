@@ -0,0 +1,146 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// tlsSettings controls whether the server terminates TLS itself. Either a
+// certificate/key pair or a list of autocert domains enables it.
+type tlsSettings struct {
+	CertFile         string
+	KeyFile          string
+	AutocertDomains  []string
+	AutocertCacheDir string
+	AutocertEmail    string
+	// RedirectAddr, when set, runs a plain-HTTP listener that redirects to
+	// HTTPS and answers ACME HTTP-01 challenges.
+	RedirectAddr string
+	HSTSMaxAge   time.Duration
+}
+
+func (t tlsSettings) enabled() bool {
+	return t.CertFile != "" || len(t.AutocertDomains) > 0
+}
+
+func (t tlsSettings) validate() error {
+	var problems []error
+	if (t.CertFile == "") != (t.KeyFile == "") {
+		problems = append(problems, errors.New("TLS_CERT_FILE and TLS_KEY_FILE must be set together"))
+	}
+	if t.CertFile != "" && len(t.AutocertDomains) > 0 {
+		problems = append(problems, errors.New("TLS_CERT_FILE and TLS_AUTOCERT_DOMAINS are mutually exclusive"))
+	}
+	if t.RedirectAddr != "" && !t.enabled() {
+		problems = append(problems, errors.New("HTTP_REDIRECT_ADDR requires TLS to be configured"))
+	}
+	return errors.Join(problems...)
+}
+
+// tlsServer holds what main needs to run the HTTPS listener.
+type tlsServer struct {
+	config *tls.Config
+	// challenge wraps the redirect handler so ACME HTTP-01 requests are
+	// answered; it is the identity when certificates come from files.
+	challenge func(http.Handler) http.Handler
+}
+
+func newTLSServer(t tlsSettings) (*tlsServer, error) {
+	if len(t.AutocertDomains) > 0 {
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(t.AutocertDomains...),
+			Cache:      autocert.DirCache(t.AutocertCacheDir),
+			Email:      t.AutocertEmail,
+		}
+		config := manager.TLSConfig()
+		config.MinVersion = tls.VersionTLS12
+		return &tlsServer{config: config, challenge: manager.HTTPHandler}, nil
+	}
+
+	cert, err := loadCertificate(t.CertFile, t.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{*cert},
+	}
+	return &tlsServer{config: config, challenge: func(h http.Handler) http.Handler { return h }}, nil
+}
+
+func loadCertificate(certFile string, keyFile string) (*tls.Certificate, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load TLS certificate: %w", err)
+	}
+	return &cert, nil
+}
+
+// certificateExpiry reports when the leaf certificate in certFile expires.
+func certificateExpiry(certFile string, keyFile string) (time.Time, error) {
+	cert, err := loadCertificate(certFile, keyFile)
+	if err != nil {
+		return time.Time{}, err
+	}
+	leaf := cert.Leaf
+	if leaf == nil {
+		if leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+			return time.Time{}, err
+		}
+	}
+	return leaf.NotAfter, nil
+}
+
+// serverProtocols enables HTTP/2 alongside HTTP/1.1. Without TLS, h2c is
+// allowed so gRPC clients and proxies can still speak HTTP/2.
+func serverProtocols(tlsEnabled bool) *http.Protocols {
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	if tlsEnabled {
+		protocols.SetHTTP2(true)
+	} else {
+		protocols.SetUnencryptedHTTP2(true)
+	}
+	return protocols
+}
+
+// withHSTS tells browsers to use HTTPS for future visits.
+func withHSTS(next http.Handler, maxAge time.Duration) http.Handler {
+	if maxAge <= 0 {
+		return next
+	}
+	value := "max-age=" + strconv.Itoa(int(maxAge.Seconds()))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Strict-Transport-Security", value)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// redirectToHTTPS sends plain-HTTP requests to the same host on the HTTPS
+// listener's port. Non-idempotent methods get 308 so the body is replayed.
+func redirectToHTTPS(httpsAddr string) http.Handler {
+	_, httpsPort, _ := net.SplitHostPort(httpsAddr)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if httpsPort != "" && httpsPort != "443" {
+			host = net.JoinHostPort(host, httpsPort)
+		}
+		status := http.StatusMovedPermanently
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			status = http.StatusPermanentRedirect
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), status)
+	})
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRedirectToHTTPS(t *testing.T) {
+	cases := []struct {
+		httpsAddr string
+		method    string
+		target    string
+		status    int
+		location  string
+	}{
+		{":443", http.MethodGet, "http://secretary.example:80/recordings/3?tab=todos", http.StatusMovedPermanently, "https://secretary.example/recordings/3?tab=todos"},
+		{":8443", http.MethodGet, "http://secretary.example/", http.StatusMovedPermanently, "https://secretary.example:8443/"},
+		{":443", http.MethodPost, "http://secretary.example/api/login", http.StatusPermanentRedirect, "https://secretary.example/api/login"},
+	}
+	for _, tc := range cases {
+		rec := httptest.NewRecorder()
+		redirectToHTTPS(tc.httpsAddr).ServeHTTP(rec, httptest.NewRequest(tc.method, tc.target, nil))
+		if rec.Code != tc.status || rec.Header().Get("Location") != tc.location {
+			t.Fatalf("%s %s: got %d %q", tc.method, tc.target, rec.Code, rec.Header().Get("Location"))
+		}
+	}
+}
+
+func TestWithHSTS(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	rec := httptest.NewRecorder()
+	withHSTS(ok, 365*24*time.Hour).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if got := rec.Header().Get("Strict-Transport-Security"); got != "max-age=31536000" {
+		t.Fatalf("unexpected HSTS header %q", got)
+	}
+	rec = httptest.NewRecorder()
+	withHSTS(ok, 0).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if got := rec.Header().Get("Strict-Transport-Security"); got != "" {
+		t.Fatalf("expected no HSTS header when disabled, got %q", got)
+	}
+}
+
+func TestTLSSettingsValidate(t *testing.T) {
+	invalid := []tlsSettings{
+		{CertFile: "cert.pem"},
+		{CertFile: "cert.pem", KeyFile: "key.pem", AutocertDomains: []string{"secretary.example"}},
+		{RedirectAddr: ":80"},
+	}
+	for _, settings := range invalid {
+		if err := settings.validate(); err == nil {
+			t.Fatalf("expected %+v to be rejected", settings)
+		}
+	}
+	valid := []tlsSettings{
+		{},
+		{CertFile: "cert.pem", KeyFile: "key.pem", RedirectAddr: ":80"},
+		{AutocertDomains: []string{"secretary.example"}, RedirectAddr: ":80"},
+	}
+	for _, settings := range valid {
+		if err := settings.validate(); err != nil {
+			t.Fatalf("expected %+v to be accepted: %v", settings, err)
+		}
+	}
+}
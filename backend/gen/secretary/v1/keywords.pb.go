@@ -0,0 +1,706 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: secretary/v1/keywords.proto
+
+package secretaryv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A word or phrase the user wants to hear about when a meeting mentions
+// it, e.g. "budget" or a competitor's name.
+type WatchKeyword struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Keyword string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	// Only meetings ready after this are matched.
+	CreatedAt     string `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchKeyword) Reset() {
+	*x = WatchKeyword{}
+	mi := &file_secretary_v1_keywords_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchKeyword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchKeyword) ProtoMessage() {}
+
+func (x *WatchKeyword) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_keywords_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchKeyword.ProtoReflect.Descriptor instead.
+func (*WatchKeyword) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_keywords_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchKeyword) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WatchKeyword) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *WatchKeyword) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// A transcript line that says one of the user's keywords.
+type KeywordAlert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	KeywordId     int64                  `protobuf:"varint,2,opt,name=keyword_id,json=keywordId,proto3" json:"keyword_id,omitempty"`
+	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	RecordingId   int64                  `protobuf:"varint,4,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+	RecordingName string                 `protobuf:"bytes,5,opt,name=recording_name,json=recordingName,proto3" json:"recording_name,omitempty"`
+	// The line's index in the transcript, counting from 0.
+	SegmentIndex  int32  `protobuf:"varint,6,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	SegmentText   string `protobuf:"bytes,7,opt,name=segment_text,json=segmentText,proto3" json:"segment_text,omitempty"`
+	CreatedAt     string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeywordAlert) Reset() {
+	*x = KeywordAlert{}
+	mi := &file_secretary_v1_keywords_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeywordAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeywordAlert) ProtoMessage() {}
+
+func (x *KeywordAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_keywords_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeywordAlert.ProtoReflect.Descriptor instead.
+func (*KeywordAlert) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_keywords_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KeywordAlert) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KeywordAlert) GetKeywordId() int64 {
+	if x != nil {
+		return x.KeywordId
+	}
+	return 0
+}
+
+func (x *KeywordAlert) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *KeywordAlert) GetRecordingId() int64 {
+	if x != nil {
+		return x.RecordingId
+	}
+	return 0
+}
+
+func (x *KeywordAlert) GetRecordingName() string {
+	if x != nil {
+		return x.RecordingName
+	}
+	return ""
+}
+
+func (x *KeywordAlert) GetSegmentIndex() int32 {
+	if x != nil {
+		return x.SegmentIndex
+	}
+	return 0
+}
+
+func (x *KeywordAlert) GetSegmentText() string {
+	if x != nil {
+		return x.SegmentText
+	}
+	return ""
+}
+
+func (x *KeywordAlert) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListWatchKeywordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchKeywordsRequest) Reset() {
+	*x = ListWatchKeywordsRequest{}
+	mi := &file_secretary_v1_keywords_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchKeywordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchKeywordsRequest) ProtoMessage() {}
+
+func (x *ListWatchKeywordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_keywords_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchKeywordsRequest.ProtoReflect.Descriptor instead.
+func (*ListWatchKeywordsRequest) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_keywords_proto_rawDescGZIP(), []int{2}
+}
+
+type ListWatchKeywordsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// In alphabetical order.
+	Keywords      []*WatchKeyword `protobuf:"bytes,1,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchKeywordsResponse) Reset() {
+	*x = ListWatchKeywordsResponse{}
+	mi := &file_secretary_v1_keywords_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchKeywordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchKeywordsResponse) ProtoMessage() {}
+
+func (x *ListWatchKeywordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_keywords_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchKeywordsResponse.ProtoReflect.Descriptor instead.
+func (*ListWatchKeywordsResponse) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_keywords_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListWatchKeywordsResponse) GetKeywords() []*WatchKeyword {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+type CreateWatchKeywordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Matched as whole words, ignoring case and accents, so "budget" finds
+	// "Budget" but not "budgeted".
+	Keyword       string `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWatchKeywordRequest) Reset() {
+	*x = CreateWatchKeywordRequest{}
+	mi := &file_secretary_v1_keywords_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWatchKeywordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWatchKeywordRequest) ProtoMessage() {}
+
+func (x *CreateWatchKeywordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_keywords_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWatchKeywordRequest.ProtoReflect.Descriptor instead.
+func (*CreateWatchKeywordRequest) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_keywords_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateWatchKeywordRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+type CreateWatchKeywordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       *WatchKeyword          `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWatchKeywordResponse) Reset() {
+	*x = CreateWatchKeywordResponse{}
+	mi := &file_secretary_v1_keywords_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWatchKeywordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWatchKeywordResponse) ProtoMessage() {}
+
+func (x *CreateWatchKeywordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_keywords_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWatchKeywordResponse.ProtoReflect.Descriptor instead.
+func (*CreateWatchKeywordResponse) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_keywords_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateWatchKeywordResponse) GetKeyword() *WatchKeyword {
+	if x != nil {
+		return x.Keyword
+	}
+	return nil
+}
+
+type DeleteWatchKeywordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWatchKeywordRequest) Reset() {
+	*x = DeleteWatchKeywordRequest{}
+	mi := &file_secretary_v1_keywords_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWatchKeywordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWatchKeywordRequest) ProtoMessage() {}
+
+func (x *DeleteWatchKeywordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_keywords_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWatchKeywordRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWatchKeywordRequest) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_keywords_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteWatchKeywordRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWatchKeywordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWatchKeywordResponse) Reset() {
+	*x = DeleteWatchKeywordResponse{}
+	mi := &file_secretary_v1_keywords_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWatchKeywordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWatchKeywordResponse) ProtoMessage() {}
+
+func (x *DeleteWatchKeywordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_keywords_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWatchKeywordResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWatchKeywordResponse) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_keywords_proto_rawDescGZIP(), []int{7}
+}
+
+type ListKeywordAlertsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only alerts for this keyword.
+	KeywordId *int64 `protobuf:"varint,1,opt,name=keyword_id,json=keywordId,proto3,oneof" json:"keyword_id,omitempty"`
+	// At most this many alerts; 100 when unset.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKeywordAlertsRequest) Reset() {
+	*x = ListKeywordAlertsRequest{}
+	mi := &file_secretary_v1_keywords_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKeywordAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeywordAlertsRequest) ProtoMessage() {}
+
+func (x *ListKeywordAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_keywords_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeywordAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListKeywordAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_keywords_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListKeywordAlertsRequest) GetKeywordId() int64 {
+	if x != nil && x.KeywordId != nil {
+		return *x.KeywordId
+	}
+	return 0
+}
+
+func (x *ListKeywordAlertsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListKeywordAlertsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Alerts        []*KeywordAlert `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKeywordAlertsResponse) Reset() {
+	*x = ListKeywordAlertsResponse{}
+	mi := &file_secretary_v1_keywords_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKeywordAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeywordAlertsResponse) ProtoMessage() {}
+
+func (x *ListKeywordAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_keywords_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeywordAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListKeywordAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_keywords_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListKeywordAlertsResponse) GetAlerts() []*KeywordAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+var File_secretary_v1_keywords_proto protoreflect.FileDescriptor
+
+var file_secretary_v1_keywords_proto_rawDesc = string([]byte{
+	0x0a, 0x1b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x57, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x88, 0x02, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x1a, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x40, 0x0a,
+	0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06,
+	0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x22,
+	0x52, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0x34, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x48, 0x00, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xf4, 0x03, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x69,
+	0x64, 0x22, 0x4f, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72,
+	0x74, 0x73, 0x32, 0xbe, 0x03, 0x0a, 0x14, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x69, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x26, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x67, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x2e, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x67, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x26, 0x2e,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03,
+	0x90, 0x02, 0x01, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6d, 0x76, 0x75, 0x6c, 0x74, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72,
+	0x79, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x61, 0x72, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_secretary_v1_keywords_proto_rawDescOnce sync.Once
+	file_secretary_v1_keywords_proto_rawDescData []byte
+)
+
+func file_secretary_v1_keywords_proto_rawDescGZIP() []byte {
+	file_secretary_v1_keywords_proto_rawDescOnce.Do(func() {
+		file_secretary_v1_keywords_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_secretary_v1_keywords_proto_rawDesc), len(file_secretary_v1_keywords_proto_rawDesc)))
+	})
+	return file_secretary_v1_keywords_proto_rawDescData
+}
+
+var file_secretary_v1_keywords_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_secretary_v1_keywords_proto_goTypes = []any{
+	(*WatchKeyword)(nil),               // 0: secretary.v1.WatchKeyword
+	(*KeywordAlert)(nil),               // 1: secretary.v1.KeywordAlert
+	(*ListWatchKeywordsRequest)(nil),   // 2: secretary.v1.ListWatchKeywordsRequest
+	(*ListWatchKeywordsResponse)(nil),  // 3: secretary.v1.ListWatchKeywordsResponse
+	(*CreateWatchKeywordRequest)(nil),  // 4: secretary.v1.CreateWatchKeywordRequest
+	(*CreateWatchKeywordResponse)(nil), // 5: secretary.v1.CreateWatchKeywordResponse
+	(*DeleteWatchKeywordRequest)(nil),  // 6: secretary.v1.DeleteWatchKeywordRequest
+	(*DeleteWatchKeywordResponse)(nil), // 7: secretary.v1.DeleteWatchKeywordResponse
+	(*ListKeywordAlertsRequest)(nil),   // 8: secretary.v1.ListKeywordAlertsRequest
+	(*ListKeywordAlertsResponse)(nil),  // 9: secretary.v1.ListKeywordAlertsResponse
+}
+var file_secretary_v1_keywords_proto_depIdxs = []int32{
+	0, // 0: secretary.v1.ListWatchKeywordsResponse.keywords:type_name -> secretary.v1.WatchKeyword
+	0, // 1: secretary.v1.CreateWatchKeywordResponse.keyword:type_name -> secretary.v1.WatchKeyword
+	1, // 2: secretary.v1.ListKeywordAlertsResponse.alerts:type_name -> secretary.v1.KeywordAlert
+	2, // 3: secretary.v1.KeywordAlertsService.ListWatchKeywords:input_type -> secretary.v1.ListWatchKeywordsRequest
+	4, // 4: secretary.v1.KeywordAlertsService.CreateWatchKeyword:input_type -> secretary.v1.CreateWatchKeywordRequest
+	6, // 5: secretary.v1.KeywordAlertsService.DeleteWatchKeyword:input_type -> secretary.v1.DeleteWatchKeywordRequest
+	8, // 6: secretary.v1.KeywordAlertsService.ListKeywordAlerts:input_type -> secretary.v1.ListKeywordAlertsRequest
+	3, // 7: secretary.v1.KeywordAlertsService.ListWatchKeywords:output_type -> secretary.v1.ListWatchKeywordsResponse
+	5, // 8: secretary.v1.KeywordAlertsService.CreateWatchKeyword:output_type -> secretary.v1.CreateWatchKeywordResponse
+	7, // 9: secretary.v1.KeywordAlertsService.DeleteWatchKeyword:output_type -> secretary.v1.DeleteWatchKeywordResponse
+	9, // 10: secretary.v1.KeywordAlertsService.ListKeywordAlerts:output_type -> secretary.v1.ListKeywordAlertsResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_secretary_v1_keywords_proto_init() }
+func file_secretary_v1_keywords_proto_init() {
+	if File_secretary_v1_keywords_proto != nil {
+		return
+	}
+	file_secretary_v1_keywords_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_secretary_v1_keywords_proto_rawDesc), len(file_secretary_v1_keywords_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_secretary_v1_keywords_proto_goTypes,
+		DependencyIndexes: file_secretary_v1_keywords_proto_depIdxs,
+		MessageInfos:      file_secretary_v1_keywords_proto_msgTypes,
+	}.Build()
+	File_secretary_v1_keywords_proto = out.File
+	file_secretary_v1_keywords_proto_goTypes = nil
+	file_secretary_v1_keywords_proto_depIdxs = nil
+}
@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: secretary/v1/quarantine.proto
+
+package secretaryv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// What the malware scanner made of a stored file.
+type ScanStatus int32
+
+const (
+	ScanStatus_SCAN_STATUS_UNSPECIFIED ScanStatus = 0
+	// Stored while no scanner was configured.
+	ScanStatus_SCAN_STATUS_UNSCANNED ScanStatus = 1
+	// Waiting for the scanner; recording audio is scanned in the background
+	// and isn't transcribed or clipped until it is clean.
+	ScanStatus_SCAN_STATUS_PENDING ScanStatus = 2
+	ScanStatus_SCAN_STATUS_CLEAN   ScanStatus = 3
+	// Found infected, or refused by the scanner, and held for an admin to
+	// review. Quarantined files can't be downloaded, transcribed or clipped.
+	ScanStatus_SCAN_STATUS_QUARANTINED ScanStatus = 4
+	// Quarantined, then released by an admin.
+	ScanStatus_SCAN_STATUS_RELEASED ScanStatus = 5
+)
+
+// Enum value maps for ScanStatus.
+var (
+	ScanStatus_name = map[int32]string{
+		0: "SCAN_STATUS_UNSPECIFIED",
+		1: "SCAN_STATUS_UNSCANNED",
+		2: "SCAN_STATUS_PENDING",
+		3: "SCAN_STATUS_CLEAN",
+		4: "SCAN_STATUS_QUARANTINED",
+		5: "SCAN_STATUS_RELEASED",
+	}
+	ScanStatus_value = map[string]int32{
+		"SCAN_STATUS_UNSPECIFIED": 0,
+		"SCAN_STATUS_UNSCANNED":   1,
+		"SCAN_STATUS_PENDING":     2,
+		"SCAN_STATUS_CLEAN":       3,
+		"SCAN_STATUS_QUARANTINED": 4,
+		"SCAN_STATUS_RELEASED":    5,
+	}
+)
+
+func (x ScanStatus) Enum() *ScanStatus {
+	p := new(ScanStatus)
+	*p = x
+	return p
+}
+
+func (x ScanStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScanStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_secretary_v1_quarantine_proto_enumTypes[0].Descriptor()
+}
+
+func (ScanStatus) Type() protoreflect.EnumType {
+	return &file_secretary_v1_quarantine_proto_enumTypes[0]
+}
+
+func (x ScanStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScanStatus.Descriptor instead.
+func (ScanStatus) EnumDescriptor() ([]byte, []int) {
+	return file_secretary_v1_quarantine_proto_rawDescGZIP(), []int{0}
+}
+
+type ScannedFileKind int32
+
+const (
+	ScannedFileKind_SCANNED_FILE_KIND_UNSPECIFIED ScannedFileKind = 0
+	ScannedFileKind_SCANNED_FILE_KIND_ATTACHMENT  ScannedFileKind = 1
+	// A recording's audio.
+	ScannedFileKind_SCANNED_FILE_KIND_RECORDING ScannedFileKind = 2
+)
+
+// Enum value maps for ScannedFileKind.
+var (
+	ScannedFileKind_name = map[int32]string{
+		0: "SCANNED_FILE_KIND_UNSPECIFIED",
+		1: "SCANNED_FILE_KIND_ATTACHMENT",
+		2: "SCANNED_FILE_KIND_RECORDING",
+	}
+	ScannedFileKind_value = map[string]int32{
+		"SCANNED_FILE_KIND_UNSPECIFIED": 0,
+		"SCANNED_FILE_KIND_ATTACHMENT":  1,
+		"SCANNED_FILE_KIND_RECORDING":   2,
+	}
+)
+
+func (x ScannedFileKind) Enum() *ScannedFileKind {
+	p := new(ScannedFileKind)
+	*p = x
+	return p
+}
+
+func (x ScannedFileKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScannedFileKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_secretary_v1_quarantine_proto_enumTypes[1].Descriptor()
+}
+
+func (ScannedFileKind) Type() protoreflect.EnumType {
+	return &file_secretary_v1_quarantine_proto_enumTypes[1]
+}
+
+func (x ScannedFileKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScannedFileKind.Descriptor instead.
+func (ScannedFileKind) EnumDescriptor() ([]byte, []int) {
+	return file_secretary_v1_quarantine_proto_rawDescGZIP(), []int{1}
+}
+
+type QuarantinedFile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  ScannedFileKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=secretary.v1.ScannedFileKind" json:"kind,omitempty"`
+	// The attachment's or the recording's ID.
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The attachment's file name or the recording's name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The signature found, or why the scanner refused the file.
+	Detail    string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	ScannedAt string `protobuf:"bytes,5,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+	// Who uploaded it; 0 when unknown.
+	UploadedByUserId int64 `protobuf:"varint,6,opt,name=uploaded_by_user_id,json=uploadedByUserId,proto3" json:"uploaded_by_user_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *QuarantinedFile) Reset() {
+	*x = QuarantinedFile{}
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuarantinedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuarantinedFile) ProtoMessage() {}
+
+func (x *QuarantinedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuarantinedFile.ProtoReflect.Descriptor instead.
+func (*QuarantinedFile) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_quarantine_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuarantinedFile) GetKind() ScannedFileKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ScannedFileKind_SCANNED_FILE_KIND_UNSPECIFIED
+}
+
+func (x *QuarantinedFile) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QuarantinedFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QuarantinedFile) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *QuarantinedFile) GetScannedAt() string {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return ""
+}
+
+func (x *QuarantinedFile) GetUploadedByUserId() int64 {
+	if x != nil {
+		return x.UploadedByUserId
+	}
+	return 0
+}
+
+type ListQuarantinedFilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuarantinedFilesRequest) Reset() {
+	*x = ListQuarantinedFilesRequest{}
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuarantinedFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuarantinedFilesRequest) ProtoMessage() {}
+
+func (x *ListQuarantinedFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuarantinedFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedFilesRequest) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_quarantine_proto_rawDescGZIP(), []int{1}
+}
+
+type ListQuarantinedFilesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest first.
+	Files         []*QuarantinedFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuarantinedFilesResponse) Reset() {
+	*x = ListQuarantinedFilesResponse{}
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuarantinedFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuarantinedFilesResponse) ProtoMessage() {}
+
+func (x *ListQuarantinedFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuarantinedFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedFilesResponse) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_quarantine_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListQuarantinedFilesResponse) GetFiles() []*QuarantinedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type ReleaseQuarantinedFileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  ScannedFileKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=secretary.v1.ScannedFileKind" json:"kind,omitempty"`
+	Id    int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Why the file is safe, e.g. a false positive; logged with the release.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseQuarantinedFileRequest) Reset() {
+	*x = ReleaseQuarantinedFileRequest{}
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseQuarantinedFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseQuarantinedFileRequest) ProtoMessage() {}
+
+func (x *ReleaseQuarantinedFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseQuarantinedFileRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseQuarantinedFileRequest) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_quarantine_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReleaseQuarantinedFileRequest) GetKind() ScannedFileKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ScannedFileKind_SCANNED_FILE_KIND_UNSPECIFIED
+}
+
+func (x *ReleaseQuarantinedFileRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReleaseQuarantinedFileRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReleaseQuarantinedFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseQuarantinedFileResponse) Reset() {
+	*x = ReleaseQuarantinedFileResponse{}
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseQuarantinedFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseQuarantinedFileResponse) ProtoMessage() {}
+
+func (x *ReleaseQuarantinedFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseQuarantinedFileResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseQuarantinedFileResponse) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_quarantine_proto_rawDescGZIP(), []int{4}
+}
+
+type DeleteQuarantinedFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          ScannedFileKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=secretary.v1.ScannedFileKind" json:"kind,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuarantinedFileRequest) Reset() {
+	*x = DeleteQuarantinedFileRequest{}
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuarantinedFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuarantinedFileRequest) ProtoMessage() {}
+
+func (x *DeleteQuarantinedFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuarantinedFileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuarantinedFileRequest) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_quarantine_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteQuarantinedFileRequest) GetKind() ScannedFileKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ScannedFileKind_SCANNED_FILE_KIND_UNSPECIFIED
+}
+
+func (x *DeleteQuarantinedFileRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteQuarantinedFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuarantinedFileResponse) Reset() {
+	*x = DeleteQuarantinedFileResponse{}
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuarantinedFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuarantinedFileResponse) ProtoMessage() {}
+
+func (x *DeleteQuarantinedFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secretary_v1_quarantine_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuarantinedFileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuarantinedFileResponse) Descriptor() ([]byte, []int) {
+	return file_secretary_v1_quarantine_proto_rawDescGZIP(), []int{6}
+}
+
+var File_secretary_v1_quarantine_proto protoreflect.FileDescriptor
+
+var file_secretary_v1_quarantine_proto_rawDesc = string([]byte{
+	0x0a, 0x1d, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x71,
+	0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0c, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62,
+	0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x01, 0x0a, 0x0f, 0x51,
+	0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x31,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x13,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x4c,
+	0x69, 0x73, 0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x1c, 0x4c, 0x69,
+	0x73, 0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74,
+	0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22,
+	0x9b, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61,
+	0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3d, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0xf4, 0x03, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x20, 0x0a,
+	0x1e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x76, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74,
+	0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3d, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x17,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0xab, 0x01, 0x0a, 0x0a, 0x53, 0x63, 0x61,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x43, 0x41, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x43, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x17, 0x0a, 0x13, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x43, 0x41, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x03, 0x12,
+	0x1b, 0x0a, 0x17, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x51,
+	0x55, 0x41, 0x52, 0x41, 0x4e, 0x54, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14,
+	0x53, 0x43, 0x41, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4c, 0x45,
+	0x41, 0x53, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x77, 0x0a, 0x0f, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x43, 0x41,
+	0x4e, 0x4e, 0x45, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c,
+	0x53, 0x43, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x1f,
+	0x0a, 0x1b, 0x53, 0x43, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x32,
+	0xee, 0x02, 0x0a, 0x11, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x72, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61,
+	0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x2e,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61, 0x72,
+	0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x73, 0x0a, 0x16, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e,
+	0x74, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70,
+	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x61,
+	0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74,
+	0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d,
+	0x76, 0x75, 0x6c, 0x74, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72, 0x79, 0x2f, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x61, 0x72,
+	0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_secretary_v1_quarantine_proto_rawDescOnce sync.Once
+	file_secretary_v1_quarantine_proto_rawDescData []byte
+)
+
+func file_secretary_v1_quarantine_proto_rawDescGZIP() []byte {
+	file_secretary_v1_quarantine_proto_rawDescOnce.Do(func() {
+		file_secretary_v1_quarantine_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_secretary_v1_quarantine_proto_rawDesc), len(file_secretary_v1_quarantine_proto_rawDesc)))
+	})
+	return file_secretary_v1_quarantine_proto_rawDescData
+}
+
+var file_secretary_v1_quarantine_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_secretary_v1_quarantine_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_secretary_v1_quarantine_proto_goTypes = []any{
+	(ScanStatus)(0),                        // 0: secretary.v1.ScanStatus
+	(ScannedFileKind)(0),                   // 1: secretary.v1.ScannedFileKind
+	(*QuarantinedFile)(nil),                // 2: secretary.v1.QuarantinedFile
+	(*ListQuarantinedFilesRequest)(nil),    // 3: secretary.v1.ListQuarantinedFilesRequest
+	(*ListQuarantinedFilesResponse)(nil),   // 4: secretary.v1.ListQuarantinedFilesResponse
+	(*ReleaseQuarantinedFileRequest)(nil),  // 5: secretary.v1.ReleaseQuarantinedFileRequest
+	(*ReleaseQuarantinedFileResponse)(nil), // 6: secretary.v1.ReleaseQuarantinedFileResponse
+	(*DeleteQuarantinedFileRequest)(nil),   // 7: secretary.v1.DeleteQuarantinedFileRequest
+	(*DeleteQuarantinedFileResponse)(nil),  // 8: secretary.v1.DeleteQuarantinedFileResponse
+}
+var file_secretary_v1_quarantine_proto_depIdxs = []int32{
+	1, // 0: secretary.v1.QuarantinedFile.kind:type_name -> secretary.v1.ScannedFileKind
+	2, // 1: secretary.v1.ListQuarantinedFilesResponse.files:type_name -> secretary.v1.QuarantinedFile
+	1, // 2: secretary.v1.ReleaseQuarantinedFileRequest.kind:type_name -> secretary.v1.ScannedFileKind
+	1, // 3: secretary.v1.DeleteQuarantinedFileRequest.kind:type_name -> secretary.v1.ScannedFileKind
+	3, // 4: secretary.v1.QuarantineService.ListQuarantinedFiles:input_type -> secretary.v1.ListQuarantinedFilesRequest
+	5, // 5: secretary.v1.QuarantineService.ReleaseQuarantinedFile:input_type -> secretary.v1.ReleaseQuarantinedFileRequest
+	7, // 6: secretary.v1.QuarantineService.DeleteQuarantinedFile:input_type -> secretary.v1.DeleteQuarantinedFileRequest
+	4, // 7: secretary.v1.QuarantineService.ListQuarantinedFiles:output_type -> secretary.v1.ListQuarantinedFilesResponse
+	6, // 8: secretary.v1.QuarantineService.ReleaseQuarantinedFile:output_type -> secretary.v1.ReleaseQuarantinedFileResponse
+	8, // 9: secretary.v1.QuarantineService.DeleteQuarantinedFile:output_type -> secretary.v1.DeleteQuarantinedFileResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_secretary_v1_quarantine_proto_init() }
+func file_secretary_v1_quarantine_proto_init() {
+	if File_secretary_v1_quarantine_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_secretary_v1_quarantine_proto_rawDesc), len(file_secretary_v1_quarantine_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_secretary_v1_quarantine_proto_goTypes,
+		DependencyIndexes: file_secretary_v1_quarantine_proto_depIdxs,
+		EnumInfos:         file_secretary_v1_quarantine_proto_enumTypes,
+		MessageInfos:      file_secretary_v1_quarantine_proto_msgTypes,
+	}.Build()
+	File_secretary_v1_quarantine_proto = out.File
+	file_secretary_v1_quarantine_proto_goTypes = nil
+	file_secretary_v1_quarantine_proto_depIdxs = nil
+}
@@ -0,0 +1,639 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: secretary/v1/admin.proto
+
+package secretaryv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "secretary.v1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceExportInstanceProcedure is the fully-qualified name of the AdminService's
+	// ExportInstance RPC.
+	AdminServiceExportInstanceProcedure = "/secretary.v1.AdminService/ExportInstance"
+	// AdminServiceImportInstanceProcedure is the fully-qualified name of the AdminService's
+	// ImportInstance RPC.
+	AdminServiceImportInstanceProcedure = "/secretary.v1.AdminService/ImportInstance"
+	// AdminServiceListBackupsProcedure is the fully-qualified name of the AdminService's ListBackups
+	// RPC.
+	AdminServiceListBackupsProcedure = "/secretary.v1.AdminService/ListBackups"
+	// AdminServiceGetSystemStatsProcedure is the fully-qualified name of the AdminService's
+	// GetSystemStats RPC.
+	AdminServiceGetSystemStatsProcedure = "/secretary.v1.AdminService/GetSystemStats"
+	// AdminServiceListScheduledTasksProcedure is the fully-qualified name of the AdminService's
+	// ListScheduledTasks RPC.
+	AdminServiceListScheduledTasksProcedure = "/secretary.v1.AdminService/ListScheduledTasks"
+	// AdminServiceUpdateScheduledTaskProcedure is the fully-qualified name of the AdminService's
+	// UpdateScheduledTask RPC.
+	AdminServiceUpdateScheduledTaskProcedure = "/secretary.v1.AdminService/UpdateScheduledTask"
+	// AdminServiceListFeatureFlagsProcedure is the fully-qualified name of the AdminService's
+	// ListFeatureFlags RPC.
+	AdminServiceListFeatureFlagsProcedure = "/secretary.v1.AdminService/ListFeatureFlags"
+	// AdminServiceUpdateFeatureFlagProcedure is the fully-qualified name of the AdminService's
+	// UpdateFeatureFlag RPC.
+	AdminServiceUpdateFeatureFlagProcedure = "/secretary.v1.AdminService/UpdateFeatureFlag"
+	// AdminServiceSetUserFeatureFlagProcedure is the fully-qualified name of the AdminService's
+	// SetUserFeatureFlag RPC.
+	AdminServiceSetUserFeatureFlagProcedure = "/secretary.v1.AdminService/SetUserFeatureFlag"
+	// AdminServiceGetMaintenanceModeProcedure is the fully-qualified name of the AdminService's
+	// GetMaintenanceMode RPC.
+	AdminServiceGetMaintenanceModeProcedure = "/secretary.v1.AdminService/GetMaintenanceMode"
+	// AdminServiceSetMaintenanceModeProcedure is the fully-qualified name of the AdminService's
+	// SetMaintenanceMode RPC.
+	AdminServiceSetMaintenanceModeProcedure = "/secretary.v1.AdminService/SetMaintenanceMode"
+	// AdminServiceListAuditLogProcedure is the fully-qualified name of the AdminService's ListAuditLog
+	// RPC.
+	AdminServiceListAuditLogProcedure = "/secretary.v1.AdminService/ListAuditLog"
+	// AdminServiceVerifyAuditLogProcedure is the fully-qualified name of the AdminService's
+	// VerifyAuditLog RPC.
+	AdminServiceVerifyAuditLogProcedure = "/secretary.v1.AdminService/VerifyAuditLog"
+	// AdminServiceListScimGroupsProcedure is the fully-qualified name of the AdminService's
+	// ListScimGroups RPC.
+	AdminServiceListScimGroupsProcedure = "/secretary.v1.AdminService/ListScimGroups"
+	// AdminServiceUpdateScimGroupMappingProcedure is the fully-qualified name of the AdminService's
+	// UpdateScimGroupMapping RPC.
+	AdminServiceUpdateScimGroupMappingProcedure = "/secretary.v1.AdminService/UpdateScimGroupMapping"
+	// AdminServiceGetIpAllowlistProcedure is the fully-qualified name of the AdminService's
+	// GetIpAllowlist RPC.
+	AdminServiceGetIpAllowlistProcedure = "/secretary.v1.AdminService/GetIpAllowlist"
+	// AdminServiceSetIpAllowlistProcedure is the fully-qualified name of the AdminService's
+	// SetIpAllowlist RPC.
+	AdminServiceSetIpAllowlistProcedure = "/secretary.v1.AdminService/SetIpAllowlist"
+)
+
+// AdminServiceClient is a client for the secretary.v1.AdminService service.
+type AdminServiceClient interface {
+	// Writes an archive of a consistent snapshot of the database and the
+	// files its rows point at, leaving out the audit log. Encrypted
+	// transcripts and audio stay encrypted.
+	ExportInstance(context.Context, *connect.Request[v1.ExportInstanceRequest]) (*connect.Response[v1.ExportInstanceResponse], error)
+	// Replaces all data but the audit log with the archive's. The archive must come from a
+	// server on the same schema or an older one, and when it holds encrypted
+	// data, this server needs the master key it was encrypted under. Tokens
+	// issued before the import still carry the replaced users' IDs: change
+	// JWT_SECRET afterwards unless this server was new.
+	ImportInstance(context.Context, *connect.Request[v1.ImportInstanceRequest]) (*connect.Response[v1.ImportInstanceResponse], error)
+	// Lists the scheduled backups in storage and how the schedule is doing.
+	ListBackups(context.Context, *connect.Request[v1.ListBackupsRequest]) (*connect.Response[v1.ListBackupsResponse], error)
+	// Counts users, recordings and stored bytes, the background work
+	// waiting or failing, and how the providers are doing.
+	GetSystemStats(context.Context, *connect.Request[v1.GetSystemStatsRequest]) (*connect.Response[v1.GetSystemStatsResponse], error)
+	// Lists the scheduled tasks with when they last ran and next run.
+	ListScheduledTasks(context.Context, *connect.Request[v1.ListScheduledTasksRequest]) (*connect.Response[v1.ListScheduledTasksResponse], error)
+	// Changes when a task runs, for every server process. Processes pick
+	// the change up within a minute.
+	UpdateScheduledTask(context.Context, *connect.Request[v1.UpdateScheduledTaskRequest]) (*connect.Response[v1.UpdateScheduledTaskResponse], error)
+	// Lists the feature flags with the organization's setting and the
+	// users with overrides.
+	ListFeatureFlags(context.Context, *connect.Request[v1.ListFeatureFlagsRequest]) (*connect.Response[v1.ListFeatureFlagsResponse], error)
+	// Turns a flag on or off for the whole organization. Users with an
+	// override keep it.
+	UpdateFeatureFlag(context.Context, *connect.Request[v1.UpdateFeatureFlagRequest]) (*connect.Response[v1.UpdateFeatureFlagResponse], error)
+	// Turns a flag on or off for one user, whatever the organization's
+	// setting.
+	SetUserFeatureFlag(context.Context, *connect.Request[v1.SetUserFeatureFlagRequest]) (*connect.Response[v1.SetUserFeatureFlagResponse], error)
+	GetMaintenanceMode(context.Context, *connect.Request[v1.GetMaintenanceModeRequest]) (*connect.Response[v1.GetMaintenanceModeResponse], error)
+	// Turns maintenance mode on or off, or changes its message, for every
+	// server process. Admins keep full access meanwhile.
+	SetMaintenanceMode(context.Context, *connect.Request[v1.SetMaintenanceModeRequest]) (*connect.Response[v1.SetMaintenanceModeResponse], error)
+	ListAuditLog(context.Context, *connect.Request[v1.ListAuditLogRequest]) (*connect.Response[v1.ListAuditLogResponse], error)
+	// Recomputes every entry's hash from its contents and the entry before
+	// it, finding entries changed, removed or inserted since they were
+	// written.
+	VerifyAuditLog(context.Context, *connect.Request[v1.VerifyAuditLogRequest]) (*connect.Response[v1.VerifyAuditLogResponse], error)
+	ListScimGroups(context.Context, *connect.Request[v1.ListScimGroupsRequest]) (*connect.Response[v1.ListScimGroupsResponse], error)
+	// Changes the role and workspace a group grants, and applies the change
+	// to the group's members right away.
+	UpdateScimGroupMapping(context.Context, *connect.Request[v1.UpdateScimGroupMappingRequest]) (*connect.Response[v1.UpdateScimGroupMappingResponse], error)
+	GetIpAllowlist(context.Context, *connect.Request[v1.GetIpAllowlistRequest]) (*connect.Response[v1.GetIpAllowlistResponse], error)
+	// Limits API access to callers from the listed ranges, for every server
+	// process. An empty list lifts the limit.
+	SetIpAllowlist(context.Context, *connect.Request[v1.SetIpAllowlistRequest]) (*connect.Response[v1.SetIpAllowlistResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the secretary.v1.AdminService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := v1.File_secretary_v1_admin_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		exportInstance: connect.NewClient[v1.ExportInstanceRequest, v1.ExportInstanceResponse](
+			httpClient,
+			baseURL+AdminServiceExportInstanceProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ExportInstance")),
+			connect.WithClientOptions(opts...),
+		),
+		importInstance: connect.NewClient[v1.ImportInstanceRequest, v1.ImportInstanceResponse](
+			httpClient,
+			baseURL+AdminServiceImportInstanceProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ImportInstance")),
+			connect.WithClientOptions(opts...),
+		),
+		listBackups: connect.NewClient[v1.ListBackupsRequest, v1.ListBackupsResponse](
+			httpClient,
+			baseURL+AdminServiceListBackupsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListBackups")),
+			connect.WithClientOptions(opts...),
+		),
+		getSystemStats: connect.NewClient[v1.GetSystemStatsRequest, v1.GetSystemStatsResponse](
+			httpClient,
+			baseURL+AdminServiceGetSystemStatsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetSystemStats")),
+			connect.WithClientOptions(opts...),
+		),
+		listScheduledTasks: connect.NewClient[v1.ListScheduledTasksRequest, v1.ListScheduledTasksResponse](
+			httpClient,
+			baseURL+AdminServiceListScheduledTasksProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListScheduledTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		updateScheduledTask: connect.NewClient[v1.UpdateScheduledTaskRequest, v1.UpdateScheduledTaskResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateScheduledTaskProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateScheduledTask")),
+			connect.WithClientOptions(opts...),
+		),
+		listFeatureFlags: connect.NewClient[v1.ListFeatureFlagsRequest, v1.ListFeatureFlagsResponse](
+			httpClient,
+			baseURL+AdminServiceListFeatureFlagsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListFeatureFlags")),
+			connect.WithClientOptions(opts...),
+		),
+		updateFeatureFlag: connect.NewClient[v1.UpdateFeatureFlagRequest, v1.UpdateFeatureFlagResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateFeatureFlagProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateFeatureFlag")),
+			connect.WithClientOptions(opts...),
+		),
+		setUserFeatureFlag: connect.NewClient[v1.SetUserFeatureFlagRequest, v1.SetUserFeatureFlagResponse](
+			httpClient,
+			baseURL+AdminServiceSetUserFeatureFlagProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetUserFeatureFlag")),
+			connect.WithClientOptions(opts...),
+		),
+		getMaintenanceMode: connect.NewClient[v1.GetMaintenanceModeRequest, v1.GetMaintenanceModeResponse](
+			httpClient,
+			baseURL+AdminServiceGetMaintenanceModeProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetMaintenanceMode")),
+			connect.WithClientOptions(opts...),
+		),
+		setMaintenanceMode: connect.NewClient[v1.SetMaintenanceModeRequest, v1.SetMaintenanceModeResponse](
+			httpClient,
+			baseURL+AdminServiceSetMaintenanceModeProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetMaintenanceMode")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditLog: connect.NewClient[v1.ListAuditLogRequest, v1.ListAuditLogResponse](
+			httpClient,
+			baseURL+AdminServiceListAuditLogProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListAuditLog")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		verifyAuditLog: connect.NewClient[v1.VerifyAuditLogRequest, v1.VerifyAuditLogResponse](
+			httpClient,
+			baseURL+AdminServiceVerifyAuditLogProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("VerifyAuditLog")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listScimGroups: connect.NewClient[v1.ListScimGroupsRequest, v1.ListScimGroupsResponse](
+			httpClient,
+			baseURL+AdminServiceListScimGroupsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListScimGroups")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateScimGroupMapping: connect.NewClient[v1.UpdateScimGroupMappingRequest, v1.UpdateScimGroupMappingResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateScimGroupMappingProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateScimGroupMapping")),
+			connect.WithClientOptions(opts...),
+		),
+		getIpAllowlist: connect.NewClient[v1.GetIpAllowlistRequest, v1.GetIpAllowlistResponse](
+			httpClient,
+			baseURL+AdminServiceGetIpAllowlistProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetIpAllowlist")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setIpAllowlist: connect.NewClient[v1.SetIpAllowlistRequest, v1.SetIpAllowlistResponse](
+			httpClient,
+			baseURL+AdminServiceSetIpAllowlistProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetIpAllowlist")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	exportInstance         *connect.Client[v1.ExportInstanceRequest, v1.ExportInstanceResponse]
+	importInstance         *connect.Client[v1.ImportInstanceRequest, v1.ImportInstanceResponse]
+	listBackups            *connect.Client[v1.ListBackupsRequest, v1.ListBackupsResponse]
+	getSystemStats         *connect.Client[v1.GetSystemStatsRequest, v1.GetSystemStatsResponse]
+	listScheduledTasks     *connect.Client[v1.ListScheduledTasksRequest, v1.ListScheduledTasksResponse]
+	updateScheduledTask    *connect.Client[v1.UpdateScheduledTaskRequest, v1.UpdateScheduledTaskResponse]
+	listFeatureFlags       *connect.Client[v1.ListFeatureFlagsRequest, v1.ListFeatureFlagsResponse]
+	updateFeatureFlag      *connect.Client[v1.UpdateFeatureFlagRequest, v1.UpdateFeatureFlagResponse]
+	setUserFeatureFlag     *connect.Client[v1.SetUserFeatureFlagRequest, v1.SetUserFeatureFlagResponse]
+	getMaintenanceMode     *connect.Client[v1.GetMaintenanceModeRequest, v1.GetMaintenanceModeResponse]
+	setMaintenanceMode     *connect.Client[v1.SetMaintenanceModeRequest, v1.SetMaintenanceModeResponse]
+	listAuditLog           *connect.Client[v1.ListAuditLogRequest, v1.ListAuditLogResponse]
+	verifyAuditLog         *connect.Client[v1.VerifyAuditLogRequest, v1.VerifyAuditLogResponse]
+	listScimGroups         *connect.Client[v1.ListScimGroupsRequest, v1.ListScimGroupsResponse]
+	updateScimGroupMapping *connect.Client[v1.UpdateScimGroupMappingRequest, v1.UpdateScimGroupMappingResponse]
+	getIpAllowlist         *connect.Client[v1.GetIpAllowlistRequest, v1.GetIpAllowlistResponse]
+	setIpAllowlist         *connect.Client[v1.SetIpAllowlistRequest, v1.SetIpAllowlistResponse]
+}
+
+// ExportInstance calls secretary.v1.AdminService.ExportInstance.
+func (c *adminServiceClient) ExportInstance(ctx context.Context, req *connect.Request[v1.ExportInstanceRequest]) (*connect.Response[v1.ExportInstanceResponse], error) {
+	return c.exportInstance.CallUnary(ctx, req)
+}
+
+// ImportInstance calls secretary.v1.AdminService.ImportInstance.
+func (c *adminServiceClient) ImportInstance(ctx context.Context, req *connect.Request[v1.ImportInstanceRequest]) (*connect.Response[v1.ImportInstanceResponse], error) {
+	return c.importInstance.CallUnary(ctx, req)
+}
+
+// ListBackups calls secretary.v1.AdminService.ListBackups.
+func (c *adminServiceClient) ListBackups(ctx context.Context, req *connect.Request[v1.ListBackupsRequest]) (*connect.Response[v1.ListBackupsResponse], error) {
+	return c.listBackups.CallUnary(ctx, req)
+}
+
+// GetSystemStats calls secretary.v1.AdminService.GetSystemStats.
+func (c *adminServiceClient) GetSystemStats(ctx context.Context, req *connect.Request[v1.GetSystemStatsRequest]) (*connect.Response[v1.GetSystemStatsResponse], error) {
+	return c.getSystemStats.CallUnary(ctx, req)
+}
+
+// ListScheduledTasks calls secretary.v1.AdminService.ListScheduledTasks.
+func (c *adminServiceClient) ListScheduledTasks(ctx context.Context, req *connect.Request[v1.ListScheduledTasksRequest]) (*connect.Response[v1.ListScheduledTasksResponse], error) {
+	return c.listScheduledTasks.CallUnary(ctx, req)
+}
+
+// UpdateScheduledTask calls secretary.v1.AdminService.UpdateScheduledTask.
+func (c *adminServiceClient) UpdateScheduledTask(ctx context.Context, req *connect.Request[v1.UpdateScheduledTaskRequest]) (*connect.Response[v1.UpdateScheduledTaskResponse], error) {
+	return c.updateScheduledTask.CallUnary(ctx, req)
+}
+
+// ListFeatureFlags calls secretary.v1.AdminService.ListFeatureFlags.
+func (c *adminServiceClient) ListFeatureFlags(ctx context.Context, req *connect.Request[v1.ListFeatureFlagsRequest]) (*connect.Response[v1.ListFeatureFlagsResponse], error) {
+	return c.listFeatureFlags.CallUnary(ctx, req)
+}
+
+// UpdateFeatureFlag calls secretary.v1.AdminService.UpdateFeatureFlag.
+func (c *adminServiceClient) UpdateFeatureFlag(ctx context.Context, req *connect.Request[v1.UpdateFeatureFlagRequest]) (*connect.Response[v1.UpdateFeatureFlagResponse], error) {
+	return c.updateFeatureFlag.CallUnary(ctx, req)
+}
+
+// SetUserFeatureFlag calls secretary.v1.AdminService.SetUserFeatureFlag.
+func (c *adminServiceClient) SetUserFeatureFlag(ctx context.Context, req *connect.Request[v1.SetUserFeatureFlagRequest]) (*connect.Response[v1.SetUserFeatureFlagResponse], error) {
+	return c.setUserFeatureFlag.CallUnary(ctx, req)
+}
+
+// GetMaintenanceMode calls secretary.v1.AdminService.GetMaintenanceMode.
+func (c *adminServiceClient) GetMaintenanceMode(ctx context.Context, req *connect.Request[v1.GetMaintenanceModeRequest]) (*connect.Response[v1.GetMaintenanceModeResponse], error) {
+	return c.getMaintenanceMode.CallUnary(ctx, req)
+}
+
+// SetMaintenanceMode calls secretary.v1.AdminService.SetMaintenanceMode.
+func (c *adminServiceClient) SetMaintenanceMode(ctx context.Context, req *connect.Request[v1.SetMaintenanceModeRequest]) (*connect.Response[v1.SetMaintenanceModeResponse], error) {
+	return c.setMaintenanceMode.CallUnary(ctx, req)
+}
+
+// ListAuditLog calls secretary.v1.AdminService.ListAuditLog.
+func (c *adminServiceClient) ListAuditLog(ctx context.Context, req *connect.Request[v1.ListAuditLogRequest]) (*connect.Response[v1.ListAuditLogResponse], error) {
+	return c.listAuditLog.CallUnary(ctx, req)
+}
+
+// VerifyAuditLog calls secretary.v1.AdminService.VerifyAuditLog.
+func (c *adminServiceClient) VerifyAuditLog(ctx context.Context, req *connect.Request[v1.VerifyAuditLogRequest]) (*connect.Response[v1.VerifyAuditLogResponse], error) {
+	return c.verifyAuditLog.CallUnary(ctx, req)
+}
+
+// ListScimGroups calls secretary.v1.AdminService.ListScimGroups.
+func (c *adminServiceClient) ListScimGroups(ctx context.Context, req *connect.Request[v1.ListScimGroupsRequest]) (*connect.Response[v1.ListScimGroupsResponse], error) {
+	return c.listScimGroups.CallUnary(ctx, req)
+}
+
+// UpdateScimGroupMapping calls secretary.v1.AdminService.UpdateScimGroupMapping.
+func (c *adminServiceClient) UpdateScimGroupMapping(ctx context.Context, req *connect.Request[v1.UpdateScimGroupMappingRequest]) (*connect.Response[v1.UpdateScimGroupMappingResponse], error) {
+	return c.updateScimGroupMapping.CallUnary(ctx, req)
+}
+
+// GetIpAllowlist calls secretary.v1.AdminService.GetIpAllowlist.
+func (c *adminServiceClient) GetIpAllowlist(ctx context.Context, req *connect.Request[v1.GetIpAllowlistRequest]) (*connect.Response[v1.GetIpAllowlistResponse], error) {
+	return c.getIpAllowlist.CallUnary(ctx, req)
+}
+
+// SetIpAllowlist calls secretary.v1.AdminService.SetIpAllowlist.
+func (c *adminServiceClient) SetIpAllowlist(ctx context.Context, req *connect.Request[v1.SetIpAllowlistRequest]) (*connect.Response[v1.SetIpAllowlistResponse], error) {
+	return c.setIpAllowlist.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the secretary.v1.AdminService service.
+type AdminServiceHandler interface {
+	// Writes an archive of a consistent snapshot of the database and the
+	// files its rows point at, leaving out the audit log. Encrypted
+	// transcripts and audio stay encrypted.
+	ExportInstance(context.Context, *connect.Request[v1.ExportInstanceRequest]) (*connect.Response[v1.ExportInstanceResponse], error)
+	// Replaces all data but the audit log with the archive's. The archive must come from a
+	// server on the same schema or an older one, and when it holds encrypted
+	// data, this server needs the master key it was encrypted under. Tokens
+	// issued before the import still carry the replaced users' IDs: change
+	// JWT_SECRET afterwards unless this server was new.
+	ImportInstance(context.Context, *connect.Request[v1.ImportInstanceRequest]) (*connect.Response[v1.ImportInstanceResponse], error)
+	// Lists the scheduled backups in storage and how the schedule is doing.
+	ListBackups(context.Context, *connect.Request[v1.ListBackupsRequest]) (*connect.Response[v1.ListBackupsResponse], error)
+	// Counts users, recordings and stored bytes, the background work
+	// waiting or failing, and how the providers are doing.
+	GetSystemStats(context.Context, *connect.Request[v1.GetSystemStatsRequest]) (*connect.Response[v1.GetSystemStatsResponse], error)
+	// Lists the scheduled tasks with when they last ran and next run.
+	ListScheduledTasks(context.Context, *connect.Request[v1.ListScheduledTasksRequest]) (*connect.Response[v1.ListScheduledTasksResponse], error)
+	// Changes when a task runs, for every server process. Processes pick
+	// the change up within a minute.
+	UpdateScheduledTask(context.Context, *connect.Request[v1.UpdateScheduledTaskRequest]) (*connect.Response[v1.UpdateScheduledTaskResponse], error)
+	// Lists the feature flags with the organization's setting and the
+	// users with overrides.
+	ListFeatureFlags(context.Context, *connect.Request[v1.ListFeatureFlagsRequest]) (*connect.Response[v1.ListFeatureFlagsResponse], error)
+	// Turns a flag on or off for the whole organization. Users with an
+	// override keep it.
+	UpdateFeatureFlag(context.Context, *connect.Request[v1.UpdateFeatureFlagRequest]) (*connect.Response[v1.UpdateFeatureFlagResponse], error)
+	// Turns a flag on or off for one user, whatever the organization's
+	// setting.
+	SetUserFeatureFlag(context.Context, *connect.Request[v1.SetUserFeatureFlagRequest]) (*connect.Response[v1.SetUserFeatureFlagResponse], error)
+	GetMaintenanceMode(context.Context, *connect.Request[v1.GetMaintenanceModeRequest]) (*connect.Response[v1.GetMaintenanceModeResponse], error)
+	// Turns maintenance mode on or off, or changes its message, for every
+	// server process. Admins keep full access meanwhile.
+	SetMaintenanceMode(context.Context, *connect.Request[v1.SetMaintenanceModeRequest]) (*connect.Response[v1.SetMaintenanceModeResponse], error)
+	ListAuditLog(context.Context, *connect.Request[v1.ListAuditLogRequest]) (*connect.Response[v1.ListAuditLogResponse], error)
+	// Recomputes every entry's hash from its contents and the entry before
+	// it, finding entries changed, removed or inserted since they were
+	// written.
+	VerifyAuditLog(context.Context, *connect.Request[v1.VerifyAuditLogRequest]) (*connect.Response[v1.VerifyAuditLogResponse], error)
+	ListScimGroups(context.Context, *connect.Request[v1.ListScimGroupsRequest]) (*connect.Response[v1.ListScimGroupsResponse], error)
+	// Changes the role and workspace a group grants, and applies the change
+	// to the group's members right away.
+	UpdateScimGroupMapping(context.Context, *connect.Request[v1.UpdateScimGroupMappingRequest]) (*connect.Response[v1.UpdateScimGroupMappingResponse], error)
+	GetIpAllowlist(context.Context, *connect.Request[v1.GetIpAllowlistRequest]) (*connect.Response[v1.GetIpAllowlistResponse], error)
+	// Limits API access to callers from the listed ranges, for every server
+	// process. An empty list lifts the limit.
+	SetIpAllowlist(context.Context, *connect.Request[v1.SetIpAllowlistRequest]) (*connect.Response[v1.SetIpAllowlistResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := v1.File_secretary_v1_admin_proto.Services().ByName("AdminService").Methods()
+	adminServiceExportInstanceHandler := connect.NewUnaryHandler(
+		AdminServiceExportInstanceProcedure,
+		svc.ExportInstance,
+		connect.WithSchema(adminServiceMethods.ByName("ExportInstance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceImportInstanceHandler := connect.NewUnaryHandler(
+		AdminServiceImportInstanceProcedure,
+		svc.ImportInstance,
+		connect.WithSchema(adminServiceMethods.ByName("ImportInstance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListBackupsHandler := connect.NewUnaryHandler(
+		AdminServiceListBackupsProcedure,
+		svc.ListBackups,
+		connect.WithSchema(adminServiceMethods.ByName("ListBackups")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetSystemStatsHandler := connect.NewUnaryHandler(
+		AdminServiceGetSystemStatsProcedure,
+		svc.GetSystemStats,
+		connect.WithSchema(adminServiceMethods.ByName("GetSystemStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListScheduledTasksHandler := connect.NewUnaryHandler(
+		AdminServiceListScheduledTasksProcedure,
+		svc.ListScheduledTasks,
+		connect.WithSchema(adminServiceMethods.ByName("ListScheduledTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateScheduledTaskHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateScheduledTaskProcedure,
+		svc.UpdateScheduledTask,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateScheduledTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListFeatureFlagsHandler := connect.NewUnaryHandler(
+		AdminServiceListFeatureFlagsProcedure,
+		svc.ListFeatureFlags,
+		connect.WithSchema(adminServiceMethods.ByName("ListFeatureFlags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateFeatureFlagHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateFeatureFlagProcedure,
+		svc.UpdateFeatureFlag,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateFeatureFlag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetUserFeatureFlagHandler := connect.NewUnaryHandler(
+		AdminServiceSetUserFeatureFlagProcedure,
+		svc.SetUserFeatureFlag,
+		connect.WithSchema(adminServiceMethods.ByName("SetUserFeatureFlag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetMaintenanceModeHandler := connect.NewUnaryHandler(
+		AdminServiceGetMaintenanceModeProcedure,
+		svc.GetMaintenanceMode,
+		connect.WithSchema(adminServiceMethods.ByName("GetMaintenanceMode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetMaintenanceModeHandler := connect.NewUnaryHandler(
+		AdminServiceSetMaintenanceModeProcedure,
+		svc.SetMaintenanceMode,
+		connect.WithSchema(adminServiceMethods.ByName("SetMaintenanceMode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListAuditLogHandler := connect.NewUnaryHandler(
+		AdminServiceListAuditLogProcedure,
+		svc.ListAuditLog,
+		connect.WithSchema(adminServiceMethods.ByName("ListAuditLog")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceVerifyAuditLogHandler := connect.NewUnaryHandler(
+		AdminServiceVerifyAuditLogProcedure,
+		svc.VerifyAuditLog,
+		connect.WithSchema(adminServiceMethods.ByName("VerifyAuditLog")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListScimGroupsHandler := connect.NewUnaryHandler(
+		AdminServiceListScimGroupsProcedure,
+		svc.ListScimGroups,
+		connect.WithSchema(adminServiceMethods.ByName("ListScimGroups")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateScimGroupMappingHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateScimGroupMappingProcedure,
+		svc.UpdateScimGroupMapping,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateScimGroupMapping")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetIpAllowlistHandler := connect.NewUnaryHandler(
+		AdminServiceGetIpAllowlistProcedure,
+		svc.GetIpAllowlist,
+		connect.WithSchema(adminServiceMethods.ByName("GetIpAllowlist")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetIpAllowlistHandler := connect.NewUnaryHandler(
+		AdminServiceSetIpAllowlistProcedure,
+		svc.SetIpAllowlist,
+		connect.WithSchema(adminServiceMethods.ByName("SetIpAllowlist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/secretary.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceExportInstanceProcedure:
+			adminServiceExportInstanceHandler.ServeHTTP(w, r)
+		case AdminServiceImportInstanceProcedure:
+			adminServiceImportInstanceHandler.ServeHTTP(w, r)
+		case AdminServiceListBackupsProcedure:
+			adminServiceListBackupsHandler.ServeHTTP(w, r)
+		case AdminServiceGetSystemStatsProcedure:
+			adminServiceGetSystemStatsHandler.ServeHTTP(w, r)
+		case AdminServiceListScheduledTasksProcedure:
+			adminServiceListScheduledTasksHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateScheduledTaskProcedure:
+			adminServiceUpdateScheduledTaskHandler.ServeHTTP(w, r)
+		case AdminServiceListFeatureFlagsProcedure:
+			adminServiceListFeatureFlagsHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateFeatureFlagProcedure:
+			adminServiceUpdateFeatureFlagHandler.ServeHTTP(w, r)
+		case AdminServiceSetUserFeatureFlagProcedure:
+			adminServiceSetUserFeatureFlagHandler.ServeHTTP(w, r)
+		case AdminServiceGetMaintenanceModeProcedure:
+			adminServiceGetMaintenanceModeHandler.ServeHTTP(w, r)
+		case AdminServiceSetMaintenanceModeProcedure:
+			adminServiceSetMaintenanceModeHandler.ServeHTTP(w, r)
+		case AdminServiceListAuditLogProcedure:
+			adminServiceListAuditLogHandler.ServeHTTP(w, r)
+		case AdminServiceVerifyAuditLogProcedure:
+			adminServiceVerifyAuditLogHandler.ServeHTTP(w, r)
+		case AdminServiceListScimGroupsProcedure:
+			adminServiceListScimGroupsHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateScimGroupMappingProcedure:
+			adminServiceUpdateScimGroupMappingHandler.ServeHTTP(w, r)
+		case AdminServiceGetIpAllowlistProcedure:
+			adminServiceGetIpAllowlistHandler.ServeHTTP(w, r)
+		case AdminServiceSetIpAllowlistProcedure:
+			adminServiceSetIpAllowlistHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) ExportInstance(context.Context, *connect.Request[v1.ExportInstanceRequest]) (*connect.Response[v1.ExportInstanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.ExportInstance is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ImportInstance(context.Context, *connect.Request[v1.ImportInstanceRequest]) (*connect.Response[v1.ImportInstanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.ImportInstance is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListBackups(context.Context, *connect.Request[v1.ListBackupsRequest]) (*connect.Response[v1.ListBackupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.ListBackups is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetSystemStats(context.Context, *connect.Request[v1.GetSystemStatsRequest]) (*connect.Response[v1.GetSystemStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.GetSystemStats is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListScheduledTasks(context.Context, *connect.Request[v1.ListScheduledTasksRequest]) (*connect.Response[v1.ListScheduledTasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.ListScheduledTasks is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateScheduledTask(context.Context, *connect.Request[v1.UpdateScheduledTaskRequest]) (*connect.Response[v1.UpdateScheduledTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.UpdateScheduledTask is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListFeatureFlags(context.Context, *connect.Request[v1.ListFeatureFlagsRequest]) (*connect.Response[v1.ListFeatureFlagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.ListFeatureFlags is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateFeatureFlag(context.Context, *connect.Request[v1.UpdateFeatureFlagRequest]) (*connect.Response[v1.UpdateFeatureFlagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.UpdateFeatureFlag is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetUserFeatureFlag(context.Context, *connect.Request[v1.SetUserFeatureFlagRequest]) (*connect.Response[v1.SetUserFeatureFlagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.SetUserFeatureFlag is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetMaintenanceMode(context.Context, *connect.Request[v1.GetMaintenanceModeRequest]) (*connect.Response[v1.GetMaintenanceModeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.GetMaintenanceMode is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetMaintenanceMode(context.Context, *connect.Request[v1.SetMaintenanceModeRequest]) (*connect.Response[v1.SetMaintenanceModeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.SetMaintenanceMode is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListAuditLog(context.Context, *connect.Request[v1.ListAuditLogRequest]) (*connect.Response[v1.ListAuditLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.ListAuditLog is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) VerifyAuditLog(context.Context, *connect.Request[v1.VerifyAuditLogRequest]) (*connect.Response[v1.VerifyAuditLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.VerifyAuditLog is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListScimGroups(context.Context, *connect.Request[v1.ListScimGroupsRequest]) (*connect.Response[v1.ListScimGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.ListScimGroups is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateScimGroupMapping(context.Context, *connect.Request[v1.UpdateScimGroupMappingRequest]) (*connect.Response[v1.UpdateScimGroupMappingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.UpdateScimGroupMapping is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetIpAllowlist(context.Context, *connect.Request[v1.GetIpAllowlistRequest]) (*connect.Response[v1.GetIpAllowlistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.GetIpAllowlist is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetIpAllowlist(context.Context, *connect.Request[v1.SetIpAllowlistRequest]) (*connect.Response[v1.SetIpAllowlistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.AdminService.SetIpAllowlist is not implemented"))
+}
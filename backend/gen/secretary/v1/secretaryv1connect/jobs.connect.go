@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: secretary/v1/jobs.proto
+
+package secretaryv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// JobsServiceName is the fully-qualified name of the JobsService service.
+	JobsServiceName = "secretary.v1.JobsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// JobsServiceListJobsProcedure is the fully-qualified name of the JobsService's ListJobs RPC.
+	JobsServiceListJobsProcedure = "/secretary.v1.JobsService/ListJobs"
+	// JobsServiceGetJobProcedure is the fully-qualified name of the JobsService's GetJob RPC.
+	JobsServiceGetJobProcedure = "/secretary.v1.JobsService/GetJob"
+	// JobsServiceRetryJobProcedure is the fully-qualified name of the JobsService's RetryJob RPC.
+	JobsServiceRetryJobProcedure = "/secretary.v1.JobsService/RetryJob"
+	// JobsServiceCancelJobProcedure is the fully-qualified name of the JobsService's CancelJob RPC.
+	JobsServiceCancelJobProcedure = "/secretary.v1.JobsService/CancelJob"
+	// JobsServicePurgeFailedJobsProcedure is the fully-qualified name of the JobsService's
+	// PurgeFailedJobs RPC.
+	JobsServicePurgeFailedJobsProcedure = "/secretary.v1.JobsService/PurgeFailedJobs"
+)
+
+// JobsServiceClient is a client for the secretary.v1.JobsService service.
+type JobsServiceClient interface {
+	ListJobs(context.Context, *connect.Request[v1.ListJobsRequest]) (*connect.Response[v1.ListJobsResponse], error)
+	GetJob(context.Context, *connect.Request[v1.GetJobRequest]) (*connect.Response[v1.GetJobResponse], error)
+	// Queues a failed job again at the stage it failed in, like
+	// RecordingsService.RetryProcessing.
+	RetryJob(context.Context, *connect.Request[v1.RetryJobRequest]) (*connect.Response[v1.RetryJobResponse], error)
+	// Fails a queued or running job. A worker already running it isn't
+	// stopped; if it still reports a result the recording moves on as if
+	// retried.
+	CancelJob(context.Context, *connect.Request[v1.CancelJobRequest]) (*connect.Response[v1.CancelJobResponse], error)
+	// Takes failed jobs off the list. The recordings stay failed and can
+	// still be retried from the recording.
+	PurgeFailedJobs(context.Context, *connect.Request[v1.PurgeFailedJobsRequest]) (*connect.Response[v1.PurgeFailedJobsResponse], error)
+}
+
+// NewJobsServiceClient constructs a client for the secretary.v1.JobsService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewJobsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) JobsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	jobsServiceMethods := v1.File_secretary_v1_jobs_proto.Services().ByName("JobsService").Methods()
+	return &jobsServiceClient{
+		listJobs: connect.NewClient[v1.ListJobsRequest, v1.ListJobsResponse](
+			httpClient,
+			baseURL+JobsServiceListJobsProcedure,
+			connect.WithSchema(jobsServiceMethods.ByName("ListJobs")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getJob: connect.NewClient[v1.GetJobRequest, v1.GetJobResponse](
+			httpClient,
+			baseURL+JobsServiceGetJobProcedure,
+			connect.WithSchema(jobsServiceMethods.ByName("GetJob")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		retryJob: connect.NewClient[v1.RetryJobRequest, v1.RetryJobResponse](
+			httpClient,
+			baseURL+JobsServiceRetryJobProcedure,
+			connect.WithSchema(jobsServiceMethods.ByName("RetryJob")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelJob: connect.NewClient[v1.CancelJobRequest, v1.CancelJobResponse](
+			httpClient,
+			baseURL+JobsServiceCancelJobProcedure,
+			connect.WithSchema(jobsServiceMethods.ByName("CancelJob")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeFailedJobs: connect.NewClient[v1.PurgeFailedJobsRequest, v1.PurgeFailedJobsResponse](
+			httpClient,
+			baseURL+JobsServicePurgeFailedJobsProcedure,
+			connect.WithSchema(jobsServiceMethods.ByName("PurgeFailedJobs")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// jobsServiceClient implements JobsServiceClient.
+type jobsServiceClient struct {
+	listJobs        *connect.Client[v1.ListJobsRequest, v1.ListJobsResponse]
+	getJob          *connect.Client[v1.GetJobRequest, v1.GetJobResponse]
+	retryJob        *connect.Client[v1.RetryJobRequest, v1.RetryJobResponse]
+	cancelJob       *connect.Client[v1.CancelJobRequest, v1.CancelJobResponse]
+	purgeFailedJobs *connect.Client[v1.PurgeFailedJobsRequest, v1.PurgeFailedJobsResponse]
+}
+
+// ListJobs calls secretary.v1.JobsService.ListJobs.
+func (c *jobsServiceClient) ListJobs(ctx context.Context, req *connect.Request[v1.ListJobsRequest]) (*connect.Response[v1.ListJobsResponse], error) {
+	return c.listJobs.CallUnary(ctx, req)
+}
+
+// GetJob calls secretary.v1.JobsService.GetJob.
+func (c *jobsServiceClient) GetJob(ctx context.Context, req *connect.Request[v1.GetJobRequest]) (*connect.Response[v1.GetJobResponse], error) {
+	return c.getJob.CallUnary(ctx, req)
+}
+
+// RetryJob calls secretary.v1.JobsService.RetryJob.
+func (c *jobsServiceClient) RetryJob(ctx context.Context, req *connect.Request[v1.RetryJobRequest]) (*connect.Response[v1.RetryJobResponse], error) {
+	return c.retryJob.CallUnary(ctx, req)
+}
+
+// CancelJob calls secretary.v1.JobsService.CancelJob.
+func (c *jobsServiceClient) CancelJob(ctx context.Context, req *connect.Request[v1.CancelJobRequest]) (*connect.Response[v1.CancelJobResponse], error) {
+	return c.cancelJob.CallUnary(ctx, req)
+}
+
+// PurgeFailedJobs calls secretary.v1.JobsService.PurgeFailedJobs.
+func (c *jobsServiceClient) PurgeFailedJobs(ctx context.Context, req *connect.Request[v1.PurgeFailedJobsRequest]) (*connect.Response[v1.PurgeFailedJobsResponse], error) {
+	return c.purgeFailedJobs.CallUnary(ctx, req)
+}
+
+// JobsServiceHandler is an implementation of the secretary.v1.JobsService service.
+type JobsServiceHandler interface {
+	ListJobs(context.Context, *connect.Request[v1.ListJobsRequest]) (*connect.Response[v1.ListJobsResponse], error)
+	GetJob(context.Context, *connect.Request[v1.GetJobRequest]) (*connect.Response[v1.GetJobResponse], error)
+	// Queues a failed job again at the stage it failed in, like
+	// RecordingsService.RetryProcessing.
+	RetryJob(context.Context, *connect.Request[v1.RetryJobRequest]) (*connect.Response[v1.RetryJobResponse], error)
+	// Fails a queued or running job. A worker already running it isn't
+	// stopped; if it still reports a result the recording moves on as if
+	// retried.
+	CancelJob(context.Context, *connect.Request[v1.CancelJobRequest]) (*connect.Response[v1.CancelJobResponse], error)
+	// Takes failed jobs off the list. The recordings stay failed and can
+	// still be retried from the recording.
+	PurgeFailedJobs(context.Context, *connect.Request[v1.PurgeFailedJobsRequest]) (*connect.Response[v1.PurgeFailedJobsResponse], error)
+}
+
+// NewJobsServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewJobsServiceHandler(svc JobsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	jobsServiceMethods := v1.File_secretary_v1_jobs_proto.Services().ByName("JobsService").Methods()
+	jobsServiceListJobsHandler := connect.NewUnaryHandler(
+		JobsServiceListJobsProcedure,
+		svc.ListJobs,
+		connect.WithSchema(jobsServiceMethods.ByName("ListJobs")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobsServiceGetJobHandler := connect.NewUnaryHandler(
+		JobsServiceGetJobProcedure,
+		svc.GetJob,
+		connect.WithSchema(jobsServiceMethods.ByName("GetJob")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobsServiceRetryJobHandler := connect.NewUnaryHandler(
+		JobsServiceRetryJobProcedure,
+		svc.RetryJob,
+		connect.WithSchema(jobsServiceMethods.ByName("RetryJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobsServiceCancelJobHandler := connect.NewUnaryHandler(
+		JobsServiceCancelJobProcedure,
+		svc.CancelJob,
+		connect.WithSchema(jobsServiceMethods.ByName("CancelJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobsServicePurgeFailedJobsHandler := connect.NewUnaryHandler(
+		JobsServicePurgeFailedJobsProcedure,
+		svc.PurgeFailedJobs,
+		connect.WithSchema(jobsServiceMethods.ByName("PurgeFailedJobs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/secretary.v1.JobsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case JobsServiceListJobsProcedure:
+			jobsServiceListJobsHandler.ServeHTTP(w, r)
+		case JobsServiceGetJobProcedure:
+			jobsServiceGetJobHandler.ServeHTTP(w, r)
+		case JobsServiceRetryJobProcedure:
+			jobsServiceRetryJobHandler.ServeHTTP(w, r)
+		case JobsServiceCancelJobProcedure:
+			jobsServiceCancelJobHandler.ServeHTTP(w, r)
+		case JobsServicePurgeFailedJobsProcedure:
+			jobsServicePurgeFailedJobsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedJobsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedJobsServiceHandler struct{}
+
+func (UnimplementedJobsServiceHandler) ListJobs(context.Context, *connect.Request[v1.ListJobsRequest]) (*connect.Response[v1.ListJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.JobsService.ListJobs is not implemented"))
+}
+
+func (UnimplementedJobsServiceHandler) GetJob(context.Context, *connect.Request[v1.GetJobRequest]) (*connect.Response[v1.GetJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.JobsService.GetJob is not implemented"))
+}
+
+func (UnimplementedJobsServiceHandler) RetryJob(context.Context, *connect.Request[v1.RetryJobRequest]) (*connect.Response[v1.RetryJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.JobsService.RetryJob is not implemented"))
+}
+
+func (UnimplementedJobsServiceHandler) CancelJob(context.Context, *connect.Request[v1.CancelJobRequest]) (*connect.Response[v1.CancelJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.JobsService.CancelJob is not implemented"))
+}
+
+func (UnimplementedJobsServiceHandler) PurgeFailedJobs(context.Context, *connect.Request[v1.PurgeFailedJobsRequest]) (*connect.Response[v1.PurgeFailedJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.JobsService.PurgeFailedJobs is not implemented"))
+}
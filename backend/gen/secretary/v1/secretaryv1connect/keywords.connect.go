@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: secretary/v1/keywords.proto
+
+package secretaryv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// KeywordAlertsServiceName is the fully-qualified name of the KeywordAlertsService service.
+	KeywordAlertsServiceName = "secretary.v1.KeywordAlertsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// KeywordAlertsServiceListWatchKeywordsProcedure is the fully-qualified name of the
+	// KeywordAlertsService's ListWatchKeywords RPC.
+	KeywordAlertsServiceListWatchKeywordsProcedure = "/secretary.v1.KeywordAlertsService/ListWatchKeywords"
+	// KeywordAlertsServiceCreateWatchKeywordProcedure is the fully-qualified name of the
+	// KeywordAlertsService's CreateWatchKeyword RPC.
+	KeywordAlertsServiceCreateWatchKeywordProcedure = "/secretary.v1.KeywordAlertsService/CreateWatchKeyword"
+	// KeywordAlertsServiceDeleteWatchKeywordProcedure is the fully-qualified name of the
+	// KeywordAlertsService's DeleteWatchKeyword RPC.
+	KeywordAlertsServiceDeleteWatchKeywordProcedure = "/secretary.v1.KeywordAlertsService/DeleteWatchKeyword"
+	// KeywordAlertsServiceListKeywordAlertsProcedure is the fully-qualified name of the
+	// KeywordAlertsService's ListKeywordAlerts RPC.
+	KeywordAlertsServiceListKeywordAlertsProcedure = "/secretary.v1.KeywordAlertsService/ListKeywordAlerts"
+)
+
+// KeywordAlertsServiceClient is a client for the secretary.v1.KeywordAlertsService service.
+type KeywordAlertsServiceClient interface {
+	ListWatchKeywords(context.Context, *connect.Request[v1.ListWatchKeywordsRequest]) (*connect.Response[v1.ListWatchKeywordsResponse], error)
+	// Fails with ALREADY_EXISTS when the user watches the keyword already.
+	CreateWatchKeyword(context.Context, *connect.Request[v1.CreateWatchKeywordRequest]) (*connect.Response[v1.CreateWatchKeywordResponse], error)
+	// Deletes the keyword with its alerts.
+	DeleteWatchKeyword(context.Context, *connect.Request[v1.DeleteWatchKeywordRequest]) (*connect.Response[v1.DeleteWatchKeywordResponse], error)
+	ListKeywordAlerts(context.Context, *connect.Request[v1.ListKeywordAlertsRequest]) (*connect.Response[v1.ListKeywordAlertsResponse], error)
+}
+
+// NewKeywordAlertsServiceClient constructs a client for the secretary.v1.KeywordAlertsService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewKeywordAlertsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) KeywordAlertsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	keywordAlertsServiceMethods := v1.File_secretary_v1_keywords_proto.Services().ByName("KeywordAlertsService").Methods()
+	return &keywordAlertsServiceClient{
+		listWatchKeywords: connect.NewClient[v1.ListWatchKeywordsRequest, v1.ListWatchKeywordsResponse](
+			httpClient,
+			baseURL+KeywordAlertsServiceListWatchKeywordsProcedure,
+			connect.WithSchema(keywordAlertsServiceMethods.ByName("ListWatchKeywords")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createWatchKeyword: connect.NewClient[v1.CreateWatchKeywordRequest, v1.CreateWatchKeywordResponse](
+			httpClient,
+			baseURL+KeywordAlertsServiceCreateWatchKeywordProcedure,
+			connect.WithSchema(keywordAlertsServiceMethods.ByName("CreateWatchKeyword")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWatchKeyword: connect.NewClient[v1.DeleteWatchKeywordRequest, v1.DeleteWatchKeywordResponse](
+			httpClient,
+			baseURL+KeywordAlertsServiceDeleteWatchKeywordProcedure,
+			connect.WithSchema(keywordAlertsServiceMethods.ByName("DeleteWatchKeyword")),
+			connect.WithClientOptions(opts...),
+		),
+		listKeywordAlerts: connect.NewClient[v1.ListKeywordAlertsRequest, v1.ListKeywordAlertsResponse](
+			httpClient,
+			baseURL+KeywordAlertsServiceListKeywordAlertsProcedure,
+			connect.WithSchema(keywordAlertsServiceMethods.ByName("ListKeywordAlerts")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// keywordAlertsServiceClient implements KeywordAlertsServiceClient.
+type keywordAlertsServiceClient struct {
+	listWatchKeywords  *connect.Client[v1.ListWatchKeywordsRequest, v1.ListWatchKeywordsResponse]
+	createWatchKeyword *connect.Client[v1.CreateWatchKeywordRequest, v1.CreateWatchKeywordResponse]
+	deleteWatchKeyword *connect.Client[v1.DeleteWatchKeywordRequest, v1.DeleteWatchKeywordResponse]
+	listKeywordAlerts  *connect.Client[v1.ListKeywordAlertsRequest, v1.ListKeywordAlertsResponse]
+}
+
+// ListWatchKeywords calls secretary.v1.KeywordAlertsService.ListWatchKeywords.
+func (c *keywordAlertsServiceClient) ListWatchKeywords(ctx context.Context, req *connect.Request[v1.ListWatchKeywordsRequest]) (*connect.Response[v1.ListWatchKeywordsResponse], error) {
+	return c.listWatchKeywords.CallUnary(ctx, req)
+}
+
+// CreateWatchKeyword calls secretary.v1.KeywordAlertsService.CreateWatchKeyword.
+func (c *keywordAlertsServiceClient) CreateWatchKeyword(ctx context.Context, req *connect.Request[v1.CreateWatchKeywordRequest]) (*connect.Response[v1.CreateWatchKeywordResponse], error) {
+	return c.createWatchKeyword.CallUnary(ctx, req)
+}
+
+// DeleteWatchKeyword calls secretary.v1.KeywordAlertsService.DeleteWatchKeyword.
+func (c *keywordAlertsServiceClient) DeleteWatchKeyword(ctx context.Context, req *connect.Request[v1.DeleteWatchKeywordRequest]) (*connect.Response[v1.DeleteWatchKeywordResponse], error) {
+	return c.deleteWatchKeyword.CallUnary(ctx, req)
+}
+
+// ListKeywordAlerts calls secretary.v1.KeywordAlertsService.ListKeywordAlerts.
+func (c *keywordAlertsServiceClient) ListKeywordAlerts(ctx context.Context, req *connect.Request[v1.ListKeywordAlertsRequest]) (*connect.Response[v1.ListKeywordAlertsResponse], error) {
+	return c.listKeywordAlerts.CallUnary(ctx, req)
+}
+
+// KeywordAlertsServiceHandler is an implementation of the secretary.v1.KeywordAlertsService
+// service.
+type KeywordAlertsServiceHandler interface {
+	ListWatchKeywords(context.Context, *connect.Request[v1.ListWatchKeywordsRequest]) (*connect.Response[v1.ListWatchKeywordsResponse], error)
+	// Fails with ALREADY_EXISTS when the user watches the keyword already.
+	CreateWatchKeyword(context.Context, *connect.Request[v1.CreateWatchKeywordRequest]) (*connect.Response[v1.CreateWatchKeywordResponse], error)
+	// Deletes the keyword with its alerts.
+	DeleteWatchKeyword(context.Context, *connect.Request[v1.DeleteWatchKeywordRequest]) (*connect.Response[v1.DeleteWatchKeywordResponse], error)
+	ListKeywordAlerts(context.Context, *connect.Request[v1.ListKeywordAlertsRequest]) (*connect.Response[v1.ListKeywordAlertsResponse], error)
+}
+
+// NewKeywordAlertsServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewKeywordAlertsServiceHandler(svc KeywordAlertsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	keywordAlertsServiceMethods := v1.File_secretary_v1_keywords_proto.Services().ByName("KeywordAlertsService").Methods()
+	keywordAlertsServiceListWatchKeywordsHandler := connect.NewUnaryHandler(
+		KeywordAlertsServiceListWatchKeywordsProcedure,
+		svc.ListWatchKeywords,
+		connect.WithSchema(keywordAlertsServiceMethods.ByName("ListWatchKeywords")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	keywordAlertsServiceCreateWatchKeywordHandler := connect.NewUnaryHandler(
+		KeywordAlertsServiceCreateWatchKeywordProcedure,
+		svc.CreateWatchKeyword,
+		connect.WithSchema(keywordAlertsServiceMethods.ByName("CreateWatchKeyword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keywordAlertsServiceDeleteWatchKeywordHandler := connect.NewUnaryHandler(
+		KeywordAlertsServiceDeleteWatchKeywordProcedure,
+		svc.DeleteWatchKeyword,
+		connect.WithSchema(keywordAlertsServiceMethods.ByName("DeleteWatchKeyword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keywordAlertsServiceListKeywordAlertsHandler := connect.NewUnaryHandler(
+		KeywordAlertsServiceListKeywordAlertsProcedure,
+		svc.ListKeywordAlerts,
+		connect.WithSchema(keywordAlertsServiceMethods.ByName("ListKeywordAlerts")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/secretary.v1.KeywordAlertsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case KeywordAlertsServiceListWatchKeywordsProcedure:
+			keywordAlertsServiceListWatchKeywordsHandler.ServeHTTP(w, r)
+		case KeywordAlertsServiceCreateWatchKeywordProcedure:
+			keywordAlertsServiceCreateWatchKeywordHandler.ServeHTTP(w, r)
+		case KeywordAlertsServiceDeleteWatchKeywordProcedure:
+			keywordAlertsServiceDeleteWatchKeywordHandler.ServeHTTP(w, r)
+		case KeywordAlertsServiceListKeywordAlertsProcedure:
+			keywordAlertsServiceListKeywordAlertsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedKeywordAlertsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedKeywordAlertsServiceHandler struct{}
+
+func (UnimplementedKeywordAlertsServiceHandler) ListWatchKeywords(context.Context, *connect.Request[v1.ListWatchKeywordsRequest]) (*connect.Response[v1.ListWatchKeywordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.KeywordAlertsService.ListWatchKeywords is not implemented"))
+}
+
+func (UnimplementedKeywordAlertsServiceHandler) CreateWatchKeyword(context.Context, *connect.Request[v1.CreateWatchKeywordRequest]) (*connect.Response[v1.CreateWatchKeywordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.KeywordAlertsService.CreateWatchKeyword is not implemented"))
+}
+
+func (UnimplementedKeywordAlertsServiceHandler) DeleteWatchKeyword(context.Context, *connect.Request[v1.DeleteWatchKeywordRequest]) (*connect.Response[v1.DeleteWatchKeywordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.KeywordAlertsService.DeleteWatchKeyword is not implemented"))
+}
+
+func (UnimplementedKeywordAlertsServiceHandler) ListKeywordAlerts(context.Context, *connect.Request[v1.ListKeywordAlertsRequest]) (*connect.Response[v1.ListKeywordAlertsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.KeywordAlertsService.ListKeywordAlerts is not implemented"))
+}
@@ -42,13 +42,195 @@ const (
 	// RecordingsServiceDeleteRecordingProcedure is the fully-qualified name of the RecordingsService's
 	// DeleteRecording RPC.
 	RecordingsServiceDeleteRecordingProcedure = "/secretary.v1.RecordingsService/DeleteRecording"
+	// RecordingsServiceUpdateRecordingStatusProcedure is the fully-qualified name of the
+	// RecordingsService's UpdateRecordingStatus RPC.
+	RecordingsServiceUpdateRecordingStatusProcedure = "/secretary.v1.RecordingsService/UpdateRecordingStatus"
+	// RecordingsServiceRetryProcessingProcedure is the fully-qualified name of the RecordingsService's
+	// RetryProcessing RPC.
+	RecordingsServiceRetryProcessingProcedure = "/secretary.v1.RecordingsService/RetryProcessing"
+	// RecordingsServiceSummarizeProcedure is the fully-qualified name of the RecordingsService's
+	// Summarize RPC.
+	RecordingsServiceSummarizeProcedure = "/secretary.v1.RecordingsService/Summarize"
+	// RecordingsServiceTranslateTranscriptProcedure is the fully-qualified name of the
+	// RecordingsService's TranslateTranscript RPC.
+	RecordingsServiceTranslateTranscriptProcedure = "/secretary.v1.RecordingsService/TranslateTranscript"
+	// RecordingsServiceLinkMentionsProcedure is the fully-qualified name of the RecordingsService's
+	// LinkMentions RPC.
+	RecordingsServiceLinkMentionsProcedure = "/secretary.v1.RecordingsService/LinkMentions"
+	// RecordingsServiceStarRecordingProcedure is the fully-qualified name of the RecordingsService's
+	// StarRecording RPC.
+	RecordingsServiceStarRecordingProcedure = "/secretary.v1.RecordingsService/StarRecording"
+	// RecordingsServiceUnstarRecordingProcedure is the fully-qualified name of the RecordingsService's
+	// UnstarRecording RPC.
+	RecordingsServiceUnstarRecordingProcedure = "/secretary.v1.RecordingsService/UnstarRecording"
+	// RecordingsServiceCreateClipProcedure is the fully-qualified name of the RecordingsService's
+	// CreateClip RPC.
+	RecordingsServiceCreateClipProcedure = "/secretary.v1.RecordingsService/CreateClip"
+	// RecordingsServiceListClipsProcedure is the fully-qualified name of the RecordingsService's
+	// ListClips RPC.
+	RecordingsServiceListClipsProcedure = "/secretary.v1.RecordingsService/ListClips"
+	// RecordingsServiceGenerateMinutesProcedure is the fully-qualified name of the RecordingsService's
+	// GenerateMinutes RPC.
+	RecordingsServiceGenerateMinutesProcedure = "/secretary.v1.RecordingsService/GenerateMinutes"
+	// RecordingsServiceGetMinutesProcedure is the fully-qualified name of the RecordingsService's
+	// GetMinutes RPC.
+	RecordingsServiceGetMinutesProcedure = "/secretary.v1.RecordingsService/GetMinutes"
+	// RecordingsServiceUpdateMinutesProcedure is the fully-qualified name of the RecordingsService's
+	// UpdateMinutes RPC.
+	RecordingsServiceUpdateMinutesProcedure = "/secretary.v1.RecordingsService/UpdateMinutes"
+	// RecordingsServiceListMinutesVersionsProcedure is the fully-qualified name of the
+	// RecordingsService's ListMinutesVersions RPC.
+	RecordingsServiceListMinutesVersionsProcedure = "/secretary.v1.RecordingsService/ListMinutesVersions"
+	// RecordingsServicePublishRecordingProcedure is the fully-qualified name of the RecordingsService's
+	// PublishRecording RPC.
+	RecordingsServicePublishRecordingProcedure = "/secretary.v1.RecordingsService/PublishRecording"
+	// RecordingsServiceListPublicationsProcedure is the fully-qualified name of the RecordingsService's
+	// ListPublications RPC.
+	RecordingsServiceListPublicationsProcedure = "/secretary.v1.RecordingsService/ListPublications"
+	// RecordingsServiceSetRecordingRetentionProcedure is the fully-qualified name of the
+	// RecordingsService's SetRecordingRetention RPC.
+	RecordingsServiceSetRecordingRetentionProcedure = "/secretary.v1.RecordingsService/SetRecordingRetention"
+	// RecordingsServiceSetLegalHoldProcedure is the fully-qualified name of the RecordingsService's
+	// SetLegalHold RPC.
+	RecordingsServiceSetLegalHoldProcedure = "/secretary.v1.RecordingsService/SetLegalHold"
+	// RecordingsServiceGetUploadURLProcedure is the fully-qualified name of the RecordingsService's
+	// GetUploadURL RPC.
+	RecordingsServiceGetUploadURLProcedure = "/secretary.v1.RecordingsService/GetUploadURL"
+	// RecordingsServiceConfirmUploadProcedure is the fully-qualified name of the RecordingsService's
+	// ConfirmUpload RPC.
+	RecordingsServiceConfirmUploadProcedure = "/secretary.v1.RecordingsService/ConfirmUpload"
+	// RecordingsServiceAddGuestParticipantProcedure is the fully-qualified name of the
+	// RecordingsService's AddGuestParticipant RPC.
+	RecordingsServiceAddGuestParticipantProcedure = "/secretary.v1.RecordingsService/AddGuestParticipant"
+	// RecordingsServiceRemoveGuestParticipantProcedure is the fully-qualified name of the
+	// RecordingsService's RemoveGuestParticipant RPC.
+	RecordingsServiceRemoveGuestParticipantProcedure = "/secretary.v1.RecordingsService/RemoveGuestParticipant"
+	// RecordingsServiceRelabelSpeakerProcedure is the fully-qualified name of the RecordingsService's
+	// RelabelSpeaker RPC.
+	RecordingsServiceRelabelSpeakerProcedure = "/secretary.v1.RecordingsService/RelabelSpeaker"
+	// RecordingsServiceGetRecordingTranscriptProcedure is the fully-qualified name of the
+	// RecordingsService's GetRecordingTranscript RPC.
+	RecordingsServiceGetRecordingTranscriptProcedure = "/secretary.v1.RecordingsService/GetRecordingTranscript"
+	// RecordingsServiceListTranscriptSegmentsProcedure is the fully-qualified name of the
+	// RecordingsService's ListTranscriptSegments RPC.
+	RecordingsServiceListTranscriptSegmentsProcedure = "/secretary.v1.RecordingsService/ListTranscriptSegments"
+	// RecordingsServiceListTranscriptRevisionsProcedure is the fully-qualified name of the
+	// RecordingsService's ListTranscriptRevisions RPC.
+	RecordingsServiceListTranscriptRevisionsProcedure = "/secretary.v1.RecordingsService/ListTranscriptRevisions"
+	// RecordingsServiceGetTranscriptRevisionDiffProcedure is the fully-qualified name of the
+	// RecordingsService's GetTranscriptRevisionDiff RPC.
+	RecordingsServiceGetTranscriptRevisionDiffProcedure = "/secretary.v1.RecordingsService/GetTranscriptRevisionDiff"
+	// RecordingsServiceCloneRecordingProcedure is the fully-qualified name of the RecordingsService's
+	// CloneRecording RPC.
+	RecordingsServiceCloneRecordingProcedure = "/secretary.v1.RecordingsService/CloneRecording"
+	// RecordingsServiceUpdateProcessingSettingsProcedure is the fully-qualified name of the
+	// RecordingsService's UpdateProcessingSettings RPC.
+	RecordingsServiceUpdateProcessingSettingsProcedure = "/secretary.v1.RecordingsService/UpdateProcessingSettings"
 )
 
 // RecordingsServiceClient is a client for the secretary.v1.RecordingsService service.
 type RecordingsServiceClient interface {
+	// Side-effect free so Connect clients may use GET and conditional
+	// requests; responses carry an ETag.
 	ListRecordings(context.Context, *connect.Request[v1.ListRecordingsRequest]) (*connect.Response[v1.ListRecordingsResponse], error)
 	GetRecording(context.Context, *connect.Request[v1.GetRecordingRequest]) (*connect.Response[v1.GetRecordingResponse], error)
+	// Deletes a recording with its stored audio, clips and attachments,
+	// keeping audio another recording still shares. Admin only. Recordings
+	// under legal hold are rejected with FAILED_PRECONDITION.
 	DeleteRecording(context.Context, *connect.Request[v1.DeleteRecordingRequest]) (*connect.Response[v1.DeleteRecordingResponse], error)
+	// Records a processing step. Admin only; transitions that skip a stage
+	// are rejected with FAILED_PRECONDITION, except going from TRANSCRIBING
+	// to READY when the recording's settings disable its summary.
+	UpdateRecordingStatus(context.Context, *connect.Request[v1.UpdateRecordingStatusRequest]) (*connect.Response[v1.UpdateRecordingStatusResponse], error)
+	// Queues another run of a stage for a failed or ready recording. Admin
+	// only. Retrying transcription also reruns summarization afterwards.
+	RetryProcessing(context.Context, *connect.Request[v1.RetryProcessingRequest]) (*connect.Response[v1.RetryProcessingResponse], error)
+	// Summarizes the transcript now. With a target language the summary is
+	// stored as a translation next to the original; without one it replaces
+	// the original, which only admins may do.
+	Summarize(context.Context, *connect.Request[v1.SummarizeRequest]) (*connect.Response[v1.SummarizeResponse], error)
+	// Translates the transcript and stores it next to the original.
+	TranslateTranscript(context.Context, *connect.Request[v1.TranslateTranscriptRequest]) (*connect.Response[v1.TranslateTranscriptResponse], error)
+	// Finds people named in the transcript and assigns the recording's
+	// unassigned todos to the one person each names. Runs on its own when a
+	// recording becomes ready; call it again after fixing a transcript.
+	LinkMentions(context.Context, *connect.Request[v1.LinkMentionsRequest]) (*connect.Response[v1.LinkMentionsResponse], error)
+	// Pins a recording for the caller. Starring one twice is a no-op, as is
+	// unstarring one that isn't starred.
+	StarRecording(context.Context, *connect.Request[v1.StarRecordingRequest]) (*connect.Response[v1.StarRecordingResponse], error)
+	UnstarRecording(context.Context, *connect.Request[v1.UnstarRecordingRequest]) (*connect.Response[v1.UnstarRecordingResponse], error)
+	// Cuts a stretch of the recording's stored audio into a clip anyone with
+	// its share URL can play. Recordings whose audio lives elsewhere are
+	// rejected with FAILED_PRECONDITION.
+	CreateClip(context.Context, *connect.Request[v1.CreateClipRequest]) (*connect.Response[v1.CreateClipResponse], error)
+	ListClips(context.Context, *connect.Request[v1.ListClipsRequest]) (*connect.Response[v1.ListClipsResponse], error)
+	// Drafts minutes from the transcript and the recording's todos and saves
+	// them as the next version. Attendees come from the identified
+	// participants and action items from the todos; the agenda, decisions
+	// and notes are written by a summarization provider.
+	GenerateMinutes(context.Context, *connect.Request[v1.GenerateMinutesRequest]) (*connect.Response[v1.GenerateMinutesResponse], error)
+	GetMinutes(context.Context, *connect.Request[v1.GetMinutesRequest]) (*connect.Response[v1.GetMinutesResponse], error)
+	// Saves an edited document as the next version. Fails with
+	// FAILED_PRECONDITION and the current version when base_version is no
+	// longer the latest.
+	UpdateMinutes(context.Context, *connect.Request[v1.UpdateMinutesRequest]) (*connect.Response[v1.UpdateMinutesResponse], error)
+	ListMinutesVersions(context.Context, *connect.Request[v1.ListMinutesVersionsRequest]) (*connect.Response[v1.ListMinutesVersionsResponse], error)
+	// Writes the summary and latest minutes to a page in a configured wiki.
+	// Publishing again replaces the recording's page there. Recordings with
+	// neither are rejected with FAILED_PRECONDITION.
+	PublishRecording(context.Context, *connect.Request[v1.PublishRecordingRequest]) (*connect.Response[v1.PublishRecordingResponse], error)
+	ListPublications(context.Context, *connect.Request[v1.ListPublicationsRequest]) (*connect.Response[v1.ListPublicationsResponse], error)
+	// Keeps a recording's audio and transcript past the retention policy
+	// until a date, or returns it to the policy. Admin only.
+	SetRecordingRetention(context.Context, *connect.Request[v1.SetRecordingRetentionRequest]) (*connect.Response[v1.SetRecordingRetentionResponse], error)
+	// Places or lifts a legal hold. Admin only; every change is kept in the
+	// recording's legal_hold_history.
+	SetLegalHold(context.Context, *connect.Request[v1.SetLegalHoldRequest]) (*connect.Response[v1.SetLegalHoldResponse], error)
+	// Returns a pre-signed URL the client uploads an audio file to, straight
+	// to object storage, so large files don't pass through the server. Fails
+	// with FAILED_PRECONDITION when the storage backend can't sign URLs;
+	// POST to /api/recordings/upload then.
+	GetUploadURL(context.Context, *connect.Request[v1.GetUploadURLRequest]) (*connect.Response[v1.GetUploadURLResponse], error)
+	// Creates the recording once the file is uploaded, which queues it for
+	// transcription.
+	ConfirmUpload(context.Context, *connect.Request[v1.ConfirmUploadRequest]) (*connect.Response[v1.ConfirmUploadResponse], error)
+	// Adds an attendee who has no account. A speaker label belongs to one
+	// person, user or guest; a second is rejected with ALREADY_EXISTS.
+	AddGuestParticipant(context.Context, *connect.Request[v1.AddGuestParticipantRequest]) (*connect.Response[v1.AddGuestParticipantResponse], error)
+	RemoveGuestParticipant(context.Context, *connect.Request[v1.RemoveGuestParticipantRequest]) (*connect.Response[v1.RemoveGuestParticipantResponse], error)
+	// Gives every line of a diarized speaker to a user or guest, fixing a
+	// misattributed speaker in one call. If that person already speaks under
+	// another label, the lines are relabeled to it so the two merge;
+	// otherwise the speaker label is mapped to them. Whoever held the label
+	// before loses it. Mentions are linked again afterwards.
+	RelabelSpeaker(context.Context, *connect.Request[v1.RelabelSpeakerRequest]) (*connect.Response[v1.RelabelSpeakerResponse], error)
+	// Finds text in a recording's transcript, returning the matching lines
+	// with the lines around them and where they fall in the audio, so
+	// clients needn't load long transcripts to search them.
+	GetRecordingTranscript(context.Context, *connect.Request[v1.GetRecordingTranscriptRequest]) (*connect.Response[v1.GetRecordingTranscriptResponse], error)
+	// Pages through a recording's transcript, or one of its stored
+	// translations, for clients that called GetRecording with
+	// omit_transcript.
+	ListTranscriptSegments(context.Context, *connect.Request[v1.ListTranscriptSegmentsRequest]) (*connect.Response[v1.ListTranscriptSegmentsResponse], error)
+	// Lists the revisions of a recording's transcript, newest first.
+	// Revisions are kept from the first edit on: the transcript as it was
+	// before becomes revision 1, and each edit adds the next. Recordings
+	// whose transcript was never edited have none.
+	ListTranscriptRevisions(context.Context, *connect.Request[v1.ListTranscriptRevisionsRequest]) (*connect.Response[v1.ListTranscriptRevisionsResponse], error)
+	// Compares two transcript revisions line by line, and the words of each
+	// changed line, so clients can show what an editor changed.
+	GetTranscriptRevisionDiff(context.Context, *connect.Request[v1.GetTranscriptRevisionDiffRequest]) (*connect.Response[v1.GetTranscriptRevisionDiffResponse], error)
+	// Copies a recording's metadata and audio into a new recording queued
+	// for transcription, so it can be processed again with another language
+	// or prompt template while the original keeps its transcript, summary
+	// and todos. The audio is shared, not copied. Recordings without stored
+	// audio, or whose audio is quarantined or awaits its malware scan, are
+	// rejected with FAILED_PRECONDITION.
+	CloneRecording(context.Context, *connect.Request[v1.CloneRecordingRequest]) (*connect.Response[v1.CloneRecordingResponse], error)
+	// Replaces a recording's processing settings, e.g. before retrying it
+	// with RetryProcessing. Recordings being transcribed or summarized are
+	// rejected with FAILED_PRECONDITION.
+	UpdateProcessingSettings(context.Context, *connect.Request[v1.UpdateProcessingSettingsRequest]) (*connect.Response[v1.UpdateProcessingSettingsResponse], error)
 }
 
 // NewRecordingsServiceClient constructs a client for the secretary.v1.RecordingsService service. By
@@ -66,12 +248,14 @@ func NewRecordingsServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			httpClient,
 			baseURL+RecordingsServiceListRecordingsProcedure,
 			connect.WithSchema(recordingsServiceMethods.ByName("ListRecordings")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getRecording: connect.NewClient[v1.GetRecordingRequest, v1.GetRecordingResponse](
 			httpClient,
 			baseURL+RecordingsServiceGetRecordingProcedure,
 			connect.WithSchema(recordingsServiceMethods.ByName("GetRecording")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		deleteRecording: connect.NewClient[v1.DeleteRecordingRequest, v1.DeleteRecordingResponse](
@@ -80,14 +264,218 @@ func NewRecordingsServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(recordingsServiceMethods.ByName("DeleteRecording")),
 			connect.WithClientOptions(opts...),
 		),
+		updateRecordingStatus: connect.NewClient[v1.UpdateRecordingStatusRequest, v1.UpdateRecordingStatusResponse](
+			httpClient,
+			baseURL+RecordingsServiceUpdateRecordingStatusProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("UpdateRecordingStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		retryProcessing: connect.NewClient[v1.RetryProcessingRequest, v1.RetryProcessingResponse](
+			httpClient,
+			baseURL+RecordingsServiceRetryProcessingProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("RetryProcessing")),
+			connect.WithClientOptions(opts...),
+		),
+		summarize: connect.NewClient[v1.SummarizeRequest, v1.SummarizeResponse](
+			httpClient,
+			baseURL+RecordingsServiceSummarizeProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("Summarize")),
+			connect.WithClientOptions(opts...),
+		),
+		translateTranscript: connect.NewClient[v1.TranslateTranscriptRequest, v1.TranslateTranscriptResponse](
+			httpClient,
+			baseURL+RecordingsServiceTranslateTranscriptProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("TranslateTranscript")),
+			connect.WithClientOptions(opts...),
+		),
+		linkMentions: connect.NewClient[v1.LinkMentionsRequest, v1.LinkMentionsResponse](
+			httpClient,
+			baseURL+RecordingsServiceLinkMentionsProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("LinkMentions")),
+			connect.WithClientOptions(opts...),
+		),
+		starRecording: connect.NewClient[v1.StarRecordingRequest, v1.StarRecordingResponse](
+			httpClient,
+			baseURL+RecordingsServiceStarRecordingProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("StarRecording")),
+			connect.WithClientOptions(opts...),
+		),
+		unstarRecording: connect.NewClient[v1.UnstarRecordingRequest, v1.UnstarRecordingResponse](
+			httpClient,
+			baseURL+RecordingsServiceUnstarRecordingProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("UnstarRecording")),
+			connect.WithClientOptions(opts...),
+		),
+		createClip: connect.NewClient[v1.CreateClipRequest, v1.CreateClipResponse](
+			httpClient,
+			baseURL+RecordingsServiceCreateClipProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("CreateClip")),
+			connect.WithClientOptions(opts...),
+		),
+		listClips: connect.NewClient[v1.ListClipsRequest, v1.ListClipsResponse](
+			httpClient,
+			baseURL+RecordingsServiceListClipsProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("ListClips")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		generateMinutes: connect.NewClient[v1.GenerateMinutesRequest, v1.GenerateMinutesResponse](
+			httpClient,
+			baseURL+RecordingsServiceGenerateMinutesProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("GenerateMinutes")),
+			connect.WithClientOptions(opts...),
+		),
+		getMinutes: connect.NewClient[v1.GetMinutesRequest, v1.GetMinutesResponse](
+			httpClient,
+			baseURL+RecordingsServiceGetMinutesProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("GetMinutes")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateMinutes: connect.NewClient[v1.UpdateMinutesRequest, v1.UpdateMinutesResponse](
+			httpClient,
+			baseURL+RecordingsServiceUpdateMinutesProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("UpdateMinutes")),
+			connect.WithClientOptions(opts...),
+		),
+		listMinutesVersions: connect.NewClient[v1.ListMinutesVersionsRequest, v1.ListMinutesVersionsResponse](
+			httpClient,
+			baseURL+RecordingsServiceListMinutesVersionsProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("ListMinutesVersions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		publishRecording: connect.NewClient[v1.PublishRecordingRequest, v1.PublishRecordingResponse](
+			httpClient,
+			baseURL+RecordingsServicePublishRecordingProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("PublishRecording")),
+			connect.WithClientOptions(opts...),
+		),
+		listPublications: connect.NewClient[v1.ListPublicationsRequest, v1.ListPublicationsResponse](
+			httpClient,
+			baseURL+RecordingsServiceListPublicationsProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("ListPublications")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setRecordingRetention: connect.NewClient[v1.SetRecordingRetentionRequest, v1.SetRecordingRetentionResponse](
+			httpClient,
+			baseURL+RecordingsServiceSetRecordingRetentionProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("SetRecordingRetention")),
+			connect.WithClientOptions(opts...),
+		),
+		setLegalHold: connect.NewClient[v1.SetLegalHoldRequest, v1.SetLegalHoldResponse](
+			httpClient,
+			baseURL+RecordingsServiceSetLegalHoldProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("SetLegalHold")),
+			connect.WithClientOptions(opts...),
+		),
+		getUploadURL: connect.NewClient[v1.GetUploadURLRequest, v1.GetUploadURLResponse](
+			httpClient,
+			baseURL+RecordingsServiceGetUploadURLProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("GetUploadURL")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmUpload: connect.NewClient[v1.ConfirmUploadRequest, v1.ConfirmUploadResponse](
+			httpClient,
+			baseURL+RecordingsServiceConfirmUploadProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("ConfirmUpload")),
+			connect.WithClientOptions(opts...),
+		),
+		addGuestParticipant: connect.NewClient[v1.AddGuestParticipantRequest, v1.AddGuestParticipantResponse](
+			httpClient,
+			baseURL+RecordingsServiceAddGuestParticipantProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("AddGuestParticipant")),
+			connect.WithClientOptions(opts...),
+		),
+		removeGuestParticipant: connect.NewClient[v1.RemoveGuestParticipantRequest, v1.RemoveGuestParticipantResponse](
+			httpClient,
+			baseURL+RecordingsServiceRemoveGuestParticipantProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("RemoveGuestParticipant")),
+			connect.WithClientOptions(opts...),
+		),
+		relabelSpeaker: connect.NewClient[v1.RelabelSpeakerRequest, v1.RelabelSpeakerResponse](
+			httpClient,
+			baseURL+RecordingsServiceRelabelSpeakerProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("RelabelSpeaker")),
+			connect.WithClientOptions(opts...),
+		),
+		getRecordingTranscript: connect.NewClient[v1.GetRecordingTranscriptRequest, v1.GetRecordingTranscriptResponse](
+			httpClient,
+			baseURL+RecordingsServiceGetRecordingTranscriptProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("GetRecordingTranscript")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listTranscriptSegments: connect.NewClient[v1.ListTranscriptSegmentsRequest, v1.ListTranscriptSegmentsResponse](
+			httpClient,
+			baseURL+RecordingsServiceListTranscriptSegmentsProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("ListTranscriptSegments")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listTranscriptRevisions: connect.NewClient[v1.ListTranscriptRevisionsRequest, v1.ListTranscriptRevisionsResponse](
+			httpClient,
+			baseURL+RecordingsServiceListTranscriptRevisionsProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("ListTranscriptRevisions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getTranscriptRevisionDiff: connect.NewClient[v1.GetTranscriptRevisionDiffRequest, v1.GetTranscriptRevisionDiffResponse](
+			httpClient,
+			baseURL+RecordingsServiceGetTranscriptRevisionDiffProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("GetTranscriptRevisionDiff")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		cloneRecording: connect.NewClient[v1.CloneRecordingRequest, v1.CloneRecordingResponse](
+			httpClient,
+			baseURL+RecordingsServiceCloneRecordingProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("CloneRecording")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProcessingSettings: connect.NewClient[v1.UpdateProcessingSettingsRequest, v1.UpdateProcessingSettingsResponse](
+			httpClient,
+			baseURL+RecordingsServiceUpdateProcessingSettingsProcedure,
+			connect.WithSchema(recordingsServiceMethods.ByName("UpdateProcessingSettings")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // recordingsServiceClient implements RecordingsServiceClient.
 type recordingsServiceClient struct {
-	listRecordings  *connect.Client[v1.ListRecordingsRequest, v1.ListRecordingsResponse]
-	getRecording    *connect.Client[v1.GetRecordingRequest, v1.GetRecordingResponse]
-	deleteRecording *connect.Client[v1.DeleteRecordingRequest, v1.DeleteRecordingResponse]
+	listRecordings            *connect.Client[v1.ListRecordingsRequest, v1.ListRecordingsResponse]
+	getRecording              *connect.Client[v1.GetRecordingRequest, v1.GetRecordingResponse]
+	deleteRecording           *connect.Client[v1.DeleteRecordingRequest, v1.DeleteRecordingResponse]
+	updateRecordingStatus     *connect.Client[v1.UpdateRecordingStatusRequest, v1.UpdateRecordingStatusResponse]
+	retryProcessing           *connect.Client[v1.RetryProcessingRequest, v1.RetryProcessingResponse]
+	summarize                 *connect.Client[v1.SummarizeRequest, v1.SummarizeResponse]
+	translateTranscript       *connect.Client[v1.TranslateTranscriptRequest, v1.TranslateTranscriptResponse]
+	linkMentions              *connect.Client[v1.LinkMentionsRequest, v1.LinkMentionsResponse]
+	starRecording             *connect.Client[v1.StarRecordingRequest, v1.StarRecordingResponse]
+	unstarRecording           *connect.Client[v1.UnstarRecordingRequest, v1.UnstarRecordingResponse]
+	createClip                *connect.Client[v1.CreateClipRequest, v1.CreateClipResponse]
+	listClips                 *connect.Client[v1.ListClipsRequest, v1.ListClipsResponse]
+	generateMinutes           *connect.Client[v1.GenerateMinutesRequest, v1.GenerateMinutesResponse]
+	getMinutes                *connect.Client[v1.GetMinutesRequest, v1.GetMinutesResponse]
+	updateMinutes             *connect.Client[v1.UpdateMinutesRequest, v1.UpdateMinutesResponse]
+	listMinutesVersions       *connect.Client[v1.ListMinutesVersionsRequest, v1.ListMinutesVersionsResponse]
+	publishRecording          *connect.Client[v1.PublishRecordingRequest, v1.PublishRecordingResponse]
+	listPublications          *connect.Client[v1.ListPublicationsRequest, v1.ListPublicationsResponse]
+	setRecordingRetention     *connect.Client[v1.SetRecordingRetentionRequest, v1.SetRecordingRetentionResponse]
+	setLegalHold              *connect.Client[v1.SetLegalHoldRequest, v1.SetLegalHoldResponse]
+	getUploadURL              *connect.Client[v1.GetUploadURLRequest, v1.GetUploadURLResponse]
+	confirmUpload             *connect.Client[v1.ConfirmUploadRequest, v1.ConfirmUploadResponse]
+	addGuestParticipant       *connect.Client[v1.AddGuestParticipantRequest, v1.AddGuestParticipantResponse]
+	removeGuestParticipant    *connect.Client[v1.RemoveGuestParticipantRequest, v1.RemoveGuestParticipantResponse]
+	relabelSpeaker            *connect.Client[v1.RelabelSpeakerRequest, v1.RelabelSpeakerResponse]
+	getRecordingTranscript    *connect.Client[v1.GetRecordingTranscriptRequest, v1.GetRecordingTranscriptResponse]
+	listTranscriptSegments    *connect.Client[v1.ListTranscriptSegmentsRequest, v1.ListTranscriptSegmentsResponse]
+	listTranscriptRevisions   *connect.Client[v1.ListTranscriptRevisionsRequest, v1.ListTranscriptRevisionsResponse]
+	getTranscriptRevisionDiff *connect.Client[v1.GetTranscriptRevisionDiffRequest, v1.GetTranscriptRevisionDiffResponse]
+	cloneRecording            *connect.Client[v1.CloneRecordingRequest, v1.CloneRecordingResponse]
+	updateProcessingSettings  *connect.Client[v1.UpdateProcessingSettingsRequest, v1.UpdateProcessingSettingsResponse]
 }
 
 // ListRecordings calls secretary.v1.RecordingsService.ListRecordings.
@@ -105,11 +493,249 @@ func (c *recordingsServiceClient) DeleteRecording(ctx context.Context, req *conn
 	return c.deleteRecording.CallUnary(ctx, req)
 }
 
+// UpdateRecordingStatus calls secretary.v1.RecordingsService.UpdateRecordingStatus.
+func (c *recordingsServiceClient) UpdateRecordingStatus(ctx context.Context, req *connect.Request[v1.UpdateRecordingStatusRequest]) (*connect.Response[v1.UpdateRecordingStatusResponse], error) {
+	return c.updateRecordingStatus.CallUnary(ctx, req)
+}
+
+// RetryProcessing calls secretary.v1.RecordingsService.RetryProcessing.
+func (c *recordingsServiceClient) RetryProcessing(ctx context.Context, req *connect.Request[v1.RetryProcessingRequest]) (*connect.Response[v1.RetryProcessingResponse], error) {
+	return c.retryProcessing.CallUnary(ctx, req)
+}
+
+// Summarize calls secretary.v1.RecordingsService.Summarize.
+func (c *recordingsServiceClient) Summarize(ctx context.Context, req *connect.Request[v1.SummarizeRequest]) (*connect.Response[v1.SummarizeResponse], error) {
+	return c.summarize.CallUnary(ctx, req)
+}
+
+// TranslateTranscript calls secretary.v1.RecordingsService.TranslateTranscript.
+func (c *recordingsServiceClient) TranslateTranscript(ctx context.Context, req *connect.Request[v1.TranslateTranscriptRequest]) (*connect.Response[v1.TranslateTranscriptResponse], error) {
+	return c.translateTranscript.CallUnary(ctx, req)
+}
+
+// LinkMentions calls secretary.v1.RecordingsService.LinkMentions.
+func (c *recordingsServiceClient) LinkMentions(ctx context.Context, req *connect.Request[v1.LinkMentionsRequest]) (*connect.Response[v1.LinkMentionsResponse], error) {
+	return c.linkMentions.CallUnary(ctx, req)
+}
+
+// StarRecording calls secretary.v1.RecordingsService.StarRecording.
+func (c *recordingsServiceClient) StarRecording(ctx context.Context, req *connect.Request[v1.StarRecordingRequest]) (*connect.Response[v1.StarRecordingResponse], error) {
+	return c.starRecording.CallUnary(ctx, req)
+}
+
+// UnstarRecording calls secretary.v1.RecordingsService.UnstarRecording.
+func (c *recordingsServiceClient) UnstarRecording(ctx context.Context, req *connect.Request[v1.UnstarRecordingRequest]) (*connect.Response[v1.UnstarRecordingResponse], error) {
+	return c.unstarRecording.CallUnary(ctx, req)
+}
+
+// CreateClip calls secretary.v1.RecordingsService.CreateClip.
+func (c *recordingsServiceClient) CreateClip(ctx context.Context, req *connect.Request[v1.CreateClipRequest]) (*connect.Response[v1.CreateClipResponse], error) {
+	return c.createClip.CallUnary(ctx, req)
+}
+
+// ListClips calls secretary.v1.RecordingsService.ListClips.
+func (c *recordingsServiceClient) ListClips(ctx context.Context, req *connect.Request[v1.ListClipsRequest]) (*connect.Response[v1.ListClipsResponse], error) {
+	return c.listClips.CallUnary(ctx, req)
+}
+
+// GenerateMinutes calls secretary.v1.RecordingsService.GenerateMinutes.
+func (c *recordingsServiceClient) GenerateMinutes(ctx context.Context, req *connect.Request[v1.GenerateMinutesRequest]) (*connect.Response[v1.GenerateMinutesResponse], error) {
+	return c.generateMinutes.CallUnary(ctx, req)
+}
+
+// GetMinutes calls secretary.v1.RecordingsService.GetMinutes.
+func (c *recordingsServiceClient) GetMinutes(ctx context.Context, req *connect.Request[v1.GetMinutesRequest]) (*connect.Response[v1.GetMinutesResponse], error) {
+	return c.getMinutes.CallUnary(ctx, req)
+}
+
+// UpdateMinutes calls secretary.v1.RecordingsService.UpdateMinutes.
+func (c *recordingsServiceClient) UpdateMinutes(ctx context.Context, req *connect.Request[v1.UpdateMinutesRequest]) (*connect.Response[v1.UpdateMinutesResponse], error) {
+	return c.updateMinutes.CallUnary(ctx, req)
+}
+
+// ListMinutesVersions calls secretary.v1.RecordingsService.ListMinutesVersions.
+func (c *recordingsServiceClient) ListMinutesVersions(ctx context.Context, req *connect.Request[v1.ListMinutesVersionsRequest]) (*connect.Response[v1.ListMinutesVersionsResponse], error) {
+	return c.listMinutesVersions.CallUnary(ctx, req)
+}
+
+// PublishRecording calls secretary.v1.RecordingsService.PublishRecording.
+func (c *recordingsServiceClient) PublishRecording(ctx context.Context, req *connect.Request[v1.PublishRecordingRequest]) (*connect.Response[v1.PublishRecordingResponse], error) {
+	return c.publishRecording.CallUnary(ctx, req)
+}
+
+// ListPublications calls secretary.v1.RecordingsService.ListPublications.
+func (c *recordingsServiceClient) ListPublications(ctx context.Context, req *connect.Request[v1.ListPublicationsRequest]) (*connect.Response[v1.ListPublicationsResponse], error) {
+	return c.listPublications.CallUnary(ctx, req)
+}
+
+// SetRecordingRetention calls secretary.v1.RecordingsService.SetRecordingRetention.
+func (c *recordingsServiceClient) SetRecordingRetention(ctx context.Context, req *connect.Request[v1.SetRecordingRetentionRequest]) (*connect.Response[v1.SetRecordingRetentionResponse], error) {
+	return c.setRecordingRetention.CallUnary(ctx, req)
+}
+
+// SetLegalHold calls secretary.v1.RecordingsService.SetLegalHold.
+func (c *recordingsServiceClient) SetLegalHold(ctx context.Context, req *connect.Request[v1.SetLegalHoldRequest]) (*connect.Response[v1.SetLegalHoldResponse], error) {
+	return c.setLegalHold.CallUnary(ctx, req)
+}
+
+// GetUploadURL calls secretary.v1.RecordingsService.GetUploadURL.
+func (c *recordingsServiceClient) GetUploadURL(ctx context.Context, req *connect.Request[v1.GetUploadURLRequest]) (*connect.Response[v1.GetUploadURLResponse], error) {
+	return c.getUploadURL.CallUnary(ctx, req)
+}
+
+// ConfirmUpload calls secretary.v1.RecordingsService.ConfirmUpload.
+func (c *recordingsServiceClient) ConfirmUpload(ctx context.Context, req *connect.Request[v1.ConfirmUploadRequest]) (*connect.Response[v1.ConfirmUploadResponse], error) {
+	return c.confirmUpload.CallUnary(ctx, req)
+}
+
+// AddGuestParticipant calls secretary.v1.RecordingsService.AddGuestParticipant.
+func (c *recordingsServiceClient) AddGuestParticipant(ctx context.Context, req *connect.Request[v1.AddGuestParticipantRequest]) (*connect.Response[v1.AddGuestParticipantResponse], error) {
+	return c.addGuestParticipant.CallUnary(ctx, req)
+}
+
+// RemoveGuestParticipant calls secretary.v1.RecordingsService.RemoveGuestParticipant.
+func (c *recordingsServiceClient) RemoveGuestParticipant(ctx context.Context, req *connect.Request[v1.RemoveGuestParticipantRequest]) (*connect.Response[v1.RemoveGuestParticipantResponse], error) {
+	return c.removeGuestParticipant.CallUnary(ctx, req)
+}
+
+// RelabelSpeaker calls secretary.v1.RecordingsService.RelabelSpeaker.
+func (c *recordingsServiceClient) RelabelSpeaker(ctx context.Context, req *connect.Request[v1.RelabelSpeakerRequest]) (*connect.Response[v1.RelabelSpeakerResponse], error) {
+	return c.relabelSpeaker.CallUnary(ctx, req)
+}
+
+// GetRecordingTranscript calls secretary.v1.RecordingsService.GetRecordingTranscript.
+func (c *recordingsServiceClient) GetRecordingTranscript(ctx context.Context, req *connect.Request[v1.GetRecordingTranscriptRequest]) (*connect.Response[v1.GetRecordingTranscriptResponse], error) {
+	return c.getRecordingTranscript.CallUnary(ctx, req)
+}
+
+// ListTranscriptSegments calls secretary.v1.RecordingsService.ListTranscriptSegments.
+func (c *recordingsServiceClient) ListTranscriptSegments(ctx context.Context, req *connect.Request[v1.ListTranscriptSegmentsRequest]) (*connect.Response[v1.ListTranscriptSegmentsResponse], error) {
+	return c.listTranscriptSegments.CallUnary(ctx, req)
+}
+
+// ListTranscriptRevisions calls secretary.v1.RecordingsService.ListTranscriptRevisions.
+func (c *recordingsServiceClient) ListTranscriptRevisions(ctx context.Context, req *connect.Request[v1.ListTranscriptRevisionsRequest]) (*connect.Response[v1.ListTranscriptRevisionsResponse], error) {
+	return c.listTranscriptRevisions.CallUnary(ctx, req)
+}
+
+// GetTranscriptRevisionDiff calls secretary.v1.RecordingsService.GetTranscriptRevisionDiff.
+func (c *recordingsServiceClient) GetTranscriptRevisionDiff(ctx context.Context, req *connect.Request[v1.GetTranscriptRevisionDiffRequest]) (*connect.Response[v1.GetTranscriptRevisionDiffResponse], error) {
+	return c.getTranscriptRevisionDiff.CallUnary(ctx, req)
+}
+
+// CloneRecording calls secretary.v1.RecordingsService.CloneRecording.
+func (c *recordingsServiceClient) CloneRecording(ctx context.Context, req *connect.Request[v1.CloneRecordingRequest]) (*connect.Response[v1.CloneRecordingResponse], error) {
+	return c.cloneRecording.CallUnary(ctx, req)
+}
+
+// UpdateProcessingSettings calls secretary.v1.RecordingsService.UpdateProcessingSettings.
+func (c *recordingsServiceClient) UpdateProcessingSettings(ctx context.Context, req *connect.Request[v1.UpdateProcessingSettingsRequest]) (*connect.Response[v1.UpdateProcessingSettingsResponse], error) {
+	return c.updateProcessingSettings.CallUnary(ctx, req)
+}
+
 // RecordingsServiceHandler is an implementation of the secretary.v1.RecordingsService service.
 type RecordingsServiceHandler interface {
+	// Side-effect free so Connect clients may use GET and conditional
+	// requests; responses carry an ETag.
 	ListRecordings(context.Context, *connect.Request[v1.ListRecordingsRequest]) (*connect.Response[v1.ListRecordingsResponse], error)
 	GetRecording(context.Context, *connect.Request[v1.GetRecordingRequest]) (*connect.Response[v1.GetRecordingResponse], error)
+	// Deletes a recording with its stored audio, clips and attachments,
+	// keeping audio another recording still shares. Admin only. Recordings
+	// under legal hold are rejected with FAILED_PRECONDITION.
 	DeleteRecording(context.Context, *connect.Request[v1.DeleteRecordingRequest]) (*connect.Response[v1.DeleteRecordingResponse], error)
+	// Records a processing step. Admin only; transitions that skip a stage
+	// are rejected with FAILED_PRECONDITION, except going from TRANSCRIBING
+	// to READY when the recording's settings disable its summary.
+	UpdateRecordingStatus(context.Context, *connect.Request[v1.UpdateRecordingStatusRequest]) (*connect.Response[v1.UpdateRecordingStatusResponse], error)
+	// Queues another run of a stage for a failed or ready recording. Admin
+	// only. Retrying transcription also reruns summarization afterwards.
+	RetryProcessing(context.Context, *connect.Request[v1.RetryProcessingRequest]) (*connect.Response[v1.RetryProcessingResponse], error)
+	// Summarizes the transcript now. With a target language the summary is
+	// stored as a translation next to the original; without one it replaces
+	// the original, which only admins may do.
+	Summarize(context.Context, *connect.Request[v1.SummarizeRequest]) (*connect.Response[v1.SummarizeResponse], error)
+	// Translates the transcript and stores it next to the original.
+	TranslateTranscript(context.Context, *connect.Request[v1.TranslateTranscriptRequest]) (*connect.Response[v1.TranslateTranscriptResponse], error)
+	// Finds people named in the transcript and assigns the recording's
+	// unassigned todos to the one person each names. Runs on its own when a
+	// recording becomes ready; call it again after fixing a transcript.
+	LinkMentions(context.Context, *connect.Request[v1.LinkMentionsRequest]) (*connect.Response[v1.LinkMentionsResponse], error)
+	// Pins a recording for the caller. Starring one twice is a no-op, as is
+	// unstarring one that isn't starred.
+	StarRecording(context.Context, *connect.Request[v1.StarRecordingRequest]) (*connect.Response[v1.StarRecordingResponse], error)
+	UnstarRecording(context.Context, *connect.Request[v1.UnstarRecordingRequest]) (*connect.Response[v1.UnstarRecordingResponse], error)
+	// Cuts a stretch of the recording's stored audio into a clip anyone with
+	// its share URL can play. Recordings whose audio lives elsewhere are
+	// rejected with FAILED_PRECONDITION.
+	CreateClip(context.Context, *connect.Request[v1.CreateClipRequest]) (*connect.Response[v1.CreateClipResponse], error)
+	ListClips(context.Context, *connect.Request[v1.ListClipsRequest]) (*connect.Response[v1.ListClipsResponse], error)
+	// Drafts minutes from the transcript and the recording's todos and saves
+	// them as the next version. Attendees come from the identified
+	// participants and action items from the todos; the agenda, decisions
+	// and notes are written by a summarization provider.
+	GenerateMinutes(context.Context, *connect.Request[v1.GenerateMinutesRequest]) (*connect.Response[v1.GenerateMinutesResponse], error)
+	GetMinutes(context.Context, *connect.Request[v1.GetMinutesRequest]) (*connect.Response[v1.GetMinutesResponse], error)
+	// Saves an edited document as the next version. Fails with
+	// FAILED_PRECONDITION and the current version when base_version is no
+	// longer the latest.
+	UpdateMinutes(context.Context, *connect.Request[v1.UpdateMinutesRequest]) (*connect.Response[v1.UpdateMinutesResponse], error)
+	ListMinutesVersions(context.Context, *connect.Request[v1.ListMinutesVersionsRequest]) (*connect.Response[v1.ListMinutesVersionsResponse], error)
+	// Writes the summary and latest minutes to a page in a configured wiki.
+	// Publishing again replaces the recording's page there. Recordings with
+	// neither are rejected with FAILED_PRECONDITION.
+	PublishRecording(context.Context, *connect.Request[v1.PublishRecordingRequest]) (*connect.Response[v1.PublishRecordingResponse], error)
+	ListPublications(context.Context, *connect.Request[v1.ListPublicationsRequest]) (*connect.Response[v1.ListPublicationsResponse], error)
+	// Keeps a recording's audio and transcript past the retention policy
+	// until a date, or returns it to the policy. Admin only.
+	SetRecordingRetention(context.Context, *connect.Request[v1.SetRecordingRetentionRequest]) (*connect.Response[v1.SetRecordingRetentionResponse], error)
+	// Places or lifts a legal hold. Admin only; every change is kept in the
+	// recording's legal_hold_history.
+	SetLegalHold(context.Context, *connect.Request[v1.SetLegalHoldRequest]) (*connect.Response[v1.SetLegalHoldResponse], error)
+	// Returns a pre-signed URL the client uploads an audio file to, straight
+	// to object storage, so large files don't pass through the server. Fails
+	// with FAILED_PRECONDITION when the storage backend can't sign URLs;
+	// POST to /api/recordings/upload then.
+	GetUploadURL(context.Context, *connect.Request[v1.GetUploadURLRequest]) (*connect.Response[v1.GetUploadURLResponse], error)
+	// Creates the recording once the file is uploaded, which queues it for
+	// transcription.
+	ConfirmUpload(context.Context, *connect.Request[v1.ConfirmUploadRequest]) (*connect.Response[v1.ConfirmUploadResponse], error)
+	// Adds an attendee who has no account. A speaker label belongs to one
+	// person, user or guest; a second is rejected with ALREADY_EXISTS.
+	AddGuestParticipant(context.Context, *connect.Request[v1.AddGuestParticipantRequest]) (*connect.Response[v1.AddGuestParticipantResponse], error)
+	RemoveGuestParticipant(context.Context, *connect.Request[v1.RemoveGuestParticipantRequest]) (*connect.Response[v1.RemoveGuestParticipantResponse], error)
+	// Gives every line of a diarized speaker to a user or guest, fixing a
+	// misattributed speaker in one call. If that person already speaks under
+	// another label, the lines are relabeled to it so the two merge;
+	// otherwise the speaker label is mapped to them. Whoever held the label
+	// before loses it. Mentions are linked again afterwards.
+	RelabelSpeaker(context.Context, *connect.Request[v1.RelabelSpeakerRequest]) (*connect.Response[v1.RelabelSpeakerResponse], error)
+	// Finds text in a recording's transcript, returning the matching lines
+	// with the lines around them and where they fall in the audio, so
+	// clients needn't load long transcripts to search them.
+	GetRecordingTranscript(context.Context, *connect.Request[v1.GetRecordingTranscriptRequest]) (*connect.Response[v1.GetRecordingTranscriptResponse], error)
+	// Pages through a recording's transcript, or one of its stored
+	// translations, for clients that called GetRecording with
+	// omit_transcript.
+	ListTranscriptSegments(context.Context, *connect.Request[v1.ListTranscriptSegmentsRequest]) (*connect.Response[v1.ListTranscriptSegmentsResponse], error)
+	// Lists the revisions of a recording's transcript, newest first.
+	// Revisions are kept from the first edit on: the transcript as it was
+	// before becomes revision 1, and each edit adds the next. Recordings
+	// whose transcript was never edited have none.
+	ListTranscriptRevisions(context.Context, *connect.Request[v1.ListTranscriptRevisionsRequest]) (*connect.Response[v1.ListTranscriptRevisionsResponse], error)
+	// Compares two transcript revisions line by line, and the words of each
+	// changed line, so clients can show what an editor changed.
+	GetTranscriptRevisionDiff(context.Context, *connect.Request[v1.GetTranscriptRevisionDiffRequest]) (*connect.Response[v1.GetTranscriptRevisionDiffResponse], error)
+	// Copies a recording's metadata and audio into a new recording queued
+	// for transcription, so it can be processed again with another language
+	// or prompt template while the original keeps its transcript, summary
+	// and todos. The audio is shared, not copied. Recordings without stored
+	// audio, or whose audio is quarantined or awaits its malware scan, are
+	// rejected with FAILED_PRECONDITION.
+	CloneRecording(context.Context, *connect.Request[v1.CloneRecordingRequest]) (*connect.Response[v1.CloneRecordingResponse], error)
+	// Replaces a recording's processing settings, e.g. before retrying it
+	// with RetryProcessing. Recordings being transcribed or summarized are
+	// rejected with FAILED_PRECONDITION.
+	UpdateProcessingSettings(context.Context, *connect.Request[v1.UpdateProcessingSettingsRequest]) (*connect.Response[v1.UpdateProcessingSettingsResponse], error)
 }
 
 // NewRecordingsServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -123,12 +749,14 @@ func NewRecordingsServiceHandler(svc RecordingsServiceHandler, opts ...connect.H
 		RecordingsServiceListRecordingsProcedure,
 		svc.ListRecordings,
 		connect.WithSchema(recordingsServiceMethods.ByName("ListRecordings")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	recordingsServiceGetRecordingHandler := connect.NewUnaryHandler(
 		RecordingsServiceGetRecordingProcedure,
 		svc.GetRecording,
 		connect.WithSchema(recordingsServiceMethods.ByName("GetRecording")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	recordingsServiceDeleteRecordingHandler := connect.NewUnaryHandler(
@@ -137,6 +765,182 @@ func NewRecordingsServiceHandler(svc RecordingsServiceHandler, opts ...connect.H
 		connect.WithSchema(recordingsServiceMethods.ByName("DeleteRecording")),
 		connect.WithHandlerOptions(opts...),
 	)
+	recordingsServiceUpdateRecordingStatusHandler := connect.NewUnaryHandler(
+		RecordingsServiceUpdateRecordingStatusProcedure,
+		svc.UpdateRecordingStatus,
+		connect.WithSchema(recordingsServiceMethods.ByName("UpdateRecordingStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceRetryProcessingHandler := connect.NewUnaryHandler(
+		RecordingsServiceRetryProcessingProcedure,
+		svc.RetryProcessing,
+		connect.WithSchema(recordingsServiceMethods.ByName("RetryProcessing")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceSummarizeHandler := connect.NewUnaryHandler(
+		RecordingsServiceSummarizeProcedure,
+		svc.Summarize,
+		connect.WithSchema(recordingsServiceMethods.ByName("Summarize")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceTranslateTranscriptHandler := connect.NewUnaryHandler(
+		RecordingsServiceTranslateTranscriptProcedure,
+		svc.TranslateTranscript,
+		connect.WithSchema(recordingsServiceMethods.ByName("TranslateTranscript")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceLinkMentionsHandler := connect.NewUnaryHandler(
+		RecordingsServiceLinkMentionsProcedure,
+		svc.LinkMentions,
+		connect.WithSchema(recordingsServiceMethods.ByName("LinkMentions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceStarRecordingHandler := connect.NewUnaryHandler(
+		RecordingsServiceStarRecordingProcedure,
+		svc.StarRecording,
+		connect.WithSchema(recordingsServiceMethods.ByName("StarRecording")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceUnstarRecordingHandler := connect.NewUnaryHandler(
+		RecordingsServiceUnstarRecordingProcedure,
+		svc.UnstarRecording,
+		connect.WithSchema(recordingsServiceMethods.ByName("UnstarRecording")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceCreateClipHandler := connect.NewUnaryHandler(
+		RecordingsServiceCreateClipProcedure,
+		svc.CreateClip,
+		connect.WithSchema(recordingsServiceMethods.ByName("CreateClip")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceListClipsHandler := connect.NewUnaryHandler(
+		RecordingsServiceListClipsProcedure,
+		svc.ListClips,
+		connect.WithSchema(recordingsServiceMethods.ByName("ListClips")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceGenerateMinutesHandler := connect.NewUnaryHandler(
+		RecordingsServiceGenerateMinutesProcedure,
+		svc.GenerateMinutes,
+		connect.WithSchema(recordingsServiceMethods.ByName("GenerateMinutes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceGetMinutesHandler := connect.NewUnaryHandler(
+		RecordingsServiceGetMinutesProcedure,
+		svc.GetMinutes,
+		connect.WithSchema(recordingsServiceMethods.ByName("GetMinutes")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceUpdateMinutesHandler := connect.NewUnaryHandler(
+		RecordingsServiceUpdateMinutesProcedure,
+		svc.UpdateMinutes,
+		connect.WithSchema(recordingsServiceMethods.ByName("UpdateMinutes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceListMinutesVersionsHandler := connect.NewUnaryHandler(
+		RecordingsServiceListMinutesVersionsProcedure,
+		svc.ListMinutesVersions,
+		connect.WithSchema(recordingsServiceMethods.ByName("ListMinutesVersions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServicePublishRecordingHandler := connect.NewUnaryHandler(
+		RecordingsServicePublishRecordingProcedure,
+		svc.PublishRecording,
+		connect.WithSchema(recordingsServiceMethods.ByName("PublishRecording")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceListPublicationsHandler := connect.NewUnaryHandler(
+		RecordingsServiceListPublicationsProcedure,
+		svc.ListPublications,
+		connect.WithSchema(recordingsServiceMethods.ByName("ListPublications")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceSetRecordingRetentionHandler := connect.NewUnaryHandler(
+		RecordingsServiceSetRecordingRetentionProcedure,
+		svc.SetRecordingRetention,
+		connect.WithSchema(recordingsServiceMethods.ByName("SetRecordingRetention")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceSetLegalHoldHandler := connect.NewUnaryHandler(
+		RecordingsServiceSetLegalHoldProcedure,
+		svc.SetLegalHold,
+		connect.WithSchema(recordingsServiceMethods.ByName("SetLegalHold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceGetUploadURLHandler := connect.NewUnaryHandler(
+		RecordingsServiceGetUploadURLProcedure,
+		svc.GetUploadURL,
+		connect.WithSchema(recordingsServiceMethods.ByName("GetUploadURL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceConfirmUploadHandler := connect.NewUnaryHandler(
+		RecordingsServiceConfirmUploadProcedure,
+		svc.ConfirmUpload,
+		connect.WithSchema(recordingsServiceMethods.ByName("ConfirmUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceAddGuestParticipantHandler := connect.NewUnaryHandler(
+		RecordingsServiceAddGuestParticipantProcedure,
+		svc.AddGuestParticipant,
+		connect.WithSchema(recordingsServiceMethods.ByName("AddGuestParticipant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceRemoveGuestParticipantHandler := connect.NewUnaryHandler(
+		RecordingsServiceRemoveGuestParticipantProcedure,
+		svc.RemoveGuestParticipant,
+		connect.WithSchema(recordingsServiceMethods.ByName("RemoveGuestParticipant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceRelabelSpeakerHandler := connect.NewUnaryHandler(
+		RecordingsServiceRelabelSpeakerProcedure,
+		svc.RelabelSpeaker,
+		connect.WithSchema(recordingsServiceMethods.ByName("RelabelSpeaker")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceGetRecordingTranscriptHandler := connect.NewUnaryHandler(
+		RecordingsServiceGetRecordingTranscriptProcedure,
+		svc.GetRecordingTranscript,
+		connect.WithSchema(recordingsServiceMethods.ByName("GetRecordingTranscript")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceListTranscriptSegmentsHandler := connect.NewUnaryHandler(
+		RecordingsServiceListTranscriptSegmentsProcedure,
+		svc.ListTranscriptSegments,
+		connect.WithSchema(recordingsServiceMethods.ByName("ListTranscriptSegments")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceListTranscriptRevisionsHandler := connect.NewUnaryHandler(
+		RecordingsServiceListTranscriptRevisionsProcedure,
+		svc.ListTranscriptRevisions,
+		connect.WithSchema(recordingsServiceMethods.ByName("ListTranscriptRevisions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceGetTranscriptRevisionDiffHandler := connect.NewUnaryHandler(
+		RecordingsServiceGetTranscriptRevisionDiffProcedure,
+		svc.GetTranscriptRevisionDiff,
+		connect.WithSchema(recordingsServiceMethods.ByName("GetTranscriptRevisionDiff")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceCloneRecordingHandler := connect.NewUnaryHandler(
+		RecordingsServiceCloneRecordingProcedure,
+		svc.CloneRecording,
+		connect.WithSchema(recordingsServiceMethods.ByName("CloneRecording")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordingsServiceUpdateProcessingSettingsHandler := connect.NewUnaryHandler(
+		RecordingsServiceUpdateProcessingSettingsProcedure,
+		svc.UpdateProcessingSettings,
+		connect.WithSchema(recordingsServiceMethods.ByName("UpdateProcessingSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/secretary.v1.RecordingsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case RecordingsServiceListRecordingsProcedure:
@@ -145,6 +949,62 @@ func NewRecordingsServiceHandler(svc RecordingsServiceHandler, opts ...connect.H
 			recordingsServiceGetRecordingHandler.ServeHTTP(w, r)
 		case RecordingsServiceDeleteRecordingProcedure:
 			recordingsServiceDeleteRecordingHandler.ServeHTTP(w, r)
+		case RecordingsServiceUpdateRecordingStatusProcedure:
+			recordingsServiceUpdateRecordingStatusHandler.ServeHTTP(w, r)
+		case RecordingsServiceRetryProcessingProcedure:
+			recordingsServiceRetryProcessingHandler.ServeHTTP(w, r)
+		case RecordingsServiceSummarizeProcedure:
+			recordingsServiceSummarizeHandler.ServeHTTP(w, r)
+		case RecordingsServiceTranslateTranscriptProcedure:
+			recordingsServiceTranslateTranscriptHandler.ServeHTTP(w, r)
+		case RecordingsServiceLinkMentionsProcedure:
+			recordingsServiceLinkMentionsHandler.ServeHTTP(w, r)
+		case RecordingsServiceStarRecordingProcedure:
+			recordingsServiceStarRecordingHandler.ServeHTTP(w, r)
+		case RecordingsServiceUnstarRecordingProcedure:
+			recordingsServiceUnstarRecordingHandler.ServeHTTP(w, r)
+		case RecordingsServiceCreateClipProcedure:
+			recordingsServiceCreateClipHandler.ServeHTTP(w, r)
+		case RecordingsServiceListClipsProcedure:
+			recordingsServiceListClipsHandler.ServeHTTP(w, r)
+		case RecordingsServiceGenerateMinutesProcedure:
+			recordingsServiceGenerateMinutesHandler.ServeHTTP(w, r)
+		case RecordingsServiceGetMinutesProcedure:
+			recordingsServiceGetMinutesHandler.ServeHTTP(w, r)
+		case RecordingsServiceUpdateMinutesProcedure:
+			recordingsServiceUpdateMinutesHandler.ServeHTTP(w, r)
+		case RecordingsServiceListMinutesVersionsProcedure:
+			recordingsServiceListMinutesVersionsHandler.ServeHTTP(w, r)
+		case RecordingsServicePublishRecordingProcedure:
+			recordingsServicePublishRecordingHandler.ServeHTTP(w, r)
+		case RecordingsServiceListPublicationsProcedure:
+			recordingsServiceListPublicationsHandler.ServeHTTP(w, r)
+		case RecordingsServiceSetRecordingRetentionProcedure:
+			recordingsServiceSetRecordingRetentionHandler.ServeHTTP(w, r)
+		case RecordingsServiceSetLegalHoldProcedure:
+			recordingsServiceSetLegalHoldHandler.ServeHTTP(w, r)
+		case RecordingsServiceGetUploadURLProcedure:
+			recordingsServiceGetUploadURLHandler.ServeHTTP(w, r)
+		case RecordingsServiceConfirmUploadProcedure:
+			recordingsServiceConfirmUploadHandler.ServeHTTP(w, r)
+		case RecordingsServiceAddGuestParticipantProcedure:
+			recordingsServiceAddGuestParticipantHandler.ServeHTTP(w, r)
+		case RecordingsServiceRemoveGuestParticipantProcedure:
+			recordingsServiceRemoveGuestParticipantHandler.ServeHTTP(w, r)
+		case RecordingsServiceRelabelSpeakerProcedure:
+			recordingsServiceRelabelSpeakerHandler.ServeHTTP(w, r)
+		case RecordingsServiceGetRecordingTranscriptProcedure:
+			recordingsServiceGetRecordingTranscriptHandler.ServeHTTP(w, r)
+		case RecordingsServiceListTranscriptSegmentsProcedure:
+			recordingsServiceListTranscriptSegmentsHandler.ServeHTTP(w, r)
+		case RecordingsServiceListTranscriptRevisionsProcedure:
+			recordingsServiceListTranscriptRevisionsHandler.ServeHTTP(w, r)
+		case RecordingsServiceGetTranscriptRevisionDiffProcedure:
+			recordingsServiceGetTranscriptRevisionDiffHandler.ServeHTTP(w, r)
+		case RecordingsServiceCloneRecordingProcedure:
+			recordingsServiceCloneRecordingHandler.ServeHTTP(w, r)
+		case RecordingsServiceUpdateProcessingSettingsProcedure:
+			recordingsServiceUpdateProcessingSettingsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -165,3 +1025,115 @@ func (UnimplementedRecordingsServiceHandler) GetRecording(context.Context, *conn
 func (UnimplementedRecordingsServiceHandler) DeleteRecording(context.Context, *connect.Request[v1.DeleteRecordingRequest]) (*connect.Response[v1.DeleteRecordingResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.DeleteRecording is not implemented"))
 }
+
+func (UnimplementedRecordingsServiceHandler) UpdateRecordingStatus(context.Context, *connect.Request[v1.UpdateRecordingStatusRequest]) (*connect.Response[v1.UpdateRecordingStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.UpdateRecordingStatus is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) RetryProcessing(context.Context, *connect.Request[v1.RetryProcessingRequest]) (*connect.Response[v1.RetryProcessingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.RetryProcessing is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) Summarize(context.Context, *connect.Request[v1.SummarizeRequest]) (*connect.Response[v1.SummarizeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.Summarize is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) TranslateTranscript(context.Context, *connect.Request[v1.TranslateTranscriptRequest]) (*connect.Response[v1.TranslateTranscriptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.TranslateTranscript is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) LinkMentions(context.Context, *connect.Request[v1.LinkMentionsRequest]) (*connect.Response[v1.LinkMentionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.LinkMentions is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) StarRecording(context.Context, *connect.Request[v1.StarRecordingRequest]) (*connect.Response[v1.StarRecordingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.StarRecording is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) UnstarRecording(context.Context, *connect.Request[v1.UnstarRecordingRequest]) (*connect.Response[v1.UnstarRecordingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.UnstarRecording is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) CreateClip(context.Context, *connect.Request[v1.CreateClipRequest]) (*connect.Response[v1.CreateClipResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.CreateClip is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) ListClips(context.Context, *connect.Request[v1.ListClipsRequest]) (*connect.Response[v1.ListClipsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.ListClips is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) GenerateMinutes(context.Context, *connect.Request[v1.GenerateMinutesRequest]) (*connect.Response[v1.GenerateMinutesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.GenerateMinutes is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) GetMinutes(context.Context, *connect.Request[v1.GetMinutesRequest]) (*connect.Response[v1.GetMinutesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.GetMinutes is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) UpdateMinutes(context.Context, *connect.Request[v1.UpdateMinutesRequest]) (*connect.Response[v1.UpdateMinutesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.UpdateMinutes is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) ListMinutesVersions(context.Context, *connect.Request[v1.ListMinutesVersionsRequest]) (*connect.Response[v1.ListMinutesVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.ListMinutesVersions is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) PublishRecording(context.Context, *connect.Request[v1.PublishRecordingRequest]) (*connect.Response[v1.PublishRecordingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.PublishRecording is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) ListPublications(context.Context, *connect.Request[v1.ListPublicationsRequest]) (*connect.Response[v1.ListPublicationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.ListPublications is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) SetRecordingRetention(context.Context, *connect.Request[v1.SetRecordingRetentionRequest]) (*connect.Response[v1.SetRecordingRetentionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.SetRecordingRetention is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) SetLegalHold(context.Context, *connect.Request[v1.SetLegalHoldRequest]) (*connect.Response[v1.SetLegalHoldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.SetLegalHold is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) GetUploadURL(context.Context, *connect.Request[v1.GetUploadURLRequest]) (*connect.Response[v1.GetUploadURLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.GetUploadURL is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) ConfirmUpload(context.Context, *connect.Request[v1.ConfirmUploadRequest]) (*connect.Response[v1.ConfirmUploadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.ConfirmUpload is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) AddGuestParticipant(context.Context, *connect.Request[v1.AddGuestParticipantRequest]) (*connect.Response[v1.AddGuestParticipantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.AddGuestParticipant is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) RemoveGuestParticipant(context.Context, *connect.Request[v1.RemoveGuestParticipantRequest]) (*connect.Response[v1.RemoveGuestParticipantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.RemoveGuestParticipant is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) RelabelSpeaker(context.Context, *connect.Request[v1.RelabelSpeakerRequest]) (*connect.Response[v1.RelabelSpeakerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.RelabelSpeaker is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) GetRecordingTranscript(context.Context, *connect.Request[v1.GetRecordingTranscriptRequest]) (*connect.Response[v1.GetRecordingTranscriptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.GetRecordingTranscript is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) ListTranscriptSegments(context.Context, *connect.Request[v1.ListTranscriptSegmentsRequest]) (*connect.Response[v1.ListTranscriptSegmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.ListTranscriptSegments is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) ListTranscriptRevisions(context.Context, *connect.Request[v1.ListTranscriptRevisionsRequest]) (*connect.Response[v1.ListTranscriptRevisionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.ListTranscriptRevisions is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) GetTranscriptRevisionDiff(context.Context, *connect.Request[v1.GetTranscriptRevisionDiffRequest]) (*connect.Response[v1.GetTranscriptRevisionDiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.GetTranscriptRevisionDiff is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) CloneRecording(context.Context, *connect.Request[v1.CloneRecordingRequest]) (*connect.Response[v1.CloneRecordingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.CloneRecording is not implemented"))
+}
+
+func (UnimplementedRecordingsServiceHandler) UpdateProcessingSettings(context.Context, *connect.Request[v1.UpdateProcessingSettingsRequest]) (*connect.Response[v1.UpdateProcessingSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.RecordingsService.UpdateProcessingSettings is not implemented"))
+}
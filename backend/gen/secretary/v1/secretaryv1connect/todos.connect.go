@@ -43,9 +43,41 @@ const (
 	TodosServiceUpdateTodoProcedure = "/secretary.v1.TodosService/UpdateTodo"
 	// TodosServiceDeleteTodoProcedure is the fully-qualified name of the TodosService's DeleteTodo RPC.
 	TodosServiceDeleteTodoProcedure = "/secretary.v1.TodosService/DeleteTodo"
+	// TodosServiceMergeTodosProcedure is the fully-qualified name of the TodosService's MergeTodos RPC.
+	TodosServiceMergeTodosProcedure = "/secretary.v1.TodosService/MergeTodos"
 	// TodosServiceListTodoHistoryProcedure is the fully-qualified name of the TodosService's
 	// ListTodoHistory RPC.
 	TodosServiceListTodoHistoryProcedure = "/secretary.v1.TodosService/ListTodoHistory"
+	// TodosServiceStarTodoProcedure is the fully-qualified name of the TodosService's StarTodo RPC.
+	TodosServiceStarTodoProcedure = "/secretary.v1.TodosService/StarTodo"
+	// TodosServiceUnstarTodoProcedure is the fully-qualified name of the TodosService's UnstarTodo RPC.
+	TodosServiceUnstarTodoProcedure = "/secretary.v1.TodosService/UnstarTodo"
+	// TodosServiceSnoozeTodoProcedure is the fully-qualified name of the TodosService's SnoozeTodo RPC.
+	TodosServiceSnoozeTodoProcedure = "/secretary.v1.TodosService/SnoozeTodo"
+	// TodosServiceExportToTrackerProcedure is the fully-qualified name of the TodosService's
+	// ExportToTracker RPC.
+	TodosServiceExportToTrackerProcedure = "/secretary.v1.TodosService/ExportToTracker"
+	// TodosServiceListTrackerLinksProcedure is the fully-qualified name of the TodosService's
+	// ListTrackerLinks RPC.
+	TodosServiceListTrackerLinksProcedure = "/secretary.v1.TodosService/ListTrackerLinks"
+	// TodosServiceListTodoCommentsProcedure is the fully-qualified name of the TodosService's
+	// ListTodoComments RPC.
+	TodosServiceListTodoCommentsProcedure = "/secretary.v1.TodosService/ListTodoComments"
+	// TodosServiceCreateTodoCommentProcedure is the fully-qualified name of the TodosService's
+	// CreateTodoComment RPC.
+	TodosServiceCreateTodoCommentProcedure = "/secretary.v1.TodosService/CreateTodoComment"
+	// TodosServiceUpdateTodoCommentProcedure is the fully-qualified name of the TodosService's
+	// UpdateTodoComment RPC.
+	TodosServiceUpdateTodoCommentProcedure = "/secretary.v1.TodosService/UpdateTodoComment"
+	// TodosServiceDeleteTodoCommentProcedure is the fully-qualified name of the TodosService's
+	// DeleteTodoComment RPC.
+	TodosServiceDeleteTodoCommentProcedure = "/secretary.v1.TodosService/DeleteTodoComment"
+	// TodosServiceAddReactionProcedure is the fully-qualified name of the TodosService's AddReaction
+	// RPC.
+	TodosServiceAddReactionProcedure = "/secretary.v1.TodosService/AddReaction"
+	// TodosServiceRemoveReactionProcedure is the fully-qualified name of the TodosService's
+	// RemoveReaction RPC.
+	TodosServiceRemoveReactionProcedure = "/secretary.v1.TodosService/RemoveReaction"
 )
 
 // TodosServiceClient is a client for the secretary.v1.TodosService service.
@@ -55,7 +87,33 @@ type TodosServiceClient interface {
 	CreateTodo(context.Context, *connect.Request[v1.CreateTodoRequest]) (*connect.Response[v1.CreateTodoResponse], error)
 	UpdateTodo(context.Context, *connect.Request[v1.UpdateTodoRequest]) (*connect.Response[v1.UpdateTodoResponse], error)
 	DeleteTodo(context.Context, *connect.Request[v1.DeleteTodoRequest]) (*connect.Response[v1.DeleteTodoResponse], error)
+	// Folds a duplicate todo, e.g. one action item picked up from two
+	// meetings, into another: its history, attachments, comments, document
+	// blocks, mentions, stars, reactions and tracker links move over, then
+	// it is deleted.
+	MergeTodos(context.Context, *connect.Request[v1.MergeTodosRequest]) (*connect.Response[v1.MergeTodosResponse], error)
 	ListTodoHistory(context.Context, *connect.Request[v1.ListTodoHistoryRequest]) (*connect.Response[v1.ListTodoHistoryResponse], error)
+	// Pins a todo for the caller; like StarRecording.
+	StarTodo(context.Context, *connect.Request[v1.StarTodoRequest]) (*connect.Response[v1.StarTodoResponse], error)
+	UnstarTodo(context.Context, *connect.Request[v1.UnstarTodoRequest]) (*connect.Response[v1.UnstarTodoResponse], error)
+	// Hides a todo from lists that exclude snoozed todos until a time. When
+	// the snooze runs out the assignee is told.
+	SnoozeTodo(context.Context, *connect.Request[v1.SnoozeTodoRequest]) (*connect.Response[v1.SnoozeTodoResponse], error)
+	// Creates an issue for the todo in a configured tracker. The tracker's
+	// webhook then keeps the todo's status in step with the issue.
+	ExportToTracker(context.Context, *connect.Request[v1.ExportToTrackerRequest]) (*connect.Response[v1.ExportToTrackerResponse], error)
+	ListTrackerLinks(context.Context, *connect.Request[v1.ListTrackerLinksRequest]) (*connect.Response[v1.ListTrackerLinksResponse], error)
+	ListTodoComments(context.Context, *connect.Request[v1.ListTodoCommentsRequest]) (*connect.Response[v1.ListTodoCommentsResponse], error)
+	// Users the body @-mentions are told, apart from the author. Editing a
+	// comment tells only those it newly mentions.
+	CreateTodoComment(context.Context, *connect.Request[v1.CreateTodoCommentRequest]) (*connect.Response[v1.CreateTodoCommentResponse], error)
+	// Only the author or an admin can edit or delete a comment.
+	UpdateTodoComment(context.Context, *connect.Request[v1.UpdateTodoCommentRequest]) (*connect.Response[v1.UpdateTodoCommentResponse], error)
+	DeleteTodoComment(context.Context, *connect.Request[v1.DeleteTodoCommentRequest]) (*connect.Response[v1.DeleteTodoCommentResponse], error)
+	// Reacts to a todo or comment with an emoji on the caller's behalf.
+	// Reacting twice with the same emoji changes nothing.
+	AddReaction(context.Context, *connect.Request[v1.AddReactionRequest]) (*connect.Response[v1.AddReactionResponse], error)
+	RemoveReaction(context.Context, *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error)
 }
 
 // NewTodosServiceClient constructs a client for the secretary.v1.TodosService service. By default,
@@ -99,23 +157,107 @@ func NewTodosServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(todosServiceMethods.ByName("DeleteTodo")),
 			connect.WithClientOptions(opts...),
 		),
+		mergeTodos: connect.NewClient[v1.MergeTodosRequest, v1.MergeTodosResponse](
+			httpClient,
+			baseURL+TodosServiceMergeTodosProcedure,
+			connect.WithSchema(todosServiceMethods.ByName("MergeTodos")),
+			connect.WithClientOptions(opts...),
+		),
 		listTodoHistory: connect.NewClient[v1.ListTodoHistoryRequest, v1.ListTodoHistoryResponse](
 			httpClient,
 			baseURL+TodosServiceListTodoHistoryProcedure,
 			connect.WithSchema(todosServiceMethods.ByName("ListTodoHistory")),
 			connect.WithClientOptions(opts...),
 		),
+		starTodo: connect.NewClient[v1.StarTodoRequest, v1.StarTodoResponse](
+			httpClient,
+			baseURL+TodosServiceStarTodoProcedure,
+			connect.WithSchema(todosServiceMethods.ByName("StarTodo")),
+			connect.WithClientOptions(opts...),
+		),
+		unstarTodo: connect.NewClient[v1.UnstarTodoRequest, v1.UnstarTodoResponse](
+			httpClient,
+			baseURL+TodosServiceUnstarTodoProcedure,
+			connect.WithSchema(todosServiceMethods.ByName("UnstarTodo")),
+			connect.WithClientOptions(opts...),
+		),
+		snoozeTodo: connect.NewClient[v1.SnoozeTodoRequest, v1.SnoozeTodoResponse](
+			httpClient,
+			baseURL+TodosServiceSnoozeTodoProcedure,
+			connect.WithSchema(todosServiceMethods.ByName("SnoozeTodo")),
+			connect.WithClientOptions(opts...),
+		),
+		exportToTracker: connect.NewClient[v1.ExportToTrackerRequest, v1.ExportToTrackerResponse](
+			httpClient,
+			baseURL+TodosServiceExportToTrackerProcedure,
+			connect.WithSchema(todosServiceMethods.ByName("ExportToTracker")),
+			connect.WithClientOptions(opts...),
+		),
+		listTrackerLinks: connect.NewClient[v1.ListTrackerLinksRequest, v1.ListTrackerLinksResponse](
+			httpClient,
+			baseURL+TodosServiceListTrackerLinksProcedure,
+			connect.WithSchema(todosServiceMethods.ByName("ListTrackerLinks")),
+			connect.WithClientOptions(opts...),
+		),
+		listTodoComments: connect.NewClient[v1.ListTodoCommentsRequest, v1.ListTodoCommentsResponse](
+			httpClient,
+			baseURL+TodosServiceListTodoCommentsProcedure,
+			connect.WithSchema(todosServiceMethods.ByName("ListTodoComments")),
+			connect.WithClientOptions(opts...),
+		),
+		createTodoComment: connect.NewClient[v1.CreateTodoCommentRequest, v1.CreateTodoCommentResponse](
+			httpClient,
+			baseURL+TodosServiceCreateTodoCommentProcedure,
+			connect.WithSchema(todosServiceMethods.ByName("CreateTodoComment")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTodoComment: connect.NewClient[v1.UpdateTodoCommentRequest, v1.UpdateTodoCommentResponse](
+			httpClient,
+			baseURL+TodosServiceUpdateTodoCommentProcedure,
+			connect.WithSchema(todosServiceMethods.ByName("UpdateTodoComment")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTodoComment: connect.NewClient[v1.DeleteTodoCommentRequest, v1.DeleteTodoCommentResponse](
+			httpClient,
+			baseURL+TodosServiceDeleteTodoCommentProcedure,
+			connect.WithSchema(todosServiceMethods.ByName("DeleteTodoComment")),
+			connect.WithClientOptions(opts...),
+		),
+		addReaction: connect.NewClient[v1.AddReactionRequest, v1.AddReactionResponse](
+			httpClient,
+			baseURL+TodosServiceAddReactionProcedure,
+			connect.WithSchema(todosServiceMethods.ByName("AddReaction")),
+			connect.WithClientOptions(opts...),
+		),
+		removeReaction: connect.NewClient[v1.RemoveReactionRequest, v1.RemoveReactionResponse](
+			httpClient,
+			baseURL+TodosServiceRemoveReactionProcedure,
+			connect.WithSchema(todosServiceMethods.ByName("RemoveReaction")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // todosServiceClient implements TodosServiceClient.
 type todosServiceClient struct {
-	listTodos       *connect.Client[v1.ListTodosRequest, v1.ListTodosResponse]
-	getTodo         *connect.Client[v1.GetTodoRequest, v1.GetTodoResponse]
-	createTodo      *connect.Client[v1.CreateTodoRequest, v1.CreateTodoResponse]
-	updateTodo      *connect.Client[v1.UpdateTodoRequest, v1.UpdateTodoResponse]
-	deleteTodo      *connect.Client[v1.DeleteTodoRequest, v1.DeleteTodoResponse]
-	listTodoHistory *connect.Client[v1.ListTodoHistoryRequest, v1.ListTodoHistoryResponse]
+	listTodos         *connect.Client[v1.ListTodosRequest, v1.ListTodosResponse]
+	getTodo           *connect.Client[v1.GetTodoRequest, v1.GetTodoResponse]
+	createTodo        *connect.Client[v1.CreateTodoRequest, v1.CreateTodoResponse]
+	updateTodo        *connect.Client[v1.UpdateTodoRequest, v1.UpdateTodoResponse]
+	deleteTodo        *connect.Client[v1.DeleteTodoRequest, v1.DeleteTodoResponse]
+	mergeTodos        *connect.Client[v1.MergeTodosRequest, v1.MergeTodosResponse]
+	listTodoHistory   *connect.Client[v1.ListTodoHistoryRequest, v1.ListTodoHistoryResponse]
+	starTodo          *connect.Client[v1.StarTodoRequest, v1.StarTodoResponse]
+	unstarTodo        *connect.Client[v1.UnstarTodoRequest, v1.UnstarTodoResponse]
+	snoozeTodo        *connect.Client[v1.SnoozeTodoRequest, v1.SnoozeTodoResponse]
+	exportToTracker   *connect.Client[v1.ExportToTrackerRequest, v1.ExportToTrackerResponse]
+	listTrackerLinks  *connect.Client[v1.ListTrackerLinksRequest, v1.ListTrackerLinksResponse]
+	listTodoComments  *connect.Client[v1.ListTodoCommentsRequest, v1.ListTodoCommentsResponse]
+	createTodoComment *connect.Client[v1.CreateTodoCommentRequest, v1.CreateTodoCommentResponse]
+	updateTodoComment *connect.Client[v1.UpdateTodoCommentRequest, v1.UpdateTodoCommentResponse]
+	deleteTodoComment *connect.Client[v1.DeleteTodoCommentRequest, v1.DeleteTodoCommentResponse]
+	addReaction       *connect.Client[v1.AddReactionRequest, v1.AddReactionResponse]
+	removeReaction    *connect.Client[v1.RemoveReactionRequest, v1.RemoveReactionResponse]
 }
 
 // ListTodos calls secretary.v1.TodosService.ListTodos.
@@ -143,11 +285,71 @@ func (c *todosServiceClient) DeleteTodo(ctx context.Context, req *connect.Reques
 	return c.deleteTodo.CallUnary(ctx, req)
 }
 
+// MergeTodos calls secretary.v1.TodosService.MergeTodos.
+func (c *todosServiceClient) MergeTodos(ctx context.Context, req *connect.Request[v1.MergeTodosRequest]) (*connect.Response[v1.MergeTodosResponse], error) {
+	return c.mergeTodos.CallUnary(ctx, req)
+}
+
 // ListTodoHistory calls secretary.v1.TodosService.ListTodoHistory.
 func (c *todosServiceClient) ListTodoHistory(ctx context.Context, req *connect.Request[v1.ListTodoHistoryRequest]) (*connect.Response[v1.ListTodoHistoryResponse], error) {
 	return c.listTodoHistory.CallUnary(ctx, req)
 }
 
+// StarTodo calls secretary.v1.TodosService.StarTodo.
+func (c *todosServiceClient) StarTodo(ctx context.Context, req *connect.Request[v1.StarTodoRequest]) (*connect.Response[v1.StarTodoResponse], error) {
+	return c.starTodo.CallUnary(ctx, req)
+}
+
+// UnstarTodo calls secretary.v1.TodosService.UnstarTodo.
+func (c *todosServiceClient) UnstarTodo(ctx context.Context, req *connect.Request[v1.UnstarTodoRequest]) (*connect.Response[v1.UnstarTodoResponse], error) {
+	return c.unstarTodo.CallUnary(ctx, req)
+}
+
+// SnoozeTodo calls secretary.v1.TodosService.SnoozeTodo.
+func (c *todosServiceClient) SnoozeTodo(ctx context.Context, req *connect.Request[v1.SnoozeTodoRequest]) (*connect.Response[v1.SnoozeTodoResponse], error) {
+	return c.snoozeTodo.CallUnary(ctx, req)
+}
+
+// ExportToTracker calls secretary.v1.TodosService.ExportToTracker.
+func (c *todosServiceClient) ExportToTracker(ctx context.Context, req *connect.Request[v1.ExportToTrackerRequest]) (*connect.Response[v1.ExportToTrackerResponse], error) {
+	return c.exportToTracker.CallUnary(ctx, req)
+}
+
+// ListTrackerLinks calls secretary.v1.TodosService.ListTrackerLinks.
+func (c *todosServiceClient) ListTrackerLinks(ctx context.Context, req *connect.Request[v1.ListTrackerLinksRequest]) (*connect.Response[v1.ListTrackerLinksResponse], error) {
+	return c.listTrackerLinks.CallUnary(ctx, req)
+}
+
+// ListTodoComments calls secretary.v1.TodosService.ListTodoComments.
+func (c *todosServiceClient) ListTodoComments(ctx context.Context, req *connect.Request[v1.ListTodoCommentsRequest]) (*connect.Response[v1.ListTodoCommentsResponse], error) {
+	return c.listTodoComments.CallUnary(ctx, req)
+}
+
+// CreateTodoComment calls secretary.v1.TodosService.CreateTodoComment.
+func (c *todosServiceClient) CreateTodoComment(ctx context.Context, req *connect.Request[v1.CreateTodoCommentRequest]) (*connect.Response[v1.CreateTodoCommentResponse], error) {
+	return c.createTodoComment.CallUnary(ctx, req)
+}
+
+// UpdateTodoComment calls secretary.v1.TodosService.UpdateTodoComment.
+func (c *todosServiceClient) UpdateTodoComment(ctx context.Context, req *connect.Request[v1.UpdateTodoCommentRequest]) (*connect.Response[v1.UpdateTodoCommentResponse], error) {
+	return c.updateTodoComment.CallUnary(ctx, req)
+}
+
+// DeleteTodoComment calls secretary.v1.TodosService.DeleteTodoComment.
+func (c *todosServiceClient) DeleteTodoComment(ctx context.Context, req *connect.Request[v1.DeleteTodoCommentRequest]) (*connect.Response[v1.DeleteTodoCommentResponse], error) {
+	return c.deleteTodoComment.CallUnary(ctx, req)
+}
+
+// AddReaction calls secretary.v1.TodosService.AddReaction.
+func (c *todosServiceClient) AddReaction(ctx context.Context, req *connect.Request[v1.AddReactionRequest]) (*connect.Response[v1.AddReactionResponse], error) {
+	return c.addReaction.CallUnary(ctx, req)
+}
+
+// RemoveReaction calls secretary.v1.TodosService.RemoveReaction.
+func (c *todosServiceClient) RemoveReaction(ctx context.Context, req *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error) {
+	return c.removeReaction.CallUnary(ctx, req)
+}
+
 // TodosServiceHandler is an implementation of the secretary.v1.TodosService service.
 type TodosServiceHandler interface {
 	ListTodos(context.Context, *connect.Request[v1.ListTodosRequest]) (*connect.Response[v1.ListTodosResponse], error)
@@ -155,7 +357,33 @@ type TodosServiceHandler interface {
 	CreateTodo(context.Context, *connect.Request[v1.CreateTodoRequest]) (*connect.Response[v1.CreateTodoResponse], error)
 	UpdateTodo(context.Context, *connect.Request[v1.UpdateTodoRequest]) (*connect.Response[v1.UpdateTodoResponse], error)
 	DeleteTodo(context.Context, *connect.Request[v1.DeleteTodoRequest]) (*connect.Response[v1.DeleteTodoResponse], error)
+	// Folds a duplicate todo, e.g. one action item picked up from two
+	// meetings, into another: its history, attachments, comments, document
+	// blocks, mentions, stars, reactions and tracker links move over, then
+	// it is deleted.
+	MergeTodos(context.Context, *connect.Request[v1.MergeTodosRequest]) (*connect.Response[v1.MergeTodosResponse], error)
 	ListTodoHistory(context.Context, *connect.Request[v1.ListTodoHistoryRequest]) (*connect.Response[v1.ListTodoHistoryResponse], error)
+	// Pins a todo for the caller; like StarRecording.
+	StarTodo(context.Context, *connect.Request[v1.StarTodoRequest]) (*connect.Response[v1.StarTodoResponse], error)
+	UnstarTodo(context.Context, *connect.Request[v1.UnstarTodoRequest]) (*connect.Response[v1.UnstarTodoResponse], error)
+	// Hides a todo from lists that exclude snoozed todos until a time. When
+	// the snooze runs out the assignee is told.
+	SnoozeTodo(context.Context, *connect.Request[v1.SnoozeTodoRequest]) (*connect.Response[v1.SnoozeTodoResponse], error)
+	// Creates an issue for the todo in a configured tracker. The tracker's
+	// webhook then keeps the todo's status in step with the issue.
+	ExportToTracker(context.Context, *connect.Request[v1.ExportToTrackerRequest]) (*connect.Response[v1.ExportToTrackerResponse], error)
+	ListTrackerLinks(context.Context, *connect.Request[v1.ListTrackerLinksRequest]) (*connect.Response[v1.ListTrackerLinksResponse], error)
+	ListTodoComments(context.Context, *connect.Request[v1.ListTodoCommentsRequest]) (*connect.Response[v1.ListTodoCommentsResponse], error)
+	// Users the body @-mentions are told, apart from the author. Editing a
+	// comment tells only those it newly mentions.
+	CreateTodoComment(context.Context, *connect.Request[v1.CreateTodoCommentRequest]) (*connect.Response[v1.CreateTodoCommentResponse], error)
+	// Only the author or an admin can edit or delete a comment.
+	UpdateTodoComment(context.Context, *connect.Request[v1.UpdateTodoCommentRequest]) (*connect.Response[v1.UpdateTodoCommentResponse], error)
+	DeleteTodoComment(context.Context, *connect.Request[v1.DeleteTodoCommentRequest]) (*connect.Response[v1.DeleteTodoCommentResponse], error)
+	// Reacts to a todo or comment with an emoji on the caller's behalf.
+	// Reacting twice with the same emoji changes nothing.
+	AddReaction(context.Context, *connect.Request[v1.AddReactionRequest]) (*connect.Response[v1.AddReactionResponse], error)
+	RemoveReaction(context.Context, *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error)
 }
 
 // NewTodosServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -195,12 +423,84 @@ func NewTodosServiceHandler(svc TodosServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(todosServiceMethods.ByName("DeleteTodo")),
 		connect.WithHandlerOptions(opts...),
 	)
+	todosServiceMergeTodosHandler := connect.NewUnaryHandler(
+		TodosServiceMergeTodosProcedure,
+		svc.MergeTodos,
+		connect.WithSchema(todosServiceMethods.ByName("MergeTodos")),
+		connect.WithHandlerOptions(opts...),
+	)
 	todosServiceListTodoHistoryHandler := connect.NewUnaryHandler(
 		TodosServiceListTodoHistoryProcedure,
 		svc.ListTodoHistory,
 		connect.WithSchema(todosServiceMethods.ByName("ListTodoHistory")),
 		connect.WithHandlerOptions(opts...),
 	)
+	todosServiceStarTodoHandler := connect.NewUnaryHandler(
+		TodosServiceStarTodoProcedure,
+		svc.StarTodo,
+		connect.WithSchema(todosServiceMethods.ByName("StarTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todosServiceUnstarTodoHandler := connect.NewUnaryHandler(
+		TodosServiceUnstarTodoProcedure,
+		svc.UnstarTodo,
+		connect.WithSchema(todosServiceMethods.ByName("UnstarTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todosServiceSnoozeTodoHandler := connect.NewUnaryHandler(
+		TodosServiceSnoozeTodoProcedure,
+		svc.SnoozeTodo,
+		connect.WithSchema(todosServiceMethods.ByName("SnoozeTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todosServiceExportToTrackerHandler := connect.NewUnaryHandler(
+		TodosServiceExportToTrackerProcedure,
+		svc.ExportToTracker,
+		connect.WithSchema(todosServiceMethods.ByName("ExportToTracker")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todosServiceListTrackerLinksHandler := connect.NewUnaryHandler(
+		TodosServiceListTrackerLinksProcedure,
+		svc.ListTrackerLinks,
+		connect.WithSchema(todosServiceMethods.ByName("ListTrackerLinks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todosServiceListTodoCommentsHandler := connect.NewUnaryHandler(
+		TodosServiceListTodoCommentsProcedure,
+		svc.ListTodoComments,
+		connect.WithSchema(todosServiceMethods.ByName("ListTodoComments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todosServiceCreateTodoCommentHandler := connect.NewUnaryHandler(
+		TodosServiceCreateTodoCommentProcedure,
+		svc.CreateTodoComment,
+		connect.WithSchema(todosServiceMethods.ByName("CreateTodoComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todosServiceUpdateTodoCommentHandler := connect.NewUnaryHandler(
+		TodosServiceUpdateTodoCommentProcedure,
+		svc.UpdateTodoComment,
+		connect.WithSchema(todosServiceMethods.ByName("UpdateTodoComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todosServiceDeleteTodoCommentHandler := connect.NewUnaryHandler(
+		TodosServiceDeleteTodoCommentProcedure,
+		svc.DeleteTodoComment,
+		connect.WithSchema(todosServiceMethods.ByName("DeleteTodoComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todosServiceAddReactionHandler := connect.NewUnaryHandler(
+		TodosServiceAddReactionProcedure,
+		svc.AddReaction,
+		connect.WithSchema(todosServiceMethods.ByName("AddReaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todosServiceRemoveReactionHandler := connect.NewUnaryHandler(
+		TodosServiceRemoveReactionProcedure,
+		svc.RemoveReaction,
+		connect.WithSchema(todosServiceMethods.ByName("RemoveReaction")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/secretary.v1.TodosService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TodosServiceListTodosProcedure:
@@ -213,8 +513,32 @@ func NewTodosServiceHandler(svc TodosServiceHandler, opts ...connect.HandlerOpti
 			todosServiceUpdateTodoHandler.ServeHTTP(w, r)
 		case TodosServiceDeleteTodoProcedure:
 			todosServiceDeleteTodoHandler.ServeHTTP(w, r)
+		case TodosServiceMergeTodosProcedure:
+			todosServiceMergeTodosHandler.ServeHTTP(w, r)
 		case TodosServiceListTodoHistoryProcedure:
 			todosServiceListTodoHistoryHandler.ServeHTTP(w, r)
+		case TodosServiceStarTodoProcedure:
+			todosServiceStarTodoHandler.ServeHTTP(w, r)
+		case TodosServiceUnstarTodoProcedure:
+			todosServiceUnstarTodoHandler.ServeHTTP(w, r)
+		case TodosServiceSnoozeTodoProcedure:
+			todosServiceSnoozeTodoHandler.ServeHTTP(w, r)
+		case TodosServiceExportToTrackerProcedure:
+			todosServiceExportToTrackerHandler.ServeHTTP(w, r)
+		case TodosServiceListTrackerLinksProcedure:
+			todosServiceListTrackerLinksHandler.ServeHTTP(w, r)
+		case TodosServiceListTodoCommentsProcedure:
+			todosServiceListTodoCommentsHandler.ServeHTTP(w, r)
+		case TodosServiceCreateTodoCommentProcedure:
+			todosServiceCreateTodoCommentHandler.ServeHTTP(w, r)
+		case TodosServiceUpdateTodoCommentProcedure:
+			todosServiceUpdateTodoCommentHandler.ServeHTTP(w, r)
+		case TodosServiceDeleteTodoCommentProcedure:
+			todosServiceDeleteTodoCommentHandler.ServeHTTP(w, r)
+		case TodosServiceAddReactionProcedure:
+			todosServiceAddReactionHandler.ServeHTTP(w, r)
+		case TodosServiceRemoveReactionProcedure:
+			todosServiceRemoveReactionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -244,6 +568,54 @@ func (UnimplementedTodosServiceHandler) DeleteTodo(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.DeleteTodo is not implemented"))
 }
 
+func (UnimplementedTodosServiceHandler) MergeTodos(context.Context, *connect.Request[v1.MergeTodosRequest]) (*connect.Response[v1.MergeTodosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.MergeTodos is not implemented"))
+}
+
 func (UnimplementedTodosServiceHandler) ListTodoHistory(context.Context, *connect.Request[v1.ListTodoHistoryRequest]) (*connect.Response[v1.ListTodoHistoryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.ListTodoHistory is not implemented"))
 }
+
+func (UnimplementedTodosServiceHandler) StarTodo(context.Context, *connect.Request[v1.StarTodoRequest]) (*connect.Response[v1.StarTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.StarTodo is not implemented"))
+}
+
+func (UnimplementedTodosServiceHandler) UnstarTodo(context.Context, *connect.Request[v1.UnstarTodoRequest]) (*connect.Response[v1.UnstarTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.UnstarTodo is not implemented"))
+}
+
+func (UnimplementedTodosServiceHandler) SnoozeTodo(context.Context, *connect.Request[v1.SnoozeTodoRequest]) (*connect.Response[v1.SnoozeTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.SnoozeTodo is not implemented"))
+}
+
+func (UnimplementedTodosServiceHandler) ExportToTracker(context.Context, *connect.Request[v1.ExportToTrackerRequest]) (*connect.Response[v1.ExportToTrackerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.ExportToTracker is not implemented"))
+}
+
+func (UnimplementedTodosServiceHandler) ListTrackerLinks(context.Context, *connect.Request[v1.ListTrackerLinksRequest]) (*connect.Response[v1.ListTrackerLinksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.ListTrackerLinks is not implemented"))
+}
+
+func (UnimplementedTodosServiceHandler) ListTodoComments(context.Context, *connect.Request[v1.ListTodoCommentsRequest]) (*connect.Response[v1.ListTodoCommentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.ListTodoComments is not implemented"))
+}
+
+func (UnimplementedTodosServiceHandler) CreateTodoComment(context.Context, *connect.Request[v1.CreateTodoCommentRequest]) (*connect.Response[v1.CreateTodoCommentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.CreateTodoComment is not implemented"))
+}
+
+func (UnimplementedTodosServiceHandler) UpdateTodoComment(context.Context, *connect.Request[v1.UpdateTodoCommentRequest]) (*connect.Response[v1.UpdateTodoCommentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.UpdateTodoComment is not implemented"))
+}
+
+func (UnimplementedTodosServiceHandler) DeleteTodoComment(context.Context, *connect.Request[v1.DeleteTodoCommentRequest]) (*connect.Response[v1.DeleteTodoCommentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.DeleteTodoComment is not implemented"))
+}
+
+func (UnimplementedTodosServiceHandler) AddReaction(context.Context, *connect.Request[v1.AddReactionRequest]) (*connect.Response[v1.AddReactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.AddReaction is not implemented"))
+}
+
+func (UnimplementedTodosServiceHandler) RemoveReaction(context.Context, *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.TodosService.RemoveReaction is not implemented"))
+}
@@ -35,11 +35,68 @@ const (
 const (
 	// UsersServiceListUsersProcedure is the fully-qualified name of the UsersService's ListUsers RPC.
 	UsersServiceListUsersProcedure = "/secretary.v1.UsersService/ListUsers"
+	// UsersServiceGetMeProcedure is the fully-qualified name of the UsersService's GetMe RPC.
+	UsersServiceGetMeProcedure = "/secretary.v1.UsersService/GetMe"
+	// UsersServiceUpdateMeProcedure is the fully-qualified name of the UsersService's UpdateMe RPC.
+	UsersServiceUpdateMeProcedure = "/secretary.v1.UsersService/UpdateMe"
+	// UsersServiceVerifyEmailProcedure is the fully-qualified name of the UsersService's VerifyEmail
+	// RPC.
+	UsersServiceVerifyEmailProcedure = "/secretary.v1.UsersService/VerifyEmail"
+	// UsersServiceDeactivateUserProcedure is the fully-qualified name of the UsersService's
+	// DeactivateUser RPC.
+	UsersServiceDeactivateUserProcedure = "/secretary.v1.UsersService/DeactivateUser"
+	// UsersServiceReactivateUserProcedure is the fully-qualified name of the UsersService's
+	// ReactivateUser RPC.
+	UsersServiceReactivateUserProcedure = "/secretary.v1.UsersService/ReactivateUser"
+	// UsersServiceListMentionsProcedure is the fully-qualified name of the UsersService's ListMentions
+	// RPC.
+	UsersServiceListMentionsProcedure = "/secretary.v1.UsersService/ListMentions"
+	// UsersServiceMarkMentionsReadProcedure is the fully-qualified name of the UsersService's
+	// MarkMentionsRead RPC.
+	UsersServiceMarkMentionsReadProcedure = "/secretary.v1.UsersService/MarkMentionsRead"
+	// UsersServiceListSessionsProcedure is the fully-qualified name of the UsersService's ListSessions
+	// RPC.
+	UsersServiceListSessionsProcedure = "/secretary.v1.UsersService/ListSessions"
+	// UsersServiceRevokeSessionProcedure is the fully-qualified name of the UsersService's
+	// RevokeSession RPC.
+	UsersServiceRevokeSessionProcedure = "/secretary.v1.UsersService/RevokeSession"
+	// UsersServiceChangePasswordProcedure is the fully-qualified name of the UsersService's
+	// ChangePassword RPC.
+	UsersServiceChangePasswordProcedure = "/secretary.v1.UsersService/ChangePassword"
 )
 
 // UsersServiceClient is a client for the secretary.v1.UsersService service.
 type UsersServiceClient interface {
 	ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error)
+	GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error)
+	UpdateMe(context.Context, *connect.Request[v1.UpdateMeRequest]) (*connect.Response[v1.UpdateMeResponse], error)
+	// Confirms a pending email change with the token from the verification
+	// link. The token must belong to the signed-in user.
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// Stops a user from signing in and refuses the tokens they hold. Users
+	// are deactivated rather than deleted, so todos and history keep naming
+	// them. With a successor, the same transaction hands their open todos
+	// over, noting the transfer in each todo's history. Admin only.
+	DeactivateUser(context.Context, *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error)
+	// Lets a deactivated user sign in again. Their old todos stay with the
+	// successor. Admin only.
+	ReactivateUser(context.Context, *connect.Request[v1.ReactivateUserRequest]) (*connect.Response[v1.ReactivateUserResponse], error)
+	// The signed-in user's inbox: transcript lines and todos naming them,
+	// most recently found first.
+	ListMentions(context.Context, *connect.Request[v1.ListMentionsRequest]) (*connect.Response[v1.ListMentionsResponse], error)
+	// Marks the signed-in user's mentions read, or unread again.
+	MarkMentionsRead(context.Context, *connect.Request[v1.MarkMentionsReadRequest]) (*connect.Response[v1.MarkMentionsReadResponse], error)
+	// The signed-in user's sessions: every sign-in whose token hasn't
+	// expired or been revoked, most recently used first.
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// Signs one of the user's sessions out, or every one but the current.
+	// Their tokens are refused from then on, by every server process.
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	// Changes the signed-in user's password and signs out their other
+	// sessions. The new password must meet the server's password policy;
+	// each rule it breaks is a field violation on new_password, with a
+	// reason such as PASSWORD_TOO_SHORT or PASSWORD_BREACHED.
+	ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error)
 }
 
 // NewUsersServiceClient constructs a client for the secretary.v1.UsersService service. By default,
@@ -59,12 +116,85 @@ func NewUsersServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(usersServiceMethods.ByName("ListUsers")),
 			connect.WithClientOptions(opts...),
 		),
+		getMe: connect.NewClient[v1.GetMeRequest, v1.GetMeResponse](
+			httpClient,
+			baseURL+UsersServiceGetMeProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("GetMe")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateMe: connect.NewClient[v1.UpdateMeRequest, v1.UpdateMeResponse](
+			httpClient,
+			baseURL+UsersServiceUpdateMeProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("UpdateMe")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyEmail: connect.NewClient[v1.VerifyEmailRequest, v1.VerifyEmailResponse](
+			httpClient,
+			baseURL+UsersServiceVerifyEmailProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("VerifyEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		deactivateUser: connect.NewClient[v1.DeactivateUserRequest, v1.DeactivateUserResponse](
+			httpClient,
+			baseURL+UsersServiceDeactivateUserProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("DeactivateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		reactivateUser: connect.NewClient[v1.ReactivateUserRequest, v1.ReactivateUserResponse](
+			httpClient,
+			baseURL+UsersServiceReactivateUserProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("ReactivateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		listMentions: connect.NewClient[v1.ListMentionsRequest, v1.ListMentionsResponse](
+			httpClient,
+			baseURL+UsersServiceListMentionsProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("ListMentions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		markMentionsRead: connect.NewClient[v1.MarkMentionsReadRequest, v1.MarkMentionsReadResponse](
+			httpClient,
+			baseURL+UsersServiceMarkMentionsReadProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("MarkMentionsRead")),
+			connect.WithClientOptions(opts...),
+		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+UsersServiceListSessionsProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("ListSessions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, v1.RevokeSessionResponse](
+			httpClient,
+			baseURL+UsersServiceRevokeSessionProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		changePassword: connect.NewClient[v1.ChangePasswordRequest, v1.ChangePasswordResponse](
+			httpClient,
+			baseURL+UsersServiceChangePasswordProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("ChangePassword")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // usersServiceClient implements UsersServiceClient.
 type usersServiceClient struct {
-	listUsers *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	listUsers        *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	getMe            *connect.Client[v1.GetMeRequest, v1.GetMeResponse]
+	updateMe         *connect.Client[v1.UpdateMeRequest, v1.UpdateMeResponse]
+	verifyEmail      *connect.Client[v1.VerifyEmailRequest, v1.VerifyEmailResponse]
+	deactivateUser   *connect.Client[v1.DeactivateUserRequest, v1.DeactivateUserResponse]
+	reactivateUser   *connect.Client[v1.ReactivateUserRequest, v1.ReactivateUserResponse]
+	listMentions     *connect.Client[v1.ListMentionsRequest, v1.ListMentionsResponse]
+	markMentionsRead *connect.Client[v1.MarkMentionsReadRequest, v1.MarkMentionsReadResponse]
+	listSessions     *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	revokeSession    *connect.Client[v1.RevokeSessionRequest, v1.RevokeSessionResponse]
+	changePassword   *connect.Client[v1.ChangePasswordRequest, v1.ChangePasswordResponse]
 }
 
 // ListUsers calls secretary.v1.UsersService.ListUsers.
@@ -72,9 +202,88 @@ func (c *usersServiceClient) ListUsers(ctx context.Context, req *connect.Request
 	return c.listUsers.CallUnary(ctx, req)
 }
 
+// GetMe calls secretary.v1.UsersService.GetMe.
+func (c *usersServiceClient) GetMe(ctx context.Context, req *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error) {
+	return c.getMe.CallUnary(ctx, req)
+}
+
+// UpdateMe calls secretary.v1.UsersService.UpdateMe.
+func (c *usersServiceClient) UpdateMe(ctx context.Context, req *connect.Request[v1.UpdateMeRequest]) (*connect.Response[v1.UpdateMeResponse], error) {
+	return c.updateMe.CallUnary(ctx, req)
+}
+
+// VerifyEmail calls secretary.v1.UsersService.VerifyEmail.
+func (c *usersServiceClient) VerifyEmail(ctx context.Context, req *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return c.verifyEmail.CallUnary(ctx, req)
+}
+
+// DeactivateUser calls secretary.v1.UsersService.DeactivateUser.
+func (c *usersServiceClient) DeactivateUser(ctx context.Context, req *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error) {
+	return c.deactivateUser.CallUnary(ctx, req)
+}
+
+// ReactivateUser calls secretary.v1.UsersService.ReactivateUser.
+func (c *usersServiceClient) ReactivateUser(ctx context.Context, req *connect.Request[v1.ReactivateUserRequest]) (*connect.Response[v1.ReactivateUserResponse], error) {
+	return c.reactivateUser.CallUnary(ctx, req)
+}
+
+// ListMentions calls secretary.v1.UsersService.ListMentions.
+func (c *usersServiceClient) ListMentions(ctx context.Context, req *connect.Request[v1.ListMentionsRequest]) (*connect.Response[v1.ListMentionsResponse], error) {
+	return c.listMentions.CallUnary(ctx, req)
+}
+
+// MarkMentionsRead calls secretary.v1.UsersService.MarkMentionsRead.
+func (c *usersServiceClient) MarkMentionsRead(ctx context.Context, req *connect.Request[v1.MarkMentionsReadRequest]) (*connect.Response[v1.MarkMentionsReadResponse], error) {
+	return c.markMentionsRead.CallUnary(ctx, req)
+}
+
+// ListSessions calls secretary.v1.UsersService.ListSessions.
+func (c *usersServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// RevokeSession calls secretary.v1.UsersService.RevokeSession.
+func (c *usersServiceClient) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return c.revokeSession.CallUnary(ctx, req)
+}
+
+// ChangePassword calls secretary.v1.UsersService.ChangePassword.
+func (c *usersServiceClient) ChangePassword(ctx context.Context, req *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error) {
+	return c.changePassword.CallUnary(ctx, req)
+}
+
 // UsersServiceHandler is an implementation of the secretary.v1.UsersService service.
 type UsersServiceHandler interface {
 	ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error)
+	GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error)
+	UpdateMe(context.Context, *connect.Request[v1.UpdateMeRequest]) (*connect.Response[v1.UpdateMeResponse], error)
+	// Confirms a pending email change with the token from the verification
+	// link. The token must belong to the signed-in user.
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// Stops a user from signing in and refuses the tokens they hold. Users
+	// are deactivated rather than deleted, so todos and history keep naming
+	// them. With a successor, the same transaction hands their open todos
+	// over, noting the transfer in each todo's history. Admin only.
+	DeactivateUser(context.Context, *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error)
+	// Lets a deactivated user sign in again. Their old todos stay with the
+	// successor. Admin only.
+	ReactivateUser(context.Context, *connect.Request[v1.ReactivateUserRequest]) (*connect.Response[v1.ReactivateUserResponse], error)
+	// The signed-in user's inbox: transcript lines and todos naming them,
+	// most recently found first.
+	ListMentions(context.Context, *connect.Request[v1.ListMentionsRequest]) (*connect.Response[v1.ListMentionsResponse], error)
+	// Marks the signed-in user's mentions read, or unread again.
+	MarkMentionsRead(context.Context, *connect.Request[v1.MarkMentionsReadRequest]) (*connect.Response[v1.MarkMentionsReadResponse], error)
+	// The signed-in user's sessions: every sign-in whose token hasn't
+	// expired or been revoked, most recently used first.
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// Signs one of the user's sessions out, or every one but the current.
+	// Their tokens are refused from then on, by every server process.
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	// Changes the signed-in user's password and signs out their other
+	// sessions. The new password must meet the server's password policy;
+	// each rule it breaks is a field violation on new_password, with a
+	// reason such as PASSWORD_TOO_SHORT or PASSWORD_BREACHED.
+	ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error)
 }
 
 // NewUsersServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -90,10 +299,93 @@ func NewUsersServiceHandler(svc UsersServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(usersServiceMethods.ByName("ListUsers")),
 		connect.WithHandlerOptions(opts...),
 	)
+	usersServiceGetMeHandler := connect.NewUnaryHandler(
+		UsersServiceGetMeProcedure,
+		svc.GetMe,
+		connect.WithSchema(usersServiceMethods.ByName("GetMe")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceUpdateMeHandler := connect.NewUnaryHandler(
+		UsersServiceUpdateMeProcedure,
+		svc.UpdateMe,
+		connect.WithSchema(usersServiceMethods.ByName("UpdateMe")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceVerifyEmailHandler := connect.NewUnaryHandler(
+		UsersServiceVerifyEmailProcedure,
+		svc.VerifyEmail,
+		connect.WithSchema(usersServiceMethods.ByName("VerifyEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceDeactivateUserHandler := connect.NewUnaryHandler(
+		UsersServiceDeactivateUserProcedure,
+		svc.DeactivateUser,
+		connect.WithSchema(usersServiceMethods.ByName("DeactivateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceReactivateUserHandler := connect.NewUnaryHandler(
+		UsersServiceReactivateUserProcedure,
+		svc.ReactivateUser,
+		connect.WithSchema(usersServiceMethods.ByName("ReactivateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceListMentionsHandler := connect.NewUnaryHandler(
+		UsersServiceListMentionsProcedure,
+		svc.ListMentions,
+		connect.WithSchema(usersServiceMethods.ByName("ListMentions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceMarkMentionsReadHandler := connect.NewUnaryHandler(
+		UsersServiceMarkMentionsReadProcedure,
+		svc.MarkMentionsRead,
+		connect.WithSchema(usersServiceMethods.ByName("MarkMentionsRead")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceListSessionsHandler := connect.NewUnaryHandler(
+		UsersServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(usersServiceMethods.ByName("ListSessions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceRevokeSessionHandler := connect.NewUnaryHandler(
+		UsersServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(usersServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceChangePasswordHandler := connect.NewUnaryHandler(
+		UsersServiceChangePasswordProcedure,
+		svc.ChangePassword,
+		connect.WithSchema(usersServiceMethods.ByName("ChangePassword")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/secretary.v1.UsersService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UsersServiceListUsersProcedure:
 			usersServiceListUsersHandler.ServeHTTP(w, r)
+		case UsersServiceGetMeProcedure:
+			usersServiceGetMeHandler.ServeHTTP(w, r)
+		case UsersServiceUpdateMeProcedure:
+			usersServiceUpdateMeHandler.ServeHTTP(w, r)
+		case UsersServiceVerifyEmailProcedure:
+			usersServiceVerifyEmailHandler.ServeHTTP(w, r)
+		case UsersServiceDeactivateUserProcedure:
+			usersServiceDeactivateUserHandler.ServeHTTP(w, r)
+		case UsersServiceReactivateUserProcedure:
+			usersServiceReactivateUserHandler.ServeHTTP(w, r)
+		case UsersServiceListMentionsProcedure:
+			usersServiceListMentionsHandler.ServeHTTP(w, r)
+		case UsersServiceMarkMentionsReadProcedure:
+			usersServiceMarkMentionsReadHandler.ServeHTTP(w, r)
+		case UsersServiceListSessionsProcedure:
+			usersServiceListSessionsHandler.ServeHTTP(w, r)
+		case UsersServiceRevokeSessionProcedure:
+			usersServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case UsersServiceChangePasswordProcedure:
+			usersServiceChangePasswordHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -106,3 +398,43 @@ type UnimplementedUsersServiceHandler struct{}
 func (UnimplementedUsersServiceHandler) ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.UsersService.ListUsers is not implemented"))
 }
+
+func (UnimplementedUsersServiceHandler) GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.UsersService.GetMe is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) UpdateMe(context.Context, *connect.Request[v1.UpdateMeRequest]) (*connect.Response[v1.UpdateMeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.UsersService.UpdateMe is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.UsersService.VerifyEmail is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) DeactivateUser(context.Context, *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.UsersService.DeactivateUser is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) ReactivateUser(context.Context, *connect.Request[v1.ReactivateUserRequest]) (*connect.Response[v1.ReactivateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.UsersService.ReactivateUser is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) ListMentions(context.Context, *connect.Request[v1.ListMentionsRequest]) (*connect.Response[v1.ListMentionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.UsersService.ListMentions is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) MarkMentionsRead(context.Context, *connect.Request[v1.MarkMentionsReadRequest]) (*connect.Response[v1.MarkMentionsReadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.UsersService.MarkMentionsRead is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.UsersService.ListSessions is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.UsersService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("secretary.v1.UsersService.ChangePassword is not implemented"))
+}
@@ -0,0 +1,317 @@
+// Package apierr maps errors from the database, storage and upstream
+// providers onto Connect error codes with structured error details, so
+// clients can tell "not found", "conflict" and "try again later" apart
+// instead of receiving an opaque internal error.
+//
+// Handlers pass the underlying error together with the message they used
+// to return:
+//
+//	if err != nil {
+//		return nil, apierr.Wrap(err, "failed to create todo")
+//	}
+//
+// Unclassified errors still become CodeInternal with that message; the
+// cause is logged, never sent to the client.
+package apierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mvult/secretary/backend/internal/storage"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain identifies this service in ErrorInfo details.
+const Domain = "secretary"
+
+// Reasons reported in ErrorInfo details.
+const (
+	ReasonNotFound            = "NOT_FOUND"
+	ReasonUniqueViolation     = "UNIQUE_VIOLATION"
+	ReasonForeignKeyViolation = "FOREIGN_KEY_VIOLATION"
+	ReasonInvalidValue        = "INVALID_VALUE"
+	ReasonConflict            = "TRANSACTION_CONFLICT"
+	ReasonVersionConflict     = "VERSION_CONFLICT"
+	ReasonQuotaExceeded       = "QUOTA_EXCEEDED"
+	ReasonDatabaseUnavailable = "DATABASE_UNAVAILABLE"
+	ReasonProviderRateLimited = "PROVIDER_RATE_LIMITED"
+	ReasonProviderUnavailable = "PROVIDER_UNAVAILABLE"
+	ReasonProviderAuth        = "PROVIDER_AUTH_FAILED"
+	ReasonProviderRejected    = "PROVIDER_REJECTED_REQUEST"
+)
+
+// ProviderError is returned by clients of upstream APIs (LLM, speech to
+// text, webhooks) for non-2xx responses.
+type ProviderError struct {
+	Provider   string
+	StatusCode int
+	RetryAfter time.Duration
+	Message    string
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("%s request failed (%d): %s", e.Provider, e.StatusCode, e.Message)
+}
+
+// NewProviderError builds a ProviderError from an upstream response whose
+// body has already been read.
+func NewProviderError(provider string, resp *http.Response, body []byte) *ProviderError {
+	return &ProviderError{
+		Provider:   provider,
+		StatusCode: resp.StatusCode,
+		RetryAfter: ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		Message:    strings.TrimSpace(string(body)),
+	}
+}
+
+// ParseRetryAfter understands both forms of the Retry-After header.
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+// Wrap classifies err and returns a Connect error. message is the client
+// facing summary, e.g. "failed to list todos". Errors that are already
+// Connect errors pass through unchanged.
+func Wrap(err error, message string) error {
+	if err == nil {
+		return nil
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr
+	}
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows), errors.Is(err, storage.ErrNotFound):
+		return withInfo(connect.CodeNotFound, message+": not found", ReasonNotFound, nil)
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, errors.New(message+": request canceled"))
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, errors.New(message+": deadline exceeded"))
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if classified := fromPgError(pgErr, message); classified != nil {
+			return classified
+		}
+	}
+	var connectErrPg *pgconn.ConnectError
+	if errors.As(err, &connectErrPg) || pgconn.Timeout(err) {
+		return retryable(connect.CodeUnavailable, message+": database unavailable", ReasonDatabaseUnavailable, time.Second, nil)
+	}
+
+	var providerErr *ProviderError
+	if errors.As(err, &providerErr) {
+		return fromProviderError(providerErr, message)
+	}
+
+	log.Printf("%s: %v", message, err)
+	return connect.NewError(connect.CodeInternal, errors.New(message))
+}
+
+// InvalidField returns CodeInvalidArgument with a BadRequest detail naming
+// the offending field, matching what request validation produces.
+func InvalidField(field string, description string) error {
+	connectErr := connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s: %s", field, description))
+	addDetail(connectErr, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	})
+	return connectErr
+}
+
+// FieldViolation is one reason a field's value was refused. Reason is a
+// stable UPPER_SNAKE_CASE code clients can match on.
+type FieldViolation struct {
+	Field       string
+	Reason      string
+	Description string
+}
+
+// InvalidFields returns CodeInvalidArgument with a BadRequest detail
+// listing every violation, so a form can show them all at once. The
+// message joins their descriptions.
+func InvalidFields(violations ...FieldViolation) error {
+	detail := &errdetails.BadRequest{}
+	messages := make([]string, 0, len(violations))
+	for _, v := range violations {
+		detail.FieldViolations = append(detail.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Reason:      v.Reason,
+			Description: v.Description,
+		})
+		messages = append(messages, fmt.Sprintf("%s: %s", v.Field, v.Description))
+	}
+	connectErr := connect.NewError(connect.CodeInvalidArgument, errors.New(strings.Join(messages, "; ")))
+	addDetail(connectErr, detail)
+	return connectErr
+}
+
+// StaleVersion returns CodeFailedPrecondition for a write that was based on
+// an out-of-date copy of a record. The ErrorInfo detail carries the current
+// version under "current_version" so clients can refetch and retry.
+func StaleVersion(message string, current int64) error {
+	return withInfo(connect.CodeFailedPrecondition, message, ReasonVersionConflict, map[string]string{
+		"current_version": strconv.FormatInt(current, 10),
+	})
+}
+
+// QuotaExceeded returns CodeResourceExhausted for work that would go over a
+// usage quota. The ErrorInfo detail names the metric, the scope
+// ("organization" or "user"), the limit and the amount already used.
+func QuotaExceeded(message string, metric string, scope string, limit int64, used int64) error {
+	return withInfo(connect.CodeResourceExhausted, message, ReasonQuotaExceeded, map[string]string{
+		"metric": metric,
+		"scope":  scope,
+		"limit":  strconv.FormatInt(limit, 10),
+		"used":   strconv.FormatInt(used, 10),
+	})
+}
+
+// CurrentVersion extracts the version reported by StaleVersion, if any.
+func CurrentVersion(err error) (int64, bool) {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return 0, false
+	}
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		if err != nil {
+			continue
+		}
+		info, ok := value.(*errdetails.ErrorInfo)
+		if !ok || info.GetReason() != ReasonVersionConflict {
+			continue
+		}
+		version, err := strconv.ParseInt(info.GetMetadata()["current_version"], 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		return version, true
+	}
+	return 0, false
+}
+
+// RetryAfter extracts the retry delay a server attached to err, if any.
+func RetryAfter(err error) (time.Duration, bool) {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return 0, false
+	}
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		if err != nil {
+			continue
+		}
+		if info, ok := value.(*errdetails.RetryInfo); ok && info.GetRetryDelay() != nil {
+			return info.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+func fromPgError(pgErr *pgconn.PgError, message string) error {
+	metadata := map[string]string{}
+	if pgErr.TableName != "" {
+		metadata["table"] = pgErr.TableName
+	}
+	if pgErr.ConstraintName != "" {
+		metadata["constraint"] = pgErr.ConstraintName
+	}
+
+	switch {
+	case pgErr.Code == "23505":
+		return withInfo(connect.CodeAlreadyExists, message+": already exists", ReasonUniqueViolation, metadata)
+	case pgErr.Code == "23503":
+		return withInfo(connect.CodeFailedPrecondition, message+": referenced record is missing or still in use", ReasonForeignKeyViolation, metadata)
+	case pgErr.Code == "23502" || pgErr.Code == "23514" || strings.HasPrefix(pgErr.Code, "22"):
+		field := pgErr.ColumnName
+		if field == "" {
+			field = pgErr.ConstraintName
+		}
+		connectErr := withInfo(connect.CodeInvalidArgument, message+": invalid value", ReasonInvalidValue, metadata)
+		if field != "" {
+			addDetail(connectErr, &errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: "value rejected by the database"}},
+			})
+		}
+		return connectErr
+	case pgErr.Code == "40001" || pgErr.Code == "40P01":
+		return retryable(connect.CodeAborted, message+": concurrent update, retry", ReasonConflict, 100*time.Millisecond, metadata)
+	case pgErr.Code == "57014":
+		return connect.NewError(connect.CodeDeadlineExceeded, errors.New(message+": query canceled"))
+	case strings.HasPrefix(pgErr.Code, "08") || strings.HasPrefix(pgErr.Code, "53") || strings.HasPrefix(pgErr.Code, "57P"):
+		return retryable(connect.CodeUnavailable, message+": database unavailable", ReasonDatabaseUnavailable, time.Second, metadata)
+	}
+	return nil
+}
+
+func fromProviderError(err *ProviderError, message string) error {
+	metadata := map[string]string{
+		"provider":    err.Provider,
+		"status_code": strconv.Itoa(err.StatusCode),
+	}
+	summary := fmt.Sprintf("%s: %s returned %d", message, err.Provider, err.StatusCode)
+	switch {
+	case err.StatusCode == http.StatusTooManyRequests:
+		delay := err.RetryAfter
+		if delay <= 0 {
+			delay = 5 * time.Second
+		}
+		return retryable(connect.CodeResourceExhausted, summary, ReasonProviderRateLimited, delay, metadata)
+	case err.StatusCode >= 500:
+		delay := err.RetryAfter
+		if delay <= 0 {
+			delay = 2 * time.Second
+		}
+		return retryable(connect.CodeUnavailable, summary, ReasonProviderUnavailable, delay, metadata)
+	case err.StatusCode == http.StatusUnauthorized || err.StatusCode == http.StatusForbidden:
+		log.Printf("%s: %v", message, err)
+		return withInfo(connect.CodeFailedPrecondition, summary+" (check the provider API key)", ReasonProviderAuth, metadata)
+	default:
+		log.Printf("%s: %v", message, err)
+		return withInfo(connect.CodeFailedPrecondition, summary, ReasonProviderRejected, metadata)
+	}
+}
+
+func withInfo(code connect.Code, message string, reason string, metadata map[string]string) *connect.Error {
+	connectErr := connect.NewError(code, errors.New(message))
+	addDetail(connectErr, &errdetails.ErrorInfo{Reason: reason, Domain: Domain, Metadata: metadata})
+	return connectErr
+}
+
+func retryable(code connect.Code, message string, reason string, delay time.Duration, metadata map[string]string) *connect.Error {
+	connectErr := withInfo(code, message, reason, metadata)
+	addDetail(connectErr, &errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	return connectErr
+}
+
+func addDetail(connectErr *connect.Error, message proto.Message) {
+	detail, err := connect.NewErrorDetail(message)
+	if err != nil {
+		return
+	}
+	connectErr.AddDetail(detail)
+}
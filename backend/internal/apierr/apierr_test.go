@@ -0,0 +1,130 @@
+package apierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func TestWrapClassifiesErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		code connect.Code
+	}{
+		{"no rows", fmt.Errorf("load: %w", pgx.ErrNoRows), connect.CodeNotFound},
+		{"unique", &pgconn.PgError{Code: "23505", ConstraintName: "user_email_key"}, connect.CodeAlreadyExists},
+		{"foreign key", &pgconn.PgError{Code: "23503"}, connect.CodeFailedPrecondition},
+		{"check", &pgconn.PgError{Code: "23514", ConstraintName: "todo_source_kind_check"}, connect.CodeInvalidArgument},
+		{"serialization", &pgconn.PgError{Code: "40001"}, connect.CodeAborted},
+		{"deadline", context.DeadlineExceeded, connect.CodeDeadlineExceeded},
+		{"rate limited", &ProviderError{Provider: "openai", StatusCode: http.StatusTooManyRequests, RetryAfter: 7 * time.Second}, connect.CodeResourceExhausted},
+		{"provider down", &ProviderError{Provider: "openai", StatusCode: http.StatusBadGateway}, connect.CodeUnavailable},
+		{"provider key", &ProviderError{Provider: "openai", StatusCode: http.StatusUnauthorized}, connect.CodeFailedPrecondition},
+		{"unknown", errors.New("boom"), connect.CodeInternal},
+	}
+	for _, tc := range cases {
+		err := Wrap(tc.err, "failed to do thing")
+		if connect.CodeOf(err) != tc.code {
+			t.Fatalf("%s: expected %v, got %v (%v)", tc.name, tc.code, connect.CodeOf(err), err)
+		}
+	}
+}
+
+func TestWrapKeepsInternalCausePrivate(t *testing.T) {
+	err := Wrap(errors.New("password=hunter2"), "failed to list users")
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Message() != "failed to list users" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestWrapPassesConnectErrorsThrough(t *testing.T) {
+	original := connect.NewError(connect.CodePermissionDenied, errors.New("nope"))
+	if err := Wrap(fmt.Errorf("ctx: %w", original), "failed to update"); err != original {
+		t.Fatalf("expected original connect error, got %v", err)
+	}
+}
+
+func TestRetryDetails(t *testing.T) {
+	err := Wrap(&ProviderError{Provider: "openai", StatusCode: http.StatusTooManyRequests, RetryAfter: 7 * time.Second}, "ai run failed")
+	delay, ok := RetryAfter(err)
+	if !ok || delay != 7*time.Second {
+		t.Fatalf("expected 7s retry delay, got %v %v", delay, ok)
+	}
+
+	var connectErr *connect.Error
+	errors.As(Wrap(&pgconn.PgError{Code: "23505", ConstraintName: "user_email_key"}, "failed to create user"), &connectErr)
+	var info *errdetails.ErrorInfo
+	for _, detail := range connectErr.Details() {
+		if value, err := detail.Value(); err == nil {
+			if v, ok := value.(*errdetails.ErrorInfo); ok {
+				info = v
+			}
+		}
+	}
+	if info == nil || info.Reason != ReasonUniqueViolation || info.Metadata["constraint"] != "user_email_key" {
+		t.Fatalf("expected unique violation info, got %v", info)
+	}
+}
+
+func TestStaleVersion(t *testing.T) {
+	err := StaleVersion("todo was modified", 4)
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected failed precondition, got %v", err)
+	}
+	if version, ok := CurrentVersion(fmt.Errorf("update: %w", err)); !ok || version != 4 {
+		t.Fatalf("expected current version 4, got %d %v", version, ok)
+	}
+	if _, ok := CurrentVersion(Wrap(&pgconn.PgError{Code: "40001"}, "failed to update")); ok {
+		t.Fatal("expected no version on unrelated error")
+	}
+}
+
+func TestInvalidFields(t *testing.T) {
+	err := InvalidFields(
+		FieldViolation{Field: "new_password", Reason: "PASSWORD_TOO_SHORT", Description: "must be at least 12 characters"},
+		FieldViolation{Field: "new_password", Reason: "PASSWORD_CONTAINS_EMAIL", Description: "must not contain your email address"},
+	)
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+	if want := "new_password: must be at least 12 characters; new_password: must not contain your email address"; connectErr.Message() != want {
+		t.Fatalf("message = %q", connectErr.Message())
+	}
+	var reasons []string
+	for _, detail := range connectErr.Details() {
+		if value, err := detail.Value(); err == nil {
+			if v, ok := value.(*errdetails.BadRequest); ok {
+				for _, violation := range v.FieldViolations {
+					reasons = append(reasons, violation.Field+" "+violation.Reason)
+				}
+			}
+		}
+	}
+	if fmt.Sprint(reasons) != "[new_password PASSWORD_TOO_SHORT new_password PASSWORD_CONTAINS_EMAIL]" {
+		t.Fatalf("violations = %v", reasons)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	if got := ParseRetryAfter("12", now); got != 12*time.Second {
+		t.Fatalf("expected 12s, got %v", got)
+	}
+	if got := ParseRetryAfter(now.Add(time.Minute).Format(http.TimeFormat), now); got != time.Minute {
+		t.Fatalf("expected 1m, got %v", got)
+	}
+	if got := ParseRetryAfter("soon", now); got != 0 {
+		t.Fatalf("expected 0, got %v", got)
+	}
+}
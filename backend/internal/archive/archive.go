@@ -0,0 +1,166 @@
+// Package archive moves a whole Secretary instance between servers. An
+// archive is a gzipped tarball holding a manifest, every table as JSON
+// lines and the stored files the rows point at:
+//
+//	manifest.json
+//	tables/<table>.jsonl
+//	media/<storage key>
+//
+// Tables are read from the database catalog rather than a fixed list, so
+// columns added by later migrations travel without changes here. An
+// archive imports into a server on the same schema or a newer one: columns
+// it lacks take their defaults, and columns the server doesn't know are an
+// error.
+//
+// Rows and files are copied as they are stored. Transcripts and audio
+// encrypted at rest stay encrypted, and the server importing them needs
+// the master key that wraps the archive's data keys.
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Format identifies Secretary archives in their manifest.
+const Format = "secretary-archive"
+
+// Version is raised when the layout changes in a way older servers can't
+// read.
+const Version = 1
+
+const (
+	manifestName = "manifest.json"
+	tablesDir    = "tables/"
+	mediaDir     = "media/"
+)
+
+// Manifest is the first entry of an archive and describes the rest.
+type Manifest struct {
+	Format    string    `json:"format"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Tables are in the order they are restored, parents first.
+	Tables []Table `json:"tables"`
+	// Media lists the storage keys the rows point at. Files that were
+	// already missing from storage have no entry in the archive.
+	Media []string `json:"media"`
+}
+
+// Table describes one table's entry.
+type Table struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Rows    int64    `json:"rows"`
+}
+
+// transientTables hold uploads in progress. They can't be resumed on
+// another server, so they aren't exported and are left empty on import.
+var transientTables = map[string]bool{
+	"pending_upload":        true,
+	"resumable_upload":      true,
+	"resumable_upload_part": true,
+}
+
+// keptTables belong to this server alone. The audit log is append-only and
+// its hash chain can't be replaced by another server's, so it is neither
+// exported nor cleared on import; the import itself is audited on it.
+var keptTables = map[string]bool{
+	"audit_log": true,
+}
+
+// mediaColumn is a column holding storage keys.
+type mediaColumn struct {
+	table, column string
+}
+
+// mediaColumns are the columns naming stored files: avatars, recording
+// and clip audio, attachments and the organization's logo.
+var mediaColumns = []mediaColumn{
+	{"user", "avatar_key"},
+	{"recording", "audio_key"},
+	{"recording_clip", "audio_key"},
+	{"attachment", "storage_key"},
+	{"org_setting", "logo_key"},
+}
+
+// sortTables orders tables so every table comes after the ones its foreign
+// keys reference, given each table's references in deps. References to
+// the table itself don't count. Ties are broken by name so archives of the
+// same data come out the same.
+func sortTables(tables []string, deps map[string][]string) ([]string, error) {
+	remaining := map[string]int{}
+	dependents := map[string][]string{}
+	for _, table := range tables {
+		remaining[table] = 0
+	}
+	for _, table := range tables {
+		for _, parent := range deps[table] {
+			if _, ok := remaining[parent]; !ok || parent == table || slices.Contains(dependents[parent], table) {
+				continue
+			}
+			remaining[table]++
+			dependents[parent] = append(dependents[parent], table)
+		}
+	}
+
+	var ready, sorted []string
+	for _, table := range tables {
+		if remaining[table] == 0 {
+			ready = append(ready, table)
+		}
+	}
+	for len(ready) > 0 {
+		slices.Sort(ready)
+		table := ready[0]
+		ready = ready[1:]
+		sorted = append(sorted, table)
+		for _, child := range dependents[table] {
+			remaining[child]--
+			if remaining[child] == 0 {
+				ready = append(ready, child)
+			}
+		}
+	}
+	if len(sorted) < len(tables) {
+		var cycle []string
+		for _, table := range tables {
+			if remaining[table] > 0 {
+				cycle = append(cycle, table)
+			}
+		}
+		slices.Sort(cycle)
+		return nil, fmt.Errorf("archive: foreign keys between %s form a cycle; make one of them deferrable", strings.Join(cycle, ", "))
+	}
+	return sorted, nil
+}
+
+// checkManifest rejects archives this version can't read, or whose tables
+// don't fit the schema in columns, the importing server's tables and their
+// columns.
+func checkManifest(m *Manifest, columns map[string][]string) error {
+	if m.Format != Format {
+		return errors.New("archive: not a Secretary archive")
+	}
+	if m.Version < 1 || m.Version > Version {
+		return fmt.Errorf("archive: version %d is not supported; this server reads up to version %d", m.Version, Version)
+	}
+	for _, table := range m.Tables {
+		if keptTables[table.Name] {
+			return fmt.Errorf("archive: table %s can't be imported", table.Name)
+		}
+		known, ok := columns[table.Name]
+		if !ok || transientTables[table.Name] {
+			return fmt.Errorf("archive: table %s does not exist on this server; upgrade it first", table.Name)
+		}
+		for _, column := range table.Columns {
+			if !slices.Contains(known, column) {
+				return fmt.Errorf("archive: column %s.%s does not exist on this server; upgrade it first", table.Name, column)
+			}
+		}
+	}
+	return nil
+}
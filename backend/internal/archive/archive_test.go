@@ -0,0 +1,194 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/mvult/secretary/backend/internal/storage"
+	"github.com/mvult/secretary/backend/internal/testdb"
+)
+
+func TestSortTablesPutsParentsFirst(t *testing.T) {
+	deps := map[string][]string{
+		"recording": {"user", "recording"},
+		"todo":      {"user", "recording"},
+		"favorite":  {"user", "recording", "todo"},
+		"user":      {"missing"},
+	}
+	got, err := sortTables([]string{"todo", "favorite", "user", "recording", "workspace"}, deps)
+	if err != nil {
+		t.Fatalf("sort: %v", err)
+	}
+	// Ties go by name, so workspace, ready from the start, sorts after
+	// each table that becomes ready later.
+	want := []string{"user", "recording", "todo", "favorite", "workspace"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestSortTablesReportsCycles(t *testing.T) {
+	deps := map[string][]string{
+		"ai_message": {"ai_run", "ai_thread"},
+		"ai_run":     {"ai_message"},
+	}
+	_, err := sortTables([]string{"ai_thread", "ai_message", "ai_run"}, deps)
+	if err == nil || !strings.Contains(err.Error(), "ai_message, ai_run") {
+		t.Fatalf("expected a cycle between ai_message and ai_run, got %v", err)
+	}
+}
+
+func TestCheckManifest(t *testing.T) {
+	columns := map[string][]string{
+		"user":           {"id", "first_name", "timezone"},
+		"pending_upload": {"id"},
+		"audit_log":      {"id"},
+	}
+	valid := Manifest{Format: Format, Version: Version, Tables: []Table{{Name: "user", Columns: []string{"id", "first_name"}}}}
+	if err := checkManifest(&valid, columns); err != nil {
+		t.Fatalf("an older archive missing columns should import: %v", err)
+	}
+
+	for name, m := range map[string]Manifest{
+		"format":  {Format: "zip", Version: Version},
+		"version": {Format: Format, Version: Version + 1},
+		"table":   {Format: Format, Version: Version, Tables: []Table{{Name: "gadget"}}},
+		"column":  {Format: Format, Version: Version, Tables: []Table{{Name: "user", Columns: []string{"shoe_size"}}}},
+		"upload":  {Format: Format, Version: Version, Tables: []Table{{Name: "pending_upload", Columns: []string{"id"}}}},
+		"audit":   {Format: Format, Version: Version, Tables: []Table{{Name: "audit_log", Columns: []string{"id"}}}},
+	} {
+		if err := checkManifest(&m, columns); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestImportWantsManifestFirst(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	if err := writeEntry(tw, tablesDir+"user.jsonl", 2, time.Now(), strings.NewReader("{}")); err != nil {
+		t.Fatal(err)
+	}
+	tw.Close()
+	gz.Close()
+
+	// The manifest is checked before the database is touched.
+	_, err := Import(context.Background(), nil, nil, &buf)
+	if err == nil || !strings.Contains(err.Error(), "manifest.json must come first") {
+		t.Fatalf("expected the manifest to be required first, got %v", err)
+	}
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	source := testdb.New(t)
+	target := testdb.New(t)
+	sourceFiles, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	targetFiles, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var userID, parentID, childID int32
+	if err := source.QueryRow(ctx, `INSERT INTO "user" (first_name, avatar_key) VALUES ('Ada', 'avatars/ada.png') RETURNING id`).Scan(&userID); err != nil {
+		t.Fatal(err)
+	}
+	if err := source.QueryRow(ctx, `INSERT INTO recording (name, audio_key, created_by_user_id) VALUES ('Standup', 'recordings/standup.wav', $1) RETURNING id`, userID).Scan(&parentID); err != nil {
+		t.Fatal(err)
+	}
+	if err := source.QueryRow(ctx, `INSERT INTO recording (name, audio_key, cloned_from_recording_id) VALUES ('Standup (es)', 'recordings/missing.wav', $1) RETURNING id`, parentID).Scan(&childID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sourceFiles.Put(ctx, "avatars/ada.png", strings.NewReader("png")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sourceFiles.Put(ctx, "recordings/standup.wav", strings.NewReader("RIFF")); err != nil {
+		t.Fatal(err)
+	}
+	// The target's own rows are replaced, except its audit log.
+	if _, err := target.Exec(ctx, `INSERT INTO "user" (first_name) VALUES ('Grace'), ('Linus')`); err != nil {
+		t.Fatal(err)
+	}
+	const auditEntry = `INSERT INTO audit_log (created_at, action, detail, request_id, key_id, prev_hash, hash) VALUES (now(), $1, '{}', '', '', '', '')`
+	if _, err := source.Exec(ctx, auditEntry, "source"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := target.Exec(ctx, auditEntry, "target"); err != nil {
+		t.Fatal(err)
+	}
+
+	var archive bytes.Buffer
+	tx, err := source.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exported, err := Export(ctx, tx, sourceFiles, &archive, time.Now())
+	tx.Rollback(ctx)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	for _, table := range exported.Tables {
+		if table.Name == "audit_log" {
+			t.Fatal("the audit log was exported")
+		}
+	}
+	if !slices.Equal(exported.Media, []string{"avatars/ada.png", "recordings/missing.wav", "recordings/standup.wav"}) {
+		t.Fatalf("media = %v", exported.Media)
+	}
+
+	tx, err = target.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Import(ctx, tx, targetFiles, &archive); err != nil {
+		tx.Rollback(ctx)
+		t.Fatalf("import: %v", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+
+	rows, _ := target.Query(ctx, `SELECT first_name FROM "user" ORDER BY id`)
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil || !slices.Equal(names, []string{"Ada"}) {
+		t.Fatalf("users = %v, %v", names, err)
+	}
+	rows, _ = target.Query(ctx, `SELECT action FROM audit_log ORDER BY id`)
+	actions, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil || !slices.Equal(actions, []string{"target"}) {
+		t.Fatalf("audit log = %v, %v", actions, err)
+	}
+	if _, err := target.Exec(ctx, `TRUNCATE audit_log`); err == nil || !strings.Contains(err.Error(), "append-only") {
+		t.Fatalf("expected truncating the audit log to fail, got %v", err)
+	}
+	var clonedFrom int32
+	if err := target.QueryRow(ctx, `SELECT cloned_from_recording_id FROM recording WHERE id = $1`, childID).Scan(&clonedFrom); err != nil || clonedFrom != parentID {
+		t.Fatalf("cloned_from = %d, %v", clonedFrom, err)
+	}
+	var nextID int32
+	if err := target.QueryRow(ctx, `INSERT INTO "user" (first_name) VALUES ('Next') RETURNING id`).Scan(&nextID); err != nil || nextID != userID+1 {
+		t.Fatalf("next user id = %d, %v; want %d", nextID, err, userID+1)
+	}
+	body, err := targetFiles.Open(ctx, "recordings/standup.wav")
+	if err != nil {
+		t.Fatalf("open imported audio: %v", err)
+	}
+	data, _ := io.ReadAll(body)
+	body.Close()
+	if string(data) != "RIFF" {
+		t.Fatalf("imported audio = %q", data)
+	}
+}
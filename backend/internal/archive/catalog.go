@@ -0,0 +1,98 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// schema is where the application's tables live.
+const schema = "public"
+
+// catalog is what the archive needs to know about the database's tables.
+type catalog struct {
+	// columns are each table's stored columns in definition order.
+	// Generated columns are computed again on insert, so they are left out.
+	columns map[string][]string
+	// deps are the tables each table's foreign keys reference. Deferrable
+	// keys are left out: imports defer them to the end of the transaction.
+	deps map[string][]string
+	// identities are each table's identity columns, whose sequences are
+	// moved past the imported rows.
+	identities map[string][]string
+}
+
+func loadCatalog(ctx context.Context, q db.DBTX) (*catalog, error) {
+	c := &catalog{columns: map[string][]string{}, deps: map[string][]string{}, identities: map[string][]string{}}
+
+	rows, err := q.Query(ctx, `
+SELECT c.relname, a.attname, a.attidentity <> ''
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+JOIN pg_attribute a ON a.attrelid = c.oid
+WHERE n.nspname = $1 AND c.relkind = 'r'
+  AND a.attnum > 0 AND NOT a.attisdropped AND a.attgenerated = ''
+ORDER BY c.relname, a.attnum`, schema)
+	if err != nil {
+		return nil, fmt.Errorf("archive: list columns: %w", err)
+	}
+	var table, column string
+	var identity bool
+	_, err = pgx.ForEachRow(rows, []any{&table, &column, &identity}, func() error {
+		c.columns[table] = append(c.columns[table], column)
+		if identity {
+			c.identities[table] = append(c.identities[table], column)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("archive: list columns: %w", err)
+	}
+
+	rows, err = q.Query(ctx, `
+SELECT child.relname, parent.relname
+FROM pg_constraint con
+JOIN pg_class child ON child.oid = con.conrelid
+JOIN pg_class parent ON parent.oid = con.confrelid
+JOIN pg_namespace n ON n.oid = child.relnamespace
+WHERE n.nspname = $1 AND con.contype = 'f' AND NOT con.condeferrable`, schema)
+	if err != nil {
+		return nil, fmt.Errorf("archive: list foreign keys: %w", err)
+	}
+	var child, parent string
+	_, err = pgx.ForEachRow(rows, []any{&child, &parent}, func() error {
+		c.deps[child] = append(c.deps[child], parent)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("archive: list foreign keys: %w", err)
+	}
+	return c, nil
+}
+
+// tables returns the tables to archive, parents first.
+func (c *catalog) tables() ([]string, error) {
+	var names []string
+	for name := range c.columns {
+		if !transientTables[name] && !keptTables[name] {
+			names = append(names, name)
+		}
+	}
+	return sortTables(names, c.deps)
+}
+
+func quoteTable(name string) string {
+	return pgx.Identifier{schema, name}.Sanitize()
+}
+
+func quoteColumns(columns []string) string {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = pgx.Identifier{column}.Sanitize()
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,196 @@
+package archive
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// Export writes an archive of every table, and of the files their rows
+// point at, to w. Run it in a read-only repeatable read transaction so the
+// tables are a consistent snapshot. Files are copied from store as they
+// are, so pass the store below any encryption (see storage.Unwrapped).
+//
+// Tables, then each file in turn, are staged in temporary files: tar
+// headers need sizes up front.
+func Export(ctx context.Context, q db.DBTX, store storage.Store, w io.Writer, now time.Time) (*Manifest, error) {
+	cat, err := loadCatalog(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := cat.tables()
+	if err != nil {
+		return nil, err
+	}
+	dir, err := os.MkdirTemp("", "secretary-archive-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	m := &Manifest{Format: Format, Version: Version, CreatedAt: now.UTC()}
+	for _, name := range tables {
+		rows, err := spoolTable(ctx, q, name, cat.columns[name], filepath.Join(dir, name+".jsonl"))
+		if err != nil {
+			return nil, err
+		}
+		m.Tables = append(m.Tables, Table{Name: name, Columns: cat.columns[name], Rows: rows})
+	}
+	if m.Media, err = mediaKeys(ctx, q, cat); err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeEntry(tw, manifestName, int64(len(manifest)), now, bytes.NewReader(manifest)); err != nil {
+		return nil, err
+	}
+	for _, table := range m.Tables {
+		if err := writeFile(tw, tablesDir+table.Name+".jsonl", filepath.Join(dir, table.Name+".jsonl"), now); err != nil {
+			return nil, err
+		}
+	}
+	staged := filepath.Join(dir, "media")
+	for _, key := range m.Media {
+		err := stageObject(ctx, store, key, staged)
+		if errors.Is(err, storage.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := writeFile(tw, mediaDir+key, staged, now); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// spoolTable writes each row of table as a JSON object on its own line
+// and returns how many there were.
+func spoolTable(ctx context.Context, q db.DBTX, table string, columns []string, name string) (int64, error) {
+	f, err := os.Create(name)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	out := bufio.NewWriter(f)
+
+	rows, err := q.Query(ctx, fmt.Sprintf("SELECT to_json(r)::text FROM (SELECT %s FROM %s) r", quoteColumns(columns), quoteTable(table)))
+	if err != nil {
+		return 0, fmt.Errorf("archive: read %s: %w", table, err)
+	}
+	var line string
+	tag, err := pgx.ForEachRow(rows, []any{&line}, func() error {
+		if _, err := out.WriteString(line); err != nil {
+			return err
+		}
+		return out.WriteByte('\n')
+	})
+	if err != nil {
+		return 0, fmt.Errorf("archive: read %s: %w", table, err)
+	}
+	if err := out.Flush(); err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), f.Close()
+}
+
+// mediaKeys returns the storage keys in the media columns, sorted.
+func mediaKeys(ctx context.Context, q db.DBTX, cat *catalog) ([]string, error) {
+	seen := map[string]bool{}
+	for _, mc := range mediaColumns {
+		if !slices.Contains(cat.columns[mc.table], mc.column) {
+			continue
+		}
+		column := quoteColumns([]string{mc.column})
+		rows, err := q.Query(ctx, fmt.Sprintf("SELECT DISTINCT %s FROM %s WHERE %s <> ''", column, quoteTable(mc.table), column))
+		if err != nil {
+			return nil, fmt.Errorf("archive: list %s.%s: %w", mc.table, mc.column, err)
+		}
+		var key string
+		if _, err := pgx.ForEachRow(rows, []any{&key}, func() error {
+			seen[key] = true
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("archive: list %s.%s: %w", mc.table, mc.column, err)
+		}
+	}
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+// stageObject copies the stored object to the file name.
+func stageObject(ctx context.Context, store storage.Store, key, name string) error {
+	body, err := store.Open(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, body); err != nil {
+		return fmt.Errorf("archive: read %s: %w", key, err)
+	}
+	return f.Close()
+}
+
+func writeFile(tw *tar.Writer, entry, name string, now time.Time) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	return writeEntry(tw, entry, info.Size(), now, f)
+}
+
+func writeEntry(tw *tar.Writer, name string, size int64, now time.Time, r io.Reader) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0o644,
+		ModTime:  now,
+		Format:   tar.FormatPAX,
+	}); err != nil {
+		return err
+	}
+	_, err := io.Copy(tw, r)
+	return err
+}
@@ -0,0 +1,192 @@
+package archive
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+const (
+	// maxManifestBytes bounds the manifest, which lists every stored file.
+	maxManifestBytes = 64 << 20
+	// Rows are inserted in batches of at most importBatchRows rows or
+	// importBatchBytes of JSON.
+	importBatchRows  = 500
+	importBatchBytes = 8 << 20
+)
+
+// Import replaces every table with the rows of the archive read from r
+// and puts its files into store, below any encryption like in Export.
+// Tables the archive doesn't include are left empty.
+//
+// q must be a transaction: Import defers the deferrable foreign keys to
+// its end. The caller commits once Import succeeds and rolls back
+// otherwise; files already put stay in store either way.
+func Import(ctx context.Context, q db.DBTX, store storage.Store, r io.Reader) (*Manifest, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("archive: %w", err)
+	}
+	tr := tar.NewReader(gz)
+	header, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("archive: %w", err)
+	}
+	if header.Name != manifestName {
+		return nil, fmt.Errorf("archive: %s must come first, found %s", manifestName, header.Name)
+	}
+	var m Manifest
+	if err := json.NewDecoder(io.LimitReader(tr, maxManifestBytes)).Decode(&m); err != nil {
+		return nil, fmt.Errorf("archive: read manifest: %w", err)
+	}
+
+	cat, err := loadCatalog(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkManifest(&m, cat.columns); err != nil {
+		return nil, err
+	}
+	if _, err := q.Exec(ctx, "SET CONSTRAINTS ALL DEFERRED"); err != nil {
+		return nil, fmt.Errorf("archive: %w", err)
+	}
+	var quoted []string
+	for _, table := range slices.Sorted(maps.Keys(cat.columns)) {
+		if !keptTables[table] {
+			quoted = append(quoted, quoteTable(table))
+		}
+	}
+	if _, err := q.Exec(ctx, "TRUNCATE "+strings.Join(quoted, ", ")+" RESTART IDENTITY CASCADE"); err != nil {
+		return nil, fmt.Errorf("archive: clear tables: %w", err)
+	}
+
+	tables := map[string]Table{}
+	for _, table := range m.Tables {
+		tables[table.Name] = table
+	}
+	media := map[string]bool{}
+	for _, key := range m.Media {
+		media[key] = true
+	}
+	restored := map[string]bool{}
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			return nil, fmt.Errorf("archive: %s is not a regular file", header.Name)
+		}
+		switch {
+		case strings.HasPrefix(header.Name, tablesDir):
+			name := strings.TrimSuffix(strings.TrimPrefix(header.Name, tablesDir), ".jsonl")
+			table, ok := tables[name]
+			if !ok || restored[name] {
+				return nil, fmt.Errorf("archive: unexpected entry %s", header.Name)
+			}
+			rows, err := restoreTable(ctx, q, table, tr)
+			if err != nil {
+				return nil, err
+			}
+			if rows != table.Rows {
+				return nil, fmt.Errorf("archive: %s has %d rows, the manifest says %d", name, rows, table.Rows)
+			}
+			restored[name] = true
+		case strings.HasPrefix(header.Name, mediaDir):
+			key := strings.TrimPrefix(header.Name, mediaDir)
+			if cleaned, err := storage.CleanKey(key); err != nil || cleaned != key || !media[key] {
+				return nil, fmt.Errorf("archive: unexpected entry %s", header.Name)
+			}
+			if _, err := store.Put(ctx, key, tr); err != nil {
+				return nil, fmt.Errorf("archive: store %s: %w", key, err)
+			}
+		default:
+			return nil, fmt.Errorf("archive: unexpected entry %s", header.Name)
+		}
+	}
+	for _, table := range m.Tables {
+		if !restored[table.Name] {
+			return nil, fmt.Errorf("archive: %s is in the manifest but not the archive", table.Name)
+		}
+	}
+
+	for _, table := range slices.Sorted(maps.Keys(cat.identities)) {
+		for _, column := range cat.identities[table] {
+			// The next ID follows the highest imported one.
+			sql := fmt.Sprintf("SELECT setval(pg_get_serial_sequence($1, $2), coalesce(max(%s), 0) + 1, false) FROM %s", quoteColumns([]string{column}), quoteTable(table))
+			if _, err := q.Exec(ctx, sql, quoteTable(table), column); err != nil {
+				return nil, fmt.Errorf("archive: reset %s.%s: %w", table, column, err)
+			}
+		}
+	}
+	return &m, nil
+}
+
+// restoreTable inserts the JSON lines read from r into table and returns
+// how many rows there were.
+func restoreTable(ctx context.Context, q db.DBTX, table Table, r io.Reader) (int64, error) {
+	columns := quoteColumns(table.Columns)
+	// Identity values are kept so the rows that reference them still match.
+	sql := fmt.Sprintf("INSERT INTO %s (%s) OVERRIDING SYSTEM VALUE SELECT %s FROM json_populate_recordset(NULL::%s, $1::json)", quoteTable(table.Name), columns, columns, quoteTable(table.Name))
+
+	var batch bytes.Buffer
+	var rows int64
+	pending := 0
+	flush := func() error {
+		if pending == 0 {
+			return nil
+		}
+		batch.WriteByte(']')
+		if _, err := q.Exec(ctx, sql, batch.String()); err != nil {
+			return fmt.Errorf("archive: restore %s: %w", table.Name, err)
+		}
+		batch.Reset()
+		pending = 0
+		return nil
+	}
+
+	lines := bufio.NewReader(r)
+	for {
+		line, err := lines.ReadBytes('\n')
+		if line := bytes.TrimSpace(line); len(line) > 0 {
+			if line[0] != '{' || !json.Valid(line) {
+				return rows, fmt.Errorf("archive: %s row %d is not a JSON object", table.Name, rows+1)
+			}
+			if pending == 0 {
+				batch.WriteByte('[')
+			} else {
+				batch.WriteByte(',')
+			}
+			batch.Write(line)
+			pending++
+			rows++
+			if pending >= importBatchRows || batch.Len() >= importBatchBytes {
+				if err := flush(); err != nil {
+					return rows, err
+				}
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return rows, fmt.Errorf("archive: read %s: %w", table.Name, err)
+		}
+	}
+	return rows, flush()
+}
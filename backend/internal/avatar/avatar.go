@@ -0,0 +1,115 @@
+// Package avatar turns an uploaded picture into square PNG thumbnails in
+// a fixed set of sizes, so clients never download more than they show.
+package avatar
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	_ "image/gif"  // registered for image.Decode
+	_ "image/jpeg" // registered for image.Decode
+	"image/png"
+)
+
+// Sizes are the edge lengths, in pixels, rendered for every avatar.
+var Sizes = []int{32, 128, 512}
+
+// DefaultSize is served when a client does not ask for a size.
+const DefaultSize = 128
+
+// maxPixels bounds the decoded image so a small, highly compressed upload
+// cannot exhaust memory.
+const maxPixels = 40_000_000
+
+// ErrUnsupported is returned for data that is not a PNG, JPEG or GIF
+// image, or is too large to decode.
+var ErrUnsupported = errors.New("avatar: unsupported image")
+
+// Render center-crops the image to a square and encodes it at each of
+// Sizes. Images smaller than a size are scaled up.
+func Render(data []byte) (map[int][]byte, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrUnsupported
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupported
+	}
+	square := cropSquare(src.Bounds())
+	out := make(map[int][]byte, len(Sizes))
+	for _, size := range Sizes {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, scale(src, square, size)); err != nil {
+			return nil, err
+		}
+		out[size] = buf.Bytes()
+	}
+	return out, nil
+}
+
+// Nearest returns the rendered size closest to want, preferring the larger
+// one on ties so images are not blurry.
+func Nearest(want int) int {
+	best := Sizes[len(Sizes)-1]
+	for _, size := range Sizes {
+		if abs(size-want) < abs(best-want) || (abs(size-want) == abs(best-want) && size > best) {
+			best = size
+		}
+	}
+	return best
+}
+
+func cropSquare(b image.Rectangle) image.Rectangle {
+	side := min(b.Dx(), b.Dy())
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+	return image.Rect(x0, y0, x0+side, y0+side)
+}
+
+// scale resamples the square region of src to size×size, averaging every
+// source pixel that falls in a destination pixel (a box filter), which
+// keeps downscaled photos free of aliasing.
+func scale(src image.Image, region image.Rectangle, size int) *image.NRGBA {
+	dst := image.NewNRGBA(image.Rect(0, 0, size, size))
+	side := region.Dx()
+	for y := 0; y < size; y++ {
+		y0 := region.Min.Y + y*side/size
+		y1 := max(region.Min.Y+(y+1)*side/size, y0+1)
+		for x := 0; x < size; x++ {
+			x0 := region.Min.X + x*side/size
+			x1 := max(region.Min.X+(x+1)*side/size, x0+1)
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, b, a, n = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca), n+1
+				}
+			}
+			dst.Set(x, y, unpremultiply(r/n, g/n, b/n, a/n))
+		}
+	}
+	return dst
+}
+
+// unpremultiply converts averaged alpha-premultiplied 16-bit channels to
+// an 8-bit non-premultiplied color.
+func unpremultiply(r, g, b, a uint64) color.NRGBA {
+	if a == 0 {
+		return color.NRGBA{}
+	}
+	return color.NRGBA{
+		R: uint8(r * 0xff / a),
+		G: uint8(g * 0xff / a),
+		B: uint8(b * 0xff / a),
+		A: uint8(a >> 8),
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
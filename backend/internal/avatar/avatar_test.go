@@ -0,0 +1,62 @@
+package avatar
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	// A wide image, red on the left half and blue on the right, so the
+	// center crop keeps both colors.
+	src := image.NewRGBA(image.Rect(0, 0, 800, 400))
+	for y := 0; y < 400; y++ {
+		for x := 0; x < 800; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 400 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			src.Set(x, y, c)
+		}
+	}
+	var upload bytes.Buffer
+	if err := jpeg.Encode(&upload, src, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := Render(upload.Bytes())
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	for _, size := range Sizes {
+		img, err := png.Decode(bytes.NewReader(out[size]))
+		if err != nil {
+			t.Fatalf("size %d: %v", size, err)
+		}
+		if b := img.Bounds(); b.Dx() != size || b.Dy() != size {
+			t.Fatalf("size %d: bounds %v", size, b)
+		}
+		left := color.NRGBAModel.Convert(img.At(size/8, size/2)).(color.NRGBA)
+		right := color.NRGBAModel.Convert(img.At(size-1-size/8, size/2)).(color.NRGBA)
+		if left.R < 200 || left.B > 50 || right.B < 200 || right.R > 50 {
+			t.Fatalf("size %d: left %v right %v", size, left, right)
+		}
+	}
+}
+
+func TestRenderRejectsNonImages(t *testing.T) {
+	if _, err := Render([]byte("<svg></svg>")); err != ErrUnsupported {
+		t.Fatalf("err = %v, want ErrUnsupported", err)
+	}
+}
+
+func TestNearest(t *testing.T) {
+	for want, size := range map[int]int{0: 32, 40: 32, 80: 128, 128: 128, 300: 128, 400: 512, 4096: 512} {
+		if got := Nearest(want); got != size {
+			t.Errorf("Nearest(%d) = %d, want %d", want, got, size)
+		}
+	}
+}
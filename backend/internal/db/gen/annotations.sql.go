@@ -0,0 +1,157 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: annotations.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAnnotation = `-- name: CreateAnnotation :one
+INSERT INTO recording_annotation (recording_id, start_ms, end_ms, note, author_user_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, recording_id, start_ms, end_ms, note, author_user_id, created_at, updated_at
+`
+
+type CreateAnnotationParams struct {
+	RecordingID  int32
+	StartMs      int32
+	EndMs        int32
+	Note         string
+	AuthorUserID pgtype.Int4
+}
+
+func (q *Queries) CreateAnnotation(ctx context.Context, arg CreateAnnotationParams) (RecordingAnnotation, error) {
+	row := q.db.QueryRow(ctx, createAnnotation,
+		arg.RecordingID,
+		arg.StartMs,
+		arg.EndMs,
+		arg.Note,
+		arg.AuthorUserID,
+	)
+	var i RecordingAnnotation
+	err := row.Scan(
+		&i.ID,
+		&i.RecordingID,
+		&i.StartMs,
+		&i.EndMs,
+		&i.Note,
+		&i.AuthorUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteAnnotation = `-- name: DeleteAnnotation :execrows
+DELETE FROM recording_annotation
+WHERE id = $1
+`
+
+func (q *Queries) DeleteAnnotation(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAnnotation, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getAnnotation = `-- name: GetAnnotation :one
+SELECT id, recording_id, start_ms, end_ms, note, author_user_id, created_at, updated_at
+FROM recording_annotation
+WHERE id = $1
+`
+
+func (q *Queries) GetAnnotation(ctx context.Context, id int32) (RecordingAnnotation, error) {
+	row := q.db.QueryRow(ctx, getAnnotation, id)
+	var i RecordingAnnotation
+	err := row.Scan(
+		&i.ID,
+		&i.RecordingID,
+		&i.StartMs,
+		&i.EndMs,
+		&i.Note,
+		&i.AuthorUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listAnnotations = `-- name: ListAnnotations :many
+SELECT id, recording_id, start_ms, end_ms, note, author_user_id, created_at, updated_at
+FROM recording_annotation
+WHERE recording_id = $1
+ORDER BY start_ms, id
+`
+
+func (q *Queries) ListAnnotations(ctx context.Context, recordingID int32) ([]RecordingAnnotation, error) {
+	rows, err := q.db.Query(ctx, listAnnotations, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RecordingAnnotation
+	for rows.Next() {
+		var i RecordingAnnotation
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecordingID,
+			&i.StartMs,
+			&i.EndMs,
+			&i.Note,
+			&i.AuthorUserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateAnnotation = `-- name: UpdateAnnotation :one
+UPDATE recording_annotation
+SET start_ms = $2,
+    end_ms = $3,
+    note = $4,
+    updated_at = now()
+WHERE id = $1
+RETURNING id, recording_id, start_ms, end_ms, note, author_user_id, created_at, updated_at
+`
+
+type UpdateAnnotationParams struct {
+	ID      int32
+	StartMs int32
+	EndMs   int32
+	Note    string
+}
+
+func (q *Queries) UpdateAnnotation(ctx context.Context, arg UpdateAnnotationParams) (RecordingAnnotation, error) {
+	row := q.db.QueryRow(ctx, updateAnnotation,
+		arg.ID,
+		arg.StartMs,
+		arg.EndMs,
+		arg.Note,
+	)
+	var i RecordingAnnotation
+	err := row.Scan(
+		&i.ID,
+		&i.RecordingID,
+		&i.StartMs,
+		&i.EndMs,
+		&i.Note,
+		&i.AuthorUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,179 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: attachments.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAttachment = `-- name: CreateAttachment :one
+INSERT INTO attachment (todo_id, recording_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id, scan_status, scan_detail, scanned_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8::text, $9::text, CASE WHEN $8::text = 'unscanned' THEN NULL ELSE now() END)
+RETURNING id, todo_id, recording_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id, created_at, scan_status, scan_detail, scanned_at
+`
+
+type CreateAttachmentParams struct {
+	TodoID           pgtype.Int4
+	RecordingID      pgtype.Int4
+	FileName         string
+	ContentType      string
+	SizeBytes        int64
+	StorageKey       string
+	UploadedByUserID pgtype.Int4
+	ScanStatus       string
+	ScanDetail       pgtype.Text
+}
+
+func (q *Queries) CreateAttachment(ctx context.Context, arg CreateAttachmentParams) (Attachment, error) {
+	row := q.db.QueryRow(ctx, createAttachment,
+		arg.TodoID,
+		arg.RecordingID,
+		arg.FileName,
+		arg.ContentType,
+		arg.SizeBytes,
+		arg.StorageKey,
+		arg.UploadedByUserID,
+		arg.ScanStatus,
+		arg.ScanDetail,
+	)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.TodoID,
+		&i.RecordingID,
+		&i.FileName,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.StorageKey,
+		&i.UploadedByUserID,
+		&i.CreatedAt,
+		&i.ScanStatus,
+		&i.ScanDetail,
+		&i.ScannedAt,
+	)
+	return i, err
+}
+
+const deleteAttachment = `-- name: DeleteAttachment :execrows
+DELETE FROM attachment
+WHERE id = $1
+`
+
+func (q *Queries) DeleteAttachment(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAttachment, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getAttachment = `-- name: GetAttachment :one
+SELECT id, todo_id, recording_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id, created_at, scan_status, scan_detail, scanned_at
+FROM attachment
+WHERE id = $1
+`
+
+func (q *Queries) GetAttachment(ctx context.Context, id int32) (Attachment, error) {
+	row := q.db.QueryRow(ctx, getAttachment, id)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.TodoID,
+		&i.RecordingID,
+		&i.FileName,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.StorageKey,
+		&i.UploadedByUserID,
+		&i.CreatedAt,
+		&i.ScanStatus,
+		&i.ScanDetail,
+		&i.ScannedAt,
+	)
+	return i, err
+}
+
+const listRecordingAttachments = `-- name: ListRecordingAttachments :many
+SELECT id, todo_id, recording_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id, created_at, scan_status, scan_detail, scanned_at
+FROM attachment
+WHERE recording_id = $1::integer
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListRecordingAttachments(ctx context.Context, recordingID int32) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, listRecordingAttachments, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Attachment
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TodoID,
+			&i.RecordingID,
+			&i.FileName,
+			&i.ContentType,
+			&i.SizeBytes,
+			&i.StorageKey,
+			&i.UploadedByUserID,
+			&i.CreatedAt,
+			&i.ScanStatus,
+			&i.ScanDetail,
+			&i.ScannedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTodoAttachments = `-- name: ListTodoAttachments :many
+SELECT id, todo_id, recording_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id, created_at, scan_status, scan_detail, scanned_at
+FROM attachment
+WHERE todo_id = $1::integer
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListTodoAttachments(ctx context.Context, todoID int32) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, listTodoAttachments, todoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Attachment
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TodoID,
+			&i.RecordingID,
+			&i.FileName,
+			&i.ContentType,
+			&i.SizeBytes,
+			&i.StorageKey,
+			&i.UploadedByUserID,
+			&i.CreatedAt,
+			&i.ScanStatus,
+			&i.ScanDetail,
+			&i.ScannedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
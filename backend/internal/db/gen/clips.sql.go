@@ -0,0 +1,123 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: clips.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createClip = `-- name: CreateClip :one
+INSERT INTO recording_clip (recording_id, start_ms, end_ms, title, transcript_excerpt, audio_key, audio_bytes, share_token, created_by_user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, recording_id, start_ms, end_ms, title, transcript_excerpt, audio_key, audio_bytes, share_token, created_by_user_id, created_at
+`
+
+type CreateClipParams struct {
+	RecordingID       int32
+	StartMs           int32
+	EndMs             int32
+	Title             string
+	TranscriptExcerpt string
+	AudioKey          string
+	AudioBytes        int64
+	ShareToken        string
+	CreatedByUserID   pgtype.Int4
+}
+
+func (q *Queries) CreateClip(ctx context.Context, arg CreateClipParams) (RecordingClip, error) {
+	row := q.db.QueryRow(ctx, createClip,
+		arg.RecordingID,
+		arg.StartMs,
+		arg.EndMs,
+		arg.Title,
+		arg.TranscriptExcerpt,
+		arg.AudioKey,
+		arg.AudioBytes,
+		arg.ShareToken,
+		arg.CreatedByUserID,
+	)
+	var i RecordingClip
+	err := row.Scan(
+		&i.ID,
+		&i.RecordingID,
+		&i.StartMs,
+		&i.EndMs,
+		&i.Title,
+		&i.TranscriptExcerpt,
+		&i.AudioKey,
+		&i.AudioBytes,
+		&i.ShareToken,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getClipByShareToken = `-- name: GetClipByShareToken :one
+SELECT id, recording_id, start_ms, end_ms, title, transcript_excerpt, audio_key, audio_bytes, share_token, created_by_user_id, created_at
+FROM recording_clip
+WHERE share_token = $1
+`
+
+func (q *Queries) GetClipByShareToken(ctx context.Context, shareToken string) (RecordingClip, error) {
+	row := q.db.QueryRow(ctx, getClipByShareToken, shareToken)
+	var i RecordingClip
+	err := row.Scan(
+		&i.ID,
+		&i.RecordingID,
+		&i.StartMs,
+		&i.EndMs,
+		&i.Title,
+		&i.TranscriptExcerpt,
+		&i.AudioKey,
+		&i.AudioBytes,
+		&i.ShareToken,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listClips = `-- name: ListClips :many
+SELECT id, recording_id, start_ms, end_ms, title, transcript_excerpt, audio_key, audio_bytes, share_token, created_by_user_id, created_at
+FROM recording_clip
+WHERE recording_id = $1
+ORDER BY created_at DESC, id DESC
+`
+
+func (q *Queries) ListClips(ctx context.Context, recordingID int32) ([]RecordingClip, error) {
+	rows, err := q.db.Query(ctx, listClips, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RecordingClip
+	for rows.Next() {
+		var i RecordingClip
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecordingID,
+			&i.StartMs,
+			&i.EndMs,
+			&i.Title,
+			&i.TranscriptExcerpt,
+			&i.AudioKey,
+			&i.AudioBytes,
+			&i.ShareToken,
+			&i.CreatedByUserID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -120,7 +120,7 @@ INSERT INTO todo (
   source_document_id,
   source_block_id
 ) VALUES ($1, $2, $3, $4, $5, 'block', $6, $7)
-RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at
+RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at, due_at, version, snoozed_until, priority
 `
 
 type CreateCanonicalTodoForBlockParams struct {
@@ -158,6 +158,10 @@ func (q *Queries) CreateCanonicalTodoForBlock(ctx context.Context, arg CreateCan
 		&i.UpdatedAtRecordingID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DueAt,
+		&i.Version,
+		&i.SnoozedUntil,
+		&i.Priority,
 	)
 	return i, err
 }
@@ -702,9 +706,10 @@ SET
   source_kind = 'block',
   source_document_id = $7,
   source_block_id = $8,
+  version = version + 1,
   updated_at = now()
 WHERE id = $1
-RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at
+RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at, due_at, version, snoozed_until, priority
 `
 
 type UpdateCanonicalTodoForBlockParams struct {
@@ -744,6 +749,10 @@ func (q *Queries) UpdateCanonicalTodoForBlock(ctx context.Context, arg UpdateCan
 		&i.UpdatedAtRecordingID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DueAt,
+		&i.Version,
+		&i.SnoozedUntil,
+		&i.Priority,
 	)
 	return i, err
 }
@@ -0,0 +1,661 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: encryption.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createDataKey = `-- name: CreateDataKey :one
+INSERT INTO data_key (wrapped_key, master_key_id, org_id)
+VALUES ($1::bytea, $2::text, $3::text)
+RETURNING id, wrapped_key, master_key_id, created_at, org_id
+`
+
+type CreateDataKeyParams struct {
+	WrappedKey  []byte
+	MasterKeyID string
+	OrgID       string
+}
+
+func (q *Queries) CreateDataKey(ctx context.Context, arg CreateDataKeyParams) (DataKey, error) {
+	row := q.db.QueryRow(ctx, createDataKey, arg.WrappedKey, arg.MasterKeyID, arg.OrgID)
+	var i DataKey
+	err := row.Scan(
+		&i.ID,
+		&i.WrappedKey,
+		&i.MasterKeyID,
+		&i.CreatedAt,
+		&i.OrgID,
+	)
+	return i, err
+}
+
+const getDataKey = `-- name: GetDataKey :one
+SELECT id, wrapped_key, master_key_id, created_at, org_id
+FROM data_key
+WHERE id = $1::integer
+`
+
+func (q *Queries) GetDataKey(ctx context.Context, id int32) (DataKey, error) {
+	row := q.db.QueryRow(ctx, getDataKey, id)
+	var i DataKey
+	err := row.Scan(
+		&i.ID,
+		&i.WrappedKey,
+		&i.MasterKeyID,
+		&i.CreatedAt,
+		&i.OrgID,
+	)
+	return i, err
+}
+
+const getOrgID = `-- name: GetOrgID :one
+WITH created AS (
+  INSERT INTO org_setting (id) VALUES (true)
+  ON CONFLICT (id) DO NOTHING
+  RETURNING org_id
+)
+SELECT org_id FROM created
+UNION ALL
+SELECT org_id FROM org_setting WHERE id
+LIMIT 1
+`
+
+// Creates the settings row the first time, so there is an org to bind
+// data keys to.
+func (q *Queries) GetOrgID(ctx context.Context) (string, error) {
+	row := q.db.QueryRow(ctx, getOrgID)
+	var org_id string
+	err := row.Scan(&org_id)
+	return org_id, err
+}
+
+const listAudioObjectKeys = `-- name: ListAudioObjectKeys :many
+SELECT key::text
+FROM (
+  SELECT audio_key AS key FROM recording WHERE audio_key IS NOT NULL
+  UNION
+  SELECT audio_key FROM recording_clip
+) audio
+WHERE key > $1::text
+ORDER BY key
+LIMIT $2::integer
+`
+
+type ListAudioObjectKeysParams struct {
+	After   string
+	MaxRows int32
+}
+
+// Stored audio of recordings and clips, in key order so a pass can resume
+// after the last key it saw.
+func (q *Queries) ListAudioObjectKeys(ctx context.Context, arg ListAudioObjectKeysParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listAudioObjectKeys, arg.After, arg.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		items = append(items, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listClipExcerptsToSeal = `-- name: ListClipExcerptsToSeal :many
+SELECT id, transcript_excerpt AS text
+FROM recording_clip
+WHERE transcript_excerpt <> ''
+  AND NOT starts_with(transcript_excerpt, $1::text)
+ORDER BY id
+LIMIT $2::integer
+`
+
+type ListClipExcerptsToSealParams struct {
+	SealedPrefix string
+	MaxRows      int32
+}
+
+type ListClipExcerptsToSealRow struct {
+	ID   int32
+	Text string
+}
+
+// Clip excerpts, mention and keyword alert lines, outcome quotes and the
+// quotes the assistant cites copy the transcript, so they are sealed with
+// it.
+func (q *Queries) ListClipExcerptsToSeal(ctx context.Context, arg ListClipExcerptsToSealParams) ([]ListClipExcerptsToSealRow, error) {
+	rows, err := q.db.Query(ctx, listClipExcerptsToSeal, arg.SealedPrefix, arg.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListClipExcerptsToSealRow
+	for rows.Next() {
+		var i ListClipExcerptsToSealRow
+		if err := rows.Scan(&i.ID, &i.Text); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDataKeys = `-- name: ListDataKeys :many
+SELECT id, wrapped_key, master_key_id, created_at, org_id
+FROM data_key
+WHERE org_id = $1::text OR org_id IS NULL
+ORDER BY id
+`
+
+// The org's data keys, and ones created before data keys belonged to an
+// org, which are bound to it as they are rewrapped.
+func (q *Queries) ListDataKeys(ctx context.Context, orgID string) ([]DataKey, error) {
+	rows, err := q.db.Query(ctx, listDataKeys, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DataKey
+	for rows.Next() {
+		var i DataKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.WrappedKey,
+			&i.MasterKeyID,
+			&i.CreatedAt,
+			&i.OrgID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listKeywordAlertLinesToSeal = `-- name: ListKeywordAlertLinesToSeal :many
+SELECT id, segment_text AS text
+FROM keyword_alert
+WHERE segment_text <> ''
+  AND NOT starts_with(segment_text, $1::text)
+ORDER BY id
+LIMIT $2::integer
+`
+
+type ListKeywordAlertLinesToSealParams struct {
+	SealedPrefix string
+	MaxRows      int32
+}
+
+type ListKeywordAlertLinesToSealRow struct {
+	ID   int64
+	Text string
+}
+
+func (q *Queries) ListKeywordAlertLinesToSeal(ctx context.Context, arg ListKeywordAlertLinesToSealParams) ([]ListKeywordAlertLinesToSealRow, error) {
+	rows, err := q.db.Query(ctx, listKeywordAlertLinesToSeal, arg.SealedPrefix, arg.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListKeywordAlertLinesToSealRow
+	for rows.Next() {
+		var i ListKeywordAlertLinesToSealRow
+		if err := rows.Scan(&i.ID, &i.Text); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMentionLinesToSeal = `-- name: ListMentionLinesToSeal :many
+SELECT id, segment_text AS text
+FROM transcript_mention
+WHERE segment_text <> ''
+  AND NOT starts_with(segment_text, $1::text)
+ORDER BY id
+LIMIT $2::integer
+`
+
+type ListMentionLinesToSealParams struct {
+	SealedPrefix string
+	MaxRows      int32
+}
+
+type ListMentionLinesToSealRow struct {
+	ID   int64
+	Text string
+}
+
+func (q *Queries) ListMentionLinesToSeal(ctx context.Context, arg ListMentionLinesToSealParams) ([]ListMentionLinesToSealRow, error) {
+	rows, err := q.db.Query(ctx, listMentionLinesToSeal, arg.SealedPrefix, arg.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMentionLinesToSealRow
+	for rows.Next() {
+		var i ListMentionLinesToSealRow
+		if err := rows.Scan(&i.ID, &i.Text); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOutcomeQuotesToSeal = `-- name: ListOutcomeQuotesToSeal :many
+SELECT id, quote::text AS text
+FROM meeting_outcome
+WHERE quote <> ''
+  AND NOT starts_with(quote, $1::text)
+ORDER BY id
+LIMIT $2::integer
+`
+
+type ListOutcomeQuotesToSealParams struct {
+	SealedPrefix string
+	MaxRows      int32
+}
+
+type ListOutcomeQuotesToSealRow struct {
+	ID   int32
+	Text string
+}
+
+func (q *Queries) ListOutcomeQuotesToSeal(ctx context.Context, arg ListOutcomeQuotesToSealParams) ([]ListOutcomeQuotesToSealRow, error) {
+	rows, err := q.db.Query(ctx, listOutcomeQuotesToSeal, arg.SealedPrefix, arg.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOutcomeQuotesToSealRow
+	for rows.Next() {
+		var i ListOutcomeQuotesToSealRow
+		if err := rows.Scan(&i.ID, &i.Text); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSourceQuotesToSeal = `-- name: ListSourceQuotesToSeal :many
+SELECT id, quote_text::text AS text
+FROM ai_source_ref
+WHERE quote_text <> ''
+  AND NOT starts_with(quote_text, $1::text)
+ORDER BY id
+LIMIT $2::integer
+`
+
+type ListSourceQuotesToSealParams struct {
+	SealedPrefix string
+	MaxRows      int32
+}
+
+type ListSourceQuotesToSealRow struct {
+	ID   int64
+	Text string
+}
+
+func (q *Queries) ListSourceQuotesToSeal(ctx context.Context, arg ListSourceQuotesToSealParams) ([]ListSourceQuotesToSealRow, error) {
+	rows, err := q.db.Query(ctx, listSourceQuotesToSeal, arg.SealedPrefix, arg.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSourceQuotesToSealRow
+	for rows.Next() {
+		var i ListSourceQuotesToSealRow
+		if err := rows.Scan(&i.ID, &i.Text); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTranscriptRevisionsToSeal = `-- name: ListTranscriptRevisionsToSeal :many
+SELECT id, transcript
+FROM transcript_revision
+WHERE transcript <> ''
+  AND NOT starts_with(transcript, $1::text)
+ORDER BY id
+LIMIT $2::integer
+`
+
+type ListTranscriptRevisionsToSealParams struct {
+	SealedPrefix string
+	MaxRows      int32
+}
+
+type ListTranscriptRevisionsToSealRow struct {
+	ID         int32
+	Transcript string
+}
+
+func (q *Queries) ListTranscriptRevisionsToSeal(ctx context.Context, arg ListTranscriptRevisionsToSealParams) ([]ListTranscriptRevisionsToSealRow, error) {
+	rows, err := q.db.Query(ctx, listTranscriptRevisionsToSeal, arg.SealedPrefix, arg.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTranscriptRevisionsToSealRow
+	for rows.Next() {
+		var i ListTranscriptRevisionsToSealRow
+		if err := rows.Scan(&i.ID, &i.Transcript); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTranscriptsToSeal = `-- name: ListTranscriptsToSeal :many
+SELECT id, transcript::text AS transcript
+FROM recording
+WHERE transcript <> ''
+  AND NOT starts_with(transcript, $1::text)
+ORDER BY id
+LIMIT $2::integer
+`
+
+type ListTranscriptsToSealParams struct {
+	SealedPrefix string
+	MaxRows      int32
+}
+
+type ListTranscriptsToSealRow struct {
+	ID         int32
+	Transcript string
+}
+
+// Transcripts not yet sealed with the active key: plaintext ones written
+// by the worker and ones sealed with an older key.
+func (q *Queries) ListTranscriptsToSeal(ctx context.Context, arg ListTranscriptsToSealParams) ([]ListTranscriptsToSealRow, error) {
+	rows, err := q.db.Query(ctx, listTranscriptsToSeal, arg.SealedPrefix, arg.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTranscriptsToSealRow
+	for rows.Next() {
+		var i ListTranscriptsToSealRow
+		if err := rows.Scan(&i.ID, &i.Transcript); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTranslationsToSeal = `-- name: ListTranslationsToSeal :many
+SELECT id, text
+FROM recording_translation
+WHERE kind = 'transcript'
+  AND NOT starts_with(text, $1::text)
+ORDER BY id
+LIMIT $2::integer
+`
+
+type ListTranslationsToSealParams struct {
+	SealedPrefix string
+	MaxRows      int32
+}
+
+type ListTranslationsToSealRow struct {
+	ID   int32
+	Text string
+}
+
+func (q *Queries) ListTranslationsToSeal(ctx context.Context, arg ListTranslationsToSealParams) ([]ListTranslationsToSealRow, error) {
+	rows, err := q.db.Query(ctx, listTranslationsToSeal, arg.SealedPrefix, arg.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTranslationsToSealRow
+	for rows.Next() {
+		var i ListTranslationsToSealRow
+		if err := rows.Scan(&i.ID, &i.Text); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rewrapDataKey = `-- name: RewrapDataKey :exec
+UPDATE data_key
+SET wrapped_key = $1::bytea,
+    master_key_id = $2::text,
+    org_id = $3::text
+WHERE id = $4::integer
+`
+
+type RewrapDataKeyParams struct {
+	WrappedKey  []byte
+	MasterKeyID string
+	OrgID       string
+	ID          int32
+}
+
+func (q *Queries) RewrapDataKey(ctx context.Context, arg RewrapDataKeyParams) error {
+	_, err := q.db.Exec(ctx, rewrapDataKey,
+		arg.WrappedKey,
+		arg.MasterKeyID,
+		arg.OrgID,
+		arg.ID,
+	)
+	return err
+}
+
+const sealClipExcerpt = `-- name: SealClipExcerpt :execrows
+UPDATE recording_clip
+SET transcript_excerpt = $1::text
+WHERE id = $2::integer
+  AND transcript_excerpt = $3::text
+`
+
+type SealClipExcerptParams struct {
+	Sealed  string
+	ID      int32
+	Current string
+}
+
+func (q *Queries) SealClipExcerpt(ctx context.Context, arg SealClipExcerptParams) (int64, error) {
+	result, err := q.db.Exec(ctx, sealClipExcerpt, arg.Sealed, arg.ID, arg.Current)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const sealKeywordAlertLine = `-- name: SealKeywordAlertLine :execrows
+UPDATE keyword_alert
+SET segment_text = $1::text
+WHERE id = $2::bigint
+  AND segment_text = $3::text
+`
+
+type SealKeywordAlertLineParams struct {
+	Sealed  string
+	ID      int64
+	Current string
+}
+
+func (q *Queries) SealKeywordAlertLine(ctx context.Context, arg SealKeywordAlertLineParams) (int64, error) {
+	result, err := q.db.Exec(ctx, sealKeywordAlertLine, arg.Sealed, arg.ID, arg.Current)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const sealMentionLine = `-- name: SealMentionLine :execrows
+UPDATE transcript_mention
+SET segment_text = $1::text
+WHERE id = $2::bigint
+  AND segment_text = $3::text
+`
+
+type SealMentionLineParams struct {
+	Sealed  string
+	ID      int64
+	Current string
+}
+
+func (q *Queries) SealMentionLine(ctx context.Context, arg SealMentionLineParams) (int64, error) {
+	result, err := q.db.Exec(ctx, sealMentionLine, arg.Sealed, arg.ID, arg.Current)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const sealOutcomeQuote = `-- name: SealOutcomeQuote :execrows
+UPDATE meeting_outcome
+SET quote = $1::text
+WHERE id = $2::integer
+  AND quote = $3::text
+`
+
+type SealOutcomeQuoteParams struct {
+	Sealed  string
+	ID      int32
+	Current string
+}
+
+func (q *Queries) SealOutcomeQuote(ctx context.Context, arg SealOutcomeQuoteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, sealOutcomeQuote, arg.Sealed, arg.ID, arg.Current)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const sealRecordingTranscript = `-- name: SealRecordingTranscript :execrows
+UPDATE recording
+SET transcript = $1::text
+WHERE id = $2::integer
+  AND transcript = $3::text
+`
+
+type SealRecordingTranscriptParams struct {
+	Sealed  string
+	ID      int32
+	Current string
+}
+
+// Only replaces the transcript it was given, so one rewritten in the
+// meantime is left for the next pass.
+func (q *Queries) SealRecordingTranscript(ctx context.Context, arg SealRecordingTranscriptParams) (int64, error) {
+	result, err := q.db.Exec(ctx, sealRecordingTranscript, arg.Sealed, arg.ID, arg.Current)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const sealRecordingTranslation = `-- name: SealRecordingTranslation :execrows
+UPDATE recording_translation
+SET text = $1::text
+WHERE id = $2::integer
+  AND text = $3::text
+`
+
+type SealRecordingTranslationParams struct {
+	Sealed  string
+	ID      int32
+	Current string
+}
+
+func (q *Queries) SealRecordingTranslation(ctx context.Context, arg SealRecordingTranslationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, sealRecordingTranslation, arg.Sealed, arg.ID, arg.Current)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const sealSourceQuote = `-- name: SealSourceQuote :execrows
+UPDATE ai_source_ref
+SET quote_text = $1::text
+WHERE id = $2::bigint
+  AND quote_text = $3::text
+`
+
+type SealSourceQuoteParams struct {
+	Sealed  string
+	ID      int64
+	Current string
+}
+
+func (q *Queries) SealSourceQuote(ctx context.Context, arg SealSourceQuoteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, sealSourceQuote, arg.Sealed, arg.ID, arg.Current)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const sealTranscriptRevision = `-- name: SealTranscriptRevision :execrows
+UPDATE transcript_revision
+SET transcript = $1::text
+WHERE id = $2::integer
+  AND transcript = $3::text
+`
+
+type SealTranscriptRevisionParams struct {
+	Sealed  string
+	ID      int32
+	Current string
+}
+
+func (q *Queries) SealTranscriptRevision(ctx context.Context, arg SealTranscriptRevisionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, sealTranscriptRevision, arg.Sealed, arg.ID, arg.Current)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
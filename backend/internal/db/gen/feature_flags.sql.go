@@ -0,0 +1,164 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: feature_flags.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteUserFeatureFlag = `-- name: DeleteUserFeatureFlag :exec
+DELETE FROM feature_flag_user
+WHERE name = $1 AND user_id = $2
+`
+
+type DeleteUserFeatureFlagParams struct {
+	Name   string
+	UserID int32
+}
+
+func (q *Queries) DeleteUserFeatureFlag(ctx context.Context, arg DeleteUserFeatureFlagParams) error {
+	_, err := q.db.Exec(ctx, deleteUserFeatureFlag, arg.Name, arg.UserID)
+	return err
+}
+
+const listFeatureFlagUsers = `-- name: ListFeatureFlagUsers :many
+SELECT name, user_id, enabled, updated_at FROM feature_flag_user
+ORDER BY name, user_id
+`
+
+func (q *Queries) ListFeatureFlagUsers(ctx context.Context) ([]FeatureFlagUser, error) {
+	rows, err := q.db.Query(ctx, listFeatureFlagUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FeatureFlagUser
+	for rows.Next() {
+		var i FeatureFlagUser
+		if err := rows.Scan(
+			&i.Name,
+			&i.UserID,
+			&i.Enabled,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFeatureFlags = `-- name: ListFeatureFlags :many
+SELECT name, enabled, updated_at, updated_by_user_id FROM feature_flag
+ORDER BY name
+`
+
+func (q *Queries) ListFeatureFlags(ctx context.Context) ([]FeatureFlag, error) {
+	rows, err := q.db.Query(ctx, listFeatureFlags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FeatureFlag
+	for rows.Next() {
+		var i FeatureFlag
+		if err := rows.Scan(
+			&i.Name,
+			&i.Enabled,
+			&i.UpdatedAt,
+			&i.UpdatedByUserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserFeatureFlags = `-- name: ListUserFeatureFlags :many
+SELECT name, user_id, enabled, updated_at FROM feature_flag_user
+WHERE user_id = $1
+`
+
+func (q *Queries) ListUserFeatureFlags(ctx context.Context, userID int32) ([]FeatureFlagUser, error) {
+	rows, err := q.db.Query(ctx, listUserFeatureFlags, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FeatureFlagUser
+	for rows.Next() {
+		var i FeatureFlagUser
+		if err := rows.Scan(
+			&i.Name,
+			&i.UserID,
+			&i.Enabled,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setFeatureFlag = `-- name: SetFeatureFlag :one
+INSERT INTO feature_flag (name, enabled, updated_by_user_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (name) DO UPDATE
+SET enabled = EXCLUDED.enabled,
+    updated_by_user_id = EXCLUDED.updated_by_user_id,
+    updated_at = now()
+RETURNING name, enabled, updated_at, updated_by_user_id
+`
+
+type SetFeatureFlagParams struct {
+	Name            string
+	Enabled         bool
+	UpdatedByUserID pgtype.Int4
+}
+
+func (q *Queries) SetFeatureFlag(ctx context.Context, arg SetFeatureFlagParams) (FeatureFlag, error) {
+	row := q.db.QueryRow(ctx, setFeatureFlag, arg.Name, arg.Enabled, arg.UpdatedByUserID)
+	var i FeatureFlag
+	err := row.Scan(
+		&i.Name,
+		&i.Enabled,
+		&i.UpdatedAt,
+		&i.UpdatedByUserID,
+	)
+	return i, err
+}
+
+const setUserFeatureFlag = `-- name: SetUserFeatureFlag :exec
+INSERT INTO feature_flag_user (name, user_id, enabled)
+VALUES ($1, $2, $3)
+ON CONFLICT (name, user_id) DO UPDATE
+SET enabled = EXCLUDED.enabled,
+    updated_at = now()
+`
+
+type SetUserFeatureFlagParams struct {
+	Name    string
+	UserID  int32
+	Enabled bool
+}
+
+func (q *Queries) SetUserFeatureFlag(ctx context.Context, arg SetUserFeatureFlagParams) error {
+	_, err := q.db.Exec(ctx, setUserFeatureFlag, arg.Name, arg.UserID, arg.Enabled)
+	return err
+}
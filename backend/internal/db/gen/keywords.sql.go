@@ -0,0 +1,310 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: keywords.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countWatchKeywords = `-- name: CountWatchKeywords :one
+SELECT COUNT(*)::bigint
+FROM watch_keyword
+WHERE user_id = $1
+`
+
+func (q *Queries) CountWatchKeywords(ctx context.Context, userID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countWatchKeywords, userID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const createKeywordAlerts = `-- name: CreateKeywordAlerts :many
+INSERT INTO keyword_alert (keyword_id, recording_id, segment_index, segment_text)
+SELECT found.keyword_id, $1::integer, found.segment_index, found.segment_text
+FROM (
+  SELECT
+    unnest($2::integer[]) AS keyword_id,
+    unnest($3::integer[]) AS segment_index,
+    unnest($4::text[]) AS segment_text
+) AS found
+ON CONFLICT (keyword_id, recording_id, segment_index) DO NOTHING
+RETURNING keyword_id, segment_index, segment_text
+`
+
+type CreateKeywordAlertsParams struct {
+	RecordingID    int32
+	KeywordIds     []int32
+	SegmentIndexes []int32
+	SegmentTexts   []string
+}
+
+type CreateKeywordAlertsRow struct {
+	KeywordID    int32
+	SegmentIndex int32
+	SegmentText  string
+}
+
+// Stores the alerts not stored yet and returns them, so a recording
+// processed again doesn't alert twice.
+func (q *Queries) CreateKeywordAlerts(ctx context.Context, arg CreateKeywordAlertsParams) ([]CreateKeywordAlertsRow, error) {
+	rows, err := q.db.Query(ctx, createKeywordAlerts,
+		arg.RecordingID,
+		arg.KeywordIds,
+		arg.SegmentIndexes,
+		arg.SegmentTexts,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CreateKeywordAlertsRow
+	for rows.Next() {
+		var i CreateKeywordAlertsRow
+		if err := rows.Scan(&i.KeywordID, &i.SegmentIndex, &i.SegmentText); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createWatchKeyword = `-- name: CreateWatchKeyword :one
+INSERT INTO watch_keyword (user_id, keyword)
+VALUES ($1, $2)
+RETURNING id, user_id, keyword, created_at
+`
+
+type CreateWatchKeywordParams struct {
+	UserID  int32
+	Keyword string
+}
+
+func (q *Queries) CreateWatchKeyword(ctx context.Context, arg CreateWatchKeywordParams) (WatchKeyword, error) {
+	row := q.db.QueryRow(ctx, createWatchKeyword, arg.UserID, arg.Keyword)
+	var i WatchKeyword
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Keyword,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteWatchKeyword = `-- name: DeleteWatchKeyword :execrows
+DELETE FROM watch_keyword
+WHERE id = $1 AND user_id = $2
+`
+
+type DeleteWatchKeywordParams struct {
+	ID     int32
+	UserID int32
+}
+
+func (q *Queries) DeleteWatchKeyword(ctx context.Context, arg DeleteWatchKeywordParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWatchKeyword, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listKeywordAlerts = `-- name: ListKeywordAlerts :many
+SELECT
+  a.id,
+  a.keyword_id,
+  k.keyword,
+  a.recording_id,
+  r.name AS recording_name,
+  a.segment_index,
+  a.segment_text,
+  a.created_at
+FROM keyword_alert a
+JOIN watch_keyword k ON k.id = a.keyword_id
+JOIN recording r ON r.id = a.recording_id
+WHERE k.user_id = $1::integer
+  AND ($2::integer IS NULL OR a.keyword_id = $2::integer)
+ORDER BY a.created_at DESC, a.id DESC
+LIMIT $3::integer
+`
+
+type ListKeywordAlertsParams struct {
+	UserID    int32
+	KeywordID pgtype.Int4
+	MaxRows   int32
+}
+
+type ListKeywordAlertsRow struct {
+	ID            int64
+	KeywordID     int32
+	Keyword       string
+	RecordingID   int32
+	RecordingName pgtype.Text
+	SegmentIndex  int32
+	SegmentText   string
+	CreatedAt     pgtype.Timestamptz
+}
+
+// The user's alerts, newest first.
+func (q *Queries) ListKeywordAlerts(ctx context.Context, arg ListKeywordAlertsParams) ([]ListKeywordAlertsRow, error) {
+	rows, err := q.db.Query(ctx, listKeywordAlerts, arg.UserID, arg.KeywordID, arg.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListKeywordAlertsRow
+	for rows.Next() {
+		var i ListKeywordAlertsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.KeywordID,
+			&i.Keyword,
+			&i.RecordingID,
+			&i.RecordingName,
+			&i.SegmentIndex,
+			&i.SegmentText,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecordingsToMatchKeywords = `-- name: ListRecordingsToMatchKeywords :many
+SELECT id, name, transcript, status_updated_at
+FROM recording
+WHERE status = 'ready'
+  AND (keywords_matched_at IS NULL OR keywords_matched_at < status_updated_at)
+ORDER BY status_updated_at, id
+LIMIT $1::integer
+`
+
+type ListRecordingsToMatchKeywordsRow struct {
+	ID              int32
+	Name            pgtype.Text
+	Transcript      pgtype.Text
+	StatusUpdatedAt pgtype.Timestamptz
+}
+
+// Ready recordings whose transcript hasn't been matched against watch
+// keywords since it last became ready.
+func (q *Queries) ListRecordingsToMatchKeywords(ctx context.Context, maxRows int32) ([]ListRecordingsToMatchKeywordsRow, error) {
+	rows, err := q.db.Query(ctx, listRecordingsToMatchKeywords, maxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecordingsToMatchKeywordsRow
+	for rows.Next() {
+		var i ListRecordingsToMatchKeywordsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Transcript,
+			&i.StatusUpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWatchKeywords = `-- name: ListWatchKeywords :many
+SELECT id, user_id, keyword, created_at
+FROM watch_keyword
+WHERE user_id = $1
+ORDER BY lower(keyword)
+`
+
+func (q *Queries) ListWatchKeywords(ctx context.Context, userID int32) ([]WatchKeyword, error) {
+	rows, err := q.db.Query(ctx, listWatchKeywords, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WatchKeyword
+	for rows.Next() {
+		var i WatchKeyword
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Keyword,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWatchKeywordsCreatedBefore = `-- name: ListWatchKeywordsCreatedBefore :many
+SELECT id, user_id, keyword, created_at
+FROM watch_keyword
+WHERE created_at <= $1::timestamptz
+ORDER BY user_id, id
+`
+
+// Keywords that existed when a recording became ready; later ones only
+// apply to later meetings.
+func (q *Queries) ListWatchKeywordsCreatedBefore(ctx context.Context, before pgtype.Timestamptz) ([]WatchKeyword, error) {
+	rows, err := q.db.Query(ctx, listWatchKeywordsCreatedBefore, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WatchKeyword
+	for rows.Next() {
+		var i WatchKeyword
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Keyword,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setRecordingKeywordsMatched = `-- name: SetRecordingKeywordsMatched :exec
+UPDATE recording
+SET keywords_matched_at = $1::timestamptz
+WHERE id = $2::integer
+`
+
+type SetRecordingKeywordsMatchedParams struct {
+	MatchedAt pgtype.Timestamptz
+	ID        int32
+}
+
+func (q *Queries) SetRecordingKeywordsMatched(ctx context.Context, arg SetRecordingKeywordsMatchedParams) error {
+	_, err := q.db.Exec(ctx, setRecordingKeywordsMatched, arg.MatchedAt, arg.ID)
+	return err
+}
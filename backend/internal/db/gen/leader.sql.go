@@ -0,0 +1,34 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: leader.sql
+
+package db
+
+import (
+	"context"
+)
+
+const tryLockLeader = `-- name: TryLockLeader :one
+SELECT pg_try_advisory_lock(hashtextextended('leader', 0))::boolean AS locked
+`
+
+// Takes the session-level advisory lock held by the leader, the process
+// that runs the background work meant for one process at a time. It is
+// held until UnlockLeader on the same connection, or until the connection
+// closes.
+func (q *Queries) TryLockLeader(ctx context.Context) (bool, error) {
+	row := q.db.QueryRow(ctx, tryLockLeader)
+	var locked bool
+	err := row.Scan(&locked)
+	return locked, err
+}
+
+const unlockLeader = `-- name: UnlockLeader :exec
+SELECT pg_advisory_unlock(hashtextextended('leader', 0))
+`
+
+func (q *Queries) UnlockLeader(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, unlockLeader)
+	return err
+}
@@ -0,0 +1,103 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: legal_holds.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const listRecordingHoldEvents = `-- name: ListRecordingHoldEvents :many
+SELECT id, held, reason, user_id, created_at
+FROM recording_hold_event
+WHERE recording_id = $1::integer
+ORDER BY created_at, id
+`
+
+type ListRecordingHoldEventsRow struct {
+	ID        int32
+	Held      bool
+	Reason    string
+	UserID    pgtype.Int4
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ListRecordingHoldEvents(ctx context.Context, recordingID int32) ([]ListRecordingHoldEventsRow, error) {
+	rows, err := q.db.Query(ctx, listRecordingHoldEvents, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecordingHoldEventsRow
+	for rows.Next() {
+		var i ListRecordingHoldEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Held,
+			&i.Reason,
+			&i.UserID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setRecordingLegalHold = `-- name: SetRecordingLegalHold :one
+WITH updated AS (
+  UPDATE recording
+  SET legal_hold = $1::boolean,
+      legal_hold_reason = CASE WHEN $1::boolean THEN $2::text END,
+      updated_at = now()
+  WHERE id = $4::integer
+  RETURNING id, name
+)
+INSERT INTO recording_hold_event (recording_id, recording_name, held, reason, user_id)
+SELECT id, name, $1::boolean, $2::text, $3::integer
+FROM updated
+RETURNING id, held, reason, user_id, created_at
+`
+
+type SetRecordingLegalHoldParams struct {
+	Held        bool
+	Reason      string
+	UserID      pgtype.Int4
+	RecordingID int32
+}
+
+type SetRecordingLegalHoldRow struct {
+	ID        int32
+	Held      bool
+	Reason    string
+	UserID    pgtype.Int4
+	CreatedAt pgtype.Timestamptz
+}
+
+// Places or lifts the hold and records who did it and why, in one
+// statement so the audit trail can't miss a change.
+func (q *Queries) SetRecordingLegalHold(ctx context.Context, arg SetRecordingLegalHoldParams) (SetRecordingLegalHoldRow, error) {
+	row := q.db.QueryRow(ctx, setRecordingLegalHold,
+		arg.Held,
+		arg.Reason,
+		arg.UserID,
+		arg.RecordingID,
+	)
+	var i SetRecordingLegalHoldRow
+	err := row.Scan(
+		&i.ID,
+		&i.Held,
+		&i.Reason,
+		&i.UserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
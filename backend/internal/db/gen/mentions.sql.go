@@ -0,0 +1,520 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: mentions.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const assignMentionedTodos = `-- name: AssignMentionedTodos :many
+WITH assigned AS (
+  UPDATE todo
+  SET user_id = pairs.user_id,
+      version = todo.version + 1,
+      updated_at = now()
+  FROM (
+    SELECT
+      unnest($2::integer[]) AS todo_id,
+      unnest($3::integer[]) AS user_id
+  ) AS pairs
+  WHERE todo.id = pairs.todo_id AND todo.user_id IS NULL
+  RETURNING todo.id, todo.name, todo."desc", todo.status, todo.user_id, todo.created_at_recording_id, todo.updated_at_recording_id
+)
+INSERT INTO todo_history (todo_id, actor_user_id, change_type, name, "desc", status, user_id, created_at_recording_id, updated_at_recording_id)
+SELECT id, $1::integer, 'update', name, "desc", status, user_id, created_at_recording_id, updated_at_recording_id
+FROM assigned
+RETURNING todo_id
+`
+
+type AssignMentionedTodosParams struct {
+	ActorUserID pgtype.Int4
+	TodoIds     []int32
+	UserIds     []int32
+}
+
+// Assigns todos to the people they name and records the change in the
+// todo history. Todos someone assigned in the meantime are left alone.
+func (q *Queries) AssignMentionedTodos(ctx context.Context, arg AssignMentionedTodosParams) ([]int32, error) {
+	rows, err := q.db.Query(ctx, assignMentionedTodos, arg.ActorUserID, arg.TodoIds, arg.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var todo_id int32
+		if err := rows.Scan(&todo_id); err != nil {
+			return nil, err
+		}
+		items = append(items, todo_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countUnreadMentions = `-- name: CountUnreadMentions :one
+SELECT (
+  (SELECT count(*) FROM transcript_mention m WHERE m.user_id = $1 AND m.read_at IS NULL)
+  + (SELECT count(*) FROM todo_mention tm WHERE tm.user_id = $1 AND tm.read_at IS NULL)
+  + (SELECT count(*) FROM todo_comment_mention cm WHERE cm.user_id = $1 AND cm.read_at IS NULL)
+)::bigint AS unread
+`
+
+func (q *Queries) CountUnreadMentions(ctx context.Context, userID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnreadMentions, userID)
+	var unread int64
+	err := row.Scan(&unread)
+	return unread, err
+}
+
+const listCommentMentions = `-- name: ListCommentMentions :many
+SELECT
+  cm.id,
+  c.id AS comment_id,
+  c.body AS comment_text,
+  c.todo_id,
+  t.name AS todo_name,
+  t.created_at_recording_id AS recording_id,
+  r.name AS recording_name,
+  r.created_at AS recording_date,
+  cm.user_id,
+  cm.matched_text,
+  cm.read_at,
+  cm.created_at
+FROM todo_comment_mention cm
+JOIN todo_comment c ON c.id = cm.comment_id
+JOIN todo t ON t.id = c.todo_id
+LEFT JOIN recording r ON r.id = t.created_at_recording_id
+WHERE ($1::integer IS NULL OR cm.user_id = $1::integer)
+  AND ($2::integer IS NULL OR t.created_at_recording_id = $2::integer)
+  AND ($3::text IS NULL
+    OR c.body ILIKE '%' || $3::text || '%'
+    OR t.name ILIKE '%' || $3::text || '%')
+  AND (NOT $4::boolean OR cm.read_at IS NULL)
+ORDER BY cm.created_at DESC, cm.id DESC
+LIMIT $5
+`
+
+type ListCommentMentionsParams struct {
+	UserID      pgtype.Int4
+	RecordingID pgtype.Int4
+	Query       pgtype.Text
+	UnreadOnly  bool
+	MaxResults  int32
+}
+
+type ListCommentMentionsRow struct {
+	ID            int64
+	CommentID     int32
+	CommentText   string
+	TodoID        int32
+	TodoName      string
+	RecordingID   pgtype.Int4
+	RecordingName pgtype.Text
+	RecordingDate pgtype.Timestamptz
+	UserID        int32
+	MatchedText   string
+	ReadAt        pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+}
+
+func (q *Queries) ListCommentMentions(ctx context.Context, arg ListCommentMentionsParams) ([]ListCommentMentionsRow, error) {
+	rows, err := q.db.Query(ctx, listCommentMentions,
+		arg.UserID,
+		arg.RecordingID,
+		arg.Query,
+		arg.UnreadOnly,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCommentMentionsRow
+	for rows.Next() {
+		var i ListCommentMentionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommentID,
+			&i.CommentText,
+			&i.TodoID,
+			&i.TodoName,
+			&i.RecordingID,
+			&i.RecordingName,
+			&i.RecordingDate,
+			&i.UserID,
+			&i.MatchedText,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMentions = `-- name: ListMentions :many
+SELECT
+  m.id,
+  m.recording_id,
+  r.name AS recording_name,
+  r.created_at AS recording_date,
+  m.segment_index,
+  m.speaker_id,
+  m.user_id,
+  m.matched_text,
+  m.segment_text,
+  m.read_at,
+  m.created_at
+FROM transcript_mention m
+JOIN recording r ON r.id = m.recording_id
+WHERE ($1::integer IS NULL OR m.user_id = $1::integer)
+  AND ($2::integer IS NULL OR m.recording_id = $2::integer)
+  AND ($3::text IS NULL OR m.segment_text ILIKE '%' || $3::text || '%')
+  AND (NOT $4::boolean OR m.read_at IS NULL)
+ORDER BY m.created_at DESC, m.id DESC
+LIMIT $5
+`
+
+type ListMentionsParams struct {
+	UserID      pgtype.Int4
+	RecordingID pgtype.Int4
+	Query       pgtype.Text
+	UnreadOnly  bool
+	MaxResults  int32
+}
+
+type ListMentionsRow struct {
+	ID            int64
+	RecordingID   int32
+	RecordingName pgtype.Text
+	RecordingDate pgtype.Timestamptz
+	SegmentIndex  int32
+	SpeakerID     pgtype.Int4
+	UserID        int32
+	MatchedText   string
+	SegmentText   string
+	ReadAt        pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+}
+
+func (q *Queries) ListMentions(ctx context.Context, arg ListMentionsParams) ([]ListMentionsRow, error) {
+	rows, err := q.db.Query(ctx, listMentions,
+		arg.UserID,
+		arg.RecordingID,
+		arg.Query,
+		arg.UnreadOnly,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMentionsRow
+	for rows.Next() {
+		var i ListMentionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecordingID,
+			&i.RecordingName,
+			&i.RecordingDate,
+			&i.SegmentIndex,
+			&i.SpeakerID,
+			&i.UserID,
+			&i.MatchedText,
+			&i.SegmentText,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecordingTodos = `-- name: ListRecordingTodos :many
+SELECT id, name, "desc", user_id
+FROM todo
+WHERE created_at_recording_id = $1
+ORDER BY id
+`
+
+type ListRecordingTodosRow struct {
+	ID     int32
+	Name   string
+	Desc   pgtype.Text
+	UserID pgtype.Int4
+}
+
+func (q *Queries) ListRecordingTodos(ctx context.Context, createdAtRecordingID pgtype.Int4) ([]ListRecordingTodosRow, error) {
+	rows, err := q.db.Query(ctx, listRecordingTodos, createdAtRecordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecordingTodosRow
+	for rows.Next() {
+		var i ListRecordingTodosRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Desc,
+			&i.UserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTodoMentions = `-- name: ListTodoMentions :many
+SELECT
+  tm.id,
+  tm.todo_id,
+  t.name AS todo_name,
+  t.created_at_recording_id AS recording_id,
+  r.name AS recording_name,
+  r.created_at AS recording_date,
+  tm.user_id,
+  tm.matched_text,
+  tm.read_at,
+  tm.created_at
+FROM todo_mention tm
+JOIN todo t ON t.id = tm.todo_id
+LEFT JOIN recording r ON r.id = t.created_at_recording_id
+WHERE ($1::integer IS NULL OR tm.user_id = $1::integer)
+  AND ($2::integer IS NULL OR t.created_at_recording_id = $2::integer)
+  AND ($3::text IS NULL
+    OR t.name ILIKE '%' || $3::text || '%'
+    OR t."desc" ILIKE '%' || $3::text || '%')
+  AND (NOT $4::boolean OR tm.read_at IS NULL)
+ORDER BY tm.created_at DESC, tm.id DESC
+LIMIT $5
+`
+
+type ListTodoMentionsParams struct {
+	UserID      pgtype.Int4
+	RecordingID pgtype.Int4
+	Query       pgtype.Text
+	UnreadOnly  bool
+	MaxResults  int32
+}
+
+type ListTodoMentionsRow struct {
+	ID            int64
+	TodoID        int32
+	TodoName      string
+	RecordingID   pgtype.Int4
+	RecordingName pgtype.Text
+	RecordingDate pgtype.Timestamptz
+	UserID        int32
+	MatchedText   string
+	ReadAt        pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+}
+
+func (q *Queries) ListTodoMentions(ctx context.Context, arg ListTodoMentionsParams) ([]ListTodoMentionsRow, error) {
+	rows, err := q.db.Query(ctx, listTodoMentions,
+		arg.UserID,
+		arg.RecordingID,
+		arg.Query,
+		arg.UnreadOnly,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTodoMentionsRow
+	for rows.Next() {
+		var i ListTodoMentionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TodoID,
+			&i.TodoName,
+			&i.RecordingID,
+			&i.RecordingName,
+			&i.RecordingDate,
+			&i.UserID,
+			&i.MatchedText,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const replaceTodoMentions = `-- name: ReplaceTodoMentions :exec
+WITH previous AS (
+  DELETE FROM todo_mention
+  USING todo
+  WHERE todo.id = todo_mention.todo_id AND todo.created_at_recording_id = $4
+  RETURNING todo_mention.todo_id, todo_mention.user_id, todo_mention.read_at, todo_mention.created_at
+)
+INSERT INTO todo_mention (todo_id, user_id, matched_text, read_at, created_at)
+SELECT detected.todo_id, detected.user_id, detected.matched_text, previous.read_at, COALESCE(previous.created_at, now())
+FROM (
+  SELECT
+    unnest($1::integer[]) AS todo_id,
+    unnest($2::integer[]) AS user_id,
+    unnest($3::text[]) AS matched_text
+) AS detected
+LEFT JOIN previous ON previous.todo_id = detected.todo_id AND previous.user_id = detected.user_id
+`
+
+type ReplaceTodoMentionsParams struct {
+	TodoIds      []int32
+	UserIds      []int32
+	MatchedTexts []string
+	RecordingID  pgtype.Int4
+}
+
+// Like ReplaceTranscriptMentions, for the todos of one recording.
+func (q *Queries) ReplaceTodoMentions(ctx context.Context, arg ReplaceTodoMentionsParams) error {
+	_, err := q.db.Exec(ctx, replaceTodoMentions,
+		arg.TodoIds,
+		arg.UserIds,
+		arg.MatchedTexts,
+		arg.RecordingID,
+	)
+	return err
+}
+
+const replaceTranscriptMentions = `-- name: ReplaceTranscriptMentions :exec
+WITH previous AS (
+  DELETE FROM transcript_mention
+  WHERE transcript_mention.recording_id = $1
+  RETURNING transcript_mention.segment_index, transcript_mention.user_id, transcript_mention.read_at, transcript_mention.created_at
+)
+INSERT INTO transcript_mention (recording_id, segment_index, speaker_id, user_id, matched_text, segment_text, read_at, created_at)
+SELECT $1, detected.segment_index, NULLIF(detected.speaker_id, -1), detected.user_id, detected.matched_text, detected.segment_text,
+  previous.read_at, COALESCE(previous.created_at, now())
+FROM (
+  SELECT
+    unnest($2::integer[]) AS segment_index,
+    unnest($3::integer[]) AS speaker_id,
+    unnest($4::integer[]) AS user_id,
+    unnest($5::text[]) AS matched_text,
+    unnest($6::text[]) AS segment_text
+) AS detected
+LEFT JOIN previous ON previous.segment_index = detected.segment_index AND previous.user_id = detected.user_id
+`
+
+type ReplaceTranscriptMentionsParams struct {
+	RecordingID    int32
+	SegmentIndexes []int32
+	SpeakerIds     []int32
+	UserIds        []int32
+	MatchedTexts   []string
+	SegmentTexts   []string
+}
+
+// Swaps a recording's mentions for a freshly detected set in one
+// statement, so relinking after a transcript correction leaves no stale
+// rows behind. Mentions found again keep when they were first found and
+// whether they were read.
+func (q *Queries) ReplaceTranscriptMentions(ctx context.Context, arg ReplaceTranscriptMentionsParams) error {
+	_, err := q.db.Exec(ctx, replaceTranscriptMentions,
+		arg.RecordingID,
+		arg.SegmentIndexes,
+		arg.SpeakerIds,
+		arg.UserIds,
+		arg.MatchedTexts,
+		arg.SegmentTexts,
+	)
+	return err
+}
+
+const setCommentMentionsRead = `-- name: SetCommentMentionsRead :exec
+UPDATE todo_comment_mention
+SET read_at = CASE WHEN $1::boolean THEN COALESCE(read_at, now()) ELSE NULL END
+WHERE user_id = $2 AND ($3::boolean OR id = ANY($4::bigint[]))
+`
+
+type SetCommentMentionsReadParams struct {
+	Read        bool
+	UserID      int32
+	AllMentions bool
+	Ids         []int64
+}
+
+func (q *Queries) SetCommentMentionsRead(ctx context.Context, arg SetCommentMentionsReadParams) error {
+	_, err := q.db.Exec(ctx, setCommentMentionsRead,
+		arg.Read,
+		arg.UserID,
+		arg.AllMentions,
+		arg.Ids,
+	)
+	return err
+}
+
+const setTodoMentionsRead = `-- name: SetTodoMentionsRead :exec
+UPDATE todo_mention
+SET read_at = CASE WHEN $1::boolean THEN COALESCE(read_at, now()) ELSE NULL END
+WHERE user_id = $2 AND ($3::boolean OR id = ANY($4::bigint[]))
+`
+
+type SetTodoMentionsReadParams struct {
+	Read        bool
+	UserID      int32
+	AllMentions bool
+	Ids         []int64
+}
+
+func (q *Queries) SetTodoMentionsRead(ctx context.Context, arg SetTodoMentionsReadParams) error {
+	_, err := q.db.Exec(ctx, setTodoMentionsRead,
+		arg.Read,
+		arg.UserID,
+		arg.AllMentions,
+		arg.Ids,
+	)
+	return err
+}
+
+const setTranscriptMentionsRead = `-- name: SetTranscriptMentionsRead :exec
+UPDATE transcript_mention
+SET read_at = CASE WHEN $1::boolean THEN COALESCE(read_at, now()) ELSE NULL END
+WHERE user_id = $2 AND ($3::boolean OR id = ANY($4::bigint[]))
+`
+
+type SetTranscriptMentionsReadParams struct {
+	Read        bool
+	UserID      int32
+	AllMentions bool
+	Ids         []int64
+}
+
+func (q *Queries) SetTranscriptMentionsRead(ctx context.Context, arg SetTranscriptMentionsReadParams) error {
+	_, err := q.db.Exec(ctx, setTranscriptMentionsRead,
+		arg.Read,
+		arg.UserID,
+		arg.AllMentions,
+		arg.Ids,
+	)
+	return err
+}
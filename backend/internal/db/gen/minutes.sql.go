@@ -0,0 +1,168 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: minutes.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createMinutes = `-- name: CreateMinutes :one
+WITH latest AS (
+    SELECT COALESCE(MAX(version), 0)::integer AS version
+    FROM meeting_minutes
+    WHERE recording_id = $1::integer
+)
+INSERT INTO meeting_minutes (recording_id, version, document, source, created_by_user_id)
+SELECT $1::integer, latest.version + 1, $2::jsonb, $3::text, $4::integer
+FROM latest
+WHERE latest.version = $5::integer
+RETURNING recording_id, version, document, source, created_by_user_id, created_at
+`
+
+type CreateMinutesParams struct {
+	RecordingID     int32
+	Document        []byte
+	Source          string
+	CreatedByUserID pgtype.Int4
+	BaseVersion     int32
+}
+
+type CreateMinutesRow struct {
+	RecordingID     int32
+	Version         int32
+	Document        []byte
+	Source          string
+	CreatedByUserID pgtype.Int4
+	CreatedAt       pgtype.Timestamptz
+}
+
+// Adds the version after base_version, returning no row when base_version
+// is no longer the latest.
+func (q *Queries) CreateMinutes(ctx context.Context, arg CreateMinutesParams) (CreateMinutesRow, error) {
+	row := q.db.QueryRow(ctx, createMinutes,
+		arg.RecordingID,
+		arg.Document,
+		arg.Source,
+		arg.CreatedByUserID,
+		arg.BaseVersion,
+	)
+	var i CreateMinutesRow
+	err := row.Scan(
+		&i.RecordingID,
+		&i.Version,
+		&i.Document,
+		&i.Source,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLatestMinutes = `-- name: GetLatestMinutes :one
+SELECT recording_id, version, document, source, created_by_user_id, created_at
+FROM meeting_minutes
+WHERE recording_id = $1
+ORDER BY version DESC
+LIMIT 1
+`
+
+type GetLatestMinutesRow struct {
+	RecordingID     int32
+	Version         int32
+	Document        []byte
+	Source          string
+	CreatedByUserID pgtype.Int4
+	CreatedAt       pgtype.Timestamptz
+}
+
+func (q *Queries) GetLatestMinutes(ctx context.Context, recordingID int32) (GetLatestMinutesRow, error) {
+	row := q.db.QueryRow(ctx, getLatestMinutes, recordingID)
+	var i GetLatestMinutesRow
+	err := row.Scan(
+		&i.RecordingID,
+		&i.Version,
+		&i.Document,
+		&i.Source,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getMinutesVersion = `-- name: GetMinutesVersion :one
+SELECT recording_id, version, document, source, created_by_user_id, created_at
+FROM meeting_minutes
+WHERE recording_id = $1 AND version = $2
+`
+
+type GetMinutesVersionParams struct {
+	RecordingID int32
+	Version     int32
+}
+
+type GetMinutesVersionRow struct {
+	RecordingID     int32
+	Version         int32
+	Document        []byte
+	Source          string
+	CreatedByUserID pgtype.Int4
+	CreatedAt       pgtype.Timestamptz
+}
+
+func (q *Queries) GetMinutesVersion(ctx context.Context, arg GetMinutesVersionParams) (GetMinutesVersionRow, error) {
+	row := q.db.QueryRow(ctx, getMinutesVersion, arg.RecordingID, arg.Version)
+	var i GetMinutesVersionRow
+	err := row.Scan(
+		&i.RecordingID,
+		&i.Version,
+		&i.Document,
+		&i.Source,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listMinutesVersions = `-- name: ListMinutesVersions :many
+SELECT version, source, created_by_user_id, created_at
+FROM meeting_minutes
+WHERE recording_id = $1
+ORDER BY version DESC
+`
+
+type ListMinutesVersionsRow struct {
+	Version         int32
+	Source          string
+	CreatedByUserID pgtype.Int4
+	CreatedAt       pgtype.Timestamptz
+}
+
+func (q *Queries) ListMinutesVersions(ctx context.Context, recordingID int32) ([]ListMinutesVersionsRow, error) {
+	rows, err := q.db.Query(ctx, listMinutesVersions, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMinutesVersionsRow
+	for rows.Next() {
+		var i ListMinutesVersionsRow
+		if err := rows.Scan(
+			&i.Version,
+			&i.Source,
+			&i.CreatedByUserID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
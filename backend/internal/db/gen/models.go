@@ -276,6 +276,36 @@ type Argument struct {
 	CreatedAt  pgtype.Timestamptz
 }
 
+type Attachment struct {
+	ID               int32
+	TodoID           pgtype.Int4
+	RecordingID      pgtype.Int4
+	FileName         string
+	ContentType      string
+	SizeBytes        int64
+	StorageKey       string
+	UploadedByUserID pgtype.Int4
+	CreatedAt        pgtype.Timestamptz
+	ScanStatus       string
+	ScanDetail       pgtype.Text
+	ScannedAt        pgtype.Timestamptz
+}
+
+type AuditLog struct {
+	ID          int64
+	CreatedAt   pgtype.Timestamptz
+	ActorUserID pgtype.Int4
+	Action      string
+	Detail      string
+	RequestID   string
+	KeyID       string
+	PrevHash    []byte
+	Hash        []byte
+	SessionID   string
+	Ip          string
+	Device      string
+}
+
 type Block struct {
 	ID            int32
 	DocumentID    int32
@@ -292,6 +322,14 @@ type BlockDocumentLink struct {
 	TargetDocumentID int32
 }
 
+type DataKey struct {
+	ID          int32
+	WrappedKey  []byte
+	MasterKeyID string
+	CreatedAt   pgtype.Timestamptz
+	OrgID       pgtype.Text
+}
+
 type Directory struct {
 	ID          int32
 	WorkspaceID int32
@@ -322,6 +360,38 @@ type DocumentHistory struct {
 	CapturedAt    pgtype.Timestamptz
 }
 
+type Favorite struct {
+	ID          int64
+	UserID      int32
+	RecordingID pgtype.Int4
+	TodoID      pgtype.Int4
+	CreatedAt   pgtype.Timestamptz
+}
+
+type FeatureFlag struct {
+	Name            string
+	Enabled         bool
+	UpdatedAt       pgtype.Timestamptz
+	UpdatedByUserID pgtype.Int4
+}
+
+type FeatureFlagUser struct {
+	Name      string
+	UserID    int32
+	Enabled   bool
+	UpdatedAt pgtype.Timestamptz
+}
+
+type GuestParticipant struct {
+	ID              int32
+	RecordingID     int32
+	SpeakerID       pgtype.Int4
+	Name            string
+	Email           pgtype.Text
+	CreatedByUserID pgtype.Int4
+	CreatedAt       pgtype.Timestamptz
+}
+
 type Issue struct {
 	ID        int32
 	TopicID   int32
@@ -336,6 +406,123 @@ type IssuePosition struct {
 	ArgumentID int32
 }
 
+type KeywordAlert struct {
+	ID           int64
+	KeywordID    int32
+	RecordingID  int32
+	SegmentIndex int32
+	SegmentText  string
+	CreatedAt    pgtype.Timestamptz
+}
+
+type MeetingMinute struct {
+	ID              int32
+	RecordingID     int32
+	Version         int32
+	Document        []byte
+	Source          string
+	CreatedByUserID pgtype.Int4
+	CreatedAt       pgtype.Timestamptz
+}
+
+type MeetingOutcome struct {
+	ID              int32
+	RecordingID     int32
+	Kind            string
+	Text            string
+	OwnerUserID     pgtype.Int4
+	Quote           pgtype.Text
+	SourceKind      string
+	ResolvedAt      pgtype.Timestamptz
+	CreatedByUserID pgtype.Int4
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+}
+
+type NotificationPreference struct {
+	UserID          int32
+	EmailEnabled    bool
+	ChatEnabled     bool
+	PushEnabled     bool
+	MutedEvents     []string
+	DigestFrequency string
+	UpdatedAt       pgtype.Timestamptz
+}
+
+type OrgSetting struct {
+	ID                      bool
+	OrgName                 string
+	LogoKey                 pgtype.Text
+	DefaultUserRole         string
+	AudioRetentionDays      int32
+	TranscriptRetentionDays int32
+	DisabledIntegrations    []string
+	UpdatedAt               pgtype.Timestamptz
+	UpdatedByUserID         pgtype.Int4
+	RedactEmails            bool
+	RedactPhoneNumbers      bool
+	RedactCreditCards       bool
+	RedactProfanity         bool
+	DefaultLanguage         string
+	DisableSummary          bool
+	DisableTodoExtraction   bool
+	MaintenanceMode         bool
+	MaintenanceMessage      string
+	MaintenanceStartedAt    pgtype.Timestamptz
+	IpAllowlist             []string
+	OrgID                   string
+}
+
+type PendingUpload struct {
+	ID              int32
+	AudioKey        string
+	Name            string
+	ContentType     string
+	SizeBytes       int64
+	CreatedByUserID pgtype.Int4
+	ExpiresAt       pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+	Sha256          pgtype.Text
+}
+
+type PromptTemplate struct {
+	ID                  int32
+	Name                string
+	Description         string
+	SummaryInstructions string
+	ExtractionGuidance  string
+	CreatedByUserID     pgtype.Int4
+	CreatedAt           pgtype.Timestamptz
+	UpdatedAt           pgtype.Timestamptz
+}
+
+type ProviderUsage struct {
+	ID           int64
+	Provider     string
+	Kind         string
+	Model        string
+	RecordingID  pgtype.Int4
+	Succeeded    bool
+	InputTokens  int64
+	OutputTokens int64
+	AudioSeconds float64
+	CostMicros   int64
+	DurationMs   int32
+	Error        pgtype.Text
+	CreatedAt    pgtype.Timestamptz
+}
+
+type PushDevice struct {
+	ID          int32
+	UserID      int32
+	Platform    string
+	Token       string
+	Name        string
+	MutedEvents []string
+	CreatedAt   pgtype.Timestamptz
+	LastSeenAt  pgtype.Timestamptz
+}
+
 type QbafResult struct {
 	RunID         int32
 	ArgumentID    int32
@@ -350,18 +537,157 @@ type QbafRun struct {
 	CreatedAt pgtype.Timestamptz
 }
 
+type QuotaOverride struct {
+	ID          int32
+	UserID      pgtype.Int4
+	Metric      string
+	LimitValue  int64
+	Reason      string
+	ExpiresAt   pgtype.Timestamptz
+	SetByUserID pgtype.Int4
+	CreatedAt   pgtype.Timestamptz
+}
+
+type Reaction struct {
+	ID        int64
+	UserID    int32
+	TodoID    pgtype.Int4
+	CommentID pgtype.Int4
+	Emoji     string
+	CreatedAt pgtype.Timestamptz
+}
+
 type Recording struct {
-	ID         int32
-	CreatedAt  pgtype.Timestamptz
-	Name       pgtype.Text
-	AudioUrl   pgtype.Text
-	Transcript pgtype.Text
-	Summary    pgtype.Text
-	LocalAudio pgtype.Text
-	NasAudio   pgtype.Text
-	Duration   pgtype.Int4
-	Notes      pgtype.Text
-	Archived   pgtype.Bool
+	ID                    int32
+	CreatedAt             pgtype.Timestamptz
+	Name                  pgtype.Text
+	AudioUrl              pgtype.Text
+	Transcript            pgtype.Text
+	Summary               pgtype.Text
+	LocalAudio            pgtype.Text
+	NasAudio              pgtype.Text
+	Duration              pgtype.Int4
+	Notes                 pgtype.Text
+	Archived              pgtype.Bool
+	AudioKey              pgtype.Text
+	UpdatedAt             pgtype.Timestamptz
+	Status                string
+	StatusError           pgtype.Text
+	StatusUpdatedAt       pgtype.Timestamptz
+	CreatedByUserID       pgtype.Int4
+	AudioBytes            pgtype.Int8
+	Sentiment             pgtype.Text
+	PromptTemplateID      pgtype.Int4
+	RetainUntil           pgtype.Timestamptz
+	AudioPurgedAt         pgtype.Timestamptz
+	TranscriptPurgedAt    pgtype.Timestamptz
+	LegalHold             bool
+	LegalHoldReason       pgtype.Text
+	AudioSha256           pgtype.Text
+	ScanStatus            string
+	ScanDetail            pgtype.Text
+	ScannedAt             pgtype.Timestamptz
+	KeywordsMatchedAt     pgtype.Timestamptz
+	ClonedFromRecordingID pgtype.Int4
+	ProcessingSettings    []byte
+	ProcessingDismissedAt pgtype.Timestamptz
+}
+
+type RecordingAnnotation struct {
+	ID           int32
+	RecordingID  int32
+	StartMs      int32
+	EndMs        int32
+	Note         string
+	AuthorUserID pgtype.Int4
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+}
+
+type RecordingClip struct {
+	ID                int32
+	RecordingID       int32
+	StartMs           int32
+	EndMs             int32
+	Title             string
+	TranscriptExcerpt string
+	AudioKey          string
+	AudioBytes        int64
+	ShareToken        string
+	CreatedByUserID   pgtype.Int4
+	CreatedAt         pgtype.Timestamptz
+}
+
+type RecordingHoldEvent struct {
+	ID            int32
+	RecordingID   pgtype.Int4
+	RecordingName pgtype.Text
+	Held          bool
+	Reason        string
+	UserID        pgtype.Int4
+	CreatedAt     pgtype.Timestamptz
+}
+
+type RecordingIngest struct {
+	RecordingID int32
+	UserID      int32
+	SampleRate  int32
+	Channels    int32
+	StartedAt   pgtype.Timestamptz
+	FinalizedAt pgtype.Timestamptz
+}
+
+type RecordingIngestChunk struct {
+	RecordingID int32
+	Seq         int32
+	SizeBytes   int64
+	ReceivedAt  pgtype.Timestamptz
+}
+
+type RecordingProcessingAttempt struct {
+	ID                int32
+	RecordingID       int32
+	Stage             string
+	Attempt           int32
+	Status            string
+	Error             pgtype.Text
+	RequestedByUserID pgtype.Int4
+	CreatedAt         pgtype.Timestamptz
+	StartedAt         pgtype.Timestamptz
+	FinishedAt        pgtype.Timestamptz
+}
+
+type RecordingPublication struct {
+	ID                int32
+	RecordingID       int32
+	Target            string
+	ExternalID        string
+	Url               string
+	PublishedByUserID pgtype.Int4
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+}
+
+type RecordingStatusEvent struct {
+	ID          int32
+	RecordingID int32
+	FromStatus  string
+	ToStatus    string
+	Error       pgtype.Text
+	CreatedAt   pgtype.Timestamptz
+}
+
+type RecordingTranslation struct {
+	ID                int32
+	RecordingID       int32
+	Kind              string
+	Language          string
+	Text              string
+	Provider          string
+	Model             string
+	RequestedByUserID pgtype.Int4
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
 }
 
 type Relation struct {
@@ -374,6 +700,56 @@ type Relation struct {
 	CreatedAt pgtype.Timestamptz
 }
 
+type ResumableUpload struct {
+	ID                 int32
+	UserID             int32
+	Name               string
+	Extension          string
+	LengthBytes        int64
+	ReceivedBytes      int64
+	RecordingID        pgtype.Int4
+	ExpiresAt          pgtype.Timestamptz
+	CreatedAt          pgtype.Timestamptz
+	UpdatedAt          pgtype.Timestamptz
+	Sha256             pgtype.Text
+	ProcessingSettings []byte
+	PromptTemplateID   pgtype.Int4
+}
+
+type ResumableUploadPart struct {
+	UploadID  int32
+	StartByte int64
+	SizeBytes int64
+	ObjectKey string
+}
+
+type ScheduledTask struct {
+	Name            string
+	Schedule        pgtype.Text
+	Paused          bool
+	LastSlotAt      pgtype.Timestamptz
+	LastStartedAt   pgtype.Timestamptz
+	LastFinishedAt  pgtype.Timestamptz
+	LastSucceededAt pgtype.Timestamptz
+	LastError       pgtype.Text
+	UpdatedAt       pgtype.Timestamptz
+}
+
+type ScimGroup struct {
+	ID          int32
+	DisplayName string
+	ExternalID  pgtype.Text
+	Role        pgtype.Text
+	WorkspaceID pgtype.Int4
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type ScimGroupMember struct {
+	GroupID int32
+	UserID  int32
+}
+
 type SpeakerToUser struct {
 	RecordingID int32
 	SpeakerID   int32
@@ -395,6 +771,28 @@ type Todo struct {
 	UpdatedAtRecordingID pgtype.Int4
 	CreatedAt            pgtype.Timestamptz
 	UpdatedAt            pgtype.Timestamptz
+	DueAt                pgtype.Timestamptz
+	Version              int32
+	SnoozedUntil         pgtype.Timestamptz
+	Priority             int16
+}
+
+type TodoComment struct {
+	ID           int32
+	TodoID       int32
+	Body         string
+	AuthorUserID pgtype.Int4
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+}
+
+type TodoCommentMention struct {
+	ID          int64
+	CommentID   int32
+	UserID      int32
+	MatchedText string
+	ReadAt      pgtype.Timestamptz
+	CreatedAt   pgtype.Timestamptz
 }
 
 type TodoHistory struct {
@@ -409,6 +807,31 @@ type TodoHistory struct {
 	CreatedAtRecordingID pgtype.Int4
 	UpdatedAtRecordingID pgtype.Int4
 	ChangedAt            pgtype.Timestamptz
+	Note                 pgtype.Text
+}
+
+type TodoMention struct {
+	ID          int64
+	TodoID      int32
+	UserID      int32
+	MatchedText string
+	ReadAt      pgtype.Timestamptz
+	CreatedAt   pgtype.Timestamptz
+}
+
+type TodoTrackerLink struct {
+	ID              int32
+	TodoID          int32
+	Tracker         string
+	ExternalID      string
+	ExternalKey     string
+	Url             string
+	ExternalState   string
+	CreatedByUserID pgtype.Int4
+	CreatedAt       pgtype.Timestamptz
+	SyncedAt        pgtype.Timestamptz
+	SyncedStatus    string
+	PolledAt        pgtype.Timestamptz
 }
 
 type Topic struct {
@@ -418,13 +841,89 @@ type Topic struct {
 	CreatedAt pgtype.Timestamptz
 }
 
+type TranscriptMention struct {
+	ID           int64
+	RecordingID  int32
+	SegmentIndex int32
+	SpeakerID    pgtype.Int4
+	UserID       int32
+	MatchedText  string
+	SegmentText  string
+	CreatedAt    pgtype.Timestamptz
+	ReadAt       pgtype.Timestamptz
+}
+
+type TranscriptRevision struct {
+	ID              int32
+	RecordingID     int32
+	Revision        int32
+	Transcript      string
+	Source          string
+	CreatedByUserID pgtype.Int4
+	CreatedAt       pgtype.Timestamptz
+}
+
+type TranscriptSegment struct {
+	RecordingID  int32
+	SegmentIndex int32
+	Confidence   float32
+}
+
+type UsageEvent struct {
+	ID          int64
+	UserID      pgtype.Int4
+	RecordingID pgtype.Int4
+	Metric      string
+	Quantity    int64
+	CreatedAt   pgtype.Timestamptz
+}
+
 type User struct {
-	ID           int32
-	FirstName    string
-	LastName     pgtype.Text
-	Role         pgtype.Text
-	Email        pgtype.Text
-	PasswordHash pgtype.Text
+	ID                         int32
+	FirstName                  string
+	LastName                   pgtype.Text
+	Role                       pgtype.Text
+	Email                      pgtype.Text
+	PasswordHash               pgtype.Text
+	EmailVerifiedAt            pgtype.Timestamptz
+	PendingEmail               pgtype.Text
+	EmailVerificationHash      pgtype.Text
+	EmailVerificationExpiresAt pgtype.Timestamptz
+	Timezone                   string
+	AvatarKey                  pgtype.Text
+	Locale                     pgtype.Text
+	DeactivatedAt              pgtype.Timestamptz
+	ScimProvisioned            bool
+	ScimExternalID             pgtype.Text
+	TodoFeedGeneration         int32
+}
+
+type UserDevice struct {
+	UserID      int32
+	Fingerprint string
+	Device      string
+	FirstSeenAt pgtype.Timestamptz
+	LastSeenAt  pgtype.Timestamptz
+}
+
+type UserSession struct {
+	ID                string
+	UserID            int32
+	UserAgent         string
+	Ip                string
+	CreatedAt         pgtype.Timestamptz
+	ExpiresAt         pgtype.Timestamptz
+	LastSeenAt        pgtype.Timestamptz
+	RevokedAt         pgtype.Timestamptz
+	Location          string
+	DeviceFingerprint string
+}
+
+type WatchKeyword struct {
+	ID        int32
+	UserID    int32
+	Keyword   string
+	CreatedAt pgtype.Timestamptz
 }
 
 type WhatsappChat struct {
@@ -475,4 +974,5 @@ type WorkspaceUserRel struct {
 	UserID      int32
 	Role        pgtype.Text
 	CreatedAt   pgtype.Timestamptz
+	ViaScim     bool
 }
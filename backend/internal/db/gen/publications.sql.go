@@ -0,0 +1,117 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: publications.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getRecordingPublication = `-- name: GetRecordingPublication :one
+SELECT id, recording_id, target, external_id, url, published_by_user_id, created_at, updated_at
+FROM recording_publication
+WHERE recording_id = $1 AND target = $2
+`
+
+type GetRecordingPublicationParams struct {
+	RecordingID int32
+	Target      string
+}
+
+func (q *Queries) GetRecordingPublication(ctx context.Context, arg GetRecordingPublicationParams) (RecordingPublication, error) {
+	row := q.db.QueryRow(ctx, getRecordingPublication, arg.RecordingID, arg.Target)
+	var i RecordingPublication
+	err := row.Scan(
+		&i.ID,
+		&i.RecordingID,
+		&i.Target,
+		&i.ExternalID,
+		&i.Url,
+		&i.PublishedByUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listRecordingPublications = `-- name: ListRecordingPublications :many
+SELECT id, recording_id, target, external_id, url, published_by_user_id, created_at, updated_at
+FROM recording_publication
+WHERE recording_id = $1
+ORDER BY target
+`
+
+func (q *Queries) ListRecordingPublications(ctx context.Context, recordingID int32) ([]RecordingPublication, error) {
+	rows, err := q.db.Query(ctx, listRecordingPublications, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RecordingPublication
+	for rows.Next() {
+		var i RecordingPublication
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecordingID,
+			&i.Target,
+			&i.ExternalID,
+			&i.Url,
+			&i.PublishedByUserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const saveRecordingPublication = `-- name: SaveRecordingPublication :one
+INSERT INTO recording_publication (recording_id, target, external_id, url, published_by_user_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (recording_id, target) DO UPDATE
+SET external_id = EXCLUDED.external_id,
+    url = EXCLUDED.url,
+    published_by_user_id = EXCLUDED.published_by_user_id,
+    updated_at = now()
+RETURNING id, recording_id, target, external_id, url, published_by_user_id, created_at, updated_at
+`
+
+type SaveRecordingPublicationParams struct {
+	RecordingID       int32
+	Target            string
+	ExternalID        string
+	Url               string
+	PublishedByUserID pgtype.Int4
+}
+
+// Publishing again replaces the page, so one row per target is kept.
+func (q *Queries) SaveRecordingPublication(ctx context.Context, arg SaveRecordingPublicationParams) (RecordingPublication, error) {
+	row := q.db.QueryRow(ctx, saveRecordingPublication,
+		arg.RecordingID,
+		arg.Target,
+		arg.ExternalID,
+		arg.Url,
+		arg.PublishedByUserID,
+	)
+	var i RecordingPublication
+	err := row.Scan(
+		&i.ID,
+		&i.RecordingID,
+		&i.Target,
+		&i.ExternalID,
+		&i.Url,
+		&i.PublishedByUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
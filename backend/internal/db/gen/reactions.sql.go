@@ -0,0 +1,109 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: reactions.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addReaction = `-- name: AddReaction :exec
+INSERT INTO reaction (user_id, todo_id, comment_id, emoji)
+VALUES ($1, $2::integer, $3::integer, $4)
+ON CONFLICT DO NOTHING
+`
+
+type AddReactionParams struct {
+	UserID    int32
+	TodoID    pgtype.Int4
+	CommentID pgtype.Int4
+	Emoji     string
+}
+
+func (q *Queries) AddReaction(ctx context.Context, arg AddReactionParams) error {
+	_, err := q.db.Exec(ctx, addReaction,
+		arg.UserID,
+		arg.TodoID,
+		arg.CommentID,
+		arg.Emoji,
+	)
+	return err
+}
+
+const listReactions = `-- name: ListReactions :many
+SELECT
+  todo_id,
+  comment_id,
+  emoji,
+  array_agg(user_id ORDER BY created_at, id)::integer[] AS user_ids
+FROM reaction
+WHERE todo_id = ANY($1::integer[]) OR comment_id = ANY($2::integer[])
+GROUP BY todo_id, comment_id, emoji
+ORDER BY todo_id, comment_id, min(created_at), emoji
+`
+
+type ListReactionsParams struct {
+	TodoIds    []int32
+	CommentIds []int32
+}
+
+type ListReactionsRow struct {
+	TodoID    pgtype.Int4
+	CommentID pgtype.Int4
+	Emoji     string
+	UserIds   []int32
+}
+
+// Sums up the reactions to some todos and comments: one row per target
+// and emoji, the emoji in the order they were first used.
+func (q *Queries) ListReactions(ctx context.Context, arg ListReactionsParams) ([]ListReactionsRow, error) {
+	rows, err := q.db.Query(ctx, listReactions, arg.TodoIds, arg.CommentIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReactionsRow
+	for rows.Next() {
+		var i ListReactionsRow
+		if err := rows.Scan(
+			&i.TodoID,
+			&i.CommentID,
+			&i.Emoji,
+			&i.UserIds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeReaction = `-- name: RemoveReaction :exec
+DELETE FROM reaction
+WHERE user_id = $1 AND emoji = $2
+  AND (todo_id = $3::integer OR comment_id = $4::integer)
+`
+
+type RemoveReactionParams struct {
+	UserID    int32
+	Emoji     string
+	TodoID    pgtype.Int4
+	CommentID pgtype.Int4
+}
+
+func (q *Queries) RemoveReaction(ctx context.Context, arg RemoveReactionParams) error {
+	_, err := q.db.Exec(ctx, removeReaction,
+		arg.UserID,
+		arg.Emoji,
+		arg.TodoID,
+		arg.CommentID,
+	)
+	return err
+}
@@ -11,13 +11,333 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const deleteRecording = `-- name: DeleteRecording :exec
-DELETE FROM recording
-WHERE id = $1
+const addRecordingParticipant = `-- name: AddRecordingParticipant :exec
+INSERT INTO speaker_to_user (recording_id, speaker_id, user_id, words_spoken)
+VALUES ($1, $2, $3, $4)
+`
+
+type AddRecordingParticipantParams struct {
+	RecordingID int32
+	SpeakerID   int32
+	UserID      int32
+	WordsSpoken pgtype.Int4
+}
+
+func (q *Queries) AddRecordingParticipant(ctx context.Context, arg AddRecordingParticipantParams) error {
+	_, err := q.db.Exec(ctx, addRecordingParticipant,
+		arg.RecordingID,
+		arg.SpeakerID,
+		arg.UserID,
+		arg.WordsSpoken,
+	)
+	return err
+}
+
+const clearSpeakerLabel = `-- name: ClearSpeakerLabel :exec
+WITH users AS (
+  DELETE FROM speaker_to_user stu
+  WHERE stu.recording_id = $1::integer AND stu.speaker_id = $2::integer
+), guests AS (
+  UPDATE guest_participant g SET speaker_id = NULL
+  WHERE g.recording_id = $1::integer AND g.speaker_id = $2::integer
+)
+UPDATE recording r SET updated_at = now()
+WHERE r.id = $1::integer
+`
+
+type ClearSpeakerLabelParams struct {
+	RecordingID int32
+	SpeakerID   int32
+}
+
+// Unmaps a speaker label from whoever it was given to, user or guest, and
+// touches the recording so its ETag changes.
+func (q *Queries) ClearSpeakerLabel(ctx context.Context, arg ClearSpeakerLabelParams) error {
+	_, err := q.db.Exec(ctx, clearSpeakerLabel, arg.RecordingID, arg.SpeakerID)
+	return err
+}
+
+const cloneRecording = `-- name: CloneRecording :one
+INSERT INTO recording (created_at, name, audio_key, duration, created_by_user_id, audio_sha256, scan_status, prompt_template_id, processing_settings, cloned_from_recording_id)
+SELECT now(), $1::text, src.audio_key, src.duration, $2::integer, src.audio_sha256, src.scan_status, $3::integer, $4::jsonb, src.id
+FROM recording src
+WHERE src.id = $5::integer
+RETURNING id
+`
+
+type CloneRecordingParams struct {
+	Name               pgtype.Text
+	CreatedByUserID    pgtype.Int4
+	PromptTemplateID   pgtype.Int4
+	ProcessingSettings []byte
+	ID                 int32
+}
+
+// Copies a recording's metadata and audio reference into a new recording
+// waiting to be transcribed. The stored audio is shared, so it isn't
+// counted against the clone's creator again.
+func (q *Queries) CloneRecording(ctx context.Context, arg CloneRecordingParams) (int32, error) {
+	row := q.db.QueryRow(ctx, cloneRecording,
+		arg.Name,
+		arg.CreatedByUserID,
+		arg.PromptTemplateID,
+		arg.ProcessingSettings,
+		arg.ID,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createLiveRecording = `-- name: CreateLiveRecording :one
+INSERT INTO recording (created_at, name, created_by_user_id)
+VALUES (now(), $1, $2)
+RETURNING id, created_at, name
+`
+
+type CreateLiveRecordingParams struct {
+	Name            pgtype.Text
+	CreatedByUserID pgtype.Int4
+}
+
+type CreateLiveRecordingRow struct {
+	ID        int32
+	CreatedAt pgtype.Timestamptz
+	Name      pgtype.Text
+}
+
+func (q *Queries) CreateLiveRecording(ctx context.Context, arg CreateLiveRecordingParams) (CreateLiveRecordingRow, error) {
+	row := q.db.QueryRow(ctx, createLiveRecording, arg.Name, arg.CreatedByUserID)
+	var i CreateLiveRecordingRow
+	err := row.Scan(&i.ID, &i.CreatedAt, &i.Name)
+	return i, err
+}
+
+const createProcessingAttempt = `-- name: CreateProcessingAttempt :exec
+INSERT INTO recording_processing_attempt (recording_id, stage, attempt, status, requested_by_user_id, started_at)
+SELECT $1::integer, $2::text, COALESCE(MAX(attempt), 0) + 1, $3::text, $4::integer,
+  CASE WHEN $3::text = 'running' THEN now() END
+FROM recording_processing_attempt
+WHERE recording_id = $1::integer AND stage = $2::text
+`
+
+type CreateProcessingAttemptParams struct {
+	RecordingID       int32
+	Stage             string
+	Status            string
+	RequestedByUserID pgtype.Int4
+}
+
+func (q *Queries) CreateProcessingAttempt(ctx context.Context, arg CreateProcessingAttemptParams) error {
+	_, err := q.db.Exec(ctx, createProcessingAttempt,
+		arg.RecordingID,
+		arg.Stage,
+		arg.Status,
+		arg.RequestedByUserID,
+	)
+	return err
+}
+
+const createRecording = `-- name: CreateRecording :one
+INSERT INTO recording (created_at, name, transcript, summary, duration, status)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id
 `
 
-func (q *Queries) DeleteRecording(ctx context.Context, id int32) error {
-	_, err := q.db.Exec(ctx, deleteRecording, id)
+type CreateRecordingParams struct {
+	CreatedAt  pgtype.Timestamptz
+	Name       pgtype.Text
+	Transcript pgtype.Text
+	Summary    pgtype.Text
+	Duration   pgtype.Int4
+	Status     string
+}
+
+func (q *Queries) CreateRecording(ctx context.Context, arg CreateRecordingParams) (int32, error) {
+	row := q.db.QueryRow(ctx, createRecording,
+		arg.CreatedAt,
+		arg.Name,
+		arg.Transcript,
+		arg.Summary,
+		arg.Duration,
+		arg.Status,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createRecordingIngest = `-- name: CreateRecordingIngest :exec
+INSERT INTO recording_ingest (recording_id, user_id, sample_rate, channels)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateRecordingIngestParams struct {
+	RecordingID int32
+	UserID      int32
+	SampleRate  int32
+	Channels    int32
+}
+
+func (q *Queries) CreateRecordingIngest(ctx context.Context, arg CreateRecordingIngestParams) error {
+	_, err := q.db.Exec(ctx, createRecordingIngest,
+		arg.RecordingID,
+		arg.UserID,
+		arg.SampleRate,
+		arg.Channels,
+	)
+	return err
+}
+
+const createRecordingStatusEvent = `-- name: CreateRecordingStatusEvent :exec
+INSERT INTO recording_status_event (recording_id, from_status, to_status, error)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateRecordingStatusEventParams struct {
+	RecordingID int32
+	FromStatus  string
+	ToStatus    string
+	Error       pgtype.Text
+}
+
+func (q *Queries) CreateRecordingStatusEvent(ctx context.Context, arg CreateRecordingStatusEventParams) error {
+	_, err := q.db.Exec(ctx, createRecordingStatusEvent,
+		arg.RecordingID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.Error,
+	)
+	return err
+}
+
+const createUploadedRecording = `-- name: CreateUploadedRecording :one
+INSERT INTO recording (created_at, name, audio_key, audio_bytes, created_by_user_id, audio_sha256, scan_status, prompt_template_id, processing_settings)
+VALUES (now(), $1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, created_at, name
+`
+
+type CreateUploadedRecordingParams struct {
+	Name               pgtype.Text
+	AudioKey           pgtype.Text
+	AudioBytes         pgtype.Int8
+	CreatedByUserID    pgtype.Int4
+	AudioSha256        pgtype.Text
+	ScanStatus         string
+	PromptTemplateID   pgtype.Int4
+	ProcessingSettings []byte
+}
+
+type CreateUploadedRecordingRow struct {
+	ID        int32
+	CreatedAt pgtype.Timestamptz
+	Name      pgtype.Text
+}
+
+func (q *Queries) CreateUploadedRecording(ctx context.Context, arg CreateUploadedRecordingParams) (CreateUploadedRecordingRow, error) {
+	row := q.db.QueryRow(ctx, createUploadedRecording,
+		arg.Name,
+		arg.AudioKey,
+		arg.AudioBytes,
+		arg.CreatedByUserID,
+		arg.AudioSha256,
+		arg.ScanStatus,
+		arg.PromptTemplateID,
+		arg.ProcessingSettings,
+	)
+	var i CreateUploadedRecordingRow
+	err := row.Scan(&i.ID, &i.CreatedAt, &i.Name)
+	return i, err
+}
+
+const deleteRecording = `-- name: DeleteRecording :one
+DELETE FROM recording r
+WHERE r.id = $1 AND NOT r.legal_hold
+RETURNING
+  (CASE WHEN EXISTS (SELECT 1 FROM recording o WHERE o.audio_key = r.audio_key AND o.id <> r.id) THEN '' ELSE COALESCE(r.audio_key, '') END)::text AS audio_key,
+  ARRAY(SELECT c.audio_key FROM recording_clip c WHERE c.recording_id = r.id ORDER BY c.id)::text[] AS clip_audio_keys,
+  ARRAY(SELECT a.storage_key FROM attachment a WHERE a.recording_id = r.id ORDER BY a.id)::text[] AS attachment_keys
+`
+
+type DeleteRecordingRow struct {
+	AudioKey       string
+	ClipAudioKeys  []string
+	AttachmentKeys []string
+}
+
+// Recordings under legal hold are left alone. Returns the stored objects
+// only the deleted rows pointed at, for the caller to delete: the audio,
+// empty when there is none or a clone or a deduplicated upload shares it,
+// and the audio of its clips and its attachments.
+func (q *Queries) DeleteRecording(ctx context.Context, id int32) (DeleteRecordingRow, error) {
+	row := q.db.QueryRow(ctx, deleteRecording, id)
+	var i DeleteRecordingRow
+	err := row.Scan(&i.AudioKey, &i.ClipAudioKeys, &i.AttachmentKeys)
+	return i, err
+}
+
+const finalizeRecordingIngest = `-- name: FinalizeRecordingIngest :exec
+UPDATE recording_ingest
+SET finalized_at = now()
+WHERE recording_id = $1
+`
+
+func (q *Queries) FinalizeRecordingIngest(ctx context.Context, recordingID int32) error {
+	_, err := q.db.Exec(ctx, finalizeRecordingIngest, recordingID)
+	return err
+}
+
+const findRecordingByAudioHash = `-- name: FindRecordingByAudioHash :one
+SELECT id, created_at, name, status
+FROM recording
+WHERE audio_sha256 = $1::text
+ORDER BY id
+LIMIT 1
+`
+
+type FindRecordingByAudioHashRow struct {
+	ID        int32
+	CreatedAt pgtype.Timestamptz
+	Name      pgtype.Text
+	Status    string
+}
+
+// The oldest recording with the same audio, so re-uploads of a meeting
+// point at it instead of being transcribed again.
+func (q *Queries) FindRecordingByAudioHash(ctx context.Context, audioSha256 string) (FindRecordingByAudioHashRow, error) {
+	row := q.db.QueryRow(ctx, findRecordingByAudioHash, audioSha256)
+	var i FindRecordingByAudioHashRow
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Name,
+		&i.Status,
+	)
+	return i, err
+}
+
+const finishProcessingAttempts = `-- name: FinishProcessingAttempts :exec
+UPDATE recording_processing_attempt
+SET status = $3,
+    error = $4,
+    finished_at = now()
+WHERE recording_id = $1 AND stage = $2 AND finished_at IS NULL
+`
+
+type FinishProcessingAttemptsParams struct {
+	RecordingID int32
+	Stage       string
+	Status      string
+	Error       pgtype.Text
+}
+
+func (q *Queries) FinishProcessingAttempts(ctx context.Context, arg FinishProcessingAttemptsParams) error {
+	_, err := q.db.Exec(ctx, finishProcessingAttempts,
+		arg.RecordingID,
+		arg.Stage,
+		arg.Status,
+		arg.Error,
+	)
 	return err
 }
 
@@ -33,14 +353,67 @@ SELECT
   r.nas_audio,
   r.duration,
   r.notes,
-  r.archived
+  r.archived,
+  r.audio_key,
+  r.status,
+  r.status_error,
+  r.status_updated_at,
+  r.sentiment,
+  r.prompt_template_id,
+  r.retain_until,
+  r.audio_purged_at,
+  r.transcript_purged_at,
+  r.legal_hold,
+  r.legal_hold_reason,
+  r.scan_status,
+  r.processing_settings,
+  r.cloned_from_recording_id,
+  todos.open_todo_count,
+  todos.done_todo_count
 FROM recording r
+CROSS JOIN LATERAL (
+  SELECT
+    COUNT(*) FILTER (WHERE COALESCE(t.status, 'todo') NOT IN ('done', 'skipped'))::integer AS open_todo_count,
+    COUNT(*) FILTER (WHERE t.status = 'done')::integer AS done_todo_count
+  FROM todo t
+  WHERE t.created_at_recording_id = r.id
+) todos
 WHERE r.id = $1
 `
 
-func (q *Queries) GetRecording(ctx context.Context, id int32) (Recording, error) {
+type GetRecordingRow struct {
+	ID                    int32
+	CreatedAt             pgtype.Timestamptz
+	Name                  pgtype.Text
+	AudioUrl              pgtype.Text
+	Transcript            pgtype.Text
+	Summary               pgtype.Text
+	LocalAudio            pgtype.Text
+	NasAudio              pgtype.Text
+	Duration              pgtype.Int4
+	Notes                 pgtype.Text
+	Archived              pgtype.Bool
+	AudioKey              pgtype.Text
+	Status                string
+	StatusError           pgtype.Text
+	StatusUpdatedAt       pgtype.Timestamptz
+	Sentiment             pgtype.Text
+	PromptTemplateID      pgtype.Int4
+	RetainUntil           pgtype.Timestamptz
+	AudioPurgedAt         pgtype.Timestamptz
+	TranscriptPurgedAt    pgtype.Timestamptz
+	LegalHold             bool
+	LegalHoldReason       pgtype.Text
+	ScanStatus            string
+	ProcessingSettings    []byte
+	ClonedFromRecordingID pgtype.Int4
+	OpenTodoCount         int32
+	DoneTodoCount         int32
+}
+
+func (q *Queries) GetRecording(ctx context.Context, id int32) (GetRecordingRow, error) {
 	row := q.db.QueryRow(ctx, getRecording, id)
-	var i Recording
+	var i GetRecordingRow
 	err := row.Scan(
 		&i.ID,
 		&i.CreatedAt,
@@ -53,16 +426,269 @@ func (q *Queries) GetRecording(ctx context.Context, id int32) (Recording, error)
 		&i.Duration,
 		&i.Notes,
 		&i.Archived,
+		&i.AudioKey,
+		&i.Status,
+		&i.StatusError,
+		&i.StatusUpdatedAt,
+		&i.Sentiment,
+		&i.PromptTemplateID,
+		&i.RetainUntil,
+		&i.AudioPurgedAt,
+		&i.TranscriptPurgedAt,
+		&i.LegalHold,
+		&i.LegalHoldReason,
+		&i.ScanStatus,
+		&i.ProcessingSettings,
+		&i.ClonedFromRecordingID,
+		&i.OpenTodoCount,
+		&i.DoneTodoCount,
 	)
 	return i, err
 }
 
+const getRecordingIngest = `-- name: GetRecordingIngest :one
+SELECT recording_id, user_id, sample_rate, channels, started_at, finalized_at
+FROM recording_ingest
+WHERE recording_id = $1
+`
+
+func (q *Queries) GetRecordingIngest(ctx context.Context, recordingID int32) (RecordingIngest, error) {
+	row := q.db.QueryRow(ctx, getRecordingIngest, recordingID)
+	var i RecordingIngest
+	err := row.Scan(
+		&i.RecordingID,
+		&i.UserID,
+		&i.SampleRate,
+		&i.Channels,
+		&i.StartedAt,
+		&i.FinalizedAt,
+	)
+	return i, err
+}
+
+const getRecordingStatusForUpdate = `-- name: GetRecordingStatusForUpdate :one
+SELECT status
+FROM recording
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetRecordingStatusForUpdate(ctx context.Context, id int32) (string, error) {
+	row := q.db.QueryRow(ctx, getRecordingStatusForUpdate, id)
+	var status string
+	err := row.Scan(&status)
+	return status, err
+}
+
+const getRecordingVersion = `-- name: GetRecordingVersion :one
+SELECT
+  r.updated_at,
+  (SELECT COUNT(*) FROM todo t WHERE t.created_at_recording_id = r.id)::bigint AS todos,
+  (SELECT COALESCE(MAX(t.updated_at), 'epoch'::timestamptz) FROM todo t WHERE t.created_at_recording_id = r.id)::timestamptz AS todos_latest
+FROM recording r
+WHERE r.id = $1
+`
+
+type GetRecordingVersionRow struct {
+	UpdatedAt   pgtype.Timestamptz
+	Todos       int64
+	TodosLatest pgtype.Timestamptz
+}
+
+func (q *Queries) GetRecordingVersion(ctx context.Context, id int32) (GetRecordingVersionRow, error) {
+	row := q.db.QueryRow(ctx, getRecordingVersion, id)
+	var i GetRecordingVersionRow
+	err := row.Scan(&i.UpdatedAt, &i.Todos, &i.TodosLatest)
+	return i, err
+}
+
+const getRecordingsVersion = `-- name: GetRecordingsVersion :one
+SELECT
+  COUNT(*)::bigint AS total,
+  COALESCE(MAX(updated_at), 'epoch'::timestamptz)::timestamptz AS latest,
+  (SELECT COUNT(*) FROM todo WHERE created_at_recording_id IS NOT NULL)::bigint AS todos,
+  (SELECT COALESCE(MAX(updated_at), 'epoch'::timestamptz) FROM todo WHERE created_at_recording_id IS NOT NULL)::timestamptz AS todos_latest
+FROM recording
+`
+
+type GetRecordingsVersionRow struct {
+	Total       int64
+	Latest      pgtype.Timestamptz
+	Todos       int64
+	TodosLatest pgtype.Timestamptz
+}
+
+// Covers the todos of every recording too, whose counts the list shows.
+func (q *Queries) GetRecordingsVersion(ctx context.Context) (GetRecordingsVersionRow, error) {
+	row := q.db.QueryRow(ctx, getRecordingsVersion)
+	var i GetRecordingsVersionRow
+	err := row.Scan(
+		&i.Total,
+		&i.Latest,
+		&i.Todos,
+		&i.TodosLatest,
+	)
+	return i, err
+}
+
+const hasOpenProcessingAttempt = `-- name: HasOpenProcessingAttempt :one
+SELECT EXISTS (
+  SELECT 1 FROM recording_processing_attempt
+  WHERE recording_id = $1 AND stage = $2 AND finished_at IS NULL
+)
+`
+
+type HasOpenProcessingAttemptParams struct {
+	RecordingID int32
+	Stage       string
+}
+
+func (q *Queries) HasOpenProcessingAttempt(ctx context.Context, arg HasOpenProcessingAttemptParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasOpenProcessingAttempt, arg.RecordingID, arg.Stage)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listParticipantsForRecordings = `-- name: ListParticipantsForRecordings :many
+SELECT
+  stu.recording_id,
+  u.id,
+  u.first_name,
+  u.last_name,
+  u.role,
+  u.avatar_key,
+  stu.speaker_id
+FROM speaker_to_user stu
+JOIN "user" u ON u.id = stu.user_id
+WHERE stu.recording_id = ANY($1::integer[])
+ORDER BY stu.recording_id, stu.speaker_id
+`
+
+type ListParticipantsForRecordingsRow struct {
+	RecordingID int32
+	ID          int32
+	FirstName   string
+	LastName    pgtype.Text
+	Role        pgtype.Text
+	AvatarKey   pgtype.Text
+	SpeakerID   int32
+}
+
+func (q *Queries) ListParticipantsForRecordings(ctx context.Context, recordingIds []int32) ([]ListParticipantsForRecordingsRow, error) {
+	rows, err := q.db.Query(ctx, listParticipantsForRecordings, recordingIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListParticipantsForRecordingsRow
+	for rows.Next() {
+		var i ListParticipantsForRecordingsRow
+		if err := rows.Scan(
+			&i.RecordingID,
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Role,
+			&i.AvatarKey,
+			&i.SpeakerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProcessingAttempts = `-- name: ListProcessingAttempts :many
+SELECT stage, attempt, status, error, requested_by_user_id, created_at, started_at, finished_at
+FROM recording_processing_attempt
+WHERE recording_id = $1
+ORDER BY stage DESC, attempt
+`
+
+type ListProcessingAttemptsRow struct {
+	Stage             string
+	Attempt           int32
+	Status            string
+	Error             pgtype.Text
+	RequestedByUserID pgtype.Int4
+	CreatedAt         pgtype.Timestamptz
+	StartedAt         pgtype.Timestamptz
+	FinishedAt        pgtype.Timestamptz
+}
+
+func (q *Queries) ListProcessingAttempts(ctx context.Context, recordingID int32) ([]ListProcessingAttemptsRow, error) {
+	rows, err := q.db.Query(ctx, listProcessingAttempts, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProcessingAttemptsRow
+	for rows.Next() {
+		var i ListProcessingAttemptsRow
+		if err := rows.Scan(
+			&i.Stage,
+			&i.Attempt,
+			&i.Status,
+			&i.Error,
+			&i.RequestedByUserID,
+			&i.CreatedAt,
+			&i.StartedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecordingIngestChunks = `-- name: ListRecordingIngestChunks :many
+SELECT seq, size_bytes
+FROM recording_ingest_chunk
+WHERE recording_id = $1
+ORDER BY seq
+`
+
+type ListRecordingIngestChunksRow struct {
+	Seq       int32
+	SizeBytes int64
+}
+
+func (q *Queries) ListRecordingIngestChunks(ctx context.Context, recordingID int32) ([]ListRecordingIngestChunksRow, error) {
+	rows, err := q.db.Query(ctx, listRecordingIngestChunks, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecordingIngestChunksRow
+	for rows.Next() {
+		var i ListRecordingIngestChunksRow
+		if err := rows.Scan(&i.Seq, &i.SizeBytes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRecordingParticipants = `-- name: ListRecordingParticipants :many
 SELECT
   u.id,
   u.first_name,
   u.last_name,
   u.role,
+  u.avatar_key,
   stu.speaker_id
 FROM speaker_to_user stu
 JOIN "user" u ON u.id = stu.user_id
@@ -74,6 +700,7 @@ type ListRecordingParticipantsRow struct {
 	FirstName string
 	LastName  pgtype.Text
 	Role      pgtype.Text
+	AvatarKey pgtype.Text
 	SpeakerID int32
 }
 
@@ -91,6 +718,7 @@ func (q *Queries) ListRecordingParticipants(ctx context.Context, recordingID int
 			&i.FirstName,
 			&i.LastName,
 			&i.Role,
+			&i.AvatarKey,
 			&i.SpeakerID,
 		); err != nil {
 			return nil, err
@@ -103,6 +731,94 @@ func (q *Queries) ListRecordingParticipants(ctx context.Context, recordingID int
 	return items, nil
 }
 
+const listRecordingStatusEvents = `-- name: ListRecordingStatusEvents :many
+SELECT id, from_status, to_status, error, created_at
+FROM recording_status_event
+WHERE recording_id = $1
+ORDER BY created_at, id
+`
+
+type ListRecordingStatusEventsRow struct {
+	ID         int32
+	FromStatus string
+	ToStatus   string
+	Error      pgtype.Text
+	CreatedAt  pgtype.Timestamptz
+}
+
+func (q *Queries) ListRecordingStatusEvents(ctx context.Context, recordingID int32) ([]ListRecordingStatusEventsRow, error) {
+	rows, err := q.db.Query(ctx, listRecordingStatusEvents, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecordingStatusEventsRow
+	for rows.Next() {
+		var i ListRecordingStatusEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Error,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecordingTranslations = `-- name: ListRecordingTranslations :many
+SELECT kind, language, text, provider, model, requested_by_user_id, created_at, updated_at
+FROM recording_translation
+WHERE recording_id = $1
+ORDER BY kind, language
+`
+
+type ListRecordingTranslationsRow struct {
+	Kind              string
+	Language          string
+	Text              string
+	Provider          string
+	Model             string
+	RequestedByUserID pgtype.Int4
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+}
+
+func (q *Queries) ListRecordingTranslations(ctx context.Context, recordingID int32) ([]ListRecordingTranslationsRow, error) {
+	rows, err := q.db.Query(ctx, listRecordingTranslations, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecordingTranslationsRow
+	for rows.Next() {
+		var i ListRecordingTranslationsRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.Language,
+			&i.Text,
+			&i.Provider,
+			&i.Model,
+			&i.RequestedByUserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRecordings = `-- name: ListRecordings :many
 SELECT
   r.id,
@@ -115,20 +831,98 @@ SELECT
   r.nas_audio,
   r.duration,
   r.notes,
-  r.archived
+  r.archived,
+  r.audio_key,
+  r.status,
+  r.status_error,
+  r.status_updated_at,
+  r.sentiment,
+  r.prompt_template_id,
+  r.retain_until,
+  r.audio_purged_at,
+  r.transcript_purged_at,
+  r.legal_hold,
+  r.legal_hold_reason,
+  r.scan_status,
+  r.processing_settings,
+  r.cloned_from_recording_id,
+  todos.open_todo_count,
+  todos.done_todo_count
 FROM recording r
+CROSS JOIN LATERAL (
+  SELECT
+    COUNT(*) FILTER (WHERE COALESCE(t.status, 'todo') NOT IN ('done', 'skipped'))::integer AS open_todo_count,
+    COUNT(*) FILTER (WHERE t.status = 'done')::integer AS done_todo_count
+  FROM todo t
+  WHERE t.created_at_recording_id = r.id
+) todos
+WHERE ($1::integer IS NULL OR EXISTS (
+    SELECT 1 FROM speaker_to_user stu
+    WHERE stu.recording_id = r.id AND stu.user_id = $1::integer
+  ))
+  AND ($2::timestamptz IS NULL OR r.created_at >= $2::timestamptz)
+  AND ($3::timestamptz IS NULL OR r.created_at < $3::timestamptz)
+  AND ($4::boolean IS NULL OR (COALESCE(r.audio_url, '') <> '' OR r.audio_key IS NOT NULL) = $4::boolean)
+  AND ($5::text IS NULL
+    OR r.name ILIKE '%' || $5::text || '%'
+    OR r.summary ILIKE '%' || $5::text || '%'
+    OR r.transcript ILIKE '%' || $5::text || '%')
 ORDER BY r.created_at DESC
 `
 
-func (q *Queries) ListRecordings(ctx context.Context) ([]Recording, error) {
-	rows, err := q.db.Query(ctx, listRecordings)
+type ListRecordingsParams struct {
+	ParticipantID pgtype.Int4
+	CreatedAfter  pgtype.Timestamptz
+	CreatedBefore pgtype.Timestamptz
+	HasAudio      pgtype.Bool
+	Query         pgtype.Text
+}
+
+type ListRecordingsRow struct {
+	ID                    int32
+	CreatedAt             pgtype.Timestamptz
+	Name                  pgtype.Text
+	AudioUrl              pgtype.Text
+	Transcript            pgtype.Text
+	Summary               pgtype.Text
+	LocalAudio            pgtype.Text
+	NasAudio              pgtype.Text
+	Duration              pgtype.Int4
+	Notes                 pgtype.Text
+	Archived              pgtype.Bool
+	AudioKey              pgtype.Text
+	Status                string
+	StatusError           pgtype.Text
+	StatusUpdatedAt       pgtype.Timestamptz
+	Sentiment             pgtype.Text
+	PromptTemplateID      pgtype.Int4
+	RetainUntil           pgtype.Timestamptz
+	AudioPurgedAt         pgtype.Timestamptz
+	TranscriptPurgedAt    pgtype.Timestamptz
+	LegalHold             bool
+	LegalHoldReason       pgtype.Text
+	ScanStatus            string
+	ProcessingSettings    []byte
+	ClonedFromRecordingID pgtype.Int4
+	OpenTodoCount         int32
+	DoneTodoCount         int32
+}
+
+func (q *Queries) ListRecordings(ctx context.Context, arg ListRecordingsParams) ([]ListRecordingsRow, error) {
+	rows, err := q.db.Query(ctx, listRecordings,
+		arg.ParticipantID,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.HasAudio,
+		arg.Query,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Recording
+	var items []ListRecordingsRow
 	for rows.Next() {
-		var i Recording
+		var i ListRecordingsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.CreatedAt,
@@ -141,6 +935,22 @@ func (q *Queries) ListRecordings(ctx context.Context) ([]Recording, error) {
 			&i.Duration,
 			&i.Notes,
 			&i.Archived,
+			&i.AudioKey,
+			&i.Status,
+			&i.StatusError,
+			&i.StatusUpdatedAt,
+			&i.Sentiment,
+			&i.PromptTemplateID,
+			&i.RetainUntil,
+			&i.AudioPurgedAt,
+			&i.TranscriptPurgedAt,
+			&i.LegalHold,
+			&i.LegalHoldReason,
+			&i.ScanStatus,
+			&i.ProcessingSettings,
+			&i.ClonedFromRecordingID,
+			&i.OpenTodoCount,
+			&i.DoneTodoCount,
 		); err != nil {
 			return nil, err
 		}
@@ -151,3 +961,188 @@ func (q *Queries) ListRecordings(ctx context.Context) ([]Recording, error) {
 	}
 	return items, nil
 }
+
+const setRecordingAudio = `-- name: SetRecordingAudio :exec
+UPDATE recording
+SET audio_key = $2,
+    duration = $3,
+    audio_bytes = $4,
+    audio_sha256 = $5,
+    scan_status = $6,
+    updated_at = now()
+WHERE id = $1
+`
+
+type SetRecordingAudioParams struct {
+	ID          int32
+	AudioKey    pgtype.Text
+	Duration    pgtype.Int4
+	AudioBytes  pgtype.Int8
+	AudioSha256 pgtype.Text
+	ScanStatus  string
+}
+
+func (q *Queries) SetRecordingAudio(ctx context.Context, arg SetRecordingAudioParams) error {
+	_, err := q.db.Exec(ctx, setRecordingAudio,
+		arg.ID,
+		arg.AudioKey,
+		arg.Duration,
+		arg.AudioBytes,
+		arg.AudioSha256,
+		arg.ScanStatus,
+	)
+	return err
+}
+
+const setRecordingProcessingSettings = `-- name: SetRecordingProcessingSettings :exec
+UPDATE recording
+SET processing_settings = $1::jsonb,
+    prompt_template_id = $2::integer,
+    updated_at = now()
+WHERE id = $3::integer
+`
+
+type SetRecordingProcessingSettingsParams struct {
+	ProcessingSettings []byte
+	PromptTemplateID   pgtype.Int4
+	ID                 int32
+}
+
+func (q *Queries) SetRecordingProcessingSettings(ctx context.Context, arg SetRecordingProcessingSettingsParams) error {
+	_, err := q.db.Exec(ctx, setRecordingProcessingSettings, arg.ProcessingSettings, arg.PromptTemplateID, arg.ID)
+	return err
+}
+
+const setRecordingStatus = `-- name: SetRecordingStatus :exec
+UPDATE recording
+SET status = $2,
+    status_error = $3,
+    status_updated_at = now(),
+    processing_dismissed_at = NULL,
+    updated_at = now()
+WHERE id = $1
+`
+
+type SetRecordingStatusParams struct {
+	ID          int32
+	Status      string
+	StatusError pgtype.Text
+}
+
+// A failed job dismissed by an admin comes back if the recording fails
+// again after a retry.
+func (q *Queries) SetRecordingStatus(ctx context.Context, arg SetRecordingStatusParams) error {
+	_, err := q.db.Exec(ctx, setRecordingStatus, arg.ID, arg.Status, arg.StatusError)
+	return err
+}
+
+const setRecordingSummary = `-- name: SetRecordingSummary :exec
+UPDATE recording
+SET summary = $2,
+    updated_at = now()
+WHERE id = $1
+`
+
+type SetRecordingSummaryParams struct {
+	ID      int32
+	Summary pgtype.Text
+}
+
+func (q *Queries) SetRecordingSummary(ctx context.Context, arg SetRecordingSummaryParams) error {
+	_, err := q.db.Exec(ctx, setRecordingSummary, arg.ID, arg.Summary)
+	return err
+}
+
+const setRecordingTranscript = `-- name: SetRecordingTranscript :exec
+UPDATE recording
+SET transcript = $2,
+    updated_at = now()
+WHERE id = $1
+`
+
+type SetRecordingTranscriptParams struct {
+	ID         int32
+	Transcript pgtype.Text
+}
+
+func (q *Queries) SetRecordingTranscript(ctx context.Context, arg SetRecordingTranscriptParams) error {
+	_, err := q.db.Exec(ctx, setRecordingTranscript, arg.ID, arg.Transcript)
+	return err
+}
+
+const startPendingProcessingAttempt = `-- name: StartPendingProcessingAttempt :execrows
+UPDATE recording_processing_attempt
+SET status = 'running',
+    started_at = now()
+WHERE recording_id = $1 AND stage = $2 AND status = 'pending'
+`
+
+type StartPendingProcessingAttemptParams struct {
+	RecordingID int32
+	Stage       string
+}
+
+func (q *Queries) StartPendingProcessingAttempt(ctx context.Context, arg StartPendingProcessingAttemptParams) (int64, error) {
+	result, err := q.db.Exec(ctx, startPendingProcessingAttempt, arg.RecordingID, arg.Stage)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertRecordingIngestChunk = `-- name: UpsertRecordingIngestChunk :exec
+INSERT INTO recording_ingest_chunk (recording_id, seq, size_bytes)
+VALUES ($1, $2, $3)
+ON CONFLICT (recording_id, seq) DO UPDATE
+SET size_bytes = EXCLUDED.size_bytes,
+    received_at = now()
+`
+
+type UpsertRecordingIngestChunkParams struct {
+	RecordingID int32
+	Seq         int32
+	SizeBytes   int64
+}
+
+func (q *Queries) UpsertRecordingIngestChunk(ctx context.Context, arg UpsertRecordingIngestChunkParams) error {
+	_, err := q.db.Exec(ctx, upsertRecordingIngestChunk, arg.RecordingID, arg.Seq, arg.SizeBytes)
+	return err
+}
+
+const upsertRecordingTranslation = `-- name: UpsertRecordingTranslation :exec
+WITH touched AS (
+  UPDATE recording SET updated_at = now() WHERE recording.id = $1
+)
+INSERT INTO recording_translation (recording_id, kind, language, text, provider, model, requested_by_user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (recording_id, kind, language) DO UPDATE
+SET text = EXCLUDED.text,
+    provider = EXCLUDED.provider,
+    model = EXCLUDED.model,
+    requested_by_user_id = EXCLUDED.requested_by_user_id,
+    updated_at = now()
+`
+
+type UpsertRecordingTranslationParams struct {
+	RecordingID       int32
+	Kind              string
+	Language          string
+	Text              string
+	Provider          string
+	Model             string
+	RequestedByUserID pgtype.Int4
+}
+
+// Bumps the recording's updated_at too, so its ETag changes.
+func (q *Queries) UpsertRecordingTranslation(ctx context.Context, arg UpsertRecordingTranslationParams) error {
+	_, err := q.db.Exec(ctx, upsertRecordingTranslation,
+		arg.RecordingID,
+		arg.Kind,
+		arg.Language,
+		arg.Text,
+		arg.Provider,
+		arg.Model,
+		arg.RequestedByUserID,
+	)
+	return err
+}
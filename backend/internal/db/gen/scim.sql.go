@@ -0,0 +1,619 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: scim.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addScimGroupMember = `-- name: AddScimGroupMember :exec
+INSERT INTO scim_group_member (group_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type AddScimGroupMemberParams struct {
+	GroupID int32
+	UserID  int32
+}
+
+func (q *Queries) AddScimGroupMember(ctx context.Context, arg AddScimGroupMemberParams) error {
+	_, err := q.db.Exec(ctx, addScimGroupMember, arg.GroupID, arg.UserID)
+	return err
+}
+
+const clearScimGroupMembers = `-- name: ClearScimGroupMembers :exec
+DELETE FROM scim_group_member WHERE group_id = $1
+`
+
+func (q *Queries) ClearScimGroupMembers(ctx context.Context, groupID int32) error {
+	_, err := q.db.Exec(ctx, clearScimGroupMembers, groupID)
+	return err
+}
+
+const countScimGroups = `-- name: CountScimGroups :one
+SELECT count(*)
+FROM scim_group g
+WHERE ($1::text IS NULL OR g.display_name = $1::text)
+  AND ($2::text IS NULL OR g.external_id = $2::text)
+`
+
+type CountScimGroupsParams struct {
+	DisplayName pgtype.Text
+	ExternalID  pgtype.Text
+}
+
+func (q *Queries) CountScimGroups(ctx context.Context, arg CountScimGroupsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countScimGroups, arg.DisplayName, arg.ExternalID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countScimUsers = `-- name: CountScimUsers :one
+SELECT count(*)
+FROM "user" u
+WHERE ($1::text IS NULL OR lower(u.email) = lower($1::text))
+  AND ($2::text IS NULL OR u.scim_external_id = $2::text)
+`
+
+type CountScimUsersParams struct {
+	Email      pgtype.Text
+	ExternalID pgtype.Text
+}
+
+func (q *Queries) CountScimUsers(ctx context.Context, arg CountScimUsersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countScimUsers, arg.Email, arg.ExternalID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createScimGroup = `-- name: CreateScimGroup :one
+INSERT INTO scim_group (display_name, external_id)
+VALUES ($1, $2)
+RETURNING id, display_name, external_id, role, workspace_id, created_at, updated_at
+`
+
+type CreateScimGroupParams struct {
+	DisplayName string
+	ExternalID  pgtype.Text
+}
+
+func (q *Queries) CreateScimGroup(ctx context.Context, arg CreateScimGroupParams) (ScimGroup, error) {
+	row := q.db.QueryRow(ctx, createScimGroup, arg.DisplayName, arg.ExternalID)
+	var i ScimGroup
+	err := row.Scan(
+		&i.ID,
+		&i.DisplayName,
+		&i.ExternalID,
+		&i.Role,
+		&i.WorkspaceID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createScimUser = `-- name: CreateScimUser :one
+INSERT INTO "user" (
+  first_name,
+  last_name,
+  role,
+  email,
+  password_hash,
+  scim_external_id,
+  scim_provisioned
+) VALUES ($1, $2, $3, $4, $5, $6, true)
+RETURNING id
+`
+
+type CreateScimUserParams struct {
+	FirstName      string
+	LastName       pgtype.Text
+	Role           pgtype.Text
+	Email          pgtype.Text
+	PasswordHash   pgtype.Text
+	ScimExternalID pgtype.Text
+}
+
+func (q *Queries) CreateScimUser(ctx context.Context, arg CreateScimUserParams) (int32, error) {
+	row := q.db.QueryRow(ctx, createScimUser,
+		arg.FirstName,
+		arg.LastName,
+		arg.Role,
+		arg.Email,
+		arg.PasswordHash,
+		arg.ScimExternalID,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteScimGroup = `-- name: DeleteScimGroup :execrows
+DELETE FROM scim_group WHERE id = $1
+`
+
+func (q *Queries) DeleteScimGroup(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteScimGroup, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getScimGroup = `-- name: GetScimGroup :one
+SELECT id, display_name, external_id, role, workspace_id, created_at, updated_at FROM scim_group WHERE id = $1
+`
+
+func (q *Queries) GetScimGroup(ctx context.Context, id int32) (ScimGroup, error) {
+	row := q.db.QueryRow(ctx, getScimGroup, id)
+	var i ScimGroup
+	err := row.Scan(
+		&i.ID,
+		&i.DisplayName,
+		&i.ExternalID,
+		&i.Role,
+		&i.WorkspaceID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getScimUser = `-- name: GetScimUser :one
+SELECT
+  u.id,
+  u.first_name,
+  u.last_name,
+  u.email,
+  u.scim_external_id,
+  u.deactivated_at
+FROM "user" u
+WHERE u.id = $1
+`
+
+type GetScimUserRow struct {
+	ID             int32
+	FirstName      string
+	LastName       pgtype.Text
+	Email          pgtype.Text
+	ScimExternalID pgtype.Text
+	DeactivatedAt  pgtype.Timestamptz
+}
+
+func (q *Queries) GetScimUser(ctx context.Context, id int32) (GetScimUserRow, error) {
+	row := q.db.QueryRow(ctx, getScimUser, id)
+	var i GetScimUserRow
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.ScimExternalID,
+		&i.DeactivatedAt,
+	)
+	return i, err
+}
+
+const listAllWorkspaces = `-- name: ListAllWorkspaces :many
+SELECT id, name, created_at
+FROM workspace
+ORDER BY name, id
+`
+
+func (q *Queries) ListAllWorkspaces(ctx context.Context) ([]Workspace, error) {
+	rows, err := q.db.Query(ctx, listAllWorkspaces)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Workspace
+	for rows.Next() {
+		var i Workspace
+		if err := rows.Scan(&i.ID, &i.Name, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScimGroupMembers = `-- name: ListScimGroupMembers :many
+SELECT
+  u.id,
+  u.first_name,
+  u.last_name,
+  u.email
+FROM scim_group_member m
+JOIN "user" u ON u.id = m.user_id
+WHERE m.group_id = $1
+ORDER BY u.id
+`
+
+type ListScimGroupMembersRow struct {
+	ID        int32
+	FirstName string
+	LastName  pgtype.Text
+	Email     pgtype.Text
+}
+
+func (q *Queries) ListScimGroupMembers(ctx context.Context, groupID int32) ([]ListScimGroupMembersRow, error) {
+	rows, err := q.db.Query(ctx, listScimGroupMembers, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListScimGroupMembersRow
+	for rows.Next() {
+		var i ListScimGroupMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Email,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScimGroups = `-- name: ListScimGroups :many
+SELECT
+  g.id,
+  g.display_name,
+  g.external_id,
+  g.role,
+  g.workspace_id,
+  w.name AS workspace_name,
+  (SELECT count(*) FROM scim_group_member m WHERE m.group_id = g.id) AS member_count
+FROM scim_group g
+LEFT JOIN workspace w ON w.id = g.workspace_id
+WHERE ($1::text IS NULL OR g.display_name = $1::text)
+  AND ($2::text IS NULL OR g.external_id = $2::text)
+ORDER BY g.id
+OFFSET $3
+LIMIT $4
+`
+
+type ListScimGroupsParams struct {
+	DisplayName pgtype.Text
+	ExternalID  pgtype.Text
+	RowOffset   int32
+	RowLimit    int32
+}
+
+type ListScimGroupsRow struct {
+	ID            int32
+	DisplayName   string
+	ExternalID    pgtype.Text
+	Role          pgtype.Text
+	WorkspaceID   pgtype.Int4
+	WorkspaceName pgtype.Text
+	MemberCount   int64
+}
+
+func (q *Queries) ListScimGroups(ctx context.Context, arg ListScimGroupsParams) ([]ListScimGroupsRow, error) {
+	rows, err := q.db.Query(ctx, listScimGroups,
+		arg.DisplayName,
+		arg.ExternalID,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListScimGroupsRow
+	for rows.Next() {
+		var i ListScimGroupsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DisplayName,
+			&i.ExternalID,
+			&i.Role,
+			&i.WorkspaceID,
+			&i.WorkspaceName,
+			&i.MemberCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScimGroupsForUser = `-- name: ListScimGroupsForUser :many
+SELECT g.id, g.display_name, g.role, g.workspace_id
+FROM scim_group_member m
+JOIN scim_group g ON g.id = m.group_id
+WHERE m.user_id = $1
+ORDER BY g.id
+`
+
+type ListScimGroupsForUserRow struct {
+	ID          int32
+	DisplayName string
+	Role        pgtype.Text
+	WorkspaceID pgtype.Int4
+}
+
+func (q *Queries) ListScimGroupsForUser(ctx context.Context, userID int32) ([]ListScimGroupsForUserRow, error) {
+	rows, err := q.db.Query(ctx, listScimGroupsForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListScimGroupsForUserRow
+	for rows.Next() {
+		var i ListScimGroupsForUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DisplayName,
+			&i.Role,
+			&i.WorkspaceID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScimUsers = `-- name: ListScimUsers :many
+SELECT
+  u.id,
+  u.first_name,
+  u.last_name,
+  u.email,
+  u.scim_external_id,
+  u.deactivated_at
+FROM "user" u
+WHERE ($1::text IS NULL OR lower(u.email) = lower($1::text))
+  AND ($2::text IS NULL OR u.scim_external_id = $2::text)
+ORDER BY u.id
+OFFSET $3
+LIMIT $4
+`
+
+type ListScimUsersParams struct {
+	Email      pgtype.Text
+	ExternalID pgtype.Text
+	RowOffset  int32
+	RowLimit   int32
+}
+
+type ListScimUsersRow struct {
+	ID             int32
+	FirstName      string
+	LastName       pgtype.Text
+	Email          pgtype.Text
+	ScimExternalID pgtype.Text
+	DeactivatedAt  pgtype.Timestamptz
+}
+
+// A page of users for the SCIM Users endpoint, matching the filter the
+// identity provider sent, if any. userName is the email address.
+func (q *Queries) ListScimUsers(ctx context.Context, arg ListScimUsersParams) ([]ListScimUsersRow, error) {
+	rows, err := q.db.Query(ctx, listScimUsers,
+		arg.Email,
+		arg.ExternalID,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListScimUsersRow
+	for rows.Next() {
+		var i ListScimUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Email,
+			&i.ScimExternalID,
+			&i.DeactivatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeScimGroupMember = `-- name: RemoveScimGroupMember :exec
+DELETE FROM scim_group_member
+WHERE group_id = $1 AND user_id = $2
+`
+
+type RemoveScimGroupMemberParams struct {
+	GroupID int32
+	UserID  int32
+}
+
+func (q *Queries) RemoveScimGroupMember(ctx context.Context, arg RemoveScimGroupMemberParams) error {
+	_, err := q.db.Exec(ctx, removeScimGroupMember, arg.GroupID, arg.UserID)
+	return err
+}
+
+const setScimGroupMapping = `-- name: SetScimGroupMapping :one
+UPDATE scim_group
+SET role = $1,
+    workspace_id = $2,
+    updated_at = now()
+WHERE id = $3
+RETURNING id, display_name, external_id, role, workspace_id, created_at, updated_at
+`
+
+type SetScimGroupMappingParams struct {
+	Role        pgtype.Text
+	WorkspaceID pgtype.Int4
+	ID          int32
+}
+
+func (q *Queries) SetScimGroupMapping(ctx context.Context, arg SetScimGroupMappingParams) (ScimGroup, error) {
+	row := q.db.QueryRow(ctx, setScimGroupMapping, arg.Role, arg.WorkspaceID, arg.ID)
+	var i ScimGroup
+	err := row.Scan(
+		&i.ID,
+		&i.DisplayName,
+		&i.ExternalID,
+		&i.Role,
+		&i.WorkspaceID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const setScimUserRole = `-- name: SetScimUserRole :exec
+UPDATE "user"
+SET role = $2
+WHERE id = $1 AND scim_provisioned
+`
+
+type SetScimUserRoleParams struct {
+	ID   int32
+	Role pgtype.Text
+}
+
+// Only users the identity provider manages take their role from groups.
+func (q *Queries) SetScimUserRole(ctx context.Context, arg SetScimUserRoleParams) error {
+	_, err := q.db.Exec(ctx, setScimUserRole, arg.ID, arg.Role)
+	return err
+}
+
+const setUserPassword = `-- name: SetUserPassword :exec
+UPDATE "user"
+SET password_hash = $2
+WHERE id = $1
+`
+
+type SetUserPasswordParams struct {
+	ID           int32
+	PasswordHash pgtype.Text
+}
+
+func (q *Queries) SetUserPassword(ctx context.Context, arg SetUserPasswordParams) error {
+	_, err := q.db.Exec(ctx, setUserPassword, arg.ID, arg.PasswordHash)
+	return err
+}
+
+const syncScimWorkspaces = `-- name: SyncScimWorkspaces :one
+WITH wanted AS (
+  SELECT DISTINCT g.workspace_id
+  FROM scim_group_member m
+  JOIN scim_group g ON g.id = m.group_id
+  WHERE m.user_id = $1 AND g.workspace_id IS NOT NULL
+), added AS (
+  INSERT INTO workspace_user_rel (workspace_id, user_id, role, via_scim)
+  SELECT w.workspace_id, $1, 'member', true
+  FROM wanted w
+  ON CONFLICT DO NOTHING
+  RETURNING workspace_id
+), removed AS (
+  DELETE FROM workspace_user_rel r
+  WHERE r.user_id = $1 AND r.via_scim
+    AND r.workspace_id NOT IN (SELECT workspace_id FROM wanted)
+  RETURNING workspace_id
+)
+SELECT
+  (SELECT count(*) FROM added)::integer AS added,
+  (SELECT count(*) FROM removed)::integer AS removed
+`
+
+type SyncScimWorkspacesRow struct {
+	Added   int32
+	Removed int32
+}
+
+// Adds the user to the workspaces their groups map to and removes them
+// from the ones they were added to over SCIM and no longer map to.
+// Memberships made in the app are left alone.
+func (q *Queries) SyncScimWorkspaces(ctx context.Context, userID int32) (SyncScimWorkspacesRow, error) {
+	row := q.db.QueryRow(ctx, syncScimWorkspaces, userID)
+	var i SyncScimWorkspacesRow
+	err := row.Scan(&i.Added, &i.Removed)
+	return i, err
+}
+
+const updateScimGroup = `-- name: UpdateScimGroup :execrows
+UPDATE scim_group
+SET display_name = $2,
+    external_id = $3,
+    updated_at = now()
+WHERE id = $1
+`
+
+type UpdateScimGroupParams struct {
+	ID          int32
+	DisplayName string
+	ExternalID  pgtype.Text
+}
+
+func (q *Queries) UpdateScimGroup(ctx context.Context, arg UpdateScimGroupParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateScimGroup, arg.ID, arg.DisplayName, arg.ExternalID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateScimUser = `-- name: UpdateScimUser :execrows
+UPDATE "user"
+SET first_name = $1,
+    last_name = $2,
+    email = $3,
+    scim_external_id = $4,
+    scim_provisioned = true
+WHERE id = $5
+`
+
+type UpdateScimUserParams struct {
+	FirstName      string
+	LastName       pgtype.Text
+	Email          pgtype.Text
+	ScimExternalID pgtype.Text
+	ID             int32
+}
+
+// Updating a user over SCIM hands their role and workspaces to the
+// identity provider from then on.
+func (q *Queries) UpdateScimUser(ctx context.Context, arg UpdateScimUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateScimUser,
+		arg.FirstName,
+		arg.LastName,
+		arg.Email,
+		arg.ScimExternalID,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
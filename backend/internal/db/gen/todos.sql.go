@@ -18,9 +18,11 @@ INSERT INTO todo (
   status,
   user_id,
   created_at_recording_id,
-  updated_at_recording_id
-) VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at
+  updated_at_recording_id,
+  due_at,
+  priority
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at, due_at, version, snoozed_until, priority
 `
 
 type CreateTodoParams struct {
@@ -30,6 +32,8 @@ type CreateTodoParams struct {
 	UserID               pgtype.Int4
 	CreatedAtRecordingID pgtype.Int4
 	UpdatedAtRecordingID pgtype.Int4
+	DueAt                pgtype.Timestamptz
+	Priority             int16
 }
 
 func (q *Queries) CreateTodo(ctx context.Context, arg CreateTodoParams) (Todo, error) {
@@ -40,6 +44,8 @@ func (q *Queries) CreateTodo(ctx context.Context, arg CreateTodoParams) (Todo, e
 		arg.UserID,
 		arg.CreatedAtRecordingID,
 		arg.UpdatedAtRecordingID,
+		arg.DueAt,
+		arg.Priority,
 	)
 	var i Todo
 	err := row.Scan(
@@ -56,6 +62,10 @@ func (q *Queries) CreateTodo(ctx context.Context, arg CreateTodoParams) (Todo, e
 		&i.UpdatedAtRecordingID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DueAt,
+		&i.Version,
+		&i.SnoozedUntil,
+		&i.Priority,
 	)
 	return i, err
 }
@@ -70,8 +80,9 @@ INSERT INTO todo_history (
   status,
   user_id,
   created_at_recording_id,
-  updated_at_recording_id
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+  updated_at_recording_id,
+  note
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 `
 
 type CreateTodoHistoryParams struct {
@@ -84,6 +95,7 @@ type CreateTodoHistoryParams struct {
 	UserID               pgtype.Int4
 	CreatedAtRecordingID pgtype.Int4
 	UpdatedAtRecordingID pgtype.Int4
+	Note                 pgtype.Text
 }
 
 func (q *Queries) CreateTodoHistory(ctx context.Context, arg CreateTodoHistoryParams) error {
@@ -97,6 +109,7 @@ func (q *Queries) CreateTodoHistory(ctx context.Context, arg CreateTodoHistoryPa
 		arg.UserID,
 		arg.CreatedAtRecordingID,
 		arg.UpdatedAtRecordingID,
+		arg.Note,
 	)
 	return err
 }
@@ -125,6 +138,10 @@ SELECT
   t.updated_at_recording_id,
   t.created_at,
   t.updated_at,
+  t.due_at,
+  t.version,
+  t.snoozed_until,
+  t.priority,
   r.name as recording_name,
   r.created_at as recording_date
 FROM todo t
@@ -146,6 +163,10 @@ type GetTodoRow struct {
 	UpdatedAtRecordingID pgtype.Int4
 	CreatedAt            pgtype.Timestamptz
 	UpdatedAt            pgtype.Timestamptz
+	DueAt                pgtype.Timestamptz
+	Version              int32
+	SnoozedUntil         pgtype.Timestamptz
+	Priority             int16
 	RecordingName        pgtype.Text
 	RecordingDate        pgtype.Timestamptz
 }
@@ -167,51 +188,72 @@ func (q *Queries) GetTodo(ctx context.Context, id int32) (GetTodoRow, error) {
 		&i.UpdatedAtRecordingID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DueAt,
+		&i.Version,
+		&i.SnoozedUntil,
+		&i.Priority,
 		&i.RecordingName,
 		&i.RecordingDate,
 	)
 	return i, err
 }
 
-const listTodoHistory = `-- name: ListTodoHistory :many
+const getTodoVersion = `-- name: GetTodoVersion :one
+SELECT version FROM todo WHERE id = $1
+`
+
+func (q *Queries) GetTodoVersion(ctx context.Context, id int32) (int32, error) {
+	row := q.db.QueryRow(ctx, getTodoVersion, id)
+	var version int32
+	err := row.Scan(&version)
+	return version, err
+}
+
+const listDueTodosByUser = `-- name: ListDueTodosByUser :many
 SELECT
-  h.id,
-  h.todo_id,
-  h.actor_user_id,
-  h.change_type,
-  h.name,
-  h."desc",
-  h.status,
-  h.user_id,
-  h.created_at_recording_id,
-  h.updated_at_recording_id,
-  h.changed_at
-FROM todo_history h
-WHERE h.todo_id = $1
-ORDER BY h.changed_at DESC
+  t.id,
+  t.name,
+  t."desc",
+  t.status,
+  t.created_at_recording_id,
+  t.updated_at,
+  t.due_at,
+  r.name as recording_name
+FROM todo t
+LEFT JOIN recording r ON t.created_at_recording_id = r.id
+WHERE t.user_id = $1 AND t.due_at IS NOT NULL
+ORDER BY t.due_at ASC, t.id ASC
 `
 
-func (q *Queries) ListTodoHistory(ctx context.Context, todoID int32) ([]TodoHistory, error) {
-	rows, err := q.db.Query(ctx, listTodoHistory, todoID)
+type ListDueTodosByUserRow struct {
+	ID                   int32
+	Name                 string
+	Desc                 pgtype.Text
+	Status               pgtype.Text
+	CreatedAtRecordingID pgtype.Int4
+	UpdatedAt            pgtype.Timestamptz
+	DueAt                pgtype.Timestamptz
+	RecordingName        pgtype.Text
+}
+
+func (q *Queries) ListDueTodosByUser(ctx context.Context, userID pgtype.Int4) ([]ListDueTodosByUserRow, error) {
+	rows, err := q.db.Query(ctx, listDueTodosByUser, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []TodoHistory
+	var items []ListDueTodosByUserRow
 	for rows.Next() {
-		var i TodoHistory
+		var i ListDueTodosByUserRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.TodoID,
-			&i.ActorUserID,
-			&i.ChangeType,
 			&i.Name,
 			&i.Desc,
 			&i.Status,
-			&i.UserID,
 			&i.CreatedAtRecordingID,
-			&i.UpdatedAtRecordingID,
-			&i.ChangedAt,
+			&i.UpdatedAt,
+			&i.DueAt,
+			&i.RecordingName,
 		); err != nil {
 			return nil, err
 		}
@@ -223,72 +265,47 @@ func (q *Queries) ListTodoHistory(ctx context.Context, todoID int32) ([]TodoHist
 	return items, nil
 }
 
-const listTodosByRecording = `-- name: ListTodosByRecording :many
+const listTodoHistory = `-- name: ListTodoHistory :many
 SELECT
-  t.id,
-  t.name,
-  t."desc",
-  t.status,
-  t.user_id,
-  t.workspace_id,
-  t.source_kind,
-  t.source_document_id,
-  t.source_block_id,
-  t.created_at_recording_id,
-  t.updated_at_recording_id,
-  t.created_at,
-  t.updated_at,
-  r.name as recording_name,
-  r.created_at as recording_date
-FROM todo t
-LEFT JOIN recording r ON t.created_at_recording_id = r.id
-WHERE t.created_at_recording_id = $1
-ORDER BY t.created_at DESC, t.id DESC
+  h.id,
+  h.todo_id,
+  h.actor_user_id,
+  h.change_type,
+  h.name,
+  h."desc",
+  h.status,
+  h.user_id,
+  h.created_at_recording_id,
+  h.updated_at_recording_id,
+  h.changed_at,
+  h.note
+FROM todo_history h
+WHERE h.todo_id = $1
+ORDER BY h.changed_at DESC
 `
 
-type ListTodosByRecordingRow struct {
-	ID                   int32
-	Name                 string
-	Desc                 pgtype.Text
-	Status               pgtype.Text
-	UserID               pgtype.Int4
-	WorkspaceID          pgtype.Int4
-	SourceKind           string
-	SourceDocumentID     pgtype.Int4
-	SourceBlockID        pgtype.Int4
-	CreatedAtRecordingID pgtype.Int4
-	UpdatedAtRecordingID pgtype.Int4
-	CreatedAt            pgtype.Timestamptz
-	UpdatedAt            pgtype.Timestamptz
-	RecordingName        pgtype.Text
-	RecordingDate        pgtype.Timestamptz
-}
-
-func (q *Queries) ListTodosByRecording(ctx context.Context, createdAtRecordingID pgtype.Int4) ([]ListTodosByRecordingRow, error) {
-	rows, err := q.db.Query(ctx, listTodosByRecording, createdAtRecordingID)
+func (q *Queries) ListTodoHistory(ctx context.Context, todoID int32) ([]TodoHistory, error) {
+	rows, err := q.db.Query(ctx, listTodoHistory, todoID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListTodosByRecordingRow
+	var items []TodoHistory
 	for rows.Next() {
-		var i ListTodosByRecordingRow
+		var i TodoHistory
 		if err := rows.Scan(
 			&i.ID,
+			&i.TodoID,
+			&i.ActorUserID,
+			&i.ChangeType,
 			&i.Name,
 			&i.Desc,
 			&i.Status,
 			&i.UserID,
-			&i.WorkspaceID,
-			&i.SourceKind,
-			&i.SourceDocumentID,
-			&i.SourceBlockID,
 			&i.CreatedAtRecordingID,
 			&i.UpdatedAtRecordingID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.RecordingName,
-			&i.RecordingDate,
+			&i.ChangedAt,
+			&i.Note,
 		); err != nil {
 			return nil, err
 		}
@@ -315,6 +332,10 @@ SELECT
   t.updated_at_recording_id,
   t.created_at,
   t.updated_at,
+  t.due_at,
+  t.version,
+  t.snoozed_until,
+  t.priority,
   r.name as recording_name,
   r.created_at as recording_date
 FROM todo t
@@ -337,6 +358,10 @@ type ListTodosByUserRow struct {
 	UpdatedAtRecordingID pgtype.Int4
 	CreatedAt            pgtype.Timestamptz
 	UpdatedAt            pgtype.Timestamptz
+	DueAt                pgtype.Timestamptz
+	Version              int32
+	SnoozedUntil         pgtype.Timestamptz
+	Priority             int16
 	RecordingName        pgtype.Text
 	RecordingDate        pgtype.Timestamptz
 }
@@ -364,6 +389,10 @@ func (q *Queries) ListTodosByUser(ctx context.Context, userID pgtype.Int4) ([]Li
 			&i.UpdatedAtRecordingID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DueAt,
+			&i.Version,
+			&i.SnoozedUntil,
+			&i.Priority,
 			&i.RecordingName,
 			&i.RecordingDate,
 		); err != nil {
@@ -377,36 +406,107 @@ func (q *Queries) ListTodosByUser(ctx context.Context, userID pgtype.Int4) ([]Li
 	return items, nil
 }
 
+const moveTodoReferences = `-- name: MoveTodoReferences :exec
+WITH history AS (
+  UPDATE todo_history SET todo_id = $1 WHERE todo_id = $2
+), attachments AS (
+  UPDATE attachment SET todo_id = $1 WHERE todo_id = $2
+), comments AS (
+  UPDATE todo_comment SET todo_id = $1 WHERE todo_id = $2
+), reactions AS (
+  UPDATE reaction r SET todo_id = $1
+  WHERE r.todo_id = $2
+    AND NOT EXISTS (SELECT 1 FROM reaction k WHERE k.todo_id = $1 AND k.user_id = r.user_id AND k.emoji = r.emoji)
+), blocks AS (
+  UPDATE block SET todo_id = $1 WHERE todo_id = $2
+), mentions AS (
+  UPDATE todo_mention m SET todo_id = $1
+  WHERE m.todo_id = $2
+    AND NOT EXISTS (SELECT 1 FROM todo_mention k WHERE k.todo_id = $1 AND k.user_id = m.user_id)
+), favorites AS (
+  UPDATE favorite f SET todo_id = $1
+  WHERE f.todo_id = $2
+    AND NOT EXISTS (SELECT 1 FROM favorite k WHERE k.todo_id = $1 AND k.user_id = f.user_id)
+)
+UPDATE todo_tracker_link l SET todo_id = $1
+WHERE l.todo_id = $2
+  AND NOT EXISTS (SELECT 1 FROM todo_tracker_link k WHERE k.todo_id = $1 AND k.tracker = l.tracker)
+`
+
+type MoveTodoReferencesParams struct {
+	IntoID int32
+	FromID int32
+}
+
+// Points everything that refers to the duplicate todo at the one it is
+// merged into. Mentions, stars, reactions and tracker links the kept todo
+// already has stay as they are; the duplicate's copies go when it is
+// deleted.
+func (q *Queries) MoveTodoReferences(ctx context.Context, arg MoveTodoReferencesParams) error {
+	_, err := q.db.Exec(ctx, moveTodoReferences, arg.IntoID, arg.FromID)
+	return err
+}
+
+const snoozeTodo = `-- name: SnoozeTodo :execrows
+UPDATE todo SET snoozed_until = $1 WHERE id = $2
+`
+
+type SnoozeTodoParams struct {
+	SnoozedUntil pgtype.Timestamptz
+	ID           int32
+}
+
+func (q *Queries) SnoozeTodo(ctx context.Context, arg SnoozeTodoParams) (int64, error) {
+	result, err := q.db.Exec(ctx, snoozeTodo, arg.SnoozedUntil, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateTodo = `-- name: UpdateTodo :one
 UPDATE todo
 SET
-  name = $2,
-  "desc" = $3,
-  status = $4,
-  user_id = $5,
-  updated_at_recording_id = $6,
+  name = $1,
+  "desc" = $2,
+  status = $3,
+  user_id = $4,
+  updated_at_recording_id = $5,
+  due_at = CASE WHEN $6::boolean THEN NULL ELSE COALESCE($7, due_at) END,
+  priority = COALESCE($8, priority),
+  version = version + 1,
   updated_at = now()
-WHERE id = $1
-RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at
+WHERE id = $9 AND version = $10
+RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at, due_at, version, snoozed_until, priority
 `
 
 type UpdateTodoParams struct {
-	ID                   int32
 	Name                 string
 	Desc                 pgtype.Text
 	Status               pgtype.Text
 	UserID               pgtype.Int4
 	UpdatedAtRecordingID pgtype.Int4
+	ClearDueAt           bool
+	DueAt                pgtype.Timestamptz
+	Priority             pgtype.Int2
+	ID                   int32
+	Version              int32
 }
 
+// A null due_at keeps the current one unless clear_due_at is set, and a
+// null priority keeps the current one.
 func (q *Queries) UpdateTodo(ctx context.Context, arg UpdateTodoParams) (Todo, error) {
 	row := q.db.QueryRow(ctx, updateTodo,
-		arg.ID,
 		arg.Name,
 		arg.Desc,
 		arg.Status,
 		arg.UserID,
 		arg.UpdatedAtRecordingID,
+		arg.ClearDueAt,
+		arg.DueAt,
+		arg.Priority,
+		arg.ID,
+		arg.Version,
 	)
 	var i Todo
 	err := row.Scan(
@@ -423,6 +523,54 @@ func (q *Queries) UpdateTodo(ctx context.Context, arg UpdateTodoParams) (Todo, e
 		&i.UpdatedAtRecordingID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DueAt,
+		&i.Version,
+		&i.SnoozedUntil,
+		&i.Priority,
 	)
 	return i, err
 }
+
+const wakeSnoozedTodos = `-- name: WakeSnoozedTodos :many
+UPDATE todo
+SET snoozed_until = NULL
+WHERE snoozed_until <= now()
+RETURNING id, name, "desc", status, user_id, created_at_recording_id
+`
+
+type WakeSnoozedTodosRow struct {
+	ID                   int32
+	Name                 string
+	Desc                 pgtype.Text
+	Status               pgtype.Text
+	UserID               pgtype.Int4
+	CreatedAtRecordingID pgtype.Int4
+}
+
+// Ends the snoozes that have run out and returns their todos.
+func (q *Queries) WakeSnoozedTodos(ctx context.Context) ([]WakeSnoozedTodosRow, error) {
+	rows, err := q.db.Query(ctx, wakeSnoozedTodos)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WakeSnoozedTodosRow
+	for rows.Next() {
+		var i WakeSnoozedTodosRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Desc,
+			&i.Status,
+			&i.UserID,
+			&i.CreatedAtRecordingID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
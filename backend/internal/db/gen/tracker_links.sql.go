@@ -0,0 +1,208 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: tracker_links.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createTrackerLink = `-- name: CreateTrackerLink :one
+INSERT INTO todo_tracker_link (todo_id, tracker, external_id, external_key, url, external_state, synced_status, created_by_user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, todo_id, tracker, external_id, external_key, url, external_state, created_by_user_id, created_at, synced_at, synced_status, polled_at
+`
+
+type CreateTrackerLinkParams struct {
+	TodoID          int32
+	Tracker         string
+	ExternalID      string
+	ExternalKey     string
+	Url             string
+	ExternalState   string
+	SyncedStatus    string
+	CreatedByUserID pgtype.Int4
+}
+
+func (q *Queries) CreateTrackerLink(ctx context.Context, arg CreateTrackerLinkParams) (TodoTrackerLink, error) {
+	row := q.db.QueryRow(ctx, createTrackerLink,
+		arg.TodoID,
+		arg.Tracker,
+		arg.ExternalID,
+		arg.ExternalKey,
+		arg.Url,
+		arg.ExternalState,
+		arg.SyncedStatus,
+		arg.CreatedByUserID,
+	)
+	var i TodoTrackerLink
+	err := row.Scan(
+		&i.ID,
+		&i.TodoID,
+		&i.Tracker,
+		&i.ExternalID,
+		&i.ExternalKey,
+		&i.Url,
+		&i.ExternalState,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+		&i.SyncedAt,
+		&i.SyncedStatus,
+		&i.PolledAt,
+	)
+	return i, err
+}
+
+const getTrackerLinkByExternalID = `-- name: GetTrackerLinkByExternalID :one
+SELECT id, todo_id, tracker, external_id, external_key, url, external_state, created_by_user_id, created_at, synced_at, synced_status, polled_at
+FROM todo_tracker_link
+WHERE tracker = $1 AND external_id = $2
+`
+
+type GetTrackerLinkByExternalIDParams struct {
+	Tracker    string
+	ExternalID string
+}
+
+func (q *Queries) GetTrackerLinkByExternalID(ctx context.Context, arg GetTrackerLinkByExternalIDParams) (TodoTrackerLink, error) {
+	row := q.db.QueryRow(ctx, getTrackerLinkByExternalID, arg.Tracker, arg.ExternalID)
+	var i TodoTrackerLink
+	err := row.Scan(
+		&i.ID,
+		&i.TodoID,
+		&i.Tracker,
+		&i.ExternalID,
+		&i.ExternalKey,
+		&i.Url,
+		&i.ExternalState,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+		&i.SyncedAt,
+		&i.SyncedStatus,
+		&i.PolledAt,
+	)
+	return i, err
+}
+
+const listTrackerLinks = `-- name: ListTrackerLinks :many
+SELECT id, todo_id, tracker, external_id, external_key, url, external_state, created_by_user_id, created_at, synced_at, synced_status, polled_at
+FROM todo_tracker_link
+WHERE todo_id = $1
+ORDER BY tracker
+`
+
+func (q *Queries) ListTrackerLinks(ctx context.Context, todoID int32) ([]TodoTrackerLink, error) {
+	rows, err := q.db.Query(ctx, listTrackerLinks, todoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TodoTrackerLink
+	for rows.Next() {
+		var i TodoTrackerLink
+		if err := rows.Scan(
+			&i.ID,
+			&i.TodoID,
+			&i.Tracker,
+			&i.ExternalID,
+			&i.ExternalKey,
+			&i.Url,
+			&i.ExternalState,
+			&i.CreatedByUserID,
+			&i.CreatedAt,
+			&i.SyncedAt,
+			&i.SyncedStatus,
+			&i.PolledAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrackerLinksToPoll = `-- name: ListTrackerLinksToPoll :many
+SELECT id, todo_id, tracker, external_id, external_key, url, external_state, created_by_user_id, created_at, synced_at, synced_status, polled_at
+FROM todo_tracker_link
+WHERE tracker = ANY($1::text[])
+ORDER BY polled_at NULLS FIRST, id
+LIMIT $2
+`
+
+type ListTrackerLinksToPollParams struct {
+	Trackers []string
+	MaxLinks int32
+}
+
+// The links checked longest ago, for trackers that cannot reach the
+// webhook.
+func (q *Queries) ListTrackerLinksToPoll(ctx context.Context, arg ListTrackerLinksToPollParams) ([]TodoTrackerLink, error) {
+	rows, err := q.db.Query(ctx, listTrackerLinksToPoll, arg.Trackers, arg.MaxLinks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TodoTrackerLink
+	for rows.Next() {
+		var i TodoTrackerLink
+		if err := rows.Scan(
+			&i.ID,
+			&i.TodoID,
+			&i.Tracker,
+			&i.ExternalID,
+			&i.ExternalKey,
+			&i.Url,
+			&i.ExternalState,
+			&i.CreatedByUserID,
+			&i.CreatedAt,
+			&i.SyncedAt,
+			&i.SyncedStatus,
+			&i.PolledAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markTrackerLinkPolled = `-- name: MarkTrackerLinkPolled :exec
+UPDATE todo_tracker_link
+SET polled_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) MarkTrackerLinkPolled(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, markTrackerLinkPolled, id)
+	return err
+}
+
+const markTrackerLinkSynced = `-- name: MarkTrackerLinkSynced :exec
+UPDATE todo_tracker_link
+SET external_state = $2,
+    synced_status = $3,
+    synced_at = now()
+WHERE id = $1
+`
+
+type MarkTrackerLinkSyncedParams struct {
+	ID            int32
+	ExternalState string
+	SyncedStatus  string
+}
+
+// Records the issue state and the todo status the two sides now agree on.
+func (q *Queries) MarkTrackerLinkSynced(ctx context.Context, arg MarkTrackerLinkSyncedParams) error {
+	_, err := q.db.Exec(ctx, markTrackerLinkSynced, arg.ID, arg.ExternalState, arg.SyncedStatus)
+	return err
+}
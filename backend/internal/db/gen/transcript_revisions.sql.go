@@ -0,0 +1,134 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: transcript_revisions.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createTranscriptRevision = `-- name: CreateTranscriptRevision :one
+INSERT INTO transcript_revision (recording_id, revision, transcript, source, created_by_user_id)
+SELECT $1::integer, COALESCE(MAX(revision), 0) + 1, $2::text, $3::text, $4::integer
+FROM transcript_revision
+WHERE recording_id = $1::integer
+RETURNING revision
+`
+
+type CreateTranscriptRevisionParams struct {
+	RecordingID     int32
+	Transcript      string
+	Source          string
+	CreatedByUserID pgtype.Int4
+}
+
+// Adds the revision after the latest. Callers hold the recording's row
+// lock, so two edits can't take the same number.
+func (q *Queries) CreateTranscriptRevision(ctx context.Context, arg CreateTranscriptRevisionParams) (int32, error) {
+	row := q.db.QueryRow(ctx, createTranscriptRevision,
+		arg.RecordingID,
+		arg.Transcript,
+		arg.Source,
+		arg.CreatedByUserID,
+	)
+	var revision int32
+	err := row.Scan(&revision)
+	return revision, err
+}
+
+const getLatestTranscriptRevision = `-- name: GetLatestTranscriptRevision :one
+SELECT revision, transcript
+FROM transcript_revision
+WHERE recording_id = $1
+ORDER BY revision DESC
+LIMIT 1
+`
+
+type GetLatestTranscriptRevisionRow struct {
+	Revision   int32
+	Transcript string
+}
+
+func (q *Queries) GetLatestTranscriptRevision(ctx context.Context, recordingID int32) (GetLatestTranscriptRevisionRow, error) {
+	row := q.db.QueryRow(ctx, getLatestTranscriptRevision, recordingID)
+	var i GetLatestTranscriptRevisionRow
+	err := row.Scan(&i.Revision, &i.Transcript)
+	return i, err
+}
+
+const getTranscriptRevision = `-- name: GetTranscriptRevision :one
+SELECT recording_id, revision, transcript, source, created_by_user_id, created_at
+FROM transcript_revision
+WHERE recording_id = $1 AND revision = $2
+`
+
+type GetTranscriptRevisionParams struct {
+	RecordingID int32
+	Revision    int32
+}
+
+type GetTranscriptRevisionRow struct {
+	RecordingID     int32
+	Revision        int32
+	Transcript      string
+	Source          string
+	CreatedByUserID pgtype.Int4
+	CreatedAt       pgtype.Timestamptz
+}
+
+func (q *Queries) GetTranscriptRevision(ctx context.Context, arg GetTranscriptRevisionParams) (GetTranscriptRevisionRow, error) {
+	row := q.db.QueryRow(ctx, getTranscriptRevision, arg.RecordingID, arg.Revision)
+	var i GetTranscriptRevisionRow
+	err := row.Scan(
+		&i.RecordingID,
+		&i.Revision,
+		&i.Transcript,
+		&i.Source,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listTranscriptRevisions = `-- name: ListTranscriptRevisions :many
+SELECT revision, source, created_by_user_id, created_at
+FROM transcript_revision
+WHERE recording_id = $1
+ORDER BY revision DESC
+`
+
+type ListTranscriptRevisionsRow struct {
+	Revision        int32
+	Source          string
+	CreatedByUserID pgtype.Int4
+	CreatedAt       pgtype.Timestamptz
+}
+
+func (q *Queries) ListTranscriptRevisions(ctx context.Context, recordingID int32) ([]ListTranscriptRevisionsRow, error) {
+	rows, err := q.db.Query(ctx, listTranscriptRevisions, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTranscriptRevisionsRow
+	for rows.Next() {
+		var i ListTranscriptRevisionsRow
+		if err := rows.Scan(
+			&i.Revision,
+			&i.Source,
+			&i.CreatedByUserID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,69 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: transcript_segments.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createTranscriptSegments = `-- name: CreateTranscriptSegments :exec
+INSERT INTO transcript_segment (recording_id, segment_index, confidence)
+SELECT $1::integer, (c.ordinality - 1)::integer, c.confidence
+FROM unnest($2::real[]) WITH ORDINALITY AS c(confidence, ordinality)
+`
+
+type CreateTranscriptSegmentsParams struct {
+	RecordingID int32
+	Confidences []float32
+}
+
+// Stores one confidence per transcript line, in line order.
+func (q *Queries) CreateTranscriptSegments(ctx context.Context, arg CreateTranscriptSegmentsParams) error {
+	_, err := q.db.Exec(ctx, createTranscriptSegments, arg.RecordingID, arg.Confidences)
+	return err
+}
+
+const deleteTranscriptSegments = `-- name: DeleteTranscriptSegments :exec
+DELETE FROM transcript_segment
+WHERE recording_id = $1
+`
+
+func (q *Queries) DeleteTranscriptSegments(ctx context.Context, recordingID int32) error {
+	_, err := q.db.Exec(ctx, deleteTranscriptSegments, recordingID)
+	return err
+}
+
+const listTranscriptSegments = `-- name: ListTranscriptSegments :many
+SELECT segment_index, confidence
+FROM transcript_segment
+WHERE recording_id = $1
+ORDER BY segment_index
+`
+
+type ListTranscriptSegmentsRow struct {
+	SegmentIndex int32
+	Confidence   float32
+}
+
+func (q *Queries) ListTranscriptSegments(ctx context.Context, recordingID int32) ([]ListTranscriptSegmentsRow, error) {
+	rows, err := q.db.Query(ctx, listTranscriptSegments, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTranscriptSegmentsRow
+	for rows.Next() {
+		var i ListTranscriptSegmentsRow
+		if err := rows.Scan(&i.SegmentIndex, &i.Confidence); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
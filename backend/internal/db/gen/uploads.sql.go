@@ -0,0 +1,435 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: uploads.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addResumableUploadPart = `-- name: AddResumableUploadPart :execrows
+WITH advanced AS (
+  UPDATE resumable_upload
+  SET received_bytes = resumable_upload.received_bytes + $2::bigint,
+      expires_at = $4::timestamptz,
+      updated_at = now()
+  WHERE resumable_upload.id = $5::integer
+    AND resumable_upload.received_bytes = $1::bigint
+    AND resumable_upload.recording_id IS NULL
+  RETURNING resumable_upload.id
+)
+INSERT INTO resumable_upload_part (upload_id, start_byte, size_bytes, object_key)
+SELECT advanced.id, $1::bigint, $2::bigint, $3::text
+FROM advanced
+`
+
+type AddResumableUploadPartParams struct {
+	StartByte int64
+	SizeBytes int64
+	ObjectKey string
+	ExpiresAt pgtype.Timestamptz
+	UploadID  int32
+}
+
+// Records a part only if it starts where the upload left off, so of two
+// concurrent requests for the same offset one wins.
+func (q *Queries) AddResumableUploadPart(ctx context.Context, arg AddResumableUploadPartParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addResumableUploadPart,
+		arg.StartByte,
+		arg.SizeBytes,
+		arg.ObjectKey,
+		arg.ExpiresAt,
+		arg.UploadID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const completeResumableUpload = `-- name: CompleteResumableUpload :one
+WITH created AS (
+  INSERT INTO recording (created_at, name, audio_key, audio_bytes, created_by_user_id, audio_sha256, scan_status, prompt_template_id, processing_settings)
+  SELECT now(), u.name, $1::text, u.length_bytes, u.user_id, $2::text, $3::text, u.prompt_template_id, u.processing_settings
+  FROM resumable_upload u
+  WHERE u.id = $4::integer AND u.recording_id IS NULL AND u.received_bytes = u.length_bytes
+  RETURNING recording.id, recording.created_at, recording.name
+), linked AS (
+  UPDATE resumable_upload
+  SET recording_id = created.id, updated_at = now()
+  FROM created
+  WHERE resumable_upload.id = $4::integer
+)
+SELECT created.id, created.created_at, created.name
+FROM created
+`
+
+type CompleteResumableUploadParams struct {
+	AudioKey    string
+	AudioSha256 string
+	ScanStatus  string
+	ID          int32
+}
+
+type CompleteResumableUploadRow struct {
+	ID        int32
+	CreatedAt pgtype.Timestamptz
+	Name      pgtype.Text
+}
+
+// Creates the recording for a fully received upload and links it, so
+// HEAD requests after completion can report it.
+func (q *Queries) CompleteResumableUpload(ctx context.Context, arg CompleteResumableUploadParams) (CompleteResumableUploadRow, error) {
+	row := q.db.QueryRow(ctx, completeResumableUpload,
+		arg.AudioKey,
+		arg.AudioSha256,
+		arg.ScanStatus,
+		arg.ID,
+	)
+	var i CompleteResumableUploadRow
+	err := row.Scan(&i.ID, &i.CreatedAt, &i.Name)
+	return i, err
+}
+
+const confirmPendingUpload = `-- name: ConfirmPendingUpload :one
+WITH claimed AS (
+  DELETE FROM pending_upload
+  WHERE pending_upload.id = $6::integer AND pending_upload.expires_at > now()
+  RETURNING pending_upload.name, pending_upload.audio_key, pending_upload.created_by_user_id
+)
+INSERT INTO recording (created_at, name, audio_key, audio_bytes, created_by_user_id, audio_sha256, scan_status, prompt_template_id, processing_settings)
+SELECT now(), claimed.name, claimed.audio_key, $1::bigint, claimed.created_by_user_id, $2::text, $3::text, $4::integer, $5::jsonb
+FROM claimed
+RETURNING id, created_at, name
+`
+
+type ConfirmPendingUploadParams struct {
+	AudioBytes         int64
+	AudioSha256        string
+	ScanStatus         string
+	PromptTemplateID   pgtype.Int4
+	ProcessingSettings []byte
+	ID                 int32
+}
+
+type ConfirmPendingUploadRow struct {
+	ID        int32
+	CreatedAt pgtype.Timestamptz
+	Name      pgtype.Text
+}
+
+// Turns the upload into a recording in one statement, so confirming twice
+// can't create two recordings.
+func (q *Queries) ConfirmPendingUpload(ctx context.Context, arg ConfirmPendingUploadParams) (ConfirmPendingUploadRow, error) {
+	row := q.db.QueryRow(ctx, confirmPendingUpload,
+		arg.AudioBytes,
+		arg.AudioSha256,
+		arg.ScanStatus,
+		arg.PromptTemplateID,
+		arg.ProcessingSettings,
+		arg.ID,
+	)
+	var i ConfirmPendingUploadRow
+	err := row.Scan(&i.ID, &i.CreatedAt, &i.Name)
+	return i, err
+}
+
+const createPendingUpload = `-- name: CreatePendingUpload :one
+INSERT INTO pending_upload (audio_key, name, content_type, size_bytes, created_by_user_id, expires_at, sha256)
+VALUES ($1::text, $2::text, $3::text, $4::bigint, $5::integer, $6::timestamptz, $7::text)
+RETURNING id
+`
+
+type CreatePendingUploadParams struct {
+	AudioKey        string
+	Name            string
+	ContentType     string
+	SizeBytes       int64
+	CreatedByUserID pgtype.Int4
+	ExpiresAt       pgtype.Timestamptz
+	Sha256          pgtype.Text
+}
+
+func (q *Queries) CreatePendingUpload(ctx context.Context, arg CreatePendingUploadParams) (int32, error) {
+	row := q.db.QueryRow(ctx, createPendingUpload,
+		arg.AudioKey,
+		arg.Name,
+		arg.ContentType,
+		arg.SizeBytes,
+		arg.CreatedByUserID,
+		arg.ExpiresAt,
+		arg.Sha256,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createResumableUpload = `-- name: CreateResumableUpload :one
+INSERT INTO resumable_upload (user_id, name, extension, length_bytes, expires_at, sha256, prompt_template_id, processing_settings)
+VALUES ($1::integer, $2::text, $3::text, $4::bigint, $5::timestamptz, $6::text, $7::integer, $8::jsonb)
+RETURNING id
+`
+
+type CreateResumableUploadParams struct {
+	UserID             int32
+	Name               string
+	Extension          string
+	LengthBytes        int64
+	ExpiresAt          pgtype.Timestamptz
+	Sha256             pgtype.Text
+	PromptTemplateID   pgtype.Int4
+	ProcessingSettings []byte
+}
+
+func (q *Queries) CreateResumableUpload(ctx context.Context, arg CreateResumableUploadParams) (int32, error) {
+	row := q.db.QueryRow(ctx, createResumableUpload,
+		arg.UserID,
+		arg.Name,
+		arg.Extension,
+		arg.LengthBytes,
+		arg.ExpiresAt,
+		arg.Sha256,
+		arg.PromptTemplateID,
+		arg.ProcessingSettings,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteExpiredPendingUploads = `-- name: DeleteExpiredPendingUploads :many
+DELETE FROM pending_upload
+WHERE expires_at <= now()
+RETURNING audio_key
+`
+
+func (q *Queries) DeleteExpiredPendingUploads(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, deleteExpiredPendingUploads)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var audio_key string
+		if err := rows.Scan(&audio_key); err != nil {
+			return nil, err
+		}
+		items = append(items, audio_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteExpiredResumableUploads = `-- name: DeleteExpiredResumableUploads :many
+WITH expired AS (
+  DELETE FROM resumable_upload
+  WHERE resumable_upload.expires_at <= now()
+  RETURNING resumable_upload.id
+)
+SELECT p.object_key
+FROM resumable_upload_part p
+JOIN expired ON expired.id = p.upload_id
+`
+
+func (q *Queries) DeleteExpiredResumableUploads(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, deleteExpiredResumableUploads)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var object_key string
+		if err := rows.Scan(&object_key); err != nil {
+			return nil, err
+		}
+		items = append(items, object_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deletePendingUpload = `-- name: DeletePendingUpload :exec
+DELETE FROM pending_upload
+WHERE id = $1::integer
+`
+
+func (q *Queries) DeletePendingUpload(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deletePendingUpload, id)
+	return err
+}
+
+const deleteResumableUpload = `-- name: DeleteResumableUpload :many
+WITH deleted AS (
+  DELETE FROM resumable_upload
+  WHERE resumable_upload.id = $1::integer
+  RETURNING resumable_upload.id
+)
+SELECT p.object_key
+FROM resumable_upload_part p
+JOIN deleted ON deleted.id = p.upload_id
+`
+
+// Returns the stored parts to delete. Parts are removed with the upload,
+// but the select still sees them.
+func (q *Queries) DeleteResumableUpload(ctx context.Context, id int32) ([]string, error) {
+	rows, err := q.db.Query(ctx, deleteResumableUpload, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var object_key string
+		if err := rows.Scan(&object_key); err != nil {
+			return nil, err
+		}
+		items = append(items, object_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteResumableUploadParts = `-- name: DeleteResumableUploadParts :exec
+DELETE FROM resumable_upload_part
+WHERE upload_id = $1::integer
+`
+
+func (q *Queries) DeleteResumableUploadParts(ctx context.Context, uploadID int32) error {
+	_, err := q.db.Exec(ctx, deleteResumableUploadParts, uploadID)
+	return err
+}
+
+const getPendingUpload = `-- name: GetPendingUpload :one
+SELECT id, audio_key, name, content_type, size_bytes, created_by_user_id, expires_at, sha256
+FROM pending_upload
+WHERE id = $1::integer
+  AND created_by_user_id IS NOT DISTINCT FROM $2::integer
+  AND expires_at > now()
+`
+
+type GetPendingUploadParams struct {
+	ID              int32
+	CreatedByUserID pgtype.Int4
+}
+
+type GetPendingUploadRow struct {
+	ID              int32
+	AudioKey        string
+	Name            string
+	ContentType     string
+	SizeBytes       int64
+	CreatedByUserID pgtype.Int4
+	ExpiresAt       pgtype.Timestamptz
+	Sha256          pgtype.Text
+}
+
+func (q *Queries) GetPendingUpload(ctx context.Context, arg GetPendingUploadParams) (GetPendingUploadRow, error) {
+	row := q.db.QueryRow(ctx, getPendingUpload, arg.ID, arg.CreatedByUserID)
+	var i GetPendingUploadRow
+	err := row.Scan(
+		&i.ID,
+		&i.AudioKey,
+		&i.Name,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.CreatedByUserID,
+		&i.ExpiresAt,
+		&i.Sha256,
+	)
+	return i, err
+}
+
+const getResumableUpload = `-- name: GetResumableUpload :one
+SELECT id, user_id, name, extension, length_bytes, received_bytes, recording_id, expires_at, sha256
+FROM resumable_upload
+WHERE id = $1::integer AND expires_at > now()
+`
+
+type GetResumableUploadRow struct {
+	ID            int32
+	UserID        int32
+	Name          string
+	Extension     string
+	LengthBytes   int64
+	ReceivedBytes int64
+	RecordingID   pgtype.Int4
+	ExpiresAt     pgtype.Timestamptz
+	Sha256        pgtype.Text
+}
+
+func (q *Queries) GetResumableUpload(ctx context.Context, id int32) (GetResumableUploadRow, error) {
+	row := q.db.QueryRow(ctx, getResumableUpload, id)
+	var i GetResumableUploadRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Extension,
+		&i.LengthBytes,
+		&i.ReceivedBytes,
+		&i.RecordingID,
+		&i.ExpiresAt,
+		&i.Sha256,
+	)
+	return i, err
+}
+
+const linkResumableUpload = `-- name: LinkResumableUpload :execrows
+UPDATE resumable_upload
+SET recording_id = $1::integer, updated_at = now()
+WHERE id = $2::integer AND recording_id IS NULL
+`
+
+type LinkResumableUploadParams struct {
+	RecordingID int32
+	ID          int32
+}
+
+// Points a finished upload at an existing recording with the same audio.
+func (q *Queries) LinkResumableUpload(ctx context.Context, arg LinkResumableUploadParams) (int64, error) {
+	result, err := q.db.Exec(ctx, linkResumableUpload, arg.RecordingID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listResumableUploadParts = `-- name: ListResumableUploadParts :many
+SELECT object_key
+FROM resumable_upload_part
+WHERE upload_id = $1::integer
+ORDER BY start_byte
+`
+
+func (q *Queries) ListResumableUploadParts(ctx context.Context, uploadID int32) ([]string, error) {
+	rows, err := q.db.Query(ctx, listResumableUploadParts, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var object_key string
+		if err := rows.Scan(&object_key); err != nil {
+			return nil, err
+		}
+		items = append(items, object_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
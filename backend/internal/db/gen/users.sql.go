@@ -11,21 +11,229 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const confirmPendingEmail = `-- name: ConfirmPendingEmail :execrows
+UPDATE "user"
+SET email = pending_email,
+    email_verified_at = now(),
+    pending_email = NULL,
+    email_verification_hash = NULL,
+    email_verification_expires_at = NULL
+WHERE id = $1
+  AND pending_email IS NOT NULL
+  AND email_verification_hash = $2
+  AND email_verification_expires_at > now()
+`
+
+type ConfirmPendingEmailParams struct {
+	ID                    int32
+	EmailVerificationHash pgtype.Text
+}
+
+func (q *Queries) ConfirmPendingEmail(ctx context.Context, arg ConfirmPendingEmailParams) (int64, error) {
+	result, err := q.db.Exec(ctx, confirmPendingEmail, arg.ID, arg.EmailVerificationHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countUsers = `-- name: CountUsers :one
+SELECT count(*) FROM "user"
+`
+
+func (q *Queries) CountUsers(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countUsers)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createUser = `-- name: CreateUser :one
+INSERT INTO "user" (
+  first_name,
+  last_name,
+  role,
+  email,
+  password_hash
+) VALUES ($1, $2, $3, $4, $5)
+RETURNING id
+`
+
+type CreateUserParams struct {
+	FirstName    string
+	LastName     pgtype.Text
+	Role         pgtype.Text
+	Email        pgtype.Text
+	PasswordHash pgtype.Text
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (int32, error) {
+	row := q.db.QueryRow(ctx, createUser,
+		arg.FirstName,
+		arg.LastName,
+		arg.Role,
+		arg.Email,
+		arg.PasswordHash,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deactivateUser = `-- name: DeactivateUser :one
+WITH deactivated AS (
+  UPDATE "user" u SET deactivated_at = now()
+  WHERE u.id = $1 AND u.deactivated_at IS NULL
+  RETURNING u.id
+), moved AS (
+  UPDATE todo t
+  SET user_id = $2,
+      version = t.version + 1,
+      updated_at = now()
+  FROM deactivated d
+  WHERE t.user_id = d.id AND COALESCE(t.status, 'todo') NOT IN ('done', 'skipped')
+    AND $2 IS NOT NULL
+  RETURNING t.id, t.name, t."desc", t.status, t.user_id, t.created_at_recording_id, t.updated_at_recording_id
+), history AS (
+  INSERT INTO todo_history (
+    todo_id,
+    actor_user_id,
+    change_type,
+    name,
+    "desc",
+    status,
+    user_id,
+    created_at_recording_id,
+    updated_at_recording_id,
+    note
+  )
+  SELECT m.id, $3::integer, 'update', m.name, m."desc", m.status, m.user_id, m.created_at_recording_id, m.updated_at_recording_id, $4::text
+  FROM moved m
+  RETURNING todo_id
+)
+SELECT
+  EXISTS (SELECT 1 FROM deactivated) AS deactivated,
+  (SELECT count(*) FROM history)::integer AS reassigned_todos
+`
+
+type DeactivateUserParams struct {
+	UserID      int32
+	SuccessorID pgtype.Int4
+	ActorUserID int32
+	Note        string
+}
+
+type DeactivateUserRow struct {
+	Deactivated     bool
+	ReassignedTodos int32
+}
+
+// Deactivates the user and hands their open todos to the successor, if
+// there is one, in one statement, recording the transfer in each todo's
+// history. Without a successor the todos stay with the user.
+func (q *Queries) DeactivateUser(ctx context.Context, arg DeactivateUserParams) (DeactivateUserRow, error) {
+	row := q.db.QueryRow(ctx, deactivateUser,
+		arg.UserID,
+		arg.SuccessorID,
+		arg.ActorUserID,
+		arg.Note,
+	)
+	var i DeactivateUserRow
+	err := row.Scan(&i.Deactivated, &i.ReassignedTodos)
+	return i, err
+}
+
+const getPasswordHash = `-- name: GetPasswordHash :one
+SELECT email, password_hash FROM "user" WHERE id = $1
+`
+
+type GetPasswordHashRow struct {
+	Email        pgtype.Text
+	PasswordHash pgtype.Text
+}
+
+func (q *Queries) GetPasswordHash(ctx context.Context, id int32) (GetPasswordHashRow, error) {
+	row := q.db.QueryRow(ctx, getPasswordHash, id)
+	var i GetPasswordHashRow
+	err := row.Scan(&i.Email, &i.PasswordHash)
+	return i, err
+}
+
+const getProfile = `-- name: GetProfile :one
+SELECT
+  u.id,
+  u.first_name,
+  u.last_name,
+  u.role,
+  u.email,
+  u.email_verified_at,
+  u.pending_email,
+  u.timezone,
+  u.avatar_key,
+  u.locale
+FROM "user" u
+WHERE u.id = $1
+`
+
+type GetProfileRow struct {
+	ID              int32
+	FirstName       string
+	LastName        pgtype.Text
+	Role            pgtype.Text
+	Email           pgtype.Text
+	EmailVerifiedAt pgtype.Timestamptz
+	PendingEmail    pgtype.Text
+	Timezone        string
+	AvatarKey       pgtype.Text
+	Locale          pgtype.Text
+}
+
+func (q *Queries) GetProfile(ctx context.Context, id int32) (GetProfileRow, error) {
+	row := q.db.QueryRow(ctx, getProfile, id)
+	var i GetProfileRow
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Role,
+		&i.Email,
+		&i.EmailVerifiedAt,
+		&i.PendingEmail,
+		&i.Timezone,
+		&i.AvatarKey,
+		&i.Locale,
+	)
+	return i, err
+}
+
+const getTodoFeedGeneration = `-- name: GetTodoFeedGeneration :one
+SELECT todo_feed_generation FROM "user" WHERE id = $1
+`
+
+func (q *Queries) GetTodoFeedGeneration(ctx context.Context, id int32) (int32, error) {
+	row := q.db.QueryRow(ctx, getTodoFeedGeneration, id)
+	var todo_feed_generation int32
+	err := row.Scan(&todo_feed_generation)
+	return todo_feed_generation, err
+}
+
 const getUser = `-- name: GetUser :one
 SELECT
   u.id,
   u.first_name,
   u.last_name,
-  u.role
+  u.role,
+  u.deactivated_at
 FROM "user" u
 WHERE u.id = $1
 `
 
 type GetUserRow struct {
-	ID        int32
-	FirstName string
-	LastName  pgtype.Text
-	Role      pgtype.Text
+	ID            int32
+	FirstName     string
+	LastName      pgtype.Text
+	Role          pgtype.Text
+	DeactivatedAt pgtype.Timestamptz
 }
 
 func (q *Queries) GetUser(ctx context.Context, id int32) (GetUserRow, error) {
@@ -36,6 +244,7 @@ func (q *Queries) GetUser(ctx context.Context, id int32) (GetUserRow, error) {
 		&i.FirstName,
 		&i.LastName,
 		&i.Role,
+		&i.DeactivatedAt,
 	)
 	return i, err
 }
@@ -47,14 +256,27 @@ SELECT
   u.last_name,
   u.role,
   u.email,
-  u.password_hash
+  u.password_hash,
+  u.deactivated_at,
+  u.locale
 FROM "user" u
 WHERE u.email = $1
 `
 
-func (q *Queries) GetUserByEmail(ctx context.Context, email pgtype.Text) (User, error) {
+type GetUserByEmailRow struct {
+	ID            int32
+	FirstName     string
+	LastName      pgtype.Text
+	Role          pgtype.Text
+	Email         pgtype.Text
+	PasswordHash  pgtype.Text
+	DeactivatedAt pgtype.Timestamptz
+	Locale        pgtype.Text
+}
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email pgtype.Text) (GetUserByEmailRow, error) {
 	row := q.db.QueryRow(ctx, getUserByEmail, email)
-	var i User
+	var i GetUserByEmailRow
 	err := row.Scan(
 		&i.ID,
 		&i.FirstName,
@@ -62,25 +284,55 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email pgtype.Text) (User,
 		&i.Role,
 		&i.Email,
 		&i.PasswordHash,
+		&i.DeactivatedAt,
+		&i.Locale,
 	)
 	return i, err
 }
 
+const listDeactivatedUserIDs = `-- name: ListDeactivatedUserIDs :many
+SELECT id FROM "user" WHERE deactivated_at IS NOT NULL
+`
+
+func (q *Queries) ListDeactivatedUserIDs(ctx context.Context) ([]int32, error) {
+	rows, err := q.db.Query(ctx, listDeactivatedUserIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listUsers = `-- name: ListUsers :many
 SELECT
   u.id,
   u.first_name,
   u.last_name,
-  u.role
+  u.role,
+  u.avatar_key,
+  u.deactivated_at
 FROM "user" u
 ORDER BY u.id
 `
 
 type ListUsersRow struct {
-	ID        int32
-	FirstName string
-	LastName  pgtype.Text
-	Role      pgtype.Text
+	ID            int32
+	FirstName     string
+	LastName      pgtype.Text
+	Role          pgtype.Text
+	AvatarKey     pgtype.Text
+	DeactivatedAt pgtype.Timestamptz
 }
 
 func (q *Queries) ListUsers(ctx context.Context) ([]ListUsersRow, error) {
@@ -97,6 +349,8 @@ func (q *Queries) ListUsers(ctx context.Context) ([]ListUsersRow, error) {
 			&i.FirstName,
 			&i.LastName,
 			&i.Role,
+			&i.AvatarKey,
+			&i.DeactivatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -107,3 +361,122 @@ func (q *Queries) ListUsers(ctx context.Context) ([]ListUsersRow, error) {
 	}
 	return items, nil
 }
+
+const reactivateUser = `-- name: ReactivateUser :execrows
+UPDATE "user" SET deactivated_at = NULL
+WHERE id = $1 AND deactivated_at IS NOT NULL
+`
+
+func (q *Queries) ReactivateUser(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, reactivateUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const resetTodoFeedToken = `-- name: ResetTodoFeedToken :one
+UPDATE "user" SET todo_feed_generation = todo_feed_generation + 1
+WHERE id = $1
+RETURNING todo_feed_generation
+`
+
+// Bumping the generation invalidates every calendar feed link handed out
+// before.
+func (q *Queries) ResetTodoFeedToken(ctx context.Context, id int32) (int32, error) {
+	row := q.db.QueryRow(ctx, resetTodoFeedToken, id)
+	var todo_feed_generation int32
+	err := row.Scan(&todo_feed_generation)
+	return todo_feed_generation, err
+}
+
+const setPendingEmail = `-- name: SetPendingEmail :exec
+UPDATE "user"
+SET pending_email = $2,
+    email_verification_hash = $3,
+    email_verification_expires_at = $4
+WHERE id = $1
+`
+
+type SetPendingEmailParams struct {
+	ID                         int32
+	PendingEmail               pgtype.Text
+	EmailVerificationHash      pgtype.Text
+	EmailVerificationExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) SetPendingEmail(ctx context.Context, arg SetPendingEmailParams) error {
+	_, err := q.db.Exec(ctx, setPendingEmail,
+		arg.ID,
+		arg.PendingEmail,
+		arg.EmailVerificationHash,
+		arg.EmailVerificationExpiresAt,
+	)
+	return err
+}
+
+const setUserAvatar = `-- name: SetUserAvatar :exec
+UPDATE "user"
+SET avatar_key = $2
+WHERE id = $1
+`
+
+type SetUserAvatarParams struct {
+	ID        int32
+	AvatarKey pgtype.Text
+}
+
+func (q *Queries) SetUserAvatar(ctx context.Context, arg SetUserAvatarParams) error {
+	_, err := q.db.Exec(ctx, setUserAvatar, arg.ID, arg.AvatarKey)
+	return err
+}
+
+const updatePasswordHash = `-- name: UpdatePasswordHash :execrows
+UPDATE "user"
+SET password_hash = $1
+WHERE id = $2 AND password_hash = $3
+`
+
+type UpdatePasswordHashParams struct {
+	NewHash pgtype.Text
+	ID      int32
+	OldHash pgtype.Text
+}
+
+// Replaces the hash only if it is still old_hash, so a rehash at sign-in
+// can't undo a password change made meanwhile.
+func (q *Queries) UpdatePasswordHash(ctx context.Context, arg UpdatePasswordHashParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updatePasswordHash, arg.NewHash, arg.ID, arg.OldHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateProfile = `-- name: UpdateProfile :exec
+UPDATE "user"
+SET first_name = $2,
+    last_name = $3,
+    timezone = $4,
+    locale = $5
+WHERE id = $1
+`
+
+type UpdateProfileParams struct {
+	ID        int32
+	FirstName string
+	LastName  pgtype.Text
+	Timezone  string
+	Locale    pgtype.Text
+}
+
+func (q *Queries) UpdateProfile(ctx context.Context, arg UpdateProfileParams) error {
+	_, err := q.db.Exec(ctx, updateProfile,
+		arg.ID,
+		arg.FirstName,
+		arg.LastName,
+		arg.Timezone,
+		arg.Locale,
+	)
+	return err
+}
@@ -59,9 +59,16 @@ type GetWorkspaceMembershipParams struct {
 	UserID      int32
 }
 
-func (q *Queries) GetWorkspaceMembership(ctx context.Context, arg GetWorkspaceMembershipParams) (WorkspaceUserRel, error) {
+type GetWorkspaceMembershipRow struct {
+	WorkspaceID int32
+	UserID      int32
+	Role        pgtype.Text
+	CreatedAt   pgtype.Timestamptz
+}
+
+func (q *Queries) GetWorkspaceMembership(ctx context.Context, arg GetWorkspaceMembershipParams) (GetWorkspaceMembershipRow, error) {
 	row := q.db.QueryRow(ctx, getWorkspaceMembership, arg.WorkspaceID, arg.UserID)
-	var i WorkspaceUserRel
+	var i GetWorkspaceMembershipRow
 	err := row.Scan(
 		&i.WorkspaceID,
 		&i.UserID,
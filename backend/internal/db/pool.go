@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PoolOptions overrides pgxpool settings. Zero values keep whatever the DSN
+// (pool_max_conns and friends) or pgxpool's defaults specify.
+type PoolOptions struct {
+	MaxConns          int32
+	MinConns          int32
+	MaxConnLifetime   time.Duration
+	MaxConnIdleTime   time.Duration
+	HealthCheckPeriod time.Duration
+	// Stats, when set, records per-statement counts and durations and logs
+	// queries slower than its threshold.
+	Stats *QueryStats
+	// DSN, when set, is parsed again for the user and password of every
+	// new connection, so rotated database credentials apply without a
+	// restart.
+	DSN func() string
+}
+
+func (o PoolOptions) apply(config *pgxpool.Config) {
+	if o.MaxConns > 0 {
+		config.MaxConns = o.MaxConns
+	}
+	if o.MinConns > 0 {
+		config.MinConns = o.MinConns
+	}
+	if o.MaxConnLifetime > 0 {
+		config.MaxConnLifetime = o.MaxConnLifetime
+	}
+	if o.MaxConnIdleTime > 0 {
+		config.MaxConnIdleTime = o.MaxConnIdleTime
+	}
+	if o.HealthCheckPeriod > 0 {
+		config.HealthCheckPeriod = o.HealthCheckPeriod
+	}
+	if o.Stats != nil {
+		config.ConnConfig.Tracer = o.Stats
+	}
+	if o.DSN != nil {
+		config.BeforeConnect = func(_ context.Context, conn *pgx.ConnConfig) error {
+			latest, err := pgx.ParseConfig(o.DSN())
+			if err != nil {
+				return err
+			}
+			conn.User, conn.Password = latest.User, latest.Password
+			return nil
+		}
+	}
+}
+
+// OpenWithOptions is Open with the pool settings from opts applied.
+func OpenWithOptions(ctx context.Context, dsn string, opts PoolOptions) (*pgxpool.Pool, error) {
+	if dsn == "" {
+		return nil, fmt.Errorf("DATABASE_URL is required")
+	}
+	config, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	opts.apply(config)
+	if config.MinConns > config.MaxConns {
+		return nil, fmt.Errorf("minimum pool size %d exceeds maximum %d", config.MinConns, config.MaxConns)
+	}
+	return pgxpool.NewWithConfig(ctx, config)
+}
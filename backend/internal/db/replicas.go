@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ReadRouter hands out connection pools for read-only work. Reads rotate
+// across healthy replicas and fall back to the primary when none are
+// available. Writes, transactions and reads that must observe a write made
+// earlier in the same request should use the primary directly.
+type ReadRouter struct {
+	primary  *pgxpool.Pool
+	replicas []*replica
+	next     atomic.Uint64
+}
+
+type replica struct {
+	pool    *pgxpool.Pool
+	healthy atomic.Bool
+}
+
+func NewReadRouter(primary *pgxpool.Pool, replicas ...*pgxpool.Pool) *ReadRouter {
+	router := &ReadRouter{primary: primary}
+	for _, pool := range replicas {
+		r := &replica{pool: pool}
+		r.healthy.Store(true)
+		router.replicas = append(router.replicas, r)
+	}
+	return router
+}
+
+// Reader returns the pool the next read-only query should use.
+func (r *ReadRouter) Reader() *pgxpool.Pool {
+	n := len(r.replicas)
+	if n == 0 {
+		return r.primary
+	}
+	start := r.next.Add(1)
+	for i := 0; i < n; i++ {
+		candidate := r.replicas[(start+uint64(i))%uint64(n)]
+		if candidate.healthy.Load() {
+			return candidate.pool
+		}
+	}
+	return r.primary
+}
+
+// Watch pings every replica each interval, taking unreachable ones out of
+// rotation until they answer again. It returns when ctx is done.
+func (r *ReadRouter) Watch(ctx context.Context, interval time.Duration) {
+	if len(r.replicas) == 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for i, rep := range r.replicas {
+			pingCtx, cancel := context.WithTimeout(ctx, interval)
+			err := rep.pool.Ping(pingCtx)
+			cancel()
+			if ctx.Err() != nil {
+				return
+			}
+			healthy := err == nil
+			if rep.healthy.Swap(healthy) != healthy {
+				if healthy {
+					log.Printf("read replica %d is back in rotation", i)
+				} else {
+					log.Printf("read replica %d removed from rotation: %v", i, err)
+				}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Close closes the replica pools. The primary belongs to the caller.
+func (r *ReadRouter) Close() {
+	for _, rep := range r.replicas {
+		rep.pool.Close()
+	}
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func lazyPool(t *testing.T, name string) *pgxpool.Pool {
+	t.Helper()
+	// pgxpool does not dial until a connection is acquired.
+	pool, err := pgxpool.New(context.Background(), "postgres://localhost:1/"+name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func TestReadRouterReader(t *testing.T) {
+	primary := lazyPool(t, "primary")
+	if got := NewReadRouter(primary).Reader(); got != primary {
+		t.Fatal("expected reads to use the primary without replicas")
+	}
+
+	first, second := lazyPool(t, "first"), lazyPool(t, "second")
+	router := NewReadRouter(primary, first, second)
+	seen := map[*pgxpool.Pool]int{}
+	for i := 0; i < 4; i++ {
+		seen[router.Reader()]++
+	}
+	if seen[first] != 2 || seen[second] != 2 {
+		t.Fatalf("expected reads to alternate between replicas, got %v", seen)
+	}
+
+	router.replicas[0].healthy.Store(false)
+	for i := 0; i < 3; i++ {
+		if got := router.Reader(); got != second {
+			t.Fatal("expected the unhealthy replica to be skipped")
+		}
+	}
+	router.replicas[1].healthy.Store(false)
+	if got := router.Reader(); got != primary {
+		t.Fatal("expected reads to fall back to the primary when no replica is healthy")
+	}
+}
@@ -0,0 +1,121 @@
+package db
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// unnamedStatement labels queries built at runtime rather than by sqlc.
+const unnamedStatement = "unnamed"
+
+// maxLoggedSQL keeps slow-query log lines readable for the larger
+// dynamically built queries.
+const maxLoggedSQL = 500
+
+// StatementStats is the running total for one statement.
+type StatementStats struct {
+	Name   string
+	Count  int64
+	Errors int64
+	Total  time.Duration
+}
+
+// QueryStats is a pgx.QueryTracer that aggregates query counts and
+// durations by sqlc statement name and logs queries that take longer than
+// SlowThreshold. Arguments are never logged; they routinely hold personal
+// data.
+type QueryStats struct {
+	SlowThreshold time.Duration
+
+	mu    sync.Mutex
+	stats map[string]*StatementStats
+}
+
+func NewQueryStats(slowThreshold time.Duration) *QueryStats {
+	return &QueryStats{SlowThreshold: slowThreshold, stats: map[string]*StatementStats{}}
+}
+
+type queryTraceKey struct{}
+
+type queryTrace struct {
+	sql   string
+	start time.Time
+}
+
+func (q *QueryStats) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryTraceKey{}, queryTrace{sql: data.SQL, start: time.Now()})
+}
+
+func (q *QueryStats) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	trace, ok := ctx.Value(queryTraceKey{}).(queryTrace)
+	if !ok {
+		return
+	}
+	elapsed := time.Since(trace.start)
+	name := statementName(trace.sql)
+	q.record(name, elapsed, data.Err != nil)
+	if q.SlowThreshold > 0 && elapsed >= q.SlowThreshold {
+		log.Printf("slow query %s took %s: %s", name, elapsed.Round(time.Millisecond), compactSQL(trace.sql))
+	}
+}
+
+func (q *QueryStats) record(name string, elapsed time.Duration, failed bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	entry, ok := q.stats[name]
+	if !ok {
+		entry = &StatementStats{Name: name}
+		q.stats[name] = entry
+	}
+	entry.Count++
+	entry.Total += elapsed
+	if failed {
+		entry.Errors++
+	}
+}
+
+// Snapshot returns a copy of the current totals, sorted by statement name.
+func (q *QueryStats) Snapshot() []StatementStats {
+	q.mu.Lock()
+	out := make([]StatementStats, 0, len(q.stats))
+	for _, entry := range q.stats {
+		out = append(out, *entry)
+	}
+	q.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// statementName extracts the name from sqlc's leading "-- name: X :kind"
+// comment.
+func statementName(sql string) string {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(sql), "-- name: ")
+	if !ok {
+		return unnamedStatement
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return unnamedStatement
+	}
+	return fields[0]
+}
+
+func compactSQL(sql string) string {
+	sql = strings.TrimSpace(sql)
+	if strings.HasPrefix(sql, "-- name: ") {
+		if _, body, ok := strings.Cut(sql, "\n"); ok {
+			sql = body
+		}
+	}
+	sql = strings.Join(strings.Fields(sql), " ")
+	if len(sql) > maxLoggedSQL {
+		sql = sql[:maxLoggedSQL] + "…"
+	}
+	return sql
+}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func TestStatementName(t *testing.T) {
+	cases := map[string]string{
+		"-- name: ListTodosByUser :many\nSELECT 1": "ListTodosByUser",
+		"\n-- name: GetRecording :one\nSELECT 1":   "GetRecording",
+		"SELECT id FROM todo WHERE user_id = $1":   unnamedStatement,
+	}
+	for sql, want := range cases {
+		if got := statementName(sql); got != want {
+			t.Fatalf("statementName(%q) = %q, want %q", sql, got, want)
+		}
+	}
+}
+
+func TestCompactSQL(t *testing.T) {
+	got := compactSQL("-- name: GetTodo :one\nSELECT id,\n       name\nFROM todo\nWHERE id = $1")
+	if got != "SELECT id, name FROM todo WHERE id = $1" {
+		t.Fatalf("unexpected compacted sql %q", got)
+	}
+	if long := compactSQL(strings.Repeat("x ", maxLoggedSQL)); len(long) > maxLoggedSQL+len("…") {
+		t.Fatalf("expected long sql to be truncated, got %d bytes", len(long))
+	}
+}
+
+func TestQueryStatsAggregatesByStatement(t *testing.T) {
+	stats := NewQueryStats(0)
+	run := func(sql string, err error) {
+		ctx := stats.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: sql})
+		stats.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: err})
+	}
+	run("-- name: ListTodosByUser :many\nSELECT 1", nil)
+	run("-- name: ListTodosByUser :many\nSELECT 1", errors.New("boom"))
+	run("SELECT 1", nil)
+
+	snapshot := stats.Snapshot()
+	if len(snapshot) != 2 || snapshot[0].Name != "ListTodosByUser" || snapshot[1].Name != unnamedStatement {
+		t.Fatalf("unexpected snapshot %+v", snapshot)
+	}
+	if snapshot[0].Count != 2 || snapshot[0].Errors != 1 || snapshot[0].Total < 0 || snapshot[0].Total > time.Second {
+		t.Fatalf("unexpected totals %+v", snapshot[0])
+	}
+}
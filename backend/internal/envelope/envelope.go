@@ -0,0 +1,258 @@
+// Package envelope encrypts data at rest with envelope encryption: data is
+// sealed with AES-256-GCM data keys, and the data keys are stored wrapped
+// by a master key that never touches the database.
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// KeySize is the length of master and data keys in bytes.
+const KeySize = 32
+
+// textPrefix marks sealed text; the data key ID and the encoded ciphertext
+// follow it, e.g. "enc:v1:3:…".
+const textPrefix = "enc:v1:"
+
+// MasterKey wraps and unwraps data keys. The local implementation keeps the
+// key in memory; a KMS-backed one only needs to implement these methods.
+type MasterKey interface {
+	// ID names the key so wrapped data keys record what wrapped them.
+	ID() string
+	// Wrap binds the data key to scope, such as the org it belongs to;
+	// Unwrap fails unless given the same scope.
+	Wrap(dataKey, scope []byte) ([]byte, error)
+	Unwrap(wrapped, scope []byte) ([]byte, error)
+}
+
+// LocalMasterKey is a master key held by the server, typically read from
+// the environment.
+type LocalMasterKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// ParseMasterKey decodes a base64 encoded 32-byte master key.
+func ParseMasterKey(encoded string) (*LocalMasterKey, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, errors.New("envelope: master key must be base64 encoded")
+	}
+	return NewLocalMasterKey(key)
+}
+
+func NewLocalMasterKey(key []byte) (*LocalMasterKey, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, fmt.Errorf("envelope: master key: %w", err)
+	}
+	sum := sha256.Sum256(key)
+	return &LocalMasterKey{id: "local:" + hex.EncodeToString(sum[:4]), aead: aead}, nil
+}
+
+func (m *LocalMasterKey) ID() string { return m.id }
+
+func (m *LocalMasterKey) Wrap(dataKey, scope []byte) ([]byte, error) {
+	nonce := make([]byte, m.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return m.aead.Seal(nonce, nonce, dataKey, m.additionalData(scope)), nil
+}
+
+func (m *LocalMasterKey) Unwrap(wrapped, scope []byte) ([]byte, error) {
+	size := m.aead.NonceSize()
+	if len(wrapped) < size {
+		return nil, errors.New("envelope: wrapped key is truncated")
+	}
+	key, err := m.aead.Open(nil, wrapped[:size], wrapped[size:], m.additionalData(scope))
+	if err != nil {
+		return nil, errors.New("envelope: data key was not wrapped by this master key for this scope")
+	}
+	return key, nil
+}
+
+// additionalData authenticates the key ID and the scope with the wrapped
+// key. Keys wrapped without a scope only authenticate the ID.
+func (m *LocalMasterKey) additionalData(scope []byte) []byte {
+	if len(scope) == 0 {
+		return []byte(m.id)
+	}
+	return append([]byte(m.id+"\x00"), scope...)
+}
+
+// NewDataKey returns a fresh random data key.
+func NewDataKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Keyring holds the unwrapped data keys. New data is sealed with the
+// active key, the one with the highest ID; older keys stay to open what
+// they sealed until it is re-encrypted.
+type Keyring struct {
+	mu     sync.RWMutex
+	keys   map[int32]cipher.AEAD
+	active int32
+	fetch  func(id int32) ([]byte, error)
+}
+
+func NewKeyring() *Keyring {
+	return &Keyring{keys: map[int32]cipher.AEAD{}}
+}
+
+// SetFetch sets how to look up a data key the keyring doesn't hold yet,
+// such as one another server created after this one loaded its keys.
+func (k *Keyring) SetFetch(fetch func(id int32) ([]byte, error)) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.fetch = fetch
+}
+
+// Add makes a data key available, activating it when it's the newest.
+func (k *Keyring) Add(id int32, key []byte) error {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return fmt.Errorf("envelope: data key %d: %w", id, err)
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys[id] = aead
+	if id > k.active {
+		k.active = id
+	}
+	return nil
+}
+
+// Reset forgets every data key, for when the stored keys were replaced
+// wholesale. Keys are fetched again as they are needed.
+func (k *Keyring) Reset() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys = map[int32]cipher.AEAD{}
+	k.active = 0
+}
+
+// Active returns the ID of the key new data is sealed with, or 0 when the
+// keyring is empty.
+func (k *Keyring) Active() int32 {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.active
+}
+
+func (k *Keyring) activeKey() (int32, cipher.AEAD, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if k.active == 0 {
+		return 0, nil, errors.New("envelope: no data key")
+	}
+	return k.active, k.keys[k.active], nil
+}
+
+func (k *Keyring) key(id int32) (cipher.AEAD, error) {
+	k.mu.RLock()
+	aead, ok := k.keys[id]
+	fetch := k.fetch
+	k.mu.RUnlock()
+	if ok {
+		return aead, nil
+	}
+	if fetch == nil {
+		return nil, fmt.Errorf("envelope: unknown data key %d", id)
+	}
+	key, err := fetch(id)
+	if err != nil {
+		return nil, fmt.Errorf("envelope: data key %d: %w", id, err)
+	}
+	if err := k.Add(id, key); err != nil {
+		return nil, err
+	}
+	return k.key(id)
+}
+
+// SealString encrypts text with the active key.
+func (k *Keyring) SealString(text string) (string, error) {
+	id, aead, err := k.activeKey()
+	if err != nil {
+		return "", err
+	}
+	prefix := SealedPrefix(id)
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(text), []byte(prefix))
+	return prefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// OpenString decrypts text sealed by SealString. Text that isn't sealed is
+// returned unchanged, so rows written before encryption was enabled still
+// read.
+func (k *Keyring) OpenString(text string) (string, error) {
+	id, ok := TextKeyID(text)
+	if !ok {
+		return text, nil
+	}
+	aead, err := k.key(id)
+	if err != nil {
+		return "", err
+	}
+	prefix := SealedPrefix(id)
+	sealed, err := base64.RawStdEncoding.DecodeString(text[len(prefix):])
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", errors.New("envelope: sealed text is corrupt")
+	}
+	size := aead.NonceSize()
+	plain, err := aead.Open(nil, sealed[:size], sealed[size:], []byte(prefix))
+	if err != nil {
+		return "", errors.New("envelope: sealed text is corrupt")
+	}
+	return string(plain), nil
+}
+
+// SealedPrefix is how text sealed with the given key starts.
+func SealedPrefix(id int32) string {
+	return textPrefix + strconv.Itoa(int(id)) + ":"
+}
+
+// TextKeyID reports the key that sealed text, and whether it is sealed.
+func TextKeyID(text string) (int32, bool) {
+	rest, ok := strings.CutPrefix(text, textPrefix)
+	if !ok {
+		return 0, false
+	}
+	digits, _, ok := strings.Cut(rest, ":")
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(digits, 10, 32)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return int32(id), true
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("key must be %d bytes, got %d", KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
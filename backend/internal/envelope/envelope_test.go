@@ -0,0 +1,219 @@
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+func testKeyring(t *testing.T, ids ...int32) *Keyring {
+	t.Helper()
+	keys := NewKeyring()
+	for _, id := range ids {
+		key, err := NewDataKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := keys.Add(id, key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return keys
+}
+
+func TestMasterKeyWrapsDataKeys(t *testing.T) {
+	master, err := ParseMasterKey("AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	dataKey, _ := NewDataKey()
+	org := []byte("org-a")
+	wrapped, err := master.Wrap(dataKey, org)
+	if err != nil {
+		t.Fatalf("wrap: %v", err)
+	}
+	if got, err := master.Unwrap(wrapped, org); err != nil || !bytes.Equal(got, dataKey) {
+		t.Fatalf("unwrap = %x, %v", got, err)
+	}
+
+	other, _ := NewLocalMasterKey(bytes.Repeat([]byte{7}, KeySize))
+	if _, err := other.Unwrap(wrapped, org); err == nil {
+		t.Fatal("another master key unwrapped the data key")
+	}
+	if _, err := master.Unwrap(wrapped, []byte("org-b")); err == nil {
+		t.Fatal("the data key unwrapped for another org")
+	}
+	if _, err := master.Unwrap(wrapped, nil); err == nil {
+		t.Fatal("the data key unwrapped without its org")
+	}
+	if _, err := ParseMasterKey("c2hvcnQ="); err == nil {
+		t.Fatal("accepted a short master key")
+	}
+}
+
+func TestSealString(t *testing.T) {
+	keys := testKeyring(t, 1)
+	sealed, err := keys.SealString("Speaker 1: hello")
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	if !strings.HasPrefix(sealed, SealedPrefix(1)) || strings.Contains(sealed, "hello") {
+		t.Fatalf("sealed = %q", sealed)
+	}
+	if id, ok := TextKeyID(sealed); !ok || id != 1 {
+		t.Fatalf("key id = %d, %v", id, ok)
+	}
+
+	// Adding a key activates it; text sealed earlier still opens.
+	if err := keys.Add(2, bytes.Repeat([]byte{2}, KeySize)); err != nil {
+		t.Fatal(err)
+	}
+	resealed, _ := keys.SealString("Speaker 1: hello")
+	if !strings.HasPrefix(resealed, SealedPrefix(2)) {
+		t.Fatalf("resealed = %q, want key 2", resealed)
+	}
+	for _, text := range []string{sealed, resealed} {
+		if plain, err := keys.OpenString(text); err != nil || plain != "Speaker 1: hello" {
+			t.Fatalf("open = %q, %v", plain, err)
+		}
+	}
+
+	if plain, err := keys.OpenString("written by the worker"); err != nil || plain != "written by the worker" {
+		t.Fatalf("plaintext = %q, %v", plain, err)
+	}
+	if _, err := keys.OpenString(sealed[:len(sealed)-4] + "AAAA"); err == nil {
+		t.Fatal("opened tampered text")
+	}
+	if _, err := testKeyring(t, 5).OpenString(sealed); err == nil {
+		t.Fatal("opened text sealed with an unknown key")
+	}
+}
+
+func TestKeyringReset(t *testing.T) {
+	replacement := bytes.Repeat([]byte{9}, KeySize)
+	keys := testKeyring(t, 1, 2)
+	keys.SetFetch(func(id int32) ([]byte, error) { return replacement, nil })
+	before, _ := keys.SealString("hello")
+
+	keys.Reset()
+	if keys.Active() != 0 {
+		t.Fatalf("active = %d after reset", keys.Active())
+	}
+	// Key 2 is fetched again and is now the replacement, which can't open
+	// what the old key 2 sealed.
+	if _, err := keys.OpenString(before); err == nil {
+		t.Fatal("opened text sealed with a forgotten key")
+	}
+	want := testKeyring(t)
+	if err := want.Add(2, replacement); err != nil {
+		t.Fatal(err)
+	}
+	sealed, _ := want.SealString("hello")
+	if plain, err := keys.OpenString(sealed); err != nil || plain != "hello" {
+		t.Fatalf("open = %q, %v", plain, err)
+	}
+}
+
+func TestStoreEncryptsObjects(t *testing.T) {
+	ctx := context.Background()
+	local, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := testKeyring(t, 1)
+	store := NewStore(local, keys, "recordings/")
+
+	for _, size := range []int{0, 100, segmentSize, 3*segmentSize + 17} {
+		audio := make([]byte, size)
+		rand.Read(audio)
+		n, err := store.Put(ctx, "recordings/a.wav", bytes.NewReader(audio))
+		if err != nil || n != int64(size) {
+			t.Fatalf("put %d bytes: n=%d err=%v", size, n, err)
+		}
+		raw := readAll(t, local, "recordings/a.wav")
+		if size > 0 && bytes.Contains(raw, audio[:min(size, 64)]) {
+			t.Fatalf("%d bytes stored in plaintext", size)
+		}
+		if got := readAll(t, store, "recordings/a.wav"); !bytes.Equal(got, audio) {
+			t.Fatalf("%d bytes: round trip returned %d bytes", size, len(got))
+		}
+	}
+
+	// Truncating the object is detected rather than returning a short read.
+	raw := readAll(t, local, "recordings/a.wav")
+	local.Put(ctx, "recordings/a.wav", bytes.NewReader(raw[:headerSize+segmentSize+keys.keys[1].Overhead()]))
+	rc, _ := store.Open(ctx, "recordings/a.wav")
+	if _, err := io.ReadAll(rc); err == nil {
+		t.Fatal("read a truncated object without error")
+	}
+	rc.Close()
+
+	// Other prefixes and objects written before encryption pass through.
+	store.Put(ctx, "avatars/a.png", strings.NewReader("PNG"))
+	local.Put(ctx, "recordings/old.wav", strings.NewReader("RIFF"))
+	for key, want := range map[string]string{"avatars/a.png": "PNG", "recordings/old.wav": "RIFF"} {
+		if got := string(readAll(t, local, key)); got != want {
+			t.Fatalf("%s stored as %q", key, got)
+		}
+		if got := string(readAll(t, store, key)); got != want {
+			t.Fatalf("%s read as %q", key, got)
+		}
+	}
+}
+
+func TestStoreRekey(t *testing.T) {
+	ctx := context.Background()
+	local, _ := storage.NewLocal(t.TempDir())
+	keys := testKeyring(t, 1)
+	store := NewStore(local, keys, "recordings/")
+	store.Put(ctx, "recordings/new.wav", strings.NewReader("new audio"))
+	local.Put(ctx, "recordings/old.wav", strings.NewReader("old audio"))
+	local.Put(ctx, "avatars/a.png", strings.NewReader("PNG"))
+
+	rekey := func(key string) bool {
+		t.Helper()
+		done, err := store.Rekey(ctx, key)
+		if err != nil {
+			t.Fatalf("rekey %s: %v", key, err)
+		}
+		return done
+	}
+	if rekey("recordings/new.wav") || rekey("avatars/a.png") {
+		t.Fatal("rewrote an object that was already current")
+	}
+	if !rekey("recordings/old.wav") {
+		t.Fatal("left plaintext audio unencrypted")
+	}
+
+	keys.Add(2, bytes.Repeat([]byte{2}, KeySize))
+	if !rekey("recordings/new.wav") || !rekey("recordings/old.wav") {
+		t.Fatal("left audio on the old key")
+	}
+	for key, want := range map[string]string{"recordings/new.wav": "new audio", "recordings/old.wav": "old audio"} {
+		if id, _ := store.objectKeyID(ctx, key); id != 2 {
+			t.Fatalf("%s key = %d, want 2", key, id)
+		}
+		if got := string(readAll(t, store, key)); got != want {
+			t.Fatalf("%s = %q", key, got)
+		}
+	}
+}
+
+func readAll(t *testing.T, store storage.Store, key string) []byte {
+	t.Helper()
+	rc, err := store.Open(context.Background(), key)
+	if err != nil {
+		t.Fatalf("open %s: %v", key, err)
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("read %s: %v", key, err)
+	}
+	return data
+}
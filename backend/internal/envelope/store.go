@@ -0,0 +1,288 @@
+package envelope
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// Encrypted objects start with a header naming the data key and the nonce
+// prefix, followed by segments of up to segmentSize plaintext bytes, each
+// sealed on its own so large audio streams without being held in memory.
+// Every segment authenticates the header and whether it is the last one,
+// so segments can't be reordered, swapped between objects or dropped from
+// the end.
+const (
+	magic       = "SECENC\x00\x01"
+	headerSize  = len(magic) + 4 + 8
+	segmentSize = 64 << 10
+)
+
+// Store encrypts objects below the given key prefixes before handing them
+// to the underlying store and decrypts them on the way out. Objects
+// written before encryption was enabled are read back as they are.
+type Store struct {
+	inner    storage.Store
+	keys     *Keyring
+	prefixes []string
+}
+
+func NewStore(inner storage.Store, keys *Keyring, prefixes ...string) *Store {
+	return &Store{inner: inner, keys: keys, prefixes: prefixes}
+}
+
+func (s *Store) encrypts(key string) bool {
+	for _, prefix := range s.prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Put returns the plaintext size so usage accounting doesn't depend on
+// whether an object is encrypted.
+func (s *Store) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	if !s.encrypts(key) {
+		return s.inner.Put(ctx, key, r)
+	}
+	id, aead, err := s.keys.activeKey()
+	if err != nil {
+		return 0, err
+	}
+	header := make([]byte, headerSize)
+	copy(header, magic)
+	binary.BigEndian.PutUint32(header[len(magic):], uint32(id))
+	if _, err := rand.Read(header[len(magic)+4:]); err != nil {
+		return 0, err
+	}
+	counted := &countingReader{r: r}
+	sealed := &sealReader{src: counted, aead: aead, header: header, out: header}
+	if _, err := s.inner.Put(ctx, key, sealed); err != nil {
+		return 0, err
+	}
+	return counted.n, nil
+}
+
+func (s *Store) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	rc, err := s.inner.Open(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReaderSize(rc, segmentSize+64)
+	header, err := br.Peek(headerSize)
+	if !bytes.HasPrefix(header, []byte(magic)) {
+		// Plaintext, possibly shorter than a header.
+		if err != nil && !errors.Is(err, io.EOF) {
+			rc.Close()
+			return nil, err
+		}
+		return readCloser{br, rc}, nil
+	}
+	if err != nil {
+		rc.Close()
+		return nil, errors.New("envelope: encrypted object is truncated")
+	}
+	aead, err := s.keys.key(int32(binary.BigEndian.Uint32(header[len(magic):])))
+	if err != nil {
+		rc.Close()
+		return nil, err
+	}
+	header = bytes.Clone(header)
+	if _, err := br.Discard(headerSize); err != nil {
+		rc.Close()
+		return nil, err
+	}
+	return readCloser{&openReader{src: br, aead: aead, header: header}, rc}, nil
+}
+
+func (s *Store) Delete(ctx context.Context, key string) error {
+	return s.inner.Delete(ctx, key)
+}
+
+// Unwrap returns the underlying store. Objects clients upload to it
+// directly arrive in plaintext and are sealed by the next Rekey.
+func (s *Store) Unwrap() storage.Store {
+	return s.inner
+}
+
+// Rekey re-encrypts an object with the active key if it was written in
+// plaintext or with an older key. It reports whether the object was
+// rewritten.
+func (s *Store) Rekey(ctx context.Context, key string) (bool, error) {
+	id, err := s.objectKeyID(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if id == s.keys.Active() || (id == 0 && !s.encrypts(key)) {
+		return false, nil
+	}
+	body, err := s.Open(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	defer body.Close()
+	if _, err := s.Put(ctx, key, body); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// objectKeyID returns the data key an object is encrypted with, or 0 for
+// plaintext.
+func (s *Store) objectKeyID(ctx context.Context, key string) (int32, error) {
+	rc, err := s.inner.Open(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+	header := make([]byte, headerSize)
+	n, err := io.ReadFull(rc, header)
+	if !bytes.HasPrefix(header[:n], []byte(magic)) || n < headerSize {
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return 0, err
+		}
+		return 0, nil
+	}
+	return int32(binary.BigEndian.Uint32(header[len(magic):])), nil
+}
+
+func segmentNonce(header []byte, counter uint32) []byte {
+	nonce := make([]byte, 12)
+	copy(nonce, header[len(magic)+4:])
+	binary.BigEndian.PutUint32(nonce[8:], counter)
+	return nonce
+}
+
+func segmentAAD(header []byte, last bool) []byte {
+	aad := append(bytes.Clone(header), 0)
+	if last {
+		aad[len(aad)-1] = 1
+	}
+	return aad
+}
+
+// sealReader reads plaintext and yields the encrypted object. It keeps one
+// byte beyond a full segment to know whether that segment is the last.
+type sealReader struct {
+	src     io.Reader
+	aead    cipher.AEAD
+	header  []byte
+	pending []byte
+	out     []byte
+	counter uint32
+	done    bool
+}
+
+func (r *sealReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.fill(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+func (r *sealReader) fill() error {
+	if r.pending == nil {
+		r.pending = make([]byte, 0, segmentSize+1)
+	}
+	n, err := io.ReadFull(r.src, r.pending[len(r.pending):segmentSize+1])
+	r.pending = r.pending[:len(r.pending)+n]
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return err
+	}
+	last := len(r.pending) <= segmentSize
+	plain := r.pending
+	if !last {
+		plain = r.pending[:segmentSize]
+	}
+	r.out = r.aead.Seal(nil, segmentNonce(r.header, r.counter), plain, segmentAAD(r.header, last))
+	r.counter++
+	if last {
+		r.done = true
+		return nil
+	}
+	r.pending = append(r.pending[:0], r.pending[segmentSize:]...)
+	return nil
+}
+
+// openReader decrypts the segments following an object's header.
+type openReader struct {
+	src     *bufio.Reader
+	aead    cipher.AEAD
+	header  []byte
+	buf     []byte
+	out     []byte
+	counter uint32
+	done    bool
+}
+
+func (r *openReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+func (r *openReader) next() error {
+	size := segmentSize + r.aead.Overhead()
+	if r.buf == nil {
+		r.buf = make([]byte, size)
+	}
+	n, err := io.ReadFull(r.src, r.buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return err
+	}
+	last := n < size
+	if !last {
+		// A full segment is the last one when nothing follows it.
+		if _, err := r.src.Peek(1); errors.Is(err, io.EOF) {
+			last = true
+		}
+	}
+	plain, err := r.aead.Open(r.buf[:0], segmentNonce(r.header, r.counter), r.buf[:n], segmentAAD(r.header, last))
+	if err != nil {
+		return errors.New("envelope: encrypted object is corrupt or truncated")
+	}
+	r.out = plain
+	r.counter++
+	r.done = last
+	return nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
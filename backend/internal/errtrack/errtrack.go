@@ -0,0 +1,57 @@
+// Package errtrack sends the failures the server only logs otherwise —
+// panics, calls that fail through the server's fault and failed jobs — to
+// an error tracker, so they are seen without reading the logs.
+package errtrack
+
+import (
+	"runtime/debug"
+	"time"
+)
+
+// Level is how bad an event is, in the tracker's terms.
+type Level string
+
+const (
+	LevelFatal   Level = "fatal"
+	LevelError   Level = "error"
+	LevelWarning Level = "warning"
+)
+
+// Event is one failure.
+type Event struct {
+	Level Level
+	// Type names the kind of failure, such as "panic", a Connect code or
+	// "job failure". The tracker shows it as the title.
+	Type    string
+	Message string
+	// Where names what failed: a Connect procedure, an HTTP path, a
+	// processing stage or a scheduled task.
+	Where     string
+	RequestID string
+	// UserID is the caller, or zero outside a request or when it was
+	// anonymous.
+	UserID int64
+	Tags   map[string]string
+	// Fingerprint groups events into one issue. Empty leaves grouping to
+	// the tracker, by stack or else by message.
+	Fingerprint []string
+	// Stack holds program counters as runtime.Callers returns them, or is
+	// empty when there is no useful stack, as for a failed job.
+	Stack []uintptr
+	Time  time.Time
+}
+
+// buildRevision is the VCS revision the binary was built from, or "" when
+// it was built outside a checkout.
+func buildRevision() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			return setting.Value
+		}
+	}
+	return ""
+}
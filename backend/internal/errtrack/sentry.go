@@ -0,0 +1,263 @@
+package errtrack
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// SentryConfig configures reporting to Sentry or a service speaking its
+// protocol, such as GlitchTip.
+type SentryConfig struct {
+	// DSN is the project's client key URL,
+	// https://<key>@<host>/<project id>.
+	DSN         string
+	Environment string
+	// Release defaults to the VCS revision the binary was built from.
+	Release string
+}
+
+const (
+	sentryTimeout = 10 * time.Second
+	// sentryInFlight bounds the events sent at once; a handler that fails
+	// every request would otherwise pile them up. Events beyond it are
+	// dropped; they are in the log.
+	sentryInFlight = 4
+	// modulePrefix marks the frames of this program's own code, which
+	// Sentry highlights over the standard library's and dependencies'.
+	modulePrefix = "github.com/mvult/secretary/"
+)
+
+// Sentry sends events to a Sentry project through its envelope endpoint.
+type Sentry struct {
+	dsn         string
+	endpoint    string
+	auth        string
+	environment string
+	release     string
+	serverName  string
+	http        *http.Client
+	inFlight    chan struct{}
+}
+
+func NewSentry(cfg SentryConfig) (*Sentry, error) {
+	u, err := url.Parse(cfg.DSN)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || u.User == nil || u.User.Username() == "" {
+		return nil, errors.New("sentry: DSN must look like https://<key>@<host>/<project id>")
+	}
+	path := strings.Trim(u.Path, "/")
+	i := strings.LastIndex(path, "/")
+	project := path[i+1:]
+	if project == "" {
+		return nil, errors.New("sentry: DSN has no project id")
+	}
+	prefix := ""
+	if i >= 0 {
+		prefix = "/" + path[:i]
+	}
+	serverName, _ := os.Hostname()
+	release := cfg.Release
+	if release == "" {
+		release = buildRevision()
+	}
+	return &Sentry{
+		dsn:         cfg.DSN,
+		endpoint:    fmt.Sprintf("%s://%s%s/api/%s/envelope/", u.Scheme, u.Host, prefix, project),
+		auth:        "Sentry sentry_version=7, sentry_client=secretary/1.0, sentry_key=" + u.User.Username(),
+		environment: cfg.Environment,
+		release:     release,
+		serverName:  serverName,
+		http:        &http.Client{Timeout: sentryTimeout},
+		inFlight:    make(chan struct{}, sentryInFlight),
+	}, nil
+}
+
+// Capture sends e in the background. Failures are only logged: so is the
+// event.
+func (s *Sentry) Capture(e Event) {
+	select {
+	case s.inFlight <- struct{}{}:
+	default:
+		log.Printf("sentry: dropped an event, %d already in flight", sentryInFlight)
+		return
+	}
+	go func() {
+		defer func() { <-s.inFlight }()
+		ctx, cancel := context.WithTimeout(context.Background(), sentryTimeout)
+		defer cancel()
+		if err := s.Send(ctx, e); err != nil {
+			log.Printf("sentry: %v", err)
+		}
+	}()
+}
+
+// Send sends e and waits for Sentry to accept it.
+func (s *Sentry) Send(ctx context.Context, e Event) error {
+	body, err := s.envelope(e)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", s.auth)
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("send event: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("send event: %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+type sentryEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Platform    string            `json:"platform"`
+	Level       string            `json:"level"`
+	Logger      string            `json:"logger"`
+	ServerName  string            `json:"server_name,omitempty"`
+	Environment string            `json:"environment,omitempty"`
+	Release     string            `json:"release,omitempty"`
+	Transaction string            `json:"transaction,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Fingerprint []string          `json:"fingerprint,omitempty"`
+	User        *sentryUser       `json:"user,omitempty"`
+	Exception   struct {
+		Values []sentryException `json:"values"`
+	} `json:"exception"`
+}
+
+type sentryUser struct {
+	ID string `json:"id"`
+}
+
+type sentryException struct {
+	Type       string            `json:"type"`
+	Value      string            `json:"value"`
+	Stacktrace *sentryStacktrace `json:"stacktrace,omitempty"`
+}
+
+type sentryStacktrace struct {
+	Frames []sentryFrame `json:"frames"`
+}
+
+type sentryFrame struct {
+	Function string `json:"function"`
+	Module   string `json:"module,omitempty"`
+	AbsPath  string `json:"abs_path"`
+	Lineno   int    `json:"lineno"`
+	InApp    bool   `json:"in_app"`
+}
+
+// envelope encodes e as a Sentry envelope holding one event.
+func (s *Sentry) envelope(e Event) ([]byte, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	when := e.Time
+	if when.IsZero() {
+		when = time.Now()
+	}
+	level := e.Level
+	if level == "" {
+		level = LevelError
+	}
+	event := sentryEvent{
+		EventID:     hex.EncodeToString(id),
+		Timestamp:   when.UTC().Format(time.RFC3339Nano),
+		Platform:    "go",
+		Level:       string(level),
+		Logger:      "secretary",
+		ServerName:  s.serverName,
+		Environment: s.environment,
+		Release:     s.release,
+		Transaction: e.Where,
+		Tags:        map[string]string{},
+		Fingerprint: e.Fingerprint,
+	}
+	for key, value := range e.Tags {
+		event.Tags[key] = value
+	}
+	if e.RequestID != "" {
+		event.Tags["request_id"] = e.RequestID
+	}
+	if e.UserID != 0 {
+		event.User = &sentryUser{ID: fmt.Sprint(e.UserID)}
+	}
+	exception := sentryException{Type: e.Type, Value: e.Message}
+	if len(e.Stack) > 0 {
+		exception.Stacktrace = &sentryStacktrace{Frames: frames(e.Stack)}
+	}
+	event.Exception.Values = []sentryException{exception}
+
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	header := map[string]string{"event_id": event.EventID, "dsn": s.dsn, "sent_at": time.Now().UTC().Format(time.RFC3339Nano)}
+	if err := enc.Encode(header); err != nil {
+		return nil, err
+	}
+	if err := enc.Encode(map[string]string{"type": "event"}); err != nil {
+		return nil, err
+	}
+	if err := enc.Encode(event); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// frames converts a stack to Sentry frames, which list the outermost call
+// first. The runtime's own panic machinery is left out.
+func frames(stack []uintptr) []sentryFrame {
+	var out []sentryFrame
+	iter := runtime.CallersFrames(stack)
+	for {
+		frame, more := iter.Next()
+		if frame.Function != "" && !strings.HasPrefix(frame.Function, "runtime.") {
+			module, function := splitFunction(frame.Function)
+			out = append(out, sentryFrame{
+				Function: function,
+				Module:   module,
+				AbsPath:  frame.File,
+				Lineno:   frame.Line,
+				InApp:    strings.HasPrefix(frame.Function, modulePrefix),
+			})
+		}
+		if !more {
+			break
+		}
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out
+}
+
+// splitFunction splits a qualified function name such as
+// github.com/a/b/pkg.(*T).Method into its package path and the rest.
+func splitFunction(name string) (module, function string) {
+	slash := strings.LastIndex(name, "/")
+	dot := strings.Index(name[slash+1:], ".")
+	if dot < 0 {
+		return "", name
+	}
+	return name[:slash+1+dot], name[slash+1+dot+1:]
+}
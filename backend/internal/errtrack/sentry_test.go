@@ -0,0 +1,81 @@
+package errtrack
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestNewSentryRejectsBadDSN(t *testing.T) {
+	for _, dsn := range []string{"", "https://sentry.example.com/1", "https://key@sentry.example.com/", "ftp://key@host/1"} {
+		if _, err := NewSentry(SentryConfig{DSN: dsn}); err == nil {
+			t.Errorf("NewSentry(%q) accepted a bad DSN", dsn)
+		}
+	}
+}
+
+func TestSentrySend(t *testing.T) {
+	var auth, path string
+	var lines []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth, path = r.Header.Get("X-Sentry-Auth"), r.URL.Path
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+	}))
+	defer ts.Close()
+
+	tracker, err := NewSentry(SentryConfig{DSN: strings.Replace(ts.URL, "://", "://public@", 1) + "/sentry/42", Environment: "test", Release: "1.2.3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stack := make([]uintptr, 32)
+	stack = stack[:runtime.Callers(1, stack)]
+	err = tracker.Send(context.Background(), Event{Level: LevelFatal, Type: "panic", Message: "boom", Where: "/secretary.v1.AdminService/GetSystemStats", RequestID: "abc123", UserID: 7, Stack: stack})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/sentry/api/42/envelope/" || !strings.Contains(auth, "sentry_key=public") {
+		t.Fatalf("posted to %s with auth %q", path, auth)
+	}
+	if len(lines) != 3 || lines[1] != `{"type":"event"}` {
+		t.Fatalf("envelope = %q", lines)
+	}
+	var event sentryEvent
+	if err := json.Unmarshal([]byte(lines[2]), &event); err != nil {
+		t.Fatal(err)
+	}
+	if event.Tags["request_id"] != "abc123" || event.User.ID != "7" || event.Environment != "test" || event.Release != "1.2.3" || event.Level != "fatal" || event.Exception.Values[0].Value != "boom" {
+		t.Fatalf("event = %+v", event)
+	}
+	frames := event.Exception.Values[0].Stacktrace.Frames
+	last := frames[len(frames)-1]
+	if last.Function != "TestSentrySend" || last.Module != "github.com/mvult/secretary/backend/internal/errtrack" || !last.InApp {
+		t.Fatalf("innermost frame = %+v; want this test", last)
+	}
+}
+
+func TestSentryEventWithoutStack(t *testing.T) {
+	tracker, err := NewSentry(SentryConfig{DSN: "https://public@sentry.example.com/1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := tracker.envelope(Event{Type: "job failure", Message: "transcription timed out", Where: "transcribing", Fingerprint: []string{"job failure", "transcribing"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+	var event sentryEvent
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &event); err != nil {
+		t.Fatal(err)
+	}
+	if event.Level != "error" || event.Exception.Values[0].Stacktrace != nil || len(event.Fingerprint) != 2 {
+		t.Fatalf("event = %+v", event)
+	}
+}
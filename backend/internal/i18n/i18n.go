@@ -0,0 +1,143 @@
+// Package i18n translates text the server writes for people: emails,
+// calendar exports and error messages. Messages are looked up by their
+// English text, gettext style, so call sites stay readable and anything
+// without a translation falls back to English unchanged.
+//
+//	subject := i18n.T(locale, "Confirm your email address")
+//	body := i18n.Sprintf(locale, "Hi %s,", name)
+//
+// Catalogs are JSON objects in locales/<tag>.json mapping the English text
+// to its translation. Format verbs must match the English text.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Default is the source language of every message.
+const Default = "en"
+
+//go:embed locales/*.json
+var files embed.FS
+
+var (
+	catalogs  = map[string]map[string]string{}
+	supported = []string{Default}
+	matcher   language.Matcher
+)
+
+func init() {
+	entries, err := files.ReadDir("locales")
+	if err != nil {
+		panic(err)
+	}
+	for _, entry := range entries {
+		data, err := files.ReadFile("locales/" + entry.Name())
+		if err != nil {
+			panic(err)
+		}
+		catalog := map[string]string{}
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			panic(fmt.Sprintf("i18n: %s: %v", entry.Name(), err))
+		}
+		locale := strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))
+		catalogs[locale] = catalog
+		supported = append(supported, locale)
+	}
+	slices.Sort(supported[1:])
+	tags := make([]language.Tag, len(supported))
+	for i, locale := range supported {
+		tags[i] = language.MustParse(locale)
+	}
+	matcher = language.NewMatcher(tags)
+}
+
+// Supported lists the locales with a catalog, English first.
+func Supported() []string {
+	return slices.Clone(supported)
+}
+
+// Normalize returns the supported locale tag names, e.g. "es" for "es-MX",
+// and false when there is no catalog for it.
+func Normalize(tag string) (string, bool) {
+	parsed, err := language.Parse(strings.TrimSpace(tag))
+	if err != nil {
+		return "", false
+	}
+	_, index, confidence := matcher.Match(parsed)
+	want, _ := parsed.Base()
+	got, _ := language.MustParse(supported[index]).Base()
+	if confidence < language.High || want != got {
+		return "", false
+	}
+	return supported[index], true
+}
+
+// Negotiate picks the locale for a reader: their saved preference when it
+// is supported, otherwise the best match for an Accept-Language header,
+// otherwise English.
+func Negotiate(preferred string, acceptLanguage string) string {
+	if locale, ok := Normalize(preferred); ok {
+		return locale
+	}
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return Default
+	}
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return Default
+	}
+	return supported[index]
+}
+
+// T translates msg, returning it unchanged when locale has no translation.
+func T(locale string, msg string) string {
+	if translated, ok := catalogs[locale][msg]; ok && translated != "" {
+		return translated
+	}
+	return msg
+}
+
+// Sprintf translates format and then formats it.
+func Sprintf(locale string, format string, args ...any) string {
+	return fmt.Sprintf(T(locale, format), args...)
+}
+
+// Translatable reports whether any catalog translates msg or one of its
+// parts, letting callers skip working out the reader's locale.
+func Translatable(msg string) bool {
+	for _, catalog := range catalogs {
+		for _, part := range append(strings.Split(msg, ": "), msg) {
+			if _, ok := catalog[part]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Message translates an error message. Server errors are built as
+// "summary: detail" (see apierr), so when the whole message has no
+// translation each part is translated on its own; field names and other
+// unknown parts stay as they are.
+func Message(locale string, msg string) string {
+	if locale == Default || catalogs[locale] == nil {
+		return msg
+	}
+	if translated := T(locale, msg); translated != msg {
+		return translated
+	}
+	parts := strings.Split(msg, ": ")
+	for i, part := range parts {
+		parts[i] = T(locale, part)
+	}
+	return strings.Join(parts, ": ")
+}
@@ -0,0 +1,55 @@
+package i18n
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+)
+
+var verbPattern = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+// TestCatalogsKeepFormatVerbs guards against translations that would
+// print %!(EXTRA ...) or drop an argument.
+func TestCatalogsKeepFormatVerbs(t *testing.T) {
+	for locale, catalog := range catalogs {
+		for msg, translated := range catalog {
+			if want, got := verbPattern.FindAllString(msg, -1), verbPattern.FindAllString(translated, -1); !slices.Equal(want, got) {
+				t.Errorf("%s: %q has verbs %v, want %v", locale, translated, got, want)
+			}
+		}
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	for _, tc := range []struct {
+		preferred, accept, want string
+	}{
+		{"", "", "en"},
+		{"", "es-MX,es;q=0.9,en;q=0.8", "es"},
+		{"", "ja,fr;q=0.5", "en"},
+		{"de", "es", "de"},
+		{"pt-BR", "de-AT", "de"},
+		{"", "not a header;;;", "en"},
+		{"tlh", "", "en"},
+	} {
+		if got := Negotiate(tc.preferred, tc.accept); got != tc.want {
+			t.Errorf("Negotiate(%q, %q) = %q, want %q", tc.preferred, tc.accept, got, tc.want)
+		}
+	}
+}
+
+func TestMessage(t *testing.T) {
+	if got := Message("es", "failed to list todos: not found"); got != "no se pudieron listar las tareas: no encontrado" {
+		t.Errorf("got %q", got)
+	}
+	if got := Message("es", "email: must be a plain email address"); got != "email: debe ser una dirección de correo simple" {
+		t.Errorf("got %q", got)
+	}
+	if got := Message("es", "something new"); got != "something new" {
+		t.Errorf("untranslated messages should pass through, got %q", got)
+	}
+	if got := Sprintf("de", "From recording: %s", "Weekly"); got != fmt.Sprintf("Aus der Aufnahme: %s", "Weekly") {
+		t.Errorf("got %q", got)
+	}
+}
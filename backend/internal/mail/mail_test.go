@@ -0,0 +1,35 @@
+package mail
+
+import (
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCompose(t *testing.T) {
+	from := &mail.Address{Name: "Secretary", Address: "noreply@example.com"}
+	to := &mail.Address{Address: "ana@example.com"}
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+
+	body, err := compose(from, to, Message{Subject: "Confirm your email", Text: "Hi\nClick the link"}, now)
+	if err != nil {
+		t.Fatalf("compose: %v", err)
+	}
+	got := string(body)
+	for _, want := range []string{
+		"From: \"Secretary\" <noreply@example.com>\r\n",
+		"To: <ana@example.com>\r\n",
+		"Subject: Confirm your email\r\n",
+		"Date: Sat, 17 Oct 2026 09:00:00 +0000\r\n",
+		"\r\n\r\nHi\r\nClick the link",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("message missing %q:\n%s", want, got)
+		}
+	}
+
+	if _, err := compose(from, to, Message{Subject: "Hi\r\nBcc: victim@example.com"}, now); err == nil {
+		t.Fatal("expected a subject with a line break to be rejected")
+	}
+}
@@ -0,0 +1,141 @@
+// Package notify posts team notifications, such as meeting summaries and
+// todo assignments, to chat channels through incoming webhooks.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+// Channel names.
+const (
+	Slack = "slack"
+	Teams = "teams"
+)
+
+// Fact is a labelled detail shown under a message, such as a due date.
+type Fact struct {
+	Name  string
+	Value string
+}
+
+// Message is a notification before a channel formats it. Text is plain
+// text; URL, when set, is offered as a button labelled LinkText.
+type Message struct {
+	Title    string
+	Text     string
+	Facts    []Fact
+	LinkText string
+	URL      string
+}
+
+// Channel delivers messages to one chat service.
+type Channel interface {
+	Name() string
+	Send(ctx context.Context, msg Message) error
+}
+
+// Notifier sends every message to each configured channel. A nil Notifier
+// sends nothing.
+type Notifier struct {
+	channels []Channel
+}
+
+func New(channels ...Channel) *Notifier {
+	return &Notifier{channels: channels}
+}
+
+// Enabled reports whether any channel is configured, so callers can skip
+// building messages nobody receives.
+func (n *Notifier) Enabled() bool {
+	return n != nil && len(n.channels) > 0
+}
+
+// Names lists the configured channels.
+func (n *Notifier) Names() []string {
+	if n == nil {
+		return nil
+	}
+	names := make([]string, 0, len(n.channels))
+	for _, channel := range n.channels {
+		names = append(names, channel.Name())
+	}
+	return names
+}
+
+// Without returns a Notifier that skips the named channels.
+func (n *Notifier) Without(names ...string) *Notifier {
+	if n == nil || len(names) == 0 {
+		return n
+	}
+	kept := make([]Channel, 0, len(n.channels))
+	for _, channel := range n.channels {
+		if !slices.Contains(names, channel.Name()) {
+			kept = append(kept, channel)
+		}
+	}
+	return New(kept...)
+}
+
+// Send delivers msg to every channel. A failing channel does not stop the
+// others; their errors are returned together.
+func (n *Notifier) Send(ctx context.Context, msg Message) error {
+	if n == nil {
+		return nil
+	}
+	var errs []error
+	for _, channel := range n.channels {
+		if err := channel.Send(ctx, msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", channel.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+const requestTimeout = 15 * time.Second
+
+// postJSON sends payload to an incoming webhook. Webhooks answer with a
+// short text body rather than JSON, so the reply is only checked for
+// errors.
+func postJSON(ctx context.Context, client *http.Client, channel string, url string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 400 {
+		return apierr.NewProviderError(channel, resp, respBody)
+	}
+	return nil
+}
+
+// truncate shortens text to at most limit runes, marking the cut.
+func truncate(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit-1]) + "…"
+}
@@ -0,0 +1,112 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+var assignment = Message{
+	Title:    "Todo assigned to Ana",
+	Text:     "Book the venue\nfor *all* 40 people",
+	Facts:    []Fact{{Name: "Due", Value: "Oct 10"}},
+	LinkText: "Open meeting",
+	URL:      "https://secretary.example.com/recordings/3",
+}
+
+func TestTeamsSendsMessageCard(t *testing.T) {
+	var card map[string]any
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&card)
+		_, _ = io.WriteString(w, "1")
+	}))
+	defer hook.Close()
+
+	if err := NewTeams(hook.URL).Send(context.Background(), assignment); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if card["@type"] != "MessageCard" || card["title"] != "Todo assigned to Ana" {
+		t.Fatalf("card = %v", card)
+	}
+	section := card["sections"].([]any)[0].(map[string]any)
+	if section["text"] != `Book the venue`+"\n\n"+`for \*all\* 40 people` {
+		t.Fatalf("text = %q", section["text"])
+	}
+	if fact := section["facts"].([]any)[0].(map[string]any); fact["name"] != "Due" || fact["value"] != "Oct 10" {
+		t.Fatalf("fact = %v", fact)
+	}
+	action := card["potentialAction"].([]any)[0].(map[string]any)
+	if action["@type"] != "OpenUri" || action["targets"].([]any)[0].(map[string]any)["uri"] != assignment.URL {
+		t.Fatalf("action = %v", action)
+	}
+}
+
+func TestSlackEscapesAndTruncates(t *testing.T) {
+	payload := slackPayload(Message{Title: strings.Repeat("t", 200), Text: "<!channel> R&D"})
+	if got := payload.Blocks[0].Text.Text; len([]rune(got)) != slackHeaderLimit {
+		t.Fatalf("header is %d runes", len([]rune(got)))
+	}
+	if got := payload.Blocks[1].Text.Text; got != "&lt;!channel&gt; R&amp;D" {
+		t.Fatalf("text = %q", got)
+	}
+}
+
+// failing always rejects messages.
+type failing struct{}
+
+func (failing) Name() string { return "broken" }
+
+func (failing) Send(context.Context, Message) error { return errors.New("down") }
+
+func TestNotifierKeepsGoingAfterAFailure(t *testing.T) {
+	var delivered int
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delivered++
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer hook.Close()
+
+	err := New(failing{}, NewSlack(hook.URL)).Send(context.Background(), assignment)
+	if err == nil || !strings.Contains(err.Error(), "broken: down") {
+		t.Fatalf("err = %v", err)
+	}
+	if delivered != 1 {
+		t.Fatalf("slack got %d messages", delivered)
+	}
+	if (*Notifier)(nil).Enabled() || New().Enabled() {
+		t.Fatal("notifier without channels reports enabled")
+	}
+}
+
+func TestNotifierWithout(t *testing.T) {
+	n := New(NewSlack("https://hooks.slack.com/x"), NewTeams("https://example.webhook.office.com/x"))
+	if got := n.Without(Teams).Names(); len(got) != 1 || got[0] != Slack {
+		t.Fatalf("Without(teams) = %v", got)
+	}
+	if n.Without(Slack, Teams).Enabled() {
+		t.Fatal("notifier without any channel left reports enabled")
+	}
+	if (*Notifier)(nil).Without(Slack) != nil {
+		t.Fatal("nil notifier grew channels")
+	}
+}
+
+func TestWebhookErrorIsProviderError(t *testing.T) {
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no_active_hooks", http.StatusGone)
+	}))
+	defer hook.Close()
+
+	err := NewSlack(hook.URL).Send(context.Background(), assignment)
+	var providerErr *apierr.ProviderError
+	if !errors.As(err, &providerErr) || providerErr.StatusCode != http.StatusGone {
+		t.Fatalf("err = %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// Slack caps header text at 150 characters and section text at 3000.
+const (
+	slackHeaderLimit = 150
+	slackTextLimit   = 3000
+)
+
+// SlackChannel posts Block Kit messages to a Slack incoming webhook.
+type SlackChannel struct {
+	webhookURL string
+	http       *http.Client
+}
+
+func NewSlack(webhookURL string) *SlackChannel {
+	return &SlackChannel{webhookURL: webhookURL, http: &http.Client{Timeout: requestTimeout}}
+}
+
+func (s *SlackChannel) Name() string { return Slack }
+
+func (s *SlackChannel) Send(ctx context.Context, msg Message) error {
+	return postJSON(ctx, s.http, Slack, s.webhookURL, slackPayload(msg))
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackElement struct {
+	Type string    `json:"type"`
+	Text slackText `json:"text"`
+	URL  string    `json:"url"`
+}
+
+type slackBlock struct {
+	Type     string         `json:"type"`
+	Text     *slackText     `json:"text,omitempty"`
+	Fields   []slackText    `json:"fields,omitempty"`
+	Elements []slackElement `json:"elements,omitempty"`
+}
+
+type slackMessage struct {
+	// Text is the fallback shown in notifications.
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+func slackPayload(msg Message) slackMessage {
+	out := slackMessage{
+		Text:   msg.Title,
+		Blocks: []slackBlock{{Type: "header", Text: &slackText{Type: "plain_text", Text: truncate(msg.Title, slackHeaderLimit)}}},
+	}
+	if text := strings.TrimSpace(msg.Text); text != "" {
+		out.Blocks = append(out.Blocks, slackBlock{Type: "section", Text: &slackText{Type: "mrkdwn", Text: truncate(slackEscaper.Replace(text), slackTextLimit)}})
+	}
+	if len(msg.Facts) > 0 {
+		fields := slackBlock{Type: "section"}
+		for _, fact := range msg.Facts {
+			fields.Fields = append(fields.Fields, slackText{Type: "mrkdwn", Text: "*" + slackEscaper.Replace(fact.Name) + "*\n" + slackEscaper.Replace(fact.Value)})
+		}
+		out.Blocks = append(out.Blocks, fields)
+	}
+	if msg.URL != "" {
+		out.Blocks = append(out.Blocks, slackBlock{Type: "actions", Elements: []slackElement{{
+			Type: "button",
+			Text: slackText{Type: "plain_text", Text: msg.LinkText},
+			URL:  msg.URL,
+		}}})
+	}
+	return out
+}
+
+// slackEscaper escapes the characters Slack reserves for links and
+// mentions.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
@@ -0,0 +1,106 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// teamsTextLimit keeps cards well under the 28 KB Teams accepts.
+const teamsTextLimit = 8000
+
+const teamsThemeColor = "4C6EF5"
+
+// TeamsChannel posts message cards to a Microsoft Teams incoming webhook.
+type TeamsChannel struct {
+	webhookURL string
+	http       *http.Client
+}
+
+func NewTeams(webhookURL string) *TeamsChannel {
+	return &TeamsChannel{webhookURL: webhookURL, http: &http.Client{Timeout: requestTimeout}}
+}
+
+func (t *TeamsChannel) Name() string { return Teams }
+
+func (t *TeamsChannel) Send(ctx context.Context, msg Message) error {
+	return postJSON(ctx, t.http, Teams, t.webhookURL, teamsCard(msg))
+}
+
+type teamsFact struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type teamsSection struct {
+	Text  string      `json:"text,omitempty"`
+	Facts []teamsFact `json:"facts,omitempty"`
+}
+
+type teamsTarget struct {
+	OS  string `json:"os"`
+	URI string `json:"uri"`
+}
+
+type teamsAction struct {
+	Type    string        `json:"@type"`
+	Name    string        `json:"name"`
+	Targets []teamsTarget `json:"targets"`
+}
+
+type teamsMessageCard struct {
+	Type            string         `json:"@type"`
+	Context         string         `json:"@context"`
+	Summary         string         `json:"summary"`
+	ThemeColor      string         `json:"themeColor"`
+	Title           string         `json:"title"`
+	Sections        []teamsSection `json:"sections,omitempty"`
+	PotentialAction []teamsAction  `json:"potentialAction,omitempty"`
+}
+
+// teamsCard formats msg as a legacy message card, the format incoming
+// webhooks accept. Card text is Markdown, so the plain text is escaped and
+// its paragraphs kept apart.
+func teamsCard(msg Message) teamsMessageCard {
+	card := teamsMessageCard{
+		Type:       "MessageCard",
+		Context:    "https://schema.org/extensions",
+		Summary:    msg.Title,
+		ThemeColor: teamsThemeColor,
+		Title:      msg.Title,
+	}
+	section := teamsSection{Text: teamsMarkdown(truncate(msg.Text, teamsTextLimit))}
+	for _, fact := range msg.Facts {
+		section.Facts = append(section.Facts, teamsFact{Name: fact.Name, Value: teamsMarkdown(fact.Value)})
+	}
+	if section.Text != "" || len(section.Facts) > 0 {
+		card.Sections = []teamsSection{section}
+	}
+	if msg.URL != "" {
+		card.PotentialAction = []teamsAction{{
+			Type:    "OpenUri",
+			Name:    msg.LinkText,
+			Targets: []teamsTarget{{OS: "default", URI: msg.URL}},
+		}}
+	}
+	return card
+}
+
+var teamsEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "#", `\#`,
+	"[", `\[`, "]", `\]`, "<", "&lt;", ">", "&gt;",
+)
+
+// teamsMarkdown escapes text for a card and turns single line breaks into
+// paragraph breaks, which Teams otherwise folds into spaces.
+func teamsMarkdown(text string) string {
+	text = teamsEscaper.Replace(strings.TrimSpace(text))
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n\n")
+}
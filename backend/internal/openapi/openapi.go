@@ -0,0 +1,214 @@
+// Package openapi builds an OpenAPI 3 description of Connect services from
+// their protobuf descriptors. Connect exposes every unary RPC as
+// POST /<package>.<Service>/<Method> with a protojson body, so the document
+// can be derived entirely from the registered descriptors at runtime.
+package openapi
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const errorSchemaName = "connect.Error"
+
+// Info describes the API as a whole.
+type Info struct {
+	Title   string
+	Version string
+}
+
+// Build returns the OpenAPI document for the named services as a JSON-ready
+// map. Streaming methods are skipped because they can't be called with a
+// plain JSON POST.
+func Build(info Info, serviceNames []string) (map[string]any, error) {
+	b := &builder{schemas: map[string]any{}}
+	paths := map[string]any{}
+	tags := make([]any, 0, len(serviceNames))
+
+	for _, name := range serviceNames {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("find service %s: %w", name, err)
+		}
+		service, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", name)
+		}
+		tag := string(service.Name())
+		tags = append(tags, map[string]any{"name": tag, "description": string(service.FullName())})
+
+		methods := service.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			method := methods.Get(i)
+			if method.IsStreamingClient() || method.IsStreamingServer() {
+				continue
+			}
+			path := "/" + string(service.FullName()) + "/" + string(method.Name())
+			paths[path] = map[string]any{
+				"post": map[string]any{
+					"tags":        []string{tag},
+					"operationId": tag + "_" + string(method.Name()),
+					"parameters": []any{map[string]any{
+						"name":     "Connect-Protocol-Version",
+						"in":       "header",
+						"required": false,
+						"schema":   map[string]any{"type": "string", "enum": []string{"1"}},
+					}},
+					"requestBody": map[string]any{
+						"required": true,
+						"content":  jsonContent(b.messageRef(method.Input())),
+					},
+					"responses": map[string]any{
+						"200": map[string]any{
+							"description": "Success",
+							"content":     jsonContent(b.messageRef(method.Output())),
+						},
+						"default": map[string]any{
+							"description": "Connect error",
+							"content":     jsonContent(ref(errorSchemaName)),
+						},
+					},
+				},
+			}
+		}
+	}
+
+	b.schemas[errorSchemaName] = map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"code":    map[string]any{"type": "string", "example": "invalid_argument"},
+			"message": map[string]any{"type": "string"},
+			"details": map[string]any{"type": "array", "items": map[string]any{"type": "object"}},
+		},
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].(map[string]any)["name"].(string) < tags[j].(map[string]any)["name"].(string)
+	})
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   info.Title,
+			"version": info.Version,
+		},
+		"tags":  tags,
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": b.schemas,
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+		"security": []any{map[string]any{"bearerAuth": []string{}}},
+	}, nil
+}
+
+type builder struct {
+	schemas map[string]any
+}
+
+// messageRef registers the schema for a message (and everything it
+// references) and returns a $ref to it.
+func (b *builder) messageRef(message protoreflect.MessageDescriptor) map[string]any {
+	if wkt, ok := wellKnownSchema(message.FullName()); ok {
+		return wkt
+	}
+	name := string(message.FullName())
+	if _, ok := b.schemas[name]; ok {
+		return ref(name)
+	}
+	// Reserve the name first so recursive messages terminate.
+	b.schemas[name] = map[string]any{}
+
+	properties := map[string]any{}
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		properties[field.JSONName()] = b.fieldSchema(field)
+	}
+	b.schemas[name] = map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	return ref(name)
+}
+
+func (b *builder) fieldSchema(field protoreflect.FieldDescriptor) map[string]any {
+	if field.IsMap() {
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": b.singularSchema(field.MapValue()),
+		}
+	}
+	if field.IsList() {
+		return map[string]any{
+			"type":  "array",
+			"items": b.singularSchema(field),
+		}
+	}
+	return b.singularSchema(field)
+}
+
+func (b *builder) singularSchema(field protoreflect.FieldDescriptor) map[string]any {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// protojson encodes 64-bit integers as strings but accepts numbers too.
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.StringKind:
+		return map[string]any{"type": "string"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		names := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return map[string]any{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.messageRef(field.Message())
+	default:
+		return map[string]any{}
+	}
+}
+
+func wellKnownSchema(name protoreflect.FullName) (map[string]any, bool) {
+	switch name {
+	case "google.protobuf.Struct":
+		return map[string]any{"type": "object", "additionalProperties": true}, true
+	case "google.protobuf.Value":
+		return map[string]any{}, true
+	case "google.protobuf.ListValue":
+		return map[string]any{"type": "array", "items": map[string]any{}}, true
+	case "google.protobuf.Timestamp":
+		return map[string]any{"type": "string", "format": "date-time"}, true
+	case "google.protobuf.Duration":
+		return map[string]any{"type": "string", "example": "1.5s"}, true
+	case "google.protobuf.Empty":
+		return map[string]any{"type": "object"}, true
+	}
+	return nil, false
+}
+
+func ref(name string) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+func jsonContent(schema map[string]any) map[string]any {
+	return map[string]any{"application/json": map[string]any{"schema": schema}}
+}
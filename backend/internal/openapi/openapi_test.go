@@ -0,0 +1,43 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	_ "github.com/mvult/secretary/backend/gen/secretary/v1"
+)
+
+func TestBuildDescribesConnectEndpoints(t *testing.T) {
+	doc, err := Build(Info{Title: "Test", Version: "v1"}, []string{"secretary.v1.TodosService", "secretary.v1.ActivitiesService"})
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if _, err := json.Marshal(doc); err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	paths := doc["paths"].(map[string]any)
+	if _, ok := paths["/secretary.v1.TodosService/CreateTodo"]; !ok {
+		t.Fatalf("expected CreateTodo path, got %v", paths)
+	}
+
+	schemas := doc["components"].(map[string]any)["schemas"].(map[string]any)
+	todo, ok := schemas["secretary.v1.Todo"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected Todo schema")
+	}
+	props := todo["properties"].(map[string]any)
+	if got := props["id"].(map[string]any)["type"]; got != "string" {
+		t.Fatalf("expected int64 id encoded as string, got %v", got)
+	}
+	if got := props["status"].(map[string]any)["type"]; got != "string" {
+		t.Fatalf("expected enum status encoded as string, got %v", got)
+	}
+	if _, ok := props["createdAtRecordingId"]; !ok {
+		t.Fatalf("expected json field names, got %v", props)
+	}
+
+	if _, err := Build(Info{}, []string{"secretary.v1.MissingService"}); err == nil {
+		t.Fatalf("expected error for unknown service")
+	}
+}
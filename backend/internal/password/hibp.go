@@ -0,0 +1,71 @@
+package password
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultHIBPURL is Have I Been Pwned's Pwned Passwords API.
+const DefaultHIBPURL = "https://api.pwnedpasswords.com"
+
+// HIBP checks passwords against Have I Been Pwned's Pwned Passwords with
+// k-anonymity: only the first five hex digits of the password's SHA-1 are
+// sent, and the matching suffixes that come back are compared locally.
+// Responses are padded so their size doesn't hint at the prefix either.
+type HIBP struct {
+	// BaseURL defaults to DefaultHIBPURL.
+	BaseURL string
+	Client  *http.Client
+}
+
+func (h HIBP) Breached(ctx context.Context, password string) (int, error) {
+	sum := sha1.Sum([]byte(password))
+	digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := digest[:5], digest[5:]
+
+	base := h.BaseURL
+	if base == "" {
+		base = DefaultHIBPURL
+	}
+	client := h.Client
+	if client == nil {
+		client = &http.Client{Timeout: 5 * time.Second}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(base, "/")+"/range/"+prefix, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Add-Padding", "true")
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("pwned passwords: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("pwned passwords: %s", resp.Status)
+	}
+	lines := bufio.NewScanner(resp.Body)
+	for lines.Scan() {
+		candidate, count, ok := strings.Cut(strings.TrimSpace(lines.Text()), ":")
+		if !ok || !strings.EqualFold(candidate, suffix) {
+			continue
+		}
+		// Padding entries have a count of 0.
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return 0, fmt.Errorf("pwned passwords: bad count %q", count)
+		}
+		return n, nil
+	}
+	if err := lines.Err(); err != nil {
+		return 0, fmt.Errorf("pwned passwords: %w", err)
+	}
+	return 0, nil
+}
@@ -0,0 +1,134 @@
+// Package password hashes passwords with argon2id and checks them against
+// argon2id hashes and the bcrypt hashes stored before it, so accounts can
+// move to argon2id as their owners sign in.
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	saltLen = 16
+	keyLen  = 32
+	// MaxLength caps passwords so hashing one can't be used to tie up the
+	// server. It is far beyond anything a person or password manager uses.
+	MaxLength = 1024
+)
+
+var (
+	// ErrTooLong reports a password over MaxLength.
+	ErrTooLong = fmt.Errorf("password: longer than %d bytes", MaxLength)
+	// ErrMalformed reports a stored hash this package can't read.
+	ErrMalformed = errors.New("password: malformed hash")
+)
+
+// Params are argon2id's costs. Raising them makes every hash slower to
+// compute, for the server as for anyone guessing passwords; existing
+// hashes are rehashed with the new costs as their users sign in.
+type Params struct {
+	// Memory is in KiB.
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+}
+
+// DefaultParams are the OWASP recommendation for argon2id: 19 MiB of
+// memory, two passes and one lane.
+var DefaultParams = Params{Memory: 19 * 1024, Iterations: 2, Parallelism: 1}
+
+// Validate reports costs too low to protect a password, or too high to
+// compute at all.
+func (p Params) Validate() error {
+	switch {
+	case p.Memory < 8*1024:
+		return errors.New("memory must be at least 8192 KiB")
+	case p.Memory > 4*1024*1024:
+		return errors.New("memory must be at most 4 GiB")
+	case p.Iterations < 1:
+		return errors.New("iterations must be at least 1")
+	case p.Parallelism < 1:
+		return errors.New("parallelism must be at least 1")
+	}
+	return nil
+}
+
+// Hash returns password's argon2id hash with a random salt, in the PHC
+// string format: $argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>.
+func Hash(password string, p Params) (string, error) {
+	if len(password) > MaxLength {
+		return "", ErrTooLong
+	}
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, keyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, p.Memory, p.Iterations, p.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Verify reports whether password matches hash, which may be argon2id or
+// bcrypt. A wrong password is not an error.
+func Verify(hash, password string) (bool, error) {
+	if isBcrypt(hash) {
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	p, salt, key, err := decode(hash)
+	if err != nil {
+		return false, err
+	}
+	if len(password) > MaxLength {
+		return false, nil
+	}
+	got := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, uint32(len(key)))
+	return subtle.ConstantTimeCompare(got, key) == 1, nil
+}
+
+// NeedsRehash reports whether hash should be replaced by a new one with p:
+// it is bcrypt, or argon2id with other costs.
+func NeedsRehash(hash string, p Params) bool {
+	if isBcrypt(hash) {
+		return true
+	}
+	current, _, _, err := decode(hash)
+	return err != nil || current != p
+}
+
+func isBcrypt(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+func decode(hash string) (p Params, salt, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return p, nil, nil, ErrMalformed
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, ErrMalformed
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism); err != nil || p.Validate() != nil {
+		return p, nil, nil, ErrMalformed
+	}
+	salt, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) == 0 {
+		return p, nil, nil, ErrMalformed
+	}
+	key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return p, nil, nil, ErrMalformed
+	}
+	return p, salt, key, nil
+}
@@ -0,0 +1,68 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestHashAndVerify(t *testing.T) {
+	hash, err := Hash("correct horse", DefaultParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=19456,t=2,p=1$") {
+		t.Fatalf("hash = %s", hash)
+	}
+	if again, _ := Hash("correct horse", DefaultParams); again == hash {
+		t.Fatal("two hashes of one password are the same; the salt isn't random")
+	}
+	if ok, err := Verify(hash, "correct horse"); !ok || err != nil {
+		t.Fatalf("right password: %v, %v", ok, err)
+	}
+	if ok, err := Verify(hash, "correct horse "); ok || err != nil {
+		t.Fatalf("wrong password: %v, %v", ok, err)
+	}
+	if NeedsRehash(hash, DefaultParams) {
+		t.Fatal("a hash with the current costs needs rehashing")
+	}
+	if !NeedsRehash(hash, Params{Memory: 64 * 1024, Iterations: 3, Parallelism: 2}) {
+		t.Fatal("a hash with old costs doesn't need rehashing")
+	}
+	if _, err := Hash(strings.Repeat("a", MaxLength+1), DefaultParams); err != ErrTooLong {
+		t.Fatalf("long password: %v", err)
+	}
+}
+
+func TestVerifyBcrypt(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := Verify(string(hash), "correct horse"); !ok || err != nil {
+		t.Fatalf("right password: %v, %v", ok, err)
+	}
+	if ok, err := Verify(string(hash), "wrong"); ok || err != nil {
+		t.Fatalf("wrong password: %v, %v", ok, err)
+	}
+	if !NeedsRehash(string(hash), DefaultParams) {
+		t.Fatal("bcrypt hashes should be rehashed")
+	}
+}
+
+func TestVerifyMalformed(t *testing.T) {
+	for _, hash := range []string{
+		"",
+		"plaintext",
+		"$argon2i$v=19$m=19456,t=2,p=1$c2FsdHNhbHQ$a2V5",
+		"$argon2id$v=16$m=19456,t=2,p=1$c2FsdHNhbHQ$a2V5",
+		"$argon2id$v=19$m=1,t=2,p=1$c2FsdHNhbHQ$a2V5",
+		"$argon2id$v=19$m=19456,t=2,p=1$$a2V5",
+		"$argon2id$v=19$m=19456,t=2,p=1$c2FsdHNhbHQ$!!",
+	} {
+		if ok, err := Verify(hash, "anything"); ok || err == nil {
+			t.Errorf("Verify(%q) = %v, %v; want an error", hash, ok, err)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package password
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Rules a new password can break, as reported in Violation.Rule.
+const (
+	RuleTooShort      = "PASSWORD_TOO_SHORT"
+	RuleTooLong       = "PASSWORD_TOO_LONG"
+	RuleContainsEmail = "PASSWORD_CONTAINS_EMAIL"
+	RuleBreached      = "PASSWORD_BREACHED"
+)
+
+// BreachChecker reports how often a password appears in known data
+// breaches.
+type BreachChecker interface {
+	Breached(ctx context.Context, password string) (int, error)
+}
+
+// Policy is what a new password must satisfy. Passwords set before the
+// policy, or before it was tightened, keep working.
+type Policy struct {
+	// MinLength counts characters, not bytes.
+	MinLength int
+	// AllowEmail lets the password contain the account's email address or
+	// the part of it before the @.
+	AllowEmail bool
+	// Breaches, when set, refuses passwords found in data breaches.
+	Breaches BreachChecker
+}
+
+// DefaultPolicy asks for 12 characters that don't include the email.
+var DefaultPolicy = Policy{MinLength: 12}
+
+// Violation is one rule a password broke.
+type Violation struct {
+	Rule        string
+	Description string
+}
+
+// Check returns every rule plain breaks as the password of the account
+// with email. It returns an error only when the breach check couldn't be
+// made; the other violations are still returned, and callers may go ahead
+// without the breach check rather than refuse every password while the
+// service is down.
+func (p Policy) Check(ctx context.Context, plain, email string) ([]Violation, error) {
+	var violations []Violation
+	if n := utf8.RuneCountInString(plain); n < p.MinLength {
+		violations = append(violations, Violation{RuleTooShort, fmt.Sprintf("must be at least %d characters", p.MinLength)})
+	}
+	if len(plain) > MaxLength {
+		violations = append(violations, Violation{RuleTooLong, fmt.Sprintf("must be at most %d bytes", MaxLength)})
+	}
+	if !p.AllowEmail && containsEmail(plain, email) {
+		violations = append(violations, Violation{RuleContainsEmail, "must not contain your email address"})
+	}
+	if p.Breaches == nil || len(violations) > 0 {
+		return violations, nil
+	}
+	count, err := p.Breaches.Breached(ctx, plain)
+	if err != nil {
+		return violations, err
+	}
+	if count > 0 {
+		violations = append(violations, Violation{RuleBreached, "has appeared in a data breach; choose another"})
+	}
+	return violations, nil
+}
+
+// containsEmail reports whether plain contains email, or its local part
+// when that is long enough to mean something, ignoring case.
+func containsEmail(plain, email string) bool {
+	plain, email = strings.ToLower(plain), strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return false
+	}
+	if strings.Contains(plain, email) {
+		return true
+	}
+	local, _, _ := strings.Cut(email, "@")
+	return len(local) >= 3 && strings.Contains(plain, local)
+}
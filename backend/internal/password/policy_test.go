@@ -0,0 +1,99 @@
+package password
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type fakeBreaches struct {
+	count int
+	err   error
+	calls int
+}
+
+func (f *fakeBreaches) Breached(context.Context, string) (int, error) {
+	f.calls++
+	return f.count, f.err
+}
+
+func rules(violations []Violation) string {
+	var names []string
+	for _, v := range violations {
+		names = append(names, v.Rule)
+	}
+	return strings.Join(names, ",")
+}
+
+func TestPolicyCheck(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		plain, email, want string
+	}{
+		{"correct horse battery", "ana@example.com", ""},
+		{"short", "ana@example.com", RuleTooShort},
+		// Characters count, not bytes.
+		{"ñññññññññññ", "ana@example.com", RuleTooShort},
+		{"my Ana@Example.com pass", "ana@example.com", RuleContainsEmail},
+		{"hello ana.gomez 2026", "ana.gomez@example.com", RuleContainsEmail},
+		// A short local part is too common to rule out.
+		{"banana bread recipe", "an@example.com", ""},
+		{"ana.gomez", "ana.gomez@example.com", RuleTooShort + "," + RuleContainsEmail},
+	} {
+		got, err := DefaultPolicy.Check(ctx, tc.plain, tc.email)
+		if err != nil || rules(got) != tc.want {
+			t.Errorf("Check(%q, %q) = %s, %v; want %s", tc.plain, tc.email, rules(got), err, tc.want)
+		}
+	}
+
+	allow := Policy{MinLength: 8, AllowEmail: true}
+	if got, _ := allow.Check(ctx, "ana.gomez 2026", "ana.gomez@example.com"); len(got) != 0 {
+		t.Errorf("AllowEmail: %s", rules(got))
+	}
+}
+
+func TestPolicyBreaches(t *testing.T) {
+	ctx := context.Background()
+	breaches := &fakeBreaches{count: 3}
+	policy := Policy{MinLength: 8, Breaches: breaches}
+	if got, err := policy.Check(ctx, "password123", ""); err != nil || rules(got) != RuleBreached {
+		t.Fatalf("breached password: %s, %v", rules(got), err)
+	}
+	// Passwords already refused aren't sent to the breach check.
+	if got, _ := policy.Check(ctx, "short", ""); rules(got) != RuleTooShort || breaches.calls != 1 {
+		t.Fatalf("short password: %s, %d breach checks", rules(got), breaches.calls)
+	}
+	breaches.err = errors.New("unreachable")
+	if got, err := policy.Check(ctx, "password123", ""); err == nil || len(got) != 0 {
+		t.Fatalf("breach check down: %s, %v", rules(got), err)
+	}
+}
+
+func TestHIBP(t *testing.T) {
+	sum := sha1.Sum([]byte("password123"))
+	digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+	var gotPath, gotPadding string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotPadding = r.URL.Path, r.Header.Get("Add-Padding")
+		fmt.Fprintf(w, "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n%s:251682\r\nFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF:0\r\n", digest[5:])
+	}))
+	defer ts.Close()
+
+	hibp := HIBP{BaseURL: ts.URL, Client: ts.Client()}
+	n, err := hibp.Breached(context.Background(), "password123")
+	if err != nil || n != 251682 {
+		t.Fatalf("Breached = %d, %v", n, err)
+	}
+	if gotPath != "/range/"+digest[:5] || gotPadding != "true" {
+		t.Fatalf("request %s, Add-Padding %q: only the hash prefix should be sent", gotPath, gotPadding)
+	}
+	if n, err := hibp.Breached(context.Background(), "not in the list at all"); err != nil || n != 0 {
+		t.Fatalf("unbreached password: %d, %v", n, err)
+	}
+}
@@ -0,0 +1,40 @@
+package providers
+
+import (
+	"sync"
+	"time"
+)
+
+// limiter is a token bucket holding up to a minute's worth of calls.
+type limiter struct {
+	mu       sync.Mutex
+	capacity float64
+	perSec   float64
+	tokens   float64
+	last     time.Time
+}
+
+func newLimiter(perMinute int, now time.Time) *limiter {
+	return &limiter{
+		capacity: float64(perMinute),
+		perSec:   float64(perMinute) / 60,
+		tokens:   float64(perMinute),
+		last:     now,
+	}
+}
+
+// take spends a token if one is available; otherwise it reports how long
+// until the next one is.
+func (l *limiter) take(now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if elapsed := now.Sub(l.last).Seconds(); elapsed > 0 {
+		l.tokens = min(l.capacity, l.tokens+elapsed*l.perSec)
+		l.last = now
+	}
+	if l.tokens >= 1 {
+		l.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - l.tokens) / l.perSec * float64(time.Second))
+}
@@ -0,0 +1,224 @@
+package providers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+const (
+	defaultBaseURL            = "https://api.openai.com/v1"
+	defaultSummaryModel       = "gpt-4o-mini"
+	defaultTranscriptionModel = "whisper-1"
+)
+
+// DefaultSummaryInstructions is the system prompt used when a
+// SummaryRequest has no instructions of its own.
+const DefaultSummaryInstructions = "Summarize this meeting transcript in a short paragraph, then list the decisions made and the action items with their owners. Speakers are labelled \"Speaker N\"."
+
+// LanguageName names a BCP 47 tag in English for prompts, e.g. "Spanish"
+// for "es"; unknown tags are returned as they are.
+func LanguageName(tag string) string {
+	parsed, err := language.Parse(tag)
+	if err != nil {
+		return tag
+	}
+	if name := display.English.Tags().Name(parsed); name != "" {
+		return name
+	}
+	return tag
+}
+
+// TranslationInstructions is the system prompt for translating a summary
+// or transcript into language.
+func TranslationInstructions(language string) string {
+	return "Translate the following meeting text into " + LanguageName(language) + ". Keep speaker labels such as \"Speaker N:\", names, line breaks and formatting as they are, and reply with the translation only."
+}
+
+// OpenAIConfig configures a client for the OpenAI API or any service that
+// implements its chat completions and audio transcription endpoints.
+type OpenAIConfig struct {
+	BaseURL            string
+	APIKey             string
+	Model              string
+	TranscriptionModel string
+	// Name is reported in provider errors; it defaults to "openai".
+	Name string
+}
+
+// OpenAI is both a Transcriber and a Summarizer.
+type OpenAI struct {
+	cfg  OpenAIConfig
+	http *http.Client
+}
+
+func NewOpenAI(cfg OpenAIConfig) *OpenAI {
+	cfg.BaseURL = strings.TrimRight(strings.TrimSpace(cfg.BaseURL), "/")
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = defaultBaseURL
+	} else if !strings.HasSuffix(cfg.BaseURL, "/v1") {
+		cfg.BaseURL += "/v1"
+	}
+	if cfg.Model == "" {
+		cfg.Model = defaultSummaryModel
+	}
+	if cfg.TranscriptionModel == "" {
+		cfg.TranscriptionModel = defaultTranscriptionModel
+	}
+	if cfg.Name == "" {
+		cfg.Name = "openai"
+	}
+	return &OpenAI{cfg: cfg, http: &http.Client{Timeout: 5 * time.Minute}}
+}
+
+// ModelFor returns the model used for kind.
+func (c *OpenAI) ModelFor(kind string) string {
+	if kind == KindTranscription {
+		return c.cfg.TranscriptionModel
+	}
+	return c.cfg.Model
+}
+
+func (c *OpenAI) Summarize(ctx context.Context, req SummaryRequest) (string, Usage, error) {
+	instructions := strings.TrimSpace(req.Instructions)
+	if instructions == "" {
+		instructions = DefaultSummaryInstructions
+	}
+	if req.Language != "" {
+		instructions += " Write the summary in " + LanguageName(req.Language) + "."
+	}
+	body, err := json.Marshal(map[string]any{
+		"model": c.cfg.Model,
+		"messages": []map[string]string{
+			{"role": "system", "content": instructions},
+			{"role": "user", "content": req.Transcript},
+		},
+	})
+	if err != nil {
+		return "", Usage{}, err
+	}
+	var parsed struct {
+		Choices []struct {
+			Message struct {
+				Content string `json:"content"`
+			} `json:"message"`
+		} `json:"choices"`
+		Usage struct {
+			PromptTokens     int64 `json:"prompt_tokens"`
+			CompletionTokens int64 `json:"completion_tokens"`
+		} `json:"usage"`
+	}
+	if err := c.post(ctx, "/chat/completions", "application/json", bytes.NewReader(body), &parsed); err != nil {
+		return "", Usage{}, err
+	}
+	usage := Usage{InputTokens: parsed.Usage.PromptTokens, OutputTokens: parsed.Usage.CompletionTokens}
+	if len(parsed.Choices) == 0 || strings.TrimSpace(parsed.Choices[0].Message.Content) == "" {
+		return "", usage, errors.New("summary response had no content")
+	}
+	return strings.TrimSpace(parsed.Choices[0].Message.Content), usage, nil
+}
+
+// Transcribe asks for verbose JSON so the segments come back with their
+// log probabilities, except from the gpt-4o transcription models, which
+// only answer plain JSON.
+func (c *OpenAI) Transcribe(ctx context.Context, audio Audio) (Transcript, Usage, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	if err := form.WriteField("model", c.cfg.TranscriptionModel); err != nil {
+		return Transcript{}, Usage{}, err
+	}
+	verbose := !strings.HasPrefix(c.cfg.TranscriptionModel, "gpt-")
+	if verbose {
+		if err := form.WriteField("response_format", "verbose_json"); err != nil {
+			return Transcript{}, Usage{}, err
+		}
+	}
+	filename := audio.Filename
+	if filename == "" {
+		filename = "audio"
+	}
+	file, err := form.CreateFormFile("file", filename)
+	if err != nil {
+		return Transcript{}, Usage{}, err
+	}
+	if _, err := file.Write(audio.Data); err != nil {
+		return Transcript{}, Usage{}, err
+	}
+	if err := form.Close(); err != nil {
+		return Transcript{}, Usage{}, err
+	}
+
+	var parsed struct {
+		Text string `json:"text"`
+		// Duration is only in verbose responses, which have no usage.
+		Duration float64 `json:"duration"`
+		Segments []struct {
+			Text       string  `json:"text"`
+			Start      float64 `json:"start"`
+			End        float64 `json:"end"`
+			AvgLogprob float64 `json:"avg_logprob"`
+		} `json:"segments"`
+		Usage struct {
+			InputTokens  int64   `json:"input_tokens"`
+			OutputTokens int64   `json:"output_tokens"`
+			Seconds      float64 `json:"seconds"`
+		} `json:"usage"`
+	}
+	if err := c.post(ctx, "/audio/transcriptions", form.FormDataContentType(), &body, &parsed); err != nil {
+		return Transcript{}, Usage{}, err
+	}
+	usage := Usage{InputTokens: parsed.Usage.InputTokens, OutputTokens: parsed.Usage.OutputTokens, AudioSeconds: parsed.Usage.Seconds}
+	if usage.AudioSeconds == 0 {
+		usage.AudioSeconds = parsed.Duration
+	}
+	if usage.AudioSeconds == 0 {
+		usage.AudioSeconds = audio.Seconds
+	}
+	transcript := Transcript{Text: parsed.Text}
+	for _, seg := range parsed.Segments {
+		transcript.Segments = append(transcript.Segments, Segment{
+			Text:       strings.TrimSpace(seg.Text),
+			StartMS:    int64(math.Round(seg.Start * 1000)),
+			EndMS:      int64(math.Round(seg.End * 1000)),
+			Confidence: math.Min(math.Exp(seg.AvgLogprob), 1),
+		})
+	}
+	return transcript, usage, nil
+}
+
+func (c *OpenAI) post(ctx context.Context, path string, contentType string, body io.Reader, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.BaseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.cfg.APIKey)
+	req.Header.Set("Content-Type", contentType)
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 400 {
+		return apierr.NewProviderError(c.cfg.Name, resp, respBody)
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("decode %s response: %w", c.cfg.Name, err)
+	}
+	return nil
+}
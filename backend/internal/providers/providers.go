@@ -0,0 +1,371 @@
+// Package providers routes transcription and summarization work across
+// the configured speech-to-text and LLM providers. Providers are tried in
+// priority order; a provider that errors or has used up its rate limit is
+// skipped in favour of the next one. Every call is priced and handed to a
+// UsageRecorder so spend can be tracked per provider and per recording.
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+// Kinds of work a provider can do, as stored in provider_usage.kind.
+const (
+	KindTranscription = "transcription"
+	KindSummarization = "summarization"
+)
+
+// ErrNoProviders is returned when no provider is registered for a kind.
+var ErrNoProviders = errors.New("no provider configured")
+
+// Audio is a recording to transcribe. The data is held in memory so it can
+// be replayed to a fallback provider.
+type Audio struct {
+	Data        []byte
+	Filename    string
+	ContentType string
+	// Seconds is the length of the audio, used for per-minute pricing when
+	// the provider does not report it.
+	Seconds float64
+}
+
+// SummaryRequest asks for a summary of a transcript.
+type SummaryRequest struct {
+	// Instructions replace the default summarization prompt when set.
+	Instructions string
+	Transcript   string
+	// Language is a BCP 47 tag for the language the summary is written in;
+	// empty leaves it to the provider, usually the transcript's language.
+	Language string
+}
+
+// Usage is what a single call consumed.
+type Usage struct {
+	InputTokens  int64
+	OutputTokens int64
+	AudioSeconds float64
+}
+
+// Transcript is what a transcriber made of some audio.
+type Transcript struct {
+	Text string
+	// Segments are the stretches of the text the provider scored, in order;
+	// empty when the provider reports no confidence.
+	Segments []Segment
+}
+
+// Segment is a stretch of a transcript with the provider's confidence in
+// it, from 0 to 1.
+type Segment struct {
+	Text       string
+	StartMS    int64
+	EndMS      int64
+	Confidence float64
+}
+
+type Transcriber interface {
+	Transcribe(ctx context.Context, audio Audio) (Transcript, Usage, error)
+}
+
+type Summarizer interface {
+	Summarize(ctx context.Context, req SummaryRequest) (string, Usage, error)
+}
+
+// Pricing is a provider's list price in US dollars.
+type Pricing struct {
+	InputPerMillionTokens  float64
+	OutputPerMillionTokens float64
+	AudioPerMinute         float64
+}
+
+// CostMicros prices usage in millionths of a dollar.
+func (p Pricing) CostMicros(u Usage) int64 {
+	dollars := float64(u.InputTokens)*p.InputPerMillionTokens/1e6 +
+		float64(u.OutputTokens)*p.OutputPerMillionTokens/1e6 +
+		u.AudioSeconds/60*p.AudioPerMinute
+	return int64(math.Round(dollars * 1e6))
+}
+
+// Options describe how a provider is scheduled and billed.
+type Options struct {
+	Name  string
+	Model string
+	// Lower priorities are tried first; ties keep registration order.
+	Priority int
+	// RatePerMinute caps calls to the provider; zero means unlimited.
+	RatePerMinute int
+	Pricing       Pricing
+}
+
+// Result is the output of the provider that handled a request.
+type Result struct {
+	Text string
+	// Segments carry the transcriber's confidence; summaries have none.
+	Segments   []Segment
+	Provider   string
+	Model      string
+	Usage      Usage
+	CostMicros int64
+}
+
+// UsageRecord describes one call to one provider, successful or not.
+type UsageRecord struct {
+	Provider    string
+	Kind        string
+	Model       string
+	RecordingID int32
+	Succeeded   bool
+	Usage       Usage
+	CostMicros  int64
+	Duration    time.Duration
+	Error       string
+}
+
+type UsageRecorder interface {
+	RecordUsage(ctx context.Context, record UsageRecord) error
+}
+
+// SharedLimits counts calls across every server process, so each provider's
+// rate limit holds for all of them together.
+type SharedLimits interface {
+	// Take spends one of the perMinute calls key allows in the current
+	// minute. When none is left it reports false and how long to wait.
+	Take(ctx context.Context, key string, perMinute int, now time.Time) (bool, time.Duration, error)
+}
+
+// Stats are the running totals for one provider and kind since start-up.
+type Stats struct {
+	Provider     string
+	Kind         string
+	Requests     int64
+	Failures     int64
+	RateLimited  int64
+	InputTokens  int64
+	OutputTokens int64
+	AudioSeconds float64
+	CostMicros   int64
+}
+
+type provider struct {
+	Options
+	kind    string
+	limiter *limiter
+	call    func(ctx context.Context, input any) (Transcript, Usage, error)
+
+	mu    sync.Mutex
+	stats Stats
+}
+
+// Registry holds the providers for each kind of work.
+type Registry struct {
+	recorder UsageRecorder
+	now      func() time.Time
+	shared   SharedLimits
+
+	mu        sync.RWMutex
+	providers map[string][]*provider
+}
+
+// NewRegistry returns an empty registry. recorder may be nil.
+func NewRegistry(recorder UsageRecorder) *Registry {
+	return &Registry{recorder: recorder, now: time.Now, providers: map[string][]*provider{}}
+}
+
+// ShareLimits counts the calls limited by RatePerMinute in shared rather
+// than in this process. When shared fails, the process falls back to
+// counting its own calls.
+func (r *Registry) ShareLimits(shared SharedLimits) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.shared = shared
+}
+
+func (r *Registry) AddTranscriber(t Transcriber, opts Options) {
+	r.add(KindTranscription, opts, func(ctx context.Context, input any) (Transcript, Usage, error) {
+		return t.Transcribe(ctx, input.(Audio))
+	})
+}
+
+func (r *Registry) AddSummarizer(s Summarizer, opts Options) {
+	r.add(KindSummarization, opts, func(ctx context.Context, input any) (Transcript, Usage, error) {
+		summary, usage, err := s.Summarize(ctx, input.(SummaryRequest))
+		return Transcript{Text: summary}, usage, err
+	})
+}
+
+func (r *Registry) add(kind string, opts Options, call func(context.Context, any) (Transcript, Usage, error)) {
+	p := &provider{Options: opts, kind: kind, call: call, stats: Stats{Provider: opts.Name, Kind: kind}}
+	if opts.RatePerMinute > 0 {
+		p.limiter = newLimiter(opts.RatePerMinute, r.now())
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	list := append(r.providers[kind], p)
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Priority < list[j].Priority })
+	r.providers[kind] = list
+}
+
+// Names lists the providers registered for kind in the order they are
+// tried.
+func (r *Registry) Names(kind string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var names []string
+	for _, p := range r.providers[kind] {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+// Transcribe converts audio to text. recordingID attributes the usage and
+// may be zero.
+func (r *Registry) Transcribe(ctx context.Context, recordingID int32, audio Audio) (Result, error) {
+	return r.run(ctx, KindTranscription, recordingID, audio)
+}
+
+// Summarize summarizes a transcript. recordingID attributes the usage and
+// may be zero.
+func (r *Registry) Summarize(ctx context.Context, recordingID int32, req SummaryRequest) (Result, error) {
+	return r.run(ctx, KindSummarization, recordingID, req)
+}
+
+// Translate translates text into language, a BCP 47 tag. Translation is
+// an LLM call like summarization, so it runs on the summarization
+// providers and is billed as summarization.
+func (r *Registry) Translate(ctx context.Context, recordingID int32, text string, language string) (Result, error) {
+	return r.run(ctx, KindSummarization, recordingID, SummaryRequest{
+		Instructions: TranslationInstructions(language),
+		Transcript:   text,
+	})
+}
+
+func (r *Registry) run(ctx context.Context, kind string, recordingID int32, input any) (Result, error) {
+	r.mu.RLock()
+	candidates := r.providers[kind]
+	shared := r.shared
+	r.mu.RUnlock()
+	if len(candidates) == 0 {
+		return Result{}, fmt.Errorf("%s: %w", kind, ErrNoProviders)
+	}
+
+	var failures []error
+	var limited []string
+	var retryAfter time.Duration
+	for _, p := range candidates {
+		if p.limiter != nil {
+			if ok, wait := r.take(ctx, shared, p); !ok {
+				p.update(func(s *Stats) { s.RateLimited++ })
+				limited = append(limited, p.Name)
+				if retryAfter == 0 || wait < retryAfter {
+					retryAfter = wait
+				}
+				continue
+			}
+		}
+
+		started := r.now()
+		output, usage, err := p.call(ctx, input)
+		cost := p.Pricing.CostMicros(usage)
+		record := UsageRecord{
+			Provider:    p.Name,
+			Kind:        kind,
+			Model:       p.Model,
+			RecordingID: recordingID,
+			Succeeded:   err == nil,
+			Usage:       usage,
+			CostMicros:  cost,
+			Duration:    r.now().Sub(started),
+		}
+		if err != nil {
+			record.Error = err.Error()
+		}
+		p.update(func(s *Stats) {
+			s.Requests++
+			if err != nil {
+				s.Failures++
+			}
+			s.InputTokens += usage.InputTokens
+			s.OutputTokens += usage.OutputTokens
+			s.AudioSeconds += usage.AudioSeconds
+			s.CostMicros += cost
+		})
+		r.record(ctx, record)
+
+		if err == nil {
+			return Result{Text: output.Text, Segments: output.Segments, Provider: p.Name, Model: p.Model, Usage: usage, CostMicros: cost}, nil
+		}
+		// A canceled request would fail the same way everywhere.
+		if ctx.Err() != nil {
+			return Result{}, err
+		}
+		log.Printf("%s provider %s failed, trying the next one: %v", kind, p.Name, err)
+		failures = append(failures, fmt.Errorf("%s: %w", p.Name, err))
+	}
+
+	if len(failures) == 0 {
+		return Result{}, &apierr.ProviderError{
+			Provider:   strings.Join(limited, ", "),
+			StatusCode: http.StatusTooManyRequests,
+			RetryAfter: retryAfter,
+			Message:    "rate limit reached for every " + kind + " provider",
+		}
+	}
+	return Result{}, errors.Join(failures...)
+}
+
+// record stores usage without failing the call it describes; the work has
+// already been paid for.
+// take spends one of p's calls, counted in shared when there is one.
+func (r *Registry) take(ctx context.Context, shared SharedLimits, p *provider) (bool, time.Duration) {
+	now := r.now()
+	if shared != nil {
+		ok, wait, err := shared.Take(ctx, p.kind+":"+p.Name, p.RatePerMinute, now)
+		if err == nil {
+			return ok, wait
+		}
+		log.Printf("providers: counting %s calls in this process: %v", p.Name, err)
+	}
+	return p.limiter.take(now)
+}
+
+func (r *Registry) record(ctx context.Context, record UsageRecord) {
+	if r.recorder == nil {
+		return
+	}
+	if err := r.recorder.RecordUsage(context.WithoutCancel(ctx), record); err != nil {
+		log.Printf("record %s usage for %s: %v", record.Kind, record.Provider, err)
+	}
+}
+
+func (p *provider) update(apply func(*Stats)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	apply(&p.stats)
+}
+
+// Snapshot returns the running totals for every provider, transcription
+// first, each in priority order.
+func (r *Registry) Snapshot() []Stats {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []Stats
+	for _, kind := range []string{KindTranscription, KindSummarization} {
+		for _, p := range r.providers[kind] {
+			p.mu.Lock()
+			out = append(out, p.stats)
+			p.mu.Unlock()
+		}
+	}
+	return out
+}
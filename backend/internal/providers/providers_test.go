@@ -0,0 +1,204 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+type stubSummarizer struct {
+	err   error
+	calls int
+}
+
+func (s *stubSummarizer) Summarize(context.Context, SummaryRequest) (string, Usage, error) {
+	s.calls++
+	if s.err != nil {
+		return "", Usage{}, s.err
+	}
+	return "summary", Usage{InputTokens: 1000, OutputTokens: 200}, nil
+}
+
+type memoryRecorder struct{ records []UsageRecord }
+
+func (m *memoryRecorder) RecordUsage(_ context.Context, record UsageRecord) error {
+	m.records = append(m.records, record)
+	return nil
+}
+
+func TestRegistryFailsOverInPriorityOrder(t *testing.T) {
+	recorder := &memoryRecorder{}
+	registry := NewRegistry(recorder)
+	backup := &stubSummarizer{}
+	primary := &stubSummarizer{err: &apierr.ProviderError{Provider: "primary", StatusCode: http.StatusServiceUnavailable}}
+	registry.AddSummarizer(backup, Options{Name: "backup", Priority: 2, Pricing: Pricing{InputPerMillionTokens: 1, OutputPerMillionTokens: 4}})
+	registry.AddSummarizer(primary, Options{Name: "primary", Priority: 1})
+
+	result, err := registry.Summarize(context.Background(), 9, SummaryRequest{Transcript: "Speaker 1: hi"})
+	if err != nil {
+		t.Fatalf("summarize: %v", err)
+	}
+	if result.Provider != "backup" || primary.calls != 1 {
+		t.Fatalf("provider = %s after %d primary calls, want backup after 1", result.Provider, primary.calls)
+	}
+	// 1000 input tokens at $1/M plus 200 output tokens at $4/M.
+	if result.CostMicros != 1800 {
+		t.Fatalf("cost = %d micros, want 1800", result.CostMicros)
+	}
+	if len(recorder.records) != 2 || recorder.records[0].Succeeded || !recorder.records[1].Succeeded || recorder.records[1].RecordingID != 9 {
+		t.Fatalf("records = %+v", recorder.records)
+	}
+}
+
+func TestRegistryReportsEveryFailure(t *testing.T) {
+	registry := NewRegistry(nil)
+	registry.AddSummarizer(&stubSummarizer{err: errors.New("boom")}, Options{Name: "a"})
+	registry.AddSummarizer(&stubSummarizer{err: &apierr.ProviderError{Provider: "b", StatusCode: http.StatusUnauthorized}}, Options{Name: "b"})
+
+	_, err := registry.Summarize(context.Background(), 0, SummaryRequest{})
+	var providerErr *apierr.ProviderError
+	if err == nil || !errors.As(err, &providerErr) {
+		t.Fatalf("err = %v, want the joined provider errors", err)
+	}
+	if _, err := registry.Transcribe(context.Background(), 0, Audio{}); !errors.Is(err, ErrNoProviders) {
+		t.Fatalf("transcribe err = %v, want ErrNoProviders", err)
+	}
+}
+
+func TestRegistryRateLimitsProviders(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	registry := NewRegistry(nil)
+	registry.now = func() time.Time { return now }
+	limited := &stubSummarizer{}
+	registry.AddSummarizer(limited, Options{Name: "limited", RatePerMinute: 1})
+
+	if _, err := registry.Summarize(context.Background(), 0, SummaryRequest{}); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	_, err := registry.Summarize(context.Background(), 0, SummaryRequest{})
+	var providerErr *apierr.ProviderError
+	if !errors.As(err, &providerErr) || providerErr.StatusCode != http.StatusTooManyRequests || providerErr.RetryAfter != time.Minute {
+		t.Fatalf("err = %v, want a 429 retrying after a minute", err)
+	}
+	now = now.Add(time.Minute)
+	if _, err := registry.Summarize(context.Background(), 0, SummaryRequest{}); err != nil {
+		t.Fatalf("after refill: %v", err)
+	}
+	stats := registry.Snapshot()
+	if len(stats) != 1 || stats[0].Requests != 2 || stats[0].RateLimited != 1 {
+		t.Fatalf("stats = %+v", stats)
+	}
+}
+
+// fakeLimits counts calls the way Redis does for every process.
+type fakeLimits struct {
+	counts map[string]int
+	err    error
+}
+
+func (f *fakeLimits) Take(_ context.Context, key string, perMinute int, _ time.Time) (bool, time.Duration, error) {
+	if f.err != nil {
+		return false, 0, f.err
+	}
+	f.counts[key]++
+	return f.counts[key] <= perMinute, 30 * time.Second, nil
+}
+
+func TestRegistrySharesRateLimits(t *testing.T) {
+	limits := &fakeLimits{counts: map[string]int{}}
+	// Two processes' registries share one limit of a call a minute.
+	first, second := NewRegistry(nil), NewRegistry(nil)
+	for _, registry := range []*Registry{first, second} {
+		registry.AddSummarizer(&stubSummarizer{}, Options{Name: "limited", RatePerMinute: 1})
+		registry.ShareLimits(limits)
+	}
+	if _, err := first.Summarize(context.Background(), 0, SummaryRequest{}); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	_, err := second.Summarize(context.Background(), 0, SummaryRequest{})
+	var providerErr *apierr.ProviderError
+	if !errors.As(err, &providerErr) || providerErr.RetryAfter != 30*time.Second {
+		t.Fatalf("err = %v, want the other process's call counted", err)
+	}
+
+	// Redis is down: each process counts its own calls.
+	limits.err = errors.New("connection refused")
+	if _, err := second.Summarize(context.Background(), 0, SummaryRequest{}); err != nil {
+		t.Fatalf("fallback call: %v", err)
+	}
+}
+
+func TestOpenAISummarizeAndTranscribe(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer key" {
+			http.Error(w, `{"error":{"message":"bad key"}}`, http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/chat/completions":
+			var body struct {
+				Model string `json:"model"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body.Model != "small" {
+				t.Errorf("model = %q", body.Model)
+			}
+			_, _ = io.WriteString(w, `{"choices":[{"message":{"content":" Short summary. "}}],"usage":{"prompt_tokens":12,"completion_tokens":3}}`)
+		case "/v1/audio/transcriptions":
+			file, _, err := r.FormFile("file")
+			if err != nil {
+				t.Errorf("form file: %v", err)
+				return
+			}
+			data, _ := io.ReadAll(file)
+			if string(data) != "RIFF" || r.FormValue("model") != "whisper-1" || r.FormValue("response_format") != "verbose_json" {
+				t.Errorf("upload = %q with model %q as %q", data, r.FormValue("model"), r.FormValue("response_format"))
+			}
+			_, _ = io.WriteString(w, `{"text":"Speaker 1: hello","duration":90,"segments":[{"text":" Speaker 1: hello","start":0,"end":1.5,"avg_logprob":-0.693147}]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer api.Close()
+
+	client := NewOpenAI(OpenAIConfig{BaseURL: api.URL, APIKey: "key", Model: "small"})
+	summary, usage, err := client.Summarize(context.Background(), SummaryRequest{Transcript: "Speaker 1: hello"})
+	if err != nil || summary != "Short summary." || usage.InputTokens != 12 || usage.OutputTokens != 3 {
+		t.Fatalf("summarize = %q %+v %v", summary, usage, err)
+	}
+	transcript, usage, err := client.Transcribe(context.Background(), Audio{Data: []byte("RIFF"), Filename: "a.wav"})
+	if err != nil || transcript.Text != "Speaker 1: hello" || usage.AudioSeconds != 90 {
+		t.Fatalf("transcribe = %+v %+v %v", transcript, usage, err)
+	}
+	if len(transcript.Segments) != 1 || transcript.Segments[0].EndMS != 1500 || math.Abs(transcript.Segments[0].Confidence-0.5) > 1e-3 {
+		t.Fatalf("segments = %+v, want one at 0.5 confidence", transcript.Segments)
+	}
+
+	bad := NewOpenAI(OpenAIConfig{BaseURL: api.URL + "/v1", APIKey: "wrong"})
+	_, _, err = bad.Summarize(context.Background(), SummaryRequest{})
+	var providerErr *apierr.ProviderError
+	if !errors.As(err, &providerErr) || providerErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("err = %v, want a 401 provider error", err)
+	}
+}
+
+func TestLanguagePrompts(t *testing.T) {
+	if got := LanguageName("pt-BR"); got != "Brazilian Portuguese" {
+		t.Errorf("LanguageName(pt-BR) = %q", got)
+	}
+	if got := LanguageName("not a tag"); got != "not a tag" {
+		t.Errorf("unknown tags should pass through, got %q", got)
+	}
+	if got := TranslationInstructions("es"); !strings.Contains(got, "into Spanish") {
+		t.Errorf("instructions = %q", got)
+	}
+}
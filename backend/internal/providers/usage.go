@@ -0,0 +1,51 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	db "github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// DBRecorder stores usage records in the provider_usage table. Tokens spent
+// on summaries are also counted against the recording's creator in
+// usage_event; transcribed minutes are counted when the recording finishes
+// transcription, whichever way it was transcribed.
+type DBRecorder struct {
+	queries *db.Queries
+}
+
+func NewDBRecorder(pool *pgxpool.Pool) *DBRecorder {
+	return &DBRecorder{queries: db.New(pool)}
+}
+
+func (r *DBRecorder) RecordUsage(ctx context.Context, record UsageRecord) error {
+	recordingID := pgtype.Int4{Int32: record.RecordingID, Valid: record.RecordingID > 0}
+	err := r.queries.CreateProviderUsage(ctx, db.CreateProviderUsageParams{
+		Provider:     record.Provider,
+		Kind:         record.Kind,
+		Model:        record.Model,
+		RecordingID:  recordingID,
+		Succeeded:    record.Succeeded,
+		InputTokens:  record.Usage.InputTokens,
+		OutputTokens: record.Usage.OutputTokens,
+		AudioSeconds: record.Usage.AudioSeconds,
+		CostMicros:   record.CostMicros,
+		DurationMs:   int32(record.Duration.Milliseconds()),
+		Error:        pgtype.Text{String: record.Error, Valid: record.Error != ""},
+	})
+	if err != nil {
+		return err
+	}
+	tokens := record.Usage.InputTokens + record.Usage.OutputTokens
+	if !record.Succeeded || record.Kind != KindSummarization || tokens == 0 {
+		return nil
+	}
+	return r.queries.CreateUsageEvent(ctx, db.CreateUsageEventParams{
+		RecordingID: recordingID,
+		Metric:      "llm_tokens",
+		Quantity:    tokens,
+	})
+}
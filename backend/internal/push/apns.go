@@ -0,0 +1,112 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+const (
+	apnsProductionURL = "https://api.push.apple.com"
+	apnsSandboxURL    = "https://api.sandbox.push.apple.com"
+	// Apple rejects provider tokens older than an hour and throttles
+	// ones refreshed more often than every 20 minutes.
+	apnsTokenLifetime = 50 * time.Minute
+)
+
+// APNsConfig holds an APNs auth key (.p8) from the Apple developer
+// account. Topic is the app's bundle ID. Sandbox sends to development
+// builds; BaseURL overrides the endpoint entirely.
+type APNsConfig struct {
+	KeyID      string
+	TeamID     string
+	PrivateKey []byte
+	Topic      string
+	Sandbox    bool
+	BaseURL    string
+}
+
+// APNsSender sends over HTTP/2 with token-based authentication.
+type APNsSender struct {
+	cfg   APNsConfig
+	key   crypto.Signer
+	http  *http.Client
+	token cachedToken
+}
+
+func NewAPNs(cfg APNsConfig) (*APNsSender, error) {
+	key, err := parsePrivateKey(cfg.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("apns: read auth key: %w", err)
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = apnsProductionURL
+		if cfg.Sandbox {
+			cfg.BaseURL = apnsSandboxURL
+		}
+	}
+	return &APNsSender{cfg: cfg, key: key, http: &http.Client{Timeout: requestTimeout}}, nil
+}
+
+func (a *APNsSender) Platform() string { return APNs }
+
+func (a *APNsSender) Send(ctx context.Context, token string, n Notification) error {
+	providerToken, err := a.token.get(time.Now(), func() (string, time.Time, error) {
+		now := time.Now()
+		signed, err := signJWT(a.key, map[string]string{"kid": a.cfg.KeyID}, map[string]any{"iss": a.cfg.TeamID, "iat": now.Unix()})
+		return signed, now.Add(apnsTokenLifetime), err
+	})
+	if err != nil {
+		return err
+	}
+	payload := map[string]any{
+		"aps": map[string]any{
+			"alert": map[string]string{"title": n.Title, "body": n.Body},
+			"sound": "default",
+		},
+	}
+	for k, v := range n.Data {
+		payload[k] = v
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.cfg.BaseURL+"/3/device/"+token, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "bearer "+providerToken)
+	req.Header.Set("apns-topic", a.cfg.Topic)
+	req.Header.Set("apns-push-type", "alert")
+	resp, err := a.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 400 {
+		return nil
+	}
+	var reason struct {
+		Reason string `json:"reason"`
+	}
+	_ = json.Unmarshal(respBody, &reason)
+	switch {
+	case resp.StatusCode == http.StatusGone, reason.Reason == "BadDeviceToken", reason.Reason == "DeviceTokenNotForTopic":
+		return ErrInvalidToken
+	}
+	return apierr.NewProviderError(APNs, resp, respBody)
+}
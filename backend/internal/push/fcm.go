@@ -0,0 +1,161 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+const (
+	defaultFCMURL = "https://fcm.googleapis.com"
+	fcmScope      = "https://www.googleapis.com/auth/firebase.messaging"
+)
+
+// FCMConfig holds a Firebase service account key, the JSON file the
+// Firebase console downloads. BaseURL overrides the FCM endpoint.
+type FCMConfig struct {
+	CredentialsJSON []byte
+	BaseURL         string
+}
+
+// FCMSender sends through the FCM HTTP v1 API, authenticating as the
+// service account.
+type FCMSender struct {
+	baseURL     string
+	projectID   string
+	clientEmail string
+	tokenURL    string
+	key         crypto.Signer
+	http        *http.Client
+	token       cachedToken
+}
+
+func NewFCM(cfg FCMConfig) (*FCMSender, error) {
+	var account struct {
+		ProjectID   string `json:"project_id"`
+		ClientEmail string `json:"client_email"`
+		PrivateKey  string `json:"private_key"`
+		TokenURI    string `json:"token_uri"`
+	}
+	if err := json.Unmarshal(cfg.CredentialsJSON, &account); err != nil {
+		return nil, fmt.Errorf("fcm: read service account: %w", err)
+	}
+	if account.ProjectID == "" || account.ClientEmail == "" || account.TokenURI == "" {
+		return nil, errors.New("fcm: service account is missing project_id, client_email or token_uri")
+	}
+	key, err := parsePrivateKey([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("fcm: read private key: %w", err)
+	}
+	baseURL := strings.TrimRight(cfg.BaseURL, "/")
+	if baseURL == "" {
+		baseURL = defaultFCMURL
+	}
+	return &FCMSender{
+		baseURL:     baseURL,
+		projectID:   account.ProjectID,
+		clientEmail: account.ClientEmail,
+		tokenURL:    account.TokenURI,
+		key:         key,
+		http:        &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+func (f *FCMSender) Platform() string { return FCM }
+
+func (f *FCMSender) Send(ctx context.Context, token string, n Notification) error {
+	accessToken, err := f.token.get(time.Now(), func() (string, time.Time, error) { return f.fetchAccessToken(ctx) })
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]any{
+		"message": map[string]any{
+			"token":        token,
+			"notification": map[string]string{"title": n.Title, "body": n.Body},
+			"data":         n.Data,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.baseURL+"/v1/projects/"+f.projectID+"/messages:send", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := f.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 400 {
+		return nil
+	}
+	// Tokens of uninstalled apps come back as 404 UNREGISTERED; malformed
+	// ones as 400 INVALID_ARGUMENT naming the token.
+	if resp.StatusCode == http.StatusNotFound || bytes.Contains(respBody, []byte("UNREGISTERED")) ||
+		(resp.StatusCode == http.StatusBadRequest && bytes.Contains(respBody, []byte("registration token"))) {
+		return ErrInvalidToken
+	}
+	return apierr.NewProviderError(FCM, resp, respBody)
+}
+
+// fetchAccessToken trades a signed assertion for an OAuth access token,
+// the two-legged flow Google offers service accounts.
+func (f *FCMSender) fetchAccessToken(ctx context.Context) (string, time.Time, error) {
+	now := time.Now()
+	assertion, err := signJWT(f.key, map[string]string{}, map[string]any{
+		"iss":   f.clientEmail,
+		"scope": fcmScope,
+		"aud":   f.tokenURL,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := f.http.Do(req)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if resp.StatusCode >= 400 {
+		return "", time.Time{}, apierr.NewProviderError(FCM, resp, respBody)
+	}
+	var out struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.Unmarshal(respBody, &out); err != nil {
+		return "", time.Time{}, fmt.Errorf("decode fcm token response: %w", err)
+	}
+	return out.AccessToken, now.Add(time.Duration(out.ExpiresIn) * time.Second), nil
+}
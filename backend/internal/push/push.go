@@ -0,0 +1,131 @@
+// Package push delivers alerts to the mobile app through Firebase Cloud
+// Messaging and the Apple Push Notification service.
+package push
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// Platform names, as stored in push_device.platform.
+const (
+	FCM  = "fcm"
+	APNs = "apns"
+)
+
+// ErrInvalidToken reports a device token the push service no longer
+// accepts, usually because the app was uninstalled. The device should be
+// forgotten.
+var ErrInvalidToken = errors.New("push: device token is no longer valid")
+
+// Notification is an alert. Data is handed to the app alongside it, e.g.
+// the ID of the todo to open.
+type Notification struct {
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+// Sender delivers alerts to devices on one platform.
+type Sender interface {
+	Platform() string
+	Send(ctx context.Context, token string, n Notification) error
+}
+
+const requestTimeout = 15 * time.Second
+
+// parsePrivateKey reads a PEM-encoded PKCS #8 key, the format both Google
+// service accounts and Apple's .p8 files use.
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+	return signer, nil
+}
+
+// signJWT builds a compact JWT signed with key: RS256 for RSA keys and
+// ES256 for P-256 keys.
+func signJWT(key crypto.Signer, header map[string]string, claims map[string]any) (string, error) {
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		header["alg"] = "RS256"
+	case *ecdsa.PrivateKey:
+		header["alg"] = "ES256"
+	default:
+		return "", fmt.Errorf("unsupported key type %T", key)
+	}
+	header["typ"] = "JWT"
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		// JWS wants the two halves of the signature side by side rather
+		// than the ASN.1 encoding ecdsa produces.
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, k, digest[:])
+		if err == nil {
+			signature = make([]byte, 64)
+			r.FillBytes(signature[:32])
+			s.FillBytes(signature[32:])
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// cachedToken keeps a bearer token until shortly before it expires.
+type cachedToken struct {
+	mu      sync.Mutex
+	value   string
+	expires time.Time
+}
+
+func (c *cachedToken) get(now time.Time, fetch func() (string, time.Time, error)) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.value != "" && now.Before(c.expires.Add(-time.Minute)) {
+		return c.value, nil
+	}
+	value, expires, err := fetch()
+	if err != nil {
+		return "", err
+	}
+	c.value, c.expires = value, expires
+	return value, nil
+}
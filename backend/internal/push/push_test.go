@@ -0,0 +1,162 @@
+package push
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func pemKey(t *testing.T, key any) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+// verifyJWT checks token's signature with pub and returns its header and
+// claims.
+func verifyJWT(t *testing.T, token string, pub crypto.PublicKey) (map[string]any, map[string]any) {
+	t.Helper()
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("token %q is not a JWT", token)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature); err != nil {
+			t.Fatalf("RS256 signature: %v", err)
+		}
+	case *ecdsa.PublicKey:
+		r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(k, digest[:], r, s) {
+			t.Fatal("ES256 signature does not verify")
+		}
+	}
+	var header, claims map[string]any
+	for i, out := range []*map[string]any{&header, &claims} {
+		data, _ := base64.RawURLEncoding.DecodeString(parts[i])
+		_ = json.Unmarshal(data, out)
+	}
+	return header, claims
+}
+
+func TestFCMSend(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tokenRequests int
+	var message map[string]any
+	var api *httptest.Server
+	api = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			tokenRequests++
+			_ = r.ParseForm()
+			_, claims := verifyJWT(t, r.PostForm.Get("assertion"), &key.PublicKey)
+			if claims["iss"] != "push@acme.iam.gserviceaccount.com" || claims["aud"] != api.URL+"/token" {
+				t.Errorf("claims = %v", claims)
+			}
+			_, _ = io.WriteString(w, `{"access_token":"ya29","expires_in":3600}`)
+		case "/v1/projects/acme/messages:send":
+			if r.Header.Get("Authorization") != "Bearer ya29" {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			_ = json.NewDecoder(r.Body).Decode(&message)
+			if message["message"].(map[string]any)["token"] == "gone" {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = io.WriteString(w, `{"error":{"status":"NOT_FOUND","details":[{"errorCode":"UNREGISTERED"}]}}`)
+				return
+			}
+			_, _ = io.WriteString(w, `{"name":"projects/acme/messages/1"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer api.Close()
+
+	credentials, _ := json.Marshal(map[string]string{
+		"project_id":   "acme",
+		"client_email": "push@acme.iam.gserviceaccount.com",
+		"private_key":  string(pemKey(t, key)),
+		"token_uri":    api.URL + "/token",
+	})
+	sender, err := NewFCM(FCMConfig{CredentialsJSON: credentials, BaseURL: api.URL})
+	if err != nil {
+		t.Fatalf("NewFCM: %v", err)
+	}
+	alert := Notification{Title: "New todo", Body: "Book the venue", Data: map[string]string{"todo_id": "7"}}
+	for range 2 {
+		if err := sender.Send(context.Background(), "device-1", alert); err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+	}
+	if tokenRequests != 1 {
+		t.Fatalf("fetched %d access tokens, want 1", tokenRequests)
+	}
+	if data := message["message"].(map[string]any)["data"].(map[string]any); data["todo_id"] != "7" {
+		t.Fatalf("message = %v", message)
+	}
+	if err := sender.Send(context.Background(), "gone", alert); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("unregistered token err = %v", err)
+	}
+}
+
+func TestAPNsSend(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header, claims := verifyJWT(t, strings.TrimPrefix(r.Header.Get("Authorization"), "bearer "), &key.PublicKey)
+		if header["kid"] != "KEY123" || header["alg"] != "ES256" || claims["iss"] != "TEAM42" {
+			t.Errorf("header = %v, claims = %v", header, claims)
+		}
+		if r.Header.Get("apns-topic") != "com.acme.secretary" {
+			t.Errorf("topic = %q", r.Header.Get("apns-topic"))
+		}
+		if r.URL.Path == "/3/device/gone" {
+			w.WriteHeader(http.StatusGone)
+			_, _ = io.WriteString(w, `{"reason":"Unregistered"}`)
+			return
+		}
+		var payload map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		if payload["recording_id"] != "3" || payload["aps"] == nil {
+			t.Errorf("payload = %v", payload)
+		}
+	}))
+	defer api.Close()
+
+	sender, err := NewAPNs(APNsConfig{KeyID: "KEY123", TeamID: "TEAM42", PrivateKey: pemKey(t, key), Topic: "com.acme.secretary", BaseURL: api.URL})
+	if err != nil {
+		t.Fatalf("NewAPNs: %v", err)
+	}
+	alert := Notification{Title: "Meeting processed", Body: "Offsite planning", Data: map[string]string{"recording_id": "3"}}
+	if err := sender.Send(context.Background(), "device-1", alert); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if err := sender.Send(context.Background(), "gone", alert); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("unregistered token err = %v", err)
+	}
+}
@@ -0,0 +1,35 @@
+package redact
+
+import "strings"
+
+// profaneWords are masked as whole words, in English and Spanish, the
+// languages meetings are mostly held in.
+var profaneWords = map[string]bool{
+	"arse": true, "arsehole": true, "ass": true, "asshole": true, "assholes": true,
+	"bastard": true, "bastards": true, "bitch": true, "bitches": true,
+	"bollocks": true, "bullshit": true, "crap": true, "cunt": true, "cunts": true,
+	"dick": true, "dickhead": true, "prick": true, "twat": true, "wanker": true,
+
+	"cabrón": true, "cabron": true, "cabrones": true, "carajo": true,
+	"coño": true, "culero": true, "gilipollas": true, "hostia": true,
+	"joder": true, "jodido": true, "jodida": true, "mierda": true,
+	"pendejo": true, "pendeja": true, "pendejos": true, "pinche": true,
+	"puta": true, "putas": true, "puto": true, "putos": true, "verga": true,
+}
+
+// profaneStems are masked in any word they start, e.g. "fucking" and
+// "shitty".
+var profaneStems = []string{"fuck", "motherfuck", "shit", "chingad", "chingar"}
+
+func profane(word string) bool {
+	word = strings.ToLower(strings.Trim(word, "'"))
+	if profaneWords[word] {
+		return true
+	}
+	for _, stem := range profaneStems {
+		if strings.HasPrefix(word, stem) {
+			return true
+		}
+	}
+	return false
+}
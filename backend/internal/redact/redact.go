@@ -0,0 +1,125 @@
+// Package redact masks personal data and profanity in meeting text before
+// it is stored or leaves the server. Detection is pattern based: it catches
+// the way transcribers and summarizers write emails, phone numbers and card
+// numbers, not every way a person could spell one out.
+package redact
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Masks replace what was found. Profanity keeps its first letter instead,
+// so readers can still tell something was said.
+const (
+	EmailMask = "[email]"
+	PhoneMask = "[phone]"
+	CardMask  = "[card]"
+)
+
+// Policy chooses what is masked. The zero value masks nothing.
+type Policy struct {
+	Emails       bool
+	PhoneNumbers bool
+	CreditCards  bool
+	Profanity    bool
+}
+
+// Enabled reports whether the policy masks anything.
+func (p Policy) Enabled() bool {
+	return p.Emails || p.PhoneNumbers || p.CreditCards || p.Profanity
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)
+	// Card numbers are 13 to 19 digits, grouped with spaces or dashes.
+	cardPattern = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+	// Phone numbers are digit groups with an optional country code and
+	// area code. Separators never include a newline, so masking keeps a
+	// transcript's lines where they were.
+	phonePattern = regexp.MustCompile(`(?:\+\d{1,3}[ .-]?)?(?:\(\d{1,4}\)[ .-]?)?\d{2,4}(?:[ .-]?\d{2,4}){1,4}\b`)
+	datePattern  = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	wordPattern  = regexp.MustCompile(`[\pL']+`)
+)
+
+// Apply returns text with what the policy covers masked, and how many
+// matches were masked.
+func (p Policy) Apply(text string) (string, int) {
+	masked := 0
+	replace := func(pattern *regexp.Regexp, keep func(string) bool, mask func(string) string) {
+		text = pattern.ReplaceAllStringFunc(text, func(match string) string {
+			if keep != nil && keep(match) {
+				return match
+			}
+			masked++
+			return mask(match)
+		})
+	}
+	fixed := func(mask string) func(string) string {
+		return func(string) string { return mask }
+	}
+	if p.Emails {
+		replace(emailPattern, nil, fixed(EmailMask))
+	}
+	// Cards go before phone numbers, which would otherwise take their
+	// digit groups.
+	if p.CreditCards {
+		replace(cardPattern, func(s string) bool { return !luhn(s) }, fixed(CardMask))
+	}
+	if p.PhoneNumbers {
+		replace(phonePattern, notPhoneNumber, fixed(PhoneMask))
+	}
+	if p.Profanity {
+		replace(wordPattern, func(s string) bool { return !profane(s) }, maskWord)
+	}
+	return text, masked
+}
+
+// notPhoneNumber rules out digit runs too short or too long to dial, ISO
+// dates, which have the same shape, and amounts: an unbroken run needs
+// the ten digits of a full number to count.
+func notPhoneNumber(s string) bool {
+	digits := countDigits(s)
+	if digits < 7 || digits > 15 || datePattern.MatchString(s) {
+		return true
+	}
+	return digits < 10 && digits == len(s)
+}
+
+func countDigits(s string) int {
+	n := 0
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			n++
+		}
+	}
+	return n
+}
+
+// luhn reports whether the digits in s pass the Luhn checksum every card
+// number carries, which most other long numbers don't.
+func luhn(s string) bool {
+	sum, double := 0, false
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func maskWord(word string) string {
+	first, size := utf8.DecodeRuneInString(word)
+	return string(first) + strings.Repeat("*", utf8.RuneCountInString(word[size:]))
+}
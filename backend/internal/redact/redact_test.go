@@ -0,0 +1,36 @@
+package redact
+
+import "testing"
+
+func TestApply(t *testing.T) {
+	all := Policy{Emails: true, PhoneNumbers: true, CreditCards: true, Profanity: true}
+	for _, tc := range []struct {
+		policy Policy
+		in     string
+		want   string
+		masked int
+	}{
+		{all, "Speaker 1: mail ana.lopez@example.com.mx by Friday", "Speaker 1: mail [email] by Friday", 1},
+		{all, "Call me on +1 (555) 123-4567 or 555.987.6543", "Call me on [phone] or [phone]", 2},
+		{all, "Her number is 5551234567.", "Her number is [phone].", 1},
+		{all, "Card 4111 1111 1111 1111, not 4111 1111 1111 1112", "Card [card], not 4111 1111 1111 1112", 1},
+		{all, "Revenue hit 1500000 on 2026-10-17, up 12.5%", "Revenue hit 1500000 on 2026-10-17, up 12.5%", 0},
+		{all, "This fucking release is a mess, qué mierda", "This f****** release is a mess, qué m*****", 2},
+		{all, "Pass the class assignment to Dickens", "Pass the class assignment to Dickens", 0},
+		{Policy{Profanity: true}, "shit, email bob@example.com", "s***, email bob@example.com", 1},
+		{Policy{}, "bob@example.com", "bob@example.com", 0},
+	} {
+		got, masked := tc.policy.Apply(tc.in)
+		if got != tc.want || masked != tc.masked {
+			t.Errorf("Apply(%q) = %q, %d; want %q, %d", tc.in, got, masked, tc.want, tc.masked)
+		}
+	}
+}
+
+func TestApplyKeepsLines(t *testing.T) {
+	// Joined, the two lines would make one number.
+	in := "555 123\n4567 89"
+	if got, _ := (Policy{PhoneNumbers: true}).Apply(in); got != in {
+		t.Fatalf("Apply joined lines: %q", got)
+	}
+}
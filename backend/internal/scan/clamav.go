@@ -0,0 +1,98 @@
+package scan
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+)
+
+// ClamAV streams files to clamd with the INSTREAM command. clamd's
+// StreamMaxLength must cover the largest audio file uploaded; longer
+// streams are rejected.
+type ClamAV struct {
+	network string
+	address string
+}
+
+// NewClamAV returns a scanner for the clamd listening on address: a unix
+// socket path such as /run/clamav/clamd.ctl, or host:port for TCP.
+func NewClamAV(address string) *ClamAV {
+	if strings.HasPrefix(address, "/") {
+		return &ClamAV{network: "unix", address: address}
+	}
+	return &ClamAV{network: "tcp", address: address}
+}
+
+func (c *ClamAV) Scan(ctx context.Context, r io.Reader) (Verdict, error) {
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, c.network, c.address)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("scan: connect to clamd: %w", err)
+	}
+	defer conn.Close()
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	sendErr := c.send(conn, r)
+	// clamd answers and hangs up as soon as a stream is over its limit, so
+	// a failed send can still have a reply waiting.
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && reply == "" {
+		if ctx.Err() != nil {
+			return Verdict{}, ctx.Err()
+		}
+		if sendErr != nil {
+			return Verdict{}, sendErr
+		}
+		return Verdict{}, fmt.Errorf("scan: read clamd reply: %w", err)
+	}
+	return parseClamdReply(strings.TrimRight(reply, "\x00\n"))
+}
+
+// send writes the INSTREAM command, r in length-prefixed chunks and the
+// zero-length chunk that ends the stream.
+func (c *ClamAV) send(conn net.Conn, r io.Reader) error {
+	if _, err := io.WriteString(conn, "zINSTREAM\x00"); err != nil {
+		return fmt.Errorf("scan: send to clamd: %w", err)
+	}
+	buf := make([]byte, 4+chunkSize)
+	for {
+		n, err := io.ReadFull(r, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf, uint32(n))
+			if _, err := conn.Write(buf[:4+n]); err != nil {
+				return fmt.Errorf("scan: send to clamd: %w", err)
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("scan: read file: %w", err)
+		}
+	}
+	if _, err := conn.Write(make([]byte, 4)); err != nil {
+		return fmt.Errorf("scan: send to clamd: %w", err)
+	}
+	return nil
+}
+
+// parseClamdReply reads "stream: OK", "stream: <signature> FOUND" or
+// "<problem> ERROR".
+func parseClamdReply(reply string) (Verdict, error) {
+	switch {
+	case strings.HasSuffix(reply, " FOUND"):
+		signature := strings.TrimSuffix(strings.TrimPrefix(reply, "stream: "), " FOUND")
+		return Verdict{Infected: true, Signature: signature}, nil
+	case strings.HasSuffix(reply, " OK"):
+		return Verdict{}, nil
+	case strings.HasSuffix(reply, " ERROR"):
+		return Verdict{}, fmt.Errorf("%w: %s", ErrRejected, strings.TrimSuffix(reply, " ERROR"))
+	}
+	return Verdict{}, fmt.Errorf("scan: unexpected clamd reply %q", reply)
+}
@@ -0,0 +1,55 @@
+package scan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// HTTP posts files to a scanning API. The API gets the file as the request
+// body and answers with {"infected": bool, "signature": string}; it answers
+// 413 or 422 for files it won't scan.
+type HTTP struct {
+	URL string
+	// Token is sent as a bearer token when set.
+	Token  string
+	Client *http.Client
+}
+
+func (h *HTTP) Scan(ctx context.Context, r io.Reader) (Verdict, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, r)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("scan: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if h.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+h.Token)
+	}
+	client := h.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("scan: %w", err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusRequestEntityTooLarge || resp.StatusCode == http.StatusUnprocessableEntity:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return Verdict{}, fmt.Errorf("%w: %s %s", ErrRejected, resp.Status, strings.TrimSpace(string(body)))
+	case resp.StatusCode != http.StatusOK:
+		return Verdict{}, fmt.Errorf("scan: scanning API returned %s", resp.Status)
+	}
+	var verdict struct {
+		Infected  bool   `json:"infected"`
+		Signature string `json:"signature"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&verdict); err != nil {
+		return Verdict{}, fmt.Errorf("scan: decode verdict: %w", err)
+	}
+	return Verdict{Infected: verdict.Infected, Signature: verdict.Signature}, nil
+}
@@ -0,0 +1,34 @@
+// Package scan checks uploaded files for malware with a ClamAV daemon or an
+// external scanning API.
+package scan
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// ErrRejected reports a file the scanner answered for but would not scan,
+// e.g. because it is over the scanner's size limit. Trying again won't
+// help, unlike when the scanner can't be reached.
+var ErrRejected = errors.New("scan: scanner rejected the file")
+
+// Verdict is a scanner's finding for one file.
+type Verdict struct {
+	Infected bool
+	// Signature names what was found, e.g. "Win.Test.EICAR_HDB-1".
+	Signature string
+}
+
+// Scanner checks a file for malware.
+type Scanner interface {
+	Scan(ctx context.Context, r io.Reader) (Verdict, error)
+}
+
+const (
+	// dialTimeout bounds connecting to the scanner; scanning a large file
+	// takes as long as the caller's context allows.
+	dialTimeout = 10 * time.Second
+	chunkSize   = 64 << 10
+)
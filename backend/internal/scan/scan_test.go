@@ -0,0 +1,131 @@
+package scan
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// fakeClamd answers INSTREAM like clamd: it finds "EICAR" and refuses
+// streams longer than limit bytes.
+func fakeClamd(t *testing.T, limit int) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveClamd(conn, limit)
+		}
+	}()
+	return ln.Addr().String()
+}
+
+func serveClamd(conn net.Conn, limit int) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	if command, err := r.ReadString(0); err != nil || command != "zINSTREAM\x00" {
+		io.WriteString(conn, "UNKNOWN COMMAND\x00")
+		return
+	}
+	var data []byte
+	for {
+		var size uint32
+		if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+			return
+		}
+		if size == 0 {
+			break
+		}
+		chunk := make([]byte, size)
+		if _, err := io.ReadFull(r, chunk); err != nil {
+			return
+		}
+		data = append(data, chunk...)
+		if len(data) > limit {
+			io.WriteString(conn, "INSTREAM size limit exceeded. ERROR\x00")
+			return
+		}
+	}
+	if strings.Contains(string(data), "EICAR") {
+		io.WriteString(conn, "stream: Win.Test.EICAR_HDB-1 FOUND\x00")
+		return
+	}
+	io.WriteString(conn, "stream: OK\x00")
+}
+
+func TestClamAV(t *testing.T) {
+	ctx := context.Background()
+	scanner := NewClamAV(fakeClamd(t, 100<<10))
+
+	verdict, err := scanner.Scan(ctx, strings.NewReader("RIFF meeting audio"))
+	if err != nil || verdict.Infected {
+		t.Fatalf("clean file: %+v, %v", verdict, err)
+	}
+	verdict, err = scanner.Scan(ctx, strings.NewReader("X5O!P%@AP EICAR test file"))
+	if err != nil || !verdict.Infected || verdict.Signature != "Win.Test.EICAR_HDB-1" {
+		t.Fatalf("infected file: %+v, %v", verdict, err)
+	}
+	if _, err := scanner.Scan(ctx, strings.NewReader(strings.Repeat("a", 1<<20))); !errors.Is(err, ErrRejected) {
+		t.Fatalf("oversized file: %v", err)
+	}
+}
+
+func TestClamAVUnreachable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := ln.Addr().String()
+	ln.Close()
+	_, err = NewClamAV(address).Scan(context.Background(), strings.NewReader("RIFF"))
+	if err == nil || errors.Is(err, ErrRejected) {
+		t.Fatalf("got %v", err)
+	}
+}
+
+func TestHTTP(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if len(body) > 10 {
+			http.Error(w, "too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		infected := strings.Contains(string(body), "EICAR")
+		json.NewEncoder(w).Encode(map[string]any{"infected": infected, "signature": map[bool]string{true: "EICAR-Test-File"}[infected]})
+	}))
+	defer api.Close()
+	ctx := context.Background()
+	scanner := &HTTP{URL: api.URL, Token: "token"}
+
+	if verdict, err := scanner.Scan(ctx, strings.NewReader("RIFF")); err != nil || verdict.Infected {
+		t.Fatalf("clean file: %+v, %v", verdict, err)
+	}
+	if verdict, err := scanner.Scan(ctx, strings.NewReader("EICAR")); err != nil || verdict.Signature != "EICAR-Test-File" {
+		t.Fatalf("infected file: %+v, %v", verdict, err)
+	}
+	if _, err := scanner.Scan(ctx, strings.NewReader("RIFF meeting audio")); !errors.Is(err, ErrRejected) {
+		t.Fatalf("oversized file: %v", err)
+	}
+	scanner.Token = "wrong"
+	if _, err := scanner.Scan(ctx, strings.NewReader("RIFF")); err == nil || errors.Is(err, ErrRejected) {
+		t.Fatalf("unauthorized: %v", err)
+	}
+}
@@ -0,0 +1,236 @@
+// Package schedule parses cron expressions and works out when they next
+// fire.
+//
+// An expression has the five standard fields, minute hour day-of-month
+// month day-of-week, each a *, a value, a range a-b or a list of them,
+// optionally stepped with /n. Months and weekdays may be given by their
+// three-letter English names, and Sunday is 0 or 7. As in cron, when both
+// day fields are restricted a day matching either one fires.
+//
+//	0 3 * * *          every day at 03:00
+//	*/15 9-17 * * 1-5  every quarter hour during office hours
+//
+// The descriptors @yearly, @monthly, @weekly, @daily (or @midnight) and
+// @hourly stand for their usual expressions, and "@every 90m" fires at
+// fixed intervals counted from the Unix epoch, so every process computes
+// the same times.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed expression.
+type Schedule struct {
+	spec  string
+	every time.Duration
+
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar record a day field given as *, for the rule
+	// combining the two.
+	domStar, dowStar bool
+}
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}},
+	{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}},
+}
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// MinEvery is the shortest interval @every accepts.
+const MinEvery = time.Second
+
+// Parse parses a cron expression or descriptor.
+func Parse(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if rest, ok := strings.CutPrefix(spec, "@every "); ok {
+		every, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, fmt.Errorf("schedule %q: %w", spec, err)
+		}
+		if every < MinEvery {
+			return nil, fmt.Errorf("schedule %q: interval must be at least %s", spec, MinEvery)
+		}
+		return &Schedule{spec: spec, every: every}, nil
+	}
+	expr := spec
+	if strings.HasPrefix(spec, "@") {
+		var ok bool
+		if expr, ok = descriptors[strings.ToLower(spec)]; !ok {
+			return nil, fmt.Errorf("schedule %q: unknown descriptor", spec)
+		}
+	}
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("schedule %q: want 5 fields, got %d", spec, len(parts))
+	}
+	s := &Schedule{spec: spec}
+	targets := []*uint64{&s.minute, &s.hour, &s.dom, &s.month, &s.dow}
+	for i, part := range parts {
+		bits, err := fields[i].parse(part)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %q: %s: %w", spec, fields[i].name, err)
+		}
+		*targets[i] = bits
+	}
+	// Sunday may be written 7.
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domStar = parts[2] == "*" || strings.HasPrefix(parts[2], "*/")
+	s.dowStar = parts[4] == "*" || strings.HasPrefix(parts[4], "*/")
+	if _, err := s.next(time.Unix(0, 0).UTC()); err != nil {
+		return nil, fmt.Errorf("schedule %q: %w", spec, err)
+	}
+	return s, nil
+}
+
+func (f field) parse(expr string) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(expr, ",") {
+		rangeExpr, stepExpr, stepped := strings.Cut(item, "/")
+		step := 1
+		if stepped {
+			n, err := strconv.Atoi(stepExpr)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q", stepExpr)
+			}
+			step = n
+		}
+		lo, hi := f.min, f.max
+		switch {
+		case rangeExpr == "*":
+		case strings.Contains(rangeExpr, "-"):
+			a, b, _ := strings.Cut(rangeExpr, "-")
+			var err error
+			if lo, err = f.value(a); err != nil {
+				return 0, err
+			}
+			if hi, err = f.value(b); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("range %q runs backwards", rangeExpr)
+			}
+		default:
+			v, err := f.value(rangeExpr)
+			if err != nil {
+				return 0, err
+			}
+			lo, hi = v, v
+			// As in cron, 5/10 means from 5 to the end every 10.
+			if stepped {
+				hi = f.max
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+func (f field) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%d is outside %d-%d", v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// String returns the expression as it was parsed.
+func (s *Schedule) String() string {
+	return s.spec
+}
+
+// Every returns the interval of an @every schedule, and 0 for the others.
+func (s *Schedule) Every() time.Duration {
+	return s.every
+}
+
+// errNever rejects expressions like "0 0 30 2 *" that name a day that
+// doesn't exist.
+var errNever = errors.New("never fires")
+
+// Next returns the first time after t the schedule fires, in t's
+// location.
+func (s *Schedule) Next(t time.Time) time.Time {
+	if s.every > 0 {
+		return t.Truncate(s.every).Add(s.every)
+	}
+	next, err := s.next(t)
+	if err != nil {
+		return time.Time{}
+	}
+	return next
+}
+
+func (s *Schedule) next(t time.Time) (time.Time, error) {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	// Five years covers every date a valid expression can name, Feb 29
+	// included.
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t, nil
+	}
+	return time.Time{}, errNever
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
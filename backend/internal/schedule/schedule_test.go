@@ -0,0 +1,72 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNext(t *testing.T) {
+	// A Wednesday.
+	from := time.Date(2026, 10, 14, 10, 7, 30, 0, time.UTC)
+	cases := []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2026, 10, 14, 10, 8, 0, 0, time.UTC)},
+		{"0 3 * * *", time.Date(2026, 10, 15, 3, 0, 0, 0, time.UTC)},
+		{"*/15 9-17 * * mon-fri", time.Date(2026, 10, 14, 10, 15, 0, 0, time.UTC)},
+		{"0 7 * * 1", time.Date(2026, 10, 19, 7, 0, 0, 0, time.UTC)},
+		{"0 7 * * 7", time.Date(2026, 10, 18, 7, 0, 0, 0, time.UTC)},
+		{"30 2 1 jan,jul *", time.Date(2027, 1, 1, 2, 30, 0, 0, time.UTC)},
+		// Either day field matches when both are restricted.
+		{"0 0 20 * 5", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2026, 10, 14, 10, 25, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, 10, 14, 11, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"@every 1h", time.Date(2026, 10, 14, 11, 0, 0, 0, time.UTC)},
+		{"@every 10m", time.Date(2026, 10, 14, 10, 10, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		s, err := Parse(tc.spec)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tc.spec, err)
+		}
+		if got := s.Next(from); !got.Equal(tc.want) {
+			t.Errorf("%q: next = %s, want %s", tc.spec, got, tc.want)
+		}
+	}
+}
+
+func TestNextInLocation(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip(err)
+	}
+	s, _ := Parse("0 7 * * *")
+	got := s.Next(time.Date(2026, 10, 14, 12, 0, 0, 0, loc))
+	if want := time.Date(2026, 10, 15, 7, 0, 0, 0, loc); !got.Equal(want) {
+		t.Fatalf("next = %s, want %s", got, want)
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"* * * foo *",
+		"0 0 30 2 *",
+		"@fortnightly",
+		"@every 10",
+		"@every 0s",
+	} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Parse(%q) succeeded", spec)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/sigv4"
+)
+
+// AWSConfig holds static credentials, as the AWS_* environment variables
+// provide them. Endpoint overrides the regional Secrets Manager endpoint.
+type AWSConfig struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	Endpoint        string
+}
+
+// AWSSource reads secrets from AWS Secrets Manager. Paths are secret names
+// or ARNs; the current version is used.
+type AWSSource struct {
+	cfg  AWSConfig
+	http *http.Client
+	now  func() time.Time
+}
+
+func NewAWS(cfg AWSConfig) (*AWSSource, error) {
+	if cfg.Region == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, errors.New("aws: AWS_REGION, AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY are required")
+	}
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = "https://secretsmanager." + cfg.Region + ".amazonaws.com"
+	}
+	cfg.Endpoint = strings.TrimRight(cfg.Endpoint, "/")
+	return &AWSSource{cfg: cfg, http: &http.Client{Timeout: requestTimeout}, now: time.Now}, nil
+}
+
+func (a *AWSSource) Fetch(ctx context.Context, path string) (string, error) {
+	body, err := json.Marshal(map[string]string{"SecretId": path})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.cfg.Endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	a.sign(req, body, a.now().UTC())
+	resp, err := a.http.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode >= 400 {
+		return "", apierr.NewProviderError(AWS, resp, respBody)
+	}
+	var out struct {
+		SecretString string `json:"SecretString"`
+		SecretBinary string `json:"SecretBinary"`
+	}
+	if err := json.Unmarshal(respBody, &out); err != nil {
+		return "", fmt.Errorf("decode secrets manager response: %w", err)
+	}
+	if out.SecretString == "" && out.SecretBinary != "" {
+		binary, err := base64.StdEncoding.DecodeString(out.SecretBinary)
+		if err != nil {
+			return "", fmt.Errorf("decode secret binary: %w", err)
+		}
+		return string(binary), nil
+	}
+	return out.SecretString, nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header.
+func (a *AWSSource) sign(req *http.Request, body []byte, now time.Time) {
+	signer := sigv4.Signer{
+		AccessKeyID:     a.cfg.AccessKeyID,
+		SecretAccessKey: a.cfg.SecretAccessKey,
+		SessionToken:    a.cfg.SessionToken,
+		Region:          a.cfg.Region,
+		Service:         "secretsmanager",
+	}
+	signer.Sign(req, sigv4.HashPayload(body), now)
+}
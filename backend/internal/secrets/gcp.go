@@ -0,0 +1,183 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+const (
+	defaultGCPURL      = "https://secretmanager.googleapis.com"
+	gcpMetadataToken   = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+	gcpScope           = "https://www.googleapis.com/auth/cloud-platform"
+	gcpTokenTimeMargin = time.Minute
+)
+
+// GCPConfig holds a service account key, the JSON file
+// GOOGLE_APPLICATION_CREDENTIALS names. Without one the source
+// authenticates as the instance's service account through the metadata
+// server. BaseURL overrides the Secret Manager endpoint.
+type GCPConfig struct {
+	CredentialsJSON []byte
+	BaseURL         string
+	MetadataURL     string
+}
+
+// GCPSource reads secrets from Google Cloud Secret Manager. Paths are
+// version names such as "projects/p/secrets/s/versions/latest"; the
+// version may be left out to read the latest.
+type GCPSource struct {
+	baseURL     string
+	metadataURL string
+	clientEmail string
+	tokenURL    string
+	key         any
+	http        *http.Client
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+func NewGCP(cfg GCPConfig) (*GCPSource, error) {
+	g := &GCPSource{
+		baseURL:     strings.TrimRight(cfg.BaseURL, "/"),
+		metadataURL: cfg.MetadataURL,
+		http:        &http.Client{Timeout: requestTimeout},
+	}
+	if g.baseURL == "" {
+		g.baseURL = defaultGCPURL
+	}
+	if g.metadataURL == "" {
+		g.metadataURL = gcpMetadataToken
+	}
+	if len(cfg.CredentialsJSON) == 0 {
+		return g, nil
+	}
+	var account struct {
+		ClientEmail string `json:"client_email"`
+		PrivateKey  string `json:"private_key"`
+		TokenURI    string `json:"token_uri"`
+	}
+	if err := json.Unmarshal(cfg.CredentialsJSON, &account); err != nil {
+		return nil, fmt.Errorf("gcp: read service account: %w", err)
+	}
+	if account.ClientEmail == "" || account.TokenURI == "" {
+		return nil, errors.New("gcp: service account is missing client_email or token_uri")
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("gcp: read private key: %w", err)
+	}
+	g.clientEmail, g.tokenURL, g.key = account.ClientEmail, account.TokenURI, key
+	return g, nil
+}
+
+func (g *GCPSource) Fetch(ctx context.Context, path string) (string, error) {
+	path = strings.Trim(path, "/")
+	if !strings.Contains(path, "/versions/") {
+		path += "/versions/latest"
+	}
+	token, err := g.accessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+"/v1/"+path+":access", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	var out struct {
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+	if err := g.do(req, &out); err != nil {
+		return "", err
+	}
+	data, err := base64.StdEncoding.DecodeString(out.Payload.Data)
+	if err != nil {
+		return "", fmt.Errorf("decode secret payload: %w", err)
+	}
+	return string(data), nil
+}
+
+// accessToken returns a cached OAuth token, fetching a new one shortly
+// before it expires.
+func (g *GCPSource) accessToken(ctx context.Context) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := time.Now()
+	if g.token != "" && now.Before(g.expires.Add(-gcpTokenTimeMargin)) {
+		return g.token, nil
+	}
+	var req *http.Request
+	var err error
+	if g.key != nil {
+		// The two-legged flow Google offers service accounts: trade a
+		// signed assertion for a token.
+		assertion, signErr := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":   g.clientEmail,
+			"scope": gcpScope,
+			"aud":   g.tokenURL,
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+		}).SignedString(g.key)
+		if signErr != nil {
+			return "", signErr
+		}
+		form := url.Values{"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"}, "assertion": {assertion}}
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, g.tokenURL, strings.NewReader(form.Encode()))
+		if err == nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+	} else {
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, g.metadataURL, nil)
+		if err == nil {
+			req.Header.Set("Metadata-Flavor", "Google")
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	var out struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := g.do(req, &out); err != nil {
+		return "", fmt.Errorf("gcp token: %w", err)
+	}
+	g.token, g.expires = out.AccessToken, now.Add(time.Duration(out.ExpiresIn)*time.Second)
+	return g.token, nil
+}
+
+func (g *GCPSource) do(req *http.Request, out any) error {
+	resp, err := g.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 400 {
+		return apierr.NewProviderError(GCP, resp, body)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decode %s response: %w", GCP, err)
+	}
+	return nil
+}
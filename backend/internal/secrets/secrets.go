@@ -0,0 +1,257 @@
+// Package secrets resolves configuration kept in an external secret
+// manager. A setting whose value is a reference such as
+// "vault://secret/data/secretary#jwt_secret" is replaced by the secret it
+// names; other values are used as they are.
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Reference schemes.
+const (
+	Vault = "vault"
+	AWS   = "awssm"
+	GCP   = "gcpsm"
+)
+
+const requestTimeout = 10 * time.Second
+
+// Source fetches a secret's payload by its path within the manager.
+type Source interface {
+	Fetch(ctx context.Context, path string) (string, error)
+}
+
+// Reference names a secret: the manager, the secret's path in it and,
+// for secrets holding a JSON object, the field to use.
+type Reference struct {
+	Scheme string
+	Path   string
+	Field  string
+}
+
+// ParseReference reports whether value refers to a secret. The field
+// follows the last "#", so paths such as AWS ARNs may contain colons.
+func ParseReference(value string) (Reference, bool) {
+	scheme, rest, ok := strings.Cut(strings.TrimSpace(value), "://")
+	if !ok || (scheme != Vault && scheme != AWS && scheme != GCP) {
+		return Reference{}, false
+	}
+	ref := Reference{Scheme: scheme, Path: rest}
+	if i := strings.LastIndex(rest, "#"); i >= 0 {
+		ref.Path, ref.Field = rest[:i], rest[i+1:]
+	}
+	return ref, ref.Path != ""
+}
+
+func (r Reference) String() string {
+	s := r.Scheme + "://" + r.Path
+	if r.Field != "" {
+		s += "#" + r.Field
+	}
+	return s
+}
+
+// Resolver fetches referenced secrets, caching each payload for ttl, or
+// until the next Refresh when ttl is 0, so settings sharing a secret fetch
+// it once.
+type Resolver struct {
+	ttl     time.Duration
+	sources map[string]Source
+
+	mu      sync.Mutex
+	cache   map[string]cachedPayload
+	watched map[string]Reference
+	values  map[string]string
+	hooks   map[string][]func(string)
+}
+
+type cachedPayload struct {
+	payload string
+	fetched time.Time
+}
+
+func NewResolver(ttl time.Duration) *Resolver {
+	return &Resolver{
+		ttl:     ttl,
+		sources: map[string]Source{},
+		cache:   map[string]cachedPayload{},
+		watched: map[string]Reference{},
+		values:  map[string]string{},
+		hooks:   map[string][]func(string){},
+	}
+}
+
+// Register adds the source for a scheme.
+func (r *Resolver) Register(scheme string, source Source) {
+	r.sources[scheme] = source
+}
+
+// Resolve returns the secret a reference names, or value itself when it
+// is not a reference.
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	ref, ok := ParseReference(value)
+	if !ok {
+		return value, nil
+	}
+	return r.fetch(ctx, ref, time.Now(), false)
+}
+
+func (r *Resolver) fetch(ctx context.Context, ref Reference, now time.Time, fresh bool) (string, error) {
+	source, ok := r.sources[ref.Scheme]
+	if !ok {
+		return "", fmt.Errorf("%s: no %s secret manager is configured", ref, ref.Scheme)
+	}
+	key := ref.Scheme + "://" + ref.Path
+	r.mu.Lock()
+	cached, hit := r.cache[key]
+	r.mu.Unlock()
+	if !hit || fresh || (r.ttl > 0 && now.Sub(cached.fetched) >= r.ttl) {
+		payload, err := source.Fetch(ctx, ref.Path)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", ref, err)
+		}
+		cached = cachedPayload{payload: payload, fetched: now}
+		r.mu.Lock()
+		r.cache[key] = cached
+		r.mu.Unlock()
+	}
+	if ref.Field == "" {
+		return cached.payload, nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(cached.payload), &fields); err != nil {
+		return "", fmt.Errorf("%s: secret is not a JSON object, so it has no field %q", ref, ref.Field)
+	}
+	field, ok := fields[ref.Field]
+	if !ok {
+		return "", fmt.Errorf("%s: secret has no field %q", ref, ref.Field)
+	}
+	if s, ok := field.(string); ok {
+		return s, nil
+	}
+	encoded, _ := json.Marshal(field)
+	return string(encoded), nil
+}
+
+// ResolveEnv replaces every environment variable holding a reference with
+// the secret, so configuration read from the environment afterwards sees
+// plain values. The variables are remembered for Refresh.
+func (r *Resolver) ResolveEnv(ctx context.Context) error {
+	now := time.Now()
+	for _, entry := range os.Environ() {
+		name, value, _ := strings.Cut(entry, "=")
+		ref, ok := ParseReference(value)
+		if !ok {
+			continue
+		}
+		secret, err := r.fetch(ctx, ref, now, false)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if err := os.Setenv(name, secret); err != nil {
+			return err
+		}
+		r.mu.Lock()
+		r.watched[name] = ref
+		r.values[name] = secret
+		r.mu.Unlock()
+	}
+	return nil
+}
+
+// Names lists the environment variables ResolveEnv replaced.
+func (r *Resolver) Names() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make([]string, 0, len(r.watched))
+	for name := range r.watched {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// OnChange registers a hook called with the new value when Refresh finds
+// the secret behind an environment variable rotated. Changes to
+// variables without a hook are logged, since they apply on restart.
+func (r *Resolver) OnChange(name string, hook func(value string)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hooks[name] = append(r.hooks[name], hook)
+}
+
+// Refresh fetches every resolved secret again, updates the environment and
+// runs the hooks of those that changed.
+func (r *Resolver) Refresh(ctx context.Context) error {
+	now := time.Now()
+	var failed []string
+	for _, name := range r.Names() {
+		r.mu.Lock()
+		ref, previous := r.watched[name], r.values[name]
+		r.mu.Unlock()
+		secret, err := r.fetch(ctx, ref, now, r.fetchedAt(ref).Before(now))
+		if err != nil {
+			failed = append(failed, err.Error())
+			continue
+		}
+		if secret == previous {
+			continue
+		}
+		if err := os.Setenv(name, secret); err != nil {
+			return err
+		}
+		r.mu.Lock()
+		r.values[name] = secret
+		hooks := r.hooks[name]
+		r.mu.Unlock()
+		if len(hooks) == 0 {
+			log.Printf("secrets: %s was rotated; restart to apply it", name)
+		}
+		for _, hook := range hooks {
+			hook(secret)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("refresh secrets: %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// fetchedAt is when the payload behind ref was last fetched, so a refresh
+// fetches a secret shared by several settings once.
+func (r *Resolver) fetchedAt(ref Reference) time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cache[ref.Scheme+"://"+ref.Path].fetched
+}
+
+// Start refreshes the resolved secrets every interval. It returns at
+// once; refreshing stops with ctx.
+func (r *Resolver) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 || len(r.Names()) == 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := r.Refresh(ctx); err != nil && ctx.Err() == nil {
+				log.Print(err)
+			}
+		}
+	}()
+}
@@ -0,0 +1,193 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// mapSource serves payloads from a map and counts fetches.
+type mapSource struct {
+	payloads map[string]string
+	fetches  int
+}
+
+func (m *mapSource) Fetch(_ context.Context, path string) (string, error) {
+	m.fetches++
+	payload, ok := m.payloads[path]
+	if !ok {
+		return "", io.EOF
+	}
+	return payload, nil
+}
+
+func TestParseReference(t *testing.T) {
+	for value, want := range map[string]Reference{
+		"vault://secret/data/secretary#jwt":                             {Scheme: Vault, Path: "secret/data/secretary", Field: "jwt"},
+		"awssm://arn:aws:secretsmanager:eu-west-1:1:secret:db#password": {Scheme: AWS, Path: "arn:aws:secretsmanager:eu-west-1:1:secret:db", Field: "password"},
+		"gcpsm://projects/p/secrets/openai":                             {Scheme: GCP, Path: "projects/p/secrets/openai"},
+	} {
+		got, ok := ParseReference(value)
+		if !ok || got != want {
+			t.Fatalf("ParseReference(%q) = %+v, %v", value, got, ok)
+		}
+	}
+	for _, value := range []string{"postgres://user:pass@db/secretary", "plain-secret", "vault://"} {
+		if _, ok := ParseReference(value); ok {
+			t.Fatalf("%q parsed as a reference", value)
+		}
+	}
+}
+
+func TestResolverResolvesEnvAndRotates(t *testing.T) {
+	source := &mapSource{payloads: map[string]string{
+		"secretary": `{"jwt":"first","db_password":"hunter2","port":5432}`,
+		"openai":    "sk-plain",
+	}}
+	resolver := NewResolver(time.Hour)
+	resolver.Register(Vault, source)
+	t.Setenv("TEST_JWT_SECRET", "vault://secretary#jwt")
+	t.Setenv("TEST_DB_PASSWORD", "vault://secretary#db_password")
+	t.Setenv("TEST_DB_PORT", "vault://secretary#port")
+	t.Setenv("TEST_OPENAI_API_KEY", "vault://openai")
+	t.Setenv("TEST_PLAIN", "left alone")
+
+	if err := resolver.ResolveEnv(context.Background()); err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	for name, want := range map[string]string{"TEST_JWT_SECRET": "first", "TEST_DB_PASSWORD": "hunter2", "TEST_DB_PORT": "5432", "TEST_OPENAI_API_KEY": "sk-plain", "TEST_PLAIN": "left alone"} {
+		if got := os.Getenv(name); got != want {
+			t.Fatalf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if source.fetches != 2 {
+		t.Fatalf("fetches = %d, want each secret fetched once", source.fetches)
+	}
+
+	var rotated []string
+	resolver.OnChange("TEST_JWT_SECRET", func(value string) { rotated = append(rotated, value) })
+	source.payloads["secretary"] = `{"jwt":"second","db_password":"hunter2","port":5432}`
+	if err := resolver.Refresh(context.Background()); err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if len(rotated) != 1 || rotated[0] != "second" || os.Getenv("TEST_JWT_SECRET") != "second" {
+		t.Fatalf("rotated = %v, env = %q", rotated, os.Getenv("TEST_JWT_SECRET"))
+	}
+	if source.fetches != 4 {
+		t.Fatalf("fetches = %d, want one more per secret", source.fetches)
+	}
+
+	t.Setenv("TEST_MISSING", "awssm://nowhere")
+	if err := resolver.ResolveEnv(context.Background()); err == nil || !strings.Contains(err.Error(), "TEST_MISSING") {
+		t.Fatalf("unconfigured manager: %v", err)
+	}
+}
+
+func TestVaultReadsKVSecrets(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root" {
+			http.Error(w, `{"errors":["permission denied"]}`, http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/secretary":
+			io.WriteString(w, `{"data":{"data":{"jwt":"s3cret"},"metadata":{"version":3}}}`)
+		case "/v1/kv/secretary":
+			io.WriteString(w, `{"data":{"jwt":"v1-secret"}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	vault, err := NewVault(VaultConfig{Addr: srv.URL, Token: "root"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := NewResolver(time.Minute)
+	resolver.Register(Vault, vault)
+	for ref, want := range map[string]string{"vault://secret/data/secretary#jwt": "s3cret", "vault://kv/secretary#jwt": "v1-secret"} {
+		if got, err := resolver.Resolve(context.Background(), ref); err != nil || got != want {
+			t.Fatalf("%s = %q, %v", ref, got, err)
+		}
+	}
+	if _, err := resolver.Resolve(context.Background(), "vault://secret/data/missing#jwt"); err == nil {
+		t.Fatal("resolved a missing secret")
+	}
+}
+
+func TestAWSSignsRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if r.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" ||
+			!strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/20261017/eu-west-1/secretsmanager/aws4_request, ") ||
+			!strings.Contains(auth, "SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date;x-amz-security-token;x-amz-target, ") ||
+			r.Header.Get("X-Amz-Security-Token") != "session" {
+			http.Error(w, "bad signature", http.StatusForbidden)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"SecretId":"prod/secretary"}` {
+			http.Error(w, "unknown secret", http.StatusBadRequest)
+			return
+		}
+		io.WriteString(w, `{"Name":"prod/secretary","SecretString":"{\"jwt\":\"aws-secret\"}"}`)
+	}))
+	defer srv.Close()
+
+	aws, err := NewAWS(AWSConfig{Region: "eu-west-1", AccessKeyID: "AKID", SecretAccessKey: "secret", SessionToken: "session", Endpoint: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	aws.now = func() time.Time { return time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC) }
+	resolver := NewResolver(time.Minute)
+	resolver.Register(AWS, aws)
+	if got, err := resolver.Resolve(context.Background(), "awssm://prod/secretary#jwt"); err != nil || got != "aws-secret" {
+		t.Fatalf("resolve = %q, %v", got, err)
+	}
+
+	// The signature covers the body.
+	sign := func(body string) string {
+		req := httptest.NewRequest(http.MethodPost, srv.URL+"/", strings.NewReader(body))
+		aws.sign(req, []byte(body), aws.now())
+		return req.Header.Get("Authorization")
+	}
+	if sign(`{"SecretId":"a"}`) == sign(`{"SecretId":"b"}`) {
+		t.Fatal("signature doesn't depend on the body")
+	}
+}
+
+func TestGCPUsesMetadataToken(t *testing.T) {
+	tokens := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token" && r.Header.Get("Metadata-Flavor") == "Google":
+			tokens++
+			io.WriteString(w, `{"access_token":"ya29.token","expires_in":3600}`)
+		case r.URL.Path == "/v1/projects/p/secrets/openai/versions/latest:access" && r.Header.Get("Authorization") == "Bearer ya29.token":
+			io.WriteString(w, `{"payload":{"data":"`+base64.StdEncoding.EncodeToString([]byte("sk-gcp"))+`"}}`)
+		default:
+			http.Error(w, "unexpected request", http.StatusForbidden)
+		}
+	}))
+	defer srv.Close()
+
+	gcp, err := NewGCP(GCPConfig{BaseURL: srv.URL, MetadataURL: srv.URL + "/token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 2 {
+		if got, err := gcp.Fetch(context.Background(), "projects/p/secrets/openai"); err != nil || got != "sk-gcp" {
+			t.Fatalf("fetch = %q, %v", got, err)
+		}
+	}
+	if tokens != 1 {
+		t.Fatalf("token fetched %d times, want it cached", tokens)
+	}
+}
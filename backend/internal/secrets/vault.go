@@ -0,0 +1,75 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+// VaultConfig points at a HashiCorp Vault server. Namespace is only used
+// by Vault Enterprise.
+type VaultConfig struct {
+	Addr      string
+	Token     string
+	Namespace string
+}
+
+// VaultSource reads secrets through Vault's HTTP API. Paths are API paths
+// below /v1, e.g. "secret/data/secretary" for a KV version 2 mount.
+type VaultSource struct {
+	cfg  VaultConfig
+	http *http.Client
+}
+
+func NewVault(cfg VaultConfig) (*VaultSource, error) {
+	if cfg.Addr == "" || cfg.Token == "" {
+		return nil, errors.New("vault: VAULT_ADDR and VAULT_TOKEN are required")
+	}
+	cfg.Addr = strings.TrimRight(cfg.Addr, "/")
+	return &VaultSource{cfg: cfg, http: &http.Client{Timeout: requestTimeout}}, nil
+}
+
+// Fetch returns the secret's key/value pairs as a JSON object.
+func (v *VaultSource) Fetch(ctx context.Context, path string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.cfg.Addr+"/v1/"+strings.TrimLeft(path, "/"), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", v.cfg.Token)
+	if v.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.cfg.Namespace)
+	}
+	resp, err := v.http.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode >= 400 {
+		return "", apierr.NewProviderError(Vault, resp, body)
+	}
+	var out struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return "", fmt.Errorf("decode vault response: %w", err)
+	}
+	// KV version 2 nests the pairs under data.data, next to metadata.
+	var kv2 struct {
+		Data     json.RawMessage `json:"data"`
+		Metadata json.RawMessage `json:"metadata"`
+	}
+	if json.Unmarshal(out.Data, &kv2) == nil && kv2.Data != nil && kv2.Metadata != nil {
+		return string(kv2.Data), nil
+	}
+	return string(out.Data), nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
 	"github.com/mvult/secretary/backend/internal/db/gen"
 )
 
@@ -18,9 +19,9 @@ func (s *Server) ListActivityTypes(ctx context.Context, _ *connect.Request[secre
 		return nil, err
 	}
 
-	rows, err := s.queries.ListActivityTypesByUser(ctx, int32(userID))
+	rows, err := s.readQueries().ListActivityTypesByUser(ctx, int32(userID))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list activity types"))
+		return nil, apierr.Wrap(err, "failed to list activity types")
 	}
 
 	activityTypes := make([]*secretaryv1.ActivityType, 0, len(rows))
@@ -52,7 +53,7 @@ func (s *Server) CreateActivityType(ctx context.Context, req *connect.Request[se
 		Unit:   optionalText(req.Msg.Unit),
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create activity type"))
+		return nil, apierr.Wrap(err, "failed to create activity type")
 	}
 
 	return connect.NewResponse(&secretaryv1.CreateActivityTypeResponse{ActivityType: activityTypeToProto(row)}), nil
@@ -68,7 +69,7 @@ func (s *Server) DeleteActivityType(ctx context.Context, req *connect.Request[se
 	}
 
 	if err := s.queries.DeleteActivityTypeForUser(ctx, db.DeleteActivityTypeForUserParams{ID: int32(req.Msg.Id), UserID: int32(userID)}); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to delete activity type"))
+		return nil, apierr.Wrap(err, "failed to delete activity type")
 	}
 	return connect.NewResponse(&secretaryv1.DeleteActivityTypeResponse{}), nil
 }
@@ -93,7 +94,7 @@ func (s *Server) ListActivityEntries(ctx context.Context, req *connect.Request[s
 		limit = 100
 	}
 
-	rows, err := s.queries.ListActivityEntriesForUser(ctx, db.ListActivityEntriesForUserParams{
+	rows, err := s.readQueries().ListActivityEntriesForUser(ctx, db.ListActivityEntriesForUserParams{
 		UserID:          int32(userID),
 		ActivityTypeID:  optionalInt4(req.Msg.ActivityTypeId),
 		ActivityTypeKey: optionalText(req.Msg.ActivityTypeKey),
@@ -102,7 +103,7 @@ func (s *Server) ListActivityEntries(ctx context.Context, req *connect.Request[s
 		LimitCount:      limit,
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list activity entries"))
+		return nil, apierr.Wrap(err, "failed to list activity entries")
 	}
 
 	entries := make([]*secretaryv1.ActivityEntry, 0, len(rows))
@@ -139,7 +140,7 @@ func (s *Server) CreateActivityEntry(ctx context.Context, req *connect.Request[s
 		Data:           data,
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create activity entry"))
+		return nil, apierr.Wrap(err, "failed to create activity entry")
 	}
 
 	return connect.NewResponse(&secretaryv1.CreateActivityEntryResponse{ActivityEntry: activityEntryToProto(row, activityType.Key)}), nil
@@ -174,7 +175,7 @@ func (s *Server) UpdateActivityEntry(ctx context.Context, req *connect.Request[s
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("activity entry not found"))
 	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update activity entry"))
+		return nil, apierr.Wrap(err, "failed to update activity entry")
 	}
 
 	return connect.NewResponse(&secretaryv1.UpdateActivityEntryResponse{ActivityEntry: updatedActivityEntryToProto(row)}), nil
@@ -190,7 +191,7 @@ func (s *Server) DeleteActivityEntry(ctx context.Context, req *connect.Request[s
 	}
 
 	if err := s.queries.DeleteActivityEntryForUser(ctx, db.DeleteActivityEntryForUserParams{ID: req.Msg.Id, UserID: int32(userID)}); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to delete activity entry"))
+		return nil, apierr.Wrap(err, "failed to delete activity entry")
 	}
 	return connect.NewResponse(&secretaryv1.DeleteActivityEntryResponse{}), nil
 }
@@ -202,7 +203,7 @@ func (s *Server) resolveActivityType(ctx context.Context, userID int32, id int64
 			return db.ActivityType{}, connect.NewError(connect.CodeNotFound, errors.New("activity type not found"))
 		}
 		if err != nil {
-			return db.ActivityType{}, connect.NewError(connect.CodeInternal, errors.New("failed to fetch activity type"))
+			return db.ActivityType{}, apierr.Wrap(err, "failed to fetch activity type")
 		}
 		return row, nil
 	}
@@ -216,7 +217,7 @@ func (s *Server) resolveActivityType(ctx context.Context, userID int32, id int64
 		return db.ActivityType{}, connect.NewError(connect.CodeNotFound, errors.New("activity type not found"))
 	}
 	if err != nil {
-		return db.ActivityType{}, connect.NewError(connect.CodeInternal, errors.New("failed to fetch activity type"))
+		return db.ActivityType{}, apierr.Wrap(err, "failed to fetch activity type")
 	}
 	return row, nil
 }
@@ -15,6 +15,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mvult/secretary/backend/internal/apierr"
 	"github.com/mvult/secretary/backend/internal/db/gen"
 )
 
@@ -330,10 +331,10 @@ func (s *Server) ingestXiaomiScaleEvent(ctx context.Context, req activityEventRe
 		metrics := calculateXiaomiScaleMetrics(*req.WeightKG, *req.ImpedanceOhms, optionalIntValue(req.ImpedanceLow), measuredAt)
 		dataBytes, err := json.Marshal(metrics)
 		if err != nil {
-			return db.ActivityEntry{}, connect.NewError(connect.CodeInternal, errors.New("failed to encode scale metrics"))
+			return db.ActivityEntry{}, apierr.Wrap(err, "failed to encode scale metrics")
 		}
 		if err := json.Unmarshal(dataBytes, &dataMap); err != nil {
-			return db.ActivityEntry{}, connect.NewError(connect.CodeInternal, errors.New("failed to encode scale metrics"))
+			return db.ActivityEntry{}, apierr.Wrap(err, "failed to encode scale metrics")
 		}
 	}
 	for key, value := range req.Data {
@@ -341,7 +342,7 @@ func (s *Server) ingestXiaomiScaleEvent(ctx context.Context, req activityEventRe
 	}
 	data, err := json.Marshal(dataMap)
 	if err != nil {
-		return db.ActivityEntry{}, connect.NewError(connect.CodeInternal, errors.New("failed to encode scale metrics"))
+		return db.ActivityEntry{}, apierr.Wrap(err, "failed to encode scale metrics")
 	}
 
 	activityType, err := s.queries.EnsureActivityType(ctx, db.EnsureActivityTypeParams{
@@ -351,7 +352,7 @@ func (s *Server) ingestXiaomiScaleEvent(ctx context.Context, req activityEventRe
 		Unit:   pgtype.Text{String: "kg", Valid: true},
 	})
 	if err != nil {
-		return db.ActivityEntry{}, connect.NewError(connect.CodeInternal, errors.New("failed to ensure weight activity type"))
+		return db.ActivityEntry{}, apierr.Wrap(err, "failed to ensure weight activity type")
 	}
 
 	return s.queries.CreateActivityEntry(ctx, db.CreateActivityEntryParams{
@@ -393,7 +394,7 @@ func (s *Server) ingestGenericActivityEvent(ctx context.Context, req activityEve
 		Unit:   optionalText(req.Unit),
 	})
 	if err != nil {
-		return db.ActivityEntry{}, connect.NewError(connect.CodeInternal, errors.New("failed to ensure activity type"))
+		return db.ActivityEntry{}, apierr.Wrap(err, "failed to ensure activity type")
 	}
 	return s.queries.CreateActivityEntry(ctx, db.CreateActivityEntryParams{
 		ActivityTypeID: activityType.ID,
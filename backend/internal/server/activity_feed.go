@@ -0,0 +1,280 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+const defaultActivityLimit = 50
+
+// ActivityFeedStore holds the queries behind the team timeline, one per
+// kind of event.
+type ActivityFeedStore interface {
+	ListRecordingStatusActivity(ctx context.Context, arg db.ListRecordingStatusActivityParams) ([]db.ListRecordingStatusActivityRow, error)
+	ListTodoActivity(ctx context.Context, arg db.ListTodoActivityParams) ([]db.ListTodoActivityRow, error)
+	ListOutcomeActivity(ctx context.Context, arg db.ListOutcomeActivityParams) ([]db.ListOutcomeActivityRow, error)
+	ListCommentActivity(ctx context.Context, arg db.ListCommentActivityParams) ([]db.ListCommentActivityRow, error)
+}
+
+// activityCursor is the position of the last event of a page. Events are
+// ordered newest first, then by kind, then by descending id, so the
+// sources can be merged and resumed without skipping ties.
+type activityCursor struct {
+	at   time.Time
+	kind secretaryv1.ActivityKind
+	id   int64
+}
+
+func (c activityCursor) token() string {
+	raw := fmt.Sprintf("%s|%d|%d", c.at.UTC().Format(time.RFC3339Nano), c.kind, c.id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func parseActivityCursor(token string) (activityCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return activityCursor{}, err
+	}
+	parts := strings.Split(string(raw), "|")
+	if len(parts) != 3 {
+		return activityCursor{}, fmt.Errorf("want 3 parts, got %d", len(parts))
+	}
+	at, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return activityCursor{}, err
+	}
+	kind, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return activityCursor{}, err
+	}
+	id, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return activityCursor{}, err
+	}
+	return activityCursor{at: at, kind: secretaryv1.ActivityKind(kind), id: id}, nil
+}
+
+// before returns the bound one source resumes from: events of kinds that
+// sort ahead of the cursor's at the same instant were already returned,
+// those that sort after it were not.
+func (c *activityCursor) before(kind secretaryv1.ActivityKind) (pgtype.Timestamptz, int64) {
+	if c == nil {
+		return pgtype.Timestamptz{}, 0
+	}
+	at := pgtype.Timestamptz{Time: c.at, Valid: true}
+	switch {
+	case kind < c.kind:
+		return at, 0
+	case kind > c.kind:
+		return at, math.MaxInt64
+	default:
+		return at, c.id
+	}
+}
+
+// less reports whether c comes before other in the feed.
+func (c activityCursor) less(other activityCursor) bool {
+	if !c.at.Equal(other.at) {
+		return c.at.After(other.at)
+	}
+	if c.kind != other.kind {
+		return c.kind < other.kind
+	}
+	return c.id > other.id
+}
+
+func recordingStatusActivityToProto(row db.ListRecordingStatusActivityRow) *secretaryv1.ActivityEvent {
+	return &secretaryv1.ActivityEvent{
+		Kind:          secretaryv1.ActivityKind_ACTIVITY_KIND_RECORDING_STATUS,
+		Id:            int64(row.ID),
+		OccurredAt:    formatTime(row.CreatedAt),
+		RecordingId:   int64(row.RecordingID),
+		RecordingName: row.RecordingName.String,
+		FromStatus:    mapRecordingStatus(row.FromStatus),
+		ToStatus:      mapRecordingStatus(row.ToStatus),
+		Error:         row.Error.String,
+	}
+}
+
+func todoActivityToProto(row db.ListTodoActivityRow) *secretaryv1.ActivityEvent {
+	return &secretaryv1.ActivityEvent{
+		Kind:           secretaryv1.ActivityKind_ACTIVITY_KIND_TODO_CHANGE,
+		Id:             row.ID,
+		OccurredAt:     formatTime(row.ChangedAt),
+		ActorUserId:    int64(row.ActorUserID.Int32),
+		RecordingId:    int64(row.RecordingID.Int32),
+		RecordingName:  row.RecordingName.String,
+		TodoId:         int64(row.TodoID),
+		ChangeType:     row.ChangeType,
+		TodoName:       row.Name.String,
+		TodoStatus:     mapStatus(row.Status.String),
+		AssigneeUserId: int64(row.UserID.Int32),
+		WorkspaceId:    int64(row.WorkspaceID.Int32),
+	}
+}
+
+func outcomeActivityToProto(row db.ListOutcomeActivityRow) *secretaryv1.ActivityEvent {
+	return &secretaryv1.ActivityEvent{
+		Kind:          secretaryv1.ActivityKind_ACTIVITY_KIND_OUTCOME,
+		Id:            int64(row.ID),
+		OccurredAt:    formatTime(row.CreatedAt),
+		ActorUserId:   int64(row.CreatedByUserID.Int32),
+		RecordingId:   int64(row.RecordingID),
+		RecordingName: row.RecordingName.String,
+		OutcomeKind:   mapOutcomeKind(row.Kind),
+		OutcomeText:   row.Text,
+		OwnerUserId:   int64(row.OwnerUserID.Int32),
+	}
+}
+
+func commentActivityToProto(row db.ListCommentActivityRow) *secretaryv1.ActivityEvent {
+	event := &secretaryv1.ActivityEvent{
+		Kind:          secretaryv1.ActivityKind_ACTIVITY_KIND_COMMENT,
+		Id:            int64(row.ID),
+		OccurredAt:    formatTime(row.CreatedAt),
+		ActorUserId:   int64(row.AuthorUserID.Int32),
+		RecordingId:   int64(row.RecordingID.Int32),
+		RecordingName: row.RecordingName.String,
+		TodoId:        int64(row.TodoID),
+		TodoName:      row.TodoName,
+		WorkspaceId:   int64(row.WorkspaceID.Int32),
+		CommentId:     int64(row.ID),
+		CommentText:   row.Body,
+	}
+	for _, id := range row.MentionedUserIds {
+		event.MentionedUserIds = append(event.MentionedUserIds, int64(id))
+	}
+	return event
+}
+
+// ListActivity is the timeline of recordings being processed, todos
+// changing and being commented on, and meeting outcomes being recorded.
+func (s *Server) ListActivity(ctx context.Context, req *connect.Request[secretaryv1.ListActivityRequest]) (*connect.Response[secretaryv1.ListActivityResponse], error) {
+	if _, err := requireUserID(ctx); err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	var cursor *activityCursor
+	if msg.PageToken != "" {
+		parsed, err := parseActivityCursor(msg.PageToken)
+		if err != nil {
+			return nil, apierr.InvalidField("page_token", "is not a token returned by ListActivity")
+		}
+		cursor = &parsed
+	}
+	limit := msg.Limit
+	if limit == 0 {
+		limit = defaultActivityLimit
+	}
+	wants := func(kind secretaryv1.ActivityKind) bool {
+		if msg.WorkspaceId > 0 && kind != secretaryv1.ActivityKind_ACTIVITY_KIND_TODO_CHANGE && kind != secretaryv1.ActivityKind_ACTIVITY_KIND_COMMENT {
+			return false
+		}
+		return len(msg.Kinds) == 0 || slices.Contains(msg.Kinds, kind)
+	}
+	recordingID := optionalInt4(msg.RecordingId)
+	userID := optionalInt4(msg.UserId)
+
+	// Each source returns one more event than the page holds, so a longer
+	// merged list means there is a next page.
+	type found struct {
+		event *secretaryv1.ActivityEvent
+		at    activityCursor
+	}
+	var all []found
+	add := func(event *secretaryv1.ActivityEvent, at pgtype.Timestamptz) {
+		all = append(all, found{event, activityCursor{at: at.Time, kind: event.Kind, id: event.Id}})
+	}
+	if kind := secretaryv1.ActivityKind_ACTIVITY_KIND_RECORDING_STATUS; wants(kind) {
+		beforeAt, beforeID := cursor.before(kind)
+		rows, err := s.activity.ListRecordingStatusActivity(ctx, db.ListRecordingStatusActivityParams{
+			RecordingID: recordingID,
+			UserID:      userID,
+			BeforeAt:    beforeAt,
+			BeforeID:    beforeID,
+			MaxResults:  limit + 1,
+		})
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to list activity")
+		}
+		for _, row := range rows {
+			add(recordingStatusActivityToProto(row), row.CreatedAt)
+		}
+	}
+	if kind := secretaryv1.ActivityKind_ACTIVITY_KIND_TODO_CHANGE; wants(kind) {
+		beforeAt, beforeID := cursor.before(kind)
+		rows, err := s.activity.ListTodoActivity(ctx, db.ListTodoActivityParams{
+			RecordingID: recordingID,
+			UserID:      userID,
+			WorkspaceID: optionalInt4(msg.WorkspaceId),
+			BeforeAt:    beforeAt,
+			BeforeID:    beforeID,
+			MaxResults:  limit + 1,
+		})
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to list activity")
+		}
+		for _, row := range rows {
+			add(todoActivityToProto(row), row.ChangedAt)
+		}
+	}
+	if kind := secretaryv1.ActivityKind_ACTIVITY_KIND_OUTCOME; wants(kind) {
+		beforeAt, beforeID := cursor.before(kind)
+		rows, err := s.activity.ListOutcomeActivity(ctx, db.ListOutcomeActivityParams{
+			RecordingID: recordingID,
+			UserID:      userID,
+			BeforeAt:    beforeAt,
+			BeforeID:    beforeID,
+			MaxResults:  limit + 1,
+		})
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to list activity")
+		}
+		for _, row := range rows {
+			add(outcomeActivityToProto(row), row.CreatedAt)
+		}
+	}
+	if kind := secretaryv1.ActivityKind_ACTIVITY_KIND_COMMENT; wants(kind) {
+		beforeAt, beforeID := cursor.before(kind)
+		rows, err := s.activity.ListCommentActivity(ctx, db.ListCommentActivityParams{
+			RecordingID: recordingID,
+			UserID:      userID,
+			WorkspaceID: optionalInt4(msg.WorkspaceId),
+			BeforeAt:    beforeAt,
+			BeforeID:    beforeID,
+			MaxResults:  limit + 1,
+		})
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to list activity")
+		}
+		for _, row := range rows {
+			add(commentActivityToProto(row), row.CreatedAt)
+		}
+	}
+
+	sort.Slice(all, func(i, j int) bool { return all[i].at.less(all[j].at) })
+	resp := &secretaryv1.ListActivityResponse{}
+	if len(all) > int(limit) {
+		all = all[:limit]
+		resp.NextPageToken = all[len(all)-1].at.token()
+	}
+	resp.Events = make([]*secretaryv1.ActivityEvent, 0, len(all))
+	for _, f := range all {
+		resp.Events = append(resp.Events, f.event)
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// fakeActivity keeps each source newest first and applies the bounds the
+// way the queries do.
+type fakeActivity struct {
+	statuses []db.ListRecordingStatusActivityRow
+	todos    []db.ListTodoActivityRow
+	outcomes []db.ListOutcomeActivityRow
+	comments []db.ListCommentActivityRow
+}
+
+func beforeBound(at pgtype.Timestamptz, id int64, beforeAt pgtype.Timestamptz, beforeID int64) bool {
+	if !beforeAt.Valid {
+		return true
+	}
+	return at.Time.Before(beforeAt.Time) || at.Time.Equal(beforeAt.Time) && id < beforeID
+}
+
+func (f *fakeActivity) ListRecordingStatusActivity(_ context.Context, arg db.ListRecordingStatusActivityParams) ([]db.ListRecordingStatusActivityRow, error) {
+	var rows []db.ListRecordingStatusActivityRow
+	for _, row := range f.statuses {
+		if beforeBound(row.CreatedAt, int64(row.ID), arg.BeforeAt, arg.BeforeID) && len(rows) < int(arg.MaxResults) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeActivity) ListTodoActivity(_ context.Context, arg db.ListTodoActivityParams) ([]db.ListTodoActivityRow, error) {
+	var rows []db.ListTodoActivityRow
+	for _, row := range f.todos {
+		if (!arg.WorkspaceID.Valid || row.WorkspaceID == arg.WorkspaceID) && beforeBound(row.ChangedAt, row.ID, arg.BeforeAt, arg.BeforeID) && len(rows) < int(arg.MaxResults) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeActivity) ListOutcomeActivity(_ context.Context, arg db.ListOutcomeActivityParams) ([]db.ListOutcomeActivityRow, error) {
+	var rows []db.ListOutcomeActivityRow
+	for _, row := range f.outcomes {
+		if beforeBound(row.CreatedAt, int64(row.ID), arg.BeforeAt, arg.BeforeID) && len(rows) < int(arg.MaxResults) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeActivity) ListCommentActivity(_ context.Context, arg db.ListCommentActivityParams) ([]db.ListCommentActivityRow, error) {
+	var rows []db.ListCommentActivityRow
+	for _, row := range f.comments {
+		if (!arg.WorkspaceID.Valid || row.WorkspaceID == arg.WorkspaceID) && beforeBound(row.CreatedAt, int64(row.ID), arg.BeforeAt, arg.BeforeID) && len(rows) < int(arg.MaxResults) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func TestListActivityPagesThroughMergedSources(t *testing.T) {
+	now := time.Now().Truncate(time.Microsecond)
+	at := func(minutesAgo int) pgtype.Timestamptz {
+		return pgtype.Timestamptz{Time: now.Add(-time.Duration(minutesAgo) * time.Minute), Valid: true}
+	}
+	store := &fakeActivity{
+		statuses: []db.ListRecordingStatusActivityRow{
+			{ID: 4, RecordingID: 3, FromStatus: "processing", ToStatus: "ready", CreatedAt: at(1)},
+			{ID: 3, RecordingID: 3, FromStatus: "uploaded", ToStatus: "processing", CreatedAt: at(5)},
+		},
+		// Three changes at the same instant as the recording becoming ready.
+		todos: []db.ListTodoActivityRow{
+			{ID: 12, TodoID: 2, ChangeType: "create", Status: optionalText("todo"), WorkspaceID: optionalInt4(1), ChangedAt: at(1)},
+			{ID: 11, TodoID: 1, ChangeType: "create", Status: optionalText("todo"), WorkspaceID: optionalInt4(2), ChangedAt: at(1)},
+			{ID: 10, TodoID: 1, ChangeType: "create", Status: optionalText("done"), WorkspaceID: optionalInt4(1), ChangedAt: at(1)},
+		},
+		outcomes: []db.ListOutcomeActivityRow{
+			{ID: 7, RecordingID: 3, Kind: outcomeRisk, Text: "Vendor may slip", CreatedAt: at(0)},
+		},
+		comments: []db.ListCommentActivityRow{
+			{ID: 2, TodoID: 2, Body: "@Ana can you take this?", WorkspaceID: optionalInt4(1), MentionedUserIds: []int32{8}, CreatedAt: at(1)},
+		},
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.activity = store
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	var got []string
+	token := ""
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatalf("still paging after %d pages: %v", pages, got)
+		}
+		resp, err := srv.ListActivity(ctx, connect.NewRequest(&secretaryv1.ListActivityRequest{Limit: 2, PageToken: token}))
+		if err != nil {
+			t.Fatalf("ListActivity: %v", err)
+		}
+		for _, e := range resp.Msg.Events {
+			got = append(got, fmt.Sprintf("%s:%d", e.Kind, e.Id))
+		}
+		if token = resp.Msg.NextPageToken; token == "" {
+			break
+		}
+	}
+	want := []string{
+		"ACTIVITY_KIND_OUTCOME:7",
+		"ACTIVITY_KIND_RECORDING_STATUS:4",
+		"ACTIVITY_KIND_TODO_CHANGE:12",
+		"ACTIVITY_KIND_TODO_CHANGE:11",
+		"ACTIVITY_KIND_TODO_CHANGE:10",
+		"ACTIVITY_KIND_COMMENT:2",
+		"ACTIVITY_KIND_RECORDING_STATUS:3",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+
+	// A workspace only has todo changes and comments.
+	resp, err := srv.ListActivity(ctx, connect.NewRequest(&secretaryv1.ListActivityRequest{WorkspaceId: 1}))
+	if err != nil {
+		t.Fatalf("ListActivity: %v", err)
+	}
+	if events := resp.Msg.Events; len(events) != 3 || events[0].Id != 12 || events[1].TodoStatus != secretaryv1.TodoStatus_TODO_STATUS_DONE ||
+		events[2].CommentId != 2 || !reflect.DeepEqual(events[2].MentionedUserIds, []int64{8}) {
+		t.Fatalf("workspace events = %v", events)
+	}
+
+	_, err = srv.ListActivity(ctx, connect.NewRequest(&secretaryv1.ListActivityRequest{PageToken: "not a token"}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("bad token error = %v, want invalid argument", err)
+	}
+}
@@ -0,0 +1,261 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/archive"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// archiveKeyPrefix is where instance archives are kept in storage. Only
+// admins reach them, through /api/admin/archives/{name}.
+const archiveKeyPrefix = "archives/"
+
+// archiveTimeLayout timestamps archive names, so they sort by age.
+const archiveTimeLayout = "20060102T150405Z"
+
+var archiveNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,199}\.tar\.gz$`)
+
+// ExportInstance archives the database and stored files. Tables are read
+// in one read-only repeatable read transaction, so the archive is a
+// consistent snapshot while the server keeps running.
+func (s *Server) ExportInstance(ctx context.Context, req *connect.Request[secretaryv1.ExportInstanceRequest]) (*connect.Response[secretaryv1.ExportInstanceResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can export the instance"); err != nil {
+		return nil, err
+	}
+	if s.storage == nil || s.db == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("storage is not configured"))
+	}
+	now := time.Now().UTC()
+	name := "secretary-" + now.Format(archiveTimeLayout) + ".tar.gz"
+	size, manifest, err := s.exportArchive(ctx, name, now)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.ExportInstanceResponse{Archive: instanceArchiveToProto(name, size, manifest)}), nil
+}
+
+// exportArchive writes an archive of the instance to storage under name.
+// A partial archive is deleted when the export fails.
+func (s *Server) exportArchive(ctx context.Context, name string, now time.Time) (int64, *archive.Manifest, error) {
+	key := archiveKeyPrefix + name
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return 0, nil, apierr.Wrap(err, "failed to export instance")
+	}
+	defer tx.Rollback(ctx)
+
+	// The archive streams straight into storage instead of being held in
+	// memory.
+	pr, pw := io.Pipe()
+	exported := make(chan error, 1)
+	var manifest *archive.Manifest
+	go func() {
+		var err error
+		manifest, err = archive.Export(ctx, tx, storage.Unwrapped(s.storage), pw, now)
+		pw.CloseWithError(err)
+		exported <- err
+	}()
+	size, putErr := s.storage.Put(ctx, key, pr)
+	pr.CloseWithError(errors.New("archive storage stopped reading"))
+	if err := <-exported; err != nil || putErr != nil {
+		if deleteErr := s.storage.Delete(context.WithoutCancel(ctx), key); deleteErr != nil && !errors.Is(deleteErr, storage.ErrNotFound) {
+			log.Printf("export: failed to delete partial archive %s: %v", key, deleteErr)
+		}
+		if err != nil {
+			return 0, nil, apierr.Wrap(err, "failed to export instance")
+		}
+		return 0, nil, apierr.Wrap(putErr, "failed to store archive")
+	}
+	log.Printf("export: wrote %s (%d bytes)", name, size)
+	return size, manifest, nil
+}
+
+// ImportInstance replaces all data but the audit log with an archive's in
+// one transaction, then reloads what the server caches from the replaced
+// tables. The audit interceptor records the import on the kept log.
+func (s *Server) ImportInstance(ctx context.Context, req *connect.Request[secretaryv1.ImportInstanceRequest]) (*connect.Response[secretaryv1.ImportInstanceResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can import an instance"); err != nil {
+		return nil, err
+	}
+	if s.storage == nil || s.db == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("storage is not configured"))
+	}
+	body, err := s.storage.Open(ctx, archiveKeyPrefix+req.Msg.Name)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to open archive")
+	}
+	defer body.Close()
+	counted := &countingReader{r: body}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to import archive")
+	}
+	defer tx.Rollback(ctx)
+	manifest, err := archive.Import(ctx, tx, storage.Unwrapped(s.storage), counted)
+	if err != nil {
+		return nil, importError(err)
+	}
+	if err := s.checkImportedDataKeys(ctx, tx); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apierr.Wrap(err, "failed to import archive")
+	}
+	log.Printf("import: replaced all data with %s", req.Msg.Name)
+	s.reloadAfterImport(context.WithoutCancel(ctx))
+	return connect.NewResponse(&secretaryv1.ImportInstanceResponse{Archive: instanceArchiveToProto(req.Msg.Name, counted.n, manifest)}), nil
+}
+
+// importError reports a failed import. Problems with the archive itself,
+// rather than with the database, are for the admin to fix.
+func importError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return apierr.Wrap(err, "failed to import archive")
+	}
+	return connect.NewError(connect.CodeFailedPrecondition, err)
+}
+
+// checkImportedDataKeys fails the import unless this server can unwrap
+// every data key of the archive's org; otherwise the archive's encrypted
+// transcripts and audio would be unreadable.
+func (s *Server) checkImportedDataKeys(ctx context.Context, q db.DBTX) error {
+	queries := db.New(q)
+	orgID, err := queries.GetOrgID(ctx)
+	if err != nil {
+		return apierr.Wrap(err, "failed to import archive")
+	}
+	rows, err := queries.ListDataKeys(ctx, orgID)
+	if err != nil {
+		return apierr.Wrap(err, "failed to import archive")
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	if s.encryption == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("the archive holds encrypted data; configure the master key it was encrypted under"))
+	}
+	for _, row := range rows {
+		if _, err := s.encryption.unwrap(row, orgID); err != nil {
+			return connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+	}
+	return nil
+}
+
+// reloadAfterImport refreshes the caches filled from the replaced tables.
+// The import already succeeded, so failures are logged; the periodic
+// refreshes retry them.
+func (s *Server) reloadAfterImport(ctx context.Context) {
+	if err := s.LoadSettings(ctx); err != nil {
+		log.Printf("import: failed to reload settings: %v", err)
+	}
+	if err := s.LoadDeactivatedUsers(ctx); err != nil {
+		log.Printf("import: failed to reload deactivated users: %v", err)
+	}
+	if err := s.LoadRevokedSessions(ctx); err != nil {
+		log.Printf("import: failed to reload revoked sessions: %v", err)
+	}
+	if err := s.reloadDataKeys(ctx); err != nil {
+		log.Printf("import: failed to reload data keys: %v", err)
+	}
+	s.recordingCache.invalidate()
+}
+
+func instanceArchiveToProto(name string, size int64, m *archive.Manifest) *secretaryv1.InstanceArchive {
+	out := &secretaryv1.InstanceArchive{
+		Name:       name,
+		SizeBytes:  size,
+		CreatedAt:  m.CreatedAt.Format(time.RFC3339),
+		MediaCount: int32(len(m.Media)),
+	}
+	for _, table := range m.Tables {
+		out.Tables = append(out.Tables, &secretaryv1.ArchivedTable{Name: table.Name, Rows: table.Rows})
+	}
+	return out
+}
+
+// handleInstanceArchive downloads an archive with GET, or uploads one with
+// PUT so ImportInstance can restore it.
+func (s *Server) handleInstanceArchive(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if err := s.requireAdmin(r.Context(), "only admins can transfer archives"); err != nil {
+		switch connect.CodeOf(err) {
+		case connect.CodeUnauthenticated:
+			writeError(w, http.StatusUnauthorized, "unauthenticated")
+		case connect.CodePermissionDenied:
+			writeError(w, http.StatusForbidden, "only admins can transfer archives")
+		default:
+			writeError(w, http.StatusInternalServerError, "failed to fetch user")
+		}
+		return
+	}
+	if s.storage == nil {
+		writeError(w, http.StatusServiceUnavailable, "storage is not configured")
+		return
+	}
+	name := r.PathValue("name")
+	if !archiveNamePattern.MatchString(name) {
+		writeError(w, http.StatusBadRequest, "archive names look like secretary-20261018T120000Z.tar.gz")
+		return
+	}
+	key := archiveKeyPrefix + name
+
+	if r.Method == http.MethodPut {
+		size, err := s.storage.Put(r.Context(), key, r.Body)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to store archive")
+			return
+		}
+		s.audit(context.WithoutCancel(r.Context()), "PUT /api/admin/archives", fmt.Sprintf(`{"name":%q,"sizeBytes":%d}`, name, size))
+		writeJSON(w, http.StatusCreated, map[string]any{"name": name, "sizeBytes": size})
+		return
+	}
+
+	body, err := s.storage.Open(r.Context(), key)
+	if errors.Is(err, storage.ErrNotFound) {
+		writeError(w, http.StatusNotFound, "archive not found")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to read archive")
+		return
+	}
+	defer body.Close()
+	s.audit(context.WithoutCancel(r.Context()), "GET /api/admin/archives", fmt.Sprintf(`{"name":%q}`, name))
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	_, _ = io.Copy(w, body)
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
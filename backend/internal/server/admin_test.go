@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+func TestInstanceArchiveTransfer(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureStorage(store)
+	audit := &fakeAuditLog{}
+	srv.auditLog = audit
+	transfer := func(method, name, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/api/admin/archives/"+name, strings.NewReader(body))
+		req.SetPathValue("name", name)
+		req = req.WithContext(withPrincipal(req.Context(), Principal{UserID: 1}))
+		rec := httptest.NewRecorder()
+		srv.handleInstanceArchive(rec, req)
+		return rec
+	}
+
+	if rec := transfer(http.MethodPut, "..tar.gz", "x"); rec.Code != http.StatusBadRequest {
+		t.Fatalf("bad name: status = %d", rec.Code)
+	}
+	if rec := transfer(http.MethodGet, "missing.tar.gz", ""); rec.Code != http.StatusNotFound {
+		t.Fatalf("missing: status = %d", rec.Code)
+	}
+	if rec := transfer(http.MethodPut, "backup.tar.gz", "archive"); rec.Code != http.StatusCreated {
+		t.Fatalf("upload: status = %d %s", rec.Code, rec.Body)
+	}
+	rec := transfer(http.MethodGet, "backup.tar.gz", "")
+	if rec.Code != http.StatusOK || rec.Body.String() != "archive" || rec.Header().Get("Content-Type") != "application/gzip" {
+		t.Fatalf("download: status = %d, body = %q", rec.Code, rec.Body)
+	}
+	if _, err := store.Open(context.Background(), archiveKeyPrefix+"backup.tar.gz"); err != nil {
+		t.Fatalf("archive should be stored under %s: %v", archiveKeyPrefix, err)
+	}
+	if len(audit.entries) != 2 || audit.entries[0].Action != "PUT /api/admin/archives" || audit.entries[1].Detail != `{"name":"backup.tar.gz"}` {
+		t.Fatalf("audit entries = %+v", audit.entries)
+	}
+
+	srv.ConfigureStores(nil, nil, memberUsers{})
+	if rec := transfer(http.MethodGet, "backup.tar.gz", ""); rec.Code != http.StatusForbidden {
+		t.Fatalf("member download: status = %d", rec.Code)
+	}
+}
+
+func TestInstanceExportAndImportNeedAdmin(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, memberUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 2})
+
+	if _, err := srv.ExportInstance(ctx, connect.NewRequest(&secretaryv1.ExportInstanceRequest{})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("export: %v", err)
+	}
+	req := &secretaryv1.ImportInstanceRequest{Name: "backup.tar.gz", ReplaceAllData: true}
+	if _, err := srv.ImportInstance(ctx, connect.NewRequest(req)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("import: %v", err)
+	}
+}
@@ -5,11 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io"
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
 )
 
 type providerClient struct {
@@ -77,7 +78,7 @@ func (c providerClient) createChatCompletion(ctx context.Context, model string,
 		return nil, err
 	}
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("openai request failed (%d): %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+		return nil, apierr.NewProviderError("openai", resp, respBody)
 	}
 	var parsed chatCompletionResponse
 	if err := json.Unmarshal(respBody, &parsed); err != nil {
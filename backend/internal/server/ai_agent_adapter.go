@@ -69,7 +69,7 @@ func (s agentServices) ListTodos(ctx context.Context, userID int32) ([]agent.Tod
 }
 
 func (s agentServices) ListRecordings(ctx context.Context) ([]agent.Recording, error) {
-	rows, err := s.server.queries.ListRecordings(ctx)
+	rows, err := s.server.queries.ListRecordings(ctx, db.ListRecordingsParams{})
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +88,11 @@ func (s agentServices) GetRecording(ctx context.Context, recordingID int64) (age
 	if err != nil {
 		return agent.Recording{}, err
 	}
-	return agent.Recording{ID: int64(row.ID), Name: row.Name.String, CreatedAt: formatTime(row.CreatedAt), Summary: row.Summary.String, Transcript: row.Transcript.String}, nil
+	transcript, err := s.server.openText(row.Transcript.String)
+	if err != nil {
+		return agent.Recording{}, err
+	}
+	return agent.Recording{ID: int64(row.ID), Name: row.Name.String, CreatedAt: formatTime(row.CreatedAt), Summary: row.Summary.String, Transcript: transcript}, nil
 }
 
 func (s agentServices) CreateSourceRef(ctx context.Context, runID int64, kind string, sourceID int64, label string, quote string) error {
@@ -13,6 +13,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
 	db "github.com/mvult/secretary/backend/internal/db/gen"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -31,10 +32,10 @@ func (s *Server) ListAIThreads(ctx context.Context, req *connect.Request[secreta
 	}
 	log.Printf("AI ListAIThreads start: workspace_id=%d user_id=%d", workspaceID, userID)
 
-	rows, err := s.queries.ListAIThreadsByWorkspace(ctx, workspaceID)
+	rows, err := s.readQueries().ListAIThreadsByWorkspace(ctx, workspaceID)
 	if err != nil {
 		log.Printf("AI ListAIThreads failed: workspace_id=%d user_id=%d err=%v", workspaceID, userID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list ai threads"))
+		return nil, apierr.Wrap(err, "failed to list ai threads")
 	}
 
 	threads := make([]*secretaryv1.AIThread, 0, len(rows))
@@ -60,22 +61,22 @@ func (s *Server) GetAIThread(ctx context.Context, req *connect.Request[secretary
 	messages, err := s.queries.ListAIMessagesByThread(ctx, thread.ID)
 	if err != nil {
 		log.Printf("AI GetAIThread messages failed: thread_id=%d err=%v", thread.ID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list ai messages"))
+		return nil, apierr.Wrap(err, "failed to list ai messages")
 	}
 	runs, err := s.queries.ListAIRunsByThread(ctx, thread.ID)
 	if err != nil {
 		log.Printf("AI GetAIThread runs failed: thread_id=%d err=%v", thread.ID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list ai runs"))
+		return nil, apierr.Wrap(err, "failed to list ai runs")
 	}
 	artifacts, err := s.queries.ListAIArtifactsByThread(ctx, thread.ID)
 	if err != nil {
 		log.Printf("AI GetAIThread artifacts failed: thread_id=%d err=%v", thread.ID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list ai artifacts"))
+		return nil, apierr.Wrap(err, "failed to list ai artifacts")
 	}
 	sourceRefs, err := s.queries.ListAISourceRefsByThread(ctx, thread.ID)
 	if err != nil {
 		log.Printf("AI GetAIThread source refs failed: thread_id=%d err=%v", thread.ID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list ai source refs"))
+		return nil, apierr.Wrap(err, "failed to list ai source refs")
 	}
 
 	resp := &secretaryv1.GetAIThreadResponse{Thread: aiThreadToProto(thread)}
@@ -89,6 +90,9 @@ func (s *Server) GetAIThread(ctx context.Context, req *connect.Request[secretary
 		resp.Artifacts = append(resp.Artifacts, aiArtifactToProto(artifact))
 	}
 	for _, sourceRef := range sourceRefs {
+		if sourceRef.QuoteText.String, err = s.openText(sourceRef.QuoteText.String); err != nil {
+			return nil, err
+		}
 		resp.SourceRefs = append(resp.SourceRefs, aiSourceRefToProto(sourceRef))
 	}
 	log.Printf("AI GetAIThread done: thread_id=%d messages=%d runs=%d artifacts=%d sources=%d", thread.ID, len(messages), len(runs), len(artifacts), len(sourceRefs))
@@ -118,7 +122,7 @@ func (s *Server) CreateAIThread(ctx context.Context, req *connect.Request[secret
 			return nil, connect.NewError(connect.CodeNotFound, errors.New("document not found"))
 		}
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.New("failed to load document"))
+			return nil, apierr.Wrap(err, "failed to load document")
 		}
 		if document.WorkspaceID != workspaceID {
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("document must belong to the same workspace"))
@@ -134,7 +138,7 @@ func (s *Server) CreateAIThread(ctx context.Context, req *connect.Request[secret
 	})
 	if err != nil {
 		log.Printf("AI CreateAIThread failed: workspace_id=%d user_id=%d err=%v", workspaceID, userID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create ai thread"))
+		return nil, apierr.Wrap(err, "failed to create ai thread")
 	}
 	log.Printf("AI CreateAIThread done: thread_id=%d workspace_id=%d document_id=%d title=%q", thread.ID, thread.WorkspaceID, thread.DocumentID.Int32, thread.Title.String)
 
@@ -161,7 +165,7 @@ func (s *Server) UpdateAIThread(ctx context.Context, req *connect.Request[secret
 	})
 	if err != nil {
 		log.Printf("AI UpdateAIThread failed: thread_id=%d user_id=%d err=%v", thread.ID, userID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update ai thread"))
+		return nil, apierr.Wrap(err, "failed to update ai thread")
 	}
 	log.Printf("AI UpdateAIThread done: thread_id=%d title=%q", updatedThread.ID, updatedThread.Title.String)
 	return connect.NewResponse(&secretaryv1.UpdateAIThreadResponse{Thread: aiThreadToProto(updatedThread)}), nil
@@ -179,7 +183,7 @@ func (s *Server) DeleteAIThread(ctx context.Context, req *connect.Request[secret
 	log.Printf("AI DeleteAIThread start: thread_id=%d user_id=%d", thread.ID, userID)
 	if err := s.queries.DeleteAIThread(ctx, thread.ID); err != nil {
 		log.Printf("AI DeleteAIThread failed: thread_id=%d user_id=%d err=%v", thread.ID, userID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to delete ai thread"))
+		return nil, apierr.Wrap(err, "failed to delete ai thread")
 	}
 	log.Printf("AI DeleteAIThread done: thread_id=%d user_id=%d", thread.ID, userID)
 	return connect.NewResponse(&secretaryv1.DeleteAIThreadResponse{}), nil
@@ -216,7 +220,7 @@ func (s *Server) CreateAIMessage(ctx context.Context, req *connect.Request[secre
 		}
 		triggerMessage, err := s.queries.GetAIMessage(ctx, run.TriggerMessageID.Int64)
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.New("failed to validate ai run message"))
+			return nil, apierr.Wrap(err, "failed to validate ai run message")
 		}
 		if triggerMessage.ThreadID != thread.ID {
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("run belongs to a different thread"))
@@ -238,11 +242,11 @@ func (s *Server) CreateAIMessage(ctx context.Context, req *connect.Request[secre
 	})
 	if err != nil {
 		log.Printf("AI CreateAIMessage failed: thread_id=%d user_id=%d role=%s err=%v", thread.ID, userID, role, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create ai message"))
+		return nil, apierr.Wrap(err, "failed to create ai message")
 	}
 	if err := s.queries.TouchAIThread(ctx, thread.ID); err != nil {
 		log.Printf("AI CreateAIMessage touch failed: thread_id=%d message_id=%d err=%v", thread.ID, message.ID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update ai thread timestamp"))
+		return nil, apierr.Wrap(err, "failed to update ai thread timestamp")
 	}
 	log.Printf("AI CreateAIMessage done: thread_id=%d message_id=%d role=%s", thread.ID, message.ID, role)
 
@@ -272,6 +276,9 @@ func (s *Server) RunAIThreadTurn(ctx context.Context, req *connect.Request[secre
 	if !validAIRunMode(mode) {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid ai run mode"))
 	}
+	if err := s.checkQuota(ctx, pgtype.Int4{Int32: int32(userID), Valid: true}, usageLLMTokens, 0); err != nil {
+		return nil, err
+	}
 	log.Printf("AI RunAIThreadTurn start: thread_id=%d user_id=%d mode=%s content_preview=%q", thread.ID, userID, mode, clampString(content, 160))
 
 	userMessage, err := s.queries.CreateAIMessage(ctx, db.CreateAIMessageParams{
@@ -282,17 +289,17 @@ func (s *Server) RunAIThreadTurn(ctx context.Context, req *connect.Request[secre
 	})
 	if err != nil {
 		log.Printf("AI RunAIThreadTurn user message failed: thread_id=%d user_id=%d err=%v", thread.ID, userID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create ai message"))
+		return nil, apierr.Wrap(err, "failed to create ai message")
 	}
 	requestStruct, err := structpb.NewStruct(map[string]any{"thread_id": thread.ID, "content": content, "mode": mode})
 	if err != nil {
 		log.Printf("AI RunAIThreadTurn request encode failed: thread_id=%d message_id=%d err=%v", thread.ID, userMessage.ID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to encode ai request"))
+		return nil, apierr.Wrap(err, "failed to encode ai request")
 	}
 	requestJSON, err := marshalStruct(requestStruct)
 	if err != nil {
 		log.Printf("AI RunAIThreadTurn request persist encode failed: thread_id=%d message_id=%d err=%v", thread.ID, userMessage.ID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to persist ai request"))
+		return nil, apierr.Wrap(err, "failed to persist ai request")
 	}
 	now := time.Now().UTC()
 	run, err := s.queries.CreateAIRun(ctx, db.CreateAIRunParams{
@@ -304,7 +311,7 @@ func (s *Server) RunAIThreadTurn(ctx context.Context, req *connect.Request[secre
 	})
 	if err != nil {
 		log.Printf("AI RunAIThreadTurn run create failed: thread_id=%d message_id=%d err=%v", thread.ID, userMessage.ID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create ai run"))
+		return nil, apierr.Wrap(err, "failed to create ai run")
 	}
 	log.Printf("AI RunAIThreadTurn persisted: thread_id=%d user_message_id=%d run_id=%d", thread.ID, userMessage.ID, run.ID)
 
@@ -326,12 +333,12 @@ func (s *Server) RunAIThreadTurn(ctx context.Context, req *connect.Request[secre
 		if updateErr != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("ai run failed: %v (also failed to update run: %v)", runErr, updateErr))
 		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("ai run failed: %w", runErr))
+		return nil, apierr.Wrap(runErr, "ai run failed")
 	}
 	responseJSON, err := marshalArbitraryJSON(result.ResponseJSON)
 	if err != nil {
 		log.Printf("RunAIThreadTurn response encoding failed: thread_id=%d run_id=%d err=%v", thread.ID, run.ID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to persist ai response"))
+		return nil, apierr.Wrap(err, "failed to persist ai response")
 	}
 	assistantContent := strings.TrimSpace(result.Content)
 	if assistantContent == "" {
@@ -345,7 +352,7 @@ func (s *Server) RunAIThreadTurn(ctx context.Context, req *connect.Request[secre
 	})
 	if err != nil {
 		log.Printf("RunAIThreadTurn assistant message create failed: thread_id=%d run_id=%d err=%v", thread.ID, run.ID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create assistant message"))
+		return nil, apierr.Wrap(err, "failed to create assistant message")
 	}
 	updatedRun, err := s.queries.UpdateAIRun(ctx, db.UpdateAIRunParams{
 		ID:           run.ID,
@@ -362,11 +369,12 @@ func (s *Server) RunAIThreadTurn(ctx context.Context, req *connect.Request[secre
 	})
 	if err != nil {
 		log.Printf("RunAIThreadTurn run update failed: thread_id=%d run_id=%d err=%v", thread.ID, run.ID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update ai run"))
+		return nil, apierr.Wrap(err, "failed to update ai run")
 	}
+	s.recordTokenUsage(ctx, userID, result.InputTokens+result.OutputTokens)
 	if err := s.queries.TouchAIThread(ctx, thread.ID); err != nil {
 		log.Printf("RunAIThreadTurn thread touch failed: thread_id=%d run_id=%d err=%v", thread.ID, run.ID, err)
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update ai thread timestamp"))
+		return nil, apierr.Wrap(err, "failed to update ai thread timestamp")
 	}
 	log.Printf("AI RunAIThreadTurn done: thread_id=%d run_id=%d assistant_message_id=%d provider=%s model=%s input_tokens=%d output_tokens=%d", thread.ID, updatedRun.ID, assistantMessage.ID, result.Provider, result.Model, result.InputTokens, result.OutputTokens)
 	return connect.NewResponse(&secretaryv1.RunAIThreadTurnResponse{UserMessage: aiMessageToProto(userMessage), AssistantMessage: aiMessageToProto(assistantMessage), Run: aiRunToProto(updatedRun)}), nil
@@ -426,7 +434,7 @@ func (s *Server) CreateAIRun(ctx context.Context, req *connect.Request[secretary
 		CompletedAt:      completedAt,
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create ai run"))
+		return nil, apierr.Wrap(err, "failed to create ai run")
 	}
 
 	return connect.NewResponse(&secretaryv1.CreateAIRunResponse{Run: aiRunToProto(run)}), nil
@@ -483,7 +491,7 @@ func (s *Server) UpdateAIRun(ctx context.Context, req *connect.Request[secretary
 		CompletedAt:  completedAt,
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update ai run"))
+		return nil, apierr.Wrap(err, "failed to update ai run")
 	}
 
 	return connect.NewResponse(&secretaryv1.UpdateAIRunResponse{Run: aiRunToProto(updatedRun)}), nil
@@ -536,7 +544,7 @@ func (s *Server) CreateAIArtifact(ctx context.Context, req *connect.Request[secr
 		SupersededByArtifactID: supersededBy,
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create ai artifact"))
+		return nil, apierr.Wrap(err, "failed to create ai artifact")
 	}
 
 	return connect.NewResponse(&secretaryv1.CreateAIArtifactResponse{Artifact: aiArtifactToProto(artifact)}), nil
@@ -574,18 +582,24 @@ func (s *Server) CreateAISourceRef(ctx context.Context, req *connect.Request[sec
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("source_id must be positive"))
 	}
 
+	// Quotes come from transcripts, so they are sealed like them.
+	quote, err := s.sealText(strings.TrimSpace(req.Msg.QuoteText))
+	if err != nil {
+		return nil, err
+	}
 	sourceRef, err := s.queries.CreateAISourceRef(ctx, db.CreateAISourceRefParams{
 		RunID:      runID,
 		ArtifactID: artifactID,
 		SourceKind: sourceKind,
 		SourceID:   int32(req.Msg.SourceId),
 		Label:      optionalText(req.Msg.Label),
-		QuoteText:  optionalText(req.Msg.QuoteText),
+		QuoteText:  optionalText(quote),
 		Rank:       optionalInt4(req.Msg.Rank),
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create ai source ref"))
+		return nil, apierr.Wrap(err, "failed to create ai source ref")
 	}
+	sourceRef.QuoteText = optionalText(req.Msg.QuoteText)
 
 	return connect.NewResponse(&secretaryv1.CreateAISourceRefResponse{SourceRef: aiSourceRefToProto(sourceRef)}), nil
 }
@@ -599,7 +613,7 @@ func (s *Server) getAuthorizedAIThread(ctx context.Context, threadID int64, user
 		return db.AiThread{}, connect.NewError(connect.CodeNotFound, errors.New("ai thread not found"))
 	}
 	if err != nil {
-		return db.AiThread{}, connect.NewError(connect.CodeInternal, errors.New("failed to fetch ai thread"))
+		return db.AiThread{}, apierr.Wrap(err, "failed to fetch ai thread")
 	}
 	if err := s.ensureWorkspaceAccess(ctx, thread.WorkspaceID, int32(userID)); err != nil {
 		return db.AiThread{}, err
@@ -616,7 +630,7 @@ func (s *Server) getAuthorizedAIMessage(ctx context.Context, messageID int64, us
 		return db.AiMessage{}, connect.NewError(connect.CodeNotFound, errors.New("ai message not found"))
 	}
 	if err != nil {
-		return db.AiMessage{}, connect.NewError(connect.CodeInternal, errors.New("failed to fetch ai message"))
+		return db.AiMessage{}, apierr.Wrap(err, "failed to fetch ai message")
 	}
 	_, err = s.getAuthorizedAIThread(ctx, message.ThreadID, userID)
 	if err != nil {
@@ -634,7 +648,7 @@ func (s *Server) getAuthorizedAIRun(ctx context.Context, runID int64, userID int
 		return db.AiRun{}, connect.NewError(connect.CodeNotFound, errors.New("ai run not found"))
 	}
 	if err != nil {
-		return db.AiRun{}, connect.NewError(connect.CodeInternal, errors.New("failed to fetch ai run"))
+		return db.AiRun{}, apierr.Wrap(err, "failed to fetch ai run")
 	}
 	if !run.TriggerMessageID.Valid {
 		return db.AiRun{}, connect.NewError(connect.CodeFailedPrecondition, errors.New("ai run is not associated with a thread message"))
@@ -654,7 +668,7 @@ func (s *Server) getAuthorizedAIArtifact(ctx context.Context, artifactID int64,
 		return db.AiArtifact{}, connect.NewError(connect.CodeNotFound, errors.New("ai artifact not found"))
 	}
 	if err != nil {
-		return db.AiArtifact{}, connect.NewError(connect.CodeInternal, errors.New("failed to fetch ai artifact"))
+		return db.AiArtifact{}, apierr.Wrap(err, "failed to fetch ai artifact")
 	}
 	if _, err := s.getAuthorizedAIRun(ctx, artifact.RunID, userID); err != nil {
 		return db.AiArtifact{}, err
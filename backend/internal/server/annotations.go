@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// AnnotationStore holds the recording annotation queries.
+type AnnotationStore interface {
+	ListAnnotations(ctx context.Context, recordingID int32) ([]db.RecordingAnnotation, error)
+	GetAnnotation(ctx context.Context, id int32) (db.RecordingAnnotation, error)
+	CreateAnnotation(ctx context.Context, arg db.CreateAnnotationParams) (db.RecordingAnnotation, error)
+	UpdateAnnotation(ctx context.Context, arg db.UpdateAnnotationParams) (db.RecordingAnnotation, error)
+	DeleteAnnotation(ctx context.Context, id int32) (int64, error)
+}
+
+func annotationToProto(row db.RecordingAnnotation) *secretaryv1.Annotation {
+	return &secretaryv1.Annotation{
+		Id:           int64(row.ID),
+		RecordingId:  int64(row.RecordingID),
+		StartMs:      row.StartMs,
+		EndMs:        row.EndMs,
+		Note:         row.Note,
+		AuthorUserId: int64(row.AuthorUserID.Int32),
+		CreatedAt:    formatTime(row.CreatedAt),
+		UpdatedAt:    formatTime(row.UpdatedAt),
+	}
+}
+
+// annotationEnd treats an unset end as a moment at start.
+func annotationEnd(startMs, endMs int32) int32 {
+	if endMs == 0 {
+		return startMs
+	}
+	return endMs
+}
+
+// requireAnnotationAuthor fetches an annotation the caller is about to
+// change, allowing its author and admins through.
+func (s *Server) requireAnnotationAuthor(ctx context.Context, id int32) error {
+	row, err := s.annotations.GetAnnotation(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return connect.NewError(connect.CodeNotFound, errors.New("annotation not found"))
+	}
+	if err != nil {
+		return apierr.Wrap(err, "failed to fetch annotation")
+	}
+	return s.requireAuthorOrAdmin(ctx, row.AuthorUserID, "only the author or an admin can change an annotation")
+}
+
+// --- AnnotationsService Implementation ---
+
+func (s *Server) ListAnnotations(ctx context.Context, req *connect.Request[secretaryv1.ListAnnotationsRequest]) (*connect.Response[secretaryv1.ListAnnotationsResponse], error) {
+	rows, err := s.annotations.ListAnnotations(ctx, int32(req.Msg.RecordingId))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list annotations")
+	}
+	annotations := make([]*secretaryv1.Annotation, 0, len(rows))
+	for _, row := range rows {
+		annotations = append(annotations, annotationToProto(row))
+	}
+	return connect.NewResponse(&secretaryv1.ListAnnotationsResponse{Annotations: annotations}), nil
+}
+
+func (s *Server) CreateAnnotation(ctx context.Context, req *connect.Request[secretaryv1.CreateAnnotationRequest]) (*connect.Response[secretaryv1.CreateAnnotationResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	row, err := s.annotations.CreateAnnotation(ctx, db.CreateAnnotationParams{
+		RecordingID:  int32(msg.RecordingId),
+		StartMs:      msg.StartMs,
+		EndMs:        annotationEnd(msg.StartMs, msg.EndMs),
+		Note:         strings.TrimSpace(msg.Note),
+		AuthorUserID: pgtype.Int4{Int32: int32(userID), Valid: true},
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to create annotation")
+	}
+	return connect.NewResponse(&secretaryv1.CreateAnnotationResponse{Annotation: annotationToProto(row)}), nil
+}
+
+func (s *Server) UpdateAnnotation(ctx context.Context, req *connect.Request[secretaryv1.UpdateAnnotationRequest]) (*connect.Response[secretaryv1.UpdateAnnotationResponse], error) {
+	msg := req.Msg
+	if err := s.requireAnnotationAuthor(ctx, int32(msg.Id)); err != nil {
+		return nil, err
+	}
+	row, err := s.annotations.UpdateAnnotation(ctx, db.UpdateAnnotationParams{
+		ID:      int32(msg.Id),
+		StartMs: msg.StartMs,
+		EndMs:   annotationEnd(msg.StartMs, msg.EndMs),
+		Note:    strings.TrimSpace(msg.Note),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("annotation not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to update annotation")
+	}
+	return connect.NewResponse(&secretaryv1.UpdateAnnotationResponse{Annotation: annotationToProto(row)}), nil
+}
+
+func (s *Server) DeleteAnnotation(ctx context.Context, req *connect.Request[secretaryv1.DeleteAnnotationRequest]) (*connect.Response[secretaryv1.DeleteAnnotationResponse], error) {
+	id := int32(req.Msg.Id)
+	if err := s.requireAnnotationAuthor(ctx, id); err != nil {
+		return nil, err
+	}
+	deleted, err := s.annotations.DeleteAnnotation(ctx, id)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to delete annotation")
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("annotation not found"))
+	}
+	return connect.NewResponse(&secretaryv1.DeleteAnnotationResponse{}), nil
+}
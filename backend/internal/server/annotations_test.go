@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// fakeAnnotations keeps annotations in memory, keyed by id.
+type fakeAnnotations struct {
+	rows   map[int32]db.RecordingAnnotation
+	nextID int32
+}
+
+func (f *fakeAnnotations) ListAnnotations(_ context.Context, recordingID int32) ([]db.RecordingAnnotation, error) {
+	var out []db.RecordingAnnotation
+	for _, row := range f.rows {
+		if row.RecordingID == recordingID {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeAnnotations) GetAnnotation(_ context.Context, id int32) (db.RecordingAnnotation, error) {
+	row, ok := f.rows[id]
+	if !ok {
+		return db.RecordingAnnotation{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (f *fakeAnnotations) CreateAnnotation(_ context.Context, arg db.CreateAnnotationParams) (db.RecordingAnnotation, error) {
+	f.nextID++
+	row := db.RecordingAnnotation{
+		ID:           f.nextID,
+		RecordingID:  arg.RecordingID,
+		StartMs:      arg.StartMs,
+		EndMs:        arg.EndMs,
+		Note:         arg.Note,
+		AuthorUserID: arg.AuthorUserID,
+	}
+	f.rows[row.ID] = row
+	return row, nil
+}
+
+func (f *fakeAnnotations) UpdateAnnotation(_ context.Context, arg db.UpdateAnnotationParams) (db.RecordingAnnotation, error) {
+	row, ok := f.rows[arg.ID]
+	if !ok {
+		return db.RecordingAnnotation{}, pgx.ErrNoRows
+	}
+	row.StartMs, row.EndMs, row.Note = arg.StartMs, arg.EndMs, arg.Note
+	f.rows[arg.ID] = row
+	return row, nil
+}
+
+func (f *fakeAnnotations) DeleteAnnotation(_ context.Context, id int32) (int64, error) {
+	if _, ok := f.rows[id]; !ok {
+		return 0, nil
+	}
+	delete(f.rows, id)
+	return 1, nil
+}
+
+func newAnnotationServer(users UserStore) *Server {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, users)
+	srv.annotations = &fakeAnnotations{rows: map[int32]db.RecordingAnnotation{}}
+	return srv
+}
+
+func TestAnnotationLifecycle(t *testing.T) {
+	srv := newAnnotationServer(memberUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	created, err := srv.CreateAnnotation(ctx, connect.NewRequest(&secretaryv1.CreateAnnotationRequest{
+		RecordingId: 3,
+		StartMs:     90_000,
+		Note:        "  Budget decision  ",
+	}))
+	if err != nil {
+		t.Fatalf("CreateAnnotation: %v", err)
+	}
+	got := created.Msg.Annotation
+	if got.EndMs != 90_000 || got.Note != "Budget decision" || got.AuthorUserId != 5 {
+		t.Fatalf("created = %+v", got)
+	}
+
+	if _, err := srv.UpdateAnnotation(ctx, connect.NewRequest(&secretaryv1.UpdateAnnotationRequest{
+		Id:      got.Id,
+		StartMs: 85_000,
+		EndMs:   120_000,
+		Note:    "Budget discussion",
+	})); err != nil {
+		t.Fatalf("UpdateAnnotation: %v", err)
+	}
+
+	list, err := srv.ListAnnotations(ctx, connect.NewRequest(&secretaryv1.ListAnnotationsRequest{RecordingId: 3}))
+	if err != nil {
+		t.Fatalf("ListAnnotations: %v", err)
+	}
+	if len(list.Msg.Annotations) != 1 || list.Msg.Annotations[0].EndMs != 120_000 {
+		t.Fatalf("annotations = %+v", list.Msg.Annotations)
+	}
+
+	if _, err := srv.DeleteAnnotation(ctx, connect.NewRequest(&secretaryv1.DeleteAnnotationRequest{Id: got.Id})); err != nil {
+		t.Fatalf("DeleteAnnotation: %v", err)
+	}
+	_, err = srv.DeleteAnnotation(ctx, connect.NewRequest(&secretaryv1.DeleteAnnotationRequest{Id: got.Id}))
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeNotFound {
+		t.Fatalf("second delete err = %v, want NotFound", err)
+	}
+}
+
+func TestAnnotationChangesRequireAuthorOrAdmin(t *testing.T) {
+	author := withPrincipal(context.Background(), Principal{UserID: 5})
+	other := withPrincipal(context.Background(), Principal{UserID: 6})
+
+	srv := newAnnotationServer(memberUsers{})
+	created, err := srv.CreateAnnotation(author, connect.NewRequest(&secretaryv1.CreateAnnotationRequest{RecordingId: 3, Note: "Intro"}))
+	if err != nil {
+		t.Fatalf("CreateAnnotation: %v", err)
+	}
+	id := created.Msg.Annotation.Id
+
+	_, err = srv.DeleteAnnotation(other, connect.NewRequest(&secretaryv1.DeleteAnnotationRequest{Id: id}))
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodePermissionDenied {
+		t.Fatalf("member delete err = %v, want PermissionDenied", err)
+	}
+
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	if _, err := srv.DeleteAnnotation(other, connect.NewRequest(&secretaryv1.DeleteAnnotationRequest{Id: id})); err != nil {
+		t.Fatalf("admin delete: %v", err)
+	}
+}
@@ -0,0 +1,233 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+const maxAttachmentBytes = 10 << 20
+
+// attachmentTypes are the content types an attachment may have. Anything a
+// browser would render as active content, such as HTML or SVG, is left out.
+var attachmentTypes = []string{
+	"application/pdf",
+	"application/msword",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	"application/vnd.ms-excel",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"application/vnd.ms-powerpoint",
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	"application/vnd.oasis.opendocument.text",
+	"application/vnd.oasis.opendocument.spreadsheet",
+	"application/vnd.oasis.opendocument.presentation",
+	"application/zip",
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"image/webp",
+	"text/plain",
+	"text/csv",
+	"text/markdown",
+}
+
+// AttachmentStore holds the attachment queries.
+type AttachmentStore interface {
+	CreateAttachment(ctx context.Context, arg db.CreateAttachmentParams) (db.Attachment, error)
+	GetAttachment(ctx context.Context, id int32) (db.Attachment, error)
+	ListTodoAttachments(ctx context.Context, todoID int32) ([]db.Attachment, error)
+	ListRecordingAttachments(ctx context.Context, recordingID int32) ([]db.Attachment, error)
+	DeleteAttachment(ctx context.Context, id int32) (int64, error)
+}
+
+func attachmentToProto(row db.Attachment) *secretaryv1.Attachment {
+	return &secretaryv1.Attachment{
+		Id:               int64(row.ID),
+		TodoId:           int64(row.TodoID.Int32),
+		RecordingId:      int64(row.RecordingID.Int32),
+		FileName:         row.FileName,
+		ContentType:      row.ContentType,
+		SizeBytes:        row.SizeBytes,
+		UploadedByUserId: int64(row.UploadedByUserID.Int32),
+		CreatedAt:        formatTime(row.CreatedAt),
+		ScanStatus:       mapScanStatus(row.ScanStatus),
+	}
+}
+
+// attachmentContentType normalizes a declared content type and reports
+// whether attachments may have it.
+func attachmentContentType(declared string) (string, bool) {
+	mediaType, _, err := mime.ParseMediaType(declared)
+	if err != nil {
+		return "", false
+	}
+	return mediaType, slices.Contains(attachmentTypes, mediaType)
+}
+
+func newAttachmentKey() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "attachments/" + time.Now().UTC().Format("2006/01") + "/" + hex.EncodeToString(buf), nil
+}
+
+func (s *Server) getAttachment(ctx context.Context, id int32) (db.Attachment, error) {
+	row, err := s.attachments.GetAttachment(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Attachment{}, connect.NewError(connect.CodeNotFound, errors.New("attachment not found"))
+	}
+	if err != nil {
+		return db.Attachment{}, apierr.Wrap(err, "failed to fetch attachment")
+	}
+	return row, nil
+}
+
+// --- AttachmentsService Implementation ---
+
+func (s *Server) UploadAttachment(ctx context.Context, req *connect.Request[secretaryv1.UploadAttachmentRequest]) (*connect.Response[secretaryv1.UploadAttachmentResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	if len(msg.Content) > maxAttachmentBytes {
+		return nil, apierr.InvalidField("content", "attachments can be at most 10 MiB")
+	}
+	contentType, ok := attachmentContentType(msg.ContentType)
+	if !ok {
+		return nil, apierr.InvalidField("content_type", "is not an allowed attachment type")
+	}
+	fileName := path.Base(strings.ReplaceAll(strings.TrimSpace(msg.FileName), "\\", "/"))
+	if fileName == "." || fileName == "/" {
+		return nil, apierr.InvalidField("file_name", "must name a file")
+	}
+	if s.storage == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("attachment storage is not configured"))
+	}
+	uploader := pgtype.Int4{Int32: int32(userID), Valid: true}
+	size := int64(len(msg.Content))
+	if err := s.checkQuota(ctx, uploader, usageStorageBytes, size); err != nil {
+		return nil, err
+	}
+	scanStatus, scanDetail, err := s.scanAttachment(ctx, msg.Content)
+	if err != nil {
+		log.Printf("upload attachment: scan failed: %v", err)
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("the malware scanner is unavailable; try again later"))
+	}
+
+	key, err := newAttachmentKey()
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to store attachment")
+	}
+	if _, err := s.storage.Put(ctx, key, bytes.NewReader(msg.Content)); err != nil {
+		return nil, apierr.Wrap(err, "failed to store attachment")
+	}
+	row, err := s.attachments.CreateAttachment(ctx, db.CreateAttachmentParams{
+		TodoID:           optionalInt4(msg.TodoId),
+		RecordingID:      optionalInt4(msg.RecordingId),
+		FileName:         fileName,
+		ContentType:      contentType,
+		SizeBytes:        size,
+		StorageKey:       key,
+		UploadedByUserID: uploader,
+		ScanStatus:       scanStatus,
+		ScanDetail:       scanDetail,
+	})
+	if err != nil {
+		if deleteErr := s.storage.Delete(ctx, key); deleteErr != nil {
+			log.Printf("upload attachment: failed to clean up %s: %v", key, deleteErr)
+		}
+		return nil, apierr.Wrap(err, "failed to create attachment")
+	}
+	if scanStatus == scanQuarantined {
+		log.Printf("upload attachment: quarantined attachment %d from user %d: %s", row.ID, userID, scanDetail.String)
+	}
+	return connect.NewResponse(&secretaryv1.UploadAttachmentResponse{Attachment: attachmentToProto(row)}), nil
+}
+
+func (s *Server) ListAttachments(ctx context.Context, req *connect.Request[secretaryv1.ListAttachmentsRequest]) (*connect.Response[secretaryv1.ListAttachmentsResponse], error) {
+	msg := req.Msg
+	var rows []db.Attachment
+	var err error
+	if msg.TodoId > 0 {
+		rows, err = s.attachments.ListTodoAttachments(ctx, int32(msg.TodoId))
+	} else {
+		rows, err = s.attachments.ListRecordingAttachments(ctx, int32(msg.RecordingId))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list attachments")
+	}
+	attachments := make([]*secretaryv1.Attachment, 0, len(rows))
+	for _, row := range rows {
+		attachments = append(attachments, attachmentToProto(row))
+	}
+	return connect.NewResponse(&secretaryv1.ListAttachmentsResponse{Attachments: attachments}), nil
+}
+
+func (s *Server) DownloadAttachment(ctx context.Context, req *connect.Request[secretaryv1.DownloadAttachmentRequest]) (*connect.Response[secretaryv1.DownloadAttachmentResponse], error) {
+	row, err := s.getAttachment(ctx, int32(req.Msg.Id))
+	if err != nil {
+		return nil, err
+	}
+	if row.ScanStatus == scanQuarantined {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("attachment is quarantined"))
+	}
+	if s.storage == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("attachment storage is not configured"))
+	}
+	body, err := s.storage.Open(ctx, row.StorageKey)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to read attachment")
+	}
+	defer body.Close()
+	content, err := io.ReadAll(io.LimitReader(body, maxAttachmentBytes+1))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to read attachment")
+	}
+	return connect.NewResponse(&secretaryv1.DownloadAttachmentResponse{
+		Attachment: attachmentToProto(row),
+		Content:    content,
+	}), nil
+}
+
+func (s *Server) DeleteAttachment(ctx context.Context, req *connect.Request[secretaryv1.DeleteAttachmentRequest]) (*connect.Response[secretaryv1.DeleteAttachmentResponse], error) {
+	row, err := s.getAttachment(ctx, int32(req.Msg.Id))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireAuthorOrAdmin(ctx, row.UploadedByUserID, "only the uploader or an admin can delete an attachment"); err != nil {
+		return nil, err
+	}
+	deleted, err := s.attachments.DeleteAttachment(ctx, row.ID)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to delete attachment")
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("attachment not found"))
+	}
+	if s.storage != nil {
+		if err := s.storage.Delete(ctx, row.StorageKey); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			log.Printf("delete attachment: failed to remove %s: %v", row.StorageKey, err)
+		}
+	}
+	return connect.NewResponse(&secretaryv1.DeleteAttachmentResponse{}), nil
+}
@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// fakeAttachments keeps attachments in memory, keyed by id.
+type fakeAttachments struct {
+	rows   map[int32]db.Attachment
+	nextID int32
+}
+
+func (f *fakeAttachments) CreateAttachment(_ context.Context, arg db.CreateAttachmentParams) (db.Attachment, error) {
+	f.nextID++
+	row := db.Attachment{
+		ID:               f.nextID,
+		TodoID:           arg.TodoID,
+		RecordingID:      arg.RecordingID,
+		FileName:         arg.FileName,
+		ContentType:      arg.ContentType,
+		SizeBytes:        arg.SizeBytes,
+		StorageKey:       arg.StorageKey,
+		UploadedByUserID: arg.UploadedByUserID,
+		ScanStatus:       arg.ScanStatus,
+		ScanDetail:       arg.ScanDetail,
+	}
+	f.rows[row.ID] = row
+	return row, nil
+}
+
+func (f *fakeAttachments) GetAttachment(_ context.Context, id int32) (db.Attachment, error) {
+	row, ok := f.rows[id]
+	if !ok {
+		return db.Attachment{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (f *fakeAttachments) ListTodoAttachments(_ context.Context, todoID int32) ([]db.Attachment, error) {
+	var out []db.Attachment
+	for _, row := range f.rows {
+		if row.TodoID.Int32 == todoID {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeAttachments) ListRecordingAttachments(_ context.Context, recordingID int32) ([]db.Attachment, error) {
+	var out []db.Attachment
+	for _, row := range f.rows {
+		if row.RecordingID.Int32 == recordingID {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeAttachments) DeleteAttachment(_ context.Context, id int32) (int64, error) {
+	if _, ok := f.rows[id]; !ok {
+		return 0, nil
+	}
+	delete(f.rows, id)
+	return 1, nil
+}
+
+func newAttachmentServer(t *testing.T, users UserStore) (*Server, storage.Store) {
+	t.Helper()
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, users)
+	srv.ConfigureStorage(store)
+	srv.attachments = &fakeAttachments{rows: map[int32]db.Attachment{}}
+	return srv, store
+}
+
+func TestAttachmentLifecycle(t *testing.T) {
+	srv, store := newAttachmentServer(t, memberUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	up, err := srv.UploadAttachment(ctx, connect.NewRequest(&secretaryv1.UploadAttachmentRequest{
+		TodoId:      3,
+		FileName:    `C:\Users\ana\Q3 plan.pdf`,
+		ContentType: "application/pdf; name=plan",
+		Content:     []byte("%PDF-1.7"),
+	}))
+	if err != nil {
+		t.Fatalf("UploadAttachment: %v", err)
+	}
+	got := up.Msg.Attachment
+	if got.FileName != "Q3 plan.pdf" || got.ContentType != "application/pdf" || got.SizeBytes != 8 || got.TodoId != 3 || got.UploadedByUserId != 5 {
+		t.Fatalf("attachment = %+v", got)
+	}
+
+	list, err := srv.ListAttachments(ctx, connect.NewRequest(&secretaryv1.ListAttachmentsRequest{TodoId: 3}))
+	if err != nil {
+		t.Fatalf("ListAttachments: %v", err)
+	}
+	if len(list.Msg.Attachments) != 1 {
+		t.Fatalf("attachments = %+v", list.Msg.Attachments)
+	}
+
+	down, err := srv.DownloadAttachment(ctx, connect.NewRequest(&secretaryv1.DownloadAttachmentRequest{Id: got.Id}))
+	if err != nil {
+		t.Fatalf("DownloadAttachment: %v", err)
+	}
+	if string(down.Msg.Content) != "%PDF-1.7" {
+		t.Fatalf("content = %q", down.Msg.Content)
+	}
+
+	key := srv.attachments.(*fakeAttachments).rows[int32(got.Id)].StorageKey
+	if _, err := srv.DeleteAttachment(ctx, connect.NewRequest(&secretaryv1.DeleteAttachmentRequest{Id: got.Id})); err != nil {
+		t.Fatalf("DeleteAttachment: %v", err)
+	}
+	if _, err := store.Open(ctx, key); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("stored file after delete: %v", err)
+	}
+}
+
+func TestUploadAttachmentRejectsDisallowedTypes(t *testing.T) {
+	srv, _ := newAttachmentServer(t, memberUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	for _, contentType := range []string{"text/html", "image/svg+xml", "not a type"} {
+		_, err := srv.UploadAttachment(ctx, connect.NewRequest(&secretaryv1.UploadAttachmentRequest{
+			RecordingId: 1,
+			FileName:    "page",
+			ContentType: contentType,
+			Content:     []byte("<script>"),
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("%s: err = %v, want InvalidArgument", contentType, err)
+		}
+	}
+	if rows := srv.attachments.(*fakeAttachments).rows; len(rows) != 0 {
+		t.Fatalf("stored %d attachments", len(rows))
+	}
+}
+
+func TestDeleteAttachmentRequiresUploaderOrAdmin(t *testing.T) {
+	uploader := withPrincipal(context.Background(), Principal{UserID: 5})
+	other := withPrincipal(context.Background(), Principal{UserID: 6})
+
+	srv, _ := newAttachmentServer(t, memberUsers{})
+	up, err := srv.UploadAttachment(uploader, connect.NewRequest(&secretaryv1.UploadAttachmentRequest{
+		RecordingId: 1,
+		FileName:    "notes.txt",
+		ContentType: "text/plain",
+		Content:     []byte("hi"),
+	}))
+	if err != nil {
+		t.Fatalf("UploadAttachment: %v", err)
+	}
+	id := up.Msg.Attachment.Id
+
+	_, err = srv.DeleteAttachment(other, connect.NewRequest(&secretaryv1.DeleteAttachmentRequest{Id: id}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member delete err = %v, want PermissionDenied", err)
+	}
+
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	if _, err := srv.DeleteAttachment(other, connect.NewRequest(&secretaryv1.DeleteAttachmentRequest{Id: id})); err != nil {
+		t.Fatalf("admin delete: %v", err)
+	}
+}
@@ -0,0 +1,332 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"log"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/errtrack"
+)
+
+// auditVerifyPage is how many entries VerifyAuditLog reads at a time.
+const auditVerifyPage = 1000
+
+// AuditStore reads the audit log and appends to it. The table only takes
+// inserts; a trigger refuses updates and deletes.
+type AuditStore interface {
+	ListAuditEntries(ctx context.Context, arg db.ListAuditEntriesParams) ([]db.ListAuditEntriesRow, error)
+	ListAuditChain(ctx context.Context, arg db.ListAuditChainParams) ([]db.AuditLog, error)
+	BeginAuditTx(ctx context.Context) (AuditTx, error)
+}
+
+// AuditTx appends one entry, holding the table lock so the entry chains
+// onto the newest one.
+type AuditTx interface {
+	LockAuditLog(ctx context.Context) error
+	LastAuditHash(ctx context.Context) ([]byte, error)
+	InsertAuditEntry(ctx context.Context, arg db.InsertAuditEntryParams) (db.AuditLog, error)
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+// auditedProcedures are the calls recorded in the audit log when they
+// succeed: admin actions that change the instance, exports and imports,
+// and deletions.
+var auditedProcedures = map[string]bool{
+	secretaryv1connect.AdminServiceExportInstanceProcedure:                 true,
+	secretaryv1connect.AdminServiceImportInstanceProcedure:                 true,
+	secretaryv1connect.AdminServiceUpdateScheduledTaskProcedure:            true,
+	secretaryv1connect.AdminServiceUpdateFeatureFlagProcedure:              true,
+	secretaryv1connect.AdminServiceSetUserFeatureFlagProcedure:             true,
+	secretaryv1connect.AdminServiceSetMaintenanceModeProcedure:             true,
+	secretaryv1connect.AdminServiceUpdateScimGroupMappingProcedure:         true,
+	secretaryv1connect.AdminServiceSetIpAllowlistProcedure:                 true,
+	secretaryv1connect.UsersServiceDeactivateUserProcedure:                 true,
+	secretaryv1connect.UsersServiceReactivateUserProcedure:                 true,
+	secretaryv1connect.SettingsServiceUpdateSettingsProcedure:              true,
+	secretaryv1connect.SettingsServicePurgeExpiredDataProcedure:            true,
+	secretaryv1connect.RecordingsServiceSetLegalHoldProcedure:              true,
+	secretaryv1connect.RecordingsServiceSetRecordingRetentionProcedure:     true,
+	secretaryv1connect.RecordingsServiceDeleteRecordingProcedure:           true,
+	secretaryv1connect.TodosServiceDeleteTodoProcedure:                     true,
+	secretaryv1connect.AttachmentsServiceDeleteAttachmentProcedure:         true,
+	secretaryv1connect.AnalyticsServiceSetQuotaOverrideProcedure:           true,
+	secretaryv1connect.AnalyticsServiceDeleteQuotaOverrideProcedure:        true,
+	secretaryv1connect.QuarantineServiceReleaseQuarantinedFileProcedure:    true,
+	secretaryv1connect.QuarantineServiceDeleteQuarantinedFileProcedure:     true,
+	secretaryv1connect.JobsServiceCancelJobProcedure:                       true,
+	secretaryv1connect.JobsServiceRetryJobProcedure:                        true,
+	secretaryv1connect.JobsServicePurgeFailedJobsProcedure:                 true,
+	secretaryv1connect.PromptTemplatesServiceCreatePromptTemplateProcedure: true,
+	secretaryv1connect.PromptTemplatesServiceUpdatePromptTemplateProcedure: true,
+	secretaryv1connect.PromptTemplatesServiceDeletePromptTemplateProcedure: true,
+}
+
+// ConfigureAuditSigning signs new audit entries with HMAC-SHA256 under
+// key. Without a key entries are only hashed, which shows accidental
+// changes but not deliberate ones by someone who can write to the
+// database.
+func (s *Server) ConfigureAuditSigning(key []byte) {
+	s.auditKey = key
+	s.auditKeyID = ""
+	if len(key) > 0 {
+		sum := sha256.Sum256(key)
+		s.auditKeyID = hex.EncodeToString(sum[:8])
+	}
+}
+
+// auditAction names a procedure the way the log shows it, e.g.
+// "UsersService.DeactivateUser".
+func auditAction(procedure string) string {
+	return strings.Replace(strings.TrimPrefix(procedure, "/secretary.v1."), "/", ".", 1)
+}
+
+// auditInterceptor records audited calls that succeeded, with the request
+// as the entry's detail. It runs after authentication, so the caller is
+// known.
+type auditInterceptor struct {
+	server *Server
+}
+
+func (i auditInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		resp, err := next(ctx, req)
+		procedure := req.Spec().Procedure
+		if err != nil || !auditedProcedures[procedure] {
+			return resp, err
+		}
+		detail := "{}"
+		if msg, ok := req.Any().(proto.Message); ok {
+			if data, err := protojson.Marshal(msg); err == nil && len(data) > 0 {
+				detail = string(data)
+			}
+		}
+		i.server.audit(context.WithoutCancel(ctx), auditAction(procedure), detail)
+		return resp, nil
+	}
+}
+
+func (i auditInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i auditInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// audit appends an entry for an action that already happened. A failure
+// can't undo the action, so it is logged and tracked rather than returned.
+func (s *Server) audit(ctx context.Context, action, detail string) {
+	entry, err := s.appendAudit(ctx, action, detail)
+	if err != nil {
+		log.Printf("audit: failed to record %s by user %d: %v", action, currentUserID(ctx), err)
+		s.capture(ctx, errtrack.Event{
+			Level:       errtrack.LevelError,
+			Type:        "audit failure",
+			Message:     err.Error(),
+			Where:       action,
+			Fingerprint: []string{"audit", action},
+		})
+		return
+	}
+	log.Printf("audit: entry %d: user %d %s", entry.ID, entry.ActorUserID.Int32, action)
+}
+
+func (s *Server) appendAudit(ctx context.Context, action, detail string) (db.AuditLog, error) {
+	tx, err := s.auditLog.BeginAuditTx(ctx)
+	if err != nil {
+		return db.AuditLog{}, err
+	}
+	defer tx.Rollback(ctx)
+	if err := tx.LockAuditLog(ctx); err != nil {
+		return db.AuditLog{}, err
+	}
+	prev, err := tx.LastAuditHash(ctx)
+	if errors.Is(err, pgx.ErrNoRows) {
+		prev = []byte{}
+	} else if err != nil {
+		return db.AuditLog{}, err
+	}
+	entry := db.AuditLog{
+		// Postgres keeps microseconds; the hash must cover what is stored.
+		CreatedAt: pgtype.Timestamptz{Time: time.Now().UTC().Truncate(time.Microsecond), Valid: true},
+		Action:    action,
+		Detail:    detail,
+		RequestID: requestIDFrom(ctx),
+		KeyID:     s.auditKeyID,
+		PrevHash:  prev,
+	}
+	if principal, ok := principalFrom(ctx); ok {
+		if principal.UserID > 0 {
+			entry.ActorUserID = pgtype.Int4{Int32: int32(principal.UserID), Valid: true}
+		}
+		entry.SessionID = principal.SessionID
+		entry.Device = principal.Device
+		if principal.IP.IsValid() {
+			entry.Ip = principal.IP.String()
+		}
+	}
+	entry.Hash = auditHash(s.auditKey, entry)
+	row, err := tx.InsertAuditEntry(ctx, db.InsertAuditEntryParams{
+		CreatedAt:   entry.CreatedAt,
+		ActorUserID: entry.ActorUserID,
+		Action:      entry.Action,
+		Detail:      entry.Detail,
+		RequestID:   entry.RequestID,
+		KeyID:       entry.KeyID,
+		PrevHash:    entry.PrevHash,
+		Hash:        entry.Hash,
+		SessionID:   entry.SessionID,
+		Ip:          entry.Ip,
+		Device:      entry.Device,
+	})
+	if err != nil {
+		return db.AuditLog{}, err
+	}
+	return row, tx.Commit(ctx)
+}
+
+// auditPayload is what an entry's hash covers, in a fixed encoding.
+type auditPayload struct {
+	CreatedAt   string `json:"created_at"`
+	ActorUserID int32  `json:"actor_user_id"`
+	Action      string `json:"action"`
+	Detail      string `json:"detail"`
+	RequestID   string `json:"request_id"`
+	KeyID       string `json:"key_id"`
+	PrevHash    string `json:"prev_hash"`
+	// Left out when empty, so entries written before they were recorded
+	// still hash the same.
+	SessionID string `json:"session_id,omitempty"`
+	IP        string `json:"ip,omitempty"`
+	Device    string `json:"device,omitempty"`
+}
+
+// auditHash is the entry's HMAC-SHA256 under key when it was signed, and
+// its SHA-256 otherwise.
+func auditHash(key []byte, e db.AuditLog) []byte {
+	payload, _ := json.Marshal(auditPayload{
+		CreatedAt:   e.CreatedAt.Time.UTC().Format(time.RFC3339Nano),
+		ActorUserID: e.ActorUserID.Int32,
+		Action:      e.Action,
+		Detail:      e.Detail,
+		RequestID:   e.RequestID,
+		KeyID:       e.KeyID,
+		PrevHash:    hex.EncodeToString(e.PrevHash),
+		SessionID:   e.SessionID,
+		IP:          e.Ip,
+		Device:      e.Device,
+	})
+	var h hash.Hash
+	if e.KeyID != "" {
+		h = hmac.New(sha256.New, key)
+	} else {
+		h = sha256.New()
+	}
+	h.Write(payload)
+	return h.Sum(nil)
+}
+
+// auditProblem checks one entry against the one before it and returns
+// what is wrong with it, if anything.
+func (s *Server) auditProblem(e db.AuditLog, prev []byte, signedBefore bool) string {
+	switch {
+	case !bytes.Equal(e.PrevHash, prev):
+		return "does not follow the entry before it; entries were removed or inserted"
+	case e.KeyID != "" && e.KeyID != s.auditKeyID:
+		return fmt.Sprintf("was signed with key %s, which this server doesn't have", e.KeyID)
+	case e.KeyID == "" && signedBefore && s.auditKeyID != "":
+		return "is unsigned although earlier entries are signed"
+	case !hmac.Equal(e.Hash, auditHash(s.auditKey, e)):
+		return "was changed after it was written"
+	}
+	return ""
+}
+
+func auditEntryToProto(row db.ListAuditEntriesRow) *secretaryv1.AuditEntry {
+	return &secretaryv1.AuditEntry{
+		Id:          row.ID,
+		CreatedAt:   formatTime(row.CreatedAt),
+		ActorUserId: int64(row.ActorUserID.Int32),
+		ActorName:   strings.TrimSpace(row.ActorFirstName.String + " " + row.ActorLastName.String),
+		Action:      row.Action,
+		Detail:      row.Detail,
+		RequestId:   row.RequestID,
+		KeyId:       row.KeyID,
+		Hash:        hex.EncodeToString(row.Hash),
+		SessionId:   row.SessionID,
+		Ip:          row.Ip,
+		Device:      row.Device,
+	}
+}
+
+func (s *Server) ListAuditLog(ctx context.Context, req *connect.Request[secretaryv1.ListAuditLogRequest]) (*connect.Response[secretaryv1.ListAuditLogResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can read the audit log"); err != nil {
+		return nil, err
+	}
+	limit := req.Msg.Limit
+	if limit == 0 {
+		limit = 100
+	}
+	rows, err := s.auditLog.ListAuditEntries(ctx, db.ListAuditEntriesParams{BeforeID: req.Msg.BeforeId, RowLimit: limit})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list audit log")
+	}
+	entries := make([]*secretaryv1.AuditEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, auditEntryToProto(row))
+	}
+	return connect.NewResponse(&secretaryv1.ListAuditLogResponse{Entries: entries}), nil
+}
+
+func (s *Server) VerifyAuditLog(ctx context.Context, req *connect.Request[secretaryv1.VerifyAuditLogRequest]) (*connect.Response[secretaryv1.VerifyAuditLogResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can verify the audit log"); err != nil {
+		return nil, err
+	}
+	res := &secretaryv1.VerifyAuditLogResponse{Valid: true}
+	prev := []byte{}
+	signed := false
+	var afterID int64
+	for {
+		rows, err := s.auditLog.ListAuditChain(ctx, db.ListAuditChainParams{AfterID: afterID, RowLimit: auditVerifyPage})
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to read audit log")
+		}
+		for _, row := range rows {
+			res.Entries++
+			if problem := s.auditProblem(row, prev, signed); problem != "" {
+				res.Valid = false
+				res.FirstInvalidId = row.ID
+				res.Problem = fmt.Sprintf("entry %d %s", row.ID, problem)
+				return connect.NewResponse(res), nil
+			}
+			prev = row.Hash
+			signed = signed || row.KeyID != ""
+			afterID = row.ID
+		}
+		if len(rows) < auditVerifyPage {
+			break
+		}
+	}
+	res.HeadHash = hex.EncodeToString(prev)
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+type fakeAuditLog struct {
+	entries []db.AuditLog
+}
+
+func (f *fakeAuditLog) ListAuditEntries(_ context.Context, arg db.ListAuditEntriesParams) ([]db.ListAuditEntriesRow, error) {
+	var rows []db.ListAuditEntriesRow
+	for i := len(f.entries) - 1; i >= 0 && len(rows) < int(arg.RowLimit); i-- {
+		e := f.entries[i]
+		if arg.BeforeID == 0 || e.ID < arg.BeforeID {
+			rows = append(rows, db.ListAuditEntriesRow{ID: e.ID, CreatedAt: e.CreatedAt, ActorUserID: e.ActorUserID, Action: e.Action, Detail: e.Detail, KeyID: e.KeyID, Hash: e.Hash, SessionID: e.SessionID, Ip: e.Ip, Device: e.Device})
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeAuditLog) ListAuditChain(_ context.Context, arg db.ListAuditChainParams) ([]db.AuditLog, error) {
+	var rows []db.AuditLog
+	for _, e := range f.entries {
+		if e.ID > arg.AfterID && len(rows) < int(arg.RowLimit) {
+			rows = append(rows, e)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeAuditLog) BeginAuditTx(context.Context) (AuditTx, error) {
+	return &fakeAuditTx{log: f}, nil
+}
+
+type fakeAuditTx struct {
+	log     *fakeAuditLog
+	pending *db.AuditLog
+}
+
+func (t *fakeAuditTx) LockAuditLog(context.Context) error { return nil }
+
+func (t *fakeAuditTx) LastAuditHash(context.Context) ([]byte, error) {
+	if len(t.log.entries) == 0 {
+		return nil, pgx.ErrNoRows
+	}
+	return t.log.entries[len(t.log.entries)-1].Hash, nil
+}
+
+func (t *fakeAuditTx) InsertAuditEntry(_ context.Context, arg db.InsertAuditEntryParams) (db.AuditLog, error) {
+	t.pending = &db.AuditLog{
+		ID:          int64(len(t.log.entries) + 1),
+		CreatedAt:   arg.CreatedAt,
+		ActorUserID: arg.ActorUserID,
+		Action:      arg.Action,
+		Detail:      arg.Detail,
+		RequestID:   arg.RequestID,
+		KeyID:       arg.KeyID,
+		PrevHash:    arg.PrevHash,
+		Hash:        arg.Hash,
+		SessionID:   arg.SessionID,
+		Ip:          arg.Ip,
+		Device:      arg.Device,
+	}
+	return *t.pending, nil
+}
+
+func (t *fakeAuditTx) Commit(context.Context) error {
+	if t.pending != nil {
+		t.log.entries = append(t.log.entries, *t.pending)
+		t.pending = nil
+	}
+	return nil
+}
+
+func (t *fakeAuditTx) Rollback(context.Context) error { return nil }
+
+func TestAuditLog(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.settings = &fakeSettings{}
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	audit := &fakeAuditLog{}
+	srv.auditLog = audit
+	srv.sessions = &fakeSessions{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	token, err := srv.issueToken(1, tokenClaims{RegisteredClaims: jwt.RegisteredClaims{ID: "laptop-session"}, Device: "Firefox on Linux"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin := secretaryv1connect.NewAdminServiceClient(ts.Client(), ts.URL, connect.WithInterceptors(connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+token)
+			return next(ctx, req)
+		}
+	})))
+	ctx := context.Background()
+	verify := func() *secretaryv1.VerifyAuditLogResponse {
+		res, err := admin.VerifyAuditLog(ctx, connect.NewRequest(&secretaryv1.VerifyAuditLogRequest{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Msg
+	}
+
+	// Audited calls are recorded once they succeed; reads aren't.
+	if _, err := admin.SetMaintenanceMode(ctx, connect.NewRequest(&secretaryv1.SetMaintenanceModeRequest{Enabled: true, Message: "upgrading"})); err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureAuditSigning([]byte("audit key"))
+	if _, err := admin.SetMaintenanceMode(ctx, connect.NewRequest(&secretaryv1.SetMaintenanceModeRequest{})); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := admin.GetMaintenanceMode(ctx, connect.NewRequest(&secretaryv1.GetMaintenanceModeRequest{})); err != nil {
+		t.Fatal(err)
+	}
+	res, err := admin.ListAuditLog(ctx, connect.NewRequest(&secretaryv1.ListAuditLogRequest{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Msg.Entries) != 2 {
+		t.Fatalf("entries = %v", res.Msg.Entries)
+	}
+	newest, oldest := res.Msg.Entries[0], res.Msg.Entries[1]
+	if oldest.Action != "AdminService.SetMaintenanceMode" || oldest.ActorUserId != 1 || !strings.Contains(oldest.Detail, "upgrading") || oldest.KeyId != "" || newest.KeyId == "" {
+		t.Fatalf("entries = %v", res.Msg.Entries)
+	}
+	// Each entry records the session it was made in.
+	if newest.SessionId != "laptop-session" || newest.Device != "Firefox on Linux" || newest.Ip != "127.0.0.1" {
+		t.Fatalf("session of %v", newest)
+	}
+	if got := verify(); !got.Valid || got.Entries != 2 || got.HeadHash != newest.Hash {
+		t.Fatalf("intact log: %v", got)
+	}
+
+	// Changing an entry, or removing one, breaks the chain there.
+	audit.entries[0].Detail = `{"enabled":false}`
+	if got := verify(); got.Valid || got.FirstInvalidId != 1 || !strings.Contains(got.Problem, "changed") {
+		t.Fatalf("changed entry: %v", got)
+	}
+	audit.entries = audit.entries[1:]
+	if got := verify(); got.Valid || got.FirstInvalidId != 2 || !strings.Contains(got.Problem, "removed") {
+		t.Fatalf("removed entry: %v", got)
+	}
+
+	// Without the signing key, signed entries can't be vouched for.
+	audit.entries = nil
+	if _, err := admin.SetMaintenanceMode(ctx, connect.NewRequest(&secretaryv1.SetMaintenanceModeRequest{})); err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureAuditSigning([]byte("another key"))
+	if got := verify(); got.Valid || !strings.Contains(got.Problem, "which this server doesn't have") {
+		t.Fatalf("unknown key: %v", got)
+	}
+}
+
+// Entries written before sessions were recorded must still verify.
+func TestAuditHashWithoutSession(t *testing.T) {
+	e := db.AuditLog{
+		CreatedAt:   pgtype.Timestamptz{Time: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC), Valid: true},
+		ActorUserID: pgtype.Int4{Int32: 1, Valid: true},
+		Action:      "AdminService.SetMaintenanceMode",
+		Detail:      "{}",
+		RequestID:   "req-1",
+		PrevHash:    []byte{0xab},
+	}
+	want := sha256.Sum256([]byte(`{"created_at":"2026-10-01T12:00:00Z","actor_user_id":1,"action":"AdminService.SetMaintenanceMode","detail":"{}","request_id":"req-1","key_id":"","prev_hash":"ab"}`))
+	if got := auditHash(nil, e); !bytes.Equal(got, want[:]) {
+		t.Fatalf("hash = %x, want %x", got, want)
+	}
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Principal is who an authenticated request acts for, and from where.
+type Principal struct {
+	UserID int64
+	// TokenIssuedAt is when the bearer token was issued; zero for tokens
+	// without the claim.
+	TokenIssuedAt time.Time
+	// SessionID is the bearer token's session; empty for tokens issued
+	// without one.
+	SessionID string
+	// Device and DeviceFingerprint are the token's device claims.
+	Device            string
+	DeviceFingerprint string
+	// IP is the address the request came from.
+	IP netip.Addr
+}
+
+type principalKey struct{}
+
+func withPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// principalFrom returns who ctx's request acts for, and false when it
+// wasn't authenticated.
+func principalFrom(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// currentUserID is the signed-in user's ID, or 0 outside an authenticated
+// request.
+func currentUserID(ctx context.Context) int64 {
+	principal, _ := principalFrom(ctx)
+	return principal.UserID
+}
+
+// authenticate checks an Authorization header's bearer token. The error
+// is safe to show the caller.
+func (s *Server) authenticate(authorization string) (Principal, error) {
+	tokenStr, ok := strings.CutPrefix(authorization, "Bearer ")
+	tokenStr = strings.TrimSpace(tokenStr)
+	if !ok || tokenStr == "" {
+		return Principal{}, errors.New("missing token")
+	}
+	token, err := jwt.Parse(tokenStr, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		secrets := s.jwtSecret.Load()
+		return jwt.VerificationKeySet{Keys: secrets.keys()}, nil
+	})
+	if err != nil || !token.Valid {
+		return Principal{}, errors.New("invalid token")
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return Principal{}, errors.New("invalid token claims")
+	}
+	sub, _ := claims.GetSubject()
+	userID, _ := strconv.ParseInt(sub, 10, 64)
+	if s.isDeactivated(userID) {
+		return Principal{}, errors.New("account is deactivated")
+	}
+	principal := Principal{UserID: userID}
+	principal.SessionID, _ = claims["jti"].(string)
+	principal.Device, _ = claims["dev"].(string)
+	principal.DeviceFingerprint, _ = claims["dfp"].(string)
+	if s.isRevoked(principal.SessionID) {
+		return Principal{}, errors.New("session was signed out")
+	}
+	if issued, err := claims.GetIssuedAt(); err == nil && issued != nil {
+		principal.TokenIssuedAt = issued.Time
+	}
+	return principal, nil
+}
+
+// authMiddleware authenticates the plain HTTP endpoints, after checking
+// the caller's address against the IP allowlist. Connect services are
+// authenticated by authInterceptor instead.
+func (s *Server) authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" || r.URL.Path == "/api/login" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ip := s.ipAllowlist.clientIP(r.RemoteAddr, r.Header)
+		if err := s.checkIPAllowlist(r.Context(), ip, r.Header, r.URL.Path); err != nil {
+			writeError(w, http.StatusForbidden, err.Error())
+			return
+		}
+		principal, err := s.authenticate(r.Header.Get("Authorization"))
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+		principal.IP = ip
+		s.touchSession(r.Context(), principal)
+		next.ServeHTTP(w, r.WithContext(withPrincipal(r.Context(), principal)))
+	})
+}
+
+// authInterceptor checks every Connect call against the IP allowlist,
+// authenticates it and puts the Principal in its context.
+type authInterceptor struct {
+	server *Server
+}
+
+func (i authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ip := i.server.ipAllowlist.clientIP(req.Peer().Addr, req.Header())
+		if err := i.server.checkIPAllowlist(ctx, ip, req.Header(), req.Spec().Procedure); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		principal, err := i.server.authenticate(req.Header().Get("Authorization"))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		principal.IP = ip
+		i.server.touchSession(ctx, principal)
+		return next(withPrincipal(ctx, principal), req)
+	}
+}
+
+func (i authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ip := i.server.ipAllowlist.clientIP(conn.Peer().Addr, conn.RequestHeader())
+		if err := i.server.checkIPAllowlist(ctx, ip, conn.RequestHeader(), conn.Spec().Procedure); err != nil {
+			return connect.NewError(connect.CodePermissionDenied, err)
+		}
+		principal, err := i.server.authenticate(conn.RequestHeader().Get("Authorization"))
+		if err != nil {
+			return connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		principal.IP = ip
+		i.server.touchSession(ctx, principal)
+		return next(withPrincipal(ctx, principal), conn)
+	}
+}
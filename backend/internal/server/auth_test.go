@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+)
+
+func TestConnectInterceptorChain(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	srv.schedules = newFakeSchedules()
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	bearer := func(token string) connect.ClientOption {
+		return connect.WithInterceptors(connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+			return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+				if token != "" {
+					req.Header().Set("Authorization", "Bearer "+token)
+				}
+				return next(ctx, req)
+			}
+		}))
+	}
+	token, err := srv.issueToken(1, tokenClaims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	anonymous := secretaryv1connect.NewAdminServiceClient(ts.Client(), ts.URL, bearer(""))
+	admin := secretaryv1connect.NewAdminServiceClient(ts.Client(), ts.URL, bearer(token))
+	ctx := context.Background()
+
+	_, err = anonymous.ListScheduledTasks(ctx, connect.NewRequest(&secretaryv1.ListScheduledTasksRequest{}))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated || !strings.Contains(err.Error(), "missing token") {
+		t.Fatalf("without a token: %v", err)
+	}
+
+	// The handler finds the caller in the principal the interceptor set.
+	if _, err := admin.ListScheduledTasks(ctx, connect.NewRequest(&secretaryv1.ListScheduledTasksRequest{})); err != nil {
+		t.Fatalf("as admin: %v", err)
+	}
+
+	srv.setDeactivated(1, true)
+	if _, err := admin.ListScheduledTasks(ctx, connect.NewRequest(&secretaryv1.ListScheduledTasksRequest{})); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("deactivated: %v", err)
+	}
+	srv.setDeactivated(1, false)
+
+	// Without a database the stats query panics; the call fails instead of
+	// the process.
+	if _, err := admin.GetSystemStats(ctx, connect.NewRequest(&secretaryv1.GetSystemStatsRequest{})); connect.CodeOf(err) != connect.CodeInternal {
+		t.Fatalf("panicking handler: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+	for _, want := range []string{
+		`secretary_rpc_requests_total{procedure="/secretary.v1.AdminService/ListScheduledTasks",code="ok"} 1`,
+		`secretary_rpc_requests_total{procedure="/secretary.v1.AdminService/ListScheduledTasks",code="unauthenticated"} 2`,
+		`secretary_rpc_panics_total 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("metrics missing %s:\n%s", want, body)
+		}
+	}
+}
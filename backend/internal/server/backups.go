@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// backupNamePrefix marks the archives the backup schedule writes, so
+// pruning never touches archives exported or uploaded by hand.
+const backupNamePrefix = "backup-"
+
+// backupSchedule is the outcome of scheduled backups on this process,
+// reported by ListBackups and /metrics.
+type backupSchedule struct {
+	mu          sync.Mutex
+	retain      int
+	lastAttempt time.Time
+	lastSuccess time.Time
+	lastSize    int64
+	lastErr     error
+	succeeded   int64
+	failed      int64
+}
+
+// runBackup writes one backup and then prunes the oldest. A failed prune
+// is retried after the next backup.
+func (s *Server) runBackup(ctx context.Context, now time.Time) error {
+	if s.storage == nil {
+		return errors.New("storage is not configured")
+	}
+	name := backupNamePrefix + now.Format(archiveTimeLayout) + ".tar.gz"
+	size, _, err := s.exportArchive(ctx, name, now)
+
+	s.backups.mu.Lock()
+	s.backups.lastAttempt = now
+	s.backups.lastErr = err
+	if err != nil {
+		s.backups.failed++
+	} else {
+		s.backups.succeeded++
+		s.backups.lastSuccess = now
+		s.backups.lastSize = size
+	}
+	retain := s.backups.retain
+	s.backups.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return s.pruneBackups(ctx, retain)
+}
+
+// pruneBackups deletes all but the newest retain backups.
+func (s *Server) pruneBackups(ctx context.Context, retain int) error {
+	backups, err := s.listBackups(ctx)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, backup := range backups[min(retain, len(backups)):] {
+		if err := s.storage.Delete(ctx, archiveKeyPrefix+backup.Name); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			errs = append(errs, err)
+			continue
+		}
+		log.Printf("backup: deleted %s", backup.Name)
+	}
+	return errors.Join(errs...)
+}
+
+// listBackups returns the backups in storage, newest first.
+func (s *Server) listBackups(ctx context.Context) ([]*secretaryv1.Backup, error) {
+	lister, ok := storage.AsLister(s.storage)
+	if !ok {
+		return nil, errors.New("storage can't list backups")
+	}
+	objects, err := lister.List(ctx, archiveKeyPrefix+backupNamePrefix)
+	if err != nil {
+		return nil, err
+	}
+	var backups []*secretaryv1.Backup
+	for _, object := range objects {
+		name := strings.TrimPrefix(object.Key, archiveKeyPrefix)
+		stamp, ok := strings.CutSuffix(strings.TrimPrefix(name, backupNamePrefix), ".tar.gz")
+		created, err := time.Parse(archiveTimeLayout, stamp)
+		if !ok || err != nil {
+			continue
+		}
+		backups = append(backups, &secretaryv1.Backup{
+			Name:      name,
+			SizeBytes: object.Size,
+			CreatedAt: created.Format(time.RFC3339),
+		})
+	}
+	// The timestamp in the name sorts backups by age.
+	slices.Reverse(backups)
+	return backups, nil
+}
+
+func (s *Server) ListBackups(ctx context.Context, req *connect.Request[secretaryv1.ListBackupsRequest]) (*connect.Response[secretaryv1.ListBackupsResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can list backups"); err != nil {
+		return nil, err
+	}
+	if s.storage == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("storage is not configured"))
+	}
+	backups, err := s.listBackups(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list backups")
+	}
+	res := &secretaryv1.ListBackupsResponse{Backups: backups}
+	s.backups.mu.Lock()
+	defer s.backups.mu.Unlock()
+	if sched := s.taskSchedule(taskBackup); sched != nil {
+		res.Schedule = sched.String()
+		res.IntervalSeconds = int64(sched.Every() / time.Second)
+	}
+	res.Retain = int32(s.backups.retain)
+	if !s.backups.lastAttempt.IsZero() {
+		res.LastAttemptAt = s.backups.lastAttempt.Format(time.RFC3339)
+	}
+	if !s.backups.lastSuccess.IsZero() {
+		res.LastSuccessAt = s.backups.lastSuccess.Format(time.RFC3339)
+	}
+	if s.backups.lastErr != nil {
+		res.LastError = s.backups.lastErr.Error()
+	}
+	return connect.NewResponse(res), nil
+}
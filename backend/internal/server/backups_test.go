@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+func TestPruneBackupsKeepsNewest(t *testing.T) {
+	ctx := context.Background()
+	srv := New(nil, []byte("test"), time.Hour)
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureStorage(store)
+	for _, name := range []string{
+		"backup-20261016T000000Z.tar.gz",
+		"backup-20261017T000000Z.tar.gz",
+		"backup-20261018T000000Z.tar.gz",
+		"secretary-20261001T000000Z.tar.gz",
+	} {
+		if _, err := store.Put(ctx, archiveKeyPrefix+name, strings.NewReader("archive")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := srv.pruneBackups(ctx, 2); err != nil {
+		t.Fatalf("prune: %v", err)
+	}
+	backups, err := srv.listBackups(ctx)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(backups) != 2 || backups[0].Name != "backup-20261018T000000Z.tar.gz" || backups[1].CreatedAt != "2026-10-17T00:00:00Z" {
+		t.Fatalf("backups = %v", backups)
+	}
+	// Archives exported by hand aren't backups and are never pruned.
+	if _, err := store.Open(ctx, archiveKeyPrefix+"secretary-20261001T000000Z.tar.gz"); err != nil {
+		t.Fatalf("manual export was pruned: %v", err)
+	}
+}
+
+func TestListBackupsReportsSchedule(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureStorage(store)
+	srv.schedules = newFakeSchedules()
+	srv.registerTasks(ScheduleConfig{Backup: "@every 24h", BackupRetain: 7})
+	srv.tick(context.Background(), time.Now())
+	srv.backups.lastAttempt = time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)
+	srv.backups.lastSuccess = time.Date(2026, 10, 17, 3, 0, 0, 0, time.UTC)
+	srv.backups.lastSize = 2048
+	srv.backups.lastErr = errors.New("storage unavailable")
+	srv.backups.succeeded = 4
+	srv.backups.failed = 1
+
+	srv.ConfigureStores(nil, nil, memberUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 2})
+	if _, err := srv.ListBackups(ctx, connect.NewRequest(&secretaryv1.ListBackupsRequest{})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member: %v", err)
+	}
+
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	res, err := srv.ListBackups(ctx, connect.NewRequest(&secretaryv1.ListBackupsRequest{}))
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if res.Msg.Schedule != "@every 24h" || res.Msg.IntervalSeconds != 86400 || res.Msg.Retain != 7 || res.Msg.LastSuccessAt != "2026-10-17T03:00:00Z" || res.Msg.LastError != "storage unavailable" {
+		t.Fatalf("unexpected status %v", res.Msg)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+	for _, want := range []string{
+		"secretary_backups_total 4",
+		"secretary_backup_failures_total 1",
+		"secretary_backup_last_success_timestamp_seconds 1792206000",
+		"secretary_backup_last_size_bytes 2048",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics lack %q:\n%s", want, body)
+		}
+	}
+}
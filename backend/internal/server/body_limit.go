@@ -0,0 +1,90 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// BodyLimits caps the size of request bodies, by kind of endpoint, so an
+// oversized payload is refused instead of being read into memory. Zero
+// leaves that kind unlimited. Handlers keep their own, tighter limits, such
+// as the avatar size.
+type BodyLimits struct {
+	// Login applies to signing in, which takes an email and a password.
+	Login int64
+	// API applies to Connect calls and the other JSON endpoints. For
+	// Connect it also bounds a message after decompression.
+	API int64
+	// Upload applies to the endpoints that take files.
+	Upload int64
+}
+
+func DefaultBodyLimits() BodyLimits {
+	return BodyLimits{
+		Login: 4 << 10,
+		// Attachments up to 10 MiB arrive base64-encoded in Connect JSON.
+		API:    16 << 20,
+		Upload: maxAudioUploadBytes,
+	}
+}
+
+func (s *Server) ConfigureBodyLimits(cfg BodyLimits) {
+	s.bodyLimits = cfg
+}
+
+// uploadPaths take files and get BodyLimits.Upload.
+var uploadPaths = []string{
+	"/api/recordings/upload",
+	"/api/recordings/live/",
+	"/api/uploads",
+	"/api/me/avatar",
+	"/api/settings/logo",
+	"/api/admin/archives/",
+}
+
+// limit returns the body limit for a request to path.
+func (c BodyLimits) limit(path string) int64 {
+	if path == "/api/login" {
+		return c.Login
+	}
+	for _, prefix := range uploadPaths {
+		if strings.HasPrefix(path, prefix) {
+			return c.Upload
+		}
+	}
+	return c.API
+}
+
+// withBodyLimit refuses a body that announces more than its endpoint's
+// limit up front, and cuts off one that turns out longer while it is read.
+func (s *Server) withBodyLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit := s.bodyLimits.limit(r.URL.Path)
+		if limit <= 0 || r.Body == nil || r.Body == http.NoBody {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.ContentLength > limit {
+			writeBodyTooLarge(w, r, limit)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// writeBodyTooLarge answers 413, as a Connect error for RPCs.
+func writeBodyTooLarge(w http.ResponseWriter, r *http.Request, limit int64) {
+	message := fmt.Sprintf("request body exceeds %d bytes", limit)
+	if strings.HasPrefix(r.URL.Path, "/secretary.v1.") {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		_ = json.NewEncoder(w).Encode(map[string]string{"code": connect.CodeResourceExhausted.String(), "message": message})
+		return
+	}
+	writeError(w, http.StatusRequestEntityTooLarge, message)
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+)
+
+func TestBodyLimitsLimit(t *testing.T) {
+	cfg := BodyLimits{Login: 1, API: 2, Upload: 3}
+	for path, want := range map[string]int64{
+		"/api/login":                           1,
+		"/secretary.v1.TodosService/ListTodos": 2,
+		"/api/activity-events":                 2,
+		"/api/recordings/upload":               3,
+		"/api/recordings/live/7/chunks/1":      3,
+		"/api/uploads/abc":                     3,
+		"/api/admin/archives/backup.tar":       3,
+	} {
+		if got := cfg.limit(path); got != want {
+			t.Fatalf("%s: got %d, want %d", path, got, want)
+		}
+	}
+}
+
+func TestWithBodyLimit(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureBodyLimits(BodyLimits{Login: 64, API: 1 << 10})
+
+	post := func(path string, body io.Reader) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, body))
+		return rec
+	}
+	// A declared length over the limit is refused before the handler runs.
+	if rec := post("/api/login", strings.NewReader(`{"email":"`+strings.Repeat("a", 100)+`","password":"x"}`)); rec.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("oversized login: %d %s", rec.Code, rec.Body)
+	}
+	// Without a length, reading stops at the limit.
+	chunked := io.MultiReader(strings.NewReader(`{"email":"`), strings.NewReader(strings.Repeat("a", 100)))
+	if rec := post("/api/login", chunked); rec.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("oversized chunked login: %d %s", rec.Code, rec.Body)
+	}
+}
+
+func TestConnectBodyLimit(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureBodyLimits(BodyLimits{API: 1 << 10})
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	req := &secretaryv1.CreateTodoRequest{Name: "todo", Desc: strings.Repeat("a", 5000)}
+	for name, opts := range map[string][]connect.ClientOption{
+		"plain": nil,
+		// Compressed, the body is small; the message is not.
+		"gzip": {connect.WithSendGzip()},
+	} {
+		client := secretaryv1connect.NewTodosServiceClient(ts.Client(), ts.URL, opts...)
+		_, err := client.CreateTodo(context.Background(), connect.NewRequest(req))
+		if connect.CodeOf(err) != connect.CodeResourceExhausted {
+			t.Fatalf("%s: got %v, want resource_exhausted", name, err)
+		}
+	}
+}
@@ -0,0 +1,295 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+const maxClipLength = 30 * time.Minute
+
+// ClipStore holds the recording clip queries.
+type ClipStore interface {
+	ListClips(ctx context.Context, recordingID int32) ([]db.RecordingClip, error)
+	GetClipByShareToken(ctx context.Context, shareToken string) (db.RecordingClip, error)
+	CreateClip(ctx context.Context, arg db.CreateClipParams) (db.RecordingClip, error)
+}
+
+// AudioCutter copies the stretch [start, end) of the audio read from src
+// to dst as MP3.
+type AudioCutter interface {
+	Cut(ctx context.Context, src io.Reader, start, end time.Duration, dst io.Writer) error
+}
+
+// FFmpegCutter cuts audio by piping it through the ffmpeg binary at Path.
+type FFmpegCutter struct {
+	Path string
+}
+
+func (c FFmpegCutter) Cut(ctx context.Context, src io.Reader, start, end time.Duration, dst io.Writer) error {
+	cmd := exec.CommandContext(ctx, c.Path,
+		"-hide_banner", "-loglevel", "error",
+		"-i", "pipe:0",
+		"-ss", ffmpegSeconds(start), "-to", ffmpegSeconds(end),
+		"-vn", "-codec:a", "libmp3lame", "-q:a", "4",
+		"-f", "mp3", "pipe:1",
+	)
+	var stderr bytes.Buffer
+	cmd.Stdin = src
+	cmd.Stdout = dst
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("ffmpeg: %w: %s", err, msg)
+		}
+		return fmt.Errorf("ffmpeg: %w", err)
+	}
+	return nil
+}
+
+func ffmpegSeconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+}
+
+// ConfigureAudioCutter sets how clips are cut from recordings. The default
+// runs ffmpeg from PATH.
+func (s *Server) ConfigureAudioCutter(cutter AudioCutter) {
+	s.cutter = cutter
+}
+
+func (s *Server) clipToProto(row db.RecordingClip) (*secretaryv1.Clip, error) {
+	excerpt, err := s.openText(row.TranscriptExcerpt)
+	if err != nil {
+		return nil, err
+	}
+	return &secretaryv1.Clip{
+		Id:                int64(row.ID),
+		RecordingId:       int64(row.RecordingID),
+		StartMs:           row.StartMs,
+		EndMs:             row.EndMs,
+		Title:             row.Title,
+		TranscriptExcerpt: excerpt,
+		ShareUrl:          s.publicURL + "/api/clips/" + row.ShareToken,
+		SizeBytes:         row.AudioBytes,
+		CreatedByUserId:   int64(row.CreatedByUserID.Int32),
+		CreatedAt:         formatTime(row.CreatedAt),
+	}, nil
+}
+
+// transcriptExcerpt picks the words of a transcript that fall between
+// startMs and endMs, assuming speech is spread evenly over the recording.
+func transcriptExcerpt(transcript string, durationSeconds int32, startMs, endMs int32) string {
+	words := strings.Fields(transcript)
+	if len(words) == 0 || durationSeconds <= 0 {
+		return ""
+	}
+	total := int64(durationSeconds) * 1000
+	from := int64(len(words)) * int64(startMs) / total
+	to := (int64(len(words))*int64(endMs) + total - 1) / total
+	from = min(from, int64(len(words)))
+	to = min(max(to, from), int64(len(words)))
+	return strings.Join(words[from:to], " ")
+}
+
+// formatClipOffset renders an offset as m:ss for default clip titles.
+func formatClipOffset(ms int32) string {
+	seconds := ms / 1000
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+func newClipKey() (key string, token string, err error) {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	tokenBuf := make([]byte, 16)
+	if _, err := rand.Read(tokenBuf); err != nil {
+		return "", "", err
+	}
+	key = "clips/" + time.Now().UTC().Format("2006/01") + "/" + hex.EncodeToString(buf) + ".mp3"
+	return key, hex.EncodeToString(tokenBuf), nil
+}
+
+// CreateClip cuts a stretch of a recording's stored audio into a shareable
+// clip.
+func (s *Server) CreateClip(ctx context.Context, req *connect.Request[secretaryv1.CreateClipRequest]) (*connect.Response[secretaryv1.CreateClipResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	if time.Duration(msg.EndMs-msg.StartMs)*time.Millisecond > maxClipLength {
+		return nil, apierr.InvalidField("end_ms", fmt.Sprintf("clips can be at most %s long", maxClipLength))
+	}
+	if s.storage == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("audio storage is not configured"))
+	}
+
+	rec, err := s.recordings.GetRecording(ctx, int32(msg.RecordingId))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch recording")
+	}
+	if !rec.AudioKey.Valid {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("recording audio is not stored on this server"))
+	}
+	if err := checkAudioScanned(rec.ScanStatus); err != nil {
+		return nil, err
+	}
+	if rec.Duration.Valid && rec.Duration.Int32 > 0 && int64(msg.StartMs) >= int64(rec.Duration.Int32)*1000 {
+		return nil, apierr.InvalidField("start_ms", "must be before the end of the recording")
+	}
+	owner := pgtype.Int4{Int32: int32(userID), Valid: true}
+	if err := s.checkQuota(ctx, owner, usageStorageBytes, 0); err != nil {
+		return nil, err
+	}
+
+	transcript, err := s.openText(rec.Transcript.String)
+	if err != nil {
+		return nil, err
+	}
+	src, err := s.storage.Open(ctx, rec.AudioKey.String)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to open recording audio")
+	}
+	defer src.Close()
+
+	key, token, err := newClipKey()
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to store clip")
+	}
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		start := time.Duration(msg.StartMs) * time.Millisecond
+		end := time.Duration(msg.EndMs) * time.Millisecond
+		pw.CloseWithError(s.cutter.Cut(ctx, src, start, end, pw))
+	}()
+	size, err := s.storage.Put(ctx, key, pr)
+	pr.CloseWithError(errors.New("clip upload stopped"))
+	<-done
+	if err != nil {
+		_ = s.storage.Delete(ctx, key)
+		return nil, apierr.Wrap(err, "failed to cut clip")
+	}
+	if size == 0 {
+		_ = s.storage.Delete(ctx, key)
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("clip has no audio"))
+	}
+	if err := s.checkQuota(ctx, owner, usageStorageBytes, size); err != nil {
+		_ = s.storage.Delete(ctx, key)
+		return nil, err
+	}
+
+	title := strings.TrimSpace(msg.Title)
+	if title == "" {
+		title = fmt.Sprintf("%s (%s–%s)", rec.Name.String, formatClipOffset(msg.StartMs), formatClipOffset(msg.EndMs))
+	}
+	// The excerpt quotes the transcript, so it is sealed like it.
+	excerpt, err := s.sealText(transcriptExcerpt(transcript, rec.Duration.Int32, msg.StartMs, msg.EndMs))
+	if err != nil {
+		_ = s.storage.Delete(ctx, key)
+		return nil, err
+	}
+	row, err := s.clips.CreateClip(ctx, db.CreateClipParams{
+		RecordingID:       rec.ID,
+		StartMs:           msg.StartMs,
+		EndMs:             msg.EndMs,
+		Title:             title,
+		TranscriptExcerpt: excerpt,
+		AudioKey:          key,
+		AudioBytes:        size,
+		ShareToken:        token,
+		CreatedByUserID:   owner,
+	})
+	if err != nil {
+		if deleteErr := s.storage.Delete(ctx, key); deleteErr != nil {
+			log.Printf("create clip: failed to clean up %s: %v", key, deleteErr)
+		}
+		return nil, apierr.Wrap(err, "failed to create clip")
+	}
+	clip, err := s.clipToProto(row)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.CreateClipResponse{Clip: clip}), nil
+}
+
+// ListClips returns the clips cut from a recording, newest first.
+func (s *Server) ListClips(ctx context.Context, req *connect.Request[secretaryv1.ListClipsRequest]) (*connect.Response[secretaryv1.ListClipsResponse], error) {
+	rows, err := s.clips.ListClips(ctx, int32(req.Msg.RecordingId))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list clips")
+	}
+	clips := make([]*secretaryv1.Clip, 0, len(rows))
+	for _, row := range rows {
+		clip, err := s.clipToProto(row)
+		if err != nil {
+			return nil, err
+		}
+		clips = append(clips, clip)
+	}
+	return connect.NewResponse(&secretaryv1.ListClipsResponse{Clips: clips}), nil
+}
+
+// handleClip plays a clip by its share token. The token is the only
+// credential, so no sign-in is needed.
+func (s *Server) handleClip(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if s.storage == nil {
+		http.NotFound(w, r)
+		return
+	}
+	clip, err := s.clips.GetClipByShareToken(r.Context(), r.PathValue("token"))
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch clip")
+		return
+	}
+	body, err := s.storage.Open(r.Context(), clip.AudioKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to read clip")
+		return
+	}
+	defer body.Close()
+	w.Header().Set("Content-Type", "audio/mpeg")
+	w.Header().Set("Content-Length", strconv.FormatInt(clip.AudioBytes, 10))
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = io.Copy(w, body)
+}
@@ -0,0 +1,206 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// fakeClips keeps clips in memory.
+type fakeClips struct {
+	rows []db.RecordingClip
+}
+
+func (f *fakeClips) ListClips(_ context.Context, recordingID int32) ([]db.RecordingClip, error) {
+	var out []db.RecordingClip
+	for _, row := range f.rows {
+		if row.RecordingID == recordingID {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeClips) GetClipByShareToken(_ context.Context, token string) (db.RecordingClip, error) {
+	for _, row := range f.rows {
+		if row.ShareToken == token {
+			return row, nil
+		}
+	}
+	return db.RecordingClip{}, pgx.ErrNoRows
+}
+
+func (f *fakeClips) CreateClip(_ context.Context, arg db.CreateClipParams) (db.RecordingClip, error) {
+	row := db.RecordingClip{
+		ID:                int32(len(f.rows) + 1),
+		RecordingID:       arg.RecordingID,
+		StartMs:           arg.StartMs,
+		EndMs:             arg.EndMs,
+		Title:             arg.Title,
+		TranscriptExcerpt: arg.TranscriptExcerpt,
+		AudioKey:          arg.AudioKey,
+		AudioBytes:        arg.AudioBytes,
+		ShareToken:        arg.ShareToken,
+		CreatedByUserID:   arg.CreatedByUserID,
+	}
+	f.rows = append(f.rows, row)
+	return row, nil
+}
+
+// clipRecording serves one recording to cut clips from.
+type clipRecording struct {
+	RecordingStore
+	rec db.GetRecordingRow
+}
+
+func (c clipRecording) GetRecording(_ context.Context, id int32) (db.GetRecordingRow, error) {
+	if id != c.rec.ID {
+		return db.GetRecordingRow{}, pgx.ErrNoRows
+	}
+	return c.rec, nil
+}
+
+// byteCutter treats the source as one byte per second of audio.
+type byteCutter struct{}
+
+func (byteCutter) Cut(_ context.Context, src io.Reader, start, end time.Duration, dst io.Writer) error {
+	audio, err := io.ReadAll(src)
+	if err != nil {
+		return err
+	}
+	_, err = dst.Write(audio[int(start.Seconds()):int(end.Seconds())])
+	return err
+}
+
+// failingCutter fails like ffmpeg would on audio it cannot decode.
+type failingCutter struct{}
+
+func (failingCutter) Cut(context.Context, io.Reader, time.Duration, time.Duration, io.Writer) error {
+	return errors.New("ffmpeg: invalid data found when processing input")
+}
+
+func newClipServer(t *testing.T, rec db.GetRecordingRow) *Server {
+	t.Helper()
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Put(context.Background(), "recordings/a.wav", strings.NewReader("0123456789")); err != nil {
+		t.Fatal(err)
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(clipRecording{rec: rec}, nil, nil)
+	srv.ConfigureStorage(store)
+	srv.ConfigureAudioCutter(byteCutter{})
+	srv.clips = &fakeClips{}
+	return srv
+}
+
+func TestCreateClip(t *testing.T) {
+	srv := newClipServer(t, db.GetRecordingRow{
+		ID:         7,
+		Name:       pgtype.Text{String: "Standup", Valid: true},
+		AudioKey:   pgtype.Text{String: "recordings/a.wav", Valid: true},
+		Duration:   pgtype.Int4{Int32: 10, Valid: true},
+		Transcript: pgtype.Text{String: "one two three four five six seven eight nine ten", Valid: true},
+	})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	res, err := srv.CreateClip(ctx, connect.NewRequest(&secretaryv1.CreateClipRequest{RecordingId: 7, StartMs: 2000, EndMs: 5000}))
+	if err != nil {
+		t.Fatalf("CreateClip: %v", err)
+	}
+	clip := res.Msg.Clip
+	if clip.SizeBytes != 3 || clip.TranscriptExcerpt != "three four five" || clip.Title != "Standup (0:02–0:05)" {
+		t.Fatalf("clip = %+v", clip)
+	}
+
+	handler := srv.Routes()
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, clip.ShareUrl, nil))
+	if rr.Code != http.StatusOK || rr.Body.String() != "234" {
+		t.Fatalf("shared clip = %d %q", rr.Code, rr.Body.String())
+	}
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/clips/nope", nil))
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("unknown token status = %d", rr.Code)
+	}
+
+	list, err := srv.ListClips(ctx, connect.NewRequest(&secretaryv1.ListClipsRequest{RecordingId: 7}))
+	if err != nil {
+		t.Fatalf("ListClips: %v", err)
+	}
+	if len(list.Msg.Clips) != 1 || list.Msg.Clips[0].Id != clip.Id {
+		t.Fatalf("clips = %+v", list.Msg.Clips)
+	}
+}
+
+func TestCreateClipErrors(t *testing.T) {
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+	stored := db.GetRecordingRow{ID: 7, AudioKey: pgtype.Text{String: "recordings/a.wav", Valid: true}, Duration: pgtype.Int4{Int32: 10, Valid: true}}
+
+	tests := []struct {
+		name   string
+		rec    db.GetRecordingRow
+		cutter AudioCutter
+		req    *secretaryv1.CreateClipRequest
+		code   connect.Code
+	}{
+		{"remote audio", db.GetRecordingRow{ID: 7, AudioUrl: pgtype.Text{String: "https://example.com/a.mp3", Valid: true}}, byteCutter{}, &secretaryv1.CreateClipRequest{RecordingId: 7, EndMs: 1000}, connect.CodeFailedPrecondition},
+		{"past the end", stored, byteCutter{}, &secretaryv1.CreateClipRequest{RecordingId: 7, StartMs: 10_000, EndMs: 11_000}, connect.CodeInvalidArgument},
+		{"too long", stored, byteCutter{}, &secretaryv1.CreateClipRequest{RecordingId: 7, EndMs: int32((maxClipLength + time.Second).Milliseconds())}, connect.CodeInvalidArgument},
+		{"cut fails", stored, failingCutter{}, &secretaryv1.CreateClipRequest{RecordingId: 7, EndMs: 1000}, connect.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newClipServer(t, tt.rec)
+			srv.ConfigureAudioCutter(tt.cutter)
+			_, err := srv.CreateClip(ctx, connect.NewRequest(tt.req))
+			if connect.CodeOf(err) != tt.code {
+				t.Fatalf("err = %v, want %s", err, tt.code)
+			}
+			if clips := srv.clips.(*fakeClips).rows; len(clips) != 0 {
+				t.Fatalf("clips = %+v, want none", clips)
+			}
+		})
+	}
+}
+
+func TestTranscriptExcerpt(t *testing.T) {
+	words := make([]string, 100)
+	for i := range words {
+		words[i] = fmt.Sprint(i)
+	}
+	transcript := strings.Join(words, " ")
+	tests := []struct {
+		start, end int32
+		want       string
+	}{
+		{0, 2000, "0 1 2 3"},
+		{49_000, 50_000, "98 99"},
+		{60_000, 70_000, ""},
+	}
+	for _, tt := range tests {
+		if got := transcriptExcerpt(transcript, 50, tt.start, tt.end); got != tt.want {
+			t.Errorf("transcriptExcerpt(%d, %d) = %q, want %q", tt.start, tt.end, got, tt.want)
+		}
+	}
+	if got := transcriptExcerpt(transcript, 0, 0, 1000); got != "" {
+		t.Errorf("without a duration got %q", got)
+	}
+}
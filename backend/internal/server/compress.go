@@ -0,0 +1,224 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+)
+
+// minCompressBytes is the smallest body worth compressing; below this the
+// framing overhead eats most of the gain.
+const minCompressBytes = 1024
+
+// compressibleTypes lists the Content-Type prefixes the middleware may
+// encode. Connect streaming and gRPC types are absent on purpose: those
+// protocols compress per message and must not get an HTTP Content-Encoding.
+var compressibleTypes = []string{
+	"application/json",
+	"application/proto",
+	"application/javascript",
+	"application/xml",
+	"image/svg+xml",
+	"text/",
+}
+
+type encoder interface {
+	io.WriteCloser
+	Reset(io.Writer)
+	Flush() error
+}
+
+var encoderPools = map[string]*sync.Pool{
+	"zstd": {New: func() any {
+		enc, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithEncoderLevel(zstd.SpeedDefault))
+		return enc
+	}},
+	"gzip": {New: func() any {
+		return gzip.NewWriter(nil)
+	}},
+}
+
+// compressResponses encodes compressible responses with zstd or gzip,
+// whichever the client prefers. Connect unary handlers already gzip when
+// asked; anything that arrives with a Content-Encoding is passed through.
+func compressResponses(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+		if encoding == "" || r.Method == http.MethodHead || r.Header.Get("Range") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		cw := &compressWriter{ResponseWriter: w, encoding: encoding, status: http.StatusOK}
+		defer cw.close()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// negotiateEncoding picks zstd or gzip from an Accept-Encoding header,
+// honouring q-values and preferring zstd on ties.
+func negotiateEncoding(header string) string {
+	accepted := parseAcceptEncoding(header)
+	if q, ok := accepted["*"]; ok {
+		if _, listed := accepted["gzip"]; !listed {
+			accepted["gzip"] = q
+		}
+	}
+	best, bestQ := "", 0.0
+	for _, name := range []string{"zstd", "gzip"} {
+		if q := accepted[name]; q > bestQ {
+			best, bestQ = name, q
+		}
+	}
+	return best
+}
+
+// parseAcceptEncoding maps each coding in an Accept-Encoding header to its
+// q-value. Entries with a malformed q-value are dropped.
+func parseAcceptEncoding(header string) map[string]float64 {
+	accepted := map[string]float64{}
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.ReplaceAll(params, " ", ""), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		accepted[name] = q
+	}
+	return accepted
+}
+
+// compressWriter buffers the first minCompressBytes of a response to decide
+// whether encoding is worthwhile, then streams through the encoder.
+type compressWriter struct {
+	http.ResponseWriter
+	encoding    string
+	status      int
+	wroteHeader bool
+	decided     bool
+	buf         []byte
+	enc         encoder
+}
+
+func (w *compressWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = status
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified {
+		_ = w.decide(false)
+	}
+}
+
+func (w *compressWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.decided {
+		w.buf = append(w.buf, p...)
+		if len(w.buf) >= minCompressBytes {
+			if err := w.decide(true); err != nil {
+				return 0, err
+			}
+		}
+		return len(p), nil
+	}
+	if w.enc != nil {
+		return w.enc.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// decide commits the headers, either switching to the encoder or writing
+// the buffered bytes through unchanged.
+func (w *compressWriter) decide(bigEnough bool) error {
+	w.decided = true
+	header := w.Header()
+	compressible := w.compressible()
+	if compressible {
+		header.Add("Vary", "Accept-Encoding")
+	}
+	if bigEnough && compressible {
+		header.Set("Content-Encoding", w.encoding)
+		header.Del("Content-Length")
+		// The encoded bytes differ from what a strong validator promised.
+		if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			header.Set("ETag", "W/"+etag)
+		}
+		w.enc = encoderPools[w.encoding].Get().(encoder)
+		w.enc.Reset(w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	if w.enc != nil {
+		_, err := w.enc.Write(buf)
+		return err
+	}
+	_, err := w.ResponseWriter.Write(buf)
+	return err
+}
+
+func (w *compressWriter) compressible() bool {
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" || w.status != http.StatusOK {
+		return false
+	}
+	contentType := strings.ToLower(header.Get("Content-Type"))
+	for _, prefix := range compressibleTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *compressWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.decided {
+		_ = w.decide(len(w.buf) >= minCompressBytes)
+	}
+	if w.enc != nil {
+		_ = w.enc.Flush()
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *compressWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *compressWriter) close() {
+	if !w.wroteHeader {
+		// Nothing was written; let net/http send its default response.
+		return
+	}
+	if !w.decided {
+		_ = w.decide(false)
+	}
+	if w.enc != nil {
+		_ = w.enc.Close()
+		encoderPools[w.encoding].Put(w.enc)
+		w.enc = nil
+	}
+}
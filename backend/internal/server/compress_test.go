@@ -0,0 +1,95 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	cases := map[string]string{
+		"":                         "",
+		"br":                       "",
+		"gzip, deflate, br":        "gzip",
+		"gzip, deflate, br, zstd":  "zstd",
+		"zstd;q=0.5, gzip":         "gzip",
+		"gzip;q=0, zstd;q=0":       "",
+		"*":                        "gzip",
+		"GZIP;q=0.8, identity;q=1": "gzip",
+		"zstd;q=bogus, gzip;q=0.1": "gzip",
+	}
+	for header, want := range cases {
+		if got := negotiateEncoding(header); got != want {
+			t.Errorf("negotiateEncoding(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestCompressResponses(t *testing.T) {
+	transcript := `{"transcript":"` + strings.Repeat("so the plan for next quarter is ", 200) + `"}`
+	respond := func(contentType string, body string, encoding string) http.Handler {
+		return compressResponses(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", contentType)
+			if encoding != "" {
+				w.Header().Set("Content-Encoding", encoding)
+			}
+			_, _ = io.WriteString(w, body)
+		}))
+	}
+	serve := func(h http.Handler, acceptEncoding string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/recordings", nil)
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(respond("application/json", transcript, ""), "gzip, zstd")
+	if rec.Header().Get("Content-Encoding") != "zstd" || rec.Body.Len() >= len(transcript) {
+		t.Fatalf("expected smaller zstd body, got %q with %d bytes", rec.Header().Get("Content-Encoding"), rec.Body.Len())
+	}
+	dec, err := zstd.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("zstd reader: %v", err)
+	}
+	if body, err := io.ReadAll(dec); err != nil || string(body) != transcript {
+		t.Fatalf("zstd round trip failed: %v", err)
+	}
+	dec.Close()
+
+	rec = serve(respond("application/json", transcript, ""), "gzip")
+	gz, err := gzip.NewReader(rec.Body)
+	if err != nil || rec.Header().Get("Vary") != "Accept-Encoding" {
+		t.Fatalf("expected gzip body with Vary header: %v %v", err, rec.Header())
+	}
+	if body, err := io.ReadAll(gz); err != nil || string(body) != transcript {
+		t.Fatalf("gzip round trip failed: %v", err)
+	}
+
+	passthrough := []struct {
+		name     string
+		handler  http.Handler
+		accept   string
+		wantBody string
+	}{
+		{"small body", respond("application/json", `{"ok":true}`, ""), "gzip", `{"ok":true}`},
+		{"no accept-encoding", respond("application/json", transcript, ""), "", transcript},
+		{"already encoded", respond("application/json", "opaque", "gzip"), "zstd", "opaque"},
+		{"grpc framing", respond("application/grpc+proto", transcript, ""), "gzip", transcript},
+		{"audio", respond("audio/wav", transcript, ""), "gzip", transcript},
+	}
+	for _, tc := range passthrough {
+		rec := serve(tc.handler, tc.accept)
+		if tc.name != "already encoded" && rec.Header().Get("Content-Encoding") != "" {
+			t.Fatalf("%s: unexpected Content-Encoding %q", tc.name, rec.Header().Get("Content-Encoding"))
+		}
+		if rec.Body.String() != tc.wantBody {
+			t.Fatalf("%s: body changed", tc.name)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/rs/cors"
+)
+
+// CORSConfig holds the allowed origins for each class of route. An empty
+// list disables CORS headers for that class, so browsers only allow
+// same-origin access to it.
+type CORSConfig struct {
+	// APIOrigins applies to the Connect services and authenticated /api endpoints.
+	APIOrigins []string
+	// PublicOrigins applies to unauthenticated read-only endpoints such as feeds.
+	PublicOrigins []string
+	// StaticOrigins applies to the embedded SPA assets.
+	StaticOrigins []string
+	// HealthOrigins applies to /healthz, which reveals nothing.
+	HealthOrigins []string
+}
+
+// DefaultCORSConfig allows no cross-origin access except to /healthz, so
+// uptime pages on other sites can poll it. The bundled frontend is served
+// from the same origin and needs none; other frontends, such as the native
+// app, have to be listed in APIOrigins.
+func DefaultCORSConfig() CORSConfig {
+	return CORSConfig{
+		HealthOrigins: []string{"*"},
+	}
+}
+
+type corsPolicies struct {
+	api    *cors.Cors
+	public *cors.Cors
+	static *cors.Cors
+	health *cors.Cors
+}
+
+var publicRoutes = map[string]bool{
+	"/api/todos.ics":       true,
+	"/api/activity-events": true,
+	"/api/openapi.json":    true,
+}
+
+func (s *Server) ConfigureCORS(cfg CORSConfig) {
+	s.cors = newCORSPolicies(cfg)
+}
+
+func newCORSPolicies(cfg CORSConfig) corsPolicies {
+	return corsPolicies{
+		api: newCORS(cfg.APIOrigins, cors.Options{
+			AllowedMethods: []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+			AllowedHeaders: []string{"Accept", "Content-Type", "Content-Length", "Accept-Encoding", "X-CSRF-Token", "Authorization", "Connect-Protocol-Version", "Connect-Timeout-Ms", "Grpc-Timeout", "X-User-Agent", "X-Grpc-Web", "If-None-Match", timezoneHeader, "Tus-Resumable", "Upload-Length", "Upload-Offset", "Upload-Metadata", checksumHeader},
+			ExposedHeaders: []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin", "ETag", timezoneHeader, "Location", "Tus-Resumable", "Tus-Version", "Tus-Extension", "Tus-Max-Size", "Upload-Offset", "Upload-Length", "Upload-Expires", recordingIDHeader, requestIDHeader, "Retry-After"},
+		}),
+		public: newCORS(cfg.PublicOrigins, cors.Options{
+			AllowedMethods: []string{"GET", "HEAD", "OPTIONS"},
+			AllowedHeaders: []string{"Accept"},
+		}),
+		static: newCORS(cfg.StaticOrigins, cors.Options{
+			AllowedMethods: []string{"GET", "HEAD"},
+		}),
+		health: newCORS(cfg.HealthOrigins, cors.Options{
+			AllowedMethods: []string{"GET", "HEAD"},
+		}),
+	}
+}
+
+func newCORS(origins []string, options cors.Options) *cors.Cors {
+	cleaned := make([]string, 0, len(origins))
+	for _, origin := range origins {
+		if trimmed := strings.TrimSpace(origin); trimmed != "" {
+			cleaned = append(cleaned, trimmed)
+		}
+	}
+	if len(cleaned) == 0 {
+		// rs/cors treats an empty origin list as "allow all", so skip it entirely.
+		return nil
+	}
+	options.AllowedOrigins = cleaned
+	return cors.New(options)
+}
+
+// withCORS picks the policy for the route class of the request path.
+func (p corsPolicies) withCORS(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		policy := p.api
+		switch {
+		case r.URL.Path == "/healthz":
+			policy = p.health
+		case publicRoutes[r.URL.Path]:
+			policy = p.public
+		}
+		if policy == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		policy.Handler(next).ServeHTTP(w, r)
+	})
+}
+
+func (p corsPolicies) withStaticCORS(next http.Handler) http.Handler {
+	if p.static == nil {
+		return next
+	}
+	return p.static.Handler(next)
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCORSPoliciesPerRouteClass(t *testing.T) {
+	s := New(nil, []byte("test"), time.Hour)
+	s.ConfigureCORS(CORSConfig{
+		APIOrigins:    []string{"https://app.example.com"},
+		PublicOrigins: []string{"*"},
+	})
+	handler := s.cors.withCORS(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	cases := []struct {
+		path   string
+		origin string
+		want   string
+	}{
+		{path: "/secretary.v1.TodosService/ListTodos", origin: "https://app.example.com", want: "https://app.example.com"},
+		{path: "/secretary.v1.TodosService/ListTodos", origin: "https://evil.example.com", want: ""},
+		{path: "/api/todos.ics", origin: "https://calendar.example.com", want: "*"},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		req.Header.Set("Origin", tc.origin)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tc.want {
+			t.Fatalf("%s from %s: expected allow-origin %q, got %q", tc.path, tc.origin, tc.want, got)
+		}
+	}
+
+	static := s.cors.withStaticCORS(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	req := httptest.NewRequest(http.MethodGet, "/assets/index.js", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	rec := httptest.NewRecorder()
+	static.ServeHTTP(rec, req)
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Fatalf("expected no CORS headers on static assets, got %q", got)
+	}
+}
+
+func TestDefaultCORSAllowsOnlyHealthz(t *testing.T) {
+	s := New(nil, []byte("test"), time.Hour)
+	s.ConfigureCORS(DefaultCORSConfig())
+	handler := s.cors.withCORS(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	for path, want := range map[string]string{
+		"/healthz":                             "*",
+		"/secretary.v1.TodosService/ListTodos": "",
+		"/api/todos.ics":                       "",
+		"/api/activity-events":                 "",
+		"/api/openapi.json":                    "",
+	} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Origin", "https://elsewhere.example.com")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if got := rec.Header().Get("Access-Control-Allow-Origin"); got != want {
+			t.Errorf("%s: expected allow-origin %q, got %q", path, want, got)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/i18n"
+	"github.com/mvult/secretary/backend/internal/mail"
+)
+
+// DigestStore holds the queries behind digest emails.
+type DigestStore interface {
+	ListDigestRecipients(ctx context.Context, frequency string) ([]db.ListDigestRecipientsRow, error)
+	ListDigestTodos(ctx context.Context, arg db.ListDigestTodosParams) ([]db.ListDigestTodosRow, error)
+	ListDigestRecordings(ctx context.Context, arg db.ListDigestRecordingsParams) ([]db.ListDigestRecordingsRow, error)
+}
+
+// Digest frequencies, as notification preferences store them.
+const (
+	digestDaily  = "daily"
+	digestWeekly = "weekly"
+)
+
+// maxDigestItems bounds the meetings and the todos listed in one digest.
+const maxDigestItems = 10
+
+// sendDigests emails every user who wants a digest this often their open
+// todos and the meetings processed since since. Users with neither get no
+// email. A user whose digest fails misses it; the others still get theirs.
+func (s *Server) sendDigests(ctx context.Context, frequency string, since time.Time) error {
+	if !s.emailNotificationsEnabled() {
+		return nil
+	}
+	recipients, err := s.digests.ListDigestRecipients(ctx, frequency)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	sent := 0
+	for _, recipient := range recipients {
+		msg, ok, err := s.digestMessage(ctx, recipient, frequency, since)
+		if err == nil && ok {
+			err = s.mailer.Send(ctx, msg)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			errs = append(errs, fmt.Errorf("user %d: %w", recipient.ID, err))
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	if sent > 0 {
+		log.Printf("digests: sent %d %s digests", sent, frequency)
+	}
+	return errors.Join(errs...)
+}
+
+// digestMessage writes recipient's digest, in their language and time
+// zone. It reports false when there is nothing to tell them.
+func (s *Server) digestMessage(ctx context.Context, recipient db.ListDigestRecipientsRow, frequency string, since time.Time) (mail.Message, bool, error) {
+	recordings, err := s.digests.ListDigestRecordings(ctx, db.ListDigestRecordingsParams{
+		Since:         pgtype.Timestamptz{Time: since, Valid: true},
+		UserID:        recipient.ID,
+		MaxRecordings: maxDigestItems,
+	})
+	if err != nil {
+		return mail.Message{}, false, err
+	}
+	todos, err := s.digests.ListDigestTodos(ctx, db.ListDigestTodosParams{UserID: recipient.ID, MaxTodos: maxDigestItems})
+	if err != nil {
+		return mail.Message{}, false, err
+	}
+	if len(recordings) == 0 && len(todos) == 0 {
+		return mail.Message{}, false, nil
+	}
+
+	locale := i18n.Negotiate(recipient.Locale.String, "")
+	loc, err := time.LoadLocation(recipient.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	var text strings.Builder
+	text.WriteString(i18n.Sprintf(locale, "Hi %s,\n", recipient.FirstName))
+	if len(recordings) > 0 {
+		text.WriteString(i18n.Sprintf(locale, "\nMeetings processed since %s:\n", since.In(loc).Format("2006-01-02 15:04")))
+		for _, rec := range recordings {
+			name := strings.TrimSpace(rec.Name.String)
+			if name == "" {
+				name = i18n.T(locale, "Untitled meeting")
+			}
+			if rec.CreatedAt.Valid {
+				name += " (" + rec.CreatedAt.Time.In(loc).Format("2006-01-02") + ")"
+			}
+			text.WriteString("- " + name + "\n")
+			if link := s.recordingURL(rec.ID); link != "" {
+				text.WriteString("  " + link + "\n")
+			}
+		}
+		if more := recordings[0].Total - int64(len(recordings)); more > 0 {
+			text.WriteString(i18n.Sprintf(locale, "...and %d more\n", more))
+		}
+	}
+	if len(todos) > 0 {
+		text.WriteString(i18n.T(locale, "\nYour open todos:\n"))
+		for _, todo := range todos {
+			line := "- " + todo.Name
+			if todo.DueAt.Valid {
+				line += i18n.Sprintf(locale, " (due %s)", todo.DueAt.Time.In(loc).Format("2006-01-02"))
+			}
+			text.WriteString(line + "\n")
+		}
+		if more := todos[0].Total - int64(len(todos)); more > 0 {
+			text.WriteString(i18n.Sprintf(locale, "...and %d more\n", more))
+		}
+	}
+	if s.publicURL != "" {
+		text.WriteString("\n" + s.publicURL + "/\n")
+	}
+	subject := i18n.T(locale, "Your daily Secretary digest")
+	if frequency == digestWeekly {
+		subject = i18n.T(locale, "Your weekly Secretary digest")
+	}
+	return mail.Message{To: recipient.Email.String, Subject: subject, Text: text.String()}, true, nil
+}
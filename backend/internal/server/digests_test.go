@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/mail"
+)
+
+// fakeDigests has two daily readers: Ana with a meeting and todos, and Bo
+// with nothing new.
+type fakeDigests struct {
+	since time.Time
+}
+
+func (f *fakeDigests) ListDigestRecipients(_ context.Context, frequency string) ([]db.ListDigestRecipientsRow, error) {
+	if frequency != digestDaily {
+		return nil, nil
+	}
+	return []db.ListDigestRecipientsRow{
+		{ID: 1, FirstName: "Ana", Email: optionalText("ana@example.com"), Locale: optionalText("es"), Timezone: "America/Mexico_City"},
+		{ID: 2, FirstName: "Bo", Email: optionalText("bo@example.com"), Timezone: "UTC"},
+	}, nil
+}
+
+func (f *fakeDigests) ListDigestTodos(_ context.Context, arg db.ListDigestTodosParams) ([]db.ListDigestTodosRow, error) {
+	if arg.UserID != 1 {
+		return nil, nil
+	}
+	due := pgtype.Timestamptz{Time: time.Date(2026, 10, 20, 3, 0, 0, 0, time.UTC), Valid: true}
+	return []db.ListDigestTodosRow{
+		{ID: 4, Name: "Send the budget", DueAt: due, Total: 12},
+		{ID: 5, Name: "Book the venue", Total: 12},
+	}, nil
+}
+
+func (f *fakeDigests) ListDigestRecordings(_ context.Context, arg db.ListDigestRecordingsParams) ([]db.ListDigestRecordingsRow, error) {
+	f.since = arg.Since.Time
+	if arg.UserID != 1 {
+		return nil, nil
+	}
+	return []db.ListDigestRecordingsRow{{ID: 9, Name: optionalText("Planning"), CreatedAt: pgtype.Timestamptz{Time: time.Date(2026, 10, 17, 16, 0, 0, 0, time.UTC), Valid: true}, Total: 1}}, nil
+}
+
+func TestSendDigests(t *testing.T) {
+	digests := &fakeDigests{}
+	mailer := fakeMailer{sent: make(chan mail.Message, 2)}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureMail(mailer, "https://secretary.example.com")
+	srv.digests = digests
+
+	since := time.Date(2026, 10, 17, 7, 0, 0, 0, time.UTC)
+	if err := srv.sendDigests(context.Background(), digestDaily, since); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if len(mailer.sent) != 1 {
+		t.Fatalf("sent %d digests, want only Ana's", len(mailer.sent))
+	}
+	msg := <-mailer.sent
+	if msg.To != "ana@example.com" || msg.Subject != "Tu resumen diario de Secretary" {
+		t.Fatalf("message = %+v", msg)
+	}
+	for _, want := range []string{
+		"Reuniones procesadas desde el 2026-10-17 01:00:",
+		"- Planning (2026-10-17)\n  https://secretary.example.com/recordings/9\n",
+		// Due dates are shown in the reader's time zone.
+		"- Send the budget (vence el 2026-10-19)\n- Book the venue\n...y 10 más\n",
+	} {
+		if !strings.Contains(msg.Text, want) {
+			t.Errorf("digest lacks %q:\n%s", want, msg.Text)
+		}
+	}
+	if !digests.since.Equal(since) {
+		t.Fatalf("recordings since %s, want %s", digests.since, since)
+	}
+}
@@ -0,0 +1,266 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+const (
+	// uploadURLLifetime is how long a pre-signed upload URL works; long
+	// enough for a 2 GiB file on a slow connection.
+	uploadURLLifetime = time.Hour
+	// uploadConfirmGrace is how long after the URL expires an upload can
+	// still be confirmed, for uploads that started just before.
+	uploadConfirmGrace = time.Hour
+)
+
+// UploadStore holds direct uploads between GetUploadURL and ConfirmUpload.
+type UploadStore interface {
+	CreatePendingUpload(ctx context.Context, arg db.CreatePendingUploadParams) (int32, error)
+	GetPendingUpload(ctx context.Context, arg db.GetPendingUploadParams) (db.GetPendingUploadRow, error)
+	ConfirmPendingUpload(ctx context.Context, arg db.ConfirmPendingUploadParams) (db.ConfirmPendingUploadRow, error)
+	DeletePendingUpload(ctx context.Context, id int32) error
+	DeleteExpiredPendingUploads(ctx context.Context) ([]string, error)
+}
+
+// GetUploadURL reserves a storage key for an audio file and signs a URL
+// the client PUTs it to. The signature pins the content type and size, so
+// quotas checked here hold for what is uploaded. Audio the organization
+// already has, by its SHA-256, isn't uploaded again.
+func (s *Server) GetUploadURL(ctx context.Context, req *connect.Request[secretaryv1.GetUploadURLRequest]) (*connect.Response[secretaryv1.GetUploadURLResponse], error) {
+	presigner, ok := storage.AsPresigner(s.storage)
+	if !ok {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("audio storage doesn't support direct uploads; upload through /api/recordings/upload"))
+	}
+	contentType := strings.ToLower(strings.TrimSpace(req.Msg.ContentType))
+	ext, ok := audioExtension(contentType, req.Msg.Filename)
+	if !ok {
+		return nil, apierr.InvalidField("content_type", "unsupported audio type")
+	}
+	if req.Msg.SizeBytes > maxAudioUploadBytes {
+		return nil, apierr.InvalidField("size_bytes", "audio file too large")
+	}
+	sum, _ := parseSHA256(req.Msg.Sha256)
+	if sum != "" {
+		duplicate, found, err := s.findDuplicateAudio(ctx, sum)
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to check for duplicates")
+		}
+		if found {
+			return connect.NewResponse(&secretaryv1.GetUploadURLResponse{DuplicateOf: duplicateRecording(duplicate)}), nil
+		}
+	}
+	userID := currentUserID(ctx)
+	owner := pgtype.Int4{Int32: int32(userID), Valid: userID > 0}
+	if err := s.checkUploadQuota(ctx, owner); err != nil {
+		return nil, err
+	}
+	if err := s.checkQuota(ctx, owner, usageStorageBytes, req.Msg.SizeBytes); err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Msg.Name)
+	if name == "" {
+		name = "Upload " + time.Now().UTC().Format("2006-01-02 15:04")
+	}
+
+	key, err := newAudioKey(ext)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to create upload")
+	}
+	url, headers, err := presigner.PresignPut(key, contentType, req.Msg.SizeBytes, uploadURLLifetime)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to sign upload URL")
+	}
+	expires := time.Now().Add(uploadURLLifetime)
+	id, err := s.uploads.CreatePendingUpload(ctx, db.CreatePendingUploadParams{
+		AudioKey:        key,
+		Name:            name,
+		ContentType:     contentType,
+		SizeBytes:       req.Msg.SizeBytes,
+		CreatedByUserID: owner,
+		ExpiresAt:       pgtype.Timestamptz{Time: expires.Add(uploadConfirmGrace), Valid: true},
+		Sha256:          pgtype.Text{String: sum, Valid: sum != ""},
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to create upload")
+	}
+
+	resp := &secretaryv1.GetUploadURLResponse{
+		UploadId:  int64(id),
+		Url:       url,
+		Method:    "PUT",
+		Headers:   map[string]string{},
+		ExpiresAt: expires.UTC().Format(time.RFC3339),
+	}
+	for name := range headers {
+		resp.Headers[name] = headers.Get(name)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ConfirmUpload checks that the file arrived intact and creates its
+// recording, unless the same audio already has one.
+func (s *Server) ConfirmUpload(ctx context.Context, req *connect.Request[secretaryv1.ConfirmUploadRequest]) (*connect.Response[secretaryv1.ConfirmUploadResponse], error) {
+	presigner, ok := storage.AsPresigner(s.storage)
+	if !ok {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("audio storage doesn't support direct uploads"))
+	}
+	resolved, err := s.resolveProcessingSettings(ctx, "settings.language", req.Msg.Settings)
+	if err != nil {
+		return nil, err
+	}
+	settings, template, err := encodeProcessingSettings(resolved)
+	if err != nil {
+		return nil, err
+	}
+	userID := currentUserID(ctx)
+	owner := pgtype.Int4{Int32: int32(userID), Valid: userID > 0}
+	upload, err := s.uploads.GetPendingUpload(ctx, db.GetPendingUploadParams{ID: int32(req.Msg.UploadId), CreatedByUserID: owner})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("upload not found or expired"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to load upload")
+	}
+	size, err := presigner.Stat(ctx, upload.AudioKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the file hasn't been uploaded yet"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to check upload")
+	}
+	if size != upload.SizeBytes {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the uploaded file doesn't match the size it was announced with"))
+	}
+	sum, err := s.hashObject(ctx, upload.AudioKey)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to check upload")
+	}
+	if upload.Sha256.Valid && sum != upload.Sha256.String {
+		_ = s.storage.Delete(ctx, upload.AudioKey)
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the uploaded file doesn't match its sha256; upload it again"))
+	}
+	duplicate, found, err := s.findDuplicateAudio(ctx, sum)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to check for duplicates")
+	}
+	if found {
+		_ = s.storage.Delete(ctx, upload.AudioKey)
+		if err := s.uploads.DeletePendingUpload(ctx, upload.ID); err != nil {
+			return nil, apierr.Wrap(err, "failed to discard upload")
+		}
+		return connect.NewResponse(&secretaryv1.ConfirmUploadResponse{
+			Recording: duplicateRecording(duplicate),
+			SizeBytes: size,
+			Duplicate: true,
+		}), nil
+	}
+	if err := s.checkQuota(ctx, owner, usageStorageBytes, size); err != nil {
+		return nil, err
+	}
+
+	row, err := s.uploads.ConfirmPendingUpload(ctx, db.ConfirmPendingUploadParams{
+		ID:                 upload.ID,
+		AudioBytes:         size,
+		AudioSha256:        sum,
+		ScanStatus:         s.newAudioScanStatus(),
+		PromptTemplateID:   template,
+		ProcessingSettings: settings,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("upload not found or expired"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to create recording")
+	}
+	s.recordingCache.invalidate()
+	s.queueAudioScan()
+	if s.encryption != nil && s.encryption.audio != nil {
+		// The object was written past the encrypting store.
+		go s.sealUploadedAudio(upload.AudioKey)
+	}
+
+	return connect.NewResponse(&secretaryv1.ConfirmUploadResponse{
+		Recording: &secretaryv1.Recording{
+			Id:        int64(row.ID),
+			Name:      row.Name.String,
+			CreatedAt: formatTime(row.CreatedAt),
+			Status:    mapRecordingStatus(recordingUploaded),
+		},
+		SizeBytes: size,
+	}), nil
+}
+
+func duplicateRecording(row db.FindRecordingByAudioHashRow) *secretaryv1.Recording {
+	return &secretaryv1.Recording{
+		Id:        int64(row.ID),
+		Name:      row.Name.String,
+		CreatedAt: formatTime(row.CreatedAt),
+		Status:    mapRecordingStatus(row.Status),
+	}
+}
+
+// sealUploadedAudio encrypts a directly uploaded object. Failures are left
+// to the key rotation job.
+func (s *Server) sealUploadedAudio(key string) {
+	ctx, cancel := context.WithTimeout(context.Background(), uploadURLLifetime)
+	defer cancel()
+	if _, err := s.encryption.audio.Rekey(ctx, key); err != nil {
+		log.Printf("direct upload: failed to encrypt %s: %v", key, err)
+	}
+}
+
+// StartUploadSweep deletes direct uploads that were never confirmed, with
+// any file uploaded for them, and expired resumable uploads every
+// interval, while this process leads. It returns at once; the sweep stops
+// with ctx.
+func (s *Server) StartUploadSweep(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if !s.isLeader() {
+				continue
+			}
+			if err := s.sweepUploads(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("upload sweep: %v", err)
+			}
+			if err := s.sweepResumableUploads(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("upload sweep: %v", err)
+			}
+		}
+	}()
+}
+
+func (s *Server) sweepUploads(ctx context.Context) error {
+	keys, err := s.uploads.DeleteExpiredPendingUploads(ctx)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := s.storage.Delete(ctx, key); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			log.Printf("upload sweep: failed to delete %s: %v", key, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// presigningStore signs fake URLs in front of a local store.
+type presigningStore struct {
+	*storage.Local
+}
+
+func (p presigningStore) PresignPut(key, contentType string, size int64, _ time.Duration) (string, http.Header, error) {
+	return "https://bucket.example/" + key + "?X-Amz-Signature=sig", http.Header{"Content-Type": {contentType}}, nil
+}
+
+func (p presigningStore) Stat(ctx context.Context, key string) (int64, error) {
+	rc, err := p.Open(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+	return io.Copy(io.Discard, rc)
+}
+
+type memoryUploads struct {
+	pending    map[int32]db.GetPendingUploadRow
+	recordings []db.ConfirmPendingUploadParams
+}
+
+func (m *memoryUploads) CreatePendingUpload(_ context.Context, arg db.CreatePendingUploadParams) (int32, error) {
+	id := int32(len(m.pending) + 1)
+	m.pending[id] = db.GetPendingUploadRow{ID: id, AudioKey: arg.AudioKey, Name: arg.Name, ContentType: arg.ContentType, SizeBytes: arg.SizeBytes, CreatedByUserID: arg.CreatedByUserID, ExpiresAt: arg.ExpiresAt, Sha256: arg.Sha256}
+	return id, nil
+}
+
+func (m *memoryUploads) GetPendingUpload(_ context.Context, arg db.GetPendingUploadParams) (db.GetPendingUploadRow, error) {
+	row, ok := m.pending[arg.ID]
+	if !ok || row.CreatedByUserID != arg.CreatedByUserID {
+		return db.GetPendingUploadRow{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (m *memoryUploads) ConfirmPendingUpload(_ context.Context, arg db.ConfirmPendingUploadParams) (db.ConfirmPendingUploadRow, error) {
+	row, ok := m.pending[arg.ID]
+	if !ok {
+		return db.ConfirmPendingUploadRow{}, pgx.ErrNoRows
+	}
+	delete(m.pending, arg.ID)
+	m.recordings = append(m.recordings, arg)
+	return db.ConfirmPendingUploadRow{ID: int32(len(m.recordings)), Name: pgtype.Text{String: row.Name, Valid: true}}, nil
+}
+
+func (m *memoryUploads) DeletePendingUpload(_ context.Context, id int32) error {
+	delete(m.pending, id)
+	return nil
+}
+
+func (m *memoryUploads) DeleteExpiredPendingUploads(context.Context) ([]string, error) {
+	var keys []string
+	for id, row := range m.pending {
+		if row.ExpiresAt.Time.Before(time.Now()) {
+			keys = append(keys, row.AudioKey)
+			delete(m.pending, id)
+		}
+	}
+	return keys, nil
+}
+
+func TestDirectUpload(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	local, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	uploads := &memoryUploads{pending: map[int32]db.GetPendingUploadRow{}}
+	srv.uploads = uploads
+	srv.recordings = hashedRecordings{}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 7})
+	request := &secretaryv1.GetUploadURLRequest{Filename: "standup.mp3", ContentType: "audio/mpeg", SizeBytes: 3, Name: "Standup"}
+
+	srv.ConfigureStorage(local)
+	if _, err := srv.GetUploadURL(ctx, connect.NewRequest(request)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("local storage: %v", err)
+	}
+
+	srv.ConfigureStorage(presigningStore{local})
+	resp, err := srv.GetUploadURL(ctx, connect.NewRequest(request))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Msg.Method != "PUT" || !strings.HasPrefix(resp.Msg.Url, "https://bucket.example/recordings/") || resp.Msg.Headers["Content-Type"] != "audio/mpeg" {
+		t.Fatalf("response = %+v", resp.Msg)
+	}
+	key := uploads.pending[int32(resp.Msg.UploadId)].AudioKey
+	confirm := connect.NewRequest(&secretaryv1.ConfirmUploadRequest{UploadId: resp.Msg.UploadId})
+
+	if _, err := srv.ConfirmUpload(ctx, confirm); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("confirm before upload: %v", err)
+	}
+	if _, err := local.Put(ctx, key, strings.NewReader("ID3")); err != nil {
+		t.Fatal(err)
+	}
+	other := withPrincipal(context.Background(), Principal{UserID: 8})
+	if _, err := srv.ConfirmUpload(other, confirm); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("confirm by another user: %v", err)
+	}
+	confirmed, err := srv.ConfirmUpload(ctx, confirm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if confirmed.Msg.Recording.Name != "Standup" || confirmed.Msg.SizeBytes != 3 || confirmed.Msg.Recording.Status != secretaryv1.RecordingStatus_RECORDING_STATUS_UPLOADED {
+		t.Fatalf("confirmed = %+v", confirmed.Msg)
+	}
+	if _, err := srv.ConfirmUpload(ctx, confirm); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("second confirm: %v", err)
+	}
+	if len(uploads.recordings) != 1 || uploads.recordings[0].AudioSha256 != sha256Hex("ID3") {
+		t.Fatalf("recordings = %+v", uploads.recordings)
+	}
+
+	request.ContentType = "text/plain"
+	if _, err := srv.GetUploadURL(ctx, connect.NewRequest(request)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("unsupported type: %v", err)
+	}
+}
+
+func TestDirectUploadChecksum(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	local, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureStorage(presigningStore{local})
+	uploads := &memoryUploads{pending: map[int32]db.GetPendingUploadRow{}}
+	srv.uploads = uploads
+	srv.recordings = hashedRecordings{byHash: map[string]db.FindRecordingByAudioHashRow{
+		sha256Hex("ID3"): {ID: 12, Name: pgtype.Text{String: "Standup", Valid: true}, Status: recordingReady},
+	}}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 7})
+
+	known, err := srv.GetUploadURL(ctx, connect.NewRequest(&secretaryv1.GetUploadURLRequest{ContentType: "audio/mpeg", SizeBytes: 3, Sha256: sha256Hex("ID3")}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if known.Msg.DuplicateOf.GetId() != 12 || known.Msg.Url != "" || len(uploads.pending) != 0 {
+		t.Fatalf("known audio = %+v", known.Msg)
+	}
+
+	// Announced with one checksum, uploaded with other content.
+	resp, err := srv.GetUploadURL(ctx, connect.NewRequest(&secretaryv1.GetUploadURLRequest{ContentType: "audio/mpeg", SizeBytes: 3, Sha256: sha256Hex("OGG")}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := uploads.pending[int32(resp.Msg.UploadId)].AudioKey
+	if _, err := local.Put(ctx, key, strings.NewReader("MP4")); err != nil {
+		t.Fatal(err)
+	}
+	confirm := connect.NewRequest(&secretaryv1.ConfirmUploadRequest{UploadId: resp.Msg.UploadId})
+	if _, err := srv.ConfirmUpload(ctx, confirm); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("mismatched upload: %v", err)
+	}
+	if _, err := local.Open(ctx, key); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("mismatched upload kept: %v", err)
+	}
+
+	// Uploaded without a checksum; only confirming finds the duplicate.
+	if _, err := local.Put(ctx, key, strings.NewReader("ID3")); err != nil {
+		t.Fatal(err)
+	}
+	uploads.pending[int32(resp.Msg.UploadId)] = db.GetPendingUploadRow{ID: int32(resp.Msg.UploadId), AudioKey: key, SizeBytes: 3, CreatedByUserID: pgtype.Int4{Int32: 7, Valid: true}}
+	confirmed, err := srv.ConfirmUpload(ctx, confirm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !confirmed.Msg.Duplicate || confirmed.Msg.Recording.Id != 12 || len(uploads.recordings) != 0 || len(uploads.pending) != 0 {
+		t.Fatalf("duplicate = %+v, recordings %v", confirmed.Msg, uploads.recordings)
+	}
+	if _, err := local.Open(ctx, key); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("duplicate upload kept: %v", err)
+	}
+}
+
+func TestSweepUploadsDeletesExpired(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	local, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureStorage(local)
+	expired := pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}
+	srv.uploads = &memoryUploads{pending: map[int32]db.GetPendingUploadRow{
+		1: {ID: 1, AudioKey: "recordings/2026/10/uploaded.mp3", ExpiresAt: expired},
+		2: {ID: 2, AudioKey: "recordings/2026/10/never-uploaded.mp3", ExpiresAt: expired},
+	}}
+	if _, err := local.Put(context.Background(), "recordings/2026/10/uploaded.mp3", strings.NewReader("ID3")); err != nil {
+		t.Fatal(err)
+	}
+	if err := srv.sweepUploads(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := local.Open(context.Background(), "recordings/2026/10/uploaded.mp3"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expired upload kept: %v", err)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
 	"github.com/mvult/secretary/backend/internal/db/gen"
 )
 
@@ -47,9 +48,9 @@ func (s *Server) ListWorkspaces(ctx context.Context, _ *connect.Request[secretar
 		return nil, err
 	}
 
-	rows, err := s.queries.ListWorkspacesByUser(ctx, int32(userID))
+	rows, err := s.readQueries().ListWorkspacesByUser(ctx, int32(userID))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list workspaces"))
+		return nil, apierr.Wrap(err, "failed to list workspaces")
 	}
 
 	workspaces := make([]*secretaryv1.Workspace, 0, len(rows))
@@ -73,14 +74,14 @@ func (s *Server) CreateWorkspace(ctx context.Context, req *connect.Request[secre
 
 	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to begin workspace transaction"))
+		return nil, apierr.Wrap(err, "failed to begin workspace transaction")
 	}
 	defer tx.Rollback(ctx)
 
 	qtx := s.queries.WithTx(tx)
 	workspace, err := qtx.CreateWorkspace(ctx, name)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create workspace"))
+		return nil, apierr.Wrap(err, "failed to create workspace")
 	}
 
 	err = qtx.AddWorkspaceUser(ctx, db.AddWorkspaceUserParams{
@@ -89,11 +90,11 @@ func (s *Server) CreateWorkspace(ctx context.Context, req *connect.Request[secre
 		Role:        pgtype.Text{String: "owner", Valid: true},
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to add workspace membership"))
+		return nil, apierr.Wrap(err, "failed to add workspace membership")
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to commit workspace transaction"))
+		return nil, apierr.Wrap(err, "failed to commit workspace transaction")
 	}
 
 	return connect.NewResponse(&secretaryv1.CreateWorkspaceResponse{Workspace: workspaceToProto(workspace)}), nil
@@ -114,14 +115,15 @@ func (s *Server) ListDocuments(ctx context.Context, req *connect.Request[secreta
 		return nil, err
 	}
 
-	directories, err := s.queries.ListDirectoriesByWorkspace(ctx, int32(workspaceID))
+	reads := s.readQueries()
+	directories, err := reads.ListDirectoriesByWorkspace(ctx, int32(workspaceID))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list directories"))
+		return nil, apierr.Wrap(err, "failed to list directories")
 	}
 
-	docs, err := s.queries.ListDocumentsByWorkspace(ctx, int32(workspaceID))
+	docs, err := reads.ListDocumentsByWorkspace(ctx, int32(workspaceID))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list documents"))
+		return nil, apierr.Wrap(err, "failed to list documents")
 	}
 
 	directoryResult := make([]*secretaryv1.Directory, 0, len(directories))
@@ -131,11 +133,11 @@ func (s *Server) ListDocuments(ctx context.Context, req *connect.Request[secreta
 
 	result := make([]*secretaryv1.Document, 0, len(docs))
 	for _, doc := range docs {
-		blocks, err := s.queries.ListBlocksByDocument(ctx, doc.ID)
+		blocks, err := reads.ListBlocksByDocument(ctx, doc.ID)
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list document blocks"))
+			return nil, apierr.Wrap(err, "failed to list document blocks")
 		}
-		blockTodoStatuses, err := s.loadBlockTodoStatuses(ctx, s.queries, blocks)
+		blockTodoStatuses, err := s.loadBlockTodoStatuses(ctx, reads, blocks)
 		if err != nil {
 			return nil, err
 		}
@@ -172,20 +174,21 @@ func (s *Server) ListDocumentHistory(ctx context.Context, req *connect.Request[s
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("document_id is required"))
 	}
 
-	doc, err := s.queries.GetDocument(ctx, int32(req.Msg.DocumentId))
+	reads := s.readQueries()
+	doc, err := reads.GetDocument(ctx, int32(req.Msg.DocumentId))
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("document not found"))
 	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to fetch document"))
+		return nil, apierr.Wrap(err, "failed to fetch document")
 	}
 	if err := s.ensureWorkspaceAccess(ctx, doc.WorkspaceID, int32(userID)); err != nil {
 		return nil, err
 	}
 
-	history, err := s.queries.ListDocumentHistoryByDocument(ctx, doc.ID)
+	history, err := reads.ListDocumentHistoryByDocument(ctx, doc.ID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list document history"))
+		return nil, apierr.Wrap(err, "failed to list document history")
 	}
 
 	result := make([]*secretaryv1.DocumentHistoryEntry, 0, len(history))
@@ -205,20 +208,21 @@ func (s *Server) GetDocumentHistoryEntry(ctx context.Context, req *connect.Reque
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
 	}
 
-	entry, err := s.queries.GetDocumentHistoryEntry(ctx, req.Msg.Id)
+	reads := s.readQueries()
+	entry, err := reads.GetDocumentHistoryEntry(ctx, req.Msg.Id)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("document history entry not found"))
 	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to fetch document history entry"))
+		return nil, apierr.Wrap(err, "failed to fetch document history entry")
 	}
 
-	doc, err := s.queries.GetDocument(ctx, entry.DocumentID)
+	doc, err := reads.GetDocument(ctx, entry.DocumentID)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("document not found"))
 	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to fetch document"))
+		return nil, apierr.Wrap(err, "failed to fetch document")
 	}
 	if err := s.ensureWorkspaceAccess(ctx, doc.WorkspaceID, int32(userID)); err != nil {
 		return nil, err
@@ -253,7 +257,7 @@ func (s *Server) CreateDirectory(ctx context.Context, req *connect.Request[secre
 		Name:        name,
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create directory"))
+		return nil, apierr.Wrap(err, "failed to create directory")
 	}
 	return connect.NewResponse(&secretaryv1.CreateDirectoryResponse{Directory: directoryToProto(directory)}), nil
 }
@@ -276,7 +280,7 @@ func (s *Server) UpdateDirectory(ctx context.Context, req *connect.Request[secre
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("directory not found"))
 	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to fetch directory"))
+		return nil, apierr.Wrap(err, "failed to fetch directory")
 	}
 	if err := s.ensureWorkspaceAccess(ctx, directory.WorkspaceID, int32(userID)); err != nil {
 		return nil, err
@@ -289,7 +293,7 @@ func (s *Server) UpdateDirectory(ctx context.Context, req *connect.Request[secre
 	}
 	updatedDirectory, err := s.queries.UpdateDirectory(ctx, db.UpdateDirectoryParams{ID: directory.ID, Name: name, ParentID: parentID})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update directory"))
+		return nil, apierr.Wrap(err, "failed to update directory")
 	}
 	return connect.NewResponse(&secretaryv1.UpdateDirectoryResponse{Directory: directoryToProto(updatedDirectory)}), nil
 }
@@ -307,7 +311,7 @@ func (s *Server) DeleteDirectory(ctx context.Context, req *connect.Request[secre
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("directory not found"))
 	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to fetch directory"))
+		return nil, apierr.Wrap(err, "failed to fetch directory")
 	}
 	if err := s.ensureWorkspaceAccess(ctx, directory.WorkspaceID, int32(userID)); err != nil {
 		return nil, err
@@ -315,20 +319,20 @@ func (s *Server) DeleteDirectory(ctx context.Context, req *connect.Request[secre
 	directoryID := pgtype.Int4{Int32: directory.ID, Valid: true}
 	childCount, err := s.queries.CountChildDirectories(ctx, directoryID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to check child directories"))
+		return nil, apierr.Wrap(err, "failed to check child directories")
 	}
 	if childCount > 0 {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("directory is not empty"))
 	}
 	documentCount, err := s.queries.CountDocumentsInDirectory(ctx, directoryID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to check directory documents"))
+		return nil, apierr.Wrap(err, "failed to check directory documents")
 	}
 	if documentCount > 0 {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("directory is not empty"))
 	}
 	if err := s.queries.DeleteDirectory(ctx, directory.ID); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to delete directory"))
+		return nil, apierr.Wrap(err, "failed to delete directory")
 	}
 	return connect.NewResponse(&secretaryv1.DeleteDirectoryResponse{}), nil
 }
@@ -361,7 +365,7 @@ func (s *Server) SaveDocument(ctx context.Context, req *connect.Request[secretar
 
 	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to begin document transaction"))
+		return nil, apierr.Wrap(err, "failed to begin document transaction")
 	}
 	defer tx.Rollback(ctx)
 
@@ -374,7 +378,7 @@ func (s *Server) SaveDocument(ctx context.Context, req *connect.Request[secretar
 			return nil, connect.NewError(connect.CodeNotFound, errors.New("document not found"))
 		}
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.New("failed to fetch document"))
+			return nil, apierr.Wrap(err, "failed to fetch document")
 		}
 		if err := s.ensureWorkspaceAccessWithQueries(ctx, qtx, existingDoc.WorkspaceID, int32(userID)); err != nil {
 			return nil, err
@@ -394,7 +398,7 @@ func (s *Server) SaveDocument(ctx context.Context, req *connect.Request[secretar
 			JournalDate: journalDate,
 		})
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update document"))
+			return nil, apierr.Wrap(err, "failed to update document")
 		}
 	} else {
 		if incoming.WorkspaceId <= 0 {
@@ -411,7 +415,7 @@ func (s *Server) SaveDocument(ctx context.Context, req *connect.Request[secretar
 		if kind == "journal" && journalDate.Valid {
 			existingJournal, err := findWorkspaceJournalByDate(ctx, qtx, workspaceID, journalDate)
 			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, errors.New("failed to look up existing journal"))
+				return nil, apierr.Wrap(err, "failed to look up existing journal")
 			}
 			if existingJournal != nil {
 				savedDoc, err = qtx.UpdateDocument(ctx, db.UpdateDocumentParams{
@@ -422,7 +426,7 @@ func (s *Server) SaveDocument(ctx context.Context, req *connect.Request[secretar
 					JournalDate: journalDate,
 				})
 				if err != nil {
-					return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update existing journal"))
+					return nil, apierr.Wrap(err, "failed to update existing journal")
 				}
 			} else {
 				savedDoc, err = qtx.CreateDocument(ctx, db.CreateDocumentParams{
@@ -433,7 +437,7 @@ func (s *Server) SaveDocument(ctx context.Context, req *connect.Request[secretar
 					JournalDate: journalDate,
 				})
 				if err != nil {
-					return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create document"))
+					return nil, apierr.Wrap(err, "failed to create document")
 				}
 			}
 		} else {
@@ -446,14 +450,14 @@ func (s *Server) SaveDocument(ctx context.Context, req *connect.Request[secretar
 				JournalDate: journalDate,
 			})
 			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create document"))
+				return nil, apierr.Wrap(err, "failed to create document")
 			}
 		}
 	}
 
 	existingBlocks, err := qtx.ListBlocksByDocument(ctx, savedDoc.ID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to fetch existing blocks"))
+		return nil, apierr.Wrap(err, "failed to fetch existing blocks")
 	}
 	existingByID := make(map[int32]db.Block, len(existingBlocks))
 	for _, block := range existingBlocks {
@@ -559,7 +563,7 @@ func (s *Server) SaveDocument(ctx context.Context, req *connect.Request[secretar
 			continue
 		}
 		if err := deleteTodoWithHistory(ctx, qtx, block.TodoID.Int32, userID); err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.New("failed to delete todo for removed block"))
+			return nil, apierr.Wrap(err, "failed to delete todo for removed block")
 		}
 	}
 
@@ -579,16 +583,16 @@ func (s *Server) SaveDocument(ctx context.Context, req *connect.Request[secretar
 	}
 
 	if err := deleteMissingBlocks(ctx, tx, savedDoc.ID, keptIDs); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to delete removed blocks"))
+		return nil, apierr.Wrap(err, "failed to delete removed blocks")
 	}
 
 	finalDoc, err := qtx.GetDocument(ctx, savedDoc.ID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to reload document"))
+		return nil, apierr.Wrap(err, "failed to reload document")
 	}
 	finalBlocks, err := qtx.ListBlocksByDocument(ctx, savedDoc.ID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to reload blocks"))
+		return nil, apierr.Wrap(err, "failed to reload blocks")
 	}
 	blockTodoStatuses, err := s.loadBlockTodoStatuses(ctx, qtx, finalBlocks)
 	if err != nil {
@@ -599,7 +603,7 @@ func (s *Server) SaveDocument(ctx context.Context, req *connect.Request[secretar
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to commit document transaction"))
+		return nil, apierr.Wrap(err, "failed to commit document transaction")
 	}
 
 	clientKey := incoming.ClientKey
@@ -630,7 +634,7 @@ func (s *Server) DeleteDocument(ctx context.Context, req *connect.Request[secret
 	}
 
 	if err := s.queries.DeleteDocument(ctx, doc.ID); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to delete document"))
+		return nil, apierr.Wrap(err, "failed to delete document")
 	}
 
 	return connect.NewResponse(&secretaryv1.DeleteDocumentResponse{}), nil
@@ -642,14 +646,14 @@ func (s *Server) loadAuthorizedDocument(ctx context.Context, documentID int32, u
 		return db.Document{}, nil, connect.NewError(connect.CodeNotFound, errors.New("document not found"))
 	}
 	if err != nil {
-		return db.Document{}, nil, connect.NewError(connect.CodeInternal, errors.New("failed to fetch document"))
+		return db.Document{}, nil, apierr.Wrap(err, "failed to fetch document")
 	}
 	if err := s.ensureWorkspaceAccess(ctx, doc.WorkspaceID, userID); err != nil {
 		return db.Document{}, nil, err
 	}
 	blocks, err := s.queries.ListBlocksByDocument(ctx, documentID)
 	if err != nil {
-		return db.Document{}, nil, connect.NewError(connect.CodeInternal, errors.New("failed to fetch document blocks"))
+		return db.Document{}, nil, apierr.Wrap(err, "failed to fetch document blocks")
 	}
 	return doc, blocks, nil
 }
@@ -667,14 +671,14 @@ func (s *Server) ensureWorkspaceAccessWithQueries(ctx context.Context, queries *
 		return connect.NewError(connect.CodePermissionDenied, errors.New("workspace access denied"))
 	}
 	if err != nil {
-		return connect.NewError(connect.CodeInternal, errors.New("failed to validate workspace access"))
+		return apierr.Wrap(err, "failed to validate workspace access")
 	}
 	return nil
 }
 
 func requireUserID(ctx context.Context) (int64, error) {
-	userID, ok := ctx.Value(userIdKey).(int64)
-	if !ok || userID == 0 {
+	userID := currentUserID(ctx)
+	if userID == 0 {
 		return 0, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
 	}
 	return userID, nil
@@ -782,7 +786,7 @@ func (s *Server) loadBlockTodoStatuses(ctx context.Context, queries *db.Queries,
 			continue
 		}
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.New("failed to load block todo"))
+			return nil, apierr.Wrap(err, "failed to load block todo")
 		}
 
 		statuses[block.ID] = todo.Status.String
@@ -834,7 +838,7 @@ func validateDocumentDirectory(ctx context.Context, queries *db.Queries, workspa
 		return connect.NewError(connect.CodeInvalidArgument, errors.New("directory not found"))
 	}
 	if err != nil {
-		return connect.NewError(connect.CodeInternal, errors.New("failed to validate directory"))
+		return apierr.Wrap(err, "failed to validate directory")
 	}
 	if directory.WorkspaceID != workspaceID {
 		return connect.NewError(connect.CodeInvalidArgument, errors.New("directory must belong to the same workspace as the document"))
@@ -851,7 +855,7 @@ func validateDirectoryParent(ctx context.Context, queries *db.Queries, workspace
 		return connect.NewError(connect.CodeInvalidArgument, errors.New("parent directory not found"))
 	}
 	if err != nil {
-		return connect.NewError(connect.CodeInternal, errors.New("failed to validate parent directory"))
+		return apierr.Wrap(err, "failed to validate parent directory")
 	}
 	if parent.WorkspaceID != workspaceID {
 		return connect.NewError(connect.CodeInvalidArgument, errors.New("parent directory must belong to the same workspace"))
@@ -879,7 +883,7 @@ func validateDirectoryMove(ctx context.Context, queries *db.Queries, directoryID
 			return connect.NewError(connect.CodeInvalidArgument, errors.New("parent directory not found"))
 		}
 		if err != nil {
-			return connect.NewError(connect.CodeInternal, errors.New("failed to validate directory move"))
+			return apierr.Wrap(err, "failed to validate directory move")
 		}
 		if !directory.ParentID.Valid {
 			break
@@ -973,7 +977,7 @@ func reconcileBlockDocumentLinks(ctx context.Context, queries *db.Queries, sourc
 	}
 
 	if err := queries.DeleteBlockDocumentLinksByBlock(ctx, block.ID); err != nil {
-		return connect.NewError(connect.CodeInternal, errors.New("failed to clear block document links"))
+		return apierr.Wrap(err, "failed to clear block document links")
 	}
 
 	for _, targetID := range targetIDs {
@@ -982,7 +986,7 @@ func reconcileBlockDocumentLinks(ctx context.Context, queries *db.Queries, sourc
 			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("linked document %d not found", targetID))
 		}
 		if err != nil {
-			return connect.NewError(connect.CodeInternal, errors.New("failed to validate linked document"))
+			return apierr.Wrap(err, "failed to validate linked document")
 		}
 		if targetDocument.WorkspaceID != sourceDocument.WorkspaceID {
 			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("linked document %d must belong to the same workspace", targetID))
@@ -992,7 +996,7 @@ func reconcileBlockDocumentLinks(ctx context.Context, queries *db.Queries, sourc
 			BlockID:          block.ID,
 			TargetDocumentID: targetID,
 		}); err != nil {
-			return connect.NewError(connect.CodeInternal, errors.New("failed to save block document link"))
+			return apierr.Wrap(err, "failed to save block document link")
 		}
 	}
 
@@ -1002,12 +1006,12 @@ func reconcileBlockDocumentLinks(ctx context.Context, queries *db.Queries, sourc
 func maybeCreateDocumentHistorySnapshot(ctx context.Context, qtx *db.Queries, doc db.Document, blocks []db.Block, blockTodoStatuses map[int32]string) error {
 	snapshotBytes, contentHash, err := buildDocumentHistorySnapshot(doc, blocks, blockTodoStatuses)
 	if err != nil {
-		return connect.NewError(connect.CodeInternal, errors.New("failed to build document history snapshot"))
+		return apierr.Wrap(err, "failed to build document history snapshot")
 	}
 
 	latestEntry, err := qtx.GetLatestDocumentHistoryEntryByDocument(ctx, doc.ID)
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
-		return connect.NewError(connect.CodeInternal, errors.New("failed to load latest document history"))
+		return apierr.Wrap(err, "failed to load latest document history")
 	}
 	if err == nil && latestEntry.ContentHash == contentHash {
 		return nil
@@ -1022,7 +1026,7 @@ func maybeCreateDocumentHistorySnapshot(ctx context.Context, qtx *db.Queries, do
 		CapturedAt_2: pgtype.Timestamptz{Time: dayEnd, Valid: true},
 	})
 	if todayErr != nil && !errors.Is(todayErr, pgx.ErrNoRows) {
-		return connect.NewError(connect.CodeInternal, errors.New("failed to load document history for day"))
+		return apierr.Wrap(todayErr, "failed to load document history for day")
 	}
 
 	reason := "periodic"
@@ -1047,14 +1051,14 @@ func maybeCreateDocumentHistorySnapshot(ctx context.Context, qtx *db.Queries, do
 		SnapshotJson:  snapshotBytes,
 		CapturedAt:    pgtype.Timestamptz{Time: now, Valid: true},
 	}); err != nil {
-		return connect.NewError(connect.CodeInternal, errors.New("failed to create document history snapshot"))
+		return apierr.Wrap(err, "failed to create document history snapshot")
 	}
 
 	if err := qtx.DeleteOldDocumentHistoryByDocument(ctx, db.DeleteOldDocumentHistoryByDocumentParams{
 		DocumentID: doc.ID,
 		CapturedAt: pgtype.Timestamptz{Time: now.Add(-documentHistoryRetention), Valid: true},
 	}); err != nil {
-		return connect.NewError(connect.CodeInternal, errors.New("failed to prune document history"))
+		return apierr.Wrap(err, "failed to prune document history")
 	}
 
 	return nil
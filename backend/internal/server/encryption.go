@@ -0,0 +1,531 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/envelope"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// DataKeyStore holds the wrapped data keys and the queries that move
+// transcripts, and the text copied from them, onto the active one.
+type DataKeyStore interface {
+	GetOrgID(ctx context.Context) (string, error)
+	ListDataKeys(ctx context.Context, orgID string) ([]db.DataKey, error)
+	GetDataKey(ctx context.Context, id int32) (db.DataKey, error)
+	CreateDataKey(ctx context.Context, arg db.CreateDataKeyParams) (db.DataKey, error)
+	RewrapDataKey(ctx context.Context, arg db.RewrapDataKeyParams) error
+	ListTranscriptsToSeal(ctx context.Context, arg db.ListTranscriptsToSealParams) ([]db.ListTranscriptsToSealRow, error)
+	SealRecordingTranscript(ctx context.Context, arg db.SealRecordingTranscriptParams) (int64, error)
+	ListTranslationsToSeal(ctx context.Context, arg db.ListTranslationsToSealParams) ([]db.ListTranslationsToSealRow, error)
+	SealRecordingTranslation(ctx context.Context, arg db.SealRecordingTranslationParams) (int64, error)
+	ListTranscriptRevisionsToSeal(ctx context.Context, arg db.ListTranscriptRevisionsToSealParams) ([]db.ListTranscriptRevisionsToSealRow, error)
+	SealTranscriptRevision(ctx context.Context, arg db.SealTranscriptRevisionParams) (int64, error)
+	ListClipExcerptsToSeal(ctx context.Context, arg db.ListClipExcerptsToSealParams) ([]db.ListClipExcerptsToSealRow, error)
+	SealClipExcerpt(ctx context.Context, arg db.SealClipExcerptParams) (int64, error)
+	ListMentionLinesToSeal(ctx context.Context, arg db.ListMentionLinesToSealParams) ([]db.ListMentionLinesToSealRow, error)
+	SealMentionLine(ctx context.Context, arg db.SealMentionLineParams) (int64, error)
+	ListKeywordAlertLinesToSeal(ctx context.Context, arg db.ListKeywordAlertLinesToSealParams) ([]db.ListKeywordAlertLinesToSealRow, error)
+	SealKeywordAlertLine(ctx context.Context, arg db.SealKeywordAlertLineParams) (int64, error)
+	ListOutcomeQuotesToSeal(ctx context.Context, arg db.ListOutcomeQuotesToSealParams) ([]db.ListOutcomeQuotesToSealRow, error)
+	SealOutcomeQuote(ctx context.Context, arg db.SealOutcomeQuoteParams) (int64, error)
+	ListSourceQuotesToSeal(ctx context.Context, arg db.ListSourceQuotesToSealParams) ([]db.ListSourceQuotesToSealRow, error)
+	SealSourceQuote(ctx context.Context, arg db.SealSourceQuoteParams) (int64, error)
+	ListAudioObjectKeys(ctx context.Context, arg db.ListAudioObjectKeysParams) ([]string, error)
+}
+
+// encryptedPrefixes are the storage keys holding meeting audio: uploads,
+// live ingest chunks and clips. Avatars, logos and attachments are stored
+// as they are.
+var encryptedPrefixes = []string{"recordings/", "ingest/", "uploads/", "clips/"}
+
+const (
+	// keyRotationBatch bounds each query of the rotation job.
+	keyRotationBatch    = 100
+	dataKeyFetchTimeout = 10 * time.Second
+)
+
+type encryption struct {
+	master   envelope.MasterKey
+	previous []envelope.MasterKey
+	keys     *envelope.Keyring
+	audio    *envelope.Store
+
+	// mu guards orgID, which the keyring reads to unwrap the keys it
+	// fetches while an import may be replacing it.
+	mu sync.RWMutex
+	// orgID is the org the data keys belong to; they are wrapped bound to
+	// it, so another org's keys don't unwrap here.
+	orgID string
+
+	// rotating is held by key rotation and by reloading the keys after an
+	// import, so they don't run at once; it guards the fields below.
+	rotating sync.Mutex
+	// newest is the active data key's row, to tell when it is due for
+	// rotation.
+	newest db.DataKey
+	// rekeyedAudio is the data key every audio object was last found
+	// encrypted with, so unchanged audio isn't scanned again each run.
+	rekeyedAudio int32
+}
+
+// org returns the org the data keys belong to.
+func (enc *encryption) org() string {
+	enc.mu.RLock()
+	defer enc.mu.RUnlock()
+	return enc.orgID
+}
+
+// ConfigureEncryption turns on encryption at rest for transcripts and
+// audio. The org's data keys are unwrapped with master, or with one of
+// previous while the master key is being rotated, and rewrapped with
+// master. The first data key is created when there is none. Call it after
+// ConfigureStorage: audio written from then on is encrypted, and earlier
+// audio and transcripts are encrypted by the key rotation job.
+func (s *Server) ConfigureEncryption(ctx context.Context, master envelope.MasterKey, previous ...envelope.MasterKey) error {
+	enc := &encryption{master: master, previous: previous, keys: envelope.NewKeyring()}
+	enc.keys.SetFetch(func(id int32) ([]byte, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), dataKeyFetchTimeout)
+		defer cancel()
+		row, err := s.dataKeys.GetDataKey(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return enc.unwrap(row, enc.org())
+	})
+	orgID, err := s.dataKeys.GetOrgID(ctx)
+	if err != nil {
+		return fmt.Errorf("load org: %w", err)
+	}
+	enc.orgID = orgID
+	if err := s.loadDataKeys(ctx, enc); err != nil {
+		return err
+	}
+	if enc.newest.ID == 0 {
+		if err := s.createDataKey(ctx, enc); err != nil {
+			return err
+		}
+	}
+	if s.storage != nil {
+		enc.audio = envelope.NewStore(s.storage, enc.keys, encryptedPrefixes...)
+		s.storage = enc.audio
+	}
+	s.encryption = enc
+	return nil
+}
+
+// loadDataKeys adds the org's data keys to the keyring, rewrapping ones
+// wrapped by a previous master key or before they were bound to the org.
+// Once encryption is configured, callers hold enc.rotating.
+func (s *Server) loadDataKeys(ctx context.Context, enc *encryption) error {
+	rows, err := s.dataKeys.ListDataKeys(ctx, enc.orgID)
+	if err != nil {
+		return fmt.Errorf("load data keys: %w", err)
+	}
+	for _, row := range rows {
+		key, err := enc.unwrap(row, enc.orgID)
+		if err != nil {
+			return err
+		}
+		if row.MasterKeyID != enc.master.ID() || !row.OrgID.Valid {
+			wrapped, err := enc.master.Wrap(key, []byte(enc.orgID))
+			if err != nil {
+				return fmt.Errorf("rewrap data key %d: %w", row.ID, err)
+			}
+			if err := s.dataKeys.RewrapDataKey(ctx, db.RewrapDataKeyParams{ID: row.ID, WrappedKey: wrapped, MasterKeyID: enc.master.ID(), OrgID: enc.orgID}); err != nil {
+				return fmt.Errorf("rewrap data key %d: %w", row.ID, err)
+			}
+			log.Printf("encryption: rewrapped data key %d with master key %s for org %s", row.ID, enc.master.ID(), enc.orgID)
+		}
+		if err := enc.keys.Add(row.ID, key); err != nil {
+			return err
+		}
+		enc.newest = row
+	}
+	return nil
+}
+
+// unwrap opens a data key of orgID. Keys from before data keys belonged
+// to an org were wrapped without one.
+func (enc *encryption) unwrap(row db.DataKey, orgID string) ([]byte, error) {
+	var scope []byte
+	if row.OrgID.Valid {
+		if row.OrgID.String != orgID {
+			return nil, fmt.Errorf("data key %d belongs to another org", row.ID)
+		}
+		scope = []byte(orgID)
+	}
+	for _, master := range append([]envelope.MasterKey{enc.master}, enc.previous...) {
+		if master.ID() == row.MasterKeyID {
+			return master.Unwrap(row.WrappedKey, scope)
+		}
+	}
+	return nil, fmt.Errorf("data key %d is wrapped by master key %s, which is not configured", row.ID, row.MasterKeyID)
+}
+
+func (s *Server) createDataKey(ctx context.Context, enc *encryption) error {
+	key, err := envelope.NewDataKey()
+	if err != nil {
+		return err
+	}
+	wrapped, err := enc.master.Wrap(key, []byte(enc.orgID))
+	if err != nil {
+		return fmt.Errorf("wrap data key: %w", err)
+	}
+	row, err := s.dataKeys.CreateDataKey(ctx, db.CreateDataKeyParams{WrappedKey: wrapped, MasterKeyID: enc.master.ID(), OrgID: enc.orgID})
+	if err != nil {
+		return fmt.Errorf("create data key: %w", err)
+	}
+	enc.newest = row
+	return enc.keys.Add(row.ID, key)
+}
+
+// reloadDataKeys replaces the keyring with the stored data keys after an
+// instance import swapped the org and its data keys for the archive's,
+// whose IDs may collide with the ones loaded before.
+func (s *Server) reloadDataKeys(ctx context.Context) error {
+	enc := s.encryption
+	if enc == nil {
+		return nil
+	}
+	enc.rotating.Lock()
+	defer enc.rotating.Unlock()
+	orgID, err := s.dataKeys.GetOrgID(ctx)
+	if err != nil {
+		return fmt.Errorf("load org: %w", err)
+	}
+	enc.mu.Lock()
+	enc.orgID = orgID
+	enc.mu.Unlock()
+	enc.keys.Reset()
+	enc.newest = db.DataKey{}
+	enc.rekeyedAudio = 0
+	if err := s.loadDataKeys(ctx, enc); err != nil {
+		return err
+	}
+	if enc.newest.ID == 0 {
+		return s.createDataKey(ctx, enc)
+	}
+	return nil
+}
+
+// openText decrypts a sealed transcript and returns any other text as it
+// is: text stored before encryption was turned on stays plaintext until
+// the rotation job seals it.
+func (s *Server) openText(text string) (string, error) {
+	if _, sealed := envelope.TextKeyID(text); !sealed {
+		return text, nil
+	}
+	if s.encryption == nil {
+		return "", connect.NewError(connect.CodeFailedPrecondition, errors.New("transcript is encrypted but encryption is not configured"))
+	}
+	plain, err := s.encryption.keys.OpenString(text)
+	if err != nil {
+		return "", apierr.Wrap(err, "failed to decrypt transcript")
+	}
+	return plain, nil
+}
+
+// sealText encrypts a transcript for storage, or returns it as it is when
+// encryption is off.
+func (s *Server) sealText(text string) (string, error) {
+	if s.encryption == nil || text == "" {
+		return text, nil
+	}
+	sealed, err := s.encryption.keys.SealString(text)
+	if err != nil {
+		return "", apierr.Wrap(err, "failed to encrypt transcript")
+	}
+	return sealed, nil
+}
+
+// sealWorkerTranscript seals the transcript the transcription worker
+// stored in plaintext, once it reports transcription finished. The caller
+// holds the row lock.
+func (s *Server) sealWorkerTranscript(ctx context.Context, q RecordingQueries, id int32) error {
+	if s.encryption == nil {
+		return nil
+	}
+	row, err := q.GetRecording(ctx, id)
+	if err != nil {
+		return apierr.Wrap(err, "failed to fetch recording")
+	}
+	text := row.Transcript.String
+	if _, sealed := envelope.TextKeyID(text); sealed || text == "" {
+		return nil
+	}
+	sealed, err := s.sealText(text)
+	if err != nil {
+		return err
+	}
+	if err := q.SetRecordingTranscript(ctx, db.SetRecordingTranscriptParams{ID: id, Transcript: pgtype.Text{String: sealed, Valid: true}}); err != nil {
+		return apierr.Wrap(err, "failed to store sealed transcript")
+	}
+	return nil
+}
+
+// StartKeyRotation replaces the data key once it is older than maxAge
+// and, every interval, moves transcripts and audio onto the active key,
+// encrypting what was stored before encryption was turned on or the
+// transcription worker left in plaintext. Only the leader rotates and
+// re-encrypts; every instance picks up the data keys the leader creates.
+// It returns at once; rotation stops with ctx.
+func (s *Server) StartKeyRotation(ctx context.Context, interval, maxAge time.Duration) {
+	if interval <= 0 || s.encryption == nil {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if err := s.rotateKeys(ctx, time.Now(), maxAge); err != nil && ctx.Err() == nil {
+				log.Printf("key rotation: %v", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *Server) rotateKeys(ctx context.Context, now time.Time, maxAge time.Duration) error {
+	enc := s.encryption
+	enc.rotating.Lock()
+	defer enc.rotating.Unlock()
+	if err := s.loadDataKeys(ctx, enc); err != nil {
+		return err
+	}
+	if !s.isLeader() {
+		return nil
+	}
+	if maxAge > 0 && now.Sub(enc.newest.CreatedAt.Time) >= maxAge {
+		if err := s.createDataKey(ctx, enc); err != nil {
+			return err
+		}
+		log.Printf("encryption: rotated to data key %d", enc.newest.ID)
+	}
+	transcripts, err := s.sealTranscripts(ctx)
+	if err != nil {
+		return err
+	}
+	objects, err := s.rekeyAudio(ctx)
+	if err != nil {
+		return err
+	}
+	if transcripts > 0 || objects > 0 {
+		s.recordingCache.invalidate()
+		log.Printf("encryption: moved %d transcripts and %d audio objects to data key %d", transcripts, objects, enc.keys.Active())
+	}
+	return nil
+}
+
+// sealedText is a row of a sealedColumn.
+type sealedText struct {
+	id   int64
+	text string
+}
+
+// sealedColumn is a column holding transcript text: how to list a batch of
+// rows not sealed with the key prefix seals with, and how to replace one
+// row's text.
+type sealedColumn struct {
+	name string
+	list func(ctx context.Context, prefix string) ([]sealedText, error)
+	seal func(ctx context.Context, row sealedText, sealed string) (int64, error)
+}
+
+func collectSealed[R any](rows []R, err error, text func(R) sealedText) ([]sealedText, error) {
+	if err != nil {
+		return nil, err
+	}
+	out := make([]sealedText, len(rows))
+	for i, row := range rows {
+		out[i] = text(row)
+	}
+	return out, nil
+}
+
+// sealedColumns are transcripts, their earlier revisions and translations,
+// and the clip excerpts, mention and keyword alert lines and outcome and
+// assistant source quotes copied from them.
+func (s *Server) sealedColumns() []sealedColumn {
+	q := s.dataKeys
+	return []sealedColumn{
+		{
+			name: "recording",
+			list: func(ctx context.Context, prefix string) ([]sealedText, error) {
+				rows, err := q.ListTranscriptsToSeal(ctx, db.ListTranscriptsToSealParams{SealedPrefix: prefix, MaxRows: keyRotationBatch})
+				return collectSealed(rows, err, func(r db.ListTranscriptsToSealRow) sealedText { return sealedText{int64(r.ID), r.Transcript} })
+			},
+			seal: func(ctx context.Context, row sealedText, sealed string) (int64, error) {
+				return q.SealRecordingTranscript(ctx, db.SealRecordingTranscriptParams{ID: int32(row.id), Sealed: sealed, Current: row.text})
+			},
+		},
+		{
+			name: "translation",
+			list: func(ctx context.Context, prefix string) ([]sealedText, error) {
+				rows, err := q.ListTranslationsToSeal(ctx, db.ListTranslationsToSealParams{SealedPrefix: prefix, MaxRows: keyRotationBatch})
+				return collectSealed(rows, err, func(r db.ListTranslationsToSealRow) sealedText { return sealedText{int64(r.ID), r.Text} })
+			},
+			seal: func(ctx context.Context, row sealedText, sealed string) (int64, error) {
+				return q.SealRecordingTranslation(ctx, db.SealRecordingTranslationParams{ID: int32(row.id), Sealed: sealed, Current: row.text})
+			},
+		},
+		{
+			name: "transcript revision",
+			list: func(ctx context.Context, prefix string) ([]sealedText, error) {
+				rows, err := q.ListTranscriptRevisionsToSeal(ctx, db.ListTranscriptRevisionsToSealParams{SealedPrefix: prefix, MaxRows: keyRotationBatch})
+				return collectSealed(rows, err, func(r db.ListTranscriptRevisionsToSealRow) sealedText { return sealedText{int64(r.ID), r.Transcript} })
+			},
+			seal: func(ctx context.Context, row sealedText, sealed string) (int64, error) {
+				return q.SealTranscriptRevision(ctx, db.SealTranscriptRevisionParams{ID: int32(row.id), Sealed: sealed, Current: row.text})
+			},
+		},
+		{
+			name: "clip",
+			list: func(ctx context.Context, prefix string) ([]sealedText, error) {
+				rows, err := q.ListClipExcerptsToSeal(ctx, db.ListClipExcerptsToSealParams{SealedPrefix: prefix, MaxRows: keyRotationBatch})
+				return collectSealed(rows, err, func(r db.ListClipExcerptsToSealRow) sealedText { return sealedText{int64(r.ID), r.Text} })
+			},
+			seal: func(ctx context.Context, row sealedText, sealed string) (int64, error) {
+				return q.SealClipExcerpt(ctx, db.SealClipExcerptParams{ID: int32(row.id), Sealed: sealed, Current: row.text})
+			},
+		},
+		{
+			name: "mention",
+			list: func(ctx context.Context, prefix string) ([]sealedText, error) {
+				rows, err := q.ListMentionLinesToSeal(ctx, db.ListMentionLinesToSealParams{SealedPrefix: prefix, MaxRows: keyRotationBatch})
+				return collectSealed(rows, err, func(r db.ListMentionLinesToSealRow) sealedText { return sealedText{r.ID, r.Text} })
+			},
+			seal: func(ctx context.Context, row sealedText, sealed string) (int64, error) {
+				return q.SealMentionLine(ctx, db.SealMentionLineParams{ID: row.id, Sealed: sealed, Current: row.text})
+			},
+		},
+		{
+			name: "keyword alert",
+			list: func(ctx context.Context, prefix string) ([]sealedText, error) {
+				rows, err := q.ListKeywordAlertLinesToSeal(ctx, db.ListKeywordAlertLinesToSealParams{SealedPrefix: prefix, MaxRows: keyRotationBatch})
+				return collectSealed(rows, err, func(r db.ListKeywordAlertLinesToSealRow) sealedText { return sealedText{r.ID, r.Text} })
+			},
+			seal: func(ctx context.Context, row sealedText, sealed string) (int64, error) {
+				return q.SealKeywordAlertLine(ctx, db.SealKeywordAlertLineParams{ID: row.id, Sealed: sealed, Current: row.text})
+			},
+		},
+		{
+			name: "outcome",
+			list: func(ctx context.Context, prefix string) ([]sealedText, error) {
+				rows, err := q.ListOutcomeQuotesToSeal(ctx, db.ListOutcomeQuotesToSealParams{SealedPrefix: prefix, MaxRows: keyRotationBatch})
+				return collectSealed(rows, err, func(r db.ListOutcomeQuotesToSealRow) sealedText { return sealedText{int64(r.ID), r.Text} })
+			},
+			seal: func(ctx context.Context, row sealedText, sealed string) (int64, error) {
+				return q.SealOutcomeQuote(ctx, db.SealOutcomeQuoteParams{ID: int32(row.id), Sealed: sealed, Current: row.text})
+			},
+		},
+		{
+			name: "source reference",
+			list: func(ctx context.Context, prefix string) ([]sealedText, error) {
+				rows, err := q.ListSourceQuotesToSeal(ctx, db.ListSourceQuotesToSealParams{SealedPrefix: prefix, MaxRows: keyRotationBatch})
+				return collectSealed(rows, err, func(r db.ListSourceQuotesToSealRow) sealedText { return sealedText{r.ID, r.Text} })
+			},
+			seal: func(ctx context.Context, row sealedText, sealed string) (int64, error) {
+				return q.SealSourceQuote(ctx, db.SealSourceQuoteParams{ID: row.id, Sealed: sealed, Current: row.text})
+			},
+		},
+	}
+}
+
+// sealTranscripts seals every sealedColumn's rows not yet sealed with the
+// active key, until none are left or a batch makes no progress because the
+// rows keep changing.
+func (s *Server) sealTranscripts(ctx context.Context) (int, error) {
+	prefix := envelope.SealedPrefix(s.encryption.keys.Active())
+	total := 0
+	for _, column := range s.sealedColumns() {
+		for {
+			rows, err := column.list(ctx, prefix)
+			if err != nil {
+				return total, fmt.Errorf("list %s text: %w", column.name, err)
+			}
+			sealed := 0
+			for _, row := range rows {
+				text, err := s.resealText(row.text)
+				if err != nil {
+					return total, fmt.Errorf("%s %d: %w", column.name, row.id, err)
+				}
+				n, err := column.seal(ctx, row, text)
+				if err != nil {
+					return total, fmt.Errorf("%s %d: %w", column.name, row.id, err)
+				}
+				sealed += int(n)
+			}
+			total += sealed
+			if len(rows) < keyRotationBatch || sealed == 0 {
+				break
+			}
+		}
+	}
+	return total, nil
+}
+
+// containsFold reports whether text contains query, ignoring case the way
+// ILIKE does, for searching text once it is opened.
+func containsFold(text, query string) bool {
+	return strings.Contains(strings.ToLower(text), strings.ToLower(query))
+}
+
+func (s *Server) resealText(text string) (string, error) {
+	plain, err := s.encryption.keys.OpenString(text)
+	if err != nil {
+		return "", err
+	}
+	return s.encryption.keys.SealString(plain)
+}
+
+// rekeyAudio re-encrypts recording and clip audio not on the active key.
+// Objects already removed from storage are skipped. The caller holds
+// s.encryption.rotating.
+func (s *Server) rekeyAudio(ctx context.Context) (int, error) {
+	enc := s.encryption
+	active := enc.keys.Active()
+	if enc.audio == nil || enc.rekeyedAudio == active {
+		return 0, nil
+	}
+	total, after := 0, ""
+	for {
+		keys, err := s.dataKeys.ListAudioObjectKeys(ctx, db.ListAudioObjectKeysParams{After: after, MaxRows: keyRotationBatch})
+		if err != nil {
+			return total, fmt.Errorf("list audio: %w", err)
+		}
+		for _, key := range keys {
+			after = key
+			rekeyed, err := enc.audio.Rekey(ctx, key)
+			if errors.Is(err, storage.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return total, fmt.Errorf("audio %s: %w", key, err)
+			}
+			if rekeyed {
+				total++
+			}
+		}
+		if len(keys) < keyRotationBatch {
+			break
+		}
+	}
+	enc.rekeyedAudio = active
+	return total, nil
+}
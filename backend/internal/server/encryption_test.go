@@ -0,0 +1,375 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/envelope"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// memoryDataKeys keeps the org's ID, data keys, transcripts, mention lines
+// and audio keys in memory.
+type memoryDataKeys struct {
+	mu           sync.Mutex
+	orgID        string
+	keys         []db.DataKey
+	transcripts  map[int32]string
+	mentionLines map[int64]string
+	audio        []string
+}
+
+func (m *memoryDataKeys) GetOrgID(context.Context) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.orgID == "" {
+		m.orgID = "org-1"
+	}
+	return m.orgID, nil
+}
+
+func (m *memoryDataKeys) ListDataKeys(_ context.Context, orgID string) ([]db.DataKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var keys []db.DataKey
+	for _, key := range m.keys {
+		if !key.OrgID.Valid || key.OrgID.String == orgID {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (m *memoryDataKeys) GetDataKey(_ context.Context, id int32) (db.DataKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range m.keys {
+		if key.ID == id {
+			return key, nil
+		}
+	}
+	return db.DataKey{}, pgx.ErrNoRows
+}
+
+func (m *memoryDataKeys) CreateDataKey(_ context.Context, arg db.CreateDataKeyParams) (db.DataKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := db.DataKey{ID: int32(len(m.keys) + 1), WrappedKey: arg.WrappedKey, MasterKeyID: arg.MasterKeyID, CreatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}, OrgID: optionalText(arg.OrgID)}
+	m.keys = append(m.keys, key)
+	return key, nil
+}
+
+func (m *memoryDataKeys) RewrapDataKey(_ context.Context, arg db.RewrapDataKeyParams) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := &m.keys[arg.ID-1]
+	key.WrappedKey, key.MasterKeyID, key.OrgID = arg.WrappedKey, arg.MasterKeyID, optionalText(arg.OrgID)
+	return nil
+}
+
+func (m *memoryDataKeys) ListTranscriptsToSeal(_ context.Context, arg db.ListTranscriptsToSealParams) ([]db.ListTranscriptsToSealRow, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var rows []db.ListTranscriptsToSealRow
+	for id, text := range m.transcripts {
+		if text != "" && !strings.HasPrefix(text, arg.SealedPrefix) {
+			rows = append(rows, db.ListTranscriptsToSealRow{ID: id, Transcript: text})
+		}
+	}
+	return rows, nil
+}
+
+func (m *memoryDataKeys) SealRecordingTranscript(_ context.Context, arg db.SealRecordingTranscriptParams) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.transcripts[arg.ID] != arg.Current {
+		return 0, nil
+	}
+	m.transcripts[arg.ID] = arg.Sealed
+	return 1, nil
+}
+
+func (m *memoryDataKeys) ListTranslationsToSeal(context.Context, db.ListTranslationsToSealParams) ([]db.ListTranslationsToSealRow, error) {
+	return nil, nil
+}
+
+func (m *memoryDataKeys) SealRecordingTranslation(context.Context, db.SealRecordingTranslationParams) (int64, error) {
+	return 0, nil
+}
+
+func (m *memoryDataKeys) ListTranscriptRevisionsToSeal(context.Context, db.ListTranscriptRevisionsToSealParams) ([]db.ListTranscriptRevisionsToSealRow, error) {
+	return nil, nil
+}
+
+func (m *memoryDataKeys) SealTranscriptRevision(context.Context, db.SealTranscriptRevisionParams) (int64, error) {
+	return 0, nil
+}
+
+func (m *memoryDataKeys) ListClipExcerptsToSeal(context.Context, db.ListClipExcerptsToSealParams) ([]db.ListClipExcerptsToSealRow, error) {
+	return nil, nil
+}
+
+func (m *memoryDataKeys) SealClipExcerpt(context.Context, db.SealClipExcerptParams) (int64, error) {
+	return 0, nil
+}
+
+func (m *memoryDataKeys) ListMentionLinesToSeal(_ context.Context, arg db.ListMentionLinesToSealParams) ([]db.ListMentionLinesToSealRow, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var rows []db.ListMentionLinesToSealRow
+	for id, text := range m.mentionLines {
+		if text != "" && !strings.HasPrefix(text, arg.SealedPrefix) {
+			rows = append(rows, db.ListMentionLinesToSealRow{ID: id, Text: text})
+		}
+	}
+	return rows, nil
+}
+
+func (m *memoryDataKeys) SealMentionLine(_ context.Context, arg db.SealMentionLineParams) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.mentionLines[arg.ID] != arg.Current {
+		return 0, nil
+	}
+	m.mentionLines[arg.ID] = arg.Sealed
+	return 1, nil
+}
+
+func (m *memoryDataKeys) ListKeywordAlertLinesToSeal(context.Context, db.ListKeywordAlertLinesToSealParams) ([]db.ListKeywordAlertLinesToSealRow, error) {
+	return nil, nil
+}
+
+func (m *memoryDataKeys) SealKeywordAlertLine(context.Context, db.SealKeywordAlertLineParams) (int64, error) {
+	return 0, nil
+}
+
+func (m *memoryDataKeys) ListOutcomeQuotesToSeal(context.Context, db.ListOutcomeQuotesToSealParams) ([]db.ListOutcomeQuotesToSealRow, error) {
+	return nil, nil
+}
+
+func (m *memoryDataKeys) SealOutcomeQuote(context.Context, db.SealOutcomeQuoteParams) (int64, error) {
+	return 0, nil
+}
+
+func (m *memoryDataKeys) ListSourceQuotesToSeal(context.Context, db.ListSourceQuotesToSealParams) ([]db.ListSourceQuotesToSealRow, error) {
+	return nil, nil
+}
+
+func (m *memoryDataKeys) SealSourceQuote(context.Context, db.SealSourceQuoteParams) (int64, error) {
+	return 0, nil
+}
+
+func (m *memoryDataKeys) ListAudioObjectKeys(_ context.Context, arg db.ListAudioObjectKeysParams) ([]string, error) {
+	var keys []string
+	for _, key := range m.audio {
+		if key > arg.After {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func TestEncryptionRotatesKeys(t *testing.T) {
+	ctx := context.Background()
+	local, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Audio and a transcript from before encryption was turned on.
+	local.Put(ctx, "recordings/2026/10/a.wav", strings.NewReader("RIFF old"))
+	store := &memoryDataKeys{
+		transcripts:  map[int32]string{1: "Speaker 1: budget is approved"},
+		mentionLines: map[int64]string{5: "Ana, the budget is approved"},
+		audio:        []string{"recordings/2026/10/a.wav"},
+	}
+	oldMaster, _ := envelope.NewLocalMasterKey(bytes.Repeat([]byte{1}, envelope.KeySize))
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.dataKeys = store
+	srv.ConfigureStorage(local)
+	if err := srv.ConfigureEncryption(ctx, oldMaster); err != nil {
+		t.Fatalf("configure: %v", err)
+	}
+	if len(store.keys) != 1 {
+		t.Fatalf("data keys = %d, want the first one created", len(store.keys))
+	}
+
+	if err := srv.rotateKeys(ctx, time.Now(), 24*time.Hour); err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+	sealed := store.transcripts[1]
+	if id, ok := envelope.TextKeyID(sealed); !ok || id != 1 {
+		t.Fatalf("transcript = %q, want sealed with key 1", sealed)
+	}
+	if plain, err := srv.openText(sealed); err != nil || plain != "Speaker 1: budget is approved" {
+		t.Fatalf("open = %q, %v", plain, err)
+	}
+	if _, ok := envelope.TextKeyID(store.mentionLines[5]); !ok {
+		t.Fatalf("mention line = %q, want it sealed with the transcript", store.mentionLines[5])
+	}
+	if raw := readObject(t, local, "recordings/2026/10/a.wav"); strings.Contains(raw, "RIFF") {
+		t.Fatal("old audio was left in plaintext")
+	}
+
+	// Restart with a new master key: the data key is rewrapped, and once it
+	// is too old a new one replaces it.
+	newMaster, _ := envelope.NewLocalMasterKey(bytes.Repeat([]byte{2}, envelope.KeySize))
+	srv = New(nil, []byte("test"), time.Hour)
+	srv.dataKeys = store
+	srv.ConfigureStorage(local)
+	if err := srv.ConfigureEncryption(ctx, newMaster, oldMaster); err != nil {
+		t.Fatalf("configure with new master key: %v", err)
+	}
+	if store.keys[0].MasterKeyID != newMaster.ID() {
+		t.Fatalf("data key wrapped by %s, want %s", store.keys[0].MasterKeyID, newMaster.ID())
+	}
+	if err := srv.rotateKeys(ctx, time.Now().Add(48*time.Hour), 24*time.Hour); err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+	if id, _ := envelope.TextKeyID(store.transcripts[1]); len(store.keys) != 2 || id != 2 {
+		t.Fatalf("keys = %d, transcript key = %d; want both on key 2", len(store.keys), id)
+	}
+	if got := readObject(t, srv.storage, "recordings/2026/10/a.wav"); got != "RIFF old" {
+		t.Fatalf("audio = %q", got)
+	}
+
+	// Without the old master key the data keys can't be unwrapped.
+	srv = New(nil, []byte("test"), time.Hour)
+	srv.dataKeys = store
+	other, _ := envelope.NewLocalMasterKey(bytes.Repeat([]byte{3}, envelope.KeySize))
+	if err := srv.ConfigureEncryption(ctx, other); err == nil {
+		t.Fatal("configured encryption with an unknown master key")
+	}
+}
+
+func TestReloadDataKeysWhileRotating(t *testing.T) {
+	ctx := context.Background()
+	srv := encryptedServer(t)
+	store := srv.dataKeys.(*memoryDataKeys)
+	store.transcripts = map[int32]string{1: "Speaker 1: budget is approved"}
+
+	// An import reloads the keys while rotation and requests use them.
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			if err := srv.rotateKeys(ctx, time.Now(), time.Nanosecond); err != nil {
+				t.Errorf("rotate: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err := srv.reloadDataKeys(ctx); err != nil {
+				t.Errorf("reload: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			sealed, err := srv.sealText("Speaker 1: hello")
+			if err != nil {
+				t.Errorf("seal: %v", err)
+				return
+			}
+			if plain, err := srv.openText(sealed); err != nil || plain != "Speaker 1: hello" {
+				t.Errorf("open = %q, %v", plain, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDataKeysBelongToTheOrg(t *testing.T) {
+	ctx := context.Background()
+	master, _ := envelope.NewLocalMasterKey(bytes.Repeat([]byte{1}, envelope.KeySize))
+	// A key from before data keys belonged to an org, wrapped without one.
+	legacy, _ := envelope.NewDataKey()
+	wrapped, err := master.Wrap(legacy, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &memoryDataKeys{orgID: "org-a", keys: []db.DataKey{{ID: 1, WrappedKey: wrapped, MasterKeyID: master.ID()}}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.dataKeys = store
+	if err := srv.ConfigureEncryption(ctx, master); err != nil {
+		t.Fatalf("configure: %v", err)
+	}
+	if got := store.keys[0].OrgID; got.String != "org-a" {
+		t.Fatalf("legacy key org = %q, want it bound to org-a", got.String)
+	}
+	if _, err := master.Unwrap(store.keys[0].WrappedKey, []byte("org-a")); err != nil {
+		t.Fatalf("rewrapped key doesn't unwrap for org-a: %v", err)
+	}
+
+	// Another org's key, even relabeled, doesn't unwrap here.
+	other, _ := envelope.NewDataKey()
+	wrapped, _ = master.Wrap(other, []byte("org-b"))
+	if _, err := srv.encryption.unwrap(db.DataKey{ID: 2, WrappedKey: wrapped, MasterKeyID: master.ID(), OrgID: optionalText("org-b")}, "org-a"); err == nil {
+		t.Fatal("unwrapped a key of another org")
+	}
+	if _, err := srv.encryption.unwrap(db.DataKey{ID: 2, WrappedKey: wrapped, MasterKeyID: master.ID(), OrgID: optionalText("org-a")}, "org-a"); err == nil {
+		t.Fatal("unwrapped a key of another org relabeled as this one's")
+	}
+}
+
+func TestListRecordingsSearchesSealedTranscripts(t *testing.T) {
+	srv := encryptedServer(t)
+	sealed, err := srv.sealText("Speaker 1: the budget is approved")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureStores(listedRecordings{rows: []db.ListRecordingsRow{
+		{ID: 1, Name: optionalText("Standup"), Transcript: optionalText(sealed)},
+		{ID: 2, Name: optionalText("Budget review")},
+		{ID: 3, Name: optionalText("Retro"), Transcript: optionalText("Speaker 1: nothing to add")},
+	}}, nil, nil)
+	srv.favorites = newFakeFavorites()
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	res, err := srv.ListRecordings(ctx, connect.NewRequest(&secretaryv1.ListRecordingsRequest{Query: " BUDGET "}))
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	var ids []int64
+	for _, rec := range res.Msg.Recordings {
+		ids = append(ids, rec.Id)
+	}
+	if !slices.Equal(ids, []int64{1, 2}) {
+		t.Fatalf("matched recordings %v, want the sealed transcript and the name", ids)
+	}
+}
+
+// encryptedServer returns a server sealing transcripts with a new data
+// key.
+func encryptedServer(t *testing.T) *Server {
+	t.Helper()
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.dataKeys = &memoryDataKeys{}
+	master, _ := envelope.NewLocalMasterKey(bytes.Repeat([]byte{1}, envelope.KeySize))
+	if err := srv.ConfigureEncryption(context.Background(), master); err != nil {
+		t.Fatalf("configure encryption: %v", err)
+	}
+	return srv
+}
+
+func readObject(t *testing.T, store storage.Store, key string) string {
+	t.Helper()
+	rc, err := store.Open(context.Background(), key)
+	if err != nil {
+		t.Fatalf("open %s: %v", key, err)
+	}
+	defer rc.Close()
+	data, _ := io.ReadAll(rc)
+	return string(data)
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/mvult/secretary/backend/internal/errtrack"
+)
+
+// ErrorTracker receives the failures the server would otherwise only log.
+// Capture must not block.
+type ErrorTracker interface {
+	Capture(errtrack.Event)
+}
+
+// ConfigureErrorTracking sends panics, calls that fail through the
+// server's fault and failed jobs to tracker as well as the log.
+func (s *Server) ConfigureErrorTracking(tracker ErrorTracker) {
+	s.errorTracker = tracker
+}
+
+// capture fills in what ctx knows about the request and passes event on
+// to the error tracker, if there is one.
+func (s *Server) capture(ctx context.Context, event errtrack.Event) {
+	if s.errorTracker == nil {
+		return
+	}
+	event.RequestID = requestIDFrom(ctx)
+	event.UserID = currentUserID(ctx)
+	event.Time = time.Now()
+	s.errorTracker.Capture(event)
+}
+
+// captureCallError tracks a Connect call that failed through the server's
+// fault. One issue collects each procedure's failures with a given code.
+// Panics are left out: the recovery interceptor tracked them with their
+// stack.
+func (s *Server) captureCallError(ctx context.Context, procedure string, err error) {
+	if errors.Is(err, errPanicked) {
+		return
+	}
+	code := connect.CodeOf(err).String()
+	s.capture(ctx, errtrack.Event{
+		Level:       errtrack.LevelError,
+		Type:        code,
+		Message:     err.Error(),
+		Where:       procedure,
+		Fingerprint: []string{"rpc", procedure, code},
+	})
+}
+
+// captureJobFailure tracks a recording's processing failing while it was
+// in status, such as transcribing.
+func (s *Server) captureJobFailure(ctx context.Context, recordingID int32, status, message string) {
+	s.capture(ctx, errtrack.Event{
+		Level:       errtrack.LevelError,
+		Type:        "job failure",
+		Message:     message,
+		Where:       status,
+		Tags:        map[string]string{"recording_id": fmt.Sprint(recordingID)},
+		Fingerprint: []string{"job", status},
+	})
+}
+
+// captureTaskFailure tracks a scheduled task's run failing.
+func (s *Server) captureTaskFailure(ctx context.Context, task string, err error) {
+	s.capture(ctx, errtrack.Event{
+		Level:       errtrack.LevelError,
+		Type:        "scheduled task failure",
+		Message:     err.Error(),
+		Where:       task,
+		Fingerprint: []string{"scheduled task", task},
+	})
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"slices"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// FavoriteStore holds the queries behind starred recordings and todos.
+type FavoriteStore interface {
+	StarRecording(ctx context.Context, arg db.StarRecordingParams) error
+	UnstarRecording(ctx context.Context, arg db.UnstarRecordingParams) error
+	StarTodo(ctx context.Context, arg db.StarTodoParams) error
+	UnstarTodo(ctx context.Context, arg db.UnstarTodoParams) error
+	ListStarredRecordingIDs(ctx context.Context, userID int32) ([]int32, error)
+	ListStarredTodoIDs(ctx context.Context, userID int32) ([]int32, error)
+}
+
+// starredRecordings returns the recordings the caller starred, in id
+// order. Stars are per user while recording responses are cached for
+// everyone, so they are applied to responses after the cache.
+func (s *Server) starredRecordings(ctx context.Context) ([]int32, error) {
+	userID := currentUserID(ctx)
+	if userID == 0 {
+		return nil, nil
+	}
+	ids, err := s.favorites.ListStarredRecordingIDs(ctx, int32(userID))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list starred recordings")
+	}
+	return ids, nil
+}
+
+// starredTodos is starredRecordings for todos.
+func (s *Server) starredTodos(ctx context.Context) ([]int32, error) {
+	userID := currentUserID(ctx)
+	if userID == 0 {
+		return nil, nil
+	}
+	ids, err := s.favorites.ListStarredTodoIDs(ctx, int32(userID))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list starred todos")
+	}
+	return ids, nil
+}
+
+// markStarredRecordings sets Recording.starred from the caller's stars and,
+// with starredOnly, drops the rest. The ETag is extended with the stars so
+// a conditional GET sees starring and unstarring as changes.
+func markStarredRecordings(res *connect.Response[secretaryv1.ListRecordingsResponse], starred []int32, starredOnly bool) {
+	recordings := res.Msg.Recordings[:0]
+	for _, rec := range res.Msg.Recordings {
+		rec.Starred = slices.Contains(starred, int32(rec.Id))
+		if rec.Starred || !starredOnly {
+			recordings = append(recordings, rec)
+		}
+	}
+	res.Msg.Recordings = recordings
+	res.Header().Set("ETag", weakETag(res.Header().Get("ETag"), starred))
+}
+
+// StarRecording pins a recording for the caller.
+func (s *Server) StarRecording(ctx context.Context, req *connect.Request[secretaryv1.StarRecordingRequest]) (*connect.Response[secretaryv1.StarRecordingResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.favorites.StarRecording(ctx, db.StarRecordingParams{UserID: int32(userID), RecordingID: int32(req.Msg.Id)}); err != nil {
+		return nil, apierr.Wrap(err, "failed to star recording")
+	}
+	return connect.NewResponse(&secretaryv1.StarRecordingResponse{}), nil
+}
+
+// UnstarRecording removes the caller's star from a recording.
+func (s *Server) UnstarRecording(ctx context.Context, req *connect.Request[secretaryv1.UnstarRecordingRequest]) (*connect.Response[secretaryv1.UnstarRecordingResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.favorites.UnstarRecording(ctx, db.UnstarRecordingParams{UserID: int32(userID), RecordingID: int32(req.Msg.Id)}); err != nil {
+		return nil, apierr.Wrap(err, "failed to unstar recording")
+	}
+	return connect.NewResponse(&secretaryv1.UnstarRecordingResponse{}), nil
+}
+
+// StarTodo pins a todo for the caller.
+func (s *Server) StarTodo(ctx context.Context, req *connect.Request[secretaryv1.StarTodoRequest]) (*connect.Response[secretaryv1.StarTodoResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.favorites.StarTodo(ctx, db.StarTodoParams{UserID: int32(userID), TodoID: int32(req.Msg.Id)}); err != nil {
+		return nil, apierr.Wrap(err, "failed to star todo")
+	}
+	return connect.NewResponse(&secretaryv1.StarTodoResponse{}), nil
+}
+
+// UnstarTodo removes the caller's star from a todo.
+func (s *Server) UnstarTodo(ctx context.Context, req *connect.Request[secretaryv1.UnstarTodoRequest]) (*connect.Response[secretaryv1.UnstarTodoResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.favorites.UnstarTodo(ctx, db.UnstarTodoParams{UserID: int32(userID), TodoID: int32(req.Msg.Id)}); err != nil {
+		return nil, apierr.Wrap(err, "failed to unstar todo")
+	}
+	return connect.NewResponse(&secretaryv1.UnstarTodoResponse{}), nil
+}
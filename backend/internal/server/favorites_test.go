@@ -0,0 +1,194 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// fakeFavorites keeps each user's starred recordings and todos in memory.
+type fakeFavorites struct {
+	recordings map[int32][]int32
+	todos      map[int32][]int32
+}
+
+func newFakeFavorites() *fakeFavorites {
+	return &fakeFavorites{recordings: map[int32][]int32{}, todos: map[int32][]int32{}}
+}
+
+func star(ids []int32, id int32) []int32 {
+	if slices.Contains(ids, id) {
+		return ids
+	}
+	ids = append(ids, id)
+	slices.Sort(ids)
+	return ids
+}
+
+func unstar(ids []int32, id int32) []int32 {
+	return slices.DeleteFunc(ids, func(v int32) bool { return v == id })
+}
+
+func (f *fakeFavorites) StarRecording(_ context.Context, arg db.StarRecordingParams) error {
+	f.recordings[arg.UserID] = star(f.recordings[arg.UserID], arg.RecordingID)
+	return nil
+}
+
+func (f *fakeFavorites) UnstarRecording(_ context.Context, arg db.UnstarRecordingParams) error {
+	f.recordings[arg.UserID] = unstar(f.recordings[arg.UserID], arg.RecordingID)
+	return nil
+}
+
+func (f *fakeFavorites) StarTodo(_ context.Context, arg db.StarTodoParams) error {
+	f.todos[arg.UserID] = star(f.todos[arg.UserID], arg.TodoID)
+	return nil
+}
+
+func (f *fakeFavorites) UnstarTodo(_ context.Context, arg db.UnstarTodoParams) error {
+	f.todos[arg.UserID] = unstar(f.todos[arg.UserID], arg.TodoID)
+	return nil
+}
+
+func (f *fakeFavorites) ListStarredRecordingIDs(_ context.Context, userID int32) ([]int32, error) {
+	return f.recordings[userID], nil
+}
+
+func (f *fakeFavorites) ListStarredTodoIDs(_ context.Context, userID int32) ([]int32, error) {
+	return f.todos[userID], nil
+}
+
+// listedRecordings serves a fixed recording list.
+type listedRecordings struct {
+	RecordingStore
+	rows []db.ListRecordingsRow
+}
+
+func (l listedRecordings) GetRecordingsVersion(context.Context) (db.GetRecordingsVersionRow, error) {
+	return db.GetRecordingsVersionRow{Total: int64(len(l.rows))}, nil
+}
+
+func (l listedRecordings) ListRecordings(context.Context, db.ListRecordingsParams) ([]db.ListRecordingsRow, error) {
+	return l.rows, nil
+}
+
+func TestStarredRecordings(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(listedRecordings{rows: []db.ListRecordingsRow{{ID: 1}, {ID: 2}, {ID: 3}}}, nil, nil)
+	srv.favorites = newFakeFavorites()
+	alice := withPrincipal(context.Background(), Principal{UserID: 5})
+	bob := withPrincipal(context.Background(), Principal{UserID: 6})
+
+	list := func(ctx context.Context, starredOnly bool) (ids []int64, starred []int64, etag string) {
+		t.Helper()
+		res, err := srv.ListRecordings(ctx, connect.NewRequest(&secretaryv1.ListRecordingsRequest{StarredOnly: starredOnly}))
+		if err != nil {
+			t.Fatalf("ListRecordings: %v", err)
+		}
+		for _, rec := range res.Msg.Recordings {
+			ids = append(ids, rec.Id)
+			if rec.Starred {
+				starred = append(starred, rec.Id)
+			}
+		}
+		return ids, starred, res.Header().Get("ETag")
+	}
+
+	_, _, before := list(alice, false)
+	if _, err := srv.StarRecording(alice, connect.NewRequest(&secretaryv1.StarRecordingRequest{Id: 2})); err != nil {
+		t.Fatalf("StarRecording: %v", err)
+	}
+
+	ids, starred, after := list(alice, false)
+	if len(ids) != 3 || !slices.Equal(starred, []int64{2}) {
+		t.Fatalf("recordings = %v, starred = %v", ids, starred)
+	}
+	if after == before {
+		t.Fatal("starring a recording did not change the ETag")
+	}
+	if ids, _, _ := list(alice, true); !slices.Equal(ids, []int64{2}) {
+		t.Fatalf("starred only = %v, want [2]", ids)
+	}
+
+	// The cached list is shared, the stars are not.
+	if ids, starred, _ := list(bob, false); len(ids) != 3 || starred != nil {
+		t.Fatalf("bob sees recordings %v starred %v", ids, starred)
+	}
+	if ids, _, _ := list(bob, true); ids != nil {
+		t.Fatalf("bob's starred only = %v, want none", ids)
+	}
+
+	if _, err := srv.UnstarRecording(alice, connect.NewRequest(&secretaryv1.UnstarRecordingRequest{Id: 2})); err != nil {
+		t.Fatalf("UnstarRecording: %v", err)
+	}
+	if _, starred, _ := list(alice, false); starred != nil {
+		t.Fatalf("starred after unstarring = %v", starred)
+	}
+}
+
+// todoCountedRecordings serves one recording whose todo counts can change
+// without the recording itself being updated.
+type todoCountedRecordings struct {
+	RecordingStore
+	open, done int32
+	todosAt    time.Time
+}
+
+func (r *todoCountedRecordings) GetRecordingsVersion(context.Context) (db.GetRecordingsVersionRow, error) {
+	return db.GetRecordingsVersionRow{Total: 1, Todos: int64(r.open + r.done), TodosLatest: pgtype.Timestamptz{Time: r.todosAt, Valid: true}}, nil
+}
+
+func (r *todoCountedRecordings) ListRecordings(context.Context, db.ListRecordingsParams) ([]db.ListRecordingsRow, error) {
+	return []db.ListRecordingsRow{{ID: 1, OpenTodoCount: r.open, DoneTodoCount: r.done}}, nil
+}
+
+func TestRecordingTodoCountsFollowTodos(t *testing.T) {
+	recordings := &todoCountedRecordings{open: 2, todosAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(recordings, nil, nil)
+	srv.favorites = newFakeFavorites()
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+	list := func() *secretaryv1.Recording {
+		t.Helper()
+		res, err := srv.ListRecordings(ctx, connect.NewRequest(&secretaryv1.ListRecordingsRequest{}))
+		if err != nil || len(res.Msg.Recordings) != 1 {
+			t.Fatalf("ListRecordings: %+v, %v", res, err)
+		}
+		return res.Msg.Recordings[0]
+	}
+
+	if rec := list(); rec.OpenTodoCount != 2 || rec.DoneTodoCount != 0 {
+		t.Fatalf("counts = %d open, %d done", rec.OpenTodoCount, rec.DoneTodoCount)
+	}
+	// Finishing a todo leaves the recording row alone; the cached list
+	// must still be refreshed.
+	recordings.open, recordings.done, recordings.todosAt = 1, 1, recordings.todosAt.Add(time.Minute)
+	if rec := list(); rec.OpenTodoCount != 1 || rec.DoneTodoCount != 1 {
+		t.Fatalf("counts after finishing a todo = %d open, %d done", rec.OpenTodoCount, rec.DoneTodoCount)
+	}
+}
+
+func TestStarredTodo(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, &fakeTodos{todos: map[int32]db.GetTodoRow{7: {ID: 7, Name: "Ship it"}}}, nil)
+	srv.favorites = newFakeFavorites()
+	srv.reactions = &fakeReactions{}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	if _, err := srv.StarTodo(ctx, connect.NewRequest(&secretaryv1.StarTodoRequest{Id: 7})); err != nil {
+		t.Fatalf("StarTodo: %v", err)
+	}
+	resp, err := srv.GetTodo(ctx, connect.NewRequest(&secretaryv1.GetTodoRequest{Id: 7}))
+	if err != nil {
+		t.Fatalf("GetTodo: %v", err)
+	}
+	if !resp.Msg.Todo.Starred {
+		t.Fatal("todo is not starred")
+	}
+}
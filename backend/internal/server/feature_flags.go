@@ -0,0 +1,212 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// FeatureFlagStore keeps which experimental features are on for the
+// organization and for particular users.
+type FeatureFlagStore interface {
+	ListFeatureFlags(ctx context.Context) ([]db.FeatureFlag, error)
+	ListFeatureFlagUsers(ctx context.Context) ([]db.FeatureFlagUser, error)
+	ListUserFeatureFlags(ctx context.Context, userID int32) ([]db.FeatureFlagUser, error)
+	SetFeatureFlag(ctx context.Context, arg db.SetFeatureFlagParams) (db.FeatureFlag, error)
+	SetUserFeatureFlag(ctx context.Context, arg db.SetUserFeatureFlagParams) error
+	DeleteUserFeatureFlag(ctx context.Context, arg db.DeleteUserFeatureFlagParams) error
+}
+
+// The feature flags. Every flag is off until an admin turns it on.
+const (
+	flagSemanticSearch = "semantic-search"
+	flagAutoExtraction = "auto-extraction"
+)
+
+type featureFlag struct {
+	name        string
+	description string
+}
+
+// featureFlags lists the flags in the order admins see them. Rows for
+// flags no longer listed are ignored.
+var featureFlags = []featureFlag{
+	{flagSemanticSearch, "Search recordings and todos by meaning rather than exact words."},
+	{flagAutoExtraction, "Suggest todos and decisions from transcripts as recordings finish processing."},
+}
+
+func findFeatureFlag(name string) *featureFlag {
+	for i := range featureFlags {
+		if featureFlags[i].name == name {
+			return &featureFlags[i]
+		}
+	}
+	return nil
+}
+
+// enabledFeatures returns the flags on for userID: the user's override
+// where there is one, the organization's setting otherwise.
+func (s *Server) enabledFeatures(ctx context.Context, userID int64) ([]string, error) {
+	orgRows, err := s.featureFlags.ListFeatureFlags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userRows, err := s.featureFlags.ListUserFeatureFlags(ctx, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+	enabled := map[string]bool{}
+	for _, row := range orgRows {
+		enabled[row.Name] = row.Enabled
+	}
+	for _, row := range userRows {
+		enabled[row.Name] = row.Enabled
+	}
+	var names []string
+	for _, flag := range featureFlags {
+		if enabled[flag.name] {
+			names = append(names, flag.name)
+		}
+	}
+	return names, nil
+}
+
+// featureEnabled reports whether the named flag is on for userID.
+func (s *Server) featureEnabled(ctx context.Context, userID int64, name string) (bool, error) {
+	names, err := s.enabledFeatures(ctx, userID)
+	if err != nil {
+		return false, apierr.Wrap(err, "failed to read feature flags")
+	}
+	for _, enabled := range names {
+		if enabled == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func featureFlagToProto(flag featureFlag, row db.FeatureFlag, overrides []db.FeatureFlagUser) *secretaryv1.FeatureFlag {
+	res := &secretaryv1.FeatureFlag{
+		Name:        flag.name,
+		Description: flag.description,
+		Enabled:     row.Enabled,
+		UpdatedAt:   formatTime(row.UpdatedAt),
+	}
+	for _, override := range overrides {
+		if override.Name == flag.name {
+			res.Overrides = append(res.Overrides, &secretaryv1.FeatureFlagOverride{UserId: int64(override.UserID), Enabled: override.Enabled})
+		}
+	}
+	return res
+}
+
+// loadFeatureFlag returns the named flag's settings as admins see them.
+func (s *Server) loadFeatureFlag(ctx context.Context, flag featureFlag) (*secretaryv1.FeatureFlag, error) {
+	flags, err := s.listFeatureFlags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range flags {
+		if res.Name == flag.name {
+			return res, nil
+		}
+	}
+	return featureFlagToProto(flag, db.FeatureFlag{}, nil), nil
+}
+
+func (s *Server) listFeatureFlags(ctx context.Context) ([]*secretaryv1.FeatureFlag, error) {
+	rows, err := s.featureFlags.ListFeatureFlags(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list feature flags")
+	}
+	overrides, err := s.featureFlags.ListFeatureFlagUsers(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list feature flag overrides")
+	}
+	byName := map[string]db.FeatureFlag{}
+	for _, row := range rows {
+		byName[row.Name] = row
+	}
+	res := make([]*secretaryv1.FeatureFlag, 0, len(featureFlags))
+	for _, flag := range featureFlags {
+		res = append(res, featureFlagToProto(flag, byName[flag.name], overrides))
+	}
+	return res, nil
+}
+
+func (s *Server) ListFeatureFlags(ctx context.Context, req *connect.Request[secretaryv1.ListFeatureFlagsRequest]) (*connect.Response[secretaryv1.ListFeatureFlagsResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can list feature flags"); err != nil {
+		return nil, err
+	}
+	flags, err := s.listFeatureFlags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.ListFeatureFlagsResponse{Flags: flags}), nil
+}
+
+func (s *Server) UpdateFeatureFlag(ctx context.Context, req *connect.Request[secretaryv1.UpdateFeatureFlagRequest]) (*connect.Response[secretaryv1.UpdateFeatureFlagResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can change feature flags"); err != nil {
+		return nil, err
+	}
+	flag := findFeatureFlag(req.Msg.Name)
+	if flag == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("feature flag not found"))
+	}
+	userID := currentUserID(ctx)
+	if _, err := s.featureFlags.SetFeatureFlag(ctx, db.SetFeatureFlagParams{
+		Name:            flag.name,
+		Enabled:         req.Msg.Enabled,
+		UpdatedByUserID: pgtype.Int4{Int32: int32(userID), Valid: true},
+	}); err != nil {
+		return nil, apierr.Wrap(err, "failed to update feature flag")
+	}
+	log.Printf("feature flags: user %d turned %s %s", userID, flag.name, onOff(req.Msg.Enabled))
+	res, err := s.loadFeatureFlag(ctx, *flag)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.UpdateFeatureFlagResponse{Flag: res}), nil
+}
+
+func (s *Server) SetUserFeatureFlag(ctx context.Context, req *connect.Request[secretaryv1.SetUserFeatureFlagRequest]) (*connect.Response[secretaryv1.SetUserFeatureFlagResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can change feature flags"); err != nil {
+		return nil, err
+	}
+	flag := findFeatureFlag(req.Msg.Name)
+	if flag == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("feature flag not found"))
+	}
+	target := int32(req.Msg.UserId)
+	userID := currentUserID(ctx)
+	if req.Msg.Enabled == nil {
+		if err := s.featureFlags.DeleteUserFeatureFlag(ctx, db.DeleteUserFeatureFlagParams{Name: flag.name, UserID: target}); err != nil {
+			return nil, apierr.Wrap(err, "failed to remove feature flag override")
+		}
+		log.Printf("feature flags: user %d removed the %s override for user %d", userID, flag.name, target)
+	} else {
+		if err := s.featureFlags.SetUserFeatureFlag(ctx, db.SetUserFeatureFlagParams{Name: flag.name, UserID: target, Enabled: req.Msg.GetEnabled()}); err != nil {
+			return nil, apierr.Wrap(err, "failed to set feature flag override")
+		}
+		log.Printf("feature flags: user %d turned %s %s for user %d", userID, flag.name, onOff(req.Msg.GetEnabled()), target)
+	}
+	res, err := s.loadFeatureFlag(ctx, *flag)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.SetUserFeatureFlagResponse{Flag: res}), nil
+}
+
+func onOff(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+	return "off"
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+type fakeFeatureFlags struct {
+	org   map[string]bool
+	users map[db.DeleteUserFeatureFlagParams]bool
+}
+
+func newFakeFeatureFlags() *fakeFeatureFlags {
+	return &fakeFeatureFlags{org: map[string]bool{}, users: map[db.DeleteUserFeatureFlagParams]bool{}}
+}
+
+func (f *fakeFeatureFlags) ListFeatureFlags(context.Context) ([]db.FeatureFlag, error) {
+	var rows []db.FeatureFlag
+	for name, enabled := range f.org {
+		rows = append(rows, db.FeatureFlag{Name: name, Enabled: enabled})
+	}
+	return rows, nil
+}
+
+func (f *fakeFeatureFlags) ListFeatureFlagUsers(context.Context) ([]db.FeatureFlagUser, error) {
+	var rows []db.FeatureFlagUser
+	for key, enabled := range f.users {
+		rows = append(rows, db.FeatureFlagUser{Name: key.Name, UserID: key.UserID, Enabled: enabled})
+	}
+	return rows, nil
+}
+
+func (f *fakeFeatureFlags) ListUserFeatureFlags(ctx context.Context, userID int32) ([]db.FeatureFlagUser, error) {
+	all, _ := f.ListFeatureFlagUsers(ctx)
+	var rows []db.FeatureFlagUser
+	for _, row := range all {
+		if row.UserID == userID {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeFeatureFlags) SetFeatureFlag(_ context.Context, arg db.SetFeatureFlagParams) (db.FeatureFlag, error) {
+	f.org[arg.Name] = arg.Enabled
+	return db.FeatureFlag{Name: arg.Name, Enabled: arg.Enabled}, nil
+}
+
+func (f *fakeFeatureFlags) SetUserFeatureFlag(_ context.Context, arg db.SetUserFeatureFlagParams) error {
+	f.users[db.DeleteUserFeatureFlagParams{Name: arg.Name, UserID: arg.UserID}] = arg.Enabled
+	return nil
+}
+
+func (f *fakeFeatureFlags) DeleteUserFeatureFlag(_ context.Context, arg db.DeleteUserFeatureFlagParams) error {
+	delete(f.users, arg)
+	return nil
+}
+
+func TestFeatureFlags(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	srv.featureFlags = newFakeFeatureFlags()
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+
+	if on, err := srv.featureEnabled(ctx, 2, flagSemanticSearch); err != nil || on {
+		t.Fatalf("semantic search on = %v, err = %v; flags start off", on, err)
+	}
+	if _, err := srv.UpdateFeatureFlag(ctx, connect.NewRequest(&secretaryv1.UpdateFeatureFlagRequest{Name: flagSemanticSearch, Enabled: true})); err != nil {
+		t.Fatal(err)
+	}
+	off := false
+	res, err := srv.SetUserFeatureFlag(ctx, connect.NewRequest(&secretaryv1.SetUserFeatureFlagRequest{Name: flagSemanticSearch, UserId: 3, Enabled: &off}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if flag := res.Msg.Flag; !flag.Enabled || len(flag.Overrides) != 1 || flag.Overrides[0].UserId != 3 {
+		t.Fatalf("flag = %+v", flag)
+	}
+	on := true
+	if _, err := srv.SetUserFeatureFlag(ctx, connect.NewRequest(&secretaryv1.SetUserFeatureFlagRequest{Name: flagAutoExtraction, UserId: 3, Enabled: &on})); err != nil {
+		t.Fatal(err)
+	}
+
+	// The organization's setting applies unless the user has an override.
+	for userID, want := range map[int64][]string{2: {flagSemanticSearch}, 3: {flagAutoExtraction}} {
+		got, err := srv.enabledFeatures(ctx, userID)
+		if err != nil || !slices.Equal(got, want) {
+			t.Fatalf("user %d: flags = %v, err = %v; want %v", userID, got, err, want)
+		}
+	}
+
+	// Removing the override puts the user back on the organization's setting.
+	if _, err := srv.SetUserFeatureFlag(ctx, connect.NewRequest(&secretaryv1.SetUserFeatureFlagRequest{Name: flagSemanticSearch, UserId: 3})); err != nil {
+		t.Fatal(err)
+	}
+	if on, _ := srv.featureEnabled(ctx, 3, flagSemanticSearch); !on {
+		t.Fatal("semantic search still off for user 3 after removing the override")
+	}
+
+	if _, err := srv.UpdateFeatureFlag(ctx, connect.NewRequest(&secretaryv1.UpdateFeatureFlagRequest{Name: "time-travel", Enabled: true})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("unknown flag: %v", err)
+	}
+	srv.ConfigureStores(nil, nil, memberUsers{})
+	if _, err := srv.ListFeatureFlags(ctx, connect.NewRequest(&secretaryv1.ListFeatureFlagsRequest{})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member listing flags: %v", err)
+	}
+}
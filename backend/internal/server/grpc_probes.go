@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+	"connectrpc.com/grpcreflect"
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+)
+
+const healthCheckTimeout = 2 * time.Second
+
+// serviceNames lists every Connect service mounted by Routes, for health
+// checks and reflection.
+var serviceNames = []string{
+	secretaryv1connect.RecordingsServiceName,
+	secretaryv1connect.TodosServiceName,
+	secretaryv1connect.UsersServiceName,
+	secretaryv1connect.WorkspacesServiceName,
+	secretaryv1connect.DocumentsServiceName,
+	secretaryv1connect.ActivitiesServiceName,
+	secretaryv1connect.AIServiceName,
+	secretaryv1connect.AnalyticsServiceName,
+	secretaryv1connect.OutcomesServiceName,
+	secretaryv1connect.ActivityServiceName,
+	secretaryv1connect.AnnotationsServiceName,
+	secretaryv1connect.AttachmentsServiceName,
+	secretaryv1connect.PromptTemplatesServiceName,
+	secretaryv1connect.NotificationsServiceName,
+	secretaryv1connect.SettingsServiceName,
+	secretaryv1connect.QuarantineServiceName,
+	secretaryv1connect.KeywordAlertsServiceName,
+	secretaryv1connect.AdminServiceName,
+	secretaryv1connect.JobsServiceName,
+}
+
+// mountGRPCProbes registers the standard gRPC health and reflection services
+// so grpcurl, load balancers, and meshes can probe the API. Both are
+// unauthenticated, like /healthz.
+func (s *Server) mountGRPCProbes(mux *http.ServeMux) {
+	mux.Handle(grpchealth.NewHandler(s))
+
+	reflector := grpcreflect.NewStaticReflector(serviceNames...)
+	mux.Handle(grpcreflect.NewHandlerV1(reflector))
+	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
+}
+
+// Check implements grpchealth.Checker. Every service depends on Postgres, so
+// the process and each service report NOT_SERVING when the database is
+// unreachable.
+func (s *Server) Check(ctx context.Context, req *grpchealth.CheckRequest) (*grpchealth.CheckResponse, error) {
+	if req.Service != "" && !knownService(req.Service) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unknown service %s", req.Service))
+	}
+	if err := s.pingDatabase(ctx); err != nil {
+		return &grpchealth.CheckResponse{Status: grpchealth.StatusNotServing}, nil
+	}
+	return &grpchealth.CheckResponse{Status: grpchealth.StatusServing}, nil
+}
+
+func (s *Server) pingDatabase(ctx context.Context) error {
+	if s.db == nil {
+		return errors.New("database is not configured")
+	}
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	return s.db.Ping(ctx)
+}
+
+func knownService(name string) bool {
+	if name == grpchealth.HealthV1ServiceName {
+		return true
+	}
+	for _, service := range serviceNames {
+		if service == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+)
+
+func TestHealthCheckWithoutDatabase(t *testing.T) {
+	s := New(nil, []byte("test"), time.Hour)
+
+	resp, err := s.Check(context.Background(), &grpchealth.CheckRequest{Service: "secretary.v1.TodosService"})
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if resp.Status != grpchealth.StatusNotServing {
+		t.Fatalf("expected not serving without a database, got %s", resp.Status)
+	}
+
+	_, err = s.Check(context.Background(), &grpchealth.CheckRequest{Service: "secretary.v1.MissingService"})
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected not found for unknown service, got %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"errors"
+	netmail "net/mail"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+func guestToProto(row db.GuestParticipant) *secretaryv1.GuestParticipant {
+	guest := &secretaryv1.GuestParticipant{
+		Id:        int64(row.ID),
+		Name:      row.Name,
+		Email:     row.Email.String,
+		SpeakerId: -1,
+	}
+	if row.SpeakerID.Valid {
+		guest.SpeakerId = row.SpeakerID.Int32
+	}
+	return guest
+}
+
+// AddGuestParticipant records an attendee who has no account, so they show
+// up in the recording's participants and minutes by name.
+func (s *Server) AddGuestParticipant(ctx context.Context, req *connect.Request[secretaryv1.AddGuestParticipantRequest]) (*connect.Response[secretaryv1.AddGuestParticipantResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recordingID := int32(req.Msg.RecordingId)
+	params := db.AddGuestParticipantParams{
+		RecordingID:     recordingID,
+		Name:            strings.TrimSpace(req.Msg.Name),
+		CreatedByUserID: pgtype.Int4{Int32: int32(userID), Valid: true},
+	}
+	if email := strings.TrimSpace(req.Msg.Email); email != "" {
+		parsed, err := netmail.ParseAddress(email)
+		if err != nil || parsed.Address != email {
+			return nil, apierr.InvalidField("email", "must be a plain email address")
+		}
+		params.Email = pgtype.Text{String: email, Valid: true}
+	}
+	if req.Msg.SpeakerId != nil {
+		speaker := req.Msg.GetSpeakerId()
+		participants, err := s.recordings.ListRecordingParticipants(ctx, recordingID)
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to list recording participants")
+		}
+		for _, p := range participants {
+			if p.SpeakerID == speaker {
+				return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("speaker is already mapped to a user"))
+			}
+		}
+		params.SpeakerID = pgtype.Int4{Int32: speaker, Valid: true}
+	}
+
+	row, err := s.recordings.AddGuestParticipant(ctx, params)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to add guest")
+	}
+	s.recordingCache.invalidate()
+	return connect.NewResponse(&secretaryv1.AddGuestParticipantResponse{Guest: guestToProto(row)}), nil
+}
+
+func (s *Server) RemoveGuestParticipant(ctx context.Context, req *connect.Request[secretaryv1.RemoveGuestParticipantRequest]) (*connect.Response[secretaryv1.RemoveGuestParticipantResponse], error) {
+	if _, err := requireUserID(ctx); err != nil {
+		return nil, err
+	}
+	removed, err := s.recordings.RemoveGuestParticipant(ctx, db.RemoveGuestParticipantParams{
+		ID:          int32(req.Msg.Id),
+		RecordingID: int32(req.Msg.RecordingId),
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to remove guest")
+	}
+	if removed == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("guest not found"))
+	}
+	s.recordingCache.invalidate()
+	return connect.NewResponse(&secretaryv1.RemoveGuestParticipantResponse{}), nil
+}
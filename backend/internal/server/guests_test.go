@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// guestRecordings keeps the guests of recording 3, where user 8 is
+// speaker 0.
+type guestRecordings struct {
+	*fakeRecordingStatus
+	guests []db.GuestParticipant
+}
+
+func (g *guestRecordings) ListRecordingParticipants(context.Context, int32) ([]db.ListRecordingParticipantsRow, error) {
+	return []db.ListRecordingParticipantsRow{{ID: 8, FirstName: "Ana", SpeakerID: 0}}, nil
+}
+
+func (g *guestRecordings) ListRecordingGuests(context.Context, int32) ([]db.GuestParticipant, error) {
+	return g.guests, nil
+}
+
+func (g *guestRecordings) AddGuestParticipant(_ context.Context, arg db.AddGuestParticipantParams) (db.GuestParticipant, error) {
+	if arg.RecordingID != 3 {
+		return db.GuestParticipant{}, pgx.ErrNoRows
+	}
+	row := db.GuestParticipant{ID: int32(len(g.guests) + 1), RecordingID: arg.RecordingID, SpeakerID: arg.SpeakerID, Name: arg.Name, Email: arg.Email, CreatedByUserID: arg.CreatedByUserID}
+	g.guests = append(g.guests, row)
+	return row, nil
+}
+
+func (g *guestRecordings) RemoveGuestParticipant(_ context.Context, arg db.RemoveGuestParticipantParams) (int64, error) {
+	n := len(g.guests)
+	g.guests = slices.DeleteFunc(g.guests, func(row db.GuestParticipant) bool { return row.ID == arg.ID && row.RecordingID == arg.RecordingID })
+	return int64(n - len(g.guests)), nil
+}
+
+func TestGuestParticipants(t *testing.T) {
+	recordings := &guestRecordings{fakeRecordingStatus: &fakeRecordingStatus{status: recordingReady}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(recordings, nil, memberUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	add := func(msg *secretaryv1.AddGuestParticipantRequest) (*secretaryv1.GuestParticipant, error) {
+		resp, err := srv.AddGuestParticipant(ctx, connect.NewRequest(msg))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Guest, nil
+	}
+	if _, err := add(&secretaryv1.AddGuestParticipantRequest{RecordingId: 3, Name: "Lee", Email: "Lee <lee@example.com>"}); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("email with a display name: %v", err)
+	}
+	speaker := int32(0)
+	if _, err := add(&secretaryv1.AddGuestParticipantRequest{RecordingId: 3, Name: "Lee", SpeakerId: &speaker}); connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Fatalf("speaker mapped to a user: %v", err)
+	}
+	if _, err := add(&secretaryv1.AddGuestParticipantRequest{RecordingId: 4, Name: "Lee"}); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("missing recording: %v", err)
+	}
+
+	speaker = 1
+	guest, err := add(&secretaryv1.AddGuestParticipantRequest{RecordingId: 3, Name: "  Lee Chen ", Email: "lee@example.com", SpeakerId: &speaker})
+	if err != nil || guest.Name != "Lee Chen" || guest.Email != "lee@example.com" || guest.SpeakerId != 1 {
+		t.Fatalf("add: %v, %v", guest, err)
+	}
+	if recordings.guests[0].CreatedByUserID.Int32 != 5 {
+		t.Fatalf("guest = %+v", recordings.guests[0])
+	}
+	unknown, err := add(&secretaryv1.AddGuestParticipantRequest{RecordingId: 3, Name: "Sam"})
+	if err != nil || unknown.SpeakerId != -1 || unknown.Email != "" {
+		t.Fatalf("guest without a speaker: %v, %v", unknown, err)
+	}
+
+	// Guests attend the minutes by name, without a user id.
+	attendees, err := srv.minutesAttendees(ctx, 3)
+	if err != nil || len(attendees) != 3 || attendees[1].Name != "Lee Chen" || attendees[1].UserId != 0 {
+		t.Fatalf("attendees = %v, %v", attendees, err)
+	}
+
+	if _, err := srv.RemoveGuestParticipant(ctx, connect.NewRequest(&secretaryv1.RemoveGuestParticipantRequest{RecordingId: 4, Id: guest.Id})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("removing from another recording: %v", err)
+	}
+	if _, err := srv.RemoveGuestParticipant(ctx, connect.NewRequest(&secretaryv1.RemoveGuestParticipantRequest{RecordingId: 3, Id: guest.Id})); err != nil {
+		t.Fatal(err)
+	}
+	if len(recordings.guests) != 1 || recordings.guests[0].Name != "Sam" {
+		t.Fatalf("guests = %+v", recordings.guests)
+	}
+}
@@ -0,0 +1,273 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/shared"
+)
+
+const (
+	// ipAllowlistBypassHeader carries IP_ALLOWLIST_BYPASS_TOKEN for an
+	// admin the allowlist locked out.
+	ipAllowlistBypassHeader = "X-IP-Allowlist-Bypass"
+	// ipAllowlistAuditInterval is how often rejections of one address, or
+	// bypasses by one admin from one address, are written to the audit
+	// log, so a client retrying in a loop doesn't flood it.
+	ipAllowlistAuditInterval = 5 * time.Minute
+)
+
+var errIPNotAllowed = errors.New("your network address is not allowed to use the API")
+
+// IPAllowlistConfig is the server side of the organization's allowlist:
+// how to find a caller's address, and the emergency bypass.
+type IPAllowlistConfig struct {
+	// TrustedProxies are the load balancers and proxies in front of the
+	// server. For requests from them, the caller's address is taken from
+	// X-Forwarded-For.
+	TrustedProxies []netip.Prefix
+	// BypassToken lets an admin who sends it in X-IP-Allowlist-Bypass in
+	// from any address. Empty disables the bypass.
+	BypassToken string
+}
+
+func (s *Server) ConfigureIPAllowlist(cfg IPAllowlistConfig) {
+	s.ipAllowlist = cfg
+}
+
+// throttle remembers when something was last done for a key, to do it at
+// most once per interval.
+type throttle struct {
+	mu   sync.Mutex
+	last map[string]time.Time
+}
+
+// due reports whether key is due again, and if so counts this time.
+func (t *throttle) due(key string, now time.Time, interval time.Duration) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.last == nil {
+		t.last = map[string]time.Time{}
+	}
+	if at, ok := t.last[key]; ok && now.Sub(at) < interval {
+		return false
+	}
+	for k, at := range t.last {
+		if now.Sub(at) >= interval {
+			delete(t.last, k)
+		}
+	}
+	t.last[key] = now
+	return true
+}
+
+func (c IPAllowlistConfig) trusted(ip netip.Addr) bool {
+	for _, p := range c.TrustedProxies {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the caller's address: the peer's, or for a peer that
+// is a trusted proxy, the nearest address in X-Forwarded-For that isn't
+// one. The zero Addr means it couldn't be told.
+func (c IPAllowlistConfig) clientIP(remoteAddr string, header http.Header) netip.Addr {
+	ip := peerAddr(remoteAddr)
+	if !ip.IsValid() || !c.trusted(ip) {
+		return ip
+	}
+	hops := strings.Split(strings.Join(header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			return ip
+		}
+		ip = hop.Unmap()
+		if !c.trusted(ip) {
+			return ip
+		}
+	}
+	return ip
+}
+
+func peerAddr(remoteAddr string) netip.Addr {
+	if addrPort, err := netip.ParseAddrPort(remoteAddr); err == nil {
+		return addrPort.Addr().Unmap()
+	}
+	if addr, err := netip.ParseAddr(remoteAddr); err == nil {
+		return addr.Unmap()
+	}
+	return netip.Addr{}
+}
+
+// locationHeaders are the city, region and country headers that CDNs and
+// load balancers can add, in Cloudflare's, CloudFront's, Google's and
+// Vercel's names.
+var locationHeaders = [][3]string{
+	{"CF-IPCity", "CF-Region-Code", "CF-IPCountry"},
+	{"CloudFront-Viewer-City", "CloudFront-Viewer-Country-Region", "CloudFront-Viewer-Country"},
+	{"X-AppEngine-City", "X-AppEngine-Region", "X-AppEngine-Country"},
+	{"X-Vercel-IP-City", "X-Vercel-IP-Country-Region", "X-Vercel-IP-Country"},
+}
+
+// location returns roughly where the caller is, e.g. "Lisbon, 11, PT", as
+// a trusted proxy reports it. Headers from anyone else are ignored, since
+// the caller could have set them.
+func (c IPAllowlistConfig) location(remoteAddr string, header http.Header) string {
+	if ip := peerAddr(remoteAddr); !ip.IsValid() || !c.trusted(ip) {
+		return ""
+	}
+	for _, names := range locationHeaders {
+		var parts []string
+		for _, name := range names {
+			value := strings.TrimSpace(header.Get(name))
+			if unescaped, err := url.PathUnescape(value); err == nil {
+				value = unescaped
+			}
+			// Cloudflare's unknown country and Tor.
+			if value != "" && value != "XX" && value != "T1" {
+				parts = append(parts, value)
+			}
+		}
+		if len(parts) > 0 {
+			return strings.Join(parts, ", ")
+		}
+	}
+	return ""
+}
+
+// normalizeIPAllowlist parses CIDR ranges and single addresses into the
+// stored form: masked ranges, without duplicates.
+func normalizeIPAllowlist(entries []string) ([]string, error) {
+	out := []string{}
+	seen := map[netip.Prefix]bool{}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		var prefix netip.Prefix
+		if strings.Contains(entry, "/") {
+			p, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a CIDR range", entry)
+			}
+			prefix = p.Masked()
+		} else {
+			addr, err := netip.ParseAddr(entry)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not an IP address", entry)
+			}
+			addr = addr.Unmap()
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		if !seen[prefix] {
+			seen[prefix] = true
+			out = append(out, prefix.String())
+		}
+	}
+	return out, nil
+}
+
+// ipAllowed reports whether the allowlist covers ip. An empty list covers
+// every address.
+func ipAllowed(list []string, ip netip.Addr) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for _, entry := range list {
+		if p, err := netip.ParsePrefix(entry); err == nil && p.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkIPAllowlist lets an authenticated request through when the
+// organization's allowlist covers ip, or when an admin sends the bypass
+// token. Rejections and bypasses go to the audit log.
+func (s *Server) checkIPAllowlist(ctx context.Context, ip netip.Addr, header http.Header, where string) error {
+	if ipAllowed(s.orgSettings().IpAllowlist, ip) {
+		return nil
+	}
+	principal, err := s.authenticate(header.Get("Authorization"))
+	if err == nil {
+		principal.IP = ip
+		ctx = withPrincipal(ctx, principal)
+	}
+	if token := header.Get(ipAllowlistBypassHeader); err == nil && token != "" && s.ipAllowlist.BypassToken != "" &&
+		subtle.ConstantTimeCompare([]byte(token), []byte(s.ipAllowlist.BypassToken)) == 1 &&
+		s.requireAdmin(ctx, "") == nil {
+		s.auditIPAllowlist(ctx, "IPAllowlist.Bypass", ip, where)
+		return nil
+	}
+	s.auditIPAllowlist(ctx, "IPAllowlist.Reject", ip, where)
+	return errIPNotAllowed
+}
+
+func (s *Server) auditIPAllowlist(ctx context.Context, action string, ip netip.Addr, where string) {
+	userID := currentUserID(ctx)
+	if !s.ipAuditLimit.due(fmt.Sprintf("%s %s %d", action, ip, userID), time.Now(), ipAllowlistAuditInterval) {
+		return
+	}
+	detail, _ := json.Marshal(map[string]any{"ip": ip.String(), "path": where, "user_id": userID})
+	s.audit(ctx, action, string(detail))
+}
+
+func (s *Server) GetIpAllowlist(ctx context.Context, req *connect.Request[secretaryv1.GetIpAllowlistRequest]) (*connect.Response[secretaryv1.GetIpAllowlistResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can see the IP allowlist"); err != nil {
+		return nil, err
+	}
+	ip := s.ipAllowlist.clientIP(req.Peer().Addr, req.Header())
+	return connect.NewResponse(&secretaryv1.GetIpAllowlistResponse{
+		Cidrs:         s.orgSettings().IpAllowlist,
+		YourIp:        ip.String(),
+		BypassEnabled: s.ipAllowlist.BypassToken != "",
+	}), nil
+}
+
+// SetIpAllowlist replaces the allowlist. It refuses a list that would
+// shut the caller out, so an admin can't lock themselves out by mistake.
+func (s *Server) SetIpAllowlist(ctx context.Context, req *connect.Request[secretaryv1.SetIpAllowlistRequest]) (*connect.Response[secretaryv1.SetIpAllowlistResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can change the IP allowlist"); err != nil {
+		return nil, err
+	}
+	cidrs, err := normalizeIPAllowlist(req.Msg.Cidrs)
+	if err != nil {
+		return nil, apierr.InvalidField("cidrs", err.Error())
+	}
+	ip := s.ipAllowlist.clientIP(req.Peer().Addr, req.Header())
+	if !ipAllowed(cidrs, ip) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the list leaves out your own address, %s; add it so you aren't locked out", ip))
+	}
+	userID := currentUserID(ctx)
+	row, err := s.settings.SetIPAllowlist(ctx, db.SetIPAllowlistParams{
+		IpAllowlist:     cidrs,
+		UpdatedByUserID: pgtype.Int4{Int32: int32(userID), Valid: true},
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to change the IP allowlist")
+	}
+	s.settingsCache.Store(&row)
+	s.publish(ctx, shared.Event{Kind: shared.EventSettingsChanged})
+	log.Printf("ip allowlist: user %d set %d range(s)", userID, len(cidrs))
+	return connect.NewResponse(&secretaryv1.SetIpAllowlistResponse{Cidrs: row.IpAllowlist}), nil
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+func TestClientIP(t *testing.T) {
+	cfg := IPAllowlistConfig{TrustedProxies: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}}
+	for _, tc := range []struct {
+		remote, forwarded, want string
+	}{
+		{"203.0.113.7:5000", "", "203.0.113.7"},
+		// Only a trusted proxy gets to say who the caller is.
+		{"203.0.113.7:5000", "198.51.100.1", "203.0.113.7"},
+		{"10.0.0.2:5000", "198.51.100.1", "198.51.100.1"},
+		// A client can prepend anything; the nearest untrusted hop wins.
+		{"10.0.0.2:5000", "1.2.3.4, 198.51.100.1, 10.0.0.3", "198.51.100.1"},
+		{"10.0.0.2:5000", "nonsense", "10.0.0.2"},
+		{"[::ffff:203.0.113.7]:5000", "", "203.0.113.7"},
+	} {
+		header := http.Header{}
+		if tc.forwarded != "" {
+			header.Set("X-Forwarded-For", tc.forwarded)
+		}
+		if got := cfg.clientIP(tc.remote, header); got.String() != tc.want {
+			t.Errorf("clientIP(%q, %q) = %s, want %s", tc.remote, tc.forwarded, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizeIPAllowlist(t *testing.T) {
+	got, err := normalizeIPAllowlist([]string{" 192.168.1.77/24", "192.168.1.0/24", "2001:db8::1", "", "198.51.100.4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"192.168.1.0/24", "2001:db8::1/128", "198.51.100.4/32"}; !slices.Equal(got, want) {
+		t.Fatalf("normalized = %v, want %v", got, want)
+	}
+	if _, err := normalizeIPAllowlist([]string{"10.0.0.0/33"}); err == nil {
+		t.Fatal("an invalid range was accepted")
+	}
+}
+
+func TestIPAllowlist(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.settings = &fakeSettings{}
+	audit := &fakeAuditLog{}
+	srv.auditLog = audit
+	srv.ConfigureStores(nil, nil, &deactivatingUsers{users: map[int32]db.GetUserRow{
+		1: {ID: 1, Role: optionalText("admin")},
+		2: {ID: 2, Role: optionalText("member")},
+	}})
+	srv.ConfigureIPAllowlist(IPAllowlistConfig{BypassToken: "let-me-in-let-me-in-let-me-in-!!"})
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	client := func(userID int64, bypass string) secretaryv1connect.AdminServiceClient {
+		token, err := srv.issueToken(userID, tokenClaims{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return secretaryv1connect.NewAdminServiceClient(ts.Client(), ts.URL, connect.WithInterceptors(connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+			return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+				req.Header().Set("Authorization", "Bearer "+token)
+				if bypass != "" {
+					req.Header().Set(ipAllowlistBypassHeader, bypass)
+				}
+				return next(ctx, req)
+			}
+		})))
+	}
+	admin, member := client(1, ""), client(2, "")
+	ctx := context.Background()
+	countAudits := func(action string) int {
+		n := 0
+		for _, e := range audit.entries {
+			if e.Action == action {
+				n++
+			}
+		}
+		return n
+	}
+
+	// The test client connects from loopback, so leaving it out is refused.
+	_, err := admin.SetIpAllowlist(ctx, connect.NewRequest(&secretaryv1.SetIpAllowlistRequest{Cidrs: []string{"10.0.0.0/8"}}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("allowlist without the admin's own address: %v", err)
+	}
+	if _, err := admin.SetIpAllowlist(ctx, connect.NewRequest(&secretaryv1.SetIpAllowlistRequest{Cidrs: []string{"not-an-ip"}})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("invalid entry: %v", err)
+	}
+	res, err := admin.SetIpAllowlist(ctx, connect.NewRequest(&secretaryv1.SetIpAllowlistRequest{Cidrs: []string{"127.0.0.1", "10.0.0.0/8"}}))
+	if err != nil || !slices.Equal(res.Msg.Cidrs, []string{"127.0.0.1/32", "10.0.0.0/8"}) {
+		t.Fatalf("setting the allowlist: %v, %v", res, err)
+	}
+	if _, err := member.GetMaintenanceMode(ctx, connect.NewRequest(&secretaryv1.GetMaintenanceModeRequest{})); strings.Contains(err.Error(), errIPNotAllowed.Error()) {
+		t.Fatalf("member from an allowed address: %v", err)
+	}
+	if countAudits("IPAllowlist.Reject") != 0 {
+		t.Fatal("a request from an allowed address was audited as rejected")
+	}
+
+	// Move the allowlist away from loopback, as if the office network changed.
+	row, _ := srv.settings.SetIPAllowlist(ctx, db.SetIPAllowlistParams{IpAllowlist: []string{"10.0.0.0/8"}})
+	srv.settingsCache.Store(&row)
+
+	for range 3 {
+		if _, err := member.GetMaintenanceMode(ctx, connect.NewRequest(&secretaryv1.GetMaintenanceModeRequest{})); !strings.Contains(err.Error(), errIPNotAllowed.Error()) {
+			t.Fatalf("member from outside the allowlist: %v", err)
+		}
+	}
+	if n := countAudits("IPAllowlist.Reject"); n != 1 {
+		t.Fatalf("%d rejection audit entries for one address, want 1", n)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/me/avatar", nil))
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("plain HTTP endpoint from outside the allowlist: %d", rec.Code)
+	}
+
+	// Only an admin gets through with the bypass token.
+	if _, err := client(2, "let-me-in-let-me-in-let-me-in-!!").GetMaintenanceMode(ctx, connect.NewRequest(&secretaryv1.GetMaintenanceModeRequest{})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member with the bypass token: %v", err)
+	}
+	if _, err := client(1, "wrong").GetIpAllowlist(ctx, connect.NewRequest(&secretaryv1.GetIpAllowlistRequest{})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("admin with a wrong bypass token: %v", err)
+	}
+	got, err := client(1, "let-me-in-let-me-in-let-me-in-!!").GetIpAllowlist(ctx, connect.NewRequest(&secretaryv1.GetIpAllowlistRequest{}))
+	if err != nil || got.Msg.YourIp != "127.0.0.1" || !got.Msg.BypassEnabled {
+		t.Fatalf("admin with the bypass token: %v, %v", got, err)
+	}
+	if countAudits("IPAllowlist.Bypass") != 1 {
+		t.Fatal("the bypass was not audited")
+	}
+}
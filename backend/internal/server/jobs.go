@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// JobStore holds the queries behind JobsService. A job is a recording in
+// processing; see ListJobs in jobs.sql.
+type JobStore interface {
+	ListJobs(ctx context.Context, arg db.ListJobsParams) ([]db.ListJobsRow, error)
+	DismissFailedJobs(ctx context.Context, arg db.DismissFailedJobsParams) (int64, error)
+}
+
+// Job states, as ListJobs computes them.
+const (
+	jobQueued  = "queued"
+	jobRunning = "running"
+	jobFailed  = "failed"
+)
+
+const defaultJobLimit = 100
+
+var jobStates = map[secretaryv1.JobState]string{
+	secretaryv1.JobState_JOB_STATE_QUEUED:  jobQueued,
+	secretaryv1.JobState_JOB_STATE_RUNNING: jobRunning,
+	secretaryv1.JobState_JOB_STATE_FAILED:  jobFailed,
+}
+
+func mapJobState(state string) secretaryv1.JobState {
+	for proto, name := range jobStates {
+		if name == state {
+			return proto
+		}
+	}
+	return secretaryv1.JobState_JOB_STATE_UNSPECIFIED
+}
+
+func jobToProto(row db.ListJobsRow) *secretaryv1.Job {
+	return &secretaryv1.Job{
+		RecordingId:     int64(row.ID),
+		RecordingName:   row.Name.String,
+		Stage:           mapProcessingStage(row.Stage),
+		State:           mapJobState(row.State),
+		Attempts:        row.Attempts,
+		Error:           row.Error.String,
+		UpdatedAt:       formatTime(row.UpdatedAt),
+		CreatedByUserId: int64(row.CreatedByUserID.Int32),
+	}
+}
+
+// getJob returns the job of a recording, or NotFound when it has none.
+func (s *Server) getJob(ctx context.Context, id int32) (*secretaryv1.Job, error) {
+	rows, err := s.jobs.ListJobs(ctx, db.ListJobsParams{RecordingID: pgtype.Int4{Int32: id, Valid: true}, MaxJobs: 1})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch job")
+	}
+	if len(rows) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording has no job; it is ready, or its failed job was purged"))
+	}
+	return jobToProto(rows[0]), nil
+}
+
+func (s *Server) ListJobs(ctx context.Context, req *connect.Request[secretaryv1.ListJobsRequest]) (*connect.Response[secretaryv1.ListJobsResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can list jobs"); err != nil {
+		return nil, err
+	}
+	arg := db.ListJobsParams{States: []string{}, MaxJobs: req.Msg.Limit}
+	if arg.MaxJobs == 0 {
+		arg.MaxJobs = defaultJobLimit
+	}
+	for _, state := range req.Msg.States {
+		arg.States = append(arg.States, jobStates[state])
+	}
+	rows, err := s.jobs.ListJobs(ctx, arg)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list jobs")
+	}
+	res := &secretaryv1.ListJobsResponse{}
+	for _, row := range rows {
+		res.Jobs = append(res.Jobs, jobToProto(row))
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *Server) GetJob(ctx context.Context, req *connect.Request[secretaryv1.GetJobRequest]) (*connect.Response[secretaryv1.GetJobResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can view jobs"); err != nil {
+		return nil, err
+	}
+	job, err := s.getJob(ctx, int32(req.Msg.RecordingId))
+	if err != nil {
+		return nil, err
+	}
+	attempts, err := s.recordings.ListProcessingAttempts(ctx, int32(req.Msg.RecordingId))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list processing attempts")
+	}
+	res := &secretaryv1.GetJobResponse{Job: job}
+	for _, attempt := range attempts {
+		res.Attempts = append(res.Attempts, processingAttemptToProto(attempt))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// RetryJob reruns the stage a failed job failed in.
+func (s *Server) RetryJob(ctx context.Context, req *connect.Request[secretaryv1.RetryJobRequest]) (*connect.Response[secretaryv1.RetryJobResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can retry jobs"); err != nil {
+		return nil, err
+	}
+	id := int32(req.Msg.RecordingId)
+	job, err := s.getJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.State != secretaryv1.JobState_JOB_STATE_FAILED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("only failed jobs can be retried"))
+	}
+	next := recordingTranscribing
+	if job.Stage == secretaryv1.ProcessingStage_PROCESSING_STAGE_SUMMARIZATION {
+		next = recordingSummarizing
+	}
+	if err := s.retryProcessing(ctx, id, next); err != nil {
+		return nil, err
+	}
+	if job, err = s.getJob(ctx, id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.RetryJobResponse{Job: job}), nil
+}
+
+// CancelJob fails a queued or running job with the admin's reason.
+func (s *Server) CancelJob(ctx context.Context, req *connect.Request[secretaryv1.CancelJobRequest]) (*connect.Response[secretaryv1.CancelJobResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can cancel jobs"); err != nil {
+		return nil, err
+	}
+	userID := currentUserID(ctx)
+	id := int32(req.Msg.RecordingId)
+	message := "cancelled by an admin"
+	if reason := strings.TrimSpace(req.Msg.Reason); reason != "" {
+		message += ": " + reason
+	}
+
+	qtx, err := s.recordings.BeginRecordingTx(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to start transaction")
+	}
+	defer func() { _ = qtx.Rollback(ctx) }()
+
+	current, err := qtx.GetRecordingStatusForUpdate(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch recording")
+	}
+	if !canTransition(current, recordingFailed) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("only queued or running jobs can be cancelled; this recording is %s", current))
+	}
+	if err := transitionRecording(ctx, qtx, id, current, recordingFailed, message, pgtype.Int4{}); err != nil {
+		return nil, err
+	}
+	if err := qtx.Commit(ctx); err != nil {
+		return nil, apierr.Wrap(err, "failed to commit transaction")
+	}
+	s.recordingCache.invalidate()
+	log.Printf("jobs: user %d cancelled processing of recording %d (%s)", userID, id, current)
+
+	job, err := s.getJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.CancelJobResponse{Job: job}), nil
+}
+
+// PurgeFailedJobs dismisses dead letters. The recordings keep their failed
+// status and error.
+func (s *Server) PurgeFailedJobs(ctx context.Context, req *connect.Request[secretaryv1.PurgeFailedJobsRequest]) (*connect.Response[secretaryv1.PurgeFailedJobsResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can purge jobs"); err != nil {
+		return nil, err
+	}
+	before, err := parseOptionalTimestamp(req.Msg.FailedBefore)
+	if err != nil {
+		return nil, apierr.InvalidField("failed_before", "must be an RFC 3339 timestamp")
+	}
+	purged, err := s.jobs.DismissFailedJobs(ctx, db.DismissFailedJobsParams{
+		RecordingID:  pgtype.Int4{Int32: int32(req.Msg.RecordingId), Valid: req.Msg.RecordingId > 0},
+		FailedBefore: before,
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to purge jobs")
+	}
+	return connect.NewResponse(&secretaryv1.PurgeFailedJobsResponse{Purged: purged}), nil
+}
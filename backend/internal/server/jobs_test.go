@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// fakeJobs derives the job of the one recording in a fakeRecordingStatus
+// the way ListJobs does.
+type fakeJobs struct {
+	recording *fakeRecordingStatus
+	dismissed []db.DismissFailedJobsParams
+}
+
+func (f *fakeJobs) ListJobs(_ context.Context, arg db.ListJobsParams) ([]db.ListJobsRow, error) {
+	r := f.recording
+	var last db.ListProcessingAttemptsRow
+	if len(r.attempts) > 0 {
+		last = r.attempts[len(r.attempts)-1]
+	}
+	row := db.ListJobsRow{ID: 3, State: jobRunning, Stage: stageForStatus(r.status), Error: optionalText(r.statusErr)}
+	switch {
+	case r.status == recordingReady:
+		return nil, nil
+	case r.status == recordingFailed:
+		row.State, row.Stage = jobFailed, stageTranscription
+		if last.Stage != "" {
+			row.Stage = last.Stage
+		}
+	case r.status == recordingUploaded:
+		row.State, row.Stage = jobQueued, stageTranscription
+	case last.Status == attemptPending:
+		row.State = jobQueued
+	}
+	if last.Stage == row.Stage {
+		row.Attempts = last.Attempt
+	}
+	return []db.ListJobsRow{row}, nil
+}
+
+func (f *fakeJobs) DismissFailedJobs(_ context.Context, arg db.DismissFailedJobsParams) (int64, error) {
+	f.dismissed = append(f.dismissed, arg)
+	return 2, nil
+}
+
+func TestCancelAndRetryJob(t *testing.T) {
+	store := &fakeRecordingStatus{status: recordingUploaded}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(store, nil, adminUsers{})
+	srv.jobs = &fakeJobs{recording: store}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING, ""); err != nil {
+		t.Fatalf("start transcribing: %v", err)
+	}
+
+	if _, err := srv.RetryJob(ctx, connect.NewRequest(&secretaryv1.RetryJobRequest{RecordingId: 3})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("retry of a running job: %v", err)
+	}
+	cancelled, err := srv.CancelJob(ctx, connect.NewRequest(&secretaryv1.CancelJobRequest{RecordingId: 3, Reason: "stuck on the worker"}))
+	if err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	job := cancelled.Msg.Job
+	if job.State != secretaryv1.JobState_JOB_STATE_FAILED || job.Stage != secretaryv1.ProcessingStage_PROCESSING_STAGE_TRANSCRIPTION || job.Error != "cancelled by an admin: stuck on the worker" {
+		t.Fatalf("cancelled job = %v", job)
+	}
+	if store.attempts[0].Status != attemptFailed {
+		t.Fatalf("attempt = %+v, want failed", store.attempts[0])
+	}
+	if _, err := srv.CancelJob(ctx, connect.NewRequest(&secretaryv1.CancelJobRequest{RecordingId: 3})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("cancel of a failed job: %v", err)
+	}
+
+	retried, err := srv.RetryJob(ctx, connect.NewRequest(&secretaryv1.RetryJobRequest{RecordingId: 3}))
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if job := retried.Msg.Job; job.State != secretaryv1.JobState_JOB_STATE_QUEUED || job.Attempts != 2 {
+		t.Fatalf("retried job = %v, want queued for attempt 2", job)
+	}
+	if store.status != recordingTranscribing {
+		t.Fatalf("status = %s, want transcribing", store.status)
+	}
+
+	detail, err := srv.GetJob(ctx, connect.NewRequest(&secretaryv1.GetJobRequest{RecordingId: 3}))
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if len(detail.Msg.Attempts) != 2 || detail.Msg.Attempts[0].Error != "cancelled by an admin: stuck on the worker" {
+		t.Fatalf("attempts = %v", detail.Msg.Attempts)
+	}
+}
+
+func TestRetryJobRerunsTheFailedStage(t *testing.T) {
+	store := &fakeRecordingStatus{status: recordingTranscribing, attempts: []db.ListProcessingAttemptsRow{{Stage: stageTranscription, Attempt: 1, Status: attemptRunning}}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(store, nil, adminUsers{})
+	srv.jobs = &fakeJobs{recording: store}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_SUMMARIZING, ""); err != nil {
+		t.Fatalf("summarize: %v", err)
+	}
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_FAILED, "model overloaded"); err != nil {
+		t.Fatalf("fail: %v", err)
+	}
+
+	// The fake recording has no transcript, so only a summarization retry
+	// is refused for lack of one.
+	_, err := srv.RetryJob(ctx, connect.NewRequest(&secretaryv1.RetryJobRequest{RecordingId: 3}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition || store.status != recordingFailed {
+		t.Fatalf("retry = %v, status = %s; want summarization retried and refused", err, store.status)
+	}
+}
+
+func TestListAndPurgeJobs(t *testing.T) {
+	store := &fakeRecordingStatus{status: recordingFailed, statusErr: "provider unavailable"}
+	jobs := &fakeJobs{recording: store}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(store, nil, memberUsers{})
+	srv.jobs = jobs
+	ctx := withPrincipal(context.Background(), Principal{UserID: 2})
+	if _, err := srv.ListJobs(ctx, connect.NewRequest(&secretaryv1.ListJobsRequest{})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member list: %v", err)
+	}
+
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	list, err := srv.ListJobs(ctx, connect.NewRequest(&secretaryv1.ListJobsRequest{}))
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(list.Msg.Jobs) != 1 || list.Msg.Jobs[0].State != secretaryv1.JobState_JOB_STATE_FAILED || list.Msg.Jobs[0].Error != "provider unavailable" {
+		t.Fatalf("jobs = %v", list.Msg.Jobs)
+	}
+
+	if _, err := srv.PurgeFailedJobs(ctx, connect.NewRequest(&secretaryv1.PurgeFailedJobsRequest{FailedBefore: "yesterday"})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("bad timestamp: %v", err)
+	}
+	purged, err := srv.PurgeFailedJobs(ctx, connect.NewRequest(&secretaryv1.PurgeFailedJobsRequest{FailedBefore: "2026-10-01T00:00:00Z"}))
+	if err != nil || purged.Msg.Purged != 2 {
+		t.Fatalf("purge = %v, %v", purged, err)
+	}
+	if arg := jobs.dismissed[0]; arg.RecordingID.Valid || !arg.FailedBefore.Time.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("dismissed with %+v", arg)
+	}
+}
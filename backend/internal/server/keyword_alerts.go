@@ -0,0 +1,375 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/i18n"
+	"github.com/mvult/secretary/backend/internal/mail"
+	"github.com/mvult/secretary/backend/internal/push"
+)
+
+const (
+	// maxWatchKeywords bounds how many keywords each user watches.
+	maxWatchKeywords = 50
+	// keywordMatchBatch is how many recordings one round of the keyword
+	// match takes on.
+	keywordMatchBatch = 50
+	// defaultKeywordAlertLimit is how many alerts ListKeywordAlerts
+	// returns when no limit is given.
+	defaultKeywordAlertLimit = 100
+	// maxAlertLinesPerEmail bounds the transcript lines quoted in one
+	// alert email; the rest are in the app.
+	maxAlertLinesPerEmail = 10
+)
+
+// KeywordStore holds users' watch keywords and the alerts raised when
+// transcripts say them.
+type KeywordStore interface {
+	ListWatchKeywords(ctx context.Context, userID int32) ([]db.WatchKeyword, error)
+	CountWatchKeywords(ctx context.Context, userID int32) (int64, error)
+	CreateWatchKeyword(ctx context.Context, arg db.CreateWatchKeywordParams) (db.WatchKeyword, error)
+	DeleteWatchKeyword(ctx context.Context, arg db.DeleteWatchKeywordParams) (int64, error)
+	ListRecordingsToMatchKeywords(ctx context.Context, maxRows int32) ([]db.ListRecordingsToMatchKeywordsRow, error)
+	ListWatchKeywordsCreatedBefore(ctx context.Context, before pgtype.Timestamptz) ([]db.WatchKeyword, error)
+	CreateKeywordAlerts(ctx context.Context, arg db.CreateKeywordAlertsParams) ([]db.CreateKeywordAlertsRow, error)
+	SetRecordingKeywordsMatched(ctx context.Context, arg db.SetRecordingKeywordsMatchedParams) error
+	ListKeywordAlerts(ctx context.Context, arg db.ListKeywordAlertsParams) ([]db.ListKeywordAlertsRow, error)
+}
+
+func watchKeywordToProto(row db.WatchKeyword) *secretaryv1.WatchKeyword {
+	return &secretaryv1.WatchKeyword{
+		Id:        int64(row.ID),
+		Keyword:   row.Keyword,
+		CreatedAt: formatTime(row.CreatedAt),
+	}
+}
+
+func (s *Server) keywordAlertToProto(row db.ListKeywordAlertsRow) (*secretaryv1.KeywordAlert, error) {
+	segment, err := s.openText(row.SegmentText)
+	if err != nil {
+		return nil, err
+	}
+	return &secretaryv1.KeywordAlert{
+		Id:            row.ID,
+		KeywordId:     int64(row.KeywordID),
+		Keyword:       row.Keyword,
+		RecordingId:   int64(row.RecordingID),
+		RecordingName: row.RecordingName.String,
+		SegmentIndex:  row.SegmentIndex,
+		SegmentText:   segment,
+		CreatedAt:     formatTime(row.CreatedAt),
+	}, nil
+}
+
+type keywordMatch struct {
+	keyword db.WatchKeyword
+	segment int32
+	line    string
+}
+
+// matchKeywords returns one match for each keyword said in each line of a
+// transcript. Keywords match whole words, ignoring case and accents, the
+// way names do in mentions.
+func matchKeywords(transcript string, keywords []db.WatchKeyword) []keywordMatch {
+	folded := make([][]string, len(keywords))
+	for i, keyword := range keywords {
+		folded[i] = foldedWords(keyword.Keyword)
+	}
+	var found []keywordMatch
+	for i, line := range strings.Split(transcript, "\n") {
+		if label := speakerLinePattern.FindString(line); label != "" {
+			line = line[len(label):]
+		}
+		line = strings.TrimSpace(line)
+		words := foldedWords(line)
+		for k, keyword := range keywords {
+			if containsWords(words, folded[k]) {
+				found = append(found, keywordMatch{keyword: keyword, segment: int32(i), line: line})
+			}
+		}
+	}
+	return found
+}
+
+// containsWords reports whether want appears as a run within words.
+func containsWords(words, want []string) bool {
+	if len(want) == 0 {
+		return false
+	}
+	for i := 0; i+len(want) <= len(words); i++ {
+		if slices.Equal(words[i:i+len(want)], want) {
+			return true
+		}
+	}
+	return false
+}
+
+// queueKeywordMatch wakes the keyword match for a newly ready recording.
+func (s *Server) queueKeywordMatch() {
+	select {
+	case s.keywordWake <- struct{}{}:
+	default:
+	}
+}
+
+// StartKeywordAlerts matches newly ready transcripts against users' watch
+// keywords every interval and whenever a recording becomes ready, while
+// this process leads. It returns at once; matching stops with ctx.
+func (s *Server) StartKeywordAlerts(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if s.isLeader() {
+				if err := s.matchKeywordAlerts(ctx); err != nil && ctx.Err() == nil {
+					log.Printf("keyword alerts: %v", err)
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-s.keywordWake:
+			}
+		}
+	}()
+}
+
+// matchKeywordAlerts matches the recordings waiting for it, a batch at a
+// time. It stops at the first recording that fails, which is tried again
+// next round.
+func (s *Server) matchKeywordAlerts(ctx context.Context) error {
+	for {
+		rows, err := s.keywords.ListRecordingsToMatchKeywords(ctx, keywordMatchBatch)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := s.matchRecordingKeywords(ctx, row); err != nil {
+				return fmt.Errorf("recording %d: %w", row.ID, err)
+			}
+		}
+		if len(rows) < keywordMatchBatch {
+			return nil
+		}
+	}
+}
+
+// matchRecordingKeywords stores an alert for each line of the recording's
+// transcript saying a keyword that was watched when it became ready, and
+// alerts each user about the lines new to them.
+func (s *Server) matchRecordingKeywords(ctx context.Context, row db.ListRecordingsToMatchKeywordsRow) error {
+	keywords, err := s.keywords.ListWatchKeywordsCreatedBefore(ctx, row.StatusUpdatedAt)
+	if err != nil {
+		return err
+	}
+	var matches []keywordMatch
+	if len(keywords) > 0 {
+		transcript, err := s.openText(row.Transcript.String)
+		if err != nil {
+			return err
+		}
+		matches = matchKeywords(transcript, keywords)
+	}
+	if len(matches) > 0 {
+		arg := db.CreateKeywordAlertsParams{RecordingID: row.ID}
+		for _, match := range matches {
+			line, err := s.sealText(match.line)
+			if err != nil {
+				return err
+			}
+			arg.KeywordIds = append(arg.KeywordIds, match.keyword.ID)
+			arg.SegmentIndexes = append(arg.SegmentIndexes, match.segment)
+			arg.SegmentTexts = append(arg.SegmentTexts, line)
+		}
+		created, err := s.keywords.CreateKeywordAlerts(ctx, arg)
+		if err != nil {
+			return err
+		}
+		s.notifyKeywordMatches(ctx, row, keywords, created)
+	}
+	return s.keywords.SetRecordingKeywordsMatched(ctx, db.SetRecordingKeywordsMatchedParams{ID: row.ID, MatchedAt: row.StatusUpdatedAt})
+}
+
+// keywordLines are the alerts one user gets about one recording.
+type keywordLines struct {
+	keywords []string
+	lines    []string
+}
+
+// notifyKeywordMatches alerts the owners of the keywords in created about
+// them on their devices and by email, once per user.
+func (s *Server) notifyKeywordMatches(ctx context.Context, row db.ListRecordingsToMatchKeywordsRow, keywords []db.WatchKeyword, created []db.CreateKeywordAlertsRow) {
+	if len(created) == 0 || !(s.pushEnabled() || s.emailNotificationsEnabled()) {
+		return
+	}
+	byUser := map[int32]*keywordLines{}
+	var userIDs []int32
+	for _, alert := range created {
+		i := slices.IndexFunc(keywords, func(k db.WatchKeyword) bool { return k.ID == alert.KeywordID })
+		if i < 0 {
+			continue
+		}
+		keyword := keywords[i]
+		found, ok := byUser[keyword.UserID]
+		if !ok {
+			found = &keywordLines{}
+			byUser[keyword.UserID] = found
+			userIDs = append(userIDs, keyword.UserID)
+		}
+		if !slices.Contains(found.keywords, keyword.Keyword) {
+			found.keywords = append(found.keywords, keyword.Keyword)
+		}
+		line, err := s.openText(alert.SegmentText)
+		if err != nil {
+			log.Printf("notifying about keywords in recording %d: %v", row.ID, err)
+			return
+		}
+		if !slices.Contains(found.lines, line) {
+			found.lines = append(found.lines, line)
+		}
+	}
+	recipients, err := s.notificationRecipients(ctx, userIDs, notificationKeywordMatched)
+	if err != nil {
+		log.Printf("notifying about keywords in recording %d: %v", row.ID, err)
+		return
+	}
+	title := recordingTitle(db.GetRecordingRow{Name: row.Name})
+	for _, recipient := range recipients {
+		found := byUser[recipient.UserID]
+		if recipient.PushEnabled {
+			s.pushToUsers(ctx, []int32{recipient.UserID}, notificationKeywordMatched, push.Notification{
+				Title: "Keyword mentioned: " + strings.Join(found.keywords, ", "),
+				Body:  title + ": " + found.lines[0],
+				Data:  map[string]string{"recording_id": strconv.Itoa(int(row.ID))},
+			})
+		}
+		if recipient.EmailEnabled && recipient.Email.String != "" && s.emailNotificationsEnabled() {
+			s.sendKeywordEmail(ctx, recipient, row.ID, title, found)
+		}
+	}
+}
+
+// sendKeywordEmail emails recipient the lines of a meeting that said
+// their keywords, in the background like sendNotification.
+func (s *Server) sendKeywordEmail(ctx context.Context, recipient db.ListNotificationRecipientsRow, recordingID int32, title string, found *keywordLines) {
+	locale := i18n.Negotiate(recipient.Locale.String, "")
+	lines := found.lines
+	var quoted strings.Builder
+	for _, line := range lines[:min(len(lines), maxAlertLinesPerEmail)] {
+		quoted.WriteString("> " + line + "\n")
+	}
+	text := i18n.Sprintf(locale, "Hi %s,\n\nThe meeting %s mentioned your keywords:\n\n%s", recipient.FirstName, title, quoted.String())
+	if more := len(lines) - maxAlertLinesPerEmail; more > 0 {
+		text += i18n.Sprintf(locale, "...and %d more\n", more)
+	}
+	if link := s.recordingURL(recordingID); link != "" {
+		text += "\n" + link + "\n"
+	}
+	msg := mail.Message{
+		To:      recipient.Email.String,
+		Subject: i18n.Sprintf(locale, "Keywords mentioned in %s: %s", title, strings.Join(found.keywords, ", ")),
+		Text:    text,
+	}
+	go func() {
+		if err := s.mailer.Send(context.WithoutCancel(ctx), msg); err != nil {
+			log.Printf("emailing keyword alert for recording %d to user %d: %v", recordingID, recipient.UserID, err)
+		}
+	}()
+}
+
+// --- KeywordAlertsService Implementation ---
+
+func (s *Server) ListWatchKeywords(ctx context.Context, req *connect.Request[secretaryv1.ListWatchKeywordsRequest]) (*connect.Response[secretaryv1.ListWatchKeywordsResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.keywords.ListWatchKeywords(ctx, int32(userID))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list keywords")
+	}
+	keywords := make([]*secretaryv1.WatchKeyword, 0, len(rows))
+	for _, row := range rows {
+		keywords = append(keywords, watchKeywordToProto(row))
+	}
+	return connect.NewResponse(&secretaryv1.ListWatchKeywordsResponse{Keywords: keywords}), nil
+}
+
+func (s *Server) CreateWatchKeyword(ctx context.Context, req *connect.Request[secretaryv1.CreateWatchKeywordRequest]) (*connect.Response[secretaryv1.CreateWatchKeywordResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keyword := strings.Join(strings.Fields(req.Msg.Keyword), " ")
+	if len(foldedWords(keyword)) == 0 {
+		return nil, apierr.InvalidField("keyword", "must contain a letter or digit")
+	}
+	count, err := s.keywords.CountWatchKeywords(ctx, int32(userID))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to create keyword")
+	}
+	if count >= maxWatchKeywords {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("at most %d keywords can be watched", maxWatchKeywords))
+	}
+	row, err := s.keywords.CreateWatchKeyword(ctx, db.CreateWatchKeywordParams{UserID: int32(userID), Keyword: keyword})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to create keyword")
+	}
+	return connect.NewResponse(&secretaryv1.CreateWatchKeywordResponse{Keyword: watchKeywordToProto(row)}), nil
+}
+
+func (s *Server) DeleteWatchKeyword(ctx context.Context, req *connect.Request[secretaryv1.DeleteWatchKeywordRequest]) (*connect.Response[secretaryv1.DeleteWatchKeywordResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := s.keywords.DeleteWatchKeyword(ctx, db.DeleteWatchKeywordParams{ID: int32(req.Msg.Id), UserID: int32(userID)})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to delete keyword")
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("keyword not found"))
+	}
+	return connect.NewResponse(&secretaryv1.DeleteWatchKeywordResponse{}), nil
+}
+
+func (s *Server) ListKeywordAlerts(ctx context.Context, req *connect.Request[secretaryv1.ListKeywordAlertsRequest]) (*connect.Response[secretaryv1.ListKeywordAlertsResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	arg := db.ListKeywordAlertsParams{UserID: int32(userID), KeywordID: optionalInt4(req.Msg.GetKeywordId()), MaxRows: req.Msg.Limit}
+	if arg.MaxRows == 0 {
+		arg.MaxRows = defaultKeywordAlertLimit
+	}
+	rows, err := s.keywords.ListKeywordAlerts(ctx, arg)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list keyword alerts")
+	}
+	alerts := make([]*secretaryv1.KeywordAlert, 0, len(rows))
+	for _, row := range rows {
+		alert, err := s.keywordAlertToProto(row)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, alert)
+	}
+	return connect.NewResponse(&secretaryv1.ListKeywordAlertsResponse{Alerts: alerts}), nil
+}
@@ -0,0 +1,208 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/mail"
+)
+
+// memoryKeywords keeps watch keywords, alerts, and the recordings the
+// keyword match sees, by ID.
+type memoryKeywords struct {
+	keywords   []db.WatchKeyword
+	recordings map[int32]db.ListRecordingsToMatchKeywordsRow
+	matched    map[int32]pgtype.Timestamptz
+	alerts     []db.ListKeywordAlertsRow
+	now        time.Time
+}
+
+func (m *memoryKeywords) ListWatchKeywords(_ context.Context, userID int32) ([]db.WatchKeyword, error) {
+	var rows []db.WatchKeyword
+	for _, k := range m.keywords {
+		if k.UserID == userID {
+			rows = append(rows, k)
+		}
+	}
+	return rows, nil
+}
+
+func (m *memoryKeywords) CountWatchKeywords(ctx context.Context, userID int32) (int64, error) {
+	rows, _ := m.ListWatchKeywords(ctx, userID)
+	return int64(len(rows)), nil
+}
+
+func (m *memoryKeywords) CreateWatchKeyword(_ context.Context, arg db.CreateWatchKeywordParams) (db.WatchKeyword, error) {
+	row := db.WatchKeyword{ID: int32(len(m.keywords) + 1), UserID: arg.UserID, Keyword: arg.Keyword, CreatedAt: pgtype.Timestamptz{Time: m.now, Valid: true}}
+	m.keywords = append(m.keywords, row)
+	return row, nil
+}
+
+func (m *memoryKeywords) DeleteWatchKeyword(_ context.Context, arg db.DeleteWatchKeywordParams) (int64, error) {
+	n := len(m.keywords)
+	m.keywords = slices.DeleteFunc(m.keywords, func(k db.WatchKeyword) bool { return k.ID == arg.ID && k.UserID == arg.UserID })
+	return int64(n - len(m.keywords)), nil
+}
+
+func (m *memoryKeywords) ListRecordingsToMatchKeywords(_ context.Context, maxRows int32) ([]db.ListRecordingsToMatchKeywordsRow, error) {
+	var rows []db.ListRecordingsToMatchKeywordsRow
+	for id := int32(1); id <= int32(len(m.recordings)); id++ {
+		rec := m.recordings[id]
+		if matched, ok := m.matched[id]; (!ok || matched.Time.Before(rec.StatusUpdatedAt.Time)) && len(rows) < int(maxRows) {
+			rows = append(rows, rec)
+		}
+	}
+	return rows, nil
+}
+
+func (m *memoryKeywords) ListWatchKeywordsCreatedBefore(_ context.Context, before pgtype.Timestamptz) ([]db.WatchKeyword, error) {
+	var rows []db.WatchKeyword
+	for _, k := range m.keywords {
+		if !k.CreatedAt.Time.After(before.Time) {
+			rows = append(rows, k)
+		}
+	}
+	return rows, nil
+}
+
+func (m *memoryKeywords) CreateKeywordAlerts(_ context.Context, arg db.CreateKeywordAlertsParams) ([]db.CreateKeywordAlertsRow, error) {
+	var created []db.CreateKeywordAlertsRow
+	for i, keywordID := range arg.KeywordIds {
+		if slices.ContainsFunc(m.alerts, func(a db.ListKeywordAlertsRow) bool {
+			return a.KeywordID == keywordID && a.RecordingID == arg.RecordingID && a.SegmentIndex == arg.SegmentIndexes[i]
+		}) {
+			continue
+		}
+		keyword := m.keywords[slices.IndexFunc(m.keywords, func(k db.WatchKeyword) bool { return k.ID == keywordID })]
+		m.alerts = append(m.alerts, db.ListKeywordAlertsRow{
+			ID:            int64(len(m.alerts) + 1),
+			KeywordID:     keywordID,
+			Keyword:       keyword.Keyword,
+			RecordingID:   arg.RecordingID,
+			RecordingName: m.recordings[arg.RecordingID].Name,
+			SegmentIndex:  arg.SegmentIndexes[i],
+			SegmentText:   arg.SegmentTexts[i],
+		})
+		created = append(created, db.CreateKeywordAlertsRow{KeywordID: keywordID, SegmentIndex: arg.SegmentIndexes[i], SegmentText: arg.SegmentTexts[i]})
+	}
+	return created, nil
+}
+
+func (m *memoryKeywords) SetRecordingKeywordsMatched(_ context.Context, arg db.SetRecordingKeywordsMatchedParams) error {
+	m.matched[arg.ID] = arg.MatchedAt
+	return nil
+}
+
+func (m *memoryKeywords) ListKeywordAlerts(_ context.Context, arg db.ListKeywordAlertsParams) ([]db.ListKeywordAlertsRow, error) {
+	var rows []db.ListKeywordAlertsRow
+	for _, a := range m.alerts {
+		i := slices.IndexFunc(m.keywords, func(k db.WatchKeyword) bool { return k.ID == a.KeywordID })
+		if i >= 0 && m.keywords[i].UserID == arg.UserID && (!arg.KeywordID.Valid || a.KeywordID == arg.KeywordID.Int32) {
+			rows = append(rows, a)
+		}
+	}
+	slices.Reverse(rows)
+	return rows[:min(len(rows), int(arg.MaxRows))], nil
+}
+
+func TestMatchKeywords(t *testing.T) {
+	keywords := []db.WatchKeyword{{ID: 1, Keyword: "budget"}, {ID: 2, Keyword: "Acme Corp"}, {ID: 3, Keyword: "presupuesto"}}
+	transcript := "Speaker 0: The BUDGET is tight.\nSpeaker 1: We budgeted for it.\nAcme lost to acme corp again.\nEl presupuésto sube."
+	var got []string
+	for _, match := range matchKeywords(transcript, keywords) {
+		got = append(got, match.keyword.Keyword+"@"+match.line)
+	}
+	want := []string{"budget@The BUDGET is tight.", "Acme Corp@Acme lost to acme corp again.", "presupuesto@El presupuésto sube."}
+	if !slices.Equal(got, want) {
+		t.Fatalf("matches = %q, want %q", got, want)
+	}
+}
+
+func TestKeywordAlerts(t *testing.T) {
+	start := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	store := &memoryKeywords{now: start, matched: map[int32]pgtype.Timestamptz{}}
+	mailer := fakeMailer{sent: make(chan mail.Message, 2)}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.keywords = store
+	srv.ConfigureMail(mailer, "https://secretary.example.com")
+	srv.notifyPrefs = storedPreferences{saved: db.NotificationPreference{UserID: 5, EmailEnabled: true, DigestFrequency: "off"}}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	if _, err := srv.CreateWatchKeyword(ctx, connect.NewRequest(&secretaryv1.CreateWatchKeywordRequest{Keyword: " -- "})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("keyword without words: %v", err)
+	}
+	created, err := srv.CreateWatchKeyword(ctx, connect.NewRequest(&secretaryv1.CreateWatchKeywordRequest{Keyword: "  churn   risk "}))
+	if err != nil || created.Msg.Keyword.Keyword != "churn risk" {
+		t.Fatalf("create: %+v, %v", created, err)
+	}
+	store.now = start.Add(2 * time.Hour)
+	if _, err := srv.CreateWatchKeyword(ctx, connect.NewRequest(&secretaryv1.CreateWatchKeywordRequest{Keyword: "budget"})); err != nil {
+		t.Fatal(err)
+	}
+
+	// The budget keyword was added after the meeting was ready, so only
+	// the churn risk line alerts.
+	store.recordings = map[int32]db.ListRecordingsToMatchKeywordsRow{
+		1: {ID: 1, Name: optionalText("Weekly sync"), Transcript: optionalText("Speaker 0: Budget first.\nSpeaker 1: The churn risk is up.\nSpeaker 0: Churn risk again."), StatusUpdatedAt: pgtype.Timestamptz{Time: start.Add(time.Hour), Valid: true}},
+	}
+	if err := srv.matchKeywordAlerts(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case msg := <-mailer.sent:
+		if msg.To != "ana@example.com" || !strings.Contains(msg.Subject, "Weekly sync") || !strings.Contains(msg.Text, "> The churn risk is up.\n> Churn risk again.\n") || !strings.Contains(msg.Text, "https://secretary.example.com/recordings/1") {
+			t.Fatalf("email = %+v", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no alert email")
+	}
+
+	// Processing the meeting again only alerts about new lines.
+	store.recordings[1] = db.ListRecordingsToMatchKeywordsRow{ID: 1, Name: optionalText("Weekly sync"), Transcript: optionalText("Speaker 0: Budget first.\nSpeaker 1: The churn risk is up.\nSpeaker 0: Churn risk again."), StatusUpdatedAt: pgtype.Timestamptz{Time: start.Add(3 * time.Hour), Valid: true}}
+	if err := srv.matchKeywordAlerts(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case msg := <-mailer.sent:
+		if strings.Contains(msg.Text, "churn") || !strings.Contains(msg.Text, "> Budget first.") {
+			t.Fatalf("second email = %+v", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no alert email for the budget line")
+	}
+	if err := srv.matchKeywordAlerts(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case msg := <-mailer.sent:
+		t.Fatalf("alerted about a matched recording: %+v", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	alerts, err := srv.ListKeywordAlerts(ctx, connect.NewRequest(&secretaryv1.ListKeywordAlertsRequest{}))
+	if err != nil || len(alerts.Msg.Alerts) != 3 || alerts.Msg.Alerts[0].Keyword != "budget" || alerts.Msg.Alerts[0].RecordingName != "Weekly sync" {
+		t.Fatalf("alerts: %+v, %v", alerts, err)
+	}
+	other := withPrincipal(context.Background(), Principal{UserID: 6})
+	if alerts, err := srv.ListKeywordAlerts(other, connect.NewRequest(&secretaryv1.ListKeywordAlertsRequest{})); err != nil || len(alerts.Msg.Alerts) != 0 {
+		t.Fatalf("another user's alerts: %+v, %v", alerts, err)
+	}
+	if _, err := srv.DeleteWatchKeyword(other, connect.NewRequest(&secretaryv1.DeleteWatchKeywordRequest{Id: created.Msg.Keyword.Id})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("deleting another user's keyword: %v", err)
+	}
+	if _, err := srv.DeleteWatchKeyword(ctx, connect.NewRequest(&secretaryv1.DeleteWatchKeywordRequest{Id: created.Msg.Keyword.Id})); err != nil {
+		t.Fatal(err)
+	}
+	list, err := srv.ListWatchKeywords(ctx, connect.NewRequest(&secretaryv1.ListWatchKeywordsRequest{}))
+	if err != nil || len(list.Msg.Keywords) != 1 || list.Msg.Keywords[0].Keyword != "budget" {
+		t.Fatalf("keywords: %+v, %v", list, err)
+	}
+}
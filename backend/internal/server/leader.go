@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+// LeaderStore elects the leader, the one process that runs the background
+// work two processes must not run at once: the upload sweep, audio scans,
+// keyword alerts and re-encryption. Scheduled tasks don't need it; each
+// run is claimed on its own.
+type LeaderStore interface {
+	// LockLeader takes the leader lock. It reports false when another
+	// process holds it.
+	LockLeader(ctx context.Context) (lease LeaderLease, locked bool, err error)
+}
+
+// LeaderLease is the leader lock, held by one database session.
+type LeaderLease interface {
+	// Check fails when the session holding the lock is gone, and the lock
+	// with it.
+	Check(ctx context.Context) error
+	Unlock()
+}
+
+// leaderCheckInterval is how often the leader checks it still holds the
+// lock and the other processes try to take it. A leader that stops is
+// replaced within about this long of its database session closing.
+const leaderCheckInterval = 15 * time.Second
+
+type leadership struct {
+	// elected is set once StartLeaderElection runs. A server that doesn't
+	// run it, like one without a database, leads on its own.
+	elected atomic.Bool
+	leading atomic.Bool
+}
+
+// isLeader reports whether this process should run leader-only work.
+func (s *Server) isLeader() bool {
+	return !s.leader.elected.Load() || s.leader.leading.Load()
+}
+
+// StartLeaderElection makes this process the leader when no other process
+// is, and gives leadership up with ctx. It tries once before returning, so
+// a lone process leads from the start.
+func (s *Server) StartLeaderElection(ctx context.Context) {
+	if s.db == nil {
+		return
+	}
+	s.leader.elected.Store(true)
+	lease := s.campaign(ctx, nil)
+	go func() {
+		ticker := time.NewTicker(leaderCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				if lease != nil {
+					s.leader.leading.Store(false)
+					lease.Unlock()
+				}
+				return
+			case <-ticker.C:
+			}
+			lease = s.campaign(ctx, lease)
+		}
+	}()
+}
+
+// campaign checks that lease, the lock this process holds, is still held,
+// or tries to take the lock when it holds none. It returns the lock held
+// afterwards.
+func (s *Server) campaign(ctx context.Context, lease LeaderLease) LeaderLease {
+	if lease != nil {
+		err := lease.Check(ctx)
+		if err == nil || ctx.Err() != nil {
+			return lease
+		}
+		s.leader.leading.Store(false)
+		lease.Unlock()
+		log.Printf("leader: lost leadership: %v", err)
+	}
+	lease, locked, err := s.leaders.LockLeader(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("leader: %v", err)
+		}
+		return nil
+	}
+	if !locked {
+		return nil
+	}
+	s.leader.leading.Store(true)
+	log.Printf("leader: this process is now the leader")
+	// Catch up on work that waited while no process led.
+	s.queueAudioScan()
+	s.queueKeywordMatch()
+	return lease
+}
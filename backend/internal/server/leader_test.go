@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeLeaders is the leader lock shared by a test's servers, as the
+// database shares it between processes.
+type fakeLeaders struct {
+	mu     sync.Mutex
+	holder *fakeLease
+}
+
+type fakeLease struct {
+	leaders *fakeLeaders
+	// lost simulates the session holding the lock closing.
+	lost bool
+}
+
+func (f *fakeLeaders) LockLeader(context.Context) (LeaderLease, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.holder != nil && !f.holder.lost {
+		return nil, false, nil
+	}
+	f.holder = &fakeLease{leaders: f}
+	return f.holder, true, nil
+}
+
+func (l *fakeLease) Check(context.Context) error {
+	l.leaders.mu.Lock()
+	defer l.leaders.mu.Unlock()
+	if l.lost {
+		return errors.New("connection closed")
+	}
+	return nil
+}
+
+func (l *fakeLease) Unlock() {
+	l.leaders.mu.Lock()
+	defer l.leaders.mu.Unlock()
+	if l.leaders.holder == l {
+		l.leaders.holder = nil
+	}
+}
+
+func newElectedServer(leaders *fakeLeaders) *Server {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.leaders = leaders
+	srv.leader.elected.Store(true)
+	return srv
+}
+
+func TestLeaderElection(t *testing.T) {
+	ctx := context.Background()
+	if srv := New(nil, []byte("test"), time.Hour); !srv.isLeader() {
+		t.Fatal("a server without an election should lead")
+	}
+
+	leaders := &fakeLeaders{}
+	first, second := newElectedServer(leaders), newElectedServer(leaders)
+	firstLease := first.campaign(ctx, nil)
+	secondLease := second.campaign(ctx, nil)
+	if !first.isLeader() || second.isLeader() || firstLease == nil || secondLease != nil {
+		t.Fatalf("first leads = %v, second leads = %v; want only the first", first.isLeader(), second.isLeader())
+	}
+
+	// The leader keeps its lease while its session lives.
+	if first.campaign(ctx, firstLease) != firstLease || !first.isLeader() {
+		t.Fatal("leader lost a lease it still holds")
+	}
+
+	// Its session closes: it steps down, and the other process takes over.
+	leaders.mu.Lock()
+	firstLease.(*fakeLease).lost = true
+	leaders.mu.Unlock()
+	secondLease = second.campaign(ctx, nil)
+	firstLease = first.campaign(ctx, firstLease)
+	if first.isLeader() || !second.isLeader() || firstLease != nil || secondLease == nil {
+		t.Fatalf("first leads = %v, second leads = %v; want the second to take over", first.isLeader(), second.isLeader())
+	}
+}
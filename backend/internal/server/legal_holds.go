@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+func legalHoldEventToProto(row db.ListRecordingHoldEventsRow) *secretaryv1.LegalHoldEvent {
+	return &secretaryv1.LegalHoldEvent{
+		Held:      row.Held,
+		Reason:    row.Reason,
+		UserId:    int64(row.UserID.Int32),
+		CreatedAt: formatTime(row.CreatedAt),
+	}
+}
+
+// SetLegalHold places or lifts a hold. The change and its reason are
+// written to recording_hold_event, which outlives the recording.
+func (s *Server) SetLegalHold(ctx context.Context, req *connect.Request[secretaryv1.SetLegalHoldRequest]) (*connect.Response[secretaryv1.SetLegalHoldResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can change legal holds"); err != nil {
+		return nil, err
+	}
+	reason := strings.TrimSpace(req.Msg.Reason)
+	if reason == "" {
+		return nil, apierr.InvalidField("reason", "must not be blank")
+	}
+	userID := currentUserID(ctx)
+	row, err := s.recordings.SetRecordingLegalHold(ctx, db.SetRecordingLegalHoldParams{
+		RecordingID: int32(req.Msg.Id),
+		Held:        req.Msg.Held,
+		Reason:      reason,
+		UserID:      pgtype.Int4{Int32: int32(userID), Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to update legal hold")
+	}
+	action := "lifted"
+	if row.Held {
+		action = "placed"
+	}
+	log.Printf("legal hold %s on recording %d by user %d: %s", action, req.Msg.Id, userID, row.Reason)
+	s.recordingCache.invalidate()
+	return connect.NewResponse(&secretaryv1.SetLegalHoldResponse{Event: legalHoldEventToProto(db.ListRecordingHoldEventsRow(row))}), nil
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// heldRecordings keeps recordings and their hold history in memory.
+type heldRecordings struct {
+	RecordingStore
+	held   map[int32]bool
+	events []db.SetRecordingLegalHoldParams
+}
+
+func (h *heldRecordings) GetRecording(_ context.Context, id int32) (db.GetRecordingRow, error) {
+	held, ok := h.held[id]
+	if !ok {
+		return db.GetRecordingRow{}, pgx.ErrNoRows
+	}
+	return db.GetRecordingRow{ID: id, LegalHold: held}, nil
+}
+
+func (h *heldRecordings) DeleteRecording(_ context.Context, id int32) (db.DeleteRecordingRow, error) {
+	if held, ok := h.held[id]; !ok || held {
+		return db.DeleteRecordingRow{}, pgx.ErrNoRows
+	}
+	delete(h.held, id)
+	return db.DeleteRecordingRow{}, nil
+}
+
+func (h *heldRecordings) SetRecordingLegalHold(_ context.Context, arg db.SetRecordingLegalHoldParams) (db.SetRecordingLegalHoldRow, error) {
+	if _, ok := h.held[arg.RecordingID]; !ok {
+		return db.SetRecordingLegalHoldRow{}, pgx.ErrNoRows
+	}
+	h.held[arg.RecordingID] = arg.Held
+	h.events = append(h.events, arg)
+	return db.SetRecordingLegalHoldRow{ID: int32(len(h.events)), Held: arg.Held, Reason: arg.Reason, UserID: arg.UserID}, nil
+}
+
+func TestLegalHoldBlocksDeletion(t *testing.T) {
+	recordings := &heldRecordings{held: map[int32]bool{7: false}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(recordings, nil, adminUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+	hold := func(held bool, reason string) error {
+		_, err := srv.SetLegalHold(ctx, connect.NewRequest(&secretaryv1.SetLegalHoldRequest{Id: 7, Held: held, Reason: reason}))
+		return err
+	}
+	del := func() error {
+		_, err := srv.DeleteRecording(ctx, connect.NewRequest(&secretaryv1.DeleteRecordingRequest{Id: 7}))
+		return err
+	}
+
+	if err := hold(true, "  "); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("blank reason: %v", err)
+	}
+	if err := hold(true, "Case 2026-114"); err != nil {
+		t.Fatalf("placing hold: %v", err)
+	}
+	if err := del(); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("deleting held recording: %v", err)
+	}
+	if err := hold(false, "Case closed"); err != nil {
+		t.Fatalf("lifting hold: %v", err)
+	}
+	if err := del(); err != nil {
+		t.Fatalf("deleting after lift: %v", err)
+	}
+	if len(recordings.events) != 2 || recordings.events[0].Reason != "Case 2026-114" || recordings.events[1].Held || recordings.events[1].UserID.Int32 != 1 {
+		t.Fatalf("hold events = %+v", recordings.events)
+	}
+
+	if _, err := srv.SetLegalHold(ctx, connect.NewRequest(&secretaryv1.SetLegalHoldRequest{Id: 8, Held: true, Reason: "x"})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("unknown recording: %v", err)
+	}
+	srv.ConfigureStores(nil, nil, memberUsers{})
+	if err := hold(true, "x"); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member: %v", err)
+	}
+}
@@ -0,0 +1,374 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// Live ingestion lets a capture client stream a recording while it is still
+// in progress: it starts a session, PUTs raw 16-bit little-endian PCM chunks
+// in sequence, and finalizes, at which point the chunks are stitched into a
+// single WAV file. Chunks are idempotent by sequence number so a client can
+// safely resend one after a network error.
+const (
+	maxIngestChunkBytes = 8 << 20
+	maxIngestSampleRate = 192000
+	maxIngestChannels   = 8
+	wavHeaderBytes      = 44
+)
+
+type startLiveRecordingRequest struct {
+	Name       string `json:"name"`
+	SampleRate int32  `json:"sampleRate"`
+	Channels   int32  `json:"channels"`
+}
+
+type finalizeLiveRecordingRequest struct {
+	ChunkCount int32 `json:"chunkCount"`
+}
+
+func (s *Server) handleLiveRecordingStart(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	userID := currentUserID(r.Context())
+	if userID <= 0 {
+		writeError(w, http.StatusUnauthorized, "unauthenticated")
+		return
+	}
+	if s.storage == nil {
+		writeError(w, http.StatusServiceUnavailable, "audio storage is not configured")
+		return
+	}
+	if err := s.checkUploadQuota(r.Context(), pgtype.Int4{Int32: int32(userID), Valid: true}); err != nil {
+		writeQuotaError(w, err)
+		return
+	}
+	var req startLiveRecordingRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<16)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.SampleRate <= 0 || req.SampleRate > maxIngestSampleRate {
+		writeError(w, http.StatusBadRequest, "sampleRate is out of range")
+		return
+	}
+	if req.Channels <= 0 || req.Channels > maxIngestChannels {
+		writeError(w, http.StatusBadRequest, "channels is out of range")
+		return
+	}
+	name := req.Name
+	if name == "" {
+		name = "Live recording"
+	}
+
+	qtx, err := s.recordings.BeginRecordingTx(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to start recording")
+		return
+	}
+	defer qtx.Rollback(r.Context())
+
+	row, err := qtx.CreateLiveRecording(r.Context(), db.CreateLiveRecordingParams{
+		Name:            pgtype.Text{String: name, Valid: true},
+		CreatedByUserID: pgtype.Int4{Int32: int32(userID), Valid: true},
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to start recording")
+		return
+	}
+	if err := qtx.CreateRecordingIngest(r.Context(), db.CreateRecordingIngestParams{
+		RecordingID: row.ID,
+		UserID:      int32(userID),
+		SampleRate:  req.SampleRate,
+		Channels:    req.Channels,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to start recording")
+		return
+	}
+	if err := qtx.Commit(r.Context()); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to start recording")
+		return
+	}
+	s.recordingCache.invalidate()
+
+	writeJSON(w, http.StatusCreated, map[string]any{
+		"recordingId":   row.ID,
+		"name":          row.Name.String,
+		"createdAt":     formatTime(row.CreatedAt),
+		"maxChunkBytes": maxIngestChunkBytes,
+	})
+}
+
+func (s *Server) handleLiveRecordingChunk(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	ingest, ok := s.openIngest(w, r)
+	if !ok {
+		return
+	}
+	seq, err := strconv.ParseInt(r.PathValue("seq"), 10, 32)
+	if err != nil || seq < 0 {
+		writeError(w, http.StatusBadRequest, "invalid chunk sequence")
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIngestChunkBytes))
+	if err != nil {
+		writeError(w, http.StatusRequestEntityTooLarge, "chunk too large")
+		return
+	}
+	frameBytes := int(ingest.Channels) * 2
+	if len(data) == 0 || len(data)%frameBytes != 0 {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("chunk must be a non-empty multiple of %d bytes", frameBytes))
+		return
+	}
+
+	key := ingestChunkKey(ingest.RecordingID, int32(seq))
+	if _, err := s.storage.Put(r.Context(), key, bytes.NewReader(data)); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to store chunk")
+		return
+	}
+	if err := s.recordings.UpsertRecordingIngestChunk(r.Context(), db.UpsertRecordingIngestChunkParams{
+		RecordingID: ingest.RecordingID,
+		Seq:         int32(seq),
+		SizeBytes:   int64(len(data)),
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to record chunk")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"seq": seq, "sizeBytes": len(data)})
+}
+
+func (s *Server) handleLiveRecordingFinalize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	ingest, ok := s.openIngest(w, r)
+	if !ok {
+		return
+	}
+	var req finalizeLiveRecordingRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<16)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	chunks, err := s.recordings.ListRecordingIngestChunks(r.Context(), ingest.RecordingID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to list chunks")
+		return
+	}
+	seqs := make([]int32, 0, len(chunks))
+	var dataBytes int64
+	for _, chunk := range chunks {
+		seqs = append(seqs, chunk.Seq)
+		dataBytes += chunk.SizeBytes
+	}
+	if missing, ok := firstMissingChunk(seqs, req.ChunkCount); !ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("chunk %d has not been received", missing))
+		return
+	}
+	if dataBytes > 0xFFFFFFFF-wavHeaderBytes {
+		writeError(w, http.StatusRequestEntityTooLarge, "recording exceeds the WAV size limit")
+		return
+	}
+
+	key, err := newAudioKey(".wav")
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to store audio")
+		return
+	}
+	header := wavHeader(uint32(dataBytes), uint32(ingest.SampleRate), uint16(ingest.Channels))
+	keys := make([]string, req.ChunkCount)
+	for seq := range keys {
+		keys[seq] = ingestChunkKey(ingest.RecordingID, int32(seq))
+	}
+	chunkData := &chunkReader{ctx: r.Context(), store: s.storage, keys: keys}
+	defer chunkData.Close()
+	hash := sha256.New()
+	size, err := s.storage.Put(r.Context(), key, io.TeeReader(io.MultiReader(bytes.NewReader(header), chunkData), hash))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to assemble audio")
+		return
+	}
+
+	bytesPerSecond := int64(ingest.SampleRate) * int64(ingest.Channels) * 2
+	duration := int32((dataBytes + bytesPerSecond/2) / bytesPerSecond)
+	if err := s.completeIngest(r.Context(), ingest.RecordingID, key, duration, size, hex.EncodeToString(hash.Sum(nil))); err != nil {
+		_ = s.storage.Delete(r.Context(), key)
+		writeError(w, http.StatusInternalServerError, "failed to finalize recording")
+		return
+	}
+	s.recordingCache.invalidate()
+	s.queueAudioScan()
+	for seq, key := range keys {
+		if err := s.storage.Delete(r.Context(), key); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			log.Printf("live ingest: failed to delete chunk %d of recording %d: %v", seq, ingest.RecordingID, err)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"recording": map[string]any{
+			"id":       ingest.RecordingID,
+			"duration": duration,
+		},
+		"sizeBytes": size,
+	})
+}
+
+// openIngest loads the in-progress session named in the path and checks that
+// it belongs to the caller.
+func (s *Server) openIngest(w http.ResponseWriter, r *http.Request) (db.RecordingIngest, bool) {
+	userID := currentUserID(r.Context())
+	if userID <= 0 {
+		writeError(w, http.StatusUnauthorized, "unauthenticated")
+		return db.RecordingIngest{}, false
+	}
+	if s.storage == nil {
+		writeError(w, http.StatusServiceUnavailable, "audio storage is not configured")
+		return db.RecordingIngest{}, false
+	}
+	recordingID, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+	if err != nil || recordingID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid recording id")
+		return db.RecordingIngest{}, false
+	}
+	ingest, err := s.recordings.GetRecordingIngest(r.Context(), int32(recordingID))
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && int64(ingest.UserID) != userID) {
+		writeError(w, http.StatusNotFound, "live recording not found")
+		return db.RecordingIngest{}, false
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load live recording")
+		return db.RecordingIngest{}, false
+	}
+	if ingest.FinalizedAt.Valid {
+		writeError(w, http.StatusConflict, "live recording already finalized")
+		return db.RecordingIngest{}, false
+	}
+	return ingest, true
+}
+
+func (s *Server) completeIngest(ctx context.Context, recordingID int32, key string, duration int32, size int64, sum string) error {
+	qtx, err := s.recordings.BeginRecordingTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer qtx.Rollback(ctx)
+	if err := qtx.SetRecordingAudio(ctx, db.SetRecordingAudioParams{
+		ID:          recordingID,
+		AudioKey:    pgtype.Text{String: key, Valid: true},
+		Duration:    pgtype.Int4{Int32: duration, Valid: true},
+		AudioBytes:  pgtype.Int8{Int64: size, Valid: true},
+		AudioSha256: pgtype.Text{String: sum, Valid: true},
+		ScanStatus:  s.newAudioScanStatus(),
+	}); err != nil {
+		return err
+	}
+	if err := qtx.FinalizeRecordingIngest(ctx, recordingID); err != nil {
+		return err
+	}
+	return qtx.Commit(ctx)
+}
+
+// firstMissingChunk checks that seqs (sorted) is exactly 0..count-1 and
+// otherwise returns the first sequence number that is absent.
+func firstMissingChunk(seqs []int32, count int32) (int32, bool) {
+	if count <= 0 {
+		return 0, false
+	}
+	for i := int32(0); i < count; i++ {
+		if int(i) >= len(seqs) || seqs[i] != i {
+			return i, false
+		}
+	}
+	return 0, true
+}
+
+func ingestChunkKey(recordingID int32, seq int32) string {
+	return fmt.Sprintf("ingest/%d/%06d.pcm", recordingID, seq)
+}
+
+// wavHeader returns a canonical 44-byte PCM WAV header for 16-bit samples.
+func wavHeader(dataBytes uint32, sampleRate uint32, channels uint16) []byte {
+	header := make([]byte, wavHeaderBytes)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], 36+dataBytes)
+	copy(header[8:], "WAVE")
+	copy(header[12:], "fmt ")
+	binary.LittleEndian.PutUint32(header[16:], 16)
+	binary.LittleEndian.PutUint16(header[20:], 1)
+	binary.LittleEndian.PutUint16(header[22:], channels)
+	binary.LittleEndian.PutUint32(header[24:], sampleRate)
+	binary.LittleEndian.PutUint32(header[28:], sampleRate*uint32(channels)*2)
+	binary.LittleEndian.PutUint16(header[32:], channels*2)
+	binary.LittleEndian.PutUint16(header[34:], 16)
+	copy(header[36:], "data")
+	binary.LittleEndian.PutUint32(header[40:], dataBytes)
+	return header
+}
+
+// chunkReader reads stored objects back to back, opening each only when the
+// previous one is exhausted so long recordings don't hold many files open.
+type chunkReader struct {
+	ctx     context.Context
+	store   storage.Store
+	keys    []string
+	next    int
+	current io.ReadCloser
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if c.current == nil {
+			if c.next >= len(c.keys) {
+				return 0, io.EOF
+			}
+			rc, err := c.store.Open(c.ctx, c.keys[c.next])
+			if err != nil {
+				return 0, fmt.Errorf("open chunk %d: %w", c.next, err)
+			}
+			c.current = rc
+			c.next++
+		}
+		n, err := c.current.Read(p)
+		if errors.Is(err, io.EOF) {
+			c.current.Close()
+			c.current = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+func (c *chunkReader) Close() error {
+	if c.current == nil {
+		return nil
+	}
+	err := c.current.Close()
+	c.current = nil
+	return err
+}
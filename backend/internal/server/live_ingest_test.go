@@ -0,0 +1,58 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+func TestWAVHeader(t *testing.T) {
+	header := wavHeader(32000, 16000, 1)
+	if len(header) != wavHeaderBytes || string(header[0:4]) != "RIFF" || string(header[36:40]) != "data" {
+		t.Fatalf("malformed header %q", header)
+	}
+	if got := binary.LittleEndian.Uint32(header[4:]); got != 36+32000 {
+		t.Fatalf("unexpected RIFF size %d", got)
+	}
+	if got := binary.LittleEndian.Uint32(header[28:]); got != 32000 {
+		t.Fatalf("unexpected byte rate %d", got)
+	}
+}
+
+func TestFirstMissingChunk(t *testing.T) {
+	if _, ok := firstMissingChunk([]int32{0, 1, 2}, 3); !ok {
+		t.Fatalf("expected complete sequence")
+	}
+	if missing, ok := firstMissingChunk([]int32{0, 2}, 3); ok || missing != 1 {
+		t.Fatalf("expected chunk 1 missing, got %d %v", missing, ok)
+	}
+	if _, ok := firstMissingChunk(nil, 0); ok {
+		t.Fatalf("expected zero chunks to be rejected")
+	}
+}
+
+func TestChunkReaderConcatenates(t *testing.T) {
+	ctx := context.Background()
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	var keys []string
+	for seq, part := range []string{"ab", "cd", "ef"} {
+		keys = append(keys, ingestChunkKey(7, int32(seq)))
+		if _, err := store.Put(ctx, keys[seq], strings.NewReader(part)); err != nil {
+			t.Fatalf("put chunk: %v", err)
+		}
+	}
+	reader := &chunkReader{ctx: ctx, store: store, keys: keys}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil || !bytes.Equal(data, []byte("abcdef")) {
+		t.Fatalf("unexpected concatenation %q (%v)", data, err)
+	}
+}
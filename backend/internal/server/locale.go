@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/mvult/secretary/backend/internal/i18n"
+)
+
+// savedLocale is the signed-in user's locale preference, or "" when they
+// follow their browser or are not signed in. It is for text written
+// outside a request from the web app, such as emails and calendar feeds.
+func (s *Server) savedLocale(ctx context.Context) string {
+	userID := currentUserID(ctx)
+	if userID <= 0 || s.users == nil {
+		return ""
+	}
+	profile, err := s.users.GetProfile(ctx, int32(userID))
+	if err != nil {
+		return ""
+	}
+	return profile.Locale.String
+}
+
+// localeInterceptor translates error messages into the best match for
+// Accept-Language; the web app sends the user's saved locale there. Codes
+// and details are untouched, so clients that branch on them are
+// unaffected.
+type localeInterceptor struct{}
+
+func (i localeInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		resp, err := next(ctx, req)
+		if err != nil {
+			err = localizeError(req.Header().Get("Accept-Language"), err)
+		}
+		return resp, err
+	}
+}
+
+func (i localeInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i localeInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		err := next(ctx, conn)
+		if err != nil {
+			err = localizeError(conn.RequestHeader().Get("Accept-Language"), err)
+		}
+		return err
+	}
+}
+
+func localizeError(acceptLanguage string, err error) error {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || acceptLanguage == "" || !i18n.Translatable(connectErr.Message()) {
+		return err
+	}
+	locale := i18n.Negotiate("", acceptLanguage)
+	message := i18n.Message(locale, connectErr.Message())
+	if message == connectErr.Message() {
+		return err
+	}
+	localized := connect.NewError(connectErr.Code(), errors.New(message))
+	for _, detail := range connectErr.Details() {
+		localized.AddDetail(detail)
+	}
+	for key, values := range connectErr.Meta() {
+		localized.Meta()[key] = values
+	}
+	return localized
+}
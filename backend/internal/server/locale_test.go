@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+func TestLocaleInterceptorTranslatesErrors(t *testing.T) {
+	handler := localeInterceptor{}.WrapUnary(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		return nil, apierr.InvalidField("email", "must be a plain email address")
+	})
+	req := connect.NewRequest(&secretaryv1.GetMeRequest{})
+
+	_, err := handler(context.Background(), req)
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Message() != "email: must be a plain email address" {
+		t.Fatalf("without Accept-Language err = %v", err)
+	}
+
+	req.Header().Set("Accept-Language", "es-ES,es;q=0.9")
+	_, err = handler(context.Background(), req)
+	if !errors.As(err, &connectErr) || connectErr.Message() != "email: debe ser una dirección de correo simple" {
+		t.Fatalf("err = %v", err)
+	}
+	if connect.CodeOf(err) != connect.CodeInvalidArgument || len(connectErr.Details()) != 1 {
+		t.Fatalf("code and details should be kept, got %v with %d details", connect.CodeOf(err), len(connectErr.Details()))
+	}
+}
+
+func TestVerificationEmailUsesLocale(t *testing.T) {
+	srv, _, mailer := newProfileServer()
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+	locale, email := "es-MX", "ana@new.example.com"
+	resp, err := srv.UpdateMe(ctx, connect.NewRequest(&secretaryv1.UpdateMeRequest{Locale: &locale, Email: &email}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Msg.Profile.Locale != "es" {
+		t.Fatalf("locale = %q, want es", resp.Msg.Profile.Locale)
+	}
+	if len(mailer.sent) != 1 || mailer.sent[0].Subject != "Confirma tu dirección de correo" {
+		t.Fatalf("sent = %+v", mailer.sent)
+	}
+
+	bad := "tlh"
+	if _, err := srv.UpdateMe(ctx, connect.NewRequest(&secretaryv1.UpdateMeRequest{Locale: &bad})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("err = %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/password"
+)
+
+const (
+	// loginFailureDelay is the least a failed sign-in takes, counted from
+	// when the request arrived. Up to as much again is added at random, so
+	// response times say nothing about why a sign-in failed.
+	loginFailureDelay = 250 * time.Millisecond
+	// loginAuditInterval is how often failures for one address and email
+	// are written to the audit log and the server log, so a password
+	// guesser doesn't flood them. The metrics count every failure.
+	loginAuditInterval = 5 * time.Minute
+)
+
+// ConfigurePasswordHashing sets the argon2id costs for new password
+// hashes. Hashes with other costs, and bcrypt hashes, are replaced when
+// their users next sign in.
+func (s *Server) ConfigurePasswordHashing(params password.Params) {
+	s.passwordParams = params
+	// Sign-ins for unknown emails check this hash, so they cost as much as
+	// a wrong password. It is made when first needed.
+	s.dummyPasswordHash = sync.OnceValue(func() string {
+		hash, err := password.Hash("not a password", params)
+		if err != nil {
+			panic(err)
+		}
+		return hash
+	})
+}
+
+// checkPassword reports whether plain matches userID's hash. A hash that
+// can't be read matches nothing.
+func (s *Server) checkPassword(userID int32, hash, plain string) bool {
+	matched, err := password.Verify(hash, plain)
+	if err != nil {
+		log.Printf("login: user %d's password hash can't be checked: %v", userID, err)
+	}
+	return matched
+}
+
+func (s *Server) hashPassword(plain string) (string, error) {
+	return password.Hash(plain, s.passwordParams)
+}
+
+// rehashPassword replaces userID's stored hash, which plain was just
+// checked against, with one using the current costs. It fails quietly: the
+// old hash keeps working, and the next sign-in tries again.
+func (s *Server) rehashPassword(ctx context.Context, userID int32, oldHash, plain string) {
+	hash, err := s.hashPassword(plain)
+	if err != nil {
+		log.Printf("login: failed to rehash user %d's password: %v", userID, err)
+		return
+	}
+	_, err = s.users.UpdatePasswordHash(ctx, db.UpdatePasswordHashParams{
+		ID:      userID,
+		OldHash: pgtype.Text{String: oldHash, Valid: true},
+		NewHash: pgtype.Text{String: hash, Valid: true},
+	})
+	if err != nil {
+		log.Printf("login: failed to store user %d's rehashed password: %v", userID, err)
+	}
+}
+
+// loginStats counts sign-ins for /metrics.
+type loginStats struct {
+	succeeded atomic.Int64
+	failed    atomic.Int64
+}
+
+// loginFailure is why a sign-in was refused. It is recorded for admins but
+// never told to the caller, beyond a deactivated account that gave the
+// right password.
+type loginFailure string
+
+const (
+	loginUnknownEmail  loginFailure = "unknown_email"
+	loginNoPassword    loginFailure = "no_password"
+	loginWrongPassword loginFailure = "wrong_password"
+	loginDeactivated   loginFailure = "deactivated"
+)
+
+// failLogin answers a refused sign-in once at least the failure delay has
+// passed since start, and records it.
+func (s *Server) failLogin(w http.ResponseWriter, r *http.Request, start time.Time, email string, userID int32, reason loginFailure) {
+	s.loginStats.failed.Add(1)
+	s.recordLoginFailure(r.Context(), s.ipAllowlist.clientIP(r.RemoteAddr, r.Header).String(), email, userID, reason)
+
+	if !s.delayFailure(r.Context(), start) {
+		return
+	}
+	if reason == loginDeactivated {
+		writeError(w, http.StatusForbidden, "account is deactivated")
+		return
+	}
+	writeError(w, http.StatusUnauthorized, "invalid credentials")
+}
+
+// delayFailure waits until at least the failure delay, plus jitter, has
+// passed since start. It reports false if ctx ended first.
+func (s *Server) delayFailure(ctx context.Context, start time.Time) bool {
+	if s.loginDelay <= 0 {
+		return true
+	}
+	select {
+	case <-time.After(time.Until(start.Add(s.loginDelay + rand.N(s.loginDelay)))):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (s *Server) recordLoginFailure(ctx context.Context, ip, email string, userID int32, reason loginFailure) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if !s.loginAuditLimit.due(fmt.Sprintf("%s %s", ip, email), time.Now(), loginAuditInterval) {
+		return
+	}
+	log.Printf("login: refused sign-in as user %d from %s: %s", userID, ip, reason)
+	detail, _ := json.Marshal(map[string]any{"email": email, "ip": ip, "reason": reason, "user_id": userID})
+	s.audit(ctx, "Login.Failure", string(detail))
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/password"
+)
+
+// loginUsers finds accounts by email and counts password rehashes.
+type loginUsers struct {
+	UserStore
+	byEmail  map[string]db.GetUserByEmailRow
+	rehashed int
+}
+
+func (f *loginUsers) UpdatePasswordHash(_ context.Context, arg db.UpdatePasswordHashParams) (int64, error) {
+	for email, row := range f.byEmail {
+		if row.ID == arg.ID && row.PasswordHash == arg.OldHash {
+			row.PasswordHash = arg.NewHash
+			f.byEmail[email] = row
+			f.rehashed++
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func (f *loginUsers) GetUserByEmail(_ context.Context, email pgtype.Text) (db.GetUserByEmailRow, error) {
+	row, ok := f.byEmail[email.String]
+	if !ok {
+		return db.GetUserByEmailRow{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func TestDummyPasswordHash(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	params := password.Params{Memory: 8 * 1024, Iterations: 1, Parallelism: 1}
+	srv.ConfigurePasswordHashing(params)
+	if hash := srv.dummyPasswordHash(); password.NeedsRehash(hash, params) {
+		t.Fatalf("dummy hash %s doesn't use the configured costs, so unknown emails would be checked faster than real ones", hash)
+	}
+}
+
+func TestLoginRehashesPasswords(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	users := &loginUsers{byEmail: map[string]db.GetUserByEmailRow{
+		"ana@example.com": {ID: 5, FirstName: "Ana", PasswordHash: optionalText(string(bcryptHash))},
+	}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, users)
+	srv.sessions = &fakeSessions{}
+	srv.loginDelay = 0
+	login := func(plain string) int {
+		body, _ := json.Marshal(LoginRequest{Email: "ana@example.com", Password: plain})
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/login", strings.NewReader(string(body))))
+		return rec.Code
+	}
+	stored := func() string { return users.byEmail["ana@example.com"].PasswordHash.String }
+
+	// A wrong password leaves the old hash alone.
+	srv.auditLog = &fakeAuditLog{}
+	if code := login("wrong"); code != http.StatusUnauthorized || users.rehashed != 0 {
+		t.Fatalf("wrong password: %d, %d rehashes", code, users.rehashed)
+	}
+	if code := login("secret"); code != http.StatusOK {
+		t.Fatalf("bcrypt sign-in: %d", code)
+	}
+	if users.rehashed != 1 || !strings.HasPrefix(stored(), "$argon2id$") {
+		t.Fatalf("bcrypt hash wasn't replaced: %s", stored())
+	}
+	if code := login("secret"); code != http.StatusOK || users.rehashed != 1 {
+		t.Fatalf("argon2id sign-in: %d, %d rehashes", code, users.rehashed)
+	}
+
+	// Raising the costs moves hashes to them as well.
+	stronger := password.Params{Memory: 32 * 1024, Iterations: 3, Parallelism: 1}
+	srv.ConfigurePasswordHashing(stronger)
+	if code := login("secret"); code != http.StatusOK || users.rehashed != 2 || password.NeedsRehash(stored(), stronger) {
+		t.Fatalf("after raising the costs: %d, %d rehashes, %s", code, users.rehashed, stored())
+	}
+}
+
+func TestLoginFailures(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, &loginUsers{byEmail: map[string]db.GetUserByEmailRow{
+		"ana@example.com":   {ID: 5, FirstName: "Ana", PasswordHash: optionalText(string(hash))},
+		"sso@example.com":   {ID: 6, FirstName: "Sam"},
+		"pedro@example.com": {ID: 9, FirstName: "Pedro", PasswordHash: optionalText(string(hash)), DeactivatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}},
+	}})
+	srv.sessions = &fakeSessions{}
+	audit := &fakeAuditLog{}
+	srv.auditLog = audit
+	srv.loginDelay = 20 * time.Millisecond
+
+	login := func(email, password string) (int, string, time.Duration) {
+		body, _ := json.Marshal(LoginRequest{Email: email, Password: password})
+		rec := httptest.NewRecorder()
+		start := time.Now()
+		srv.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/login", strings.NewReader(string(body))))
+		return rec.Code, strings.TrimSpace(rec.Body.String()), time.Since(start)
+	}
+
+	// An unknown email, an account without a password and a wrong password
+	// get the same answer, after the same delay.
+	_, want, _ := login("ana@example.com", "wrong")
+	for _, email := range []string{"nobody@example.com", "sso@example.com", "ana@example.com"} {
+		for range 2 {
+			code, body, took := login(email, "wrong")
+			if code != http.StatusUnauthorized || body != want {
+				t.Fatalf("%s: %d %s, want 401 %s", email, code, body, want)
+			}
+			if took < srv.loginDelay {
+				t.Fatalf("%s: refused after %s, want at least %s", email, took, srv.loginDelay)
+			}
+		}
+	}
+	// A deactivated account is only revealed to someone with its password.
+	if code, _, _ := login("pedro@example.com", "wrong"); code != http.StatusUnauthorized {
+		t.Fatalf("deactivated account, wrong password: %d", code)
+	}
+	if code, _, _ := login("pedro@example.com", "secret"); code != http.StatusForbidden {
+		t.Fatalf("deactivated account, right password: %d", code)
+	}
+	if code, _, _ := login("ana@example.com", "secret"); code != http.StatusOK {
+		t.Fatalf("right password: %d", code)
+	}
+
+	// Failures are audited once per address and email within the interval.
+	reasons := map[string]int{}
+	for _, e := range audit.entries {
+		if e.Action != "Login.Failure" {
+			t.Fatalf("unexpected audit entry %s", e.Action)
+		}
+		var detail struct {
+			Email  string `json:"email"`
+			Reason string `json:"reason"`
+		}
+		if err := json.Unmarshal([]byte(e.Detail), &detail); err != nil {
+			t.Fatal(err)
+		}
+		reasons[detail.Email+" "+detail.Reason]++
+	}
+	for _, want := range []string{"nobody@example.com unknown_email", "sso@example.com no_password", "ana@example.com wrong_password", "pedro@example.com wrong_password"} {
+		if reasons[want] != 1 {
+			t.Errorf("audit entries for %q = %d, want 1 (all: %v)", want, reasons[want], reasons)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	for _, want := range []string{"secretary_logins_total 1\n", "secretary_login_failures_total 9\n"} {
+		if !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("metrics lack %q", want)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package server
+
+import (
+	"os"
+	"testing"
+
+	"github.com/mvult/secretary/backend/internal/testdb"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testdb.Main(m))
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/i18n"
+	"github.com/mvult/secretary/backend/internal/shared"
+)
+
+const (
+	maintenanceTitle          = "Down for maintenance"
+	defaultMaintenanceMessage = "Secretary is down for maintenance. Please try again shortly."
+	// maintenanceRetryAfter is the Retry-After sent with the 503, in
+	// seconds; maintenance has no known end.
+	maintenanceRetryAfter = 120
+)
+
+// maintenanceExempt are the paths that keep working for everyone during
+// maintenance: probes, metrics, and signing in, so admins can get a token.
+var maintenanceExempt = map[string]bool{
+	"/healthz":   true,
+	"/metrics":   true,
+	"/api/login": true,
+}
+
+// withMaintenance answers everyone but admins with 503 while maintenance
+// mode is on. It runs before authentication, so it checks the token
+// itself; that only costs anything during maintenance.
+func (s *Server) withMaintenance(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		settings := s.orgSettings()
+		if !settings.MaintenanceMode || maintenanceExempt[r.URL.Path] || strings.HasPrefix(r.URL.Path, "/grpc.") || s.callerIsAdmin(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		writeMaintenance(w, r, settings.MaintenanceMessage)
+	})
+}
+
+func (s *Server) callerIsAdmin(r *http.Request) bool {
+	principal, err := s.authenticate(r.Header.Get("Authorization"))
+	if err != nil {
+		return false
+	}
+	user, err := s.users.GetUser(r.Context(), int32(principal.UserID))
+	return err == nil && user.Role.String == "admin"
+}
+
+// writeMaintenance answers in the form the caller reads: a Connect error
+// for RPCs, a page for browsers and the usual JSON error otherwise. An
+// admin's message is shown as written; the default one is translated.
+func writeMaintenance(w http.ResponseWriter, r *http.Request, message string) {
+	locale := i18n.Negotiate("", r.Header.Get("Accept-Language"))
+	if message == "" {
+		message = i18n.T(locale, defaultMaintenanceMessage)
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(maintenanceRetryAfter))
+	w.Header().Set("Cache-Control", "no-store")
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/secretary.v1."):
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_ = json.NewEncoder(w).Encode(map[string]string{"code": connect.CodeUnavailable.String(), "message": message})
+	case strings.Contains(r.Header.Get("Accept"), "text/html"):
+		title := html.EscapeString(i18n.T(locale, maintenanceTitle))
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintf(w, "<!doctype html>\n<html lang=%q>\n<head><meta charset=\"utf-8\"><title>%s</title></head>\n<body><h1>%s</h1>\n<p>%s</p></body>\n</html>\n",
+			locale, title, title, html.EscapeString(message))
+	default:
+		writeError(w, http.StatusServiceUnavailable, message)
+	}
+}
+
+func maintenanceToProto(row db.OrgSetting) *secretaryv1.MaintenanceMode {
+	return &secretaryv1.MaintenanceMode{
+		Enabled:   row.MaintenanceMode,
+		Message:   row.MaintenanceMessage,
+		StartedAt: formatTime(row.MaintenanceStartedAt),
+	}
+}
+
+func (s *Server) GetMaintenanceMode(ctx context.Context, req *connect.Request[secretaryv1.GetMaintenanceModeRequest]) (*connect.Response[secretaryv1.GetMaintenanceModeResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can see maintenance mode"); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.GetMaintenanceModeResponse{Mode: maintenanceToProto(s.orgSettings())}), nil
+}
+
+func (s *Server) SetMaintenanceMode(ctx context.Context, req *connect.Request[secretaryv1.SetMaintenanceModeRequest]) (*connect.Response[secretaryv1.SetMaintenanceModeResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can change maintenance mode"); err != nil {
+		return nil, err
+	}
+	userID := currentUserID(ctx)
+	row, err := s.settings.SetMaintenanceMode(ctx, db.SetMaintenanceModeParams{
+		MaintenanceMode:    req.Msg.Enabled,
+		MaintenanceMessage: strings.TrimSpace(req.Msg.Message),
+		UpdatedByUserID:    pgtype.Int4{Int32: int32(userID), Valid: true},
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to change maintenance mode")
+	}
+	s.settingsCache.Store(&row)
+	s.publish(ctx, shared.Event{Kind: shared.EventSettingsChanged})
+	log.Printf("maintenance: user %d turned maintenance mode %s", userID, onOff(row.MaintenanceMode))
+	return connect.NewResponse(&secretaryv1.SetMaintenanceModeResponse{Mode: maintenanceToProto(row)}), nil
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+func TestMaintenanceMode(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.settings = &fakeSettings{}
+	srv.auditLog = &fakeAuditLog{}
+	srv.ConfigureStores(nil, nil, &deactivatingUsers{users: map[int32]db.GetUserRow{
+		1: {ID: 1, Role: optionalText("admin")},
+		2: {ID: 2, Role: optionalText("member")},
+	}})
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	client := func(userID int64) secretaryv1connect.AdminServiceClient {
+		token, err := srv.issueToken(userID, tokenClaims{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return secretaryv1connect.NewAdminServiceClient(ts.Client(), ts.URL, connect.WithInterceptors(connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+			return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+				req.Header().Set("Authorization", "Bearer "+token)
+				return next(ctx, req)
+			}
+		})))
+	}
+	admin, member := client(1), client(2)
+	ctx := context.Background()
+
+	res, err := admin.SetMaintenanceMode(ctx, connect.NewRequest(&secretaryv1.SetMaintenanceModeRequest{Enabled: true}))
+	if err != nil || !res.Msg.Mode.Enabled {
+		t.Fatalf("turning maintenance on: %v, %v", res, err)
+	}
+
+	// Admins keep working; everyone else is told to come back later.
+	if _, err := admin.GetMaintenanceMode(ctx, connect.NewRequest(&secretaryv1.GetMaintenanceModeRequest{})); err != nil {
+		t.Fatalf("admin during maintenance: %v", err)
+	}
+	_, err = member.GetMaintenanceMode(ctx, connect.NewRequest(&secretaryv1.GetMaintenanceModeRequest{}))
+	if connect.CodeOf(err) != connect.CodeUnavailable || !strings.Contains(err.Error(), defaultMaintenanceMessage) {
+		t.Fatalf("member during maintenance: %v", err)
+	}
+
+	get := func(path string, header http.Header) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		for name, values := range header {
+			r.Header[name] = values
+		}
+		srv.ServeHTTP(rec, r)
+		return rec
+	}
+	if rec := get("/healthz", nil); rec.Code != http.StatusOK {
+		t.Fatalf("healthz during maintenance: %d", rec.Code)
+	}
+	rec := get("/api/docs", http.Header{"Accept": {"text/html"}, "Accept-Language": {"es"}})
+	if rec.Code != http.StatusServiceUnavailable || rec.Header().Get("Retry-After") == "" || !strings.Contains(rec.Body.String(), "<h1>En mantenimiento</h1>") {
+		t.Fatalf("browser during maintenance: %d %s", rec.Code, rec.Body)
+	}
+
+	if _, err := admin.SetMaintenanceMode(ctx, connect.NewRequest(&secretaryv1.SetMaintenanceModeRequest{Enabled: true, Message: "Moving to a new database <b>now</b>"})); err != nil {
+		t.Fatal(err)
+	}
+	rec = get("/api/docs", http.Header{"Accept": {"text/html"}})
+	if !strings.Contains(rec.Body.String(), "Moving to a new database &lt;b&gt;now&lt;/b&gt;") {
+		t.Fatalf("admin's message not shown escaped: %s", rec.Body)
+	}
+
+	if _, err := admin.SetMaintenanceMode(ctx, connect.NewRequest(&secretaryv1.SetMaintenanceModeRequest{})); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := member.GetMaintenanceMode(ctx, connect.NewRequest(&secretaryv1.GetMaintenanceModeRequest{})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member after maintenance: %v", err)
+	}
+}
@@ -0,0 +1,578 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// Result sizes for ListMentions when no limit is given, and for what
+// LinkMentions returns about one recording.
+const (
+	defaultMentionLimit     = 100
+	maxMentionsPerRecording = 500
+)
+
+// MentionStore holds the transcript, todo and comment mention queries.
+type MentionStore interface {
+	ListMentions(ctx context.Context, arg db.ListMentionsParams) ([]db.ListMentionsRow, error)
+	ListTodoMentions(ctx context.Context, arg db.ListTodoMentionsParams) ([]db.ListTodoMentionsRow, error)
+	ListCommentMentions(ctx context.Context, arg db.ListCommentMentionsParams) ([]db.ListCommentMentionsRow, error)
+	CountUnreadMentions(ctx context.Context, userID int32) (int64, error)
+	SetTranscriptMentionsRead(ctx context.Context, arg db.SetTranscriptMentionsReadParams) error
+	SetTodoMentionsRead(ctx context.Context, arg db.SetTodoMentionsReadParams) error
+	SetCommentMentionsRead(ctx context.Context, arg db.SetCommentMentionsReadParams) error
+	ReplaceTranscriptMentions(ctx context.Context, arg db.ReplaceTranscriptMentionsParams) error
+	ListRecordingTodos(ctx context.Context, createdAtRecordingID pgtype.Int4) ([]db.ListRecordingTodosRow, error)
+	ReplaceTodoMentions(ctx context.Context, arg db.ReplaceTodoMentionsParams) error
+	AssignMentionedTodos(ctx context.Context, arg db.AssignMentionedTodosParams) ([]int32, error)
+}
+
+func (s *Server) transcriptMentionToProto(row db.ListMentionsRow) (*secretaryv1.Mention, error) {
+	speaker := int32(-1)
+	if row.SpeakerID.Valid {
+		speaker = row.SpeakerID.Int32
+	}
+	segment, err := s.openText(row.SegmentText)
+	if err != nil {
+		return nil, err
+	}
+	return &secretaryv1.Mention{
+		Kind:          secretaryv1.MentionKind_MENTION_KIND_TRANSCRIPT,
+		Id:            row.ID,
+		RecordingId:   int64(row.RecordingID),
+		RecordingName: row.RecordingName.String,
+		RecordingDate: formatTime(row.RecordingDate),
+		SegmentIndex:  row.SegmentIndex,
+		SpeakerId:     speaker,
+		UserId:        int64(row.UserID),
+		MatchedText:   row.MatchedText,
+		SegmentText:   segment,
+		Read:          row.ReadAt.Valid,
+		CreatedAt:     formatTime(row.CreatedAt),
+	}, nil
+}
+
+func todoMentionToProto(row db.ListTodoMentionsRow) *secretaryv1.Mention {
+	return &secretaryv1.Mention{
+		Kind:          secretaryv1.MentionKind_MENTION_KIND_TODO,
+		Id:            row.ID,
+		RecordingId:   int64(row.RecordingID.Int32),
+		RecordingName: row.RecordingName.String,
+		RecordingDate: formatTime(row.RecordingDate),
+		SpeakerId:     -1,
+		UserId:        int64(row.UserID),
+		MatchedText:   row.MatchedText,
+		TodoId:        int64(row.TodoID),
+		TodoName:      row.TodoName,
+		Read:          row.ReadAt.Valid,
+		CreatedAt:     formatTime(row.CreatedAt),
+	}
+}
+
+func commentMentionToProto(row db.ListCommentMentionsRow) *secretaryv1.Mention {
+	return &secretaryv1.Mention{
+		Kind:          secretaryv1.MentionKind_MENTION_KIND_COMMENT,
+		Id:            row.ID,
+		RecordingId:   int64(row.RecordingID.Int32),
+		RecordingName: row.RecordingName.String,
+		RecordingDate: formatTime(row.RecordingDate),
+		SpeakerId:     -1,
+		UserId:        int64(row.UserID),
+		MatchedText:   row.MatchedText,
+		TodoId:        int64(row.TodoID),
+		TodoName:      row.TodoName,
+		CommentId:     int64(row.CommentID),
+		CommentText:   row.CommentText,
+		Read:          row.ReadAt.Valid,
+		CreatedAt:     formatTime(row.CreatedAt),
+	}
+}
+
+// listMentions merges the transcript, todo and comment mentions matching
+// arg, most recently found first, up to arg.MaxResults. An empty kinds
+// means all of them.
+func (s *Server) listMentions(ctx context.Context, arg db.ListMentionsParams, kinds []secretaryv1.MentionKind) ([]*secretaryv1.Mention, error) {
+	wants := func(kind secretaryv1.MentionKind) bool {
+		return len(kinds) == 0 || slices.Contains(kinds, kind)
+	}
+	type found struct {
+		mention   *secretaryv1.Mention
+		createdAt time.Time
+	}
+	var all []found
+	if wants(secretaryv1.MentionKind_MENTION_KIND_TRANSCRIPT) {
+		// Sealed lines can't be matched in SQL, so with encryption on they
+		// are searched once opened, among all of them.
+		transcriptArg := arg
+		searchOpened := s.encryption != nil && arg.Query.Valid
+		if searchOpened {
+			transcriptArg.Query = pgtype.Text{}
+			transcriptArg.MaxResults = math.MaxInt32
+		}
+		rows, err := s.mentions.ListMentions(ctx, transcriptArg)
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to list mentions")
+		}
+		for _, row := range rows {
+			mention, err := s.transcriptMentionToProto(row)
+			if err != nil {
+				return nil, err
+			}
+			if searchOpened && !containsFold(mention.SegmentText, arg.Query.String) {
+				continue
+			}
+			all = append(all, found{mention, row.CreatedAt.Time})
+		}
+	}
+	if wants(secretaryv1.MentionKind_MENTION_KIND_TODO) {
+		rows, err := s.mentions.ListTodoMentions(ctx, db.ListTodoMentionsParams(arg))
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to list mentions")
+		}
+		for _, row := range rows {
+			all = append(all, found{todoMentionToProto(row), row.CreatedAt.Time})
+		}
+	}
+	if wants(secretaryv1.MentionKind_MENTION_KIND_COMMENT) {
+		rows, err := s.mentions.ListCommentMentions(ctx, db.ListCommentMentionsParams(arg))
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to list mentions")
+		}
+		for _, row := range rows {
+			all = append(all, found{commentMentionToProto(row), row.CreatedAt.Time})
+		}
+	}
+	sort.SliceStable(all, func(i, j int) bool { return all[i].createdAt.After(all[j].createdAt) })
+	if len(all) > int(arg.MaxResults) {
+		all = all[:arg.MaxResults]
+	}
+	mentions := make([]*secretaryv1.Mention, 0, len(all))
+	for _, f := range all {
+		mentions = append(mentions, f.mention)
+	}
+	return mentions, nil
+}
+
+// LinkMentions detects who a recording's transcript and todos name and
+// assigns its unassigned todos.
+func (s *Server) LinkMentions(ctx context.Context, req *connect.Request[secretaryv1.LinkMentionsRequest]) (*connect.Response[secretaryv1.LinkMentionsResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := int32(req.Msg.Id)
+	assigned, err := s.linkMentions(ctx, id, pgtype.Int4{Int32: int32(userID), Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	mentions, err := s.listMentions(ctx, db.ListMentionsParams{RecordingID: pgtype.Int4{Int32: id, Valid: true}, MaxResults: maxMentionsPerRecording}, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp := &secretaryv1.LinkMentionsResponse{Mentions: mentions}
+	for _, todoID := range assigned {
+		resp.AssignedTodoIds = append(resp.AssignedTodoIds, int64(todoID))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ListMentions is the caller's mentions inbox.
+func (s *Server) ListMentions(ctx context.Context, req *connect.Request[secretaryv1.ListMentionsRequest]) (*connect.Response[secretaryv1.ListMentionsResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	arg := db.ListMentionsParams{
+		UserID:     pgtype.Int4{Int32: int32(userID), Valid: true},
+		Query:      optionalText(msg.Query),
+		UnreadOnly: msg.UnreadOnly,
+		MaxResults: msg.Limit,
+	}
+	if msg.RecordingId != nil {
+		arg.RecordingID = pgtype.Int4{Int32: int32(*msg.RecordingId), Valid: true}
+	}
+	if arg.MaxResults == 0 {
+		arg.MaxResults = defaultMentionLimit
+	}
+	mentions, err := s.listMentions(ctx, arg, msg.Kinds)
+	if err != nil {
+		return nil, err
+	}
+	unread, err := s.mentions.CountUnreadMentions(ctx, int32(userID))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to count unread mentions")
+	}
+	return connect.NewResponse(&secretaryv1.ListMentionsResponse{Mentions: mentions, UnreadCount: unread}), nil
+}
+
+// MarkMentionsRead updates the read state of the caller's mentions. IDs of
+// other users' mentions are ignored.
+func (s *Server) MarkMentionsRead(ctx context.Context, req *connect.Request[secretaryv1.MarkMentionsReadRequest]) (*connect.Response[secretaryv1.MarkMentionsReadResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	transcript := db.SetTranscriptMentionsReadParams{Read: !msg.Unread, UserID: int32(userID), AllMentions: msg.All, Ids: []int64{}}
+	todo := db.SetTodoMentionsReadParams{Read: !msg.Unread, UserID: int32(userID), AllMentions: msg.All, Ids: []int64{}}
+	comment := db.SetCommentMentionsReadParams{Read: !msg.Unread, UserID: int32(userID), AllMentions: msg.All, Ids: []int64{}}
+	for _, ref := range msg.Mentions {
+		switch ref.Kind {
+		case secretaryv1.MentionKind_MENTION_KIND_TRANSCRIPT:
+			transcript.Ids = append(transcript.Ids, ref.Id)
+		case secretaryv1.MentionKind_MENTION_KIND_TODO:
+			todo.Ids = append(todo.Ids, ref.Id)
+		case secretaryv1.MentionKind_MENTION_KIND_COMMENT:
+			comment.Ids = append(comment.Ids, ref.Id)
+		}
+	}
+	if msg.All || len(transcript.Ids) > 0 {
+		if err := s.mentions.SetTranscriptMentionsRead(ctx, transcript); err != nil {
+			return nil, apierr.Wrap(err, "failed to update mentions")
+		}
+	}
+	if msg.All || len(todo.Ids) > 0 {
+		if err := s.mentions.SetTodoMentionsRead(ctx, todo); err != nil {
+			return nil, apierr.Wrap(err, "failed to update mentions")
+		}
+	}
+	if msg.All || len(comment.Ids) > 0 {
+		if err := s.mentions.SetCommentMentionsRead(ctx, comment); err != nil {
+			return nil, apierr.Wrap(err, "failed to update mentions")
+		}
+	}
+	unread, err := s.mentions.CountUnreadMentions(ctx, int32(userID))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to count unread mentions")
+	}
+	return connect.NewResponse(&secretaryv1.MarkMentionsReadResponse{UnreadCount: unread}), nil
+}
+
+// linkMentions stores who a recording's transcript and todos name, and
+// assigns each unassigned todo of the recording that names exactly one
+// person to them. It returns the assigned todo IDs. actor is recorded in
+// the todo history; it is unset when the server links a newly ready
+// recording.
+func (s *Server) linkMentions(ctx context.Context, id int32, actor pgtype.Int4) ([]int32, error) {
+	row, err := s.recordings.GetRecording(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch recording")
+	}
+	users, err := s.users.ListUsers(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list users")
+	}
+	participants, err := s.recordings.ListRecordingParticipants(ctx, id)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list recording participants")
+	}
+	matcher := newNameMatcher(users, participants)
+	transcript, err := s.openText(row.Transcript.String)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.ReplaceTranscriptMentionsParams{
+		RecordingID:    id,
+		SegmentIndexes: []int32{},
+		SpeakerIds:     []int32{},
+		UserIds:        []int32{},
+		MatchedTexts:   []string{},
+		SegmentTexts:   []string{},
+	}
+	for _, found := range matcher.transcriptMentions(transcript) {
+		line, err := s.sealText(found.line)
+		if err != nil {
+			return nil, err
+		}
+		arg.SegmentIndexes = append(arg.SegmentIndexes, found.segment)
+		arg.SpeakerIds = append(arg.SpeakerIds, found.speaker)
+		arg.UserIds = append(arg.UserIds, found.userID)
+		arg.MatchedTexts = append(arg.MatchedTexts, found.text)
+		arg.SegmentTexts = append(arg.SegmentTexts, line)
+	}
+	if err := s.mentions.ReplaceTranscriptMentions(ctx, arg); err != nil {
+		return nil, apierr.Wrap(err, "failed to store mentions")
+	}
+
+	todos, err := s.mentions.ListRecordingTodos(ctx, pgtype.Int4{Int32: id, Valid: true})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list recording todos")
+	}
+	todoMentions := db.ReplaceTodoMentionsParams{RecordingID: pgtype.Int4{Int32: id, Valid: true}, TodoIds: []int32{}, UserIds: []int32{}, MatchedTexts: []string{}}
+	assign := db.AssignMentionedTodosParams{ActorUserID: actor, TodoIds: []int32{}, UserIds: []int32{}}
+	for _, todo := range todos {
+		named := matcher.namedUsers(todo.Name + "\n" + todo.Desc.String)
+		for _, match := range named {
+			todoMentions.TodoIds = append(todoMentions.TodoIds, todo.ID)
+			todoMentions.UserIds = append(todoMentions.UserIds, match.userID)
+			todoMentions.MatchedTexts = append(todoMentions.MatchedTexts, match.text)
+		}
+		if len(named) == 1 && !todo.UserID.Valid {
+			assign.TodoIds = append(assign.TodoIds, todo.ID)
+			assign.UserIds = append(assign.UserIds, named[0].userID)
+		}
+	}
+	if err := s.mentions.ReplaceTodoMentions(ctx, todoMentions); err != nil {
+		return nil, apierr.Wrap(err, "failed to store mentions")
+	}
+	if len(assign.TodoIds) == 0 {
+		return nil, nil
+	}
+	assigned, err := s.mentions.AssignMentionedTodos(ctx, assign)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to assign todos")
+	}
+	for _, todo := range todos {
+		if i := slices.Index(assign.TodoIds, todo.ID); i >= 0 && slices.Contains(assigned, todo.ID) {
+			s.notifyTodoAssigned(ctx, db.Todo{
+				ID:                   todo.ID,
+				Name:                 todo.Name,
+				Desc:                 todo.Desc,
+				UserID:               pgtype.Int4{Int32: assign.UserIds[i], Valid: true},
+				CreatedAtRecordingID: pgtype.Int4{Int32: id, Valid: true},
+			}, pgtype.Int4{})
+		}
+	}
+	return assigned, nil
+}
+
+// linkReadyRecording links mentions once the worker reports a recording
+// ready. Failing to link is logged rather than failing the status update;
+// LinkMentions can be called again later.
+func (s *Server) linkReadyRecording(ctx context.Context, id int32) {
+	if _, err := s.linkMentions(ctx, id, pgtype.Int4{}); err != nil {
+		log.Printf("linking mentions for recording %d: %v", id, err)
+	}
+}
+
+// speakerLinePattern matches the "Speaker N:" label transcripts put in
+// front of each line.
+var speakerLinePattern = regexp.MustCompile(`(?i)^\s*speaker\s*(\d+)\s*:\s*`)
+
+type transcriptMention struct {
+	segment int32
+	speaker int32
+	userID  int32
+	text    string
+	line    string
+}
+
+// nameMatcher finds users' names in free text. A name is a user's full name
+// or their first name alone; a first name several users share only counts
+// when exactly one of them took part in the recording. Names must start
+// with a capital letter in the text, so a user called Will is not found in
+// "we will ship", and accents are ignored, so "Jose" finds José.
+type nameMatcher struct {
+	// names maps a folded name, its words joined by spaces, to the users
+	// it may refer to.
+	names map[string][]int32
+	// longest is the most words any name has.
+	longest int
+	// speakers maps the recording's speaker labels to identified users,
+	// and participants holds those users.
+	speakers     map[int32]int32
+	participants map[int32]bool
+}
+
+func newNameMatcher(users []db.ListUsersRow, participants []db.ListRecordingParticipantsRow) *nameMatcher {
+	m := &nameMatcher{
+		names:        map[string][]int32{},
+		speakers:     map[int32]int32{},
+		participants: map[int32]bool{},
+	}
+	for _, p := range participants {
+		m.speakers[p.SpeakerID] = p.ID
+		m.participants[p.ID] = true
+	}
+	for _, u := range users {
+		first := foldedWords(u.FirstName)
+		full := foldedWords(u.FirstName + " " + u.LastName.String)
+		for _, name := range [][]string{first, full} {
+			if len(name) == 0 || (len(name) == 1 && len([]rune(name[0])) < 2) {
+				continue
+			}
+			key := strings.Join(name, " ")
+			if !slices.Contains(m.names[key], u.ID) {
+				m.names[key] = append(m.names[key], u.ID)
+			}
+			m.longest = max(m.longest, len(name))
+		}
+	}
+	return m
+}
+
+// resolve picks the one user a name refers to, or returns false.
+func (m *nameMatcher) resolve(ids []int32) (int32, bool) {
+	if len(ids) == 1 {
+		return ids[0], true
+	}
+	var found []int32
+	for _, id := range ids {
+		if m.participants[id] {
+			found = append(found, id)
+		}
+	}
+	if len(found) == 1 {
+		return found[0], true
+	}
+	return 0, false
+}
+
+type nameMatch struct {
+	userID int32
+	text   string
+}
+
+// match returns the names in text, longest name first at each position.
+func (m *nameMatcher) match(text string) []nameMatch {
+	words := splitWords(text)
+	var matches []nameMatch
+	for i := 0; i < len(words); {
+		matched := false
+		if startsUpper(words[i].text) {
+			for n := min(m.longest, len(words)-i); n > 0; n-- {
+				folded := make([]string, n)
+				for j := range folded {
+					folded[j] = words[i+j].folded
+				}
+				ids, ok := m.names[strings.Join(folded, " ")]
+				if !ok {
+					continue
+				}
+				if id, ok := m.resolve(ids); ok {
+					matches = append(matches, nameMatch{userID: id, text: text[words[i].start:words[i+n-1].end]})
+					i += n
+					matched = true
+				}
+				break
+			}
+		}
+		if !matched {
+			i++
+		}
+	}
+	return matches
+}
+
+// namedUsers returns the first match for each distinct user text names,
+// in order.
+func (m *nameMatcher) namedUsers(text string) []nameMatch {
+	var named []nameMatch
+	for _, match := range m.match(text) {
+		if !slices.ContainsFunc(named, func(n nameMatch) bool { return n.userID == match.userID }) {
+			named = append(named, match)
+		}
+	}
+	return named
+}
+
+// transcriptMentions returns one mention per user named in each line of a
+// transcript. Speakers naming themselves are skipped.
+func (m *nameMatcher) transcriptMentions(transcript string) []transcriptMention {
+	var found []transcriptMention
+	for i, line := range strings.Split(transcript, "\n") {
+		speaker := int32(-1)
+		if label := speakerLinePattern.FindStringSubmatch(line); label != nil {
+			if n, err := strconv.ParseInt(label[1], 10, 32); err == nil {
+				speaker = int32(n)
+			}
+			line = line[len(label[0]):]
+		}
+		line = strings.TrimSpace(line)
+		seen := map[int32]bool{}
+		for _, match := range m.match(line) {
+			if seen[match.userID] {
+				continue
+			}
+			if self, ok := m.speakers[speaker]; ok && speaker >= 0 && self == match.userID {
+				continue
+			}
+			seen[match.userID] = true
+			found = append(found, transcriptMention{
+				segment: int32(i),
+				speaker: speaker,
+				userID:  match.userID,
+				text:    match.text,
+				line:    line,
+			})
+		}
+	}
+	return found
+}
+
+type word struct {
+	text       string
+	folded     string
+	start, end int
+}
+
+// splitWords splits text into runs of letters and digits, keeping their
+// byte offsets so a match can be quoted as written.
+func splitWords(text string) []word {
+	var words []word
+	start := -1
+	for i, r := range text {
+		inWord := unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+		switch {
+		case inWord && start < 0:
+			start = i
+		case !inWord && start >= 0:
+			words = append(words, word{text: text[start:i], folded: foldName(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		words = append(words, word{text: text[start:], folded: foldName(text[start:]), start: start, end: len(text)})
+	}
+	return words
+}
+
+func foldedWords(name string) []string {
+	words := splitWords(name)
+	folded := make([]string, len(words))
+	for i, w := range words {
+		folded[i] = w.folded
+	}
+	return folded
+}
+
+// foldName lowercases a word and strips its accents.
+func foldName(value string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), value)
+	if err != nil {
+		folded = value
+	}
+	return strings.ToLower(folded)
+}
+
+func startsUpper(value string) bool {
+	for _, r := range value {
+		return unicode.IsUpper(r)
+	}
+	return false
+}
@@ -0,0 +1,279 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// namedUsers is a team with two Anas; Ana López (8) is speaker 2 in the
+// test recordings.
+type namedUsers struct{ UserStore }
+
+func (namedUsers) ListUsers(context.Context) ([]db.ListUsersRow, error) {
+	return []db.ListUsersRow{
+		{ID: 7, FirstName: "José", LastName: optionalText("Pérez")},
+		{ID: 8, FirstName: "Ana", LastName: optionalText("López")},
+		{ID: 9, FirstName: "Ana", LastName: optionalText("Ruiz")},
+		{ID: 10, FirstName: "Will"},
+	}, nil
+}
+
+// fakeMentions keeps mentions and the todos of recording 3 in memory.
+type fakeMentions struct {
+	MentionStore
+	mentions     []db.ListMentionsRow
+	todoMentions []db.ListTodoMentionsRow
+	comments     []db.ListCommentMentionsRow
+	todos        []db.ListRecordingTodosRow
+	assigned     db.AssignMentionedTodosParams
+}
+
+func (f *fakeMentions) ListMentions(_ context.Context, arg db.ListMentionsParams) ([]db.ListMentionsRow, error) {
+	var rows []db.ListMentionsRow
+	for _, row := range f.mentions {
+		if (!arg.UserID.Valid || row.UserID == arg.UserID.Int32) && (!arg.UnreadOnly || !row.ReadAt.Valid) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeMentions) ListTodoMentions(_ context.Context, arg db.ListTodoMentionsParams) ([]db.ListTodoMentionsRow, error) {
+	var rows []db.ListTodoMentionsRow
+	for _, row := range f.todoMentions {
+		if (!arg.UserID.Valid || row.UserID == arg.UserID.Int32) && (!arg.UnreadOnly || !row.ReadAt.Valid) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeMentions) ListCommentMentions(_ context.Context, arg db.ListCommentMentionsParams) ([]db.ListCommentMentionsRow, error) {
+	var rows []db.ListCommentMentionsRow
+	for _, row := range f.comments {
+		if (!arg.UserID.Valid || row.UserID == arg.UserID.Int32) && (!arg.UnreadOnly || !row.ReadAt.Valid) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeMentions) CountUnreadMentions(_ context.Context, userID int32) (int64, error) {
+	var unread int64
+	for _, row := range f.mentions {
+		if row.UserID == userID && !row.ReadAt.Valid {
+			unread++
+		}
+	}
+	for _, row := range f.todoMentions {
+		if row.UserID == userID && !row.ReadAt.Valid {
+			unread++
+		}
+	}
+	for _, row := range f.comments {
+		if row.UserID == userID && !row.ReadAt.Valid {
+			unread++
+		}
+	}
+	return unread, nil
+}
+
+func (f *fakeMentions) SetTranscriptMentionsRead(_ context.Context, arg db.SetTranscriptMentionsReadParams) error {
+	for i, row := range f.mentions {
+		if row.UserID == arg.UserID && (arg.AllMentions || slices.Contains(arg.Ids, row.ID)) {
+			f.mentions[i].ReadAt = pgtype.Timestamptz{Time: time.Now(), Valid: arg.Read}
+		}
+	}
+	return nil
+}
+
+func (f *fakeMentions) SetTodoMentionsRead(_ context.Context, arg db.SetTodoMentionsReadParams) error {
+	for i, row := range f.todoMentions {
+		if row.UserID == arg.UserID && (arg.AllMentions || slices.Contains(arg.Ids, row.ID)) {
+			f.todoMentions[i].ReadAt = pgtype.Timestamptz{Time: time.Now(), Valid: arg.Read}
+		}
+	}
+	return nil
+}
+
+func (f *fakeMentions) SetCommentMentionsRead(_ context.Context, arg db.SetCommentMentionsReadParams) error {
+	for i, row := range f.comments {
+		if row.UserID == arg.UserID && (arg.AllMentions || slices.Contains(arg.Ids, row.ID)) {
+			f.comments[i].ReadAt = pgtype.Timestamptz{Time: time.Now(), Valid: arg.Read}
+		}
+	}
+	return nil
+}
+
+func (f *fakeMentions) ReplaceTranscriptMentions(_ context.Context, arg db.ReplaceTranscriptMentionsParams) error {
+	f.mentions = nil
+	for i := range arg.UserIds {
+		f.mentions = append(f.mentions, db.ListMentionsRow{
+			ID:           int64(i + 1),
+			RecordingID:  arg.RecordingID,
+			SegmentIndex: arg.SegmentIndexes[i],
+			SpeakerID:    pgtype.Int4{Int32: arg.SpeakerIds[i], Valid: arg.SpeakerIds[i] >= 0},
+			UserID:       arg.UserIds[i],
+			MatchedText:  arg.MatchedTexts[i],
+			SegmentText:  arg.SegmentTexts[i],
+		})
+	}
+	return nil
+}
+
+func (f *fakeMentions) ListRecordingTodos(context.Context, pgtype.Int4) ([]db.ListRecordingTodosRow, error) {
+	return f.todos, nil
+}
+
+func (f *fakeMentions) ReplaceTodoMentions(_ context.Context, arg db.ReplaceTodoMentionsParams) error {
+	f.todoMentions = nil
+	for i := range arg.TodoIds {
+		f.todoMentions = append(f.todoMentions, db.ListTodoMentionsRow{ID: int64(i + 1), TodoID: arg.TodoIds[i], UserID: arg.UserIds[i], MatchedText: arg.MatchedTexts[i]})
+	}
+	return nil
+}
+
+func (f *fakeMentions) AssignMentionedTodos(_ context.Context, arg db.AssignMentionedTodosParams) ([]int32, error) {
+	f.assigned = arg
+	return arg.TodoIds, nil
+}
+
+const mentionTranscript = `Speaker 1: Ana, can you take the budget? José will review it.
+Speaker 2: Sure. I'm Ana López, by the way.
+Speaker 1: We will ask Jose Perez about the vendor.`
+
+func TestLinkMentions(t *testing.T) {
+	recordings := speakingParticipants{&fakeTranslations{fakeRecordingStatus: &fakeRecordingStatus{status: recordingReady}, transcript: mentionTranscript}}
+	mentions := &fakeMentions{todos: []db.ListRecordingTodosRow{
+		{ID: 1, Name: "Send the budget to Ana"},
+		{ID: 2, Name: "Ana and José compare quotes"},
+		{ID: 3, Name: "Book a room", Desc: optionalText("ask José which day")},
+		{ID: 4, Name: "We will decide later"},
+		{ID: 5, Name: "Call José back", UserID: optionalInt4(8)},
+	}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(recordings, nil, namedUsers{})
+	srv.mentions = mentions
+
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+	resp, err := srv.LinkMentions(ctx, connect.NewRequest(&secretaryv1.LinkMentionsRequest{Id: 3}))
+	if err != nil {
+		t.Fatalf("LinkMentions: %v", err)
+	}
+
+	type found struct {
+		segment int32
+		user    int64
+		text    string
+	}
+	var got []found
+	for _, m := range resp.Msg.Mentions {
+		if m.Kind == secretaryv1.MentionKind_MENTION_KIND_TRANSCRIPT {
+			got = append(got, found{m.SegmentIndex, m.UserId, m.MatchedText})
+		}
+	}
+	// "Ana" is the participating Ana; Ana López naming herself is skipped;
+	// the lowercase "will" is not Will.
+	want := []found{{0, 8, "Ana"}, {0, 7, "José"}, {2, 7, "Jose Perez"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("mentions = %v, want %v", got, want)
+	}
+	if m := resp.Msg.Mentions[0]; m.SegmentText != "Ana, can you take the budget? José will review it." {
+		t.Fatalf("segment text = %q", m.SegmentText)
+	}
+
+	var todoMentions []string
+	for _, m := range mentions.todoMentions {
+		todoMentions = append(todoMentions, fmt.Sprintf("%d:%d", m.TodoID, m.UserID))
+	}
+	if want := []string{"1:8", "2:8", "2:7", "3:7", "5:7"}; !reflect.DeepEqual(todoMentions, want) {
+		t.Fatalf("todo mentions = %v, want %v", todoMentions, want)
+	}
+
+	// The todo naming two people stays unassigned, and the assigned one
+	// keeps its owner.
+	if !reflect.DeepEqual(mentions.assigned.TodoIds, []int32{1, 3}) || !reflect.DeepEqual(mentions.assigned.UserIds, []int32{8, 7}) {
+		t.Fatalf("assigned todos %v to users %v", mentions.assigned.TodoIds, mentions.assigned.UserIds)
+	}
+	if mentions.assigned.ActorUserID.Int32 != 5 || !reflect.DeepEqual(resp.Msg.AssignedTodoIds, []int64{1, 3}) {
+		t.Fatalf("actor = %v, assigned = %v", mentions.assigned.ActorUserID, resp.Msg.AssignedTodoIds)
+	}
+}
+
+func TestMentionInbox(t *testing.T) {
+	now := time.Now()
+	store := &fakeMentions{
+		mentions: []db.ListMentionsRow{
+			{ID: 1, UserID: 5, SegmentText: "Thanks, Sam.", CreatedAt: pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true}},
+			{ID: 2, UserID: 6, SegmentText: "Ask Kim."},
+		},
+		todoMentions: []db.ListTodoMentionsRow{
+			{ID: 1, UserID: 5, TodoName: "Sam reviews the deck", CreatedAt: pgtype.Timestamptz{Time: now, Valid: true}},
+		},
+		comments: []db.ListCommentMentionsRow{
+			{ID: 1, UserID: 5, CommentID: 4, CommentText: "@Sam thoughts?", CreatedAt: pgtype.Timestamptz{Time: now.Add(-2 * time.Hour), Valid: true}, ReadAt: pgtype.Timestamptz{Time: now, Valid: true}},
+		},
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.mentions = store
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	resp, err := srv.ListMentions(ctx, connect.NewRequest(&secretaryv1.ListMentionsRequest{}))
+	if err != nil {
+		t.Fatalf("ListMentions: %v", err)
+	}
+	if got := resp.Msg.Mentions; len(got) != 3 || got[0].TodoName != "Sam reviews the deck" || got[1].SegmentText != "Thanks, Sam." || got[2].CommentId != 4 {
+		t.Fatalf("mentions = %v, want the caller's todo mention, then the older transcript and comment ones", got)
+	}
+	if resp.Msg.UnreadCount != 2 {
+		t.Fatalf("unread = %d, want 2", resp.Msg.UnreadCount)
+	}
+
+	marked, err := srv.MarkMentionsRead(ctx, connect.NewRequest(&secretaryv1.MarkMentionsReadRequest{
+		Mentions: []*secretaryv1.MentionRef{{Kind: secretaryv1.MentionKind_MENTION_KIND_TODO, Id: 1}},
+	}))
+	if err != nil {
+		t.Fatalf("MarkMentionsRead: %v", err)
+	}
+	if marked.Msg.UnreadCount != 1 || store.mentions[0].ReadAt.Valid {
+		t.Fatalf("unread = %d, want only the todo mention read", marked.Msg.UnreadCount)
+	}
+
+	resp, err = srv.ListMentions(ctx, connect.NewRequest(&secretaryv1.ListMentionsRequest{UnreadOnly: true}))
+	if err != nil {
+		t.Fatalf("ListMentions: %v", err)
+	}
+	if len(resp.Msg.Mentions) != 1 || resp.Msg.Mentions[0].Read || resp.Msg.Mentions[0].Kind != secretaryv1.MentionKind_MENTION_KIND_TRANSCRIPT {
+		t.Fatalf("unread mentions = %v", resp.Msg.Mentions)
+	}
+
+	// Marking everything read leaves other users' mentions alone.
+	if _, err := srv.MarkMentionsRead(ctx, connect.NewRequest(&secretaryv1.MarkMentionsReadRequest{All: true})); err != nil {
+		t.Fatalf("MarkMentionsRead all: %v", err)
+	}
+	if !store.mentions[0].ReadAt.Valid || store.mentions[1].ReadAt.Valid {
+		t.Fatalf("read = %v, %v; want only the caller's mention read", store.mentions[0].ReadAt.Valid, store.mentions[1].ReadAt.Valid)
+	}
+}
+
+func TestNameMatcherLeavesAmbiguousNamesAlone(t *testing.T) {
+	users, _ := namedUsers{}.ListUsers(context.Background())
+	matcher := newNameMatcher(users, nil)
+
+	// Without participants nothing tells the two Anas apart, but their full
+	// names still do.
+	got := matcher.namedUsers("Ana said hi to Ana Ruiz and WILL.")
+	if want := []nameMatch{{9, "Ana Ruiz"}, {10, "WILL"}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("namedUsers = %v, want %v", got, want)
+	}
+}
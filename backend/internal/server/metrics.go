@@ -0,0 +1,154 @@
+package server
+
+import (
+	"bufio"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	dbconn "github.com/mvult/secretary/backend/internal/db"
+	"github.com/mvult/secretary/backend/internal/providers"
+)
+
+// ConfigureMetrics enables statement metrics on /metrics. When token is
+// set, scrapers must send it as a bearer token.
+func (s *Server) ConfigureMetrics(stats *dbconn.QueryStats, token string) {
+	s.queryStats = stats
+	s.metricsToken = token
+}
+
+// handleMetrics writes pool and statement metrics in the Prometheus text
+// exposition format.
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if s.metricsToken != "" {
+		token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.metricsToken)) != 1 {
+			writeError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	out := bufio.NewWriter(w)
+	defer out.Flush()
+
+	if s.db != nil {
+		stat := s.db.Stat()
+		gauge := func(name string, help string, value int64) {
+			writeMetric(out, "secretary_db_pool_"+name, "gauge", help, strconv.FormatInt(value, 10))
+		}
+		counter := func(name string, help string, value int64) {
+			writeMetric(out, "secretary_db_pool_"+name, "counter", help, strconv.FormatInt(value, 10))
+		}
+		gauge("max_conns", "Maximum size of the connection pool.", int64(stat.MaxConns()))
+		gauge("total_conns", "Connections currently open.", int64(stat.TotalConns()))
+		gauge("acquired_conns", "Connections currently checked out.", int64(stat.AcquiredConns()))
+		gauge("idle_conns", "Connections currently idle.", int64(stat.IdleConns()))
+		gauge("constructing_conns", "Connections currently being established.", int64(stat.ConstructingConns()))
+		counter("acquires_total", "Successful connection acquires.", stat.AcquireCount())
+		counter("empty_acquires_total", "Acquires that had to wait for a connection.", stat.EmptyAcquireCount())
+		counter("canceled_acquires_total", "Acquires canceled by their context.", stat.CanceledAcquireCount())
+		counter("new_conns_total", "Connections opened.", stat.NewConnsCount())
+		counter("max_lifetime_destroys_total", "Connections closed for exceeding their maximum lifetime.", stat.MaxLifetimeDestroyCount())
+		counter("max_idle_destroys_total", "Connections closed for exceeding the maximum idle time.", stat.MaxIdleDestroyCount())
+		writeMetric(out, "secretary_db_pool_acquire_seconds_total", "counter", "Time spent acquiring connections.", formatSeconds(stat.AcquireDuration().Seconds()))
+	}
+
+	if s.leader.elected.Load() {
+		leading := "0"
+		if s.leader.leading.Load() {
+			leading = "1"
+		}
+		writeMetric(out, "secretary_leader", "gauge", "1 while this process runs the leader-only background work.", leading)
+	}
+
+	if s.providers != nil {
+		writeProviderMetrics(out, s.providers.Snapshot())
+	}
+
+	writeMetric(out, "secretary_logins_total", "counter", "Successful sign-ins with a password.", strconv.FormatInt(s.loginStats.succeeded.Load(), 10))
+	writeMetric(out, "secretary_login_failures_total", "counter", "Refused sign-ins: unknown emails, wrong passwords and deactivated accounts.", strconv.FormatInt(s.loginStats.failed.Load(), 10))
+
+	s.writeBackupMetrics(out)
+	s.rpcStats.write(out)
+
+	if s.queryStats == nil {
+		return
+	}
+	statements := s.queryStats.Snapshot()
+	if len(statements) == 0 {
+		return
+	}
+	writeHeader(out, "secretary_db_queries_total", "counter", "Queries executed, by sqlc statement name.")
+	for _, st := range statements {
+		writeSample(out, "secretary_db_queries_total", st.Name, strconv.FormatInt(st.Count, 10))
+	}
+	writeHeader(out, "secretary_db_query_errors_total", "counter", "Queries that returned an error, by statement.")
+	for _, st := range statements {
+		writeSample(out, "secretary_db_query_errors_total", st.Name, strconv.FormatInt(st.Errors, 10))
+	}
+	writeHeader(out, "secretary_db_query_seconds_total", "counter", "Time spent executing queries, by statement.")
+	for _, st := range statements {
+		writeSample(out, "secretary_db_query_seconds_total", st.Name, formatSeconds(st.Total.Seconds()))
+	}
+}
+
+func writeProviderMetrics(out *bufio.Writer, stats []providers.Stats) {
+	if len(stats) == 0 {
+		return
+	}
+	series := []struct {
+		name  string
+		help  string
+		value func(providers.Stats) string
+	}{
+		{"secretary_provider_requests_total", "Calls made to transcription and summarization providers.", func(st providers.Stats) string { return strconv.FormatInt(st.Requests, 10) }},
+		{"secretary_provider_failures_total", "Provider calls that failed.", func(st providers.Stats) string { return strconv.FormatInt(st.Failures, 10) }},
+		{"secretary_provider_rate_limited_total", "Calls skipped because the provider's rate limit was reached.", func(st providers.Stats) string { return strconv.FormatInt(st.RateLimited, 10) }},
+		{"secretary_provider_cost_usd_total", "Estimated provider spend in US dollars.", func(st providers.Stats) string { return formatSeconds(float64(st.CostMicros) / 1e6) }},
+	}
+	for _, m := range series {
+		writeHeader(out, m.name, "counter", m.help)
+		for _, st := range stats {
+			fmt.Fprintf(out, "%s{provider=%q,kind=%q} %s\n", m.name, st.Provider, st.Kind, m.value(st))
+		}
+	}
+}
+
+// writeBackupMetrics reports scheduled backups, when they are on.
+func (s *Server) writeBackupMetrics(out *bufio.Writer) {
+	if s.taskSchedule(taskBackup) == nil {
+		return
+	}
+	s.backups.mu.Lock()
+	defer s.backups.mu.Unlock()
+	writeMetric(out, "secretary_backups_total", "counter", "Scheduled backups written.", strconv.FormatInt(s.backups.succeeded, 10))
+	writeMetric(out, "secretary_backup_failures_total", "counter", "Scheduled backups that failed.", strconv.FormatInt(s.backups.failed, 10))
+	if !s.backups.lastSuccess.IsZero() {
+		writeMetric(out, "secretary_backup_last_success_timestamp_seconds", "gauge", "When the last scheduled backup succeeded, in Unix seconds.", strconv.FormatInt(s.backups.lastSuccess.Unix(), 10))
+		writeMetric(out, "secretary_backup_last_size_bytes", "gauge", "Size of the last scheduled backup.", strconv.FormatInt(s.backups.lastSize, 10))
+	}
+}
+
+func writeMetric(out *bufio.Writer, name string, kind string, help string, value string) {
+	writeHeader(out, name, kind, help)
+	fmt.Fprintf(out, "%s %s\n", name, value)
+}
+
+func writeHeader(out *bufio.Writer, name string, kind string, help string) {
+	fmt.Fprintf(out, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func writeSample(out *bufio.Writer, name string, statement string, value string) {
+	fmt.Fprintf(out, "%s{statement=%q} %s\n", name, statement, value)
+}
+
+func formatSeconds(seconds float64) string {
+	return strconv.FormatFloat(seconds, 'f', -1, 64)
+}
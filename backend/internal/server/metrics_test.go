@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	dbconn "github.com/mvult/secretary/backend/internal/db"
+	"github.com/mvult/secretary/backend/internal/providers"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	stats := dbconn.NewQueryStats(0)
+	ctx := stats.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "-- name: GetRecording :one\nSELECT 1"})
+	stats.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureMetrics(stats, "scrape-token")
+
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without token, got %d", rec.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Authorization", "Bearer scrape-token")
+	rec = httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK || !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain") {
+		t.Fatalf("unexpected response %d %v", rec.Code, rec.Header())
+	}
+	if body := rec.Body.String(); !strings.Contains(body, `secretary_db_queries_total{statement="GetRecording"} 1`) {
+		t.Fatalf("expected statement counter in body:\n%s", body)
+	}
+}
+
+type failingSummarizer struct{}
+
+func (failingSummarizer) Summarize(context.Context, providers.SummaryRequest) (string, providers.Usage, error) {
+	return "", providers.Usage{}, errors.New("provider down")
+}
+
+func TestMetricsIncludeProviderStats(t *testing.T) {
+	registry := providers.NewRegistry(nil)
+	registry.AddSummarizer(failingSummarizer{}, providers.Options{Name: "primary"})
+	_, _ = registry.Summarize(context.Background(), 0, providers.SummaryRequest{})
+
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureProviders(registry)
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if body := rec.Body.String(); !strings.Contains(body, `secretary_provider_failures_total{provider="primary",kind="summarization"} 1`) {
+		t.Fatalf("expected provider failure counter in body:\n%s", body)
+	}
+}
@@ -0,0 +1,330 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/providers"
+)
+
+// Minutes sources, as stored in meeting_minutes.
+const (
+	minutesSourceLLM    = "llm"
+	minutesSourceManual = "manual"
+)
+
+// Limits on what is kept from a model's draft, matching what UpdateMinutes
+// accepts.
+const (
+	maxMinutesItems = 100
+	maxMinutesText  = 2000
+	maxMinutesNotes = 20000
+)
+
+// minutesInstructions is the system prompt for GenerateMinutes. The answer
+// is parsed by parseMinutesDraft.
+const minutesInstructions = `Read this meeting transcript and write its minutes. Reply with a single JSON object and nothing else, shaped like:
+{"agenda": ["topic"], "decisions": ["one short sentence"], "notes": "a few short paragraphs"}
+The agenda lists the topics discussed in the order they came up. Decisions are things the participants agreed on. The notes summarize the discussion of each topic. Do not list action items or attendees. Use empty lists when there is nothing to report. Speakers are labelled "Speaker N".`
+
+// MinutesStore holds the meeting minutes queries.
+type MinutesStore interface {
+	GetLatestMinutes(ctx context.Context, recordingID int32) (db.GetLatestMinutesRow, error)
+	GetMinutesVersion(ctx context.Context, arg db.GetMinutesVersionParams) (db.GetMinutesVersionRow, error)
+	ListMinutesVersions(ctx context.Context, recordingID int32) ([]db.ListMinutesVersionsRow, error)
+	CreateMinutes(ctx context.Context, arg db.CreateMinutesParams) (db.CreateMinutesRow, error)
+}
+
+func minutesToProto(row db.GetLatestMinutesRow) (*secretaryv1.MeetingMinutes, error) {
+	var document secretaryv1.MinutesDocument
+	if err := protojson.Unmarshal(row.Document, &document); err != nil {
+		return nil, apierr.Wrap(err, "failed to read minutes")
+	}
+	return &secretaryv1.MeetingMinutes{
+		RecordingId:     int64(row.RecordingID),
+		Version:         row.Version,
+		Document:        &document,
+		Source:          row.Source,
+		CreatedByUserId: int64(row.CreatedByUserID.Int32),
+		CreatedAt:       formatTime(row.CreatedAt),
+	}, nil
+}
+
+// latestMinutesVersion returns the newest version of a recording's
+// minutes, or 0 when it has none.
+func (s *Server) latestMinutesVersion(ctx context.Context, recordingID int32) (int32, error) {
+	row, err := s.minutes.GetLatestMinutes(ctx, recordingID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, apierr.Wrap(err, "failed to fetch minutes")
+	}
+	return row.Version, nil
+}
+
+// saveMinutes stores document as the version after baseVersion.
+func (s *Server) saveMinutes(ctx context.Context, recordingID, baseVersion int32, document *secretaryv1.MinutesDocument, source string, userID int64) (*secretaryv1.MeetingMinutes, error) {
+	data, err := protojson.Marshal(document)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to save minutes")
+	}
+	row, err := s.minutes.CreateMinutes(ctx, db.CreateMinutesParams{
+		RecordingID:     recordingID,
+		Document:        data,
+		Source:          source,
+		CreatedByUserID: pgtype.Int4{Int32: int32(userID), Valid: true},
+		BaseVersion:     baseVersion,
+	})
+	var pgErr *pgconn.PgError
+	if errors.Is(err, pgx.ErrNoRows) || (errors.As(err, &pgErr) && pgErr.Code == "23505") {
+		// Someone else saved a version after baseVersion first.
+		current, versionErr := s.latestMinutesVersion(ctx, recordingID)
+		if versionErr != nil {
+			return nil, versionErr
+		}
+		return nil, apierr.StaleVersion(fmt.Sprintf("minutes were modified (base version %d, current version %d)", baseVersion, current), int64(current))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to save minutes")
+	}
+	return minutesToProto(db.GetLatestMinutesRow(row))
+}
+
+// cleanMinutesDocument trims every text in document and drops entries left
+// empty.
+func cleanMinutesDocument(document *secretaryv1.MinutesDocument) {
+	attendees := document.Attendees[:0]
+	for _, attendee := range document.Attendees {
+		attendee.Name = strings.TrimSpace(attendee.Name)
+		if attendee.Name != "" || attendee.UserId > 0 {
+			attendees = append(attendees, attendee)
+		}
+	}
+	document.Attendees = attendees
+	document.Agenda = cleanMinutesItems(document.Agenda)
+	document.Decisions = cleanMinutesItems(document.Decisions)
+	for _, item := range document.ActionItems {
+		item.Text = strings.TrimSpace(item.Text)
+		item.OwnerName = strings.TrimSpace(item.OwnerName)
+	}
+	document.Notes = strings.TrimSpace(document.Notes)
+}
+
+func cleanMinutesItems(items []string) []string {
+	cleaned := make([]string, 0, len(items))
+	for _, item := range items {
+		item = truncateRunes(strings.TrimSpace(item), maxMinutesText)
+		if item == "" {
+			continue
+		}
+		cleaned = append(cleaned, item)
+		if len(cleaned) == maxMinutesItems {
+			break
+		}
+	}
+	return cleaned
+}
+
+// minutesAttendees lists the recording's identified participants, then
+// its guests, who have no user id.
+func (s *Server) minutesAttendees(ctx context.Context, recordingID int32) ([]*secretaryv1.MinutesAttendee, error) {
+	participants, err := s.recordings.ListRecordingParticipants(ctx, recordingID)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list recording participants")
+	}
+	guests, err := s.recordings.ListRecordingGuests(ctx, recordingID)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list recording guests")
+	}
+	attendees := make([]*secretaryv1.MinutesAttendee, 0, len(participants)+len(guests))
+	for _, p := range participants {
+		attendees = append(attendees, &secretaryv1.MinutesAttendee{
+			Name:   strings.TrimSpace(p.FirstName + " " + p.LastName.String),
+			UserId: int64(p.ID),
+		})
+	}
+	for _, g := range guests {
+		attendees = append(attendees, &secretaryv1.MinutesAttendee{Name: g.Name})
+	}
+	return attendees, nil
+}
+
+// minutesActionItems turns the todos created at the recording into action
+// items. Owners who did not attend are looked up by id.
+func (s *Server) minutesActionItems(ctx context.Context, recordingID int32, attendees []*secretaryv1.MinutesAttendee) ([]*secretaryv1.MinutesActionItem, error) {
+	id := int64(recordingID)
+	todos, err := s.todos.FilterTodos(ctx, &secretaryv1.ListTodosRequest{RecordingId: &id}, nil)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list todos")
+	}
+	names := make(map[int64]string, len(attendees))
+	for _, attendee := range attendees {
+		if attendee.UserId != 0 {
+			names[attendee.UserId] = attendee.Name
+		}
+	}
+	items := make([]*secretaryv1.MinutesActionItem, 0, len(todos))
+	for _, todo := range todos {
+		item := &secretaryv1.MinutesActionItem{
+			Text:        todo.Name,
+			OwnerUserId: int64(todo.UserID.Int32),
+			DueAt:       formatTime(todo.DueAt),
+			TodoId:      int64(todo.ID),
+		}
+		if todo.UserID.Valid {
+			name, ok := names[item.OwnerUserId]
+			if !ok {
+				user, err := s.users.GetUser(ctx, todo.UserID.Int32)
+				if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+					return nil, apierr.Wrap(err, "failed to fetch user")
+				}
+				name = strings.TrimSpace(user.FirstName + " " + user.LastName.String)
+				names[item.OwnerUserId] = name
+			}
+			item.OwnerName = name
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// GenerateMinutes drafts minutes for a recording and saves them after
+// whatever version is current, so earlier edits stay in the history.
+func (s *Server) GenerateMinutes(ctx context.Context, req *connect.Request[secretaryv1.GenerateMinutesRequest]) (*connect.Response[secretaryv1.GenerateMinutesResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := int32(req.Msg.RecordingId)
+	transcript, template, _, err := s.recordingTranscript(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.runProvider(ctx, userID, "failed to generate minutes", func(registry *providers.Registry) (providers.Result, error) {
+		return registry.Summarize(ctx, id, providers.SummaryRequest{Instructions: withGuidance(minutesInstructions, template), Transcript: transcript})
+	})
+	if err != nil {
+		return nil, err
+	}
+	draft, err := parseMinutesDraft(result.Text)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("the provider's answer could not be read as minutes; try again"))
+	}
+
+	attendees, err := s.minutesAttendees(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	actionItems, err := s.minutesActionItems(ctx, id, attendees)
+	if err != nil {
+		return nil, err
+	}
+	base, err := s.latestMinutesVersion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	minutes, err := s.saveMinutes(ctx, id, base, &secretaryv1.MinutesDocument{
+		Attendees:   attendees,
+		Agenda:      draft.Agenda,
+		Decisions:   draft.Decisions,
+		ActionItems: actionItems,
+		Notes:       draft.Notes,
+	}, minutesSourceLLM, userID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.GenerateMinutesResponse{Minutes: minutes}), nil
+}
+
+func (s *Server) GetMinutes(ctx context.Context, req *connect.Request[secretaryv1.GetMinutesRequest]) (*connect.Response[secretaryv1.GetMinutesResponse], error) {
+	msg := req.Msg
+	var row db.GetLatestMinutesRow
+	var err error
+	if msg.Version == 0 {
+		row, err = s.minutes.GetLatestMinutes(ctx, int32(msg.RecordingId))
+	} else {
+		var versionRow db.GetMinutesVersionRow
+		versionRow, err = s.minutes.GetMinutesVersion(ctx, db.GetMinutesVersionParams{RecordingID: int32(msg.RecordingId), Version: msg.Version})
+		row = db.GetLatestMinutesRow(versionRow)
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("minutes not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch minutes")
+	}
+	minutes, err := minutesToProto(row)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.GetMinutesResponse{Minutes: minutes}), nil
+}
+
+func (s *Server) UpdateMinutes(ctx context.Context, req *connect.Request[secretaryv1.UpdateMinutesRequest]) (*connect.Response[secretaryv1.UpdateMinutesResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	cleanMinutesDocument(msg.Document)
+	minutes, err := s.saveMinutes(ctx, int32(msg.RecordingId), msg.BaseVersion, msg.Document, minutesSourceManual, userID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.UpdateMinutesResponse{Minutes: minutes}), nil
+}
+
+func (s *Server) ListMinutesVersions(ctx context.Context, req *connect.Request[secretaryv1.ListMinutesVersionsRequest]) (*connect.Response[secretaryv1.ListMinutesVersionsResponse], error) {
+	recordingID := req.Msg.RecordingId
+	rows, err := s.minutes.ListMinutesVersions(ctx, int32(recordingID))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list minutes versions")
+	}
+	versions := make([]*secretaryv1.MeetingMinutes, 0, len(rows))
+	for _, row := range rows {
+		versions = append(versions, &secretaryv1.MeetingMinutes{
+			RecordingId:     recordingID,
+			Version:         row.Version,
+			Source:          row.Source,
+			CreatedByUserId: int64(row.CreatedByUserID.Int32),
+			CreatedAt:       formatTime(row.CreatedAt),
+		})
+	}
+	return connect.NewResponse(&secretaryv1.ListMinutesVersionsResponse{Versions: versions}), nil
+}
+
+type minutesDraft struct {
+	Agenda    []string `json:"agenda"`
+	Decisions []string `json:"decisions"`
+	Notes     string   `json:"notes"`
+}
+
+// parseMinutesDraft reads the JSON object answering minutesInstructions,
+// the same way parseExtraction does.
+func parseMinutesDraft(text string) (minutesDraft, error) {
+	start, end := strings.Index(text, "{"), strings.LastIndex(text, "}")
+	if start < 0 || end < start {
+		return minutesDraft{}, errors.New("no JSON object in answer")
+	}
+	var parsed minutesDraft
+	if err := json.Unmarshal([]byte(text[start:end+1]), &parsed); err != nil {
+		return minutesDraft{}, err
+	}
+	parsed.Agenda = cleanMinutesItems(parsed.Agenda)
+	parsed.Decisions = cleanMinutesItems(parsed.Decisions)
+	parsed.Notes = truncateRunes(strings.TrimSpace(parsed.Notes), maxMinutesNotes)
+	return parsed, nil
+}
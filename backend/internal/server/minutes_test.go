@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/providers"
+)
+
+// fakeMinutes keeps every version of one recording's minutes, oldest
+// first.
+type fakeMinutes struct {
+	versions []db.GetLatestMinutesRow
+}
+
+func (f *fakeMinutes) GetLatestMinutes(context.Context, int32) (db.GetLatestMinutesRow, error) {
+	if len(f.versions) == 0 {
+		return db.GetLatestMinutesRow{}, pgx.ErrNoRows
+	}
+	return f.versions[len(f.versions)-1], nil
+}
+
+func (f *fakeMinutes) GetMinutesVersion(_ context.Context, arg db.GetMinutesVersionParams) (db.GetMinutesVersionRow, error) {
+	if arg.Version < 1 || int(arg.Version) > len(f.versions) {
+		return db.GetMinutesVersionRow{}, pgx.ErrNoRows
+	}
+	return db.GetMinutesVersionRow(f.versions[arg.Version-1]), nil
+}
+
+func (f *fakeMinutes) ListMinutesVersions(context.Context, int32) ([]db.ListMinutesVersionsRow, error) {
+	var rows []db.ListMinutesVersionsRow
+	for i := len(f.versions) - 1; i >= 0; i-- {
+		row := f.versions[i]
+		rows = append(rows, db.ListMinutesVersionsRow{Version: row.Version, Source: row.Source, CreatedByUserID: row.CreatedByUserID})
+	}
+	return rows, nil
+}
+
+func (f *fakeMinutes) CreateMinutes(_ context.Context, arg db.CreateMinutesParams) (db.CreateMinutesRow, error) {
+	if int(arg.BaseVersion) != len(f.versions) {
+		return db.CreateMinutesRow{}, pgx.ErrNoRows
+	}
+	row := db.GetLatestMinutesRow{
+		RecordingID:     arg.RecordingID,
+		Version:         arg.BaseVersion + 1,
+		Document:        arg.Document,
+		Source:          arg.Source,
+		CreatedByUserID: arg.CreatedByUserID,
+	}
+	f.versions = append(f.versions, row)
+	return db.CreateMinutesRow(row), nil
+}
+
+// recordingTodos lists the same todos for every recording.
+type recordingTodos struct {
+	TodoStore
+	rows []db.ListTodosByUserRow
+}
+
+func (r recordingTodos) FilterTodos(context.Context, *secretaryv1.ListTodosRequest, []int32) ([]db.ListTodosByUserRow, error) {
+	return r.rows, nil
+}
+
+const minutesAnswer = "```json\n" + `{
+  "agenda": ["Beta launch", " ", "Hosting costs"],
+  "decisions": ["Ship the beta on Friday."],
+  "notes": "  The team reviewed the launch checklist.  "
+}` + "\n```"
+
+func TestGenerateMinutes(t *testing.T) {
+	recordings := speakingParticipants{&fakeTranslations{fakeRecordingStatus: &fakeRecordingStatus{status: recordingReady}, transcript: "Speaker 2: let's ship Friday"}}
+	todos := recordingTodos{rows: []db.ListTodosByUserRow{
+		{ID: 11, Name: "Write release notes", UserID: pgtype.Int4{Int32: 8, Valid: true}},
+		{ID: 12, Name: "Book the venue", UserID: pgtype.Int4{Int32: 9, Valid: true}},
+		{ID: 13, Name: "Pick a date"},
+	}}
+	registry := providers.NewRegistry(nil)
+	registry.AddSummarizer(cannedSummarizer{answer: minutesAnswer}, providers.Options{Name: "stub"})
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(recordings, todos, memberUsers{})
+	srv.ConfigureProviders(registry)
+	srv.minutes = &fakeMinutes{}
+	srv.usage = &fakeUsage{}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 4})
+
+	resp, err := srv.GenerateMinutes(ctx, connect.NewRequest(&secretaryv1.GenerateMinutesRequest{RecordingId: 3}))
+	if err != nil {
+		t.Fatalf("GenerateMinutes: %v", err)
+	}
+	minutes := resp.Msg.Minutes
+	if minutes.Version != 1 || minutes.Source != minutesSourceLLM || minutes.CreatedByUserId != 4 {
+		t.Fatalf("minutes = %v", minutes)
+	}
+	doc := minutes.Document
+	if len(doc.Attendees) != 1 || doc.Attendees[0].UserId != 8 {
+		t.Fatalf("attendees = %v", doc.Attendees)
+	}
+	if len(doc.Agenda) != 2 || doc.Agenda[1] != "Hosting costs" || len(doc.Decisions) != 1 || doc.Notes != "The team reviewed the launch checklist." {
+		t.Fatalf("document = %v", doc)
+	}
+	if len(doc.ActionItems) != 3 {
+		t.Fatalf("action items = %v", doc.ActionItems)
+	}
+	if item := doc.ActionItems[1]; item.TodoId != 12 || item.OwnerUserId != 9 {
+		t.Fatalf("action item = %v", item)
+	}
+	if item := doc.ActionItems[2]; item.OwnerUserId != 0 || item.OwnerName != "" {
+		t.Fatalf("unassigned action item = %v", item)
+	}
+
+	// Generating again keeps the first draft as version 1.
+	if _, err := srv.GenerateMinutes(ctx, connect.NewRequest(&secretaryv1.GenerateMinutesRequest{RecordingId: 3})); err != nil {
+		t.Fatalf("GenerateMinutes again: %v", err)
+	}
+	versions, err := srv.ListMinutesVersions(ctx, connect.NewRequest(&secretaryv1.ListMinutesVersionsRequest{RecordingId: 3}))
+	if err != nil {
+		t.Fatalf("ListMinutesVersions: %v", err)
+	}
+	if got := versions.Msg.Versions; len(got) != 2 || got[0].Version != 2 || got[0].Document != nil {
+		t.Fatalf("versions = %v", got)
+	}
+}
+
+func TestUpdateMinutesStaleVersion(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.minutes = &fakeMinutes{}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 4})
+	update := func(base int32, notes string) (*secretaryv1.MeetingMinutes, error) {
+		resp, err := srv.UpdateMinutes(ctx, connect.NewRequest(&secretaryv1.UpdateMinutesRequest{
+			RecordingId: 3,
+			BaseVersion: base,
+			Document:    &secretaryv1.MinutesDocument{Agenda: []string{"Budget", ""}, Notes: notes},
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Minutes, nil
+	}
+
+	first, err := update(0, "first")
+	if err != nil {
+		t.Fatalf("first update: %v", err)
+	}
+	if first.Version != 1 || first.Source != minutesSourceManual || len(first.Document.Agenda) != 1 {
+		t.Fatalf("minutes = %v", first)
+	}
+	if _, err := update(1, "second"); err != nil {
+		t.Fatalf("second update: %v", err)
+	}
+
+	// An editor still working from version 1 is told about version 2.
+	_, err = update(1, "late")
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("stale update err = %v, want FailedPrecondition", err)
+	}
+	if current, ok := apierr.CurrentVersion(err); !ok || current != 2 {
+		t.Fatalf("current version = %d, %v", current, ok)
+	}
+
+	got, err := srv.GetMinutes(ctx, connect.NewRequest(&secretaryv1.GetMinutesRequest{RecordingId: 3, Version: 1}))
+	if err != nil {
+		t.Fatalf("GetMinutes: %v", err)
+	}
+	if got.Msg.Minutes.Document.Notes != "first" {
+		t.Fatalf("version 1 = %v", got.Msg.Minutes)
+	}
+}
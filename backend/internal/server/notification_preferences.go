@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/mail"
+)
+
+// NotificationPreferenceStore holds the queries for how users want to be
+// alerted.
+type NotificationPreferenceStore interface {
+	GetNotificationPreference(ctx context.Context, userID int32) (db.NotificationPreference, error)
+	SaveNotificationPreference(ctx context.Context, arg db.SaveNotificationPreferenceParams) (db.NotificationPreference, error)
+	ListNotificationRecipients(ctx context.Context, arg db.ListNotificationRecipientsParams) ([]db.ListNotificationRecipientsRow, error)
+}
+
+var digestFrequencyNames = map[secretaryv1.DigestFrequency]string{
+	secretaryv1.DigestFrequency_DIGEST_FREQUENCY_OFF:    "off",
+	secretaryv1.DigestFrequency_DIGEST_FREQUENCY_DAILY:  "daily",
+	secretaryv1.DigestFrequency_DIGEST_FREQUENCY_WEEKLY: "weekly",
+}
+
+func digestFrequencyFromName(name string) secretaryv1.DigestFrequency {
+	for frequency, n := range digestFrequencyNames {
+		if n == name {
+			return frequency
+		}
+	}
+	return secretaryv1.DigestFrequency_DIGEST_FREQUENCY_UNSPECIFIED
+}
+
+// defaultNotificationPreference matches the column defaults of
+// notification_preference, for users who never saved any.
+func defaultNotificationPreference(userID int32) db.NotificationPreference {
+	return db.NotificationPreference{UserID: userID, ChatEnabled: true, PushEnabled: true, DigestFrequency: "off"}
+}
+
+func notificationPreferenceToProto(row db.NotificationPreference) *secretaryv1.NotificationPreferences {
+	prefs := &secretaryv1.NotificationPreferences{
+		Email:           row.EmailEnabled,
+		Chat:            row.ChatEnabled,
+		Push:            row.PushEnabled,
+		DigestFrequency: digestFrequencyFromName(row.DigestFrequency),
+	}
+	for _, name := range row.MutedEvents {
+		for event, n := range notificationEventNames {
+			if n == name {
+				prefs.MutedEvents = append(prefs.MutedEvents, event)
+			}
+		}
+	}
+	sort.Slice(prefs.MutedEvents, func(i, j int) bool { return prefs.MutedEvents[i] < prefs.MutedEvents[j] })
+	return prefs
+}
+
+// emailNotificationsEnabled reports whether alerts can be emailed; a
+// server that only logs mail has no business queueing them.
+func (s *Server) emailNotificationsEnabled() bool {
+	_, logOnly := s.mailer.(mail.LogSender)
+	return s.mailer != nil && !logOnly
+}
+
+// notificationRecipients narrows userIDs to those who want to hear about
+// event, with the channels each takes it on.
+func (s *Server) notificationRecipients(ctx context.Context, userIDs []int32, event string) ([]db.ListNotificationRecipientsRow, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	return s.notifyPrefs.ListNotificationRecipients(ctx, db.ListNotificationRecipientsParams{UserIds: userIDs, Event: event})
+}
+
+// --- NotificationsService preference methods ---
+
+func (s *Server) GetPreferences(ctx context.Context, req *connect.Request[secretaryv1.GetPreferencesRequest]) (*connect.Response[secretaryv1.GetPreferencesResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.notifyPrefs.GetNotificationPreference(ctx, int32(userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		row, err = defaultNotificationPreference(int32(userID)), nil
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch notification preferences")
+	}
+	return connect.NewResponse(&secretaryv1.GetPreferencesResponse{
+		Preferences:    notificationPreferenceToProto(row),
+		EmailAvailable: s.emailNotificationsEnabled(),
+		ChatAvailable:  s.chat().Enabled(),
+		PushAvailable:  s.pushEnabled(),
+	}), nil
+}
+
+func (s *Server) UpdatePreferences(ctx context.Context, req *connect.Request[secretaryv1.UpdatePreferencesRequest]) (*connect.Response[secretaryv1.UpdatePreferencesResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefs := req.Msg.Preferences
+	row, err := s.notifyPrefs.SaveNotificationPreference(ctx, db.SaveNotificationPreferenceParams{
+		UserID:          int32(userID),
+		EmailEnabled:    prefs.Email,
+		ChatEnabled:     prefs.Chat,
+		PushEnabled:     prefs.Push,
+		MutedEvents:     notificationEventsToNames(prefs.MutedEvents),
+		DigestFrequency: digestFrequencyNames[prefs.DigestFrequency],
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to update notification preferences")
+	}
+	return connect.NewResponse(&secretaryv1.UpdatePreferencesResponse{Preferences: notificationPreferenceToProto(row)}), nil
+}
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/i18n"
+	"github.com/mvult/secretary/backend/internal/mail"
+	"github.com/mvult/secretary/backend/internal/notify"
+	"github.com/mvult/secretary/backend/internal/push"
+)
+
+// ConfigureNotifications sets the chat channels that hear about finished
+// meetings and todo assignments. Without any, nothing is sent.
+func (s *Server) ConfigureNotifications(channels ...notify.Channel) {
+	s.notifier = notify.New(channels...)
+}
+
+// notificationsEnabled reports whether anything would receive a
+// notification, so callers can skip the lookups building one.
+func (s *Server) notificationsEnabled() bool {
+	return s.chat().Enabled() || s.pushEnabled() || s.emailNotificationsEnabled()
+}
+
+// sendNotification delivers msg in the background; chat services can be
+// slow and a failed delivery should not fail the change that caused it.
+func (s *Server) sendNotification(ctx context.Context, msg notify.Message) {
+	go func() {
+		if err := s.chat().Send(context.WithoutCancel(ctx), msg); err != nil {
+			log.Printf("notification %q: %v", msg.Title, err)
+		}
+	}()
+}
+
+func (s *Server) recordingURL(id int32) string {
+	if s.publicURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/recordings/%d", s.publicURL, id)
+}
+
+// notifyRecordingReady posts a finished meeting's summary to the team
+// channels, when it has one, and alerts the devices of participants who
+// want push alerts about it.
+func (s *Server) notifyRecordingReady(ctx context.Context, id int32) {
+	if !s.notificationsEnabled() {
+		return
+	}
+	rec, err := s.recordings.GetRecording(ctx, id)
+	if err != nil {
+		log.Printf("notifying about recording %d: %v", id, err)
+		return
+	}
+	if s.chat().Enabled() && strings.TrimSpace(rec.Summary.String) != "" {
+		policy, err := s.rowRedactionPolicy(rec.ProcessingSettings)
+		if err != nil {
+			log.Printf("notifying about recording %d: %v", id, err)
+			return
+		}
+		rec.Summary.String = maskText(policy, rec.Summary.String, fmt.Sprintf("recording %d chat summary", id))
+		s.sendNotification(ctx, summaryMessage(rec, s.recordingURL(rec.ID)))
+	}
+	if !s.pushEnabled() {
+		return
+	}
+	participants, err := s.recordings.ListRecordingParticipants(ctx, id)
+	if err != nil {
+		log.Printf("notifying about recording %d: %v", id, err)
+		return
+	}
+	userIDs := make([]int32, 0, len(participants))
+	for _, participant := range participants {
+		userIDs = append(userIDs, participant.ID)
+	}
+	recipients, err := s.notificationRecipients(ctx, userIDs, notificationRecordingReady)
+	if err != nil {
+		log.Printf("notifying about recording %d: %v", id, err)
+		return
+	}
+	userIDs = userIDs[:0]
+	for _, recipient := range recipients {
+		if recipient.PushEnabled {
+			userIDs = append(userIDs, recipient.UserID)
+		}
+	}
+	s.pushToUsers(ctx, userIDs, notificationRecordingReady, push.Notification{
+		Title: "Meeting processed",
+		Body:  recordingTitle(rec),
+		Data:  map[string]string{"recording_id": strconv.Itoa(int(rec.ID))},
+	})
+}
+
+func recordingTitle(rec db.GetRecordingRow) string {
+	if name := strings.TrimSpace(rec.Name.String); name != "" {
+		return name
+	}
+	return "Untitled meeting"
+}
+
+func summaryMessage(rec db.GetRecordingRow, url string) notify.Message {
+	msg := notify.Message{
+		Title:    "Meeting summary: " + recordingTitle(rec),
+		Text:     strings.TrimSpace(rec.Summary.String),
+		LinkText: "Open recording",
+		URL:      url,
+	}
+	if rec.CreatedAt.Valid {
+		msg.Facts = append(msg.Facts, notify.Fact{Name: "Date", Value: rec.CreatedAt.Time.Format("Jan 2, 2006")})
+	}
+	if rec.Duration.Int32 > 0 {
+		msg.Facts = append(msg.Facts, notify.Fact{Name: "Duration", Value: fmt.Sprintf("%d min", (rec.Duration.Int32+59)/60)})
+	}
+	return msg
+}
+
+// notifyTodoAssigned tells the new assignee of todo about it on the
+// channels they chose: their devices, an email, and a note in the team
+// channels. previous is the assignee before the change; nothing is sent
+// when it is the same.
+func (s *Server) notifyTodoAssigned(ctx context.Context, todo db.Todo, previous pgtype.Int4) {
+	if !s.notificationsEnabled() || !todo.UserID.Valid || todo.UserID.Int32 == 0 || todo.UserID == previous {
+		return
+	}
+	recipients, err := s.notificationRecipients(ctx, []int32{todo.UserID.Int32}, notificationTodoAssigned)
+	if err != nil {
+		log.Printf("notifying about todo %d: %v", todo.ID, err)
+		return
+	}
+	if len(recipients) == 0 {
+		return
+	}
+	recipient := recipients[0]
+
+	if recipient.PushEnabled {
+		data := map[string]string{"todo_id": strconv.Itoa(int(todo.ID))}
+		if todo.CreatedAtRecordingID.Valid {
+			data["recording_id"] = strconv.Itoa(int(todo.CreatedAtRecordingID.Int32))
+		}
+		s.pushToUsers(ctx, []int32{todo.UserID.Int32}, notificationTodoAssigned, push.Notification{Title: "New todo for you", Body: todo.Name, Data: data})
+	}
+	link := s.publicURL + "/"
+	if todo.CreatedAtRecordingID.Valid {
+		link = s.recordingURL(todo.CreatedAtRecordingID.Int32)
+	}
+	if recipient.EmailEnabled && recipient.Email.String != "" && s.emailNotificationsEnabled() {
+		s.sendAssignmentEmail(ctx, recipient, todo, link)
+	}
+	if !recipient.ChatEnabled || !s.chat().Enabled() {
+		return
+	}
+
+	user, err := s.users.GetUser(ctx, todo.UserID.Int32)
+	if err != nil {
+		log.Printf("notifying about todo %d: %v", todo.ID, err)
+		return
+	}
+	msg := notify.Message{
+		Title: "Todo assigned to " + strings.TrimSpace(user.FirstName+" "+user.LastName.String),
+		Text:  strings.TrimSpace(todo.Name + "\n" + todo.Desc.String),
+	}
+	if todo.DueAt.Valid {
+		msg.Facts = append(msg.Facts, notify.Fact{Name: "Due", Value: todo.DueAt.Time.Format("Jan 2, 2006")})
+	}
+	if todo.CreatedAtRecordingID.Valid {
+		msg.LinkText = "Open meeting"
+		msg.URL = link
+	} else if s.publicURL != "" {
+		msg.LinkText = "Open todos"
+		msg.URL = link
+	}
+	s.sendNotification(ctx, msg)
+}
+
+// sendAssignmentEmail emails recipient about a todo assigned to them, in
+// the background like sendNotification.
+func (s *Server) sendAssignmentEmail(ctx context.Context, recipient db.ListNotificationRecipientsRow, todo db.Todo, link string) {
+	locale := i18n.Negotiate(recipient.Locale.String, "")
+	text := i18n.Sprintf(locale, "Hi %s,\n\nA todo was assigned to you:\n\n%s\n", recipient.FirstName, strings.TrimSpace(todo.Name+"\n"+todo.Desc.String))
+	if todo.DueAt.Valid {
+		text += i18n.Sprintf(locale, "Due %s\n", todo.DueAt.Time.Format("2006-01-02"))
+	}
+	if link != "/" {
+		text += "\n" + link + "\n"
+	}
+	msg := mail.Message{
+		To:      recipient.Email.String,
+		Subject: i18n.Sprintf(locale, "New todo: %s", todo.Name),
+		Text:    text,
+	}
+	go func() {
+		if err := s.mailer.Send(context.WithoutCancel(ctx), msg); err != nil {
+			log.Printf("emailing todo %d to user %d: %v", todo.ID, recipient.UserID, err)
+		}
+	}()
+}
@@ -0,0 +1,225 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/proto"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/mail"
+	"github.com/mvult/secretary/backend/internal/notify"
+)
+
+// fakeChannel hands every message it is sent to the test.
+type fakeChannel struct {
+	sent chan notify.Message
+}
+
+func (c fakeChannel) Name() string { return "fake" }
+
+func (c fakeChannel) Send(_ context.Context, msg notify.Message) error {
+	c.sent <- msg
+	return nil
+}
+
+// storedPreferences serves one user's saved preferences; everyone else
+// gets the defaults.
+type storedPreferences struct {
+	NotificationPreferenceStore
+	saved db.NotificationPreference
+}
+
+func (p storedPreferences) ListNotificationRecipients(_ context.Context, arg db.ListNotificationRecipientsParams) ([]db.ListNotificationRecipientsRow, error) {
+	var out []db.ListNotificationRecipientsRow
+	for _, id := range arg.UserIds {
+		prefs := defaultNotificationPreference(id)
+		if id == p.saved.UserID {
+			prefs = p.saved
+		}
+		if slices.Contains(prefs.MutedEvents, arg.Event) {
+			continue
+		}
+		out = append(out, db.ListNotificationRecipientsRow{
+			UserID:       id,
+			FirstName:    "Ana",
+			Email:        optionalText("ana@example.com"),
+			Locale:       optionalText("es"),
+			EmailEnabled: prefs.EmailEnabled,
+			ChatEnabled:  prefs.ChatEnabled,
+			PushEnabled:  prefs.PushEnabled,
+		})
+	}
+	return out, nil
+}
+
+// fakeMailer hands every email it is sent to the test.
+type fakeMailer struct {
+	sent chan mail.Message
+}
+
+func (m fakeMailer) Send(_ context.Context, msg mail.Message) error {
+	m.sent <- msg
+	return nil
+}
+
+type assigneeUsers struct{ UserStore }
+
+func (assigneeUsers) GetUser(_ context.Context, id int32) (db.GetUserRow, error) {
+	return db.GetUserRow{ID: id, FirstName: "Ana", LastName: optionalText("Silva")}, nil
+}
+
+func TestUpdateTodoAnnouncesReassignment(t *testing.T) {
+	todos := trackedTodos{tx: &trackedTodo{todo: db.GetTodoRow{ID: 7, UserID: pgtype.Int4{Int32: 2, Valid: true}, Version: 1}}}
+	channel := fakeChannel{sent: make(chan notify.Message, 1)}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, todos, assigneeUsers{})
+	srv.ConfigureNotifications(channel)
+	srv.notifyPrefs = storedPreferences{}
+	update := func(userID int64) {
+		t.Helper()
+		version := todos.tx.todo.Version
+		if _, err := srv.UpdateTodo(context.Background(), connect.NewRequest(&secretaryv1.UpdateTodoRequest{Id: 7, Name: "Book the venue", UserId: userID, ExpectedVersion: int64(version)})); err != nil {
+			t.Fatalf("UpdateTodo: %v", err)
+		}
+	}
+
+	update(5)
+	select {
+	case msg := <-channel.sent:
+		if msg.Title != "Todo assigned to Ana Silva" || msg.Text != "Book the venue" {
+			t.Fatalf("message = %+v", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no notification for the new assignee")
+	}
+
+	todos.tx.todo.UserID = pgtype.Int4{Int32: 5, Valid: true}
+	update(5)
+	select {
+	case msg := <-channel.sent:
+		t.Fatalf("notified without a reassignment: %+v", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestNotifyTodoAssignedFollowsPreferences(t *testing.T) {
+	channel := fakeChannel{sent: make(chan notify.Message, 1)}
+	mailer := fakeMailer{sent: make(chan mail.Message, 1)}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, assigneeUsers{})
+	srv.ConfigureNotifications(channel)
+	srv.ConfigureMail(mailer, "https://secretary.example.com")
+	todo := db.Todo{ID: 7, Name: "Book the venue", UserID: pgtype.Int4{Int32: 5, Valid: true}}
+
+	srv.notifyPrefs = storedPreferences{saved: db.NotificationPreference{UserID: 5, EmailEnabled: true, DigestFrequency: "off"}}
+	srv.notifyTodoAssigned(context.Background(), todo, pgtype.Int4{})
+	select {
+	case msg := <-mailer.sent:
+		if msg.To != "ana@example.com" || !strings.Contains(msg.Text, "Book the venue") || !strings.Contains(msg.Text, "https://secretary.example.com/") {
+			t.Fatalf("email = %+v", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no email for an assignee who wants them")
+	}
+	select {
+	case msg := <-channel.sent:
+		t.Fatalf("announced in chat against the assignee's wishes: %+v", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	srv.notifyPrefs = storedPreferences{saved: db.NotificationPreference{UserID: 5, EmailEnabled: true, ChatEnabled: true, MutedEvents: []string{notificationTodoAssigned}}}
+	srv.notifyTodoAssigned(context.Background(), todo, pgtype.Int4{})
+	select {
+	case msg := <-channel.sent:
+		t.Fatalf("announced a muted event: %+v", msg)
+	case msg := <-mailer.sent:
+		t.Fatalf("emailed a muted event: %+v", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestNotificationPreferences(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.notifyPrefs = &savedPreferences{}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	got, err := srv.GetPreferences(ctx, connect.NewRequest(&secretaryv1.GetPreferencesRequest{}))
+	if err != nil {
+		t.Fatalf("GetPreferences: %v", err)
+	}
+	if prefs := got.Msg.Preferences; prefs.Email || !prefs.Chat || !prefs.Push || prefs.DigestFrequency != secretaryv1.DigestFrequency_DIGEST_FREQUENCY_OFF {
+		t.Fatalf("defaults = %v", prefs)
+	}
+	if got.Msg.EmailAvailable || got.Msg.ChatAvailable || got.Msg.PushAvailable {
+		t.Fatalf("channels available on an unconfigured server: %v", got.Msg)
+	}
+
+	want := &secretaryv1.NotificationPreferences{
+		Email:           true,
+		MutedEvents:     []secretaryv1.NotificationEvent{secretaryv1.NotificationEvent_NOTIFICATION_EVENT_RECORDING_READY},
+		DigestFrequency: secretaryv1.DigestFrequency_DIGEST_FREQUENCY_WEEKLY,
+	}
+	if _, err := srv.UpdatePreferences(ctx, connect.NewRequest(&secretaryv1.UpdatePreferencesRequest{Preferences: want})); err != nil {
+		t.Fatalf("UpdatePreferences: %v", err)
+	}
+	got, err = srv.GetPreferences(ctx, connect.NewRequest(&secretaryv1.GetPreferencesRequest{}))
+	if err != nil {
+		t.Fatalf("GetPreferences: %v", err)
+	}
+	if !proto.Equal(got.Msg.Preferences, want) {
+		t.Fatalf("preferences = %v, want %v", got.Msg.Preferences, want)
+	}
+}
+
+// savedPreferences stores preferences like the upsert does.
+type savedPreferences struct {
+	NotificationPreferenceStore
+	rows map[int32]db.NotificationPreference
+}
+
+func (p *savedPreferences) GetNotificationPreference(_ context.Context, userID int32) (db.NotificationPreference, error) {
+	row, ok := p.rows[userID]
+	if !ok {
+		return db.NotificationPreference{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (p *savedPreferences) SaveNotificationPreference(_ context.Context, arg db.SaveNotificationPreferenceParams) (db.NotificationPreference, error) {
+	if p.rows == nil {
+		p.rows = map[int32]db.NotificationPreference{}
+	}
+	p.rows[arg.UserID] = db.NotificationPreference{
+		UserID:          arg.UserID,
+		EmailEnabled:    arg.EmailEnabled,
+		ChatEnabled:     arg.ChatEnabled,
+		PushEnabled:     arg.PushEnabled,
+		MutedEvents:     arg.MutedEvents,
+		DigestFrequency: arg.DigestFrequency,
+	}
+	return p.rows[arg.UserID], nil
+}
+
+func TestSummaryMessage(t *testing.T) {
+	msg := summaryMessage(db.GetRecordingRow{
+		ID:        3,
+		Name:      optionalText("Offsite planning"),
+		Summary:   optionalText(" We picked Lisbon. "),
+		CreatedAt: pgtype.Timestamptz{Time: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC), Valid: true},
+		Duration:  pgtype.Int4{Int32: 1530, Valid: true},
+	}, "https://secretary.example.com/recordings/3")
+	if msg.Title != "Meeting summary: Offsite planning" || msg.Text != "We picked Lisbon." {
+		t.Fatalf("message = %+v", msg)
+	}
+	if len(msg.Facts) != 2 || msg.Facts[0].Value != "Oct 1, 2026" || msg.Facts[1].Value != "26 min" {
+		t.Fatalf("facts = %+v", msg.Facts)
+	}
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"embed"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/mvult/secretary/backend/internal/openapi"
+)
+
+// swaggerUIAssets is swagger-ui-dist 5.18.2, vendored so the docs page
+// loads nothing from outside the server and never changes under it. To
+// upgrade, replace both files from the same swagger-ui-dist release.
+//
+//go:embed swaggerui/swagger-ui.css swaggerui/swagger-ui-bundle.js
+var swaggerUIAssets embed.FS
+
+// swaggerUIPage points the vendored Swagger UI at the generated spec, so
+// the docs page doesn't add a frontend build dependency.
+const swaggerUIPage = `<!doctype html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Secretary API</title>
+  <link rel="stylesheet" href="/api/docs/swaggerui/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="/api/docs/swaggerui/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({
+      url: "/api/openapi.json",
+      dom_id: "#swagger-ui",
+      persistAuthorization: true,
+      requestInterceptor: (req) => {
+        req.headers["Connect-Protocol-Version"] = "1";
+        return req;
+      },
+    });
+  </script>
+</body>
+</html>
+`
+
+var (
+	openAPIOnce sync.Once
+	openAPIDoc  map[string]any
+	openAPIErr  error
+)
+
+func (s *Server) handleOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	openAPIOnce.Do(func() {
+		openAPIDoc, openAPIErr = openapi.Build(openapi.Info{Title: "Secretary API", Version: "v1"}, serviceNames)
+		if openAPIErr != nil {
+			log.Printf("openapi: %v", openAPIErr)
+		}
+	})
+	if openAPIErr != nil {
+		writeError(w, http.StatusInternalServerError, "failed to build openapi document")
+		return
+	}
+	writeJSON(w, http.StatusOK, openAPIDoc)
+}
+
+func (s *Server) handleAPIDocs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write([]byte(swaggerUIPage))
+}
+
+// handleSwaggerUIAsset serves the vendored Swagger UI files. They only
+// change with a release, so browsers may keep them for a day.
+func (s *Server) handleSwaggerUIAsset(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	http.FileServerFS(swaggerUIAssets).ServeHTTP(w, r)
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPIDocsLoadOnlyVendoredAssets(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	page := get("/api/docs")
+	if page.Code != http.StatusOK {
+		t.Fatalf("docs page status = %d", page.Code)
+	}
+	refs := regexp.MustCompile(`(?:src|href)="([^"]+)"`).FindAllStringSubmatch(page.Body.String(), -1)
+	if len(refs) != 2 {
+		t.Fatalf("docs page loads %v", refs)
+	}
+	for _, ref := range refs {
+		if !strings.HasPrefix(ref[1], "/api/docs/swaggerui/") {
+			t.Fatalf("docs page loads %s from outside the server", ref[1])
+		}
+		rec := get(ref[1])
+		if rec.Code != http.StatusOK || rec.Body.Len() == 0 {
+			t.Fatalf("%s status = %d, %d bytes", ref[1], rec.Code, rec.Body.Len())
+		}
+	}
+}
@@ -0,0 +1,402 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/providers"
+)
+
+// Outcome kinds and sources, as stored in meeting_outcome.
+const (
+	outcomeDecision     = "decision"
+	outcomeRisk         = "risk"
+	outcomeOpenQuestion = "open_question"
+
+	outcomeSourceManual = "manual"
+	outcomeSourceLLM    = "llm"
+)
+
+// Limits on what is kept from a model's answer; longer texts are cut to
+// the length CreateOutcome accepts.
+const (
+	maxExtractedPerKind = 50
+	maxOutcomeText      = 2000
+)
+
+// outcomeInstructions is the system prompt for ExtractOutcomes. The answer
+// is parsed by parseExtraction.
+const outcomeInstructions = `Read this meeting transcript and list what the meeting settled and what it left open. Reply with a single JSON object and nothing else, shaped like:
+{"sentiment": "positive" | "neutral" | "negative" | "mixed", "decisions": [ITEM], "risks": [ITEM], "open_questions": [ITEM]}
+where each ITEM is {"text": "one short sentence", "owner": "Speaker N, or empty when nobody in particular", "quote": "the words in the transcript it comes from"}.
+Decisions are things the participants agreed on, risks are problems they expect or worry about, and open questions are things they did not resolve. Do not list action items. Use empty lists when there is nothing to report. Speakers are labelled "Speaker N".`
+
+// OutcomeStore holds the meeting outcome queries.
+type OutcomeStore interface {
+	ListOutcomes(ctx context.Context, arg db.ListOutcomesParams) ([]db.ListOutcomesRow, error)
+	GetOutcome(ctx context.Context, id int32) (db.GetOutcomeRow, error)
+	CreateOutcome(ctx context.Context, arg db.CreateOutcomeParams) (int32, error)
+	UpdateOutcome(ctx context.Context, arg db.UpdateOutcomeParams) (int64, error)
+	DeleteOutcome(ctx context.Context, id int32) (int64, error)
+	ReplaceExtractedOutcomes(ctx context.Context, arg db.ReplaceExtractedOutcomesParams) error
+	SetRecordingSentiment(ctx context.Context, arg db.SetRecordingSentimentParams) error
+}
+
+func mapOutcomeKind(kind string) secretaryv1.OutcomeKind {
+	switch kind {
+	case outcomeDecision:
+		return secretaryv1.OutcomeKind_OUTCOME_KIND_DECISION
+	case outcomeRisk:
+		return secretaryv1.OutcomeKind_OUTCOME_KIND_RISK
+	case outcomeOpenQuestion:
+		return secretaryv1.OutcomeKind_OUTCOME_KIND_OPEN_QUESTION
+	default:
+		return secretaryv1.OutcomeKind_OUTCOME_KIND_UNSPECIFIED
+	}
+}
+
+func mapOutcomeKindToString(kind secretaryv1.OutcomeKind) string {
+	switch kind {
+	case secretaryv1.OutcomeKind_OUTCOME_KIND_DECISION:
+		return outcomeDecision
+	case secretaryv1.OutcomeKind_OUTCOME_KIND_RISK:
+		return outcomeRisk
+	case secretaryv1.OutcomeKind_OUTCOME_KIND_OPEN_QUESTION:
+		return outcomeOpenQuestion
+	default:
+		return ""
+	}
+}
+
+func mapSentiment(sentiment string) secretaryv1.Sentiment {
+	switch sentiment {
+	case "positive":
+		return secretaryv1.Sentiment_SENTIMENT_POSITIVE
+	case "neutral":
+		return secretaryv1.Sentiment_SENTIMENT_NEUTRAL
+	case "negative":
+		return secretaryv1.Sentiment_SENTIMENT_NEGATIVE
+	case "mixed":
+		return secretaryv1.Sentiment_SENTIMENT_MIXED
+	default:
+		return secretaryv1.Sentiment_SENTIMENT_UNSPECIFIED
+	}
+}
+
+func (s *Server) outcomeToProto(row db.ListOutcomesRow) (*secretaryv1.Outcome, error) {
+	quote, err := s.openText(row.Quote.String)
+	if err != nil {
+		return nil, err
+	}
+	return &secretaryv1.Outcome{
+		Id:              int64(row.ID),
+		RecordingId:     int64(row.RecordingID),
+		RecordingName:   row.RecordingName.String,
+		RecordingDate:   formatTime(row.RecordingDate),
+		Kind:            mapOutcomeKind(row.Kind),
+		Text:            row.Text,
+		OwnerUserId:     int64(row.OwnerUserID.Int32),
+		Quote:           quote,
+		SourceKind:      row.SourceKind,
+		Resolved:        row.ResolvedAt.Valid,
+		ResolvedAt:      formatTime(row.ResolvedAt),
+		CreatedByUserId: int64(row.CreatedByUserID.Int32),
+		CreatedAt:       formatTime(row.CreatedAt),
+		UpdatedAt:       formatTime(row.UpdatedAt),
+	}, nil
+}
+
+func (s *Server) getOutcome(ctx context.Context, id int32) (*secretaryv1.Outcome, error) {
+	row, err := s.outcomes.GetOutcome(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("outcome not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch outcome")
+	}
+	return s.outcomeToProto(db.ListOutcomesRow(row))
+}
+
+func (s *Server) listOutcomes(ctx context.Context, arg db.ListOutcomesParams) ([]*secretaryv1.Outcome, error) {
+	// Sealed quotes can't be matched in SQL, so with encryption on the
+	// query is matched once they are opened.
+	query := arg.Query
+	searchOpened := s.encryption != nil && query.Valid
+	if searchOpened {
+		arg.Query = pgtype.Text{}
+	}
+	rows, err := s.outcomes.ListOutcomes(ctx, arg)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list outcomes")
+	}
+	outcomes := make([]*secretaryv1.Outcome, 0, len(rows))
+	for _, row := range rows {
+		outcome, err := s.outcomeToProto(row)
+		if err != nil {
+			return nil, err
+		}
+		if searchOpened && !containsFold(outcome.Text, query.String) && !containsFold(outcome.Quote, query.String) {
+			continue
+		}
+		outcomes = append(outcomes, outcome)
+	}
+	return outcomes, nil
+}
+
+// --- OutcomesService Implementation ---
+
+// ListOutcomes finds decisions, risks and open questions across meetings.
+func (s *Server) ListOutcomes(ctx context.Context, req *connect.Request[secretaryv1.ListOutcomesRequest]) (*connect.Response[secretaryv1.ListOutcomesResponse], error) {
+	msg := req.Msg
+	arg := db.ListOutcomesParams{
+		Kinds:       []string{},
+		OwnerUserID: pgtype.Int4{Int32: int32(msg.OwnerUserId), Valid: msg.OwnerUserId > 0},
+		Query:       optionalText(msg.Query),
+	}
+	if msg.RecordingId != nil {
+		arg.RecordingID = pgtype.Int4{Int32: int32(*msg.RecordingId), Valid: true}
+	}
+	for _, kind := range msg.Kinds {
+		arg.Kinds = append(arg.Kinds, mapOutcomeKindToString(kind))
+	}
+	if msg.Resolved != nil {
+		arg.Resolved = pgtype.Bool{Bool: *msg.Resolved, Valid: true}
+	}
+	var err error
+	if arg.MeetingAfter, err = parseOptionalTimestamp(msg.MeetingAfter); err != nil {
+		return nil, apierr.InvalidField("meeting_after", "must be an RFC 3339 timestamp")
+	}
+	if arg.MeetingBefore, err = parseOptionalTimestamp(msg.MeetingBefore); err != nil {
+		return nil, apierr.InvalidField("meeting_before", "must be an RFC 3339 timestamp")
+	}
+	outcomes, err := s.listOutcomes(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.ListOutcomesResponse{Outcomes: outcomes}), nil
+}
+
+func (s *Server) CreateOutcome(ctx context.Context, req *connect.Request[secretaryv1.CreateOutcomeRequest]) (*connect.Response[secretaryv1.CreateOutcomeResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	quote, err := s.sealText(strings.TrimSpace(msg.Quote))
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.outcomes.CreateOutcome(ctx, db.CreateOutcomeParams{
+		RecordingID:     int32(msg.RecordingId),
+		Kind:            mapOutcomeKindToString(msg.Kind),
+		Text:            strings.TrimSpace(msg.Text),
+		OwnerUserID:     optionalInt4(msg.OwnerUserId),
+		Quote:           optionalText(quote),
+		CreatedByUserID: pgtype.Int4{Int32: int32(userID), Valid: true},
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to create outcome")
+	}
+	outcome, err := s.getOutcome(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.CreateOutcomeResponse{Outcome: outcome}), nil
+}
+
+// UpdateOutcome rewrites an outcome, e.g. to correct what a model
+// extracted or to mark a risk or question resolved.
+func (s *Server) UpdateOutcome(ctx context.Context, req *connect.Request[secretaryv1.UpdateOutcomeRequest]) (*connect.Response[secretaryv1.UpdateOutcomeResponse], error) {
+	msg := req.Msg
+	updated, err := s.outcomes.UpdateOutcome(ctx, db.UpdateOutcomeParams{
+		ID:          int32(msg.Id),
+		Text:        strings.TrimSpace(msg.Text),
+		OwnerUserID: optionalInt4(msg.OwnerUserId),
+		Resolved:    msg.Resolved,
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to update outcome")
+	}
+	if updated == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("outcome not found"))
+	}
+	outcome, err := s.getOutcome(ctx, int32(msg.Id))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.UpdateOutcomeResponse{Outcome: outcome}), nil
+}
+
+func (s *Server) DeleteOutcome(ctx context.Context, req *connect.Request[secretaryv1.DeleteOutcomeRequest]) (*connect.Response[secretaryv1.DeleteOutcomeResponse], error) {
+	deleted, err := s.outcomes.DeleteOutcome(ctx, int32(req.Msg.Id))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to delete outcome")
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("outcome not found"))
+	}
+	return connect.NewResponse(&secretaryv1.DeleteOutcomeResponse{}), nil
+}
+
+// ExtractOutcomes has a summarization provider read the transcript for
+// decisions, risks and open questions. Owners given as speaker labels are
+// resolved through the recording's identified participants.
+func (s *Server) ExtractOutcomes(ctx context.Context, req *connect.Request[secretaryv1.ExtractOutcomesRequest]) (*connect.Response[secretaryv1.ExtractOutcomesResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := int32(req.Msg.RecordingId)
+	transcript, template, _, err := s.recordingTranscript(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.runProvider(ctx, userID, "failed to extract outcomes", func(registry *providers.Registry) (providers.Result, error) {
+		return registry.Summarize(ctx, id, providers.SummaryRequest{Instructions: withGuidance(outcomeInstructions, template), Transcript: transcript})
+	})
+	if err != nil {
+		return nil, err
+	}
+	extracted, err := parseExtraction(result.Text)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("the provider's answer could not be read as outcomes; try again"))
+	}
+
+	participants, err := s.recordings.ListRecordingParticipants(ctx, id)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list recording participants")
+	}
+	speakers := make(map[int32]int32, len(participants))
+	for _, p := range participants {
+		speakers[p.SpeakerID] = p.ID
+	}
+	arg := db.ReplaceExtractedOutcomesParams{
+		RecordingID:     id,
+		CreatedByUserID: pgtype.Int4{Int32: int32(userID), Valid: true},
+		Kinds:           []string{},
+		Texts:           []string{},
+		OwnerUserIds:    []int32{},
+		Quotes:          []string{},
+	}
+	for _, group := range []struct {
+		kind  string
+		items []extractedOutcome
+	}{
+		{outcomeDecision, extracted.Decisions},
+		{outcomeRisk, extracted.Risks},
+		{outcomeOpenQuestion, extracted.OpenQuestions},
+	} {
+		for _, item := range group.items {
+			quote, err := s.sealText(item.Quote)
+			if err != nil {
+				return nil, err
+			}
+			arg.Kinds = append(arg.Kinds, group.kind)
+			arg.Texts = append(arg.Texts, item.Text)
+			arg.OwnerUserIds = append(arg.OwnerUserIds, speakers[speakerNumber(item.Owner)])
+			arg.Quotes = append(arg.Quotes, quote)
+		}
+	}
+	if err := s.outcomes.ReplaceExtractedOutcomes(ctx, arg); err != nil {
+		return nil, apierr.Wrap(err, "failed to store outcomes")
+	}
+	if err := s.outcomes.SetRecordingSentiment(ctx, db.SetRecordingSentimentParams{ID: id, Sentiment: optionalText(extracted.Sentiment)}); err != nil {
+		return nil, apierr.Wrap(err, "failed to store sentiment")
+	}
+	s.recordingCache.invalidate()
+
+	outcomes, err := s.listOutcomes(ctx, db.ListOutcomesParams{RecordingID: pgtype.Int4{Int32: id, Valid: true}, Kinds: []string{}})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.ExtractOutcomesResponse{
+		Outcomes:  outcomes,
+		Sentiment: mapSentiment(extracted.Sentiment),
+	}), nil
+}
+
+type extractedOutcome struct {
+	Text  string `json:"text"`
+	Owner string `json:"owner"`
+	Quote string `json:"quote"`
+}
+
+type extraction struct {
+	Sentiment     string             `json:"sentiment"`
+	Decisions     []extractedOutcome `json:"decisions"`
+	Risks         []extractedOutcome `json:"risks"`
+	OpenQuestions []extractedOutcome `json:"open_questions"`
+}
+
+// parseExtraction reads the JSON object answering outcomeInstructions.
+// Models sometimes wrap it in a code fence or a sentence, so only the
+// outermost braces are parsed. Empty items are dropped and an unknown
+// sentiment is left blank.
+func parseExtraction(text string) (extraction, error) {
+	start, end := strings.Index(text, "{"), strings.LastIndex(text, "}")
+	if start < 0 || end < start {
+		return extraction{}, errors.New("no JSON object in answer")
+	}
+	var parsed extraction
+	if err := json.Unmarshal([]byte(text[start:end+1]), &parsed); err != nil {
+		return extraction{}, err
+	}
+	parsed.Sentiment = strings.ToLower(strings.TrimSpace(parsed.Sentiment))
+	if mapSentiment(parsed.Sentiment) == secretaryv1.Sentiment_SENTIMENT_UNSPECIFIED {
+		parsed.Sentiment = ""
+	}
+	parsed.Decisions = cleanOutcomes(parsed.Decisions)
+	parsed.Risks = cleanOutcomes(parsed.Risks)
+	parsed.OpenQuestions = cleanOutcomes(parsed.OpenQuestions)
+	return parsed, nil
+}
+
+func cleanOutcomes(items []extractedOutcome) []extractedOutcome {
+	cleaned := items[:0]
+	for _, item := range items {
+		item.Text = truncateRunes(strings.TrimSpace(item.Text), maxOutcomeText)
+		item.Quote = truncateRunes(strings.TrimSpace(item.Quote), maxOutcomeText)
+		if item.Text == "" {
+			continue
+		}
+		cleaned = append(cleaned, item)
+		if len(cleaned) == maxExtractedPerKind {
+			break
+		}
+	}
+	return cleaned
+}
+
+func truncateRunes(value string, limit int) string {
+	runes := []rune(value)
+	if len(runes) <= limit {
+		return value
+	}
+	return string(runes[:limit])
+}
+
+var speakerPattern = regexp.MustCompile(`(?i)^speaker\s*(\d+)$`)
+
+// speakerNumber returns N for a "Speaker N" label, or -1.
+func speakerNumber(label string) int32 {
+	match := speakerPattern.FindStringSubmatch(strings.TrimSpace(label))
+	if match == nil {
+		return -1
+	}
+	n, err := strconv.ParseInt(match[1], 10, 32)
+	if err != nil {
+		return -1
+	}
+	return int32(n)
+}
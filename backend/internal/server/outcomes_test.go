@@ -0,0 +1,185 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/providers"
+)
+
+// fakeOutcomes keeps outcomes in memory; every outcome belongs to
+// recording 3.
+type fakeOutcomes struct {
+	OutcomeStore
+	rows      []db.ListOutcomesRow
+	sentiment string
+}
+
+func (f *fakeOutcomes) ListOutcomes(_ context.Context, arg db.ListOutcomesParams) ([]db.ListOutcomesRow, error) {
+	var rows []db.ListOutcomesRow
+	for _, row := range f.rows {
+		if len(arg.Kinds) == 0 || strings.Contains(strings.Join(arg.Kinds, ","), row.Kind) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeOutcomes) ReplaceExtractedOutcomes(_ context.Context, arg db.ReplaceExtractedOutcomesParams) error {
+	kept := f.rows[:0]
+	for _, row := range f.rows {
+		if row.SourceKind != outcomeSourceLLM {
+			kept = append(kept, row)
+		}
+	}
+	f.rows = kept
+	for i, kind := range arg.Kinds {
+		f.rows = append(f.rows, db.ListOutcomesRow{
+			ID:              int32(len(f.rows) + 1),
+			RecordingID:     arg.RecordingID,
+			Kind:            kind,
+			Text:            arg.Texts[i],
+			OwnerUserID:     optionalInt4(int64(arg.OwnerUserIds[i])),
+			Quote:           optionalText(arg.Quotes[i]),
+			SourceKind:      outcomeSourceLLM,
+			CreatedByUserID: arg.CreatedByUserID,
+		})
+	}
+	return nil
+}
+
+func (f *fakeOutcomes) SetRecordingSentiment(_ context.Context, arg db.SetRecordingSentimentParams) error {
+	f.sentiment = arg.Sentiment.String
+	return nil
+}
+
+// cannedSummarizer answers every request with the same text.
+type cannedSummarizer struct{ answer string }
+
+func (c cannedSummarizer) Summarize(context.Context, providers.SummaryRequest) (string, providers.Usage, error) {
+	return c.answer, providers.Usage{InputTokens: 100, OutputTokens: 20}, nil
+}
+
+type speakingParticipants struct{ *fakeTranslations }
+
+func (speakingParticipants) ListRecordingParticipants(context.Context, int32) ([]db.ListRecordingParticipantsRow, error) {
+	return []db.ListRecordingParticipantsRow{{ID: 8, SpeakerID: 2}}, nil
+}
+
+const extractionAnswer = "Here you go:\n```json\n" + `{
+  "sentiment": "Mixed",
+  "decisions": [{"text": "Ship the beta on Friday.", "owner": "Speaker 2", "quote": "let's ship Friday"}, {"text": "  "}],
+  "risks": [{"text": "The vendor may miss the deadline.", "owner": "Speaker 7"}],
+  "open_questions": [{"text": "Who pays for hosting?", "owner": ""}]
+}` + "\n```"
+
+func TestExtractOutcomes(t *testing.T) {
+	recordings := speakingParticipants{&fakeTranslations{fakeRecordingStatus: &fakeRecordingStatus{status: recordingReady}, transcript: "Speaker 2: let's ship Friday"}}
+	manual := db.ListOutcomesRow{ID: 1, RecordingID: 3, Kind: outcomeRisk, Text: "Budget is tight.", SourceKind: outcomeSourceManual}
+	outcomes := &fakeOutcomes{rows: []db.ListOutcomesRow{manual}}
+	registry := providers.NewRegistry(nil)
+	registry.AddSummarizer(cannedSummarizer{answer: extractionAnswer}, providers.Options{Name: "stub"})
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(recordings, nil, nil)
+	srv.ConfigureProviders(registry)
+	srv.outcomes = outcomes
+	srv.usage = &fakeUsage{}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 4})
+
+	// Extracting twice replaces the first run's outcomes but keeps the
+	// manual one.
+	var resp *connect.Response[secretaryv1.ExtractOutcomesResponse]
+	for range 2 {
+		var err error
+		resp, err = srv.ExtractOutcomes(ctx, connect.NewRequest(&secretaryv1.ExtractOutcomesRequest{RecordingId: 3}))
+		if err != nil {
+			t.Fatalf("extract: %v", err)
+		}
+	}
+	if resp.Msg.Sentiment != secretaryv1.Sentiment_SENTIMENT_MIXED || outcomes.sentiment != "mixed" {
+		t.Fatalf("sentiment = %v (stored %q), want mixed", resp.Msg.Sentiment, outcomes.sentiment)
+	}
+	got := resp.Msg.Outcomes
+	if len(got) != 4 || got[0].Text != "Budget is tight." {
+		t.Fatalf("outcomes = %v, want the manual risk and three extracted outcomes", got)
+	}
+	decision, risk, question := got[1], got[2], got[3]
+	if decision.Kind != secretaryv1.OutcomeKind_OUTCOME_KIND_DECISION || decision.OwnerUserId != 8 || decision.Quote != "let's ship Friday" || decision.SourceKind != outcomeSourceLLM {
+		t.Fatalf("decision = %v", decision)
+	}
+	// Speaker 7 was never identified, so the risk has no owner.
+	if risk.Kind != secretaryv1.OutcomeKind_OUTCOME_KIND_RISK || risk.OwnerUserId != 0 {
+		t.Fatalf("risk = %v", risk)
+	}
+	if question.Kind != secretaryv1.OutcomeKind_OUTCOME_KIND_OPEN_QUESTION || question.CreatedByUserId != 4 {
+		t.Fatalf("question = %v", question)
+	}
+}
+
+func TestExtractOutcomesSealsQuotes(t *testing.T) {
+	recordings := speakingParticipants{&fakeTranslations{fakeRecordingStatus: &fakeRecordingStatus{status: recordingReady}, transcript: "Speaker 2: let's ship Friday"}}
+	outcomes := &fakeOutcomes{}
+	registry := providers.NewRegistry(nil)
+	registry.AddSummarizer(cannedSummarizer{answer: extractionAnswer}, providers.Options{Name: "stub"})
+	srv := encryptedServer(t)
+	srv.ConfigureStores(recordings, nil, nil)
+	srv.ConfigureProviders(registry)
+	srv.outcomes = outcomes
+	srv.usage = &fakeUsage{}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 4})
+
+	if _, err := srv.ExtractOutcomes(ctx, connect.NewRequest(&secretaryv1.ExtractOutcomesRequest{RecordingId: 3})); err != nil {
+		t.Fatalf("extract: %v", err)
+	}
+	if stored := outcomes.rows[0].Quote.String; strings.Contains(stored, "Friday") {
+		t.Fatalf("quote stored as %q, want it sealed", stored)
+	}
+	// The quote is searched once opened, since SQL can't match it.
+	resp, err := srv.ListOutcomes(ctx, connect.NewRequest(&secretaryv1.ListOutcomesRequest{Query: "SHIP friday"}))
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if got := resp.Msg.Outcomes; len(got) != 1 || got[0].Quote != "let's ship Friday" {
+		t.Fatalf("outcomes = %v, want the decision quoting the transcript", got)
+	}
+}
+
+func TestExtractOutcomesRejectsUnreadableAnswers(t *testing.T) {
+	registry := providers.NewRegistry(nil)
+	registry.AddSummarizer(cannedSummarizer{answer: "The meeting went well."}, providers.Options{Name: "stub"})
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(&fakeTranslations{fakeRecordingStatus: &fakeRecordingStatus{}, transcript: "Speaker 1: hi"}, nil, nil)
+	srv.ConfigureProviders(registry)
+	srv.outcomes = &fakeOutcomes{}
+	srv.usage = &fakeUsage{}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 4})
+
+	_, err := srv.ExtractOutcomes(ctx, connect.NewRequest(&secretaryv1.ExtractOutcomesRequest{RecordingId: 3}))
+	if connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("err = %v, want Unavailable", err)
+	}
+}
+
+func TestParseExtraction(t *testing.T) {
+	parsed, err := parseExtraction(`{"sentiment": "ecstatic", "decisions": [{"text": "` + strings.Repeat("é", maxOutcomeText+10) + `"}]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Sentiment != "" {
+		t.Errorf("unknown sentiment kept as %q", parsed.Sentiment)
+	}
+	if len(parsed.Decisions) != 1 || len([]rune(parsed.Decisions[0].Text)) != maxOutcomeText {
+		t.Errorf("decision text not cut to %d runes", maxOutcomeText)
+	}
+	for label, want := range map[string]int32{"Speaker 3": 3, "speaker12": 12, "Ana": -1, "": -1} {
+		if got := speakerNumber(label); got != want {
+			t.Errorf("speakerNumber(%q) = %d, want %d", label, got, want)
+		}
+	}
+}
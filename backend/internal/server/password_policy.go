@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/password"
+)
+
+// ConfigurePasswordPolicy sets the rules new passwords must meet, wherever
+// they are set: ChangePassword and SCIM provisioning.
+func (s *Server) ConfigurePasswordPolicy(policy password.Policy) {
+	s.passwordPolicy = policy
+}
+
+// passwordViolations returns the policy rules plain breaks as the password
+// for email. When the breach check can't be made it is skipped, so an
+// outage doesn't stop people changing passwords.
+func (s *Server) passwordViolations(ctx context.Context, plain, email string) []password.Violation {
+	violations, err := s.passwordPolicy.Check(ctx, plain, email)
+	if err != nil {
+		log.Printf("password policy: skipping the breach check: %v", err)
+	}
+	return violations
+}
+
+// checkNewPassword returns an InvalidArgument error listing every rule
+// plain breaks as field, or nil.
+func (s *Server) checkNewPassword(ctx context.Context, field, plain, email string) error {
+	violations := s.passwordViolations(ctx, plain, email)
+	if len(violations) == 0 {
+		return nil
+	}
+	fields := make([]apierr.FieldViolation, len(violations))
+	for i, v := range violations {
+		fields[i] = apierr.FieldViolation{Field: field, Reason: v.Rule, Description: v.Description}
+	}
+	return apierr.InvalidFields(fields...)
+}
+
+// checkScimPassword is checkNewPassword for SCIM, whose errors carry one
+// detail string.
+func (s *Server) checkScimPassword(ctx context.Context, plain, email string) error {
+	violations := s.passwordViolations(ctx, plain, email)
+	if len(violations) == 0 {
+		return nil
+	}
+	descriptions := make([]string, len(violations))
+	for i, v := range violations {
+		descriptions[i] = v.Description
+	}
+	return newScimError(http.StatusBadRequest, "invalidValue", "password %s", strings.Join(descriptions, "; "))
+}
+
+func (s *Server) ChangePassword(ctx context.Context, req *connect.Request[secretaryv1.ChangePasswordRequest]) (*connect.Response[secretaryv1.ChangePasswordResponse], error) {
+	start := time.Now()
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.users.GetPasswordHash(ctx, int32(userID))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to change password")
+	}
+	if row.PasswordHash.String == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("your account signs in without a password"))
+	}
+	// A stolen token shouldn't make guessing the password any faster than
+	// signing in does.
+	if !s.checkPassword(int32(userID), row.PasswordHash.String, req.Msg.CurrentPassword) {
+		if !s.delayFailure(ctx, start) {
+			return nil, ctx.Err()
+		}
+		return nil, apierr.InvalidField("current_password", "is incorrect")
+	}
+	if err := s.checkNewPassword(ctx, "new_password", req.Msg.NewPassword, row.Email.String); err != nil {
+		return nil, err
+	}
+	hash, err := s.hashPassword(req.Msg.NewPassword)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to change password")
+	}
+	changed, err := s.users.UpdatePasswordHash(ctx, db.UpdatePasswordHashParams{
+		ID:      int32(userID),
+		OldHash: row.PasswordHash,
+		NewHash: pgtype.Text{String: hash, Valid: true},
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to change password")
+	}
+	if changed == 0 {
+		return nil, connect.NewError(connect.CodeAborted, errors.New("the password was changed meanwhile; try again"))
+	}
+
+	principal, _ := principalFrom(ctx)
+	revoked, err := s.sessions.RevokeOtherSessions(ctx, db.RevokeOtherSessionsParams{UserID: int32(userID), KeepID: principal.SessionID})
+	if err != nil {
+		return nil, apierr.Wrap(err, "password changed, but failed to sign out other sessions")
+	}
+	s.sessionsRevoked(ctx, userID, revoked)
+	log.Printf("password: user %d changed their password", userID)
+	return connect.NewResponse(&secretaryv1.ChangePasswordResponse{SessionsRevoked: int64(len(revoked))}), nil
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/password"
+)
+
+// breachedPasswords is a breach check that knows a fixed list.
+type breachedPasswords []string
+
+func (b breachedPasswords) Breached(_ context.Context, plain string) (int, error) {
+	for _, known := range b {
+		if known == plain {
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func (f *loginUsers) GetPasswordHash(_ context.Context, id int32) (db.GetPasswordHashRow, error) {
+	for _, row := range f.byEmail {
+		if row.ID == id {
+			return db.GetPasswordHashRow{Email: row.Email, PasswordHash: row.PasswordHash}, nil
+		}
+	}
+	return db.GetPasswordHashRow{}, errors.New("no such user")
+}
+
+func fieldViolations(err error) []string {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return nil
+	}
+	var reasons []string
+	for _, detail := range connectErr.Details() {
+		if value, err := detail.Value(); err == nil {
+			if v, ok := value.(*errdetails.BadRequest); ok {
+				for _, violation := range v.FieldViolations {
+					reasons = append(reasons, violation.Field+" "+violation.Reason)
+				}
+			}
+		}
+	}
+	return reasons
+}
+
+func TestChangePassword(t *testing.T) {
+	hash, err := password.Hash("old password 1", password.DefaultParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	users := &loginUsers{byEmail: map[string]db.GetUserByEmailRow{
+		"ana@example.com": {ID: 5, FirstName: "Ana", Email: optionalText("ana@example.com"), PasswordHash: optionalText(hash)},
+		"sso@example.com": {ID: 6, FirstName: "Sam", Email: optionalText("sso@example.com")},
+	}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, users)
+	sessions := &fakeSessions{}
+	srv.sessions = sessions
+	srv.loginDelay = 0
+	srv.ConfigurePasswordPolicy(password.Policy{MinLength: 12, Breaches: breachedPasswords{"password1234"}})
+
+	ctx := context.Background()
+	current, _, err := srv.startSession(ctx, 5, sessionClient{UserAgent: "laptop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, _, _ := srv.startSession(ctx, 5, sessionClient{UserAgent: "phone"})
+	principal, err := srv.authenticate("Bearer " + current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx = withPrincipal(ctx, principal)
+	change := func(from, to string) (*connect.Response[secretaryv1.ChangePasswordResponse], error) {
+		return srv.ChangePassword(ctx, connect.NewRequest(&secretaryv1.ChangePasswordRequest{CurrentPassword: from, NewPassword: to}))
+	}
+
+	if _, err := change("wrong", "a good new password"); connect.CodeOf(err) != connect.CodeInvalidArgument || !strings.Contains(err.Error(), "current_password") {
+		t.Fatalf("wrong current password: %v", err)
+	}
+	for to, want := range map[string]string{
+		"short":             "new_password PASSWORD_TOO_SHORT",
+		"ana@example.com 1": "new_password PASSWORD_CONTAINS_EMAIL",
+		"ana":               "new_password PASSWORD_TOO_SHORT,new_password PASSWORD_CONTAINS_EMAIL",
+		"password1234":      "new_password PASSWORD_BREACHED",
+	} {
+		_, err := change("old password 1", to)
+		if connect.CodeOf(err) != connect.CodeInvalidArgument || strings.Join(fieldViolations(err), ",") != want {
+			t.Errorf("new password %q: %v, violations %v; want %s", to, err, fieldViolations(err), want)
+		}
+	}
+	if users.byEmail["ana@example.com"].PasswordHash.String != hash {
+		t.Fatal("a refused password was stored")
+	}
+
+	res, err := change("old password 1", "a good new password")
+	if err != nil || res.Msg.SessionsRevoked != 1 {
+		t.Fatalf("change: %v, %v", res, err)
+	}
+	if ok, _ := password.Verify(users.byEmail["ana@example.com"].PasswordHash.String, "a good new password"); !ok {
+		t.Fatal("new password not stored")
+	}
+	if _, err := srv.authenticate("Bearer " + other); err == nil {
+		t.Fatal("other session still signed in")
+	}
+	if _, err := srv.authenticate("Bearer " + current); err != nil {
+		t.Fatalf("current session signed out: %v", err)
+	}
+
+	_, err = srv.ChangePassword(withPrincipal(context.Background(), Principal{UserID: 6}), connect.NewRequest(&secretaryv1.ChangePasswordRequest{CurrentPassword: "x", NewPassword: "a good new password"}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("account without a password: %v", err)
+	}
+}
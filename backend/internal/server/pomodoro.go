@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
 )
 
 const pomodoroApprovalPrompt = "You are deciding whether a work-hours distraction unlock should be approved. Return JSON only with shape {\"decision\":\"approve\"|\"deny\",\"time\":<integer minutes>,\"reason\":<short string>}. Approve only if the rationale is specific, work-related, and time-bounded. Deny vague reasons.  In general however, if the reason is valid, allot as much time as is requestion, but never approve more than 120 minutes."
@@ -129,7 +131,7 @@ func (s *Server) requestPomodoroApproval(ctx context.Context, req pomodoroApprov
 		return pomodoroApprovalResponse{}, fmt.Errorf("read approval response: %w", err)
 	}
 	if resp.StatusCode >= 400 {
-		return pomodoroApprovalResponse{}, fmt.Errorf("approval request failed (%d): %s", resp.StatusCode, strings.TrimSpace(string(responseBody)))
+		return pomodoroApprovalResponse{}, apierr.NewProviderError("openai", resp, responseBody)
 	}
 
 	var parsed pomodoroChatCompletionResponse
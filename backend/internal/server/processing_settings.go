@@ -0,0 +1,186 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/redact"
+)
+
+// decodeProcessingSettings reads a recording's settings. processing_settings
+// holds them as JSON, all but the prompt template, which keeps its own
+// column so deleting a template takes it off its recordings.
+func decodeProcessingSettings(raw []byte, template pgtype.Int4) (*secretaryv1.ProcessingSettings, error) {
+	settings := &secretaryv1.ProcessingSettings{}
+	if len(raw) > 0 {
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(raw, settings); err != nil {
+			return nil, apierr.Wrap(err, "failed to read processing settings")
+		}
+	}
+	settings.PromptTemplateId = int64(template.Int32)
+	return settings, nil
+}
+
+// encodeProcessingSettings splits settings into the JSON stored in
+// processing_settings and the prompt template column.
+func encodeProcessingSettings(settings *secretaryv1.ProcessingSettings) ([]byte, pgtype.Int4, error) {
+	stored := &secretaryv1.ProcessingSettings{}
+	if settings != nil {
+		stored = proto.Clone(settings).(*secretaryv1.ProcessingSettings)
+	}
+	template := optionalInt4(stored.PromptTemplateId)
+	stored.PromptTemplateId = 0
+	data, err := protojson.Marshal(stored)
+	if err != nil {
+		return nil, pgtype.Int4{}, apierr.Wrap(err, "failed to store processing settings")
+	}
+	return data, template, nil
+}
+
+// resolveProcessingSettings fills in what settings leave to the
+// organization's defaults, canonicalizes the language and checks the
+// prompt template exists. Nil settings are all defaults. languageField
+// names the language in errors.
+func (s *Server) resolveProcessingSettings(ctx context.Context, languageField string, settings *secretaryv1.ProcessingSettings) (*secretaryv1.ProcessingSettings, error) {
+	if settings == nil {
+		settings = &secretaryv1.ProcessingSettings{}
+	}
+	defaults := s.orgSettings()
+	if settings.Language == "" {
+		settings.Language = defaults.DefaultLanguage
+	}
+	if settings.DisableSummary == nil {
+		settings.DisableSummary = proto.Bool(defaults.DisableSummary)
+	}
+	if settings.DisableTodoExtraction == nil {
+		settings.DisableTodoExtraction = proto.Bool(defaults.DisableTodoExtraction)
+	}
+	lang, err := parseLanguageTag(languageField, settings.Language)
+	if err != nil {
+		return nil, err
+	}
+	settings.Language = lang
+	if settings.PromptTemplateId == 0 {
+		return settings, nil
+	}
+	if err := s.checkPromptTemplate(ctx, settings.PromptTemplateId); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (s *Server) checkPromptTemplate(ctx context.Context, id int64) error {
+	_, err := s.prompts.GetPromptTemplate(ctx, int32(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return connect.NewError(connect.CodeNotFound, errors.New("prompt template not found"))
+	}
+	if err != nil {
+		return apierr.Wrap(err, "failed to fetch prompt template")
+	}
+	return nil
+}
+
+// uploadSettings reads the processing settings an HTTP upload passes as
+// JSON, e.g. {"language": "es", "disableDiarization": true}. Missing
+// settings are the defaults.
+func (s *Server) uploadSettings(ctx context.Context, raw string) (*secretaryv1.ProcessingSettings, error) {
+	settings := &secretaryv1.ProcessingSettings{}
+	if raw != "" {
+		if err := protojson.Unmarshal([]byte(raw), settings); err != nil {
+			return nil, apierr.InvalidField("settings", "must be ProcessingSettings as JSON")
+		}
+	}
+	return s.resolveProcessingSettings(ctx, "settings.language", settings)
+}
+
+// writeUploadSettingsError reports an uploadSettings failure from a plain
+// HTTP handler.
+func writeUploadSettingsError(w http.ResponseWriter, err error) {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) && (connectErr.Code() == connect.CodeInvalidArgument || connectErr.Code() == connect.CodeNotFound) {
+		writeError(w, http.StatusBadRequest, connectErr.Message())
+		return
+	}
+	writeError(w, http.StatusInternalServerError, "failed to check processing settings")
+}
+
+// recordingRedactionPolicy adds what a recording's settings mask to the
+// organization's policy.
+func (s *Server) recordingRedactionPolicy(settings *secretaryv1.ProcessingSettings) redact.Policy {
+	policy := s.redactionPolicy()
+	extra := settings.GetRedaction()
+	policy.Emails = policy.Emails || extra.GetEmails()
+	policy.PhoneNumbers = policy.PhoneNumbers || extra.GetPhoneNumbers()
+	policy.CreditCards = policy.CreditCards || extra.GetCreditCards()
+	policy.Profanity = policy.Profanity || extra.GetProfanity()
+	return policy
+}
+
+// rowRedactionPolicy is recordingRedactionPolicy for a stored recording.
+func (s *Server) rowRedactionPolicy(raw []byte) (redact.Policy, error) {
+	settings, err := decodeProcessingSettings(raw, pgtype.Int4{})
+	if err != nil {
+		return redact.Policy{}, err
+	}
+	return s.recordingRedactionPolicy(settings), nil
+}
+
+// UpdateProcessingSettings replaces the settings of a recording that isn't
+// being processed. The recording is processed with them the next time it
+// is retried.
+func (s *Server) UpdateProcessingSettings(ctx context.Context, req *connect.Request[secretaryv1.UpdateProcessingSettingsRequest]) (*connect.Response[secretaryv1.UpdateProcessingSettingsResponse], error) {
+	if _, err := requireUserID(ctx); err != nil {
+		return nil, err
+	}
+	id := int32(req.Msg.RecordingId)
+	settings, err := s.resolveProcessingSettings(ctx, "settings.language", req.Msg.Settings)
+	if err != nil {
+		return nil, err
+	}
+	data, template, err := encodeProcessingSettings(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	qtx, err := s.recordings.BeginRecordingTx(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to start transaction")
+	}
+	defer func() { _ = qtx.Rollback(ctx) }()
+
+	current, err := qtx.GetRecordingStatusForUpdate(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch recording")
+	}
+	if stageForStatus(current) != "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("processing settings can't change while the recording is being processed"))
+	}
+	if err := qtx.SetRecordingProcessingSettings(ctx, db.SetRecordingProcessingSettingsParams{
+		ID:                 id,
+		ProcessingSettings: data,
+		PromptTemplateID:   template,
+	}); err != nil {
+		return nil, apierr.Wrap(err, "failed to update processing settings")
+	}
+	if err := qtx.Commit(ctx); err != nil {
+		return nil, apierr.Wrap(err, "failed to commit transaction")
+	}
+	rec, err := s.refreshedRecording(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.UpdateProcessingSettingsResponse{Recording: rec}), nil
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+func TestUpdateProcessingSettings(t *testing.T) {
+	recordings := &fakeRecordingStatus{status: recordingTranscribing}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(recordings, nil, memberUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	update := func(settings *secretaryv1.ProcessingSettings) (*secretaryv1.Recording, error) {
+		resp, err := srv.UpdateProcessingSettings(ctx, connect.NewRequest(&secretaryv1.UpdateProcessingSettingsRequest{RecordingId: 3, Settings: settings}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Recording, nil
+	}
+	if _, err := update(&secretaryv1.ProcessingSettings{Language: "es"}); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("while transcribing: %v", err)
+	}
+	recordings.status = recordingFailed
+	if _, err := update(&secretaryv1.ProcessingSettings{Language: "not a language"}); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("bad language: %v", err)
+	}
+	rec, err := update(&secretaryv1.ProcessingSettings{Language: "PT-br", DisableDiarization: true})
+	if err != nil || rec.Language != "pt-BR" || !rec.ProcessingSettings.GetDisableDiarization() {
+		t.Fatalf("update: %+v, %v", rec, err)
+	}
+}
+
+func TestRecordingRedactionAddsToOrgPolicy(t *testing.T) {
+	srv, store, _, _ := newTranslationServer(adminUsers{})
+	srv.settingsCache.Store(&db.OrgSetting{RedactPhoneNumbers: true})
+	store.settings = []byte(`{"redaction": {"emails": true}}`)
+	store.status = recordingTranscribing
+	store.transcript = "Speaker 1: ana@example.com or 555-123-4567"
+
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_SUMMARIZING, ""); err != nil {
+		t.Fatalf("finish transcribing: %v", err)
+	}
+	if want := "Speaker 1: [email] or [phone]"; store.transcript != want {
+		t.Fatalf("transcript = %q, want %q", store.transcript, want)
+	}
+}
+
+// emptyTeam is an admin alone on the team, so a ready recording has
+// nobody to link mentions to.
+type emptyTeam struct{ adminUsers }
+
+func (emptyTeam) ListUsers(context.Context) ([]db.ListUsersRow, error) {
+	return nil, nil
+}
+
+func TestProcessingDefaultsApplyUnlessOverridden(t *testing.T) {
+	recordings := &fakeRecordingStatus{status: recordingReady}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(recordings, nil, emptyTeam{})
+	srv.mentions = &fakeMentions{}
+	srv.settingsCache.Store(&db.OrgSetting{DefaultLanguage: "es", DisableSummary: true})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+	update := func(settings *secretaryv1.ProcessingSettings) *secretaryv1.Recording {
+		t.Helper()
+		resp, err := srv.UpdateProcessingSettings(ctx, connect.NewRequest(&secretaryv1.UpdateProcessingSettingsRequest{RecordingId: 3, Settings: settings}))
+		if err != nil {
+			t.Fatalf("update: %v", err)
+		}
+		return resp.Msg.Recording
+	}
+
+	rec := update(&secretaryv1.ProcessingSettings{})
+	if s := rec.ProcessingSettings; s.Language != "es" || !s.GetDisableSummary() || s.DisableTodoExtraction == nil || s.GetDisableTodoExtraction() {
+		t.Fatalf("defaults: %+v", s)
+	}
+	// A recording without a summary goes straight to ready.
+	recordings.status = recordingTranscribing
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_READY, ""); err != nil {
+		t.Fatalf("transcribing to ready without a summary: %v", err)
+	}
+
+	recordings.status = recordingReady
+	rec = update(&secretaryv1.ProcessingSettings{Language: "en", DisableSummary: proto.Bool(false)})
+	if s := rec.ProcessingSettings; s.Language != "en" || s.DisableSummary == nil || s.GetDisableSummary() {
+		t.Fatalf("overridden: %+v", s)
+	}
+	recordings.status = recordingTranscribing
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_READY, ""); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("skipping the summary: %v", err)
+	}
+}
@@ -0,0 +1,365 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	netmail "net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	// Embedded so time zones validate on hosts without zoneinfo.
+	_ "time/tzdata"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/avatar"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/i18n"
+	"github.com/mvult/secretary/backend/internal/mail"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+const (
+	emailVerificationTTL = 24 * time.Hour
+	maxAvatarBytes       = 5 << 20
+	avatarKeyPrefix      = "avatars/"
+)
+
+var avatarNamePattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// ConfigureMail sets how verification emails are sent and the address of
+// the web app used in their links. Until it is called messages are only
+// logged.
+func (s *Server) ConfigureMail(sender mail.Sender, publicURL string) {
+	s.mailer = sender
+	s.publicURL = strings.TrimRight(publicURL, "/")
+}
+
+func avatarURL(key pgtype.Text) string {
+	if !key.Valid || key.String == "" {
+		return ""
+	}
+	return "/api/avatars/" + strings.TrimPrefix(key.String, avatarKeyPrefix)
+}
+
+func profileToProto(row db.GetProfileRow) *secretaryv1.Profile {
+	return &secretaryv1.Profile{
+		Id:               int64(row.ID),
+		FirstName:        row.FirstName,
+		LastName:         row.LastName.String,
+		Role:             row.Role.String,
+		Email:            row.Email.String,
+		EmailVerified:    row.Email.Valid && row.EmailVerifiedAt.Valid,
+		PendingEmail:     row.PendingEmail.String,
+		Timezone:         row.Timezone,
+		AvatarUrl:        avatarURL(row.AvatarKey),
+		Locale:           row.Locale.String,
+		SupportedLocales: i18n.Supported(),
+	}
+}
+
+func (s *Server) loadProfile(ctx context.Context, userID int64) (*secretaryv1.Profile, error) {
+	row, err := s.users.GetProfile(ctx, int32(userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user no longer exists"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch profile")
+	}
+	return profileToProto(row), nil
+}
+
+func (s *Server) GetMe(ctx context.Context, req *connect.Request[secretaryv1.GetMeRequest]) (*connect.Response[secretaryv1.GetMeResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := s.loadProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	flags, err := s.enabledFeatures(ctx, userID)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to read feature flags")
+	}
+	return connect.NewResponse(&secretaryv1.GetMeResponse{Profile: profile, FeatureFlags: flags}), nil
+}
+
+// UpdateMe changes the caller's own name, time zone and email. A new
+// email is stored as pending and a verification link is sent to it; the
+// current address stays in use for sign-in until the link is followed.
+func (s *Server) UpdateMe(ctx context.Context, req *connect.Request[secretaryv1.UpdateMeRequest]) (*connect.Response[secretaryv1.UpdateMeResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.users.GetProfile(ctx, int32(userID))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch profile")
+	}
+
+	update := db.UpdateProfileParams{ID: current.ID, FirstName: current.FirstName, LastName: current.LastName, Timezone: current.Timezone, Locale: current.Locale}
+	if req.Msg.FirstName != nil {
+		update.FirstName = strings.TrimSpace(req.Msg.GetFirstName())
+		if update.FirstName == "" {
+			return nil, apierr.InvalidField("first_name", "must not be blank")
+		}
+	}
+	if req.Msg.LastName != nil {
+		lastName := strings.TrimSpace(req.Msg.GetLastName())
+		update.LastName = pgtype.Text{String: lastName, Valid: lastName != ""}
+	}
+	if req.Msg.Timezone != nil {
+		update.Timezone = strings.TrimSpace(req.Msg.GetTimezone())
+		if _, err := time.LoadLocation(update.Timezone); err != nil || update.Timezone == "" || update.Timezone == "Local" {
+			return nil, apierr.InvalidField("timezone", "must be an IANA time zone name such as Europe/Madrid")
+		}
+	}
+	if req.Msg.Locale != nil {
+		update.Locale = pgtype.Text{}
+		if raw := strings.TrimSpace(req.Msg.GetLocale()); raw != "" {
+			locale, ok := i18n.Normalize(raw)
+			if !ok {
+				return nil, apierr.InvalidField("locale", "must be a supported locale")
+			}
+			update.Locale = pgtype.Text{String: locale, Valid: true}
+		}
+	}
+	if err := s.users.UpdateProfile(ctx, update); err != nil {
+		return nil, apierr.Wrap(err, "failed to update profile")
+	}
+
+	if req.Msg.Email != nil {
+		current.Locale = update.Locale
+		if err := s.changeEmail(ctx, current, strings.TrimSpace(req.Msg.GetEmail()), req.Header().Get("Accept-Language")); err != nil {
+			return nil, err
+		}
+	}
+	profile, err := s.loadProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.UpdateMeResponse{Profile: profile}), nil
+}
+
+// changeEmail starts verification of a new address, or cancels a pending
+// change when the current address is given again. The email is written in
+// the user's locale, or the one the request's Accept-Language asks for.
+func (s *Server) changeEmail(ctx context.Context, current db.GetProfileRow, email string, acceptLanguage string) error {
+	if email == current.Email.String {
+		if err := s.users.SetPendingEmail(ctx, db.SetPendingEmailParams{ID: current.ID}); err != nil {
+			return apierr.Wrap(err, "failed to update email")
+		}
+		return nil
+	}
+	parsed, err := netmail.ParseAddress(email)
+	if err != nil || parsed.Address != email {
+		return apierr.InvalidField("email", "must be a plain email address")
+	}
+	existing, err := s.users.GetUserByEmail(ctx, pgtype.Text{String: email, Valid: true})
+	switch {
+	case err == nil && existing.ID != current.ID:
+		return connect.NewError(connect.CodeAlreadyExists, errors.New("email is already in use"))
+	case err != nil && !errors.Is(err, pgx.ErrNoRows):
+		return apierr.Wrap(err, "failed to check email")
+	}
+
+	token, err := newVerificationToken()
+	if err != nil {
+		return apierr.Wrap(err, "failed to create verification token")
+	}
+	if err := s.users.SetPendingEmail(ctx, db.SetPendingEmailParams{
+		ID:                         current.ID,
+		PendingEmail:               pgtype.Text{String: email, Valid: true},
+		EmailVerificationHash:      pgtype.Text{String: hashVerificationToken(token), Valid: true},
+		EmailVerificationExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(emailVerificationTTL), Valid: true},
+	}); err != nil {
+		return apierr.Wrap(err, "failed to update email")
+	}
+	link := s.publicURL + "/verify-email?token=" + token
+	locale := i18n.Negotiate(current.Locale.String, acceptLanguage)
+	if err := s.mailer.Send(ctx, mail.Message{
+		To:      email,
+		Subject: i18n.T(locale, "Confirm your email address"),
+		Text: i18n.Sprintf(locale, "Hi %s,\n\nFollow this link within 24 hours to start using %s with Secretary:\n\n%s\n\nIf you did not ask for this, ignore this email.\n",
+			current.FirstName, email, link),
+	}); err != nil {
+		return apierr.Wrap(err, "failed to send verification email")
+	}
+	return nil
+}
+
+func (s *Server) VerifyEmail(ctx context.Context, req *connect.Request[secretaryv1.VerifyEmailRequest]) (*connect.Response[secretaryv1.VerifyEmailResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	confirmed, err := s.users.ConfirmPendingEmail(ctx, db.ConfirmPendingEmailParams{
+		ID:                    int32(userID),
+		EmailVerificationHash: pgtype.Text{String: hashVerificationToken(strings.TrimSpace(req.Msg.Token)), Valid: true},
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to verify email")
+	}
+	if confirmed == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("verification link is invalid or has expired"))
+	}
+	profile, err := s.loadProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.VerifyEmailResponse{Profile: profile}), nil
+}
+
+func newVerificationToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// hashVerificationToken is what is stored, so a database leak does not
+// expose usable links.
+func hashVerificationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// handleMyAvatar replaces (PUT, raw image body) or removes (DELETE) the
+// caller's avatar. Uploads are rendered at every avatar.Sizes, stored as
+// avatars/<id>/<size>.png.
+func (s *Server) handleMyAvatar(w http.ResponseWriter, r *http.Request) {
+	userID := currentUserID(r.Context())
+	if userID <= 0 {
+		writeError(w, http.StatusUnauthorized, "unauthenticated")
+		return
+	}
+	if s.storage == nil {
+		writeError(w, http.StatusServiceUnavailable, "storage is not configured")
+		return
+	}
+	current, err := s.users.GetProfile(r.Context(), int32(userID))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch profile")
+		return
+	}
+
+	var key string
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxAvatarBytes))
+		if err != nil {
+			writeError(w, http.StatusRequestEntityTooLarge, "avatar is too large")
+			return
+		}
+		images, err := avatar.Render(data)
+		if err != nil {
+			writeError(w, http.StatusUnsupportedMediaType, "avatar must be a PNG, JPEG or GIF image")
+			return
+		}
+		if key, err = newAvatarKey(); err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to store avatar")
+			return
+		}
+		for size, image := range images {
+			if _, err := s.storage.Put(r.Context(), avatarObjectKey(key, size), bytes.NewReader(image)); err != nil {
+				s.deleteAvatar(r.Context(), key)
+				writeError(w, http.StatusInternalServerError, "failed to store avatar")
+				return
+			}
+		}
+	case http.MethodDelete:
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	if err := s.users.SetUserAvatar(r.Context(), db.SetUserAvatarParams{ID: current.ID, AvatarKey: pgtype.Text{String: key, Valid: key != ""}}); err != nil {
+		if key != "" {
+			s.deleteAvatar(r.Context(), key)
+		}
+		writeError(w, http.StatusInternalServerError, "failed to update avatar")
+		return
+	}
+	if current.AvatarKey.Valid {
+		s.deleteAvatar(r.Context(), current.AvatarKey.String)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"avatarUrl": avatarURL(pgtype.Text{String: key, Valid: key != ""})})
+}
+
+// handleAvatar serves an avatar image at the rendered size nearest the
+// size query parameter. Avatar keys are random and change on every
+// upload, so the URLs are unguessable and can be cached forever; they are
+// served without authentication so plain <img> tags work.
+func (s *Server) handleAvatar(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	name := r.PathValue("name")
+	if s.storage == nil || !avatarNamePattern.MatchString(name) {
+		http.NotFound(w, r)
+		return
+	}
+	size := avatar.DefaultSize
+	if raw := r.URL.Query().Get("size"); raw != "" {
+		want, err := strconv.Atoi(raw)
+		if err != nil || want <= 0 {
+			writeError(w, http.StatusBadRequest, "size must be a positive integer")
+			return
+		}
+		size = avatar.Nearest(want)
+	}
+	body, err := s.storage.Open(r.Context(), avatarObjectKey(avatarKeyPrefix+name, size))
+	if errors.Is(err, storage.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to read avatar")
+		return
+	}
+	defer body.Close()
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = io.Copy(w, body)
+}
+
+func newAvatarKey() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return avatarKeyPrefix + hex.EncodeToString(buf), nil
+}
+
+func avatarObjectKey(key string, size int) string {
+	return fmt.Sprintf("%s/%d.png", key, size)
+}
+
+// deleteAvatar removes every rendered size. Failures only leak storage,
+// so they are logged.
+func (s *Server) deleteAvatar(ctx context.Context, key string) {
+	for _, size := range avatar.Sizes {
+		if err := s.storage.Delete(ctx, avatarObjectKey(key, size)); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			log.Printf("avatar: failed to delete %s: %v", avatarObjectKey(key, size), err)
+		}
+	}
+}
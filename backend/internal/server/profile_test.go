@@ -0,0 +1,198 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/avatar"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/mail"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// fakeProfiles holds one user's profile.
+type fakeProfiles struct {
+	UserStore
+	profile db.GetProfileRow
+	hash    string
+	taken   string
+}
+
+func (f *fakeProfiles) GetProfile(context.Context, int32) (db.GetProfileRow, error) {
+	return f.profile, nil
+}
+
+func (f *fakeProfiles) UpdateProfile(_ context.Context, arg db.UpdateProfileParams) error {
+	f.profile.FirstName, f.profile.LastName, f.profile.Timezone, f.profile.Locale = arg.FirstName, arg.LastName, arg.Timezone, arg.Locale
+	return nil
+}
+
+func (f *fakeProfiles) GetUserByEmail(_ context.Context, email pgtype.Text) (db.GetUserByEmailRow, error) {
+	if email.String == f.taken {
+		return db.GetUserByEmailRow{ID: 99}, nil
+	}
+	return db.GetUserByEmailRow{}, pgx.ErrNoRows
+}
+
+func (f *fakeProfiles) SetPendingEmail(_ context.Context, arg db.SetPendingEmailParams) error {
+	f.profile.PendingEmail, f.hash = arg.PendingEmail, arg.EmailVerificationHash.String
+	return nil
+}
+
+func (f *fakeProfiles) ConfirmPendingEmail(_ context.Context, arg db.ConfirmPendingEmailParams) (int64, error) {
+	if !f.profile.PendingEmail.Valid || arg.EmailVerificationHash.String != f.hash {
+		return 0, nil
+	}
+	f.profile.Email, f.profile.PendingEmail = f.profile.PendingEmail, pgtype.Text{}
+	f.profile.EmailVerifiedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	return 1, nil
+}
+
+func (f *fakeProfiles) SetUserAvatar(_ context.Context, arg db.SetUserAvatarParams) error {
+	f.profile.AvatarKey = arg.AvatarKey
+	return nil
+}
+
+type outbox struct{ sent []mail.Message }
+
+func (o *outbox) Send(_ context.Context, msg mail.Message) error {
+	o.sent = append(o.sent, msg)
+	return nil
+}
+
+func newProfileServer() (*Server, *fakeProfiles, *outbox) {
+	users := &fakeProfiles{
+		profile: db.GetProfileRow{ID: 1, FirstName: "Ana", Email: optionalText("ana@example.com"), EmailVerifiedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}, Timezone: "UTC"},
+		taken:   "bo@example.com",
+	}
+	mailer := &outbox{}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, users)
+	srv.ConfigureMail(mailer, "https://secretary.example.com/")
+	return srv, users, mailer
+}
+
+func TestUpdateMe(t *testing.T) {
+	srv, users, _ := newProfileServer()
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+
+	first, tz := " Ana María ", "Europe/Madrid"
+	resp, err := srv.UpdateMe(ctx, connect.NewRequest(&secretaryv1.UpdateMeRequest{FirstName: &first, Timezone: &tz}))
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if p := resp.Msg.Profile; p.FirstName != "Ana María" || p.Timezone != "Europe/Madrid" || p.Email != "ana@example.com" {
+		t.Fatalf("profile = %+v", p)
+	}
+
+	bad := "Mars/Olympus"
+	_, err = srv.UpdateMe(ctx, connect.NewRequest(&secretaryv1.UpdateMeRequest{Timezone: &bad}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument || users.profile.Timezone != "Europe/Madrid" {
+		t.Fatalf("err = %v, timezone = %s; want InvalidArgument and no change", err, users.profile.Timezone)
+	}
+}
+
+func TestEmailChangeNeedsVerification(t *testing.T) {
+	srv, users, mailer := newProfileServer()
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+
+	taken := "bo@example.com"
+	if _, err := srv.UpdateMe(ctx, connect.NewRequest(&secretaryv1.UpdateMeRequest{Email: &taken})); connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Fatalf("err = %v, want AlreadyExists", err)
+	}
+
+	email := "ana@new.example.com"
+	resp, err := srv.UpdateMe(ctx, connect.NewRequest(&secretaryv1.UpdateMeRequest{Email: &email}))
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if resp.Msg.Profile.Email != "ana@example.com" || resp.Msg.Profile.PendingEmail != email {
+		t.Fatalf("profile = %+v, want the old email kept until verified", resp.Msg.Profile)
+	}
+	if len(mailer.sent) != 1 || mailer.sent[0].To != email {
+		t.Fatalf("sent = %+v", mailer.sent)
+	}
+	_, link, _ := strings.Cut(mailer.sent[0].Text, "https://secretary.example.com/verify-email?token=")
+	token, _, _ := strings.Cut(link, "\n")
+	if token == "" || users.hash == token {
+		t.Fatalf("token %q should be mailed and only its hash stored", token)
+	}
+
+	if _, err := srv.VerifyEmail(ctx, connect.NewRequest(&secretaryv1.VerifyEmailRequest{Token: "wrong"})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("err = %v, want FailedPrecondition", err)
+	}
+	verified, err := srv.VerifyEmail(ctx, connect.NewRequest(&secretaryv1.VerifyEmailRequest{Token: token}))
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if p := verified.Msg.Profile; p.Email != email || !p.EmailVerified || p.PendingEmail != "" {
+		t.Fatalf("profile = %+v", p)
+	}
+}
+
+func TestAvatarUploadAndServe(t *testing.T) {
+	srv, users, _ := newProfileServer()
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureStorage(store)
+	upload := func(body []byte) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/api/me/avatar", bytes.NewReader(body))
+		req = req.WithContext(withPrincipal(req.Context(), Principal{UserID: 1}))
+		rec := httptest.NewRecorder()
+		srv.handleMyAvatar(rec, req)
+		return rec
+	}
+
+	if rec := upload([]byte("not an image")); rec.Code != http.StatusUnsupportedMediaType {
+		t.Fatalf("status = %d, want 415", rec.Code)
+	}
+	var picture bytes.Buffer
+	if err := png.Encode(&picture, image.NewGray(image.Rect(0, 0, 300, 200))); err != nil {
+		t.Fatal(err)
+	}
+	rec := upload(picture.Bytes())
+	var body struct {
+		AvatarURL string `json:"avatarUrl"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil || rec.Code != http.StatusOK || !strings.HasPrefix(body.AvatarURL, "/api/avatars/") {
+		t.Fatalf("status = %d, url = %q, err = %v", rec.Code, body.AvatarURL, err)
+	}
+	first := users.profile.AvatarKey.String
+
+	for query, want := range map[string]int{"": 128, "?size=40": 32, "?size=1000": 512} {
+		serve := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, body.AvatarURL+query, nil)
+		req.SetPathValue("name", strings.TrimPrefix(body.AvatarURL, "/api/avatars/"))
+		srv.handleAvatar(serve, req)
+		if serve.Code != http.StatusOK || serve.Header().Get("Content-Type") != "image/png" {
+			t.Fatalf("%q: serve = %d %s", query, serve.Code, serve.Header().Get("Content-Type"))
+		}
+		img, err := png.Decode(serve.Body)
+		if err != nil || img.Bounds().Dx() != want {
+			t.Fatalf("%q: got %v (%v), want %dpx", query, img.Bounds(), err, want)
+		}
+	}
+
+	// Replacing the avatar removes every size of the old one.
+	upload(picture.Bytes())
+	for _, size := range avatar.Sizes {
+		if _, err := store.Open(context.Background(), avatarObjectKey(first, size)); err == nil {
+			t.Fatalf("expected the previous %dpx avatar to be deleted", size)
+		}
+	}
+}
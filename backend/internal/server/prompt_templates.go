@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// PromptTemplateStore holds the prompt template queries.
+type PromptTemplateStore interface {
+	ListPromptTemplates(ctx context.Context) ([]db.PromptTemplate, error)
+	GetPromptTemplate(ctx context.Context, id int32) (db.PromptTemplate, error)
+	CreatePromptTemplate(ctx context.Context, arg db.CreatePromptTemplateParams) (db.PromptTemplate, error)
+	UpdatePromptTemplate(ctx context.Context, arg db.UpdatePromptTemplateParams) (db.PromptTemplate, error)
+	DeletePromptTemplate(ctx context.Context, id int32) (int64, error)
+	SetRecordingPromptTemplate(ctx context.Context, arg db.SetRecordingPromptTemplateParams) (int64, error)
+}
+
+func promptTemplateToProto(row db.PromptTemplate) *secretaryv1.PromptTemplate {
+	return &secretaryv1.PromptTemplate{
+		Id:                  int64(row.ID),
+		Name:                row.Name,
+		Description:         row.Description,
+		SummaryInstructions: row.SummaryInstructions,
+		ExtractionGuidance:  row.ExtractionGuidance,
+		CreatedByUserId:     int64(row.CreatedByUserID.Int32),
+		CreatedAt:           formatTime(row.CreatedAt),
+		UpdatedAt:           formatTime(row.UpdatedAt),
+	}
+}
+
+// withGuidance adds a template's extraction guidance to one of the
+// built-in extraction prompts. The guidance goes last so the answer format
+// stays at the top of the prompt.
+func withGuidance(instructions string, template db.PromptTemplate) string {
+	guidance := strings.TrimSpace(template.ExtractionGuidance)
+	if guidance == "" {
+		return instructions
+	}
+	return instructions + "\nAlso follow these instructions from the organization, as long as the reply keeps the shape above:\n" + guidance
+}
+
+// --- PromptTemplatesService Implementation ---
+
+func (s *Server) ListPromptTemplates(ctx context.Context, _ *connect.Request[secretaryv1.ListPromptTemplatesRequest]) (*connect.Response[secretaryv1.ListPromptTemplatesResponse], error) {
+	rows, err := s.prompts.ListPromptTemplates(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list prompt templates")
+	}
+	templates := make([]*secretaryv1.PromptTemplate, 0, len(rows))
+	for _, row := range rows {
+		templates = append(templates, promptTemplateToProto(row))
+	}
+	return connect.NewResponse(&secretaryv1.ListPromptTemplatesResponse{Templates: templates}), nil
+}
+
+func (s *Server) CreatePromptTemplate(ctx context.Context, req *connect.Request[secretaryv1.CreatePromptTemplateRequest]) (*connect.Response[secretaryv1.CreatePromptTemplateResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can create prompt templates"); err != nil {
+		return nil, err
+	}
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	name := strings.TrimSpace(msg.Name)
+	if name == "" {
+		return nil, apierr.InvalidField("name", "must not be blank")
+	}
+	row, err := s.prompts.CreatePromptTemplate(ctx, db.CreatePromptTemplateParams{
+		Name:                name,
+		Description:         strings.TrimSpace(msg.Description),
+		SummaryInstructions: strings.TrimSpace(msg.SummaryInstructions),
+		ExtractionGuidance:  strings.TrimSpace(msg.ExtractionGuidance),
+		CreatedByUserID:     pgtype.Int4{Int32: int32(userID), Valid: true},
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to create prompt template")
+	}
+	return connect.NewResponse(&secretaryv1.CreatePromptTemplateResponse{Template: promptTemplateToProto(row)}), nil
+}
+
+func (s *Server) UpdatePromptTemplate(ctx context.Context, req *connect.Request[secretaryv1.UpdatePromptTemplateRequest]) (*connect.Response[secretaryv1.UpdatePromptTemplateResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can change prompt templates"); err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	name := strings.TrimSpace(msg.Name)
+	if name == "" {
+		return nil, apierr.InvalidField("name", "must not be blank")
+	}
+	row, err := s.prompts.UpdatePromptTemplate(ctx, db.UpdatePromptTemplateParams{
+		ID:                  int32(msg.Id),
+		Name:                name,
+		Description:         strings.TrimSpace(msg.Description),
+		SummaryInstructions: strings.TrimSpace(msg.SummaryInstructions),
+		ExtractionGuidance:  strings.TrimSpace(msg.ExtractionGuidance),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("prompt template not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to update prompt template")
+	}
+	return connect.NewResponse(&secretaryv1.UpdatePromptTemplateResponse{Template: promptTemplateToProto(row)}), nil
+}
+
+func (s *Server) DeletePromptTemplate(ctx context.Context, req *connect.Request[secretaryv1.DeletePromptTemplateRequest]) (*connect.Response[secretaryv1.DeletePromptTemplateResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can delete prompt templates"); err != nil {
+		return nil, err
+	}
+	deleted, err := s.prompts.DeletePromptTemplate(ctx, int32(req.Msg.Id))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to delete prompt template")
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("prompt template not found"))
+	}
+	s.recordingCache.invalidate()
+	return connect.NewResponse(&secretaryv1.DeletePromptTemplateResponse{}), nil
+}
+
+func (s *Server) SetRecordingPromptTemplate(ctx context.Context, req *connect.Request[secretaryv1.SetRecordingPromptTemplateRequest]) (*connect.Response[secretaryv1.SetRecordingPromptTemplateResponse], error) {
+	msg := req.Msg
+	updated, err := s.prompts.SetRecordingPromptTemplate(ctx, db.SetRecordingPromptTemplateParams{
+		ID:               int32(msg.RecordingId),
+		PromptTemplateID: optionalInt4(msg.TemplateId),
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to set prompt template")
+	}
+	if updated == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	s.recordingCache.invalidate()
+	return connect.NewResponse(&secretaryv1.SetRecordingPromptTemplateResponse{}), nil
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// fakePrompts keeps templates in memory, keyed by id.
+type fakePrompts struct {
+	PromptTemplateStore
+	rows map[int32]db.PromptTemplate
+}
+
+func (f *fakePrompts) GetPromptTemplate(_ context.Context, id int32) (db.PromptTemplate, error) {
+	row, ok := f.rows[id]
+	if !ok {
+		return db.PromptTemplate{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (f *fakePrompts) CreatePromptTemplate(_ context.Context, arg db.CreatePromptTemplateParams) (db.PromptTemplate, error) {
+	row := db.PromptTemplate{
+		ID:                  int32(len(f.rows) + 1),
+		Name:                arg.Name,
+		Description:         arg.Description,
+		SummaryInstructions: arg.SummaryInstructions,
+		ExtractionGuidance:  arg.ExtractionGuidance,
+		CreatedByUserID:     arg.CreatedByUserID,
+	}
+	f.rows[row.ID] = row
+	return row, nil
+}
+
+// templatedRecording is a recording that uses prompt template 1.
+type templatedRecording struct{ *fakeTranslations }
+
+func (r templatedRecording) GetRecording(ctx context.Context, id int32) (db.GetRecordingRow, error) {
+	row, err := r.fakeTranslations.GetRecording(ctx, id)
+	row.PromptTemplateID = pgtype.Int4{Int32: 1, Valid: true}
+	return row, err
+}
+
+func TestSummarizeUsesRecordingPromptTemplate(t *testing.T) {
+	srv, store, summarizer, _ := newTranslationServer(adminUsers{})
+	srv.ConfigureStores(templatedRecording{store}, nil, adminUsers{})
+	srv.prompts = &fakePrompts{rows: map[int32]db.PromptTemplate{}}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+
+	created, err := srv.CreatePromptTemplate(ctx, connect.NewRequest(&secretaryv1.CreatePromptTemplateRequest{
+		Name:                " Standup ",
+		SummaryInstructions: "List what each person did yesterday, will do today and is blocked on.",
+	}))
+	if err != nil {
+		t.Fatalf("CreatePromptTemplate: %v", err)
+	}
+	if got := created.Msg.Template; got.Id != 1 || got.Name != "Standup" || got.CreatedByUserId != 1 {
+		t.Fatalf("template = %v", got)
+	}
+
+	if _, err := srv.Summarize(ctx, connect.NewRequest(&secretaryv1.SummarizeRequest{Id: 3})); err != nil {
+		t.Fatalf("Summarize: %v", err)
+	}
+	if req := summarizer.requests[0]; !strings.HasPrefix(req.Instructions, "List what each person did") {
+		t.Fatalf("instructions = %q, want the template's", req.Instructions)
+	}
+}
+
+func TestCreatePromptTemplateRequiresAdmin(t *testing.T) {
+	srv, _, _, _ := newTranslationServer(memberUsers{})
+	srv.prompts = &fakePrompts{rows: map[int32]db.PromptTemplate{}}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 4})
+
+	_, err := srv.CreatePromptTemplate(ctx, connect.NewRequest(&secretaryv1.CreatePromptTemplateRequest{Name: "Board meeting"}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("err = %v, want PermissionDenied", err)
+	}
+}
+
+func TestWithGuidance(t *testing.T) {
+	if got := withGuidance(outcomeInstructions, db.PromptTemplate{ExtractionGuidance: "  "}); got != outcomeInstructions {
+		t.Fatalf("blank guidance changed the prompt: %q", got)
+	}
+	got := withGuidance(outcomeInstructions, db.PromptTemplate{ExtractionGuidance: "Treat budget figures as decisions."})
+	if !strings.HasPrefix(got, outcomeInstructions) || !strings.HasSuffix(got, "Treat budget figures as decisions.") {
+		t.Fatalf("prompt = %q", got)
+	}
+}
@@ -0,0 +1,9 @@
+package server
+
+import "github.com/mvult/secretary/backend/internal/providers"
+
+// ConfigureProviders sets the transcription and summarization providers.
+// Their call and cost totals are exported on /metrics.
+func (s *Server) ConfigureProviders(registry *providers.Registry) {
+	s.providers = registry
+}
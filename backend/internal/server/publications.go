@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/wiki"
+)
+
+// PublicationStore holds the queries recording a recording's wiki pages.
+type PublicationStore interface {
+	ListRecordingPublications(ctx context.Context, recordingID int32) ([]db.RecordingPublication, error)
+	GetRecordingPublication(ctx context.Context, arg db.GetRecordingPublicationParams) (db.RecordingPublication, error)
+	SaveRecordingPublication(ctx context.Context, arg db.SaveRecordingPublicationParams) (db.RecordingPublication, error)
+}
+
+var wikiNames = map[secretaryv1.WikiTarget]string{
+	secretaryv1.WikiTarget_WIKI_TARGET_NOTION:     wiki.Notion,
+	secretaryv1.WikiTarget_WIKI_TARGET_CONFLUENCE: wiki.Confluence,
+}
+
+func wikiFromName(name string) secretaryv1.WikiTarget {
+	for target, n := range wikiNames {
+		if n == name {
+			return target
+		}
+	}
+	return secretaryv1.WikiTarget_WIKI_TARGET_UNSPECIFIED
+}
+
+// ConfigureWikis sets the wikis recordings can be published to, and which
+// of them get every recording once it finishes processing.
+func (s *Server) ConfigureWikis(targets []wiki.Target, autoPublish []string) {
+	s.wikis = map[string]wiki.Target{}
+	for _, target := range targets {
+		s.wikis[target.Name()] = target
+	}
+	s.publishOnReady = autoPublish
+}
+
+func publicationToProto(row db.RecordingPublication) *secretaryv1.Publication {
+	return &secretaryv1.Publication{
+		Id:                int64(row.ID),
+		RecordingId:       int64(row.RecordingID),
+		Target:            wikiFromName(row.Target),
+		Url:               row.Url,
+		PublishedByUserId: int64(row.PublishedByUserID.Int32),
+		CreatedAt:         formatTime(row.CreatedAt),
+		UpdatedAt:         formatTime(row.UpdatedAt),
+	}
+}
+
+// wikiPage gathers what is published for a recording: its summary and its
+// latest minutes.
+func (s *Server) wikiPage(ctx context.Context, id int32) (wiki.Page, error) {
+	rec, err := s.recordings.GetRecording(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return wiki.Page{}, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return wiki.Page{}, apierr.Wrap(err, "failed to fetch recording")
+	}
+	name := strings.TrimSpace(rec.Name.String)
+	if name == "" {
+		name = fmt.Sprintf("Recording %d", rec.ID)
+	}
+	page := wiki.Page{
+		Title:   fmt.Sprintf("%s — %s", name, rec.CreatedAt.Time.Format("2006-01-02")),
+		Date:    rec.CreatedAt.Time,
+		Summary: strings.TrimSpace(rec.Summary.String),
+	}
+	if s.publicURL != "" {
+		page.SourceURL = fmt.Sprintf("%s/recordings/%d", s.publicURL, rec.ID)
+	}
+
+	row, err := s.minutes.GetLatestMinutes(ctx, id)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return wiki.Page{}, apierr.Wrap(err, "failed to fetch minutes")
+	default:
+		minutes, err := minutesToProto(row)
+		if err != nil {
+			return wiki.Page{}, err
+		}
+		page.Minutes = wikiMinutes(minutes.Document)
+	}
+	if page.Summary == "" && page.Minutes == nil {
+		return wiki.Page{}, connect.NewError(connect.CodeFailedPrecondition, errors.New("recording has no summary or minutes to publish"))
+	}
+	policy, err := s.rowRedactionPolicy(rec.ProcessingSettings)
+	if err != nil {
+		return wiki.Page{}, err
+	}
+	return redactPage(policy, page, id), nil
+}
+
+func wikiMinutes(document *secretaryv1.MinutesDocument) *wiki.Minutes {
+	minutes := &wiki.Minutes{
+		Agenda:    document.Agenda,
+		Decisions: document.Decisions,
+		Notes:     strings.TrimSpace(document.Notes),
+	}
+	for _, attendee := range document.Attendees {
+		minutes.Attendees = append(minutes.Attendees, attendee.Name)
+	}
+	for _, item := range document.ActionItems {
+		text := item.Text
+		var details []string
+		if item.OwnerName != "" {
+			details = append(details, item.OwnerName)
+		}
+		if len(item.DueAt) >= len("2006-01-02") {
+			details = append(details, "due "+item.DueAt[:len("2006-01-02")])
+		}
+		if len(details) > 0 {
+			text += " (" + strings.Join(details, ", ") + ")"
+		}
+		minutes.ActionItems = append(minutes.ActionItems, text)
+	}
+	return minutes
+}
+
+// publishRecording writes the recording's page to one wiki, replacing the
+// page from any earlier publish. publishedBy is unset for automatic
+// publishes.
+func (s *Server) publishRecording(ctx context.Context, id int32, target wiki.Target, publishedBy pgtype.Int4) (db.RecordingPublication, error) {
+	page, err := s.wikiPage(ctx, id)
+	if err != nil {
+		return db.RecordingPublication{}, err
+	}
+	var previous string
+	existing, err := s.publications.GetRecordingPublication(ctx, db.GetRecordingPublicationParams{RecordingID: id, Target: target.Name()})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return db.RecordingPublication{}, apierr.Wrap(err, "failed to fetch publication")
+	default:
+		previous = existing.ExternalID
+	}
+
+	published, err := target.Publish(ctx, page, previous)
+	if err != nil {
+		return db.RecordingPublication{}, apierr.Wrap(err, "failed to publish recording")
+	}
+	row, err := s.publications.SaveRecordingPublication(ctx, db.SaveRecordingPublicationParams{
+		RecordingID:       id,
+		Target:            target.Name(),
+		ExternalID:        published.ExternalID,
+		Url:               published.URL,
+		PublishedByUserID: publishedBy,
+	})
+	if err != nil {
+		return db.RecordingPublication{}, apierr.Wrap(err, "failed to save publication")
+	}
+	return row, nil
+}
+
+// autoPublish publishes a recording that just finished processing to the
+// wikis configured for it. Recordings with nothing to publish yet are
+// skipped; they can still be published by hand later.
+func (s *Server) autoPublish(ctx context.Context, id int32) {
+	for _, name := range s.publishOnReady {
+		target, ok := s.wikiTarget(name)
+		if !ok {
+			continue
+		}
+		_, err := s.publishRecording(ctx, id, target, pgtype.Int4{})
+		if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+			return
+		}
+		if err != nil {
+			log.Printf("publishing recording %d to %s: %v", id, name, err)
+		}
+	}
+}
+
+// --- RecordingsService publication methods ---
+
+func (s *Server) PublishRecording(ctx context.Context, req *connect.Request[secretaryv1.PublishRecordingRequest]) (*connect.Response[secretaryv1.PublishRecordingResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := wikiNames[req.Msg.Target]
+	target, ok := s.wikiTarget(name)
+	if !ok {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s is not configured on this server", name))
+	}
+	row, err := s.publishRecording(ctx, int32(req.Msg.RecordingId), target, pgtype.Int4{Int32: int32(userID), Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.PublishRecordingResponse{Publication: publicationToProto(row)}), nil
+}
+
+func (s *Server) ListPublications(ctx context.Context, req *connect.Request[secretaryv1.ListPublicationsRequest]) (*connect.Response[secretaryv1.ListPublicationsResponse], error) {
+	rows, err := s.publications.ListRecordingPublications(ctx, int32(req.Msg.RecordingId))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list publications")
+	}
+	publications := make([]*secretaryv1.Publication, 0, len(rows))
+	for _, row := range rows {
+		publications = append(publications, publicationToProto(row))
+	}
+	var available []secretaryv1.WikiTarget
+	for _, target := range []secretaryv1.WikiTarget{secretaryv1.WikiTarget_WIKI_TARGET_NOTION, secretaryv1.WikiTarget_WIKI_TARGET_CONFLUENCE} {
+		if _, ok := s.wikiTarget(wikiNames[target]); ok {
+			available = append(available, target)
+		}
+	}
+	return connect.NewResponse(&secretaryv1.ListPublicationsResponse{Publications: publications, AvailableTargets: available}), nil
+}
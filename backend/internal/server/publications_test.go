@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/wiki"
+)
+
+// fakePublications keeps one row per recording and target.
+type fakePublications struct {
+	rows []db.RecordingPublication
+}
+
+func (f *fakePublications) ListRecordingPublications(_ context.Context, recordingID int32) ([]db.RecordingPublication, error) {
+	var rows []db.RecordingPublication
+	for _, row := range f.rows {
+		if row.RecordingID == recordingID {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakePublications) GetRecordingPublication(_ context.Context, arg db.GetRecordingPublicationParams) (db.RecordingPublication, error) {
+	for _, row := range f.rows {
+		if row.RecordingID == arg.RecordingID && row.Target == arg.Target {
+			return row, nil
+		}
+	}
+	return db.RecordingPublication{}, pgx.ErrNoRows
+}
+
+func (f *fakePublications) SaveRecordingPublication(_ context.Context, arg db.SaveRecordingPublicationParams) (db.RecordingPublication, error) {
+	row := db.RecordingPublication{
+		RecordingID:       arg.RecordingID,
+		Target:            arg.Target,
+		ExternalID:        arg.ExternalID,
+		Url:               arg.Url,
+		PublishedByUserID: arg.PublishedByUserID,
+	}
+	for i := range f.rows {
+		if f.rows[i].RecordingID == arg.RecordingID && f.rows[i].Target == arg.Target {
+			row.ID = f.rows[i].ID
+			f.rows[i] = row
+			return row, nil
+		}
+	}
+	row.ID = int32(len(f.rows) + 1)
+	f.rows = append(f.rows, row)
+	return row, nil
+}
+
+// fakeWiki records the pages it is sent and what each replaced.
+type fakeWiki struct {
+	name     string
+	pages    []wiki.Page
+	replaced []string
+}
+
+func (w *fakeWiki) Name() string { return w.name }
+
+func (w *fakeWiki) Publish(_ context.Context, page wiki.Page, previous string) (wiki.Published, error) {
+	w.pages = append(w.pages, page)
+	w.replaced = append(w.replaced, previous)
+	id := fmt.Sprintf("%s-%d", w.name, len(w.pages))
+	return wiki.Published{ExternalID: id, URL: "https://wiki.example.com/" + id}, nil
+}
+
+func newPublishingServer(rec db.GetRecordingRow, targets ...wiki.Target) (*Server, *fakePublications) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(clipRecording{rec: rec}, nil, nil)
+	srv.minutes = &fakeMinutes{}
+	publications := &fakePublications{}
+	srv.publications = publications
+	srv.publicURL = "https://secretary.example.com"
+	srv.ConfigureWikis(targets, []string{wiki.Notion})
+	return srv, publications
+}
+
+func TestPublishRecordingReplacesPreviousPage(t *testing.T) {
+	notion := &fakeWiki{name: wiki.Notion}
+	srv, _ := newPublishingServer(db.GetRecordingRow{
+		ID:        3,
+		Name:      pgtype.Text{String: "Offsite planning", Valid: true},
+		Summary:   pgtype.Text{String: "We picked a venue.", Valid: true},
+		CreatedAt: pgtype.Timestamptz{Time: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC), Valid: true},
+	}, notion)
+	if _, err := srv.saveMinutes(context.Background(), 3, 0, &secretaryv1.MinutesDocument{
+		Decisions:   []string{"Go to Lisbon"},
+		ActionItems: []*secretaryv1.MinutesActionItem{{Text: "Book the venue", OwnerName: "Ana", DueAt: "2026-10-10T00:00:00Z"}},
+	}, minutesSourceLLM, 4); err != nil {
+		t.Fatalf("saveMinutes: %v", err)
+	}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 4})
+	publish := func() *secretaryv1.Publication {
+		resp, err := srv.PublishRecording(ctx, connect.NewRequest(&secretaryv1.PublishRecordingRequest{RecordingId: 3, Target: secretaryv1.WikiTarget_WIKI_TARGET_NOTION}))
+		if err != nil {
+			t.Fatalf("PublishRecording: %v", err)
+		}
+		return resp.Msg.Publication
+	}
+
+	first := publish()
+	if first.Target != secretaryv1.WikiTarget_WIKI_TARGET_NOTION || first.PublishedByUserId != 4 {
+		t.Fatalf("publication = %v", first)
+	}
+	page := notion.pages[0]
+	if page.Title != "Offsite planning — 2026-10-01" || page.SourceURL != "https://secretary.example.com/recordings/3" {
+		t.Fatalf("page = %+v", page)
+	}
+	if page.Minutes == nil || page.Minutes.ActionItems[0] != "Book the venue (Ana, due 2026-10-10)" {
+		t.Fatalf("minutes = %+v", page.Minutes)
+	}
+
+	second := publish()
+	if notion.replaced[1] != "notion-1" || second.Id != first.Id || second.Url != "https://wiki.example.com/notion-2" {
+		t.Fatalf("second publish = %v, replaced %q", second, notion.replaced[1])
+	}
+
+	_, err := srv.PublishRecording(ctx, connect.NewRequest(&secretaryv1.PublishRecordingRequest{RecordingId: 3, Target: secretaryv1.WikiTarget_WIKI_TARGET_CONFLUENCE}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("unconfigured target err = %v", err)
+	}
+}
+
+func TestAutoPublish(t *testing.T) {
+	notion := &fakeWiki{name: wiki.Notion}
+	confluence := &fakeWiki{name: wiki.Confluence}
+	srv, publications := newPublishingServer(db.GetRecordingRow{ID: 3, Summary: pgtype.Text{String: "Short call.", Valid: true}}, notion, confluence)
+
+	srv.autoPublish(context.Background(), 3)
+	if len(notion.pages) != 1 || len(confluence.pages) != 0 {
+		t.Fatalf("published %d notion and %d confluence pages", len(notion.pages), len(confluence.pages))
+	}
+	if row := publications.rows[0]; row.PublishedByUserID.Valid || row.Target != wiki.Notion {
+		t.Fatalf("publication = %+v", row)
+	}
+
+	// Nothing to publish yet: skipped quietly.
+	empty, _ := newPublishingServer(db.GetRecordingRow{ID: 5}, notion)
+	empty.autoPublish(context.Background(), 5)
+	if len(notion.pages) != 1 {
+		t.Fatalf("published a recording without a summary or minutes")
+	}
+}
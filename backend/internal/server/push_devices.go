@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/push"
+)
+
+// PushDeviceStore holds the queries managing users' push tokens.
+type PushDeviceStore interface {
+	RegisterPushDevice(ctx context.Context, arg db.RegisterPushDeviceParams) (db.PushDevice, error)
+	ListPushDevices(ctx context.Context, userID int32) ([]db.PushDevice, error)
+	UpdatePushDeviceMutedEvents(ctx context.Context, arg db.UpdatePushDeviceMutedEventsParams) (db.PushDevice, error)
+	UnregisterPushDevice(ctx context.Context, arg db.UnregisterPushDeviceParams) (int64, error)
+	ListPushTargets(ctx context.Context, arg db.ListPushTargetsParams) ([]db.PushDevice, error)
+	DeletePushDeviceToken(ctx context.Context, token string) error
+}
+
+// Event names stored in push_device.muted_events.
+const (
+	notificationTodoAssigned   = "todo_assigned"
+	notificationRecordingReady = "recording_ready"
+	notificationKeywordMatched = "keyword_matched"
+	notificationMentioned      = "mentioned"
+	notificationSnoozeEnded    = "snooze_ended"
+)
+
+var notificationEventNames = map[secretaryv1.NotificationEvent]string{
+	secretaryv1.NotificationEvent_NOTIFICATION_EVENT_TODO_ASSIGNED:   notificationTodoAssigned,
+	secretaryv1.NotificationEvent_NOTIFICATION_EVENT_RECORDING_READY: notificationRecordingReady,
+	secretaryv1.NotificationEvent_NOTIFICATION_EVENT_KEYWORD_MATCHED: notificationKeywordMatched,
+	secretaryv1.NotificationEvent_NOTIFICATION_EVENT_MENTIONED:       notificationMentioned,
+	secretaryv1.NotificationEvent_NOTIFICATION_EVENT_SNOOZE_ENDED:    notificationSnoozeEnded,
+}
+
+var pushPlatformNames = map[secretaryv1.PushPlatform]string{
+	secretaryv1.PushPlatform_PUSH_PLATFORM_FCM:  push.FCM,
+	secretaryv1.PushPlatform_PUSH_PLATFORM_APNS: push.APNs,
+}
+
+func pushPlatformFromName(name string) secretaryv1.PushPlatform {
+	for platform, n := range pushPlatformNames {
+		if n == name {
+			return platform
+		}
+	}
+	return secretaryv1.PushPlatform_PUSH_PLATFORM_UNSPECIFIED
+}
+
+func notificationEventsToNames(events []secretaryv1.NotificationEvent) []string {
+	names := make([]string, 0, len(events))
+	for _, event := range events {
+		names = append(names, notificationEventNames[event])
+	}
+	return names
+}
+
+// ConfigurePush sets the services alerts are pushed through. Without any,
+// devices can't be registered and nothing is pushed.
+func (s *Server) ConfigurePush(senders ...push.Sender) {
+	s.pushSenders = map[string]push.Sender{}
+	for _, sender := range senders {
+		s.pushSenders[sender.Platform()] = sender
+	}
+}
+
+func pushDeviceToProto(row db.PushDevice) *secretaryv1.PushDevice {
+	device := &secretaryv1.PushDevice{
+		Id:         int64(row.ID),
+		Platform:   pushPlatformFromName(row.Platform),
+		Name:       row.Name,
+		CreatedAt:  formatTime(row.CreatedAt),
+		LastSeenAt: formatTime(row.LastSeenAt),
+	}
+	for _, name := range row.MutedEvents {
+		for event, n := range notificationEventNames {
+			if n == name {
+				device.MutedEvents = append(device.MutedEvents, event)
+			}
+		}
+	}
+	sort.Slice(device.MutedEvents, func(i, j int) bool { return device.MutedEvents[i] < device.MutedEvents[j] })
+	return device
+}
+
+// pushToUsers alerts every device of userIDs that hasn't muted event, in
+// the background like sendNotification. Tokens the service reports as
+// gone are forgotten.
+func (s *Server) pushToUsers(ctx context.Context, userIDs []int32, event string, n push.Notification) {
+	if !s.pushEnabled() || len(userIDs) == 0 {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		devices, err := s.pushDevices.ListPushTargets(ctx, db.ListPushTargetsParams{UserIds: userIDs, Event: event})
+		if err != nil {
+			log.Printf("push %q: %v", n.Title, err)
+			return
+		}
+		for _, device := range devices {
+			sender, ok := s.pushSender(device.Platform)
+			if !ok {
+				continue
+			}
+			err := sender.Send(ctx, device.Token, n)
+			if errors.Is(err, push.ErrInvalidToken) {
+				if err := s.pushDevices.DeletePushDeviceToken(ctx, device.Token); err != nil {
+					log.Printf("forgetting push device %d: %v", device.ID, err)
+				}
+				continue
+			}
+			if err != nil {
+				log.Printf("push %q to device %d: %v", n.Title, device.ID, err)
+			}
+		}
+	}()
+}
+
+// --- NotificationsService ---
+
+func (s *Server) RegisterDevice(ctx context.Context, req *connect.Request[secretaryv1.RegisterDeviceRequest]) (*connect.Response[secretaryv1.RegisterDeviceResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	platform := pushPlatformNames[req.Msg.Platform]
+	if _, ok := s.pushSender(platform); !ok {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s push is not configured on this server", platform))
+	}
+	row, err := s.pushDevices.RegisterPushDevice(ctx, db.RegisterPushDeviceParams{
+		UserID:      int32(userID),
+		Platform:    platform,
+		Token:       req.Msg.Token,
+		Name:        req.Msg.Name,
+		MutedEvents: notificationEventsToNames(req.Msg.MutedEvents),
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to register device")
+	}
+	return connect.NewResponse(&secretaryv1.RegisterDeviceResponse{Device: pushDeviceToProto(row)}), nil
+}
+
+func (s *Server) ListDevices(ctx context.Context, req *connect.Request[secretaryv1.ListDevicesRequest]) (*connect.Response[secretaryv1.ListDevicesResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pushDevices.ListPushDevices(ctx, int32(userID))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list devices")
+	}
+	resp := &secretaryv1.ListDevicesResponse{Devices: make([]*secretaryv1.PushDevice, 0, len(rows))}
+	for _, row := range rows {
+		resp.Devices = append(resp.Devices, pushDeviceToProto(row))
+	}
+	for platform, name := range pushPlatformNames {
+		if _, ok := s.pushSender(name); ok {
+			resp.AvailablePlatforms = append(resp.AvailablePlatforms, platform)
+		}
+	}
+	sort.Slice(resp.AvailablePlatforms, func(i, j int) bool { return resp.AvailablePlatforms[i] < resp.AvailablePlatforms[j] })
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Server) UpdateDevice(ctx context.Context, req *connect.Request[secretaryv1.UpdateDeviceRequest]) (*connect.Response[secretaryv1.UpdateDeviceResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.pushDevices.UpdatePushDeviceMutedEvents(ctx, db.UpdatePushDeviceMutedEventsParams{
+		ID:          int32(req.Msg.Id),
+		UserID:      int32(userID),
+		MutedEvents: notificationEventsToNames(req.Msg.MutedEvents),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("device not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to update device")
+	}
+	return connect.NewResponse(&secretaryv1.UpdateDeviceResponse{Device: pushDeviceToProto(row)}), nil
+}
+
+func (s *Server) UnregisterDevice(ctx context.Context, req *connect.Request[secretaryv1.UnregisterDeviceRequest]) (*connect.Response[secretaryv1.UnregisterDeviceResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Unknown tokens are fine: the app signs out whether or not the
+	// server still had it.
+	if _, err := s.pushDevices.UnregisterPushDevice(ctx, db.UnregisterPushDeviceParams{Token: req.Msg.Token, UserID: int32(userID)}); err != nil {
+		return nil, apierr.Wrap(err, "failed to unregister device")
+	}
+	return connect.NewResponse(&secretaryv1.UnregisterDeviceResponse{}), nil
+}
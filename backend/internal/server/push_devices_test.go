@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/push"
+)
+
+// memoryPushDevices keeps devices in memory, applying the queries' muting
+// and ownership rules.
+type memoryPushDevices struct {
+	mu      sync.Mutex
+	devices []db.PushDevice
+	deleted chan string
+}
+
+func (m *memoryPushDevices) RegisterPushDevice(_ context.Context, arg db.RegisterPushDeviceParams) (db.PushDevice, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	device := db.PushDevice{ID: int32(len(m.devices) + 1), UserID: arg.UserID, Platform: arg.Platform, Token: arg.Token, Name: arg.Name, MutedEvents: arg.MutedEvents}
+	m.devices = append(m.devices, device)
+	return device, nil
+}
+
+func (m *memoryPushDevices) ListPushDevices(_ context.Context, userID int32) ([]db.PushDevice, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []db.PushDevice
+	for _, device := range m.devices {
+		if device.UserID == userID {
+			out = append(out, device)
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryPushDevices) UpdatePushDeviceMutedEvents(_ context.Context, arg db.UpdatePushDeviceMutedEventsParams) (db.PushDevice, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, device := range m.devices {
+		if device.ID == arg.ID && device.UserID == arg.UserID {
+			m.devices[i].MutedEvents = arg.MutedEvents
+			return m.devices[i], nil
+		}
+	}
+	return db.PushDevice{}, pgx.ErrNoRows
+}
+
+func (m *memoryPushDevices) UnregisterPushDevice(context.Context, db.UnregisterPushDeviceParams) (int64, error) {
+	return 0, nil
+}
+
+func (m *memoryPushDevices) ListPushTargets(_ context.Context, arg db.ListPushTargetsParams) ([]db.PushDevice, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []db.PushDevice
+	for _, device := range m.devices {
+		if slices.Contains(arg.UserIds, device.UserID) && !slices.Contains(device.MutedEvents, arg.Event) {
+			out = append(out, device)
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryPushDevices) DeletePushDeviceToken(_ context.Context, token string) error {
+	m.deleted <- token
+	return nil
+}
+
+// fakeSender hands every token it is sent to the test and rejects the
+// token "gone".
+type fakeSender struct {
+	sent chan string
+}
+
+func (fakeSender) Platform() string { return push.FCM }
+
+func (f fakeSender) Send(_ context.Context, token string, _ push.Notification) error {
+	if token == "gone" {
+		return push.ErrInvalidToken
+	}
+	f.sent <- token
+	return nil
+}
+
+func TestPushDevices(t *testing.T) {
+	devices := &memoryPushDevices{deleted: make(chan string, 1)}
+	sender := fakeSender{sent: make(chan string, 2)}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.pushDevices = devices
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	register := func(platform secretaryv1.PushPlatform, token string, muted ...secretaryv1.NotificationEvent) (*secretaryv1.PushDevice, error) {
+		resp, err := srv.RegisterDevice(ctx, connect.NewRequest(&secretaryv1.RegisterDeviceRequest{Platform: platform, Token: token, MutedEvents: muted}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Device, nil
+	}
+	if _, err := register(secretaryv1.PushPlatform_PUSH_PLATFORM_FCM, "pixel"); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("register without push configured: %v", err)
+	}
+
+	srv.ConfigurePush(sender)
+	if _, err := register(secretaryv1.PushPlatform_PUSH_PLATFORM_APNS, "iphone"); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("register for an unconfigured platform: %v", err)
+	}
+	pixel, err := register(secretaryv1.PushPlatform_PUSH_PLATFORM_FCM, "pixel")
+	if err != nil {
+		t.Fatalf("RegisterDevice: %v", err)
+	}
+	if _, err := register(secretaryv1.PushPlatform_PUSH_PLATFORM_FCM, "gone"); err != nil {
+		t.Fatalf("RegisterDevice: %v", err)
+	}
+
+	list, err := srv.ListDevices(ctx, connect.NewRequest(&secretaryv1.ListDevicesRequest{}))
+	if err != nil {
+		t.Fatalf("ListDevices: %v", err)
+	}
+	if len(list.Msg.Devices) != 2 || !slices.Equal(list.Msg.AvailablePlatforms, []secretaryv1.PushPlatform{secretaryv1.PushPlatform_PUSH_PLATFORM_FCM}) {
+		t.Fatalf("ListDevices = %v", list.Msg)
+	}
+
+	srv.pushToUsers(ctx, []int32{5}, notificationTodoAssigned, push.Notification{Title: "New todo for you"})
+	select {
+	case token := <-sender.sent:
+		if token != "pixel" {
+			t.Fatalf("pushed to %q", token)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("nothing pushed")
+	}
+	select {
+	case token := <-devices.deleted:
+		if token != "gone" {
+			t.Fatalf("forgot %q", token)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("rejected token was kept")
+	}
+
+	muted := []secretaryv1.NotificationEvent{secretaryv1.NotificationEvent_NOTIFICATION_EVENT_TODO_ASSIGNED}
+	updated, err := srv.UpdateDevice(ctx, connect.NewRequest(&secretaryv1.UpdateDeviceRequest{Id: pixel.Id, MutedEvents: muted}))
+	if err != nil {
+		t.Fatalf("UpdateDevice: %v", err)
+	}
+	if !slices.Equal(updated.Msg.Device.MutedEvents, muted) {
+		t.Fatalf("muted events = %v", updated.Msg.Device.MutedEvents)
+	}
+	srv.pushToUsers(ctx, []int32{5}, notificationTodoAssigned, push.Notification{Title: "New todo for you"})
+	select {
+	case token := <-sender.sent:
+		t.Fatalf("pushed to %q despite muting", token)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	other := withPrincipal(context.Background(), Principal{UserID: 6})
+	if _, err := srv.UpdateDevice(other, connect.NewRequest(&secretaryv1.UpdateDeviceRequest{Id: pixel.Id})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("updating someone else's device: %v", err)
+	}
+}
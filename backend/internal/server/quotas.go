@@ -0,0 +1,309 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+const (
+	quotaScopeOrganization = "organization"
+	quotaScopeUser         = "user"
+)
+
+// UsageLimits caps each usage metric; zero means unlimited. Transcription
+// and token limits apply per calendar month (UTC), storage and recordings
+// at any time.
+type UsageLimits struct {
+	TranscriptionSeconds int64
+	LLMTokens            int64
+	StorageBytes         int64
+	Recordings           int64
+}
+
+func (l UsageLimits) limit(metric string) int64 {
+	switch metric {
+	case usageTranscriptionSeconds:
+		return l.TranscriptionSeconds
+	case usageLLMTokens:
+		return l.LLMTokens
+	case usageStorageBytes:
+		return l.StorageBytes
+	case usageRecordings:
+		return l.Recordings
+	default:
+		return 0
+	}
+}
+
+func (l *UsageLimits) set(metric string, value int64) {
+	switch metric {
+	case usageTranscriptionSeconds:
+		l.TranscriptionSeconds = value
+	case usageLLMTokens:
+		l.LLMTokens = value
+	case usageStorageBytes:
+		l.StorageBytes = value
+	case usageRecordings:
+		l.Recordings = value
+	}
+}
+
+func (l UsageLimits) toProto() *secretaryv1.UsageLimits {
+	return &secretaryv1.UsageLimits{
+		TranscriptionSeconds: l.TranscriptionSeconds,
+		LlmTokens:            l.LLMTokens,
+		StorageBytes:         l.StorageBytes,
+		Recordings:           l.Recordings,
+	}
+}
+
+// UsageQuotas are the configured limits, enforced on upload,
+// transcription and AI turns. Admins can replace any of them at runtime
+// with SetQuotaOverride.
+type UsageQuotas struct {
+	Organization UsageLimits
+	PerUser      UsageLimits
+}
+
+// ConfigureQuotas sets the usage quotas. Nothing is enforced, overrides
+// included, until it is called.
+func (s *Server) ConfigureQuotas(quotas UsageQuotas) {
+	s.quotas = &quotas
+}
+
+// effectiveQuotas are the configured limits with overrides applied.
+type effectiveQuotas struct {
+	organization UsageLimits
+	user         UsageLimits
+	overrides    []db.QuotaOverride
+}
+
+// quotaLimits returns the organization limits and, when userID is valid,
+// that user's limits.
+func (s *Server) quotaLimits(ctx context.Context, userID pgtype.Int4) (effectiveQuotas, error) {
+	if s.quotas == nil {
+		return effectiveQuotas{}, nil
+	}
+	overrides, err := s.usage.ListQuotaOverrides(ctx)
+	if err != nil {
+		return effectiveQuotas{}, apierr.Wrap(err, "failed to load quota overrides")
+	}
+	limits := effectiveQuotas{organization: s.quotas.Organization, user: s.quotas.PerUser, overrides: overrides}
+	for _, override := range overrides {
+		switch {
+		case !override.UserID.Valid:
+			limits.organization.set(override.Metric, override.LimitValue)
+		case userID.Valid && override.UserID.Int32 == userID.Int32:
+			limits.user.set(override.Metric, override.LimitValue)
+		}
+	}
+	return limits, nil
+}
+
+// checkQuota returns CodeResourceExhausted if the organization, or user
+// when valid, has reached its limit for metric or would pass it by adding
+// more.
+func (s *Server) checkQuota(ctx context.Context, userID pgtype.Int4, metric string, adding int64) error {
+	if s.quotas == nil {
+		return nil
+	}
+	limits, err := s.quotaLimits(ctx, userID)
+	if err != nil {
+		return err
+	}
+	scopes := []struct {
+		name  string
+		limit int64
+		user  pgtype.Int4
+	}{
+		{quotaScopeOrganization, limits.organization.limit(metric), pgtype.Int4{}},
+		{quotaScopeUser, limits.user.limit(metric), userID},
+	}
+	for _, scope := range scopes {
+		if scope.limit <= 0 || (scope.name == quotaScopeUser && !scope.user.Valid) {
+			continue
+		}
+		used, err := s.currentUsage(ctx, metric, scope.user)
+		if err != nil {
+			return apierr.Wrap(err, "failed to check usage quota")
+		}
+		if used >= scope.limit || used+adding > scope.limit {
+			return apierr.QuotaExceeded(fmt.Sprintf("%s %s quota exceeded", scope.name, metric), metric, scope.name, scope.limit, used)
+		}
+	}
+	return nil
+}
+
+func (s *Server) currentUsage(ctx context.Context, metric string, userID pgtype.Int4) (int64, error) {
+	switch metric {
+	case usageStorageBytes:
+		return s.usage.SumStorageBytes(ctx, userID)
+	case usageRecordings:
+		return s.usage.CountRecordings(ctx, userID)
+	}
+	start, end := monthBounds(time.Now())
+	rows, err := s.usage.SumUsage(ctx, db.SumUsageParams{
+		StartAt: pgtype.Timestamptz{Time: start, Valid: true},
+		EndAt:   pgtype.Timestamptz{Time: end, Valid: true},
+		UserID:  userID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, row := range rows {
+		if row.Metric == metric {
+			return row.Total, nil
+		}
+	}
+	return 0, nil
+}
+
+// checkUploadQuota checks there is room for one more recording before any
+// audio is accepted.
+func (s *Server) checkUploadQuota(ctx context.Context, userID pgtype.Int4) error {
+	if err := s.checkQuota(ctx, userID, usageRecordings, 1); err != nil {
+		return err
+	}
+	return s.checkQuota(ctx, userID, usageStorageBytes, 0)
+}
+
+// checkTranscriptionQuota checks that transcribing the recording fits in
+// its creator's and the organization's monthly minutes.
+func (s *Server) checkTranscriptionQuota(ctx context.Context, recordingID int32) error {
+	if s.quotas == nil {
+		return nil
+	}
+	owner, err := s.usage.GetRecordingUsageOwner(ctx, recordingID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return apierr.Wrap(err, "failed to check usage quota")
+	}
+	return s.checkQuota(ctx, owner.CreatedByUserID, usageTranscriptionSeconds, int64(owner.Duration))
+}
+
+// writeQuotaError reports a checkQuota failure from a plain HTTP handler.
+func writeQuotaError(w http.ResponseWriter, err error) {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) && connectErr.Code() == connect.CodeResourceExhausted {
+		writeError(w, http.StatusTooManyRequests, connectErr.Message())
+		return
+	}
+	writeError(w, http.StatusInternalServerError, "failed to check usage quota")
+}
+
+func mapUsageMetric(metric string) secretaryv1.UsageMetric {
+	switch metric {
+	case usageTranscriptionSeconds:
+		return secretaryv1.UsageMetric_USAGE_METRIC_TRANSCRIPTION_SECONDS
+	case usageLLMTokens:
+		return secretaryv1.UsageMetric_USAGE_METRIC_LLM_TOKENS
+	case usageStorageBytes:
+		return secretaryv1.UsageMetric_USAGE_METRIC_STORAGE_BYTES
+	case usageRecordings:
+		return secretaryv1.UsageMetric_USAGE_METRIC_RECORDINGS
+	default:
+		return secretaryv1.UsageMetric_USAGE_METRIC_UNSPECIFIED
+	}
+}
+
+func mapUsageMetricToString(metric secretaryv1.UsageMetric) string {
+	switch metric {
+	case secretaryv1.UsageMetric_USAGE_METRIC_TRANSCRIPTION_SECONDS:
+		return usageTranscriptionSeconds
+	case secretaryv1.UsageMetric_USAGE_METRIC_LLM_TOKENS:
+		return usageLLMTokens
+	case secretaryv1.UsageMetric_USAGE_METRIC_STORAGE_BYTES:
+		return usageStorageBytes
+	case secretaryv1.UsageMetric_USAGE_METRIC_RECORDINGS:
+		return usageRecordings
+	default:
+		return ""
+	}
+}
+
+func quotaOverrideToProto(row db.QuotaOverride) *secretaryv1.QuotaOverride {
+	return &secretaryv1.QuotaOverride{
+		UserId:      int64(row.UserID.Int32),
+		Metric:      mapUsageMetric(row.Metric),
+		Limit:       row.LimitValue,
+		Reason:      row.Reason,
+		ExpiresAt:   formatTime(row.ExpiresAt),
+		SetByUserId: int64(row.SetByUserID.Int32),
+		CreatedAt:   formatTime(row.CreatedAt),
+	}
+}
+
+func (s *Server) listQuotaOverrides(ctx context.Context) ([]*secretaryv1.QuotaOverride, error) {
+	rows, err := s.usage.ListQuotaOverrides(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list quota overrides")
+	}
+	overrides := make([]*secretaryv1.QuotaOverride, 0, len(rows))
+	for _, row := range rows {
+		overrides = append(overrides, quotaOverrideToProto(row))
+	}
+	return overrides, nil
+}
+
+// SetQuotaOverride replaces the configured limit for one metric, for the
+// organization or a single user, e.g. to let a customer finish a large
+// import. Setting an override again replaces it.
+func (s *Server) SetQuotaOverride(ctx context.Context, req *connect.Request[secretaryv1.SetQuotaOverrideRequest]) (*connect.Response[secretaryv1.SetQuotaOverrideResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can override quotas"); err != nil {
+		return nil, err
+	}
+	adminID := currentUserID(ctx)
+	expiresAt, err := parseOptionalTimestamp(req.Msg.ExpiresAt)
+	if err != nil {
+		return nil, apierr.InvalidField("expires_at", "must be an RFC 3339 timestamp")
+	}
+	if expiresAt.Valid && !expiresAt.Time.After(time.Now()) {
+		return nil, apierr.InvalidField("expires_at", "must be in the future")
+	}
+	if err := s.usage.UpsertQuotaOverride(ctx, db.UpsertQuotaOverrideParams{
+		UserID:      pgtype.Int4{Int32: int32(req.Msg.UserId), Valid: req.Msg.UserId > 0},
+		Metric:      mapUsageMetricToString(req.Msg.Metric),
+		LimitValue:  req.Msg.Limit,
+		Reason:      strings.TrimSpace(req.Msg.Reason),
+		ExpiresAt:   expiresAt,
+		SetByUserID: pgtype.Int4{Int32: int32(adminID), Valid: true},
+	}); err != nil {
+		return nil, apierr.Wrap(err, "failed to set quota override")
+	}
+	overrides, err := s.listQuotaOverrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.SetQuotaOverrideResponse{Overrides: overrides}), nil
+}
+
+// DeleteQuotaOverride restores the configured limit.
+func (s *Server) DeleteQuotaOverride(ctx context.Context, req *connect.Request[secretaryv1.DeleteQuotaOverrideRequest]) (*connect.Response[secretaryv1.DeleteQuotaOverrideResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can override quotas"); err != nil {
+		return nil, err
+	}
+	if _, err := s.usage.DeleteQuotaOverride(ctx, db.DeleteQuotaOverrideParams{
+		UserID: pgtype.Int4{Int32: int32(req.Msg.UserId), Valid: req.Msg.UserId > 0},
+		Metric: mapUsageMetricToString(req.Msg.Metric),
+	}); err != nil {
+		return nil, apierr.Wrap(err, "failed to delete quota override")
+	}
+	overrides, err := s.listQuotaOverrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.DeleteQuotaOverrideResponse{Overrides: overrides}), nil
+}
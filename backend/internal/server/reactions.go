@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// maxReactionEmoji caps how many different emoji one todo or comment can
+// collect, which keeps list responses small.
+const maxReactionEmoji = 20
+
+// ReactionStore holds the queries behind emoji reactions to todos and
+// comments.
+type ReactionStore interface {
+	AddReaction(ctx context.Context, arg db.AddReactionParams) error
+	RemoveReaction(ctx context.Context, arg db.RemoveReactionParams) error
+	ListReactions(ctx context.Context, arg db.ListReactionsParams) ([]db.ListReactionsRow, error)
+}
+
+// validEmoji reports whether value is made of emoji only, counting the
+// joiners, skin tones and presentation selectors of sequences such as
+// 👍🏽 or 👩‍💻, and keycaps such as 1️⃣.
+func validEmoji(value string) bool {
+	runes := []rune(value)
+	symbols := 0
+	for i, r := range runes {
+		switch {
+		case unicode.Is(unicode.So, r):
+			symbols++
+		case r == '\u200d' || r == '\ufe0f' || r == '\u20e3',
+			r >= 0x1f3fb && r <= 0x1f3ff,
+			r >= 0xe0020 && r <= 0xe007f:
+		case strings.ContainsRune("0123456789#*", r) && i+1 < len(runes) && (runes[i+1] == '\ufe0f' || runes[i+1] == '\u20e3'):
+			symbols++
+		default:
+			return false
+		}
+	}
+	return symbols > 0
+}
+
+// reactionsByTarget holds the reactions to some todos and comments, by
+// todo and by comment.
+type reactionsByTarget struct {
+	todos    map[int32][]*secretaryv1.Reaction
+	comments map[int32][]*secretaryv1.Reaction
+}
+
+// listReactions fetches the reactions to the given todos and comments,
+// marking those the caller made.
+func (s *Server) listReactions(ctx context.Context, todoIDs, commentIDs []int32) (reactionsByTarget, error) {
+	found := reactionsByTarget{todos: map[int32][]*secretaryv1.Reaction{}, comments: map[int32][]*secretaryv1.Reaction{}}
+	if len(todoIDs) == 0 && len(commentIDs) == 0 {
+		return found, nil
+	}
+	if todoIDs == nil {
+		todoIDs = []int32{}
+	}
+	if commentIDs == nil {
+		commentIDs = []int32{}
+	}
+	rows, err := s.reactions.ListReactions(ctx, db.ListReactionsParams{TodoIds: todoIDs, CommentIds: commentIDs})
+	if err != nil {
+		return found, apierr.Wrap(err, "failed to list reactions")
+	}
+	caller := int32(currentUserID(ctx))
+	for _, row := range rows {
+		reaction := &secretaryv1.Reaction{Emoji: row.Emoji, Reacted: caller != 0 && slices.Contains(row.UserIds, caller)}
+		for _, id := range row.UserIds {
+			reaction.UserIds = append(reaction.UserIds, int64(id))
+		}
+		if row.TodoID.Valid {
+			found.todos[row.TodoID.Int32] = append(found.todos[row.TodoID.Int32], reaction)
+		} else {
+			found.comments[row.CommentID.Int32] = append(found.comments[row.CommentID.Int32], reaction)
+		}
+	}
+	return found, nil
+}
+
+// reactionTarget checks that the todo or comment a reaction request names
+// exists and returns its reactions.
+func (s *Server) reactionTarget(ctx context.Context, todoID, commentID int64) (pgtype.Int4, pgtype.Int4, []*secretaryv1.Reaction, error) {
+	if todoID > 0 {
+		_, err := s.todos.GetTodo(ctx, int32(todoID))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgtype.Int4{}, pgtype.Int4{}, nil, connect.NewError(connect.CodeNotFound, errors.New("todo not found"))
+		}
+		if err != nil {
+			return pgtype.Int4{}, pgtype.Int4{}, nil, apierr.Wrap(err, "failed to fetch todo")
+		}
+		found, err := s.listReactions(ctx, []int32{int32(todoID)}, nil)
+		return pgtype.Int4{Int32: int32(todoID), Valid: true}, pgtype.Int4{}, found.todos[int32(todoID)], err
+	}
+	_, err := s.comments.GetTodoComment(ctx, int32(commentID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return pgtype.Int4{}, pgtype.Int4{}, nil, connect.NewError(connect.CodeNotFound, errors.New("comment not found"))
+	}
+	if err != nil {
+		return pgtype.Int4{}, pgtype.Int4{}, nil, apierr.Wrap(err, "failed to fetch comment")
+	}
+	found, err := s.listReactions(ctx, nil, []int32{int32(commentID)})
+	return pgtype.Int4{}, pgtype.Int4{Int32: int32(commentID), Valid: true}, found.comments[int32(commentID)], err
+}
+
+// reactionsAfter refetches the reactions to a target after a change.
+func (s *Server) reactionsAfter(ctx context.Context, todoID, commentID pgtype.Int4) ([]*secretaryv1.Reaction, error) {
+	if todoID.Valid {
+		found, err := s.listReactions(ctx, []int32{todoID.Int32}, nil)
+		return found.todos[todoID.Int32], err
+	}
+	found, err := s.listReactions(ctx, nil, []int32{commentID.Int32})
+	return found.comments[commentID.Int32], err
+}
+
+// --- TodosService reaction methods ---
+
+func (s *Server) AddReaction(ctx context.Context, req *connect.Request[secretaryv1.AddReactionRequest]) (*connect.Response[secretaryv1.AddReactionResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	if !validEmoji(msg.Emoji) {
+		return nil, apierr.InvalidField("emoji", "must be an emoji")
+	}
+	todoID, commentID, current, err := s.reactionTarget(ctx, msg.TodoId, msg.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	used := slices.ContainsFunc(current, func(r *secretaryv1.Reaction) bool { return r.Emoji == msg.Emoji })
+	if !used && len(current) >= maxReactionEmoji {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("a todo or comment can have at most %d different reactions", maxReactionEmoji))
+	}
+	if err := s.reactions.AddReaction(ctx, db.AddReactionParams{UserID: int32(userID), TodoID: todoID, CommentID: commentID, Emoji: msg.Emoji}); err != nil {
+		return nil, apierr.Wrap(err, "failed to add reaction")
+	}
+	reactions, err := s.reactionsAfter(ctx, todoID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.AddReactionResponse{Reactions: reactions}), nil
+}
+
+// RemoveReaction takes back the caller's reaction; removing one they
+// didn't make changes nothing.
+func (s *Server) RemoveReaction(ctx context.Context, req *connect.Request[secretaryv1.RemoveReactionRequest]) (*connect.Response[secretaryv1.RemoveReactionResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	todoID, commentID, _, err := s.reactionTarget(ctx, msg.TodoId, msg.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.reactions.RemoveReaction(ctx, db.RemoveReactionParams{UserID: int32(userID), Emoji: msg.Emoji, TodoID: todoID, CommentID: commentID}); err != nil {
+		return nil, apierr.Wrap(err, "failed to remove reaction")
+	}
+	reactions, err := s.reactionsAfter(ctx, todoID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.RemoveReactionResponse{Reactions: reactions}), nil
+}
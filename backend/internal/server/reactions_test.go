@@ -0,0 +1,180 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// fakeReaction is one user's emoji on a todo or comment.
+type fakeReaction struct {
+	userID, todoID, commentID int32
+	emoji                     string
+}
+
+// fakeReactions keeps reactions in the order they were made.
+type fakeReactions struct{ rows []fakeReaction }
+
+func reactionKey(userID int32, todoID, commentID pgtype.Int4, emoji string) fakeReaction {
+	return fakeReaction{userID: userID, todoID: todoID.Int32, commentID: commentID.Int32, emoji: emoji}
+}
+
+func (f *fakeReactions) AddReaction(_ context.Context, arg db.AddReactionParams) error {
+	key := reactionKey(arg.UserID, arg.TodoID, arg.CommentID, arg.Emoji)
+	if !slices.Contains(f.rows, key) {
+		f.rows = append(f.rows, key)
+	}
+	return nil
+}
+
+func (f *fakeReactions) RemoveReaction(_ context.Context, arg db.RemoveReactionParams) error {
+	key := reactionKey(arg.UserID, arg.TodoID, arg.CommentID, arg.Emoji)
+	f.rows = slices.DeleteFunc(f.rows, func(r fakeReaction) bool { return r == key })
+	return nil
+}
+
+func (f *fakeReactions) ListReactions(_ context.Context, arg db.ListReactionsParams) ([]db.ListReactionsRow, error) {
+	var rows []db.ListReactionsRow
+	for _, r := range f.rows {
+		if !(r.todoID != 0 && slices.Contains(arg.TodoIds, r.todoID)) && !(r.commentID != 0 && slices.Contains(arg.CommentIds, r.commentID)) {
+			continue
+		}
+		i := slices.IndexFunc(rows, func(row db.ListReactionsRow) bool {
+			return row.TodoID.Int32 == r.todoID && row.CommentID.Int32 == r.commentID && row.Emoji == r.emoji
+		})
+		if i < 0 {
+			rows = append(rows, db.ListReactionsRow{
+				TodoID:    pgtype.Int4{Int32: r.todoID, Valid: r.todoID != 0},
+				CommentID: pgtype.Int4{Int32: r.commentID, Valid: r.commentID != 0},
+				Emoji:     r.emoji,
+			})
+			i = len(rows) - 1
+		}
+		rows[i].UserIds = append(rows[i].UserIds, r.userID)
+	}
+	return rows, nil
+}
+
+func TestValidEmoji(t *testing.T) {
+	for value, want := range map[string]bool{
+		"👍":       true,
+		"👍🏽":      true,
+		"👩🏽‍💻":    true,
+		"❤️":      true,
+		"1️⃣":     true,
+		"🏴󠁧󠁢󠁳󠁣󠁴󠁿": true,
+		"👍👍":      true,
+		"":        false,
+		"ok":      false,
+		"1":       false,
+		"👍 ":      false,
+		"\u200d":  false,
+	} {
+		if got := validEmoji(value); got != want {
+			t.Errorf("validEmoji(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
+
+func TestReactions(t *testing.T) {
+	reactions := &fakeReactions{}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, &fakeTodos{todos: map[int32]db.GetTodoRow{3: {ID: 3, Name: "Ship the deck"}}}, nil)
+	srv.comments = &fakeComments{rows: map[int32]db.TodoComment{1: {ID: 1, TodoID: 3, Body: "Done?"}}, mentioned: map[int32][]int32{}}
+	srv.favorites = newFakeFavorites()
+	srv.reactions = reactions
+	ana := withPrincipal(context.Background(), Principal{UserID: 5})
+	will := withPrincipal(context.Background(), Principal{UserID: 6})
+
+	add := func(ctx context.Context, req *secretaryv1.AddReactionRequest) ([]*secretaryv1.Reaction, error) {
+		res, err := srv.AddReaction(ctx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg.Reactions, nil
+	}
+	if _, err := add(ana, &secretaryv1.AddReactionRequest{TodoId: 4, Emoji: "👍"}); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("unknown todo: %v", err)
+	}
+	if _, err := add(ana, &secretaryv1.AddReactionRequest{CommentId: 2, Emoji: "👍"}); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("unknown comment: %v", err)
+	}
+	if _, err := add(ana, &secretaryv1.AddReactionRequest{TodoId: 3, Emoji: "ok"}); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("text reaction: %v", err)
+	}
+
+	// Reacting twice with the same emoji counts once.
+	for range 2 {
+		if _, err := add(ana, &secretaryv1.AddReactionRequest{TodoId: 3, Emoji: "👍"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := add(will, &secretaryv1.AddReactionRequest{TodoId: 3, Emoji: "👍"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []*secretaryv1.Reaction{{Emoji: "👍", UserIds: []int64{5, 6}, Reacted: true}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("reactions = %v, want %v", got, want)
+	}
+	if _, err := add(will, &secretaryv1.AddReactionRequest{CommentId: 1, Emoji: "🎉"}); err != nil {
+		t.Fatal(err)
+	}
+
+	todo, err := srv.GetTodo(ana, connect.NewRequest(&secretaryv1.GetTodoRequest{Id: 3}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []*secretaryv1.Reaction{{Emoji: "👍", UserIds: []int64{5, 6}, Reacted: true}}; !reflect.DeepEqual(todo.Msg.Todo.Reactions, want) {
+		t.Fatalf("todo reactions = %v", todo.Msg.Todo.Reactions)
+	}
+	comments, err := srv.ListTodoComments(ana, connect.NewRequest(&secretaryv1.ListTodoCommentsRequest{TodoId: 3}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []*secretaryv1.Reaction{{Emoji: "🎉", UserIds: []int64{6}}}; !reflect.DeepEqual(comments.Msg.Comments[0].Reactions, want) {
+		t.Fatalf("comment reactions = %v", comments.Msg.Comments[0].Reactions)
+	}
+
+	// Removing takes back only the caller's reaction, and removing one
+	// that isn't there is fine.
+	for range 2 {
+		res, err := srv.RemoveReaction(ana, connect.NewRequest(&secretaryv1.RemoveReactionRequest{TodoId: 3, Emoji: "👍"}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []*secretaryv1.Reaction{{Emoji: "👍", UserIds: []int64{6}}}; !reflect.DeepEqual(res.Msg.Reactions, want) {
+			t.Fatalf("reactions after removing = %v", res.Msg.Reactions)
+		}
+	}
+}
+
+func TestReactionLimit(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, &fakeTodos{todos: map[int32]db.GetTodoRow{3: {ID: 3}}}, nil)
+	srv.reactions = &fakeReactions{}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	emoji := []rune("😀")[0]
+	for i := range maxReactionEmoji {
+		req := &secretaryv1.AddReactionRequest{TodoId: 3, Emoji: string(emoji + rune(i))}
+		if _, err := srv.AddReaction(ctx, connect.NewRequest(req)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := srv.AddReaction(ctx, connect.NewRequest(&secretaryv1.AddReactionRequest{TodoId: 3, Emoji: "👍"})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("one emoji too many: %v", err)
+	}
+	// Joining in on an emoji already there is still allowed.
+	other := withPrincipal(context.Background(), Principal{UserID: 6})
+	if _, err := srv.AddReaction(other, connect.NewRequest(&secretaryv1.AddReactionRequest{TodoId: 3, Emoji: "😀"})); err != nil {
+		t.Fatal(err)
+	}
+}
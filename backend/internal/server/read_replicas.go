@@ -0,0 +1,22 @@
+package server
+
+import (
+	dbconn "github.com/mvult/secretary/backend/internal/db"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// ConfigureReadReplicas routes list and get RPCs through router. Without
+// it every query goes to the primary.
+func (s *Server) ConfigureReadReplicas(router *dbconn.ReadRouter) {
+	s.reads = router
+}
+
+// readQueries returns the queries for a read-only RPC. Only use it when
+// the result may lag the primary slightly: never after a write in the same
+// request, and never for checks that guard a write.
+func (s *Server) readQueries() *db.Queries {
+	if s.reads == nil {
+		return s.queries
+	}
+	return db.New(s.reads.Reader())
+}
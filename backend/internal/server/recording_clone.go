@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// CloneRecording copies a recording into a new one that the transcription
+// worker picks up like a fresh upload. Only the metadata and the audio
+// reference are copied; the transcript, summary, todos and everything else
+// derived from the audio stay with the original.
+func (s *Server) CloneRecording(ctx context.Context, req *connect.Request[secretaryv1.CloneRecordingRequest]) (*connect.Response[secretaryv1.CloneRecordingResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	row, err := s.recordings.GetRecording(ctx, int32(msg.Id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch recording")
+	}
+	if !row.AudioKey.Valid {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("recording has no stored audio to process again"))
+	}
+	switch row.ScanStatus {
+	case scanPending:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("recording audio is still being scanned"))
+	case scanQuarantined:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("recording audio is quarantined"))
+	}
+
+	name := strings.TrimSpace(msg.Name)
+	if name == "" && row.Name.String != "" {
+		name = row.Name.String + " (copy)"
+	}
+	settings := msg.Settings
+	if settings == nil {
+		if settings, err = decodeProcessingSettings(row.ProcessingSettings, row.PromptTemplateID); err != nil {
+			return nil, err
+		}
+	}
+	if msg.Language != nil {
+		settings.Language = *msg.Language
+	}
+	if msg.PromptTemplateId != nil {
+		settings.PromptTemplateId = *msg.PromptTemplateId
+	}
+	languageField := "settings.language"
+	if msg.Language != nil {
+		languageField = "language"
+	}
+	if settings, err = s.resolveProcessingSettings(ctx, languageField, settings); err != nil {
+		return nil, err
+	}
+	data, template, err := encodeProcessingSettings(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := pgtype.Int4{Int32: int32(userID), Valid: true}
+	if err := s.checkQuota(ctx, owner, usageRecordings, 1); err != nil {
+		return nil, err
+	}
+	if err := s.checkQuota(ctx, owner, usageTranscriptionSeconds, int64(row.Duration.Int32)); err != nil {
+		return nil, err
+	}
+	id, err := s.recordings.CloneRecording(ctx, db.CloneRecordingParams{
+		ID:                 row.ID,
+		Name:               optionalText(name),
+		CreatedByUserID:    owner,
+		PromptTemplateID:   template,
+		ProcessingSettings: data,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to clone recording")
+	}
+	rec, err := s.refreshedRecording(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.CloneRecordingResponse{Recording: rec}), nil
+}
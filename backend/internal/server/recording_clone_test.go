@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// clonedRecordings keeps recordings by ID and clones them the way the
+// CloneRecording query does.
+type clonedRecordings struct {
+	*fakeRecordingStatus
+	rows map[int32]db.GetRecordingRow
+}
+
+func (c *clonedRecordings) GetRecording(_ context.Context, id int32) (db.GetRecordingRow, error) {
+	row, ok := c.rows[id]
+	if !ok {
+		return db.GetRecordingRow{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (c *clonedRecordings) CloneRecording(_ context.Context, arg db.CloneRecordingParams) (int32, error) {
+	src, ok := c.rows[arg.ID]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	id := int32(len(c.rows) + 1)
+	c.rows[id] = db.GetRecordingRow{
+		ID:                    id,
+		Name:                  arg.Name,
+		AudioKey:              src.AudioKey,
+		Duration:              src.Duration,
+		Status:                recordingUploaded,
+		ScanStatus:            src.ScanStatus,
+		PromptTemplateID:      arg.PromptTemplateID,
+		ProcessingSettings:    arg.ProcessingSettings,
+		ClonedFromRecordingID: pgtype.Int4{Int32: src.ID, Valid: true},
+	}
+	return id, nil
+}
+
+func TestCloneRecording(t *testing.T) {
+	recordings := &clonedRecordings{fakeRecordingStatus: &fakeRecordingStatus{}, rows: map[int32]db.GetRecordingRow{
+		1: {ID: 1, Name: optionalText("Weekly sync"), AudioKey: optionalText("recordings/1.webm"), Status: recordingReady, ScanStatus: scanClean, ProcessingSettings: []byte(`{"language": "en", "disableDiarization": true}`), PromptTemplateID: pgtype.Int4{Int32: 3, Valid: true}},
+		2: {ID: 2, Name: optionalText("Imported"), AudioUrl: optionalText("https://example.com/2.mp3"), Status: recordingReady},
+		3: {ID: 3, AudioKey: optionalText("recordings/3.webm"), Status: recordingUploaded, ScanStatus: scanPending},
+	}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(recordings, nil, memberUsers{})
+	srv.prompts = &fakePrompts{rows: map[int32]db.PromptTemplate{3: {ID: 3, Name: "Sales call"}}}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	clone := func(msg *secretaryv1.CloneRecordingRequest) (*secretaryv1.Recording, error) {
+		resp, err := srv.CloneRecording(ctx, connect.NewRequest(msg))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Recording, nil
+	}
+	if _, err := srv.CloneRecording(context.Background(), connect.NewRequest(&secretaryv1.CloneRecordingRequest{Id: 1})); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("signed out: %v", err)
+	}
+	if _, err := clone(&secretaryv1.CloneRecordingRequest{Id: 9}); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("missing recording: %v", err)
+	}
+	for _, id := range []int64{2, 3} {
+		if _, err := clone(&secretaryv1.CloneRecordingRequest{Id: id}); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Fatalf("recording %d: %v", id, err)
+		}
+	}
+	bad := "not a language"
+	if _, err := clone(&secretaryv1.CloneRecordingRequest{Id: 1, Language: &bad}); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("bad language: %v", err)
+	}
+
+	missing := int64(4)
+	if _, err := clone(&secretaryv1.CloneRecordingRequest{Id: 1, PromptTemplateId: &missing}); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("missing prompt template: %v", err)
+	}
+
+	rec, err := clone(&secretaryv1.CloneRecordingRequest{Id: 1})
+	if err != nil || rec.Name != "Weekly sync (copy)" || rec.Language != "en" || !rec.ProcessingSettings.DisableDiarization || rec.PromptTemplateId != 3 || rec.ClonedFromRecordingId != 1 || rec.Status != secretaryv1.RecordingStatus_RECORDING_STATUS_UPLOADED || !rec.HasAudio {
+		t.Fatalf("clone with the original's settings: %+v, %v", rec, err)
+	}
+
+	lang, builtin := "PT-br", int64(0)
+	rec, err = clone(&secretaryv1.CloneRecordingRequest{Id: 1, Name: " Sync in Portuguese ", Language: &lang, PromptTemplateId: &builtin})
+	if err != nil || rec.Name != "Sync in Portuguese" || rec.Language != "pt-BR" || rec.PromptTemplateId != 0 {
+		t.Fatalf("clone with other settings: %+v, %v", rec, err)
+	}
+	rec, err = clone(&secretaryv1.CloneRecordingRequest{Id: 1, Settings: &secretaryv1.ProcessingSettings{Language: "es"}, Language: &lang})
+	if err != nil || rec.Language != "pt-BR" || rec.ProcessingSettings.DisableDiarization || rec.PromptTemplateId != 0 {
+		t.Fatalf("clone with new settings: %+v, %v", rec, err)
+	}
+	if original := recordings.rows[1]; original.Status != recordingReady || original.PromptTemplateID.Int32 != 3 {
+		t.Fatalf("original changed: %+v", original)
+	}
+}
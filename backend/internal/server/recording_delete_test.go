@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// storedRecordings keeps recordings' audio, clip and attachment keys in
+// memory, sharing audio the way clones and deduplicated uploads do.
+type storedRecordings struct {
+	RecordingStore
+	audio       map[int32]string
+	clips       map[int32][]string
+	attachments map[int32][]string
+}
+
+func (r *storedRecordings) DeleteRecording(_ context.Context, id int32) (db.DeleteRecordingRow, error) {
+	key, ok := r.audio[id]
+	if !ok {
+		return db.DeleteRecordingRow{}, pgx.ErrNoRows
+	}
+	delete(r.audio, id)
+	for _, other := range r.audio {
+		if other == key {
+			key = ""
+		}
+	}
+	return db.DeleteRecordingRow{AudioKey: key, ClipAudioKeys: r.clips[id], AttachmentKeys: r.attachments[id]}, nil
+}
+
+func (r *storedRecordings) GetRecording(context.Context, int32) (db.GetRecordingRow, error) {
+	return db.GetRecordingRow{}, pgx.ErrNoRows
+}
+
+func TestDeleteRecordingRemovesStoredObjects(t *testing.T) {
+	ctx := context.Background()
+	local, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"recordings/a.wav", "recordings/shared.wav", "clips/a-1.wav", "attachments/a/notes.pdf"} {
+		if _, err := local.Put(ctx, key, strings.NewReader("data")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Recording 2 is a clone of 3, sharing its audio.
+	recordings := &storedRecordings{
+		audio:       map[int32]string{1: "recordings/a.wav", 2: "recordings/shared.wav", 3: "recordings/shared.wav"},
+		clips:       map[int32][]string{1: {"clips/a-1.wav"}},
+		attachments: map[int32][]string{1: {"attachments/a/notes.pdf"}},
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(recordings, nil, adminUsers{})
+	srv.ConfigureStorage(local)
+	admin := withPrincipal(ctx, Principal{UserID: 1})
+	del := func(id int64) {
+		t.Helper()
+		if _, err := srv.DeleteRecording(admin, connect.NewRequest(&secretaryv1.DeleteRecordingRequest{Id: id})); err != nil {
+			t.Fatalf("delete %d: %v", id, err)
+		}
+	}
+	exists := func(key string) bool {
+		rc, err := local.Open(ctx, key)
+		if err == nil {
+			rc.Close()
+		}
+		return !errors.Is(err, storage.ErrNotFound)
+	}
+
+	del(1)
+	for _, key := range []string{"recordings/a.wav", "clips/a-1.wav", "attachments/a/notes.pdf"} {
+		if exists(key) {
+			t.Errorf("%s is still stored", key)
+		}
+	}
+	del(2)
+	if !exists("recordings/shared.wav") {
+		t.Fatal("deleted audio the original still plays")
+	}
+	del(3)
+	if exists("recordings/shared.wav") {
+		t.Fatal("shared audio is still stored once no recording uses it")
+	}
+	// Deleting it again is a no-op.
+	del(3)
+}
@@ -0,0 +1,185 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// Processing stages and attempt statuses, as stored in
+// recording_processing_attempt.
+const (
+	stageTranscription = "transcription"
+	stageSummarization = "summarization"
+
+	attemptPending   = "pending"
+	attemptRunning   = "running"
+	attemptSucceeded = "succeeded"
+	attemptFailed    = "failed"
+)
+
+// stageForStatus returns the stage a recording is in while it has status,
+// or "" for statuses outside processing.
+func stageForStatus(status string) string {
+	switch status {
+	case recordingTranscribing:
+		return stageTranscription
+	case recordingSummarizing:
+		return stageSummarization
+	default:
+		return ""
+	}
+}
+
+// startAttempt opens an attempt for stage. Retries requested by an admin
+// start out pending; otherwise a pending attempt is promoted to running, or
+// a running one is created when none is open.
+func startAttempt(ctx context.Context, q RecordingQueries, id int32, stage string, requestedBy pgtype.Int4) error {
+	if !requestedBy.Valid {
+		started, err := q.StartPendingProcessingAttempt(ctx, db.StartPendingProcessingAttemptParams{RecordingID: id, Stage: stage})
+		if err != nil {
+			return apierr.Wrap(err, "failed to start processing attempt")
+		}
+		if started > 0 {
+			return nil
+		}
+		open, err := q.HasOpenProcessingAttempt(ctx, db.HasOpenProcessingAttemptParams{RecordingID: id, Stage: stage})
+		if err != nil {
+			return apierr.Wrap(err, "failed to start processing attempt")
+		}
+		if open {
+			return nil
+		}
+	}
+	status := attemptRunning
+	if requestedBy.Valid {
+		status = attemptPending
+	}
+	if err := q.CreateProcessingAttempt(ctx, db.CreateProcessingAttemptParams{
+		RecordingID:       id,
+		Stage:             stage,
+		Status:            status,
+		RequestedByUserID: requestedBy,
+	}); err != nil {
+		return apierr.Wrap(err, "failed to start processing attempt")
+	}
+	return nil
+}
+
+func mapProcessingStage(stage string) secretaryv1.ProcessingStage {
+	switch stage {
+	case stageTranscription:
+		return secretaryv1.ProcessingStage_PROCESSING_STAGE_TRANSCRIPTION
+	case stageSummarization:
+		return secretaryv1.ProcessingStage_PROCESSING_STAGE_SUMMARIZATION
+	default:
+		return secretaryv1.ProcessingStage_PROCESSING_STAGE_UNSPECIFIED
+	}
+}
+
+func mapAttemptStatus(status string) secretaryv1.ProcessingAttemptStatus {
+	switch status {
+	case attemptPending:
+		return secretaryv1.ProcessingAttemptStatus_PROCESSING_ATTEMPT_STATUS_PENDING
+	case attemptRunning:
+		return secretaryv1.ProcessingAttemptStatus_PROCESSING_ATTEMPT_STATUS_RUNNING
+	case attemptSucceeded:
+		return secretaryv1.ProcessingAttemptStatus_PROCESSING_ATTEMPT_STATUS_SUCCEEDED
+	case attemptFailed:
+		return secretaryv1.ProcessingAttemptStatus_PROCESSING_ATTEMPT_STATUS_FAILED
+	default:
+		return secretaryv1.ProcessingAttemptStatus_PROCESSING_ATTEMPT_STATUS_UNSPECIFIED
+	}
+}
+
+func processingAttemptToProto(row db.ListProcessingAttemptsRow) *secretaryv1.ProcessingAttempt {
+	return &secretaryv1.ProcessingAttempt{
+		Stage:             mapProcessingStage(row.Stage),
+		Attempt:           row.Attempt,
+		Status:            mapAttemptStatus(row.Status),
+		Error:             row.Error.String,
+		RequestedByUserId: int64(row.RequestedByUserID.Int32),
+		CreatedAt:         formatTime(row.CreatedAt),
+		StartedAt:         formatTime(row.StartedAt),
+		FinishedAt:        formatTime(row.FinishedAt),
+	}
+}
+
+// RetryProcessing sends a failed or ready recording back to a processing
+// stage, typically after a provider outage. The worker sees the new status
+// and reports progress through UpdateRecordingStatus as usual.
+func (s *Server) RetryProcessing(ctx context.Context, req *connect.Request[secretaryv1.RetryProcessingRequest]) (*connect.Response[secretaryv1.RetryProcessingResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can retry processing"); err != nil {
+		return nil, err
+	}
+	id := int32(req.Msg.Id)
+	next := recordingTranscribing
+	if req.Msg.Stage == secretaryv1.ProcessingStage_PROCESSING_STAGE_SUMMARIZATION {
+		next = recordingSummarizing
+	}
+	if err := s.retryProcessing(ctx, id, next); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.getRecording(ctx, s.recordings, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.RetryProcessingResponse{Recording: resp.Recording}), nil
+}
+
+// retryProcessing moves a failed or ready recording to next, the status
+// of the stage to run again, on behalf of the calling admin.
+func (s *Server) retryProcessing(ctx context.Context, id int32, next string) error {
+	userID := currentUserID(ctx)
+	qtx, err := s.recordings.BeginRecordingTx(ctx)
+	if err != nil {
+		return apierr.Wrap(err, "failed to start transaction")
+	}
+	defer func() { _ = qtx.Rollback(ctx) }()
+
+	current, err := qtx.GetRecordingStatusForUpdate(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return apierr.Wrap(err, "failed to fetch recording")
+	}
+	if current != recordingFailed && current != recordingReady {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("only failed or ready recordings can be retried; this one is %s", current))
+	}
+	if next == recordingTranscribing {
+		if err := checkRecordingScanned(ctx, qtx, id); err != nil {
+			return err
+		}
+		if err := s.checkTranscriptionQuota(ctx, id); err != nil {
+			return err
+		}
+	}
+	if next == recordingSummarizing {
+		row, err := qtx.GetRecording(ctx, id)
+		if err != nil {
+			return apierr.Wrap(err, "failed to fetch recording")
+		}
+		if strings.TrimSpace(row.Transcript.String) == "" {
+			return connect.NewError(connect.CodeFailedPrecondition, errors.New("recording has no transcript to summarize; retry transcription instead"))
+		}
+	}
+	if err := transitionRecording(ctx, qtx, id, current, next, "", pgtype.Int4{Int32: int32(userID), Valid: true}); err != nil {
+		return err
+	}
+	if err := qtx.Commit(ctx); err != nil {
+		return apierr.Wrap(err, "failed to commit transaction")
+	}
+	s.recordingCache.invalidate()
+	return nil
+}
@@ -0,0 +1,247 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// Recording processing statuses, as stored in recording.status.
+const (
+	recordingUploaded     = "uploaded"
+	recordingTranscribing = "transcribing"
+	recordingSummarizing  = "summarizing"
+	recordingReady        = "ready"
+	recordingFailed       = "failed"
+)
+
+// recordingTransitions lists the statuses each status may move to. Any
+// stage can fail; failed and ready recordings can be sent back through
+// transcription or summarization.
+var recordingTransitions = map[string][]string{
+	recordingUploaded:     {recordingTranscribing, recordingFailed},
+	recordingTranscribing: {recordingSummarizing, recordingFailed},
+	recordingSummarizing:  {recordingReady, recordingFailed},
+	recordingReady:        {recordingTranscribing, recordingSummarizing},
+	recordingFailed:       {recordingTranscribing, recordingSummarizing},
+}
+
+func canTransition(from, to string) bool {
+	for _, next := range recordingTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+func mapRecordingStatus(status string) secretaryv1.RecordingStatus {
+	switch strings.ToLower(strings.TrimSpace(status)) {
+	case recordingUploaded:
+		return secretaryv1.RecordingStatus_RECORDING_STATUS_UPLOADED
+	case recordingTranscribing:
+		return secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING
+	case recordingSummarizing:
+		return secretaryv1.RecordingStatus_RECORDING_STATUS_SUMMARIZING
+	case recordingReady:
+		return secretaryv1.RecordingStatus_RECORDING_STATUS_READY
+	case recordingFailed:
+		return secretaryv1.RecordingStatus_RECORDING_STATUS_FAILED
+	default:
+		return secretaryv1.RecordingStatus_RECORDING_STATUS_UNSPECIFIED
+	}
+}
+
+func mapRecordingStatusToString(status secretaryv1.RecordingStatus) string {
+	switch status {
+	case secretaryv1.RecordingStatus_RECORDING_STATUS_UPLOADED:
+		return recordingUploaded
+	case secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING:
+		return recordingTranscribing
+	case secretaryv1.RecordingStatus_RECORDING_STATUS_SUMMARIZING:
+		return recordingSummarizing
+	case secretaryv1.RecordingStatus_RECORDING_STATUS_READY:
+		return recordingReady
+	case secretaryv1.RecordingStatus_RECORDING_STATUS_FAILED:
+		return recordingFailed
+	default:
+		return ""
+	}
+}
+
+func recordingStatusEventToProto(row db.ListRecordingStatusEventsRow) *secretaryv1.RecordingStatusEvent {
+	return &secretaryv1.RecordingStatusEvent{
+		FromStatus: mapRecordingStatus(row.FromStatus),
+		ToStatus:   mapRecordingStatus(row.ToStatus),
+		Error:      row.Error.String,
+		CreatedAt:  formatTime(row.CreatedAt),
+	}
+}
+
+// UpdateRecordingStatus is called by the transcription worker as a
+// recording moves through processing. Reporting the status a recording is
+// already in is a no-op, so the worker can retry the call safely; repeating
+// FAILED records the new error.
+func (s *Server) UpdateRecordingStatus(ctx context.Context, req *connect.Request[secretaryv1.UpdateRecordingStatusRequest]) (*connect.Response[secretaryv1.UpdateRecordingStatusResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can update recording status"); err != nil {
+		return nil, err
+	}
+	id := int32(req.Msg.Id)
+	next := mapRecordingStatusToString(req.Msg.Status)
+	message := strings.TrimSpace(req.Msg.Error)
+	if next == recordingFailed && message == "" {
+		return nil, apierr.InvalidField("error", "required when status is FAILED")
+	}
+	if next != recordingFailed && message != "" {
+		return nil, apierr.InvalidField("error", "only allowed when status is FAILED")
+	}
+
+	qtx, err := s.recordings.BeginRecordingTx(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to start transaction")
+	}
+	defer func() { _ = qtx.Rollback(ctx) }()
+
+	current, err := qtx.GetRecordingStatusForUpdate(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch recording")
+	}
+	allowed := current == next || canTransition(current, next)
+	if !allowed && current == recordingTranscribing && next == recordingReady {
+		if allowed, err = summaryDisabled(ctx, qtx, id); err != nil {
+			return nil, err
+		}
+	}
+	if !allowed {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("recording cannot move from %s to %s", current, next))
+	}
+	// A retried report may repeat the scores stored the first time.
+	transcribed := current == recordingTranscribing && (next == recordingSummarizing || next == recordingReady)
+	if len(req.Msg.SegmentConfidences) > 0 && !transcribed && current != next {
+		return nil, apierr.InvalidField("segment_confidences", "only allowed when transcription finishes")
+	}
+	if current != next && next == recordingTranscribing {
+		if err := checkRecordingScanned(ctx, qtx, id); err != nil {
+			return nil, err
+		}
+		if err := s.checkTranscriptionQuota(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	switch {
+	case current != next || next == recordingFailed:
+		if err := transitionRecording(ctx, qtx, id, current, next, message, pgtype.Int4{}); err != nil {
+			return nil, err
+		}
+	case stageForStatus(next) != "":
+		// The worker picking up a retry reports the status RetryProcessing
+		// already set; that starts the pending attempt.
+		if err := startAttempt(ctx, qtx, id, stageForStatus(next), pgtype.Int4{}); err != nil {
+			return nil, err
+		}
+	}
+	if transcribed {
+		if err := setTranscriptSegments(ctx, qtx, id, req.Msg.SegmentConfidences); err != nil {
+			return nil, err
+		}
+	}
+	summarized := current == recordingSummarizing && next == recordingReady
+	if transcribed || summarized {
+		if err := s.redactRecording(ctx, qtx, id, transcribed, summarized); err != nil {
+			return nil, err
+		}
+	}
+	if transcribed {
+		if err := s.sealWorkerTranscript(ctx, qtx, id); err != nil {
+			return nil, err
+		}
+	}
+	if err := qtx.Commit(ctx); err != nil {
+		return nil, apierr.Wrap(err, "failed to commit transaction")
+	}
+	if current != next && next == recordingFailed {
+		s.captureJobFailure(ctx, id, current, message)
+	}
+	if current != next && next == recordingReady {
+		s.linkReadyRecording(ctx, id)
+		// Wikis can be slow; the status change should not wait on them.
+		go s.autoPublish(context.WithoutCancel(ctx), id)
+		s.notifyRecordingReady(ctx, id)
+		s.queueKeywordMatch()
+	}
+	s.recordingCache.invalidate()
+
+	resp, err := s.getRecording(ctx, s.recordings, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.UpdateRecordingStatusResponse{Recording: resp.Recording}), nil
+}
+
+// summaryDisabled reports whether a recording's settings leave it
+// unsummarized, so it may go straight from transcribing to ready.
+func summaryDisabled(ctx context.Context, q RecordingQueries, id int32) (bool, error) {
+	row, err := q.GetRecording(ctx, id)
+	if err != nil {
+		return false, apierr.Wrap(err, "failed to fetch recording")
+	}
+	settings, err := decodeProcessingSettings(row.ProcessingSettings, row.PromptTemplateID)
+	if err != nil {
+		return false, err
+	}
+	return settings.GetDisableSummary(), nil
+}
+
+// transitionRecording moves a recording from one status to another,
+// recording the event and closing or opening processing attempts for the
+// stages involved. The caller has checked the transition is allowed and
+// holds the row lock.
+func transitionRecording(ctx context.Context, q RecordingQueries, id int32, from, to, message string, requestedBy pgtype.Int4) error {
+	statusError := pgtype.Text{String: message, Valid: message != ""}
+	if err := q.SetRecordingStatus(ctx, db.SetRecordingStatusParams{ID: id, Status: to, StatusError: statusError}); err != nil {
+		return apierr.Wrap(err, "failed to update recording status")
+	}
+	if err := q.CreateRecordingStatusEvent(ctx, db.CreateRecordingStatusEventParams{
+		RecordingID: id,
+		FromStatus:  from,
+		ToStatus:    to,
+		Error:       statusError,
+	}); err != nil {
+		return apierr.Wrap(err, "failed to record status change")
+	}
+	if stage := stageForStatus(from); stage != "" && from != to {
+		outcome := attemptSucceeded
+		if to == recordingFailed {
+			outcome = attemptFailed
+		}
+		if err := q.FinishProcessingAttempts(ctx, db.FinishProcessingAttemptsParams{
+			RecordingID: id,
+			Stage:       stage,
+			Status:      outcome,
+			Error:       statusError,
+		}); err != nil {
+			return apierr.Wrap(err, "failed to record processing attempt")
+		}
+	}
+	if from == recordingTranscribing && (to == recordingSummarizing || to == recordingReady) {
+		if err := q.RecordTranscriptionUsage(ctx, id); err != nil {
+			return apierr.Wrap(err, "failed to record transcription usage")
+		}
+	}
+	if stage := stageForStatus(to); stage != "" {
+		return startAttempt(ctx, q, id, stage, requestedBy)
+	}
+	return nil
+}
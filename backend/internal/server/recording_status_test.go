@@ -0,0 +1,334 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/envelope"
+)
+
+type adminUsers struct{ UserStore }
+
+func (adminUsers) GetUser(_ context.Context, id int32) (db.GetUserRow, error) {
+	return db.GetUserRow{ID: id, Role: optionalText("admin")}, nil
+}
+
+// fakeRecordingStatus keeps one recording's status and its events; it is
+// its own transaction.
+type fakeRecordingStatus struct {
+	RecordingTx
+	status    string
+	statusErr string
+	events    []db.ListRecordingStatusEventsRow
+	attempts  []db.ListProcessingAttemptsRow
+	// transcribed counts the times transcription usage was recorded.
+	transcribed int
+	confidences []float32
+	// settings is the recording's processing_settings JSON.
+	settings   []byte
+	transcript string
+}
+
+func (f *fakeRecordingStatus) BeginRecordingTx(context.Context) (RecordingTx, error) {
+	return f, nil
+}
+
+func (f *fakeRecordingStatus) GetRecordingStatusForUpdate(context.Context, int32) (string, error) {
+	return f.status, nil
+}
+
+func (f *fakeRecordingStatus) SetRecordingStatus(_ context.Context, arg db.SetRecordingStatusParams) error {
+	f.status, f.statusErr = arg.Status, arg.StatusError.String
+	return nil
+}
+
+func (f *fakeRecordingStatus) CreateRecordingStatusEvent(_ context.Context, arg db.CreateRecordingStatusEventParams) error {
+	f.events = append(f.events, db.ListRecordingStatusEventsRow{FromStatus: arg.FromStatus, ToStatus: arg.ToStatus, Error: arg.Error})
+	return nil
+}
+
+func (f *fakeRecordingStatus) GetRecording(_ context.Context, id int32) (db.GetRecordingRow, error) {
+	return db.GetRecordingRow{ID: id, Status: f.status, StatusError: optionalText(f.statusErr), ProcessingSettings: f.settings, Transcript: optionalText(f.transcript)}, nil
+}
+
+func (f *fakeRecordingStatus) SetRecordingTranscript(_ context.Context, arg db.SetRecordingTranscriptParams) error {
+	f.transcript = arg.Transcript.String
+	return nil
+}
+
+func (f *fakeRecordingStatus) SetRecordingProcessingSettings(_ context.Context, arg db.SetRecordingProcessingSettingsParams) error {
+	f.settings = arg.ProcessingSettings
+	return nil
+}
+
+func (f *fakeRecordingStatus) ListRecordingParticipants(context.Context, int32) ([]db.ListRecordingParticipantsRow, error) {
+	return nil, nil
+}
+
+func (f *fakeRecordingStatus) ListRecordingGuests(context.Context, int32) ([]db.GuestParticipant, error) {
+	return nil, nil
+}
+
+func (f *fakeRecordingStatus) ListRecordingStatusEvents(context.Context, int32) ([]db.ListRecordingStatusEventsRow, error) {
+	return f.events, nil
+}
+
+func (f *fakeRecordingStatus) ListRecordingHoldEvents(context.Context, int32) ([]db.ListRecordingHoldEventsRow, error) {
+	return nil, nil
+}
+
+func (f *fakeRecordingStatus) CreateProcessingAttempt(_ context.Context, arg db.CreateProcessingAttemptParams) error {
+	number := int32(1)
+	for _, a := range f.attempts {
+		if a.Stage == arg.Stage {
+			number = a.Attempt + 1
+		}
+	}
+	f.attempts = append(f.attempts, db.ListProcessingAttemptsRow{Stage: arg.Stage, Attempt: number, Status: arg.Status, RequestedByUserID: arg.RequestedByUserID})
+	return nil
+}
+
+func (f *fakeRecordingStatus) StartPendingProcessingAttempt(_ context.Context, arg db.StartPendingProcessingAttemptParams) (int64, error) {
+	var started int64
+	for i, a := range f.attempts {
+		if a.Stage == arg.Stage && a.Status == attemptPending {
+			f.attempts[i].Status = attemptRunning
+			started++
+		}
+	}
+	return started, nil
+}
+
+func (f *fakeRecordingStatus) HasOpenProcessingAttempt(_ context.Context, arg db.HasOpenProcessingAttemptParams) (bool, error) {
+	for _, a := range f.attempts {
+		if a.Stage == arg.Stage && (a.Status == attemptPending || a.Status == attemptRunning) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeRecordingStatus) FinishProcessingAttempts(_ context.Context, arg db.FinishProcessingAttemptsParams) error {
+	for i, a := range f.attempts {
+		if a.Stage == arg.Stage && (a.Status == attemptPending || a.Status == attemptRunning) {
+			f.attempts[i].Status, f.attempts[i].Error = arg.Status, arg.Error
+		}
+	}
+	return nil
+}
+
+func (f *fakeRecordingStatus) ListProcessingAttempts(context.Context, int32) ([]db.ListProcessingAttemptsRow, error) {
+	return f.attempts, nil
+}
+
+func (f *fakeRecordingStatus) ListRecordingTranslations(context.Context, int32) ([]db.ListRecordingTranslationsRow, error) {
+	return nil, nil
+}
+
+func (f *fakeRecordingStatus) ListTranscriptSegments(context.Context, int32) ([]db.ListTranscriptSegmentsRow, error) {
+	var rows []db.ListTranscriptSegmentsRow
+	for i, confidence := range f.confidences {
+		rows = append(rows, db.ListTranscriptSegmentsRow{SegmentIndex: int32(i), Confidence: confidence})
+	}
+	return rows, nil
+}
+
+func (f *fakeRecordingStatus) DeleteTranscriptSegments(context.Context, int32) error {
+	f.confidences = nil
+	return nil
+}
+
+func (f *fakeRecordingStatus) CreateTranscriptSegments(_ context.Context, arg db.CreateTranscriptSegmentsParams) error {
+	f.confidences = arg.Confidences
+	return nil
+}
+
+func (f *fakeRecordingStatus) RecordTranscriptionUsage(context.Context, int32) error {
+	f.transcribed++
+	return nil
+}
+
+func (f *fakeRecordingStatus) Commit(context.Context) error { return nil }
+
+func (f *fakeRecordingStatus) Rollback(context.Context) error { return nil }
+
+func updateStatus(srv *Server, status secretaryv1.RecordingStatus, message string) (*secretaryv1.Recording, error) {
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+	resp, err := srv.UpdateRecordingStatus(ctx, connect.NewRequest(&secretaryv1.UpdateRecordingStatusRequest{Id: 3, Status: status, Error: message}))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.Recording, nil
+}
+
+func TestUpdateRecordingStatusRecordsTransitions(t *testing.T) {
+	store := &fakeRecordingStatus{status: recordingUploaded}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(store, nil, adminUsers{})
+
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING, ""); err != nil {
+		t.Fatalf("start transcribing: %v", err)
+	}
+	rec, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_FAILED, "provider timed out")
+	if err != nil {
+		t.Fatalf("fail: %v", err)
+	}
+	if rec.Status != secretaryv1.RecordingStatus_RECORDING_STATUS_FAILED || rec.StatusError != "provider timed out" {
+		t.Fatalf("recording = %v %q, want FAILED with error", rec.Status, rec.StatusError)
+	}
+	if len(rec.StatusHistory) != 2 || rec.StatusHistory[1].FromStatus != secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING {
+		t.Fatalf("history = %v", rec.StatusHistory)
+	}
+
+	// Retrying transcription clears the error, and repeating the report is
+	// a no-op.
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING, ""); err != nil {
+		t.Fatalf("retry transcribing: %v", err)
+	}
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING, ""); err != nil {
+		t.Fatalf("repeat transcribing: %v", err)
+	}
+	if len(store.events) != 3 || store.statusErr != "" {
+		t.Fatalf("events = %d, error = %q; want 3 events and the error cleared", len(store.events), store.statusErr)
+	}
+}
+
+func TestUpdateRecordingStatusRejectsSkippedStages(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(&fakeRecordingStatus{status: recordingUploaded}, nil, adminUsers{})
+
+	_, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_READY, "")
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeFailedPrecondition {
+		t.Fatalf("err = %v, want FailedPrecondition", err)
+	}
+
+	_, err = updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_FAILED, " ")
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+		t.Fatalf("err = %v, want InvalidArgument for a failure without an error", err)
+	}
+}
+
+func TestRetryProcessingTracksAttempts(t *testing.T) {
+	store := &fakeRecordingStatus{status: recordingUploaded}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(store, nil, adminUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+	retry := func(stage secretaryv1.ProcessingStage) (*secretaryv1.Recording, error) {
+		resp, err := srv.RetryProcessing(ctx, connect.NewRequest(&secretaryv1.RetryProcessingRequest{Id: 3, Stage: stage}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Recording, nil
+	}
+
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING, ""); err != nil {
+		t.Fatalf("start transcribing: %v", err)
+	}
+	var connectErr *connect.Error
+	if _, err := retry(secretaryv1.ProcessingStage_PROCESSING_STAGE_TRANSCRIPTION); !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeFailedPrecondition {
+		t.Fatalf("retry while transcribing: err = %v, want FailedPrecondition", err)
+	}
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_FAILED, "provider unavailable"); err != nil {
+		t.Fatalf("fail: %v", err)
+	}
+
+	rec, err := retry(secretaryv1.ProcessingStage_PROCESSING_STAGE_TRANSCRIPTION)
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if rec.Status != secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING {
+		t.Fatalf("status = %v, want TRANSCRIBING", rec.Status)
+	}
+	if len(rec.ProcessingAttempts) != 2 {
+		t.Fatalf("attempts = %v, want 2", rec.ProcessingAttempts)
+	}
+	first, second := rec.ProcessingAttempts[0], rec.ProcessingAttempts[1]
+	if first.Status != secretaryv1.ProcessingAttemptStatus_PROCESSING_ATTEMPT_STATUS_FAILED || first.Error != "provider unavailable" {
+		t.Fatalf("first attempt = %v, want failed with the provider error", first)
+	}
+	if second.Attempt != 2 || second.Status != secretaryv1.ProcessingAttemptStatus_PROCESSING_ATTEMPT_STATUS_PENDING || second.RequestedByUserId != 1 {
+		t.Fatalf("second attempt = %v, want pending attempt 2 requested by user 1", second)
+	}
+
+	// The worker picks the retry up and finishes transcription.
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING, ""); err != nil {
+		t.Fatalf("pick up retry: %v", err)
+	}
+	if store.attempts[1].Status != attemptRunning {
+		t.Fatalf("retry status = %s, want running", store.attempts[1].Status)
+	}
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_SUMMARIZING, ""); err != nil {
+		t.Fatalf("summarize: %v", err)
+	}
+	if store.attempts[1].Status != attemptSucceeded || store.attempts[2].Stage != stageSummarization {
+		t.Fatalf("attempts = %+v, want transcription succeeded and summarization started", store.attempts)
+	}
+}
+
+func TestUpdateRecordingStatusStoresTranscriptConfidence(t *testing.T) {
+	store := &fakeRecordingStatus{status: recordingUploaded}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(store, nil, adminUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+	report := func(status secretaryv1.RecordingStatus, confidences ...float32) (*secretaryv1.Recording, error) {
+		resp, err := srv.UpdateRecordingStatus(ctx, connect.NewRequest(&secretaryv1.UpdateRecordingStatusRequest{Id: 3, Status: status, SegmentConfidences: confidences}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Recording, nil
+	}
+
+	var connectErr *connect.Error
+	if _, err := report(secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING, 0.9); !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+		t.Fatalf("scores before transcription: err = %v, want InvalidArgument", err)
+	}
+	if _, err := report(secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING); err != nil {
+		t.Fatalf("start transcribing: %v", err)
+	}
+	rec, err := report(secretaryv1.RecordingStatus_RECORDING_STATUS_SUMMARIZING, 0.95, 0.4, 0.8)
+	if err != nil {
+		t.Fatalf("finish transcribing: %v", err)
+	}
+	if len(rec.TranscriptSegments) != 3 {
+		t.Fatalf("segments = %v, want 3", rec.TranscriptSegments)
+	}
+	for i, want := range []bool{false, true, false} {
+		if seg := rec.TranscriptSegments[i]; seg.Index != int32(i) || seg.LowConfidence != want {
+			t.Fatalf("segment %d = %v, want low confidence %v", i, seg, want)
+		}
+	}
+	// A retried report is a no-op, and retranscribing without scores
+	// drops the old ones.
+	if _, err := report(secretaryv1.RecordingStatus_RECORDING_STATUS_SUMMARIZING, 0.95, 0.4, 0.8); err != nil {
+		t.Fatalf("repeat report: %v", err)
+	}
+	store.status = recordingTranscribing
+	if rec, err = report(secretaryv1.RecordingStatus_RECORDING_STATUS_SUMMARIZING); err != nil || len(rec.TranscriptSegments) != 0 {
+		t.Fatalf("retranscribed: segments = %v, err = %v; want none", rec.GetTranscriptSegments(), err)
+	}
+}
+
+func TestUpdateRecordingStatusSealsTheWorkersTranscript(t *testing.T) {
+	// The worker stores the transcript in plaintext, then reports it.
+	store := &fakeRecordingStatus{status: recordingTranscribing, transcript: "Speaker 1: the budget is approved"}
+	srv := encryptedServer(t)
+	srv.ConfigureStores(store, nil, adminUsers{})
+
+	rec, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_SUMMARIZING, "")
+	if err != nil {
+		t.Fatalf("finish transcribing: %v", err)
+	}
+	if _, sealed := envelope.TextKeyID(store.transcript); !sealed {
+		t.Fatalf("stored transcript = %q, want it sealed", store.transcript)
+	}
+	if rec.Transcript != "Speaker 1: the budget is approved" {
+		t.Fatalf("transcript = %q", rec.Transcript)
+	}
+}
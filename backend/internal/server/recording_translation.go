@@ -0,0 +1,238 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"golang.org/x/text/language"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/providers"
+	"github.com/mvult/secretary/backend/internal/redact"
+)
+
+// Translation kinds, as stored in recording_translation.kind.
+const (
+	translationSummary    = "summary"
+	translationTranscript = "transcript"
+)
+
+func mapTranslationKind(kind string) secretaryv1.TranslationKind {
+	switch kind {
+	case translationSummary:
+		return secretaryv1.TranslationKind_TRANSLATION_KIND_SUMMARY
+	case translationTranscript:
+		return secretaryv1.TranslationKind_TRANSLATION_KIND_TRANSCRIPT
+	default:
+		return secretaryv1.TranslationKind_TRANSLATION_KIND_UNSPECIFIED
+	}
+}
+
+func recordingTranslationToProto(row db.ListRecordingTranslationsRow) *secretaryv1.RecordingTranslation {
+	return &secretaryv1.RecordingTranslation{
+		Kind:              mapTranslationKind(row.Kind),
+		Language:          row.Language,
+		Text:              row.Text,
+		Provider:          row.Provider,
+		Model:             row.Model,
+		RequestedByUserId: int64(row.RequestedByUserID.Int32),
+		CreatedAt:         formatTime(row.CreatedAt),
+		UpdatedAt:         formatTime(row.UpdatedAt),
+	}
+}
+
+// parseTargetLanguage canonicalizes a BCP 47 tag, so "PT-br" and "pt-BR"
+// share one stored translation.
+func parseTargetLanguage(tag string) (string, error) {
+	return parseLanguageTag("target_language", tag)
+}
+
+// parseLanguageTag canonicalizes the BCP 47 tag in field, or returns ""
+// when it's blank.
+func parseLanguageTag(field, tag string) (string, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return "", nil
+	}
+	parsed, err := language.Parse(tag)
+	if err != nil || parsed == language.Und {
+		return "", apierr.InvalidField(field, "must be a BCP 47 language tag such as \"es\" or \"pt-BR\"")
+	}
+	return parsed.String(), nil
+}
+
+// recordingTranscript returns the transcript to summarize or translate,
+// along with the prompt template chosen for the recording and the policy
+// for what is written from it. The template is the zero value when the
+// recording uses the built-in prompts.
+func (s *Server) recordingTranscript(ctx context.Context, id int32) (string, db.PromptTemplate, redact.Policy, error) {
+	row, err := s.recordings.GetRecording(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", db.PromptTemplate{}, redact.Policy{}, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return "", db.PromptTemplate{}, redact.Policy{}, apierr.Wrap(err, "failed to fetch recording")
+	}
+	transcript, err := s.openText(row.Transcript.String)
+	if err != nil {
+		return "", db.PromptTemplate{}, redact.Policy{}, err
+	}
+	transcript = strings.TrimSpace(transcript)
+	if transcript == "" {
+		return "", db.PromptTemplate{}, redact.Policy{}, connect.NewError(connect.CodeFailedPrecondition, errors.New("recording has no transcript yet"))
+	}
+	policy, err := s.rowRedactionPolicy(row.ProcessingSettings)
+	if err != nil {
+		return "", db.PromptTemplate{}, redact.Policy{}, err
+	}
+	if !row.PromptTemplateID.Valid {
+		return transcript, db.PromptTemplate{}, policy, nil
+	}
+	template, err := s.prompts.GetPromptTemplate(ctx, row.PromptTemplateID.Int32)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", db.PromptTemplate{}, redact.Policy{}, apierr.Wrap(err, "failed to fetch prompt template")
+	}
+	return transcript, template, policy, nil
+}
+
+// runProvider calls a summarization provider on the caller's behalf,
+// within their token quota, and records the tokens it used.
+func (s *Server) runProvider(ctx context.Context, userID int64, message string, call func(*providers.Registry) (providers.Result, error)) (providers.Result, error) {
+	if s.providers == nil {
+		return providers.Result{}, connect.NewError(connect.CodeFailedPrecondition, errors.New("summarization is not configured on the server"))
+	}
+	if err := s.checkQuota(ctx, pgtype.Int4{Int32: int32(userID), Valid: true}, usageLLMTokens, 0); err != nil {
+		return providers.Result{}, err
+	}
+	result, err := call(s.providers)
+	if errors.Is(err, providers.ErrNoProviders) {
+		return providers.Result{}, connect.NewError(connect.CodeFailedPrecondition, errors.New("summarization is not configured on the server"))
+	}
+	if err != nil {
+		return providers.Result{}, apierr.Wrap(err, message)
+	}
+	s.recordTokenUsage(ctx, userID, result.Usage.InputTokens+result.Usage.OutputTokens)
+	return result, nil
+}
+
+// storeTranslation keeps text, masked under policy, next to the original
+// and returns the refreshed recording.
+func (s *Server) storeTranslation(ctx context.Context, id int32, kind string, lang string, userID int64, policy redact.Policy, result providers.Result) (*secretaryv1.Recording, error) {
+	text := maskText(policy, result.Text, fmt.Sprintf("recording %d %s translation", id, kind))
+	if kind == translationTranscript {
+		sealed, err := s.sealText(text)
+		if err != nil {
+			return nil, err
+		}
+		text = sealed
+	}
+	if err := s.recordings.UpsertRecordingTranslation(ctx, db.UpsertRecordingTranslationParams{
+		RecordingID:       id,
+		Kind:              kind,
+		Language:          lang,
+		Text:              text,
+		Provider:          result.Provider,
+		Model:             result.Model,
+		RequestedByUserID: pgtype.Int4{Int32: int32(userID), Valid: true},
+	}); err != nil {
+		return nil, apierr.Wrap(err, "failed to store translation")
+	}
+	return s.refreshedRecording(ctx, id)
+}
+
+func (s *Server) refreshedRecording(ctx context.Context, id int32) (*secretaryv1.Recording, error) {
+	s.recordingCache.invalidate()
+	resp, err := s.getRecording(ctx, s.recordings, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Recording, nil
+}
+
+// Summarize summarizes a recording's transcript on demand, optionally in
+// another language. Summaries in another language are stored as
+// translations so the original, usually written in the meeting's own
+// language, is kept.
+func (s *Server) Summarize(ctx context.Context, req *connect.Request[secretaryv1.SummarizeRequest]) (*connect.Response[secretaryv1.SummarizeResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lang, err := parseTargetLanguage(req.Msg.TargetLanguage)
+	if err != nil {
+		return nil, err
+	}
+	if lang == "" {
+		if err := s.requireAdmin(ctx, "only admins can replace a recording's summary; pass target_language to summarize in another language"); err != nil {
+			return nil, err
+		}
+	}
+	id := int32(req.Msg.Id)
+	transcript, template, policy, err := s.recordingTranscript(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.runProvider(ctx, userID, "failed to summarize recording", func(registry *providers.Registry) (providers.Result, error) {
+		return registry.Summarize(ctx, id, providers.SummaryRequest{Instructions: template.SummaryInstructions, Transcript: transcript, Language: lang})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if lang != "" {
+		rec, err := s.storeTranslation(ctx, id, translationSummary, lang, userID, policy, result)
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&secretaryv1.SummarizeResponse{Recording: rec}), nil
+	}
+	summary := maskText(policy, result.Text, fmt.Sprintf("recording %d summary", id))
+	if err := s.recordings.SetRecordingSummary(ctx, db.SetRecordingSummaryParams{ID: id, Summary: optionalText(summary)}); err != nil {
+		return nil, apierr.Wrap(err, "failed to store summary")
+	}
+	rec, err := s.refreshedRecording(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.SummarizeResponse{Recording: rec}), nil
+}
+
+// TranslateTranscript translates a recording's transcript and stores it
+// next to the original. Translating into the same language again replaces
+// the earlier translation, e.g. after the transcript was corrected.
+func (s *Server) TranslateTranscript(ctx context.Context, req *connect.Request[secretaryv1.TranslateTranscriptRequest]) (*connect.Response[secretaryv1.TranslateTranscriptResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lang, err := parseTargetLanguage(req.Msg.TargetLanguage)
+	if err != nil {
+		return nil, err
+	}
+	if lang == "" {
+		return nil, apierr.InvalidField("target_language", "is required")
+	}
+	id := int32(req.Msg.Id)
+	transcript, _, policy, err := s.recordingTranscript(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.runProvider(ctx, userID, "failed to translate transcript", func(registry *providers.Registry) (providers.Result, error) {
+		return registry.Translate(ctx, id, transcript, lang)
+	})
+	if err != nil {
+		return nil, err
+	}
+	rec, err := s.storeTranslation(ctx, id, translationTranscript, lang, userID, policy, result)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.TranslateTranscriptResponse{Recording: rec}), nil
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/providers"
+)
+
+// fakeTranslations is a ready recording with a transcript that keeps its
+// summary and translations.
+type fakeTranslations struct {
+	*fakeRecordingStatus
+	transcript   string
+	summary      string
+	translations []db.ListRecordingTranslationsRow
+}
+
+func (f *fakeTranslations) BeginRecordingTx(context.Context) (RecordingTx, error) {
+	return f, nil
+}
+
+func (f *fakeTranslations) GetRecording(_ context.Context, id int32) (db.GetRecordingRow, error) {
+	return db.GetRecordingRow{ID: id, Status: recordingReady, Transcript: optionalText(f.transcript), Summary: optionalText(f.summary), ProcessingSettings: f.settings}, nil
+}
+
+func (f *fakeTranslations) ListRecordingTranslations(context.Context, int32) ([]db.ListRecordingTranslationsRow, error) {
+	return f.translations, nil
+}
+
+func (f *fakeTranslations) UpsertRecordingTranslation(_ context.Context, arg db.UpsertRecordingTranslationParams) error {
+	row := db.ListRecordingTranslationsRow{Kind: arg.Kind, Language: arg.Language, Text: arg.Text, Provider: arg.Provider, Model: arg.Model, RequestedByUserID: arg.RequestedByUserID}
+	for i, t := range f.translations {
+		if t.Kind == arg.Kind && t.Language == arg.Language {
+			f.translations[i] = row
+			return nil
+		}
+	}
+	f.translations = append(f.translations, row)
+	return nil
+}
+
+func (f *fakeTranslations) SetRecordingTranscript(_ context.Context, arg db.SetRecordingTranscriptParams) error {
+	f.transcript = arg.Transcript.String
+	return nil
+}
+
+func (f *fakeTranslations) SetRecordingSummary(_ context.Context, arg db.SetRecordingSummaryParams) error {
+	f.summary = arg.Summary.String
+	return nil
+}
+
+// echoSummarizer echoes the transcript prefixed with the requested
+// language.
+type echoSummarizer struct{ requests []providers.SummaryRequest }
+
+func (e *echoSummarizer) Summarize(_ context.Context, req providers.SummaryRequest) (string, providers.Usage, error) {
+	e.requests = append(e.requests, req)
+	return "[" + req.Language + "] " + req.Transcript, providers.Usage{InputTokens: 40, OutputTokens: 10}, nil
+}
+
+func newTranslationServer(users UserStore) (*Server, *fakeTranslations, *echoSummarizer, *fakeUsage) {
+	store := &fakeTranslations{fakeRecordingStatus: &fakeRecordingStatus{status: recordingReady}, transcript: "Speaker 1: hola", summary: "Saludos."}
+	summarizer := &echoSummarizer{}
+	registry := providers.NewRegistry(nil)
+	registry.AddSummarizer(summarizer, providers.Options{Name: "stub", Model: "small"})
+	usage := &fakeUsage{}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(store, nil, users)
+	srv.ConfigureProviders(registry)
+	srv.usage = usage
+	return srv, store, summarizer, usage
+}
+
+func TestSummarizeInTargetLanguageKeepsOriginal(t *testing.T) {
+	srv, store, summarizer, usage := newTranslationServer(memberUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 4})
+
+	resp, err := srv.Summarize(ctx, connect.NewRequest(&secretaryv1.SummarizeRequest{Id: 3, TargetLanguage: "PT-br"}))
+	if err != nil {
+		t.Fatalf("summarize: %v", err)
+	}
+	if len(summarizer.requests) != 1 || summarizer.requests[0].Language != "pt-BR" {
+		t.Fatalf("requests = %+v, want one in pt-BR", summarizer.requests)
+	}
+	rec := resp.Msg.Recording
+	if rec.Summary != "Saludos." || len(rec.Translations) != 1 {
+		t.Fatalf("summary %q with %d translations, want the original and one translation", rec.Summary, len(rec.Translations))
+	}
+	translation := rec.Translations[0]
+	if translation.Kind != secretaryv1.TranslationKind_TRANSLATION_KIND_SUMMARY || translation.Language != "pt-BR" || translation.Provider != "stub" || translation.RequestedByUserId != 4 {
+		t.Fatalf("translation = %+v", translation)
+	}
+	if len(usage.events) != 1 || usage.events[0].Metric != usageLLMTokens || usage.events[0].Quantity != 50 || usage.events[0].UserID.Int32 != 4 {
+		t.Fatalf("usage events = %+v, want 50 tokens for user 4", usage.events)
+	}
+
+	// Replacing the original summary is for admins only.
+	_, err = srv.Summarize(ctx, connect.NewRequest(&secretaryv1.SummarizeRequest{Id: 3}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied || store.summary != "Saludos." {
+		t.Fatalf("err = %v with summary %q, want PermissionDenied and the original kept", err, store.summary)
+	}
+}
+
+func TestSummarizeReplacesOriginalForAdmins(t *testing.T) {
+	srv, store, _, _ := newTranslationServer(adminUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+
+	resp, err := srv.Summarize(ctx, connect.NewRequest(&secretaryv1.SummarizeRequest{Id: 3}))
+	if err != nil {
+		t.Fatalf("summarize: %v", err)
+	}
+	if store.summary != "[] Speaker 1: hola" || resp.Msg.Recording.Summary != store.summary || len(store.translations) != 0 {
+		t.Fatalf("summary = %q with translations %+v", store.summary, store.translations)
+	}
+}
+
+func TestTranslateTranscript(t *testing.T) {
+	srv, store, summarizer, _ := newTranslationServer(memberUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 4})
+
+	for range 2 {
+		if _, err := srv.TranslateTranscript(ctx, connect.NewRequest(&secretaryv1.TranslateTranscriptRequest{Id: 3, TargetLanguage: "en"})); err != nil {
+			t.Fatalf("translate: %v", err)
+		}
+	}
+	if len(store.translations) != 1 || store.translations[0].Kind != translationTranscript || store.translations[0].Language != "en" {
+		t.Fatalf("translations = %+v, want one English transcript", store.translations)
+	}
+	if req := summarizer.requests[0]; req.Instructions != providers.TranslationInstructions("en") || req.Transcript != "Speaker 1: hola" {
+		t.Fatalf("request = %+v", summarizer.requests[0])
+	}
+
+	_, err := srv.TranslateTranscript(ctx, connect.NewRequest(&secretaryv1.TranslateTranscriptRequest{Id: 3, TargetLanguage: "not a language"}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("err = %v, want InvalidArgument", err)
+	}
+	store.transcript = ""
+	_, err = srv.TranslateTranscript(ctx, connect.NewRequest(&secretaryv1.TranslateTranscriptRequest{Id: 3, TargetLanguage: "es"}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("err = %v, want FailedPrecondition without a transcript", err)
+	}
+}
@@ -0,0 +1,244 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+const maxAudioUploadBytes = 2 << 30
+
+// checksumHeader optionally carries the hex SHA-256 of an uploaded file,
+// which the stored audio is checked against.
+const checksumHeader = "X-Content-SHA256"
+
+// ConfigureStorage sets the backend used for uploaded audio. Uploads are
+// rejected until a store is configured.
+func (s *Server) ConfigureStorage(store storage.Store) {
+	s.storage = store
+}
+
+// handleRecordingUpload stores a raw audio request body and creates a
+// recording for it. The file name is taken from the name query parameter so
+// clients can stream the body without multipart encoding, and processing
+// settings from the settings parameter as JSON. Audio identical to an
+// existing recording's isn't stored again; the response points at that
+// recording instead.
+func (s *Server) handleRecordingUpload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if s.storage == nil {
+		writeError(w, http.StatusServiceUnavailable, "audio storage is not configured")
+		return
+	}
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	ext, ok := audioExtension(contentType, r.URL.Query().Get("filename"))
+	if !ok {
+		writeError(w, http.StatusUnsupportedMediaType, "unsupported audio type")
+		return
+	}
+	expected, ok := parseSHA256(r.Header.Get(checksumHeader))
+	if !ok {
+		writeError(w, http.StatusBadRequest, checksumHeader+" must be a hex SHA-256")
+		return
+	}
+	userID := currentUserID(r.Context())
+	owner := pgtype.Int4{Int32: int32(userID), Valid: userID > 0}
+	if err := s.checkUploadQuota(r.Context(), owner); err != nil {
+		writeQuotaError(w, err)
+		return
+	}
+	name := strings.TrimSpace(r.URL.Query().Get("name"))
+	if name == "" {
+		name = "Upload " + time.Now().UTC().Format("2006-01-02 15:04")
+	}
+	settings, err := s.uploadSettings(r.Context(), r.URL.Query().Get("settings"))
+	if err != nil {
+		writeUploadSettingsError(w, err)
+		return
+	}
+	settingsJSON, template, err := encodeProcessingSettings(settings)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to store processing settings")
+		return
+	}
+
+	key, err := newAudioKey(ext)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to store audio")
+		return
+	}
+	hash := sha256.New()
+	size, err := s.storage.Put(r.Context(), key, io.TeeReader(http.MaxBytesReader(w, r.Body, maxAudioUploadBytes), hash))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "audio file too large")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to store audio")
+		return
+	}
+	if size == 0 {
+		_ = s.storage.Delete(r.Context(), key)
+		writeError(w, http.StatusBadRequest, "audio body is empty")
+		return
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+	if expected != "" && sum != expected {
+		_ = s.storage.Delete(r.Context(), key)
+		writeError(w, http.StatusBadRequest, "audio doesn't match its "+checksumHeader)
+		return
+	}
+	duplicate, found, err := s.findDuplicateAudio(r.Context(), sum)
+	if err != nil {
+		_ = s.storage.Delete(r.Context(), key)
+		writeError(w, http.StatusInternalServerError, "failed to check for duplicates")
+		return
+	}
+	if found {
+		_ = s.storage.Delete(r.Context(), key)
+		writeJSON(w, http.StatusOK, map[string]any{
+			"recording": map[string]any{
+				"id":        duplicate.ID,
+				"name":      duplicate.Name.String,
+				"createdAt": formatTime(duplicate.CreatedAt),
+			},
+			"sizeBytes": size,
+			"duplicate": true,
+		})
+		return
+	}
+	if err := s.checkQuota(r.Context(), owner, usageStorageBytes, size); err != nil {
+		_ = s.storage.Delete(r.Context(), key)
+		writeQuotaError(w, err)
+		return
+	}
+
+	row, err := s.recordings.CreateUploadedRecording(r.Context(), db.CreateUploadedRecordingParams{
+		Name:               pgtype.Text{String: name, Valid: true},
+		AudioKey:           pgtype.Text{String: key, Valid: true},
+		AudioBytes:         pgtype.Int8{Int64: size, Valid: true},
+		CreatedByUserID:    owner,
+		AudioSha256:        pgtype.Text{String: sum, Valid: true},
+		ScanStatus:         s.newAudioScanStatus(),
+		PromptTemplateID:   template,
+		ProcessingSettings: settingsJSON,
+	})
+	if err != nil {
+		if deleteErr := s.storage.Delete(r.Context(), key); deleteErr != nil {
+			log.Printf("recording upload: failed to clean up %s: %v", key, deleteErr)
+		}
+		writeError(w, http.StatusInternalServerError, "failed to create recording")
+		return
+	}
+	s.recordingCache.invalidate()
+	s.queueAudioScan()
+
+	writeJSON(w, http.StatusCreated, map[string]any{
+		"recording": map[string]any{
+			"id":        row.ID,
+			"name":      row.Name.String,
+			"createdAt": formatTime(row.CreatedAt),
+		},
+		"sizeBytes": size,
+		"duplicate": false,
+	})
+}
+
+var audioExtensions = map[string]string{
+	"audio/mpeg":   ".mp3",
+	"audio/mp3":    ".mp3",
+	"audio/wav":    ".wav",
+	"audio/x-wav":  ".wav",
+	"audio/wave":   ".wav",
+	"audio/flac":   ".flac",
+	"audio/ogg":    ".ogg",
+	"audio/webm":   ".webm",
+	"audio/mp4":    ".m4a",
+	"audio/x-m4a":  ".m4a",
+	"audio/aac":    ".aac",
+	"video/webm":   ".webm",
+	"video/mp4":    ".mp4",
+	"audio/opus":   ".opus",
+	"audio/x-flac": ".flac",
+}
+
+// audioExtension picks the stored file extension from the content type,
+// falling back to the client file name for generic binary uploads.
+func audioExtension(contentType string, filename string) (string, bool) {
+	if ext, ok := audioExtensions[strings.ToLower(contentType)]; ok {
+		return ext, true
+	}
+	if contentType != "" && contentType != "application/octet-stream" {
+		return "", false
+	}
+	ext := strings.ToLower(path.Ext(filename))
+	for _, known := range audioExtensions {
+		if ext == known {
+			return ext, true
+		}
+	}
+	return "", false
+}
+
+func newAudioKey(ext string) (string, error) {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "recordings/" + time.Now().UTC().Format("2006/01") + "/" + hex.EncodeToString(buf) + ext, nil
+}
+
+// parseSHA256 normalizes a client-provided hex SHA-256. An empty value
+// means the client sent none.
+func parseSHA256(value string) (string, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return "", true
+	}
+	if _, err := hex.DecodeString(value); err != nil || len(value) != 2*sha256.Size {
+		return "", false
+	}
+	return value, true
+}
+
+// findDuplicateAudio looks up the recording already holding audio with the
+// given SHA-256.
+func (s *Server) findDuplicateAudio(ctx context.Context, sum string) (db.FindRecordingByAudioHashRow, bool, error) {
+	row, err := s.recordings.FindRecordingByAudioHash(ctx, sum)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return row, false, nil
+	}
+	return row, err == nil, err
+}
+
+// hashObject returns the hex SHA-256 of a stored object's content.
+func (s *Server) hashObject(ctx context.Context, key string) (string, error) {
+	rc, err := s.storage.Open(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, rc); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
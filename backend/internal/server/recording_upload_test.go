@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	db "github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// hashedRecordings finds recordings by the SHA-256 of their audio.
+type hashedRecordings struct {
+	RecordingStore
+	byHash map[string]db.FindRecordingByAudioHashRow
+}
+
+func (h hashedRecordings) FindRecordingByAudioHash(_ context.Context, sum string) (db.FindRecordingByAudioHashRow, error) {
+	row, ok := h.byHash[sum]
+	if !ok {
+		return row, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func sha256Hex(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestAudioExtension(t *testing.T) {
+	cases := []struct {
+		contentType string
+		filename    string
+		want        string
+		ok          bool
+	}{
+		{"audio/mpeg", "", ".mp3", true},
+		{"audio/x-wav", "meeting.bin", ".wav", true},
+		{"application/octet-stream", "Standup.M4A", ".m4a", true},
+		{"", "notes.txt", "", false},
+		{"text/plain", "meeting.wav", "", false},
+	}
+	for _, tc := range cases {
+		got, ok := audioExtension(tc.contentType, tc.filename)
+		if got != tc.want || ok != tc.ok {
+			t.Fatalf("audioExtension(%q, %q) = %q, %v; want %q, %v", tc.contentType, tc.filename, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestRecordingUploadRequiresStorage(t *testing.T) {
+	s := New(nil, []byte("test"), time.Hour)
+	req := httptest.NewRequest(http.MethodPost, "/api/recordings/upload", strings.NewReader("RIFF"))
+	req.Header.Set("Content-Type", "audio/wav")
+	rec := httptest.NewRecorder()
+	s.handleRecordingUpload(rec, req)
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 without storage, got %d", rec.Code)
+	}
+}
+
+func TestRecordingUploadChecksum(t *testing.T) {
+	s := New(nil, []byte("test"), time.Hour)
+	dir := t.TempDir()
+	local, err := storage.NewLocal(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.ConfigureStorage(local)
+	s.recordings = hashedRecordings{byHash: map[string]db.FindRecordingByAudioHashRow{
+		sha256Hex("RIFF"): {ID: 12, Name: pgtype.Text{String: "Standup", Valid: true}, Status: recordingUploaded},
+	}}
+	upload := func(body, checksum string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/recordings/upload", strings.NewReader(body))
+		req.Header.Set("Content-Type", "audio/wav")
+		if checksum != "" {
+			req.Header.Set(checksumHeader, checksum)
+		}
+		rec := httptest.NewRecorder()
+		s.handleRecordingUpload(rec, req)
+		return rec
+	}
+
+	if rec := upload("RIFF", "not-a-hash"); rec.Code != http.StatusBadRequest {
+		t.Fatalf("malformed checksum: %d", rec.Code)
+	}
+	if rec := upload("RIFX", sha256Hex("RIFF")); rec.Code != http.StatusBadRequest {
+		t.Fatalf("mismatched checksum: %d", rec.Code)
+	}
+	rec := upload("RIFF", strings.ToUpper(sha256Hex("RIFF")))
+	var resp struct {
+		Recording struct{ ID int32 }
+		Duplicate bool
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil || rec.Code != http.StatusOK || !resp.Duplicate || resp.Recording.ID != 12 {
+		t.Fatalf("duplicate: %d %s", rec.Code, rec.Body)
+	}
+	_ = filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() {
+			t.Errorf("rejected audio kept: %s", path)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+
+	"connectrpc.com/connect"
+
+	"github.com/mvult/secretary/backend/internal/errtrack"
+)
+
+// maxPanicFrames bounds the stack sent to the error tracker.
+const maxPanicFrames = 64
+
+// errPanicked is what the caller sees of a panic: no detail, but the
+// request ID to quote when reporting it.
+var errPanicked = errors.New("internal error")
+
+// reportPanic logs a recovered panic with its stack and request ID and
+// passes it on to the error tracker. It must be called from the deferred
+// function that recovered, so the stack still shows where the panic
+// happened.
+func (s *Server) reportPanic(ctx context.Context, where string, recovered any) {
+	log.Printf("%s: panic (request %s): %v\n%s", where, requestIDFrom(ctx), recovered, debug.Stack())
+	stack := make([]uintptr, maxPanicFrames)
+	stack = stack[:runtime.Callers(2, stack)]
+	s.capture(ctx, errtrack.Event{
+		Level:   errtrack.LevelFatal,
+		Type:    "panic",
+		Message: fmt.Sprint(recovered),
+		Where:   where,
+		Stack:   stack,
+	})
+}
+
+func internalError(ctx context.Context) error {
+	if id := requestIDFrom(ctx); id != "" {
+		return fmt.Errorf("%w (request %s)", errPanicked, id)
+	}
+	return errPanicked
+}
+
+// recoverInterceptor turns a panicking Connect handler into CodeInternal
+// rather than letting it take the process down. It runs last, so the
+// report names the authenticated caller.
+type recoverInterceptor struct {
+	server *Server
+}
+
+func (i recoverInterceptor) recover(ctx context.Context, procedure string, err *error) {
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+	i.server.rpcStats.recordPanic()
+	i.server.reportPanic(ctx, "rpc "+procedure, recovered)
+	*err = connect.NewError(connect.CodeInternal, internalError(ctx))
+}
+
+func (i recoverInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (resp connect.AnyResponse, err error) {
+		defer i.recover(ctx, req.Spec().Procedure, &err)
+		return next(ctx, req)
+	}
+}
+
+func (i recoverInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i recoverInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) (err error) {
+		defer i.recover(ctx, conn.Spec().Procedure, &err)
+		return next(ctx, conn)
+	}
+}
+
+// recoverPanics does the same for the plain HTTP endpoints, and catches
+// a panic in an interceptor. If the handler had already started its
+// response, the client sees it cut short.
+func (s *Server) recoverPanics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			if recovered == http.ErrAbortHandler {
+				// Handlers abort a response on purpose with this panic.
+				panic(recovered)
+			}
+			s.reportPanic(r.Context(), r.Method+" "+r.URL.Path, recovered)
+			writeError(w, http.StatusInternalServerError, internalError(r.Context()).Error())
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
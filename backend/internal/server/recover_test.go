@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+	"github.com/mvult/secretary/backend/internal/errtrack"
+)
+
+type fakeErrorTracker struct {
+	mu     sync.Mutex
+	events []errtrack.Event
+}
+
+func (f *fakeErrorTracker) Capture(e errtrack.Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, e)
+}
+
+func TestRecoverConnectPanic(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	tracker := &fakeErrorTracker{}
+	srv.ConfigureErrorTracking(tracker)
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	token, err := srv.issueToken(1, tokenClaims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := secretaryv1connect.NewAdminServiceClient(ts.Client(), ts.URL)
+	req := connect.NewRequest(&secretaryv1.GetSystemStatsRequest{})
+	req.Header().Set("Authorization", "Bearer "+token)
+	req.Header().Set(requestIDHeader, "req-1")
+
+	// Without a database the stats query panics.
+	_, err = client.GetSystemStats(context.Background(), req)
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInternal || !strings.Contains(err.Error(), "request req-1") {
+		t.Fatalf("panicking handler: %v", err)
+	}
+	if got := connectErr.Meta().Get(requestIDHeader); got != "req-1" {
+		t.Fatalf("response request ID = %q, want the caller's", got)
+	}
+	// The panic is tracked once, with its stack, and not again as the
+	// internal error the call failed with.
+	if len(tracker.events) != 1 {
+		t.Fatalf("got %d events, want 1", len(tracker.events))
+	}
+	event := tracker.events[0]
+	if event.Type != "panic" || event.Where != "rpc "+secretaryv1connect.AdminServiceGetSystemStatsProcedure || event.RequestID != "req-1" || event.UserID != 1 || len(event.Stack) == 0 {
+		t.Fatalf("event = %+v", event)
+	}
+}
+
+func TestRecoverHTTPPanic(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	tracker := &fakeErrorTracker{}
+	srv.ConfigureErrorTracking(tracker)
+	handler := withRequestID(srv.recoverPanics(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	})))
+
+	rec := httptest.NewRecorder()
+	// A malformed ID from the client is replaced.
+	r := httptest.NewRequest(http.MethodGet, "/api/example", nil)
+	r.Header.Set(requestIDHeader, "not valid\n")
+	handler.ServeHTTP(rec, r)
+	id := rec.Header().Get(requestIDHeader)
+	if rec.Code != http.StatusInternalServerError || !validRequestID(id) || !strings.Contains(rec.Body.String(), id) {
+		t.Fatalf("status %d, request ID %q, body %s", rec.Code, id, rec.Body)
+	}
+	if len(tracker.events) != 1 || tracker.events[0].Message != "boom" || tracker.events[0].RequestID != id {
+		t.Fatalf("events = %+v", tracker.events)
+	}
+}
+
+func TestTrackCallErrors(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	tracker := &fakeErrorTracker{}
+	srv.ConfigureErrorTracking(tracker)
+	observe := observeInterceptor{server: srv}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 3})
+	procedure := secretaryv1connect.AdminServiceGetSystemStatsProcedure
+
+	observe.observe(ctx, procedure, time.Now(), connect.NewError(connect.CodeNotFound, errors.New("no such recording")))
+	observe.observe(ctx, procedure, time.Now(), connect.NewError(connect.CodeInternal, internalError(ctx)))
+	if len(tracker.events) != 0 {
+		t.Fatalf("tracked the caller's mistake or a panic already tracked: %+v", tracker.events)
+	}
+	observe.observe(ctx, procedure, time.Now(), connect.NewError(connect.CodeUnavailable, errors.New("database is down")))
+	if len(tracker.events) != 1 {
+		t.Fatalf("got %d events, want 1", len(tracker.events))
+	}
+	event := tracker.events[0]
+	if event.Type != "unavailable" || event.Where != procedure || event.UserID != 3 || len(event.Fingerprint) != 3 {
+		t.Fatalf("event = %+v", event)
+	}
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/redact"
+	"github.com/mvult/secretary/backend/internal/wiki"
+)
+
+// redactionPolicy is what the organization has chosen to mask.
+func (s *Server) redactionPolicy() redact.Policy {
+	row := s.orgSettings()
+	return redact.Policy{
+		Emails:       row.RedactEmails,
+		PhoneNumbers: row.RedactPhoneNumbers,
+		CreditCards:  row.RedactCreditCards,
+		Profanity:    row.RedactProfanity,
+	}
+}
+
+func redactionPolicyToProto(row db.OrgSetting) *secretaryv1.RedactionPolicy {
+	return &secretaryv1.RedactionPolicy{
+		Emails:       row.RedactEmails,
+		PhoneNumbers: row.RedactPhoneNumbers,
+		CreditCards:  row.RedactCreditCards,
+		Profanity:    row.RedactProfanity,
+	}
+}
+
+// maskText masks text under policy, usually a recording's
+// recordingRedactionPolicy. what describes the text for the log line
+// counting what was masked; the matches themselves are never logged.
+func maskText(policy redact.Policy, text, what string) string {
+	masked, n := policy.Apply(text)
+	if n > 0 {
+		log.Printf("redaction: masked %d matches in %s", n, what)
+	}
+	return masked
+}
+
+// redactRecording is the redaction stage for what the transcription
+// worker stores itself: the transcript when transcription finishes and the
+// summary when summarization does. The caller holds the row lock.
+func (s *Server) redactRecording(ctx context.Context, q RecordingQueries, id int32, transcript, summary bool) error {
+	row, err := q.GetRecording(ctx, id)
+	if err != nil {
+		return apierr.Wrap(err, "failed to fetch recording")
+	}
+	policy, err := s.rowRedactionPolicy(row.ProcessingSettings)
+	if err != nil {
+		return err
+	}
+	if !policy.Enabled() {
+		return nil
+	}
+	if transcript && row.Transcript.String != "" {
+		plain, err := s.openText(row.Transcript.String)
+		if err != nil {
+			return err
+		}
+		if masked := maskText(policy, plain, fmt.Sprintf("recording %d transcript", id)); masked != plain {
+			sealed, err := s.sealText(masked)
+			if err != nil {
+				return err
+			}
+			if err := q.SetRecordingTranscript(ctx, db.SetRecordingTranscriptParams{ID: id, Transcript: pgtype.Text{String: sealed, Valid: true}}); err != nil {
+				return apierr.Wrap(err, "failed to store redacted transcript")
+			}
+		}
+	}
+	if summary && row.Summary.String != "" {
+		if masked := maskText(policy, row.Summary.String, fmt.Sprintf("recording %d summary", id)); masked != row.Summary.String {
+			if err := q.SetRecordingSummary(ctx, db.SetRecordingSummaryParams{ID: id, Summary: optionalText(masked)}); err != nil {
+				return apierr.Wrap(err, "failed to store redacted summary")
+			}
+		}
+	}
+	return nil
+}
+
+// redactPage masks a wiki page before it is published, so text stored
+// before the policy was switched on doesn't leave the server unmasked.
+func redactPage(policy redact.Policy, page wiki.Page, id int32) wiki.Page {
+	if !policy.Enabled() {
+		return page
+	}
+	what := fmt.Sprintf("recording %d page", id)
+	page.Summary = maskText(policy, page.Summary, what)
+	if minutes := page.Minutes; minutes != nil {
+		redactAll := func(list []string) []string {
+			out := make([]string, len(list))
+			for i, text := range list {
+				out[i] = maskText(policy, text, what)
+			}
+			return out
+		}
+		page.Minutes = &wiki.Minutes{
+			Attendees:   minutes.Attendees,
+			Agenda:      redactAll(minutes.Agenda),
+			Decisions:   redactAll(minutes.Decisions),
+			ActionItems: redactAll(minutes.ActionItems),
+			Notes:       maskText(policy, minutes.Notes, what),
+		}
+	}
+	return page
+}
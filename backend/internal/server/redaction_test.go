@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+func TestRedactionStageMasksWorkerOutput(t *testing.T) {
+	srv, store, _, _ := newTranslationServer(adminUsers{})
+	srv.settingsCache.Store(&db.OrgSetting{RedactEmails: true, RedactProfanity: true})
+	store.status = recordingTranscribing
+	store.transcript = "Speaker 1: send it to ana@example.com\nSpeaker 2: shit, fine"
+	store.summary = ""
+
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_SUMMARIZING, ""); err != nil {
+		t.Fatalf("finish transcribing: %v", err)
+	}
+	if want := "Speaker 1: send it to [email]\nSpeaker 2: s***, fine"; store.transcript != want {
+		t.Fatalf("transcript = %q, want %q", store.transcript, want)
+	}
+
+	// Finishing summarization masks the summary the worker stored.
+	store.summary = "Ana (ana@example.com) owns the rollout."
+	if err := srv.redactRecording(context.Background(), store, 3, false, true); err != nil {
+		t.Fatalf("redact summary: %v", err)
+	}
+	if want := "Ana ([email]) owns the rollout."; store.summary != want {
+		t.Fatalf("summary = %q, want %q", store.summary, want)
+	}
+}
+
+func TestSummarizeRedactsBeforeStoring(t *testing.T) {
+	srv, store, _, _ := newTranslationServer(adminUsers{})
+	srv.settingsCache.Store(&db.OrgSetting{RedactPhoneNumbers: true})
+	store.transcript = "Speaker 1: call 555-123-4567"
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+
+	if _, err := srv.Summarize(ctx, connect.NewRequest(&secretaryv1.SummarizeRequest{Id: 3})); err != nil {
+		t.Fatalf("summarize: %v", err)
+	}
+	if strings.Contains(store.summary, "555") || !strings.Contains(store.summary, "[phone]") {
+		t.Fatalf("summary = %q, want the number masked", store.summary)
+	}
+	if _, err := srv.Summarize(ctx, connect.NewRequest(&secretaryv1.SummarizeRequest{Id: 3, TargetLanguage: "es"})); err != nil {
+		t.Fatalf("summarize in Spanish: %v", err)
+	}
+	if text := store.translations[0].Text; strings.Contains(text, "555") {
+		t.Fatalf("translation = %q, want the number masked", text)
+	}
+}
@@ -0,0 +1,269 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mvult/secretary/backend/internal/shared"
+)
+
+// maxCachedResponses bounds responseCache; when full it starts over rather
+// than tracking recency, which is plenty for the handful of distinct list
+// and detail requests the SPA makes.
+const maxCachedResponses = 256
+
+// responseCache holds read responses keyed by procedure and request. An
+// entry is only served while its ETag still matches the data it was built
+// from, so writers outside this process are picked up on the next read;
+// invalidate drops everything after writes made here. Entries are copied
+// in and out so interceptors may rewrite the responses they pass on.
+//
+// With shared state the entries are also kept there for the other
+// processes, and invalidate reaches every process.
+type responseCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedResponse
+	remote  atomic.Pointer[remoteResponses]
+}
+
+// remoteResponses are the responses in shared state. Their keys carry the
+// generation, which every invalidation bumps, so an invalidation needn't
+// find the entries it drops; they expire.
+type remoteResponses struct {
+	state      SharedState
+	generation atomic.Int64
+}
+
+// responseGenerationKey holds the generation of the shared responses.
+const responseGenerationKey = "responses:generation"
+
+// sharedResponseTTL is how long a response is kept in shared state.
+const sharedResponseTTL = 10 * time.Minute
+
+type cachedResponse struct {
+	etag string
+	msg  proto.Message
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{entries: map[string]cachedResponse{}}
+}
+
+// share keeps the entries in state too, starting at generation.
+func (c *responseCache) share(state SharedState, generation int64) {
+	remote := &remoteResponses{state: state}
+	remote.generation.Store(generation)
+	c.remote.Store(remote)
+}
+
+// get fills into with the response cached under key for etag. It looks in
+// shared state when this process has none, and takes what it finds there
+// for itself. A shared state that fails counts as a miss.
+func (c *responseCache) get(ctx context.Context, key string, etag string, into proto.Message) bool {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && entry.etag == etag {
+		proto.Merge(into, entry.msg)
+		return true
+	}
+	remote := c.remote.Load()
+	if remote == nil {
+		return false
+	}
+	value, ok, err := remote.state.Get(ctx, remote.key(key))
+	if err != nil || !ok {
+		return false
+	}
+	storedETag, data, _ := bytes.Cut(value, []byte{0})
+	if string(storedETag) != etag || proto.Unmarshal(data, into) != nil {
+		return false
+	}
+	c.putLocal(key, etag, into)
+	return true
+}
+
+func (c *responseCache) put(ctx context.Context, key string, etag string, msg proto.Message) {
+	c.putLocal(key, etag, msg)
+	remote := c.remote.Load()
+	if remote == nil {
+		return
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return
+	}
+	value := append([]byte(etag+"\x00"), data...)
+	_ = remote.state.Set(ctx, remote.key(key), value, sharedResponseTTL)
+}
+
+func (c *responseCache) putLocal(key string, etag string, msg proto.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxCachedResponses {
+		c.entries = map[string]cachedResponse{}
+	}
+	c.entries[key] = cachedResponse{etag: etag, msg: proto.Clone(msg)}
+}
+
+// invalidate drops every cached response, in every process when they are
+// shared.
+func (c *responseCache) invalidate() {
+	c.dropLocal(0)
+	remote := c.remote.Load()
+	if remote == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sharedStateTimeout)
+	defer cancel()
+	generation, err := remote.state.Increment(ctx, responseGenerationKey)
+	if err == nil {
+		remote.advance(generation)
+		err = remote.state.Publish(ctx, shared.Event{Kind: shared.EventResponsesChanged, Generation: generation})
+	}
+	if err != nil {
+		log.Printf("shared state: failed to invalidate cached responses: %v", err)
+	}
+}
+
+// dropLocal drops the responses this process holds, after another process
+// moved the shared ones to generation.
+func (c *responseCache) dropLocal(generation int64) {
+	if remote := c.remote.Load(); remote != nil {
+		remote.advance(generation)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]cachedResponse{}
+}
+
+func (r *remoteResponses) advance(generation int64) {
+	for {
+		current := r.generation.Load()
+		if generation <= current || r.generation.CompareAndSwap(current, generation) {
+			return
+		}
+	}
+}
+
+func (r *remoteResponses) key(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return "responses:" + strconv.FormatInt(r.generation.Load(), 10) + ":" + hex.EncodeToString(sum[:])
+}
+
+// cacheKey identifies a request by procedure and its deterministic wire
+// encoding.
+func cacheKey(procedure string, req proto.Message) string {
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	return procedure + "\x00" + string(data)
+}
+
+// weakETag hashes the given version parts into a weak entity tag.
+func weakETag(parts ...any) string {
+	sum := sha256.Sum256([]byte(fmt.Sprint(parts...)))
+	return `W/"` + hex.EncodeToString(sum[:12]) + `"`
+}
+
+// cachedRead serves a cached response for req when its ETag still matches,
+// otherwise calls load and caches the result. The ETag is set on the
+// response either way so conditional GETs can be answered with 304.
+func cachedRead[Req, Res any](ctx context.Context, c *responseCache, req *connect.Request[Req], etag string, load func() (*Res, error)) (*connect.Response[Res], error) {
+	key := cacheKey(req.Spec().Procedure, any(req.Msg).(proto.Message))
+	msg := new(Res)
+	if !c.get(ctx, key, etag, any(msg).(proto.Message)) {
+		loaded, err := load()
+		if err != nil {
+			return nil, err
+		}
+		c.put(ctx, key, etag, any(loaded).(proto.Message))
+		msg = loaded
+	}
+	res := connect.NewResponse(msg)
+	res.Header().Set("ETag", etag)
+	res.Header().Set("Cache-Control", "private, no-cache")
+	return res, nil
+}
+
+// notModified answers conditional GETs (Connect's GET encoding for
+// side-effect-free RPCs) with 304 when the handler's ETag matches
+// If-None-Match. The handler still runs; cachedRead keeps that cheap.
+func notModified(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch := r.Header.Get("If-None-Match")
+		if r.Method != http.MethodGet || ifNoneMatch == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(&conditionalWriter{ResponseWriter: w, ifNoneMatch: ifNoneMatch}, r)
+	})
+}
+
+type conditionalWriter struct {
+	http.ResponseWriter
+	ifNoneMatch string
+	wroteHeader bool
+	suppressed  bool
+}
+
+func (w *conditionalWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if status == http.StatusOK && etagMatches(w.ifNoneMatch, w.Header().Get("ETag")) {
+		w.suppressed = true
+		header := w.Header()
+		header.Del("Content-Length")
+		header.Del("Content-Type")
+		header.Del("Content-Encoding")
+		w.ResponseWriter.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *conditionalWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.suppressed {
+		return len(p), nil
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *conditionalWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok && !w.suppressed {
+		flusher.Flush()
+	}
+}
+
+// etagMatches implements the weak comparison If-None-Match uses.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+	want := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == want {
+			return true
+		}
+	}
+	return false
+}
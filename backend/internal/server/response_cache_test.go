@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+)
+
+func TestCachedRead(t *testing.T) {
+	cache := newResponseCache()
+	req := connect.NewRequest(&secretaryv1.GetRecordingRequest{Id: 7})
+	loads := 0
+	load := func() (*secretaryv1.GetRecordingResponse, error) {
+		loads++
+		return &secretaryv1.GetRecordingResponse{Recording: &secretaryv1.Recording{Id: 7}}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		res, err := cachedRead(context.Background(), cache, req, `W/"a"`, load)
+		if err != nil {
+			t.Fatalf("read: %v", err)
+		}
+		if res.Header().Get("ETag") != `W/"a"` || res.Msg.GetRecording().GetId() != 7 {
+			t.Fatalf("unexpected response %v %v", res.Header(), res.Msg)
+		}
+	}
+	if loads != 1 {
+		t.Fatalf("expected one load for a repeated read, got %d", loads)
+	}
+
+	if _, err := cachedRead(context.Background(), cache, req, `W/"b"`, load); err != nil || loads != 2 {
+		t.Fatalf("expected reload after the etag changed, got %d loads (%v)", loads, err)
+	}
+	cache.invalidate()
+	if _, err := cachedRead(context.Background(), cache, req, `W/"b"`, load); err != nil || loads != 3 {
+		t.Fatalf("expected reload after invalidation, got %d loads (%v)", loads, err)
+	}
+}
+
+func TestNotModified(t *testing.T) {
+	handler := notModified(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `W/"v1"`)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"recordings":[]}`))
+	}))
+
+	cases := []struct {
+		method      string
+		ifNoneMatch string
+		want        int
+	}{
+		{http.MethodGet, `W/"v1"`, http.StatusNotModified},
+		{http.MethodGet, `"v0", "v1"`, http.StatusNotModified},
+		{http.MethodGet, `W/"v0"`, http.StatusOK},
+		{http.MethodGet, "", http.StatusOK},
+		{http.MethodPost, `W/"v1"`, http.StatusOK},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(tc.method, "/secretary.v1.RecordingsService/ListRecordings", nil)
+		if tc.ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", tc.ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != tc.want {
+			t.Fatalf("%s %q: expected %d, got %d", tc.method, tc.ifNoneMatch, tc.want, rec.Code)
+		}
+		if tc.want == http.StatusNotModified && rec.Body.Len() != 0 {
+			t.Fatalf("%s %q: expected empty body, got %q", tc.method, tc.ifNoneMatch, rec.Body.String())
+		}
+	}
+}
@@ -0,0 +1,465 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// Resumable uploads speak the tus 1.0 protocol (https://tus.io) with the
+// creation, termination and expiration extensions, so off-the-shelf tus
+// clients can continue an interrupted upload from the last byte the server
+// kept. Every PATCH is stored as its own part and recorded with its offset;
+// once all bytes are in, the parts are joined into the recording's audio.
+const (
+	tusVersion    = "1.0.0"
+	tusExtensions = "creation,termination,expiration"
+	// tusContentType is the only body type PATCH requests may carry.
+	tusContentType = "application/offset+octet-stream"
+	// resumableUploadTTL is how long an upload survives without progress.
+	resumableUploadTTL = 24 * time.Hour
+	// recordingIDHeader tells the client which recording a finished upload
+	// created; tus itself has no way to return it.
+	recordingIDHeader = "Upload-Recording-Id"
+	// statusChecksumMismatch is the status tus defines for a body that
+	// doesn't match its checksum.
+	statusChecksumMismatch = 460
+)
+
+// errChecksumMismatch means the assembled file doesn't match the sha256
+// the upload was created with.
+var errChecksumMismatch = errors.New("upload doesn't match its checksum")
+
+// ResumableUploadStore tracks resumable uploads and their parts.
+type ResumableUploadStore interface {
+	CreateResumableUpload(ctx context.Context, arg db.CreateResumableUploadParams) (int32, error)
+	GetResumableUpload(ctx context.Context, id int32) (db.GetResumableUploadRow, error)
+	AddResumableUploadPart(ctx context.Context, arg db.AddResumableUploadPartParams) (int64, error)
+	ListResumableUploadParts(ctx context.Context, uploadID int32) ([]string, error)
+	CompleteResumableUpload(ctx context.Context, arg db.CompleteResumableUploadParams) (db.CompleteResumableUploadRow, error)
+	LinkResumableUpload(ctx context.Context, arg db.LinkResumableUploadParams) (int64, error)
+	DeleteResumableUploadParts(ctx context.Context, uploadID int32) error
+	DeleteResumableUpload(ctx context.Context, id int32) ([]string, error)
+	DeleteExpiredResumableUploads(ctx context.Context) ([]string, error)
+}
+
+// handleUploadOptions answers tus discovery requests, which clients send
+// without credentials.
+func (s *Server) handleUploadOptions(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Tus-Resumable", tusVersion)
+	w.Header().Set("Tus-Version", tusVersion)
+	w.Header().Set("Tus-Extension", tusExtensions)
+	w.Header().Set("Tus-Max-Size", strconv.Itoa(maxAudioUploadBytes))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleResumableUploadCreate starts an upload of Upload-Length bytes. The
+// file name, its type, the recording name and optionally the file's hex
+// SHA-256 and the recording's processing settings as JSON come from
+// Upload-Metadata as filename, filetype, name, sha256 and settings.
+func (s *Server) handleResumableUploadCreate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if !checkTusVersion(w, r) {
+		return
+	}
+	userID := currentUserID(r.Context())
+	if userID <= 0 {
+		writeError(w, http.StatusUnauthorized, "unauthenticated")
+		return
+	}
+	if s.storage == nil {
+		writeError(w, http.StatusServiceUnavailable, "audio storage is not configured")
+		return
+	}
+	length, err := strconv.ParseInt(r.Header.Get("Upload-Length"), 10, 64)
+	if err != nil || length <= 0 {
+		writeError(w, http.StatusBadRequest, "Upload-Length must be a positive number of bytes")
+		return
+	}
+	if length > maxAudioUploadBytes {
+		writeError(w, http.StatusRequestEntityTooLarge, "audio file too large")
+		return
+	}
+	metadata, err := parseUploadMetadata(r.Header.Get("Upload-Metadata"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	ext, ok := audioExtension(strings.ToLower(metadata["filetype"]), metadata["filename"])
+	if !ok {
+		writeError(w, http.StatusUnsupportedMediaType, "unsupported audio type")
+		return
+	}
+	expected, ok := parseSHA256(metadata["sha256"])
+	if !ok {
+		writeError(w, http.StatusBadRequest, "sha256 metadata must be a hex SHA-256")
+		return
+	}
+	settings, err := s.uploadSettings(r.Context(), metadata["settings"])
+	if err != nil {
+		writeUploadSettingsError(w, err)
+		return
+	}
+	settingsJSON, template, err := encodeProcessingSettings(settings)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to store processing settings")
+		return
+	}
+	owner := pgtype.Int4{Int32: int32(userID), Valid: true}
+	if err := s.checkUploadQuota(r.Context(), owner); err != nil {
+		writeQuotaError(w, err)
+		return
+	}
+	if err := s.checkQuota(r.Context(), owner, usageStorageBytes, length); err != nil {
+		writeQuotaError(w, err)
+		return
+	}
+	name := strings.TrimSpace(metadata["name"])
+	if name == "" {
+		name = "Upload " + time.Now().UTC().Format("2006-01-02 15:04")
+	}
+
+	expires := time.Now().Add(resumableUploadTTL)
+	id, err := s.resumable.CreateResumableUpload(r.Context(), db.CreateResumableUploadParams{
+		UserID:             int32(userID),
+		Name:               name,
+		Extension:          ext,
+		LengthBytes:        length,
+		ExpiresAt:          pgtype.Timestamptz{Time: expires, Valid: true},
+		Sha256:             pgtype.Text{String: expected, Valid: expected != ""},
+		PromptTemplateID:   template,
+		ProcessingSettings: settingsJSON,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to create upload")
+		return
+	}
+	w.Header().Set("Location", fmt.Sprintf("/api/uploads/%d", id))
+	w.Header().Set("Upload-Expires", expires.UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// handleResumableUpload reports (HEAD), continues (PATCH) or cancels
+// (DELETE) an upload.
+func (s *Server) handleResumableUpload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodHead && r.Method != http.MethodPatch && r.Method != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if !checkTusVersion(w, r) {
+		return
+	}
+	upload, ok := s.openResumableUpload(w, r)
+	if !ok {
+		return
+	}
+	switch r.Method {
+	case http.MethodHead:
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Upload-Length", strconv.FormatInt(upload.LengthBytes, 10))
+		writeUploadProgress(w, upload)
+		w.WriteHeader(http.StatusOK)
+	case http.MethodPatch:
+		s.patchResumableUpload(w, r, upload)
+	case http.MethodDelete:
+		keys, err := s.resumable.DeleteResumableUpload(r.Context(), upload.ID)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to delete upload")
+			return
+		}
+		s.deleteUploadParts(r.Context(), keys)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) patchResumableUpload(w http.ResponseWriter, r *http.Request, upload db.GetResumableUploadRow) {
+	if r.Header.Get("Content-Type") != tusContentType {
+		writeError(w, http.StatusUnsupportedMediaType, "Content-Type must be "+tusContentType)
+		return
+	}
+	offset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		writeError(w, http.StatusBadRequest, "invalid Upload-Offset")
+		return
+	}
+	if offset != upload.ReceivedBytes {
+		writeUploadProgress(w, upload)
+		writeError(w, http.StatusConflict, fmt.Sprintf("upload is at offset %d", upload.ReceivedBytes))
+		return
+	}
+
+	// A dropped connection cancels the request context, but the bytes
+	// that made it are still stored and recorded.
+	ctx := context.WithoutCancel(r.Context())
+	remaining := upload.LengthBytes - upload.ReceivedBytes
+	body := &partialBody{r: io.LimitReader(r.Body, remaining+1)}
+	if remaining > 0 {
+		key, err := uploadPartKey(upload.ID, offset)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to store upload")
+			return
+		}
+		size, err := s.storage.Put(ctx, key, body)
+		switch {
+		case err != nil:
+			writeError(w, http.StatusInternalServerError, "failed to store upload")
+			return
+		case size > remaining:
+			_ = s.storage.Delete(ctx, key)
+			writeError(w, http.StatusRequestEntityTooLarge, "body runs past Upload-Length")
+			return
+		case size == 0:
+			_ = s.storage.Delete(ctx, key)
+		default:
+			added, err := s.resumable.AddResumableUploadPart(ctx, db.AddResumableUploadPartParams{
+				UploadID:  upload.ID,
+				StartByte: offset,
+				SizeBytes: size,
+				ObjectKey: key,
+				ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(resumableUploadTTL), Valid: true},
+			})
+			if err != nil || added == 0 {
+				_ = s.storage.Delete(ctx, key)
+			}
+			if err != nil {
+				writeError(w, http.StatusInternalServerError, "failed to record upload progress")
+				return
+			}
+			if added == 0 {
+				writeError(w, http.StatusConflict, "upload offset changed; send HEAD for the current one")
+				return
+			}
+			upload.ReceivedBytes += size
+			upload.ExpiresAt = pgtype.Timestamptz{Time: time.Now().Add(resumableUploadTTL), Valid: true}
+		}
+	}
+	if body.err != nil {
+		// The bytes before the failure are kept; the client resumes after
+		// them.
+		writeUploadProgress(w, upload)
+		writeError(w, http.StatusBadRequest, "upload interrupted")
+		return
+	}
+
+	if upload.ReceivedBytes == upload.LengthBytes && !upload.RecordingID.Valid {
+		// Quotas may have filled up since the upload started.
+		if err := s.checkQuota(ctx, pgtype.Int4{Int32: upload.UserID, Valid: true}, usageStorageBytes, upload.LengthBytes); err != nil {
+			writeUploadProgress(w, upload)
+			writeQuotaError(w, err)
+			return
+		}
+		recordingID, err := s.completeResumableUpload(ctx, upload)
+		if errors.Is(err, errChecksumMismatch) {
+			writeError(w, statusChecksumMismatch, "the upload doesn't match its sha256 and was discarded")
+			return
+		}
+		if err != nil {
+			log.Printf("resumable upload %d: %v", upload.ID, err)
+			writeError(w, http.StatusInternalServerError, "failed to assemble upload")
+			return
+		}
+		upload.RecordingID = pgtype.Int4{Int32: recordingID, Valid: true}
+	}
+	writeUploadProgress(w, upload)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// completeResumableUpload joins the parts into one audio object and creates
+// the recording, or links the upload to the recording that already has the
+// same audio. A PATCH at the final offset retries it after a failure. An
+// upload that doesn't match its checksum is deleted.
+func (s *Server) completeResumableUpload(ctx context.Context, upload db.GetResumableUploadRow) (int32, error) {
+	parts, err := s.resumable.ListResumableUploadParts(ctx, upload.ID)
+	if err != nil {
+		return 0, fmt.Errorf("list parts: %w", err)
+	}
+	key, err := newAudioKey(upload.Extension)
+	if err != nil {
+		return 0, err
+	}
+	reader := &chunkReader{ctx: ctx, store: s.storage, keys: parts}
+	defer reader.Close()
+	hash := sha256.New()
+	size, err := s.storage.Put(ctx, key, io.TeeReader(reader, hash))
+	if err != nil {
+		return 0, fmt.Errorf("assemble: %w", err)
+	}
+	if size != upload.LengthBytes {
+		_ = s.storage.Delete(ctx, key)
+		return 0, fmt.Errorf("assembled %d of %d bytes", size, upload.LengthBytes)
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+	if upload.Sha256.Valid && sum != upload.Sha256.String {
+		_ = s.storage.Delete(ctx, key)
+		if _, err := s.resumable.DeleteResumableUpload(ctx, upload.ID); err != nil {
+			return 0, fmt.Errorf("delete mismatched upload: %w", err)
+		}
+		s.deleteUploadParts(ctx, parts)
+		return 0, errChecksumMismatch
+	}
+	duplicate, found, err := s.findDuplicateAudio(ctx, sum)
+	if err != nil {
+		_ = s.storage.Delete(ctx, key)
+		return 0, fmt.Errorf("check for duplicates: %w", err)
+	}
+	if found {
+		_ = s.storage.Delete(ctx, key)
+		if _, err := s.resumable.LinkResumableUpload(ctx, db.LinkResumableUploadParams{ID: upload.ID, RecordingID: duplicate.ID}); err != nil {
+			return 0, fmt.Errorf("link duplicate: %w", err)
+		}
+		s.forgetUploadParts(ctx, upload.ID, parts)
+		return duplicate.ID, nil
+	}
+	row, err := s.resumable.CompleteResumableUpload(ctx, db.CompleteResumableUploadParams{ID: upload.ID, AudioKey: key, AudioSha256: sum, ScanStatus: s.newAudioScanStatus()})
+	if err != nil {
+		_ = s.storage.Delete(ctx, key)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, errors.New("upload was completed by another request")
+		}
+		return 0, fmt.Errorf("create recording: %w", err)
+	}
+	s.recordingCache.invalidate()
+	s.queueAudioScan()
+	s.forgetUploadParts(ctx, upload.ID, parts)
+	return row.ID, nil
+}
+
+// forgetUploadParts removes a finished upload's parts, which the upload row
+// outlives so HEAD can still report the recording.
+func (s *Server) forgetUploadParts(ctx context.Context, uploadID int32, parts []string) {
+	if err := s.resumable.DeleteResumableUploadParts(ctx, uploadID); err != nil {
+		log.Printf("resumable upload %d: failed to forget parts: %v", uploadID, err)
+	}
+	s.deleteUploadParts(ctx, parts)
+}
+
+// openResumableUpload loads the upload named in the path and checks that it
+// belongs to the caller.
+func (s *Server) openResumableUpload(w http.ResponseWriter, r *http.Request) (db.GetResumableUploadRow, bool) {
+	userID := currentUserID(r.Context())
+	if userID <= 0 {
+		writeError(w, http.StatusUnauthorized, "unauthenticated")
+		return db.GetResumableUploadRow{}, false
+	}
+	if s.storage == nil {
+		writeError(w, http.StatusServiceUnavailable, "audio storage is not configured")
+		return db.GetResumableUploadRow{}, false
+	}
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusNotFound, "upload not found")
+		return db.GetResumableUploadRow{}, false
+	}
+	upload, err := s.resumable.GetResumableUpload(r.Context(), int32(id))
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && int64(upload.UserID) != userID) {
+		writeError(w, http.StatusNotFound, "upload not found")
+		return db.GetResumableUploadRow{}, false
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load upload")
+		return db.GetResumableUploadRow{}, false
+	}
+	return upload, true
+}
+
+func (s *Server) deleteUploadParts(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.storage.Delete(ctx, key); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			log.Printf("resumable upload: failed to delete part %s: %v", key, err)
+		}
+	}
+}
+
+// sweepResumableUploads deletes uploads that made no progress within
+// resumableUploadTTL, and finished ones once they expire.
+func (s *Server) sweepResumableUploads(ctx context.Context) error {
+	keys, err := s.resumable.DeleteExpiredResumableUploads(ctx)
+	if err != nil {
+		return err
+	}
+	s.deleteUploadParts(ctx, keys)
+	return nil
+}
+
+// checkTusVersion rejects requests for a protocol version other than the
+// one implemented, as tus requires.
+func checkTusVersion(w http.ResponseWriter, r *http.Request) bool {
+	w.Header().Set("Tus-Resumable", tusVersion)
+	if r.Header.Get("Tus-Resumable") != tusVersion {
+		w.Header().Set("Tus-Version", tusVersion)
+		writeError(w, http.StatusPreconditionFailed, "unsupported Tus-Resumable version")
+		return false
+	}
+	return true
+}
+
+func writeUploadProgress(w http.ResponseWriter, upload db.GetResumableUploadRow) {
+	w.Header().Set("Upload-Offset", strconv.FormatInt(upload.ReceivedBytes, 10))
+	w.Header().Set("Upload-Expires", upload.ExpiresAt.Time.UTC().Format(http.TimeFormat))
+	if upload.RecordingID.Valid {
+		w.Header().Set(recordingIDHeader, strconv.Itoa(int(upload.RecordingID.Int32)))
+	}
+}
+
+// parseUploadMetadata decodes the comma-separated "key base64value" pairs
+// of an Upload-Metadata header.
+func parseUploadMetadata(header string) (map[string]string, error) {
+	metadata := map[string]string{}
+	for _, pair := range strings.Split(header, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, encoded, _ := strings.Cut(pair, " ")
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("Upload-Metadata value for %q is not base64", key)
+		}
+		metadata[key] = string(value)
+	}
+	return metadata, nil
+}
+
+// uploadPartKey names a part by its offset plus a random suffix, so two
+// requests racing for the same offset never overwrite each other's data.
+func uploadPartKey(uploadID int32, offset int64) (string, error) {
+	buf := make([]byte, 6)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("uploads/%d/%012d-%s.part", uploadID, offset, hex.EncodeToString(buf)), nil
+}
+
+// partialBody ends a request body at the first read error, such as a
+// dropped connection, so the bytes received before it can be kept.
+type partialBody struct {
+	r   io.Reader
+	err error
+}
+
+func (p *partialBody) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if err != nil && !errors.Is(err, io.EOF) {
+		p.err = err
+		return n, io.EOF
+	}
+	return n, err
+}
@@ -0,0 +1,238 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	db "github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+type memoryResumableUploads struct {
+	uploads   map[int32]db.GetResumableUploadRow
+	parts     map[int32][]string
+	audioKeys []string
+}
+
+func (m *memoryResumableUploads) CreateResumableUpload(_ context.Context, arg db.CreateResumableUploadParams) (int32, error) {
+	id := int32(len(m.uploads) + 1)
+	m.uploads[id] = db.GetResumableUploadRow{ID: id, UserID: arg.UserID, Name: arg.Name, Extension: arg.Extension, LengthBytes: arg.LengthBytes, ExpiresAt: arg.ExpiresAt, Sha256: arg.Sha256}
+	return id, nil
+}
+
+func (m *memoryResumableUploads) GetResumableUpload(_ context.Context, id int32) (db.GetResumableUploadRow, error) {
+	upload, ok := m.uploads[id]
+	if !ok {
+		return upload, pgx.ErrNoRows
+	}
+	return upload, nil
+}
+
+func (m *memoryResumableUploads) AddResumableUploadPart(_ context.Context, arg db.AddResumableUploadPartParams) (int64, error) {
+	upload := m.uploads[arg.UploadID]
+	if upload.ReceivedBytes != arg.StartByte {
+		return 0, nil
+	}
+	upload.ReceivedBytes += arg.SizeBytes
+	m.uploads[arg.UploadID] = upload
+	m.parts[arg.UploadID] = append(m.parts[arg.UploadID], arg.ObjectKey)
+	return 1, nil
+}
+
+func (m *memoryResumableUploads) ListResumableUploadParts(_ context.Context, uploadID int32) ([]string, error) {
+	return m.parts[uploadID], nil
+}
+
+func (m *memoryResumableUploads) CompleteResumableUpload(_ context.Context, arg db.CompleteResumableUploadParams) (db.CompleteResumableUploadRow, error) {
+	upload := m.uploads[arg.ID]
+	m.audioKeys = append(m.audioKeys, arg.AudioKey)
+	upload.RecordingID = pgtype.Int4{Int32: int32(40 + len(m.audioKeys)), Valid: true}
+	m.uploads[arg.ID] = upload
+	return db.CompleteResumableUploadRow{ID: upload.RecordingID.Int32}, nil
+}
+
+func (m *memoryResumableUploads) LinkResumableUpload(_ context.Context, arg db.LinkResumableUploadParams) (int64, error) {
+	upload := m.uploads[arg.ID]
+	if upload.RecordingID.Valid {
+		return 0, nil
+	}
+	upload.RecordingID = pgtype.Int4{Int32: arg.RecordingID, Valid: true}
+	m.uploads[arg.ID] = upload
+	return 1, nil
+}
+
+func (m *memoryResumableUploads) DeleteResumableUploadParts(_ context.Context, uploadID int32) error {
+	delete(m.parts, uploadID)
+	return nil
+}
+
+func (m *memoryResumableUploads) DeleteResumableUpload(_ context.Context, id int32) ([]string, error) {
+	keys := m.parts[id]
+	delete(m.uploads, id)
+	delete(m.parts, id)
+	return keys, nil
+}
+
+func (m *memoryResumableUploads) DeleteExpiredResumableUploads(context.Context) ([]string, error) {
+	return nil, nil
+}
+
+// droppedBody yields data and then fails, like a connection lost mid-body.
+type droppedBody struct {
+	data io.Reader
+}
+
+func (d droppedBody) Read(p []byte) (int, error) {
+	n, err := d.data.Read(p)
+	if errors.Is(err, io.EOF) {
+		return n, io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func tusRequest(method, target string, body io.Reader, headers map[string]string) *http.Request {
+	req := httptest.NewRequest(method, target, body)
+	req.Header.Set("Tus-Resumable", tusVersion)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	req.SetPathValue("id", strings.TrimPrefix(target, "/api/uploads/"))
+	return req.WithContext(withPrincipal(req.Context(), Principal{UserID: 7}))
+}
+
+func TestResumableUploadResumesAfterDrop(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	local, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureStorage(local)
+	uploads := &memoryResumableUploads{uploads: map[int32]db.GetResumableUploadRow{}, parts: map[int32][]string{}}
+	srv.resumable = uploads
+	srv.recordings = hashedRecordings{}
+
+	rec := httptest.NewRecorder()
+	srv.handleResumableUploadCreate(rec, tusRequest(http.MethodPost, "/api/uploads", nil, map[string]string{
+		"Upload-Length":   "10",
+		"Upload-Metadata": "filename " + base64.StdEncoding.EncodeToString([]byte("standup.mp3")) + ",name " + base64.StdEncoding.EncodeToString([]byte("Standup")),
+	}))
+	location := rec.Header().Get("Location")
+	if rec.Code != http.StatusCreated || location != "/api/uploads/1" {
+		t.Fatalf("create: %d %q", rec.Code, location)
+	}
+
+	patch := func(offset string, body io.Reader) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		srv.handleResumableUpload(rec, tusRequest(http.MethodPatch, location, body, map[string]string{
+			"Content-Type":  tusContentType,
+			"Upload-Offset": offset,
+		}))
+		return rec
+	}
+	if rec := patch("0", droppedBody{strings.NewReader("0123")}); rec.Code != http.StatusBadRequest || rec.Header().Get("Upload-Offset") != "4" {
+		t.Fatalf("dropped patch: %d offset %q", rec.Code, rec.Header().Get("Upload-Offset"))
+	}
+	rec = httptest.NewRecorder()
+	srv.handleResumableUpload(rec, tusRequest(http.MethodHead, location, nil, nil))
+	if rec.Code != http.StatusOK || rec.Header().Get("Upload-Offset") != "4" || rec.Header().Get("Upload-Length") != "10" {
+		t.Fatalf("head: %d %v", rec.Code, rec.Header())
+	}
+	if rec := patch("0", strings.NewReader("0123456789")); rec.Code != http.StatusConflict {
+		t.Fatalf("stale offset: %d", rec.Code)
+	}
+	rec = patch("4", strings.NewReader("456789"))
+	if rec.Code != http.StatusNoContent || rec.Header().Get("Upload-Offset") != "10" || rec.Header().Get(recordingIDHeader) != "41" {
+		t.Fatalf("final patch: %d %v %s", rec.Code, rec.Header(), rec.Body)
+	}
+
+	if len(uploads.audioKeys) != 1 || !strings.HasSuffix(uploads.audioKeys[0], ".mp3") {
+		t.Fatalf("audio keys = %v", uploads.audioKeys)
+	}
+	audio, err := local.Open(context.Background(), uploads.audioKeys[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := io.ReadAll(audio)
+	audio.Close()
+	if string(data) != "0123456789" {
+		t.Fatalf("assembled %q", data)
+	}
+	if len(uploads.parts[1]) != 0 {
+		t.Fatalf("parts kept after completion: %v", uploads.parts[1])
+	}
+}
+
+func TestResumableUploadChecksum(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	local, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureStorage(local)
+	uploads := &memoryResumableUploads{uploads: map[int32]db.GetResumableUploadRow{}, parts: map[int32][]string{}}
+	srv.resumable = uploads
+	srv.recordings = hashedRecordings{byHash: map[string]db.FindRecordingByAudioHashRow{
+		sha256Hex("0123456789"): {ID: 12, Status: recordingReady},
+	}}
+	upload := func(sum, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		srv.handleResumableUploadCreate(rec, tusRequest(http.MethodPost, "/api/uploads", nil, map[string]string{
+			"Upload-Length":   "10",
+			"Upload-Metadata": "filetype " + base64.StdEncoding.EncodeToString([]byte("audio/wav")) + ",sha256 " + base64.StdEncoding.EncodeToString([]byte(sum)),
+		}))
+		if rec.Code != http.StatusCreated {
+			return rec
+		}
+		location := rec.Header().Get("Location")
+		rec = httptest.NewRecorder()
+		srv.handleResumableUpload(rec, tusRequest(http.MethodPatch, location, strings.NewReader(body), map[string]string{
+			"Content-Type":  tusContentType,
+			"Upload-Offset": "0",
+		}))
+		return rec
+	}
+
+	if rec := upload("abc", "0123456789"); rec.Code != http.StatusBadRequest {
+		t.Fatalf("malformed checksum: %d", rec.Code)
+	}
+	if rec := upload(sha256Hex("0123456789"), "9876543210"); rec.Code != statusChecksumMismatch {
+		t.Fatalf("mismatched upload: %d %s", rec.Code, rec.Body)
+	}
+	if len(uploads.uploads) != 0 || len(uploads.parts) != 0 {
+		t.Fatalf("mismatched upload kept: %v %v", uploads.uploads, uploads.parts)
+	}
+	rec := upload(sha256Hex("0123456789"), "0123456789")
+	if rec.Code != http.StatusNoContent || rec.Header().Get(recordingIDHeader) != "12" || len(uploads.audioKeys) != 0 {
+		t.Fatalf("duplicate upload: %d %v %v", rec.Code, rec.Header(), uploads.audioKeys)
+	}
+}
+
+func TestResumableUploadRequiresTusVersion(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	req := tusRequest(http.MethodPost, "/api/uploads", nil, map[string]string{"Tus-Resumable": "0.2.2", "Upload-Length": "10"})
+	rec := httptest.NewRecorder()
+	srv.handleResumableUploadCreate(rec, req)
+	if rec.Code != http.StatusPreconditionFailed || rec.Header().Get("Tus-Version") != tusVersion {
+		t.Fatalf("got %d %v", rec.Code, rec.Header())
+	}
+}
+
+func TestParseUploadMetadata(t *testing.T) {
+	got, err := parseUploadMetadata("filename bWVldGluZy53YXY=, filetype YXVkaW8vd2F2,is_private")
+	if err != nil || got["filename"] != "meeting.wav" || got["filetype"] != "audio/wav" || got["is_private"] != "" {
+		t.Fatalf("got %v, %v", got, err)
+	}
+	if _, err := parseUploadMetadata("filename not-base64!"); err == nil {
+		t.Fatal("accepted invalid base64")
+	}
+}
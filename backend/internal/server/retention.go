@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// RetentionStore holds the queries enforcing the retention policy.
+type RetentionStore interface {
+	ListRetentionCandidates(ctx context.Context, arg db.ListRetentionCandidatesParams) ([]db.ListRetentionCandidatesRow, error)
+	PurgeRecordingAudio(ctx context.Context, id int32) error
+	PurgeRecordingTranscript(ctx context.Context, id int32) error
+	SetRecordingRetainUntil(ctx context.Context, arg db.SetRecordingRetainUntilParams) (int64, error)
+}
+
+// retentionBatch bounds how many recordings one purge handles, so a
+// newly shortened policy is worked through over several runs.
+const retentionBatch = 100
+
+// retentionCutoff is the creation time before which data kept for days is
+// expired; NULL when days is 0 and the data is kept forever.
+func retentionCutoff(days int32, now time.Time) pgtype.Timestamptz {
+	if days <= 0 {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: now.AddDate(0, 0, -int(days)), Valid: true}
+}
+
+// runRetentionPurge removes expired audio and transcripts. In a dry run it
+// only logs what it would remove.
+func (s *Server) runRetentionPurge(ctx context.Context, dryRun bool) error {
+	rows, err := s.purgeExpiredData(ctx, dryRun)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		for _, row := range rows {
+			log.Printf("retention purge (dry run): would remove %s of recording %d %q from %s",
+				purgedData(row), row.ID, row.Name.String, formatTime(row.CreatedAt))
+		}
+	} else if len(rows) > 0 {
+		log.Printf("retention purge: removed data of %d recordings", len(rows))
+	}
+	return nil
+}
+
+func purgedData(row db.ListRetentionCandidatesRow) string {
+	var kinds []string
+	if row.PurgeAudio {
+		kinds = append(kinds, "audio")
+	}
+	if row.PurgeTranscript {
+		kinds = append(kinds, "transcript")
+	}
+	return strings.Join(kinds, " and ")
+}
+
+// purgeExpiredData removes the data the retention policy no longer keeps,
+// oldest first, and returns the recordings it removed data of. A
+// recording that fails is logged and retried on the next run.
+func (s *Server) purgeExpiredData(ctx context.Context, dryRun bool) ([]db.ListRetentionCandidatesRow, error) {
+	settings := s.orgSettings()
+	now := time.Now()
+	arg := db.ListRetentionCandidatesParams{
+		AudioBefore:      retentionCutoff(settings.AudioRetentionDays, now),
+		TranscriptBefore: retentionCutoff(settings.TranscriptRetentionDays, now),
+		MaxRecordings:    retentionBatch,
+	}
+	if !arg.AudioBefore.Valid && !arg.TranscriptBefore.Valid {
+		return nil, nil
+	}
+	rows, err := s.retention.ListRetentionCandidates(ctx, arg)
+	if err != nil || dryRun {
+		return rows, err
+	}
+	purged := rows[:0]
+	for _, row := range rows {
+		if err := s.purgeRecording(ctx, row); err != nil {
+			log.Printf("retention purge: recording %d: %v", row.ID, err)
+			continue
+		}
+		purged = append(purged, row)
+	}
+	if len(purged) > 0 {
+		s.recordingCache.invalidate()
+	}
+	return purged, nil
+}
+
+// purgeRecording deletes stored audio before forgetting it, so a failure
+// leaves the keys in place for the next run.
+func (s *Server) purgeRecording(ctx context.Context, row db.ListRetentionCandidatesRow) error {
+	if row.PurgeAudio {
+		keys := row.ClipAudioKeys
+		// A clone may still play audio it shares with this recording.
+		if row.AudioKey.Valid && !row.AudioKeyShared {
+			keys = append(keys, row.AudioKey.String)
+		}
+		if len(keys) > 0 && s.storage == nil {
+			return errors.New("audio storage is not configured")
+		}
+		for _, key := range keys {
+			if err := s.storage.Delete(ctx, key); err != nil && !errors.Is(err, storage.ErrNotFound) {
+				return fmt.Errorf("delete %s: %w", key, err)
+			}
+		}
+		if err := s.retention.PurgeRecordingAudio(ctx, row.ID); err != nil {
+			return fmt.Errorf("forget audio: %w", err)
+		}
+	}
+	if row.PurgeTranscript {
+		if err := s.retention.PurgeRecordingTranscript(ctx, row.ID); err != nil {
+			return fmt.Errorf("remove transcript: %w", err)
+		}
+	}
+	return nil
+}
+
+// --- SettingsService retention methods ---
+
+func (s *Server) PurgeExpiredData(ctx context.Context, req *connect.Request[secretaryv1.PurgeExpiredDataRequest]) (*connect.Response[secretaryv1.PurgeExpiredDataResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can purge data"); err != nil {
+		return nil, err
+	}
+	rows, err := s.purgeExpiredData(ctx, req.Msg.DryRun)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to purge expired data")
+	}
+	resp := &secretaryv1.PurgeExpiredDataResponse{Recordings: make([]*secretaryv1.PurgedRecording, 0, len(rows))}
+	for _, row := range rows {
+		resp.Recordings = append(resp.Recordings, &secretaryv1.PurgedRecording{
+			RecordingId: int64(row.ID),
+			Name:        row.Name.String,
+			CreatedAt:   formatTime(row.CreatedAt),
+			Audio:       row.PurgeAudio,
+			Transcript:  row.PurgeTranscript,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// --- RecordingsService retention methods ---
+
+func (s *Server) SetRecordingRetention(ctx context.Context, req *connect.Request[secretaryv1.SetRecordingRetentionRequest]) (*connect.Response[secretaryv1.SetRecordingRetentionResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can change a recording's retention"); err != nil {
+		return nil, err
+	}
+	retainUntil, err := parseOptionalTimestamp(req.Msg.RetainUntil)
+	if err != nil {
+		return nil, apierr.InvalidField("retain_until", "must be an RFC3339 timestamp")
+	}
+	n, err := s.retention.SetRecordingRetainUntil(ctx, db.SetRecordingRetainUntilParams{ID: int32(req.Msg.Id), RetainUntil: retainUntil})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to update recording retention")
+	}
+	if n == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	s.recordingCache.invalidate()
+	return connect.NewResponse(&secretaryv1.SetRecordingRetentionResponse{}), nil
+}
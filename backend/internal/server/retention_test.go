@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+type fakeRetention struct {
+	candidates        []db.ListRetentionCandidatesRow
+	listed            db.ListRetentionCandidatesParams
+	audioPurged       []int32
+	transcriptsPurged []int32
+	retainUntil       map[int32]pgtype.Timestamptz
+}
+
+func (f *fakeRetention) ListRetentionCandidates(_ context.Context, arg db.ListRetentionCandidatesParams) ([]db.ListRetentionCandidatesRow, error) {
+	f.listed = arg
+	return append([]db.ListRetentionCandidatesRow(nil), f.candidates...), nil
+}
+
+func (f *fakeRetention) PurgeRecordingAudio(_ context.Context, id int32) error {
+	f.audioPurged = append(f.audioPurged, id)
+	return nil
+}
+
+func (f *fakeRetention) PurgeRecordingTranscript(_ context.Context, id int32) error {
+	f.transcriptsPurged = append(f.transcriptsPurged, id)
+	return nil
+}
+
+func (f *fakeRetention) SetRecordingRetainUntil(_ context.Context, arg db.SetRecordingRetainUntilParams) (int64, error) {
+	if _, ok := f.retainUntil[arg.ID]; !ok {
+		return 0, nil
+	}
+	f.retainUntil[arg.ID] = arg.RetainUntil
+	return 1, nil
+}
+
+func TestPurgeExpiredData(t *testing.T) {
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for _, key := range []string{"recordings/1.webm", "clips/1.mp3"} {
+		if _, err := store.Put(ctx, key, strings.NewReader("audio")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	retention := &fakeRetention{candidates: []db.ListRetentionCandidatesRow{
+		{ID: 1, AudioKey: pgtype.Text{String: "recordings/1.webm", Valid: true}, ClipAudioKeys: []string{"clips/1.mp3"}, PurgeAudio: true},
+		{ID: 2, PurgeTranscript: true},
+	}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.retention = retention
+	srv.ConfigureStorage(store)
+
+	if rows, err := srv.purgeExpiredData(ctx, false); err != nil || rows != nil {
+		t.Fatalf("without a policy = %v, %v", rows, err)
+	}
+
+	srv.settingsCache.Store(&db.OrgSetting{AudioRetentionDays: 90})
+	rows, err := srv.purgeExpiredData(ctx, true)
+	if err != nil || len(rows) != 2 {
+		t.Fatalf("dry run = %v, %v", rows, err)
+	}
+	if retention.listed.TranscriptBefore.Valid || time.Since(retention.listed.AudioBefore.Time) < 89*24*time.Hour {
+		t.Fatalf("cutoffs = %+v", retention.listed)
+	}
+	if len(retention.audioPurged) != 0 || len(retention.transcriptsPurged) != 0 {
+		t.Fatal("dry run removed data")
+	}
+	if _, err := store.Open(ctx, "recordings/1.webm"); err != nil {
+		t.Fatalf("dry run deleted audio: %v", err)
+	}
+
+	rows, err = srv.purgeExpiredData(ctx, false)
+	if err != nil || len(rows) != 2 {
+		t.Fatalf("purge = %v, %v", rows, err)
+	}
+	if len(retention.audioPurged) != 1 || retention.audioPurged[0] != 1 || len(retention.transcriptsPurged) != 1 || retention.transcriptsPurged[0] != 2 {
+		t.Fatalf("purged audio %v, transcripts %v", retention.audioPurged, retention.transcriptsPurged)
+	}
+	for _, key := range []string{"recordings/1.webm", "clips/1.mp3"} {
+		if _, err := store.Open(ctx, key); err == nil {
+			t.Fatalf("%s was not deleted", key)
+		}
+	}
+
+	// Audio that can't be deleted stays referenced, to be retried.
+	srv.storage = nil
+	retention.audioPurged = nil
+	rows, err = srv.purgeExpiredData(ctx, false)
+	if err != nil || len(rows) != 1 || rows[0].ID != 2 || len(retention.audioPurged) != 0 {
+		t.Fatalf("without storage = %v, %v, purged %v", rows, err, retention.audioPurged)
+	}
+}
+
+func TestPurgeKeepsSharedAudio(t *testing.T) {
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := store.Put(ctx, "recordings/1.webm", strings.NewReader("audio")); err != nil {
+		t.Fatal(err)
+	}
+	retention := &fakeRetention{candidates: []db.ListRetentionCandidatesRow{
+		{ID: 1, AudioKey: pgtype.Text{String: "recordings/1.webm", Valid: true}, AudioKeyShared: true, PurgeAudio: true},
+	}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.retention = retention
+	srv.ConfigureStorage(store)
+	srv.settingsCache.Store(&db.OrgSetting{AudioRetentionDays: 90})
+
+	if rows, err := srv.purgeExpiredData(ctx, false); err != nil || len(rows) != 1 || len(retention.audioPurged) != 1 {
+		t.Fatalf("purge = %v, %v, purged %v", rows, err, retention.audioPurged)
+	}
+	if _, err := store.Open(ctx, "recordings/1.webm"); err != nil {
+		t.Fatalf("audio a clone still plays was deleted: %v", err)
+	}
+}
+
+func TestSetRecordingRetention(t *testing.T) {
+	retention := &fakeRetention{retainUntil: map[int32]pgtype.Timestamptz{3: {}}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.retention = retention
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+	set := func(id int64, until string) error {
+		_, err := srv.SetRecordingRetention(ctx, connect.NewRequest(&secretaryv1.SetRecordingRetentionRequest{Id: id, RetainUntil: until}))
+		return err
+	}
+
+	if err := set(3, "2030-01-01T00:00:00Z"); err != nil {
+		t.Fatalf("SetRecordingRetention: %v", err)
+	}
+	if got := retention.retainUntil[3]; !got.Valid || got.Time.Year() != 2030 {
+		t.Fatalf("retain_until = %+v", got)
+	}
+	if err := set(3, ""); err != nil || retention.retainUntil[3].Valid {
+		t.Fatalf("clearing: %v, %+v", err, retention.retainUntil[3])
+	}
+	if err := set(3, "next year"); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("bad date: %v", err)
+	}
+	if err := set(4, ""); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("unknown recording: %v", err)
+	}
+
+	srv.ConfigureStores(nil, nil, memberUsers{})
+	if err := set(3, ""); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member: %v", err)
+	}
+}
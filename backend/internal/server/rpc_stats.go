@@ -0,0 +1,127 @@
+package server
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// rpcStats counts the Connect calls this process served since it started,
+// by procedure and code.
+type rpcStats struct {
+	mu     sync.Mutex
+	calls  map[rpcCallKey]int64
+	time   map[string]time.Duration
+	panics int64
+}
+
+type rpcCallKey struct {
+	procedure string
+	code      string
+}
+
+func newRPCStats() *rpcStats {
+	return &rpcStats{calls: map[rpcCallKey]int64{}, time: map[string]time.Duration{}}
+}
+
+func (st *rpcStats) record(procedure string, err error, elapsed time.Duration) {
+	code := "ok"
+	if err != nil {
+		code = connect.CodeOf(err).String()
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.calls[rpcCallKey{procedure, code}]++
+	st.time[procedure] += elapsed
+}
+
+func (st *rpcStats) recordPanic() {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.panics++
+}
+
+func (st *rpcStats) write(out *bufio.Writer) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	writeMetric(out, "secretary_rpc_panics_total", "counter", "Connect calls whose handler panicked.", strconv.FormatInt(st.panics, 10))
+	if len(st.calls) == 0 {
+		return
+	}
+	keys := make([]rpcCallKey, 0, len(st.calls))
+	for key := range st.calls {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b rpcCallKey) int {
+		return cmp.Or(cmp.Compare(a.procedure, b.procedure), cmp.Compare(a.code, b.code))
+	})
+	writeHeader(out, "secretary_rpc_requests_total", "counter", "Connect calls served, by procedure and code.")
+	for _, key := range keys {
+		fmt.Fprintf(out, "secretary_rpc_requests_total{procedure=%q,code=%q} %d\n", key.procedure, key.code, st.calls[key])
+	}
+	procedures := make([]string, 0, len(st.time))
+	for procedure := range st.time {
+		procedures = append(procedures, procedure)
+	}
+	slices.Sort(procedures)
+	writeHeader(out, "secretary_rpc_seconds_total", "counter", "Time spent serving Connect calls, by procedure.")
+	for _, procedure := range procedures {
+		fmt.Fprintf(out, "secretary_rpc_seconds_total{procedure=%q} %s\n", procedure, formatSeconds(st.time[procedure].Seconds()))
+	}
+}
+
+// serverFault reports whether err is the server's fault rather than the
+// caller's, and so worth logging.
+func serverFault(err error) bool {
+	switch connect.CodeOf(err) {
+	case connect.CodeInternal, connect.CodeUnknown, connect.CodeDataLoss, connect.CodeUnavailable, connect.CodeUnimplemented:
+		return true
+	}
+	return false
+}
+
+// observeInterceptor counts every Connect call for /metrics and logs and
+// tracks the ones that fail through the server's fault. It runs first, so
+// it sees the errors the caller gets.
+type observeInterceptor struct {
+	server *Server
+}
+
+func (i observeInterceptor) observe(ctx context.Context, procedure string, started time.Time, err error) {
+	elapsed := time.Since(started)
+	i.server.rpcStats.record(procedure, err, elapsed)
+	if err != nil && serverFault(err) {
+		i.server.captureCallError(ctx, procedure, err)
+		log.Printf("rpc %s (request %s): %s after %s: %v", procedure, requestIDFrom(ctx), connect.CodeOf(err), elapsed.Round(time.Millisecond), err)
+	}
+}
+
+func (i observeInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		started := time.Now()
+		resp, err := next(ctx, req)
+		i.observe(ctx, req.Spec().Procedure, started, err)
+		return resp, err
+	}
+}
+
+func (i observeInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i observeInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		started := time.Now()
+		err := next(ctx, conn)
+		i.observe(ctx, conn.Spec().Procedure, started, err)
+		return err
+	}
+}
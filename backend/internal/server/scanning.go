@@ -0,0 +1,289 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/scan"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// Malware scan statuses, as stored in attachment.scan_status and
+// recording.scan_status.
+const (
+	scanUnscanned   = "unscanned"
+	scanPending     = "pending"
+	scanClean       = "clean"
+	scanQuarantined = "quarantined"
+	scanReleased    = "released"
+)
+
+// audioScanBatch is how many recordings one round of the audio scan takes
+// on.
+const audioScanBatch = 20
+
+// ScanStore holds the scan results and the quarantine.
+type ScanStore interface {
+	ListRecordingsPendingScan(ctx context.Context, maxRows int32) ([]db.ListRecordingsPendingScanRow, error)
+	SetRecordingScanResult(ctx context.Context, arg db.SetRecordingScanResultParams) error
+	ListQuarantinedFiles(ctx context.Context) ([]db.ListQuarantinedFilesRow, error)
+	ReleaseQuarantinedAttachment(ctx context.Context, id int32) (int64, error)
+	ReleaseQuarantinedRecording(ctx context.Context, id int32) (int64, error)
+	DeleteQuarantinedAttachment(ctx context.Context, id int32) (string, error)
+	DeleteQuarantinedRecording(ctx context.Context, id int32) (pgtype.Text, error)
+}
+
+// ConfigureScanner sets the malware scanner attachments and audio are
+// checked with. Without one, files are stored unscanned.
+func (s *Server) ConfigureScanner(scanner scan.Scanner) {
+	s.scanner = scanner
+}
+
+func mapScanStatus(status string) secretaryv1.ScanStatus {
+	switch status {
+	case scanUnscanned:
+		return secretaryv1.ScanStatus_SCAN_STATUS_UNSCANNED
+	case scanPending:
+		return secretaryv1.ScanStatus_SCAN_STATUS_PENDING
+	case scanClean:
+		return secretaryv1.ScanStatus_SCAN_STATUS_CLEAN
+	case scanQuarantined:
+		return secretaryv1.ScanStatus_SCAN_STATUS_QUARANTINED
+	case scanReleased:
+		return secretaryv1.ScanStatus_SCAN_STATUS_RELEASED
+	}
+	return secretaryv1.ScanStatus_SCAN_STATUS_UNSPECIFIED
+}
+
+// newAudioScanStatus is the status newly stored audio starts in.
+func (s *Server) newAudioScanStatus() string {
+	if s.scanner == nil {
+		return scanUnscanned
+	}
+	return scanPending
+}
+
+// checkAudioScanned fails for audio that hasn't been found clean yet or
+// was quarantined.
+func checkAudioScanned(status string) error {
+	switch status {
+	case scanPending:
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("the recording's audio hasn't been scanned for malware yet"))
+	case scanQuarantined:
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("the recording's audio is quarantined"))
+	}
+	return nil
+}
+
+// checkRecordingScanned is checkAudioScanned for a recording by ID.
+func checkRecordingScanned(ctx context.Context, q RecordingQueries, id int32) error {
+	row, err := q.GetRecording(ctx, id)
+	if err != nil {
+		return apierr.Wrap(err, "failed to fetch recording")
+	}
+	return checkAudioScanned(row.ScanStatus)
+}
+
+// scanAttachment scans an attachment before it is stored and returns the
+// scan status and detail to store it with. Infected files and files the
+// scanner refuses are quarantined.
+func (s *Server) scanAttachment(ctx context.Context, content []byte) (string, pgtype.Text, error) {
+	if s.scanner == nil {
+		return scanUnscanned, pgtype.Text{}, nil
+	}
+	return s.scanFile(ctx, bytes.NewReader(content))
+}
+
+// scanFile returns the scan status and detail for a file. It fails only
+// when the file couldn't be scanned and might be on another try.
+func (s *Server) scanFile(ctx context.Context, r io.Reader) (string, pgtype.Text, error) {
+	verdict, err := s.scanner.Scan(ctx, r)
+	if errors.Is(err, scan.ErrRejected) {
+		return scanQuarantined, pgtype.Text{String: "not scanned: " + err.Error(), Valid: true}, nil
+	}
+	if err != nil {
+		return "", pgtype.Text{}, err
+	}
+	if verdict.Infected {
+		return scanQuarantined, pgtype.Text{String: verdict.Signature, Valid: true}, nil
+	}
+	return scanClean, pgtype.Text{}, nil
+}
+
+// queueAudioScan wakes the audio scan for newly stored audio.
+func (s *Server) queueAudioScan() {
+	if s.scanner == nil {
+		return
+	}
+	select {
+	case s.scanWake <- struct{}{}:
+	default:
+	}
+}
+
+// StartAudioScan scans stored audio waiting for the scanner every interval
+// and whenever audio is uploaded, while this process leads. It returns at
+// once; scanning stops with ctx.
+func (s *Server) StartAudioScan(ctx context.Context, interval time.Duration) {
+	if s.scanner == nil || interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if s.isLeader() {
+				if err := s.scanPendingAudio(ctx); err != nil && ctx.Err() == nil {
+					log.Printf("audio scan: %v", err)
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-s.scanWake:
+			}
+		}
+	}()
+}
+
+// scanPendingAudio scans a batch of pending recordings. It stops at the
+// first recording that can't be scanned, which is tried again next round.
+func (s *Server) scanPendingAudio(ctx context.Context) error {
+	rows, err := s.scans.ListRecordingsPendingScan(ctx, audioScanBatch)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		status, detail, err := s.scanAudio(ctx, row.AudioKey.String)
+		if err != nil {
+			return fmt.Errorf("recording %d: %w", row.ID, err)
+		}
+		if err := s.scans.SetRecordingScanResult(ctx, db.SetRecordingScanResultParams{ID: row.ID, ScanStatus: status, ScanDetail: detail}); err != nil {
+			return fmt.Errorf("recording %d: %w", row.ID, err)
+		}
+		if status == scanQuarantined {
+			log.Printf("audio scan: quarantined recording %d: %s", row.ID, detail.String)
+		}
+		s.recordingCache.invalidate()
+	}
+	return nil
+}
+
+func (s *Server) scanAudio(ctx context.Context, key string) (string, pgtype.Text, error) {
+	rc, err := s.storage.Open(ctx, key)
+	if err != nil {
+		return "", pgtype.Text{}, err
+	}
+	defer rc.Close()
+	return s.scanFile(ctx, rc)
+}
+
+// --- QuarantineService Implementation ---
+
+func (s *Server) ListQuarantinedFiles(ctx context.Context, req *connect.Request[secretaryv1.ListQuarantinedFilesRequest]) (*connect.Response[secretaryv1.ListQuarantinedFilesResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can review quarantined files"); err != nil {
+		return nil, err
+	}
+	rows, err := s.scans.ListQuarantinedFiles(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list quarantined files")
+	}
+	files := make([]*secretaryv1.QuarantinedFile, 0, len(rows))
+	for _, row := range rows {
+		kind := secretaryv1.ScannedFileKind_SCANNED_FILE_KIND_ATTACHMENT
+		if row.Kind == "recording" {
+			kind = secretaryv1.ScannedFileKind_SCANNED_FILE_KIND_RECORDING
+		}
+		files = append(files, &secretaryv1.QuarantinedFile{
+			Kind:             kind,
+			Id:               int64(row.ID),
+			Name:             row.Name,
+			Detail:           row.ScanDetail.String,
+			ScannedAt:        formatTime(row.ScannedAt),
+			UploadedByUserId: int64(row.UserID.Int32),
+		})
+	}
+	return connect.NewResponse(&secretaryv1.ListQuarantinedFilesResponse{Files: files}), nil
+}
+
+func (s *Server) ReleaseQuarantinedFile(ctx context.Context, req *connect.Request[secretaryv1.ReleaseQuarantinedFileRequest]) (*connect.Response[secretaryv1.ReleaseQuarantinedFileResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can release quarantined files"); err != nil {
+		return nil, err
+	}
+	reason := strings.TrimSpace(req.Msg.Reason)
+	if reason == "" {
+		return nil, apierr.InvalidField("reason", "must not be blank")
+	}
+	id := int32(req.Msg.Id)
+	release, kind := s.scans.ReleaseQuarantinedAttachment, "attachment"
+	if req.Msg.Kind == secretaryv1.ScannedFileKind_SCANNED_FILE_KIND_RECORDING {
+		release, kind = s.scans.ReleaseQuarantinedRecording, "recording"
+	}
+	released, err := release(ctx, id)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to release file")
+	}
+	if released == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no quarantined %s with that ID", kind))
+	}
+	userID := currentUserID(ctx)
+	log.Printf("quarantine: %s %d released by user %d: %s", kind, id, userID, reason)
+	s.recordingCache.invalidate()
+	return connect.NewResponse(&secretaryv1.ReleaseQuarantinedFileResponse{}), nil
+}
+
+func (s *Server) DeleteQuarantinedFile(ctx context.Context, req *connect.Request[secretaryv1.DeleteQuarantinedFileRequest]) (*connect.Response[secretaryv1.DeleteQuarantinedFileResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can delete quarantined files"); err != nil {
+		return nil, err
+	}
+	id := int32(req.Msg.Id)
+	var key, kind string
+	if req.Msg.Kind == secretaryv1.ScannedFileKind_SCANNED_FILE_KIND_RECORDING {
+		kind = "recording"
+		audioKey, err := s.scans.DeleteQuarantinedRecording(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			row, err := s.recordings.GetRecording(ctx, id)
+			if err == nil && row.LegalHold && row.ScanStatus == scanQuarantined {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("recording is under legal hold"))
+			}
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("no quarantined recording with that ID"))
+		}
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to delete recording")
+		}
+		key = audioKey.String
+		s.recordingCache.invalidate()
+	} else {
+		kind = "attachment"
+		storageKey, err := s.scans.DeleteQuarantinedAttachment(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("no quarantined attachment with that ID"))
+		}
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to delete attachment")
+		}
+		key = storageKey
+	}
+	if key != "" && s.storage != nil {
+		if err := s.storage.Delete(ctx, key); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			log.Printf("quarantine: failed to remove %s: %v", key, err)
+		}
+	}
+	userID := currentUserID(ctx)
+	log.Printf("quarantine: %s %d deleted by user %d", kind, id, userID)
+	return connect.NewResponse(&secretaryv1.DeleteQuarantinedFileResponse{}), nil
+}
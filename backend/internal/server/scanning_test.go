@@ -0,0 +1,235 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/scan"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// fakeScanner finds "EICAR", refuses files over 16 bytes and is down
+// while unavailable is set.
+type fakeScanner struct {
+	unavailable bool
+}
+
+func (f *fakeScanner) Scan(_ context.Context, r io.Reader) (scan.Verdict, error) {
+	if f.unavailable {
+		return scan.Verdict{}, errors.New("connection refused")
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return scan.Verdict{}, err
+	}
+	if len(data) > 16 {
+		return scan.Verdict{}, fmt.Errorf("%w: size limit exceeded", scan.ErrRejected)
+	}
+	if strings.Contains(string(data), "EICAR") {
+		return scan.Verdict{Infected: true, Signature: "Eicar-Test-Signature"}, nil
+	}
+	return scan.Verdict{}, nil
+}
+
+// memoryScans keeps the recordings the audio scan sees, by ID.
+type memoryScans struct {
+	recordings map[int32]db.GetRecordingRow
+}
+
+func (m *memoryScans) ListRecordingsPendingScan(_ context.Context, maxRows int32) ([]db.ListRecordingsPendingScanRow, error) {
+	var rows []db.ListRecordingsPendingScanRow
+	for id := int32(1); id <= int32(len(m.recordings)); id++ {
+		if rec := m.recordings[id]; rec.ScanStatus == scanPending && len(rows) < int(maxRows) {
+			rows = append(rows, db.ListRecordingsPendingScanRow{ID: id, AudioKey: rec.AudioKey})
+		}
+	}
+	return rows, nil
+}
+
+func (m *memoryScans) SetRecordingScanResult(_ context.Context, arg db.SetRecordingScanResultParams) error {
+	rec := m.recordings[arg.ID]
+	rec.ScanStatus = arg.ScanStatus
+	m.recordings[arg.ID] = rec
+	return nil
+}
+
+func (m *memoryScans) ListQuarantinedFiles(context.Context) ([]db.ListQuarantinedFilesRow, error) {
+	var rows []db.ListQuarantinedFilesRow
+	for id := int32(1); id <= int32(len(m.recordings)); id++ {
+		if rec := m.recordings[id]; rec.ScanStatus == scanQuarantined {
+			rows = append(rows, db.ListQuarantinedFilesRow{Kind: "recording", ID: id, Name: rec.Name.String})
+		}
+	}
+	return rows, nil
+}
+
+func (m *memoryScans) ReleaseQuarantinedAttachment(context.Context, int32) (int64, error) {
+	return 0, nil
+}
+
+func (m *memoryScans) ReleaseQuarantinedRecording(_ context.Context, id int32) (int64, error) {
+	rec, ok := m.recordings[id]
+	if !ok || rec.ScanStatus != scanQuarantined {
+		return 0, nil
+	}
+	rec.ScanStatus = scanReleased
+	m.recordings[id] = rec
+	return 1, nil
+}
+
+func (m *memoryScans) DeleteQuarantinedAttachment(context.Context, int32) (string, error) {
+	return "", pgx.ErrNoRows
+}
+
+func (m *memoryScans) DeleteQuarantinedRecording(_ context.Context, id int32) (pgtype.Text, error) {
+	rec, ok := m.recordings[id]
+	if !ok || rec.ScanStatus != scanQuarantined || rec.LegalHold {
+		return pgtype.Text{}, pgx.ErrNoRows
+	}
+	delete(m.recordings, id)
+	return rec.AudioKey, nil
+}
+
+func TestUploadAttachmentScansContent(t *testing.T) {
+	srv, _ := newAttachmentServer(t, memberUsers{})
+	scanner := &fakeScanner{}
+	srv.ConfigureScanner(scanner)
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+	upload := func(content string) (*secretaryv1.Attachment, error) {
+		resp, err := srv.UploadAttachment(ctx, connect.NewRequest(&secretaryv1.UploadAttachmentRequest{
+			TodoId:      3,
+			FileName:    "notes.txt",
+			ContentType: "text/plain",
+			Content:     []byte(content),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Attachment, nil
+	}
+
+	clean, err := upload("agenda")
+	if err != nil || clean.ScanStatus != secretaryv1.ScanStatus_SCAN_STATUS_CLEAN {
+		t.Fatalf("clean upload: %+v, %v", clean, err)
+	}
+	infected, err := upload("EICAR")
+	if err != nil || infected.ScanStatus != secretaryv1.ScanStatus_SCAN_STATUS_QUARANTINED {
+		t.Fatalf("infected upload: %+v, %v", infected, err)
+	}
+	if _, err := srv.DownloadAttachment(ctx, connect.NewRequest(&secretaryv1.DownloadAttachmentRequest{Id: infected.Id})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("download quarantined: %v", err)
+	}
+	if _, err := srv.DownloadAttachment(ctx, connect.NewRequest(&secretaryv1.DownloadAttachmentRequest{Id: clean.Id})); err != nil {
+		t.Fatalf("download clean: %v", err)
+	}
+	refused, err := upload("a very long agenda for the week")
+	if err != nil || refused.ScanStatus != secretaryv1.ScanStatus_SCAN_STATUS_QUARANTINED {
+		t.Fatalf("refused upload: %+v, %v", refused, err)
+	}
+
+	scanner.unavailable = true
+	if _, err := upload("agenda"); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("scanner down: %v", err)
+	}
+	if rows := srv.attachments.(*fakeAttachments).rows; len(rows) != 3 {
+		t.Fatalf("stored %d attachments, want 3", len(rows))
+	}
+}
+
+func TestScanPendingAudio(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	local, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureStorage(local)
+	scanner := &fakeScanner{}
+	srv.ConfigureScanner(scanner)
+	scans := &memoryScans{recordings: map[int32]db.GetRecordingRow{}}
+	srv.scans = scans
+	for id, audio := range []string{"RIFF", "RIFF EICAR", "RIFF but far too long"} {
+		key := fmt.Sprintf("recordings/2026/10/%d.wav", id+1)
+		if _, err := local.Put(context.Background(), key, strings.NewReader(audio)); err != nil {
+			t.Fatal(err)
+		}
+		scans.recordings[int32(id+1)] = db.GetRecordingRow{ID: int32(id + 1), AudioKey: pgtype.Text{String: key, Valid: true}, ScanStatus: srv.newAudioScanStatus()}
+	}
+
+	scanner.unavailable = true
+	if err := srv.scanPendingAudio(context.Background()); err == nil || scans.recordings[1].ScanStatus != scanPending {
+		t.Fatalf("scanner down: %v, status %s", err, scans.recordings[1].ScanStatus)
+	}
+	scanner.unavailable = false
+	if err := srv.scanPendingAudio(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for id, want := range map[int32]string{1: scanClean, 2: scanQuarantined, 3: scanQuarantined} {
+		if got := scans.recordings[id].ScanStatus; got != want {
+			t.Errorf("recording %d: %s, want %s", id, got, want)
+		}
+	}
+	if err := checkAudioScanned(scans.recordings[2].ScanStatus); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("quarantined audio usable: %v", err)
+	}
+}
+
+func TestQuarantineReview(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	local, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureStorage(local)
+	scans := &memoryScans{recordings: map[int32]db.GetRecordingRow{
+		1: {ID: 1, Name: optionalText("Standup"), ScanStatus: scanQuarantined, AudioKey: pgtype.Text{String: "recordings/2026/10/1.wav", Valid: true}},
+		2: {ID: 2, Name: optionalText("Retro"), ScanStatus: scanQuarantined, AudioKey: pgtype.Text{String: "recordings/2026/10/2.wav", Valid: true}},
+	}}
+	srv.scans = scans
+	if _, err := local.Put(context.Background(), "recordings/2026/10/2.wav", strings.NewReader("EICAR")); err != nil {
+		t.Fatal(err)
+	}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+	recording := secretaryv1.ScannedFileKind_SCANNED_FILE_KIND_RECORDING
+
+	srv.ConfigureStores(nil, nil, memberUsers{})
+	if _, err := srv.ListQuarantinedFiles(ctx, connect.NewRequest(&secretaryv1.ListQuarantinedFilesRequest{})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member list: %v", err)
+	}
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	list, err := srv.ListQuarantinedFiles(ctx, connect.NewRequest(&secretaryv1.ListQuarantinedFilesRequest{}))
+	if err != nil || len(list.Msg.Files) != 2 || list.Msg.Files[0].Kind != recording || list.Msg.Files[0].Name != "Standup" {
+		t.Fatalf("list: %+v, %v", list, err)
+	}
+
+	release := connect.NewRequest(&secretaryv1.ReleaseQuarantinedFileRequest{Kind: recording, Id: 1, Reason: "false positive"})
+	if _, err := srv.ReleaseQuarantinedFile(ctx, release); err != nil {
+		t.Fatal(err)
+	}
+	if scans.recordings[1].ScanStatus != scanReleased {
+		t.Fatalf("status = %s", scans.recordings[1].ScanStatus)
+	}
+	if _, err := srv.ReleaseQuarantinedFile(ctx, release); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("second release: %v", err)
+	}
+
+	if _, err := srv.DeleteQuarantinedFile(ctx, connect.NewRequest(&secretaryv1.DeleteQuarantinedFileRequest{Kind: recording, Id: 2})); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := scans.recordings[2]; ok {
+		t.Fatal("recording kept")
+	}
+	if _, err := local.Open(context.Background(), "recordings/2026/10/2.wav"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("audio kept: %v", err)
+	}
+}
@@ -0,0 +1,399 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/schedule"
+)
+
+// ScheduleStore records scheduled task runs, shared by every server
+// process. LockScheduledTask takes the task's advisory lock, held until
+// unlock is called.
+type ScheduleStore interface {
+	ListScheduledTasks(ctx context.Context) ([]db.ScheduledTask, error)
+	CreateScheduledTasks(ctx context.Context, names []string) error
+	ClaimScheduledTask(ctx context.Context, arg db.ClaimScheduledTaskParams) (int64, error)
+	FinishScheduledTask(ctx context.Context, arg db.FinishScheduledTaskParams) error
+	UpdateScheduledTask(ctx context.Context, arg db.UpdateScheduledTaskParams) (db.ScheduledTask, error)
+	LockScheduledTask(ctx context.Context, name string) (unlock func(), locked bool, err error)
+}
+
+// The scheduled tasks.
+const (
+	taskRetentionPurge = "retention-purge"
+	taskBackup         = "backup"
+	taskTrackerSync    = "tracker-sync"
+	taskDailyDigest    = "daily-digest"
+	taskWeeklyDigest   = "weekly-digest"
+	taskSnoozeWake     = "snooze-wake"
+)
+
+// scheduleOff is the schedule an admin sets to turn a configured task off.
+const scheduleOff = "off"
+
+// schedulerPoll bounds how long a schedule changed through another
+// process takes to reach this one.
+const schedulerPoll = time.Minute
+
+// ScheduleConfig is when each task runs, as a cron expression or
+// descriptor the schedule package parses. Empty leaves a task off unless
+// an admin schedules it.
+type ScheduleConfig struct {
+	RetentionPurge string
+	Backup         string
+	TrackerSync    string
+	DailyDigest    string
+	WeeklyDigest   string
+	SnoozeWake     string
+	// RetentionDryRun only logs what retention purges would remove.
+	RetentionDryRun bool
+	// BackupRetain is how many backups are kept.
+	BackupRetain int
+}
+
+type scheduledTask struct {
+	name        string
+	description string
+	configured  string
+	run         func(ctx context.Context, slot time.Time) error
+
+	// Guarded by scheduler.mu. schedule is nil while the task is off.
+	spec     string
+	schedule *schedule.Schedule
+	paused   bool
+	next     time.Time
+	running  bool
+}
+
+type scheduler struct {
+	mu    sync.Mutex
+	tasks []*scheduledTask
+	wake  chan struct{}
+	// runs tracks the runs in flight, for tests.
+	runs sync.WaitGroup
+}
+
+// StartScheduler runs the scheduled tasks. Every process keeps the
+// schedule, and each run happens on the first process to claim it. It
+// returns at once; tasks stop being started with ctx.
+func (s *Server) StartScheduler(ctx context.Context, cfg ScheduleConfig) {
+	if s.db == nil {
+		return
+	}
+	s.registerTasks(cfg)
+	go func() {
+		for {
+			wait := s.tick(ctx, time.Now())
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			case <-s.scheduler.wake:
+			}
+		}
+	}()
+}
+
+func (s *Server) registerTasks(cfg ScheduleConfig) {
+	s.backups.mu.Lock()
+	s.backups.retain = max(cfg.BackupRetain, 1)
+	s.backups.mu.Unlock()
+	digest := func(frequency string, period func(time.Time) time.Time) func(context.Context, time.Time) error {
+		return func(ctx context.Context, slot time.Time) error {
+			return s.sendDigests(ctx, frequency, period(slot))
+		}
+	}
+	s.scheduler.mu.Lock()
+	defer s.scheduler.mu.Unlock()
+	s.scheduler.wake = make(chan struct{}, 1)
+	s.scheduler.tasks = []*scheduledTask{
+		{
+			name:        taskRetentionPurge,
+			description: "Removes the audio and transcripts the retention policy no longer keeps.",
+			configured:  cfg.RetentionPurge,
+			run: func(ctx context.Context, _ time.Time) error {
+				return s.runRetentionPurge(ctx, cfg.RetentionDryRun)
+			},
+		},
+		{
+			name:        taskBackup,
+			description: "Writes an instance archive to storage and deletes the oldest backups.",
+			configured:  cfg.Backup,
+			run: func(ctx context.Context, slot time.Time) error {
+				return s.runBackup(ctx, slot.UTC())
+			},
+		},
+		{
+			name:        taskTrackerSync,
+			description: "Polls the issue trackers for changes to the issues of linked todos.",
+			configured:  cfg.TrackerSync,
+			run: func(ctx context.Context, _ time.Time) error {
+				s.pollTrackers(ctx)
+				return nil
+			},
+		},
+		{
+			name:        taskDailyDigest,
+			description: "Emails users who want a daily digest their open todos and the meetings processed in the last day.",
+			configured:  cfg.DailyDigest,
+			run:         digest(digestDaily, func(t time.Time) time.Time { return t.AddDate(0, 0, -1) }),
+		},
+		{
+			name:        taskWeeklyDigest,
+			description: "Emails users who want a weekly digest their open todos and the meetings processed in the last week.",
+			configured:  cfg.WeeklyDigest,
+			run:         digest(digestWeekly, func(t time.Time) time.Time { return t.AddDate(0, 0, -7) }),
+		},
+		{
+			name:        taskSnoozeWake,
+			description: "Ends the todo snoozes that have run out and tells the assignees.",
+			configured:  cfg.SnoozeWake,
+			run: func(ctx context.Context, _ time.Time) error {
+				return s.wakeSnoozedTodos(ctx)
+			},
+		},
+	}
+}
+
+// tick reloads the tasks' schedules, starts the runs due by now and
+// returns how long to wait before the next tick.
+func (s *Server) tick(ctx context.Context, now time.Time) time.Duration {
+	rows, err := s.scheduledTaskRows(ctx)
+	if err != nil && ctx.Err() == nil {
+		log.Printf("scheduler: %v", err)
+	}
+	s.scheduler.mu.Lock()
+	defer s.scheduler.mu.Unlock()
+	wait := schedulerPoll
+	for _, task := range s.scheduler.tasks {
+		if err == nil {
+			task.apply(rows[task.name], now)
+		}
+		if task.schedule == nil || task.paused {
+			continue
+		}
+		if !task.next.After(now) {
+			slot := task.next
+			task.next = task.schedule.Next(now)
+			if !task.running {
+				task.running = true
+				s.scheduler.runs.Add(1)
+				go s.runTask(ctx, task, slot)
+			}
+		}
+		wait = min(wait, task.next.Sub(now))
+	}
+	return wait
+}
+
+// scheduledTaskRows returns the tasks' rows by name, adding those missing.
+func (s *Server) scheduledTaskRows(ctx context.Context) (map[string]db.ScheduledTask, error) {
+	rows, err := s.schedules.ListScheduledTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]db.ScheduledTask, len(rows))
+	for _, row := range rows {
+		byName[row.Name] = row
+	}
+	var missing []string
+	s.scheduler.mu.Lock()
+	for _, task := range s.scheduler.tasks {
+		if _, ok := byName[task.name]; !ok {
+			missing = append(missing, task.name)
+		}
+	}
+	s.scheduler.mu.Unlock()
+	if len(missing) > 0 {
+		if err := s.schedules.CreateScheduledTasks(ctx, missing); err != nil {
+			return nil, err
+		}
+	}
+	return byName, nil
+}
+
+// effectiveSpec is what the task runs on given its row: an admin's
+// schedule, or else the configured one. Empty means off.
+func (t *scheduledTask) effectiveSpec(row db.ScheduledTask) string {
+	spec := t.configured
+	if row.Schedule.Valid {
+		spec = row.Schedule.String
+	}
+	if spec == scheduleOff {
+		return ""
+	}
+	return spec
+}
+
+// apply picks up the task's row, working out the next run again when the
+// schedule changed.
+func (t *scheduledTask) apply(row db.ScheduledTask, now time.Time) {
+	t.paused = row.Paused
+	spec := t.effectiveSpec(row)
+	if spec == t.spec && (t.schedule != nil || spec == "") {
+		return
+	}
+	t.spec, t.schedule = spec, nil
+	if spec == "" {
+		return
+	}
+	parsed, err := schedule.Parse(spec)
+	if err != nil {
+		log.Printf("scheduler: %s: %v", t.name, err)
+		return
+	}
+	t.schedule = parsed
+	t.next = parsed.Next(now)
+}
+
+// runTask runs task for the slot unless another process is running it or
+// already ran the slot. The advisory lock keeps a long run from
+// overlapping the next slot's on another process; the claim keeps a slot
+// from running twice.
+func (s *Server) runTask(ctx context.Context, task *scheduledTask, slot time.Time) {
+	defer s.scheduler.runs.Done()
+	defer func() {
+		s.scheduler.mu.Lock()
+		task.running = false
+		s.scheduler.mu.Unlock()
+	}()
+	unlock, locked, err := s.schedules.LockScheduledTask(ctx, task.name)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("scheduler: lock %s: %v", task.name, err)
+		}
+		return
+	}
+	if !locked {
+		return
+	}
+	defer unlock()
+	claimed, err := s.schedules.ClaimScheduledTask(ctx, db.ClaimScheduledTaskParams{
+		Name: task.name,
+		Slot: pgtype.Timestamptz{Time: slot, Valid: true},
+	})
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("scheduler: claim %s: %v", task.name, err)
+		}
+		return
+	}
+	if claimed == 0 {
+		return
+	}
+	runErr := task.run(ctx, slot)
+	var message pgtype.Text
+	if runErr != nil {
+		log.Printf("scheduler: %s: %v", task.name, runErr)
+		s.captureTaskFailure(ctx, task.name, runErr)
+		message = pgtype.Text{String: runErr.Error(), Valid: true}
+	}
+	if err := s.schedules.FinishScheduledTask(context.WithoutCancel(ctx), db.FinishScheduledTaskParams{Name: task.name, Error: message}); err != nil {
+		log.Printf("scheduler: finish %s: %v", task.name, err)
+	}
+}
+
+// taskSchedule returns what the named task runs on, or nil while it is off
+// or paused.
+func (s *Server) taskSchedule(name string) *schedule.Schedule {
+	s.scheduler.mu.Lock()
+	defer s.scheduler.mu.Unlock()
+	for _, task := range s.scheduler.tasks {
+		if task.name == name && !task.paused {
+			return task.schedule
+		}
+	}
+	return nil
+}
+
+func (s *Server) findTask(name string) *scheduledTask {
+	s.scheduler.mu.Lock()
+	defer s.scheduler.mu.Unlock()
+	for _, task := range s.scheduler.tasks {
+		if task.name == name {
+			return task
+		}
+	}
+	return nil
+}
+
+func scheduledTaskToProto(task *scheduledTask, row db.ScheduledTask, now time.Time) *secretaryv1.ScheduledTask {
+	res := &secretaryv1.ScheduledTask{
+		Name:            task.name,
+		Description:     task.description,
+		Schedule:        task.effectiveSpec(row),
+		DefaultSchedule: task.configured,
+		Paused:          row.Paused,
+		LastStartedAt:   formatTime(row.LastStartedAt),
+		LastFinishedAt:  formatTime(row.LastFinishedAt),
+		LastSucceededAt: formatTime(row.LastSucceededAt),
+		LastError:       row.LastError.String,
+		Running:         row.LastStartedAt.Valid && (!row.LastFinishedAt.Valid || row.LastFinishedAt.Time.Before(row.LastStartedAt.Time)),
+	}
+	if res.Schedule != "" && !row.Paused {
+		if parsed, err := schedule.Parse(res.Schedule); err == nil {
+			res.NextRunAt = parsed.Next(now).Format(time.RFC3339)
+		}
+	}
+	return res
+}
+
+func (s *Server) ListScheduledTasks(ctx context.Context, req *connect.Request[secretaryv1.ListScheduledTasksRequest]) (*connect.Response[secretaryv1.ListScheduledTasksResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can list scheduled tasks"); err != nil {
+		return nil, err
+	}
+	rows, err := s.scheduledTaskRows(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list scheduled tasks")
+	}
+	s.scheduler.mu.Lock()
+	tasks := s.scheduler.tasks
+	s.scheduler.mu.Unlock()
+	now := time.Now()
+	res := &secretaryv1.ListScheduledTasksResponse{}
+	for _, task := range tasks {
+		res.Tasks = append(res.Tasks, scheduledTaskToProto(task, rows[task.name], now))
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *Server) UpdateScheduledTask(ctx context.Context, req *connect.Request[secretaryv1.UpdateScheduledTaskRequest]) (*connect.Response[secretaryv1.UpdateScheduledTaskResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can change scheduled tasks"); err != nil {
+		return nil, err
+	}
+	task := s.findTask(req.Msg.Name)
+	if task == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("scheduled task not found"))
+	}
+	arg := db.UpdateScheduledTaskParams{Name: task.name, Paused: req.Msg.Paused}
+	if spec := strings.TrimSpace(req.Msg.Schedule); spec != "" {
+		if spec != scheduleOff {
+			if _, err := schedule.Parse(spec); err != nil {
+				return nil, apierr.InvalidField("schedule", err.Error())
+			}
+		}
+		arg.Schedule = pgtype.Text{String: spec, Valid: true}
+	}
+	row, err := s.schedules.UpdateScheduledTask(ctx, arg)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to update scheduled task")
+	}
+	userID := currentUserID(ctx)
+	log.Printf("scheduler: user %d set %s to %q (paused: %t)", userID, task.name, task.effectiveSpec(row), row.Paused)
+	select {
+	case s.scheduler.wake <- struct{}{}:
+	default:
+	}
+	return connect.NewResponse(&secretaryv1.UpdateScheduledTaskResponse{Task: scheduledTaskToProto(task, row, time.Now())}), nil
+}
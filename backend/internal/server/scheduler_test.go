@@ -0,0 +1,234 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// fakeSchedules is the scheduled_task table and its advisory locks, shared
+// by the servers of a test like the database is by server processes.
+type fakeSchedules struct {
+	mu     sync.Mutex
+	rows   map[string]db.ScheduledTask
+	locked map[string]bool
+}
+
+func newFakeSchedules() *fakeSchedules {
+	return &fakeSchedules{rows: map[string]db.ScheduledTask{}, locked: map[string]bool{}}
+}
+
+func (f *fakeSchedules) ListScheduledTasks(context.Context) ([]db.ScheduledTask, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var rows []db.ScheduledTask
+	for _, row := range f.rows {
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (f *fakeSchedules) CreateScheduledTasks(_ context.Context, names []string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, name := range names {
+		if _, ok := f.rows[name]; !ok {
+			f.rows[name] = db.ScheduledTask{Name: name}
+		}
+	}
+	return nil
+}
+
+func (f *fakeSchedules) ClaimScheduledTask(_ context.Context, arg db.ClaimScheduledTaskParams) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	row := f.rows[arg.Name]
+	if row.Paused || (row.LastSlotAt.Valid && !row.LastSlotAt.Time.Before(arg.Slot.Time)) {
+		return 0, nil
+	}
+	row.LastSlotAt = arg.Slot
+	row.LastStartedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	f.rows[arg.Name] = row
+	return 1, nil
+}
+
+func (f *fakeSchedules) FinishScheduledTask(_ context.Context, arg db.FinishScheduledTaskParams) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	row := f.rows[arg.Name]
+	row.LastFinishedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	row.LastError = arg.Error
+	if !arg.Error.Valid {
+		row.LastSucceededAt = row.LastFinishedAt
+	}
+	f.rows[arg.Name] = row
+	return nil
+}
+
+func (f *fakeSchedules) UpdateScheduledTask(_ context.Context, arg db.UpdateScheduledTaskParams) (db.ScheduledTask, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	row := f.rows[arg.Name]
+	row.Name, row.Schedule, row.Paused = arg.Name, arg.Schedule, arg.Paused
+	f.rows[arg.Name] = row
+	return row, nil
+}
+
+func (f *fakeSchedules) LockScheduledTask(_ context.Context, name string) (func(), bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.locked[name] {
+		return nil, false, nil
+	}
+	f.locked[name] = true
+	return func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		delete(f.locked, name)
+	}, true, nil
+}
+
+// newScheduledServer returns a server whose only task is one named test,
+// scheduled every minute, that runs run.
+func newScheduledServer(schedules *fakeSchedules, run func(context.Context, time.Time) error) *Server {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.schedules = schedules
+	srv.scheduler.tasks = []*scheduledTask{{name: "test", description: "A test task.", configured: "@every 1m", run: run}}
+	return srv
+}
+
+func TestSchedulerRunsEachSlotOnce(t *testing.T) {
+	ctx := context.Background()
+	schedules := newFakeSchedules()
+	var runs atomic.Int32
+	var slots []time.Time
+	var mu sync.Mutex
+	run := func(_ context.Context, slot time.Time) error {
+		runs.Add(1)
+		mu.Lock()
+		slots = append(slots, slot)
+		mu.Unlock()
+		return nil
+	}
+	first, second := newScheduledServer(schedules, run), newScheduledServer(schedules, run)
+	start := time.Date(2026, 10, 18, 9, 0, 30, 0, time.UTC)
+
+	if wait := first.tick(ctx, start); wait != 30*time.Second {
+		t.Fatalf("wait = %s, want 30s until the next minute", wait)
+	}
+	second.tick(ctx, start)
+	if runs.Load() != 0 {
+		t.Fatalf("ran %d times before the first slot", runs.Load())
+	}
+
+	// Both processes wake for the slot; only one runs it.
+	due := start.Add(45 * time.Second)
+	first.tick(ctx, due)
+	second.tick(ctx, due)
+	first.scheduler.runs.Wait()
+	second.scheduler.runs.Wait()
+	if runs.Load() != 1 || !slots[0].Equal(time.Date(2026, 10, 18, 9, 1, 0, 0, time.UTC)) {
+		t.Fatalf("runs = %d, slots = %v; want one run at 09:01", runs.Load(), slots)
+	}
+	if row := schedules.rows["test"]; !row.LastSucceededAt.Valid || row.LastError.Valid {
+		t.Fatalf("row = %+v, want a success recorded", row)
+	}
+}
+
+func TestSchedulerSkipsTaskLockedElsewhere(t *testing.T) {
+	ctx := context.Background()
+	schedules := newFakeSchedules()
+	fail := errors.New("tracker unreachable")
+	var runs atomic.Int32
+	srv := newScheduledServer(schedules, func(context.Context, time.Time) error {
+		runs.Add(1)
+		return fail
+	})
+	start := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	srv.tick(ctx, start)
+
+	// Another process is still running the previous slot.
+	schedules.locked["test"] = true
+	srv.tick(ctx, start.Add(time.Minute))
+	srv.scheduler.runs.Wait()
+	if runs.Load() != 0 {
+		t.Fatalf("ran while locked elsewhere")
+	}
+
+	delete(schedules.locked, "test")
+	srv.tick(ctx, start.Add(2*time.Minute))
+	srv.scheduler.runs.Wait()
+	if row := schedules.rows["test"]; runs.Load() != 1 || row.LastError.String != fail.Error() || row.LastSucceededAt.Valid {
+		t.Fatalf("runs = %d, row = %+v; want the failure recorded", runs.Load(), row)
+	}
+}
+
+func TestUpdateScheduledTask(t *testing.T) {
+	schedules := newFakeSchedules()
+	var runs atomic.Int32
+	srv := newScheduledServer(schedules, func(context.Context, time.Time) error {
+		runs.Add(1)
+		return nil
+	})
+	srv.ConfigureStores(nil, nil, memberUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 2})
+	if _, err := srv.ListScheduledTasks(ctx, connect.NewRequest(&secretaryv1.ListScheduledTasksRequest{})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member list: %v", err)
+	}
+
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	list, err := srv.ListScheduledTasks(ctx, connect.NewRequest(&secretaryv1.ListScheduledTasksRequest{}))
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(list.Msg.Tasks) != 1 || list.Msg.Tasks[0].Schedule != "@every 1m" || list.Msg.Tasks[0].NextRunAt == "" || list.Msg.Tasks[0].LastStartedAt != "" {
+		t.Fatalf("tasks = %v", list.Msg.Tasks)
+	}
+
+	if _, err := srv.UpdateScheduledTask(ctx, connect.NewRequest(&secretaryv1.UpdateScheduledTaskRequest{Name: "test", Schedule: "0 25 * * *"})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("bad schedule: %v", err)
+	}
+	if _, err := srv.UpdateScheduledTask(ctx, connect.NewRequest(&secretaryv1.UpdateScheduledTaskRequest{Name: "nightly"})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("unknown task: %v", err)
+	}
+	updated, err := srv.UpdateScheduledTask(ctx, connect.NewRequest(&secretaryv1.UpdateScheduledTaskRequest{Name: "test", Schedule: "30 2 * * *"}))
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if task := updated.Msg.Task; task.Schedule != "30 2 * * *" || task.DefaultSchedule != "@every 1m" {
+		t.Fatalf("task = %v", task)
+	}
+
+	// The new schedule takes over from the configured one on the next tick.
+	start := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	srv.tick(ctx, start)
+	srv.tick(ctx, start.Add(time.Minute))
+	srv.scheduler.runs.Wait()
+	if runs.Load() != 0 {
+		t.Fatalf("ran on the configured schedule")
+	}
+	if next := srv.scheduler.tasks[0].next; !next.Equal(time.Date(2026, 10, 19, 2, 30, 0, 0, time.UTC)) {
+		t.Fatalf("next = %s", next)
+	}
+
+	off, err := srv.UpdateScheduledTask(ctx, connect.NewRequest(&secretaryv1.UpdateScheduledTaskRequest{Name: "test", Schedule: "off"}))
+	if err != nil {
+		t.Fatalf("turn off: %v", err)
+	}
+	if off.Msg.Task.Schedule != "" || off.Msg.Task.NextRunAt != "" {
+		t.Fatalf("task = %v, want off", off.Msg.Task)
+	}
+	srv.tick(ctx, start.Add(2*time.Minute))
+	if srv.taskSchedule("test") != nil {
+		t.Fatal("task still scheduled")
+	}
+}
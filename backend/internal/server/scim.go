@@ -0,0 +1,750 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/errtrack"
+	"github.com/mvult/secretary/backend/internal/shared"
+)
+
+// SCIM 2.0 (RFC 7643 and 7644) lets an identity provider create, update
+// and deactivate users and push groups. Users sign in with their email
+// address, so a SCIM userName must be one. Groups map to a role and a
+// workspace, set by an admin; see scim_groups.go.
+
+const (
+	scimUserSchema     = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimGroupSchema    = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimListSchema     = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema    = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimConfigSchema   = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	scimResourceSchema = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+
+	// scimMaxResults caps a page of users or groups.
+	scimMaxResults = 200
+)
+
+// ScimQueries are the queries SCIM provisioning runs, usable inside or
+// outside a transaction.
+type ScimQueries interface {
+	ListScimUsers(ctx context.Context, arg db.ListScimUsersParams) ([]db.ListScimUsersRow, error)
+	CountScimUsers(ctx context.Context, arg db.CountScimUsersParams) (int64, error)
+	GetScimUser(ctx context.Context, id int32) (db.GetScimUserRow, error)
+	CreateScimUser(ctx context.Context, arg db.CreateScimUserParams) (int32, error)
+	UpdateScimUser(ctx context.Context, arg db.UpdateScimUserParams) (int64, error)
+	SetUserPassword(ctx context.Context, arg db.SetUserPasswordParams) error
+	SetScimUserRole(ctx context.Context, arg db.SetScimUserRoleParams) error
+	DeactivateUser(ctx context.Context, arg db.DeactivateUserParams) (db.DeactivateUserRow, error)
+	ReactivateUser(ctx context.Context, id int32) (int64, error)
+	ListScimGroups(ctx context.Context, arg db.ListScimGroupsParams) ([]db.ListScimGroupsRow, error)
+	CountScimGroups(ctx context.Context, arg db.CountScimGroupsParams) (int64, error)
+	GetScimGroup(ctx context.Context, id int32) (db.ScimGroup, error)
+	CreateScimGroup(ctx context.Context, arg db.CreateScimGroupParams) (db.ScimGroup, error)
+	UpdateScimGroup(ctx context.Context, arg db.UpdateScimGroupParams) (int64, error)
+	DeleteScimGroup(ctx context.Context, id int32) (int64, error)
+	SetScimGroupMapping(ctx context.Context, arg db.SetScimGroupMappingParams) (db.ScimGroup, error)
+	ListScimGroupMembers(ctx context.Context, groupID int32) ([]db.ListScimGroupMembersRow, error)
+	AddScimGroupMember(ctx context.Context, arg db.AddScimGroupMemberParams) error
+	RemoveScimGroupMember(ctx context.Context, arg db.RemoveScimGroupMemberParams) error
+	ClearScimGroupMembers(ctx context.Context, groupID int32) error
+	ListScimGroupsForUser(ctx context.Context, userID int32) ([]db.ListScimGroupsForUserRow, error)
+	SyncScimWorkspaces(ctx context.Context, userID int32) (db.SyncScimWorkspacesRow, error)
+	ListAllWorkspaces(ctx context.Context) ([]db.Workspace, error)
+}
+
+// ScimStore keeps the users and groups an identity provider manages.
+// Group changes run in a transaction, with the members' roles and
+// workspaces updated to match.
+type ScimStore interface {
+	ScimQueries
+	BeginScimTx(ctx context.Context) (ScimTx, error)
+}
+
+type ScimTx interface {
+	ScimQueries
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+// ConfigureSCIM sets the bearer token identity providers authenticate
+// with. Without one the SCIM endpoints answer 404.
+func (s *Server) ConfigureSCIM(token string) {
+	s.scimToken = token
+}
+
+// scimError is a SCIM error response. It doubles as the error the
+// handlers return, so they can pass it up as is.
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+	status   int
+}
+
+func (e *scimError) Error() string { return e.Detail }
+
+func newScimError(status int, scimType, format string, args ...any) *scimError {
+	return &scimError{
+		Schemas:  []string{scimErrorSchema},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+		status:   status,
+	}
+}
+
+func scimNotFound(kind, id string) *scimError {
+	return newScimError(http.StatusNotFound, "", "%s %s not found", kind, id)
+}
+
+type scimName struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type scimEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// scimRef points at a user from a group or at a group from a user.
+type scimRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+type scimMeta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location"`
+}
+
+type scimUser struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *scimName   `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []scimEmail `json:"emails,omitempty"`
+	Active      *bool       `json:"active,omitempty"`
+	// Password is write-only: it is never sent back.
+	Password string    `json:"password,omitempty"`
+	Groups   []scimRef `json:"groups,omitempty"`
+	Meta     *scimMeta `json:"meta,omitempty"`
+}
+
+type scimGroup struct {
+	Schemas     []string  `json:"schemas"`
+	ID          string    `json:"id,omitempty"`
+	ExternalID  string    `json:"externalId,omitempty"`
+	DisplayName string    `json:"displayName"`
+	Members     []scimRef `json:"members"`
+	Meta        *scimMeta `json:"meta,omitempty"`
+}
+
+type scimListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int64    `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type scimPatchOp struct {
+	Schemas    []string `json:"schemas"`
+	Operations []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	} `json:"Operations"`
+}
+
+// scimHandler serves /scim/v2/, for identity providers holding the SCIM
+// token.
+func (s *Server) scimHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /scim/v2/ServiceProviderConfig", s.handleScimServiceProviderConfig)
+	mux.HandleFunc("GET /scim/v2/ResourceTypes", s.handleScimResourceTypes)
+	mux.Handle("GET /scim/v2/Users", s.scimFunc(s.listScimUsers))
+	mux.Handle("POST /scim/v2/Users", s.scimFunc(s.createScimUser))
+	mux.Handle("GET /scim/v2/Users/{id}", s.scimFunc(s.getScimUser))
+	mux.Handle("PUT /scim/v2/Users/{id}", s.scimFunc(s.replaceScimUser))
+	mux.Handle("PATCH /scim/v2/Users/{id}", s.scimFunc(s.patchScimUser))
+	mux.Handle("DELETE /scim/v2/Users/{id}", s.scimFunc(s.deleteScimUser))
+	mux.Handle("GET /scim/v2/Groups", s.scimFunc(s.listScimGroups))
+	mux.Handle("POST /scim/v2/Groups", s.scimFunc(s.createScimGroup))
+	mux.Handle("GET /scim/v2/Groups/{id}", s.scimFunc(s.getScimGroup))
+	mux.Handle("PUT /scim/v2/Groups/{id}", s.scimFunc(s.replaceScimGroup))
+	mux.Handle("PATCH /scim/v2/Groups/{id}", s.scimFunc(s.patchScimGroup))
+	mux.Handle("DELETE /scim/v2/Groups/{id}", s.scimFunc(s.deleteScimGroup))
+	mux.HandleFunc("/scim/v2/", func(w http.ResponseWriter, r *http.Request) {
+		writeScimError(w, newScimError(http.StatusNotFound, "", "no such endpoint"))
+	})
+	return s.scimAuth(mux)
+}
+
+func (s *Server) scimAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.scimToken == "" {
+			writeScimError(w, newScimError(http.StatusNotFound, "", "SCIM provisioning is not enabled"))
+			return
+		}
+		token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.scimToken)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+			writeScimError(w, newScimError(http.StatusUnauthorized, "", "invalid SCIM token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// scimFunc adapts a handler that returns its status and resource, or an
+// error, to http. Errors other than scimError are logged and reported as
+// 500s.
+func (s *Server) scimFunc(fn func(*http.Request) (int, any, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status, body, err := fn(r)
+		if err != nil {
+			var scimErr *scimError
+			if !errors.As(err, &scimErr) {
+				log.Printf("scim: %s %s: %v", r.Method, r.URL.Path, err)
+				s.capture(r.Context(), errtrack.Event{
+					Level:       errtrack.LevelError,
+					Type:        "scim failure",
+					Message:     err.Error(),
+					Where:       r.Method + " " + r.URL.Path,
+					Fingerprint: []string{"scim", r.Method},
+				})
+				scimErr = newScimError(http.StatusInternalServerError, "", "internal error")
+			}
+			writeScimError(w, scimErr)
+			return
+		}
+		if body == nil {
+			w.WriteHeader(status)
+			return
+		}
+		writeScim(w, status, body)
+	})
+}
+
+func writeScim(w http.ResponseWriter, status int, payload any) {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(payload)
+}
+
+func writeScimError(w http.ResponseWriter, err *scimError) {
+	writeScim(w, err.status, err)
+}
+
+func (s *Server) handleScimServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeScim(w, http.StatusOK, map[string]any{
+		"schemas":        []string{scimConfigSchema},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": scimMaxResults},
+		"changePassword": map[string]bool{"supported": true},
+		"sort":           map[string]bool{"supported": false},
+		"etag":           map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "Bearer token",
+			"description": "The token set in SCIM_TOKEN on the server.",
+			"primary":     true,
+		}},
+	})
+}
+
+func (s *Server) handleScimResourceTypes(w http.ResponseWriter, r *http.Request) {
+	resource := func(name, endpoint, schema string) map[string]any {
+		return map[string]any{
+			"schemas":  []string{scimResourceSchema},
+			"id":       name,
+			"name":     name,
+			"endpoint": endpoint,
+			"schema":   schema,
+			"meta":     scimMeta{ResourceType: "ResourceType", Location: s.scimLocation("ResourceTypes", name)},
+		}
+	}
+	types := []any{resource("User", "/Users", scimUserSchema), resource("Group", "/Groups", scimGroupSchema)}
+	writeScim(w, http.StatusOK, scimListResponse{
+		Schemas:      []string{scimListSchema},
+		TotalResults: int64(len(types)),
+		StartIndex:   1,
+		ItemsPerPage: len(types),
+		Resources:    types,
+	})
+}
+
+func (s *Server) scimLocation(kind, id string) string {
+	return s.publicURL + "/scim/v2/" + kind + "/" + id
+}
+
+// scimFilterPattern matches the one filter form identity providers send
+// to look a resource up: attribute eq "value".
+var scimFilterPattern = regexp.MustCompile(`(?i)^\s*([a-z.]+)\s+eq\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+// parseScimFilter returns the attribute, lowercased, and value of an eq
+// filter. An empty filter returns empty strings.
+func parseScimFilter(filter string) (string, string, error) {
+	if strings.TrimSpace(filter) == "" {
+		return "", "", nil
+	}
+	m := scimFilterPattern.FindStringSubmatch(filter)
+	if m == nil {
+		return "", "", newScimError(http.StatusBadRequest, "invalidFilter", "only filters of the form attribute eq \"value\" are supported")
+	}
+	value, err := strconv.Unquote(m[2])
+	if err != nil {
+		return "", "", newScimError(http.StatusBadRequest, "invalidFilter", "invalid filter value %s", m[2])
+	}
+	return strings.ToLower(m[1]), value, nil
+}
+
+// scimPage reads startIndex, which counts from 1, and count, as an offset
+// and a limit.
+func scimPage(r *http.Request) (int, int, error) {
+	start, count := 1, 100
+	if v := r.URL.Query().Get("startIndex"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, newScimError(http.StatusBadRequest, "invalidValue", "invalid startIndex %q", v)
+		}
+		start = max(n, 1)
+	}
+	if v := r.URL.Query().Get("count"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, newScimError(http.StatusBadRequest, "invalidValue", "invalid count %q", v)
+		}
+		count = min(max(n, 0), scimMaxResults)
+	}
+	return start, count, nil
+}
+
+func scimID(r *http.Request) (int32, string) {
+	raw := r.PathValue("id")
+	id, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || id <= 0 {
+		return 0, raw
+	}
+	return int32(id), raw
+}
+
+func decodeScim(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return newScimError(http.StatusRequestEntityTooLarge, "", "request body exceeds %d bytes", tooLarge.Limit)
+		}
+		return newScimError(http.StatusBadRequest, "invalidSyntax", "invalid JSON: %v", err)
+	}
+	return nil
+}
+
+// auditScim records a change made by the identity provider, with the
+// resource as it stands afterwards.
+func (s *Server) auditScim(r *http.Request, resource any) {
+	detail, err := json.Marshal(resource)
+	if err != nil {
+		detail = []byte("{}")
+	}
+	s.audit(r.Context(), r.Method+" "+r.URL.Path, string(detail))
+}
+
+func (s *Server) listScimUsers(r *http.Request) (int, any, error) {
+	ctx := r.Context()
+	attr, value, err := parseScimFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return 0, nil, err
+	}
+	var email, externalID pgtype.Text
+	switch attr {
+	case "":
+	case "username", "emails.value", "emails":
+		email = pgtype.Text{String: value, Valid: true}
+	case "externalid":
+		externalID = pgtype.Text{String: value, Valid: true}
+	default:
+		return 0, nil, newScimError(http.StatusBadRequest, "invalidFilter", "can't filter users by %s", attr)
+	}
+	start, count, err := scimPage(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	total, err := s.scim.CountScimUsers(ctx, db.CountScimUsersParams{Email: email, ExternalID: externalID})
+	if err != nil {
+		return 0, nil, err
+	}
+	rows, err := s.scim.ListScimUsers(ctx, db.ListScimUsersParams{Email: email, ExternalID: externalID, RowOffset: int32(start - 1), RowLimit: int32(count)})
+	if err != nil {
+		return 0, nil, err
+	}
+	resources := make([]any, 0, len(rows))
+	for _, row := range rows {
+		user, err := s.scimUserResource(ctx, s.scim, db.GetScimUserRow(row))
+		if err != nil {
+			return 0, nil, err
+		}
+		resources = append(resources, user)
+	}
+	return http.StatusOK, scimListResponse{
+		Schemas:      []string{scimListSchema},
+		TotalResults: total,
+		StartIndex:   start,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}, nil
+}
+
+func (s *Server) scimUserResource(ctx context.Context, q ScimQueries, row db.GetScimUserRow) (scimUser, error) {
+	groups, err := q.ListScimGroupsForUser(ctx, row.ID)
+	if err != nil {
+		return scimUser{}, err
+	}
+	id := strconv.Itoa(int(row.ID))
+	active := !row.DeactivatedAt.Valid
+	user := scimUser{
+		Schemas:     []string{scimUserSchema},
+		ID:          id,
+		ExternalID:  row.ScimExternalID.String,
+		UserName:    row.Email.String,
+		Name:        &scimName{GivenName: row.FirstName, FamilyName: row.LastName.String},
+		DisplayName: strings.TrimSpace(row.FirstName + " " + row.LastName.String),
+		Active:      &active,
+		Meta:        &scimMeta{ResourceType: "User", Location: s.scimLocation("Users", id)},
+	}
+	if row.Email.Valid {
+		user.Emails = []scimEmail{{Value: row.Email.String, Type: "work", Primary: true}}
+	}
+	for _, g := range groups {
+		groupID := strconv.Itoa(int(g.ID))
+		user.Groups = append(user.Groups, scimRef{Value: groupID, Display: g.DisplayName, Ref: s.scimLocation("Groups", groupID)})
+	}
+	return user, nil
+}
+
+func (s *Server) loadScimUser(ctx context.Context, r *http.Request) (db.GetScimUserRow, error) {
+	id, raw := scimID(r)
+	if id == 0 {
+		return db.GetScimUserRow{}, scimNotFound("user", raw)
+	}
+	row, err := s.scim.GetScimUser(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.GetScimUserRow{}, scimNotFound("user", raw)
+	}
+	return row, err
+}
+
+func (s *Server) getScimUser(r *http.Request) (int, any, error) {
+	row, err := s.loadScimUser(r.Context(), r)
+	if err != nil {
+		return 0, nil, err
+	}
+	user, err := s.scimUserResource(r.Context(), s.scim, row)
+	return http.StatusOK, user, err
+}
+
+// scimUserFields checks a user resource from the identity provider and
+// returns what the user table keeps of it.
+func scimUserFields(user scimUser) (first, last, email string, err error) {
+	email = strings.TrimSpace(user.UserName)
+	if !strings.Contains(email, "@") {
+		return "", "", "", newScimError(http.StatusBadRequest, "invalidValue", "userName must be the email address the user signs in with")
+	}
+	if user.Name != nil {
+		first, last = strings.TrimSpace(user.Name.GivenName), strings.TrimSpace(user.Name.FamilyName)
+	}
+	if first == "" {
+		first = strings.TrimSpace(user.DisplayName)
+	}
+	if first == "" {
+		first, _, _ = strings.Cut(email, "@")
+	}
+	return first, last, email, nil
+}
+
+// checkScimEmail refuses an email address another user has.
+func (s *Server) checkScimEmail(ctx context.Context, email string, userID int32) error {
+	rows, err := s.scim.ListScimUsers(ctx, db.ListScimUsersParams{Email: pgtype.Text{String: email, Valid: true}, RowLimit: 2})
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if row.ID != userID {
+			return newScimError(http.StatusConflict, "uniqueness", "a user with userName %s already exists", email)
+		}
+	}
+	return nil
+}
+
+// hashScimPassword checks plain against the password policy and hashes
+// it.
+func (s *Server) hashScimPassword(ctx context.Context, plain, email string) (pgtype.Text, error) {
+	if err := s.checkScimPassword(ctx, plain, email); err != nil {
+		return pgtype.Text{}, err
+	}
+	hash, err := s.hashPassword(plain)
+	if err != nil {
+		return pgtype.Text{}, err
+	}
+	return pgtype.Text{String: hash, Valid: true}, nil
+}
+
+func (s *Server) createScimUser(r *http.Request) (int, any, error) {
+	ctx := r.Context()
+	var in scimUser
+	if err := decodeScim(r, &in); err != nil {
+		return 0, nil, err
+	}
+	first, last, email, err := scimUserFields(in)
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := s.checkScimEmail(ctx, email, 0); err != nil {
+		return 0, nil, err
+	}
+	var hash pgtype.Text
+	if in.Password != "" {
+		if hash, err = s.hashScimPassword(ctx, in.Password, email); err != nil {
+			return 0, nil, err
+		}
+	}
+	id, err := s.scim.CreateScimUser(ctx, db.CreateScimUserParams{
+		FirstName:      first,
+		LastName:       pgtype.Text{String: last, Valid: last != ""},
+		Role:           pgtype.Text{String: s.orgSettings().DefaultUserRole, Valid: true},
+		Email:          pgtype.Text{String: email, Valid: true},
+		PasswordHash:   hash,
+		ScimExternalID: pgtype.Text{String: in.ExternalID, Valid: in.ExternalID != ""},
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	if in.Active != nil && !*in.Active {
+		if err := s.setScimUserActive(ctx, id, false); err != nil {
+			return 0, nil, err
+		}
+	}
+	row, err := s.scim.GetScimUser(ctx, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	user, err := s.scimUserResource(ctx, s.scim, row)
+	if err != nil {
+		return 0, nil, err
+	}
+	s.auditScim(r, user)
+	return http.StatusCreated, user, nil
+}
+
+// saveScimUser writes the user resource in over the user's row.
+func (s *Server) saveScimUser(r *http.Request, current db.GetScimUserRow, in scimUser) (int, any, error) {
+	ctx := r.Context()
+	first, last, email, err := scimUserFields(in)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !strings.EqualFold(email, current.Email.String) {
+		if err := s.checkScimEmail(ctx, email, current.ID); err != nil {
+			return 0, nil, err
+		}
+	}
+	// The password is checked before anything is written, so a refused
+	// one leaves the user as it was.
+	var hash pgtype.Text
+	if in.Password != "" {
+		if hash, err = s.hashScimPassword(ctx, in.Password, email); err != nil {
+			return 0, nil, err
+		}
+	}
+	if _, err := s.scim.UpdateScimUser(ctx, db.UpdateScimUserParams{
+		ID:             current.ID,
+		FirstName:      first,
+		LastName:       pgtype.Text{String: last, Valid: last != ""},
+		Email:          pgtype.Text{String: email, Valid: true},
+		ScimExternalID: pgtype.Text{String: in.ExternalID, Valid: in.ExternalID != ""},
+	}); err != nil {
+		return 0, nil, err
+	}
+	if hash.Valid {
+		if err := s.scim.SetUserPassword(ctx, db.SetUserPasswordParams{ID: current.ID, PasswordHash: hash}); err != nil {
+			return 0, nil, err
+		}
+	}
+	if in.Active != nil && *in.Active == current.DeactivatedAt.Valid {
+		if err := s.setScimUserActive(ctx, current.ID, *in.Active); err != nil {
+			return 0, nil, err
+		}
+	}
+	row, err := s.scim.GetScimUser(ctx, current.ID)
+	if err != nil {
+		return 0, nil, err
+	}
+	user, err := s.scimUserResource(ctx, s.scim, row)
+	if err != nil {
+		return 0, nil, err
+	}
+	s.auditScim(r, user)
+	return http.StatusOK, user, nil
+}
+
+// setScimUserActive deactivates or reactivates a user. A deactivated
+// user keeps their todos, as there is no successor to hand them to.
+func (s *Server) setScimUserActive(ctx context.Context, id int32, active bool) error {
+	if active {
+		if _, err := s.scim.ReactivateUser(ctx, id); err != nil {
+			return err
+		}
+		s.setDeactivated(int64(id), false)
+		s.publish(ctx, shared.Event{Kind: shared.EventUserReactivated, UserID: int64(id)})
+		return nil
+	}
+	if _, err := s.scim.DeactivateUser(ctx, db.DeactivateUserParams{UserID: id}); err != nil {
+		return err
+	}
+	s.setDeactivated(int64(id), true)
+	s.publish(ctx, shared.Event{Kind: shared.EventUserDeactivated, UserID: int64(id)})
+	return nil
+}
+
+func (s *Server) replaceScimUser(r *http.Request) (int, any, error) {
+	current, err := s.loadScimUser(r.Context(), r)
+	if err != nil {
+		return 0, nil, err
+	}
+	var in scimUser
+	if err := decodeScim(r, &in); err != nil {
+		return 0, nil, err
+	}
+	return s.saveScimUser(r, current, in)
+}
+
+func (s *Server) patchScimUser(r *http.Request) (int, any, error) {
+	ctx := r.Context()
+	current, err := s.loadScimUser(ctx, r)
+	if err != nil {
+		return 0, nil, err
+	}
+	var patch scimPatchOp
+	if err := decodeScim(r, &patch); err != nil {
+		return 0, nil, err
+	}
+	user, err := s.scimUserResource(ctx, s.scim, current)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, op := range patch.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if op.Path == "" {
+				var attrs map[string]json.RawMessage
+				if err := json.Unmarshal(op.Value, &attrs); err != nil {
+					return 0, nil, newScimError(http.StatusBadRequest, "invalidValue", "an operation without a path needs an object value")
+				}
+				for attr, value := range attrs {
+					if err := setScimUserAttr(&user, attr, value); err != nil {
+						return 0, nil, err
+					}
+				}
+				continue
+			}
+			if err := setScimUserAttr(&user, op.Path, op.Value); err != nil {
+				return 0, nil, err
+			}
+		case "remove":
+			if err := setScimUserAttr(&user, op.Path, json.RawMessage(`""`)); err != nil {
+				return 0, nil, err
+			}
+		default:
+			return 0, nil, newScimError(http.StatusBadRequest, "invalidSyntax", "unknown operation %q", op.Op)
+		}
+	}
+	return s.saveScimUser(r, current, user)
+}
+
+// setScimUserAttr applies one PATCH change. Attributes the app doesn't
+// keep, such as titles or phone numbers, are ignored, since identity
+// providers send whatever they have.
+func setScimUserAttr(user *scimUser, attr string, value json.RawMessage) error {
+	str := func() (string, error) {
+		var v string
+		if err := json.Unmarshal(value, &v); err != nil {
+			return "", newScimError(http.StatusBadRequest, "invalidValue", "%s must be a string", attr)
+		}
+		return v, nil
+	}
+	var err error
+	if user.Name == nil {
+		user.Name = &scimName{}
+	}
+	switch strings.ToLower(attr) {
+	case "active":
+		var active bool
+		// Some providers send the boolean as a string.
+		if json.Unmarshal(value, &active) != nil {
+			var v string
+			if json.Unmarshal(value, &v) != nil {
+				return newScimError(http.StatusBadRequest, "invalidValue", "active must be a boolean")
+			}
+			if active, err = strconv.ParseBool(v); err != nil {
+				return newScimError(http.StatusBadRequest, "invalidValue", "active must be a boolean")
+			}
+		}
+		user.Active = &active
+	case "username":
+		user.UserName, err = str()
+	case "externalid":
+		user.ExternalID, err = str()
+	case "password":
+		user.Password, err = str()
+	case "displayname":
+		user.DisplayName, err = str()
+	case "name.givenname":
+		user.Name.GivenName, err = str()
+	case "name.familyname":
+		user.Name.FamilyName, err = str()
+	case "name":
+		var name scimName
+		if json.Unmarshal(value, &name) != nil {
+			return newScimError(http.StatusBadRequest, "invalidValue", "name must be an object")
+		}
+		user.Name = &name
+	}
+	return err
+}
+
+// deleteScimUser deactivates the user. Their recordings and todos stay,
+// and the user can be reactivated by setting active again.
+func (s *Server) deleteScimUser(r *http.Request) (int, any, error) {
+	ctx := r.Context()
+	current, err := s.loadScimUser(ctx, r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !current.DeactivatedAt.Valid {
+		if err := s.setScimUserActive(ctx, current.ID, false); err != nil {
+			return 0, nil, err
+		}
+	}
+	s.auditScim(r, map[string]any{"id": strconv.Itoa(int(current.ID)), "userName": current.Email.String, "active": false})
+	return http.StatusNoContent, nil, nil
+}
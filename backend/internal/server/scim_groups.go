@@ -0,0 +1,515 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// maxScimGroupsListed caps the groups ListScimGroups returns.
+const maxScimGroupsListed = 10000
+
+// syncScimUser gives a user the role and workspaces their groups map to:
+// admin when any group grants it, member when any grants that, and the
+// organization's default role otherwise. Only users the identity
+// provider manages change role; workspace memberships made in the app
+// stay either way.
+func syncScimUser(ctx context.Context, q ScimQueries, userID int32, defaultRole string) error {
+	groups, err := q.ListScimGroupsForUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	role := defaultRole
+	if slices.ContainsFunc(groups, func(g db.ListScimGroupsForUserRow) bool { return g.Role.String == "admin" }) {
+		role = "admin"
+	} else if slices.ContainsFunc(groups, func(g db.ListScimGroupsForUserRow) bool { return g.Role.String == "member" }) {
+		role = "member"
+	}
+	if err := q.SetScimUserRole(ctx, db.SetScimUserRoleParams{ID: userID, Role: pgtype.Text{String: role, Valid: true}}); err != nil {
+		return err
+	}
+	_, err = q.SyncScimWorkspaces(ctx, userID)
+	return err
+}
+
+// changeScimGroup runs change in a transaction and then syncs everyone who
+// was in the group before or is in it after.
+func (s *Server) changeScimGroup(ctx context.Context, groupID int32, change func(ScimTx) error) error {
+	tx, err := s.scim.BeginScimTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	before, err := tx.ListScimGroupMembers(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if err := change(tx); err != nil {
+		return err
+	}
+	after, err := tx.ListScimGroupMembers(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	affected := map[int32]bool{}
+	for _, m := range slices.Concat(before, after) {
+		affected[m.ID] = true
+	}
+	defaultRole := s.orgSettings().DefaultUserRole
+	for _, id := range slices.Sorted(maps.Keys(affected)) {
+		if err := syncScimUser(ctx, tx, id, defaultRole); err != nil {
+			return fmt.Errorf("sync user %d: %w", id, err)
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+func (s *Server) scimGroupResource(ctx context.Context, q ScimQueries, group db.ScimGroup, withMembers bool) (scimGroup, error) {
+	id := strconv.Itoa(int(group.ID))
+	out := scimGroup{
+		Schemas:     []string{scimGroupSchema},
+		ID:          id,
+		ExternalID:  group.ExternalID.String,
+		DisplayName: group.DisplayName,
+		Members:     []scimRef{},
+		Meta:        &scimMeta{ResourceType: "Group", Location: s.scimLocation("Groups", id)},
+	}
+	if !withMembers {
+		return out, nil
+	}
+	members, err := q.ListScimGroupMembers(ctx, group.ID)
+	if err != nil {
+		return scimGroup{}, err
+	}
+	for _, m := range members {
+		userID := strconv.Itoa(int(m.ID))
+		out.Members = append(out.Members, scimRef{
+			Value:   userID,
+			Display: strings.TrimSpace(m.FirstName + " " + m.LastName.String),
+			Ref:     s.scimLocation("Users", userID),
+		})
+	}
+	return out, nil
+}
+
+// excludesMembers reports whether the caller asked to leave members out,
+// as providers do when they only need a group's name.
+func excludesMembers(r *http.Request) bool {
+	for _, attr := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attr), "members") {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) listScimGroups(r *http.Request) (int, any, error) {
+	ctx := r.Context()
+	attr, value, err := parseScimFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return 0, nil, err
+	}
+	var displayName, externalID pgtype.Text
+	switch attr {
+	case "":
+	case "displayname":
+		displayName = pgtype.Text{String: value, Valid: true}
+	case "externalid":
+		externalID = pgtype.Text{String: value, Valid: true}
+	default:
+		return 0, nil, newScimError(http.StatusBadRequest, "invalidFilter", "can't filter groups by %s", attr)
+	}
+	start, count, err := scimPage(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	total, err := s.scim.CountScimGroups(ctx, db.CountScimGroupsParams{DisplayName: displayName, ExternalID: externalID})
+	if err != nil {
+		return 0, nil, err
+	}
+	rows, err := s.scim.ListScimGroups(ctx, db.ListScimGroupsParams{DisplayName: displayName, ExternalID: externalID, RowOffset: int32(start - 1), RowLimit: int32(count)})
+	if err != nil {
+		return 0, nil, err
+	}
+	withMembers := !excludesMembers(r)
+	resources := make([]any, 0, len(rows))
+	for _, row := range rows {
+		group, err := s.scimGroupResource(ctx, s.scim, db.ScimGroup{ID: row.ID, DisplayName: row.DisplayName, ExternalID: row.ExternalID}, withMembers)
+		if err != nil {
+			return 0, nil, err
+		}
+		resources = append(resources, group)
+	}
+	return http.StatusOK, scimListResponse{
+		Schemas:      []string{scimListSchema},
+		TotalResults: total,
+		StartIndex:   start,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}, nil
+}
+
+func (s *Server) loadScimGroup(ctx context.Context, r *http.Request) (db.ScimGroup, error) {
+	id, raw := scimID(r)
+	if id == 0 {
+		return db.ScimGroup{}, scimNotFound("group", raw)
+	}
+	group, err := s.scim.GetScimGroup(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.ScimGroup{}, scimNotFound("group", raw)
+	}
+	return group, err
+}
+
+func (s *Server) getScimGroup(r *http.Request) (int, any, error) {
+	group, err := s.loadScimGroup(r.Context(), r)
+	if err != nil {
+		return 0, nil, err
+	}
+	out, err := s.scimGroupResource(r.Context(), s.scim, group, !excludesMembers(r))
+	return http.StatusOK, out, err
+}
+
+// scimMemberIDs checks that every member is a user and returns their IDs.
+func scimMemberIDs(ctx context.Context, q ScimQueries, members []scimRef) ([]int32, error) {
+	ids := make([]int32, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.ParseInt(m.Value, 10, 32)
+		if err != nil || id <= 0 {
+			return nil, newScimError(http.StatusBadRequest, "invalidValue", "member %s is not a user", m.Value)
+		}
+		if _, err := q.GetScimUser(ctx, int32(id)); errors.Is(err, pgx.ErrNoRows) {
+			return nil, newScimError(http.StatusBadRequest, "invalidValue", "member %s is not a user", m.Value)
+		} else if err != nil {
+			return nil, err
+		}
+		ids = append(ids, int32(id))
+	}
+	return ids, nil
+}
+
+// finishScimGroup answers with the group as it now stands and records the
+// change.
+func (s *Server) finishScimGroup(r *http.Request, status int, id int32) (int, any, error) {
+	ctx := r.Context()
+	group, err := s.scim.GetScimGroup(ctx, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	out, err := s.scimGroupResource(ctx, s.scim, group, true)
+	if err != nil {
+		return 0, nil, err
+	}
+	s.auditScim(r, out)
+	return status, out, nil
+}
+
+func (s *Server) createScimGroup(r *http.Request) (int, any, error) {
+	ctx := r.Context()
+	var in scimGroup
+	if err := decodeScim(r, &in); err != nil {
+		return 0, nil, err
+	}
+	name := strings.TrimSpace(in.DisplayName)
+	if name == "" {
+		return 0, nil, newScimError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	existing, err := s.scim.CountScimGroups(ctx, db.CountScimGroupsParams{DisplayName: pgtype.Text{String: name, Valid: true}})
+	if err != nil {
+		return 0, nil, err
+	}
+	if existing > 0 {
+		return 0, nil, newScimError(http.StatusConflict, "uniqueness", "a group named %s already exists", name)
+	}
+	memberIDs, err := scimMemberIDs(ctx, s.scim, in.Members)
+	if err != nil {
+		return 0, nil, err
+	}
+	group, err := s.scim.CreateScimGroup(ctx, db.CreateScimGroupParams{
+		DisplayName: name,
+		ExternalID:  pgtype.Text{String: in.ExternalID, Valid: in.ExternalID != ""},
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	// A new group maps to nothing yet, so its members' roles don't change
+	// until an admin maps it.
+	for _, id := range memberIDs {
+		if err := s.scim.AddScimGroupMember(ctx, db.AddScimGroupMemberParams{GroupID: group.ID, UserID: id}); err != nil {
+			return 0, nil, err
+		}
+	}
+	return s.finishScimGroup(r, http.StatusCreated, group.ID)
+}
+
+func (s *Server) replaceScimGroup(r *http.Request) (int, any, error) {
+	ctx := r.Context()
+	group, err := s.loadScimGroup(ctx, r)
+	if err != nil {
+		return 0, nil, err
+	}
+	var in scimGroup
+	if err := decodeScim(r, &in); err != nil {
+		return 0, nil, err
+	}
+	name := strings.TrimSpace(in.DisplayName)
+	if name == "" {
+		return 0, nil, newScimError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	memberIDs, err := scimMemberIDs(ctx, s.scim, in.Members)
+	if err != nil {
+		return 0, nil, err
+	}
+	err = s.changeScimGroup(ctx, group.ID, func(tx ScimTx) error {
+		if _, err := tx.UpdateScimGroup(ctx, db.UpdateScimGroupParams{
+			ID:          group.ID,
+			DisplayName: name,
+			ExternalID:  pgtype.Text{String: in.ExternalID, Valid: in.ExternalID != ""},
+		}); err != nil {
+			return err
+		}
+		if err := tx.ClearScimGroupMembers(ctx, group.ID); err != nil {
+			return err
+		}
+		for _, id := range memberIDs {
+			if err := tx.AddScimGroupMember(ctx, db.AddScimGroupMemberParams{GroupID: group.ID, UserID: id}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return s.finishScimGroup(r, http.StatusOK, group.ID)
+}
+
+// scimMemberPath matches a remove of one member: members[value eq "12"].
+var scimMemberPath = regexp.MustCompile(`(?i)^members\[\s*value\s+eq\s+"([^"]*)"\s*\]$`)
+
+func (s *Server) patchScimGroup(r *http.Request) (int, any, error) {
+	ctx := r.Context()
+	group, err := s.loadScimGroup(ctx, r)
+	if err != nil {
+		return 0, nil, err
+	}
+	var patch scimPatchOp
+	if err := decodeScim(r, &patch); err != nil {
+		return 0, nil, err
+	}
+	name, externalID := group.DisplayName, group.ExternalID.String
+	err = s.changeScimGroup(ctx, group.ID, func(tx ScimTx) error {
+		members := func(value json.RawMessage) ([]int32, error) {
+			var refs []scimRef
+			if len(value) > 0 && json.Unmarshal(value, &refs) != nil {
+				return nil, newScimError(http.StatusBadRequest, "invalidValue", "members must be a list of {\"value\": id}")
+			}
+			return scimMemberIDs(ctx, tx, refs)
+		}
+		for _, op := range patch.Operations {
+			path := strings.TrimSpace(op.Path)
+			kind := strings.ToLower(op.Op)
+			if m := scimMemberPath.FindStringSubmatch(path); m != nil && kind == "remove" {
+				id, err := strconv.ParseInt(m[1], 10, 32)
+				if err != nil {
+					return newScimError(http.StatusBadRequest, "invalidValue", "member %s is not a user", m[1])
+				}
+				if err := tx.RemoveScimGroupMember(ctx, db.RemoveScimGroupMemberParams{GroupID: group.ID, UserID: int32(id)}); err != nil {
+					return err
+				}
+				continue
+			}
+			switch {
+			case strings.EqualFold(path, "members"):
+				ids, err := members(op.Value)
+				if err != nil {
+					return err
+				}
+				switch kind {
+				case "replace":
+					if err := tx.ClearScimGroupMembers(ctx, group.ID); err != nil {
+						return err
+					}
+					fallthrough
+				case "add":
+					for _, id := range ids {
+						if err := tx.AddScimGroupMember(ctx, db.AddScimGroupMemberParams{GroupID: group.ID, UserID: id}); err != nil {
+							return err
+						}
+					}
+				case "remove":
+					// Without a value, every member goes.
+					if len(op.Value) == 0 {
+						if err := tx.ClearScimGroupMembers(ctx, group.ID); err != nil {
+							return err
+						}
+					}
+					for _, id := range ids {
+						if err := tx.RemoveScimGroupMember(ctx, db.RemoveScimGroupMemberParams{GroupID: group.ID, UserID: id}); err != nil {
+							return err
+						}
+					}
+				default:
+					return newScimError(http.StatusBadRequest, "invalidSyntax", "unknown operation %q", op.Op)
+				}
+			case kind != "add" && kind != "replace":
+				return newScimError(http.StatusBadRequest, "invalidPath", "can't %s %s", op.Op, path)
+			case strings.EqualFold(path, "displayName"):
+				if err := json.Unmarshal(op.Value, &name); err != nil {
+					return newScimError(http.StatusBadRequest, "invalidValue", "displayName must be a string")
+				}
+			case strings.EqualFold(path, "externalId"):
+				if err := json.Unmarshal(op.Value, &externalID); err != nil {
+					return newScimError(http.StatusBadRequest, "invalidValue", "externalId must be a string")
+				}
+			case path == "":
+				var attrs struct {
+					DisplayName *string `json:"displayName"`
+					ExternalID  *string `json:"externalId"`
+				}
+				if err := json.Unmarshal(op.Value, &attrs); err != nil {
+					return newScimError(http.StatusBadRequest, "invalidValue", "an operation without a path needs an object value")
+				}
+				if attrs.DisplayName != nil {
+					name = *attrs.DisplayName
+				}
+				if attrs.ExternalID != nil {
+					externalID = *attrs.ExternalID
+				}
+			default:
+				return newScimError(http.StatusBadRequest, "invalidPath", "unknown attribute %s", path)
+			}
+		}
+		if strings.TrimSpace(name) == "" {
+			return newScimError(http.StatusBadRequest, "invalidValue", "displayName is required")
+		}
+		_, err := tx.UpdateScimGroup(ctx, db.UpdateScimGroupParams{
+			ID:          group.ID,
+			DisplayName: strings.TrimSpace(name),
+			ExternalID:  pgtype.Text{String: externalID, Valid: externalID != ""},
+		})
+		return err
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return s.finishScimGroup(r, http.StatusOK, group.ID)
+}
+
+func (s *Server) deleteScimGroup(r *http.Request) (int, any, error) {
+	ctx := r.Context()
+	group, err := s.loadScimGroup(ctx, r)
+	if err != nil {
+		return 0, nil, err
+	}
+	out, err := s.scimGroupResource(ctx, s.scim, group, true)
+	if err != nil {
+		return 0, nil, err
+	}
+	err = s.changeScimGroup(ctx, group.ID, func(tx ScimTx) error {
+		_, err := tx.DeleteScimGroup(ctx, group.ID)
+		return err
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	s.auditScim(r, out)
+	return http.StatusNoContent, nil, nil
+}
+
+// ListScimGroups lists the groups identity providers pushed, with what
+// each maps to.
+func (s *Server) ListScimGroups(ctx context.Context, req *connect.Request[secretaryv1.ListScimGroupsRequest]) (*connect.Response[secretaryv1.ListScimGroupsResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can view SCIM groups"); err != nil {
+		return nil, err
+	}
+	rows, err := s.scim.ListScimGroups(ctx, db.ListScimGroupsParams{RowLimit: maxScimGroupsListed})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list SCIM groups")
+	}
+	workspaces, err := s.scim.ListAllWorkspaces(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list workspaces")
+	}
+	res := &secretaryv1.ListScimGroupsResponse{Enabled: s.scimToken != ""}
+	for _, row := range rows {
+		res.Groups = append(res.Groups, scimGroupProto(row))
+	}
+	for _, w := range workspaces {
+		res.Workspaces = append(res.Workspaces, workspaceToProto(w))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// UpdateScimGroupMapping sets the role and workspace a group grants and
+// brings its members in line.
+func (s *Server) UpdateScimGroupMapping(ctx context.Context, req *connect.Request[secretaryv1.UpdateScimGroupMappingRequest]) (*connect.Response[secretaryv1.UpdateScimGroupMappingResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can map SCIM groups"); err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	groupID := int32(msg.GroupId)
+	if _, err := s.scim.GetScimGroup(ctx, groupID); errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("SCIM group not found"))
+	} else if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch SCIM group")
+	}
+	if msg.WorkspaceId != 0 {
+		workspaces, err := s.scim.ListAllWorkspaces(ctx)
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to list workspaces")
+		}
+		if !slices.ContainsFunc(workspaces, func(w db.Workspace) bool { return int64(w.ID) == msg.WorkspaceId }) {
+			return nil, apierr.InvalidField("workspace_id", "workspace not found")
+		}
+	}
+	err := s.changeScimGroup(ctx, groupID, func(tx ScimTx) error {
+		_, err := tx.SetScimGroupMapping(ctx, db.SetScimGroupMappingParams{
+			ID:          groupID,
+			Role:        pgtype.Text{String: msg.Role, Valid: msg.Role != ""},
+			WorkspaceID: pgtype.Int4{Int32: int32(msg.WorkspaceId), Valid: msg.WorkspaceId != 0},
+		})
+		return err
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to update SCIM group")
+	}
+	rows, err := s.scim.ListScimGroups(ctx, db.ListScimGroupsParams{RowLimit: maxScimGroupsListed})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch SCIM group")
+	}
+	i := slices.IndexFunc(rows, func(row db.ListScimGroupsRow) bool { return row.ID == groupID })
+	if i < 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("SCIM group not found"))
+	}
+	log.Printf("scim: user %d mapped group %d to role %q, workspace %d", currentUserID(ctx), groupID, msg.Role, msg.WorkspaceId)
+	return connect.NewResponse(&secretaryv1.UpdateScimGroupMappingResponse{Group: scimGroupProto(rows[i])}), nil
+}
+
+func scimGroupProto(row db.ListScimGroupsRow) *secretaryv1.ScimGroup {
+	return &secretaryv1.ScimGroup{
+		Id:            int64(row.ID),
+		DisplayName:   row.DisplayName,
+		ExternalId:    row.ExternalID.String,
+		Role:          row.Role.String,
+		WorkspaceId:   int64(row.WorkspaceID.Int32),
+		WorkspaceName: row.WorkspaceName.String,
+		MemberCount:   row.MemberCount,
+	}
+}
@@ -0,0 +1,369 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+type fakeScimUser struct {
+	row  db.GetScimUserRow
+	role string
+	// workspaces the user was added to over SCIM.
+	workspaces []int32
+}
+
+// fakeScim keeps users and groups in memory; it is its own transaction.
+type fakeScim struct {
+	ScimTx
+	users      []*fakeScimUser
+	groups     []db.ScimGroup
+	members    map[int32][]int32
+	workspaces []db.Workspace
+}
+
+func newFakeScim() *fakeScim {
+	return &fakeScim{members: map[int32][]int32{}, workspaces: []db.Workspace{{ID: 7, Name: "Engineering"}}}
+}
+
+func (f *fakeScim) BeginScimTx(context.Context) (ScimTx, error) { return f, nil }
+func (f *fakeScim) Commit(context.Context) error                { return nil }
+func (f *fakeScim) Rollback(context.Context) error              { return nil }
+
+func (f *fakeScim) user(id int32) *fakeScimUser {
+	for _, u := range f.users {
+		if u.row.ID == id {
+			return u
+		}
+	}
+	return nil
+}
+
+func (f *fakeScim) matchUsers(email, externalID pgtype.Text) []db.ListScimUsersRow {
+	var rows []db.ListScimUsersRow
+	for _, u := range f.users {
+		if email.Valid && !strings.EqualFold(u.row.Email.String, email.String) || externalID.Valid && u.row.ScimExternalID != externalID {
+			continue
+		}
+		rows = append(rows, db.ListScimUsersRow(u.row))
+	}
+	return rows
+}
+
+func (f *fakeScim) ListScimUsers(_ context.Context, arg db.ListScimUsersParams) ([]db.ListScimUsersRow, error) {
+	rows := f.matchUsers(arg.Email, arg.ExternalID)
+	rows = rows[min(int(arg.RowOffset), len(rows)):]
+	return rows[:min(int(arg.RowLimit), len(rows))], nil
+}
+
+func (f *fakeScim) CountScimUsers(_ context.Context, arg db.CountScimUsersParams) (int64, error) {
+	return int64(len(f.matchUsers(arg.Email, arg.ExternalID))), nil
+}
+
+func (f *fakeScim) GetScimUser(_ context.Context, id int32) (db.GetScimUserRow, error) {
+	if u := f.user(id); u != nil {
+		return u.row, nil
+	}
+	return db.GetScimUserRow{}, pgx.ErrNoRows
+}
+
+func (f *fakeScim) CreateScimUser(_ context.Context, arg db.CreateScimUserParams) (int32, error) {
+	id := int32(len(f.users) + 1)
+	f.users = append(f.users, &fakeScimUser{
+		row:  db.GetScimUserRow{ID: id, FirstName: arg.FirstName, LastName: arg.LastName, Email: arg.Email, ScimExternalID: arg.ScimExternalID},
+		role: arg.Role.String,
+	})
+	return id, nil
+}
+
+func (f *fakeScim) UpdateScimUser(_ context.Context, arg db.UpdateScimUserParams) (int64, error) {
+	u := f.user(arg.ID)
+	u.row.FirstName, u.row.LastName, u.row.Email, u.row.ScimExternalID = arg.FirstName, arg.LastName, arg.Email, arg.ScimExternalID
+	return 1, nil
+}
+
+func (f *fakeScim) SetScimUserRole(_ context.Context, arg db.SetScimUserRoleParams) error {
+	f.user(arg.ID).role = arg.Role.String
+	return nil
+}
+
+func (f *fakeScim) DeactivateUser(_ context.Context, arg db.DeactivateUserParams) (db.DeactivateUserRow, error) {
+	u := f.user(arg.UserID)
+	u.row.DeactivatedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	return db.DeactivateUserRow{Deactivated: true}, nil
+}
+
+func (f *fakeScim) ReactivateUser(_ context.Context, id int32) (int64, error) {
+	f.user(id).row.DeactivatedAt = pgtype.Timestamptz{}
+	return 1, nil
+}
+
+func (f *fakeScim) ListScimGroups(_ context.Context, arg db.ListScimGroupsParams) ([]db.ListScimGroupsRow, error) {
+	var rows []db.ListScimGroupsRow
+	for _, g := range f.groups {
+		if arg.DisplayName.Valid && g.DisplayName != arg.DisplayName.String {
+			continue
+		}
+		rows = append(rows, db.ListScimGroupsRow{ID: g.ID, DisplayName: g.DisplayName, ExternalID: g.ExternalID, Role: g.Role, WorkspaceID: g.WorkspaceID, MemberCount: int64(len(f.members[g.ID]))})
+	}
+	return rows, nil
+}
+
+func (f *fakeScim) CountScimGroups(ctx context.Context, arg db.CountScimGroupsParams) (int64, error) {
+	rows, _ := f.ListScimGroups(ctx, db.ListScimGroupsParams{DisplayName: arg.DisplayName})
+	return int64(len(rows)), nil
+}
+
+func (f *fakeScim) group(id int32) *db.ScimGroup {
+	for i := range f.groups {
+		if f.groups[i].ID == id {
+			return &f.groups[i]
+		}
+	}
+	return nil
+}
+
+func (f *fakeScim) GetScimGroup(_ context.Context, id int32) (db.ScimGroup, error) {
+	if g := f.group(id); g != nil {
+		return *g, nil
+	}
+	return db.ScimGroup{}, pgx.ErrNoRows
+}
+
+func (f *fakeScim) CreateScimGroup(_ context.Context, arg db.CreateScimGroupParams) (db.ScimGroup, error) {
+	g := db.ScimGroup{ID: int32(len(f.groups) + 1), DisplayName: arg.DisplayName, ExternalID: arg.ExternalID}
+	f.groups = append(f.groups, g)
+	return g, nil
+}
+
+func (f *fakeScim) UpdateScimGroup(_ context.Context, arg db.UpdateScimGroupParams) (int64, error) {
+	g := f.group(arg.ID)
+	g.DisplayName, g.ExternalID = arg.DisplayName, arg.ExternalID
+	return 1, nil
+}
+
+func (f *fakeScim) DeleteScimGroup(_ context.Context, id int32) (int64, error) {
+	f.groups = slices.DeleteFunc(f.groups, func(g db.ScimGroup) bool { return g.ID == id })
+	delete(f.members, id)
+	return 1, nil
+}
+
+func (f *fakeScim) SetScimGroupMapping(_ context.Context, arg db.SetScimGroupMappingParams) (db.ScimGroup, error) {
+	g := f.group(arg.ID)
+	g.Role, g.WorkspaceID = arg.Role, arg.WorkspaceID
+	return *g, nil
+}
+
+func (f *fakeScim) ListScimGroupMembers(_ context.Context, groupID int32) ([]db.ListScimGroupMembersRow, error) {
+	var rows []db.ListScimGroupMembersRow
+	for _, id := range f.members[groupID] {
+		u := f.user(id)
+		rows = append(rows, db.ListScimGroupMembersRow{ID: id, FirstName: u.row.FirstName, LastName: u.row.LastName, Email: u.row.Email})
+	}
+	return rows, nil
+}
+
+func (f *fakeScim) AddScimGroupMember(_ context.Context, arg db.AddScimGroupMemberParams) error {
+	if !slices.Contains(f.members[arg.GroupID], arg.UserID) {
+		f.members[arg.GroupID] = append(f.members[arg.GroupID], arg.UserID)
+	}
+	return nil
+}
+
+func (f *fakeScim) RemoveScimGroupMember(_ context.Context, arg db.RemoveScimGroupMemberParams) error {
+	f.members[arg.GroupID] = slices.DeleteFunc(f.members[arg.GroupID], func(id int32) bool { return id == arg.UserID })
+	return nil
+}
+
+func (f *fakeScim) ClearScimGroupMembers(_ context.Context, groupID int32) error {
+	delete(f.members, groupID)
+	return nil
+}
+
+func (f *fakeScim) ListScimGroupsForUser(_ context.Context, userID int32) ([]db.ListScimGroupsForUserRow, error) {
+	var rows []db.ListScimGroupsForUserRow
+	for _, g := range f.groups {
+		if slices.Contains(f.members[g.ID], userID) {
+			rows = append(rows, db.ListScimGroupsForUserRow{ID: g.ID, DisplayName: g.DisplayName, Role: g.Role, WorkspaceID: g.WorkspaceID})
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeScim) SyncScimWorkspaces(ctx context.Context, userID int32) (db.SyncScimWorkspacesRow, error) {
+	groups, _ := f.ListScimGroupsForUser(ctx, userID)
+	var workspaces []int32
+	for _, g := range groups {
+		if g.WorkspaceID.Valid && !slices.Contains(workspaces, g.WorkspaceID.Int32) {
+			workspaces = append(workspaces, g.WorkspaceID.Int32)
+		}
+	}
+	f.user(userID).workspaces = workspaces
+	return db.SyncScimWorkspacesRow{}, nil
+}
+
+func (f *fakeScim) ListAllWorkspaces(context.Context) ([]db.Workspace, error) {
+	return f.workspaces, nil
+}
+
+func TestScimProvisioning(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	store := newFakeScim()
+	srv.scim = store
+	srv.auditLog = &fakeAuditLog{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	const token = "0123456789abcdef0123456789abcdef"
+	call := func(method, path, body string, v any) int {
+		t.Helper()
+		req, err := http.NewRequest(method, ts.URL+"/scim/v2"+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/scim+json")
+		res, err := ts.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		data, _ := io.ReadAll(res.Body)
+		if v != nil && len(data) > 0 {
+			if err := json.Unmarshal(data, v); err != nil {
+				t.Fatalf("%s %s: %v: %s", method, path, err, data)
+			}
+		}
+		return res.StatusCode
+	}
+
+	// Without a token configured, SCIM is off.
+	if code := call(http.MethodGet, "/Users", "", nil); code != http.StatusNotFound {
+		t.Fatalf("disabled: %d", code)
+	}
+	srv.ConfigureSCIM(token)
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/scim/v2/Users", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	if res, err := ts.Client().Do(req); err != nil || res.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("wrong token: %v %v", res, err)
+	}
+
+	var user scimUser
+	if code := call(http.MethodPost, "/Users", `{"schemas":["`+scimUserSchema+`"],"userName":"ada@example.com","externalId":"okta-1","name":{"givenName":"Ada","familyName":"Lovelace"},"active":true}`, &user); code != http.StatusCreated {
+		t.Fatalf("create: %d %+v", code, user)
+	}
+	if user.ID != "1" || user.UserName != "ada@example.com" || user.Active == nil || !*user.Active || store.users[0].role != "member" {
+		t.Fatalf("created user: %+v", user)
+	}
+	var scimErr scimError
+	if code := call(http.MethodPost, "/Users", `{"userName":"ADA@example.com"}`, &scimErr); code != http.StatusConflict || scimErr.ScimType != "uniqueness" {
+		t.Fatalf("duplicate: %d %+v", code, scimErr)
+	}
+	if code := call(http.MethodPost, "/Users", `{"userName":"ada"}`, &scimErr); code != http.StatusBadRequest {
+		t.Fatalf("userName without @: %d %+v", code, scimErr)
+	}
+	// Passwords meet the password policy, and a refused one changes nothing.
+	if code := call(http.MethodPost, "/Users", `{"userName":"grace@example.com","password":"grace@example.com!"}`, &scimErr); code != http.StatusBadRequest || scimErr.ScimType != "invalidValue" || !strings.Contains(scimErr.Detail, "email") {
+		t.Fatalf("password containing the email: %d %+v", code, scimErr)
+	}
+	if code := call(http.MethodPatch, "/Users/1", `{"Operations":[{"op":"replace","value":{"name.familyName":"King","password":"short"}}]}`, &scimErr); code != http.StatusBadRequest || !strings.Contains(scimErr.Detail, "at least 12 characters") {
+		t.Fatalf("short password: %d %+v", code, scimErr)
+	}
+	if name := store.users[0].row.LastName.String; name != "Lovelace" || len(store.users) != 1 {
+		t.Fatalf("a refused password still changed the users: %q, %d users", name, len(store.users))
+	}
+
+	var list scimListResponse
+	if code := call(http.MethodGet, `/Users?filter=userName+eq+%22ada%40example.com%22`, "", &list); code != http.StatusOK || list.TotalResults != 1 || len(list.Resources) != 1 {
+		t.Fatalf("filter: %d %+v", code, list)
+	}
+	if code := call(http.MethodGet, `/Users?filter=externalId+eq+%22nobody%22`, "", &list); code != http.StatusOK || list.TotalResults != 0 {
+		t.Fatalf("filter no match: %d %+v", code, list)
+	}
+	if code := call(http.MethodGet, `/Users?filter=title+co+%22x%22`, "", &scimErr); code != http.StatusBadRequest || scimErr.ScimType != "invalidFilter" {
+		t.Fatalf("unsupported filter: %d %+v", code, scimErr)
+	}
+
+	// Deactivating by PATCH, with the string form some providers send.
+	patch := `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"Replace","path":"active","value":"False"},{"op":"replace","value":{"name.familyName":"Byron"}}]}`
+	if code := call(http.MethodPatch, "/Users/1", patch, &user); code != http.StatusOK || *user.Active || user.Name.FamilyName != "Byron" {
+		t.Fatalf("patch: %d %+v", code, user)
+	}
+	if !srv.isDeactivated(1) {
+		t.Fatal("user not deactivated")
+	}
+	if code := call(http.MethodPatch, "/Users/1", `{"Operations":[{"op":"replace","value":{"active":true}}]}`, &user); code != http.StatusOK || !*user.Active || srv.isDeactivated(1) {
+		t.Fatalf("reactivate: %d %+v", code, user)
+	}
+
+	// A group mapped to admin and a workspace grants both to its members,
+	// and leaving it takes them away again.
+	var group scimGroup
+	if code := call(http.MethodPost, "/Groups", `{"displayName":"Leads","members":[{"value":"1"}]}`, &group); code != http.StatusCreated || len(group.Members) != 1 {
+		t.Fatalf("create group: %d %+v", code, group)
+	}
+	if code := call(http.MethodPost, "/Groups", `{"displayName":"Leads","members":[{"value":"99"}]}`, &scimErr); code != http.StatusConflict {
+		t.Fatalf("duplicate group: %d %+v", code, scimErr)
+	}
+
+	adminToken, err := srv.issueToken(100, tokenClaims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin := secretaryv1connect.NewAdminServiceClient(ts.Client(), ts.URL, connect.WithInterceptors(connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+adminToken)
+			return next(ctx, req)
+		}
+	})))
+	ctx := context.Background()
+	mapped, err := admin.UpdateScimGroupMapping(ctx, connect.NewRequest(&secretaryv1.UpdateScimGroupMappingRequest{GroupId: 1, Role: "admin", WorkspaceId: 7}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mapped.Msg.Group.Role != "admin" || mapped.Msg.Group.MemberCount != 1 {
+		t.Fatalf("mapped group: %v", mapped.Msg.Group)
+	}
+	if u := store.users[0]; u.role != "admin" || !slices.Equal(u.workspaces, []int32{7}) {
+		t.Fatalf("after mapping: role %q, workspaces %v", u.role, u.workspaces)
+	}
+	if _, err := admin.UpdateScimGroupMapping(ctx, connect.NewRequest(&secretaryv1.UpdateScimGroupMappingRequest{GroupId: 1, WorkspaceId: 8})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("unknown workspace: %v", err)
+	}
+	listed, err := admin.ListScimGroups(ctx, connect.NewRequest(&secretaryv1.ListScimGroupsRequest{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !listed.Msg.Enabled || len(listed.Msg.Groups) != 1 || len(listed.Msg.Workspaces) != 1 {
+		t.Fatalf("listed: %v", listed.Msg)
+	}
+
+	if code := call(http.MethodPatch, "/Groups/1", `{"Operations":[{"op":"remove","path":"members[value eq \"1\"]"}]}`, &group); code != http.StatusOK || len(group.Members) != 0 {
+		t.Fatalf("remove member: %d %+v", code, group)
+	}
+	if u := store.users[0]; u.role != "member" || len(u.workspaces) != 0 {
+		t.Fatalf("after leaving: role %q, workspaces %v", u.role, u.workspaces)
+	}
+
+	if code := call(http.MethodDelete, "/Users/1", "", nil); code != http.StatusNoContent || !srv.isDeactivated(1) {
+		t.Fatalf("delete: %d", code)
+	}
+	if code := call(http.MethodGet, "/Users/2", "", &scimErr); code != http.StatusNotFound {
+		t.Fatalf("missing user: %d", code)
+	}
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"strings"
+)
+
+// CheckSPABundle verifies the frontend build was embedded into the binary.
+// A missing bundle still compiles when dist/ holds a placeholder, but the
+// server would then serve a blank page.
+func CheckSPABundle() error {
+	data, err := fs.ReadFile(content, "dist/index.html")
+	if err != nil {
+		return fmt.Errorf("embedded index.html missing: %w", err)
+	}
+	if !strings.Contains(strings.ToLower(string(data)), "<script") {
+		return errors.New("embedded index.html has no script tags; was the frontend built before the server?")
+	}
+	return nil
+}
+
+// CheckOpenAIKey validates the provider key with a models listing, which is
+// free and doesn't consume tokens.
+func CheckOpenAIKey(ctx context.Context, apiKey string, baseURL string) error {
+	apiKey = strings.TrimSpace(apiKey)
+	if apiKey == "" {
+		return errors.New("OPENAI_API_KEY is not set")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, openAIModelsURL(baseURL), nil)
+	if err != nil {
+		return fmt.Errorf("create models request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("models request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("models request failed (%d): %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+func openAIModelsURL(baseURL string) string {
+	chatURL := openAIChatCompletionsURL(baseURL)
+	return strings.TrimSuffix(chatURL, "/chat/completions") + "/models"
+}
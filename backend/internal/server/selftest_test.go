@@ -0,0 +1,33 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckOpenAIKey(t *testing.T) {
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/models" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer good-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid key"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer provider.Close()
+
+	if err := CheckOpenAIKey(context.Background(), "good-key", provider.URL+"/v1"); err != nil {
+		t.Fatalf("expected key to be accepted: %v", err)
+	}
+	if err := CheckOpenAIKey(context.Background(), "bad-key", provider.URL+"/v1"); err == nil {
+		t.Fatalf("expected rejected key to fail")
+	}
+	if err := CheckOpenAIKey(context.Background(), " ", provider.URL+"/v1"); err == nil {
+		t.Fatalf("expected empty key to fail")
+	}
+}
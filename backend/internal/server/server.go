@@ -5,12 +5,14 @@ import (
 	"embed"
 	"encoding/json"
 	"errors"
-	"io"
+	"fmt"
+	"log"
 	"net/http"
-	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"connectrpc.com/connect"
@@ -20,30 +22,121 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
 	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	dbconn "github.com/mvult/secretary/backend/internal/db"
 	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/mail"
+	"github.com/mvult/secretary/backend/internal/notify"
+	"github.com/mvult/secretary/backend/internal/password"
+	"github.com/mvult/secretary/backend/internal/providers"
+	"github.com/mvult/secretary/backend/internal/push"
+	"github.com/mvult/secretary/backend/internal/scan"
 	"github.com/mvult/secretary/backend/internal/server/agent"
+	"github.com/mvult/secretary/backend/internal/storage"
+	"github.com/mvult/secretary/backend/internal/trackers"
 	whatsappsvc "github.com/mvult/secretary/backend/internal/whatsapp"
-	"github.com/rs/cors"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/mvult/secretary/backend/internal/wiki"
 )
 
 //go:embed dist/*
 var content embed.FS
 
-type contextKey string
-
-const userIdKey contextKey = "user_id"
-
 type Server struct {
 	db        *pgxpool.Pool
 	queries   *db.Queries
-	jwtSecret []byte
+	jwtSecret atomic.Pointer[signingSecrets]
 	tokenTTL  time.Duration
 	aiRunner  agent.Runner
 	aiAPIKey  string
 	aiBaseURL string
 	aiModel   string
 	whatsapp  *whatsappsvc.Service
+	cors      corsPolicies
+	storage   storage.Store
+	mailer    mail.Sender
+	publicURL string
+
+	// routes is Routes, built once for ServeHTTP on the first request, after
+	// the server is configured.
+	routesOnce sync.Once
+	routes     http.Handler
+
+	recordings        RecordingStore
+	todos             TodoStore
+	users             UserStore
+	usage             UsageStore
+	outcomes          OutcomeStore
+	mentions          MentionStore
+	activity          ActivityFeedStore
+	favorites         FavoriteStore
+	annotations       AnnotationStore
+	comments          CommentStore
+	reactions         ReactionStore
+	snoozes           SnoozeStore
+	clips             ClipStore
+	attachments       AttachmentStore
+	minutes           MinutesStore
+	prompts           PromptTemplateStore
+	trackerLinks      TrackerLinkStore
+	issueTrackers     map[string]trackers.Tracker
+	publications      PublicationStore
+	wikis             map[string]wiki.Target
+	publishOnReady    []string
+	notifier          *notify.Notifier
+	pushDevices       PushDeviceStore
+	notifyPrefs       NotificationPreferenceStore
+	settings          SettingsStore
+	retention         RetentionStore
+	systemStats       SystemStatsStore
+	jobs              JobStore
+	schedules         ScheduleStore
+	featureFlags      FeatureFlagStore
+	auditLog          AuditStore
+	scim              ScimStore
+	sessions          SessionStore
+	leaders           LeaderStore
+	shared            SharedState
+	digests           DigestStore
+	dataKeys          DataKeyStore
+	uploads           UploadStore
+	resumable         ResumableUploadStore
+	scans             ScanStore
+	scanner           scan.Scanner
+	scanWake          chan struct{}
+	keywords          KeywordStore
+	keywordWake       chan struct{}
+	encryption        *encryption
+	settingsCache     atomic.Pointer[db.OrgSetting]
+	deactivated       atomic.Pointer[map[int64]bool]
+	revoked           atomic.Pointer[map[string]bool]
+	pushSenders       map[string]push.Sender
+	cutter            AudioCutter
+	quotas            *UsageQuotas
+	timeouts          TimeoutConfig
+	bodyLimits        BodyLimits
+	reads             *dbconn.ReadRouter
+	queryStats        *dbconn.QueryStats
+	providers         *providers.Registry
+	metricsToken      string
+	recordingCache    *responseCache
+	rpcStats          *rpcStats
+	errorTracker      ErrorTracker
+	auditKey          []byte
+	auditKeyID        string
+	scimToken         string
+	ipAllowlist       IPAllowlistConfig
+	ipAuditLimit      throttle
+	sessionTouch      throttle
+	loginDelay        time.Duration
+	loginAuditLimit   throttle
+	passwordParams    password.Params
+	passwordPolicy    password.Policy
+	dummyPasswordHash func() string
+	loginStats        loginStats
+	static            *staticFiles
+	backups           backupSchedule
+	scheduler         scheduler
+	leader            leadership
 
 	s400Mu       sync.Mutex
 	s400Sessions map[string]s400ScaleSession
@@ -51,19 +144,71 @@ type Server struct {
 }
 
 func New(pool *pgxpool.Pool, jwtSecret []byte, tokenTTL time.Duration) *Server {
-	return &Server{
-		db:           pool,
-		queries:      db.New(pool),
-		jwtSecret:    jwtSecret,
-		tokenTTL:     tokenTTL,
-		s400Sessions: map[string]s400ScaleSession{},
-		s400Recent:   map[string]s400RecentMeasurement{},
-	}
+	store := newPGStore(pool)
+	srv := &Server{
+		db:             pool,
+		queries:        store.Queries,
+		recordings:     store,
+		todos:          store,
+		users:          store,
+		usage:          store,
+		outcomes:       store,
+		mentions:       store,
+		activity:       store,
+		favorites:      store,
+		annotations:    store,
+		comments:       store,
+		reactions:      store,
+		snoozes:        store,
+		clips:          store,
+		attachments:    store,
+		minutes:        store,
+		prompts:        store,
+		trackerLinks:   store,
+		publications:   store,
+		pushDevices:    store,
+		notifyPrefs:    store,
+		settings:       store,
+		retention:      store,
+		systemStats:    store,
+		jobs:           store,
+		schedules:      store,
+		featureFlags:   store,
+		auditLog:       store,
+		scim:           store,
+		sessions:       store,
+		leaders:        store,
+		digests:        store,
+		dataKeys:       store,
+		uploads:        store,
+		resumable:      store,
+		scans:          store,
+		scanWake:       make(chan struct{}, 1),
+		keywords:       store,
+		keywordWake:    make(chan struct{}, 1),
+		cutter:         FFmpegCutter{Path: "ffmpeg"},
+		tokenTTL:       tokenTTL,
+		cors:           newCORSPolicies(DefaultCORSConfig()),
+		mailer:         mail.LogSender{},
+		timeouts:       DefaultTimeoutConfig(),
+		loginDelay:     loginFailureDelay,
+		passwordPolicy: password.DefaultPolicy,
+		bodyLimits:     DefaultBodyLimits(),
+		recordingCache: newResponseCache(),
+		rpcStats:       newRPCStats(),
+		static:         newStaticFiles(mustSub(content, "dist")),
+		s400Sessions:   map[string]s400ScaleSession{},
+		s400Recent:     map[string]s400RecentMeasurement{},
+	}
+	srv.jwtSecret.Store(&signingSecrets{current: jwtSecret})
+	srv.ConfigurePasswordHashing(password.DefaultParams)
+	return srv
 }
 
 func (s *Server) Routes() http.Handler {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/healthz", s.handleHealth)
+	mux.HandleFunc("/metrics", s.handleMetrics)
 	mux.HandleFunc("/api/login", s.handleLogin)
 	mux.HandleFunc("/api/activity-events", s.handleActivityEvent)
 	mux.Handle("/api/whatsapp/status", s.authMiddleware(http.HandlerFunc(s.handleWhatsAppStatus)))
@@ -74,37 +219,87 @@ func (s *Server) Routes() http.Handler {
 	mux.Handle("/api/whatsapp/notifications/pending", s.authMiddleware(http.HandlerFunc(s.handleWhatsAppPendingNotifications)))
 	mux.Handle("/api/whatsapp/notifications/mark-notified", s.authMiddleware(http.HandlerFunc(s.handleWhatsAppMarkNotified)))
 	mux.Handle("/api/pomodoro/approve", s.authMiddleware(http.HandlerFunc(s.handlePomodoroApprove)))
-
-	// Mount ConnectRPC handlers
-	recPath, recHandler := secretaryv1connect.NewRecordingsServiceHandler(s)
-	mux.Handle(recPath, s.authMiddleware(recHandler))
-
-	todoPath, todoHandler := secretaryv1connect.NewTodosServiceHandler(s)
-	mux.Handle(todoPath, s.authMiddleware(todoHandler))
-
-	userPath, userHandler := secretaryv1connect.NewUsersServiceHandler(s)
-	mux.Handle(userPath, s.authMiddleware(userHandler))
-
-	workspacePath, workspaceHandler := secretaryv1connect.NewWorkspacesServiceHandler(s)
-	mux.Handle(workspacePath, s.authMiddleware(workspaceHandler))
-
-	documentPath, documentHandler := secretaryv1connect.NewDocumentsServiceHandler(s)
-	mux.Handle(documentPath, s.authMiddleware(documentHandler))
-
-	activityPath, activityHandler := secretaryv1connect.NewActivitiesServiceHandler(s)
-	mux.Handle(activityPath, s.authMiddleware(activityHandler))
-
-	aiPath, aiHandler := secretaryv1connect.NewAIServiceHandler(s)
-	mux.Handle(aiPath, s.authMiddleware(aiHandler))
-
-	c := cors.New(cors.Options{
-		AllowedOrigins: []string{"*"},
-		AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders: []string{"Accept", "Content-Type", "Content-Length", "Accept-Encoding", "X-CSRF-Token", "Authorization", "Connect-Protocol-Version", "Connect-Timeout-Ms", "Grpc-Timeout", "X-User-Agent", "X-Grpc-Web"},
-		ExposedHeaders: []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"},
-	})
-
-	return c.Handler(mux)
+	mux.HandleFunc("/api/todos.ics", s.handleTodoFeed)
+	mux.HandleFunc("/api/openapi.json", s.handleOpenAPISpec)
+	mux.HandleFunc("/api/docs", s.handleAPIDocs)
+	mux.Handle("GET /api/docs/swaggerui/", http.StripPrefix("/api/docs", http.HandlerFunc(s.handleSwaggerUIAsset)))
+	mux.Handle("/api/todos/feed", s.authMiddleware(http.HandlerFunc(s.handleTodoFeedURL)))
+	mux.Handle("/api/me/avatar", s.authMiddleware(http.HandlerFunc(s.handleMyAvatar)))
+	mux.HandleFunc("/api/avatars/{name}", s.handleAvatar)
+	mux.Handle("/api/settings/logo", s.authMiddleware(http.HandlerFunc(s.handleOrgLogo)))
+	mux.HandleFunc("/api/logos/{name}", s.handleLogo)
+	mux.Handle("/api/admin/archives/{name}", s.authMiddleware(http.HandlerFunc(s.handleInstanceArchive)))
+	mux.Handle("/api/recordings/upload", s.authMiddleware(http.HandlerFunc(s.handleRecordingUpload)))
+	mux.Handle("/api/recordings/live", s.authMiddleware(http.HandlerFunc(s.handleLiveRecordingStart)))
+	mux.Handle("/api/recordings/live/{id}/chunks/{seq}", s.authMiddleware(http.HandlerFunc(s.handleLiveRecordingChunk)))
+	mux.Handle("/api/recordings/live/{id}/finalize", s.authMiddleware(http.HandlerFunc(s.handleLiveRecordingFinalize)))
+	mux.HandleFunc("OPTIONS /api/uploads", s.handleUploadOptions)
+	mux.Handle("/api/uploads", s.authMiddleware(http.HandlerFunc(s.handleResumableUploadCreate)))
+	mux.Handle("/api/uploads/{id}", s.authMiddleware(http.HandlerFunc(s.handleResumableUpload)))
+	mux.HandleFunc("/api/clips/{token}", s.handleClip)
+	mux.HandleFunc("/api/trackers/{tracker}/webhook", s.handleTrackerWebhook)
+	mux.Handle("/scim/v2/", s.scimHandler())
+
+	// Mount ConnectRPC handlers. Every service shares one interceptor chain,
+	// which authenticates callers and validates requests uniformly.
+	opts := s.handlerOptions()
+	recPath, recHandler := secretaryv1connect.NewRecordingsServiceHandler(s, opts...)
+	mux.Handle(recPath, notModified(recHandler))
+
+	todoPath, todoHandler := secretaryv1connect.NewTodosServiceHandler(s, opts...)
+	mux.Handle(todoPath, todoHandler)
+
+	userPath, userHandler := secretaryv1connect.NewUsersServiceHandler(s, opts...)
+	mux.Handle(userPath, userHandler)
+
+	workspacePath, workspaceHandler := secretaryv1connect.NewWorkspacesServiceHandler(s, opts...)
+	mux.Handle(workspacePath, workspaceHandler)
+
+	documentPath, documentHandler := secretaryv1connect.NewDocumentsServiceHandler(s, opts...)
+	mux.Handle(documentPath, documentHandler)
+
+	activityPath, activityHandler := secretaryv1connect.NewActivitiesServiceHandler(s, opts...)
+	mux.Handle(activityPath, activityHandler)
+
+	aiPath, aiHandler := secretaryv1connect.NewAIServiceHandler(s, opts...)
+	mux.Handle(aiPath, aiHandler)
+
+	analyticsPath, analyticsHandler := secretaryv1connect.NewAnalyticsServiceHandler(s, opts...)
+	mux.Handle(analyticsPath, analyticsHandler)
+
+	outcomePath, outcomeHandler := secretaryv1connect.NewOutcomesServiceHandler(s, opts...)
+	mux.Handle(outcomePath, outcomeHandler)
+
+	activityFeedPath, activityFeedHandler := secretaryv1connect.NewActivityServiceHandler(s, opts...)
+	mux.Handle(activityFeedPath, activityFeedHandler)
+
+	annotationPath, annotationHandler := secretaryv1connect.NewAnnotationsServiceHandler(s, opts...)
+	mux.Handle(annotationPath, annotationHandler)
+
+	attachmentPath, attachmentHandler := secretaryv1connect.NewAttachmentsServiceHandler(s, opts...)
+	mux.Handle(attachmentPath, attachmentHandler)
+
+	promptTemplatePath, promptTemplateHandler := secretaryv1connect.NewPromptTemplatesServiceHandler(s, opts...)
+	mux.Handle(promptTemplatePath, promptTemplateHandler)
+
+	notificationPath, notificationHandler := secretaryv1connect.NewNotificationsServiceHandler(s, opts...)
+	mux.Handle(notificationPath, notificationHandler)
+
+	settingsPath, settingsHandler := secretaryv1connect.NewSettingsServiceHandler(s, opts...)
+	mux.Handle(settingsPath, settingsHandler)
+
+	quarantinePath, quarantineHandler := secretaryv1connect.NewQuarantineServiceHandler(s, opts...)
+	mux.Handle(quarantinePath, quarantineHandler)
+	keywordsPath, keywordsHandler := secretaryv1connect.NewKeywordAlertsServiceHandler(s, opts...)
+	mux.Handle(keywordsPath, keywordsHandler)
+	adminPath, adminHandler := secretaryv1connect.NewAdminServiceHandler(s, opts...)
+	mux.Handle(adminPath, adminHandler)
+	jobsPath, jobsHandler := secretaryv1connect.NewJobsServiceHandler(s, opts...)
+	mux.Handle(jobsPath, jobsHandler)
+
+	s.mountGRPCProbes(mux)
+
+	return withRequestID(s.recoverPanics(s.cors.withCORS(s.withMaintenance(s.withBodyLimit(compressResponses(s.withRequestDeadline(mux)))))))
 }
 
 // ServeHTTP implements the http.Handler interface
@@ -120,53 +315,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Check if the request is for an API endpoint or ConnectRPC service
 	// ConnectRPC services usually look like /secretary.v1.RecordingsService/ListRecordings
 	// Our custom API endpoints start with /api
-	if strings.HasPrefix(r.URL.Path, "/api") || strings.Contains(r.URL.Path, "Service/") || r.URL.Path == "/healthz" {
-		s.Routes().ServeHTTP(w, r)
+	// Standard gRPC health and reflection services live under /grpc.*
+	// Identity providers provision users under /scim/
+	if strings.HasPrefix(r.URL.Path, "/api") || strings.Contains(r.URL.Path, "Service/") || strings.HasPrefix(r.URL.Path, "/grpc.") || strings.HasPrefix(r.URL.Path, "/scim/") || r.URL.Path == "/healthz" || r.URL.Path == "/metrics" {
+		s.routesOnce.Do(func() { s.routes = s.Routes() })
+		s.routes.ServeHTTP(w, r)
 		return
 	}
 
-	// Try to serve static file
-	path := r.URL.Path
-	if path == "/" {
-		path = "/index.html"
-	}
-	// dist/ is the root of our embedded FS
-	fullPath := "dist" + path
-
-	// Check if file exists in embedded FS
-	f, err := content.Open(fullPath)
-	if err == nil {
-		defer f.Close()
-		// Get content type
-		ext := filepath.Ext(fullPath)
-		contentType := "application/octet-stream"
-		switch ext {
-		case ".html":
-			contentType = "text/html"
-		case ".css":
-			contentType = "text/css"
-		case ".js":
-			contentType = "application/javascript"
-		case ".svg":
-			contentType = "image/svg+xml"
-		}
-		w.Header().Set("Content-Type", contentType)
-
-		stat, _ := f.Stat()
-		http.ServeContent(w, r, fullPath, stat.ModTime(), f.(io.ReadSeeker))
-		return
-	}
-
-	// Fallback to index.html for SPA
-	indexFile, err := content.Open("dist/index.html")
-	if err != nil {
-		http.Error(w, "index.html not found", http.StatusInternalServerError)
-		return
-	}
-	defer indexFile.Close()
-	stat, _ := indexFile.Stat()
-	w.Header().Set("Content-Type", "text/html")
-	http.ServeContent(w, r, "index.html", stat.ModTime(), indexFile.(io.ReadSeeker))
+	s.cors.withStaticCORS(compressResponses(http.HandlerFunc(s.serveStatic))).ServeHTTP(w, r)
 }
 
 func (s *Server) handleHealth(w http.ResponseWriter, _ *http.Request) {
@@ -182,6 +339,11 @@ func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
 	}
 	var req LoginRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "request body too large")
+			return
+		}
 		writeError(w, http.StatusBadRequest, "invalid request body")
 		return
 	}
@@ -190,26 +352,49 @@ func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userRow, err := s.queries.GetUserByEmail(r.Context(), pgtype.Text{String: req.Email, Valid: true})
-	if errors.Is(err, pgx.ErrNoRows) {
-		writeError(w, http.StatusUnauthorized, "invalid credentials")
+	// Every sign-in checks a password hash, whether or not the account
+	// exists, so timing doesn't tell which emails have accounts.
+	start := time.Now()
+	userRow, err := s.users.GetUserByEmail(r.Context(), pgtype.Text{String: req.Email, Valid: true})
+	found := err == nil
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		writeError(w, http.StatusInternalServerError, "failed to login")
 		return
 	}
-	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to login")
+	hash := userRow.PasswordHash.String
+	if !found || hash == "" {
+		hash = s.dummyPasswordHash()
+	}
+	matched := s.checkPassword(userRow.ID, hash, req.Password)
+	switch {
+	case !found:
+		s.failLogin(w, r, start, req.Email, 0, loginUnknownEmail)
+		return
+	case userRow.PasswordHash.String == "":
+		s.failLogin(w, r, start, req.Email, userRow.ID, loginNoPassword)
+		return
+	case !matched:
+		s.failLogin(w, r, start, req.Email, userRow.ID, loginWrongPassword)
+		return
+	case userRow.DeactivatedAt.Valid:
+		s.failLogin(w, r, start, req.Email, userRow.ID, loginDeactivated)
 		return
 	}
 
-	if userRow.PasswordHash.String == "" || bcrypt.CompareHashAndPassword([]byte(userRow.PasswordHash.String), []byte(req.Password)) != nil {
-		writeError(w, http.StatusUnauthorized, "invalid credentials")
-		return
+	if password.NeedsRehash(hash, s.passwordParams) {
+		s.rehashPassword(r.Context(), userRow.ID, hash, req.Password)
 	}
 
-	token, err := s.issueToken(int64(userRow.ID))
+	client := s.loginClient(r, req.DeviceID)
+	token, newDevice, err := s.startSession(r.Context(), int64(userRow.ID), client)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, "failed to issue token")
 		return
 	}
+	if newDevice {
+		s.sendNewDeviceEmail(r.Context(), userRow, client, r.Header.Get("Accept-Language"))
+	}
+	s.loginStats.succeeded.Add(1)
 
 	writeJSON(w, http.StatusOK, map[string]any{
 		"token": token,
@@ -225,55 +410,257 @@ func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
 // --- RecordingsService Implementation ---
 
 func (s *Server) ListRecordings(ctx context.Context, req *connect.Request[secretaryv1.ListRecordingsRequest]) (*connect.Response[secretaryv1.ListRecordingsResponse], error) {
-	rows, err := s.queries.ListRecordings(ctx)
+	msg := req.Msg
+	arg := db.ListRecordingsParams{
+		ParticipantID: optionalInt4(msg.ParticipantId),
+	}
+	// Sealed transcripts can't be matched in SQL, so with encryption on
+	// listRecordings matches the query once they are opened.
+	if s.encryption == nil {
+		arg.Query = optionalText(escapeLike(msg.Query))
+	}
+	if msg.HasAudio != nil {
+		arg.HasAudio = pgtype.Bool{Bool: *msg.HasAudio, Valid: true}
+	}
+	var err error
+	if arg.CreatedAfter, err = parseOptionalTimestamp(msg.CreatedAfter); err != nil {
+		return nil, apierr.InvalidField("created_after", "must be an RFC3339 timestamp")
+	}
+	if arg.CreatedBefore, err = parseOptionalTimestamp(msg.CreatedBefore); err != nil {
+		return nil, apierr.InvalidField("created_before", "must be an RFC3339 timestamp")
+	}
+
+	// The version and the body must come from the same database, or a
+	// lagging replica could cache an old body under a new ETag.
+	reads := s.recordingReads()
+	version, err := reads.GetRecordingsVersion(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list recordings")
+	}
+	etag := weakETag("recordings", version.Total, version.Latest.Time.UnixNano(), version.Todos, version.TodosLatest.Time.UnixNano())
+	starred, err := s.starredRecordings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cachedRead(ctx, s.recordingCache, req, etag, func() (*secretaryv1.ListRecordingsResponse, error) {
+		return s.listRecordings(ctx, reads, msg, arg)
+	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list recordings"))
+		return nil, err
 	}
+	markStarredRecordings(res, starred, msg.StarredOnly)
+	return res, nil
+}
 
+func (s *Server) listRecordings(ctx context.Context, q RecordingQueries, msg *secretaryv1.ListRecordingsRequest, arg db.ListRecordingsParams) (*secretaryv1.ListRecordingsResponse, error) {
+	rows, err := q.ListRecordings(ctx, arg)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list recordings")
+	}
+
+	query := strings.TrimSpace(msg.Query)
+	searchOpened := s.encryption != nil && query != ""
 	var recordings []*secretaryv1.Recording
 	for _, row := range rows {
+		transcript, err := s.openText(row.Transcript.String)
+		if err != nil {
+			return nil, err
+		}
+		if searchOpened && !containsFold(row.Name.String, query) && !containsFold(row.Summary.String, query) && !containsFold(transcript, query) {
+			continue
+		}
+		settings, err := decodeProcessingSettings(row.ProcessingSettings, row.PromptTemplateID)
+		if err != nil {
+			return nil, err
+		}
 		rec := &secretaryv1.Recording{
-			Id:         int64(row.ID),
-			CreatedAt:  formatTime(row.CreatedAt),
-			Name:       row.Name.String,
-			AudioUrl:   row.AudioUrl.String,
-			Transcript: row.Transcript.String,
-			Summary:    row.Summary.String,
-			HasAudio:   row.AudioUrl.String != "",
+			Id:                    int64(row.ID),
+			CreatedAt:             formatTime(row.CreatedAt),
+			Name:                  row.Name.String,
+			AudioUrl:              row.AudioUrl.String,
+			Transcript:            transcript,
+			Summary:               row.Summary.String,
+			HasAudio:              row.AudioUrl.String != "" || row.AudioKey.Valid,
+			Status:                mapRecordingStatus(row.Status),
+			StatusError:           row.StatusError.String,
+			StatusUpdatedAt:       formatTime(row.StatusUpdatedAt),
+			Sentiment:             mapSentiment(row.Sentiment.String),
+			PromptTemplateId:      int64(row.PromptTemplateID.Int32),
+			RetainUntil:           formatTime(row.RetainUntil),
+			AudioPurgedAt:         formatTime(row.AudioPurgedAt),
+			TranscriptPurgedAt:    formatTime(row.TranscriptPurgedAt),
+			LegalHold:             row.LegalHold,
+			LegalHoldReason:       row.LegalHoldReason.String,
+			ScanStatus:            mapScanStatus(row.ScanStatus),
+			OpenTodoCount:         row.OpenTodoCount,
+			DoneTodoCount:         row.DoneTodoCount,
+			Language:              settings.Language,
+			ClonedFromRecordingId: int64(row.ClonedFromRecordingID.Int32),
+			ProcessingSettings:    settings,
 		}
 		if row.Duration.Valid {
 			rec.Duration = row.Duration.Int32
 		}
 		recordings = append(recordings, rec)
 	}
-	return connect.NewResponse(&secretaryv1.ListRecordingsResponse{Recordings: recordings}), nil
+
+	if msg.IncludeParticipants && len(recordings) > 0 {
+		ids := make([]int32, len(recordings))
+		for i, rec := range recordings {
+			ids[i] = int32(rec.Id)
+		}
+		participants, err := q.ListParticipantsForRecordings(ctx, ids)
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to list recording participants")
+		}
+		byRecording := make(map[int64][]*secretaryv1.User, len(recordings))
+		for _, p := range participants {
+			byRecording[int64(p.RecordingID)] = append(byRecording[int64(p.RecordingID)], &secretaryv1.User{
+				Id:        int64(p.ID),
+				FirstName: p.FirstName,
+				LastName:  p.LastName.String,
+				Role:      p.Role.String,
+				SpeakerId: p.SpeakerID,
+				AvatarUrl: avatarURL(p.AvatarKey),
+			})
+		}
+		guests, err := q.ListGuestsForRecordings(ctx, ids)
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to list recording guests")
+		}
+		guestsByRecording := make(map[int64][]*secretaryv1.GuestParticipant, len(recordings))
+		for _, g := range guests {
+			guestsByRecording[int64(g.RecordingID)] = append(guestsByRecording[int64(g.RecordingID)], guestToProto(g))
+		}
+		for _, rec := range recordings {
+			rec.Participants = byRecording[rec.Id]
+			rec.Guests = guestsByRecording[rec.Id]
+		}
+	}
+	return &secretaryv1.ListRecordingsResponse{Recordings: recordings}, nil
 }
 
 func (s *Server) GetRecording(ctx context.Context, req *connect.Request[secretaryv1.GetRecordingRequest]) (*connect.Response[secretaryv1.GetRecordingResponse], error) {
 	id := req.Msg.Id
-	row, err := s.queries.GetRecording(ctx, int32(id))
+	reads := s.recordingReads()
+	version, err := reads.GetRecordingVersion(ctx, int32(id))
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
 	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to fetch recording"))
+		return nil, apierr.Wrap(err, "failed to fetch recording")
+	}
+	etag := weakETag("recording", id, version.UpdatedAt.Time.UnixNano(), version.Todos, version.TodosLatest.Time.UnixNano())
+	starred, err := s.starredRecordings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cachedRead(ctx, s.recordingCache, req, etag, func() (*secretaryv1.GetRecordingResponse, error) {
+		loaded, err := s.getRecording(ctx, reads, id)
+		if err == nil && req.Msg.OmitTranscript {
+			omitTranscript(loaded.Recording)
+		}
+		return loaded, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Msg.Recording.Starred = slices.Contains(starred, int32(id))
+	res.Header().Set("ETag", weakETag(etag, res.Msg.Recording.Starred))
+	return res, nil
+}
+
+func (s *Server) getRecording(ctx context.Context, q RecordingQueries, id int64) (*secretaryv1.GetRecordingResponse, error) {
+	row, err := q.GetRecording(ctx, int32(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch recording")
+	}
+	transcript, err := s.openText(row.Transcript.String)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := decodeProcessingSettings(row.ProcessingSettings, row.PromptTemplateID)
+	if err != nil {
+		return nil, err
 	}
 
 	rec := &secretaryv1.Recording{
-		Id:         int64(row.ID),
-		CreatedAt:  formatTime(row.CreatedAt),
-		Name:       row.Name.String,
-		AudioUrl:   row.AudioUrl.String,
-		Transcript: row.Transcript.String,
-		Summary:    row.Summary.String,
-		HasAudio:   row.AudioUrl.String != "",
+		Id:                    int64(row.ID),
+		CreatedAt:             formatTime(row.CreatedAt),
+		Name:                  row.Name.String,
+		AudioUrl:              row.AudioUrl.String,
+		Transcript:            transcript,
+		Summary:               row.Summary.String,
+		HasAudio:              row.AudioUrl.String != "" || row.AudioKey.Valid,
+		Status:                mapRecordingStatus(row.Status),
+		StatusError:           row.StatusError.String,
+		StatusUpdatedAt:       formatTime(row.StatusUpdatedAt),
+		Sentiment:             mapSentiment(row.Sentiment.String),
+		PromptTemplateId:      int64(row.PromptTemplateID.Int32),
+		RetainUntil:           formatTime(row.RetainUntil),
+		AudioPurgedAt:         formatTime(row.AudioPurgedAt),
+		TranscriptPurgedAt:    formatTime(row.TranscriptPurgedAt),
+		LegalHold:             row.LegalHold,
+		LegalHoldReason:       row.LegalHoldReason.String,
+		ScanStatus:            mapScanStatus(row.ScanStatus),
+		OpenTodoCount:         row.OpenTodoCount,
+		DoneTodoCount:         row.DoneTodoCount,
+		Language:              settings.Language,
+		ClonedFromRecordingId: int64(row.ClonedFromRecordingID.Int32),
+		ProcessingSettings:    settings,
 	}
 	if row.Duration.Valid {
 		rec.Duration = row.Duration.Int32
 	}
+	if strings.TrimSpace(transcript) != "" {
+		rec.TranscriptLineCount = int32(strings.Count(transcript, "\n") + 1)
+		rec.SpeakerWords = speakerWordCounts(transcript)
+	}
+
+	events, err := q.ListRecordingStatusEvents(ctx, int32(id))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch recording status history")
+	}
+	for _, event := range events {
+		rec.StatusHistory = append(rec.StatusHistory, recordingStatusEventToProto(event))
+	}
+	holds, err := q.ListRecordingHoldEvents(ctx, int32(id))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch legal hold history")
+	}
+	for _, hold := range holds {
+		rec.LegalHoldHistory = append(rec.LegalHoldHistory, legalHoldEventToProto(hold))
+	}
+	attempts, err := q.ListProcessingAttempts(ctx, int32(id))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch processing attempts")
+	}
+	for _, attempt := range attempts {
+		rec.ProcessingAttempts = append(rec.ProcessingAttempts, processingAttemptToProto(attempt))
+	}
+	translations, err := q.ListRecordingTranslations(ctx, int32(id))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch recording translations")
+	}
+	for _, translation := range translations {
+		if translation.Text, err = s.openText(translation.Text); err != nil {
+			return nil, err
+		}
+		rec.Translations = append(rec.Translations, recordingTranslationToProto(translation))
+	}
+	segments, err := q.ListTranscriptSegments(ctx, int32(id))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch transcript confidence")
+	}
+	for _, segment := range segments {
+		rec.TranscriptSegments = append(rec.TranscriptSegments, transcriptSegmentToProto(segment))
+	}
 
 	// Fetch participants
-	participants, err := s.queries.ListRecordingParticipants(ctx, int32(id))
+	participants, err := q.ListRecordingParticipants(ctx, int32(id))
 	if err == nil {
 		for _, p := range participants {
 			rec.Participants = append(rec.Participants, &secretaryv1.User{
@@ -282,47 +669,111 @@ func (s *Server) GetRecording(ctx context.Context, req *connect.Request[secretar
 				LastName:  p.LastName.String,
 				Role:      p.Role.String,
 				SpeakerId: int32(p.SpeakerID),
+				AvatarUrl: avatarURL(p.AvatarKey),
 			})
 		}
 	}
+	guests, err := q.ListRecordingGuests(ctx, int32(id))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list recording guests")
+	}
+	for _, g := range guests {
+		rec.Guests = append(rec.Guests, guestToProto(g))
+	}
 
-	return connect.NewResponse(&secretaryv1.GetRecordingResponse{Recording: rec}), nil
+	return &secretaryv1.GetRecordingResponse{Recording: rec}, nil
 }
 
 func (s *Server) DeleteRecording(ctx context.Context, req *connect.Request[secretaryv1.DeleteRecordingRequest]) (*connect.Response[secretaryv1.DeleteRecordingResponse], error) {
-	userID, ok := ctx.Value(userIdKey).(int64)
+	if err := s.requireAdmin(ctx, "only admins can delete recordings"); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.recordings.DeleteRecording(ctx, int32(req.Msg.Id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Either it's already gone, which is fine, or it's held.
+		row, err := s.recordings.GetRecording(ctx, int32(req.Msg.Id))
+		if err == nil && row.LegalHold {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("recording is under legal hold"))
+		}
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, apierr.Wrap(err, "failed to fetch recording")
+		}
+		return connect.NewResponse(&secretaryv1.DeleteRecordingResponse{}), nil
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to delete recording")
+	}
+	s.recordingCache.invalidate()
+	s.deleteRecordingObjects(ctx, deleted)
+	return connect.NewResponse(&secretaryv1.DeleteRecordingResponse{}), nil
+}
+
+// deleteRecordingObjects removes the stored files of a deleted recording.
+// The row is already gone, so failures are logged and the files left
+// behind.
+func (s *Server) deleteRecordingObjects(ctx context.Context, row db.DeleteRecordingRow) {
+	keys := append(row.ClipAudioKeys, row.AttachmentKeys...)
+	if row.AudioKey != "" {
+		keys = append(keys, row.AudioKey)
+	}
+	if len(keys) == 0 || s.storage == nil {
+		return
+	}
+	for _, key := range keys {
+		if err := s.storage.Delete(ctx, key); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			log.Printf("delete recording: failed to remove %s: %v", key, err)
+		}
+	}
+}
+
+// requireAdmin returns CodePermissionDenied with denied unless the caller
+// is an admin.
+func (s *Server) requireAdmin(ctx context.Context, denied string) error {
+	principal, ok := principalFrom(ctx)
 	if !ok {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
 	}
-	user, err := s.queries.GetUser(ctx, int32(userID))
+	user, err := s.users.GetUser(ctx, int32(principal.UserID))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to fetch user"))
+		return apierr.Wrap(err, "failed to fetch user")
 	}
 	if user.Role.String != "admin" {
-		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("only admins can delete recordings"))
+		return connect.NewError(connect.CodePermissionDenied, errors.New(denied))
 	}
+	return nil
+}
 
-	if err := s.queries.DeleteRecording(ctx, int32(req.Msg.Id)); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to delete recording"))
+// requireAuthorOrAdmin lets the caller through when they created the record
+// or are an admin.
+func (s *Server) requireAuthorOrAdmin(ctx context.Context, author pgtype.Int4, denied string) error {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return err
 	}
-	return connect.NewResponse(&secretaryv1.DeleteRecordingResponse{}), nil
+	if author.Valid && int64(author.Int32) == userID {
+		return nil
+	}
+	return s.requireAdmin(ctx, denied)
 }
 
 // --- UsersService Implementation ---
 
 func (s *Server) ListUsers(ctx context.Context, req *connect.Request[secretaryv1.ListUsersRequest]) (*connect.Response[secretaryv1.ListUsersResponse], error) {
-	rows, err := s.queries.ListUsers(ctx)
+	rows, err := s.userReads().ListUsers(ctx)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list users"))
+		return nil, apierr.Wrap(err, "failed to list users")
 	}
 
 	var users []*secretaryv1.User
 	for _, row := range rows {
 		users = append(users, &secretaryv1.User{
-			Id:        int64(row.ID),
-			FirstName: row.FirstName,
-			LastName:  row.LastName.String,
-			Role:      row.Role.String,
+			Id:          int64(row.ID),
+			FirstName:   row.FirstName,
+			LastName:    row.LastName.String,
+			Role:        row.Role.String,
+			AvatarUrl:   avatarURL(row.AvatarKey),
+			Deactivated: row.DeactivatedAt.Valid,
 		})
 	}
 	return connect.NewResponse(&secretaryv1.ListUsersResponse{Users: users}), nil
@@ -331,74 +782,84 @@ func (s *Server) ListUsers(ctx context.Context, req *connect.Request[secretaryv1
 // --- TodosService Implementation ---
 
 func (s *Server) ListTodos(ctx context.Context, req *connect.Request[secretaryv1.ListTodosRequest]) (*connect.Response[secretaryv1.ListTodosResponse], error) {
-	var todos []*secretaryv1.Todo
-
-	if req.Msg.RecordingId != nil {
-		// ... existing recording logic ...
-		recordingID := *req.Msg.RecordingId
-		rows, err := s.queries.ListTodosByRecording(ctx, pgtype.Int4{Int32: int32(recordingID), Valid: true})
-		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list todos by recording"))
-		}
-		for _, row := range rows {
-			todos = append(todos, todoRowToProto(row.ID, row.Name, row.Desc, row.Status, row.UserID, row.CreatedAtRecordingID, row.UpdatedAtRecordingID, row.RecordingName, row.RecordingDate, row.CreatedAt, row.UpdatedAt, row.SourceKind, row.SourceDocumentID, row.SourceBlockID))
-		}
-	} else {
-		userID := req.Msg.UserId
-		if userID == 0 {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id is required"))
-		}
+	starred, err := s.starredTodos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.todoReads().FilterTodos(ctx, req.Msg, starred)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list todos")
+	}
 
-		rows, err := s.queries.ListTodosByUser(ctx, pgtype.Int4{Int32: int32(userID), Valid: true})
-		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list todos"))
-		}
-		for _, row := range rows {
-			todos = append(todos, todoRowToProto(row.ID, row.Name, row.Desc, row.Status, row.UserID, row.CreatedAtRecordingID, row.UpdatedAtRecordingID, row.RecordingName, row.RecordingDate, row.CreatedAt, row.UpdatedAt, row.SourceKind, row.SourceDocumentID, row.SourceBlockID))
-		}
+	ids := make([]int32, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	reactions, err := s.listReactions(ctx, ids, nil)
+	if err != nil {
+		return nil, err
 	}
 
+	var todos []*secretaryv1.Todo
+	for _, row := range rows {
+		todo := todoRowToProto(row.ID, row.Name, row.Desc, row.Status, row.UserID, row.CreatedAtRecordingID, row.UpdatedAtRecordingID, row.RecordingName, row.RecordingDate, row.CreatedAt, row.UpdatedAt, row.SourceKind, row.SourceDocumentID, row.SourceBlockID, row.DueAt, row.Version, row.SnoozedUntil, row.Priority)
+		todo.Starred = slices.Contains(starred, row.ID)
+		todo.Reactions = reactions.todos[row.ID]
+		todos = append(todos, todo)
+	}
 	return connect.NewResponse(&secretaryv1.ListTodosResponse{Todos: todos}), nil
 }
 
 func (s *Server) GetTodo(ctx context.Context, req *connect.Request[secretaryv1.GetTodoRequest]) (*connect.Response[secretaryv1.GetTodoResponse], error) {
 	id := req.Msg.Id
-	row, err := s.queries.GetTodo(ctx, int32(id))
+	row, err := s.todos.GetTodo(ctx, int32(id))
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("todo not found"))
 	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to fetch todo"))
+		return nil, apierr.Wrap(err, "failed to fetch todo")
 	}
 
-	todo := todoRowToProto(row.ID, row.Name, row.Desc, row.Status, row.UserID, row.CreatedAtRecordingID, row.UpdatedAtRecordingID, row.RecordingName, row.RecordingDate, row.CreatedAt, row.UpdatedAt, row.SourceKind, row.SourceDocumentID, row.SourceBlockID)
+	starred, err := s.starredTodos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reactions, err := s.listReactions(ctx, []int32{row.ID}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	todo := todoRowToProto(row.ID, row.Name, row.Desc, row.Status, row.UserID, row.CreatedAtRecordingID, row.UpdatedAtRecordingID, row.RecordingName, row.RecordingDate, row.CreatedAt, row.UpdatedAt, row.SourceKind, row.SourceDocumentID, row.SourceBlockID, row.DueAt, row.Version, row.SnoozedUntil, row.Priority)
+	todo.Starred = slices.Contains(starred, row.ID)
+	todo.Reactions = reactions.todos[row.ID]
 	return connect.NewResponse(&secretaryv1.GetTodoResponse{Todo: todo}), nil
 }
 
 func (s *Server) CreateTodo(ctx context.Context, req *connect.Request[secretaryv1.CreateTodoRequest]) (*connect.Response[secretaryv1.CreateTodoResponse], error) {
 	msg := req.Msg
 	statusStr := mapStatusToString(msg.Status)
-	if err := validateTodoInput(msg.Name, statusStr); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	dueAt, err := parseOptionalTimestamp(msg.DueAt)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid due_at"))
 	}
-	if msg.UserId == 0 {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id is required"))
+	if s.isDeactivated(msg.UserId) {
+		return nil, apierr.InvalidField("user_id", "user is deactivated")
 	}
 
-	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	qtx, err := s.todos.BeginTodoTx(ctx)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to start transaction"))
+		return nil, apierr.Wrap(err, "failed to start transaction")
 	}
-	defer func() { _ = tx.Rollback(ctx) }()
-
-	qtx := s.queries.WithTx(tx)
+	defer func() { _ = qtx.Rollback(ctx) }()
 
 	// Create Todo
 	arg := db.CreateTodoParams{
-		Name:   msg.Name,
-		Desc:   pgtype.Text{String: msg.Desc, Valid: msg.Desc != ""},
-		Status: pgtype.Text{String: statusStr, Valid: true},
-		UserID: pgtype.Int4{Int32: int32(msg.UserId), Valid: true},
+		Name:     msg.Name,
+		Desc:     pgtype.Text{String: msg.Desc, Valid: msg.Desc != ""},
+		Status:   pgtype.Text{String: statusStr, Valid: true},
+		UserID:   pgtype.Int4{Int32: int32(msg.UserId), Valid: true},
+		DueAt:    dueAt,
+		Priority: int16(msg.Priority),
 	}
 	if msg.CreatedAtRecordingId != 0 {
 		arg.CreatedAtRecordingID = pgtype.Int4{Int32: int32(msg.CreatedAtRecordingId), Valid: true}
@@ -409,7 +870,7 @@ func (s *Server) CreateTodo(ctx context.Context, req *connect.Request[secretaryv
 
 	todoRow, err := qtx.CreateTodo(ctx, arg)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create todo"))
+		return nil, apierr.Wrap(err, "failed to create todo")
 	}
 
 	// Create History
@@ -428,14 +889,15 @@ func (s *Server) CreateTodo(ctx context.Context, req *connect.Request[secretaryv
 
 	err = qtx.CreateTodoHistory(ctx, historyArg)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to create todo history"))
+		return nil, apierr.Wrap(err, "failed to create todo history")
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to commit todo"))
+	if err := qtx.Commit(ctx); err != nil {
+		return nil, apierr.Wrap(err, "failed to commit todo")
 	}
+	s.notifyTodoAssigned(ctx, todoRow, pgtype.Int4{})
 
-	todo := todoRowToProto(todoRow.ID, todoRow.Name, todoRow.Desc, todoRow.Status, todoRow.UserID, todoRow.CreatedAtRecordingID, todoRow.UpdatedAtRecordingID, pgtype.Text{}, pgtype.Timestamptz{}, todoRow.CreatedAt, todoRow.UpdatedAt, todoRow.SourceKind, todoRow.SourceDocumentID, todoRow.SourceBlockID)
+	todo := todoRowToProto(todoRow.ID, todoRow.Name, todoRow.Desc, todoRow.Status, todoRow.UserID, todoRow.CreatedAtRecordingID, todoRow.UpdatedAtRecordingID, pgtype.Text{}, pgtype.Timestamptz{}, todoRow.CreatedAt, todoRow.UpdatedAt, todoRow.SourceKind, todoRow.SourceDocumentID, todoRow.SourceBlockID, todoRow.DueAt, todoRow.Version, todoRow.SnoozedUntil, todoRow.Priority)
 
 	return connect.NewResponse(&secretaryv1.CreateTodoResponse{Todo: todo}), nil
 }
@@ -443,27 +905,49 @@ func (s *Server) CreateTodo(ctx context.Context, req *connect.Request[secretaryv
 func (s *Server) UpdateTodo(ctx context.Context, req *connect.Request[secretaryv1.UpdateTodoRequest]) (*connect.Response[secretaryv1.UpdateTodoResponse], error) {
 	msg := req.Msg
 	statusStr := mapStatusToString(msg.Status)
-	if err := validateTodoInput(msg.Name, statusStr); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	dueAt, err := parseOptionalTimestamp(msg.GetDueAt())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid due_at"))
 	}
-	if msg.UserId == 0 {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id is required"))
+
+	// Read the assignee first so a reassignment can be announced.
+	var previousUserID pgtype.Int4
+	if s.notificationsEnabled() {
+		if previous, err := s.todos.GetTodo(ctx, int32(msg.Id)); err == nil {
+			previousUserID = previous.UserID
+		}
 	}
 
-	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	qtx, err := s.todos.BeginTodoTx(ctx)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to start transaction"))
+		return nil, apierr.Wrap(err, "failed to start transaction")
 	}
-	defer func() { _ = tx.Rollback(ctx) }()
+	defer func() { _ = qtx.Rollback(ctx) }()
 
-	qtx := s.queries.WithTx(tx)
+	// A deactivated user keeps the todos they had, but gets no new ones.
+	if s.isDeactivated(msg.UserId) {
+		current, err := qtx.GetTodo(ctx, int32(msg.Id))
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, apierr.Wrap(err, "failed to fetch todo")
+		}
+		if err == nil && int64(current.UserID.Int32) != msg.UserId {
+			return nil, apierr.InvalidField("user_id", "user is deactivated")
+		}
+	}
 
 	arg := db.UpdateTodoParams{
-		ID:     int32(msg.Id),
-		Name:   msg.Name,
-		Desc:   pgtype.Text{String: msg.Desc, Valid: msg.Desc != ""},
-		Status: pgtype.Text{String: statusStr, Valid: true},
-		UserID: pgtype.Int4{Int32: int32(msg.UserId), Valid: true},
+		ID:      int32(msg.Id),
+		Name:    msg.Name,
+		Desc:    pgtype.Text{String: msg.Desc, Valid: msg.Desc != ""},
+		Status:  pgtype.Text{String: statusStr, Valid: true},
+		UserID:  pgtype.Int4{Int32: int32(msg.UserId), Valid: true},
+		DueAt:   dueAt,
+		Version: int32(msg.ExpectedVersion),
+	}
+	// An unset due date keeps the current one; an empty one clears it.
+	arg.ClearDueAt = msg.DueAt != nil && !dueAt.Valid
+	if msg.Priority != nil {
+		arg.Priority = pgtype.Int2{Int16: int16(*msg.Priority), Valid: true}
 	}
 	if msg.UpdatedAtRecordingId != 0 {
 		arg.UpdatedAtRecordingID = pgtype.Int4{Int32: int32(msg.UpdatedAtRecordingId), Valid: true}
@@ -471,10 +955,18 @@ func (s *Server) UpdateTodo(ctx context.Context, req *connect.Request[secretaryv
 
 	todoRow, err := qtx.UpdateTodo(ctx, arg)
 	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, connect.NewError(connect.CodeNotFound, errors.New("todo not found"))
+		// Either the todo is gone or someone else saved it first.
+		current, versionErr := qtx.GetTodoVersion(ctx, arg.ID)
+		if errors.Is(versionErr, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("todo not found"))
+		}
+		if versionErr != nil {
+			return nil, apierr.Wrap(versionErr, "failed to fetch todo version")
+		}
+		return nil, apierr.StaleVersion(fmt.Sprintf("todo was modified (expected version %d, current version %d)", msg.ExpectedVersion, current), int64(current))
 	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update todo"))
+		return nil, apierr.Wrap(err, "failed to update todo")
 	}
 
 	actorID := msg.UserId // Defaulting to owner
@@ -492,14 +984,15 @@ func (s *Server) UpdateTodo(ctx context.Context, req *connect.Request[secretaryv
 
 	err = qtx.CreateTodoHistory(ctx, historyArg)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to update todo history"))
+		return nil, apierr.Wrap(err, "failed to update todo history")
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to commit todo"))
+	if err := qtx.Commit(ctx); err != nil {
+		return nil, apierr.Wrap(err, "failed to commit todo")
 	}
+	s.notifyTodoAssigned(ctx, todoRow, previousUserID)
 
-	todo := todoRowToProto(todoRow.ID, todoRow.Name, todoRow.Desc, todoRow.Status, todoRow.UserID, todoRow.CreatedAtRecordingID, todoRow.UpdatedAtRecordingID, pgtype.Text{}, pgtype.Timestamptz{}, todoRow.CreatedAt, todoRow.UpdatedAt, todoRow.SourceKind, todoRow.SourceDocumentID, todoRow.SourceBlockID)
+	todo := todoRowToProto(todoRow.ID, todoRow.Name, todoRow.Desc, todoRow.Status, todoRow.UserID, todoRow.CreatedAtRecordingID, todoRow.UpdatedAtRecordingID, pgtype.Text{}, pgtype.Timestamptz{}, todoRow.CreatedAt, todoRow.UpdatedAt, todoRow.SourceKind, todoRow.SourceDocumentID, todoRow.SourceBlockID, todoRow.DueAt, todoRow.Version, todoRow.SnoozedUntil, todoRow.Priority)
 
 	return connect.NewResponse(&secretaryv1.UpdateTodoResponse{Todo: todo}), nil
 }
@@ -507,25 +1000,15 @@ func (s *Server) UpdateTodo(ctx context.Context, req *connect.Request[secretaryv
 func (s *Server) DeleteTodo(ctx context.Context, req *connect.Request[secretaryv1.DeleteTodoRequest]) (*connect.Response[secretaryv1.DeleteTodoResponse], error) {
 	id := req.Msg.Id
 
-	userID, ok := ctx.Value(userIdKey).(int64)
-	if !ok {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
-	}
-	user, err := s.queries.GetUser(ctx, int32(userID))
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to fetch user"))
-	}
-	if user.Role.String != "admin" {
-		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("only admins can delete todos"))
+	if err := s.requireAdmin(ctx, "only admins can delete todos"); err != nil {
+		return nil, err
 	}
 
-	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	qtx, err := s.todos.BeginTodoTx(ctx)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to start transaction"))
+		return nil, apierr.Wrap(err, "failed to start transaction")
 	}
-	defer func() { _ = tx.Rollback(ctx) }()
-
-	qtx := s.queries.WithTx(tx)
+	defer func() { _ = qtx.Rollback(ctx) }()
 
 	// Fetch existing todo to record history
 	todoRow, err := qtx.GetTodo(ctx, int32(id))
@@ -533,7 +1016,7 @@ func (s *Server) DeleteTodo(ctx context.Context, req *connect.Request[secretaryv
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("todo not found"))
 	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to delete todo"))
+		return nil, apierr.Wrap(err, "failed to delete todo")
 	}
 
 	actorID := todoRow.UserID.Int32 // Defaulting to owner
@@ -551,25 +1034,25 @@ func (s *Server) DeleteTodo(ctx context.Context, req *connect.Request[secretaryv
 
 	err = qtx.CreateTodoHistory(ctx, historyArg)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to delete todo history"))
+		return nil, apierr.Wrap(err, "failed to delete todo history")
 	}
 
 	err = qtx.DeleteTodo(ctx, int32(id))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to delete todo"))
+		return nil, apierr.Wrap(err, "failed to delete todo")
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to commit delete"))
+	if err := qtx.Commit(ctx); err != nil {
+		return nil, apierr.Wrap(err, "failed to commit delete")
 	}
 	return connect.NewResponse(&secretaryv1.DeleteTodoResponse{}), nil
 }
 
 func (s *Server) ListTodoHistory(ctx context.Context, req *connect.Request[secretaryv1.ListTodoHistoryRequest]) (*connect.Response[secretaryv1.ListTodoHistoryResponse], error) {
 	id := req.Msg.TodoId
-	rows, err := s.queries.ListTodoHistory(ctx, int32(id))
+	rows, err := s.todoReads().ListTodoHistory(ctx, int32(id))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to list todo history"))
+		return nil, apierr.Wrap(err, "failed to list todo history")
 	}
 
 	var history []*secretaryv1.TodoHistory
@@ -583,6 +1066,7 @@ func (s *Server) ListTodoHistory(ctx context.Context, req *connect.Request[secre
 			Status:     mapStatus(row.Status.String),
 			UserId:     int64(row.UserID.Int32),
 			ChangedAt:  formatTime(row.ChangedAt),
+			Note:       row.Note.String,
 		}
 		if row.ActorUserID.Valid {
 			item.ActorUserId = int64(row.ActorUserID.Int32)
@@ -610,55 +1094,47 @@ func writeError(w http.ResponseWriter, status int, message string) {
 	writeJSON(w, status, map[string]any{"error": message})
 }
 
-func (s *Server) authMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == "/healthz" || r.URL.Path == "/api/login" {
-			next.ServeHTTP(w, r)
-			return
-		}
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-			writeError(w, http.StatusUnauthorized, "missing token")
-			return
-		}
-		tokenStr := strings.TrimSpace(strings.TrimPrefix(authHeader, "Bearer "))
-		if tokenStr == "" {
-			writeError(w, http.StatusUnauthorized, "missing token")
-			return
-		}
-		token, err := jwt.Parse(tokenStr, func(t *jwt.Token) (any, error) {
-			if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, errors.New("unexpected signing method")
-			}
-			return s.jwtSecret, nil
-		})
-		if err != nil || !token.Valid {
-			writeError(w, http.StatusUnauthorized, "invalid token")
-			return
-		}
+// tokenClaims are what the server's tokens carry besides the registered
+// claims: the device the session signed in on. The registered ID is the
+// session's, which lets it be revoked; tokens without one can't be.
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	// Device describes the browser and system, e.g. "Firefox on Windows".
+	Device string `json:"dev,omitempty"`
+	// DeviceFingerprint identifies the device across sign-ins.
+	DeviceFingerprint string `json:"dfp,omitempty"`
+}
 
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			writeError(w, http.StatusUnauthorized, "invalid token claims")
-			return
-		}
-		sub, _ := claims.GetSubject()
-		userID, _ := strconv.ParseInt(sub, 10, 64)
-		ctx := context.WithValue(r.Context(), userIdKey, userID)
+// issueToken signs claims for userID, filling in the subject and times.
+func (s *Server) issueToken(userID int64, claims tokenClaims) (string, error) {
+	now := time.Now().UTC()
+	claims.Subject = strconv.FormatInt(userID, 10)
+	claims.IssuedAt = jwt.NewNumericDate(now)
+	claims.ExpiresAt = jwt.NewNumericDate(now.Add(s.tokenTTL))
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(s.jwtSecret.Load().current)
+}
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+// signingSecrets holds the secret tokens are signed with and the one it
+// replaced, which still verifies tokens issued before the rotation.
+type signingSecrets struct {
+	current  []byte
+	previous []byte
 }
 
-func (s *Server) issueToken(userID int64) (string, error) {
-	now := time.Now().UTC()
-	claims := jwt.RegisteredClaims{
-		Subject:   strconv.FormatInt(userID, 10),
-		IssuedAt:  jwt.NewNumericDate(now),
-		ExpiresAt: jwt.NewNumericDate(now.Add(s.tokenTTL)),
+func (s *signingSecrets) keys() []jwt.VerificationKey {
+	keys := []jwt.VerificationKey{s.current}
+	if s.previous != nil {
+		keys = append(keys, s.previous)
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.jwtSecret)
+	return keys
+}
+
+// RotateJWTSecret signs new tokens with secret. Tokens signed with the
+// previous secret stay valid until they expire or the secret is rotated
+// again.
+func (s *Server) RotateJWTSecret(secret []byte) {
+	s.jwtSecret.Store(&signingSecrets{current: secret, previous: s.jwtSecret.Load().current})
 }
 
 func formatTime(ts pgtype.Timestamptz) string {
@@ -705,6 +1181,10 @@ func todoRowToProto(
 	sourceKind string,
 	sourceDocumentID pgtype.Int4,
 	sourceBlockID pgtype.Int4,
+	dueAt pgtype.Timestamptz,
+	version int32,
+	snoozedUntil pgtype.Timestamptz,
+	priority int16,
 ) *secretaryv1.Todo {
 	todo := &secretaryv1.Todo{
 		Id:                     int64(id),
@@ -717,6 +1197,10 @@ func todoRowToProto(
 		CreatedAt:              formatTime(createdAt),
 		UpdatedAt:              formatTime(updatedAt),
 		SourceKind:             sourceKind,
+		DueAt:                  formatTime(dueAt),
+		Version:                int64(version),
+		SnoozedUntil:           formatTime(snoozedUntil),
+		Priority:               secretaryv1.TodoPriority(priority),
 	}
 	if createdAtRecordingID.Valid {
 		todo.CreatedAtRecordingId = int64(createdAtRecordingID.Int32)
@@ -779,4 +1263,7 @@ func nullInt(v int64) any {
 type LoginRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	// DeviceID is a random ID the web app keeps per browser, to tell
+	// devices apart. Optional.
+	DeviceID string `json:"deviceId"`
 }
@@ -8,7 +8,6 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
-	"os"
 	"strconv"
 	"testing"
 	"time"
@@ -17,22 +16,15 @@ import (
 	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
 	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
 	"github.com/mvult/secretary/backend/internal/server/agent"
+	"github.com/mvult/secretary/backend/internal/testdb"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 )
 
 func TestRecordingsListAndGet(t *testing.T) {
-	dbURL := os.Getenv("DATABASE_URL")
-	if dbURL == "" {
-		t.Skip("DATABASE_URL not set")
-	}
 	ctx := context.Background()
-	pool, err := pgxpool.New(ctx, dbURL)
-	if err != nil {
-		t.Fatalf("open db: %v", err)
-	}
-	t.Cleanup(pool.Close)
+	pool := testdb.New(t)
 
 	recordingID := insertRecording(t, ctx, pool)
 	defer cleanupRecording(t, ctx, pool, recordingID)
@@ -94,16 +86,8 @@ func TestRecordingsListAndGet(t *testing.T) {
 }
 
 func TestTodoLifecycle(t *testing.T) {
-	dbURL := os.Getenv("DATABASE_URL")
-	if dbURL == "" {
-		t.Skip("DATABASE_URL not set")
-	}
 	ctx := context.Background()
-	pool, err := pgxpool.New(ctx, dbURL)
-	if err != nil {
-		t.Fatalf("open db: %v", err)
-	}
-	t.Cleanup(pool.Close)
+	pool := testdb.New(t)
 
 	userID, email, password := insertUser(t, ctx, pool)
 	recordingID := insertRecording(t, ctx, pool)
@@ -124,7 +108,7 @@ func TestTodoLifecycle(t *testing.T) {
 		CreatedAtRecordingId: recordingID,
 		UpdatedAtRecordingId: recordingID,
 	}
-	todo := createTodo(t, ts.URL, token, createReq)
+	todo := createTodo(t, ts.URL, token, &createReq)
 	defer cleanupTodo(t, ctx, pool, todo.Id)
 
 	// ListTodos
@@ -146,16 +130,54 @@ func TestTodoLifecycle(t *testing.T) {
 		Status:               secretaryv1.TodoStatus_TODO_STATUS_DONE,
 		UserId:               userID,
 		UpdatedAtRecordingId: recordingID,
+		ExpectedVersion:      todo.Version,
 	}
 	updateURL := ts.URL + secretaryv1connect.TodosServiceUpdateTodoProcedure
-	updateResp, err := authPost(updateURL, token, updateReq)
+	updateResp, err := authPost(updateURL, token, &updateReq)
 	if err != nil {
 		t.Fatalf("update todo: %v", err)
 	}
 	if updateResp.StatusCode != http.StatusOK {
 		t.Fatalf("update todo status: %d", updateResp.StatusCode)
 	}
+	var updatePayload secretaryv1.UpdateTodoResponse
+	if err := json.NewDecoder(updateResp.Body).Decode(&updatePayload); err != nil {
+		t.Fatalf("decode update: %v", err)
+	}
 	updateResp.Body.Close()
+	if updatePayload.Todo.GetVersion() != todo.Version+1 {
+		t.Fatalf("expected version %d, got %d", todo.Version+1, updatePayload.Todo.GetVersion())
+	}
+
+	// The recording counts the todo as done.
+	getResp, err := authPost(ts.URL+secretaryv1connect.RecordingsServiceGetRecordingProcedure, token, map[string]any{"id": recordingID})
+	if err != nil {
+		t.Fatalf("get recording: %v", err)
+	}
+	var getPayload secretaryv1.GetRecordingResponse
+	if err := json.NewDecoder(getResp.Body).Decode(&getPayload); err != nil {
+		t.Fatalf("decode get: %v", err)
+	}
+	getResp.Body.Close()
+	if rec := getPayload.Recording; rec.OpenTodoCount != 0 || rec.DoneTodoCount != 1 {
+		t.Fatalf("expected 0 open and 1 done todo, got %d and %d", rec.OpenTodoCount, rec.DoneTodoCount)
+	}
+
+	// A second write based on the old version must not clobber the first.
+	staleResp, err := authPost(updateURL, token, &updateReq)
+	if err != nil {
+		t.Fatalf("stale update: %v", err)
+	}
+	var staleErr struct {
+		Code string `json:"code"`
+	}
+	if err := json.NewDecoder(staleResp.Body).Decode(&staleErr); err != nil {
+		t.Fatalf("decode stale update: %v", err)
+	}
+	staleResp.Body.Close()
+	if staleErr.Code != "failed_precondition" {
+		t.Fatalf("expected failed_precondition for stale update, got %q", staleErr.Code)
+	}
 
 	// ListTodoHistory
 	historyURL := ts.URL + secretaryv1connect.TodosServiceListTodoHistoryProcedure
@@ -188,16 +210,8 @@ func TestTodoLifecycle(t *testing.T) {
 }
 
 func TestWorkspaceDocumentPersistenceFlow(t *testing.T) {
-	dbURL := os.Getenv("DATABASE_URL")
-	if dbURL == "" {
-		t.Skip("DATABASE_URL not set")
-	}
 	ctx := context.Background()
-	pool, err := pgxpool.New(ctx, dbURL)
-	if err != nil {
-		t.Fatalf("open db: %v", err)
-	}
-	t.Cleanup(pool.Close)
+	pool := testdb.New(t)
 
 	userID, email, password := insertUser(t, ctx, pool)
 	defer cleanupUser(t, ctx, pool, userID)
@@ -606,16 +620,8 @@ func TestWorkspaceDocumentPersistenceFlow(t *testing.T) {
 }
 
 func TestDirectoryLifecycle(t *testing.T) {
-	dbURL := os.Getenv("DATABASE_URL")
-	if dbURL == "" {
-		t.Skip("DATABASE_URL not set")
-	}
 	ctx := context.Background()
-	pool, err := pgxpool.New(ctx, dbURL)
-	if err != nil {
-		t.Fatalf("open db: %v", err)
-	}
-	t.Cleanup(pool.Close)
+	pool := testdb.New(t)
 
 	userID, email, password := insertUser(t, ctx, pool)
 	defer cleanupUser(t, ctx, pool, userID)
@@ -757,16 +763,8 @@ func TestDirectoryLifecycle(t *testing.T) {
 }
 
 func TestAIThreadPersistenceLifecycle(t *testing.T) {
-	dbURL := os.Getenv("DATABASE_URL")
-	if dbURL == "" {
-		t.Skip("DATABASE_URL not set")
-	}
 	ctx := context.Background()
-	pool, err := pgxpool.New(ctx, dbURL)
-	if err != nil {
-		t.Fatalf("open db: %v", err)
-	}
-	t.Cleanup(pool.Close)
+	pool := testdb.New(t)
 
 	userID, email, password := insertUser(t, ctx, pool)
 	defer cleanupUser(t, ctx, pool, userID)
@@ -979,16 +977,8 @@ func TestAIThreadPersistenceLifecycle(t *testing.T) {
 }
 
 func TestRunAIThreadTurn(t *testing.T) {
-	dbURL := os.Getenv("DATABASE_URL")
-	if dbURL == "" {
-		t.Skip("DATABASE_URL not set")
-	}
 	ctx := context.Background()
-	pool, err := pgxpool.New(ctx, dbURL)
-	if err != nil {
-		t.Fatalf("open db: %v", err)
-	}
-	t.Cleanup(pool.Close)
+	pool := testdb.New(t)
 
 	srv := New(pool, []byte("test-secret"), 24*time.Hour)
 	srv.SetAIRunner(fakeAIRunner{result: &agent.Result{Content: "Here is a grounded reply.", Provider: "test-provider", Model: "test-model", InputTokens: 11, OutputTokens: 7, ResponseJSON: map[string]any{"ok": true}}})
@@ -1131,7 +1121,7 @@ func cleanupWorkspace(t *testing.T, ctx context.Context, pool *pgxpool.Pool, wor
 	_, _ = pool.Exec(ctx, `DELETE FROM workspace WHERE id = $1`, workspaceID)
 }
 
-func createTodo(t *testing.T, baseURL string, token string, req secretaryv1.CreateTodoRequest) *secretaryv1.Todo {
+func createTodo(t *testing.T, baseURL string, token string, req *secretaryv1.CreateTodoRequest) *secretaryv1.Todo {
 	t.Helper()
 	createURL := baseURL + secretaryv1connect.TodosServiceCreateTodoProcedure
 	resp, err := authPost(createURL, token, req)
@@ -0,0 +1,345 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"maps"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/i18n"
+	"github.com/mvult/secretary/backend/internal/mail"
+	"github.com/mvult/secretary/backend/internal/shared"
+)
+
+// SessionStore holds the queries tracking sign-ins and the tokens they
+// issued.
+type SessionStore interface {
+	CreateSession(ctx context.Context, arg db.CreateSessionParams) (db.UserSession, error)
+	ListSessions(ctx context.Context, userID int32) ([]db.UserSession, error)
+	TouchSession(ctx context.Context, arg db.TouchSessionParams) error
+	RevokeSession(ctx context.Context, arg db.RevokeSessionParams) (db.UserSession, error)
+	RevokeOtherSessions(ctx context.Context, arg db.RevokeOtherSessionsParams) ([]string, error)
+	ListRevokedSessionIDs(ctx context.Context) ([]string, error)
+	RememberDevice(ctx context.Context, arg db.RememberDeviceParams) (db.RememberDeviceRow, error)
+}
+
+// sessionTouchInterval is how often a session's last use is written, so
+// busy clients don't cost a write per request.
+const sessionTouchInterval = time.Minute
+
+// Longer device IDs and User-Agents are cut, so a client can't fill the
+// table with them.
+const (
+	maxDeviceIDLen  = 100
+	maxUserAgentLen = 512
+)
+
+// sessionClient is what the server can tell about the client signing in.
+type sessionClient struct {
+	UserAgent string
+	IP        string
+	// Location is roughly where the client is, as a trusted proxy reports
+	// it; empty when none does.
+	Location string
+	// DeviceID is the random ID the web app keeps per browser; empty for
+	// other clients.
+	DeviceID string
+}
+
+// loginClient describes the client behind a sign-in request.
+func (s *Server) loginClient(r *http.Request, deviceID string) sessionClient {
+	if len(deviceID) > maxDeviceIDLen {
+		deviceID = ""
+	}
+	userAgent := r.UserAgent()
+	if len(userAgent) > maxUserAgentLen {
+		userAgent = strings.ToValidUTF8(userAgent[:maxUserAgentLen], "")
+	}
+	return sessionClient{
+		UserAgent: userAgent,
+		IP:        s.ipAllowlist.clientIP(r.RemoteAddr, r.Header).String(),
+		Location:  s.ipAllowlist.location(r.RemoteAddr, r.Header),
+		DeviceID:  deviceID,
+	}
+}
+
+func (c sessionClient) device() string {
+	return describeUserAgent(c.UserAgent)
+}
+
+// fingerprint identifies the device across sign-ins: by the web app's
+// device ID when there is one, and otherwise by browser and system, so a
+// browser update doesn't make a device new.
+func (c sessionClient) fingerprint() string {
+	key := "ua:" + c.device()
+	if c.DeviceID != "" {
+		key = "id:" + c.DeviceID
+	}
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:16])
+}
+
+// startSession records a sign-in and issues its token. newDevice reports
+// a sign-in from a device the user hasn't used before, when they have
+// used others; their first sign-in isn't news.
+func (s *Server) startSession(ctx context.Context, userID int64, client sessionClient) (token string, newDevice bool, err error) {
+	id := uuid.NewString()
+	fingerprint := client.fingerprint()
+	_, err = s.sessions.CreateSession(ctx, db.CreateSessionParams{
+		ID:                id,
+		UserID:            int32(userID),
+		UserAgent:         client.UserAgent,
+		Ip:                client.IP,
+		Location:          client.Location,
+		DeviceFingerprint: fingerprint,
+		ExpiresAt:         pgtype.Timestamptz{Time: time.Now().Add(s.tokenTTL), Valid: true},
+	})
+	if err != nil {
+		return "", false, err
+	}
+	token, err = s.issueToken(userID, tokenClaims{
+		RegisteredClaims:  jwt.RegisteredClaims{ID: id},
+		Device:            client.device(),
+		DeviceFingerprint: fingerprint,
+	})
+	if err != nil {
+		return "", false, err
+	}
+	known, err := s.sessions.RememberDevice(ctx, db.RememberDeviceParams{UserID: int32(userID), Fingerprint: fingerprint, Device: client.device()})
+	if err != nil {
+		// The sign-in worked; only the alert is lost.
+		log.Printf("sessions: failed to remember user %d's device: %v", userID, err)
+		return token, false, nil
+	}
+	return token, known.NewDevice && known.KnownDevices > 0, nil
+}
+
+// sendNewDeviceEmail tells user about a sign-in from a new device, in the
+// background like sendAssignmentEmail.
+func (s *Server) sendNewDeviceEmail(ctx context.Context, user db.GetUserByEmailRow, client sessionClient, acceptLanguage string) {
+	if user.Email.String == "" || !s.emailNotificationsEnabled() {
+		return
+	}
+	locale := i18n.Negotiate(user.Locale.String, acceptLanguage)
+	where := client.IP
+	if client.Location != "" {
+		where = client.Location + " (" + client.IP + ")"
+	}
+	msg := mail.Message{
+		To:      user.Email.String,
+		Subject: i18n.T(locale, "New sign-in to Secretary"),
+		Text: i18n.Sprintf(locale, "Hi %s,\n\nYour account was just signed in to from a new device:\n\n%s\n%s\n%s\n\nIf this was you, there is nothing to do. If it wasn't, sign that session out under Sessions on your profile page and change your password.\n",
+			user.FirstName, client.device(), where, time.Now().UTC().Format("2006-01-02 15:04 MST")),
+	}
+	go func() {
+		if err := s.mailer.Send(context.WithoutCancel(ctx), msg); err != nil {
+			log.Printf("emailing user %d about a new device: %v", user.ID, err)
+		}
+	}()
+}
+
+// touchSession records that principal's session was used, and from where.
+// It fails quietly: the request goes ahead either way.
+func (s *Server) touchSession(ctx context.Context, principal Principal) {
+	if principal.SessionID == "" || !s.sessionTouch.due(principal.SessionID, time.Now(), sessionTouchInterval) {
+		return
+	}
+	if err := s.sessions.TouchSession(ctx, db.TouchSessionParams{ID: principal.SessionID, Ip: principal.IP.String()}); err != nil {
+		log.Printf("sessions: failed to record use of session %s: %v", principal.SessionID, err)
+	}
+}
+
+func (s *Server) isRevoked(sessionID string) bool {
+	if sessionID == "" {
+		return false
+	}
+	if ids := s.revoked.Load(); ids != nil {
+		return (*ids)[sessionID]
+	}
+	return false
+}
+
+// setRevoked records sessions revoked through this instance, or published
+// by another, right away instead of waiting for the next load.
+func (s *Server) setRevoked(sessionIDs []string) {
+	ids := map[string]bool{}
+	if current := s.revoked.Load(); current != nil {
+		ids = maps.Clone(*current)
+	}
+	for _, id := range sessionIDs {
+		ids[id] = true
+	}
+	s.revoked.Store(&ids)
+}
+
+// LoadRevokedSessions reads the revoked sessions whose tokens haven't
+// expired into the cache.
+func (s *Server) LoadRevokedSessions(ctx context.Context) error {
+	rows, err := s.sessions.ListRevokedSessionIDs(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make(map[string]bool, len(rows))
+	for _, id := range rows {
+		ids[id] = true
+	}
+	s.revoked.Store(&ids)
+	return nil
+}
+
+// StartRevokedSessionsRefresh reloads the revoked sessions every
+// interval, so a session revoked through another instance is signed out
+// of this one too, and expired ones drop out of the cache. It returns at
+// once; reloading stops with ctx.
+func (s *Server) StartRevokedSessionsRefresh(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := s.LoadRevokedSessions(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("revoked sessions refresh: %v", err)
+			}
+		}
+	}()
+}
+
+// describeUserAgent names the browser and system in a User-Agent, e.g.
+// "Firefox on Windows", or the client for other callers.
+func describeUserAgent(ua string) string {
+	if ua == "" {
+		return "Unknown device"
+	}
+	browser := ""
+	for _, b := range []struct{ token, name string }{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"CriOS/", "Chrome"},
+		{"Safari/", "Safari"},
+	} {
+		if strings.Contains(ua, b.token) {
+			browser = b.name
+			break
+		}
+	}
+	system := ""
+	for _, o := range []struct{ token, name string }{
+		{"iPhone", "iOS"},
+		{"iPad", "iPadOS"},
+		{"Android", "Android"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	} {
+		if strings.Contains(ua, o.token) {
+			system = o.name
+			break
+		}
+	}
+	switch {
+	case browser != "" && system != "":
+		return browser + " on " + system
+	case browser != "":
+		return browser
+	case system != "":
+		return system
+	}
+	// Other clients, like "curl/8.4.0" or "okhttp/4.12.0".
+	name, _, _ := strings.Cut(ua, "/")
+	return strings.TrimSpace(name)
+}
+
+func sessionToProto(row db.UserSession, current string) *secretaryv1.Session {
+	return &secretaryv1.Session{
+		Id:         row.ID,
+		Device:     describeUserAgent(row.UserAgent),
+		UserAgent:  row.UserAgent,
+		Ip:         row.Ip,
+		LastSeenAt: formatTime(row.LastSeenAt),
+		IssuedAt:   formatTime(row.CreatedAt),
+		ExpiresAt:  formatTime(row.ExpiresAt),
+		Current:    row.ID == current,
+		Location:   row.Location,
+	}
+}
+
+func (s *Server) ListSessions(ctx context.Context, _ *connect.Request[secretaryv1.ListSessionsRequest]) (*connect.Response[secretaryv1.ListSessionsResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.sessions.ListSessions(ctx, int32(userID))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list sessions")
+	}
+	principal, _ := principalFrom(ctx)
+	sessions := make([]*secretaryv1.Session, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, sessionToProto(row, principal.SessionID))
+	}
+	return connect.NewResponse(&secretaryv1.ListSessionsResponse{Sessions: sessions}), nil
+}
+
+func (s *Server) RevokeSession(ctx context.Context, req *connect.Request[secretaryv1.RevokeSessionRequest]) (*connect.Response[secretaryv1.RevokeSessionResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	principal, _ := principalFrom(ctx)
+	var revoked []string
+	if req.Msg.Others {
+		revoked, err = s.sessions.RevokeOtherSessions(ctx, db.RevokeOtherSessionsParams{UserID: int32(userID), KeepID: principal.SessionID})
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to revoke sessions")
+		}
+	} else {
+		if req.Msg.SessionId == "" {
+			return nil, apierr.InvalidField("session_id", "is required unless others is set")
+		}
+		row, err := s.sessions.RevokeSession(ctx, db.RevokeSessionParams{ID: req.Msg.SessionId, UserID: int32(userID)})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("session not found"))
+		}
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to revoke session")
+		}
+		revoked = []string{row.ID}
+	}
+	s.sessionsRevoked(ctx, userID, revoked)
+	return connect.NewResponse(&secretaryv1.RevokeSessionResponse{Revoked: int64(len(revoked))}), nil
+}
+
+// sessionsRevoked has every server process refuse the revoked sessions'
+// tokens from now on.
+func (s *Server) sessionsRevoked(ctx context.Context, userID int64, revoked []string) {
+	if len(revoked) == 0 {
+		return
+	}
+	s.setRevoked(revoked)
+	s.publish(ctx, shared.Event{Kind: shared.EventSessionsRevoked, SessionIDs: revoked})
+	log.Printf("sessions: user %d revoked %d session(s)", userID, len(revoked))
+}
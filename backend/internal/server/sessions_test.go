@@ -0,0 +1,285 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"golang.org/x/crypto/bcrypt"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/mail"
+	"github.com/mvult/secretary/backend/internal/shared"
+)
+
+type fakeSessions struct {
+	SessionStore
+	rows    map[string]db.UserSession
+	devices map[int32][]string
+	touched int
+}
+
+func (f *fakeSessions) RememberDevice(_ context.Context, arg db.RememberDeviceParams) (db.RememberDeviceRow, error) {
+	if f.devices == nil {
+		f.devices = map[int32][]string{}
+	}
+	known := f.devices[arg.UserID]
+	if slices.Contains(known, arg.Fingerprint) {
+		return db.RememberDeviceRow{KnownDevices: int64(len(known))}, nil
+	}
+	f.devices[arg.UserID] = append(known, arg.Fingerprint)
+	return db.RememberDeviceRow{NewDevice: true, KnownDevices: int64(len(known))}, nil
+}
+
+func (f *fakeSessions) CreateSession(_ context.Context, arg db.CreateSessionParams) (db.UserSession, error) {
+	if f.rows == nil {
+		f.rows = map[string]db.UserSession{}
+	}
+	now := pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	row := db.UserSession{ID: arg.ID, UserID: arg.UserID, UserAgent: arg.UserAgent, Ip: arg.Ip, Location: arg.Location, DeviceFingerprint: arg.DeviceFingerprint, CreatedAt: now, ExpiresAt: arg.ExpiresAt, LastSeenAt: now}
+	f.rows[arg.ID] = row
+	return row, nil
+}
+
+func (f *fakeSessions) ListSessions(_ context.Context, userID int32) ([]db.UserSession, error) {
+	var rows []db.UserSession
+	for _, row := range f.rows {
+		if row.UserID == userID && !row.RevokedAt.Valid {
+			rows = append(rows, row)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].UserAgent < rows[j].UserAgent })
+	return rows, nil
+}
+
+func (f *fakeSessions) TouchSession(_ context.Context, arg db.TouchSessionParams) error {
+	f.touched++
+	row, ok := f.rows[arg.ID]
+	if !ok {
+		return nil
+	}
+	row.Ip = arg.Ip
+	f.rows[arg.ID] = row
+	return nil
+}
+
+func (f *fakeSessions) RevokeSession(_ context.Context, arg db.RevokeSessionParams) (db.UserSession, error) {
+	row, ok := f.rows[arg.ID]
+	if !ok || row.UserID != arg.UserID || row.RevokedAt.Valid {
+		return db.UserSession{}, pgx.ErrNoRows
+	}
+	row.RevokedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	f.rows[arg.ID] = row
+	return row, nil
+}
+
+func (f *fakeSessions) RevokeOtherSessions(_ context.Context, arg db.RevokeOtherSessionsParams) ([]string, error) {
+	var ids []string
+	for id, row := range f.rows {
+		if row.UserID == arg.UserID && id != arg.KeepID && !row.RevokedAt.Valid {
+			row.RevokedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+			f.rows[id] = row
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (f *fakeSessions) ListRevokedSessionIDs(context.Context) ([]string, error) {
+	var ids []string
+	for id, row := range f.rows {
+		if row.RevokedAt.Valid {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func TestSessions(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	sessions := &fakeSessions{}
+	srv.sessions = sessions
+	ctx := context.Background()
+
+	start := func(userID int64, userAgent, ip string) string {
+		token, _, err := srv.startSession(ctx, userID, sessionClient{UserAgent: userAgent, IP: ip})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	laptop := start(1, "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:131.0) Gecko/20100101 Firefox/131.0", "198.51.100.1")
+	phone := start(1, "Mozilla/5.0 (iPhone; CPU iPhone OS 18_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/18.0 Mobile/15E148 Safari/604.1", "198.51.100.2")
+	start(2, "curl/8.4.0", "198.51.100.3")
+	status := func(token string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		srv.authMiddleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})).ServeHTTP(rec, req)
+		return rec.Code
+	}
+	if status(laptop) != http.StatusOK || status(laptop) != http.StatusOK {
+		t.Fatal("laptop's token refused")
+	}
+	if sessions.touched != 1 {
+		t.Fatalf("session use recorded %d times within a minute, want 1", sessions.touched)
+	}
+
+	principal, err := srv.authenticate("Bearer " + laptop)
+	if err != nil || principal.SessionID == "" || principal.Device != "Firefox on Windows" || principal.DeviceFingerprint == "" {
+		t.Fatalf("laptop's principal: %+v, %v", principal, err)
+	}
+	userCtx := withPrincipal(ctx, principal)
+	list, err := srv.ListSessions(userCtx, connect.NewRequest(&secretaryv1.ListSessionsRequest{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := list.Msg.Sessions
+	if len(got) != 2 || got[0].Device != "Firefox on Windows" || !got[0].Current || got[0].Ip != "192.0.2.1" || got[1].Device != "Safari on iOS" || got[1].Current {
+		t.Fatalf("sessions = %v", got)
+	}
+
+	// Another user's session can't be revoked.
+	for id, row := range sessions.rows {
+		if row.UserID == 2 {
+			_, err := srv.RevokeSession(userCtx, connect.NewRequest(&secretaryv1.RevokeSessionRequest{SessionId: id}))
+			if connect.CodeOf(err) != connect.CodeNotFound {
+				t.Fatalf("revoking another user's session: %v", err)
+			}
+		}
+	}
+	res, err := srv.RevokeSession(userCtx, connect.NewRequest(&secretaryv1.RevokeSessionRequest{SessionId: got[1].Id}))
+	if err != nil || res.Msg.Revoked != 1 {
+		t.Fatalf("revoking the phone: %v, %v", res, err)
+	}
+	if status(phone) != http.StatusUnauthorized || status(laptop) != http.StatusOK {
+		t.Fatal("revoking the phone's session didn't sign out just the phone")
+	}
+
+	// Another process hears about it through shared state, or on its
+	// next load.
+	other := New(nil, []byte("test"), time.Hour)
+	other.sessions = sessions
+	other.handleSharedEvent(ctx, shared.Event{Kind: shared.EventSessionsRevoked, SessionIDs: []string{got[1].Id}})
+	if _, err := other.authenticate("Bearer " + phone); err == nil {
+		t.Fatal("revoked session accepted after the event")
+	}
+	other.revoked.Store(nil)
+	if err := other.LoadRevokedSessions(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.authenticate("Bearer " + phone); err == nil {
+		t.Fatal("revoked session accepted after loading")
+	}
+
+	tablet := start(1, "Mozilla/5.0 (iPad; CPU OS 18_0 like Mac OS X)", "198.51.100.4")
+	res, err = srv.RevokeSession(userCtx, connect.NewRequest(&secretaryv1.RevokeSessionRequest{Others: true}))
+	if err != nil || res.Msg.Revoked != 1 || status(tablet) != http.StatusUnauthorized || status(laptop) != http.StatusOK {
+		t.Fatalf("revoking the other sessions: %v, %v", res, err)
+	}
+	if _, err := srv.RevokeSession(userCtx, connect.NewRequest(&secretaryv1.RevokeSessionRequest{})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("revoking without a session: %v", err)
+	}
+}
+
+func TestDescribeUserAgent(t *testing.T) {
+	for ua, want := range map[string]string{
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/130.0.0.0 Safari/537.36":         "Chrome on macOS",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/130.0.0.0 Safari/537.36 Edg/130.0.0.0": "Edge on Windows",
+		"Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/130.0.0.0 Mobile Safari/537.36":         "Chrome on Android",
+		"okhttp/4.12.0": "okhttp",
+		"":              "Unknown device",
+	} {
+		if got := describeUserAgent(ua); got != want {
+			t.Errorf("describeUserAgent(%q) = %q, want %q", ua, got, want)
+		}
+	}
+}
+
+func TestNewDeviceAlert(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, &deactivatingUsers{
+		users: map[int32]db.GetUserRow{7: {ID: 7, FirstName: "Luis", Role: optionalText("member")}},
+		hash:  string(hash),
+	})
+	sessions := &fakeSessions{}
+	srv.sessions = sessions
+	mailer := fakeMailer{sent: make(chan mail.Message, 1)}
+	srv.mailer = mailer
+	srv.ConfigureIPAllowlist(IPAllowlistConfig{TrustedProxies: []netip.Prefix{netip.MustParsePrefix("192.0.2.0/24")}})
+
+	login := func(deviceID, userAgent string) {
+		body := `{"email":"luis@example.com","password":"secret","deviceId":"` + deviceID + `"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/login", strings.NewReader(body))
+		req.Header.Set("User-Agent", userAgent)
+		req.Header.Set("X-Forwarded-For", "203.0.113.9")
+		req.Header.Set("CF-IPCity", "Lisbon")
+		req.Header.Set("CF-IPCountry", "PT")
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("login: %d %s", rec.Code, rec.Body)
+		}
+	}
+	noMail := func(when string) {
+		select {
+		case msg := <-mailer.sent:
+			t.Fatalf("%s: unexpected email %q", when, msg.Subject)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	firefox := "Mozilla/5.0 (X11; Linux x86_64; rv:131.0) Gecko/20100101 Firefox/131.0"
+	login("browser-a", firefox)
+	noMail("first sign-in")
+	login("browser-a", firefox)
+	noMail("known device")
+
+	login("browser-b", firefox)
+	select {
+	case msg := <-mailer.sent:
+		if msg.To != "luis@example.com" || !strings.Contains(msg.Text, "Firefox on Linux") || !strings.Contains(msg.Text, "Lisbon, PT (203.0.113.9)") {
+			t.Fatalf("alert = %+v", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no alert for a new device")
+	}
+
+	for _, row := range sessions.rows {
+		if row.Location != "Lisbon, PT" || row.Ip != "203.0.113.9" || row.DeviceFingerprint == "" {
+			t.Fatalf("session = %+v", row)
+		}
+	}
+}
+
+func TestLocation(t *testing.T) {
+	cfg := IPAllowlistConfig{TrustedProxies: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}}
+	header := http.Header{}
+	header.Set("X-Vercel-IP-City", "S%C3%A3o%20Paulo")
+	header.Set("X-Vercel-IP-Country", "BR")
+	if got := cfg.location("10.0.0.2:443", header); got != "São Paulo, BR" {
+		t.Errorf("from a trusted proxy: %q", got)
+	}
+	if got := cfg.location("203.0.113.7:443", header); got != "" {
+		t.Errorf("from the caller itself: %q", got)
+	}
+	header = http.Header{}
+	header.Set("CF-IPCountry", "XX")
+	if got := cfg.location("10.0.0.2:443", header); got != "" {
+		t.Errorf("unknown country: %q", got)
+	}
+}
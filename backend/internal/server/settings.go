@@ -0,0 +1,369 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/notify"
+	"github.com/mvult/secretary/backend/internal/push"
+	"github.com/mvult/secretary/backend/internal/shared"
+	"github.com/mvult/secretary/backend/internal/storage"
+	"github.com/mvult/secretary/backend/internal/trackers"
+	"github.com/mvult/secretary/backend/internal/wiki"
+)
+
+const (
+	maxLogoBytes  = 1 << 20
+	logoKeyPrefix = "logos/"
+)
+
+var logoNamePattern = regexp.MustCompile(`^[0-9a-f]{32}\.(png|jpg|gif|webp)$`)
+
+// logoExtensions maps the image types accepted as logos to the extension
+// their key is stored under, which later decides the served type.
+var logoExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// SettingsStore holds the queries for the organization's settings row.
+type SettingsStore interface {
+	GetOrgSetting(ctx context.Context) (db.OrgSetting, error)
+	SaveOrgSetting(ctx context.Context, arg db.SaveOrgSettingParams) (db.OrgSetting, error)
+	SetOrgLogo(ctx context.Context, arg db.SetOrgLogoParams) (db.OrgSetting, error)
+	SetMaintenanceMode(ctx context.Context, arg db.SetMaintenanceModeParams) (db.OrgSetting, error)
+	SetIPAllowlist(ctx context.Context, arg db.SetIPAllowlistParams) (db.OrgSetting, error)
+}
+
+// defaultOrgSetting matches the column defaults of org_setting, for
+// servers where no admin has saved settings yet.
+func defaultOrgSetting() db.OrgSetting {
+	return db.OrgSetting{ID: true, DefaultUserRole: "member"}
+}
+
+// orgSettings returns the settings as last loaded. Handlers read them on
+// every request, so they are cached rather than queried.
+func (s *Server) orgSettings() db.OrgSetting {
+	if cached := s.settingsCache.Load(); cached != nil {
+		return *cached
+	}
+	return defaultOrgSetting()
+}
+
+// LoadSettings reads the settings into the cache.
+func (s *Server) LoadSettings(ctx context.Context) error {
+	row, err := s.settings.GetOrgSetting(ctx)
+	if errors.Is(err, pgx.ErrNoRows) {
+		row, err = defaultOrgSetting(), nil
+	}
+	if err != nil {
+		return err
+	}
+	s.settingsCache.Store(&row)
+	return nil
+}
+
+// StartSettingsRefresh reloads the settings every interval, so changes
+// saved through another instance reach this one. It returns at once;
+// reloading stops with ctx.
+func (s *Server) StartSettingsRefresh(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := s.LoadSettings(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("settings refresh: %v", err)
+			}
+		}
+	}()
+}
+
+// integrationEnabled reports whether an admin has left the named
+// integration on. Whether it is configured is up to the caller.
+func (s *Server) integrationEnabled(name string) bool {
+	return !slices.Contains(s.orgSettings().DisabledIntegrations, name)
+}
+
+func (s *Server) tracker(name string) (trackers.Tracker, bool) {
+	tracker, ok := s.issueTrackers[name]
+	return tracker, ok && s.integrationEnabled(name)
+}
+
+func (s *Server) wikiTarget(name string) (wiki.Target, bool) {
+	target, ok := s.wikis[name]
+	return target, ok && s.integrationEnabled(name)
+}
+
+func (s *Server) pushSender(platform string) (push.Sender, bool) {
+	sender, ok := s.pushSenders[platform]
+	return sender, ok && s.integrationEnabled(platform)
+}
+
+// pushEnabled reports whether any push service is configured and on.
+func (s *Server) pushEnabled() bool {
+	for platform := range s.pushSenders {
+		if s.integrationEnabled(platform) {
+			return true
+		}
+	}
+	return false
+}
+
+// chat returns the team channels that are configured and on.
+func (s *Server) chat() *notify.Notifier {
+	return s.notifier.Without(s.orgSettings().DisabledIntegrations...)
+}
+
+// integrations lists every integration the server has credentials for
+// and whether it is on.
+func (s *Server) integrations() []*secretaryv1.Integration {
+	var names []string
+	for name := range s.issueTrackers {
+		names = append(names, name)
+	}
+	for name := range s.wikis {
+		names = append(names, name)
+	}
+	names = append(names, s.notifier.Names()...)
+	for name := range s.pushSenders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	list := make([]*secretaryv1.Integration, 0, len(names))
+	for _, name := range names {
+		list = append(list, &secretaryv1.Integration{Name: name, Configured: true, Enabled: s.integrationEnabled(name)})
+	}
+	return list
+}
+
+// knownIntegrations are the names disabled_integrations may hold; an
+// integration can be switched off before its credentials are deployed.
+func knownIntegrations() []string {
+	names := []string{notify.Slack, notify.Teams, push.FCM, push.APNs}
+	for _, name := range trackerNames {
+		names = append(names, name)
+	}
+	for _, name := range wikiNames {
+		names = append(names, name)
+	}
+	return names
+}
+
+func logoURL(key pgtype.Text) string {
+	if !key.Valid || key.String == "" {
+		return ""
+	}
+	return "/api/logos/" + strings.TrimPrefix(key.String, logoKeyPrefix)
+}
+
+func orgSettingToProto(row db.OrgSetting) *secretaryv1.OrgSettings {
+	return &secretaryv1.OrgSettings{
+		OrgName:         row.OrgName,
+		LogoUrl:         logoURL(row.LogoKey),
+		DefaultUserRole: row.DefaultUserRole,
+		Retention: &secretaryv1.RetentionPolicy{
+			AudioDays:      row.AudioRetentionDays,
+			TranscriptDays: row.TranscriptRetentionDays,
+		},
+		DisabledIntegrations: append([]string{}, row.DisabledIntegrations...),
+		Redaction:            redactionPolicyToProto(row),
+		ProcessingDefaults: &secretaryv1.ProcessingDefaults{
+			Language:              row.DefaultLanguage,
+			DisableSummary:        row.DisableSummary,
+			DisableTodoExtraction: row.DisableTodoExtraction,
+		},
+		UpdatedAt:       formatTime(row.UpdatedAt),
+		UpdatedByUserId: int64(row.UpdatedByUserID.Int32),
+	}
+}
+
+// --- SettingsService ---
+
+func (s *Server) GetSettings(ctx context.Context, req *connect.Request[secretaryv1.GetSettingsRequest]) (*connect.Response[secretaryv1.GetSettingsResponse], error) {
+	if _, err := requireUserID(ctx); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.GetSettingsResponse{
+		Settings:     orgSettingToProto(s.orgSettings()),
+		Integrations: s.integrations(),
+	}), nil
+}
+
+func (s *Server) UpdateSettings(ctx context.Context, req *connect.Request[secretaryv1.UpdateSettingsRequest]) (*connect.Response[secretaryv1.UpdateSettingsResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can change settings"); err != nil {
+		return nil, err
+	}
+	userID := currentUserID(ctx)
+	settings := req.Msg.Settings
+	known := knownIntegrations()
+	for _, name := range settings.DisabledIntegrations {
+		if !slices.Contains(known, name) {
+			return nil, apierr.InvalidField("settings.disabled_integrations", fmt.Sprintf("%q is not an integration", name))
+		}
+	}
+	defaults := settings.ProcessingDefaults
+	language, err := parseLanguageTag("settings.processing_defaults.language", defaults.GetLanguage())
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.settings.SaveOrgSetting(ctx, db.SaveOrgSettingParams{
+		OrgName:                 strings.TrimSpace(settings.OrgName),
+		DefaultUserRole:         settings.DefaultUserRole,
+		AudioRetentionDays:      settings.Retention.GetAudioDays(),
+		TranscriptRetentionDays: settings.Retention.GetTranscriptDays(),
+		DisabledIntegrations:    append([]string{}, settings.DisabledIntegrations...),
+		RedactEmails:            settings.Redaction.GetEmails(),
+		RedactPhoneNumbers:      settings.Redaction.GetPhoneNumbers(),
+		RedactCreditCards:       settings.Redaction.GetCreditCards(),
+		RedactProfanity:         settings.Redaction.GetProfanity(),
+		DefaultLanguage:         language,
+		DisableSummary:          defaults.GetDisableSummary(),
+		DisableTodoExtraction:   defaults.GetDisableTodoExtraction(),
+		UpdatedByUserID:         pgtype.Int4{Int32: int32(userID), Valid: true},
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to update settings")
+	}
+	s.settingsCache.Store(&row)
+	s.publish(ctx, shared.Event{Kind: shared.EventSettingsChanged})
+	return connect.NewResponse(&secretaryv1.UpdateSettingsResponse{Settings: orgSettingToProto(row)}), nil
+}
+
+// handleOrgLogo replaces (PUT) or removes (DELETE) the organization's
+// logo. Admin only.
+func (s *Server) handleOrgLogo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut && r.Method != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if err := s.requireAdmin(r.Context(), "only admins can change the logo"); err != nil {
+		switch connect.CodeOf(err) {
+		case connect.CodeUnauthenticated:
+			writeError(w, http.StatusUnauthorized, "unauthenticated")
+		case connect.CodePermissionDenied:
+			writeError(w, http.StatusForbidden, "only admins can change the logo")
+		default:
+			writeError(w, http.StatusInternalServerError, "failed to fetch user")
+		}
+		return
+	}
+	if s.storage == nil {
+		writeError(w, http.StatusServiceUnavailable, "storage is not configured")
+		return
+	}
+	userID := currentUserID(r.Context())
+	previous := s.orgSettings().LogoKey
+
+	var key string
+	if r.Method == http.MethodPut {
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxLogoBytes))
+		if err != nil {
+			writeError(w, http.StatusRequestEntityTooLarge, "logo must be at most 1 MiB")
+			return
+		}
+		ext, ok := logoExtensions[http.DetectContentType(data)]
+		if !ok {
+			writeError(w, http.StatusUnsupportedMediaType, "logo must be a PNG, JPEG, GIF or WebP image")
+			return
+		}
+		buf := make([]byte, 16)
+		if _, err := rand.Read(buf); err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to store logo")
+			return
+		}
+		key = logoKeyPrefix + hex.EncodeToString(buf) + ext
+		if _, err := s.storage.Put(r.Context(), key, bytes.NewReader(data)); err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to store logo")
+			return
+		}
+	}
+
+	row, err := s.settings.SetOrgLogo(r.Context(), db.SetOrgLogoParams{
+		LogoKey:         pgtype.Text{String: key, Valid: key != ""},
+		UpdatedByUserID: pgtype.Int4{Int32: int32(userID), Valid: true},
+	})
+	if err != nil {
+		if key != "" {
+			s.deleteLogo(r.Context(), key)
+		}
+		writeError(w, http.StatusInternalServerError, "failed to update logo")
+		return
+	}
+	s.settingsCache.Store(&row)
+	s.publish(r.Context(), shared.Event{Kind: shared.EventSettingsChanged})
+	if previous.Valid {
+		s.deleteLogo(r.Context(), previous.String)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"logoUrl": logoURL(row.LogoKey)})
+}
+
+// handleLogo serves the organization's logo. Like avatars, logo keys
+// change on every upload, so they are cached forever and served without
+// authentication for the sign-in page.
+func (s *Server) handleLogo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	name := r.PathValue("name")
+	if s.storage == nil || !logoNamePattern.MatchString(name) {
+		http.NotFound(w, r)
+		return
+	}
+	body, err := s.storage.Open(r.Context(), logoKeyPrefix+name)
+	if errors.Is(err, storage.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to read logo")
+		return
+	}
+	defer body.Close()
+	w.Header().Set("Content-Type", mime.TypeByExtension(path.Ext(name)))
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = io.Copy(w, body)
+}
+
+// deleteLogo removes a replaced logo. Failures only leak storage, so
+// they are logged.
+func (s *Server) deleteLogo(ctx context.Context, key string) {
+	if err := s.storage.Delete(ctx, key); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		log.Printf("logo: failed to delete %s: %v", key, err)
+	}
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+	"github.com/mvult/secretary/backend/internal/wiki"
+)
+
+// fakeSettings keeps the settings row in memory; a nil row is a server
+// where nobody saved settings yet.
+type fakeSettings struct {
+	row *db.OrgSetting
+}
+
+func (f *fakeSettings) GetOrgSetting(context.Context) (db.OrgSetting, error) {
+	if f.row == nil {
+		return db.OrgSetting{}, pgx.ErrNoRows
+	}
+	return *f.row, nil
+}
+
+func (f *fakeSettings) SaveOrgSetting(_ context.Context, arg db.SaveOrgSettingParams) (db.OrgSetting, error) {
+	row := defaultOrgSetting()
+	if f.row != nil {
+		row = *f.row
+	}
+	row.OrgName = arg.OrgName
+	row.DefaultUserRole = arg.DefaultUserRole
+	row.AudioRetentionDays = arg.AudioRetentionDays
+	row.TranscriptRetentionDays = arg.TranscriptRetentionDays
+	row.DisabledIntegrations = arg.DisabledIntegrations
+	row.DefaultLanguage = arg.DefaultLanguage
+	row.DisableSummary = arg.DisableSummary
+	row.DisableTodoExtraction = arg.DisableTodoExtraction
+	row.UpdatedByUserID = arg.UpdatedByUserID
+	f.row = &row
+	return row, nil
+}
+
+func (f *fakeSettings) SetOrgLogo(_ context.Context, arg db.SetOrgLogoParams) (db.OrgSetting, error) {
+	row := defaultOrgSetting()
+	if f.row != nil {
+		row = *f.row
+	}
+	row.LogoKey = arg.LogoKey
+	f.row = &row
+	return row, nil
+}
+
+func (f *fakeSettings) SetMaintenanceMode(_ context.Context, arg db.SetMaintenanceModeParams) (db.OrgSetting, error) {
+	row := defaultOrgSetting()
+	if f.row != nil {
+		row = *f.row
+	}
+	row.MaintenanceMode = arg.MaintenanceMode
+	row.MaintenanceMessage = arg.MaintenanceMessage
+	f.row = &row
+	return row, nil
+}
+
+func (f *fakeSettings) SetIPAllowlist(_ context.Context, arg db.SetIPAllowlistParams) (db.OrgSetting, error) {
+	row := defaultOrgSetting()
+	if f.row != nil {
+		row = *f.row
+	}
+	row.IpAllowlist = arg.IpAllowlist
+	f.row = &row
+	return row, nil
+}
+
+func TestUpdateSettings(t *testing.T) {
+	settings := &fakeSettings{}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.settings = settings
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	srv.ConfigureWikis([]wiki.Target{&fakeWiki{name: wiki.Notion}}, nil)
+	if err := srv.LoadSettings(context.Background()); err != nil {
+		t.Fatalf("LoadSettings: %v", err)
+	}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+
+	got, err := srv.GetSettings(ctx, connect.NewRequest(&secretaryv1.GetSettingsRequest{}))
+	if err != nil {
+		t.Fatalf("GetSettings: %v", err)
+	}
+	if got.Msg.Settings.DefaultUserRole != "member" || len(got.Msg.Integrations) != 1 || !got.Msg.Integrations[0].Enabled {
+		t.Fatalf("GetSettings = %v", got.Msg)
+	}
+
+	update := func(settings *secretaryv1.OrgSettings) error {
+		_, err := srv.UpdateSettings(ctx, connect.NewRequest(&secretaryv1.UpdateSettingsRequest{Settings: settings}))
+		return err
+	}
+	if err := update(&secretaryv1.OrgSettings{DefaultUserRole: "member", DisabledIntegrations: []string{"dropbox"}}); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("unknown integration: %v", err)
+	}
+	if err := update(&secretaryv1.OrgSettings{DefaultUserRole: "member", ProcessingDefaults: &secretaryv1.ProcessingDefaults{Language: "not a language"}}); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("bad default language: %v", err)
+	}
+	err = update(&secretaryv1.OrgSettings{
+		OrgName:              " Acme ",
+		DefaultUserRole:      "member",
+		Retention:            &secretaryv1.RetentionPolicy{AudioDays: 90},
+		DisabledIntegrations: []string{wiki.Notion},
+		ProcessingDefaults:   &secretaryv1.ProcessingDefaults{Language: "PT-br", DisableTodoExtraction: true},
+	})
+	if err != nil {
+		t.Fatalf("UpdateSettings: %v", err)
+	}
+	if settings.row.OrgName != "Acme" || settings.row.AudioRetentionDays != 90 || settings.row.DefaultLanguage != "pt-BR" || !settings.row.DisableTodoExtraction || settings.row.UpdatedByUserID.Int32 != 1 {
+		t.Fatalf("saved = %+v", settings.row)
+	}
+	if _, ok := srv.wikiTarget(wiki.Notion); ok {
+		t.Fatal("disabled wiki is still offered")
+	}
+	_, err = srv.PublishRecording(ctx, connect.NewRequest(&secretaryv1.PublishRecordingRequest{RecordingId: 3, Target: secretaryv1.WikiTarget_WIKI_TARGET_NOTION}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("publishing to a disabled wiki: %v", err)
+	}
+
+	srv.ConfigureStores(nil, nil, memberUsers{})
+	if err := update(&secretaryv1.OrgSettings{DefaultUserRole: "admin"}); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member changing settings: %v", err)
+	}
+}
+
+func TestOrgLogoUploadAndServe(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.settings = &fakeSettings{}
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.ConfigureStorage(store)
+	upload := func(body []byte) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/api/settings/logo", bytes.NewReader(body))
+		req = req.WithContext(withPrincipal(req.Context(), Principal{UserID: 1}))
+		rec := httptest.NewRecorder()
+		srv.handleOrgLogo(rec, req)
+		return rec
+	}
+
+	if rec := upload([]byte("<svg></svg>")); rec.Code != http.StatusUnsupportedMediaType {
+		t.Fatalf("status = %d, want 415", rec.Code)
+	}
+	var picture bytes.Buffer
+	if err := png.Encode(&picture, image.NewGray(image.Rect(0, 0, 240, 60))); err != nil {
+		t.Fatal(err)
+	}
+	rec := upload(picture.Bytes())
+	var body struct {
+		LogoURL string `json:"logoUrl"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil || rec.Code != http.StatusOK || !strings.HasPrefix(body.LogoURL, "/api/logos/") {
+		t.Fatalf("status = %d, url = %q, err = %v", rec.Code, body.LogoURL, err)
+	}
+	first := srv.orgSettings().LogoKey.String
+
+	serve := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, body.LogoURL, nil)
+	req.SetPathValue("name", strings.TrimPrefix(body.LogoURL, "/api/logos/"))
+	srv.handleLogo(serve, req)
+	if serve.Code != http.StatusOK || serve.Header().Get("Content-Type") != "image/png" || !bytes.Equal(serve.Body.Bytes(), picture.Bytes()) {
+		t.Fatalf("serve = %d %s", serve.Code, serve.Header().Get("Content-Type"))
+	}
+
+	upload(picture.Bytes())
+	if _, err := store.Open(context.Background(), first); err == nil {
+		t.Fatal("expected the previous logo to be deleted")
+	}
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/shared"
+)
+
+// SharedState is state every server process sees, kept in Redis. Without
+// it each process keeps its own and catches up with the others' changes
+// on its periodic refreshes.
+type SharedState interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Counter(ctx context.Context, key string) (int64, error)
+	Increment(ctx context.Context, key string) (int64, error)
+	Publish(ctx context.Context, event shared.Event) error
+	// Subscribe calls handle with the events the other processes publish.
+	Subscribe(ctx context.Context, handle func(shared.Event))
+}
+
+// sharedStateTimeout bounds the calls made outside a request, like
+// publishing an event after a write.
+const sharedStateTimeout = 2 * time.Second
+
+// ConfigureSharedState shares cached responses, settings changes, the
+// deactivated users and revoked sessions with the other processes through state, so they catch
+// up at once instead of on their next refresh. Events stop with ctx.
+func (s *Server) ConfigureSharedState(ctx context.Context, state SharedState) error {
+	generation, err := state.Counter(ctx, responseGenerationKey)
+	if err != nil {
+		return err
+	}
+	s.shared = state
+	s.recordingCache.share(state, generation)
+	state.Subscribe(ctx, func(event shared.Event) {
+		s.handleSharedEvent(ctx, event)
+	})
+	return nil
+}
+
+func (s *Server) handleSharedEvent(ctx context.Context, event shared.Event) {
+	switch event.Kind {
+	case shared.EventResponsesChanged:
+		s.recordingCache.dropLocal(event.Generation)
+	case shared.EventSettingsChanged:
+		if err := s.LoadSettings(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("settings refresh: %v", err)
+		}
+	case shared.EventUserDeactivated:
+		s.setDeactivated(event.UserID, true)
+	case shared.EventUserReactivated:
+		s.setDeactivated(event.UserID, false)
+	case shared.EventSessionsRevoked:
+		s.setRevoked(event.SessionIDs)
+	}
+}
+
+// publish tells the other processes about event. A failure is only
+// logged: they catch up on their next refresh.
+func (s *Server) publish(ctx context.Context, event shared.Event) {
+	if s.shared == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sharedStateTimeout)
+	defer cancel()
+	if err := s.shared.Publish(ctx, event); err != nil {
+		log.Printf("shared state: failed to publish %s: %v", event.Kind, err)
+	}
+}
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/shared"
+)
+
+// fakeSharedState is Redis for a test's servers. Events reach the other
+// subscribers synchronously.
+type fakeSharedState struct {
+	mu          sync.Mutex
+	values      map[string][]byte
+	counters    map[string]int64
+	subscribers []fakeSubscriber
+}
+
+type fakeSubscriber struct {
+	origin string
+	handle func(shared.Event)
+}
+
+// fakeProcess is one server's connection to the fake, carrying its origin.
+type fakeProcess struct {
+	*fakeSharedState
+	origin string
+}
+
+func newFakeSharedState() *fakeSharedState {
+	return &fakeSharedState{values: map[string][]byte{}, counters: map[string]int64{}}
+}
+
+func (f *fakeSharedState) process(origin string) fakeProcess {
+	return fakeProcess{fakeSharedState: f, origin: origin}
+}
+
+func (f fakeProcess) Get(_ context.Context, key string) ([]byte, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	value, ok := f.values[key]
+	return value, ok, nil
+}
+
+func (f fakeProcess) Set(_ context.Context, key string, value []byte, _ time.Duration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.values[key] = value
+	return nil
+}
+
+func (f fakeProcess) Counter(_ context.Context, key string) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.counters[key], nil
+}
+
+func (f fakeProcess) Increment(_ context.Context, key string) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.counters[key]++
+	return f.counters[key], nil
+}
+
+func (f fakeProcess) Publish(_ context.Context, event shared.Event) error {
+	f.mu.Lock()
+	subscribers := f.subscribers
+	f.mu.Unlock()
+	for _, sub := range subscribers {
+		if sub.origin != f.origin {
+			sub.handle(event)
+		}
+	}
+	return nil
+}
+
+func (f fakeProcess) Subscribe(_ context.Context, handle func(shared.Event)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.subscribers = append(f.subscribers, fakeSubscriber{origin: f.origin, handle: handle})
+}
+
+func TestSharedResponseCache(t *testing.T) {
+	ctx := context.Background()
+	state := newFakeSharedState()
+	first, second := New(nil, []byte("test"), time.Hour), New(nil, []byte("test"), time.Hour)
+	if err := first.ConfigureSharedState(ctx, state.process("first")); err != nil {
+		t.Fatal(err)
+	}
+	if err := second.ConfigureSharedState(ctx, state.process("second")); err != nil {
+		t.Fatal(err)
+	}
+
+	req := connect.NewRequest(&secretaryv1.GetRecordingRequest{Id: 7})
+	loads := 0
+	load := func() (*secretaryv1.GetRecordingResponse, error) {
+		loads++
+		return &secretaryv1.GetRecordingResponse{Recording: &secretaryv1.Recording{Id: 7}}, nil
+	}
+	if _, err := cachedRead(ctx, first.recordingCache, req, `W/"a"`, load); err != nil {
+		t.Fatal(err)
+	}
+	res, err := cachedRead(ctx, second.recordingCache, req, `W/"a"`, load)
+	if err != nil || loads != 1 || res.Msg.GetRecording().GetId() != 7 {
+		t.Fatalf("loads = %d, res = %v, err = %v; want the first process's response", loads, res, err)
+	}
+
+	// A write through the second process drops the response everywhere.
+	second.recordingCache.invalidate()
+	if _, err := cachedRead(ctx, first.recordingCache, req, `W/"a"`, load); err != nil || loads != 2 {
+		t.Fatalf("loads = %d, err = %v; want a reload after invalidation", loads, err)
+	}
+}
+
+// reactivatingUsers reactivates any user.
+type reactivatingUsers struct{ adminUsers }
+
+func (reactivatingUsers) ReactivateUser(context.Context, int32) (int64, error) {
+	return 1, nil
+}
+
+func TestSharedDeactivation(t *testing.T) {
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+	state := newFakeSharedState()
+	first, second := New(nil, []byte("test"), time.Hour), New(nil, []byte("test"), time.Hour)
+	first.ConfigureSharedState(ctx, state.process("first"))
+	second.ConfigureSharedState(ctx, state.process("second"))
+	first.ConfigureStores(nil, nil, reactivatingUsers{})
+
+	second.handleSharedEvent(ctx, shared.Event{Kind: shared.EventUserDeactivated, UserID: 5})
+	if !second.isDeactivated(5) || first.isDeactivated(5) {
+		t.Fatalf("deactivated: first %v, second %v", first.isDeactivated(5), second.isDeactivated(5))
+	}
+	// Reactivating through the first process lets the user back into the
+	// second at once, not on its next refresh.
+	if _, err := first.ReactivateUser(ctx, connect.NewRequest(&secretaryv1.ReactivateUserRequest{UserId: 5})); err != nil {
+		t.Fatalf("reactivate: %v", err)
+	}
+	if second.isDeactivated(5) {
+		t.Fatal("reactivation didn't reach the other process")
+	}
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// relabelSpeakerLines gives the transcript lines labeled from to the label
+// to, keeping the label's spelling, and counts the lines it changed.
+func relabelSpeakerLines(transcript string, from, to int32) (string, int) {
+	lines := strings.Split(transcript, "\n")
+	moved := 0
+	for i, line := range lines {
+		label := speakerLinePattern.FindStringSubmatchIndex(line)
+		if label == nil {
+			continue
+		}
+		if n, err := strconv.Atoi(line[label[2]:label[3]]); err != nil || int32(n) != from {
+			continue
+		}
+		lines[i] = line[:label[2]] + strconv.Itoa(int(to)) + line[label[3]:]
+		moved++
+	}
+	return strings.Join(lines, "\n"), moved
+}
+
+// RelabelSpeaker hands a diarized speaker to a user or guest. The speaker
+// label either becomes theirs or, when they already speak under another
+// label, its lines are moved to that label.
+func (s *Server) RelabelSpeaker(ctx context.Context, req *connect.Request[secretaryv1.RelabelSpeakerRequest]) (*connect.Response[secretaryv1.RelabelSpeakerResponse], error) {
+	actor, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := int32(req.Msg.RecordingId)
+	speaker := req.Msg.SpeakerId
+	userID, guestID := int32(req.Msg.UserId), int32(req.Msg.GuestId)
+	if userID > 0 {
+		if _, err := s.users.GetUser(ctx, userID); errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+		} else if err != nil {
+			return nil, apierr.Wrap(err, "failed to fetch user")
+		}
+	}
+
+	qtx, err := s.recordings.BeginRecordingTx(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to start transaction")
+	}
+	defer func() { _ = qtx.Rollback(ctx) }()
+
+	status, err := qtx.GetRecordingStatusForUpdate(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch recording")
+	}
+
+	// other is the label the person already speaks under, if any.
+	other := int32(-1)
+	if userID > 0 {
+		participants, err := qtx.ListRecordingParticipants(ctx, id)
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to list recording participants")
+		}
+		for _, p := range participants {
+			if p.ID != userID {
+				continue
+			}
+			if p.SpeakerID == speaker {
+				return s.relabeledSpeaker(ctx, id, 0)
+			}
+			if other < 0 || p.SpeakerID < other {
+				other = p.SpeakerID
+			}
+		}
+	} else {
+		guests, err := qtx.ListRecordingGuests(ctx, id)
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to list recording guests")
+		}
+		i := slices.IndexFunc(guests, func(g db.GuestParticipant) bool { return g.ID == guestID })
+		if i < 0 {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("guest not found"))
+		}
+		if label := guests[i].SpeakerID; label.Valid {
+			if label.Int32 == speaker {
+				return s.relabeledSpeaker(ctx, id, 0)
+			}
+			other = label.Int32
+		}
+	}
+
+	if err := qtx.ClearSpeakerLabel(ctx, db.ClearSpeakerLabelParams{RecordingID: id, SpeakerID: speaker}); err != nil {
+		return nil, apierr.Wrap(err, "failed to clear speaker")
+	}
+	moved := 0
+	switch {
+	case other >= 0:
+		row, err := qtx.GetRecording(ctx, id)
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to fetch recording")
+		}
+		plain, err := s.openText(row.Transcript.String)
+		if err != nil {
+			return nil, err
+		}
+		var relabeled string
+		if relabeled, moved = relabelSpeakerLines(plain, speaker, other); moved > 0 {
+			sealed, err := s.sealText(relabeled)
+			if err != nil {
+				return nil, err
+			}
+			if err := qtx.SetRecordingTranscript(ctx, db.SetRecordingTranscriptParams{ID: id, Transcript: pgtype.Text{String: sealed, Valid: true}}); err != nil {
+				return nil, apierr.Wrap(err, "failed to store transcript")
+			}
+			if err := s.recordTranscriptEdit(ctx, qtx, id, plain, relabeled, transcriptSourceRelabel, actor); err != nil {
+				return nil, err
+			}
+		}
+	case userID > 0:
+		if err := qtx.AddRecordingParticipant(ctx, db.AddRecordingParticipantParams{RecordingID: id, SpeakerID: speaker, UserID: userID}); err != nil {
+			return nil, apierr.Wrap(err, "failed to map speaker")
+		}
+	default:
+		if _, err := qtx.SetGuestSpeaker(ctx, db.SetGuestSpeakerParams{ID: guestID, RecordingID: id, SpeakerID: speaker}); err != nil {
+			return nil, apierr.Wrap(err, "failed to map speaker")
+		}
+	}
+	if err := qtx.Commit(ctx); err != nil {
+		return nil, apierr.Wrap(err, "failed to commit transaction")
+	}
+
+	// Mentions remember who said them, and speakers don't mention
+	// themselves, so they're linked again under the new labels.
+	if status == recordingReady {
+		if _, err := s.linkMentions(ctx, id, pgtype.Int4{Int32: int32(actor), Valid: true}); err != nil {
+			log.Printf("linking mentions for recording %d: %v", id, err)
+		}
+	}
+	return s.relabeledSpeaker(ctx, id, moved)
+}
+
+func (s *Server) relabeledSpeaker(ctx context.Context, id int32, moved int) (*connect.Response[secretaryv1.RelabelSpeakerResponse], error) {
+	rec, err := s.refreshedRecording(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&secretaryv1.RelabelSpeakerResponse{Recording: rec, RelabeledLineCount: int32(moved)}), nil
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// labeledSpeakers is a recording whose speaker labels map to users and
+// guests.
+type labeledSpeakers struct {
+	*fakeTranslations
+	participants []db.ListRecordingParticipantsRow
+	guests       []db.GuestParticipant
+	revisions    []db.GetTranscriptRevisionRow
+}
+
+func (l *labeledSpeakers) BeginRecordingTx(context.Context) (RecordingTx, error) {
+	return l, nil
+}
+
+func (l *labeledSpeakers) ListRecordingParticipants(context.Context, int32) ([]db.ListRecordingParticipantsRow, error) {
+	return l.participants, nil
+}
+
+func (l *labeledSpeakers) ListRecordingGuests(context.Context, int32) ([]db.GuestParticipant, error) {
+	return l.guests, nil
+}
+
+func (l *labeledSpeakers) ClearSpeakerLabel(_ context.Context, arg db.ClearSpeakerLabelParams) error {
+	l.participants = slices.DeleteFunc(l.participants, func(p db.ListRecordingParticipantsRow) bool { return p.SpeakerID == arg.SpeakerID })
+	for i, g := range l.guests {
+		if g.SpeakerID.Valid && g.SpeakerID.Int32 == arg.SpeakerID {
+			l.guests[i].SpeakerID = pgtype.Int4{}
+		}
+	}
+	return nil
+}
+
+func (l *labeledSpeakers) AddRecordingParticipant(_ context.Context, arg db.AddRecordingParticipantParams) error {
+	l.participants = append(l.participants, db.ListRecordingParticipantsRow{ID: arg.UserID, SpeakerID: arg.SpeakerID})
+	return nil
+}
+
+func (l *labeledSpeakers) SetGuestSpeaker(_ context.Context, arg db.SetGuestSpeakerParams) (int64, error) {
+	for i, g := range l.guests {
+		if g.ID == arg.ID {
+			l.guests[i].SpeakerID = pgtype.Int4{Int32: arg.SpeakerID, Valid: true}
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func (l *labeledSpeakers) GetLatestTranscriptRevision(context.Context, int32) (db.GetLatestTranscriptRevisionRow, error) {
+	if len(l.revisions) == 0 {
+		return db.GetLatestTranscriptRevisionRow{}, pgx.ErrNoRows
+	}
+	latest := l.revisions[len(l.revisions)-1]
+	return db.GetLatestTranscriptRevisionRow{Revision: latest.Revision, Transcript: latest.Transcript}, nil
+}
+
+func (l *labeledSpeakers) GetTranscriptRevision(_ context.Context, arg db.GetTranscriptRevisionParams) (db.GetTranscriptRevisionRow, error) {
+	for _, r := range l.revisions {
+		if r.Revision == arg.Revision {
+			return r, nil
+		}
+	}
+	return db.GetTranscriptRevisionRow{}, pgx.ErrNoRows
+}
+
+func (l *labeledSpeakers) ListTranscriptRevisions(context.Context, int32) ([]db.ListTranscriptRevisionsRow, error) {
+	var rows []db.ListTranscriptRevisionsRow
+	for _, r := range slices.Backward(l.revisions) {
+		rows = append(rows, db.ListTranscriptRevisionsRow{Revision: r.Revision, Source: r.Source, CreatedByUserID: r.CreatedByUserID})
+	}
+	return rows, nil
+}
+
+func (l *labeledSpeakers) CreateTranscriptRevision(_ context.Context, arg db.CreateTranscriptRevisionParams) (int32, error) {
+	revision := int32(len(l.revisions) + 1)
+	l.revisions = append(l.revisions, db.GetTranscriptRevisionRow{RecordingID: arg.RecordingID, Revision: revision, Transcript: arg.Transcript, Source: arg.Source, CreatedByUserID: arg.CreatedByUserID})
+	return revision, nil
+}
+
+func TestRelabelSpeakerLines(t *testing.T) {
+	transcript := "Speaker 3: hi\nSPEAKER 13: no\nspeaker03 : yes\nSpeaker 1: hello"
+	got, moved := relabelSpeakerLines(transcript, 3, 1)
+	if want := "Speaker 1: hi\nSPEAKER 13: no\nspeaker1 : yes\nSpeaker 1: hello"; got != want || moved != 2 {
+		t.Fatalf("relabeled %d lines:\n%s\nwant:\n%s", moved, got, want)
+	}
+}
+
+func TestRelabelSpeaker(t *testing.T) {
+	// Summarizing, so mentions aren't linked again.
+	store := &labeledSpeakers{
+		fakeTranslations: &fakeTranslations{
+			fakeRecordingStatus: &fakeRecordingStatus{status: recordingSummarizing},
+			transcript:          "Speaker 0: Welcome.\nSpeaker 1: Thanks.\nSpeaker 2: Hi all.\nSpeaker 3: Sorry, me again.",
+		},
+		participants: []db.ListRecordingParticipantsRow{{ID: 8, FirstName: "Ana", SpeakerID: 1}, {ID: 9, FirstName: "Bo", SpeakerID: 2}},
+		guests:       []db.GuestParticipant{{ID: 4, RecordingID: 3, Name: "Lee"}},
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(store, nil, memberUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+	relabel := func(msg *secretaryv1.RelabelSpeakerRequest) (*secretaryv1.RelabelSpeakerResponse, error) {
+		msg.RecordingId = 3
+		resp, err := srv.RelabelSpeaker(ctx, connect.NewRequest(msg))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+
+	if _, err := relabel(&secretaryv1.RelabelSpeakerRequest{SpeakerId: 2, GuestId: 7}); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("unknown guest: %v", err)
+	}
+
+	// Speaker 2 was Lee, not Bo.
+	resp, err := relabel(&secretaryv1.RelabelSpeakerRequest{SpeakerId: 2, GuestId: 4})
+	if err != nil || resp.RelabeledLineCount != 0 {
+		t.Fatalf("relabel to a guest: %v, %v", resp, err)
+	}
+	if store.guests[0].SpeakerID.Int32 != 2 || slices.ContainsFunc(store.participants, func(p db.ListRecordingParticipantsRow) bool { return p.ID == 9 }) {
+		t.Fatalf("participants = %+v, guests = %+v", store.participants, store.guests)
+	}
+
+	// Speaker 3 was Ana again, so her lines join speaker 1.
+	resp, err = relabel(&secretaryv1.RelabelSpeakerRequest{SpeakerId: 3, UserId: 8})
+	if err != nil || resp.RelabeledLineCount != 1 {
+		t.Fatalf("relabel to a user's other label: %v, %v", resp, err)
+	}
+	if want := "Speaker 0: Welcome.\nSpeaker 1: Thanks.\nSpeaker 2: Hi all.\nSpeaker 1: Sorry, me again."; store.transcript != want {
+		t.Fatalf("transcript = %q", store.transcript)
+	}
+	// The transcript as it was is kept, then the edit.
+	if len(store.revisions) != 2 || store.revisions[0].Source != transcriptSourceTranscription || store.revisions[0].CreatedByUserID.Valid ||
+		store.revisions[1].Source != transcriptSourceRelabel || store.revisions[1].CreatedByUserID.Int32 != 5 || store.revisions[1].Transcript != store.transcript {
+		t.Fatalf("revisions = %+v", store.revisions)
+	}
+
+	// Speaker 0 was unmapped and becomes Bo's.
+	if _, err := relabel(&secretaryv1.RelabelSpeakerRequest{SpeakerId: 0, UserId: 9}); err != nil {
+		t.Fatal(err)
+	}
+	if i := slices.IndexFunc(store.participants, func(p db.ListRecordingParticipantsRow) bool { return p.ID == 9 }); i < 0 || store.participants[i].SpeakerID != 0 {
+		t.Fatalf("participants = %+v", store.participants)
+	}
+
+	// Relabeling to whoever already holds the label changes nothing.
+	before := len(store.participants)
+	if resp, err := relabel(&secretaryv1.RelabelSpeakerRequest{SpeakerId: 1, UserId: 8}); err != nil || resp.RelabeledLineCount != 0 || len(store.participants) != before {
+		t.Fatalf("no-op relabel: %v, %v", resp, err)
+	}
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// hashedAsset matches the content-hashed file names Vite emits, e.g.
+// assets/index-DiwrgTda.js. Those never change, so they can be cached
+// forever; everything else has to be revalidated.
+var hashedAsset = regexp.MustCompile(`^assets/.+-[A-Za-z0-9_-]{8,}\.[a-z0-9]+$`)
+
+// precompressed lists the sibling files a build may ship next to an asset,
+// in order of preference.
+var precompressed = []struct {
+	encoding string
+	suffix   string
+}{
+	{"br", ".br"},
+	{"zstd", ".zst"},
+	{"gzip", ".gz"},
+}
+
+// staticFiles serves the embedded SPA bundle.
+type staticFiles struct {
+	fsys  fs.FS
+	etags sync.Map // file path -> strong ETag
+}
+
+func newStaticFiles(fsys fs.FS) *staticFiles {
+	return &staticFiles{fsys: fsys}
+}
+
+func (s *Server) serveStatic(w http.ResponseWriter, r *http.Request) {
+	s.static.ServeHTTP(w, r)
+}
+
+func (s *staticFiles) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+	if name == "" {
+		name = "index.html"
+	}
+	if s.isFile(name) {
+		s.serveFile(w, r, name)
+		return
+	}
+
+	// Client-side routes fall back to the SPA shell, but a missing asset or
+	// any other path with an extension is a real 404: serving HTML in place
+	// of a stale script makes the browser fail with a confusing MIME error.
+	if strings.HasPrefix(name, "assets/") || path.Ext(name) != "" {
+		http.NotFound(w, r)
+		return
+	}
+	if !s.isFile("index.html") {
+		http.Error(w, "index.html not found", http.StatusInternalServerError)
+		return
+	}
+	s.serveFile(w, r, "index.html")
+}
+
+func (s *staticFiles) serveFile(w http.ResponseWriter, r *http.Request, name string) {
+	header := w.Header()
+	contentType := mime.TypeByExtension(path.Ext(name))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header.Set("Content-Type", contentType)
+	if hashedAsset.MatchString(name) {
+		header.Set("Cache-Control", "public, max-age=31536000, immutable")
+	} else {
+		header.Set("Cache-Control", "no-cache")
+	}
+
+	served := name
+	if variant, encoding := s.precompressedVariant(r, name); variant != "" {
+		served = variant
+		header.Set("Content-Encoding", encoding)
+	}
+	for _, variant := range precompressed {
+		if s.isFile(name + variant.suffix) {
+			header.Add("Vary", "Accept-Encoding")
+			break
+		}
+	}
+
+	data, err := fs.ReadFile(s.fsys, served)
+	if err != nil {
+		http.Error(w, "failed to read asset", http.StatusInternalServerError)
+		return
+	}
+	header.Set("ETag", s.etag(served, data))
+	// embed.FS carries no modification times, so the ETag is the only
+	// validator; ServeContent handles If-None-Match and Range from it.
+	http.ServeContent(w, r, name, time.Time{}, bytes.NewReader(data))
+}
+
+// precompressedVariant returns the best pre-built encoding of name the
+// client accepts. Range requests always get the identity encoding.
+func (s *staticFiles) precompressedVariant(r *http.Request, name string) (string, string) {
+	if r.Header.Get("Range") != "" {
+		return "", ""
+	}
+	accepted := parseAcceptEncoding(r.Header.Get("Accept-Encoding"))
+	for _, variant := range precompressed {
+		if accepted[variant.encoding] > 0 && s.isFile(name+variant.suffix) {
+			return name + variant.suffix, variant.encoding
+		}
+	}
+	return "", ""
+}
+
+func (s *staticFiles) isFile(name string) bool {
+	info, err := fs.Stat(s.fsys, name)
+	return err == nil && !info.IsDir()
+}
+
+func (s *staticFiles) etag(name string, data []byte) string {
+	if cached, ok := s.etags.Load(name); ok {
+		return cached.(string)
+	}
+	sum := sha256.Sum256(data)
+	tag := `"` + hex.EncodeToString(sum[:12]) + `"`
+	s.etags.Store(name, tag)
+	return tag
+}
+
+func mustSub(fsys fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestStaticFiles(t *testing.T) {
+	static := newStaticFiles(fstest.MapFS{
+		"index.html":                  {Data: []byte("<html>app</html>")},
+		"favicon.svg":                 {Data: []byte("<svg/>")},
+		"assets/index-DiwrgTda.js":    {Data: []byte("console.log('app')")},
+		"assets/index-DiwrgTda.js.br": {Data: []byte("brotli bytes")},
+	})
+	get := func(target string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		rec := httptest.NewRecorder()
+		static.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get("/assets/index-DiwrgTda.js", http.Header{"Accept-Encoding": {"gzip, br"}})
+	if rec.Code != http.StatusOK || rec.Body.String() != "brotli bytes" || rec.Header().Get("Content-Encoding") != "br" {
+		t.Fatalf("expected brotli variant, got %d %q %v", rec.Code, rec.Body.String(), rec.Header())
+	}
+	if !strings.Contains(rec.Header().Get("Cache-Control"), "immutable") || !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/javascript") {
+		t.Fatalf("unexpected asset headers %v", rec.Header())
+	}
+
+	rec = get("/assets/index-DiwrgTda.js", http.Header{"Accept-Encoding": {"gzip"}})
+	if rec.Body.String() != "console.log('app')" || rec.Header().Get("Content-Encoding") != "" || rec.Header().Get("Vary") != "Accept-Encoding" {
+		t.Fatalf("expected identity asset with Vary, got %q %v", rec.Body.String(), rec.Header())
+	}
+	etag := rec.Header().Get("ETag")
+	if rec = get("/assets/index-DiwrgTda.js", http.Header{"If-None-Match": {etag}}); rec.Code != http.StatusNotModified {
+		t.Fatalf("expected 304 for matching ETag, got %d", rec.Code)
+	}
+
+	rec = get("/recordings/12", nil)
+	if rec.Code != http.StatusOK || rec.Body.String() != "<html>app</html>" || rec.Header().Get("Cache-Control") != "no-cache" {
+		t.Fatalf("expected SPA shell, got %d %q %v", rec.Code, rec.Body.String(), rec.Header())
+	}
+	if rec = get("/favicon.svg", nil); rec.Header().Get("Content-Type") != "image/svg+xml" || rec.Header().Get("Cache-Control") != "no-cache" {
+		t.Fatalf("unexpected favicon headers %v", rec.Header())
+	}
+
+	for _, target := range []string{"/assets/index-OldHash1.js", "/robots.txt", "/../../etc/passwd.conf"} {
+		if rec = get(target, nil); rec.Code != http.StatusNotFound {
+			t.Fatalf("%s: expected 404, got %d", target, rec.Code)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec = httptest.NewRecorder()
+	static.ServeHTTP(rec, req)
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected 405 for POST, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,283 @@
+package server
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// The store interfaces list the queries the recording, todo and user
+// handlers run. The sqlc-generated *db.Queries satisfies the query sets
+// directly; pgStore adds transactions and the hand-built todo filter on
+// top. Tests can swap in fakes with ConfigureStores.
+
+// RecordingQueries are the recording queries, usable inside or outside a
+// transaction.
+type RecordingQueries interface {
+	GetRecordingsVersion(ctx context.Context) (db.GetRecordingsVersionRow, error)
+	ListRecordings(ctx context.Context, arg db.ListRecordingsParams) ([]db.ListRecordingsRow, error)
+	ListParticipantsForRecordings(ctx context.Context, recordingIds []int32) ([]db.ListParticipantsForRecordingsRow, error)
+	GetRecordingVersion(ctx context.Context, id int32) (db.GetRecordingVersionRow, error)
+	GetRecording(ctx context.Context, id int32) (db.GetRecordingRow, error)
+	ListRecordingParticipants(ctx context.Context, recordingID int32) ([]db.ListRecordingParticipantsRow, error)
+	DeleteRecording(ctx context.Context, id int32) (db.DeleteRecordingRow, error)
+	CreateUploadedRecording(ctx context.Context, arg db.CreateUploadedRecordingParams) (db.CreateUploadedRecordingRow, error)
+	FindRecordingByAudioHash(ctx context.Context, audioSha256 string) (db.FindRecordingByAudioHashRow, error)
+	CreateLiveRecording(ctx context.Context, arg db.CreateLiveRecordingParams) (db.CreateLiveRecordingRow, error)
+	SetRecordingAudio(ctx context.Context, arg db.SetRecordingAudioParams) error
+	RecordTranscriptionUsage(ctx context.Context, id int32) error
+	GetRecordingIngest(ctx context.Context, recordingID int32) (db.RecordingIngest, error)
+	CreateRecordingIngest(ctx context.Context, arg db.CreateRecordingIngestParams) error
+	UpsertRecordingIngestChunk(ctx context.Context, arg db.UpsertRecordingIngestChunkParams) error
+	ListRecordingIngestChunks(ctx context.Context, recordingID int32) ([]db.ListRecordingIngestChunksRow, error)
+	FinalizeRecordingIngest(ctx context.Context, recordingID int32) error
+	GetRecordingStatusForUpdate(ctx context.Context, id int32) (string, error)
+	SetRecordingStatus(ctx context.Context, arg db.SetRecordingStatusParams) error
+	CreateRecordingStatusEvent(ctx context.Context, arg db.CreateRecordingStatusEventParams) error
+	ListRecordingStatusEvents(ctx context.Context, recordingID int32) ([]db.ListRecordingStatusEventsRow, error)
+	SetRecordingLegalHold(ctx context.Context, arg db.SetRecordingLegalHoldParams) (db.SetRecordingLegalHoldRow, error)
+	ListRecordingHoldEvents(ctx context.Context, recordingID int32) ([]db.ListRecordingHoldEventsRow, error)
+	CreateProcessingAttempt(ctx context.Context, arg db.CreateProcessingAttemptParams) error
+	StartPendingProcessingAttempt(ctx context.Context, arg db.StartPendingProcessingAttemptParams) (int64, error)
+	HasOpenProcessingAttempt(ctx context.Context, arg db.HasOpenProcessingAttemptParams) (bool, error)
+	FinishProcessingAttempts(ctx context.Context, arg db.FinishProcessingAttemptsParams) error
+	ListProcessingAttempts(ctx context.Context, recordingID int32) ([]db.ListProcessingAttemptsRow, error)
+	ListRecordingTranslations(ctx context.Context, recordingID int32) ([]db.ListRecordingTranslationsRow, error)
+	UpsertRecordingTranslation(ctx context.Context, arg db.UpsertRecordingTranslationParams) error
+	SetRecordingSummary(ctx context.Context, arg db.SetRecordingSummaryParams) error
+	SetRecordingTranscript(ctx context.Context, arg db.SetRecordingTranscriptParams) error
+	ListTranscriptSegments(ctx context.Context, recordingID int32) ([]db.ListTranscriptSegmentsRow, error)
+	DeleteTranscriptSegments(ctx context.Context, recordingID int32) error
+	CreateTranscriptSegments(ctx context.Context, arg db.CreateTranscriptSegmentsParams) error
+	GetLatestTranscriptRevision(ctx context.Context, recordingID int32) (db.GetLatestTranscriptRevisionRow, error)
+	GetTranscriptRevision(ctx context.Context, arg db.GetTranscriptRevisionParams) (db.GetTranscriptRevisionRow, error)
+	ListTranscriptRevisions(ctx context.Context, recordingID int32) ([]db.ListTranscriptRevisionsRow, error)
+	CreateTranscriptRevision(ctx context.Context, arg db.CreateTranscriptRevisionParams) (int32, error)
+	ListRecordingGuests(ctx context.Context, recordingID int32) ([]db.GuestParticipant, error)
+	ListGuestsForRecordings(ctx context.Context, recordingIds []int32) ([]db.GuestParticipant, error)
+	AddGuestParticipant(ctx context.Context, arg db.AddGuestParticipantParams) (db.GuestParticipant, error)
+	RemoveGuestParticipant(ctx context.Context, arg db.RemoveGuestParticipantParams) (int64, error)
+	SetGuestSpeaker(ctx context.Context, arg db.SetGuestSpeakerParams) (int64, error)
+	AddRecordingParticipant(ctx context.Context, arg db.AddRecordingParticipantParams) error
+	ClearSpeakerLabel(ctx context.Context, arg db.ClearSpeakerLabelParams) error
+	CloneRecording(ctx context.Context, arg db.CloneRecordingParams) (int32, error)
+	SetRecordingProcessingSettings(ctx context.Context, arg db.SetRecordingProcessingSettingsParams) error
+}
+
+type RecordingStore interface {
+	RecordingQueries
+	BeginRecordingTx(ctx context.Context) (RecordingTx, error)
+}
+
+type RecordingTx interface {
+	RecordingQueries
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+// TodoQueries are the todo queries, usable inside or outside a
+// transaction.
+type TodoQueries interface {
+	GetTodo(ctx context.Context, id int32) (db.GetTodoRow, error)
+	GetTodoVersion(ctx context.Context, id int32) (int32, error)
+	ListDueTodosByUser(ctx context.Context, userID pgtype.Int4) ([]db.ListDueTodosByUserRow, error)
+	ListTodoHistory(ctx context.Context, todoID int32) ([]db.TodoHistory, error)
+	CreateTodo(ctx context.Context, arg db.CreateTodoParams) (db.Todo, error)
+	UpdateTodo(ctx context.Context, arg db.UpdateTodoParams) (db.Todo, error)
+	DeleteTodo(ctx context.Context, id int32) error
+	MoveTodoReferences(ctx context.Context, arg db.MoveTodoReferencesParams) error
+	CreateTodoHistory(ctx context.Context, arg db.CreateTodoHistoryParams) error
+}
+
+type TodoStore interface {
+	TodoQueries
+	// FilterTodos runs the ListTodos request filters and sort. With
+	// msg.StarredOnly, only the todos in starredIDs are kept.
+	FilterTodos(ctx context.Context, msg *secretaryv1.ListTodosRequest, starredIDs []int32) ([]db.ListTodosByUserRow, error)
+	BeginTodoTx(ctx context.Context) (TodoTx, error)
+}
+
+type TodoTx interface {
+	TodoQueries
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+type UserStore interface {
+	GetUser(ctx context.Context, id int32) (db.GetUserRow, error)
+	GetUserByEmail(ctx context.Context, email pgtype.Text) (db.GetUserByEmailRow, error)
+	GetPasswordHash(ctx context.Context, id int32) (db.GetPasswordHashRow, error)
+	UpdatePasswordHash(ctx context.Context, arg db.UpdatePasswordHashParams) (int64, error)
+	ListUsers(ctx context.Context) ([]db.ListUsersRow, error)
+	GetProfile(ctx context.Context, id int32) (db.GetProfileRow, error)
+	UpdateProfile(ctx context.Context, arg db.UpdateProfileParams) error
+	SetPendingEmail(ctx context.Context, arg db.SetPendingEmailParams) error
+	ConfirmPendingEmail(ctx context.Context, arg db.ConfirmPendingEmailParams) (int64, error)
+	SetUserAvatar(ctx context.Context, arg db.SetUserAvatarParams) error
+	DeactivateUser(ctx context.Context, arg db.DeactivateUserParams) (db.DeactivateUserRow, error)
+	ReactivateUser(ctx context.Context, id int32) (int64, error)
+	ListDeactivatedUserIDs(ctx context.Context) ([]int32, error)
+	GetTodoFeedGeneration(ctx context.Context, id int32) (int32, error)
+	ResetTodoFeedToken(ctx context.Context, id int32) (int32, error)
+}
+
+// ConfigureStores replaces the Postgres-backed stores. A nil argument
+// keeps the current store. Reads still go to replicas when
+// ConfigureReadReplicas is used, so tests with fakes should not set both.
+func (s *Server) ConfigureStores(recordings RecordingStore, todos TodoStore, users UserStore) {
+	if recordings != nil {
+		s.recordings = recordings
+	}
+	if todos != nil {
+		s.todos = todos
+	}
+	if users != nil {
+		s.users = users
+	}
+}
+
+// pgStore implements every store over a pool, primary or replica.
+type pgStore struct {
+	*db.Queries
+	pool *pgxpool.Pool
+}
+
+func newPGStore(pool *pgxpool.Pool) *pgStore {
+	return &pgStore{Queries: db.New(pool), pool: pool}
+}
+
+func (p *pgStore) begin(ctx context.Context) (*pgTx, error) {
+	tx, err := p.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &pgTx{Queries: p.Queries.WithTx(tx), tx: tx}, nil
+}
+
+// LockScheduledTask takes the task's advisory lock on a connection of its
+// own, since the lock belongs to the session that took it.
+func (p *pgStore) LockScheduledTask(ctx context.Context, name string) (func(), bool, error) {
+	conn, err := p.pool.Acquire(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	q := db.New(conn)
+	locked, err := q.TryLockScheduledTask(ctx, name)
+	if err != nil || !locked {
+		conn.Release()
+		return nil, false, err
+	}
+	unlock := func() {
+		ctx := context.Background()
+		if err := q.UnlockScheduledTask(ctx, name); err != nil {
+			// Closing the session releases the lock; returning it to the
+			// pool would keep it held.
+			_ = conn.Hijack().Close(ctx)
+			return
+		}
+		conn.Release()
+	}
+	return unlock, true, nil
+}
+
+// LockLeader takes the leader lock on a connection of its own, kept out
+// of the pool while the lock is held.
+func (p *pgStore) LockLeader(ctx context.Context) (LeaderLease, bool, error) {
+	conn, err := p.pool.Acquire(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	locked, err := db.New(conn).TryLockLeader(ctx)
+	if err != nil || !locked {
+		conn.Release()
+		return nil, false, err
+	}
+	return &pgLeaderLease{conn: conn}, true, nil
+}
+
+type pgLeaderLease struct {
+	conn *pgxpool.Conn
+}
+
+func (l *pgLeaderLease) Check(ctx context.Context) error {
+	return l.conn.Ping(ctx)
+}
+
+func (l *pgLeaderLease) Unlock() {
+	ctx := context.Background()
+	if err := db.New(l.conn).UnlockLeader(ctx); err != nil {
+		_ = l.conn.Hijack().Close(ctx)
+		return
+	}
+	l.conn.Release()
+}
+
+func (p *pgStore) BeginRecordingTx(ctx context.Context) (RecordingTx, error) {
+	return p.begin(ctx)
+}
+
+func (p *pgStore) BeginTodoTx(ctx context.Context) (TodoTx, error) {
+	return p.begin(ctx)
+}
+
+func (p *pgStore) BeginAuditTx(ctx context.Context) (AuditTx, error) {
+	return p.begin(ctx)
+}
+
+func (p *pgStore) BeginScimTx(ctx context.Context) (ScimTx, error) {
+	return p.begin(ctx)
+}
+
+func (p *pgStore) FilterTodos(ctx context.Context, msg *secretaryv1.ListTodosRequest, starredIDs []int32) ([]db.ListTodosByUserRow, error) {
+	sql, args, err := buildListTodosQuery(msg, starredIDs)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := p.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[db.ListTodosByUserRow])
+}
+
+type pgTx struct {
+	*db.Queries
+	tx pgx.Tx
+}
+
+func (t *pgTx) Commit(ctx context.Context) error {
+	return t.tx.Commit(ctx)
+}
+
+func (t *pgTx) Rollback(ctx context.Context) error {
+	return t.tx.Rollback(ctx)
+}
+
+// recordingReads, todoReads and userReads return the store a read-only RPC
+// should use: a replica when read routing is configured, otherwise the
+// regular store. The same caveats as readQueries apply.
+func (s *Server) recordingReads() RecordingStore {
+	if s.reads == nil {
+		return s.recordings
+	}
+	return newPGStore(s.reads.Reader())
+}
+
+func (s *Server) todoReads() TodoStore {
+	if s.reads == nil {
+		return s.todos
+	}
+	return newPGStore(s.reads.Reader())
+}
+
+func (s *Server) userReads() UserStore {
+	if s.reads == nil {
+		return s.users
+	}
+	return newPGStore(s.reads.Reader())
+}
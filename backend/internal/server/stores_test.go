@@ -0,0 +1,187 @@
+package server
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// fakeTodos implements TodoStore; unset methods panic through the nil
+// embedded interface, which flags handlers touching queries a test did not
+// expect.
+type fakeTodos struct {
+	TodoStore
+	todos map[int32]db.GetTodoRow
+	tx    *fakeTodoTx
+}
+
+func (f *fakeTodos) GetTodo(_ context.Context, id int32) (db.GetTodoRow, error) {
+	row, ok := f.todos[id]
+	if !ok {
+		return db.GetTodoRow{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (f *fakeTodos) BeginTodoTx(context.Context) (TodoTx, error) {
+	return f.tx, nil
+}
+
+type fakeTodoTx struct {
+	TodoTx
+	currentVersion int32
+	committed      bool
+	rolledBack     bool
+	history        []db.CreateTodoHistoryParams
+	dueAt          pgtype.Timestamptz
+}
+
+func (f *fakeTodoTx) UpdateTodo(_ context.Context, arg db.UpdateTodoParams) (db.Todo, error) {
+	if arg.Version != f.currentVersion {
+		return db.Todo{}, pgx.ErrNoRows
+	}
+	switch {
+	case arg.ClearDueAt:
+		f.dueAt = pgtype.Timestamptz{}
+	case arg.DueAt.Valid:
+		f.dueAt = arg.DueAt
+	}
+	f.currentVersion++
+	return db.Todo{ID: arg.ID, Name: arg.Name, DueAt: f.dueAt, Version: f.currentVersion}, nil
+}
+
+func (f *fakeTodoTx) CreateTodoHistory(_ context.Context, arg db.CreateTodoHistoryParams) error {
+	f.history = append(f.history, arg)
+	return nil
+}
+
+func (f *fakeTodoTx) GetTodoVersion(context.Context, int32) (int32, error) {
+	return f.currentVersion, nil
+}
+
+func (f *fakeTodoTx) Commit(context.Context) error {
+	f.committed = true
+	return nil
+}
+
+func (f *fakeTodoTx) Rollback(context.Context) error {
+	f.rolledBack = true
+	return nil
+}
+
+type fakeUsers struct {
+	UserStore
+	users []db.ListUsersRow
+}
+
+func (f *fakeUsers) ListUsers(context.Context) ([]db.ListUsersRow, error) {
+	return f.users, nil
+}
+
+func TestGetTodoWithFakeStore(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, &fakeTodos{todos: map[int32]db.GetTodoRow{7: {ID: 7, Name: "Ship it", Version: 4}}}, nil)
+	srv.reactions = &fakeReactions{}
+
+	resp, err := srv.GetTodo(context.Background(), connect.NewRequest(&secretaryv1.GetTodoRequest{Id: 7}))
+	if err != nil {
+		t.Fatalf("get todo: %v", err)
+	}
+	if resp.Msg.Todo.Name != "Ship it" || resp.Msg.Todo.Version != 4 {
+		t.Fatalf("unexpected todo %+v", resp.Msg.Todo)
+	}
+
+	_, err = srv.GetTodo(context.Background(), connect.NewRequest(&secretaryv1.GetTodoRequest{Id: 8}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected not_found, got %v", err)
+	}
+}
+
+func TestUpdateTodoStaleVersionWithFakeStore(t *testing.T) {
+	tx := &fakeTodoTx{currentVersion: 5}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, &fakeTodos{tx: tx}, nil)
+
+	_, err := srv.UpdateTodo(context.Background(), connect.NewRequest(&secretaryv1.UpdateTodoRequest{Id: 7, Name: "Ship it", ExpectedVersion: 3}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected failed_precondition, got %v", err)
+	}
+	if current, ok := apierr.CurrentVersion(err); !ok || current != 5 {
+		t.Fatalf("expected current version 5 in the error, got %d %v", current, ok)
+	}
+	if tx.committed || !tx.rolledBack {
+		t.Fatalf("expected the transaction to roll back, committed=%v rolledBack=%v", tx.committed, tx.rolledBack)
+	}
+}
+
+func TestUpdateTodoWithoutVersionWithFakeStore(t *testing.T) {
+	tx := &fakeTodoTx{currentVersion: 5}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, &fakeTodos{tx: tx}, nil)
+	token, err := srv.issueToken(1, tokenClaims{})
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+	ts := httptest.NewServer(srv.Routes())
+	defer ts.Close()
+	todos := secretaryv1connect.NewTodosServiceClient(ts.Client(), ts.URL)
+
+	// Every update names the version it was made against.
+	req := connect.NewRequest(&secretaryv1.UpdateTodoRequest{Id: 7, Name: "Ship it", Status: secretaryv1.TodoStatus_TODO_STATUS_DONE, UserId: 1})
+	req.Header().Set("Authorization", "Bearer "+token)
+	if _, err := todos.UpdateTodo(context.Background(), req); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid_argument, got %v", err)
+	}
+	if tx.committed || tx.currentVersion != 5 {
+		t.Fatalf("todo was updated: committed=%v, version %d", tx.committed, tx.currentVersion)
+	}
+}
+
+func TestUpdateTodoKeepsDueDateWhenUnset(t *testing.T) {
+	due := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+	tx := &fakeTodoTx{currentVersion: 2, dueAt: pgtype.Timestamptz{Time: due, Valid: true}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, &fakeTodos{tx: tx}, nil)
+	update := func(dueAt *string) string {
+		t.Helper()
+		resp, err := srv.UpdateTodo(context.Background(), connect.NewRequest(&secretaryv1.UpdateTodoRequest{Id: 7, Name: "Ship it", DueAt: dueAt, ExpectedVersion: int64(tx.currentVersion)}))
+		if err != nil {
+			t.Fatalf("update todo: %v", err)
+		}
+		return resp.Msg.Todo.DueAt
+	}
+
+	if got := update(nil); got != due.Format(time.RFC3339) {
+		t.Fatalf("due date after an update without one = %q", got)
+	}
+	later := "2026-11-09T09:00:00Z"
+	if got := update(&later); got != later {
+		t.Fatalf("due date after moving it = %q", got)
+	}
+	if got := update(new(string)); got != "" {
+		t.Fatalf("due date after clearing it = %q", got)
+	}
+}
+
+func TestListUsersWithFakeStore(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, &fakeUsers{users: []db.ListUsersRow{{ID: 1, FirstName: "Ada"}, {ID: 2, FirstName: "Grace"}}})
+
+	resp, err := srv.ListUsers(context.Background(), connect.NewRequest(&secretaryv1.ListUsersRequest{}))
+	if err != nil {
+		t.Fatalf("list users: %v", err)
+	}
+	if len(resp.Msg.Users) != 2 || resp.Msg.Users[1].FirstName != "Grace" {
+		t.Fatalf("unexpected users %+v", resp.Msg.Users)
+	}
+}
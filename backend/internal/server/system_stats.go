@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// SystemStatsStore holds the queries behind GetSystemStats.
+type SystemStatsStore interface {
+	GetSystemStats(ctx context.Context) (db.GetSystemStatsRow, error)
+	CountJobs(ctx context.Context, since pgtype.Timestamptz) ([]db.CountJobsRow, error)
+}
+
+// failedJobsWindow is how far back GetSystemStats counts failed jobs.
+const failedJobsWindow = 24 * time.Hour
+
+func (s *Server) GetSystemStats(ctx context.Context, req *connect.Request[secretaryv1.GetSystemStatsRequest]) (*connect.Response[secretaryv1.GetSystemStatsResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can view system stats"); err != nil {
+		return nil, err
+	}
+	stats, err := s.systemStats.GetSystemStats(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to load system stats")
+	}
+	since := pgtype.Timestamptz{Time: time.Now().Add(-failedJobsWindow), Valid: true}
+	jobs, err := s.systemStats.CountJobs(ctx, since)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to count jobs")
+	}
+
+	res := &secretaryv1.GetSystemStatsResponse{
+		Users:            stats.Users,
+		ActiveUsers:      stats.ActiveUsers,
+		Admins:           stats.Admins,
+		Recordings:       stats.Recordings,
+		RecordedSeconds:  stats.RecordedSeconds,
+		FailedRecordings: stats.FailedRecordings,
+		AudioBytes:       stats.AudioBytes,
+		ClipBytes:        stats.ClipBytes,
+		AttachmentBytes:  stats.AttachmentBytes,
+	}
+	for _, job := range jobs {
+		res.Queues = append(res.Queues, &secretaryv1.JobQueue{
+			Name:    job.Queue,
+			Queued:  job.Queued,
+			Running: job.Running,
+			Failed:  job.Failed,
+		})
+	}
+	if s.providers != nil {
+		for _, st := range s.providers.Snapshot() {
+			health := &secretaryv1.ProviderHealth{
+				Provider:    st.Provider,
+				Kind:        st.Kind,
+				Requests:    st.Requests,
+				Failures:    st.Failures,
+				RateLimited: st.RateLimited,
+			}
+			if st.Requests > 0 {
+				health.ErrorRate = float64(st.Failures) / float64(st.Requests)
+			}
+			res.Providers = append(res.Providers, health)
+		}
+	}
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/providers"
+)
+
+type fakeSystemStats struct {
+	since pgtype.Timestamptz
+}
+
+func (f *fakeSystemStats) GetSystemStats(context.Context) (db.GetSystemStatsRow, error) {
+	return db.GetSystemStatsRow{Users: 5, ActiveUsers: 4, Admins: 1, Recordings: 12, FailedRecordings: 2, AudioBytes: 1 << 20}, nil
+}
+
+func (f *fakeSystemStats) CountJobs(_ context.Context, since pgtype.Timestamptz) ([]db.CountJobsRow, error) {
+	f.since = since
+	return []db.CountJobsRow{{Queue: "transcription", Queued: 3, Running: 1, Failed: 2}}, nil
+}
+
+func TestGetSystemStats(t *testing.T) {
+	registry := providers.NewRegistry(nil)
+	registry.AddSummarizer(failingSummarizer{}, providers.Options{Name: "primary"})
+	_, _ = registry.Summarize(context.Background(), 0, providers.SummaryRequest{})
+
+	store := &fakeSystemStats{}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureProviders(registry)
+	srv.systemStats = store
+	srv.ConfigureStores(nil, nil, memberUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 2})
+	if _, err := srv.GetSystemStats(ctx, connect.NewRequest(&secretaryv1.GetSystemStatsRequest{})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member: %v", err)
+	}
+
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	res, err := srv.GetSystemStats(ctx, connect.NewRequest(&secretaryv1.GetSystemStatsRequest{}))
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	if res.Msg.Users != 5 || res.Msg.ActiveUsers != 4 || res.Msg.FailedRecordings != 2 || res.Msg.AudioBytes != 1<<20 {
+		t.Fatalf("unexpected totals %v", res.Msg)
+	}
+	if len(res.Msg.Queues) != 1 || res.Msg.Queues[0].Queued != 3 || res.Msg.Queues[0].Failed != 2 {
+		t.Fatalf("unexpected queues %v", res.Msg.Queues)
+	}
+	if age := time.Since(store.since.Time); age < failedJobsWindow || age > failedJobsWindow+time.Minute {
+		t.Fatalf("failed jobs counted since %v", store.since.Time)
+	}
+	if len(res.Msg.Providers) != 1 {
+		t.Fatalf("providers = %v", res.Msg.Providers)
+	}
+	if p := res.Msg.Providers[0]; p.Provider != "primary" || p.Kind != "summarization" || p.Failures == 0 || p.ErrorRate != float64(p.Failures)/float64(p.Requests) {
+		t.Fatalf("unexpected provider health %v", p)
+	}
+}
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+)
+
+// TimeoutConfig bounds how long a request may hold server resources,
+// database connections in particular.
+type TimeoutConfig struct {
+	// Default applies when the client sends no Connect-Timeout-Ms or
+	// grpc-timeout header.
+	Default time.Duration
+	// Max caps client-supplied deadlines.
+	Max time.Duration
+	// LongRunning replaces both for procedures that wait on a model
+	// provider, such as running an AI thread turn, or that copy the whole
+	// instance.
+	LongRunning time.Duration
+}
+
+func DefaultTimeoutConfig() TimeoutConfig {
+	return TimeoutConfig{
+		Default:     30 * time.Second,
+		Max:         2 * time.Minute,
+		LongRunning: 5 * time.Minute,
+	}
+}
+
+func (s *Server) ConfigureTimeouts(cfg TimeoutConfig) {
+	s.timeouts = cfg
+}
+
+// longRunningProcedures get TimeoutConfig.LongRunning instead of the
+// regular limits.
+var longRunningProcedures = map[string]bool{
+	secretaryv1connect.AIServiceRunAIThreadTurnProcedure:   true,
+	secretaryv1connect.AdminServiceExportInstanceProcedure: true,
+	secretaryv1connect.AdminServiceImportInstanceProcedure: true,
+}
+
+// untimedPaths are plain HTTP endpoints that stream bodies of
+// arbitrary size; a fixed deadline would cut off slow transfers.
+var untimedPaths = []string{
+	"/api/recordings/upload",
+	"/api/recordings/live",
+	"/api/admin/archives/",
+}
+
+// limit returns the timeout to apply to a request for procedure, given
+// whether the client already set a deadline.
+func (c TimeoutConfig) limit(procedure string, clientDeadline bool) time.Duration {
+	if longRunningProcedures[procedure] {
+		return c.LongRunning
+	}
+	if clientDeadline || c.Default <= 0 || (c.Max > 0 && c.Default > c.Max) {
+		return c.Max
+	}
+	return c.Default
+}
+
+// withLimit derives a context that expires after timeout. A client deadline
+// that is already earlier wins, so this only ever shortens the budget.
+func withLimit(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// deadlineInterceptor applies the server timeouts to Connect handlers.
+// Connect has already turned Connect-Timeout-Ms into a context deadline by
+// the time it runs.
+type deadlineInterceptor struct {
+	server *Server
+}
+
+func (i deadlineInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		_, clientDeadline := ctx.Deadline()
+		ctx, cancel := withLimit(ctx, i.server.timeouts.limit(req.Spec().Procedure, clientDeadline))
+		defer cancel()
+		resp, err := next(ctx, req)
+		return resp, deadlineError(ctx, err)
+	}
+}
+
+func (i deadlineInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i deadlineInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		_, clientDeadline := ctx.Deadline()
+		ctx, cancel := withLimit(ctx, i.server.timeouts.limit(conn.Spec().Procedure, clientDeadline))
+		defer cancel()
+		return deadlineError(ctx, next(ctx, conn))
+	}
+}
+
+// deadlineError reports CodeDeadlineExceeded for any failure that happened
+// after the deadline passed, whatever the handler wrapped it in. Otherwise
+// a timed-out query can surface as Internal or Unavailable depending on
+// where pgx noticed the cancellation.
+func deadlineError(ctx context.Context, err error) error {
+	if err == nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	if connect.CodeOf(err) == connect.CodeDeadlineExceeded {
+		return err
+	}
+	return connect.NewError(connect.CodeDeadlineExceeded, errors.New("request deadline exceeded"))
+}
+
+// withRequestDeadline gives plain HTTP API handlers the default timeout.
+// Connect routes are left to deadlineInterceptor so it can still tell
+// whether the client set its own deadline.
+func (s *Server) withRequestDeadline(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/api/") || s.timeouts.Default <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		for _, prefix := range untimedPaths {
+			if strings.HasPrefix(r.URL.Path, prefix) {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), s.timeouts.Default)
+		defer cancel()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+)
+
+func TestTimeoutConfigLimit(t *testing.T) {
+	cfg := TimeoutConfig{Default: 10 * time.Second, Max: time.Minute, LongRunning: 5 * time.Minute}
+	cases := []struct {
+		procedure      string
+		clientDeadline bool
+		want           time.Duration
+	}{
+		{secretaryv1connect.TodosServiceListTodosProcedure, false, 10 * time.Second},
+		{secretaryv1connect.TodosServiceListTodosProcedure, true, time.Minute},
+		{secretaryv1connect.AIServiceRunAIThreadTurnProcedure, false, 5 * time.Minute},
+		{secretaryv1connect.AIServiceRunAIThreadTurnProcedure, true, 5 * time.Minute},
+	}
+	for _, tc := range cases {
+		if got := cfg.limit(tc.procedure, tc.clientDeadline); got != tc.want {
+			t.Fatalf("%s (client deadline %v): got %s, want %s", tc.procedure, tc.clientDeadline, got, tc.want)
+		}
+	}
+}
+
+func TestDeadlineError(t *testing.T) {
+	expired, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+
+	err := deadlineError(expired, connect.NewError(connect.CodeInternal, errors.New("failed to list todos")))
+	if connect.CodeOf(err) != connect.CodeDeadlineExceeded {
+		t.Fatalf("expected deadline_exceeded after the deadline, got %v", err)
+	}
+	if err := deadlineError(context.Background(), connect.NewError(connect.CodeInternal, errors.New("boom"))); connect.CodeOf(err) != connect.CodeInternal {
+		t.Fatalf("expected errors before the deadline to pass through, got %v", err)
+	}
+	if err := deadlineError(expired, nil); err != nil {
+		t.Fatalf("expected nil to stay nil, got %v", err)
+	}
+}
+
+func TestWithRequestDeadline(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureTimeouts(TimeoutConfig{Default: time.Second})
+	var hasDeadline bool
+	handler := srv.withRequestDeadline(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, hasDeadline = r.Context().Deadline()
+	}))
+
+	for target, want := range map[string]bool{
+		"/api/login":                           true,
+		"/api/recordings/upload":               false,
+		"/api/recordings/live/3/chunks/1":      false,
+		"/secretary.v1.TodosService/ListTodos": false,
+	} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, target, nil))
+		if hasDeadline != want {
+			t.Fatalf("%s: deadline %v, want %v", target, hasDeadline, want)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// timezoneHeader asks for timestamps in responses to be written in an IANA
+// time zone instead of UTC. They stay RFC 3339, only the offset changes,
+// so clients that parse them keep working.
+const timezoneHeader = "X-Timezone"
+
+// loadTimezone resolves an IANA zone name; empty means UTC.
+func loadTimezone(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(name)
+}
+
+// userLocation returns the caller's saved time zone, or UTC when it can't
+// be loaded.
+func (s *Server) userLocation(ctx context.Context, userID int64) *time.Location {
+	if s.users == nil || userID <= 0 {
+		return time.UTC
+	}
+	profile, err := s.users.GetProfile(ctx, int32(userID))
+	if err != nil {
+		return time.UTC
+	}
+	loc, err := loadTimezone(profile.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// timezoneInterceptor rewrites the *_at timestamps of responses into the
+// zone named by X-Timezone, so handlers keep formatting in UTC. The ETag
+// covers the zone too, or a conditional GET after switching zones would
+// get a 304 for the old rendering.
+type timezoneInterceptor struct{}
+
+func (timezoneInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		name := req.Header().Get(timezoneHeader)
+		if name == "" {
+			resp, err := next(ctx, req)
+			if resp != nil {
+				resp.Header().Add("Vary", timezoneHeader)
+			}
+			return resp, err
+		}
+		loc, err := loadTimezone(name)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("X-Timezone must be an IANA time zone name"))
+		}
+		resp, err := next(ctx, req)
+		if err != nil || resp == nil {
+			return resp, err
+		}
+		if msg, ok := resp.Any().(proto.Message); ok && loc != time.UTC {
+			localizeTimestamps(msg.ProtoReflect(), loc)
+		}
+		if etag := resp.Header().Get("ETag"); etag != "" {
+			resp.Header().Set("ETag", weakETag(etag, loc.String()))
+		}
+		resp.Header().Add("Vary", timezoneHeader)
+		resp.Header().Set(timezoneHeader, loc.String())
+		return resp, nil
+	}
+}
+
+func (timezoneInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (timezoneInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// localizeTimestamps converts every string field named *_at holding an RFC
+// 3339 time, including in nested messages, to loc.
+func localizeTimestamps(msg protoreflect.Message, loc *time.Location) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					localizeTimestamps(v.Message(), loc)
+					return true
+				})
+			}
+		case fd.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				if fd.Message() != nil {
+					localizeTimestamps(list.Get(i).Message(), loc)
+				} else if fd.Kind() == protoreflect.StringKind && strings.HasSuffix(string(fd.Name()), "_at") {
+					list.Set(i, protoreflect.ValueOfString(localizeTimestamp(list.Get(i).String(), loc)))
+				}
+			}
+		case fd.Message() != nil:
+			localizeTimestamps(value.Message(), loc)
+		case fd.Kind() == protoreflect.StringKind && strings.HasSuffix(string(fd.Name()), "_at"):
+			msg.Set(fd, protoreflect.ValueOfString(localizeTimestamp(value.String(), loc)))
+		}
+		return true
+	})
+}
+
+func localizeTimestamp(value string, loc *time.Location) string {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return value
+	}
+	if t.Nanosecond() == 0 {
+		return t.In(loc).Format(time.RFC3339)
+	}
+	return t.In(loc).Format(time.RFC3339Nano)
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+)
+
+func TestTimezoneInterceptor(t *testing.T) {
+	handler := timezoneInterceptor{}.WrapUnary(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		res := connect.NewResponse(&secretaryv1.ListTodosResponse{Todos: []*secretaryv1.Todo{
+			{Name: "Plan", CreatedAt: "2026-10-17T09:30:00Z", DueAt: "2026-12-01T09:00:00Z"},
+		}})
+		res.Header().Set("ETag", `W/"abc"`)
+		return res, nil
+	})
+
+	req := connect.NewRequest(&secretaryv1.ListTodosRequest{})
+	plain, err := handler(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := plain.Any().(*secretaryv1.ListTodosResponse).Todos[0].CreatedAt; got != "2026-10-17T09:30:00Z" {
+		t.Fatalf("without header created_at = %q, want UTC", got)
+	}
+
+	req.Header().Set(timezoneHeader, "America/New_York")
+	local, err := handler(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	todo := local.Any().(*secretaryv1.ListTodosResponse).Todos[0]
+	if todo.CreatedAt != "2026-10-17T05:30:00-04:00" || todo.DueAt != "2026-12-01T04:00:00-05:00" || todo.Name != "Plan" {
+		t.Fatalf("todo = %+v", todo)
+	}
+	if etag := local.Header().Get("ETag"); etag == `W/"abc"` || etag == "" {
+		t.Fatalf("ETag = %q, want it to cover the zone", etag)
+	}
+	if vary := local.Header().Values("Vary"); len(vary) == 0 || vary[0] != timezoneHeader {
+		t.Fatalf("Vary = %v", vary)
+	}
+
+	req.Header().Set(timezoneHeader, "Nowhere/Special")
+	if _, err := handler(context.Background(), req); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("err = %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,304 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/i18n"
+	"github.com/mvult/secretary/backend/internal/mail"
+	"github.com/mvult/secretary/backend/internal/push"
+)
+
+// CommentStore holds the todo comment queries.
+type CommentStore interface {
+	ListTodoComments(ctx context.Context, todoID int32) ([]db.TodoComment, error)
+	GetTodoComment(ctx context.Context, id int32) (db.TodoComment, error)
+	CreateTodoComment(ctx context.Context, arg db.CreateTodoCommentParams) (db.TodoComment, error)
+	UpdateTodoComment(ctx context.Context, arg db.UpdateTodoCommentParams) (db.TodoComment, error)
+	DeleteTodoComment(ctx context.Context, id int32) (int64, error)
+	ListCommentMentionedUsers(ctx context.Context, commentIds []int32) ([]db.ListCommentMentionedUsersRow, error)
+	ReplaceCommentMentions(ctx context.Context, arg db.ReplaceCommentMentionsParams) error
+}
+
+func todoCommentToProto(row db.TodoComment, mentioned []int32) *secretaryv1.TodoComment {
+	comment := &secretaryv1.TodoComment{
+		Id:           int64(row.ID),
+		TodoId:       int64(row.TodoID),
+		Body:         row.Body,
+		AuthorUserId: int64(row.AuthorUserID.Int32),
+		CreatedAt:    formatTime(row.CreatedAt),
+		UpdatedAt:    formatTime(row.UpdatedAt),
+	}
+	for _, id := range mentioned {
+		comment.MentionedUserIds = append(comment.MentionedUserIds, int64(id))
+	}
+	return comment
+}
+
+// mentionedUsers returns who each of the comments mentions, by comment.
+func (s *Server) mentionedUsers(ctx context.Context, commentIDs []int32) (map[int32][]int32, error) {
+	rows, err := s.comments.ListCommentMentionedUsers(ctx, commentIDs)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list comment mentions")
+	}
+	mentioned := map[int32][]int32{}
+	for _, row := range rows {
+		mentioned[row.CommentID] = append(mentioned[row.CommentID], row.UserID)
+	}
+	return mentioned, nil
+}
+
+// requireCommentAuthor fetches a comment the caller is about to change,
+// allowing its author and admins through.
+func (s *Server) requireCommentAuthor(ctx context.Context, id int32) error {
+	row, err := s.comments.GetTodoComment(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return connect.NewError(connect.CodeNotFound, errors.New("comment not found"))
+	}
+	if err != nil {
+		return apierr.Wrap(err, "failed to fetch comment")
+	}
+	return s.requireAuthorOrAdmin(ctx, row.AuthorUserID, "only the author or an admin can change a comment")
+}
+
+// linkCommentMentions stores who a comment @-mentions and returns them.
+// Deactivated users can't be mentioned.
+func (s *Server) linkCommentMentions(ctx context.Context, row db.TodoComment) ([]int32, error) {
+	users, err := s.users.ListUsers(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list users")
+	}
+	users = slices.DeleteFunc(users, func(u db.ListUsersRow) bool { return u.DeactivatedAt.Valid })
+	arg := db.ReplaceCommentMentionsParams{CommentID: row.ID, UserIds: []int32{}, MatchedTexts: []string{}}
+	for _, match := range newNameMatcher(users, nil).atMentions(row.Body) {
+		arg.UserIds = append(arg.UserIds, match.userID)
+		arg.MatchedTexts = append(arg.MatchedTexts, match.text)
+	}
+	if err := s.comments.ReplaceCommentMentions(ctx, arg); err != nil {
+		return nil, apierr.Wrap(err, "failed to store comment mentions")
+	}
+	return arg.UserIds, nil
+}
+
+// notifyMentioned tells users a comment on todo newly mentions them, on
+// their devices and by email. The author isn't told about mentioning
+// themselves.
+func (s *Server) notifyMentioned(ctx context.Context, todo db.GetTodoRow, comment db.TodoComment, userIDs []int32) {
+	userIDs = slices.DeleteFunc(slices.Clone(userIDs), func(id int32) bool { return id == comment.AuthorUserID.Int32 })
+	if len(userIDs) == 0 || !(s.pushEnabled() || s.emailNotificationsEnabled()) {
+		return
+	}
+	recipients, err := s.notificationRecipients(ctx, userIDs, notificationMentioned)
+	if err != nil {
+		log.Printf("notifying about mentions in comment %d: %v", comment.ID, err)
+		return
+	}
+	if len(recipients) == 0 {
+		return
+	}
+	author := "Someone"
+	if comment.AuthorUserID.Valid {
+		user, err := s.users.GetUser(ctx, comment.AuthorUserID.Int32)
+		if err != nil {
+			log.Printf("notifying about mentions in comment %d: %v", comment.ID, err)
+			return
+		}
+		author = strings.TrimSpace(user.FirstName + " " + user.LastName.String)
+	}
+	link := s.publicURL + "/"
+	if todo.CreatedAtRecordingID.Valid {
+		link = s.recordingURL(todo.CreatedAtRecordingID.Int32)
+	}
+	for _, recipient := range recipients {
+		if recipient.PushEnabled {
+			data := map[string]string{"todo_id": strconv.Itoa(int(todo.ID)), "comment_id": strconv.Itoa(int(comment.ID))}
+			s.pushToUsers(ctx, []int32{recipient.UserID}, notificationMentioned, push.Notification{
+				Title: author + " mentioned you",
+				Body:  todo.Name + ": " + comment.Body,
+				Data:  data,
+			})
+		}
+		if recipient.EmailEnabled && recipient.Email.String != "" && s.emailNotificationsEnabled() {
+			s.sendMentionEmail(ctx, recipient, author, todo, comment, link)
+		}
+	}
+}
+
+// sendMentionEmail emails recipient a comment that mentions them, in the
+// background like sendNotification.
+func (s *Server) sendMentionEmail(ctx context.Context, recipient db.ListNotificationRecipientsRow, author string, todo db.GetTodoRow, comment db.TodoComment, link string) {
+	locale := i18n.Negotiate(recipient.Locale.String, "")
+	var quoted strings.Builder
+	for _, line := range strings.Split(comment.Body, "\n") {
+		quoted.WriteString("> " + line + "\n")
+	}
+	text := i18n.Sprintf(locale, "Hi %s,\n\n%s mentioned you in a comment on the todo %s:\n\n%s", recipient.FirstName, author, todo.Name, quoted.String())
+	if link != "/" {
+		text += "\n" + link + "\n"
+	}
+	msg := mail.Message{
+		To:      recipient.Email.String,
+		Subject: i18n.Sprintf(locale, "%s mentioned you on %s", author, todo.Name),
+		Text:    text,
+	}
+	go func() {
+		if err := s.mailer.Send(context.WithoutCancel(ctx), msg); err != nil {
+			log.Printf("emailing mention in comment %d to user %d: %v", comment.ID, recipient.UserID, err)
+		}
+	}()
+}
+
+// atMentions returns the users text mentions as "@Name", the first
+// mention of each in order. Unlike match, case doesn't matter, as typing
+// the @ is deliberate, but the words of a name may only be separated by
+// spaces, and an @ inside a word, as in an email address, doesn't count.
+func (m *nameMatcher) atMentions(text string) []nameMatch {
+	words := splitWords(text)
+	var named []nameMatch
+	for i, w := range words {
+		if w.start == 0 || text[w.start-1] != '@' {
+			continue
+		}
+		if before, _ := utf8.DecodeLastRuneInString(text[:w.start-1]); unicode.IsLetter(before) || unicode.IsDigit(before) {
+			continue
+		}
+		n := 1
+		for n < m.longest && i+n < len(words) && strings.TrimSpace(text[words[i+n-1].end:words[i+n].start]) == "" {
+			n++
+		}
+		for ; n > 0; n-- {
+			folded := make([]string, n)
+			for j := range folded {
+				folded[j] = words[i+j].folded
+			}
+			ids, ok := m.names[strings.Join(folded, " ")]
+			if !ok {
+				continue
+			}
+			id, ok := m.resolve(ids)
+			if ok && !slices.ContainsFunc(named, func(n nameMatch) bool { return n.userID == id }) {
+				named = append(named, nameMatch{userID: id, text: text[w.start-1 : words[i+n-1].end]})
+			}
+			break
+		}
+	}
+	return named
+}
+
+// --- TodosService comment methods ---
+
+func (s *Server) ListTodoComments(ctx context.Context, req *connect.Request[secretaryv1.ListTodoCommentsRequest]) (*connect.Response[secretaryv1.ListTodoCommentsResponse], error) {
+	rows, err := s.comments.ListTodoComments(ctx, int32(req.Msg.TodoId))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list comments")
+	}
+	ids := make([]int32, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	mentioned, err := s.mentionedUsers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	reactions, err := s.listReactions(ctx, nil, ids)
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]*secretaryv1.TodoComment, 0, len(rows))
+	for _, row := range rows {
+		comment := todoCommentToProto(row, mentioned[row.ID])
+		comment.Reactions = reactions.comments[row.ID]
+		comments = append(comments, comment)
+	}
+	return connect.NewResponse(&secretaryv1.ListTodoCommentsResponse{Comments: comments}), nil
+}
+
+func (s *Server) CreateTodoComment(ctx context.Context, req *connect.Request[secretaryv1.CreateTodoCommentRequest]) (*connect.Response[secretaryv1.CreateTodoCommentResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	todo, err := s.todos.GetTodo(ctx, int32(msg.TodoId))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("todo not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch todo")
+	}
+	row, err := s.comments.CreateTodoComment(ctx, db.CreateTodoCommentParams{
+		TodoID:       todo.ID,
+		Body:         strings.TrimSpace(msg.Body),
+		AuthorUserID: pgtype.Int4{Int32: int32(userID), Valid: true},
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to create comment")
+	}
+	mentioned, err := s.linkCommentMentions(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyMentioned(ctx, todo, row, mentioned)
+	return connect.NewResponse(&secretaryv1.CreateTodoCommentResponse{Comment: todoCommentToProto(row, mentioned)}), nil
+}
+
+func (s *Server) UpdateTodoComment(ctx context.Context, req *connect.Request[secretaryv1.UpdateTodoCommentRequest]) (*connect.Response[secretaryv1.UpdateTodoCommentResponse], error) {
+	msg := req.Msg
+	id := int32(msg.Id)
+	if err := s.requireCommentAuthor(ctx, id); err != nil {
+		return nil, err
+	}
+	previous, err := s.mentionedUsers(ctx, []int32{id})
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.comments.UpdateTodoComment(ctx, db.UpdateTodoCommentParams{ID: id, Body: strings.TrimSpace(msg.Body)})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("comment not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to update comment")
+	}
+	mentioned, err := s.linkCommentMentions(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	added := slices.DeleteFunc(slices.Clone(mentioned), func(id int32) bool { return slices.Contains(previous[row.ID], id) })
+	if len(added) > 0 {
+		todo, err := s.todos.GetTodo(ctx, row.TodoID)
+		if err != nil {
+			log.Printf("notifying about mentions in comment %d: %v", row.ID, err)
+		} else {
+			s.notifyMentioned(ctx, todo, row, added)
+		}
+	}
+	return connect.NewResponse(&secretaryv1.UpdateTodoCommentResponse{Comment: todoCommentToProto(row, mentioned)}), nil
+}
+
+func (s *Server) DeleteTodoComment(ctx context.Context, req *connect.Request[secretaryv1.DeleteTodoCommentRequest]) (*connect.Response[secretaryv1.DeleteTodoCommentResponse], error) {
+	id := int32(req.Msg.Id)
+	if err := s.requireCommentAuthor(ctx, id); err != nil {
+		return nil, err
+	}
+	deleted, err := s.comments.DeleteTodoComment(ctx, id)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to delete comment")
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("comment not found"))
+	}
+	return connect.NewResponse(&secretaryv1.DeleteTodoCommentResponse{}), nil
+}
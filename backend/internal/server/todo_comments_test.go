@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/mail"
+)
+
+// fakeComments keeps comments and who they mention in memory.
+type fakeComments struct {
+	rows      map[int32]db.TodoComment
+	mentioned map[int32][]int32
+}
+
+func (f *fakeComments) ListTodoComments(_ context.Context, todoID int32) ([]db.TodoComment, error) {
+	var rows []db.TodoComment
+	for id := int32(1); id <= int32(len(f.rows)); id++ {
+		if row, ok := f.rows[id]; ok && row.TodoID == todoID {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeComments) GetTodoComment(_ context.Context, id int32) (db.TodoComment, error) {
+	row, ok := f.rows[id]
+	if !ok {
+		return db.TodoComment{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (f *fakeComments) CreateTodoComment(_ context.Context, arg db.CreateTodoCommentParams) (db.TodoComment, error) {
+	row := db.TodoComment{ID: int32(len(f.rows) + 1), TodoID: arg.TodoID, Body: arg.Body, AuthorUserID: arg.AuthorUserID}
+	f.rows[row.ID] = row
+	return row, nil
+}
+
+func (f *fakeComments) UpdateTodoComment(_ context.Context, arg db.UpdateTodoCommentParams) (db.TodoComment, error) {
+	row, ok := f.rows[arg.ID]
+	if !ok {
+		return db.TodoComment{}, pgx.ErrNoRows
+	}
+	row.Body = arg.Body
+	f.rows[arg.ID] = row
+	return row, nil
+}
+
+func (f *fakeComments) DeleteTodoComment(_ context.Context, id int32) (int64, error) {
+	if _, ok := f.rows[id]; !ok {
+		return 0, nil
+	}
+	delete(f.rows, id)
+	return 1, nil
+}
+
+func (f *fakeComments) ListCommentMentionedUsers(_ context.Context, commentIDs []int32) ([]db.ListCommentMentionedUsersRow, error) {
+	var rows []db.ListCommentMentionedUsersRow
+	for _, id := range commentIDs {
+		for _, userID := range f.mentioned[id] {
+			rows = append(rows, db.ListCommentMentionedUsersRow{CommentID: id, UserID: userID})
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeComments) ReplaceCommentMentions(_ context.Context, arg db.ReplaceCommentMentionsParams) error {
+	f.mentioned[arg.CommentID] = arg.UserIds
+	return nil
+}
+
+// commentingUsers is namedUsers with a deactivated Kim and names for
+// authors.
+type commentingUsers struct{ namedUsers }
+
+func (u commentingUsers) ListUsers(ctx context.Context) ([]db.ListUsersRow, error) {
+	users, _ := u.namedUsers.ListUsers(ctx)
+	return append(users, db.ListUsersRow{ID: 11, FirstName: "Kim", DeactivatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}}), nil
+}
+
+func (commentingUsers) GetUser(_ context.Context, id int32) (db.GetUserRow, error) {
+	return db.GetUserRow{ID: id, FirstName: "Sam", LastName: optionalText("Ortiz"), Role: optionalText("member")}, nil
+}
+
+func TestAtMentions(t *testing.T) {
+	users, _ := namedUsers{}.ListUsers(context.Background())
+	matcher := newNameMatcher(users, nil)
+	for _, tc := range []struct {
+		text string
+		want []nameMatch
+	}{
+		// Case doesn't matter after an @, and the longest name wins.
+		{"@jose can you check? cc @Ana López", []nameMatch{{7, "@jose"}, {8, "@Ana López"}}},
+		// Names without an @ and email addresses aren't mentions.
+		{"Ask José, or write to will@example.com", nil},
+		// A bare first name two users share is left alone.
+		{"@Ana, @Ana Ruiz and @Will. @will again.", []nameMatch{{9, "@Ana Ruiz"}, {10, "@Will"}}},
+		// Words of a name must be separated by spaces only.
+		{"@Ana,López", nil},
+	} {
+		if got := matcher.atMentions(tc.text); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("atMentions(%q) = %v, want %v", tc.text, got, tc.want)
+		}
+	}
+}
+
+func TestTodoComments(t *testing.T) {
+	comments := &fakeComments{rows: map[int32]db.TodoComment{}, mentioned: map[int32][]int32{}}
+	mailer := fakeMailer{sent: make(chan mail.Message, 4)}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, &fakeTodos{todos: map[int32]db.GetTodoRow{3: {ID: 3, Name: "Ship the deck"}}}, commentingUsers{})
+	srv.ConfigureMail(mailer, "https://secretary.example.com")
+	srv.notifyPrefs = storedPreferences{saved: db.NotificationPreference{UserID: 7, EmailEnabled: true, DigestFrequency: "off"}}
+	srv.comments = comments
+	srv.reactions = &fakeReactions{}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 10})
+
+	if _, err := srv.CreateTodoComment(ctx, connect.NewRequest(&secretaryv1.CreateTodoCommentRequest{TodoId: 4, Body: "hi"})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("unknown todo: %v", err)
+	}
+	created, err := srv.CreateTodoComment(ctx, connect.NewRequest(&secretaryv1.CreateTodoCommentRequest{TodoId: 3, Body: " @José please review, @Kim too. -@Will "}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Kim is deactivated, and Will wrote it.
+	if comment := created.Msg.Comment; comment.Body != "@José please review, @Kim too. -@Will" || !reflect.DeepEqual(comment.MentionedUserIds, []int64{7, 10}) {
+		t.Fatalf("comment = %v", comment)
+	}
+	select {
+	case msg := <-mailer.sent:
+		if !strings.Contains(msg.Subject, "Sam Ortiz") || !strings.Contains(msg.Text, "> @José please review") || !strings.Contains(msg.Text, "Ship the deck") {
+			t.Fatalf("email = %+v", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no mention email")
+	}
+
+	// Editing only tells people the comment newly mentions.
+	if _, err := srv.UpdateTodoComment(ctx, connect.NewRequest(&secretaryv1.UpdateTodoCommentRequest{Id: 1, Body: "@José please review"})); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case msg := <-mailer.sent:
+		t.Fatalf("emailed about an old mention: %+v", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	list, err := srv.ListTodoComments(ctx, connect.NewRequest(&secretaryv1.ListTodoCommentsRequest{TodoId: 3}))
+	if err != nil || len(list.Msg.Comments) != 1 || !reflect.DeepEqual(list.Msg.Comments[0].MentionedUserIds, []int64{7}) {
+		t.Fatalf("comments = %v, %v", list, err)
+	}
+
+	other := withPrincipal(context.Background(), Principal{UserID: 7})
+	if _, err := srv.DeleteTodoComment(other, connect.NewRequest(&secretaryv1.DeleteTodoCommentRequest{Id: 1})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("deleting someone else's comment: %v", err)
+	}
+	if _, err := srv.DeleteTodoComment(ctx, connect.NewRequest(&secretaryv1.DeleteTodoCommentRequest{Id: 1})); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,350 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/i18n"
+)
+
+const (
+	todoFeedTokenPurpose = "todo-feed"
+	icsDateTimeLayout    = "20060102T150405Z"
+	icsLocalLayout       = "20060102T150405"
+	icsLineLimit         = 75
+)
+
+var errInvalidFeedToken = errors.New("invalid feed token")
+
+// handleTodoFeedURL returns the signed calendar feed location for the
+// authenticated user. Calendar apps can't send bearer tokens, so the feed
+// is authorized by a token embedded in the URL instead of the JWT. POST
+// resets the token, cutting off every link handed out before, and returns
+// the new location.
+func (s *Server) handleTodoFeedURL(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	userID := currentUserID(r.Context())
+	if userID <= 0 {
+		writeError(w, http.StatusUnauthorized, "unauthenticated")
+		return
+	}
+
+	var generation int32
+	var err error
+	if r.Method == http.MethodPost {
+		generation, err = s.users.ResetTodoFeedToken(r.Context(), int32(userID))
+	} else {
+		generation, err = s.users.GetTodoFeedGeneration(r.Context(), int32(userID))
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch feed token")
+		return
+	}
+
+	token := s.todoFeedToken(userID, generation)
+	path := "/api/todos.ics?token=" + url.QueryEscape(token)
+	scheme := "http"
+	if r.TLS != nil || strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https") {
+		scheme = "https"
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"token": token,
+		"path":  path,
+		"url":   scheme + "://" + r.Host + path,
+	})
+}
+
+func (s *Server) handleTodoFeed(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	userID, err := s.parseTodoFeedToken(r.Context(), r.URL.Query().Get("token"))
+	if errors.Is(err, errInvalidFeedToken) {
+		writeError(w, http.StatusUnauthorized, "invalid feed token")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to check feed token")
+		return
+	}
+	// The link outlives the session, so it has to be cut off with it.
+	if s.isDeactivated(userID) {
+		writeError(w, http.StatusUnauthorized, "account is deactivated")
+		return
+	}
+
+	rows, err := s.todos.ListDueTodosByUser(r.Context(), pgtype.Int4{Int32: int32(userID), Valid: true})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to list todos")
+		return
+	}
+
+	// Calendar apps can't send X-Timezone either: times are written in the
+	// user's saved zone unless the URL names another with tz.
+	loc := s.userLocation(r.Context(), userID)
+	if name := r.URL.Query().Get("tz"); name != "" {
+		if loc, err = loadTimezone(name); err != nil {
+			writeError(w, http.StatusBadRequest, "tz must be an IANA time zone name")
+			return
+		}
+	}
+
+	locale := i18n.Negotiate(s.savedLocale(withPrincipal(r.Context(), Principal{UserID: userID})), r.Header.Get("Accept-Language"))
+	asTodos := strings.EqualFold(r.URL.Query().Get("kind"), "todo")
+	body := renderTodoCalendar(rows, asTodos, time.Now().UTC(), loc, locale)
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="todos.ics"`)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+// todoFeedToken signs userID together with their feed generation, which
+// ResetTodoFeedToken bumps to revoke the tokens signed before.
+func (s *Server) todoFeedToken(userID int64, generation int32) string {
+	subject := strconv.FormatInt(userID, 10)
+	return subject + "." + hex.EncodeToString(todoFeedSignature(s.jwtSecret.Load().current, subject, generation))
+}
+
+func (s *Server) parseTodoFeedToken(ctx context.Context, token string) (int64, error) {
+	subject, signature, ok := strings.Cut(strings.TrimSpace(token), ".")
+	if !ok || subject == "" || signature == "" {
+		return 0, errInvalidFeedToken
+	}
+	userID, err := strconv.ParseInt(subject, 10, 64)
+	if err != nil || userID <= 0 {
+		return 0, errInvalidFeedToken
+	}
+	provided, err := hex.DecodeString(signature)
+	if err != nil {
+		return 0, errInvalidFeedToken
+	}
+	generation, err := s.users.GetTodoFeedGeneration(ctx, int32(userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, errInvalidFeedToken
+	}
+	if err != nil {
+		return 0, err
+	}
+	// Feed URLs live in calendar apps for a long time, so ones signed
+	// before the last secret rotation still work.
+	for _, secret := range s.jwtSecret.Load().keys() {
+		if hmac.Equal(provided, todoFeedSignature(secret.([]byte), subject, generation)) {
+			return userID, nil
+		}
+	}
+	return 0, errInvalidFeedToken
+}
+
+func todoFeedSignature(secret []byte, subject string, generation int32) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(todoFeedTokenPurpose + ":" + subject))
+	// Generation 0 signs like the tokens from before feeds could be reset,
+	// so links already in calendar apps keep working until the first reset.
+	if generation > 0 {
+		mac.Write([]byte(":" + strconv.FormatInt(int64(generation), 10)))
+	}
+	return mac.Sum(nil)[:16]
+}
+
+// renderTodoCalendar renders due todos as an RFC 5545 calendar. By default
+// each open todo becomes a short VEVENT at its due time, which every calendar
+// app displays; asTodos switches to VTODO components carrying status instead.
+// Due times are local to loc, described by a VTIMEZONE, unless loc is UTC,
+// and the text Secretary adds is written in locale.
+func renderTodoCalendar(rows []db.ListDueTodosByUserRow, asTodos bool, now time.Time, loc *time.Location, locale string) string {
+	var b strings.Builder
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//Secretary//Todos//EN")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	writeICSLine(&b, "METHOD:PUBLISH")
+	writeICSLine(&b, "X-WR-CALNAME:"+escapeICSText(i18n.T(locale, "Secretary todos")))
+	local := loc != nil && loc != time.UTC
+	if local {
+		writeICSLine(&b, "X-WR-TIMEZONE:"+loc.String())
+		first, last := dueRange(rows)
+		writeICSTimezone(&b, loc, first, last)
+	}
+
+	stamp := now.UTC().Format(icsDateTimeLayout)
+	for _, row := range rows {
+		if !row.DueAt.Valid {
+			continue
+		}
+		status := strings.ToLower(strings.TrimSpace(row.Status.String))
+		due := ":" + row.DueAt.Time.UTC().Format(icsDateTimeLayout)
+		if local {
+			due = ";TZID=" + loc.String() + ":" + row.DueAt.Time.In(loc).Format(icsLocalLayout)
+		}
+		uid := "todo-" + strconv.FormatInt(int64(row.ID), 10) + "@secretary"
+		description := row.Desc.String
+		if row.RecordingName.Valid && row.RecordingName.String != "" {
+			if description != "" {
+				description += "\n\n"
+			}
+			description += i18n.Sprintf(locale, "From recording: %s", row.RecordingName.String)
+		}
+
+		if asTodos {
+			writeICSLine(&b, "BEGIN:VTODO")
+			writeICSLine(&b, "UID:"+uid)
+			writeICSLine(&b, "DTSTAMP:"+stamp)
+			writeICSLine(&b, "DUE"+due)
+			writeICSLine(&b, "SUMMARY:"+escapeICSText(row.Name))
+			if description != "" {
+				writeICSLine(&b, "DESCRIPTION:"+escapeICSText(description))
+			}
+			writeICSLine(&b, "STATUS:"+icsTodoStatus(status))
+			if row.UpdatedAt.Valid {
+				writeICSLine(&b, "LAST-MODIFIED:"+row.UpdatedAt.Time.UTC().Format(icsDateTimeLayout))
+			}
+			writeICSLine(&b, "END:VTODO")
+			continue
+		}
+
+		if status == "done" || status == "skipped" {
+			continue
+		}
+		writeICSLine(&b, "BEGIN:VEVENT")
+		writeICSLine(&b, "UID:"+uid)
+		writeICSLine(&b, "DTSTAMP:"+stamp)
+		writeICSLine(&b, "DTSTART"+due)
+		writeICSLine(&b, "DURATION:PT30M")
+		writeICSLine(&b, "SUMMARY:"+escapeICSText(row.Name))
+		if description != "" {
+			writeICSLine(&b, "DESCRIPTION:"+escapeICSText(description))
+		}
+		writeICSLine(&b, "TRANSP:TRANSPARENT")
+		writeICSLine(&b, "END:VEVENT")
+	}
+
+	writeICSLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// dueRange spans the due dates in rows, or just now when there are none.
+func dueRange(rows []db.ListDueTodosByUserRow) (time.Time, time.Time) {
+	var first, last time.Time
+	for _, row := range rows {
+		if !row.DueAt.Valid {
+			continue
+		}
+		if first.IsZero() || row.DueAt.Time.Before(first) {
+			first = row.DueAt.Time
+		}
+		if row.DueAt.Time.After(last) {
+			last = row.DueAt.Time
+		}
+	}
+	if first.IsZero() {
+		first = time.Now()
+		last = first
+	}
+	return first, last
+}
+
+// writeICSTimezone describes loc between from and to as a VTIMEZONE with
+// one STANDARD or DAYLIGHT component per offset change, taken from the Go
+// time zone database rather than RRULEs.
+func writeICSTimezone(b *strings.Builder, loc *time.Location, from, to time.Time) {
+	writeICSLine(b, "BEGIN:VTIMEZONE")
+	writeICSLine(b, "TZID:"+loc.String())
+	t := from.In(loc)
+	for {
+		start, end := t.ZoneBounds()
+		name, offset := t.Zone()
+		previous := offset
+		if start.IsZero() {
+			start = time.Date(1970, 1, 1, 0, 0, 0, 0, loc)
+		} else {
+			_, previous = start.Add(-time.Second).Zone()
+		}
+		kind := "STANDARD"
+		if t.IsDST() {
+			kind = "DAYLIGHT"
+		}
+		writeICSLine(b, "BEGIN:"+kind)
+		// DTSTART is the wall time the change happens at, before it applies.
+		writeICSLine(b, "DTSTART:"+start.In(time.FixedZone("", previous)).Format(icsLocalLayout))
+		writeICSLine(b, "TZOFFSETFROM:"+icsOffset(previous))
+		writeICSLine(b, "TZOFFSETTO:"+icsOffset(offset))
+		writeICSLine(b, "TZNAME:"+escapeICSText(name))
+		writeICSLine(b, "END:"+kind)
+		if end.IsZero() || end.After(to) {
+			break
+		}
+		t = end
+	}
+	writeICSLine(b, "END:VTIMEZONE")
+}
+
+func icsOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+}
+
+func icsTodoStatus(status string) string {
+	switch status {
+	case "doing":
+		return "IN-PROCESS"
+	case "done":
+		return "COMPLETED"
+	case "skipped":
+		return "CANCELLED"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+func escapeICSText(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return replacer.Replace(value)
+}
+
+// writeICSLine folds content lines longer than 75 octets as required by
+// RFC 5545, taking care not to split a multi-byte UTF-8 sequence.
+func writeICSLine(b *strings.Builder, line string) {
+	limit := icsLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icsLineLimit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
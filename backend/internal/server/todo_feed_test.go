@@ -0,0 +1,229 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/i18n"
+)
+
+// feedUsers keeps each user's feed generation in memory.
+type feedUsers struct {
+	UserStore
+	generations map[int32]int32
+}
+
+func (u *feedUsers) GetTodoFeedGeneration(_ context.Context, id int32) (int32, error) {
+	generation, ok := u.generations[id]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	return generation, nil
+}
+
+func (u *feedUsers) ResetTodoFeedToken(_ context.Context, id int32) (int32, error) {
+	if _, ok := u.generations[id]; !ok {
+		return 0, pgx.ErrNoRows
+	}
+	u.generations[id]++
+	return u.generations[id], nil
+}
+
+func newFeedServer(secret string) *Server {
+	s := New(nil, []byte(secret), time.Hour)
+	s.ConfigureStores(nil, nil, &feedUsers{generations: map[int32]int32{42: 0, 43: 0}})
+	return s
+}
+
+func TestTodoFeedTokenRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s := newFeedServer("test-secret")
+	token := s.todoFeedToken(42, 0)
+
+	userID, err := s.parseTodoFeedToken(ctx, token)
+	if err != nil {
+		t.Fatalf("parse feed token: %v", err)
+	}
+	if userID != 42 {
+		t.Fatalf("expected user 42, got %d", userID)
+	}
+
+	if _, err := s.parseTodoFeedToken(ctx, "43"+token[2:]); err == nil {
+		t.Fatalf("expected tampered subject to be rejected")
+	}
+	if _, err := s.parseTodoFeedToken(ctx, "44"+token[2:]); !errors.Is(err, errInvalidFeedToken) {
+		t.Fatalf("expected unknown user to be rejected, got %v", err)
+	}
+	other := newFeedServer("other-secret")
+	if _, err := other.parseTodoFeedToken(ctx, token); err == nil {
+		t.Fatalf("expected token signed with another secret to be rejected")
+	}
+}
+
+func TestResetTodoFeedTokenRevokesOldLinks(t *testing.T) {
+	s := newFeedServer("test-secret")
+	feedURL := func(method string) string {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(method, "/api/todos/feed", nil)
+		s.handleTodoFeedURL(rec, req.WithContext(withPrincipal(req.Context(), Principal{UserID: 42})))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s feed url status = %d", method, rec.Code)
+		}
+		var body struct{ Token string }
+		if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		return body.Token
+	}
+
+	old := feedURL(http.MethodGet)
+	if old != s.todoFeedToken(42, 0) {
+		t.Fatal("a user who never reset their feed got a new token")
+	}
+	fresh := feedURL(http.MethodPost)
+	if fresh == old || feedURL(http.MethodGet) != fresh {
+		t.Fatal("reset didn't hand out a new, lasting token")
+	}
+	ctx := context.Background()
+	if _, err := s.parseTodoFeedToken(ctx, old); !errors.Is(err, errInvalidFeedToken) {
+		t.Fatalf("token from before the reset: %v", err)
+	}
+	if userID, err := s.parseTodoFeedToken(ctx, fresh); err != nil || userID != 42 {
+		t.Fatalf("new token: user %d, %v", userID, err)
+	}
+}
+
+func TestTodoFeedRefusesDeactivatedUsers(t *testing.T) {
+	s := newFeedServer("test-secret")
+	s.setDeactivated(42, true)
+
+	rec := httptest.NewRecorder()
+	s.handleTodoFeed(rec, httptest.NewRequest(http.MethodGet, "/api/todos.ics?token="+s.todoFeedToken(42, 0), nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("deactivated user's feed status = %d", rec.Code)
+	}
+}
+
+func TestRotateJWTSecretKeepsPreviousTokens(t *testing.T) {
+	ctx := context.Background()
+	s := newFeedServer("first")
+	feed := s.todoFeedToken(42, 0)
+	bearer, err := s.issueToken(42, tokenClaims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	authorized := func(token string) bool {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		s.authMiddleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})).ServeHTTP(rec, req)
+		return rec.Code == http.StatusOK
+	}
+
+	s.RotateJWTSecret([]byte("second"))
+	if _, err := s.parseTodoFeedToken(ctx, feed); err != nil || !authorized(bearer) {
+		t.Fatalf("tokens from before the rotation: feed err = %v, bearer authorized = %v", err, authorized(bearer))
+	}
+	if fresh, _ := s.issueToken(42, tokenClaims{}); !authorized(fresh) || s.todoFeedToken(42, 0) == feed {
+		t.Fatal("new tokens aren't signed with the new secret")
+	}
+
+	s.RotateJWTSecret([]byte("third"))
+	if _, err := s.parseTodoFeedToken(ctx, feed); err == nil || authorized(bearer) {
+		t.Fatal("tokens two rotations old are still accepted")
+	}
+}
+
+func TestRenderTodoCalendar(t *testing.T) {
+	due := time.Date(2026, time.October, 20, 15, 0, 0, 0, time.UTC)
+	rows := []db.ListDueTodosByUserRow{
+		{
+			ID:            7,
+			Name:          "Send budget, v2; final",
+			Desc:          pgtype.Text{String: "line one\nline two", Valid: true},
+			Status:        pgtype.Text{String: "todo", Valid: true},
+			DueAt:         pgtype.Timestamptz{Time: due, Valid: true},
+			RecordingName: pgtype.Text{String: "Weekly sync", Valid: true},
+		},
+		{
+			ID:     8,
+			Name:   "Already finished",
+			Status: pgtype.Text{String: "done", Valid: true},
+			DueAt:  pgtype.Timestamptz{Time: due, Valid: true},
+		},
+	}
+
+	events := renderTodoCalendar(rows, false, due, time.UTC, i18n.Default)
+	if !strings.Contains(events, `SUMMARY:Send budget\, v2\; final`+"\r\n") {
+		t.Fatalf("expected escaped summary, got:\n%s", events)
+	}
+	if !strings.Contains(events, "DTSTART:20261020T150000Z\r\n") {
+		t.Fatalf("expected DTSTART in UTC, got:\n%s", events)
+	}
+	if strings.Contains(events, "todo-8@secretary") {
+		t.Fatalf("done todos should not be rendered as events")
+	}
+
+	todos := renderTodoCalendar(rows, true, due, time.UTC, i18n.Default)
+	if !strings.Contains(todos, "UID:todo-8@secretary\r\n") || !strings.Contains(todos, "STATUS:COMPLETED\r\n") {
+		t.Fatalf("expected completed VTODO, got:\n%s", todos)
+	}
+	for _, line := range strings.Split(todos, "\r\n") {
+		if len(line) > icsLineLimit {
+			t.Fatalf("line exceeds %d octets: %q", icsLineLimit, line)
+		}
+	}
+}
+
+func TestRenderTodoCalendarInTimezone(t *testing.T) {
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := []db.ListDueTodosByUserRow{
+		{ID: 1, Name: "Summer", Status: pgtype.Text{String: "todo", Valid: true}, DueAt: pgtype.Timestamptz{Time: time.Date(2026, time.October, 20, 15, 0, 0, 0, time.UTC), Valid: true}},
+		{ID: 2, Name: "Winter", Status: pgtype.Text{String: "todo", Valid: true}, DueAt: pgtype.Timestamptz{Time: time.Date(2026, time.December, 1, 9, 0, 0, 0, time.UTC), Valid: true}},
+	}
+
+	calendar := renderTodoCalendar(rows, false, time.Now(), madrid, "es")
+	for _, want := range []string{
+		"X-WR-TIMEZONE:Europe/Madrid\r\n",
+		"BEGIN:DAYLIGHT\r\nDTSTART:20260329T020000\r\nTZOFFSETFROM:+0100\r\nTZOFFSETTO:+0200\r\n",
+		"BEGIN:STANDARD\r\nDTSTART:20261025T030000\r\nTZOFFSETFROM:+0200\r\nTZOFFSETTO:+0100\r\n",
+		"DTSTART;TZID=Europe/Madrid:20261020T170000\r\n",
+		"DTSTART;TZID=Europe/Madrid:20261201T100000\r\n",
+		"DTSTAMP:",
+		"X-WR-CALNAME:Tareas de Secretary\r\n",
+	} {
+		if !strings.Contains(calendar, want) {
+			t.Fatalf("expected %q in:\n%s", want, calendar)
+		}
+	}
+}
+
+func TestWriteICSLineFoldsLongLines(t *testing.T) {
+	var b strings.Builder
+	writeICSLine(&b, "DESCRIPTION:"+strings.Repeat("é", 60))
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n")
+	if len(lines) < 2 {
+		t.Fatalf("expected folded output, got %q", b.String())
+	}
+	for i, line := range lines {
+		if len(line) > icsLineLimit {
+			t.Fatalf("line %d exceeds limit: %d", i, len(line))
+		}
+		if i > 0 && !strings.HasPrefix(line, " ") {
+			t.Fatalf("continuation line %d must start with a space", i)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// mergeTodoFields returns the update for a todo absorbing a duplicate. The
+// kept todo's name and status win; from the duplicate it takes what it is
+// missing, a description it does not already have, and an earlier due date.
+func mergeTodoFields(kept, duplicate db.GetTodoRow) db.UpdateTodoParams {
+	arg := db.UpdateTodoParams{
+		ID:                   kept.ID,
+		Name:                 kept.Name,
+		Desc:                 kept.Desc,
+		Status:               kept.Status,
+		UserID:               kept.UserID,
+		UpdatedAtRecordingID: kept.UpdatedAtRecordingID,
+		DueAt:                kept.DueAt,
+		Version:              kept.Version,
+	}
+	if extra := strings.TrimSpace(duplicate.Desc.String); extra != "" && !strings.Contains(kept.Desc.String, extra) {
+		if strings.TrimSpace(kept.Desc.String) == "" {
+			arg.Desc = pgtype.Text{String: extra, Valid: true}
+		} else {
+			arg.Desc = pgtype.Text{String: kept.Desc.String + "\n\n" + extra, Valid: true}
+		}
+	}
+	if !arg.UserID.Valid {
+		arg.UserID = duplicate.UserID
+	}
+	if !arg.UpdatedAtRecordingID.Valid {
+		arg.UpdatedAtRecordingID = duplicate.UpdatedAtRecordingID
+	}
+	if duplicate.DueAt.Valid && (!arg.DueAt.Valid || duplicate.DueAt.Time.Before(arg.DueAt.Time)) {
+		arg.DueAt = duplicate.DueAt
+	}
+	return arg
+}
+
+// MergeTodos folds a duplicate todo into another in one transaction. The
+// duplicate's history is kept under the merged todo, followed by a "merge"
+// entry naming it, so the timeline reads as one.
+func (s *Server) MergeTodos(ctx context.Context, req *connect.Request[secretaryv1.MergeTodosRequest]) (*connect.Response[secretaryv1.MergeTodosResponse], error) {
+	msg := req.Msg
+	// Merging deletes the duplicate, so it takes what deleting takes.
+	if err := s.requireAdmin(ctx, "only admins can merge todos"); err != nil {
+		return nil, err
+	}
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	qtx, err := s.todos.BeginTodoTx(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to start transaction")
+	}
+	defer func() { _ = qtx.Rollback(ctx) }()
+
+	kept, err := qtx.GetTodo(ctx, int32(msg.Id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("todo not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch todo")
+	}
+	duplicate, err := qtx.GetTodo(ctx, int32(msg.DuplicateId))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("duplicate todo not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch duplicate todo")
+	}
+	if int64(duplicate.Version) != msg.ExpectedDuplicateVersion {
+		return nil, apierr.StaleVersion(fmt.Sprintf("duplicate todo was modified (expected version %d, current version %d)", msg.ExpectedDuplicateVersion, duplicate.Version), int64(duplicate.Version))
+	}
+
+	arg := mergeTodoFields(kept, duplicate)
+	arg.Version = int32(msg.ExpectedVersion)
+	todoRow, err := qtx.UpdateTodo(ctx, arg)
+	if errors.Is(err, pgx.ErrNoRows) {
+		current, versionErr := qtx.GetTodoVersion(ctx, arg.ID)
+		if versionErr != nil {
+			return nil, apierr.Wrap(versionErr, "failed to fetch todo version")
+		}
+		return nil, apierr.StaleVersion(fmt.Sprintf("todo was modified (expected version %d, current version %d)", msg.ExpectedVersion, current), int64(current))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to merge todo")
+	}
+
+	if err := qtx.MoveTodoReferences(ctx, db.MoveTodoReferencesParams{IntoID: todoRow.ID, FromID: duplicate.ID}); err != nil {
+		return nil, apierr.Wrap(err, "failed to move todo references")
+	}
+	if err := qtx.DeleteTodo(ctx, duplicate.ID); err != nil {
+		return nil, apierr.Wrap(err, "failed to delete duplicate todo")
+	}
+	err = qtx.CreateTodoHistory(ctx, db.CreateTodoHistoryParams{
+		TodoID:               todoRow.ID,
+		ActorUserID:          pgtype.Int4{Int32: int32(userID), Valid: true},
+		ChangeType:           "merge",
+		Name:                 pgtype.Text{String: todoRow.Name, Valid: true},
+		Desc:                 todoRow.Desc,
+		Status:               todoRow.Status,
+		UserID:               todoRow.UserID,
+		CreatedAtRecordingID: todoRow.CreatedAtRecordingID,
+		UpdatedAtRecordingID: todoRow.UpdatedAtRecordingID,
+		Note:                 optionalText(fmt.Sprintf("Merged todo #%d %q", duplicate.ID, duplicate.Name)),
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to record merge in todo history")
+	}
+
+	if err := qtx.Commit(ctx); err != nil {
+		return nil, apierr.Wrap(err, "failed to commit merge")
+	}
+	s.notifyTodoAssigned(ctx, todoRow, kept.UserID)
+
+	todo := todoRowToProto(todoRow.ID, todoRow.Name, todoRow.Desc, todoRow.Status, todoRow.UserID, todoRow.CreatedAtRecordingID, todoRow.UpdatedAtRecordingID, kept.RecordingName, kept.RecordingDate, todoRow.CreatedAt, todoRow.UpdatedAt, todoRow.SourceKind, todoRow.SourceDocumentID, todoRow.SourceBlockID, todoRow.DueAt, todoRow.Version, todoRow.SnoozedUntil, todoRow.Priority)
+	return connect.NewResponse(&secretaryv1.MergeTodosResponse{Todo: todo}), nil
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// mergingTodos holds todos by ID, with their history and the references the
+// merge moved; it is its own transaction.
+type mergingTodos struct {
+	TodoStore
+	todos     map[int32]db.GetTodoRow
+	history   map[int32][]string
+	moved     []db.MoveTodoReferencesParams
+	committed bool
+}
+
+func (f *mergingTodos) BeginTodoTx(context.Context) (TodoTx, error) { return f, nil }
+func (f *mergingTodos) Commit(context.Context) error                { f.committed = true; return nil }
+func (f *mergingTodos) Rollback(context.Context) error              { return nil }
+
+func (f *mergingTodos) GetTodo(_ context.Context, id int32) (db.GetTodoRow, error) {
+	row, ok := f.todos[id]
+	if !ok {
+		return db.GetTodoRow{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (f *mergingTodos) GetTodoVersion(_ context.Context, id int32) (int32, error) {
+	return f.todos[id].Version, nil
+}
+
+func (f *mergingTodos) UpdateTodo(_ context.Context, arg db.UpdateTodoParams) (db.Todo, error) {
+	row := f.todos[arg.ID]
+	if row.Version != arg.Version {
+		return db.Todo{}, pgx.ErrNoRows
+	}
+	row.Name, row.Desc, row.Status, row.UserID, row.DueAt, row.Version = arg.Name, arg.Desc, arg.Status, arg.UserID, arg.DueAt, row.Version+1
+	f.todos[arg.ID] = row
+	return db.Todo{ID: row.ID, Name: row.Name, Desc: row.Desc, Status: row.Status, UserID: row.UserID, DueAt: row.DueAt, Version: row.Version}, nil
+}
+
+func (f *mergingTodos) MoveTodoReferences(_ context.Context, arg db.MoveTodoReferencesParams) error {
+	f.moved = append(f.moved, arg)
+	f.history[arg.IntoID] = append(f.history[arg.IntoID], f.history[arg.FromID]...)
+	delete(f.history, arg.FromID)
+	return nil
+}
+
+func (f *mergingTodos) DeleteTodo(_ context.Context, id int32) error {
+	delete(f.todos, id)
+	delete(f.history, id)
+	return nil
+}
+
+func (f *mergingTodos) CreateTodoHistory(_ context.Context, arg db.CreateTodoHistoryParams) error {
+	f.history[arg.TodoID] = append(f.history[arg.TodoID], arg.ChangeType+": "+arg.Note.String)
+	return nil
+}
+
+func TestMergeTodoFields(t *testing.T) {
+	early := pgtype.Timestamptz{Time: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC), Valid: true}
+	late := pgtype.Timestamptz{Time: time.Date(2026, 11, 9, 0, 0, 0, 0, time.UTC), Valid: true}
+	kept := db.GetTodoRow{ID: 1, Name: "Send the proposal", Desc: optionalText("Draft is in the shared folder."), Status: optionalText("doing"), DueAt: late, Version: 3}
+	duplicate := db.GetTodoRow{ID: 2, Name: "Proposal to Acme", Desc: optionalText("Include the pricing table."), Status: optionalText("todo"), UserID: pgtype.Int4{Int32: 4, Valid: true}, DueAt: early}
+
+	arg := mergeTodoFields(kept, duplicate)
+	if arg.Name != kept.Name || arg.Status.String != "doing" || arg.DueAt != early || arg.UserID.Int32 != 4 {
+		t.Fatalf("merged fields = %+v", arg)
+	}
+	if arg.Desc.String != "Draft is in the shared folder.\n\nInclude the pricing table." {
+		t.Fatalf("merged desc = %q", arg.Desc.String)
+	}
+	// A description the kept todo already says is not repeated.
+	duplicate.Desc = optionalText("Draft is in the shared folder.")
+	if arg := mergeTodoFields(kept, duplicate); arg.Desc != kept.Desc {
+		t.Fatalf("repeated desc = %q", arg.Desc.String)
+	}
+}
+
+func TestMergeTodos(t *testing.T) {
+	store := &mergingTodos{
+		todos: map[int32]db.GetTodoRow{
+			1: {ID: 1, Name: "Send the proposal", Status: optionalText("todo"), Version: 2},
+			2: {ID: 2, Name: "Proposal to Acme", Desc: optionalText("Include pricing."), Status: optionalText("todo"), Version: 5},
+		},
+		history: map[int32][]string{1: {"create: "}, 2: {"create: ", "update: "}},
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, store, adminUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	_, err := srv.MergeTodos(ctx, connect.NewRequest(&secretaryv1.MergeTodosRequest{Id: 1, DuplicateId: 2, ExpectedVersion: 2, ExpectedDuplicateVersion: 4}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition || store.committed || len(store.todos) != 2 {
+		t.Fatalf("stale duplicate: %v", err)
+	}
+	if _, err := srv.MergeTodos(ctx, connect.NewRequest(&secretaryv1.MergeTodosRequest{Id: 1, DuplicateId: 3, ExpectedVersion: 2, ExpectedDuplicateVersion: 1})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("missing duplicate: %v", err)
+	}
+
+	resp, err := srv.MergeTodos(ctx, connect.NewRequest(&secretaryv1.MergeTodosRequest{Id: 1, DuplicateId: 2, ExpectedVersion: 2, ExpectedDuplicateVersion: 5}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !store.committed || resp.Msg.Todo.Version != 3 || resp.Msg.Todo.Desc != "Include pricing." {
+		t.Fatalf("merged todo = %+v", resp.Msg.Todo)
+	}
+	if _, ok := store.todos[2]; ok || len(store.moved) != 1 || store.moved[0] != (db.MoveTodoReferencesParams{IntoID: 1, FromID: 2}) {
+		t.Fatalf("duplicate not folded in: todos=%v moved=%v", store.todos, store.moved)
+	}
+	history := store.history[1]
+	if len(history) != 4 || history[3] != `merge: Merged todo #2 "Proposal to Acme"` {
+		t.Fatalf("history = %q", history)
+	}
+
+	srv.ConfigureStores(nil, nil, memberUsers{})
+	if _, err := srv.MergeTodos(ctx, connect.NewRequest(&secretaryv1.MergeTodosRequest{Id: 1, DuplicateId: 3, ExpectedVersion: 3, ExpectedDuplicateVersion: 1})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member merge: %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+// listTodosSelect matches the column order of db.ListTodosByUserRow so
+// rows can be scanned positionally.
+const listTodosSelect = `SELECT
+  t.id,
+  t.name,
+  t."desc",
+  t.status,
+  t.user_id,
+  t.workspace_id,
+  t.source_kind,
+  t.source_document_id,
+  t.source_block_id,
+  t.created_at_recording_id,
+  t.updated_at_recording_id,
+  t.created_at,
+  t.updated_at,
+  t.due_at,
+  t.version,
+  t.snoozed_until,
+  t.priority,
+  r.name as recording_name,
+  r.created_at as recording_date
+FROM todo t
+LEFT JOIN recording r ON t.created_at_recording_id = r.id`
+
+// Sort clauses are looked up, never built from input.
+var todoSortClauses = map[secretaryv1.TodoSort]string{
+	secretaryv1.TodoSort_TODO_SORT_UNSPECIFIED:     "t.created_at DESC, t.id DESC",
+	secretaryv1.TodoSort_TODO_SORT_CREATED_AT_DESC: "t.created_at DESC, t.id DESC",
+	secretaryv1.TodoSort_TODO_SORT_CREATED_AT_ASC:  "t.created_at ASC, t.id ASC",
+	secretaryv1.TodoSort_TODO_SORT_DUE_AT_ASC:      "t.due_at ASC NULLS LAST, t.id ASC",
+	secretaryv1.TodoSort_TODO_SORT_DUE_AT_DESC:     "t.due_at DESC NULLS LAST, t.id DESC",
+	secretaryv1.TodoSort_TODO_SORT_UPDATED_AT_DESC: "t.updated_at DESC, t.id DESC",
+	secretaryv1.TodoSort_TODO_SORT_PRIORITY_DESC:   "t.priority DESC, t.due_at ASC NULLS LAST, t.id DESC",
+}
+
+type todoQuery struct {
+	conds []string
+	args  []any
+}
+
+// where appends a condition; each "?" in cond refers to arg.
+func (q *todoQuery) where(cond string, arg any) {
+	q.args = append(q.args, arg)
+	q.conds = append(q.conds, strings.ReplaceAll(cond, "?", "$"+strconv.Itoa(len(q.args))))
+}
+
+// buildListTodosQuery turns a ListTodosRequest into SQL and its arguments.
+// starredIDs are the caller's starred todos, used by StarredOnly.
+func buildListTodosQuery(msg *secretaryv1.ListTodosRequest, starredIDs []int32) (string, []any, error) {
+	// Repeats the request's validation rule, which callers inside the
+	// server skip, so nothing lists every todo at once.
+	if msg.UserId <= 0 && msg.RecordingId == nil && !msg.StarredOnly {
+		return "", nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id is required"))
+	}
+
+	var q todoQuery
+	if msg.UserId > 0 {
+		q.where("t.user_id = ?", int32(msg.UserId))
+	}
+	if msg.RecordingId != nil {
+		q.where("t.created_at_recording_id = ?", int32(*msg.RecordingId))
+	}
+	if len(msg.Statuses) > 0 {
+		statuses := make([]string, 0, len(msg.Statuses))
+		for _, status := range msg.Statuses {
+			statuses = append(statuses, mapStatusToString(status))
+		}
+		q.where("t.status = ANY(?)", statuses)
+	}
+
+	bounds := []struct {
+		field string
+		value string
+		cond  string
+	}{
+		{"created_after", msg.CreatedAfter, "t.created_at >= ?"},
+		{"created_before", msg.CreatedBefore, "t.created_at < ?"},
+		{"due_after", msg.DueAfter, "t.due_at >= ?"},
+		{"due_before", msg.DueBefore, "t.due_at < ?"},
+	}
+	for _, bound := range bounds {
+		ts, err := parseOptionalTimestamp(bound.value)
+		if err != nil {
+			return "", nil, apierr.InvalidField(bound.field, "must be an RFC3339 timestamp")
+		}
+		if ts.Valid {
+			q.where(bound.cond, ts)
+		}
+	}
+
+	if text := strings.TrimSpace(msg.Query); text != "" {
+		q.where(`(t.name ILIKE ? OR t."desc" ILIKE ?)`, "%"+escapeLike(text)+"%")
+	}
+
+	if msg.StarredOnly {
+		q.where("t.id = ANY(?)", starredIDs)
+	}
+	if msg.ExcludeSnoozed {
+		// A snooze that ran out counts as over before snooze-wake clears it.
+		q.conds = append(q.conds, "(t.snoozed_until IS NULL OR t.snoozed_until <= now())")
+	}
+
+	sql := listTodosSelect
+	if len(q.conds) > 0 {
+		sql += "\nWHERE " + strings.Join(q.conds, " AND ")
+	}
+	sql += "\nORDER BY " + todoSortClauses[msg.Sort]
+	return sql, q.args, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+)
+
+func TestBuildListTodosQuery(t *testing.T) {
+	recordingID := int64(9)
+	sql, args, err := buildListTodosQuery(&secretaryv1.ListTodosRequest{
+		UserId:       4,
+		RecordingId:  &recordingID,
+		Statuses:     []secretaryv1.TodoStatus{secretaryv1.TodoStatus_TODO_STATUS_TODO, secretaryv1.TodoStatus_TODO_STATUS_BLOCKED},
+		CreatedAfter: "2026-10-01T00:00:00Z",
+		DueBefore:    "2026-11-01T00:00:00Z",
+		Query:        "50%_off",
+		Sort:         secretaryv1.TodoSort_TODO_SORT_DUE_AT_ASC,
+	}, nil)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+
+	wantWhere := `WHERE t.user_id = $1 AND t.created_at_recording_id = $2 AND t.status = ANY($3) AND t.created_at >= $4 AND t.due_at < $5 AND (t.name ILIKE $6 OR t."desc" ILIKE $6)`
+	if !strings.Contains(sql, wantWhere) {
+		t.Fatalf("unexpected where clause:\n%s", sql)
+	}
+	if !strings.HasSuffix(sql, "ORDER BY t.due_at ASC NULLS LAST, t.id ASC") {
+		t.Fatalf("unexpected order clause:\n%s", sql)
+	}
+	if len(args) != 6 {
+		t.Fatalf("expected 6 args, got %d", len(args))
+	}
+	if statuses := args[2].([]string); len(statuses) != 2 || statuses[0] != "todo" || statuses[1] != "blocked" {
+		t.Fatalf("unexpected statuses: %v", statuses)
+	}
+	if ts := args[3].(pgtype.Timestamptz); !ts.Valid || ts.Time.Month() != 10 {
+		t.Fatalf("unexpected created_after: %v", ts)
+	}
+	if pattern := args[5].(string); pattern != `%50\%\_off%` {
+		t.Fatalf("expected escaped pattern, got %q", pattern)
+	}
+}
+
+func TestBuildListTodosQueryDefaults(t *testing.T) {
+	sql, args, err := buildListTodosQuery(&secretaryv1.ListTodosRequest{UserId: 1}, nil)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if len(args) != 1 || !strings.HasSuffix(sql, "WHERE t.user_id = $1\nORDER BY t.created_at DESC, t.id DESC") {
+		t.Fatalf("unexpected default query (%d args):\n%s", len(args), sql)
+	}
+
+	_, _, err = buildListTodosQuery(&secretaryv1.ListTodosRequest{UserId: 1, DueAfter: "tomorrow"}, nil)
+	if connect.CodeOf(err) != connect.CodeInvalidArgument || !strings.Contains(err.Error(), "due_after") {
+		t.Fatalf("expected invalid due_after, got %v", err)
+	}
+}
+
+func TestBuildListTodosQueryStarredOnly(t *testing.T) {
+	sql, args, err := buildListTodosQuery(&secretaryv1.ListTodosRequest{StarredOnly: true}, []int32{3, 5})
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if ids, ok := args[0].([]int32); len(args) != 1 || !ok || len(ids) != 2 || !strings.Contains(sql, "WHERE t.id = ANY($1)") {
+		t.Fatalf("unexpected starred query (%v):\n%s", args, sql)
+	}
+}
+
+func TestBuildListTodosQueryExcludeSnoozed(t *testing.T) {
+	sql, args, err := buildListTodosQuery(&secretaryv1.ListTodosRequest{UserId: 1, ExcludeSnoozed: true}, nil)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if len(args) != 1 || !strings.Contains(sql, "WHERE t.user_id = $1 AND (t.snoozed_until IS NULL OR t.snoozed_until <= now())") {
+		t.Fatalf("unexpected snoozed query (%d args):\n%s", len(args), sql)
+	}
+}
+
+func TestBuildListTodosQueryRequiresScope(t *testing.T) {
+	_, _, err := buildListTodosQuery(&secretaryv1.ListTodosRequest{Query: "invoice"}, nil)
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected an unscoped list to be rejected, got %v", err)
+	}
+}
+
+func TestBuildListTodosQuerySortsByPriority(t *testing.T) {
+	sql, _, err := buildListTodosQuery(&secretaryv1.ListTodosRequest{UserId: 1, Sort: secretaryv1.TodoSort_TODO_SORT_PRIORITY_DESC}, nil)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if !strings.HasSuffix(sql, "ORDER BY t.priority DESC, t.due_at ASC NULLS LAST, t.id DESC") {
+		t.Fatalf("unexpected order clause:\n%s", sql)
+	}
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/i18n"
+	"github.com/mvult/secretary/backend/internal/mail"
+	"github.com/mvult/secretary/backend/internal/push"
+)
+
+// SnoozeStore sets and ends todo snoozes.
+type SnoozeStore interface {
+	SnoozeTodo(ctx context.Context, arg db.SnoozeTodoParams) (int64, error)
+	WakeSnoozedTodos(ctx context.Context) ([]db.WakeSnoozedTodosRow, error)
+}
+
+// SnoozeTodo hides a todo from lists that exclude snoozed todos until the
+// requested time, or brings it back early.
+func (s *Server) SnoozeTodo(ctx context.Context, req *connect.Request[secretaryv1.SnoozeTodoRequest]) (*connect.Response[secretaryv1.SnoozeTodoResponse], error) {
+	if _, err := requireUserID(ctx); err != nil {
+		return nil, err
+	}
+	until, err := parseOptionalTimestamp(req.Msg.Until)
+	if err != nil {
+		return nil, apierr.InvalidField("until", "must be an RFC3339 timestamp")
+	}
+	if until.Valid && !until.Time.After(time.Now()) {
+		return nil, apierr.InvalidField("until", "must be in the future")
+	}
+	n, err := s.snoozes.SnoozeTodo(ctx, db.SnoozeTodoParams{ID: int32(req.Msg.Id), SnoozedUntil: until})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to snooze todo")
+	}
+	if n == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("todo not found"))
+	}
+	return connect.NewResponse(&secretaryv1.SnoozeTodoResponse{}), nil
+}
+
+// wakeSnoozedTodos ends the snoozes that have run out and tells the
+// assignees of the todos still open.
+func (s *Server) wakeSnoozedTodos(ctx context.Context) error {
+	rows, err := s.snoozes.WakeSnoozedTodos(ctx)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if row.Status.String == "done" || row.Status.String == "skipped" {
+			continue
+		}
+		s.notifySnoozeEnded(ctx, row)
+	}
+	return nil
+}
+
+// notifySnoozeEnded tells a todo's assignee it is back from its snooze,
+// by push and email as they chose.
+func (s *Server) notifySnoozeEnded(ctx context.Context, todo db.WakeSnoozedTodosRow) {
+	if !todo.UserID.Valid || todo.UserID.Int32 == 0 || !(s.pushEnabled() || s.emailNotificationsEnabled()) {
+		return
+	}
+	recipients, err := s.notificationRecipients(ctx, []int32{todo.UserID.Int32}, notificationSnoozeEnded)
+	if err != nil {
+		log.Printf("notifying about snoozed todo %d: %v", todo.ID, err)
+		return
+	}
+	if len(recipients) == 0 {
+		return
+	}
+	recipient := recipients[0]
+	if recipient.PushEnabled {
+		data := map[string]string{"todo_id": strconv.Itoa(int(todo.ID))}
+		if todo.CreatedAtRecordingID.Valid {
+			data["recording_id"] = strconv.Itoa(int(todo.CreatedAtRecordingID.Int32))
+		}
+		s.pushToUsers(ctx, []int32{recipient.UserID}, notificationSnoozeEnded, push.Notification{Title: "Snoozed todo is back", Body: todo.Name, Data: data})
+	}
+	if recipient.EmailEnabled && recipient.Email.String != "" && s.emailNotificationsEnabled() {
+		link := s.publicURL + "/"
+		if todo.CreatedAtRecordingID.Valid {
+			link = s.recordingURL(todo.CreatedAtRecordingID.Int32)
+		}
+		s.sendSnoozeEndedEmail(ctx, recipient, todo, link)
+	}
+}
+
+// sendSnoozeEndedEmail emails recipient a todo back from its snooze, in
+// the background like sendNotification.
+func (s *Server) sendSnoozeEndedEmail(ctx context.Context, recipient db.ListNotificationRecipientsRow, todo db.WakeSnoozedTodosRow, link string) {
+	locale := i18n.Negotiate(recipient.Locale.String, "")
+	text := i18n.Sprintf(locale, "Hi %s,\n\nA todo assigned to you is back from its snooze:\n\n%s\n", recipient.FirstName, strings.TrimSpace(todo.Name+"\n"+todo.Desc.String))
+	if link != "/" {
+		text += "\n" + link + "\n"
+	}
+	msg := mail.Message{
+		To:      recipient.Email.String,
+		Subject: i18n.Sprintf(locale, "Snooze over: %s", todo.Name),
+		Text:    text,
+	}
+	go func() {
+		if err := s.mailer.Send(context.WithoutCancel(ctx), msg); err != nil {
+			log.Printf("emailing snoozed todo %d to user %d: %v", todo.ID, recipient.UserID, err)
+		}
+	}()
+}
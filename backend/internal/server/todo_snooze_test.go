@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/mail"
+)
+
+// fakeSnoozes keeps todo snoozes by todo, waking them against now.
+type fakeSnoozes struct {
+	todos map[int32]db.WakeSnoozedTodosRow
+	until map[int32]pgtype.Timestamptz
+	now   time.Time
+}
+
+func (f *fakeSnoozes) SnoozeTodo(_ context.Context, arg db.SnoozeTodoParams) (int64, error) {
+	if _, ok := f.todos[arg.ID]; !ok {
+		return 0, nil
+	}
+	f.until[arg.ID] = arg.SnoozedUntil
+	return 1, nil
+}
+
+func (f *fakeSnoozes) WakeSnoozedTodos(context.Context) ([]db.WakeSnoozedTodosRow, error) {
+	var rows []db.WakeSnoozedTodosRow
+	for id, until := range f.until {
+		if until.Valid && !until.Time.After(f.now) {
+			rows = append(rows, f.todos[id])
+			delete(f.until, id)
+		}
+	}
+	return rows, nil
+}
+
+func TestSnoozeTodo(t *testing.T) {
+	snoozes := &fakeSnoozes{
+		todos: map[int32]db.WakeSnoozedTodosRow{
+			3: {ID: 3, Name: "Send the contract", Status: optionalText("todo"), UserID: pgtype.Int4{Int32: 7, Valid: true}},
+			4: {ID: 4, Name: "Book the venue", Status: optionalText("done"), UserID: pgtype.Int4{Int32: 7, Valid: true}},
+		},
+		until: map[int32]pgtype.Timestamptz{},
+	}
+	mailer := fakeMailer{sent: make(chan mail.Message, 2)}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureMail(mailer, "https://secretary.example.com")
+	srv.notifyPrefs = storedPreferences{saved: db.NotificationPreference{UserID: 7, EmailEnabled: true, DigestFrequency: "off"}}
+	srv.snoozes = snoozes
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	snooze := func(id int64, until string) error {
+		_, err := srv.SnoozeTodo(ctx, connect.NewRequest(&secretaryv1.SnoozeTodoRequest{Id: id, Until: until}))
+		return err
+	}
+	tomorrow := time.Now().Add(24 * time.Hour).Format(time.RFC3339)
+	if err := snooze(3, time.Now().Add(-time.Hour).Format(time.RFC3339)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("snoozing into the past: %v", err)
+	}
+	if err := snooze(8, tomorrow); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("unknown todo: %v", err)
+	}
+	for _, id := range []int64{3, 4} {
+		if err := snooze(id, tomorrow); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Nothing wakes before its time.
+	snoozes.now = time.Now()
+	if err := srv.wakeSnoozedTodos(ctx); err != nil || len(snoozes.until) != 2 {
+		t.Fatalf("woke early: %v, %v", snoozes.until, err)
+	}
+
+	// Both wake, but only the open todo's assignee is told.
+	snoozes.now = time.Now().Add(48 * time.Hour)
+	if err := srv.wakeSnoozedTodos(ctx); err != nil || len(snoozes.until) != 0 {
+		t.Fatalf("still snoozed: %v, %v", snoozes.until, err)
+	}
+	select {
+	case msg := <-mailer.sent:
+		if msg.To != "ana@example.com" || !strings.Contains(msg.Subject, "Send the contract") {
+			t.Fatalf("email = %+v", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no email about the woken todo")
+	}
+	select {
+	case msg := <-mailer.sent:
+		t.Fatalf("emailed about a done todo: %+v", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
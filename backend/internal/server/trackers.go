@@ -0,0 +1,320 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/trackers"
+)
+
+const maxWebhookBytes = 1 << 20
+
+// TrackerLinkStore holds the queries linking todos to tracker issues.
+type TrackerLinkStore interface {
+	ListTrackerLinks(ctx context.Context, todoID int32) ([]db.TodoTrackerLink, error)
+	GetTrackerLinkByExternalID(ctx context.Context, arg db.GetTrackerLinkByExternalIDParams) (db.TodoTrackerLink, error)
+	CreateTrackerLink(ctx context.Context, arg db.CreateTrackerLinkParams) (db.TodoTrackerLink, error)
+	MarkTrackerLinkSynced(ctx context.Context, arg db.MarkTrackerLinkSyncedParams) error
+	ListTrackerLinksToPoll(ctx context.Context, arg db.ListTrackerLinksToPollParams) ([]db.TodoTrackerLink, error)
+	MarkTrackerLinkPolled(ctx context.Context, id int32) error
+}
+
+var trackerNames = map[secretaryv1.Tracker]string{
+	secretaryv1.Tracker_TRACKER_GITHUB: trackers.GitHub,
+	secretaryv1.Tracker_TRACKER_LINEAR: trackers.Linear,
+	secretaryv1.Tracker_TRACKER_JIRA:   trackers.Jira,
+}
+
+var trackerLabels = map[string]string{
+	trackers.GitHub: "GitHub",
+	trackers.Linear: "Linear",
+	trackers.Jira:   "Jira",
+}
+
+func trackerFromName(name string) secretaryv1.Tracker {
+	for tracker, n := range trackerNames {
+		if n == name {
+			return tracker
+		}
+	}
+	return secretaryv1.Tracker_TRACKER_UNSPECIFIED
+}
+
+// ConfigureTrackers sets the issue trackers todos can be exported to.
+// Without any, ExportToTracker is unavailable.
+func (s *Server) ConfigureTrackers(list ...trackers.Tracker) {
+	s.issueTrackers = map[string]trackers.Tracker{}
+	for _, tracker := range list {
+		s.issueTrackers[tracker.Name()] = tracker
+	}
+}
+
+func trackerLinkToProto(row db.TodoTrackerLink) *secretaryv1.TrackerLink {
+	return &secretaryv1.TrackerLink{
+		Id:              int64(row.ID),
+		TodoId:          int64(row.TodoID),
+		Tracker:         trackerFromName(row.Tracker),
+		ExternalKey:     row.ExternalKey,
+		Url:             row.Url,
+		ExternalState:   row.ExternalState,
+		CreatedByUserId: int64(row.CreatedByUserID.Int32),
+		CreatedAt:       formatTime(row.CreatedAt),
+		SyncedAt:        formatTime(row.SyncedAt),
+	}
+}
+
+// trackerIssue describes a todo for the tracker, linking back to the
+// meeting it came from when there is one.
+func (s *Server) trackerIssue(todo db.GetTodoRow) trackers.Issue {
+	description := strings.TrimSpace(todo.Desc.String)
+	if todo.CreatedAtRecordingID.Valid && s.publicURL != "" {
+		source := fmt.Sprintf("From the meeting %q: %s/recordings/%d", todo.RecordingName.String, s.publicURL, todo.CreatedAtRecordingID.Int32)
+		description = strings.TrimSpace(description + "\n\n" + source)
+	}
+	return trackers.Issue{Title: todo.Name, Description: description}
+}
+
+// --- TodosService tracker methods ---
+
+func (s *Server) ExportToTracker(ctx context.Context, req *connect.Request[secretaryv1.ExportToTrackerRequest]) (*connect.Response[secretaryv1.ExportToTrackerResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	name := trackerNames[msg.Tracker]
+	tracker, ok := s.tracker(name)
+	if !ok {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s is not configured on this server", name))
+	}
+	todo, err := s.todos.GetTodo(ctx, int32(msg.TodoId))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("todo not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch todo")
+	}
+	// Check before filing so a second click does not open a duplicate
+	// issue that the unique index would then refuse to link.
+	links, err := s.trackerLinks.ListTrackerLinks(ctx, todo.ID)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list tracker links")
+	}
+	for _, link := range links {
+		if link.Tracker == name {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("todo is already linked to %s", link.Url))
+		}
+	}
+
+	created, err := tracker.CreateIssue(ctx, s.trackerIssue(todo))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to create issue")
+	}
+	row, err := s.trackerLinks.CreateTrackerLink(ctx, db.CreateTrackerLinkParams{
+		TodoID:          todo.ID,
+		Tracker:         name,
+		ExternalID:      created.ExternalID,
+		ExternalKey:     created.Key,
+		Url:             created.URL,
+		ExternalState:   created.State,
+		SyncedStatus:    todo.Status.String,
+		CreatedByUserID: pgtype.Int4{Int32: int32(userID), Valid: true},
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to link issue")
+	}
+	return connect.NewResponse(&secretaryv1.ExportToTrackerResponse{Link: trackerLinkToProto(row)}), nil
+}
+
+func (s *Server) ListTrackerLinks(ctx context.Context, req *connect.Request[secretaryv1.ListTrackerLinksRequest]) (*connect.Response[secretaryv1.ListTrackerLinksResponse], error) {
+	rows, err := s.trackerLinks.ListTrackerLinks(ctx, int32(req.Msg.TodoId))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list tracker links")
+	}
+	links := make([]*secretaryv1.TrackerLink, 0, len(rows))
+	for _, row := range rows {
+		links = append(links, trackerLinkToProto(row))
+	}
+	var available []secretaryv1.Tracker
+	for _, tracker := range []secretaryv1.Tracker{secretaryv1.Tracker_TRACKER_GITHUB, secretaryv1.Tracker_TRACKER_LINEAR, secretaryv1.Tracker_TRACKER_JIRA} {
+		if _, ok := s.tracker(trackerNames[tracker]); ok {
+			available = append(available, tracker)
+		}
+	}
+	return connect.NewResponse(&secretaryv1.ListTrackerLinksResponse{Links: links, AvailableTrackers: available}), nil
+}
+
+// handleTrackerWebhook receives issue changes from a tracker. The
+// delivery's signature is the only credential, so no sign-in is needed.
+// Deliveries about issues that did not come from a todo are acknowledged
+// and ignored, since trackers send every issue in a repository or team.
+func (s *Server) handleTrackerWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	tracker, ok := s.tracker(r.PathValue("tracker"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBytes))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	event, ok, err := tracker.ParseWebhook(r.Header, body)
+	if errors.Is(err, trackers.ErrBadSignature) {
+		writeError(w, http.StatusUnauthorized, "invalid signature")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid webhook payload")
+		return
+	}
+	if !ok {
+		writeJSON(w, http.StatusOK, map[string]any{"ok": true})
+		return
+	}
+	link, err := s.trackerLinks.GetTrackerLinkByExternalID(r.Context(), db.GetTrackerLinkByExternalIDParams{
+		Tracker:    tracker.Name(),
+		ExternalID: event.ExternalID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		writeJSON(w, http.StatusOK, map[string]any{"ok": true})
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to fetch tracker link")
+		return
+	}
+	if err := s.syncTrackerStatus(r.Context(), link, event); err != nil {
+		log.Printf("tracker sync: %s issue %s for todo %d: %v", link.Tracker, link.ExternalKey, link.TodoID, err)
+		writeError(w, http.StatusInternalServerError, "failed to update todo")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"ok": true})
+}
+
+// syncTrackerStatus applies an issue's state to the linked todo. A
+// change on the tracker side wins unless the todo's status was also
+// changed here since the two last agreed; that is a conflict, which keeps
+// the todo as it is and leaves a "sync_conflict" entry in its history for
+// someone to resolve. Entries are written without an actor, since nobody
+// here made the change.
+func (s *Server) syncTrackerStatus(ctx context.Context, link db.TodoTrackerLink, event trackers.Event) error {
+	if event.State == link.ExternalState {
+		return nil
+	}
+	qtx, err := s.todos.BeginTodoTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = qtx.Rollback(ctx) }()
+	todo, err := qtx.GetTodo(ctx, link.TodoID)
+	if err != nil {
+		return err
+	}
+	current := todo.Status.String
+	history := db.CreateTodoHistoryParams{
+		TodoID:               todo.ID,
+		ChangeType:           "update",
+		Name:                 pgtype.Text{String: todo.Name, Valid: true},
+		Desc:                 todo.Desc,
+		Status:               todo.Status,
+		UserID:               todo.UserID,
+		CreatedAtRecordingID: todo.CreatedAtRecordingID,
+		UpdatedAtRecordingID: todo.UpdatedAtRecordingID,
+	}
+	agreed := current
+	switch {
+	case event.Status == "" || event.Status == current:
+		history.ChangeType = ""
+	case link.SyncedStatus != "" && current != link.SyncedStatus:
+		history.ChangeType = "sync_conflict"
+		history.Note = pgtype.Text{
+			String: fmt.Sprintf("%s %s moved to %q after this todo was changed here; kept %s.", trackerLabels[link.Tracker], link.ExternalKey, event.State, current),
+			Valid:  true,
+		}
+	default:
+		updated, err := qtx.UpdateTodo(ctx, db.UpdateTodoParams{
+			ID:                   todo.ID,
+			Name:                 todo.Name,
+			Desc:                 todo.Desc,
+			Status:               pgtype.Text{String: event.Status, Valid: true},
+			UserID:               todo.UserID,
+			UpdatedAtRecordingID: todo.UpdatedAtRecordingID,
+			DueAt:                todo.DueAt,
+			Version:              todo.Version,
+		})
+		if err != nil {
+			return err
+		}
+		history.Status = updated.Status
+		agreed = event.Status
+	}
+	if history.ChangeType != "" {
+		if err := qtx.CreateTodoHistory(ctx, history); err != nil {
+			return err
+		}
+	}
+	if err := qtx.Commit(ctx); err != nil {
+		return err
+	}
+	return s.trackerLinks.MarkTrackerLinkSynced(ctx, db.MarkTrackerLinkSyncedParams{
+		ID:            link.ID,
+		ExternalState: event.State,
+		SyncedStatus:  agreed,
+	})
+}
+
+const trackerPollBatch = 100
+
+// pollTrackers checks the links polled longest ago, for setups where the
+// trackers' webhooks cannot reach the server. A failure with one issue,
+// such as one deleted on the tracker, does not hold up the rest.
+func (s *Server) pollTrackers(ctx context.Context) {
+	if len(s.issueTrackers) == 0 {
+		return
+	}
+	names := make([]string, 0, len(s.issueTrackers))
+	for name := range s.issueTrackers {
+		if s.integrationEnabled(name) {
+			names = append(names, name)
+		}
+	}
+	links, err := s.trackerLinks.ListTrackerLinksToPoll(ctx, db.ListTrackerLinksToPollParams{Trackers: names, MaxLinks: trackerPollBatch})
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("tracker sync: list links: %v", err)
+		}
+		return
+	}
+	for _, link := range links {
+		event, err := s.issueTrackers[link.Tracker].IssueState(ctx, trackers.IssueRef{ExternalID: link.ExternalID, Key: link.ExternalKey})
+		if err == nil {
+			err = s.syncTrackerStatus(ctx, link, event)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("tracker sync: %s issue %s for todo %d: %v", link.Tracker, link.ExternalKey, link.TodoID, err)
+		}
+		if err := s.trackerLinks.MarkTrackerLinkPolled(ctx, link.ID); err != nil && ctx.Err() == nil {
+			log.Printf("tracker sync: mark link %d polled: %v", link.ID, err)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/trackers"
+)
+
+// fakeTrackerLinks keeps links in memory.
+type fakeTrackerLinks struct {
+	rows []db.TodoTrackerLink
+}
+
+func (f *fakeTrackerLinks) ListTrackerLinks(_ context.Context, todoID int32) ([]db.TodoTrackerLink, error) {
+	var rows []db.TodoTrackerLink
+	for _, row := range f.rows {
+		if row.TodoID == todoID {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *fakeTrackerLinks) GetTrackerLinkByExternalID(_ context.Context, arg db.GetTrackerLinkByExternalIDParams) (db.TodoTrackerLink, error) {
+	for _, row := range f.rows {
+		if row.Tracker == arg.Tracker && row.ExternalID == arg.ExternalID {
+			return row, nil
+		}
+	}
+	return db.TodoTrackerLink{}, pgx.ErrNoRows
+}
+
+func (f *fakeTrackerLinks) CreateTrackerLink(_ context.Context, arg db.CreateTrackerLinkParams) (db.TodoTrackerLink, error) {
+	row := db.TodoTrackerLink{
+		ID:              int32(len(f.rows) + 1),
+		TodoID:          arg.TodoID,
+		Tracker:         arg.Tracker,
+		ExternalID:      arg.ExternalID,
+		ExternalKey:     arg.ExternalKey,
+		Url:             arg.Url,
+		ExternalState:   arg.ExternalState,
+		SyncedStatus:    arg.SyncedStatus,
+		CreatedByUserID: arg.CreatedByUserID,
+	}
+	f.rows = append(f.rows, row)
+	return row, nil
+}
+
+func (f *fakeTrackerLinks) MarkTrackerLinkSynced(_ context.Context, arg db.MarkTrackerLinkSyncedParams) error {
+	for i := range f.rows {
+		if f.rows[i].ID == arg.ID {
+			f.rows[i].ExternalState = arg.ExternalState
+			f.rows[i].SyncedStatus = arg.SyncedStatus
+			f.rows[i].SyncedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+		}
+	}
+	return nil
+}
+
+func (f *fakeTrackerLinks) ListTrackerLinksToPoll(context.Context, db.ListTrackerLinksToPollParams) ([]db.TodoTrackerLink, error) {
+	return f.rows, nil
+}
+
+func (f *fakeTrackerLinks) MarkTrackerLinkPolled(_ context.Context, id int32) error {
+	for i := range f.rows {
+		if f.rows[i].ID == id {
+			f.rows[i].PolledAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+		}
+	}
+	return nil
+}
+
+// fakeTracker files every issue as ENG-1 and reports state as the
+// issue's current state.
+type fakeTracker struct {
+	trackers.Tracker
+	issues []trackers.Issue
+	state  trackers.Event
+}
+
+func (f *fakeTracker) IssueState(context.Context, trackers.IssueRef) (trackers.Event, error) {
+	return f.state, nil
+}
+
+func (f *fakeTracker) Name() string { return trackers.Linear }
+
+func (f *fakeTracker) CreateIssue(_ context.Context, issue trackers.Issue) (trackers.Created, error) {
+	f.issues = append(f.issues, issue)
+	return trackers.Created{ExternalID: "uuid-1", Key: "ENG-1", URL: "https://linear.app/acme/issue/ENG-1", State: "Todo"}, nil
+}
+
+// trackedTodo holds one todo, serving both the store and its
+// transactions, and the history written for it.
+type trackedTodo struct {
+	TodoTx
+	todo    db.GetTodoRow
+	history []db.CreateTodoHistoryParams
+}
+
+type trackedTodos struct {
+	TodoStore
+	tx *trackedTodo
+}
+
+func (f trackedTodos) GetTodo(ctx context.Context, id int32) (db.GetTodoRow, error) {
+	return f.tx.GetTodo(ctx, id)
+}
+
+func (f trackedTodos) BeginTodoTx(context.Context) (TodoTx, error) { return f.tx, nil }
+
+func (f *trackedTodo) GetTodo(context.Context, int32) (db.GetTodoRow, error) { return f.todo, nil }
+
+func (f *trackedTodo) UpdateTodo(_ context.Context, arg db.UpdateTodoParams) (db.Todo, error) {
+	if arg.Version != f.todo.Version {
+		return db.Todo{}, pgx.ErrNoRows
+	}
+	f.todo.Status = arg.Status
+	f.todo.Version++
+	return db.Todo{ID: arg.ID, Name: arg.Name, Status: arg.Status, UserID: arg.UserID, Version: f.todo.Version}, nil
+}
+
+func (f *trackedTodo) CreateTodoHistory(_ context.Context, arg db.CreateTodoHistoryParams) error {
+	f.history = append(f.history, arg)
+	return nil
+}
+
+func (f *trackedTodo) Commit(context.Context) error   { return nil }
+func (f *trackedTodo) Rollback(context.Context) error { return nil }
+
+func TestExportToTracker(t *testing.T) {
+	todos := trackedTodos{tx: &trackedTodo{todo: db.GetTodoRow{
+		ID:                   7,
+		Name:                 "Book the venue",
+		CreatedAtRecordingID: pgtype.Int4{Int32: 3, Valid: true},
+		RecordingName:        pgtype.Text{String: "Offsite planning", Valid: true},
+	}}}
+	tracker := &fakeTracker{}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, todos, nil)
+	srv.publicURL = "https://secretary.example.com"
+	srv.ConfigureTrackers(tracker)
+	srv.trackerLinks = &fakeTrackerLinks{}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 4})
+	export := func(tracker secretaryv1.Tracker) (*secretaryv1.TrackerLink, error) {
+		resp, err := srv.ExportToTracker(ctx, connect.NewRequest(&secretaryv1.ExportToTrackerRequest{TodoId: 7, Tracker: tracker}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Link, nil
+	}
+
+	link, err := export(secretaryv1.Tracker_TRACKER_LINEAR)
+	if err != nil {
+		t.Fatalf("ExportToTracker: %v", err)
+	}
+	if link.Tracker != secretaryv1.Tracker_TRACKER_LINEAR || link.ExternalKey != "ENG-1" || link.CreatedByUserId != 4 {
+		t.Fatalf("link = %v", link)
+	}
+	if issue := tracker.issues[0]; issue.Title != "Book the venue" || !strings.HasSuffix(issue.Description, "https://secretary.example.com/recordings/3") {
+		t.Fatalf("issue = %+v", issue)
+	}
+
+	if _, err := export(secretaryv1.Tracker_TRACKER_LINEAR); connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Fatalf("second export err = %v, want AlreadyExists", err)
+	}
+	if len(tracker.issues) != 1 {
+		t.Fatalf("filed %d issues, want 1", len(tracker.issues))
+	}
+	if _, err := export(secretaryv1.Tracker_TRACKER_JIRA); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("unconfigured tracker err = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestTrackerWebhookClosesTodo(t *testing.T) {
+	todos := trackedTodos{tx: &trackedTodo{todo: db.GetTodoRow{ID: 7, Name: "Book the venue", Status: pgtype.Text{String: "doing", Valid: true}, Version: 2}}}
+	links := &fakeTrackerLinks{rows: []db.TodoTrackerLink{{ID: 1, TodoID: 7, Tracker: trackers.GitHub, ExternalID: "9001", ExternalKey: "#17"}}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, todos, nil)
+	srv.ConfigureTrackers(trackers.NewGitHub(trackers.GitHubConfig{WebhookSecret: "shh"}))
+	srv.trackerLinks = links
+	deliver := func(body string, secret string) int {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(body))
+		req := httptest.NewRequest(http.MethodPost, "/api/trackers/github/webhook", strings.NewReader(body))
+		req.Header.Set("X-GitHub-Event", "issues")
+		req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+		rec := httptest.NewRecorder()
+		srv.Routes().ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := deliver(`{"action":"closed","issue":{"id":9001,"state":"closed"}}`, "guess"); code != http.StatusUnauthorized {
+		t.Fatalf("forged delivery status = %d", code)
+	}
+	if todos.tx.todo.Status.String != "doing" {
+		t.Fatalf("forged delivery changed the todo")
+	}
+
+	// Issues that were not filed from a todo are acknowledged and ignored.
+	if code := deliver(`{"action":"closed","issue":{"id":1234,"state":"closed"}}`, "shh"); code != http.StatusOK {
+		t.Fatalf("unknown issue status = %d", code)
+	}
+
+	if code := deliver(`{"action":"closed","issue":{"id":9001,"state":"closed"}}`, "shh"); code != http.StatusOK {
+		t.Fatalf("status = %d", code)
+	}
+	if todos.tx.todo.Status.String != "done" || todos.tx.todo.Version != 3 {
+		t.Fatalf("todo = %+v", todos.tx.todo)
+	}
+	if len(todos.tx.history) != 1 || todos.tx.history[0].ActorUserID.Valid || todos.tx.history[0].Status.String != "done" {
+		t.Fatalf("history = %+v", todos.tx.history)
+	}
+	if link := links.rows[0]; link.ExternalState != "closed" || !link.SyncedAt.Valid {
+		t.Fatalf("link = %+v", link)
+	}
+}
+
+func TestTrackerWebhookConflict(t *testing.T) {
+	// The issue and the todo agreed on "doing"; since then someone marked
+	// the todo blocked here.
+	todos := trackedTodos{tx: &trackedTodo{todo: db.GetTodoRow{ID: 7, Name: "Book the venue", Status: pgtype.Text{String: "blocked", Valid: true}, Version: 3}}}
+	links := &fakeTrackerLinks{rows: []db.TodoTrackerLink{{ID: 1, TodoID: 7, Tracker: trackers.GitHub, ExternalID: "9001", ExternalKey: "#17", ExternalState: "open", SyncedStatus: "doing"}}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, todos, nil)
+	srv.trackerLinks = links
+
+	if err := srv.syncTrackerStatus(context.Background(), links.rows[0], trackers.Event{ExternalID: "9001", State: "closed", Status: "done"}); err != nil {
+		t.Fatalf("syncTrackerStatus: %v", err)
+	}
+	if todos.tx.todo.Status.String != "blocked" || todos.tx.todo.Version != 3 {
+		t.Fatalf("conflicting change overwrote the todo: %+v", todos.tx.todo)
+	}
+	if len(todos.tx.history) != 1 || todos.tx.history[0].ChangeType != "sync_conflict" || !strings.Contains(todos.tx.history[0].Note.String, "GitHub #17") {
+		t.Fatalf("history = %+v", todos.tx.history)
+	}
+	// The sides now agree on the todo's status, so the next change on the
+	// tracker applies again.
+	if link := links.rows[0]; link.SyncedStatus != "blocked" || link.ExternalState != "closed" {
+		t.Fatalf("link = %+v", link)
+	}
+}
+
+func TestPollTrackers(t *testing.T) {
+	todos := trackedTodos{tx: &trackedTodo{todo: db.GetTodoRow{ID: 7, Name: "Book the venue", Status: pgtype.Text{String: "todo", Valid: true}, Version: 1}}}
+	links := &fakeTrackerLinks{rows: []db.TodoTrackerLink{{ID: 1, TodoID: 7, Tracker: trackers.Linear, ExternalID: "uuid-1", ExternalKey: "ENG-1", ExternalState: "Todo", SyncedStatus: "todo"}}}
+	tracker := &fakeTracker{state: trackers.Event{ExternalID: "uuid-1", State: "In Progress", Status: "doing"}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, todos, nil)
+	srv.ConfigureTrackers(tracker)
+	srv.trackerLinks = links
+
+	srv.pollTrackers(context.Background())
+	if todos.tx.todo.Status.String != "doing" || len(todos.tx.history) != 1 {
+		t.Fatalf("todo = %+v, history = %+v", todos.tx.todo, todos.tx.history)
+	}
+	if link := links.rows[0]; !link.PolledAt.Valid || link.SyncedStatus != "doing" {
+		t.Fatalf("link = %+v", link)
+	}
+
+	// Polling again without a change on the tracker leaves the todo alone.
+	srv.pollTrackers(context.Background())
+	if len(todos.tx.history) != 1 {
+		t.Fatalf("history = %+v", todos.tx.history)
+	}
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"context"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// lowConfidence is the score below which a transcript line is flagged for
+// review. Clear speech mostly scores above 0.8; crosstalk, mumbling and
+// unfamiliar names fall well below.
+const lowConfidence = 0.6
+
+// setTranscriptSegments replaces the confidence scores stored for a
+// recording's transcript. No scores clears them, so a transcriber that
+// reports none doesn't leave an earlier transcript's behind.
+func setTranscriptSegments(ctx context.Context, q RecordingQueries, id int32, confidences []float32) error {
+	if err := q.DeleteTranscriptSegments(ctx, id); err != nil {
+		return apierr.Wrap(err, "failed to clear transcript confidence")
+	}
+	if len(confidences) == 0 {
+		return nil
+	}
+	if err := q.CreateTranscriptSegments(ctx, db.CreateTranscriptSegmentsParams{RecordingID: id, Confidences: confidences}); err != nil {
+		return apierr.Wrap(err, "failed to store transcript confidence")
+	}
+	return nil
+}
+
+func transcriptSegmentToProto(row db.ListTranscriptSegmentsRow) *secretaryv1.TranscriptSegment {
+	return &secretaryv1.TranscriptSegment{
+		Index:         row.SegmentIndex,
+		Confidence:    row.Confidence,
+		LowConfidence: row.Confidence < lowConfidence,
+	}
+}
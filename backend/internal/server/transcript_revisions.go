@@ -0,0 +1,381 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// Transcript revision sources, as stored in transcript_revision.
+const (
+	transcriptSourceTranscription = "transcription"
+	transcriptSourceRelabel       = "relabel"
+)
+
+// maxDiffEdits bounds the edits diffSequences looks for between two
+// sequences. Past it the parts that differ are treated as replaced
+// outright, which diffTranscripts still pairs line by line.
+const maxDiffEdits = 1000
+
+// recordTranscriptEdit saves after, an edit of the plaintext transcript
+// before, as the recording's next revision. before is saved first when it
+// isn't the latest revision: on the first edit, or when the recording was
+// transcribed again since the last one. The caller holds the row lock.
+func (s *Server) recordTranscriptEdit(ctx context.Context, q RecordingQueries, id int32, before, after, source string, userID int64) error {
+	latest, err := q.GetLatestTranscriptRevision(ctx, id)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return apierr.Wrap(err, "failed to fetch transcript revision")
+	}
+	saved := false
+	if err == nil {
+		plain, err := s.openText(latest.Transcript)
+		if err != nil {
+			return err
+		}
+		saved = plain == before
+	}
+	if !saved {
+		if err := s.createTranscriptRevision(ctx, q, id, before, transcriptSourceTranscription, pgtype.Int4{}); err != nil {
+			return err
+		}
+	}
+	return s.createTranscriptRevision(ctx, q, id, after, source, pgtype.Int4{Int32: int32(userID), Valid: true})
+}
+
+func (s *Server) createTranscriptRevision(ctx context.Context, q RecordingQueries, id int32, plain, source string, userID pgtype.Int4) error {
+	sealed, err := s.sealText(plain)
+	if err != nil {
+		return err
+	}
+	if _, err := q.CreateTranscriptRevision(ctx, db.CreateTranscriptRevisionParams{
+		RecordingID:     id,
+		Transcript:      sealed,
+		Source:          source,
+		CreatedByUserID: userID,
+	}); err != nil {
+		return apierr.Wrap(err, "failed to save transcript revision")
+	}
+	return nil
+}
+
+// transcriptRevisionText returns a revision's plaintext transcript.
+func (s *Server) transcriptRevisionText(ctx context.Context, id, revision int32) (string, error) {
+	row, err := s.recordings.GetTranscriptRevision(ctx, db.GetTranscriptRevisionParams{RecordingID: id, Revision: revision})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", connect.NewError(connect.CodeNotFound, errors.New("transcript revision not found"))
+	}
+	if err != nil {
+		return "", apierr.Wrap(err, "failed to fetch transcript revision")
+	}
+	return s.openText(row.Transcript)
+}
+
+// editOp is one step of an edit script turning one sequence into another.
+type editOp int8
+
+const (
+	editEqual editOp = iota
+	editInsert
+	editDelete
+)
+
+// diffSequences returns the shortest edit script turning a into b, with
+// Myers' algorithm. Deletions come before the insertions next to them.
+func diffSequences[T comparable](a, b []T) []editOp {
+	// The common ends are cheap to match and usually most of an edited
+	// transcript.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	ops := make([]editOp, 0, len(a)+len(b))
+	for range prefix {
+		ops = append(ops, editEqual)
+	}
+	ops = append(ops, myersDiff(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for range suffix {
+		ops = append(ops, editEqual)
+	}
+	// Put the deletions of each run of changes first.
+	for start := 0; start < len(ops); {
+		if ops[start] == editEqual {
+			start++
+			continue
+		}
+		end, deletions := start, 0
+		for ; end < len(ops) && ops[end] != editEqual; end++ {
+			if ops[end] == editDelete {
+				deletions++
+			}
+		}
+		for i := start; i < end; i++ {
+			ops[i] = editInsert
+			if i < start+deletions {
+				ops[i] = editDelete
+			}
+		}
+		start = end
+	}
+	return ops
+}
+
+func myersDiff[T comparable](a, b []T) []editOp {
+	n, m := len(a), len(b)
+	limit := min(n+m, maxDiffEdits)
+	// v[off+k] is the furthest x reached on diagonal k = x-y. trace[d]
+	// keeps diagonals -d..d of v as they were before round d, for the
+	// walk back.
+	off := limit + 1
+	v := make([]int, 2*limit+3)
+	var trace [][]int
+	for d := 0; d <= limit; d++ {
+		trace = append(trace, slices.Clone(v[off-d:off+d+1]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[off+k-1] < v[off+k+1]) {
+				x = v[off+k+1]
+			} else {
+				x = v[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[off+k] = x
+			if x >= n && y >= m {
+				return myersPath(trace, n, m)
+			}
+		}
+	}
+	ops := make([]editOp, 0, n+m)
+	for range n {
+		ops = append(ops, editDelete)
+	}
+	for range m {
+		ops = append(ops, editInsert)
+	}
+	return ops
+}
+
+// myersPath walks trace back from (n, m) to the start, returning the edit
+// script in order.
+func myersPath(trace [][]int, n, m int) []editOp {
+	var ops []editOp
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		// before holds diagonals -d..d as round d found them.
+		before := trace[d]
+		at := func(k int) int { return before[k+d] }
+		k := x - y
+		prev := k - 1
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prev = k + 1
+		}
+		prevX := at(prev)
+		prevY := prevX - prev
+		for x > prevX && y > prevY {
+			ops = append(ops, editEqual)
+			x--
+			y--
+		}
+		if prev == k+1 {
+			ops = append(ops, editInsert)
+		} else {
+			ops = append(ops, editDelete)
+		}
+		x, y = prevX, prevY
+	}
+	for ; x > 0; x-- {
+		ops = append(ops, editEqual)
+	}
+	slices.Reverse(ops)
+	return ops
+}
+
+// revisionLine is what a transcript line is compared by.
+type revisionLine struct {
+	speaker int32
+	text    string
+}
+
+func revisionLines(transcript string) []revisionLine {
+	if strings.TrimSpace(transcript) == "" {
+		return nil
+	}
+	parsed := transcriptLines(transcript, 0)
+	lines := make([]revisionLine, len(parsed))
+	for i, line := range parsed {
+		lines[i] = revisionLine{speaker: line.SpeakerId, text: line.Text}
+	}
+	return lines
+}
+
+// transcriptDiff is the diff between two transcripts: every line, and
+// totals over them.
+type transcriptDiff struct {
+	segments []*secretaryv1.TranscriptDiffSegment
+	changed  int
+	inserted int
+	deleted  int
+}
+
+// diffTranscripts compares two plaintext transcripts line by line. Lines
+// replaced by others are paired in order, and their words compared.
+func diffTranscripts(older, newer string) transcriptDiff {
+	a, b := revisionLines(older), revisionLines(newer)
+	var diff transcriptDiff
+	var deleted, inserted []int
+	flush := func() {
+		for len(deleted) > 0 || len(inserted) > 0 {
+			from, to := -1, -1
+			segment := &secretaryv1.TranscriptDiffSegment{FromIndex: -1, ToIndex: -1, FromSpeakerId: -1, ToSpeakerId: -1}
+			if len(deleted) > 0 {
+				from, deleted = deleted[0], deleted[1:]
+				segment.FromIndex, segment.FromSpeakerId = int32(from), a[from].speaker
+			}
+			if len(inserted) > 0 {
+				to, inserted = inserted[0], inserted[1:]
+				segment.ToIndex, segment.ToSpeakerId = int32(to), b[to].speaker
+			}
+			var before, after string
+			if from >= 0 {
+				before = a[from].text
+			}
+			if to >= 0 {
+				after = b[to].text
+			}
+			diff.add(segment, before, after)
+		}
+	}
+	i, j := 0, 0
+	for _, op := range diffSequences(a, b) {
+		switch op {
+		case editEqual:
+			flush()
+			segment := &secretaryv1.TranscriptDiffSegment{FromIndex: int32(i), ToIndex: int32(j), FromSpeakerId: a[i].speaker, ToSpeakerId: b[j].speaker}
+			diff.add(segment, a[i].text, b[j].text)
+			i++
+			j++
+		case editDelete:
+			deleted = append(deleted, i)
+			i++
+		case editInsert:
+			inserted = append(inserted, j)
+			j++
+		}
+	}
+	flush()
+	return diff
+}
+
+// add compares the words of a line before and after, and adds it to the
+// diff.
+func (d *transcriptDiff) add(segment *secretaryv1.TranscriptDiffSegment, before, after string) {
+	a, b := strings.Fields(before), strings.Fields(after)
+	i, j := 0, 0
+	for _, op := range diffSequences(a, b) {
+		var word string
+		var kind secretaryv1.TranscriptDiffOp
+		switch op {
+		case editEqual:
+			word, kind = a[i], secretaryv1.TranscriptDiffOp_TRANSCRIPT_DIFF_OP_EQUAL
+			i++
+			j++
+		case editDelete:
+			word, kind = a[i], secretaryv1.TranscriptDiffOp_TRANSCRIPT_DIFF_OP_DELETE
+			i++
+			d.deleted++
+			segment.Changed = true
+		case editInsert:
+			word, kind = b[j], secretaryv1.TranscriptDiffOp_TRANSCRIPT_DIFF_OP_INSERT
+			j++
+			d.inserted++
+			segment.Changed = true
+		}
+		if n := len(segment.Spans); n > 0 && segment.Spans[n-1].Op == kind {
+			segment.Spans[n-1].Text += " " + word
+			continue
+		}
+		segment.Spans = append(segment.Spans, &secretaryv1.TranscriptDiffSpan{Op: kind, Text: word})
+	}
+	if segment.FromIndex < 0 || segment.ToIndex < 0 || segment.FromSpeakerId != segment.ToSpeakerId {
+		segment.Changed = true
+	}
+	if segment.Changed {
+		d.changed++
+	}
+	d.segments = append(d.segments, segment)
+}
+
+// --- RecordingsService transcript revision methods ---
+
+func (s *Server) ListTranscriptRevisions(ctx context.Context, req *connect.Request[secretaryv1.ListTranscriptRevisionsRequest]) (*connect.Response[secretaryv1.ListTranscriptRevisionsResponse], error) {
+	recordingID := req.Msg.RecordingId
+	rows, err := s.recordings.ListTranscriptRevisions(ctx, int32(recordingID))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list transcript revisions")
+	}
+	revisions := make([]*secretaryv1.TranscriptRevision, 0, len(rows))
+	for _, row := range rows {
+		revisions = append(revisions, &secretaryv1.TranscriptRevision{
+			RecordingId:     recordingID,
+			Revision:        row.Revision,
+			Source:          row.Source,
+			CreatedByUserId: int64(row.CreatedByUserID.Int32),
+			CreatedAt:       formatTime(row.CreatedAt),
+		})
+	}
+	return connect.NewResponse(&secretaryv1.ListTranscriptRevisionsResponse{Revisions: revisions}), nil
+}
+
+func (s *Server) GetTranscriptRevisionDiff(ctx context.Context, req *connect.Request[secretaryv1.GetTranscriptRevisionDiffRequest]) (*connect.Response[secretaryv1.GetTranscriptRevisionDiffResponse], error) {
+	msg := req.Msg
+	id := int32(msg.RecordingId)
+	to := msg.ToRevision
+	if to == 0 {
+		latest, err := s.recordings.GetLatestTranscriptRevision(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("transcript has no revisions"))
+		}
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to fetch transcript revision")
+		}
+		to = latest.Revision
+	}
+	older, err := s.transcriptRevisionText(ctx, id, msg.FromRevision)
+	if err != nil {
+		return nil, err
+	}
+	newer, err := s.transcriptRevisionText(ctx, id, to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := diffTranscripts(older, newer)
+	segments := diff.segments
+	if msg.ChangesOnly {
+		segments = slices.DeleteFunc(segments, func(segment *secretaryv1.TranscriptDiffSegment) bool { return !segment.Changed })
+	}
+	return connect.NewResponse(&secretaryv1.GetTranscriptRevisionDiffResponse{
+		FromRevision:        msg.FromRevision,
+		ToRevision:          to,
+		Segments:            segments,
+		ChangedSegmentCount: int32(diff.changed),
+		InsertedWordCount:   int32(diff.inserted),
+		DeletedWordCount:    int32(diff.deleted),
+	}), nil
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// lcsLength is the length of the longest common subsequence of a and b,
+// which a shortest edit script keeps.
+func lcsLength(a, b []byte) int {
+	row := make([]int, len(b)+1)
+	for i := range a {
+		diagonal := 0
+		for j := range b {
+			next := row[j+1]
+			if a[i] == b[j] {
+				row[j+1] = diagonal + 1
+			} else {
+				row[j+1] = max(row[j+1], row[j])
+			}
+			diagonal = next
+		}
+	}
+	return row[len(b)]
+}
+
+// applyScript replays ops on a, failing t unless it gives b or puts an
+// insertion before a deletion, and returns how many elements it kept.
+func applyScript(t *testing.T, a, b []byte, ops []editOp) int {
+	t.Helper()
+	var got []byte
+	i, kept := 0, 0
+	for n, op := range ops {
+		switch op {
+		case editEqual:
+			got = append(got, a[i])
+			i++
+			kept++
+		case editDelete:
+			if n > 0 && ops[n-1] == editInsert {
+				t.Fatalf("%q -> %q: insertion before a deletion in %v", a, b, ops)
+			}
+			i++
+		case editInsert:
+			got = append(got, b[len(got)])
+		}
+	}
+	if i != len(a) || string(got) != string(b) {
+		t.Fatalf("%q -> %q: script %v gives %q", a, b, ops, got)
+	}
+	return kept
+}
+
+func TestDiffSequences(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	random := func() []byte {
+		out := make([]byte, rng.IntN(12))
+		for i := range out {
+			out[i] = "abc"[rng.IntN(3)]
+		}
+		return out
+	}
+	for range 2000 {
+		a, b := random(), random()
+		if kept, want := applyScript(t, a, b, diffSequences(a, b)), lcsLength(a, b); kept != want {
+			t.Fatalf("%q -> %q: kept %d, want %d", a, b, kept, want)
+		}
+	}
+
+	// Too many edits to look for: the parts that differ are replaced
+	// whole, but the script still turns a into b.
+	long := func() []byte {
+		out := make([]byte, 3*maxDiffEdits)
+		for i := range out {
+			out[i] = "ab"[rng.IntN(2)]
+		}
+		return out
+	}
+	a, b := long(), long()
+	if kept := applyScript(t, a, b, diffSequences(a, b)); kept >= lcsLength(a, b) {
+		t.Fatalf("kept %d: the edit limit wasn't reached", kept)
+	}
+}
+
+// spans renders a segment's spans as "=kept -deleted +inserted".
+func spans(segment *secretaryv1.TranscriptDiffSegment) string {
+	var parts []string
+	for _, span := range segment.Spans {
+		mark := map[secretaryv1.TranscriptDiffOp]string{
+			secretaryv1.TranscriptDiffOp_TRANSCRIPT_DIFF_OP_EQUAL:  "=",
+			secretaryv1.TranscriptDiffOp_TRANSCRIPT_DIFF_OP_DELETE: "-",
+			secretaryv1.TranscriptDiffOp_TRANSCRIPT_DIFF_OP_INSERT: "+",
+		}[span.Op]
+		parts = append(parts, mark+span.Text)
+	}
+	return fmt.Sprintf("%d>%d %d>%d %s", segment.FromIndex, segment.ToIndex, segment.FromSpeakerId, segment.ToSpeakerId, strings.Join(parts, " "))
+}
+
+func TestDiffTranscripts(t *testing.T) {
+	older := "Speaker 0: Welcome everyone.\nSpeaker 1: The budget is fine.\nSpeaker 2: Sorry, me again.\nSpeaker 0: Next item."
+	newer := "Speaker 0: Welcome everyone.\nSpeaker 1: The budget is approved.\nSpeaker 1: Sorry, me again.\nSpeaker 0: Next item.\nSpeaker 1: Bye."
+	diff := diffTranscripts(older, newer)
+	var got []string
+	for _, segment := range diff.segments {
+		got = append(got, spans(segment))
+	}
+	want := []string{
+		"0>0 0>0 =Welcome everyone.",
+		"1>1 1>1 =The budget is -fine. +approved.",
+		"2>2 2>1 =Sorry, me again.",
+		"3>3 0>0 =Next item.",
+		"-1>4 -1>1 +Bye.",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("segments:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if diff.changed != 3 || diff.inserted != 2 || diff.deleted != 1 {
+		t.Fatalf("changed %d, inserted %d, deleted %d", diff.changed, diff.inserted, diff.deleted)
+	}
+
+	if diff := diffTranscripts("Speaker 0: Gone.", ""); len(diff.segments) != 1 || spans(diff.segments[0]) != "0>-1 0>-1 -Gone." {
+		t.Fatalf("deleted transcript: %v", diff.segments)
+	}
+}
+
+func TestGetTranscriptRevisionDiff(t *testing.T) {
+	store := &labeledSpeakers{
+		fakeTranslations: &fakeTranslations{
+			fakeRecordingStatus: &fakeRecordingStatus{status: recordingSummarizing},
+			transcript:          "Speaker 0: Welcome.\nSpeaker 1: Thanks.\nSpeaker 2: Me again.",
+		},
+		participants: []db.ListRecordingParticipantsRow{{ID: 8, FirstName: "Ana", SpeakerID: 1}},
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(store, nil, memberUsers{})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+	diff := func(from, to int32) (*secretaryv1.GetTranscriptRevisionDiffResponse, error) {
+		resp, err := srv.GetTranscriptRevisionDiff(ctx, connect.NewRequest(&secretaryv1.GetTranscriptRevisionDiffRequest{RecordingId: 3, FromRevision: from, ToRevision: to, ChangesOnly: true}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+
+	if _, err := diff(1, 0); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("unedited transcript: %v", err)
+	}
+	if _, err := srv.RelabelSpeaker(ctx, connect.NewRequest(&secretaryv1.RelabelSpeakerRequest{RecordingId: 3, SpeakerId: 2, UserId: 8})); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := diff(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ToRevision != 2 || resp.ChangedSegmentCount != 1 || len(resp.Segments) != 1 || spans(resp.Segments[0]) != "2>2 2>1 =Me again." {
+		t.Fatalf("diff = %v", resp)
+	}
+
+	// Transcribed again since: the new transcript is kept before the next
+	// edit, so the edit's diff shows only the edit.
+	store.transcript = "Speaker 0: Welcome all.\nSpeaker 1: Thanks.\nSpeaker 2: Me again."
+	if _, err := srv.RelabelSpeaker(ctx, connect.NewRequest(&secretaryv1.RelabelSpeakerRequest{RecordingId: 3, SpeakerId: 2, UserId: 8})); err != nil {
+		t.Fatal(err)
+	}
+	list, err := srv.ListTranscriptRevisions(ctx, connect.NewRequest(&secretaryv1.ListTranscriptRevisionsRequest{RecordingId: 3}))
+	if err != nil || len(list.Msg.Revisions) != 4 || list.Msg.Revisions[1].Source != transcriptSourceTranscription || list.Msg.Revisions[0].CreatedByUserId != 5 {
+		t.Fatalf("revisions = %v, %v", list, err)
+	}
+	if resp, err := diff(3, 4); err != nil || len(resp.Segments) != 1 || resp.InsertedWordCount != 0 {
+		t.Fatalf("diff = %v, %v", resp, err)
+	}
+	if resp, err := diff(2, 3); err != nil || len(resp.Segments) != 2 || spans(resp.Segments[0]) != "0>0 0>0 -Welcome. +Welcome all." {
+		t.Fatalf("diff = %v, %v", resp, err)
+	}
+	if _, err := diff(9, 0); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("unknown revision: %v", err)
+	}
+}
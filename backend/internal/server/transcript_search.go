@@ -0,0 +1,265 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+const (
+	defaultTranscriptMatches = 100
+	defaultTranscriptPage    = 200
+)
+
+// transcriptSearch is the result of searching a transcript: the lines to
+// return, and how many matched in all.
+type transcriptSearch struct {
+	lines   []*secretaryv1.TranscriptLine
+	matches int
+	total   int
+}
+
+// transcriptLines splits a transcript into lines without their speaker
+// labels. Offsets are estimated the way transcriptExcerpt maps offsets to
+// words.
+func transcriptLines(transcript string, durationSeconds int32) []*secretaryv1.TranscriptLine {
+	raw := strings.Split(transcript, "\n")
+	// Word offsets count speaker labels, as transcriptExcerpt does.
+	ends := make([]int64, len(raw))
+	var words int64
+	for i, line := range raw {
+		words += int64(len(strings.Fields(line)))
+		ends[i] = words
+	}
+	offset := func(word int64) int32 {
+		if words == 0 || durationSeconds <= 0 {
+			return 0
+		}
+		return int32(word * int64(durationSeconds) * 1000 / words)
+	}
+
+	lines := make([]*secretaryv1.TranscriptLine, len(raw))
+	for i, line := range raw {
+		out := &secretaryv1.TranscriptLine{Index: int32(i), SpeakerId: -1, EndMs: offset(ends[i])}
+		if i > 0 {
+			out.StartMs = offset(ends[i-1])
+		}
+		if label := speakerLinePattern.FindStringSubmatch(line); label != nil {
+			if n, err := strconv.Atoi(label[1]); err == nil {
+				out.SpeakerId = int32(n)
+			}
+			line = line[len(label[0]):]
+		}
+		out.Text = strings.TrimSpace(line)
+		lines[i] = out
+	}
+	return lines
+}
+
+// searchTranscript finds the lines containing query, ignoring case and
+// accents, and returns them with contextLines lines on either side.
+func searchTranscript(transcript string, durationSeconds int32, query string, contextLines, maxMatches int) transcriptSearch {
+	lines := transcriptLines(transcript, durationSeconds)
+	result := transcriptSearch{total: len(lines)}
+	query = foldName(strings.TrimSpace(query))
+	included := make([]bool, len(lines))
+	for i, line := range lines {
+		if query != "" && !strings.Contains(foldName(line.Text), query) {
+			continue
+		}
+		result.matches++
+		if result.matches > maxMatches && query != "" {
+			continue
+		}
+		line.Match = query != ""
+		for j := max(i-contextLines, 0); j <= min(i+contextLines, len(lines)-1); j++ {
+			included[j] = true
+		}
+	}
+	for i, line := range lines {
+		if included[i] {
+			result.lines = append(result.lines, line)
+		}
+	}
+	return result
+}
+
+// GetRecordingTranscript searches a recording's transcript on the server,
+// for clients that shouldn't have to load a long meeting to find a line.
+func (s *Server) GetRecordingTranscript(ctx context.Context, req *connect.Request[secretaryv1.GetRecordingTranscriptRequest]) (*connect.Response[secretaryv1.GetRecordingTranscriptResponse], error) {
+	if _, err := requireUserID(ctx); err != nil {
+		return nil, err
+	}
+	row, err := s.recordings.GetRecording(ctx, int32(req.Msg.Id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch recording")
+	}
+	transcript, err := s.openText(row.Transcript.String)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(transcript) == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("recording has no transcript yet"))
+	}
+	maxMatches := int(req.Msg.MaxMatches)
+	if maxMatches == 0 {
+		maxMatches = defaultTranscriptMatches
+	}
+	found := searchTranscript(transcript, row.Duration.Int32, req.Msg.Query, int(req.Msg.ContextLines), maxMatches)
+	return connect.NewResponse(&secretaryv1.GetRecordingTranscriptResponse{
+		Lines:      found.lines,
+		MatchCount: int32(found.matches),
+		LineCount:  int32(found.total),
+	}), nil
+}
+
+// speakerWordLabel matches the labels the web client used to count
+// speaking time from: "Speaker 2:", "SPEAKER_02:" or a name and a colon.
+var speakerWordLabel = regexp.MustCompile(`^(?i:speaker|SPEAKER_)\s*0*(\d+):\s+(.+)$|^([^:]+):\s+(.+)$`)
+
+// speakerWordCounts counts the words each speaker said, in order of their
+// first line.
+func speakerWordCounts(transcript string) []*secretaryv1.SpeakerWords {
+	var counts []*secretaryv1.SpeakerWords
+	byLabel := map[string]*secretaryv1.SpeakerWords{}
+	for _, line := range strings.Split(transcript, "\n") {
+		m := speakerWordLabel.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		key, count := "", &secretaryv1.SpeakerWords{SpeakerId: -1}
+		if m[1] != "" {
+			n, err := strconv.Atoi(m[1])
+			if err != nil {
+				continue
+			}
+			key, count.SpeakerId = "id:"+m[1], int32(n)
+		} else {
+			count.Name = strings.TrimSpace(m[3])
+			key = "name:" + strings.ToLower(count.Name)
+		}
+		text := m[2] + m[4]
+		if existing, ok := byLabel[key]; ok {
+			count = existing
+		} else {
+			byLabel[key] = count
+			counts = append(counts, count)
+		}
+		count.Words += int32(len(strings.Fields(text)))
+	}
+	return counts
+}
+
+// omitTranscript leaves the transcript's text out of a recording.
+func omitTranscript(rec *secretaryv1.Recording) {
+	rec.Transcript = ""
+	for _, translation := range rec.Translations {
+		if translation.Kind == secretaryv1.TranslationKind_TRANSLATION_KIND_TRANSCRIPT {
+			translation.Text = ""
+		}
+	}
+}
+
+// transcriptPageToken encodes the index of the first line of the next
+// page.
+func transcriptPageToken(index int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("line|" + strconv.Itoa(index)))
+}
+
+func parseTranscriptPageToken(token string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	index, ok := strings.CutPrefix(string(raw), "line|")
+	if !ok {
+		return 0, errors.New("missing line prefix")
+	}
+	n, err := strconv.Atoi(index)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("bad line index %q", index)
+	}
+	return n, nil
+}
+
+// ListTranscriptSegments pages through a transcript, so clients showing a
+// long meeting needn't load all of it with the recording.
+func (s *Server) ListTranscriptSegments(ctx context.Context, req *connect.Request[secretaryv1.ListTranscriptSegmentsRequest]) (*connect.Response[secretaryv1.ListTranscriptSegmentsResponse], error) {
+	if _, err := requireUserID(ctx); err != nil {
+		return nil, err
+	}
+	msg := req.Msg
+	start := 0
+	if msg.PageToken != "" {
+		parsed, err := parseTranscriptPageToken(msg.PageToken)
+		if err != nil {
+			return nil, apierr.InvalidField("page_token", "is not a token returned by ListTranscriptSegments")
+		}
+		start = parsed
+	}
+	lang, err := parseTargetLanguage(msg.Language)
+	if err != nil {
+		return nil, apierr.InvalidField("language", "must be a BCP 47 language tag such as \"es\" or \"pt-BR\"")
+	}
+	limit := int(msg.Limit)
+	if limit == 0 {
+		limit = defaultTranscriptPage
+	}
+
+	id := int32(msg.RecordingId)
+	row, err := s.recordings.GetRecording(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("recording not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch recording")
+	}
+	text := row.Transcript.String
+	if lang != "" {
+		translations, err := s.recordings.ListRecordingTranslations(ctx, id)
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to fetch translations")
+		}
+		i := slices.IndexFunc(translations, func(t db.ListRecordingTranslationsRow) bool {
+			return t.Kind == translationTranscript && t.Language == lang
+		})
+		if i < 0 {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("transcript has not been translated into %s", lang))
+		}
+		text = translations[i].Text
+	}
+	transcript, err := s.openText(text)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &secretaryv1.ListTranscriptSegmentsResponse{}
+	if strings.TrimSpace(transcript) == "" {
+		return connect.NewResponse(resp), nil
+	}
+	lines := transcriptLines(transcript, row.Duration.Int32)
+	resp.LineCount = int32(len(lines))
+	if start < len(lines) {
+		end := min(start+limit, len(lines))
+		resp.Lines = lines[start:end]
+		if end < len(lines) {
+			resp.NextPageToken = transcriptPageToken(end)
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
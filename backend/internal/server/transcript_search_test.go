@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+func TestSearchTranscript(t *testing.T) {
+	// Five lines of five words each, labels included, over 20 seconds.
+	transcript := "Speaker 0: budget first please\nSpeaker 1: the presupuésto grew\nSpeaker 0: moving on now\nSpeaker 1: coffee anyone here\nSpeaker 0: BUDGET again sorry"
+
+	found := searchTranscript(transcript, 20, "Presupuesto", 1, 100)
+	if found.matches != 1 || found.total != 5 || len(found.lines) != 3 {
+		t.Fatalf("search = %+v", found)
+	}
+	line := found.lines[1]
+	if line.Index != 1 || !line.Match || line.SpeakerId != 1 || line.Text != "the presupuésto grew" || line.StartMs != 4000 || line.EndMs != 8000 {
+		t.Fatalf("match = %v", line)
+	}
+	if found.lines[0].Match || found.lines[0].Index != 0 || found.lines[0].StartMs != 0 {
+		t.Fatalf("context = %v", found.lines[0])
+	}
+
+	// Context shared by two matches is returned once.
+	found = searchTranscript(transcript, 20, "budget", 2, 100)
+	if found.matches != 2 || len(found.lines) != 5 || !found.lines[4].Match || found.lines[2].Match {
+		t.Fatalf("overlapping context = %+v", found.lines)
+	}
+
+	// Matches past the limit are counted but not returned.
+	found = searchTranscript(transcript, 20, "budget", 0, 1)
+	if found.matches != 2 || len(found.lines) != 1 || found.lines[0].Index != 0 {
+		t.Fatalf("limited = %+v", found)
+	}
+
+	// Without a duration there are no offsets; without a query every line
+	// comes back.
+	found = searchTranscript(transcript, 0, "", 0, 1)
+	if len(found.lines) != 5 || found.lines[3].EndMs != 0 || found.lines[3].Text != "coffee anyone here" {
+		t.Fatalf("whole transcript = %+v", found.lines)
+	}
+}
+
+func TestGetRecordingTranscriptWithoutTranscript(t *testing.T) {
+	store := &fakeTranslations{fakeRecordingStatus: &fakeRecordingStatus{status: recordingReady}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(store, nil, nil)
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	req := &secretaryv1.GetRecordingTranscriptRequest{Id: 3, Query: "budget"}
+	if _, err := srv.GetRecordingTranscript(ctx, connect.NewRequest(req)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("no transcript: %v", err)
+	}
+	store.transcript = "Speaker 0: the budget\nSpeaker 1: ok"
+	resp, err := srv.GetRecordingTranscript(ctx, connect.NewRequest(req))
+	if err != nil || resp.Msg.MatchCount != 1 || resp.Msg.LineCount != 2 || len(resp.Msg.Lines) != 1 {
+		t.Fatalf("search: %v, %v", resp, err)
+	}
+}
+
+func TestSpeakerWordCounts(t *testing.T) {
+	transcript := "SPEAKER_02: good morning all\nSpeaker 2: again\nAna: hi there\n(silence)\nana: bye"
+	counts := speakerWordCounts(transcript)
+	if len(counts) != 2 || counts[0].SpeakerId != 2 || counts[0].Words != 4 || counts[1].Name != "Ana" || counts[1].SpeakerId != -1 || counts[1].Words != 3 {
+		t.Fatalf("counts = %v", counts)
+	}
+}
+
+func TestListTranscriptSegments(t *testing.T) {
+	store := &fakeTranslations{
+		fakeRecordingStatus: &fakeRecordingStatus{status: recordingReady},
+		transcript:          "Speaker 0: one\nSpeaker 1: two\nSpeaker 0: three\nSpeaker 1: four\nSpeaker 0: five",
+		translations:        []db.ListRecordingTranslationsRow{{Kind: translationTranscript, Language: "es", Text: "Speaker 0: uno\nSpeaker 1: dos"}},
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(store, nil, nil)
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+	list := func(msg *secretaryv1.ListTranscriptSegmentsRequest) (*secretaryv1.ListTranscriptSegmentsResponse, error) {
+		msg.RecordingId = 3
+		resp, err := srv.ListTranscriptSegments(ctx, connect.NewRequest(msg))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+
+	var texts []string
+	token := ""
+	for pages := 0; ; pages++ {
+		page, err := list(&secretaryv1.ListTranscriptSegmentsRequest{Limit: 2, PageToken: token})
+		if err != nil || page.LineCount != 5 || pages > 3 {
+			t.Fatalf("page %d: %v, %v", pages, page, err)
+		}
+		for _, line := range page.Lines {
+			texts = append(texts, line.Text)
+		}
+		if token = page.NextPageToken; token == "" {
+			break
+		}
+	}
+	if !slices.Equal(texts, []string{"one", "two", "three", "four", "five"}) {
+		t.Fatalf("lines = %q", texts)
+	}
+
+	translated, err := list(&secretaryv1.ListTranscriptSegmentsRequest{Language: "ES"})
+	if err != nil || translated.LineCount != 2 || translated.Lines[1].Text != "dos" || translated.NextPageToken != "" {
+		t.Fatalf("translation: %v, %v", translated, err)
+	}
+	if _, err := list(&secretaryv1.ListTranscriptSegmentsRequest{Language: "de"}); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("missing translation: %v", err)
+	}
+	if _, err := list(&secretaryv1.ListTranscriptSegmentsRequest{PageToken: "bm9wZQ"}); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("bad token: %v", err)
+	}
+}
+
+func TestOmitTranscript(t *testing.T) {
+	rec := &secretaryv1.Recording{
+		Transcript: "Speaker 0: hola",
+		Translations: []*secretaryv1.RecordingTranslation{
+			{Kind: secretaryv1.TranslationKind_TRANSLATION_KIND_TRANSCRIPT, Language: "en", Text: "Speaker 0: hello"},
+			{Kind: secretaryv1.TranslationKind_TRANSLATION_KIND_SUMMARY, Language: "en", Text: "Greetings."},
+		},
+	}
+	omitTranscript(rec)
+	if rec.Transcript != "" || rec.Translations[0].Text != "" || rec.Translations[0].Language != "en" || rec.Translations[1].Text != "Greetings." {
+		t.Fatalf("recording = %v", rec)
+	}
+}
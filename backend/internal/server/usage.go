@@ -0,0 +1,203 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// Usage is counted per user and for the instance as a whole, which is the
+// organization quotas apply to. Transcribed minutes and LLM tokens are
+// events in usage_event; storage and recordings are counted from the
+// recording table.
+const (
+	usageTranscriptionSeconds = "transcription_seconds"
+	usageLLMTokens            = "llm_tokens"
+	usageStorageBytes         = "storage_bytes"
+	usageRecordings           = "recordings"
+)
+
+// UsageStore holds the usage accounting queries.
+type UsageStore interface {
+	CreateUsageEvent(ctx context.Context, arg db.CreateUsageEventParams) error
+	GetRecordingUsageOwner(ctx context.Context, id int32) (db.GetRecordingUsageOwnerRow, error)
+	SumUsage(ctx context.Context, arg db.SumUsageParams) ([]db.SumUsageRow, error)
+	SumUsageByUser(ctx context.Context, arg db.SumUsageByUserParams) ([]db.SumUsageByUserRow, error)
+	SumStorageBytes(ctx context.Context, userID pgtype.Int4) (int64, error)
+	SumStorageBytesByUser(ctx context.Context) ([]db.SumStorageBytesByUserRow, error)
+	SumProviderCost(ctx context.Context, arg db.SumProviderCostParams) (int64, error)
+	CountRecordings(ctx context.Context, userID pgtype.Int4) (int64, error)
+	CountRecordingsByUser(ctx context.Context) ([]db.CountRecordingsByUserRow, error)
+	ListQuotaOverrides(ctx context.Context) ([]db.QuotaOverride, error)
+	UpsertQuotaOverride(ctx context.Context, arg db.UpsertQuotaOverrideParams) error
+	DeleteQuotaOverride(ctx context.Context, arg db.DeleteQuotaOverrideParams) (int64, error)
+}
+
+// monthBounds returns the calendar month (UTC) containing now.
+func monthBounds(now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// recordTokenUsage logs tokens spent on the user's behalf. Accounting
+// failures are logged rather than failing work that already happened.
+func (s *Server) recordTokenUsage(ctx context.Context, userID int64, tokens int64) {
+	if tokens <= 0 {
+		return
+	}
+	if err := s.usage.CreateUsageEvent(ctx, db.CreateUsageEventParams{
+		UserID:   pgtype.Int4{Int32: int32(userID), Valid: true},
+		Metric:   usageLLMTokens,
+		Quantity: tokens,
+	}); err != nil {
+		log.Printf("usage: failed to record %d tokens for user %d: %v", tokens, userID, err)
+	}
+}
+
+// --- AnalyticsService Implementation ---
+
+// GetUsage reports usage over a period, the current month by default.
+// Admins see the whole organization with a per-user breakdown; everyone
+// else sees only their own usage.
+func (s *Server) GetUsage(ctx context.Context, req *connect.Request[secretaryv1.GetUsageRequest]) (*connect.Response[secretaryv1.GetUsageResponse], error) {
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	caller, err := s.users.GetUser(ctx, int32(userID))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch user")
+	}
+	target := pgtype.Int4{Int32: int32(req.Msg.UserId), Valid: req.Msg.UserId > 0}
+	if caller.Role.String != "admin" {
+		if target.Valid && int64(target.Int32) != userID {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("only admins can view other users' usage"))
+		}
+		target = pgtype.Int4{Int32: int32(userID), Valid: true}
+	}
+
+	monthStart, monthEnd := monthBounds(time.Now())
+	startAt, err := parseOptionalTimestamp(req.Msg.StartAt)
+	if err != nil {
+		return nil, apierr.InvalidField("start_at", "must be an RFC 3339 timestamp")
+	}
+	endAt, err := parseOptionalTimestamp(req.Msg.EndAt)
+	if err != nil {
+		return nil, apierr.InvalidField("end_at", "must be an RFC 3339 timestamp")
+	}
+	if !startAt.Valid {
+		startAt = pgtype.Timestamptz{Time: monthStart, Valid: true}
+	}
+	if !endAt.Valid {
+		endAt = pgtype.Timestamptz{Time: monthEnd, Valid: true}
+	}
+	if !endAt.Time.After(startAt.Time) {
+		return nil, apierr.InvalidField("end_at", "must be after start_at")
+	}
+
+	sums, err := s.usage.SumUsage(ctx, db.SumUsageParams{StartAt: startAt, EndAt: endAt, UserID: target})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to sum usage")
+	}
+	total := &secretaryv1.UsageTotals{}
+	for _, row := range sums {
+		addUsage(total, row.Metric, row.Total)
+	}
+	if total.StorageBytes, err = s.usage.SumStorageBytes(ctx, target); err != nil {
+		return nil, apierr.Wrap(err, "failed to sum storage")
+	}
+	if total.Recordings, err = s.usage.CountRecordings(ctx, target); err != nil {
+		return nil, apierr.Wrap(err, "failed to count recordings")
+	}
+	limits, err := s.quotaLimits(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	resp := &secretaryv1.GetUsageResponse{
+		StartAt:            formatTime(startAt),
+		EndAt:              formatTime(endAt),
+		Total:              total,
+		UserLimits:         limits.user.toProto(),
+		OrganizationLimits: limits.organization.toProto(),
+	}
+	if target.Valid {
+		return connect.NewResponse(resp), nil
+	}
+	for _, override := range limits.overrides {
+		resp.Overrides = append(resp.Overrides, quotaOverrideToProto(override))
+	}
+
+	costMicros, err := s.usage.SumProviderCost(ctx, db.SumProviderCostParams{StartAt: startAt, EndAt: endAt})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to sum provider cost")
+	}
+	resp.EstimatedCostUsd = float64(costMicros) / 1e6
+	if resp.Users, err = s.usageByUser(ctx, startAt, endAt); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Server) usageByUser(ctx context.Context, startAt, endAt pgtype.Timestamptz) ([]*secretaryv1.UserUsage, error) {
+	users, err := s.users.ListUsers(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to list users")
+	}
+	byID := map[int32]*secretaryv1.UserUsage{}
+	result := make([]*secretaryv1.UserUsage, 0, len(users))
+	for _, user := range users {
+		row := &secretaryv1.UserUsage{
+			UserId:    int64(user.ID),
+			FirstName: user.FirstName,
+			LastName:  user.LastName.String,
+			Usage:     &secretaryv1.UsageTotals{},
+		}
+		byID[user.ID] = row
+		result = append(result, row)
+	}
+	sums, err := s.usage.SumUsageByUser(ctx, db.SumUsageByUserParams{StartAt: startAt, EndAt: endAt})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to sum usage")
+	}
+	for _, row := range sums {
+		if user, ok := byID[row.UserID.Int32]; ok {
+			addUsage(user.Usage, row.Metric, row.Total)
+		}
+	}
+	storage, err := s.usage.SumStorageBytesByUser(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to sum storage")
+	}
+	for _, row := range storage {
+		if user, ok := byID[row.UserID.Int32]; ok {
+			user.Usage.StorageBytes = row.Total
+		}
+	}
+	recordings, err := s.usage.CountRecordingsByUser(ctx)
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to count recordings")
+	}
+	for _, row := range recordings {
+		if user, ok := byID[row.UserID.Int32]; ok {
+			user.Usage.Recordings = row.Total
+		}
+	}
+	return result, nil
+}
+
+func addUsage(totals *secretaryv1.UsageTotals, metric string, quantity int64) {
+	switch metric {
+	case usageTranscriptionSeconds:
+		totals.TranscriptionSeconds += quantity
+	case usageLLMTokens:
+		totals.LlmTokens += quantity
+	}
+}
@@ -0,0 +1,284 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/storage"
+)
+
+// fakeUsage reports fixed monthly totals per user; the organization total
+// is their sum.
+type fakeUsage struct {
+	UsageStore
+	seconds    map[int32]int64
+	tokens     map[int32]int64
+	storage    map[int32]int64
+	recordings map[int32]int64
+	owner      db.GetRecordingUsageOwnerRow
+	overrides  []db.QuotaOverride
+	events     []db.CreateUsageEventParams
+}
+
+func (f *fakeUsage) CreateUsageEvent(_ context.Context, arg db.CreateUsageEventParams) error {
+	f.events = append(f.events, arg)
+	return nil
+}
+
+func (f *fakeUsage) sum(values map[int32]int64, userID pgtype.Int4) int64 {
+	var total int64
+	for id, v := range values {
+		if !userID.Valid || id == userID.Int32 {
+			total += v
+		}
+	}
+	return total
+}
+
+func (f *fakeUsage) SumUsage(_ context.Context, arg db.SumUsageParams) ([]db.SumUsageRow, error) {
+	return []db.SumUsageRow{
+		{Metric: usageTranscriptionSeconds, Total: f.sum(f.seconds, arg.UserID)},
+		{Metric: usageLLMTokens, Total: f.sum(f.tokens, arg.UserID)},
+	}, nil
+}
+
+func (f *fakeUsage) SumStorageBytes(_ context.Context, userID pgtype.Int4) (int64, error) {
+	return f.sum(f.storage, userID), nil
+}
+
+func (f *fakeUsage) CountRecordings(_ context.Context, userID pgtype.Int4) (int64, error) {
+	return f.sum(f.recordings, userID), nil
+}
+
+func (f *fakeUsage) ListQuotaOverrides(context.Context) ([]db.QuotaOverride, error) {
+	return f.overrides, nil
+}
+
+func (f *fakeUsage) UpsertQuotaOverride(ctx context.Context, arg db.UpsertQuotaOverrideParams) error {
+	_, _ = f.DeleteQuotaOverride(ctx, db.DeleteQuotaOverrideParams{UserID: arg.UserID, Metric: arg.Metric})
+	f.overrides = append(f.overrides, db.QuotaOverride{
+		UserID:      arg.UserID,
+		Metric:      arg.Metric,
+		LimitValue:  arg.LimitValue,
+		Reason:      arg.Reason,
+		ExpiresAt:   arg.ExpiresAt,
+		SetByUserID: arg.SetByUserID,
+	})
+	return nil
+}
+
+func (f *fakeUsage) DeleteQuotaOverride(_ context.Context, arg db.DeleteQuotaOverrideParams) (int64, error) {
+	kept := f.overrides[:0]
+	for _, o := range f.overrides {
+		if o.UserID != arg.UserID || o.Metric != arg.Metric {
+			kept = append(kept, o)
+		}
+	}
+	deleted := int64(len(f.overrides) - len(kept))
+	f.overrides = kept
+	return deleted, nil
+}
+
+func (f *fakeUsage) GetRecordingUsageOwner(context.Context, int32) (db.GetRecordingUsageOwnerRow, error) {
+	return f.owner, nil
+}
+
+type memberUsers struct{ UserStore }
+
+func (memberUsers) GetUser(_ context.Context, id int32) (db.GetUserRow, error) {
+	return db.GetUserRow{ID: id, Role: optionalText("member")}, nil
+}
+
+func TestCheckQuota(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	if err := srv.checkQuota(context.Background(), pgtype.Int4{Int32: 1, Valid: true}, usageLLMTokens, 500); err != nil {
+		t.Fatalf("unlimited: %v", err)
+	}
+
+	srv.usage = &fakeUsage{tokens: map[int32]int64{1: 700, 2: 200}}
+	srv.ConfigureQuotas(UsageQuotas{Organization: UsageLimits{LLMTokens: 1000}, PerUser: UsageLimits{LLMTokens: 700}})
+	user := func(id int32) pgtype.Int4 { return pgtype.Int4{Int32: id, Valid: true} }
+
+	if err := srv.checkQuota(context.Background(), user(2), usageLLMTokens, 100); err != nil {
+		t.Fatalf("within both quotas: %v", err)
+	}
+	err := srv.checkQuota(context.Background(), user(2), usageLLMTokens, 200)
+	if connect.CodeOf(err) != connect.CodeResourceExhausted || !strings.Contains(err.Error(), "organization") {
+		t.Fatalf("err = %v, want the organization quota exceeded", err)
+	}
+	err = srv.checkQuota(context.Background(), user(1), usageLLMTokens, 50)
+	if connect.CodeOf(err) != connect.CodeResourceExhausted || !strings.Contains(err.Error(), "user") {
+		t.Fatalf("err = %v, want the user quota exceeded", err)
+	}
+}
+
+func TestTranscriptionQuotaBlocksTranscribing(t *testing.T) {
+	store := &fakeRecordingStatus{status: recordingUploaded}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(store, nil, adminUsers{})
+	srv.usage = &fakeUsage{
+		seconds: map[int32]int64{2: 30},
+		owner:   db.GetRecordingUsageOwnerRow{CreatedByUserID: pgtype.Int4{Int32: 2, Valid: true}, Duration: 45},
+	}
+	srv.ConfigureQuotas(UsageQuotas{PerUser: UsageLimits{TranscriptionSeconds: 60}})
+
+	_, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING, "")
+	if connect.CodeOf(err) != connect.CodeResourceExhausted || store.status != recordingUploaded {
+		t.Fatalf("err = %v with status %s, want ResourceExhausted and still uploaded", err, store.status)
+	}
+
+	srv.ConfigureQuotas(UsageQuotas{PerUser: UsageLimits{TranscriptionSeconds: 120}})
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_TRANSCRIBING, ""); err != nil {
+		t.Fatalf("transcribing: %v", err)
+	}
+	if _, err := updateStatus(srv, secretaryv1.RecordingStatus_RECORDING_STATUS_SUMMARIZING, ""); err != nil {
+		t.Fatalf("summarizing: %v", err)
+	}
+	if store.transcribed != 1 {
+		t.Fatalf("transcription usage recorded %d times, want 1", store.transcribed)
+	}
+}
+
+func TestRecordingUploadEnforcesStorageQuota(t *testing.T) {
+	dir := t.TempDir()
+	store, err := storage.NewLocal(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStorage(store)
+	srv.recordings = hashedRecordings{}
+	srv.usage = &fakeUsage{storage: map[int32]int64{1: 10}}
+	srv.ConfigureQuotas(UsageQuotas{PerUser: UsageLimits{StorageBytes: 12}})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/recordings/upload", strings.NewReader("RIFF...."))
+	req.Header.Set("Content-Type", "audio/wav")
+	req = req.WithContext(withPrincipal(req.Context(), Principal{UserID: 1}))
+	rec := httptest.NewRecorder()
+	srv.handleRecordingUpload(rec, req)
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want 429", rec.Code)
+	}
+	var files []string
+	_ = filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if len(files) != 0 {
+		t.Fatalf("rejected upload left %v behind", files)
+	}
+}
+
+func TestGetUsageShowsMembersOnlyTheirOwn(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, memberUsers{})
+	srv.usage = &fakeUsage{
+		seconds: map[int32]int64{1: 120, 2: 600},
+		tokens:  map[int32]int64{1: 900},
+		storage: map[int32]int64{1: 2048, 2: 4096},
+	}
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+
+	_, err := srv.GetUsage(ctx, connect.NewRequest(&secretaryv1.GetUsageRequest{UserId: 2}))
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodePermissionDenied {
+		t.Fatalf("err = %v, want PermissionDenied", err)
+	}
+
+	resp, err := srv.GetUsage(ctx, connect.NewRequest(&secretaryv1.GetUsageRequest{}))
+	if err != nil {
+		t.Fatalf("get usage: %v", err)
+	}
+	total := resp.Msg.Total
+	if total.TranscriptionSeconds != 120 || total.LlmTokens != 900 || total.StorageBytes != 2048 || len(resp.Msg.Users) != 0 {
+		t.Fatalf("usage = %+v with %d users, want only user 1", total, len(resp.Msg.Users))
+	}
+	start, _ := monthBounds(time.Now())
+	if resp.Msg.StartAt != start.Format(time.RFC3339) {
+		t.Fatalf("start = %s, want the start of the month", resp.Msg.StartAt)
+	}
+}
+
+func TestRecordingQuotaBlocksUploads(t *testing.T) {
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStorage(store)
+	srv.usage = &fakeUsage{recordings: map[int32]int64{1: 3, 2: 2}}
+	srv.ConfigureQuotas(UsageQuotas{Organization: UsageLimits{Recordings: 5}})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/recordings/upload", strings.NewReader("RIFF"))
+	req.Header.Set("Content-Type", "audio/wav")
+	req = req.WithContext(withPrincipal(req.Context(), Principal{UserID: 1}))
+	rec := httptest.NewRecorder()
+	srv.handleRecordingUpload(rec, req)
+	if rec.Code != http.StatusTooManyRequests || !strings.Contains(rec.Body.String(), "organization recordings quota exceeded") {
+		t.Fatalf("status = %d %s, want 429 for the recordings quota", rec.Code, rec.Body.String())
+	}
+}
+
+func TestQuotaOverrides(t *testing.T) {
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, adminUsers{})
+	usage := &fakeUsage{seconds: map[int32]int64{2: 600}}
+	srv.usage = usage
+	srv.ConfigureQuotas(UsageQuotas{PerUser: UsageLimits{TranscriptionSeconds: 600}})
+	ctx := withPrincipal(context.Background(), Principal{UserID: 1})
+	user2 := pgtype.Int4{Int32: 2, Valid: true}
+
+	if err := srv.checkQuota(ctx, user2, usageTranscriptionSeconds, 60); connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Fatalf("err = %v, want the configured quota enforced", err)
+	}
+	resp, err := srv.SetQuotaOverride(ctx, connect.NewRequest(&secretaryv1.SetQuotaOverrideRequest{
+		UserId: 2,
+		Metric: secretaryv1.UsageMetric_USAGE_METRIC_TRANSCRIPTION_SECONDS,
+		Limit:  1200,
+		Reason: "quarterly board meeting",
+	}))
+	if err != nil {
+		t.Fatalf("set override: %v", err)
+	}
+	if len(resp.Msg.Overrides) != 1 || resp.Msg.Overrides[0].SetByUserId != 1 {
+		t.Fatalf("overrides = %v", resp.Msg.Overrides)
+	}
+	if err := srv.checkQuota(ctx, user2, usageTranscriptionSeconds, 60); err != nil {
+		t.Fatalf("with override: %v", err)
+	}
+	// Other users keep the configured limit.
+	usage.seconds[3] = 600
+	if err := srv.checkQuota(ctx, pgtype.Int4{Int32: 3, Valid: true}, usageTranscriptionSeconds, 60); connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Fatalf("err = %v, want user 3 still limited", err)
+	}
+
+	if _, err := srv.DeleteQuotaOverride(ctx, connect.NewRequest(&secretaryv1.DeleteQuotaOverrideRequest{
+		UserId: 2,
+		Metric: secretaryv1.UsageMetric_USAGE_METRIC_TRANSCRIPTION_SECONDS,
+	})); err != nil {
+		t.Fatalf("delete override: %v", err)
+	}
+	if err := srv.checkQuota(ctx, user2, usageTranscriptionSeconds, 60); connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Fatalf("err = %v, want the configured quota back", err)
+	}
+
+	srv.ConfigureStores(nil, nil, memberUsers{})
+	_, err = srv.SetQuotaOverride(ctx, connect.NewRequest(&secretaryv1.SetQuotaOverrideRequest{Metric: secretaryv1.UsageMetric_USAGE_METRIC_RECORDINGS}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("err = %v, want PermissionDenied for members", err)
+	}
+}
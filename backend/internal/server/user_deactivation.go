@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/apierr"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+	"github.com/mvult/secretary/backend/internal/shared"
+)
+
+// isDeactivated reports whether userID was deactivated as of the last
+// load. The auth middleware asks on every request, so the IDs are cached
+// rather than queried.
+func (s *Server) isDeactivated(userID int64) bool {
+	if ids := s.deactivated.Load(); ids != nil {
+		return (*ids)[userID]
+	}
+	return false
+}
+
+// setDeactivated records a change made through this instance, or
+// published by another, right away instead of waiting for the next load.
+func (s *Server) setDeactivated(userID int64, deactivated bool) {
+	ids := map[int64]bool{}
+	if current := s.deactivated.Load(); current != nil {
+		ids = maps.Clone(*current)
+	}
+	if deactivated {
+		ids[userID] = true
+	} else {
+		delete(ids, userID)
+	}
+	s.deactivated.Store(&ids)
+}
+
+// LoadDeactivatedUsers reads the deactivated users into the cache.
+func (s *Server) LoadDeactivatedUsers(ctx context.Context) error {
+	rows, err := s.users.ListDeactivatedUserIDs(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make(map[int64]bool, len(rows))
+	for _, id := range rows {
+		ids[int64(id)] = true
+	}
+	s.deactivated.Store(&ids)
+	return nil
+}
+
+// StartDeactivatedUsersRefresh reloads the deactivated users every
+// interval, so a user deactivated through another instance is signed out
+// of this one too. It returns at once; reloading stops with ctx.
+func (s *Server) StartDeactivatedUsersRefresh(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := s.LoadDeactivatedUsers(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("deactivated users refresh: %v", err)
+			}
+		}
+	}()
+}
+
+// DeactivateUser stops a user from signing in, and hands their open todos
+// to a successor when one is given. Their existing tokens are refused from
+// then on. The user row is kept, so todos and history still name them.
+func (s *Server) DeactivateUser(ctx context.Context, req *connect.Request[secretaryv1.DeactivateUserRequest]) (*connect.Response[secretaryv1.DeactivateUserResponse], error) {
+	msg := req.Msg
+	if err := s.requireAdmin(ctx, "only admins can deactivate users"); err != nil {
+		return nil, err
+	}
+	actorID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.UserId == actorID {
+		return nil, apierr.InvalidField("user_id", "you cannot deactivate yourself")
+	}
+
+	user, err := s.users.GetUser(ctx, int32(msg.UserId))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to fetch user")
+	}
+	var successorID pgtype.Int4
+	if msg.SuccessorUserId != 0 {
+		successor, err := s.users.GetUser(ctx, int32(msg.SuccessorUserId))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apierr.InvalidField("successor_user_id", "user not found")
+		}
+		if err != nil {
+			return nil, apierr.Wrap(err, "failed to fetch successor")
+		}
+		if successor.DeactivatedAt.Valid {
+			return nil, apierr.InvalidField("successor_user_id", "user is deactivated")
+		}
+		successorID = pgtype.Int4{Int32: successor.ID, Valid: true}
+	}
+
+	name := strings.TrimSpace(user.FirstName + " " + user.LastName.String)
+	row, err := s.users.DeactivateUser(ctx, db.DeactivateUserParams{
+		UserID:      user.ID,
+		SuccessorID: successorID,
+		ActorUserID: int32(actorID),
+		Note:        fmt.Sprintf("Reassigned from %s, who was deactivated", name),
+	})
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to deactivate user")
+	}
+	if !row.Deactivated {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("user is already deactivated"))
+	}
+	s.setDeactivated(msg.UserId, true)
+	s.publish(ctx, shared.Event{Kind: shared.EventUserDeactivated, UserID: msg.UserId})
+	return connect.NewResponse(&secretaryv1.DeactivateUserResponse{ReassignedTodoCount: row.ReassignedTodos}), nil
+}
+
+// ReactivateUser lets a deactivated user sign in again.
+func (s *Server) ReactivateUser(ctx context.Context, req *connect.Request[secretaryv1.ReactivateUserRequest]) (*connect.Response[secretaryv1.ReactivateUserResponse], error) {
+	if err := s.requireAdmin(ctx, "only admins can reactivate users"); err != nil {
+		return nil, err
+	}
+	n, err := s.users.ReactivateUser(ctx, int32(req.Msg.UserId))
+	if err != nil {
+		return nil, apierr.Wrap(err, "failed to reactivate user")
+	}
+	if n == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("user is not deactivated"))
+	}
+	s.setDeactivated(req.Msg.UserId, false)
+	s.publish(ctx, shared.Event{Kind: shared.EventUserReactivated, UserID: req.Msg.UserId})
+	return connect.NewResponse(&secretaryv1.ReactivateUserResponse{}), nil
+}
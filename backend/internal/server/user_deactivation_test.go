@@ -0,0 +1,207 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"golang.org/x/crypto/bcrypt"
+
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/internal/db/gen"
+)
+
+// deactivatingUsers keeps users by ID and the open todos assigned to each.
+type deactivatingUsers struct {
+	UserStore
+	users    map[int32]db.GetUserRow
+	openTodo map[int32]int32
+	notes    []string
+	hash     string
+}
+
+func (f *deactivatingUsers) GetUser(_ context.Context, id int32) (db.GetUserRow, error) {
+	row, ok := f.users[id]
+	if !ok {
+		return db.GetUserRow{}, pgx.ErrNoRows
+	}
+	return row, nil
+}
+
+func (f *deactivatingUsers) GetUserByEmail(_ context.Context, email pgtype.Text) (db.GetUserByEmailRow, error) {
+	row := f.users[7]
+	return db.GetUserByEmailRow{ID: row.ID, FirstName: row.FirstName, Email: email, PasswordHash: optionalText(f.hash), DeactivatedAt: row.DeactivatedAt}, nil
+}
+
+func (f *deactivatingUsers) UpdatePasswordHash(_ context.Context, arg db.UpdatePasswordHashParams) (int64, error) {
+	f.hash = arg.NewHash.String
+	return 1, nil
+}
+
+func (f *deactivatingUsers) DeactivateUser(_ context.Context, arg db.DeactivateUserParams) (db.DeactivateUserRow, error) {
+	row := f.users[arg.UserID]
+	if row.DeactivatedAt.Valid {
+		return db.DeactivateUserRow{}, nil
+	}
+	row.DeactivatedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	f.users[arg.UserID] = row
+	if !arg.SuccessorID.Valid {
+		return db.DeactivateUserRow{Deactivated: true}, nil
+	}
+	moved := f.openTodo[arg.UserID]
+	f.openTodo[arg.SuccessorID.Int32] += moved
+	f.openTodo[arg.UserID] = 0
+	for range moved {
+		f.notes = append(f.notes, arg.Note)
+	}
+	return db.DeactivateUserRow{Deactivated: true, ReassignedTodos: moved}, nil
+}
+
+func (f *deactivatingUsers) ReactivateUser(_ context.Context, id int32) (int64, error) {
+	row := f.users[id]
+	if !row.DeactivatedAt.Valid {
+		return 0, nil
+	}
+	row.DeactivatedAt = pgtype.Timestamptz{}
+	f.users[id] = row
+	return 1, nil
+}
+
+func (f *deactivatingUsers) ListDeactivatedUserIDs(context.Context) ([]int32, error) {
+	var ids []int32
+	for id, row := range f.users {
+		if row.DeactivatedAt.Valid {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func TestDeactivateUser(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	users := &deactivatingUsers{
+		users: map[int32]db.GetUserRow{
+			5: {ID: 5, FirstName: "Ana", Role: optionalText("admin")},
+			7: {ID: 7, FirstName: "Luis", LastName: optionalText("Gómez"), Role: optionalText("member")},
+			8: {ID: 8, FirstName: "Marta", Role: optionalText("member")},
+			9: {ID: 9, FirstName: "Pedro", Role: optionalText("member"), DeactivatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}},
+		},
+		openTodo: map[int32]int32{7: 3, 8: 1},
+		hash:     string(hash),
+	}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, nil, users)
+	srv.sessions = &fakeSessions{}
+	srv.auditLog = &fakeAuditLog{}
+	srv.loginDelay = 0
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+	if err := srv.LoadDeactivatedUsers(ctx); err != nil || !srv.isDeactivated(9) || srv.isDeactivated(7) {
+		t.Fatalf("loaded deactivated users: %v", err)
+	}
+	bearer, err := srv.issueToken(7, tokenClaims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	authorized := func() bool {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer "+bearer)
+		rec := httptest.NewRecorder()
+		srv.authMiddleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})).ServeHTTP(rec, req)
+		return rec.Code == http.StatusOK
+	}
+	if !authorized() {
+		t.Fatal("active user's token refused")
+	}
+	deactivate := func(ctx context.Context, userID, successorID int64) (*connect.Response[secretaryv1.DeactivateUserResponse], error) {
+		return srv.DeactivateUser(ctx, connect.NewRequest(&secretaryv1.DeactivateUserRequest{UserId: userID, SuccessorUserId: successorID}))
+	}
+
+	if _, err := deactivate(withPrincipal(context.Background(), Principal{UserID: 8}), 7, 8); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("member deactivating: %v", err)
+	}
+	if _, err := deactivate(ctx, 5, 8); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("deactivating yourself: %v", err)
+	}
+	if _, err := deactivate(ctx, 7, 9); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("deactivated successor: %v", err)
+	}
+
+	resp, err := deactivate(ctx, 7, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Msg.ReassignedTodoCount != 3 || users.openTodo[8] != 4 || users.openTodo[7] != 0 {
+		t.Fatalf("reassigned %d, open todos %v", resp.Msg.ReassignedTodoCount, users.openTodo)
+	}
+	if len(users.notes) != 3 || users.notes[0] != "Reassigned from Luis Gómez, who was deactivated" {
+		t.Fatalf("history notes = %q", users.notes)
+	}
+	if _, err := deactivate(ctx, 7, 8); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("deactivating twice: %v", err)
+	}
+	if authorized() {
+		t.Fatal("deactivated user's token still accepted")
+	}
+
+	ts := httptest.NewServer(srv.Routes())
+	defer ts.Close()
+	signIn := func() int {
+		body, _ := json.Marshal(LoginRequest{Email: "luis@example.com", Password: "secret"})
+		resp, err := http.Post(ts.URL+"/api/login", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	if code := signIn(); code != http.StatusForbidden {
+		t.Fatalf("deactivated login status = %d", code)
+	}
+	if _, err := srv.ReactivateUser(ctx, connect.NewRequest(&secretaryv1.ReactivateUserRequest{UserId: 7})); err != nil {
+		t.Fatal(err)
+	}
+	if code := signIn(); code != http.StatusOK || !authorized() {
+		t.Fatalf("reactivated login status = %d", code)
+	}
+}
+
+func TestDeactivatedUsersKeepTheirTodos(t *testing.T) {
+	users := &deactivatingUsers{
+		users: map[int32]db.GetUserRow{
+			5: {ID: 5, FirstName: "Ana", Role: optionalText("admin")},
+			7: {ID: 7, FirstName: "Luis", Role: optionalText("member")},
+		},
+		openTodo: map[int32]int32{7: 2},
+	}
+	todo := &trackedTodo{todo: db.GetTodoRow{ID: 3, Name: "Ship it", Status: optionalText("todo"), UserID: pgtype.Int4{Int32: 7, Valid: true}, Version: 1}}
+	srv := New(nil, []byte("test"), time.Hour)
+	srv.ConfigureStores(nil, trackedTodos{tx: todo}, users)
+	ctx := withPrincipal(context.Background(), Principal{UserID: 5})
+
+	resp, err := srv.DeactivateUser(ctx, connect.NewRequest(&secretaryv1.DeactivateUserRequest{UserId: 7}))
+	if err != nil || resp.Msg.ReassignedTodoCount != 0 || users.openTodo[7] != 2 {
+		t.Fatalf("deactivating without a successor: %+v, %v, open todos %v", resp, err, users.openTodo)
+	}
+
+	if _, err := srv.CreateTodo(ctx, connect.NewRequest(&secretaryv1.CreateTodoRequest{Name: "Follow up", Status: secretaryv1.TodoStatus_TODO_STATUS_TODO, UserId: 7})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("new todo for a deactivated user: %v", err)
+	}
+	// Their own todo can still be edited, e.g. closed by a teammate.
+	if _, err := srv.UpdateTodo(ctx, connect.NewRequest(&secretaryv1.UpdateTodoRequest{Id: 3, Name: "Ship it", Status: secretaryv1.TodoStatus_TODO_STATUS_DONE, UserId: 7, ExpectedVersion: 1})); err != nil {
+		t.Fatalf("closing a deactivated user's todo: %v", err)
+	}
+	todo.todo.UserID = pgtype.Int4{Int32: 5, Valid: true}
+	if _, err := srv.UpdateTodo(ctx, connect.NewRequest(&secretaryv1.UpdateTodoRequest{Id: 3, Name: "Ship it", Status: secretaryv1.TodoStatus_TODO_STATUS_DONE, UserId: 7, ExpectedVersion: 2})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("assigning a todo to a deactivated user: %v", err)
+	}
+}
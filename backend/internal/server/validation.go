@@ -0,0 +1,36 @@
+package server
+
+import (
+	"connectrpc.com/connect"
+	"connectrpc.com/validate"
+)
+
+// requestValidator enforces the buf.validate annotations on request
+// messages. Violations are rejected with CodeInvalidArgument before the
+// handler runs, with the field violations attached as error details.
+var requestValidator = validate.NewInterceptor()
+
+// handlerOptions are shared by every Connect service. The interceptors run
+// in order: counting and logging the call, translating its errors,
+// authenticating the caller, applying the deadline, validating the request,
+// reading the caller's time zone and recovering from a panicking handler,
+// which fails the call with CodeInternal.
+func (s *Server) handlerOptions() []connect.HandlerOption {
+	opts := []connect.HandlerOption{
+		connect.WithInterceptors(
+			observeInterceptor{server: s},
+			localeInterceptor{},
+			authInterceptor{server: s},
+			auditInterceptor{server: s},
+			deadlineInterceptor{server: s},
+			requestValidator,
+			timezoneInterceptor{},
+			recoverInterceptor{server: s},
+		),
+		connect.WithCompressMinBytes(minCompressBytes),
+	}
+	if s.bodyLimits.API > 0 {
+		opts = append(opts, connect.WithReadMaxBytes(int(s.bodyLimits.API)))
+	}
+	return opts
+}
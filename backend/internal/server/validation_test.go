@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"connectrpc.com/connect"
+	secretaryv1 "github.com/mvult/secretary/backend/gen/secretary/v1"
+	"github.com/mvult/secretary/backend/gen/secretary/v1/secretaryv1connect"
+)
+
+func TestRequestValidationRejectsInvalidMessages(t *testing.T) {
+	s := New(nil, []byte("test"), time.Hour)
+	token, err := s.issueToken(1, tokenClaims{})
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+	ts := httptest.NewServer(s.Routes())
+	defer ts.Close()
+
+	auth := connect.WithInterceptors(connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+token)
+			return next(ctx, req)
+		}
+	}))
+	todos := secretaryv1connect.NewTodosServiceClient(ts.Client(), ts.URL, auth)
+	recordings := secretaryv1connect.NewRecordingsServiceClient(ts.Client(), ts.URL, auth)
+	admin := secretaryv1connect.NewAdminServiceClient(ts.Client(), ts.URL, auth)
+	jobs := secretaryv1connect.NewJobsServiceClient(ts.Client(), ts.URL, auth)
+
+	cases := []struct {
+		name  string
+		call  func() error
+		field string
+	}{
+		{"blank todo name", func() error {
+			_, err := todos.CreateTodo(context.Background(), connect.NewRequest(&secretaryv1.CreateTodoRequest{
+				Name: "   ", Status: secretaryv1.TodoStatus_TODO_STATUS_TODO, UserId: 1,
+			}))
+			return err
+		}, "name"},
+		{"missing status", func() error {
+			_, err := todos.UpdateTodo(context.Background(), connect.NewRequest(&secretaryv1.UpdateTodoRequest{
+				Id: 3, Name: "Ship it", UserId: 1,
+			}))
+			return err
+		}, "status"},
+		{"missing expected version", func() error {
+			_, err := todos.UpdateTodo(context.Background(), connect.NewRequest(&secretaryv1.UpdateTodoRequest{
+				Id: 3, Name: "Ship it", Status: secretaryv1.TodoStatus_TODO_STATUS_DONE, UserId: 1,
+			}))
+			return err
+		}, "expected_version"},
+		{"list without owner", func() error {
+			_, err := todos.ListTodos(context.Background(), connect.NewRequest(&secretaryv1.ListTodosRequest{}))
+			return err
+		}, ""},
+		{"recording id", func() error {
+			_, err := recordings.GetRecording(context.Background(), connect.NewRequest(&secretaryv1.GetRecordingRequest{}))
+			return err
+		}, "id"},
+		{"recording date filter", func() error {
+			_, err := recordings.ListRecordings(context.Background(), connect.NewRequest(&secretaryv1.ListRecordingsRequest{CreatedAfter: "last week"}))
+			return err
+		}, ""},
+		{"unconfirmed import", func() error {
+			_, err := admin.ImportInstance(context.Background(), connect.NewRequest(&secretaryv1.ImportInstanceRequest{Name: "backup.tar.gz"}))
+			return err
+		}, "replace_all_data"},
+		{"archive outside the archives", func() error {
+			_, err := admin.ImportInstance(context.Background(), connect.NewRequest(&secretaryv1.ImportInstanceRequest{Name: "../backup.tar.gz", ReplaceAllData: true}))
+			return err
+		}, "name"},
+		{"unknown job state", func() error {
+			_, err := jobs.ListJobs(context.Background(), connect.NewRequest(&secretaryv1.ListJobsRequest{States: []secretaryv1.JobState{secretaryv1.JobState_JOB_STATE_UNSPECIFIED}}))
+			return err
+		}, "states"},
+	}
+	for _, tc := range cases {
+		err := tc.call()
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf("%s: expected invalid argument, got %v", tc.name, err)
+		}
+		if tc.field == "" {
+			continue
+		}
+		var connectErr *connect.Error
+		if !errors.As(err, &connectErr) {
+			t.Fatalf("%s: expected connect error", tc.name)
+		}
+		found := false
+		for _, detail := range connectErr.Details() {
+			value, err := detail.Value()
+			if err != nil {
+				continue
+			}
+			if violations, ok := value.(*validate.Violations); ok {
+				for _, violation := range violations.GetViolations() {
+					if elements := violation.GetField().GetElements(); len(elements) > 0 && elements[0].GetFieldName() == tc.field {
+						found = true
+					}
+				}
+			}
+		}
+		if !found {
+			t.Fatalf("%s: expected a violation on %q, got %v", tc.name, tc.field, err)
+		}
+	}
+}
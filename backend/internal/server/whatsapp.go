@@ -15,6 +15,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mvult/secretary/backend/internal/apierr"
 	db "github.com/mvult/secretary/backend/internal/db/gen"
 	whatsappsvc "github.com/mvult/secretary/backend/internal/whatsapp"
 )
@@ -283,7 +284,7 @@ func (s *Server) classifyWhatsAppText(ctx context.Context, instructions string,
 		return whatsAppClassificationResult{}, err
 	}
 	if resp.StatusCode >= 400 {
-		return whatsAppClassificationResult{}, fmt.Errorf("openai request failed (%d): %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+		return whatsAppClassificationResult{}, apierr.NewProviderError("openai", resp, respBody)
 	}
 	var parsed struct {
 		Choices []struct {
@@ -0,0 +1,173 @@
+// Package shared keeps the state server processes share in Redis: rate
+// limit counters, cached responses and the events that tell the other
+// processes to drop what they cached. Without Redis every process keeps
+// its own, which is right for a single process.
+package shared
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Event kinds.
+const (
+	// EventResponsesChanged: cached responses are stale. Generation is
+	// the new cache generation.
+	EventResponsesChanged = "responses-changed"
+	// EventSettingsChanged: the organization settings were saved.
+	EventSettingsChanged = "settings-changed"
+	// EventUserDeactivated and EventUserReactivated: UserID's tokens stop
+	// or start being accepted.
+	EventUserDeactivated = "user-deactivated"
+	EventUserReactivated = "user-reactivated"
+	// EventSessionsRevoked: the tokens of SessionIDs stop being accepted.
+	EventSessionsRevoked = "sessions-revoked"
+)
+
+// Event is something one process tells the others.
+type Event struct {
+	Kind       string   `json:"kind"`
+	UserID     int64    `json:"user_id,omitempty"`
+	Generation int64    `json:"generation,omitempty"`
+	SessionIDs []string `json:"session_ids,omitempty"`
+	// Origin is the process that published the event.
+	Origin string `json:"origin"`
+}
+
+// keyPrefix namespaces every key, so a Redis shared with other
+// applications is safe.
+const keyPrefix = "secretary:"
+
+const eventsChannel = keyPrefix + "events"
+
+// Redis is the shared state, kept in one Redis server.
+type Redis struct {
+	client *redis.Client
+	// origin identifies this process in the events it publishes.
+	origin string
+}
+
+// Open connects to the Redis server at url, e.g.
+// redis://:password@localhost:6379/0 or rediss:// for TLS.
+func Open(ctx context.Context, url string) (*Redis, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("redis: %w", err)
+	}
+	client := redis.NewClient(opts)
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("redis: %w", err)
+	}
+	origin := make([]byte, 8)
+	if _, err := rand.Read(origin); err != nil {
+		client.Close()
+		return nil, err
+	}
+	return &Redis{client: client, origin: hex.EncodeToString(origin)}, nil
+}
+
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
+
+// Take spends one of the perMinute calls key allows in the current
+// minute, counted across every process. When none is left it reports false
+// and how long until the next minute starts.
+func (r *Redis) Take(ctx context.Context, key string, perMinute int, now time.Time) (bool, time.Duration, error) {
+	window := now.Truncate(time.Minute)
+	counter := keyPrefix + "rate:" + key + ":" + strconv.FormatInt(window.Unix(), 10)
+	var incr *redis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, counter)
+		pipe.Expire(ctx, counter, 2*time.Minute)
+		return nil
+	})
+	if err != nil {
+		return false, 0, err
+	}
+	if incr.Val() > int64(perMinute) {
+		return false, window.Add(time.Minute).Sub(now), nil
+	}
+	return true, 0, nil
+}
+
+// Get returns the value cached under key.
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := r.client.Get(ctx, keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set caches value under key for ttl.
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return r.client.Set(ctx, keyPrefix+key, value, ttl).Err()
+}
+
+// Counter returns the counter stored under key; zero when there is none.
+func (r *Redis) Counter(ctx context.Context, key string) (int64, error) {
+	n, err := r.client.Get(ctx, keyPrefix+key).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return n, err
+}
+
+// Increment adds one to the counter stored under key and returns it.
+func (r *Redis) Increment(ctx context.Context, key string) (int64, error) {
+	return r.client.Incr(ctx, keyPrefix+key).Result()
+}
+
+// Publish tells the other processes about event.
+func (r *Redis) Publish(ctx context.Context, event Event) error {
+	event.Origin = r.origin
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return r.client.Publish(ctx, eventsChannel, payload).Err()
+}
+
+// Subscribe calls handle with the events the other processes publish, one
+// at a time. It returns at once; delivery stops with ctx. Events published
+// while the connection is down are lost, so whatever handle refreshes
+// should also be refreshed periodically.
+func (r *Redis) Subscribe(ctx context.Context, handle func(Event)) {
+	sub := r.client.Subscribe(ctx, eventsChannel)
+	go func() {
+		defer sub.Close()
+		messages := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				var event Event
+				if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+					log.Printf("redis: ignoring malformed event: %v", err)
+					continue
+				}
+				if event.Origin != r.origin {
+					handle(event)
+				}
+			}
+		}
+	}()
+}
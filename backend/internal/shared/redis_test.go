@@ -0,0 +1,102 @@
+package shared
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+func openTwo(t *testing.T) (*Redis, *Redis) {
+	t.Helper()
+	server := miniredis.RunT(t)
+	ctx := context.Background()
+	first, err := Open(ctx, "redis://"+server.Addr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { first.Close() })
+	second, err := Open(ctx, "redis://"+server.Addr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { second.Close() })
+	return first, second
+}
+
+func TestTakeCountsEveryProcess(t *testing.T) {
+	first, second := openTwo(t)
+	ctx := context.Background()
+	now := time.Date(2026, 10, 18, 9, 0, 40, 0, time.UTC)
+
+	if ok, _, err := first.Take(ctx, "summarization:openai", 2, now); !ok || err != nil {
+		t.Fatalf("first call: %v %v", ok, err)
+	}
+	if ok, _, err := second.Take(ctx, "summarization:openai", 2, now); !ok || err != nil {
+		t.Fatalf("second call: %v %v", ok, err)
+	}
+	ok, wait, err := first.Take(ctx, "summarization:openai", 2, now)
+	if ok || err != nil || wait != 20*time.Second {
+		t.Fatalf("third call: ok = %v, wait = %s, err = %v; want refused until the next minute", ok, wait, err)
+	}
+	if ok, _, _ := second.Take(ctx, "summarization:openai", 2, now.Add(20*time.Second)); !ok {
+		t.Fatal("refused in the next minute")
+	}
+}
+
+func TestCacheAndCounter(t *testing.T) {
+	first, second := openTwo(t)
+	ctx := context.Background()
+	if _, ok, err := first.Get(ctx, "k"); ok || err != nil {
+		t.Fatalf("get missing: %v %v", ok, err)
+	}
+	if err := first.Set(ctx, "k", []byte("v"), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if value, ok, err := second.Get(ctx, "k"); !ok || err != nil || string(value) != "v" {
+		t.Fatalf("get = %q %v %v", value, ok, err)
+	}
+	if n, err := second.Counter(ctx, "n"); n != 0 || err != nil {
+		t.Fatalf("counter = %d %v", n, err)
+	}
+	first.Increment(ctx, "n")
+	if n, err := second.Counter(ctx, "n"); n != 1 || err != nil {
+		t.Fatalf("counter = %d %v", n, err)
+	}
+}
+
+func TestEventsReachTheOtherProcesses(t *testing.T) {
+	first, second := openTwo(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan Event, 2)
+	first.Subscribe(ctx, func(event Event) { received <- event })
+	second.Subscribe(ctx, func(event Event) { received <- event })
+
+	// Give the subscriptions time to register before publishing.
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if n, _ := first.client.PubSubNumSub(ctx, eventsChannel).Result(); n[eventsChannel] == 2 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := first.Publish(ctx, Event{Kind: EventUserDeactivated, UserID: 7}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case event := <-received:
+		if event.Kind != EventUserDeactivated || event.UserID != 7 || event.Origin != first.origin {
+			t.Fatalf("event = %+v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("event not delivered")
+	}
+	select {
+	case event := <-received:
+		t.Fatalf("publisher received its own event %+v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLocalRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("new local store: %v", err)
+	}
+
+	n, err := store.Put(ctx, "recordings/1/audio.wav", strings.NewReader("RIFF"))
+	if err != nil || n != 4 {
+		t.Fatalf("put: n=%d err=%v", n, err)
+	}
+	rc, err := store.Open(ctx, "recordings/1/audio.wav")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	data, _ := io.ReadAll(rc)
+	rc.Close()
+	if string(data) != "RIFF" {
+		t.Fatalf("unexpected content %q", data)
+	}
+
+	if err := store.Delete(ctx, "recordings/1/audio.wav"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := store.Open(ctx, "recordings/1/audio.wav"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestCleanKeyRejectsTraversal(t *testing.T) {
+	for _, key := range []string{"", "../etc/passwd", "a/../../b"} {
+		if _, err := CleanKey(key); err == nil {
+			t.Fatalf("expected %q to be rejected", key)
+		}
+	}
+	if got, err := CleanKey("/recordings//2/a.wav"); err != nil || got != "recordings/2/a.wav" {
+		t.Fatalf("unexpected clean key %q (%v)", got, err)
+	}
+}
+
+func TestLocalList(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("new local store: %v", err)
+	}
+	for _, key := range []string{"archives/b.tar.gz", "archives/a.tar.gz", "avatars/1.png"} {
+		if _, err := store.Put(ctx, key, strings.NewReader(key)); err != nil {
+			t.Fatalf("put %s: %v", key, err)
+		}
+	}
+
+	objects, err := store.List(ctx, "archives/")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	want := []Object{{Key: "archives/a.tar.gz", Size: 17}, {Key: "archives/b.tar.gz", Size: 17}}
+	if !slices.Equal(objects, want) {
+		t.Fatalf("got %v, want %v", objects, want)
+	}
+}
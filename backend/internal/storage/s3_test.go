@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeBucket serves a path-style bucket from memory. It checks that
+// requests are signed but not the signatures themselves.
+type fakeBucket struct {
+	mu      sync.Mutex
+	objects map[string]string
+}
+
+func (b *fakeBucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	signed := strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") || r.URL.Query().Get("X-Amz-Signature") != ""
+	if !signed || !strings.HasPrefix(r.URL.Path, "/audio/") {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/audio/")
+	if key == "" && r.URL.Query().Get("list-type") == "2" {
+		b.list(w, r.URL.Query())
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		b.objects[key] = string(body)
+	case http.MethodGet, http.MethodHead:
+		body, ok := b.objects[key]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		if r.Method == http.MethodGet {
+			io.WriteString(w, body)
+		}
+	case http.MethodDelete:
+		delete(b.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// list answers ListObjectsV2 two keys a page, with the last key of a page
+// as its continuation token.
+func (b *fakeBucket) list(w http.ResponseWriter, query url.Values) {
+	var keys []string
+	for key := range b.objects {
+		if strings.HasPrefix(key, query.Get("prefix")) && key > query.Get("continuation-token") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	truncated := len(keys) > 2
+	if truncated {
+		keys = keys[:2]
+	}
+	io.WriteString(w, "<ListBucketResult>")
+	for _, key := range keys {
+		fmt.Fprintf(w, "<Contents><Key>%s</Key><Size>%d</Size></Contents>", key, len(b.objects[key]))
+	}
+	if truncated {
+		fmt.Fprintf(w, "<IsTruncated>true</IsTruncated><NextContinuationToken>%s</NextContinuationToken>", keys[1])
+	}
+	io.WriteString(w, "</ListBucketResult>")
+}
+
+func newTestS3(t *testing.T) (*S3, *fakeBucket) {
+	bucket := &fakeBucket{objects: map[string]string{}}
+	srv := httptest.NewServer(bucket)
+	t.Cleanup(srv.Close)
+	store, err := NewS3(S3Config{Bucket: "audio", Region: "auto", Endpoint: srv.URL, AccessKeyID: "key", SecretAccessKey: "secret", PathStyle: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store, bucket
+}
+
+func TestS3RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	store, _ := newTestS3(t)
+
+	n, err := store.Put(ctx, "recordings/1/audio.wav", strings.NewReader("RIFF"))
+	if err != nil || n != 4 {
+		t.Fatalf("put: n=%d err=%v", n, err)
+	}
+	if size, err := store.Stat(ctx, "recordings/1/audio.wav"); err != nil || size != 4 {
+		t.Fatalf("stat: size=%d err=%v", size, err)
+	}
+	rc, err := store.Open(ctx, "recordings/1/audio.wav")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	data, _ := io.ReadAll(rc)
+	rc.Close()
+	if string(data) != "RIFF" {
+		t.Fatalf("unexpected content %q", data)
+	}
+
+	if err := store.Delete(ctx, "recordings/1/audio.wav"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := store.Open(ctx, "recordings/1/audio.wav"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := store.Stat(ctx, "recordings/1/audio.wav"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound from stat, got %v", err)
+	}
+}
+
+func TestS3PresignedPut(t *testing.T) {
+	store, bucket := newTestS3(t)
+	url, headers, err := store.PresignPut("recordings/2/audio.mp3", "audio/mpeg", 3, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(url, "X-Amz-SignedHeaders=content-length%3Bcontent-type%3Bhost") || !strings.Contains(url, "X-Amz-Expires=3600") {
+		t.Fatalf("url = %s", url)
+	}
+	req, _ := http.NewRequest(http.MethodPut, url, strings.NewReader("ID3"))
+	for name := range headers {
+		req.Header.Set(name, headers.Get(name))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || bucket.objects["recordings/2/audio.mp3"] != "ID3" {
+		t.Fatalf("status %d, objects %v", resp.StatusCode, bucket.objects)
+	}
+	if _, ok := AsPresigner(store); !ok {
+		t.Fatal("S3 isn't a Presigner")
+	}
+}
+
+func TestS3ListFollowsContinuationTokens(t *testing.T) {
+	store, bucket := newTestS3(t)
+	for _, key := range []string{"archive/2023/a.mp3", "archive/2023/b b.mp3", "archive/2024/c.wav", "other/d.mp3"} {
+		bucket.objects[key] = key
+	}
+	objects, err := store.List(context.Background(), "archive/")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(objects) != 3 || objects[1].Key != "archive/2023/b b.mp3" || objects[2].Key != "archive/2024/c.wav" || objects[2].Size != int64(len("archive/2024/c.wav")) {
+		t.Fatalf("objects = %+v", objects)
+	}
+}
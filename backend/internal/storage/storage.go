@@ -0,0 +1,107 @@
+// Package storage persists binary objects such as recording audio. Objects
+// are addressed by slash-separated keys so the same layout works on local
+// disk and on object stores.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned when an object does not exist.
+var ErrNotFound = errors.New("storage: object not found")
+
+// Store is implemented by every storage backend.
+type Store interface {
+	// Put writes the object, replacing any existing one, and returns the
+	// number of bytes stored.
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Presigner is implemented by stores clients can upload to directly,
+// without streaming the object through the server.
+type Presigner interface {
+	// PresignPut returns a URL accepting a PUT of the object and the
+	// headers the client must send with it.
+	PresignPut(key, contentType string, size int64, expires time.Duration) (string, http.Header, error)
+	// Stat returns the size of a stored object.
+	Stat(ctx context.Context, key string) (int64, error)
+}
+
+// Lister is implemented by stores that can enumerate their objects.
+type Lister interface {
+	// List returns the objects whose keys start with prefix, in key order.
+	List(ctx context.Context, prefix string) ([]Object, error)
+}
+
+// Object is a stored object found by List.
+type Object struct {
+	Key  string
+	Size int64
+}
+
+// AsLister returns the Lister behind s, looking through stores that wrap
+// another one with an Unwrap method.
+func AsLister(s Store) (Lister, bool) {
+	for s != nil {
+		if l, ok := s.(Lister); ok {
+			return l, true
+		}
+		wrapper, ok := s.(interface{ Unwrap() Store })
+		if !ok {
+			break
+		}
+		s = wrapper.Unwrap()
+	}
+	return nil, false
+}
+
+// AsPresigner returns the Presigner behind s, looking through stores that
+// wrap another one with an Unwrap method.
+func AsPresigner(s Store) (Presigner, bool) {
+	for s != nil {
+		if p, ok := s.(Presigner); ok {
+			return p, true
+		}
+		wrapper, ok := s.(interface{ Unwrap() Store })
+		if !ok {
+			break
+		}
+		s = wrapper.Unwrap()
+	}
+	return nil, false
+}
+
+// Unwrapped returns the innermost store behind s, looking through stores
+// that wrap another one with an Unwrap method. Objects read from it are as
+// they were stored, still encrypted for instance.
+func Unwrapped(s Store) Store {
+	for {
+		wrapper, ok := s.(interface{ Unwrap() Store })
+		if !ok {
+			return s
+		}
+		s = wrapper.Unwrap()
+	}
+}
+
+// CleanKey normalizes a key and rejects ones that would escape the store
+// root.
+func CleanKey(key string) (string, error) {
+	cleaned := path.Clean("/" + strings.TrimSpace(key))
+	cleaned = strings.TrimPrefix(cleaned, "/")
+	if cleaned == "" || cleaned == "." {
+		return "", errors.New("storage: empty key")
+	}
+	if strings.Contains(key, "..") {
+		return "", errors.New("storage: invalid key")
+	}
+	return cleaned, nil
+}
@@ -0,0 +1,226 @@
+// Package testdb gives tests a disposable Postgres database with every
+// migration applied.
+//
+// The server comes from TEST_DATABASE_URL, then DATABASE_URL; the role
+// needs CREATEDB. When neither is set, a postgres container is started
+// with docker for the lifetime of the test binary. Each call to New clones
+// a migrated template database, so tests do not see each other's rows.
+// Tests skip when no server is reachable by either route.
+package testdb
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// image is the Postgres version production runs.
+const image = "postgres:16-alpine"
+
+const readyTimeout = 30 * time.Second
+
+var errUnavailable = errors.New("no test database: set TEST_DATABASE_URL or install docker")
+
+var (
+	setupOnce sync.Once
+	setupErr  error
+	admin     *pgx.ConnConfig
+	template  string
+	container string
+)
+
+// New returns a pool connected to a fresh, migrated database that is
+// dropped when the test finishes.
+func New(t testing.TB) *pgxpool.Pool {
+	t.Helper()
+	setupOnce.Do(func() { setupErr = setup() })
+	if errors.Is(setupErr, errUnavailable) {
+		t.Skip(setupErr.Error())
+	}
+	if setupErr != nil {
+		t.Fatalf("test database: %v", setupErr)
+	}
+
+	ctx := context.Background()
+	name := "secretary_test_" + randomSuffix()
+	if err := adminExec(ctx, fmt.Sprintf("CREATE DATABASE %s TEMPLATE %s", name, template)); err != nil {
+		t.Fatalf("create test database: %v", err)
+	}
+	config, err := pgxpool.ParseConfig(admin.ConnString())
+	if err != nil {
+		t.Fatalf("parse test database config: %v", err)
+	}
+	config.ConnConfig.Database = name
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+	t.Cleanup(func() {
+		pool.Close()
+		if err := adminExec(context.Background(), fmt.Sprintf("DROP DATABASE IF EXISTS %s WITH (FORCE)", name)); err != nil {
+			t.Logf("drop test database %s: %v", name, err)
+		}
+	})
+	return pool
+}
+
+// Main runs the tests and then removes the template database and any
+// container New started. Call it from TestMain:
+//
+//	func TestMain(m *testing.M) { os.Exit(testdb.Main(m)) }
+func Main(m *testing.M) int {
+	code := m.Run()
+	if template != "" {
+		if err := adminExec(context.Background(), "DROP DATABASE IF EXISTS "+template+" WITH (FORCE)"); err != nil {
+			fmt.Fprintf(os.Stderr, "testdb: drop template: %v\n", err)
+		}
+	}
+	if container != "" {
+		if out, err := exec.Command("docker", "rm", "-f", container).CombinedOutput(); err != nil {
+			fmt.Fprintf(os.Stderr, "testdb: remove container: %v: %s\n", err, out)
+		}
+	}
+	return code
+}
+
+func setup() error {
+	ctx := context.Background()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		dsn = os.Getenv("DATABASE_URL")
+	}
+	if dsn == "" {
+		if _, err := exec.LookPath("docker"); err != nil {
+			return errUnavailable
+		}
+		var err error
+		if dsn, err = startContainer(); err != nil {
+			return err
+		}
+	}
+
+	config, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return err
+	}
+	admin = config
+	if err := waitReady(ctx); err != nil {
+		return err
+	}
+
+	template = "secretary_test_template_" + randomSuffix()
+	if err := adminExec(ctx, "CREATE DATABASE "+template); err != nil {
+		return fmt.Errorf("create template database: %w", err)
+	}
+	templateConfig := admin.Copy()
+	templateConfig.Database = template
+	conn, err := pgx.ConnectConfig(ctx, templateConfig)
+	if err != nil {
+		return err
+	}
+	// The template must have no open connections when it is cloned.
+	defer conn.Close(ctx)
+	return applyMigrations(ctx, conn, migrationsDir())
+}
+
+// startContainer runs Postgres on a random loopback port and returns its
+// connection string.
+func startContainer() (string, error) {
+	out, err := exec.Command("docker", "run", "-d", "--rm",
+		"-e", "POSTGRES_PASSWORD=secretary",
+		"-p", "127.0.0.1::5432",
+		image,
+	).Output()
+	if err != nil {
+		return "", fmt.Errorf("start postgres container: %w", commandError(err))
+	}
+	container = strings.TrimSpace(string(out))
+	out, err = exec.Command("docker", "port", container, "5432/tcp").Output()
+	if err != nil {
+		return "", fmt.Errorf("inspect postgres container: %w", commandError(err))
+	}
+	hostPort, _, _ := strings.Cut(strings.TrimSpace(string(out)), "\n")
+	return "postgres://postgres:secretary@" + hostPort + "/postgres?sslmode=disable", nil
+}
+
+func waitReady(ctx context.Context) error {
+	deadline := time.Now().Add(readyTimeout)
+	for {
+		conn, err := pgx.ConnectConfig(ctx, admin)
+		if err == nil {
+			err = conn.Ping(ctx)
+			conn.Close(ctx)
+			if err == nil {
+				return nil
+			}
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("postgres not ready after %s: %w", readyTimeout, err)
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+}
+
+// applyMigrations runs every migration in file-name order, the same order
+// atlas applies them.
+func applyMigrations(ctx context.Context, conn *pgx.Conn, dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+		sql, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		if _, err := conn.Exec(ctx, string(sql)); err != nil {
+			return fmt.Errorf("apply migration %s: %w", entry.Name(), err)
+		}
+	}
+	return nil
+}
+
+func migrationsDir() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "migrations")
+}
+
+func adminExec(ctx context.Context, sql string) error {
+	conn, err := pgx.ConnectConfig(ctx, admin)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+	_, err = conn.Exec(ctx, sql)
+	return err
+}
+
+func randomSuffix() string {
+	b := make([]byte, 6)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func commandError(err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return err
+}
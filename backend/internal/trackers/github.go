@@ -0,0 +1,106 @@
+package trackers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const defaultGitHubURL = "https://api.github.com"
+
+// GitHubConfig files issues in one repository. Token needs the issues
+// write permission.
+type GitHubConfig struct {
+	BaseURL       string
+	Token         string
+	Repo          string // "owner/name"
+	WebhookSecret string
+}
+
+type GitHubTracker struct {
+	cfg  GitHubConfig
+	http *http.Client
+}
+
+func NewGitHub(cfg GitHubConfig) *GitHubTracker {
+	cfg.BaseURL = strings.TrimRight(strings.TrimSpace(cfg.BaseURL), "/")
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = defaultGitHubURL
+	}
+	return &GitHubTracker{cfg: cfg, http: &http.Client{Timeout: requestTimeout}}
+}
+
+func (g *GitHubTracker) Name() string { return GitHub }
+
+type githubIssue struct {
+	ID          int64  `json:"id"`
+	Number      int64  `json:"number"`
+	HTMLURL     string `json:"html_url"`
+	State       string `json:"state"`
+	StateReason string `json:"state_reason"`
+}
+
+func (g *GitHubTracker) CreateIssue(ctx context.Context, issue Issue) (Created, error) {
+	var out githubIssue
+	err := doJSON(ctx, g.http, GitHub, http.MethodPost, g.cfg.BaseURL+"/repos/"+g.cfg.Repo+"/issues", g.authorize,
+		map[string]string{"title": issue.Title, "body": issue.Description}, &out)
+	if err != nil {
+		return Created{}, err
+	}
+	return Created{
+		ExternalID: strconv.FormatInt(out.ID, 10),
+		Key:        "#" + strconv.FormatInt(out.Number, 10),
+		URL:        out.HTMLURL,
+		State:      out.State,
+	}, nil
+}
+
+func (g *GitHubTracker) IssueState(ctx context.Context, ref IssueRef) (Event, error) {
+	var out githubIssue
+	url := g.cfg.BaseURL + "/repos/" + g.cfg.Repo + "/issues/" + strings.TrimPrefix(ref.Key, "#")
+	if err := doJSON(ctx, g.http, GitHub, http.MethodGet, url, g.authorize, nil, &out); err != nil {
+		return Event{}, err
+	}
+	return githubEvent(out), nil
+}
+
+// githubEvent maps an issue's state. Issues closed as not planned map to
+// skipped rather than done.
+func githubEvent(issue githubIssue) Event {
+	event := Event{ExternalID: strconv.FormatInt(issue.ID, 10), State: issue.State, Status: "todo"}
+	if issue.State == "closed" {
+		event.Status = "done"
+		if issue.StateReason == "not_planned" {
+			event.Status = "skipped"
+		}
+	}
+	return event
+}
+
+func (g *GitHubTracker) authorize(req *http.Request) {
+	req.Header.Set("Authorization", "Bearer "+g.cfg.Token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+}
+
+// ParseWebhook reads the "issues" events that close or reopen an issue.
+func (g *GitHubTracker) ParseWebhook(header http.Header, body []byte) (Event, bool, error) {
+	if err := verifySignature(g.cfg.WebhookSecret, body, header.Get("X-Hub-Signature-256")); err != nil {
+		return Event{}, false, err
+	}
+	if header.Get("X-GitHub-Event") != "issues" {
+		return Event{}, false, nil
+	}
+	var payload struct {
+		Action string      `json:"action"`
+		Issue  githubIssue `json:"issue"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return Event{}, false, err
+	}
+	if payload.Action != "closed" && payload.Action != "reopened" {
+		return Event{}, false, nil
+	}
+	return githubEvent(payload.Issue), true, nil
+}
@@ -0,0 +1,132 @@
+package trackers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// JiraConfig files issues in one Jira Cloud project, authenticating with
+// an Atlassian account email and API token.
+type JiraConfig struct {
+	BaseURL       string // e.g. "https://example.atlassian.net"
+	Email         string
+	APIToken      string
+	ProjectKey    string
+	IssueType     string
+	WebhookSecret string
+}
+
+type JiraTracker struct {
+	cfg  JiraConfig
+	http *http.Client
+}
+
+func NewJira(cfg JiraConfig) *JiraTracker {
+	cfg.BaseURL = strings.TrimRight(strings.TrimSpace(cfg.BaseURL), "/")
+	if cfg.IssueType == "" {
+		cfg.IssueType = "Task"
+	}
+	return &JiraTracker{cfg: cfg, http: &http.Client{Timeout: requestTimeout}}
+}
+
+func (j *JiraTracker) Name() string { return Jira }
+
+func (j *JiraTracker) CreateIssue(ctx context.Context, issue Issue) (Created, error) {
+	fields := map[string]any{
+		"project":   map[string]string{"key": j.cfg.ProjectKey},
+		"issuetype": map[string]string{"name": j.cfg.IssueType},
+		"summary":   issue.Title,
+	}
+	if issue.Description != "" {
+		fields["description"] = jiraDocument(issue.Description)
+	}
+	var out struct {
+		ID  string `json:"id"`
+		Key string `json:"key"`
+	}
+	if err := doJSON(ctx, j.http, Jira, http.MethodPost, j.cfg.BaseURL+"/rest/api/3/issue", j.authorize, map[string]any{"fields": fields}, &out); err != nil {
+		return Created{}, err
+	}
+	return Created{ExternalID: out.ID, Key: out.Key, URL: j.cfg.BaseURL + "/browse/" + out.Key}, nil
+}
+
+type jiraStatus struct {
+	Name           string `json:"name"`
+	StatusCategory struct {
+		Key string `json:"key"`
+	} `json:"statusCategory"`
+}
+
+func (j *JiraTracker) IssueState(ctx context.Context, ref IssueRef) (Event, error) {
+	var out struct {
+		ID     string `json:"id"`
+		Fields struct {
+			Status jiraStatus `json:"status"`
+		} `json:"fields"`
+	}
+	url := j.cfg.BaseURL + "/rest/api/3/issue/" + ref.ExternalID + "?fields=status"
+	if err := doJSON(ctx, j.http, Jira, http.MethodGet, url, j.authorize, nil, &out); err != nil {
+		return Event{}, err
+	}
+	return jiraEvent(out.ID, out.Fields.Status), nil
+}
+
+// jiraEvent maps the status category (To Do, In Progress, Done) onto todo
+// statuses, since status names vary between workflows.
+func jiraEvent(id string, status jiraStatus) Event {
+	event := Event{ExternalID: id, State: status.Name}
+	switch status.StatusCategory.Key {
+	case "done":
+		event.Status = "done"
+	case "indeterminate":
+		event.Status = "doing"
+	case "new":
+		event.Status = "todo"
+	}
+	return event
+}
+
+func (j *JiraTracker) authorize(req *http.Request) {
+	req.SetBasicAuth(j.cfg.Email, j.cfg.APIToken)
+}
+
+// jiraDocument wraps plain text in the Atlassian document format the v3
+// API requires, one paragraph per blank-line separated block.
+func jiraDocument(text string) map[string]any {
+	var content []map[string]any
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph == "" {
+			continue
+		}
+		content = append(content, map[string]any{
+			"type":    "paragraph",
+			"content": []map[string]string{{"type": "text", "text": paragraph}},
+		})
+	}
+	return map[string]any{"type": "doc", "version": 1, "content": content}
+}
+
+// ParseWebhook reads issue update events.
+func (j *JiraTracker) ParseWebhook(header http.Header, body []byte) (Event, bool, error) {
+	if err := verifySignature(j.cfg.WebhookSecret, body, header.Get("X-Hub-Signature")); err != nil {
+		return Event{}, false, err
+	}
+	var payload struct {
+		WebhookEvent string `json:"webhookEvent"`
+		Issue        struct {
+			ID     string `json:"id"`
+			Fields struct {
+				Status jiraStatus `json:"status"`
+			} `json:"fields"`
+		} `json:"issue"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return Event{}, false, err
+	}
+	if payload.WebhookEvent != "jira:issue_updated" || payload.Issue.ID == "" {
+		return Event{}, false, nil
+	}
+	return jiraEvent(payload.Issue.ID, payload.Issue.Fields.Status), true, nil
+}
@@ -0,0 +1,152 @@
+package trackers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+const defaultLinearURL = "https://api.linear.app"
+
+// LinearConfig files issues in one team. APIKey is a personal API key.
+type LinearConfig struct {
+	BaseURL       string
+	APIKey        string
+	TeamID        string
+	WebhookSecret string
+}
+
+type LinearTracker struct {
+	cfg  LinearConfig
+	http *http.Client
+}
+
+func NewLinear(cfg LinearConfig) *LinearTracker {
+	cfg.BaseURL = strings.TrimRight(strings.TrimSpace(cfg.BaseURL), "/")
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = defaultLinearURL
+	}
+	return &LinearTracker{cfg: cfg, http: &http.Client{Timeout: requestTimeout}}
+}
+
+func (l *LinearTracker) Name() string { return Linear }
+
+const linearIssueCreate = `mutation IssueCreate($input: IssueCreateInput!) {
+  issueCreate(input: $input) {
+    success
+    issue { id identifier url state { name } }
+  }
+}`
+
+type linearState struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+func (l *LinearTracker) CreateIssue(ctx context.Context, issue Issue) (Created, error) {
+	body := map[string]any{
+		"query": linearIssueCreate,
+		"variables": map[string]any{"input": map[string]string{
+			"teamId":      l.cfg.TeamID,
+			"title":       issue.Title,
+			"description": issue.Description,
+		}},
+	}
+	var out struct {
+		Data struct {
+			IssueCreate struct {
+				Success bool `json:"success"`
+				Issue   struct {
+					ID         string      `json:"id"`
+					Identifier string      `json:"identifier"`
+					URL        string      `json:"url"`
+					State      linearState `json:"state"`
+				} `json:"issue"`
+			} `json:"issueCreate"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := doJSON(ctx, l.http, Linear, http.MethodPost, l.cfg.BaseURL+"/graphql", l.authorize, body, &out); err != nil {
+		return Created{}, err
+	}
+	// GraphQL reports failures in the body of a 200 response.
+	if len(out.Errors) > 0 {
+		return Created{}, errors.New("linear: " + out.Errors[0].Message)
+	}
+	if !out.Data.IssueCreate.Success {
+		return Created{}, errors.New("linear: issue was not created")
+	}
+	created := out.Data.IssueCreate.Issue
+	return Created{ExternalID: created.ID, Key: created.Identifier, URL: created.URL, State: created.State.Name}, nil
+}
+
+const linearIssueState = `query IssueState($id: String!) {
+  issue(id: $id) { id state { name type } }
+}`
+
+func (l *LinearTracker) IssueState(ctx context.Context, ref IssueRef) (Event, error) {
+	body := map[string]any{"query": linearIssueState, "variables": map[string]string{"id": ref.ExternalID}}
+	var out struct {
+		Data struct {
+			Issue struct {
+				ID    string      `json:"id"`
+				State linearState `json:"state"`
+			} `json:"issue"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := doJSON(ctx, l.http, Linear, http.MethodPost, l.cfg.BaseURL+"/graphql", l.authorize, body, &out); err != nil {
+		return Event{}, err
+	}
+	if len(out.Errors) > 0 {
+		return Event{}, errors.New("linear: " + out.Errors[0].Message)
+	}
+	return linearEvent(out.Data.Issue.ID, out.Data.Issue.State), nil
+}
+
+// linearEvent maps Linear's workflow state types onto todo statuses.
+func linearEvent(id string, state linearState) Event {
+	event := Event{ExternalID: id, State: state.Name}
+	switch state.Type {
+	case "completed":
+		event.Status = "done"
+	case "canceled":
+		event.Status = "skipped"
+	case "started":
+		event.Status = "doing"
+	case "unstarted", "backlog", "triage":
+		event.Status = "todo"
+	}
+	return event
+}
+
+func (l *LinearTracker) authorize(req *http.Request) {
+	req.Header.Set("Authorization", l.cfg.APIKey)
+}
+
+// ParseWebhook reads Issue events.
+func (l *LinearTracker) ParseWebhook(header http.Header, body []byte) (Event, bool, error) {
+	if err := verifySignature(l.cfg.WebhookSecret, body, header.Get("Linear-Signature")); err != nil {
+		return Event{}, false, err
+	}
+	var payload struct {
+		Type string `json:"type"`
+		Data struct {
+			ID    string      `json:"id"`
+			State linearState `json:"state"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return Event{}, false, err
+	}
+	if payload.Type != "Issue" || payload.Data.ID == "" {
+		return Event{}, false, nil
+	}
+	return linearEvent(payload.Data.ID, payload.Data.State), true, nil
+}
@@ -0,0 +1,135 @@
+// Package trackers files todos as issues in external issue trackers
+// (GitHub, Linear and Jira) and reads the webhooks those trackers send
+// when an issue changes state.
+package trackers
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+// Tracker names, as stored in todo_tracker_link.tracker.
+const (
+	GitHub = "github"
+	Linear = "linear"
+	Jira   = "jira"
+)
+
+// ErrBadSignature is returned for webhook deliveries that are unsigned or
+// signed with the wrong secret.
+var ErrBadSignature = errors.New("trackers: webhook signature does not match")
+
+// Issue is what gets filed for a todo.
+type Issue struct {
+	Title       string
+	Description string
+}
+
+// Created identifies a newly filed issue. ExternalID is the tracker's
+// stable id, the one its webhooks refer to; Key is the reference people
+// use, such as "ENG-42".
+type Created struct {
+	ExternalID string
+	Key        string
+	URL        string
+	State      string
+}
+
+// IssueRef points at an issue filed earlier, as returned in Created.
+type IssueRef struct {
+	ExternalID string
+	Key        string
+}
+
+// Event is an issue's state, as reported by a webhook or fetched with
+// IssueState. Status is the todo status the state corresponds to, or empty
+// when there is none.
+type Event struct {
+	ExternalID string
+	State      string
+	Status     string
+}
+
+// Tracker files issues in one tracker and reads its webhooks.
+type Tracker interface {
+	Name() string
+	CreateIssue(ctx context.Context, issue Issue) (Created, error)
+	// IssueState fetches an issue's current state, for trackers whose
+	// webhooks cannot reach the server.
+	IssueState(ctx context.Context, ref IssueRef) (Event, error)
+	// ParseWebhook checks the delivery's signature and returns the issue
+	// change it reports. ok is false for deliveries about anything else,
+	// such as comments or other kinds of records.
+	ParseWebhook(header http.Header, body []byte) (event Event, ok bool, err error)
+}
+
+const requestTimeout = 30 * time.Second
+
+// doJSON sends body, if any, as JSON and decodes the reply into out.
+// setAuth adds the tracker's credentials.
+func doJSON(ctx context.Context, client *http.Client, tracker string, method string, url string, setAuth func(*http.Request), body any, out any) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	setAuth(req)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 400 {
+		return apierr.NewProviderError(tracker, resp, respBody)
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("decode %s response: %w", tracker, err)
+	}
+	return nil
+}
+
+// verifySignature checks a hex HMAC-SHA256 of body, optionally prefixed
+// with "sha256=". Deliveries are refused outright when no secret is
+// configured, since anyone could otherwise close todos.
+func verifySignature(secret string, body []byte, signature string) error {
+	if secret == "" {
+		return ErrBadSignature
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(signature), "sha256="))
+	if err != nil {
+		return ErrBadSignature
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrBadSignature
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package trackers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+func sign(secret string, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestGitHubCreateIssue(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/acme/app/issues" || r.Header.Get("Authorization") != "Bearer tok" {
+			http.Error(w, `{"message":"Bad credentials"}`, http.StatusUnauthorized)
+			return
+		}
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["title"] != "Write release notes" {
+			t.Errorf("body = %v", body)
+		}
+		_, _ = io.WriteString(w, `{"id":9001,"number":17,"html_url":"https://github.com/acme/app/issues/17","state":"open"}`)
+	}))
+	defer api.Close()
+
+	created, err := NewGitHub(GitHubConfig{BaseURL: api.URL, Token: "tok", Repo: "acme/app"}).CreateIssue(context.Background(), Issue{Title: "Write release notes"})
+	if err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+	if created.ExternalID != "9001" || created.Key != "#17" || created.State != "open" {
+		t.Fatalf("created = %+v", created)
+	}
+
+	_, err = NewGitHub(GitHubConfig{BaseURL: api.URL, Token: "wrong", Repo: "acme/app"}).CreateIssue(context.Background(), Issue{Title: "x"})
+	var providerErr *apierr.ProviderError
+	if !errors.As(err, &providerErr) || providerErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("err = %v, want a provider error", err)
+	}
+}
+
+func TestGitHubParseWebhook(t *testing.T) {
+	tracker := NewGitHub(GitHubConfig{WebhookSecret: "shh"})
+	body := `{"action":"closed","issue":{"id":9001,"state":"closed","state_reason":"not_planned"}}`
+	header := http.Header{}
+	header.Set("X-GitHub-Event", "issues")
+	header.Set("X-Hub-Signature-256", "sha256="+sign("shh", body))
+
+	event, ok, err := tracker.ParseWebhook(header, []byte(body))
+	if err != nil || !ok {
+		t.Fatalf("ParseWebhook = %v, %v", ok, err)
+	}
+	if event.ExternalID != "9001" || event.Status != "skipped" || event.State != "closed" {
+		t.Fatalf("event = %+v", event)
+	}
+
+	header.Set("X-Hub-Signature-256", "sha256="+sign("other", body))
+	if _, _, err := tracker.ParseWebhook(header, []byte(body)); !errors.Is(err, ErrBadSignature) {
+		t.Fatalf("forged delivery err = %v", err)
+	}
+
+	if _, _, err := NewGitHub(GitHubConfig{}).ParseWebhook(header, []byte(body)); !errors.Is(err, ErrBadSignature) {
+		t.Fatalf("delivery without a secret configured err = %v", err)
+	}
+}
+
+func TestLinearCreateIssueGraphQLError(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"errors":[{"message":"Team not found"}]}`)
+	}))
+	defer api.Close()
+
+	_, err := NewLinear(LinearConfig{BaseURL: api.URL, APIKey: "key", TeamID: "t"}).CreateIssue(context.Background(), Issue{Title: "x"})
+	if err == nil || err.Error() != "linear: Team not found" {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+func TestLinearParseWebhook(t *testing.T) {
+	tracker := NewLinear(LinearConfig{WebhookSecret: "shh"})
+	tests := []struct {
+		body   string
+		ok     bool
+		status string
+	}{
+		{`{"type":"Issue","data":{"id":"abc","state":{"name":"In Review","type":"started"}}}`, true, "doing"},
+		{`{"type":"Issue","data":{"id":"abc","state":{"name":"Done","type":"completed"}}}`, true, "done"},
+		{`{"type":"Comment","data":{"id":"c1"}}`, false, ""},
+	}
+	for _, tt := range tests {
+		header := http.Header{}
+		header.Set("Linear-Signature", sign("shh", tt.body))
+		event, ok, err := tracker.ParseWebhook(header, []byte(tt.body))
+		if err != nil || ok != tt.ok || event.Status != tt.status {
+			t.Errorf("%s: event = %+v, %v, %v", tt.body, event, ok, err)
+		}
+	}
+}
+
+func TestJiraCreateIssue(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ := r.BasicAuth()
+		if r.URL.Path != "/rest/api/3/issue" || user != "me@example.com" || pass != "tok" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		var body struct {
+			Fields struct {
+				Project     map[string]string `json:"project"`
+				IssueType   map[string]string `json:"issuetype"`
+				Description struct {
+					Content []any `json:"content"`
+				} `json:"description"`
+			} `json:"fields"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Fields.Project["key"] != "OPS" || body.Fields.IssueType["name"] != "Task" || len(body.Fields.Description.Content) != 2 {
+			t.Errorf("fields = %+v", body.Fields)
+		}
+		_, _ = io.WriteString(w, `{"id":"10042","key":"OPS-7"}`)
+	}))
+	defer api.Close()
+
+	tracker := NewJira(JiraConfig{BaseURL: api.URL + "/", Email: "me@example.com", APIToken: "tok", ProjectKey: "OPS"})
+	created, err := tracker.CreateIssue(context.Background(), Issue{Title: "Renew cert", Description: "Expires soon.\n\nAsk Ana."})
+	if err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+	if created.ExternalID != "10042" || created.URL != api.URL+"/browse/OPS-7" {
+		t.Fatalf("created = %+v", created)
+	}
+}
+
+func TestJiraIssueState(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/rest/api/3/issue/10042" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = io.WriteString(w, `{"id":"10042","fields":{"status":{"name":"Code review","statusCategory":{"key":"indeterminate"}}}}`)
+	}))
+	defer api.Close()
+
+	event, err := NewJira(JiraConfig{BaseURL: api.URL}).IssueState(context.Background(), IssueRef{ExternalID: "10042", Key: "OPS-7"})
+	if err != nil {
+		t.Fatalf("IssueState: %v", err)
+	}
+	if event.State != "Code review" || event.Status != "doing" {
+		t.Fatalf("event = %+v", event)
+	}
+}
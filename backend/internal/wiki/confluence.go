@@ -0,0 +1,132 @@
+package wiki
+
+import (
+	"context"
+	"errors"
+	"html"
+	"net/http"
+	"strings"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+// ConfluenceConfig adds pages to one space, under ParentPageID when set.
+// BaseURL is the wiki's root, e.g. "https://example.atlassian.net/wiki".
+// Cloud sites authenticate with an account email and API token.
+type ConfluenceConfig struct {
+	BaseURL      string
+	Email        string
+	APIToken     string
+	SpaceKey     string
+	ParentPageID string
+}
+
+type ConfluenceTarget struct {
+	cfg  ConfluenceConfig
+	http *http.Client
+}
+
+func NewConfluence(cfg ConfluenceConfig) *ConfluenceTarget {
+	cfg.BaseURL = strings.TrimRight(strings.TrimSpace(cfg.BaseURL), "/")
+	return &ConfluenceTarget{cfg: cfg, http: &http.Client{Timeout: requestTimeout}}
+}
+
+func (c *ConfluenceTarget) Name() string { return Confluence }
+
+type confluenceContent struct {
+	ID    string `json:"id"`
+	Links struct {
+		Base  string `json:"base"`
+		WebUI string `json:"webui"`
+	} `json:"_links"`
+	Version struct {
+		Number int `json:"number"`
+	} `json:"version"`
+}
+
+// Publish updates the previous page in place, keeping its history in
+// Confluence, or creates one when there is none or it was deleted.
+func (c *ConfluenceTarget) Publish(ctx context.Context, page Page, previous string) (Published, error) {
+	body := map[string]any{
+		"type":  "page",
+		"title": page.Title,
+		"space": map[string]string{"key": c.cfg.SpaceKey},
+		"body":  map[string]any{"storage": map[string]string{"value": confluenceBody(page), "representation": "storage"}},
+	}
+	var out confluenceContent
+	if previous != "" {
+		var current confluenceContent
+		err := doJSON(ctx, c.http, Confluence, http.MethodGet, c.cfg.BaseURL+"/rest/api/content/"+previous+"?expand=version", c.authorize, nil, &current)
+		var providerErr *apierr.ProviderError
+		switch {
+		case err == nil:
+			body["id"] = previous
+			body["version"] = map[string]int{"number": current.Version.Number + 1}
+			if err := doJSON(ctx, c.http, Confluence, http.MethodPut, c.cfg.BaseURL+"/rest/api/content/"+previous, c.authorize, body, &out); err != nil {
+				return Published{}, err
+			}
+			return c.published(out), nil
+		case errors.As(err, &providerErr) && providerErr.StatusCode == http.StatusNotFound:
+		default:
+			return Published{}, err
+		}
+	}
+	if c.cfg.ParentPageID != "" {
+		body["ancestors"] = []map[string]string{{"id": c.cfg.ParentPageID}}
+	}
+	if err := doJSON(ctx, c.http, Confluence, http.MethodPost, c.cfg.BaseURL+"/rest/api/content", c.authorize, body, &out); err != nil {
+		return Published{}, err
+	}
+	return c.published(out), nil
+}
+
+func (c *ConfluenceTarget) published(content confluenceContent) Published {
+	base := content.Links.Base
+	if base == "" {
+		base = c.cfg.BaseURL
+	}
+	return Published{ExternalID: content.ID, URL: base + content.Links.WebUI}
+}
+
+func (c *ConfluenceTarget) authorize(req *http.Request) {
+	req.SetBasicAuth(c.cfg.Email, c.cfg.APIToken)
+}
+
+// confluenceBody renders the page in Confluence's storage format, which is
+// XHTML.
+func confluenceBody(page Page) string {
+	var b strings.Builder
+	list := func(heading string, tag string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		b.WriteString("<h2>" + html.EscapeString(heading) + "</h2><" + tag + ">")
+		for _, item := range items {
+			b.WriteString("<li>" + html.EscapeString(item) + "</li>")
+		}
+		b.WriteString("</" + tag + ">")
+	}
+	text := func(heading string, value string) {
+		parts := paragraphs(value)
+		if len(parts) == 0 {
+			return
+		}
+		b.WriteString("<h2>" + html.EscapeString(heading) + "</h2>")
+		for _, p := range parts {
+			b.WriteString("<p>" + html.EscapeString(p) + "</p>")
+		}
+	}
+	text("Summary", page.Summary)
+	if m := page.Minutes; m != nil {
+		list("Attendees", "ul", m.Attendees)
+		list("Agenda", "ol", m.Agenda)
+		list("Decisions", "ul", m.Decisions)
+		list("Action items", "ul", m.ActionItems)
+		text("Notes", m.Notes)
+	}
+	if page.SourceURL != "" {
+		url := html.EscapeString(page.SourceURL)
+		b.WriteString(`<p>Recording: <a href="` + url + `">` + url + `</a></p>`)
+	}
+	return b.String()
+}
@@ -0,0 +1,156 @@
+package wiki
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+const (
+	defaultNotionURL = "https://api.notion.com"
+	notionVersion    = "2022-06-28"
+	// Notion caps rich text at 2000 characters and a request at 100
+	// blocks.
+	notionTextLimit  = 2000
+	notionBlockLimit = 100
+)
+
+// NotionConfig adds pages to one database. The property names map the
+// meeting onto the database's columns; DateProperty and URLProperty may be
+// empty to leave those out.
+type NotionConfig struct {
+	BaseURL       string
+	Token         string
+	DatabaseID    string
+	TitleProperty string
+	DateProperty  string
+	URLProperty   string
+}
+
+type NotionTarget struct {
+	cfg  NotionConfig
+	http *http.Client
+}
+
+func NewNotion(cfg NotionConfig) *NotionTarget {
+	cfg.BaseURL = strings.TrimRight(strings.TrimSpace(cfg.BaseURL), "/")
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = defaultNotionURL
+	}
+	if cfg.TitleProperty == "" {
+		cfg.TitleProperty = "Name"
+	}
+	return &NotionTarget{cfg: cfg, http: &http.Client{Timeout: requestTimeout}}
+}
+
+func (n *NotionTarget) Name() string { return Notion }
+
+// Publish archives the previous page and adds a new one, since Notion has
+// no call to replace a page's content.
+func (n *NotionTarget) Publish(ctx context.Context, page Page, previous string) (Published, error) {
+	if previous != "" {
+		err := doJSON(ctx, n.http, Notion, http.MethodPatch, n.cfg.BaseURL+"/v1/pages/"+previous, n.authorize, map[string]bool{"archived": true}, nil)
+		var providerErr *apierr.ProviderError
+		if err != nil && !(errors.As(err, &providerErr) && providerErr.StatusCode == http.StatusNotFound) {
+			return Published{}, err
+		}
+	}
+
+	properties := map[string]any{
+		n.cfg.TitleProperty: map[string]any{"title": notionText(page.Title)},
+	}
+	if n.cfg.DateProperty != "" && !page.Date.IsZero() {
+		properties[n.cfg.DateProperty] = map[string]any{"date": map[string]string{"start": page.Date.Format("2006-01-02")}}
+	}
+	if n.cfg.URLProperty != "" && page.SourceURL != "" {
+		properties[n.cfg.URLProperty] = map[string]string{"url": page.SourceURL}
+	}
+	blocks := notionBlocks(page)
+	first := blocks[:min(len(blocks), notionBlockLimit)]
+	var created struct {
+		ID  string `json:"id"`
+		URL string `json:"url"`
+	}
+	body := map[string]any{
+		"parent":     map[string]string{"database_id": n.cfg.DatabaseID},
+		"properties": properties,
+		"children":   first,
+	}
+	if err := doJSON(ctx, n.http, Notion, http.MethodPost, n.cfg.BaseURL+"/v1/pages", n.authorize, body, &created); err != nil {
+		return Published{}, err
+	}
+	for rest := blocks[len(first):]; len(rest) > 0; {
+		batch := rest[:min(len(rest), notionBlockLimit)]
+		rest = rest[len(batch):]
+		if err := doJSON(ctx, n.http, Notion, http.MethodPatch, n.cfg.BaseURL+"/v1/blocks/"+created.ID+"/children", n.authorize, map[string]any{"children": batch}, nil); err != nil {
+			return Published{}, err
+		}
+	}
+	return Published{ExternalID: created.ID, URL: created.URL}, nil
+}
+
+func (n *NotionTarget) authorize(req *http.Request) {
+	req.Header.Set("Authorization", "Bearer "+n.cfg.Token)
+	req.Header.Set("Notion-Version", notionVersion)
+}
+
+// notionText splits text into rich text objects under Notion's length cap.
+func notionText(text string) []map[string]any {
+	var parts []map[string]any
+	runes := []rune(text)
+	for len(runes) > 0 {
+		chunk := runes[:min(len(runes), notionTextLimit)]
+		runes = runes[len(chunk):]
+		parts = append(parts, map[string]any{"type": "text", "text": map[string]string{"content": string(chunk)}})
+	}
+	return parts
+}
+
+func notionBlock(kind string, text string) map[string]any {
+	content := map[string]any{"rich_text": notionText(text)}
+	if kind == "to_do" {
+		content["checked"] = false
+	}
+	return map[string]any{"object": "block", "type": kind, kind: content}
+}
+
+// notionBlocks lays out the page: the summary, then each part of the
+// minutes under its own heading. Empty parts are left out.
+func notionBlocks(page Page) []map[string]any {
+	var blocks []map[string]any
+	section := func(heading string, kind string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		blocks = append(blocks, notionBlock("heading_2", heading))
+		for _, item := range items {
+			blocks = append(blocks, notionBlock(kind, item))
+		}
+	}
+	section("Summary", "paragraph", paragraphs(page.Summary))
+	if m := page.Minutes; m != nil {
+		section("Attendees", "bulleted_list_item", m.Attendees)
+		section("Agenda", "numbered_list_item", m.Agenda)
+		section("Decisions", "bulleted_list_item", m.Decisions)
+		section("Action items", "to_do", m.ActionItems)
+		section("Notes", "paragraph", paragraphs(m.Notes))
+	}
+	if page.SourceURL != "" {
+		blocks = append(blocks, notionBlock("paragraph", "Recording: "+page.SourceURL))
+	}
+	return blocks
+}
+
+// paragraphs splits text on blank lines.
+func paragraphs(text string) []string {
+	var out []string
+	for _, p := range strings.Split(text, "\n\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,98 @@
+// Package wiki publishes meeting notes as pages in a team wiki: a Notion
+// database or a Confluence space.
+package wiki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/mvult/secretary/backend/internal/apierr"
+)
+
+// Target names, as stored in recording_publication.target.
+const (
+	Notion     = "notion"
+	Confluence = "confluence"
+)
+
+// Page is a meeting's notes. Summary and Minutes are each optional.
+type Page struct {
+	Title     string
+	Date      time.Time
+	SourceURL string
+	Summary   string
+	Minutes   *Minutes
+}
+
+// Minutes are a meeting's minutes flattened to text; action items already
+// carry their owner and due date.
+type Minutes struct {
+	Attendees   []string
+	Agenda      []string
+	Decisions   []string
+	ActionItems []string
+	Notes       string
+}
+
+// Published identifies a page in the wiki.
+type Published struct {
+	ExternalID string
+	URL        string
+}
+
+// Target publishes pages to one wiki.
+type Target interface {
+	Name() string
+	// Publish writes page to the wiki. previous is the ExternalID from an
+	// earlier Publish of the same meeting, or empty; that page is replaced
+	// so the wiki keeps one page per meeting.
+	Publish(ctx context.Context, page Page, previous string) (Published, error)
+}
+
+const requestTimeout = 30 * time.Second
+
+// doJSON sends body, if any, as JSON and decodes the reply into out when
+// out is not nil. setAuth adds the wiki's credentials.
+func doJSON(ctx context.Context, client *http.Client, target string, method string, url string, setAuth func(*http.Request), body any, out any) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	setAuth(req)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 400 {
+		return apierr.NewProviderError(target, resp, respBody)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("decode %s response: %w", target, err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNotionPublishReplacesPrevious(t *testing.T) {
+	var archived string
+	var appended int
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("Notion-Version") == "" {
+			http.Error(w, `{"message":"unauthorized"}`, http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == "/v1/pages/old":
+			archived = "old"
+			_, _ = io.WriteString(w, `{}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/pages":
+			var body struct {
+				Parent     map[string]string          `json:"parent"`
+				Properties map[string]json.RawMessage `json:"properties"`
+				Children   []map[string]any           `json:"children"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body.Parent["database_id"] != "db1" || body.Properties["Meeting"] == nil || body.Properties["When"] == nil {
+				t.Errorf("body = %+v", body)
+			}
+			if len(body.Children) != notionBlockLimit {
+				t.Errorf("children = %d, want %d", len(body.Children), notionBlockLimit)
+			}
+			_, _ = io.WriteString(w, `{"id":"new","url":"https://notion.so/new"}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/v1/blocks/new/children":
+			var body struct {
+				Children []any `json:"children"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			appended += len(body.Children)
+			_, _ = io.WriteString(w, `{}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer api.Close()
+
+	decisions := make([]string, 120)
+	for i := range decisions {
+		decisions[i] = "Decision"
+	}
+	target := NewNotion(NotionConfig{BaseURL: api.URL, Token: "secret", DatabaseID: "db1", TitleProperty: "Meeting", DateProperty: "When"})
+	published, err := target.Publish(context.Background(), Page{
+		Title:   "Weekly sync",
+		Date:    time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		Summary: "We shipped.",
+		Minutes: &Minutes{Decisions: decisions},
+	}, "old")
+	if err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if published.ExternalID != "new" || published.URL != "https://notion.so/new" {
+		t.Fatalf("published = %+v", published)
+	}
+	if archived != "old" {
+		t.Fatal("previous page was not archived")
+	}
+	// The summary heading and paragraph, then the decisions heading and 120 items.
+	if appended != 123-notionBlockLimit {
+		t.Fatalf("appended = %d", appended)
+	}
+}
+
+func TestNotionTextSplitsLongText(t *testing.T) {
+	parts := notionText(strings.Repeat("a", notionTextLimit+1))
+	if len(parts) != 2 {
+		t.Fatalf("parts = %d, want 2", len(parts))
+	}
+}
+
+func TestConfluencePublishUpdatesPrevious(t *testing.T) {
+	var put map[string]any
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ := r.BasicAuth()
+		if user != "me@example.com" || pass != "tok" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/wiki/rest/api/content/42":
+			_, _ = io.WriteString(w, `{"id":"42","version":{"number":3}}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/wiki/rest/api/content/42":
+			_ = json.NewDecoder(r.Body).Decode(&put)
+			_, _ = io.WriteString(w, `{"id":"42","_links":{"base":"https://acme.atlassian.net/wiki","webui":"/spaces/OPS/pages/42"}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer api.Close()
+
+	target := NewConfluence(ConfluenceConfig{BaseURL: api.URL + "/wiki/", Email: "me@example.com", APIToken: "tok", SpaceKey: "OPS"})
+	published, err := target.Publish(context.Background(), Page{Title: "Weekly sync", Summary: "Fish & chips <3"}, "42")
+	if err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if published.ExternalID != "42" || published.URL != "https://acme.atlassian.net/wiki/spaces/OPS/pages/42" {
+		t.Fatalf("published = %+v", published)
+	}
+	if version := put["version"].(map[string]any)["number"]; version != float64(4) {
+		t.Fatalf("version = %v, want 4", version)
+	}
+	body := put["body"].(map[string]any)["storage"].(map[string]any)["value"].(string)
+	if !strings.Contains(body, "Fish &amp; chips &lt;3") {
+		t.Fatalf("body = %q", body)
+	}
+}
+
+func TestConfluencePublishRecreatesDeletedPage(t *testing.T) {
+	var ancestors []any
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/rest/api/content" {
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			ancestors, _ = body["ancestors"].([]any)
+			_, _ = io.WriteString(w, `{"id":"77","_links":{"webui":"/pages/77"}}`)
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer api.Close()
+
+	target := NewConfluence(ConfluenceConfig{BaseURL: api.URL, SpaceKey: "OPS", ParentPageID: "10"})
+	published, err := target.Publish(context.Background(), Page{Title: "Weekly sync"}, "42")
+	if err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if published.ExternalID != "77" || published.URL != api.URL+"/pages/77" || len(ancestors) != 1 {
+		t.Fatalf("published = %+v, ancestors = %v", published, ancestors)
+	}
+}
@@ -0,0 +1,4 @@
+ALTER TABLE "public"."todo"
+  ADD COLUMN "due_at" timestamptz NULL;
+
+CREATE INDEX "todo_user_due_idx" ON "public"."todo" ("user_id", "due_at") WHERE ("due_at" IS NOT NULL);
@@ -0,0 +1,2 @@
+ALTER TABLE "public"."recording"
+  ADD COLUMN "audio_key" text NULL;
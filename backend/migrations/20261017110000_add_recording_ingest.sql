@@ -0,0 +1,21 @@
+CREATE TABLE "public"."recording_ingest" (
+  "recording_id" integer NOT NULL,
+  "user_id" integer NOT NULL,
+  "sample_rate" integer NOT NULL,
+  "channels" integer NOT NULL,
+  "started_at" timestamptz NOT NULL DEFAULT now(),
+  "finalized_at" timestamptz NULL,
+  PRIMARY KEY ("recording_id"),
+  CONSTRAINT "recording_ingest_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_ingest_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_ingest_format_check" CHECK (("sample_rate" > 0) AND ("channels" > 0))
+);
+CREATE TABLE "public"."recording_ingest_chunk" (
+  "recording_id" integer NOT NULL,
+  "seq" integer NOT NULL,
+  "size_bytes" bigint NOT NULL,
+  "received_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("recording_id", "seq"),
+  CONSTRAINT "recording_ingest_chunk_ingest_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording_ingest" ("recording_id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_ingest_chunk_seq_check" CHECK ("seq" >= 0)
+);
@@ -0,0 +1,2 @@
+-- Modify "todo" table
+ALTER TABLE "public"."todo" ADD COLUMN "version" integer NOT NULL DEFAULT 1;
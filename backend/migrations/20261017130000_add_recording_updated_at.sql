@@ -0,0 +1,2 @@
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "updated_at" timestamptz NOT NULL DEFAULT now();
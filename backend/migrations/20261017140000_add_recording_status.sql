@@ -0,0 +1,22 @@
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "status" text NOT NULL DEFAULT 'uploaded', ADD COLUMN "status_error" text NULL, ADD COLUMN "status_updated_at" timestamptz NOT NULL DEFAULT now(), ADD CONSTRAINT "recording_status_check" CHECK ("status" = ANY (ARRAY['uploaded'::text, 'transcribing'::text, 'summarizing'::text, 'ready'::text, 'failed'::text]));
+-- Existing recordings were processed before status tracking existed; infer
+-- how far they got from which fields the worker filled in.
+UPDATE "public"."recording" SET "status" = CASE
+    WHEN COALESCE("transcript", '') <> '' AND COALESCE("summary", '') <> '' THEN 'ready'
+    WHEN COALESCE("transcript", '') <> '' THEN 'summarizing'
+    ELSE 'uploaded'
+  END;
+-- Create "recording_status_event" table
+CREATE TABLE "public"."recording_status_event" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "from_status" text NOT NULL,
+  "to_status" text NOT NULL,
+  "error" text NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_status_event_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "recording_status_event_recording_idx" to table: "recording_status_event"
+CREATE INDEX "recording_status_event_recording_idx" ON "public"."recording_status_event" ("recording_id", "created_at", "id");
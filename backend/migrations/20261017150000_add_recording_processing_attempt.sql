@@ -0,0 +1,20 @@
+-- Create "recording_processing_attempt" table
+CREATE TABLE "public"."recording_processing_attempt" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "stage" text NOT NULL,
+  "attempt" integer NOT NULL,
+  "status" text NOT NULL,
+  "error" text NULL,
+  "requested_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "started_at" timestamptz NULL,
+  "finished_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_processing_attempt_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_processing_attempt_requested_by_fk" FOREIGN KEY ("requested_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recording_processing_attempt_stage_check" CHECK ("stage" = ANY (ARRAY['transcription'::text, 'summarization'::text])),
+  CONSTRAINT "recording_processing_attempt_status_check" CHECK ("status" = ANY (ARRAY['pending'::text, 'running'::text, 'succeeded'::text, 'failed'::text]))
+);
+-- Create index "recording_processing_attempt_number_idx" to table: "recording_processing_attempt"
+CREATE UNIQUE INDEX "recording_processing_attempt_number_idx" ON "public"."recording_processing_attempt" ("recording_id", "stage", "attempt");
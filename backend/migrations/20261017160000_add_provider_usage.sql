@@ -0,0 +1,23 @@
+-- Create "provider_usage" table
+CREATE TABLE "public"."provider_usage" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "provider" text NOT NULL,
+  "kind" text NOT NULL,
+  "model" text NOT NULL,
+  "recording_id" integer NULL,
+  "succeeded" boolean NOT NULL,
+  "input_tokens" bigint NOT NULL DEFAULT 0,
+  "output_tokens" bigint NOT NULL DEFAULT 0,
+  "audio_seconds" double precision NOT NULL DEFAULT 0,
+  "cost_micros" bigint NOT NULL DEFAULT 0,
+  "duration_ms" integer NOT NULL,
+  "error" text NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "provider_usage_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "provider_usage_kind_check" CHECK ("kind" = ANY (ARRAY['transcription'::text, 'summarization'::text]))
+);
+-- Create index "provider_usage_provider_created_idx" to table: "provider_usage"
+CREATE INDEX "provider_usage_provider_created_idx" ON "public"."provider_usage" ("provider", "created_at");
+-- Create index "provider_usage_recording_idx" to table: "provider_usage"
+CREATE INDEX "provider_usage_recording_idx" ON "public"."provider_usage" ("recording_id");
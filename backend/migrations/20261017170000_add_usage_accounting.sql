@@ -0,0 +1,23 @@
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "created_by_user_id" integer NULL, ADD COLUMN "audio_bytes" bigint NULL, ADD CONSTRAINT "recording_created_by_user_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Live recordings already know who captured them.
+UPDATE "public"."recording" r SET "created_by_user_id" = ri."user_id"
+  FROM "public"."recording_ingest" ri
+  WHERE ri."recording_id" = r."id";
+-- Create "usage_event" table
+CREATE TABLE "public"."usage_event" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NULL,
+  "recording_id" integer NULL,
+  "metric" text NOT NULL,
+  "quantity" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "usage_event_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "usage_event_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "usage_event_metric_check" CHECK ("metric" = ANY (ARRAY['transcription_seconds'::text, 'llm_tokens'::text]))
+);
+-- Create index "usage_event_created_idx" to table: "usage_event"
+CREATE INDEX "usage_event_created_idx" ON "public"."usage_event" ("created_at");
+-- Create index "usage_event_user_created_idx" to table: "usage_event"
+CREATE INDEX "usage_event_user_created_idx" ON "public"."usage_event" ("user_id", "created_at");
@@ -0,0 +1,18 @@
+-- Create "quota_override" table
+CREATE TABLE "public"."quota_override" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NULL,
+  "metric" text NOT NULL,
+  "limit_value" bigint NOT NULL,
+  "reason" text NOT NULL DEFAULT '',
+  "expires_at" timestamptz NULL,
+  "set_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "quota_override_set_by_user_fk" FOREIGN KEY ("set_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "quota_override_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "quota_override_limit_check" CHECK ("limit_value" >= 0),
+  CONSTRAINT "quota_override_metric_check" CHECK ("metric" = ANY (ARRAY['transcription_seconds'::text, 'llm_tokens'::text, 'storage_bytes'::text, 'recordings'::text]))
+);
+-- Create index "quota_override_scope_idx" to table: "quota_override"
+CREATE UNIQUE INDEX "quota_override_scope_idx" ON "public"."quota_override" ((COALESCE("user_id", 0)), "metric");
@@ -0,0 +1,4 @@
+-- Modify "user" table
+ALTER TABLE "public"."user" ADD COLUMN "email_verified_at" timestamptz NULL, ADD COLUMN "pending_email" text NULL, ADD COLUMN "email_verification_hash" text NULL, ADD COLUMN "email_verification_expires_at" timestamptz NULL, ADD COLUMN "timezone" text NOT NULL DEFAULT 'UTC', ADD COLUMN "avatar_key" text NULL;
+-- Addresses set before verification existed were entered by an admin.
+UPDATE "public"."user" SET "email_verified_at" = now() WHERE "email" IS NOT NULL;
@@ -0,0 +1,2 @@
+-- Modify "user" table
+ALTER TABLE "public"."user" ADD COLUMN "locale" text NULL;
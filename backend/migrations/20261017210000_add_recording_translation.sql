@@ -0,0 +1,19 @@
+-- Create "recording_translation" table
+CREATE TABLE "public"."recording_translation" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "kind" text NOT NULL,
+  "language" text NOT NULL,
+  "text" text NOT NULL,
+  "provider" text NOT NULL,
+  "model" text NOT NULL,
+  "requested_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_translation_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_translation_requested_by_fk" FOREIGN KEY ("requested_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recording_translation_kind_check" CHECK ("kind" = ANY (ARRAY['summary'::text, 'transcript'::text]))
+);
+-- Create index "recording_translation_language_idx" to table: "recording_translation"
+CREATE UNIQUE INDEX "recording_translation_language_idx" ON "public"."recording_translation" ("recording_id", "kind", "language");
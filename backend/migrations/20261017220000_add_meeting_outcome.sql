@@ -0,0 +1,26 @@
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "sentiment" text NULL, ADD CONSTRAINT "recording_sentiment_check" CHECK ("sentiment" = ANY (ARRAY['positive'::text, 'neutral'::text, 'negative'::text, 'mixed'::text]));
+-- Create "meeting_outcome" table
+CREATE TABLE "public"."meeting_outcome" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "kind" text NOT NULL,
+  "text" text NOT NULL,
+  "owner_user_id" integer NULL,
+  "quote" text NULL,
+  "source_kind" text NOT NULL DEFAULT 'manual',
+  "resolved_at" timestamptz NULL,
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "meeting_outcome_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "meeting_outcome_owner_fk" FOREIGN KEY ("owner_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "meeting_outcome_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "meeting_outcome_kind_check" CHECK ("kind" = ANY (ARRAY['decision'::text, 'risk'::text, 'open_question'::text])),
+  CONSTRAINT "meeting_outcome_source_kind_check" CHECK ("source_kind" = ANY (ARRAY['manual'::text, 'llm'::text]))
+);
+-- Create index "meeting_outcome_recording_idx" to table: "meeting_outcome"
+CREATE INDEX "meeting_outcome_recording_idx" ON "public"."meeting_outcome" ("recording_id", "kind", "id");
+-- Create index "meeting_outcome_owner_idx" to table: "meeting_outcome"
+CREATE INDEX "meeting_outcome_owner_idx" ON "public"."meeting_outcome" ("owner_user_id");
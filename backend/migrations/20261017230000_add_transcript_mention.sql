@@ -0,0 +1,19 @@
+-- Create "transcript_mention" table
+CREATE TABLE "public"."transcript_mention" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "segment_index" integer NOT NULL,
+  "speaker_id" integer NULL,
+  "user_id" integer NOT NULL,
+  "matched_text" text NOT NULL,
+  "segment_text" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "transcript_mention_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "transcript_mention_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "transcript_mention_segment_index_check" CHECK ("segment_index" >= 0)
+);
+-- Create index "transcript_mention_recording_segment_user_key" to table: "transcript_mention"
+CREATE UNIQUE INDEX "transcript_mention_recording_segment_user_key" ON "public"."transcript_mention" ("recording_id", "segment_index", "user_id");
+-- Create index "transcript_mention_user_idx" to table: "transcript_mention"
+CREATE INDEX "transcript_mention_user_idx" ON "public"."transcript_mention" ("user_id", "recording_id");
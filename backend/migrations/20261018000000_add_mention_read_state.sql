@@ -0,0 +1,20 @@
+-- Modify "transcript_mention" table
+ALTER TABLE "public"."transcript_mention" ADD COLUMN "read_at" timestamptz NULL;
+-- Create "todo_mention" table
+CREATE TABLE "public"."todo_mention" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "todo_id" integer NOT NULL,
+  "user_id" integer NOT NULL,
+  "matched_text" text NOT NULL,
+  "read_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_mention_todo_fk" FOREIGN KEY ("todo_id") REFERENCES "public"."todo" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_mention_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "todo_mention_todo_user_key" to table: "todo_mention"
+CREATE UNIQUE INDEX "todo_mention_todo_user_key" ON "public"."todo_mention" ("todo_id", "user_id");
+-- Create index "todo_mention_user_idx" to table: "todo_mention"
+CREATE INDEX "todo_mention_user_idx" ON "public"."todo_mention" ("user_id", "created_at" DESC);
+-- Create index "transcript_mention_user_unread_idx" to table: "transcript_mention"
+CREATE INDEX "transcript_mention_user_unread_idx" ON "public"."transcript_mention" ("user_id") WHERE (read_at IS NULL);
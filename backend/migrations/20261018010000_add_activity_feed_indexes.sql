@@ -0,0 +1,6 @@
+-- Create index "meeting_outcome_created_idx" to table: "meeting_outcome"
+CREATE INDEX "meeting_outcome_created_idx" ON "public"."meeting_outcome" ("created_at" DESC, "id" DESC);
+-- Create index "recording_status_event_created_idx" to table: "recording_status_event"
+CREATE INDEX "recording_status_event_created_idx" ON "public"."recording_status_event" ("created_at" DESC, "id" DESC);
+-- Create index "todo_history_changed_idx" to table: "todo_history"
+CREATE INDEX "todo_history_changed_idx" ON "public"."todo_history" ("changed_at" DESC, "id" DESC);
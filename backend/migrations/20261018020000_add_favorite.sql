@@ -0,0 +1,17 @@
+-- Create "favorite" table
+CREATE TABLE "public"."favorite" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NOT NULL,
+  "recording_id" integer NULL,
+  "todo_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "favorite_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "favorite_todo_fk" FOREIGN KEY ("todo_id") REFERENCES "public"."todo" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "favorite_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "favorite_target_check" CHECK (num_nonnulls("recording_id", "todo_id") = 1)
+);
+-- Create index "favorite_user_recording_key" to table: "favorite"
+CREATE UNIQUE INDEX "favorite_user_recording_key" ON "public"."favorite" ("user_id", "recording_id") WHERE (recording_id IS NOT NULL);
+-- Create index "favorite_user_todo_key" to table: "favorite"
+CREATE UNIQUE INDEX "favorite_user_todo_key" ON "public"."favorite" ("user_id", "todo_id") WHERE (todo_id IS NOT NULL);
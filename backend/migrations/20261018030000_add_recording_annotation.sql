@@ -0,0 +1,17 @@
+-- Create "recording_annotation" table
+CREATE TABLE "public"."recording_annotation" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "start_ms" integer NOT NULL,
+  "end_ms" integer NOT NULL,
+  "note" text NOT NULL,
+  "author_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_annotation_author_fk" FOREIGN KEY ("author_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recording_annotation_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_annotation_range_check" CHECK (("start_ms" >= 0) AND ("end_ms" >= "start_ms"))
+);
+-- Create index "recording_annotation_recording_idx" to table: "recording_annotation"
+CREATE INDEX "recording_annotation_recording_idx" ON "public"."recording_annotation" ("recording_id", "start_ms", "id");
@@ -0,0 +1,22 @@
+-- Create "recording_clip" table
+CREATE TABLE "public"."recording_clip" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "start_ms" integer NOT NULL,
+  "end_ms" integer NOT NULL,
+  "title" text NOT NULL,
+  "transcript_excerpt" text NOT NULL DEFAULT '',
+  "audio_key" text NOT NULL,
+  "audio_bytes" bigint NOT NULL,
+  "share_token" text NOT NULL,
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_clip_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recording_clip_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_clip_range_check" CHECK (("start_ms" >= 0) AND ("end_ms" > "start_ms"))
+);
+-- Create index "recording_clip_recording_idx" to table: "recording_clip"
+CREATE INDEX "recording_clip_recording_idx" ON "public"."recording_clip" ("recording_id", "created_at", "id");
+-- Create index "recording_clip_share_token_key" to table: "recording_clip"
+CREATE UNIQUE INDEX "recording_clip_share_token_key" ON "public"."recording_clip" ("share_token");
@@ -0,0 +1,21 @@
+-- Create "attachment" table
+CREATE TABLE "public"."attachment" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "todo_id" integer NULL,
+  "recording_id" integer NULL,
+  "file_name" text NOT NULL,
+  "content_type" text NOT NULL,
+  "size_bytes" bigint NOT NULL,
+  "storage_key" text NOT NULL,
+  "uploaded_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "attachment_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "attachment_todo_fk" FOREIGN KEY ("todo_id") REFERENCES "public"."todo" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "attachment_uploaded_by_fk" FOREIGN KEY ("uploaded_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "attachment_target_check" CHECK (num_nonnulls("todo_id", "recording_id") = 1)
+);
+-- Create index "attachment_recording_idx" to table: "attachment"
+CREATE INDEX "attachment_recording_idx" ON "public"."attachment" ("recording_id") WHERE (recording_id IS NOT NULL);
+-- Create index "attachment_todo_idx" to table: "attachment"
+CREATE INDEX "attachment_todo_idx" ON "public"."attachment" ("todo_id") WHERE (todo_id IS NOT NULL);
@@ -0,0 +1,17 @@
+-- Create "meeting_minutes" table
+CREATE TABLE "public"."meeting_minutes" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "version" integer NOT NULL,
+  "document" jsonb NOT NULL,
+  "source" text NOT NULL,
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "meeting_minutes_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "meeting_minutes_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "meeting_minutes_source_check" CHECK (source = ANY (ARRAY['llm'::text, 'manual'::text])),
+  CONSTRAINT "meeting_minutes_version_check" CHECK (version > 0)
+);
+-- Create index "meeting_minutes_recording_version_key" to table: "meeting_minutes"
+CREATE UNIQUE INDEX "meeting_minutes_recording_version_key" ON "public"."meeting_minutes" ("recording_id", "version");
@@ -0,0 +1,16 @@
+-- Create "prompt_template" table
+CREATE TABLE "public"."prompt_template" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "name" text NOT NULL,
+  "description" text NOT NULL DEFAULT '',
+  "summary_instructions" text NOT NULL DEFAULT '',
+  "extraction_guidance" text NOT NULL DEFAULT '',
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "prompt_template_name_key" UNIQUE ("name"),
+  CONSTRAINT "prompt_template_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "prompt_template_id" integer NULL, ADD CONSTRAINT "recording_prompt_template_fk" FOREIGN KEY ("prompt_template_id") REFERENCES "public"."prompt_template" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,21 @@
+-- Create "todo_tracker_link" table
+CREATE TABLE "public"."todo_tracker_link" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "todo_id" integer NOT NULL,
+  "tracker" text NOT NULL,
+  "external_id" text NOT NULL,
+  "external_key" text NOT NULL,
+  "url" text NOT NULL,
+  "external_state" text NOT NULL DEFAULT '',
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "synced_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_tracker_link_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "todo_tracker_link_todo_fk" FOREIGN KEY ("todo_id") REFERENCES "public"."todo" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_tracker_link_tracker_check" CHECK (tracker = ANY (ARRAY['github'::text, 'linear'::text, 'jira'::text]))
+);
+-- Create index "todo_tracker_link_external_key" to table: "todo_tracker_link"
+CREATE UNIQUE INDEX "todo_tracker_link_external_key" ON "public"."todo_tracker_link" ("tracker", "external_id");
+-- Create index "todo_tracker_link_todo_tracker_key" to table: "todo_tracker_link"
+CREATE UNIQUE INDEX "todo_tracker_link_todo_tracker_key" ON "public"."todo_tracker_link" ("todo_id", "tracker");
@@ -0,0 +1,4 @@
+-- Modify "todo_history" table
+ALTER TABLE "public"."todo_history" ADD COLUMN "note" text NULL;
+-- Modify "todo_tracker_link" table
+ALTER TABLE "public"."todo_tracker_link" ADD COLUMN "synced_status" text NOT NULL DEFAULT '', ADD COLUMN "polled_at" timestamptz NULL;
@@ -0,0 +1,17 @@
+-- Create "recording_publication" table
+CREATE TABLE "public"."recording_publication" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "target" text NOT NULL,
+  "external_id" text NOT NULL,
+  "url" text NOT NULL,
+  "published_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_publication_published_by_fk" FOREIGN KEY ("published_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recording_publication_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_publication_target_check" CHECK (target = ANY (ARRAY['notion'::text, 'confluence'::text]))
+);
+-- Create index "recording_publication_recording_target_key" to table: "recording_publication"
+CREATE UNIQUE INDEX "recording_publication_recording_target_key" ON "public"."recording_publication" ("recording_id", "target");
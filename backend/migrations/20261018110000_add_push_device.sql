@@ -0,0 +1,18 @@
+-- Create "push_device" table
+CREATE TABLE "public"."push_device" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NOT NULL,
+  "platform" text NOT NULL,
+  "token" text NOT NULL,
+  "name" text NOT NULL DEFAULT '',
+  "muted_events" text[] NOT NULL DEFAULT '{}',
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "last_seen_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "push_device_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "push_device_platform_check" CHECK (platform = ANY (ARRAY['fcm'::text, 'apns'::text]))
+);
+-- Create index "push_device_token_key" to table: "push_device"
+CREATE UNIQUE INDEX "push_device_token_key" ON "public"."push_device" ("token");
+-- Create index "push_device_user_id_idx" to table: "push_device"
+CREATE INDEX "push_device_user_id_idx" ON "public"."push_device" ("user_id");
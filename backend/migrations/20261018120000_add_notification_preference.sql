@@ -0,0 +1,13 @@
+-- Create "notification_preference" table
+CREATE TABLE "public"."notification_preference" (
+  "user_id" integer NOT NULL,
+  "email_enabled" boolean NOT NULL DEFAULT false,
+  "chat_enabled" boolean NOT NULL DEFAULT true,
+  "push_enabled" boolean NOT NULL DEFAULT true,
+  "muted_events" text[] NOT NULL DEFAULT '{}',
+  "digest_frequency" text NOT NULL DEFAULT 'off',
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("user_id"),
+  CONSTRAINT "notification_preference_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "notification_preference_digest_frequency_check" CHECK (digest_frequency = ANY (ARRAY['off'::text, 'daily'::text, 'weekly'::text]))
+);
@@ -0,0 +1,17 @@
+-- Create "org_setting" table
+CREATE TABLE "public"."org_setting" (
+  "id" boolean NOT NULL DEFAULT true,
+  "org_name" text NOT NULL DEFAULT '',
+  "logo_key" text NULL,
+  "default_user_role" text NOT NULL DEFAULT 'member',
+  "audio_retention_days" integer NOT NULL DEFAULT 0,
+  "transcript_retention_days" integer NOT NULL DEFAULT 0,
+  "disabled_integrations" text[] NOT NULL DEFAULT '{}',
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_by_user_id" integer NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "org_setting_updated_by_fk" FOREIGN KEY ("updated_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "org_setting_single_row_check" CHECK (id),
+  CONSTRAINT "org_setting_default_user_role_check" CHECK (default_user_role = ANY (ARRAY['member'::text, 'admin'::text])),
+  CONSTRAINT "org_setting_retention_check" CHECK ((audio_retention_days >= 0) AND (transcript_retention_days >= 0))
+);
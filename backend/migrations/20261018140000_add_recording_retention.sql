@@ -0,0 +1,4 @@
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "retain_until" timestamptz NULL, ADD COLUMN "audio_purged_at" timestamptz NULL, ADD COLUMN "transcript_purged_at" timestamptz NULL;
+-- Create index "recording_created_at_idx" to table: "recording"
+CREATE INDEX "recording_created_at_idx" ON "public"."recording" ("created_at");
@@ -0,0 +1,17 @@
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "legal_hold" boolean NOT NULL DEFAULT false, ADD COLUMN "legal_hold_reason" text NULL;
+-- Create "recording_hold_event" table
+CREATE TABLE "public"."recording_hold_event" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NULL,
+  "recording_name" text NULL,
+  "held" boolean NOT NULL,
+  "reason" text NOT NULL,
+  "user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_hold_event_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recording_hold_event_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create index "recording_hold_event_recording_idx" to table: "recording_hold_event"
+CREATE INDEX "recording_hold_event_recording_idx" ON "public"."recording_hold_event" ("recording_id", "created_at", "id");
@@ -0,0 +1,8 @@
+-- Create "data_key" table
+CREATE TABLE "public"."data_key" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "wrapped_key" bytea NOT NULL,
+  "master_key_id" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id")
+);
@@ -0,0 +1,15 @@
+-- Create "pending_upload" table
+CREATE TABLE "public"."pending_upload" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "audio_key" text NOT NULL,
+  "name" text NOT NULL,
+  "content_type" text NOT NULL,
+  "size_bytes" bigint NOT NULL,
+  "created_by_user_id" integer NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "pending_upload_user_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "pending_upload_expires_at_idx" to table: "pending_upload"
+CREATE INDEX "pending_upload_expires_at_idx" ON "public"."pending_upload" ("expires_at");
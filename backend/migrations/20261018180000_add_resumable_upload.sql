@@ -0,0 +1,28 @@
+-- Create "resumable_upload" table
+CREATE TABLE "public"."resumable_upload" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NOT NULL,
+  "name" text NOT NULL,
+  "extension" text NOT NULL,
+  "length_bytes" bigint NOT NULL,
+  "received_bytes" bigint NOT NULL DEFAULT 0,
+  "recording_id" integer NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "resumable_upload_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "resumable_upload_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "resumable_upload_received_check" CHECK ("received_bytes" <= "length_bytes")
+);
+-- Create index "resumable_upload_expires_at_idx" to table: "resumable_upload"
+CREATE INDEX "resumable_upload_expires_at_idx" ON "public"."resumable_upload" ("expires_at");
+-- Create "resumable_upload_part" table
+CREATE TABLE "public"."resumable_upload_part" (
+  "upload_id" integer NOT NULL,
+  "start_byte" bigint NOT NULL,
+  "size_bytes" bigint NOT NULL,
+  "object_key" text NOT NULL,
+  PRIMARY KEY ("upload_id", "start_byte"),
+  CONSTRAINT "resumable_upload_part_upload_fk" FOREIGN KEY ("upload_id") REFERENCES "public"."resumable_upload" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
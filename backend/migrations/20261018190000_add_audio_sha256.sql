@@ -0,0 +1,8 @@
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "audio_sha256" text NULL;
+-- Create index "recording_audio_sha256_idx" to table: "recording"
+CREATE INDEX "recording_audio_sha256_idx" ON "public"."recording" ("audio_sha256") WHERE (audio_sha256 IS NOT NULL);
+-- Modify "pending_upload" table
+ALTER TABLE "public"."pending_upload" ADD COLUMN "sha256" text NULL;
+-- Modify "resumable_upload" table
+ALTER TABLE "public"."resumable_upload" ADD COLUMN "sha256" text NULL;
@@ -0,0 +1,6 @@
+-- Modify "attachment" table
+ALTER TABLE "public"."attachment" ADD COLUMN "scan_status" text NOT NULL DEFAULT 'unscanned', ADD COLUMN "scan_detail" text NULL, ADD COLUMN "scanned_at" timestamptz NULL, ADD CONSTRAINT "attachment_scan_status_check" CHECK (scan_status = ANY (ARRAY['unscanned'::text, 'pending'::text, 'clean'::text, 'quarantined'::text, 'released'::text]));
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "scan_status" text NOT NULL DEFAULT 'unscanned', ADD COLUMN "scan_detail" text NULL, ADD COLUMN "scanned_at" timestamptz NULL, ADD CONSTRAINT "recording_scan_status_check" CHECK (scan_status = ANY (ARRAY['unscanned'::text, 'pending'::text, 'clean'::text, 'quarantined'::text, 'released'::text]));
+-- Create index "recording_scan_pending_idx" to table: "recording"
+CREATE INDEX "recording_scan_pending_idx" ON "public"."recording" ("id") WHERE (scan_status = 'pending'::text);
@@ -0,0 +1,10 @@
+-- Create "transcript_segment" table
+CREATE TABLE "public"."transcript_segment" (
+  "recording_id" integer NOT NULL,
+  "segment_index" integer NOT NULL,
+  "confidence" real NOT NULL,
+  PRIMARY KEY ("recording_id", "segment_index"),
+  CONSTRAINT "transcript_segment_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "transcript_segment_segment_index_check" CHECK ("segment_index" >= 0),
+  CONSTRAINT "transcript_segment_confidence_check" CHECK (("confidence" >= 0) AND ("confidence" <= 1))
+);
@@ -0,0 +1,2 @@
+-- Modify "org_setting" table
+ALTER TABLE "public"."org_setting" ADD COLUMN "redact_emails" boolean NOT NULL DEFAULT false, ADD COLUMN "redact_phone_numbers" boolean NOT NULL DEFAULT false, ADD COLUMN "redact_credit_cards" boolean NOT NULL DEFAULT false, ADD COLUMN "redact_profanity" boolean NOT NULL DEFAULT false;
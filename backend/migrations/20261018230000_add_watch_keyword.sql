@@ -0,0 +1,33 @@
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "keywords_matched_at" timestamptz NULL;
+-- Recordings ready before watch keywords existed have nothing to match
+UPDATE "public"."recording" SET "keywords_matched_at" = now();
+-- Create "watch_keyword" table
+CREATE TABLE "public"."watch_keyword" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NOT NULL,
+  "keyword" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "watch_keyword_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "watch_keyword_keyword_check" CHECK ((char_length(keyword) >= 1) AND (char_length(keyword) <= 100))
+);
+-- Create index "watch_keyword_user_keyword_key" to table: "watch_keyword"
+CREATE UNIQUE INDEX "watch_keyword_user_keyword_key" ON "public"."watch_keyword" ("user_id", (lower(keyword)));
+-- Create "keyword_alert" table
+CREATE TABLE "public"."keyword_alert" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "keyword_id" integer NOT NULL,
+  "recording_id" integer NOT NULL,
+  "segment_index" integer NOT NULL,
+  "segment_text" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "keyword_alert_keyword_fk" FOREIGN KEY ("keyword_id") REFERENCES "public"."watch_keyword" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "keyword_alert_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "keyword_alert_segment_index_check" CHECK ("segment_index" >= 0)
+);
+-- Create index "keyword_alert_keyword_recording_segment_key" to table: "keyword_alert"
+CREATE UNIQUE INDEX "keyword_alert_keyword_recording_segment_key" ON "public"."keyword_alert" ("keyword_id", "recording_id", "segment_index");
+-- Create index "keyword_alert_recording_idx" to table: "keyword_alert"
+CREATE INDEX "keyword_alert_recording_idx" ON "public"."keyword_alert" ("recording_id");
@@ -0,0 +1,2 @@
+-- Create index "todo_created_at_recording_idx" to table: "todo"
+CREATE INDEX "todo_created_at_recording_idx" ON "public"."todo" ("created_at_recording_id");
@@ -0,0 +1,2 @@
+-- Modify "user" table
+ALTER TABLE "public"."user" ADD COLUMN "deactivated_at" timestamptz NULL;
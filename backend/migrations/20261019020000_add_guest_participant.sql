@@ -0,0 +1,19 @@
+-- Create "guest_participant" table
+CREATE TABLE "public"."guest_participant" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "speaker_id" integer NULL,
+  "name" text NOT NULL,
+  "email" text NULL,
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "guest_participant_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "guest_participant_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "guest_participant_name_check" CHECK ((char_length(name) >= 1) AND (char_length(name) <= 200)),
+  CONSTRAINT "guest_participant_speaker_check" CHECK (speaker_id >= 0)
+);
+-- Create index "guest_participant_recording_idx" to table: "guest_participant"
+CREATE INDEX "guest_participant_recording_idx" ON "public"."guest_participant" ("recording_id");
+-- Create index "guest_participant_speaker_key" to table: "guest_participant"
+CREATE UNIQUE INDEX "guest_participant_speaker_key" ON "public"."guest_participant" ("recording_id", "speaker_id") WHERE (speaker_id IS NOT NULL);
@@ -0,0 +1,4 @@
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "language" text NULL, ADD COLUMN "cloned_from_recording_id" integer NULL, ADD CONSTRAINT "recording_cloned_from_fk" FOREIGN KEY ("cloned_from_recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "recording_audio_key_idx" to table: "recording"
+CREATE INDEX "recording_audio_key_idx" ON "public"."recording" ("audio_key") WHERE (audio_key IS NOT NULL);
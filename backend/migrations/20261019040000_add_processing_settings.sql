@@ -0,0 +1,8 @@
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "processing_settings" jsonb NOT NULL DEFAULT '{}';
+-- Move the language hint into the processing settings
+UPDATE "public"."recording" SET "processing_settings" = jsonb_build_object('language', "language") WHERE "language" IS NOT NULL;
+-- Modify "recording" table
+ALTER TABLE "public"."recording" DROP COLUMN "language";
+-- Modify "resumable_upload" table
+ALTER TABLE "public"."resumable_upload" ADD COLUMN "processing_settings" jsonb NOT NULL DEFAULT '{}', ADD COLUMN "prompt_template_id" integer NULL, ADD CONSTRAINT "resumable_upload_prompt_template_fk" FOREIGN KEY ("prompt_template_id") REFERENCES "public"."prompt_template" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
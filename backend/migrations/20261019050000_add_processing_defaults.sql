@@ -0,0 +1,2 @@
+-- Modify "org_setting" table
+ALTER TABLE "public"."org_setting" ADD COLUMN "default_language" text NOT NULL DEFAULT '', ADD COLUMN "disable_summary" boolean NOT NULL DEFAULT false, ADD COLUMN "disable_todo_extraction" boolean NOT NULL DEFAULT false;
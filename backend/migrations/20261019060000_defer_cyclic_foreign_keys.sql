@@ -0,0 +1,10 @@
+-- Modify "directory" table
+ALTER TABLE "public"."directory" ALTER CONSTRAINT "directory_parent_fk" DEFERRABLE INITIALLY IMMEDIATE;
+-- Modify "block" table
+ALTER TABLE "public"."block" ALTER CONSTRAINT "block_parent_fk" DEFERRABLE INITIALLY IMMEDIATE;
+-- Modify "ai_message" table
+ALTER TABLE "public"."ai_message" ALTER CONSTRAINT "ai_message_run_fk" DEFERRABLE INITIALLY IMMEDIATE;
+-- Modify "ai_artifact" table
+ALTER TABLE "public"."ai_artifact" ALTER CONSTRAINT "ai_artifact_superseded_by_fk" DEFERRABLE INITIALLY IMMEDIATE;
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ALTER CONSTRAINT "recording_cloned_from_fk" DEFERRABLE INITIALLY IMMEDIATE;
@@ -0,0 +1,2 @@
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "processing_dismissed_at" timestamptz NULL;
@@ -0,0 +1,13 @@
+-- Create "scheduled_task" table
+CREATE TABLE "public"."scheduled_task" (
+  "name" text NOT NULL,
+  "schedule" text NULL,
+  "paused" boolean NOT NULL DEFAULT false,
+  "last_slot_at" timestamptz NULL,
+  "last_started_at" timestamptz NULL,
+  "last_finished_at" timestamptz NULL,
+  "last_succeeded_at" timestamptz NULL,
+  "last_error" text NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("name")
+);
@@ -0,0 +1,20 @@
+-- Create "feature_flag" table
+CREATE TABLE "public"."feature_flag" (
+  "name" text NOT NULL,
+  "enabled" boolean NOT NULL DEFAULT false,
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_by_user_id" integer NULL,
+  PRIMARY KEY ("name"),
+  CONSTRAINT "feature_flag_updated_by_fk" FOREIGN KEY ("updated_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create "feature_flag_user" table
+CREATE TABLE "public"."feature_flag_user" (
+  "name" text NOT NULL,
+  "user_id" integer NOT NULL,
+  "enabled" boolean NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("name", "user_id"),
+  CONSTRAINT "feature_flag_user_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "feature_flag_user_user_id_idx" to table: "feature_flag_user"
+CREATE INDEX "feature_flag_user_user_id_idx" ON "public"."feature_flag_user" ("user_id");
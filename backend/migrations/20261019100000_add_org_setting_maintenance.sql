@@ -0,0 +1,2 @@
+-- Modify "org_setting" table
+ALTER TABLE "public"."org_setting" ADD COLUMN "maintenance_mode" boolean NOT NULL DEFAULT false, ADD COLUMN "maintenance_message" text NOT NULL DEFAULT '', ADD COLUMN "maintenance_started_at" timestamptz NULL;
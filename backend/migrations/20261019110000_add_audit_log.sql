@@ -0,0 +1,21 @@
+-- Create "audit_log" table
+CREATE TABLE "public"."audit_log" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "created_at" timestamptz NOT NULL,
+  "actor_user_id" integer NULL,
+  "action" text NOT NULL,
+  "detail" text NOT NULL,
+  "request_id" text NOT NULL,
+  "key_id" text NOT NULL,
+  "prev_hash" bytea NOT NULL,
+  "hash" bytea NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create "audit_log_append_only" function
+CREATE FUNCTION "public"."audit_log_append_only"() RETURNS trigger LANGUAGE plpgsql AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$;
+-- Create trigger "audit_log_append_only"
+CREATE TRIGGER "audit_log_append_only" BEFORE UPDATE OR DELETE ON "public"."audit_log" FOR EACH ROW EXECUTE FUNCTION "public"."audit_log_append_only"();
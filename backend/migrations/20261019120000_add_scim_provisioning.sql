@@ -0,0 +1,27 @@
+-- Modify "user" table
+ALTER TABLE "public"."user" ADD COLUMN "scim_provisioned" boolean NOT NULL DEFAULT false, ADD COLUMN "scim_external_id" text NULL;
+-- Modify "workspace_user_rel" table
+ALTER TABLE "public"."workspace_user_rel" ADD COLUMN "via_scim" boolean NOT NULL DEFAULT false;
+-- Create "scim_group" table
+CREATE TABLE "public"."scim_group" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "display_name" text NOT NULL,
+  "external_id" text NULL,
+  "role" text NULL,
+  "workspace_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "scim_group_workspace_fk" FOREIGN KEY ("workspace_id") REFERENCES "public"."workspace" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "scim_group_role_check" CHECK ("role" = ANY (ARRAY['admin'::text, 'member'::text]))
+);
+-- Create "scim_group_member" table
+CREATE TABLE "public"."scim_group_member" (
+  "group_id" integer NOT NULL,
+  "user_id" integer NOT NULL,
+  PRIMARY KEY ("group_id", "user_id"),
+  CONSTRAINT "scim_group_member_group_fk" FOREIGN KEY ("group_id") REFERENCES "public"."scim_group" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "scim_group_member_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "scim_group_member_user_idx" to table: "scim_group_member"
+CREATE INDEX "scim_group_member_user_idx" ON "public"."scim_group_member" ("user_id");
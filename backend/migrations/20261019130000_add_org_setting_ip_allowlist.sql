@@ -0,0 +1,2 @@
+-- Modify "org_setting" table
+ALTER TABLE "public"."org_setting" ADD COLUMN "ip_allowlist" text[] NOT NULL DEFAULT '{}';
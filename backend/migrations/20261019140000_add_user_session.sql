@@ -0,0 +1,15 @@
+-- Create "user_session" table
+CREATE TABLE "public"."user_session" (
+  "id" text NOT NULL,
+  "user_id" integer NOT NULL,
+  "user_agent" text NOT NULL DEFAULT '',
+  "ip" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "expires_at" timestamptz NOT NULL,
+  "last_seen_at" timestamptz NOT NULL DEFAULT now(),
+  "revoked_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "user_session_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "user_session_user_id_idx" to table: "user_session"
+CREATE INDEX "user_session_user_id_idx" ON "public"."user_session" ("user_id");
@@ -0,0 +1,14 @@
+-- Modify "user_session" table
+ALTER TABLE "public"."user_session" ADD COLUMN "location" text NOT NULL DEFAULT '', ADD COLUMN "device_fingerprint" text NOT NULL DEFAULT '';
+-- Create "user_device" table
+CREATE TABLE "public"."user_device" (
+  "user_id" integer NOT NULL,
+  "fingerprint" text NOT NULL,
+  "device" text NOT NULL DEFAULT '',
+  "first_seen_at" timestamptz NOT NULL DEFAULT now(),
+  "last_seen_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("user_id", "fingerprint"),
+  CONSTRAINT "user_device_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Modify "audit_log" table
+ALTER TABLE "public"."audit_log" ADD COLUMN "session_id" text NOT NULL DEFAULT '', ADD COLUMN "ip" text NOT NULL DEFAULT '', ADD COLUMN "device" text NOT NULL DEFAULT '';
@@ -0,0 +1,17 @@
+-- Create "transcript_revision" table
+CREATE TABLE "public"."transcript_revision" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "revision" integer NOT NULL,
+  "transcript" text NOT NULL,
+  "source" text NOT NULL,
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "transcript_revision_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "transcript_revision_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "transcript_revision_revision_check" CHECK (revision > 0),
+  CONSTRAINT "transcript_revision_source_check" CHECK (source = ANY (ARRAY['transcription'::text, 'relabel'::text]))
+);
+-- Create index "transcript_revision_recording_revision_key" to table: "transcript_revision"
+CREATE UNIQUE INDEX "transcript_revision_recording_revision_key" ON "public"."transcript_revision" ("recording_id", "revision");
@@ -0,0 +1,30 @@
+-- Create "todo_comment" table
+CREATE TABLE "public"."todo_comment" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "todo_id" integer NOT NULL,
+  "body" text NOT NULL,
+  "author_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_comment_author_fk" FOREIGN KEY ("author_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "todo_comment_todo_fk" FOREIGN KEY ("todo_id") REFERENCES "public"."todo" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "todo_comment_todo_idx" to table: "todo_comment"
+CREATE INDEX "todo_comment_todo_idx" ON "public"."todo_comment" ("todo_id", "created_at", "id");
+-- Create "todo_comment_mention" table
+CREATE TABLE "public"."todo_comment_mention" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "comment_id" integer NOT NULL,
+  "user_id" integer NOT NULL,
+  "matched_text" text NOT NULL,
+  "read_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_comment_mention_comment_fk" FOREIGN KEY ("comment_id") REFERENCES "public"."todo_comment" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_comment_mention_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "todo_comment_mention_comment_user_key" to table: "todo_comment_mention"
+CREATE UNIQUE INDEX "todo_comment_mention_comment_user_key" ON "public"."todo_comment_mention" ("comment_id", "user_id");
+-- Create index "todo_comment_mention_user_idx" to table: "todo_comment_mention"
+CREATE INDEX "todo_comment_mention_user_idx" ON "public"."todo_comment_mention" ("user_id", "created_at" DESC);
@@ -0,0 +1,18 @@
+-- Create "reaction" table
+CREATE TABLE "public"."reaction" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NOT NULL,
+  "todo_id" integer NULL,
+  "comment_id" integer NULL,
+  "emoji" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "reaction_comment_fk" FOREIGN KEY ("comment_id") REFERENCES "public"."todo_comment" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "reaction_todo_fk" FOREIGN KEY ("todo_id") REFERENCES "public"."todo" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "reaction_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "reaction_target_check" CHECK (num_nonnulls("todo_id", "comment_id") = 1)
+);
+-- Create index "reaction_todo_key" to table: "reaction"
+CREATE UNIQUE INDEX "reaction_todo_key" ON "public"."reaction" ("todo_id", "user_id", "emoji") WHERE (todo_id IS NOT NULL);
+-- Create index "reaction_comment_key" to table: "reaction"
+CREATE UNIQUE INDEX "reaction_comment_key" ON "public"."reaction" ("comment_id", "user_id", "emoji") WHERE (comment_id IS NOT NULL);
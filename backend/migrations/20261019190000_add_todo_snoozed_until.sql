@@ -0,0 +1,4 @@
+-- Modify "todo" table
+ALTER TABLE "public"."todo" ADD COLUMN "snoozed_until" timestamptz NULL;
+
+CREATE INDEX "todo_snoozed_until_idx" ON "public"."todo" ("snoozed_until") WHERE ("snoozed_until" IS NOT NULL);
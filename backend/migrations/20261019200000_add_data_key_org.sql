@@ -0,0 +1,4 @@
+-- Modify "org_setting" table
+ALTER TABLE "public"."org_setting" ADD COLUMN "org_id" text NOT NULL DEFAULT (gen_random_uuid())::text;
+-- Modify "data_key" table
+ALTER TABLE "public"."data_key" ADD COLUMN "org_id" text NULL;
@@ -0,0 +1,2 @@
+-- Create trigger "audit_log_no_truncate"
+CREATE TRIGGER "audit_log_no_truncate" BEFORE TRUNCATE ON "public"."audit_log" FOR EACH STATEMENT EXECUTE FUNCTION "public"."audit_log_append_only"();
@@ -0,0 +1,2 @@
+-- Modify "todo" table
+ALTER TABLE "public"."todo" ADD COLUMN "priority" smallint NOT NULL DEFAULT 0, ADD CONSTRAINT "todo_priority_check" CHECK ((priority >= 0) AND (priority <= 3));
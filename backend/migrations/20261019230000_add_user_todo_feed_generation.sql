@@ -0,0 +1,2 @@
+-- Modify "user" table
+ALTER TABLE "public"."user" ADD COLUMN "todo_feed_generation" integer NOT NULL DEFAULT 0;
@@ -0,0 +1,28 @@
+// Package migrations embeds the atlas migration directory so the server
+// can tell whether its database is up to date.
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+	"strings"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// Versions returns the version of every migration, oldest first. Like
+// atlas, it takes the version from the part of the file name before the
+// first underscore.
+func Versions() []string {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		panic(err)
+	}
+	versions := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		version, _, _ := strings.Cut(strings.TrimSuffix(entry.Name(), ".sql"), "_")
+		versions = append(versions, version)
+	}
+	return versions
+}
@@ -0,0 +1,107 @@
+-- The activity feed merges several event tables. Each query returns one
+-- source newest first, resuming strictly before (before_at, before_id) so
+-- the feed can page through ties on the timestamp.
+
+-- name: ListRecordingStatusActivity :many
+SELECT
+  e.id,
+  e.recording_id,
+  r.name AS recording_name,
+  e.from_status,
+  e.to_status,
+  e.error,
+  e.created_at
+FROM recording_status_event e
+JOIN recording r ON r.id = e.recording_id
+WHERE (sqlc.narg(recording_id)::integer IS NULL OR e.recording_id = sqlc.narg(recording_id)::integer)
+  AND (sqlc.narg(user_id)::integer IS NULL
+    OR r.created_by_user_id = sqlc.narg(user_id)::integer
+    OR EXISTS (
+      SELECT 1 FROM speaker_to_user stu
+      WHERE stu.recording_id = e.recording_id AND stu.user_id = sqlc.narg(user_id)::integer
+    ))
+  AND (sqlc.narg(before_at)::timestamptz IS NULL
+    OR (e.created_at, e.id::bigint) < (sqlc.narg(before_at)::timestamptz, @before_id::bigint))
+ORDER BY e.created_at DESC, e.id DESC
+LIMIT @max_results;
+
+-- name: ListTodoActivity :many
+SELECT
+  h.id,
+  h.todo_id,
+  h.actor_user_id,
+  h.change_type,
+  h.name,
+  h.status,
+  h.user_id,
+  t.workspace_id,
+  COALESCE(h.updated_at_recording_id, h.created_at_recording_id) AS recording_id,
+  r.name AS recording_name,
+  h.changed_at
+FROM todo_history h
+JOIN todo t ON t.id = h.todo_id
+LEFT JOIN recording r ON r.id = COALESCE(h.updated_at_recording_id, h.created_at_recording_id)
+WHERE (sqlc.narg(recording_id)::integer IS NULL
+    OR h.created_at_recording_id = sqlc.narg(recording_id)::integer
+    OR h.updated_at_recording_id = sqlc.narg(recording_id)::integer)
+  AND (sqlc.narg(user_id)::integer IS NULL
+    OR h.actor_user_id = sqlc.narg(user_id)::integer
+    OR h.user_id = sqlc.narg(user_id)::integer)
+  AND (sqlc.narg(workspace_id)::integer IS NULL OR t.workspace_id = sqlc.narg(workspace_id)::integer)
+  AND (sqlc.narg(before_at)::timestamptz IS NULL
+    OR (h.changed_at, h.id) < (sqlc.narg(before_at)::timestamptz, @before_id::bigint))
+ORDER BY h.changed_at DESC, h.id DESC
+LIMIT @max_results;
+
+-- name: ListOutcomeActivity :many
+SELECT
+  o.id,
+  o.recording_id,
+  r.name AS recording_name,
+  o.kind,
+  o.text,
+  o.owner_user_id,
+  o.created_by_user_id,
+  o.created_at
+FROM meeting_outcome o
+JOIN recording r ON r.id = o.recording_id
+WHERE (sqlc.narg(recording_id)::integer IS NULL OR o.recording_id = sqlc.narg(recording_id)::integer)
+  AND (sqlc.narg(user_id)::integer IS NULL
+    OR o.created_by_user_id = sqlc.narg(user_id)::integer
+    OR o.owner_user_id = sqlc.narg(user_id)::integer)
+  AND (sqlc.narg(before_at)::timestamptz IS NULL
+    OR (o.created_at, o.id::bigint) < (sqlc.narg(before_at)::timestamptz, @before_id::bigint))
+ORDER BY o.created_at DESC, o.id DESC
+LIMIT @max_results;
+
+-- name: ListCommentActivity :many
+SELECT
+  c.id,
+  c.todo_id,
+  t.name AS todo_name,
+  t.workspace_id,
+  t.created_at_recording_id AS recording_id,
+  r.name AS recording_name,
+  c.author_user_id,
+  c.body,
+  COALESCE(
+    (SELECT array_agg(cm.user_id ORDER BY cm.id) FROM todo_comment_mention cm WHERE cm.comment_id = c.id),
+    '{}'
+  )::integer[] AS mentioned_user_ids,
+  c.created_at
+FROM todo_comment c
+JOIN todo t ON t.id = c.todo_id
+LEFT JOIN recording r ON r.id = t.created_at_recording_id
+WHERE (sqlc.narg(recording_id)::integer IS NULL OR t.created_at_recording_id = sqlc.narg(recording_id)::integer)
+  AND (sqlc.narg(user_id)::integer IS NULL
+    OR c.author_user_id = sqlc.narg(user_id)::integer
+    OR t.user_id = sqlc.narg(user_id)::integer
+    OR EXISTS (
+      SELECT 1 FROM todo_comment_mention cm
+      WHERE cm.comment_id = c.id AND cm.user_id = sqlc.narg(user_id)::integer
+    ))
+  AND (sqlc.narg(workspace_id)::integer IS NULL OR t.workspace_id = sqlc.narg(workspace_id)::integer)
+  AND (sqlc.narg(before_at)::timestamptz IS NULL
+    OR (c.created_at, c.id::bigint) < (sqlc.narg(before_at)::timestamptz, @before_id::bigint))
+ORDER BY c.created_at DESC, c.id DESC
+LIMIT @max_results;
@@ -0,0 +1,39 @@
+-- name: GetSystemStats :one
+SELECT
+  (SELECT COUNT(*) FROM "user")::bigint AS users,
+  (SELECT COUNT(*) FROM "user" WHERE deactivated_at IS NULL)::bigint AS active_users,
+  (SELECT COUNT(*) FROM "user" WHERE role = 'admin' AND deactivated_at IS NULL)::bigint AS admins,
+  (SELECT COUNT(*) FROM recording)::bigint AS recordings,
+  (SELECT COALESCE(SUM(duration), 0) FROM recording)::bigint AS recorded_seconds,
+  (SELECT COUNT(*) FROM recording WHERE status = 'failed' AND processing_dismissed_at IS NULL)::bigint AS failed_recordings,
+  (SELECT COALESCE(SUM(audio_bytes), 0) FROM recording)::bigint AS audio_bytes,
+  (SELECT COALESCE(SUM(audio_bytes), 0) FROM recording_clip)::bigint AS clip_bytes,
+  (SELECT COALESCE(SUM(size_bytes), 0) FROM attachment)::bigint AS attachment_bytes;
+
+-- name: CountJobs :many
+-- Work waiting and in progress in each queue, and how much of it failed
+-- since @since. Recordings waiting to be transcribed are uploaded ones;
+-- summarization starts as soon as a transcript is stored.
+SELECT jobs.queue::text AS queue, jobs.queued::bigint AS queued, jobs.running::bigint AS running, jobs.failed::bigint AS failed
+FROM (
+  SELECT 1 AS position, 'transcription' AS queue,
+    (SELECT COUNT(*) FROM recording WHERE status = 'uploaded') AS queued,
+    (SELECT COUNT(*) FROM recording WHERE status = 'transcribing') AS running,
+    (SELECT COUNT(*) FROM recording_processing_attempt WHERE stage = 'transcription' AND status = 'failed' AND finished_at >= @since::timestamptz) AS failed
+  UNION ALL
+  SELECT 2, 'summarization',
+    0,
+    (SELECT COUNT(*) FROM recording WHERE status = 'summarizing'),
+    (SELECT COUNT(*) FROM recording_processing_attempt WHERE stage = 'summarization' AND status = 'failed' AND finished_at >= @since::timestamptz)
+  UNION ALL
+  SELECT 3, 'ai',
+    (SELECT COUNT(*) FROM ai_run WHERE status = 'queued'),
+    (SELECT COUNT(*) FROM ai_run WHERE status = 'running'),
+    (SELECT COUNT(*) FROM ai_run WHERE status = 'failed' AND COALESCE(completed_at, created_at) >= @since::timestamptz)
+  UNION ALL
+  SELECT 4, 'scan',
+    (SELECT COUNT(*) FROM recording WHERE scan_status = 'pending') + (SELECT COUNT(*) FROM attachment WHERE scan_status = 'pending'),
+    0,
+    0
+) AS jobs
+ORDER BY jobs.position;
@@ -0,0 +1,28 @@
+-- name: ListAnnotations :many
+SELECT id, recording_id, start_ms, end_ms, note, author_user_id, created_at, updated_at
+FROM recording_annotation
+WHERE recording_id = $1
+ORDER BY start_ms, id;
+
+-- name: GetAnnotation :one
+SELECT id, recording_id, start_ms, end_ms, note, author_user_id, created_at, updated_at
+FROM recording_annotation
+WHERE id = $1;
+
+-- name: CreateAnnotation :one
+INSERT INTO recording_annotation (recording_id, start_ms, end_ms, note, author_user_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, recording_id, start_ms, end_ms, note, author_user_id, created_at, updated_at;
+
+-- name: UpdateAnnotation :one
+UPDATE recording_annotation
+SET start_ms = $2,
+    end_ms = $3,
+    note = $4,
+    updated_at = now()
+WHERE id = $1
+RETURNING id, recording_id, start_ms, end_ms, note, author_user_id, created_at, updated_at;
+
+-- name: DeleteAnnotation :execrows
+DELETE FROM recording_annotation
+WHERE id = $1;
@@ -0,0 +1,25 @@
+-- name: CreateAttachment :one
+INSERT INTO attachment (todo_id, recording_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id, scan_status, scan_detail, scanned_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, @scan_status::text, sqlc.narg(scan_detail)::text, CASE WHEN @scan_status::text = 'unscanned' THEN NULL ELSE now() END)
+RETURNING id, todo_id, recording_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id, created_at, scan_status, scan_detail, scanned_at;
+
+-- name: GetAttachment :one
+SELECT id, todo_id, recording_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id, created_at, scan_status, scan_detail, scanned_at
+FROM attachment
+WHERE id = $1;
+
+-- name: ListTodoAttachments :many
+SELECT id, todo_id, recording_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id, created_at, scan_status, scan_detail, scanned_at
+FROM attachment
+WHERE todo_id = @todo_id::integer
+ORDER BY created_at, id;
+
+-- name: ListRecordingAttachments :many
+SELECT id, todo_id, recording_id, file_name, content_type, size_bytes, storage_key, uploaded_by_user_id, created_at, scan_status, scan_detail, scanned_at
+FROM attachment
+WHERE recording_id = @recording_id::integer
+ORDER BY created_at, id;
+
+-- name: DeleteAttachment :execrows
+DELETE FROM attachment
+WHERE id = $1;
@@ -0,0 +1,29 @@
+-- name: LockAuditLog :exec
+-- Serializes appends, so every entry chains onto the one before it.
+LOCK TABLE audit_log IN SHARE ROW EXCLUSIVE MODE;
+
+-- name: LastAuditHash :one
+SELECT hash FROM audit_log
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: InsertAuditEntry :one
+INSERT INTO audit_log (created_at, actor_user_id, action, detail, request_id, key_id, prev_hash, hash, session_id, ip, device)
+VALUES (@created_at, sqlc.narg(actor_user_id), @action, @detail, @request_id, @key_id, @prev_hash, @hash, @session_id, @ip, @device)
+RETURNING *;
+
+-- name: ListAuditEntries :many
+-- Newest first, before before_id when it is set.
+SELECT a.*, u.first_name AS actor_first_name, u.last_name AS actor_last_name
+FROM audit_log a
+LEFT JOIN "user" u ON u.id = a.actor_user_id
+WHERE (sqlc.arg(before_id)::bigint = 0 OR a.id < sqlc.arg(before_id)::bigint)
+ORDER BY a.id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListAuditChain :many
+-- Oldest first, after after_id, for verifying the chain a page at a time.
+SELECT * FROM audit_log
+WHERE id > sqlc.arg(after_id)::bigint
+ORDER BY id
+LIMIT sqlc.arg(row_limit);
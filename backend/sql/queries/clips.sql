@@ -0,0 +1,15 @@
+-- name: ListClips :many
+SELECT id, recording_id, start_ms, end_ms, title, transcript_excerpt, audio_key, audio_bytes, share_token, created_by_user_id, created_at
+FROM recording_clip
+WHERE recording_id = $1
+ORDER BY created_at DESC, id DESC;
+
+-- name: GetClipByShareToken :one
+SELECT id, recording_id, start_ms, end_ms, title, transcript_excerpt, audio_key, audio_bytes, share_token, created_by_user_id, created_at
+FROM recording_clip
+WHERE share_token = $1;
+
+-- name: CreateClip :one
+INSERT INTO recording_clip (recording_id, start_ms, end_ms, title, transcript_excerpt, audio_key, audio_bytes, share_token, created_by_user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, recording_id, start_ms, end_ms, title, transcript_excerpt, audio_key, audio_bytes, share_token, created_by_user_id, created_at;
@@ -0,0 +1,31 @@
+-- name: ListDigestRecipients :many
+-- Active users with an email address who want a digest this often.
+SELECT u.id, u.first_name, u.email, u.locale, u.timezone
+FROM "user" u
+JOIN notification_preference p ON p.user_id = u.id
+WHERE p.digest_frequency = sqlc.arg(frequency)
+  AND u.deactivated_at IS NULL
+  AND COALESCE(u.email, '') <> ''
+ORDER BY u.id;
+
+-- name: ListDigestTodos :many
+-- The user's open todos, soonest due first, with how many there are in
+-- all.
+SELECT t.id, t.name, t.due_at, t.created_at_recording_id, COUNT(*) OVER () AS total
+FROM todo t
+WHERE t.user_id = sqlc.arg(user_id)::int
+  AND COALESCE(t.status, 'todo') NOT IN ('done', 'skipped')
+ORDER BY t.due_at ASC NULLS LAST, t.created_at ASC
+LIMIT sqlc.arg(max_todos);
+
+-- name: ListDigestRecordings :many
+-- Meetings the user created or spoke in that became ready since the given
+-- time, newest first.
+SELECT r.id, r.name, r.created_at, r.duration, COUNT(*) OVER () AS total
+FROM recording r
+WHERE r.status = 'ready'
+  AND r.status_updated_at >= sqlc.arg(since)
+  AND (r.created_by_user_id = sqlc.arg(user_id)::int
+    OR EXISTS (SELECT 1 FROM speaker_to_user stu WHERE stu.recording_id = r.id AND stu.user_id = sqlc.arg(user_id)))
+ORDER BY r.status_updated_at DESC
+LIMIT sqlc.arg(max_recordings);
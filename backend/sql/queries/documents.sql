@@ -245,7 +245,7 @@ INSERT INTO todo (
   source_document_id,
   source_block_id
 ) VALUES ($1, $2, $3, $4, $5, 'block', $6, $7)
-RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at;
+RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at, due_at, version, snoozed_until, priority;
 
 -- name: UpdateCanonicalTodoForBlock :one
 UPDATE todo
@@ -258,6 +258,7 @@ SET
   source_kind = 'block',
   source_document_id = $7,
   source_block_id = $8,
+  version = version + 1,
   updated_at = now()
 WHERE id = $1
-RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at;
+RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at, due_at, version, snoozed_until, priority;
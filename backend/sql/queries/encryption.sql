@@ -0,0 +1,169 @@
+-- name: GetOrgID :one
+-- Creates the settings row the first time, so there is an org to bind
+-- data keys to.
+WITH created AS (
+  INSERT INTO org_setting (id) VALUES (true)
+  ON CONFLICT (id) DO NOTHING
+  RETURNING org_id
+)
+SELECT org_id FROM created
+UNION ALL
+SELECT org_id FROM org_setting WHERE id
+LIMIT 1;
+
+-- name: ListDataKeys :many
+-- The org's data keys, and ones created before data keys belonged to an
+-- org, which are bound to it as they are rewrapped.
+SELECT id, wrapped_key, master_key_id, created_at, org_id
+FROM data_key
+WHERE org_id = @org_id::text OR org_id IS NULL
+ORDER BY id;
+
+-- name: GetDataKey :one
+SELECT id, wrapped_key, master_key_id, created_at, org_id
+FROM data_key
+WHERE id = @id::integer;
+
+-- name: CreateDataKey :one
+INSERT INTO data_key (wrapped_key, master_key_id, org_id)
+VALUES (@wrapped_key::bytea, @master_key_id::text, @org_id::text)
+RETURNING id, wrapped_key, master_key_id, created_at, org_id;
+
+-- name: RewrapDataKey :exec
+UPDATE data_key
+SET wrapped_key = @wrapped_key::bytea,
+    master_key_id = @master_key_id::text,
+    org_id = @org_id::text
+WHERE id = @id::integer;
+
+-- name: ListTranscriptsToSeal :many
+-- Transcripts not yet sealed with the active key: plaintext ones written
+-- by the worker and ones sealed with an older key.
+SELECT id, transcript::text AS transcript
+FROM recording
+WHERE transcript <> ''
+  AND NOT starts_with(transcript, @sealed_prefix::text)
+ORDER BY id
+LIMIT @max_rows::integer;
+
+-- name: SealRecordingTranscript :execrows
+-- Only replaces the transcript it was given, so one rewritten in the
+-- meantime is left for the next pass.
+UPDATE recording
+SET transcript = @sealed::text
+WHERE id = @id::integer
+  AND transcript = @current::text;
+
+-- name: ListTranslationsToSeal :many
+SELECT id, text
+FROM recording_translation
+WHERE kind = 'transcript'
+  AND NOT starts_with(text, @sealed_prefix::text)
+ORDER BY id
+LIMIT @max_rows::integer;
+
+-- name: SealRecordingTranslation :execrows
+UPDATE recording_translation
+SET text = @sealed::text
+WHERE id = @id::integer
+  AND text = @current::text;
+
+-- name: ListTranscriptRevisionsToSeal :many
+SELECT id, transcript
+FROM transcript_revision
+WHERE transcript <> ''
+  AND NOT starts_with(transcript, @sealed_prefix::text)
+ORDER BY id
+LIMIT @max_rows::integer;
+
+-- name: SealTranscriptRevision :execrows
+UPDATE transcript_revision
+SET transcript = @sealed::text
+WHERE id = @id::integer
+  AND transcript = @current::text;
+
+-- name: ListClipExcerptsToSeal :many
+-- Clip excerpts, mention and keyword alert lines, outcome quotes and the
+-- quotes the assistant cites copy the transcript, so they are sealed with
+-- it.
+SELECT id, transcript_excerpt AS text
+FROM recording_clip
+WHERE transcript_excerpt <> ''
+  AND NOT starts_with(transcript_excerpt, @sealed_prefix::text)
+ORDER BY id
+LIMIT @max_rows::integer;
+
+-- name: SealClipExcerpt :execrows
+UPDATE recording_clip
+SET transcript_excerpt = @sealed::text
+WHERE id = @id::integer
+  AND transcript_excerpt = @current::text;
+
+-- name: ListMentionLinesToSeal :many
+SELECT id, segment_text AS text
+FROM transcript_mention
+WHERE segment_text <> ''
+  AND NOT starts_with(segment_text, @sealed_prefix::text)
+ORDER BY id
+LIMIT @max_rows::integer;
+
+-- name: SealMentionLine :execrows
+UPDATE transcript_mention
+SET segment_text = @sealed::text
+WHERE id = @id::bigint
+  AND segment_text = @current::text;
+
+-- name: ListKeywordAlertLinesToSeal :many
+SELECT id, segment_text AS text
+FROM keyword_alert
+WHERE segment_text <> ''
+  AND NOT starts_with(segment_text, @sealed_prefix::text)
+ORDER BY id
+LIMIT @max_rows::integer;
+
+-- name: SealKeywordAlertLine :execrows
+UPDATE keyword_alert
+SET segment_text = @sealed::text
+WHERE id = @id::bigint
+  AND segment_text = @current::text;
+
+-- name: ListOutcomeQuotesToSeal :many
+SELECT id, quote::text AS text
+FROM meeting_outcome
+WHERE quote <> ''
+  AND NOT starts_with(quote, @sealed_prefix::text)
+ORDER BY id
+LIMIT @max_rows::integer;
+
+-- name: SealOutcomeQuote :execrows
+UPDATE meeting_outcome
+SET quote = @sealed::text
+WHERE id = @id::integer
+  AND quote = @current::text;
+
+-- name: ListSourceQuotesToSeal :many
+SELECT id, quote_text::text AS text
+FROM ai_source_ref
+WHERE quote_text <> ''
+  AND NOT starts_with(quote_text, @sealed_prefix::text)
+ORDER BY id
+LIMIT @max_rows::integer;
+
+-- name: SealSourceQuote :execrows
+UPDATE ai_source_ref
+SET quote_text = @sealed::text
+WHERE id = @id::bigint
+  AND quote_text = @current::text;
+
+-- name: ListAudioObjectKeys :many
+-- Stored audio of recordings and clips, in key order so a pass can resume
+-- after the last key it saw.
+SELECT key::text
+FROM (
+  SELECT audio_key AS key FROM recording WHERE audio_key IS NOT NULL
+  UNION
+  SELECT audio_key FROM recording_clip
+) audio
+WHERE key > @after::text
+ORDER BY key
+LIMIT @max_rows::integer;
@@ -0,0 +1,29 @@
+-- name: StarRecording :exec
+INSERT INTO favorite (user_id, recording_id)
+VALUES (@user_id, @recording_id::integer)
+ON CONFLICT (user_id, recording_id) WHERE recording_id IS NOT NULL DO NOTHING;
+
+-- name: UnstarRecording :exec
+DELETE FROM favorite
+WHERE user_id = @user_id AND recording_id = @recording_id::integer;
+
+-- name: StarTodo :exec
+INSERT INTO favorite (user_id, todo_id)
+VALUES (@user_id, @todo_id::integer)
+ON CONFLICT (user_id, todo_id) WHERE todo_id IS NOT NULL DO NOTHING;
+
+-- name: UnstarTodo :exec
+DELETE FROM favorite
+WHERE user_id = @user_id AND todo_id = @todo_id::integer;
+
+-- name: ListStarredRecordingIDs :many
+SELECT recording_id::integer
+FROM favorite
+WHERE user_id = $1 AND recording_id IS NOT NULL
+ORDER BY recording_id;
+
+-- name: ListStarredTodoIDs :many
+SELECT todo_id::integer
+FROM favorite
+WHERE user_id = $1 AND todo_id IS NOT NULL
+ORDER BY todo_id;
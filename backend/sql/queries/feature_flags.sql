@@ -0,0 +1,31 @@
+-- name: ListFeatureFlags :many
+SELECT * FROM feature_flag
+ORDER BY name;
+
+-- name: ListFeatureFlagUsers :many
+SELECT * FROM feature_flag_user
+ORDER BY name, user_id;
+
+-- name: ListUserFeatureFlags :many
+SELECT * FROM feature_flag_user
+WHERE user_id = $1;
+
+-- name: SetFeatureFlag :one
+INSERT INTO feature_flag (name, enabled, updated_by_user_id)
+VALUES (sqlc.arg(name), sqlc.arg(enabled), sqlc.narg(updated_by_user_id))
+ON CONFLICT (name) DO UPDATE
+SET enabled = EXCLUDED.enabled,
+    updated_by_user_id = EXCLUDED.updated_by_user_id,
+    updated_at = now()
+RETURNING *;
+
+-- name: SetUserFeatureFlag :exec
+INSERT INTO feature_flag_user (name, user_id, enabled)
+VALUES ($1, $2, $3)
+ON CONFLICT (name, user_id) DO UPDATE
+SET enabled = EXCLUDED.enabled,
+    updated_at = now();
+
+-- name: DeleteUserFeatureFlag :exec
+DELETE FROM feature_flag_user
+WHERE name = $1 AND user_id = $2;
@@ -0,0 +1,39 @@
+-- name: ListRecordingGuests :many
+SELECT id, recording_id, speaker_id, name, email, created_by_user_id, created_at
+FROM guest_participant
+WHERE recording_id = $1
+ORDER BY speaker_id NULLS LAST, id;
+
+-- name: ListGuestsForRecordings :many
+SELECT id, recording_id, speaker_id, name, email, created_by_user_id, created_at
+FROM guest_participant
+WHERE recording_id = ANY(sqlc.arg(recording_ids)::integer[])
+ORDER BY recording_id, speaker_id NULLS LAST, id;
+
+-- name: AddGuestParticipant :one
+-- Adds the guest and touches the recording, so its ETag changes. No row
+-- comes back when the recording doesn't exist.
+WITH touched AS (
+  UPDATE recording r SET updated_at = now()
+  WHERE r.id = @recording_id::integer
+  RETURNING r.id
+)
+INSERT INTO guest_participant (recording_id, speaker_id, name, email, created_by_user_id)
+SELECT t.id, sqlc.narg(speaker_id)::integer, @name::text, sqlc.narg(email)::text, sqlc.narg(created_by_user_id)::integer
+FROM touched t
+RETURNING id, recording_id, speaker_id, name, email, created_by_user_id, created_at;
+
+-- name: RemoveGuestParticipant :execrows
+WITH removed AS (
+  DELETE FROM guest_participant g
+  WHERE g.id = @id AND g.recording_id = @recording_id
+  RETURNING g.recording_id
+)
+UPDATE recording r SET updated_at = now()
+FROM removed d
+WHERE r.id = d.recording_id;
+
+-- name: SetGuestSpeaker :execrows
+UPDATE guest_participant
+SET speaker_id = @speaker_id::integer
+WHERE id = @id AND recording_id = @recording_id;
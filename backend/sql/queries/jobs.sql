@@ -0,0 +1,47 @@
+-- name: ListJobs :many
+-- A job is a recording's way through processing: queued while uploaded or
+-- while a retry waits for the worker, running while the worker has it,
+-- failed until it is retried or dismissed. The stage of a failed job is
+-- the one its last attempt failed in; a job cancelled before it started
+-- never had one and failed in transcription.
+SELECT id, name, state, stage,
+  (CASE WHEN attempt_stage = stage THEN attempt ELSE 0 END)::integer AS attempts,
+  error, updated_at, created_by_user_id
+FROM (
+  SELECT r.id, r.name, r.status_error AS error, r.status_updated_at AS updated_at, r.created_by_user_id,
+    (CASE
+      WHEN r.status = 'failed' THEN 'failed'
+      WHEN r.status = 'uploaded' OR a.status = 'pending' THEN 'queued'
+      ELSE 'running'
+    END)::text AS state,
+    (CASE
+      WHEN r.status = 'summarizing' THEN 'summarization'
+      WHEN r.status = 'failed' THEN COALESCE(a.stage, 'transcription')
+      ELSE 'transcription'
+    END)::text AS stage,
+    a.stage AS attempt_stage,
+    a.attempt
+  FROM recording r
+  LEFT JOIN LATERAL (
+    SELECT stage, status, attempt
+    FROM recording_processing_attempt
+    WHERE recording_id = r.id
+    ORDER BY created_at DESC, id DESC
+    LIMIT 1
+  ) a ON true
+  WHERE r.status IN ('uploaded', 'transcribing', 'summarizing', 'failed')
+    AND r.processing_dismissed_at IS NULL
+    AND (sqlc.narg(recording_id)::integer IS NULL OR r.id = sqlc.narg(recording_id)::integer)
+) AS jobs
+WHERE cardinality(@states::text[]) = 0 OR state = ANY(@states::text[])
+ORDER BY updated_at, id
+LIMIT @max_jobs;
+
+-- name: DismissFailedJobs :execrows
+-- Takes failed recordings off the job list without deleting them.
+UPDATE recording
+SET processing_dismissed_at = now()
+WHERE status = 'failed'
+  AND processing_dismissed_at IS NULL
+  AND (sqlc.narg(recording_id)::integer IS NULL OR id = sqlc.narg(recording_id)::integer)
+  AND (sqlc.narg(failed_before)::timestamptz IS NULL OR status_updated_at < sqlc.narg(failed_before)::timestamptz);
@@ -0,0 +1,75 @@
+-- name: ListWatchKeywords :many
+SELECT id, user_id, keyword, created_at
+FROM watch_keyword
+WHERE user_id = $1
+ORDER BY lower(keyword);
+
+-- name: CountWatchKeywords :one
+SELECT COUNT(*)::bigint
+FROM watch_keyword
+WHERE user_id = $1;
+
+-- name: CreateWatchKeyword :one
+INSERT INTO watch_keyword (user_id, keyword)
+VALUES ($1, $2)
+RETURNING id, user_id, keyword, created_at;
+
+-- name: DeleteWatchKeyword :execrows
+DELETE FROM watch_keyword
+WHERE id = $1 AND user_id = $2;
+
+-- name: ListRecordingsToMatchKeywords :many
+-- Ready recordings whose transcript hasn't been matched against watch
+-- keywords since it last became ready.
+SELECT id, name, transcript, status_updated_at
+FROM recording
+WHERE status = 'ready'
+  AND (keywords_matched_at IS NULL OR keywords_matched_at < status_updated_at)
+ORDER BY status_updated_at, id
+LIMIT @max_rows::integer;
+
+-- name: ListWatchKeywordsCreatedBefore :many
+-- Keywords that existed when a recording became ready; later ones only
+-- apply to later meetings.
+SELECT id, user_id, keyword, created_at
+FROM watch_keyword
+WHERE created_at <= @before::timestamptz
+ORDER BY user_id, id;
+
+-- name: CreateKeywordAlerts :many
+-- Stores the alerts not stored yet and returns them, so a recording
+-- processed again doesn't alert twice.
+INSERT INTO keyword_alert (keyword_id, recording_id, segment_index, segment_text)
+SELECT found.keyword_id, @recording_id::integer, found.segment_index, found.segment_text
+FROM (
+  SELECT
+    unnest(@keyword_ids::integer[]) AS keyword_id,
+    unnest(@segment_indexes::integer[]) AS segment_index,
+    unnest(@segment_texts::text[]) AS segment_text
+) AS found
+ON CONFLICT (keyword_id, recording_id, segment_index) DO NOTHING
+RETURNING keyword_id, segment_index, segment_text;
+
+-- name: SetRecordingKeywordsMatched :exec
+UPDATE recording
+SET keywords_matched_at = @matched_at::timestamptz
+WHERE id = @id::integer;
+
+-- name: ListKeywordAlerts :many
+-- The user's alerts, newest first.
+SELECT
+  a.id,
+  a.keyword_id,
+  k.keyword,
+  a.recording_id,
+  r.name AS recording_name,
+  a.segment_index,
+  a.segment_text,
+  a.created_at
+FROM keyword_alert a
+JOIN watch_keyword k ON k.id = a.keyword_id
+JOIN recording r ON r.id = a.recording_id
+WHERE k.user_id = @user_id::integer
+  AND (sqlc.narg(keyword_id)::integer IS NULL OR a.keyword_id = sqlc.narg(keyword_id)::integer)
+ORDER BY a.created_at DESC, a.id DESC
+LIMIT @max_rows::integer;
@@ -0,0 +1,9 @@
+-- name: TryLockLeader :one
+-- Takes the session-level advisory lock held by the leader, the process
+-- that runs the background work meant for one process at a time. It is
+-- held until UnlockLeader on the same connection, or until the connection
+-- closes.
+SELECT pg_try_advisory_lock(hashtextextended('leader', 0))::boolean AS locked;
+
+-- name: UnlockLeader :exec
+SELECT pg_advisory_unlock(hashtextextended('leader', 0));
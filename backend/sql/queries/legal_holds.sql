@@ -0,0 +1,21 @@
+-- name: SetRecordingLegalHold :one
+-- Places or lifts the hold and records who did it and why, in one
+-- statement so the audit trail can't miss a change.
+WITH updated AS (
+  UPDATE recording
+  SET legal_hold = @held::boolean,
+      legal_hold_reason = CASE WHEN @held::boolean THEN @reason::text END,
+      updated_at = now()
+  WHERE id = @recording_id::integer
+  RETURNING id, name
+)
+INSERT INTO recording_hold_event (recording_id, recording_name, held, reason, user_id)
+SELECT id, name, @held::boolean, @reason::text, sqlc.narg(user_id)::integer
+FROM updated
+RETURNING id, held, reason, user_id, created_at;
+
+-- name: ListRecordingHoldEvents :many
+SELECT id, held, reason, user_id, created_at
+FROM recording_hold_event
+WHERE recording_id = @recording_id::integer
+ORDER BY created_at, id;
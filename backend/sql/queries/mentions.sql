@@ -0,0 +1,162 @@
+-- name: ListMentions :many
+SELECT
+  m.id,
+  m.recording_id,
+  r.name AS recording_name,
+  r.created_at AS recording_date,
+  m.segment_index,
+  m.speaker_id,
+  m.user_id,
+  m.matched_text,
+  m.segment_text,
+  m.read_at,
+  m.created_at
+FROM transcript_mention m
+JOIN recording r ON r.id = m.recording_id
+WHERE (sqlc.narg(user_id)::integer IS NULL OR m.user_id = sqlc.narg(user_id)::integer)
+  AND (sqlc.narg(recording_id)::integer IS NULL OR m.recording_id = sqlc.narg(recording_id)::integer)
+  AND (sqlc.narg(query)::text IS NULL OR m.segment_text ILIKE '%' || sqlc.narg(query)::text || '%')
+  AND (NOT @unread_only::boolean OR m.read_at IS NULL)
+ORDER BY m.created_at DESC, m.id DESC
+LIMIT @max_results;
+
+-- name: ListTodoMentions :many
+SELECT
+  tm.id,
+  tm.todo_id,
+  t.name AS todo_name,
+  t.created_at_recording_id AS recording_id,
+  r.name AS recording_name,
+  r.created_at AS recording_date,
+  tm.user_id,
+  tm.matched_text,
+  tm.read_at,
+  tm.created_at
+FROM todo_mention tm
+JOIN todo t ON t.id = tm.todo_id
+LEFT JOIN recording r ON r.id = t.created_at_recording_id
+WHERE (sqlc.narg(user_id)::integer IS NULL OR tm.user_id = sqlc.narg(user_id)::integer)
+  AND (sqlc.narg(recording_id)::integer IS NULL OR t.created_at_recording_id = sqlc.narg(recording_id)::integer)
+  AND (sqlc.narg(query)::text IS NULL
+    OR t.name ILIKE '%' || sqlc.narg(query)::text || '%'
+    OR t."desc" ILIKE '%' || sqlc.narg(query)::text || '%')
+  AND (NOT @unread_only::boolean OR tm.read_at IS NULL)
+ORDER BY tm.created_at DESC, tm.id DESC
+LIMIT @max_results;
+
+-- name: ListCommentMentions :many
+SELECT
+  cm.id,
+  c.id AS comment_id,
+  c.body AS comment_text,
+  c.todo_id,
+  t.name AS todo_name,
+  t.created_at_recording_id AS recording_id,
+  r.name AS recording_name,
+  r.created_at AS recording_date,
+  cm.user_id,
+  cm.matched_text,
+  cm.read_at,
+  cm.created_at
+FROM todo_comment_mention cm
+JOIN todo_comment c ON c.id = cm.comment_id
+JOIN todo t ON t.id = c.todo_id
+LEFT JOIN recording r ON r.id = t.created_at_recording_id
+WHERE (sqlc.narg(user_id)::integer IS NULL OR cm.user_id = sqlc.narg(user_id)::integer)
+  AND (sqlc.narg(recording_id)::integer IS NULL OR t.created_at_recording_id = sqlc.narg(recording_id)::integer)
+  AND (sqlc.narg(query)::text IS NULL
+    OR c.body ILIKE '%' || sqlc.narg(query)::text || '%'
+    OR t.name ILIKE '%' || sqlc.narg(query)::text || '%')
+  AND (NOT @unread_only::boolean OR cm.read_at IS NULL)
+ORDER BY cm.created_at DESC, cm.id DESC
+LIMIT @max_results;
+
+-- name: CountUnreadMentions :one
+SELECT (
+  (SELECT count(*) FROM transcript_mention m WHERE m.user_id = @user_id AND m.read_at IS NULL)
+  + (SELECT count(*) FROM todo_mention tm WHERE tm.user_id = @user_id AND tm.read_at IS NULL)
+  + (SELECT count(*) FROM todo_comment_mention cm WHERE cm.user_id = @user_id AND cm.read_at IS NULL)
+)::bigint AS unread;
+
+-- name: SetTranscriptMentionsRead :exec
+UPDATE transcript_mention
+SET read_at = CASE WHEN @read::boolean THEN COALESCE(read_at, now()) ELSE NULL END
+WHERE user_id = @user_id AND (@all_mentions::boolean OR id = ANY(@ids::bigint[]));
+
+-- name: SetTodoMentionsRead :exec
+UPDATE todo_mention
+SET read_at = CASE WHEN @read::boolean THEN COALESCE(read_at, now()) ELSE NULL END
+WHERE user_id = @user_id AND (@all_mentions::boolean OR id = ANY(@ids::bigint[]));
+
+-- name: SetCommentMentionsRead :exec
+UPDATE todo_comment_mention
+SET read_at = CASE WHEN @read::boolean THEN COALESCE(read_at, now()) ELSE NULL END
+WHERE user_id = @user_id AND (@all_mentions::boolean OR id = ANY(@ids::bigint[]));
+
+-- name: ReplaceTranscriptMentions :exec
+-- Swaps a recording's mentions for a freshly detected set in one
+-- statement, so relinking after a transcript correction leaves no stale
+-- rows behind. Mentions found again keep when they were first found and
+-- whether they were read.
+WITH previous AS (
+  DELETE FROM transcript_mention
+  WHERE transcript_mention.recording_id = @recording_id
+  RETURNING transcript_mention.segment_index, transcript_mention.user_id, transcript_mention.read_at, transcript_mention.created_at
+)
+INSERT INTO transcript_mention (recording_id, segment_index, speaker_id, user_id, matched_text, segment_text, read_at, created_at)
+SELECT @recording_id, detected.segment_index, NULLIF(detected.speaker_id, -1), detected.user_id, detected.matched_text, detected.segment_text,
+  previous.read_at, COALESCE(previous.created_at, now())
+FROM (
+  SELECT
+    unnest(@segment_indexes::integer[]) AS segment_index,
+    unnest(@speaker_ids::integer[]) AS speaker_id,
+    unnest(@user_ids::integer[]) AS user_id,
+    unnest(@matched_texts::text[]) AS matched_text,
+    unnest(@segment_texts::text[]) AS segment_text
+) AS detected
+LEFT JOIN previous ON previous.segment_index = detected.segment_index AND previous.user_id = detected.user_id;
+
+-- name: ListRecordingTodos :many
+SELECT id, name, "desc", user_id
+FROM todo
+WHERE created_at_recording_id = $1
+ORDER BY id;
+
+-- name: ReplaceTodoMentions :exec
+-- Like ReplaceTranscriptMentions, for the todos of one recording.
+WITH previous AS (
+  DELETE FROM todo_mention
+  USING todo
+  WHERE todo.id = todo_mention.todo_id AND todo.created_at_recording_id = @recording_id
+  RETURNING todo_mention.todo_id, todo_mention.user_id, todo_mention.read_at, todo_mention.created_at
+)
+INSERT INTO todo_mention (todo_id, user_id, matched_text, read_at, created_at)
+SELECT detected.todo_id, detected.user_id, detected.matched_text, previous.read_at, COALESCE(previous.created_at, now())
+FROM (
+  SELECT
+    unnest(@todo_ids::integer[]) AS todo_id,
+    unnest(@user_ids::integer[]) AS user_id,
+    unnest(@matched_texts::text[]) AS matched_text
+) AS detected
+LEFT JOIN previous ON previous.todo_id = detected.todo_id AND previous.user_id = detected.user_id;
+
+-- name: AssignMentionedTodos :many
+-- Assigns todos to the people they name and records the change in the
+-- todo history. Todos someone assigned in the meantime are left alone.
+WITH assigned AS (
+  UPDATE todo
+  SET user_id = pairs.user_id,
+      version = todo.version + 1,
+      updated_at = now()
+  FROM (
+    SELECT
+      unnest(@todo_ids::integer[]) AS todo_id,
+      unnest(@user_ids::integer[]) AS user_id
+  ) AS pairs
+  WHERE todo.id = pairs.todo_id AND todo.user_id IS NULL
+  RETURNING todo.id, todo.name, todo."desc", todo.status, todo.user_id, todo.created_at_recording_id, todo.updated_at_recording_id
+)
+INSERT INTO todo_history (todo_id, actor_user_id, change_type, name, "desc", status, user_id, created_at_recording_id, updated_at_recording_id)
+SELECT id, sqlc.narg(actor_user_id)::integer, 'update', name, "desc", status, user_id, created_at_recording_id, updated_at_recording_id
+FROM assigned
+RETURNING todo_id;
@@ -0,0 +1,31 @@
+-- name: GetLatestMinutes :one
+SELECT recording_id, version, document, source, created_by_user_id, created_at
+FROM meeting_minutes
+WHERE recording_id = $1
+ORDER BY version DESC
+LIMIT 1;
+
+-- name: GetMinutesVersion :one
+SELECT recording_id, version, document, source, created_by_user_id, created_at
+FROM meeting_minutes
+WHERE recording_id = $1 AND version = $2;
+
+-- name: ListMinutesVersions :many
+SELECT version, source, created_by_user_id, created_at
+FROM meeting_minutes
+WHERE recording_id = $1
+ORDER BY version DESC;
+
+-- name: CreateMinutes :one
+-- Adds the version after base_version, returning no row when base_version
+-- is no longer the latest.
+WITH latest AS (
+    SELECT COALESCE(MAX(version), 0)::integer AS version
+    FROM meeting_minutes
+    WHERE recording_id = sqlc.arg(recording_id)::integer
+)
+INSERT INTO meeting_minutes (recording_id, version, document, source, created_by_user_id)
+SELECT sqlc.arg(recording_id)::integer, latest.version + 1, sqlc.arg(document)::jsonb, sqlc.arg(source)::text, sqlc.narg(created_by_user_id)::integer
+FROM latest
+WHERE latest.version = sqlc.arg(base_version)::integer
+RETURNING recording_id, version, document, source, created_by_user_id, created_at;
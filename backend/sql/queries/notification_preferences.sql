@@ -0,0 +1,34 @@
+-- name: GetNotificationPreference :one
+SELECT user_id, email_enabled, chat_enabled, push_enabled, muted_events, digest_frequency, updated_at
+FROM notification_preference
+WHERE user_id = $1;
+
+-- name: SaveNotificationPreference :one
+INSERT INTO notification_preference (user_id, email_enabled, chat_enabled, push_enabled, muted_events, digest_frequency)
+VALUES ($1, $2, $3, $4, sqlc.arg(muted_events)::text[], $5)
+ON CONFLICT (user_id) DO UPDATE
+SET email_enabled = EXCLUDED.email_enabled,
+    chat_enabled = EXCLUDED.chat_enabled,
+    push_enabled = EXCLUDED.push_enabled,
+    muted_events = EXCLUDED.muted_events,
+    digest_frequency = EXCLUDED.digest_frequency,
+    updated_at = now()
+RETURNING user_id, email_enabled, chat_enabled, push_enabled, muted_events, digest_frequency, updated_at;
+
+-- name: ListNotificationRecipients :many
+-- The given users that want to hear about the event, with the channels
+-- they take it on. Users without saved preferences get the defaults;
+-- deactivated users get nothing.
+SELECT
+  u.id AS user_id,
+  u.first_name,
+  u.email,
+  u.locale,
+  COALESCE(p.email_enabled, false)::boolean AS email_enabled,
+  COALESCE(p.chat_enabled, true)::boolean AS chat_enabled,
+  COALESCE(p.push_enabled, true)::boolean AS push_enabled
+FROM "user" u
+LEFT JOIN notification_preference p ON p.user_id = u.id
+WHERE u.id = ANY(sqlc.arg(user_ids)::int[])
+  AND u.deactivated_at IS NULL
+  AND NOT (sqlc.arg(event)::text = ANY(COALESCE(p.muted_events, '{}')));
@@ -0,0 +1,89 @@
+-- name: ListOutcomes :many
+SELECT
+  o.id,
+  o.recording_id,
+  r.name AS recording_name,
+  r.created_at AS recording_date,
+  o.kind,
+  o.text,
+  o.owner_user_id,
+  o.quote,
+  o.source_kind,
+  o.resolved_at,
+  o.created_by_user_id,
+  o.created_at,
+  o.updated_at
+FROM meeting_outcome o
+JOIN recording r ON r.id = o.recording_id
+WHERE (sqlc.narg(recording_id)::integer IS NULL OR o.recording_id = sqlc.narg(recording_id)::integer)
+  AND (cardinality(@kinds::text[]) = 0 OR o.kind = ANY(@kinds::text[]))
+  AND (sqlc.narg(owner_user_id)::integer IS NULL OR o.owner_user_id = sqlc.narg(owner_user_id)::integer)
+  AND (sqlc.narg(resolved)::boolean IS NULL OR (o.resolved_at IS NOT NULL) = sqlc.narg(resolved)::boolean)
+  AND (sqlc.narg(meeting_after)::timestamptz IS NULL OR r.created_at >= sqlc.narg(meeting_after)::timestamptz)
+  AND (sqlc.narg(meeting_before)::timestamptz IS NULL OR r.created_at < sqlc.narg(meeting_before)::timestamptz)
+  AND (sqlc.narg(query)::text IS NULL
+    OR o.text ILIKE '%' || sqlc.narg(query)::text || '%'
+    OR o.quote ILIKE '%' || sqlc.narg(query)::text || '%')
+ORDER BY r.created_at DESC, o.recording_id DESC, o.kind, o.id;
+
+-- name: GetOutcome :one
+SELECT
+  o.id,
+  o.recording_id,
+  r.name AS recording_name,
+  r.created_at AS recording_date,
+  o.kind,
+  o.text,
+  o.owner_user_id,
+  o.quote,
+  o.source_kind,
+  o.resolved_at,
+  o.created_by_user_id,
+  o.created_at,
+  o.updated_at
+FROM meeting_outcome o
+JOIN recording r ON r.id = o.recording_id
+WHERE o.id = $1;
+
+-- name: CreateOutcome :one
+INSERT INTO meeting_outcome (recording_id, kind, text, owner_user_id, quote, source_kind, created_by_user_id)
+VALUES ($1, $2, $3, $4, $5, 'manual', $6)
+RETURNING id;
+
+-- name: UpdateOutcome :execrows
+UPDATE meeting_outcome
+SET text = $2,
+    owner_user_id = $3,
+    resolved_at = CASE
+      WHEN NOT @resolved::boolean THEN NULL
+      ELSE COALESCE(resolved_at, now())
+    END,
+    updated_at = now()
+WHERE id = $1;
+
+-- name: DeleteOutcome :execrows
+DELETE FROM meeting_outcome
+WHERE id = $1;
+
+-- name: ReplaceExtractedOutcomes :exec
+-- Swaps the outcomes a model extracted from a recording for a new set in
+-- one statement; outcomes people added by hand are kept.
+WITH cleared AS (
+  DELETE FROM meeting_outcome
+  WHERE meeting_outcome.recording_id = @recording_id AND meeting_outcome.source_kind = 'llm'
+)
+INSERT INTO meeting_outcome (recording_id, kind, text, owner_user_id, quote, source_kind, created_by_user_id)
+SELECT @recording_id, extracted.kind, extracted.text, NULLIF(extracted.owner_user_id, 0), NULLIF(extracted.quote, ''), 'llm', @created_by_user_id
+FROM (
+  SELECT
+    unnest(@kinds::text[]) AS kind,
+    unnest(@texts::text[]) AS text,
+    unnest(@owner_user_ids::integer[]) AS owner_user_id,
+    unnest(@quotes::text[]) AS quote
+) AS extracted;
+
+-- name: SetRecordingSentiment :exec
+UPDATE recording
+SET sentiment = $2,
+    updated_at = now()
+WHERE id = $1;
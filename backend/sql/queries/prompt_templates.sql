@@ -0,0 +1,41 @@
+-- name: ListPromptTemplates :many
+SELECT id, name, description, summary_instructions, extraction_guidance, created_by_user_id, created_at, updated_at
+FROM prompt_template
+ORDER BY name;
+
+-- name: GetPromptTemplate :one
+SELECT id, name, description, summary_instructions, extraction_guidance, created_by_user_id, created_at, updated_at
+FROM prompt_template
+WHERE id = $1;
+
+-- name: CreatePromptTemplate :one
+INSERT INTO prompt_template (name, description, summary_instructions, extraction_guidance, created_by_user_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, name, description, summary_instructions, extraction_guidance, created_by_user_id, created_at, updated_at;
+
+-- name: UpdatePromptTemplate :one
+UPDATE prompt_template
+SET name = $2,
+    description = $3,
+    summary_instructions = $4,
+    extraction_guidance = $5,
+    updated_at = now()
+WHERE id = $1
+RETURNING id, name, description, summary_instructions, extraction_guidance, created_by_user_id, created_at, updated_at;
+
+-- name: DeletePromptTemplate :execrows
+-- Touches the recordings that used the template, so cached recording
+-- lists notice they went back to the built-in prompts.
+WITH touched AS (
+    UPDATE recording
+    SET updated_at = now()
+    WHERE prompt_template_id = $1
+)
+DELETE FROM prompt_template pt
+WHERE pt.id = $1;
+
+-- name: SetRecordingPromptTemplate :execrows
+UPDATE recording
+SET prompt_template_id = $2,
+    updated_at = now()
+WHERE id = $1;
@@ -0,0 +1,6 @@
+-- name: CreateProviderUsage :exec
+INSERT INTO provider_usage (
+  provider, kind, model, recording_id, succeeded,
+  input_tokens, output_tokens, audio_seconds, cost_micros, duration_ms, error
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
@@ -0,0 +1,21 @@
+-- name: ListRecordingPublications :many
+SELECT id, recording_id, target, external_id, url, published_by_user_id, created_at, updated_at
+FROM recording_publication
+WHERE recording_id = $1
+ORDER BY target;
+
+-- name: GetRecordingPublication :one
+SELECT id, recording_id, target, external_id, url, published_by_user_id, created_at, updated_at
+FROM recording_publication
+WHERE recording_id = $1 AND target = $2;
+
+-- name: SaveRecordingPublication :one
+-- Publishing again replaces the page, so one row per target is kept.
+INSERT INTO recording_publication (recording_id, target, external_id, url, published_by_user_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (recording_id, target) DO UPDATE
+SET external_id = EXCLUDED.external_id,
+    url = EXCLUDED.url,
+    published_by_user_id = EXCLUDED.published_by_user_id,
+    updated_at = now()
+RETURNING id, recording_id, target, external_id, url, published_by_user_id, created_at, updated_at;
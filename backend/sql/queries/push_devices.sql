@@ -0,0 +1,40 @@
+-- name: RegisterPushDevice :one
+-- A token moves to whoever registers it last, since a phone can change
+-- hands between sign-ins.
+INSERT INTO push_device (user_id, platform, token, name, muted_events)
+VALUES ($1, $2, $3, $4, sqlc.arg(muted_events)::text[])
+ON CONFLICT (token) DO UPDATE
+SET user_id = EXCLUDED.user_id,
+    platform = EXCLUDED.platform,
+    name = EXCLUDED.name,
+    muted_events = EXCLUDED.muted_events,
+    last_seen_at = now()
+RETURNING id, user_id, platform, token, name, muted_events, created_at, last_seen_at;
+
+-- name: ListPushDevices :many
+SELECT id, user_id, platform, token, name, muted_events, created_at, last_seen_at
+FROM push_device
+WHERE user_id = $1
+ORDER BY last_seen_at DESC;
+
+-- name: UpdatePushDeviceMutedEvents :one
+UPDATE push_device
+SET muted_events = sqlc.arg(muted_events)::text[]
+WHERE id = $1 AND user_id = $2
+RETURNING id, user_id, platform, token, name, muted_events, created_at, last_seen_at;
+
+-- name: UnregisterPushDevice :execrows
+DELETE FROM push_device
+WHERE token = $1 AND user_id = $2;
+
+-- name: ListPushTargets :many
+-- The devices of the given users that have not muted the event.
+SELECT id, user_id, platform, token, name, muted_events, created_at, last_seen_at
+FROM push_device
+WHERE user_id = ANY(sqlc.arg(user_ids)::int[])
+  AND NOT (sqlc.arg(event)::text = ANY(muted_events));
+
+-- name: DeletePushDeviceToken :exec
+-- Drops a token the push service reported as no longer valid.
+DELETE FROM push_device
+WHERE token = $1;
@@ -0,0 +1,22 @@
+-- name: AddReaction :exec
+INSERT INTO reaction (user_id, todo_id, comment_id, emoji)
+VALUES (@user_id, sqlc.narg(todo_id)::integer, sqlc.narg(comment_id)::integer, @emoji)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveReaction :exec
+DELETE FROM reaction
+WHERE user_id = @user_id AND emoji = @emoji
+  AND (todo_id = sqlc.narg(todo_id)::integer OR comment_id = sqlc.narg(comment_id)::integer);
+
+-- name: ListReactions :many
+-- Sums up the reactions to some todos and comments: one row per target
+-- and emoji, the emoji in the order they were first used.
+SELECT
+  todo_id,
+  comment_id,
+  emoji,
+  array_agg(user_id ORDER BY created_at, id)::integer[] AS user_ids
+FROM reaction
+WHERE todo_id = ANY(@todo_ids::integer[]) OR comment_id = ANY(@comment_ids::integer[])
+GROUP BY todo_id, comment_id, emoji
+ORDER BY todo_id, comment_id, min(created_at), emoji;
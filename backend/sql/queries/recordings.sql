@@ -10,8 +10,42 @@ SELECT
   r.nas_audio,
   r.duration,
   r.notes,
-  r.archived
+  r.archived,
+  r.audio_key,
+  r.status,
+  r.status_error,
+  r.status_updated_at,
+  r.sentiment,
+  r.prompt_template_id,
+  r.retain_until,
+  r.audio_purged_at,
+  r.transcript_purged_at,
+  r.legal_hold,
+  r.legal_hold_reason,
+  r.scan_status,
+  r.processing_settings,
+  r.cloned_from_recording_id,
+  todos.open_todo_count,
+  todos.done_todo_count
 FROM recording r
+CROSS JOIN LATERAL (
+  SELECT
+    COUNT(*) FILTER (WHERE COALESCE(t.status, 'todo') NOT IN ('done', 'skipped'))::integer AS open_todo_count,
+    COUNT(*) FILTER (WHERE t.status = 'done')::integer AS done_todo_count
+  FROM todo t
+  WHERE t.created_at_recording_id = r.id
+) todos
+WHERE (sqlc.narg(participant_id)::integer IS NULL OR EXISTS (
+    SELECT 1 FROM speaker_to_user stu
+    WHERE stu.recording_id = r.id AND stu.user_id = sqlc.narg(participant_id)::integer
+  ))
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR r.created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR r.created_at < sqlc.narg(created_before)::timestamptz)
+  AND (sqlc.narg(has_audio)::boolean IS NULL OR (COALESCE(r.audio_url, '') <> '' OR r.audio_key IS NOT NULL) = sqlc.narg(has_audio)::boolean)
+  AND (sqlc.narg(query)::text IS NULL
+    OR r.name ILIKE '%' || sqlc.narg(query)::text || '%'
+    OR r.summary ILIKE '%' || sqlc.narg(query)::text || '%'
+    OR r.transcript ILIKE '%' || sqlc.narg(query)::text || '%')
 ORDER BY r.created_at DESC;
 
 -- name: GetRecording :one
@@ -26,7 +60,47 @@ SELECT
   r.nas_audio,
   r.duration,
   r.notes,
-  r.archived
+  r.archived,
+  r.audio_key,
+  r.status,
+  r.status_error,
+  r.status_updated_at,
+  r.sentiment,
+  r.prompt_template_id,
+  r.retain_until,
+  r.audio_purged_at,
+  r.transcript_purged_at,
+  r.legal_hold,
+  r.legal_hold_reason,
+  r.scan_status,
+  r.processing_settings,
+  r.cloned_from_recording_id,
+  todos.open_todo_count,
+  todos.done_todo_count
+FROM recording r
+CROSS JOIN LATERAL (
+  SELECT
+    COUNT(*) FILTER (WHERE COALESCE(t.status, 'todo') NOT IN ('done', 'skipped'))::integer AS open_todo_count,
+    COUNT(*) FILTER (WHERE t.status = 'done')::integer AS done_todo_count
+  FROM todo t
+  WHERE t.created_at_recording_id = r.id
+) todos
+WHERE r.id = $1;
+
+-- name: GetRecordingsVersion :one
+-- Covers the todos of every recording too, whose counts the list shows.
+SELECT
+  COUNT(*)::bigint AS total,
+  COALESCE(MAX(updated_at), 'epoch'::timestamptz)::timestamptz AS latest,
+  (SELECT COUNT(*) FROM todo WHERE created_at_recording_id IS NOT NULL)::bigint AS todos,
+  (SELECT COALESCE(MAX(updated_at), 'epoch'::timestamptz) FROM todo WHERE created_at_recording_id IS NOT NULL)::timestamptz AS todos_latest
+FROM recording;
+
+-- name: GetRecordingVersion :one
+SELECT
+  r.updated_at,
+  (SELECT COUNT(*) FROM todo t WHERE t.created_at_recording_id = r.id)::bigint AS todos,
+  (SELECT COALESCE(MAX(t.updated_at), 'epoch'::timestamptz) FROM todo t WHERE t.created_at_recording_id = r.id)::timestamptz AS todos_latest
 FROM recording r
 WHERE r.id = $1;
 
@@ -36,11 +110,220 @@ SELECT
   u.first_name,
   u.last_name,
   u.role,
+  u.avatar_key,
   stu.speaker_id
 FROM speaker_to_user stu
 JOIN "user" u ON u.id = stu.user_id
 WHERE stu.recording_id = $1;
 
--- name: DeleteRecording :exec
-DELETE FROM recording
+-- name: ListParticipantsForRecordings :many
+SELECT
+  stu.recording_id,
+  u.id,
+  u.first_name,
+  u.last_name,
+  u.role,
+  u.avatar_key,
+  stu.speaker_id
+FROM speaker_to_user stu
+JOIN "user" u ON u.id = stu.user_id
+WHERE stu.recording_id = ANY(sqlc.arg(recording_ids)::integer[])
+ORDER BY stu.recording_id, stu.speaker_id;
+
+-- name: CreateUploadedRecording :one
+INSERT INTO recording (created_at, name, audio_key, audio_bytes, created_by_user_id, audio_sha256, scan_status, prompt_template_id, processing_settings)
+VALUES (now(), $1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, created_at, name;
+
+-- name: FindRecordingByAudioHash :one
+-- The oldest recording with the same audio, so re-uploads of a meeting
+-- point at it instead of being transcribed again.
+SELECT id, created_at, name, status
+FROM recording
+WHERE audio_sha256 = @audio_sha256::text
+ORDER BY id
+LIMIT 1;
+
+-- name: CreateRecording :one
+INSERT INTO recording (created_at, name, transcript, summary, duration, status)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id;
+
+-- name: AddRecordingParticipant :exec
+INSERT INTO speaker_to_user (recording_id, speaker_id, user_id, words_spoken)
+VALUES ($1, $2, $3, $4);
+
+-- name: DeleteRecording :one
+-- Recordings under legal hold are left alone. Returns the stored objects
+-- only the deleted rows pointed at, for the caller to delete: the audio,
+-- empty when there is none or a clone or a deduplicated upload shares it,
+-- and the audio of its clips and its attachments.
+DELETE FROM recording r
+WHERE r.id = $1 AND NOT r.legal_hold
+RETURNING
+  (CASE WHEN EXISTS (SELECT 1 FROM recording o WHERE o.audio_key = r.audio_key AND o.id <> r.id) THEN '' ELSE COALESCE(r.audio_key, '') END)::text AS audio_key,
+  ARRAY(SELECT c.audio_key FROM recording_clip c WHERE c.recording_id = r.id ORDER BY c.id)::text[] AS clip_audio_keys,
+  ARRAY(SELECT a.storage_key FROM attachment a WHERE a.recording_id = r.id ORDER BY a.id)::text[] AS attachment_keys;
+
+-- name: CloneRecording :one
+-- Copies a recording's metadata and audio reference into a new recording
+-- waiting to be transcribed. The stored audio is shared, so it isn't
+-- counted against the clone's creator again.
+INSERT INTO recording (created_at, name, audio_key, duration, created_by_user_id, audio_sha256, scan_status, prompt_template_id, processing_settings, cloned_from_recording_id)
+SELECT now(), sqlc.narg(name)::text, src.audio_key, src.duration, sqlc.narg(created_by_user_id)::integer, src.audio_sha256, src.scan_status, sqlc.narg(prompt_template_id)::integer, @processing_settings::jsonb, src.id
+FROM recording src
+WHERE src.id = sqlc.arg(id)::integer
+RETURNING id;
+
+-- name: SetRecordingProcessingSettings :exec
+UPDATE recording
+SET processing_settings = @processing_settings::jsonb,
+    prompt_template_id = sqlc.narg(prompt_template_id)::integer,
+    updated_at = now()
+WHERE id = @id::integer;
+
+-- name: CreateLiveRecording :one
+INSERT INTO recording (created_at, name, created_by_user_id)
+VALUES (now(), $1, $2)
+RETURNING id, created_at, name;
+
+-- name: CreateRecordingIngest :exec
+INSERT INTO recording_ingest (recording_id, user_id, sample_rate, channels)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetRecordingIngest :one
+SELECT recording_id, user_id, sample_rate, channels, started_at, finalized_at
+FROM recording_ingest
+WHERE recording_id = $1;
+
+-- name: UpsertRecordingIngestChunk :exec
+INSERT INTO recording_ingest_chunk (recording_id, seq, size_bytes)
+VALUES ($1, $2, $3)
+ON CONFLICT (recording_id, seq) DO UPDATE
+SET size_bytes = EXCLUDED.size_bytes,
+    received_at = now();
+
+-- name: ListRecordingIngestChunks :many
+SELECT seq, size_bytes
+FROM recording_ingest_chunk
+WHERE recording_id = $1
+ORDER BY seq;
+
+-- name: FinalizeRecordingIngest :exec
+UPDATE recording_ingest
+SET finalized_at = now()
+WHERE recording_id = $1;
+
+-- name: SetRecordingAudio :exec
+UPDATE recording
+SET audio_key = $2,
+    duration = $3,
+    audio_bytes = $4,
+    audio_sha256 = $5,
+    scan_status = $6,
+    updated_at = now()
+WHERE id = $1;
+
+-- name: GetRecordingStatusForUpdate :one
+SELECT status
+FROM recording
+WHERE id = $1
+FOR UPDATE;
+
+-- name: SetRecordingStatus :exec
+-- A failed job dismissed by an admin comes back if the recording fails
+-- again after a retry.
+UPDATE recording
+SET status = $2,
+    status_error = $3,
+    status_updated_at = now(),
+    processing_dismissed_at = NULL,
+    updated_at = now()
+WHERE id = $1;
+
+-- name: CreateRecordingStatusEvent :exec
+INSERT INTO recording_status_event (recording_id, from_status, to_status, error)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListRecordingStatusEvents :many
+SELECT id, from_status, to_status, error, created_at
+FROM recording_status_event
+WHERE recording_id = $1
+ORDER BY created_at, id;
+
+-- name: CreateProcessingAttempt :exec
+INSERT INTO recording_processing_attempt (recording_id, stage, attempt, status, requested_by_user_id, started_at)
+SELECT @recording_id::integer, @stage::text, COALESCE(MAX(attempt), 0) + 1, @status::text, sqlc.narg(requested_by_user_id)::integer,
+  CASE WHEN @status::text = 'running' THEN now() END
+FROM recording_processing_attempt
+WHERE recording_id = @recording_id::integer AND stage = @stage::text;
+
+-- name: StartPendingProcessingAttempt :execrows
+UPDATE recording_processing_attempt
+SET status = 'running',
+    started_at = now()
+WHERE recording_id = $1 AND stage = $2 AND status = 'pending';
+
+-- name: HasOpenProcessingAttempt :one
+SELECT EXISTS (
+  SELECT 1 FROM recording_processing_attempt
+  WHERE recording_id = $1 AND stage = $2 AND finished_at IS NULL
+);
+
+-- name: FinishProcessingAttempts :exec
+UPDATE recording_processing_attempt
+SET status = $3,
+    error = $4,
+    finished_at = now()
+WHERE recording_id = $1 AND stage = $2 AND finished_at IS NULL;
+
+-- name: ListProcessingAttempts :many
+SELECT stage, attempt, status, error, requested_by_user_id, created_at, started_at, finished_at
+FROM recording_processing_attempt
+WHERE recording_id = $1
+ORDER BY stage DESC, attempt;
+
+-- name: ListRecordingTranslations :many
+SELECT kind, language, text, provider, model, requested_by_user_id, created_at, updated_at
+FROM recording_translation
+WHERE recording_id = $1
+ORDER BY kind, language;
+
+-- name: UpsertRecordingTranslation :exec
+-- Bumps the recording's updated_at too, so its ETag changes.
+WITH touched AS (
+  UPDATE recording SET updated_at = now() WHERE recording.id = $1
+)
+INSERT INTO recording_translation (recording_id, kind, language, text, provider, model, requested_by_user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (recording_id, kind, language) DO UPDATE
+SET text = EXCLUDED.text,
+    provider = EXCLUDED.provider,
+    model = EXCLUDED.model,
+    requested_by_user_id = EXCLUDED.requested_by_user_id,
+    updated_at = now();
+
+-- name: SetRecordingSummary :exec
+UPDATE recording
+SET summary = $2,
+    updated_at = now()
 WHERE id = $1;
+
+-- name: SetRecordingTranscript :exec
+UPDATE recording
+SET transcript = $2,
+    updated_at = now()
+WHERE id = $1;
+
+-- name: ClearSpeakerLabel :exec
+-- Unmaps a speaker label from whoever it was given to, user or guest, and
+-- touches the recording so its ETag changes.
+WITH users AS (
+  DELETE FROM speaker_to_user stu
+  WHERE stu.recording_id = @recording_id::integer AND stu.speaker_id = @speaker_id::integer
+), guests AS (
+  UPDATE guest_participant g SET speaker_id = NULL
+  WHERE g.recording_id = @recording_id::integer AND g.speaker_id = @speaker_id::integer
+)
+UPDATE recording r SET updated_at = now()
+WHERE r.id = @recording_id::integer;
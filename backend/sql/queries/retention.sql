@@ -0,0 +1,82 @@
+-- name: ListRetentionCandidates :many
+-- Recordings holding audio created before audio_before or transcripts
+-- created before transcript_before, oldest first. A NULL bound keeps that
+-- kind of data forever. Recordings under legal hold, kept by retain_until
+-- or still being processed are skipped. Audio shared with a clone is
+-- flagged so it's left in storage for the other recording.
+SELECT id, name, created_at, audio_key, audio_key_shared, clip_audio_keys, purge_audio, purge_transcript
+FROM (
+  SELECT
+    r.id,
+    r.name,
+    r.created_at,
+    r.audio_key,
+    EXISTS (SELECT 1 FROM recording o WHERE o.audio_key = r.audio_key AND o.id <> r.id)::boolean AS audio_key_shared,
+    ARRAY(SELECT c.audio_key FROM recording_clip c WHERE c.recording_id = r.id ORDER BY c.id)::text[] AS clip_audio_keys,
+    COALESCE(r.audio_purged_at IS NULL
+      AND r.created_at < sqlc.narg(audio_before)::timestamptz
+      AND (r.audio_key IS NOT NULL
+        OR COALESCE(r.audio_url, '') <> ''
+        OR COALESCE(r.local_audio, '') <> ''
+        OR COALESCE(r.nas_audio, '') <> ''
+        OR EXISTS (SELECT 1 FROM recording_clip c WHERE c.recording_id = r.id)), false)::boolean AS purge_audio,
+    COALESCE(r.transcript_purged_at IS NULL
+      AND r.created_at < sqlc.narg(transcript_before)::timestamptz
+      AND (COALESCE(r.transcript, '') <> ''
+        OR EXISTS (SELECT 1 FROM recording_translation t WHERE t.recording_id = r.id AND t.kind = 'transcript')), false)::boolean AS purge_transcript
+  FROM recording r
+  WHERE NOT r.legal_hold
+    AND (r.retain_until IS NULL OR r.retain_until <= now())
+    AND r.status IN ('ready', 'failed')
+) candidate
+WHERE purge_audio OR purge_transcript
+ORDER BY created_at, id
+LIMIT sqlc.arg(max_recordings);
+
+-- name: PurgeRecordingAudio :exec
+-- Forgets the recording's audio and the clips cut from it. The stored
+-- objects must already be deleted.
+WITH clips AS (
+  DELETE FROM recording_clip WHERE recording_id = $1
+)
+UPDATE recording
+SET audio_key = NULL,
+    audio_url = NULL,
+    local_audio = NULL,
+    nas_audio = NULL,
+    audio_bytes = NULL,
+    audio_purged_at = now(),
+    updated_at = now()
+WHERE recording.id = $1;
+
+-- name: PurgeRecordingTranscript :exec
+-- Removes the transcript and everything quoting or scoring it:
+-- translations, mentions, keyword alerts, confidence scores, earlier
+-- revisions, clip excerpts and outcome quotes. Summaries, minutes and
+-- todos are kept.
+WITH translations AS (
+  DELETE FROM recording_translation WHERE recording_id = $1 AND kind = 'transcript'
+), mentions AS (
+  DELETE FROM transcript_mention WHERE recording_id = $1
+), alerts AS (
+  DELETE FROM keyword_alert WHERE recording_id = $1
+), segments AS (
+  DELETE FROM transcript_segment WHERE recording_id = $1
+), revisions AS (
+  DELETE FROM transcript_revision WHERE recording_id = $1
+), clips AS (
+  UPDATE recording_clip SET transcript_excerpt = '' WHERE recording_id = $1
+), outcomes AS (
+  UPDATE meeting_outcome SET quote = NULL WHERE recording_id = $1
+)
+UPDATE recording
+SET transcript = NULL,
+    transcript_purged_at = now(),
+    updated_at = now()
+WHERE recording.id = $1;
+
+-- name: SetRecordingRetainUntil :execrows
+UPDATE recording
+SET retain_until = $2,
+    updated_at = now()
+WHERE id = $1;
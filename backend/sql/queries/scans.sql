@@ -0,0 +1,47 @@
+-- name: ListRecordingsPendingScan :many
+SELECT id, audio_key
+FROM recording
+WHERE scan_status = 'pending' AND audio_key IS NOT NULL
+ORDER BY id
+LIMIT @max_rows::integer;
+
+-- name: SetRecordingScanResult :exec
+UPDATE recording
+SET scan_status = @scan_status::text,
+    scan_detail = sqlc.narg(scan_detail)::text,
+    scanned_at = now(),
+    updated_at = now()
+WHERE id = @id::integer AND scan_status = 'pending';
+
+-- name: ListQuarantinedFiles :many
+-- Attachments and recording audio held back by the scanner, oldest first.
+SELECT 'attachment'::text AS kind, a.id, a.file_name AS name, a.scan_detail, a.scanned_at, a.uploaded_by_user_id AS user_id
+FROM attachment a
+WHERE a.scan_status = 'quarantined'
+UNION ALL
+SELECT 'recording'::text AS kind, r.id, COALESCE(r.name, '') AS name, r.scan_detail, r.scanned_at, r.created_by_user_id AS user_id
+FROM recording r
+WHERE r.scan_status = 'quarantined'
+ORDER BY scanned_at, id;
+
+-- name: ReleaseQuarantinedAttachment :execrows
+UPDATE attachment
+SET scan_status = 'released'
+WHERE id = $1 AND scan_status = 'quarantined';
+
+-- name: ReleaseQuarantinedRecording :execrows
+UPDATE recording
+SET scan_status = 'released',
+    updated_at = now()
+WHERE id = $1 AND scan_status = 'quarantined';
+
+-- name: DeleteQuarantinedAttachment :one
+DELETE FROM attachment
+WHERE id = $1 AND scan_status = 'quarantined'
+RETURNING storage_key;
+
+-- name: DeleteQuarantinedRecording :one
+-- Recordings under legal hold are left alone.
+DELETE FROM recording
+WHERE id = $1 AND scan_status = 'quarantined' AND NOT legal_hold
+RETURNING audio_key;
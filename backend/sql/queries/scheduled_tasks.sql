@@ -0,0 +1,47 @@
+-- name: ListScheduledTasks :many
+SELECT * FROM scheduled_task
+ORDER BY name;
+
+-- name: CreateScheduledTasks :exec
+-- Adds rows for tasks new to the database; existing rows keep their
+-- schedule and history.
+INSERT INTO scheduled_task (name)
+SELECT unnest(sqlc.arg(names)::text[])
+ON CONFLICT (name) DO NOTHING;
+
+-- name: ClaimScheduledTask :execrows
+-- Claims the run due at slot. Every process wakes for the same slot, and
+-- only the first to claim it runs the task; later claims, and claims of a
+-- paused task, change nothing.
+UPDATE scheduled_task
+SET last_slot_at = sqlc.arg(slot),
+    last_started_at = now()
+WHERE name = sqlc.arg(name)
+  AND NOT paused
+  AND (last_slot_at IS NULL OR last_slot_at < sqlc.arg(slot));
+
+-- name: FinishScheduledTask :exec
+UPDATE scheduled_task
+SET last_finished_at = now(),
+    last_error = sqlc.narg(error),
+    last_succeeded_at = CASE WHEN sqlc.narg(error)::text IS NULL THEN now() ELSE last_succeeded_at END
+WHERE name = sqlc.arg(name);
+
+-- name: UpdateScheduledTask :one
+-- A NULL schedule goes back to the one the server is configured with.
+INSERT INTO scheduled_task (name, schedule, paused)
+VALUES (sqlc.arg(name), sqlc.narg(schedule), sqlc.arg(paused))
+ON CONFLICT (name) DO UPDATE
+SET schedule = EXCLUDED.schedule,
+    paused = EXCLUDED.paused,
+    updated_at = now()
+RETURNING *;
+
+-- name: TryLockScheduledTask :one
+-- Takes the session-level advisory lock that keeps a task from running in
+-- two processes at once. It is held until UnlockScheduledTask on the same
+-- connection, or until the connection closes.
+SELECT pg_try_advisory_lock(hashtextextended('scheduled_task:' || sqlc.arg(name)::text, 0))::boolean AS locked;
+
+-- name: UnlockScheduledTask :exec
+SELECT pg_advisory_unlock(hashtextextended('scheduled_task:' || sqlc.arg(name)::text, 0));
@@ -0,0 +1,176 @@
+-- name: ListScimUsers :many
+-- A page of users for the SCIM Users endpoint, matching the filter the
+-- identity provider sent, if any. userName is the email address.
+SELECT
+  u.id,
+  u.first_name,
+  u.last_name,
+  u.email,
+  u.scim_external_id,
+  u.deactivated_at
+FROM "user" u
+WHERE (sqlc.narg(email)::text IS NULL OR lower(u.email) = lower(sqlc.narg(email)::text))
+  AND (sqlc.narg(external_id)::text IS NULL OR u.scim_external_id = sqlc.narg(external_id)::text)
+ORDER BY u.id
+OFFSET sqlc.arg(row_offset)
+LIMIT sqlc.arg(row_limit);
+
+-- name: CountScimUsers :one
+SELECT count(*)
+FROM "user" u
+WHERE (sqlc.narg(email)::text IS NULL OR lower(u.email) = lower(sqlc.narg(email)::text))
+  AND (sqlc.narg(external_id)::text IS NULL OR u.scim_external_id = sqlc.narg(external_id)::text);
+
+-- name: GetScimUser :one
+SELECT
+  u.id,
+  u.first_name,
+  u.last_name,
+  u.email,
+  u.scim_external_id,
+  u.deactivated_at
+FROM "user" u
+WHERE u.id = $1;
+
+-- name: CreateScimUser :one
+INSERT INTO "user" (
+  first_name,
+  last_name,
+  role,
+  email,
+  password_hash,
+  scim_external_id,
+  scim_provisioned
+) VALUES (@first_name, @last_name, @role, @email, @password_hash, @scim_external_id, true)
+RETURNING id;
+
+-- name: UpdateScimUser :execrows
+-- Updating a user over SCIM hands their role and workspaces to the
+-- identity provider from then on.
+UPDATE "user"
+SET first_name = @first_name,
+    last_name = @last_name,
+    email = @email,
+    scim_external_id = @scim_external_id,
+    scim_provisioned = true
+WHERE id = @id;
+
+-- name: SetUserPassword :exec
+UPDATE "user"
+SET password_hash = $2
+WHERE id = $1;
+
+-- name: SetScimUserRole :exec
+-- Only users the identity provider manages take their role from groups.
+UPDATE "user"
+SET role = $2
+WHERE id = $1 AND scim_provisioned;
+
+-- name: ListScimGroups :many
+SELECT
+  g.id,
+  g.display_name,
+  g.external_id,
+  g.role,
+  g.workspace_id,
+  w.name AS workspace_name,
+  (SELECT count(*) FROM scim_group_member m WHERE m.group_id = g.id) AS member_count
+FROM scim_group g
+LEFT JOIN workspace w ON w.id = g.workspace_id
+WHERE (sqlc.narg(display_name)::text IS NULL OR g.display_name = sqlc.narg(display_name)::text)
+  AND (sqlc.narg(external_id)::text IS NULL OR g.external_id = sqlc.narg(external_id)::text)
+ORDER BY g.id
+OFFSET sqlc.arg(row_offset)
+LIMIT sqlc.arg(row_limit);
+
+-- name: CountScimGroups :one
+SELECT count(*)
+FROM scim_group g
+WHERE (sqlc.narg(display_name)::text IS NULL OR g.display_name = sqlc.narg(display_name)::text)
+  AND (sqlc.narg(external_id)::text IS NULL OR g.external_id = sqlc.narg(external_id)::text);
+
+-- name: GetScimGroup :one
+SELECT * FROM scim_group WHERE id = $1;
+
+-- name: CreateScimGroup :one
+INSERT INTO scim_group (display_name, external_id)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: UpdateScimGroup :execrows
+UPDATE scim_group
+SET display_name = $2,
+    external_id = $3,
+    updated_at = now()
+WHERE id = $1;
+
+-- name: DeleteScimGroup :execrows
+DELETE FROM scim_group WHERE id = $1;
+
+-- name: SetScimGroupMapping :one
+UPDATE scim_group
+SET role = sqlc.narg(role),
+    workspace_id = sqlc.narg(workspace_id),
+    updated_at = now()
+WHERE id = @id
+RETURNING *;
+
+-- name: ListScimGroupMembers :many
+SELECT
+  u.id,
+  u.first_name,
+  u.last_name,
+  u.email
+FROM scim_group_member m
+JOIN "user" u ON u.id = m.user_id
+WHERE m.group_id = $1
+ORDER BY u.id;
+
+-- name: AddScimGroupMember :exec
+INSERT INTO scim_group_member (group_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveScimGroupMember :exec
+DELETE FROM scim_group_member
+WHERE group_id = $1 AND user_id = $2;
+
+-- name: ClearScimGroupMembers :exec
+DELETE FROM scim_group_member WHERE group_id = $1;
+
+-- name: ListScimGroupsForUser :many
+SELECT g.id, g.display_name, g.role, g.workspace_id
+FROM scim_group_member m
+JOIN scim_group g ON g.id = m.group_id
+WHERE m.user_id = $1
+ORDER BY g.id;
+
+-- name: SyncScimWorkspaces :one
+-- Adds the user to the workspaces their groups map to and removes them
+-- from the ones they were added to over SCIM and no longer map to.
+-- Memberships made in the app are left alone.
+WITH wanted AS (
+  SELECT DISTINCT g.workspace_id
+  FROM scim_group_member m
+  JOIN scim_group g ON g.id = m.group_id
+  WHERE m.user_id = @user_id AND g.workspace_id IS NOT NULL
+), added AS (
+  INSERT INTO workspace_user_rel (workspace_id, user_id, role, via_scim)
+  SELECT w.workspace_id, @user_id, 'member', true
+  FROM wanted w
+  ON CONFLICT DO NOTHING
+  RETURNING workspace_id
+), removed AS (
+  DELETE FROM workspace_user_rel r
+  WHERE r.user_id = @user_id AND r.via_scim
+    AND r.workspace_id NOT IN (SELECT workspace_id FROM wanted)
+  RETURNING workspace_id
+)
+SELECT
+  (SELECT count(*) FROM added)::integer AS added,
+  (SELECT count(*) FROM removed)::integer AS removed;
+
+-- name: ListAllWorkspaces :many
+SELECT id, name, created_at
+FROM workspace
+ORDER BY name, id;
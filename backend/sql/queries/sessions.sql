@@ -0,0 +1,52 @@
+-- name: CreateSession :one
+-- Signing in also forgets the user's expired sessions, so the table only
+-- grows with the sessions in use.
+WITH expired AS (
+  DELETE FROM user_session
+  WHERE user_session.user_id = $2 AND expires_at <= now()
+)
+INSERT INTO user_session (id, user_id, user_agent, ip, location, device_fingerprint, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, user_id, user_agent, ip, created_at, expires_at, last_seen_at, revoked_at, location, device_fingerprint;
+
+-- name: ListSessions :many
+SELECT id, user_id, user_agent, ip, created_at, expires_at, last_seen_at, revoked_at, location, device_fingerprint
+FROM user_session
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > now()
+ORDER BY last_seen_at DESC;
+
+-- name: TouchSession :exec
+UPDATE user_session
+SET last_seen_at = now(),
+    ip = $2
+WHERE id = $1;
+
+-- name: RevokeSession :one
+UPDATE user_session
+SET revoked_at = now()
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+RETURNING id, user_id, user_agent, ip, created_at, expires_at, last_seen_at, revoked_at, location, device_fingerprint;
+
+-- name: RevokeOtherSessions :many
+UPDATE user_session
+SET revoked_at = now()
+WHERE user_id = $1 AND id <> sqlc.arg(keep_id) AND revoked_at IS NULL AND expires_at > now()
+RETURNING id;
+
+-- name: ListRevokedSessionIDs :many
+-- Revoked sessions whose tokens would otherwise still be accepted.
+SELECT id
+FROM user_session
+WHERE revoked_at IS NOT NULL AND expires_at > now();
+
+
+-- name: RememberDevice :one
+-- known_devices counts the user's devices before this one, since the
+-- subquery sees the table as it was when the statement started.
+INSERT INTO user_device (user_id, fingerprint, device)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, fingerprint) DO UPDATE
+SET device = EXCLUDED.device,
+    last_seen_at = now()
+RETURNING (xmax = 0)::bool AS new_device,
+  (SELECT count(*) FROM user_device d WHERE d.user_id = $1)::bigint AS known_devices;
@@ -0,0 +1,59 @@
+-- name: GetOrgSetting :one
+SELECT id, org_name, logo_key, default_user_role, audio_retention_days, transcript_retention_days, disabled_integrations, updated_at, updated_by_user_id, redact_emails, redact_phone_numbers, redact_credit_cards, redact_profanity, default_language, disable_summary, disable_todo_extraction, maintenance_mode, maintenance_message, maintenance_started_at, ip_allowlist, org_id
+FROM org_setting
+WHERE id;
+
+-- name: SaveOrgSetting :one
+INSERT INTO org_setting (org_name, default_user_role, audio_retention_days, transcript_retention_days, disabled_integrations, redact_emails, redact_phone_numbers, redact_credit_cards, redact_profanity, default_language, disable_summary, disable_todo_extraction, updated_by_user_id)
+VALUES ($1, $2, $3, $4, sqlc.arg(disabled_integrations)::text[], $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (id) DO UPDATE
+SET org_name = EXCLUDED.org_name,
+    default_user_role = EXCLUDED.default_user_role,
+    audio_retention_days = EXCLUDED.audio_retention_days,
+    transcript_retention_days = EXCLUDED.transcript_retention_days,
+    disabled_integrations = EXCLUDED.disabled_integrations,
+    redact_emails = EXCLUDED.redact_emails,
+    redact_phone_numbers = EXCLUDED.redact_phone_numbers,
+    redact_credit_cards = EXCLUDED.redact_credit_cards,
+    redact_profanity = EXCLUDED.redact_profanity,
+    default_language = EXCLUDED.default_language,
+    disable_summary = EXCLUDED.disable_summary,
+    disable_todo_extraction = EXCLUDED.disable_todo_extraction,
+    updated_by_user_id = EXCLUDED.updated_by_user_id,
+    updated_at = now()
+RETURNING id, org_name, logo_key, default_user_role, audio_retention_days, transcript_retention_days, disabled_integrations, updated_at, updated_by_user_id, redact_emails, redact_phone_numbers, redact_credit_cards, redact_profanity, default_language, disable_summary, disable_todo_extraction, maintenance_mode, maintenance_message, maintenance_started_at, ip_allowlist, org_id;
+
+-- name: SetOrgLogo :one
+INSERT INTO org_setting (logo_key, updated_by_user_id)
+VALUES ($1, $2)
+ON CONFLICT (id) DO UPDATE
+SET logo_key = EXCLUDED.logo_key,
+    updated_by_user_id = EXCLUDED.updated_by_user_id,
+    updated_at = now()
+RETURNING id, org_name, logo_key, default_user_role, audio_retention_days, transcript_retention_days, disabled_integrations, updated_at, updated_by_user_id, redact_emails, redact_phone_numbers, redact_credit_cards, redact_profanity, default_language, disable_summary, disable_todo_extraction, maintenance_mode, maintenance_message, maintenance_started_at, ip_allowlist, org_id;
+
+-- name: SetMaintenanceMode :one
+-- maintenance_started_at keeps the time maintenance began while it stays
+-- on, however often the message changes.
+INSERT INTO org_setting (maintenance_mode, maintenance_message, maintenance_started_at, updated_by_user_id)
+VALUES (sqlc.arg(maintenance_mode), sqlc.arg(maintenance_message), CASE WHEN sqlc.arg(maintenance_mode)::boolean THEN now() END, sqlc.narg(updated_by_user_id))
+ON CONFLICT (id) DO UPDATE
+SET maintenance_mode = EXCLUDED.maintenance_mode,
+    maintenance_message = EXCLUDED.maintenance_message,
+    maintenance_started_at = CASE
+      WHEN NOT EXCLUDED.maintenance_mode THEN NULL
+      WHEN org_setting.maintenance_mode THEN org_setting.maintenance_started_at
+      ELSE now()
+    END,
+    updated_by_user_id = EXCLUDED.updated_by_user_id,
+    updated_at = now()
+RETURNING id, org_name, logo_key, default_user_role, audio_retention_days, transcript_retention_days, disabled_integrations, updated_at, updated_by_user_id, redact_emails, redact_phone_numbers, redact_credit_cards, redact_profanity, default_language, disable_summary, disable_todo_extraction, maintenance_mode, maintenance_message, maintenance_started_at, ip_allowlist, org_id;
+
+-- name: SetIPAllowlist :one
+INSERT INTO org_setting (ip_allowlist, updated_by_user_id)
+VALUES (sqlc.arg(ip_allowlist)::text[], sqlc.narg(updated_by_user_id))
+ON CONFLICT (id) DO UPDATE
+SET ip_allowlist = EXCLUDED.ip_allowlist,
+    updated_by_user_id = EXCLUDED.updated_by_user_id,
+    updated_at = now()
+RETURNING id, org_name, logo_key, default_user_role, audio_retention_days, transcript_retention_days, disabled_integrations, updated_at, updated_by_user_id, redact_emails, redact_phone_numbers, redact_credit_cards, redact_profanity, default_language, disable_summary, disable_todo_extraction, maintenance_mode, maintenance_message, maintenance_started_at, ip_allowlist, org_id;
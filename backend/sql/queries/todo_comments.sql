@@ -0,0 +1,48 @@
+-- name: ListTodoComments :many
+SELECT id, todo_id, body, author_user_id, created_at, updated_at
+FROM todo_comment
+WHERE todo_id = $1
+ORDER BY created_at, id;
+
+-- name: GetTodoComment :one
+SELECT id, todo_id, body, author_user_id, created_at, updated_at
+FROM todo_comment
+WHERE id = $1;
+
+-- name: CreateTodoComment :one
+INSERT INTO todo_comment (todo_id, body, author_user_id)
+VALUES ($1, $2, $3)
+RETURNING id, todo_id, body, author_user_id, created_at, updated_at;
+
+-- name: UpdateTodoComment :one
+UPDATE todo_comment
+SET body = $2,
+    updated_at = now()
+WHERE id = $1
+RETURNING id, todo_id, body, author_user_id, created_at, updated_at;
+
+-- name: DeleteTodoComment :execrows
+DELETE FROM todo_comment
+WHERE id = $1;
+
+-- name: ListCommentMentionedUsers :many
+SELECT comment_id, user_id
+FROM todo_comment_mention
+WHERE comment_id = ANY(@comment_ids::integer[])
+ORDER BY comment_id, id;
+
+-- name: ReplaceCommentMentions :exec
+-- Like ReplaceTodoMentions, for one comment after it is written or edited.
+WITH previous AS (
+  DELETE FROM todo_comment_mention
+  WHERE todo_comment_mention.comment_id = @comment_id
+  RETURNING todo_comment_mention.user_id, todo_comment_mention.read_at, todo_comment_mention.created_at
+)
+INSERT INTO todo_comment_mention (comment_id, user_id, matched_text, read_at, created_at)
+SELECT @comment_id, detected.user_id, detected.matched_text, previous.read_at, COALESCE(previous.created_at, now())
+FROM (
+  SELECT
+    unnest(@user_ids::integer[]) AS user_id,
+    unnest(@matched_texts::text[]) AS matched_text
+) AS detected
+LEFT JOIN previous ON previous.user_id = detected.user_id;
@@ -13,6 +13,10 @@ SELECT
   t.updated_at_recording_id,
   t.created_at,
   t.updated_at,
+  t.due_at,
+  t.version,
+  t.snoozed_until,
+  t.priority,
   r.name as recording_name,
   r.created_at as recording_date
 FROM todo t
@@ -20,27 +24,20 @@ LEFT JOIN recording r ON t.created_at_recording_id = r.id
 WHERE t.user_id = $1
 ORDER BY t.created_at DESC, t.id DESC;
 
--- name: ListTodosByRecording :many
+-- name: ListDueTodosByUser :many
 SELECT
   t.id,
   t.name,
   t."desc",
   t.status,
-  t.user_id,
-  t.workspace_id,
-  t.source_kind,
-  t.source_document_id,
-  t.source_block_id,
   t.created_at_recording_id,
-  t.updated_at_recording_id,
-  t.created_at,
   t.updated_at,
-  r.name as recording_name,
-  r.created_at as recording_date
+  t.due_at,
+  r.name as recording_name
 FROM todo t
 LEFT JOIN recording r ON t.created_at_recording_id = r.id
-WHERE t.created_at_recording_id = $1
-ORDER BY t.created_at DESC, t.id DESC;
+WHERE t.user_id = $1 AND t.due_at IS NOT NULL
+ORDER BY t.due_at ASC, t.id ASC;
 
 -- name: GetTodo :one
 SELECT
@@ -57,6 +54,10 @@ SELECT
   t.updated_at_recording_id,
   t.created_at,
   t.updated_at,
+  t.due_at,
+  t.version,
+  t.snoozed_until,
+  t.priority,
   r.name as recording_name,
   r.created_at as recording_date
 FROM todo t
@@ -70,25 +71,75 @@ INSERT INTO todo (
   status,
   user_id,
   created_at_recording_id,
-  updated_at_recording_id
-) VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at;
+  updated_at_recording_id,
+  due_at,
+  priority
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at, due_at, version, snoozed_until, priority;
 
 -- name: UpdateTodo :one
+-- A null due_at keeps the current one unless clear_due_at is set, and a
+-- null priority keeps the current one.
 UPDATE todo
 SET
-  name = $2,
-  "desc" = $3,
-  status = $4,
-  user_id = $5,
-  updated_at_recording_id = $6,
+  name = @name,
+  "desc" = sqlc.narg('desc'),
+  status = @status,
+  user_id = @user_id,
+  updated_at_recording_id = @updated_at_recording_id,
+  due_at = CASE WHEN @clear_due_at::boolean THEN NULL ELSE COALESCE(sqlc.narg(due_at), due_at) END,
+  priority = COALESCE(sqlc.narg(priority), priority),
+  version = version + 1,
   updated_at = now()
-WHERE id = $1
-RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at;
+WHERE id = @id AND version = @version
+RETURNING id, name, "desc", status, user_id, workspace_id, source_kind, source_document_id, source_block_id, created_at_recording_id, updated_at_recording_id, created_at, updated_at, due_at, version, snoozed_until, priority;
+
+-- name: SnoozeTodo :execrows
+UPDATE todo SET snoozed_until = sqlc.narg(snoozed_until) WHERE id = @id;
+
+-- name: WakeSnoozedTodos :many
+-- Ends the snoozes that have run out and returns their todos.
+UPDATE todo
+SET snoozed_until = NULL
+WHERE snoozed_until <= now()
+RETURNING id, name, "desc", status, user_id, created_at_recording_id;
+
+-- name: GetTodoVersion :one
+SELECT version FROM todo WHERE id = $1;
 
 -- name: DeleteTodo :exec
 DELETE FROM todo WHERE id = $1;
 
+-- name: MoveTodoReferences :exec
+-- Points everything that refers to the duplicate todo at the one it is
+-- merged into. Mentions, stars, reactions and tracker links the kept todo
+-- already has stay as they are; the duplicate's copies go when it is
+-- deleted.
+WITH history AS (
+  UPDATE todo_history SET todo_id = @into_id WHERE todo_id = @from_id
+), attachments AS (
+  UPDATE attachment SET todo_id = @into_id WHERE todo_id = @from_id
+), comments AS (
+  UPDATE todo_comment SET todo_id = @into_id WHERE todo_id = @from_id
+), reactions AS (
+  UPDATE reaction r SET todo_id = @into_id
+  WHERE r.todo_id = @from_id
+    AND NOT EXISTS (SELECT 1 FROM reaction k WHERE k.todo_id = @into_id AND k.user_id = r.user_id AND k.emoji = r.emoji)
+), blocks AS (
+  UPDATE block SET todo_id = @into_id WHERE todo_id = @from_id
+), mentions AS (
+  UPDATE todo_mention m SET todo_id = @into_id
+  WHERE m.todo_id = @from_id
+    AND NOT EXISTS (SELECT 1 FROM todo_mention k WHERE k.todo_id = @into_id AND k.user_id = m.user_id)
+), favorites AS (
+  UPDATE favorite f SET todo_id = @into_id
+  WHERE f.todo_id = @from_id
+    AND NOT EXISTS (SELECT 1 FROM favorite k WHERE k.todo_id = @into_id AND k.user_id = f.user_id)
+)
+UPDATE todo_tracker_link l SET todo_id = @into_id
+WHERE l.todo_id = @from_id
+  AND NOT EXISTS (SELECT 1 FROM todo_tracker_link k WHERE k.todo_id = @into_id AND k.tracker = l.tracker);
+
 -- name: CreateTodoHistory :exec
 INSERT INTO todo_history (
   todo_id,
@@ -99,8 +150,9 @@ INSERT INTO todo_history (
   status,
   user_id,
   created_at_recording_id,
-  updated_at_recording_id
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+  updated_at_recording_id,
+  note
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
 
 -- name: ListTodoHistory :many
 SELECT
@@ -114,7 +166,8 @@ SELECT
   h.user_id,
   h.created_at_recording_id,
   h.updated_at_recording_id,
-  h.changed_at
+  h.changed_at,
+  h.note
 FROM todo_history h
 WHERE h.todo_id = $1
 ORDER BY h.changed_at DESC;
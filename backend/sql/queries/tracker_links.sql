@@ -0,0 +1,37 @@
+-- name: ListTrackerLinks :many
+SELECT id, todo_id, tracker, external_id, external_key, url, external_state, created_by_user_id, created_at, synced_at, synced_status, polled_at
+FROM todo_tracker_link
+WHERE todo_id = $1
+ORDER BY tracker;
+
+-- name: GetTrackerLinkByExternalID :one
+SELECT id, todo_id, tracker, external_id, external_key, url, external_state, created_by_user_id, created_at, synced_at, synced_status, polled_at
+FROM todo_tracker_link
+WHERE tracker = $1 AND external_id = $2;
+
+-- name: CreateTrackerLink :one
+INSERT INTO todo_tracker_link (todo_id, tracker, external_id, external_key, url, external_state, synced_status, created_by_user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, todo_id, tracker, external_id, external_key, url, external_state, created_by_user_id, created_at, synced_at, synced_status, polled_at;
+
+-- name: MarkTrackerLinkSynced :exec
+-- Records the issue state and the todo status the two sides now agree on.
+UPDATE todo_tracker_link
+SET external_state = $2,
+    synced_status = $3,
+    synced_at = now()
+WHERE id = $1;
+
+-- name: ListTrackerLinksToPoll :many
+-- The links checked longest ago, for trackers that cannot reach the
+-- webhook.
+SELECT id, todo_id, tracker, external_id, external_key, url, external_state, created_by_user_id, created_at, synced_at, synced_status, polled_at
+FROM todo_tracker_link
+WHERE tracker = ANY(sqlc.arg(trackers)::text[])
+ORDER BY polled_at NULLS FIRST, id
+LIMIT sqlc.arg(max_links);
+
+-- name: MarkTrackerLinkPolled :exec
+UPDATE todo_tracker_link
+SET polled_at = now()
+WHERE id = $1;
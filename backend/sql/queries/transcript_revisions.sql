@@ -0,0 +1,26 @@
+-- name: GetLatestTranscriptRevision :one
+SELECT revision, transcript
+FROM transcript_revision
+WHERE recording_id = $1
+ORDER BY revision DESC
+LIMIT 1;
+
+-- name: GetTranscriptRevision :one
+SELECT recording_id, revision, transcript, source, created_by_user_id, created_at
+FROM transcript_revision
+WHERE recording_id = $1 AND revision = $2;
+
+-- name: ListTranscriptRevisions :many
+SELECT revision, source, created_by_user_id, created_at
+FROM transcript_revision
+WHERE recording_id = $1
+ORDER BY revision DESC;
+
+-- name: CreateTranscriptRevision :one
+-- Adds the revision after the latest. Callers hold the recording's row
+-- lock, so two edits can't take the same number.
+INSERT INTO transcript_revision (recording_id, revision, transcript, source, created_by_user_id)
+SELECT sqlc.arg(recording_id)::integer, COALESCE(MAX(revision), 0) + 1, sqlc.arg(transcript)::text, sqlc.arg(source)::text, sqlc.narg(created_by_user_id)::integer
+FROM transcript_revision
+WHERE recording_id = sqlc.arg(recording_id)::integer
+RETURNING revision;
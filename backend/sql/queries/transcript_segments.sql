@@ -0,0 +1,15 @@
+-- name: ListTranscriptSegments :many
+SELECT segment_index, confidence
+FROM transcript_segment
+WHERE recording_id = $1
+ORDER BY segment_index;
+
+-- name: DeleteTranscriptSegments :exec
+DELETE FROM transcript_segment
+WHERE recording_id = $1;
+
+-- name: CreateTranscriptSegments :exec
+-- Stores one confidence per transcript line, in line order.
+INSERT INTO transcript_segment (recording_id, segment_index, confidence)
+SELECT @recording_id::integer, (c.ordinality - 1)::integer, c.confidence
+FROM unnest(@confidences::real[]) WITH ORDINALITY AS c(confidence, ordinality);
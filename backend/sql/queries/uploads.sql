@@ -0,0 +1,116 @@
+-- name: CreatePendingUpload :one
+INSERT INTO pending_upload (audio_key, name, content_type, size_bytes, created_by_user_id, expires_at, sha256)
+VALUES (@audio_key::text, @name::text, @content_type::text, @size_bytes::bigint, sqlc.narg(created_by_user_id)::integer, @expires_at::timestamptz, sqlc.narg(sha256)::text)
+RETURNING id;
+
+-- name: GetPendingUpload :one
+SELECT id, audio_key, name, content_type, size_bytes, created_by_user_id, expires_at, sha256
+FROM pending_upload
+WHERE id = @id::integer
+  AND created_by_user_id IS NOT DISTINCT FROM sqlc.narg(created_by_user_id)::integer
+  AND expires_at > now();
+
+-- name: ConfirmPendingUpload :one
+-- Turns the upload into a recording in one statement, so confirming twice
+-- can't create two recordings.
+WITH claimed AS (
+  DELETE FROM pending_upload
+  WHERE pending_upload.id = @id::integer AND pending_upload.expires_at > now()
+  RETURNING pending_upload.name, pending_upload.audio_key, pending_upload.created_by_user_id
+)
+INSERT INTO recording (created_at, name, audio_key, audio_bytes, created_by_user_id, audio_sha256, scan_status, prompt_template_id, processing_settings)
+SELECT now(), claimed.name, claimed.audio_key, @audio_bytes::bigint, claimed.created_by_user_id, @audio_sha256::text, @scan_status::text, sqlc.narg(prompt_template_id)::integer, @processing_settings::jsonb
+FROM claimed
+RETURNING id, created_at, name;
+
+-- name: DeletePendingUpload :exec
+DELETE FROM pending_upload
+WHERE id = @id::integer;
+
+-- name: DeleteExpiredPendingUploads :many
+DELETE FROM pending_upload
+WHERE expires_at <= now()
+RETURNING audio_key;
+
+-- name: CreateResumableUpload :one
+INSERT INTO resumable_upload (user_id, name, extension, length_bytes, expires_at, sha256, prompt_template_id, processing_settings)
+VALUES (@user_id::integer, @name::text, @extension::text, @length_bytes::bigint, @expires_at::timestamptz, sqlc.narg(sha256)::text, sqlc.narg(prompt_template_id)::integer, @processing_settings::jsonb)
+RETURNING id;
+
+-- name: GetResumableUpload :one
+SELECT id, user_id, name, extension, length_bytes, received_bytes, recording_id, expires_at, sha256
+FROM resumable_upload
+WHERE id = @id::integer AND expires_at > now();
+
+-- name: AddResumableUploadPart :execrows
+-- Records a part only if it starts where the upload left off, so of two
+-- concurrent requests for the same offset one wins.
+WITH advanced AS (
+  UPDATE resumable_upload
+  SET received_bytes = resumable_upload.received_bytes + @size_bytes::bigint,
+      expires_at = @expires_at::timestamptz,
+      updated_at = now()
+  WHERE resumable_upload.id = @upload_id::integer
+    AND resumable_upload.received_bytes = @start_byte::bigint
+    AND resumable_upload.recording_id IS NULL
+  RETURNING resumable_upload.id
+)
+INSERT INTO resumable_upload_part (upload_id, start_byte, size_bytes, object_key)
+SELECT advanced.id, @start_byte::bigint, @size_bytes::bigint, @object_key::text
+FROM advanced;
+
+-- name: ListResumableUploadParts :many
+SELECT object_key
+FROM resumable_upload_part
+WHERE upload_id = @upload_id::integer
+ORDER BY start_byte;
+
+-- name: CompleteResumableUpload :one
+-- Creates the recording for a fully received upload and links it, so
+-- HEAD requests after completion can report it.
+WITH created AS (
+  INSERT INTO recording (created_at, name, audio_key, audio_bytes, created_by_user_id, audio_sha256, scan_status, prompt_template_id, processing_settings)
+  SELECT now(), u.name, @audio_key::text, u.length_bytes, u.user_id, @audio_sha256::text, @scan_status::text, u.prompt_template_id, u.processing_settings
+  FROM resumable_upload u
+  WHERE u.id = @id::integer AND u.recording_id IS NULL AND u.received_bytes = u.length_bytes
+  RETURNING recording.id, recording.created_at, recording.name
+), linked AS (
+  UPDATE resumable_upload
+  SET recording_id = created.id, updated_at = now()
+  FROM created
+  WHERE resumable_upload.id = @id::integer
+)
+SELECT created.id, created.created_at, created.name
+FROM created;
+
+-- name: LinkResumableUpload :execrows
+-- Points a finished upload at an existing recording with the same audio.
+UPDATE resumable_upload
+SET recording_id = @recording_id::integer, updated_at = now()
+WHERE id = @id::integer AND recording_id IS NULL;
+
+-- name: DeleteResumableUploadParts :exec
+DELETE FROM resumable_upload_part
+WHERE upload_id = @upload_id::integer;
+
+-- name: DeleteResumableUpload :many
+-- Returns the stored parts to delete. Parts are removed with the upload,
+-- but the select still sees them.
+WITH deleted AS (
+  DELETE FROM resumable_upload
+  WHERE resumable_upload.id = @id::integer
+  RETURNING resumable_upload.id
+)
+SELECT p.object_key
+FROM resumable_upload_part p
+JOIN deleted ON deleted.id = p.upload_id;
+
+-- name: DeleteExpiredResumableUploads :many
+WITH expired AS (
+  DELETE FROM resumable_upload
+  WHERE resumable_upload.expires_at <= now()
+  RETURNING resumable_upload.id
+)
+SELECT p.object_key
+FROM resumable_upload_part p
+JOIN expired ON expired.id = p.upload_id;
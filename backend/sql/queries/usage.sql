@@ -0,0 +1,87 @@
+-- name: CreateUsageEvent :exec
+-- Without a user, usage is attributed to whoever created the recording.
+INSERT INTO usage_event (user_id, recording_id, metric, quantity)
+VALUES (
+  COALESCE(sqlc.narg(user_id)::integer, (SELECT created_by_user_id FROM recording WHERE id = sqlc.narg(recording_id)::integer)),
+  sqlc.narg(recording_id)::integer,
+  @metric::text,
+  @quantity::bigint
+);
+
+-- name: RecordTranscriptionUsage :exec
+INSERT INTO usage_event (user_id, recording_id, metric, quantity)
+SELECT r.created_by_user_id, r.id, 'transcription_seconds', r.duration
+FROM recording r
+WHERE r.id = $1 AND r.duration > 0;
+
+-- name: SumUsage :many
+SELECT metric, COALESCE(SUM(quantity), 0)::bigint AS total
+FROM usage_event
+WHERE created_at >= @start_at::timestamptz
+  AND created_at < @end_at::timestamptz
+  AND (sqlc.narg(user_id)::integer IS NULL OR user_id = sqlc.narg(user_id)::integer)
+GROUP BY metric;
+
+-- name: SumUsageByUser :many
+SELECT user_id, metric, COALESCE(SUM(quantity), 0)::bigint AS total
+FROM usage_event
+WHERE created_at >= @start_at::timestamptz
+  AND created_at < @end_at::timestamptz
+  AND user_id IS NOT NULL
+GROUP BY user_id, metric
+ORDER BY user_id, metric;
+
+-- name: SumStorageBytes :one
+SELECT COALESCE(SUM(audio_bytes), 0)::bigint
+FROM recording
+WHERE sqlc.narg(user_id)::integer IS NULL OR created_by_user_id = sqlc.narg(user_id)::integer;
+
+-- name: SumStorageBytesByUser :many
+SELECT created_by_user_id AS user_id, COALESCE(SUM(audio_bytes), 0)::bigint AS total
+FROM recording
+WHERE created_by_user_id IS NOT NULL
+GROUP BY created_by_user_id
+ORDER BY created_by_user_id;
+
+-- name: SumProviderCost :one
+SELECT COALESCE(SUM(cost_micros), 0)::bigint
+FROM provider_usage
+WHERE created_at >= @start_at::timestamptz
+  AND created_at < @end_at::timestamptz;
+
+-- name: GetRecordingUsageOwner :one
+SELECT created_by_user_id, COALESCE(duration, 0)::integer AS duration
+FROM recording
+WHERE id = $1;
+
+-- name: CountRecordings :one
+SELECT COUNT(*)::bigint
+FROM recording
+WHERE sqlc.narg(user_id)::integer IS NULL OR created_by_user_id = sqlc.narg(user_id)::integer;
+
+-- name: CountRecordingsByUser :many
+SELECT created_by_user_id AS user_id, COUNT(*)::bigint AS total
+FROM recording
+WHERE created_by_user_id IS NOT NULL
+GROUP BY created_by_user_id
+ORDER BY created_by_user_id;
+
+-- name: ListQuotaOverrides :many
+SELECT id, user_id, metric, limit_value, reason, expires_at, set_by_user_id, created_at
+FROM quota_override
+WHERE expires_at IS NULL OR expires_at > now()
+ORDER BY user_id NULLS FIRST, metric;
+
+-- name: UpsertQuotaOverride :exec
+INSERT INTO quota_override (user_id, metric, limit_value, reason, expires_at, set_by_user_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT ((COALESCE(user_id, 0)), metric) DO UPDATE
+SET limit_value = EXCLUDED.limit_value,
+    reason = EXCLUDED.reason,
+    expires_at = EXCLUDED.expires_at,
+    set_by_user_id = EXCLUDED.set_by_user_id,
+    created_at = now();
+
+-- name: DeleteQuotaOverride :execrows
+DELETE FROM quota_override
+WHERE COALESCE(user_id, 0) = COALESCE(sqlc.narg(user_id)::integer, 0) AND metric = @metric::text;
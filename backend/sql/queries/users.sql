@@ -3,7 +3,9 @@ SELECT
   u.id,
   u.first_name,
   u.last_name,
-  u.role
+  u.role,
+  u.avatar_key,
+  u.deactivated_at
 FROM "user" u
 ORDER BY u.id;
 
@@ -14,7 +16,9 @@ SELECT
   u.last_name,
   u.role,
   u.email,
-  u.password_hash
+  u.password_hash,
+  u.deactivated_at,
+  u.locale
 FROM "user" u
 WHERE u.email = $1;
 
@@ -23,6 +27,132 @@ SELECT
   u.id,
   u.first_name,
   u.last_name,
-  u.role
+  u.role,
+  u.deactivated_at
 FROM "user" u
 WHERE u.id = $1;
+
+-- name: GetPasswordHash :one
+SELECT email, password_hash FROM "user" WHERE id = $1;
+
+-- name: UpdatePasswordHash :execrows
+-- Replaces the hash only if it is still old_hash, so a rehash at sign-in
+-- can't undo a password change made meanwhile.
+UPDATE "user"
+SET password_hash = @new_hash
+WHERE id = @id AND password_hash = @old_hash;
+
+-- name: CreateUser :one
+INSERT INTO "user" (
+  first_name,
+  last_name,
+  role,
+  email,
+  password_hash
+) VALUES ($1, $2, $3, $4, $5)
+RETURNING id;
+
+-- name: CountUsers :one
+SELECT count(*) FROM "user";
+
+-- name: GetProfile :one
+SELECT
+  u.id,
+  u.first_name,
+  u.last_name,
+  u.role,
+  u.email,
+  u.email_verified_at,
+  u.pending_email,
+  u.timezone,
+  u.avatar_key,
+  u.locale
+FROM "user" u
+WHERE u.id = $1;
+
+-- name: UpdateProfile :exec
+UPDATE "user"
+SET first_name = $2,
+    last_name = $3,
+    timezone = $4,
+    locale = $5
+WHERE id = $1;
+
+-- name: SetPendingEmail :exec
+UPDATE "user"
+SET pending_email = $2,
+    email_verification_hash = $3,
+    email_verification_expires_at = $4
+WHERE id = $1;
+
+-- name: ConfirmPendingEmail :execrows
+UPDATE "user"
+SET email = pending_email,
+    email_verified_at = now(),
+    pending_email = NULL,
+    email_verification_hash = NULL,
+    email_verification_expires_at = NULL
+WHERE id = $1
+  AND pending_email IS NOT NULL
+  AND email_verification_hash = $2
+  AND email_verification_expires_at > now();
+
+-- name: SetUserAvatar :exec
+UPDATE "user"
+SET avatar_key = $2
+WHERE id = $1;
+
+-- name: DeactivateUser :one
+-- Deactivates the user and hands their open todos to the successor, if
+-- there is one, in one statement, recording the transfer in each todo's
+-- history. Without a successor the todos stay with the user.
+WITH deactivated AS (
+  UPDATE "user" u SET deactivated_at = now()
+  WHERE u.id = @user_id AND u.deactivated_at IS NULL
+  RETURNING u.id
+), moved AS (
+  UPDATE todo t
+  SET user_id = @successor_id,
+      version = t.version + 1,
+      updated_at = now()
+  FROM deactivated d
+  WHERE t.user_id = d.id AND COALESCE(t.status, 'todo') NOT IN ('done', 'skipped')
+    AND @successor_id IS NOT NULL
+  RETURNING t.id, t.name, t."desc", t.status, t.user_id, t.created_at_recording_id, t.updated_at_recording_id
+), history AS (
+  INSERT INTO todo_history (
+    todo_id,
+    actor_user_id,
+    change_type,
+    name,
+    "desc",
+    status,
+    user_id,
+    created_at_recording_id,
+    updated_at_recording_id,
+    note
+  )
+  SELECT m.id, @actor_user_id::integer, 'update', m.name, m."desc", m.status, m.user_id, m.created_at_recording_id, m.updated_at_recording_id, @note::text
+  FROM moved m
+  RETURNING todo_id
+)
+SELECT
+  EXISTS (SELECT 1 FROM deactivated) AS deactivated,
+  (SELECT count(*) FROM history)::integer AS reassigned_todos;
+
+-- name: ReactivateUser :execrows
+UPDATE "user" SET deactivated_at = NULL
+WHERE id = $1 AND deactivated_at IS NOT NULL;
+
+-- name: ListDeactivatedUserIDs :many
+SELECT id FROM "user" WHERE deactivated_at IS NOT NULL;
+
+-- name: GetTodoFeedGeneration :one
+SELECT todo_feed_generation FROM "user" WHERE id = $1;
+
+-- name: ResetTodoFeedToken :one
+-- Bumping the generation invalidates every calendar feed link handed out
+-- before.
+UPDATE "user" SET todo_feed_generation = todo_feed_generation + 1
+WHERE id = $1
+RETURNING todo_feed_generation;
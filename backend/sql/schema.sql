@@ -95,6 +95,13 @@ CREATE TABLE "public"."user" (
   "role" text NULL,
   "email" text NULL,
   "password_hash" text NULL,
+  "email_verified_at" timestamptz NULL,
+  "pending_email" text NULL,
+  "email_verification_hash" text NULL,
+  "email_verification_expires_at" timestamptz NULL,
+  "timezone" text NOT NULL DEFAULT 'UTC',
+  "avatar_key" text NULL,
+  "locale" text NULL,
   PRIMARY KEY ("id")
 );
 -- Create "workspace" table
@@ -218,7 +225,19 @@ CREATE TABLE "public"."recording" (
   "duration" integer NULL,
   "notes" text NULL,
   "archived" boolean NULL,
-  PRIMARY KEY ("id")
+  "audio_key" text NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  "status" text NOT NULL DEFAULT 'uploaded',
+  "status_error" text NULL,
+  "status_updated_at" timestamptz NOT NULL DEFAULT now(),
+  "created_by_user_id" integer NULL,
+  "audio_bytes" bigint NULL,
+  "sentiment" text NULL,
+  "prompt_template_id" integer NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_created_by_user_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recording_sentiment_check" CHECK ("sentiment" = ANY (ARRAY['positive'::text, 'neutral'::text, 'negative'::text, 'mixed'::text])),
+  CONSTRAINT "recording_status_check" CHECK ("status" = ANY (ARRAY['uploaded'::text, 'transcribing'::text, 'summarizing'::text, 'ready'::text, 'failed'::text]))
 );
 -- Create "directory" table
 CREATE TABLE "public"."directory" (
@@ -289,6 +308,8 @@ CREATE TABLE "public"."todo" (
   "updated_at_recording_id" integer NULL,
   "created_at" timestamptz NOT NULL DEFAULT now(),
   "updated_at" timestamptz NOT NULL DEFAULT now(),
+  "due_at" timestamptz NULL,
+  "version" integer NOT NULL DEFAULT 1,
   PRIMARY KEY ("id"),
   CONSTRAINT "created_session_fk" FOREIGN KEY ("created_at_recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
   CONSTRAINT "todo_source_document_fk" FOREIGN KEY ("source_document_id") REFERENCES "public"."document" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
@@ -329,6 +350,8 @@ CREATE TABLE "public"."document_history" (
 CREATE UNIQUE INDEX "todo_source_block_idx" ON "public"."todo" ("source_block_id") WHERE (source_block_id IS NOT NULL);
 -- Create index "todo_workspace_idx" to table: "todo"
 CREATE INDEX "todo_workspace_idx" ON "public"."todo" ("workspace_id");
+-- Create index "todo_user_due_idx" to table: "todo"
+CREATE INDEX "todo_user_due_idx" ON "public"."todo" ("user_id", "due_at") WHERE (due_at IS NOT NULL);
 -- Create index "document_history_document_captured_idx" to table: "document_history"
 CREATE INDEX "document_history_document_captured_idx" ON "public"."document_history" ("document_id", "captured_at" DESC, "id" DESC);
 -- Create index "document_history_document_hash_idx" to table: "document_history"
@@ -346,6 +369,7 @@ CREATE TABLE "public"."todo_history" (
   "created_at_recording_id" integer NULL,
   "updated_at_recording_id" integer NULL,
   "changed_at" timestamptz NOT NULL DEFAULT now(),
+  "note" text NULL,
   PRIMARY KEY ("id"),
   CONSTRAINT "todo_history_todo_fk" FOREIGN KEY ("todo_id") REFERENCES "public"."todo" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
   CONSTRAINT "todo_history_actor_user_fk" FOREIGN KEY ("actor_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
@@ -353,6 +377,8 @@ CREATE TABLE "public"."todo_history" (
   CONSTRAINT "todo_history_created_at_recording_fk" FOREIGN KEY ("created_at_recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
   CONSTRAINT "todo_history_updated_at_recording_fk" FOREIGN KEY ("updated_at_recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
 );
+-- Create index "todo_history_changed_idx" to table: "todo_history"
+CREATE INDEX "todo_history_changed_idx" ON "public"."todo_history" ("changed_at" DESC, "id" DESC);
 -- Create "ai_thread" table
 CREATE TABLE "public"."ai_thread" (
   "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
@@ -459,3 +485,776 @@ CREATE INDEX "ai_source_ref_run_idx" ON "public"."ai_source_ref" ("run_id", "ran
 CREATE INDEX "ai_source_ref_source_idx" ON "public"."ai_source_ref" ("source_kind", "source_id");
 -- Create index "ai_thread_workspace_updated_idx" to table: "ai_thread"
 CREATE INDEX "ai_thread_workspace_updated_idx" ON "public"."ai_thread" ("workspace_id", "updated_at" DESC, "id" DESC);
+-- Create "recording_ingest" table
+CREATE TABLE "public"."recording_ingest" (
+  "recording_id" integer NOT NULL,
+  "user_id" integer NOT NULL,
+  "sample_rate" integer NOT NULL,
+  "channels" integer NOT NULL,
+  "started_at" timestamptz NOT NULL DEFAULT now(),
+  "finalized_at" timestamptz NULL,
+  PRIMARY KEY ("recording_id"),
+  CONSTRAINT "recording_ingest_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_ingest_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_ingest_format_check" CHECK (("sample_rate" > 0) AND ("channels" > 0))
+);
+-- Create "recording_ingest_chunk" table
+CREATE TABLE "public"."recording_ingest_chunk" (
+  "recording_id" integer NOT NULL,
+  "seq" integer NOT NULL,
+  "size_bytes" bigint NOT NULL,
+  "received_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("recording_id", "seq"),
+  CONSTRAINT "recording_ingest_chunk_ingest_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording_ingest" ("recording_id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_ingest_chunk_seq_check" CHECK ("seq" >= 0)
+);
+-- Create "recording_status_event" table
+CREATE TABLE "public"."recording_status_event" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "from_status" text NOT NULL,
+  "to_status" text NOT NULL,
+  "error" text NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_status_event_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "recording_status_event_recording_idx" to table: "recording_status_event"
+CREATE INDEX "recording_status_event_recording_idx" ON "public"."recording_status_event" ("recording_id", "created_at", "id");
+-- Create index "recording_status_event_created_idx" to table: "recording_status_event"
+CREATE INDEX "recording_status_event_created_idx" ON "public"."recording_status_event" ("created_at" DESC, "id" DESC);
+-- Create "recording_processing_attempt" table
+CREATE TABLE "public"."recording_processing_attempt" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "stage" text NOT NULL,
+  "attempt" integer NOT NULL,
+  "status" text NOT NULL,
+  "error" text NULL,
+  "requested_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "started_at" timestamptz NULL,
+  "finished_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_processing_attempt_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_processing_attempt_requested_by_fk" FOREIGN KEY ("requested_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recording_processing_attempt_stage_check" CHECK ("stage" = ANY (ARRAY['transcription'::text, 'summarization'::text])),
+  CONSTRAINT "recording_processing_attempt_status_check" CHECK ("status" = ANY (ARRAY['pending'::text, 'running'::text, 'succeeded'::text, 'failed'::text]))
+);
+-- Create index "recording_processing_attempt_number_idx" to table: "recording_processing_attempt"
+CREATE UNIQUE INDEX "recording_processing_attempt_number_idx" ON "public"."recording_processing_attempt" ("recording_id", "stage", "attempt");
+-- Create "recording_translation" table
+CREATE TABLE "public"."recording_translation" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "kind" text NOT NULL,
+  "language" text NOT NULL,
+  "text" text NOT NULL,
+  "provider" text NOT NULL,
+  "model" text NOT NULL,
+  "requested_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_translation_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_translation_requested_by_fk" FOREIGN KEY ("requested_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recording_translation_kind_check" CHECK ("kind" = ANY (ARRAY['summary'::text, 'transcript'::text]))
+);
+-- Create index "recording_translation_language_idx" to table: "recording_translation"
+CREATE UNIQUE INDEX "recording_translation_language_idx" ON "public"."recording_translation" ("recording_id", "kind", "language");
+-- Create "meeting_outcome" table
+CREATE TABLE "public"."meeting_outcome" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "kind" text NOT NULL,
+  "text" text NOT NULL,
+  "owner_user_id" integer NULL,
+  "quote" text NULL,
+  "source_kind" text NOT NULL DEFAULT 'manual',
+  "resolved_at" timestamptz NULL,
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "meeting_outcome_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "meeting_outcome_owner_fk" FOREIGN KEY ("owner_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "meeting_outcome_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "meeting_outcome_kind_check" CHECK ("kind" = ANY (ARRAY['decision'::text, 'risk'::text, 'open_question'::text])),
+  CONSTRAINT "meeting_outcome_source_kind_check" CHECK ("source_kind" = ANY (ARRAY['manual'::text, 'llm'::text]))
+);
+-- Create index "meeting_outcome_recording_idx" to table: "meeting_outcome"
+CREATE INDEX "meeting_outcome_recording_idx" ON "public"."meeting_outcome" ("recording_id", "kind", "id");
+-- Create index "meeting_outcome_owner_idx" to table: "meeting_outcome"
+CREATE INDEX "meeting_outcome_owner_idx" ON "public"."meeting_outcome" ("owner_user_id");
+-- Create index "meeting_outcome_created_idx" to table: "meeting_outcome"
+CREATE INDEX "meeting_outcome_created_idx" ON "public"."meeting_outcome" ("created_at" DESC, "id" DESC);
+-- Create "transcript_mention" table
+CREATE TABLE "public"."transcript_mention" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "segment_index" integer NOT NULL,
+  "speaker_id" integer NULL,
+  "user_id" integer NOT NULL,
+  "matched_text" text NOT NULL,
+  "segment_text" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "read_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "transcript_mention_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "transcript_mention_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "transcript_mention_segment_index_check" CHECK ("segment_index" >= 0)
+);
+-- Create index "transcript_mention_recording_segment_user_key" to table: "transcript_mention"
+CREATE UNIQUE INDEX "transcript_mention_recording_segment_user_key" ON "public"."transcript_mention" ("recording_id", "segment_index", "user_id");
+-- Create index "transcript_mention_user_idx" to table: "transcript_mention"
+CREATE INDEX "transcript_mention_user_idx" ON "public"."transcript_mention" ("user_id", "recording_id");
+-- Create index "transcript_mention_user_unread_idx" to table: "transcript_mention"
+CREATE INDEX "transcript_mention_user_unread_idx" ON "public"."transcript_mention" ("user_id") WHERE (read_at IS NULL);
+-- Create "todo_mention" table
+CREATE TABLE "public"."todo_mention" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "todo_id" integer NOT NULL,
+  "user_id" integer NOT NULL,
+  "matched_text" text NOT NULL,
+  "read_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_mention_todo_fk" FOREIGN KEY ("todo_id") REFERENCES "public"."todo" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_mention_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "todo_mention_todo_user_key" to table: "todo_mention"
+CREATE UNIQUE INDEX "todo_mention_todo_user_key" ON "public"."todo_mention" ("todo_id", "user_id");
+-- Create index "todo_mention_user_idx" to table: "todo_mention"
+CREATE INDEX "todo_mention_user_idx" ON "public"."todo_mention" ("user_id", "created_at" DESC);
+-- Create "provider_usage" table
+CREATE TABLE "public"."provider_usage" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "provider" text NOT NULL,
+  "kind" text NOT NULL,
+  "model" text NOT NULL,
+  "recording_id" integer NULL,
+  "succeeded" boolean NOT NULL,
+  "input_tokens" bigint NOT NULL DEFAULT 0,
+  "output_tokens" bigint NOT NULL DEFAULT 0,
+  "audio_seconds" double precision NOT NULL DEFAULT 0,
+  "cost_micros" bigint NOT NULL DEFAULT 0,
+  "duration_ms" integer NOT NULL,
+  "error" text NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "provider_usage_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "provider_usage_kind_check" CHECK ("kind" = ANY (ARRAY['transcription'::text, 'summarization'::text]))
+);
+-- Create index "provider_usage_provider_created_idx" to table: "provider_usage"
+CREATE INDEX "provider_usage_provider_created_idx" ON "public"."provider_usage" ("provider", "created_at");
+-- Create index "provider_usage_recording_idx" to table: "provider_usage"
+CREATE INDEX "provider_usage_recording_idx" ON "public"."provider_usage" ("recording_id");
+-- Create "usage_event" table
+CREATE TABLE "public"."usage_event" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NULL,
+  "recording_id" integer NULL,
+  "metric" text NOT NULL,
+  "quantity" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "usage_event_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "usage_event_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "usage_event_metric_check" CHECK ("metric" = ANY (ARRAY['transcription_seconds'::text, 'llm_tokens'::text]))
+);
+-- Create index "usage_event_created_idx" to table: "usage_event"
+CREATE INDEX "usage_event_created_idx" ON "public"."usage_event" ("created_at");
+-- Create index "usage_event_user_created_idx" to table: "usage_event"
+CREATE INDEX "usage_event_user_created_idx" ON "public"."usage_event" ("user_id", "created_at");
+-- Create "quota_override" table
+CREATE TABLE "public"."quota_override" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NULL,
+  "metric" text NOT NULL,
+  "limit_value" bigint NOT NULL,
+  "reason" text NOT NULL DEFAULT '',
+  "expires_at" timestamptz NULL,
+  "set_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "quota_override_set_by_user_fk" FOREIGN KEY ("set_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "quota_override_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "quota_override_limit_check" CHECK ("limit_value" >= 0),
+  CONSTRAINT "quota_override_metric_check" CHECK ("metric" = ANY (ARRAY['transcription_seconds'::text, 'llm_tokens'::text, 'storage_bytes'::text, 'recordings'::text]))
+);
+-- Create index "quota_override_scope_idx" to table: "quota_override"
+CREATE UNIQUE INDEX "quota_override_scope_idx" ON "public"."quota_override" ((COALESCE("user_id", 0)), "metric");
+-- Create "favorite" table
+CREATE TABLE "public"."favorite" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NOT NULL,
+  "recording_id" integer NULL,
+  "todo_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "favorite_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "favorite_todo_fk" FOREIGN KEY ("todo_id") REFERENCES "public"."todo" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "favorite_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "favorite_target_check" CHECK (num_nonnulls("recording_id", "todo_id") = 1)
+);
+-- Create index "favorite_user_recording_key" to table: "favorite"
+CREATE UNIQUE INDEX "favorite_user_recording_key" ON "public"."favorite" ("user_id", "recording_id") WHERE (recording_id IS NOT NULL);
+-- Create index "favorite_user_todo_key" to table: "favorite"
+CREATE UNIQUE INDEX "favorite_user_todo_key" ON "public"."favorite" ("user_id", "todo_id") WHERE (todo_id IS NOT NULL);
+-- Create "recording_annotation" table
+CREATE TABLE "public"."recording_annotation" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "start_ms" integer NOT NULL,
+  "end_ms" integer NOT NULL,
+  "note" text NOT NULL,
+  "author_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_annotation_author_fk" FOREIGN KEY ("author_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recording_annotation_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_annotation_range_check" CHECK (("start_ms" >= 0) AND ("end_ms" >= "start_ms"))
+);
+-- Create index "recording_annotation_recording_idx" to table: "recording_annotation"
+CREATE INDEX "recording_annotation_recording_idx" ON "public"."recording_annotation" ("recording_id", "start_ms", "id");
+-- Create "recording_clip" table
+CREATE TABLE "public"."recording_clip" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "start_ms" integer NOT NULL,
+  "end_ms" integer NOT NULL,
+  "title" text NOT NULL,
+  "transcript_excerpt" text NOT NULL DEFAULT '',
+  "audio_key" text NOT NULL,
+  "audio_bytes" bigint NOT NULL,
+  "share_token" text NOT NULL,
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_clip_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recording_clip_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_clip_range_check" CHECK (("start_ms" >= 0) AND ("end_ms" > "start_ms"))
+);
+-- Create index "recording_clip_recording_idx" to table: "recording_clip"
+CREATE INDEX "recording_clip_recording_idx" ON "public"."recording_clip" ("recording_id", "created_at", "id");
+-- Create index "recording_clip_share_token_key" to table: "recording_clip"
+CREATE UNIQUE INDEX "recording_clip_share_token_key" ON "public"."recording_clip" ("share_token");
+-- Create "attachment" table
+CREATE TABLE "public"."attachment" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "todo_id" integer NULL,
+  "recording_id" integer NULL,
+  "file_name" text NOT NULL,
+  "content_type" text NOT NULL,
+  "size_bytes" bigint NOT NULL,
+  "storage_key" text NOT NULL,
+  "uploaded_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "attachment_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "attachment_todo_fk" FOREIGN KEY ("todo_id") REFERENCES "public"."todo" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "attachment_uploaded_by_fk" FOREIGN KEY ("uploaded_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "attachment_target_check" CHECK (num_nonnulls("todo_id", "recording_id") = 1)
+);
+-- Create index "attachment_recording_idx" to table: "attachment"
+CREATE INDEX "attachment_recording_idx" ON "public"."attachment" ("recording_id") WHERE (recording_id IS NOT NULL);
+-- Create index "attachment_todo_idx" to table: "attachment"
+CREATE INDEX "attachment_todo_idx" ON "public"."attachment" ("todo_id") WHERE (todo_id IS NOT NULL);
+-- Create "meeting_minutes" table
+CREATE TABLE "public"."meeting_minutes" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "version" integer NOT NULL,
+  "document" jsonb NOT NULL,
+  "source" text NOT NULL,
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "meeting_minutes_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "meeting_minutes_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "meeting_minutes_source_check" CHECK (source = ANY (ARRAY['llm'::text, 'manual'::text])),
+  CONSTRAINT "meeting_minutes_version_check" CHECK (version > 0)
+);
+-- Create index "meeting_minutes_recording_version_key" to table: "meeting_minutes"
+CREATE UNIQUE INDEX "meeting_minutes_recording_version_key" ON "public"."meeting_minutes" ("recording_id", "version");
+-- Create "prompt_template" table
+CREATE TABLE "public"."prompt_template" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "name" text NOT NULL,
+  "description" text NOT NULL DEFAULT '',
+  "summary_instructions" text NOT NULL DEFAULT '',
+  "extraction_guidance" text NOT NULL DEFAULT '',
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "prompt_template_name_key" UNIQUE ("name"),
+  CONSTRAINT "prompt_template_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+ALTER TABLE "public"."recording" ADD CONSTRAINT "recording_prompt_template_fk" FOREIGN KEY ("prompt_template_id") REFERENCES "public"."prompt_template" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create "todo_tracker_link" table
+CREATE TABLE "public"."todo_tracker_link" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "todo_id" integer NOT NULL,
+  "tracker" text NOT NULL,
+  "external_id" text NOT NULL,
+  "external_key" text NOT NULL,
+  "url" text NOT NULL,
+  "external_state" text NOT NULL DEFAULT '',
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "synced_at" timestamptz NULL,
+  "synced_status" text NOT NULL DEFAULT '',
+  "polled_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_tracker_link_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "todo_tracker_link_todo_fk" FOREIGN KEY ("todo_id") REFERENCES "public"."todo" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_tracker_link_tracker_check" CHECK (tracker = ANY (ARRAY['github'::text, 'linear'::text, 'jira'::text]))
+);
+-- Create index "todo_tracker_link_external_key" to table: "todo_tracker_link"
+CREATE UNIQUE INDEX "todo_tracker_link_external_key" ON "public"."todo_tracker_link" ("tracker", "external_id");
+-- Create index "todo_tracker_link_todo_tracker_key" to table: "todo_tracker_link"
+CREATE UNIQUE INDEX "todo_tracker_link_todo_tracker_key" ON "public"."todo_tracker_link" ("todo_id", "tracker");
+-- Create "recording_publication" table
+CREATE TABLE "public"."recording_publication" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "target" text NOT NULL,
+  "external_id" text NOT NULL,
+  "url" text NOT NULL,
+  "published_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_publication_published_by_fk" FOREIGN KEY ("published_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recording_publication_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "recording_publication_target_check" CHECK (target = ANY (ARRAY['notion'::text, 'confluence'::text]))
+);
+-- Create index "recording_publication_recording_target_key" to table: "recording_publication"
+CREATE UNIQUE INDEX "recording_publication_recording_target_key" ON "public"."recording_publication" ("recording_id", "target");
+-- Create "push_device" table
+CREATE TABLE "public"."push_device" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NOT NULL,
+  "platform" text NOT NULL,
+  "token" text NOT NULL,
+  "name" text NOT NULL DEFAULT '',
+  "muted_events" text[] NOT NULL DEFAULT '{}',
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "last_seen_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "push_device_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "push_device_platform_check" CHECK (platform = ANY (ARRAY['fcm'::text, 'apns'::text]))
+);
+-- Create index "push_device_token_key" to table: "push_device"
+CREATE UNIQUE INDEX "push_device_token_key" ON "public"."push_device" ("token");
+-- Create index "push_device_user_id_idx" to table: "push_device"
+CREATE INDEX "push_device_user_id_idx" ON "public"."push_device" ("user_id");
+-- Create "notification_preference" table
+CREATE TABLE "public"."notification_preference" (
+  "user_id" integer NOT NULL,
+  "email_enabled" boolean NOT NULL DEFAULT false,
+  "chat_enabled" boolean NOT NULL DEFAULT true,
+  "push_enabled" boolean NOT NULL DEFAULT true,
+  "muted_events" text[] NOT NULL DEFAULT '{}',
+  "digest_frequency" text NOT NULL DEFAULT 'off',
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("user_id"),
+  CONSTRAINT "notification_preference_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "notification_preference_digest_frequency_check" CHECK (digest_frequency = ANY (ARRAY['off'::text, 'daily'::text, 'weekly'::text]))
+);
+-- Create "org_setting" table
+CREATE TABLE "public"."org_setting" (
+  "id" boolean NOT NULL DEFAULT true,
+  "org_name" text NOT NULL DEFAULT '',
+  "logo_key" text NULL,
+  "default_user_role" text NOT NULL DEFAULT 'member',
+  "audio_retention_days" integer NOT NULL DEFAULT 0,
+  "transcript_retention_days" integer NOT NULL DEFAULT 0,
+  "disabled_integrations" text[] NOT NULL DEFAULT '{}',
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_by_user_id" integer NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "org_setting_updated_by_fk" FOREIGN KEY ("updated_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "org_setting_single_row_check" CHECK (id),
+  CONSTRAINT "org_setting_default_user_role_check" CHECK (default_user_role = ANY (ARRAY['member'::text, 'admin'::text])),
+  CONSTRAINT "org_setting_retention_check" CHECK ((audio_retention_days >= 0) AND (transcript_retention_days >= 0))
+);
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "retain_until" timestamptz NULL, ADD COLUMN "audio_purged_at" timestamptz NULL, ADD COLUMN "transcript_purged_at" timestamptz NULL;
+-- Create index "recording_created_at_idx" to table: "recording"
+CREATE INDEX "recording_created_at_idx" ON "public"."recording" ("created_at");
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "legal_hold" boolean NOT NULL DEFAULT false, ADD COLUMN "legal_hold_reason" text NULL;
+-- Create "recording_hold_event" table
+CREATE TABLE "public"."recording_hold_event" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NULL,
+  "recording_name" text NULL,
+  "held" boolean NOT NULL,
+  "reason" text NOT NULL,
+  "user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recording_hold_event_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recording_hold_event_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create index "recording_hold_event_recording_idx" to table: "recording_hold_event"
+CREATE INDEX "recording_hold_event_recording_idx" ON "public"."recording_hold_event" ("recording_id", "created_at", "id");
+-- Create "data_key" table
+CREATE TABLE "public"."data_key" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "wrapped_key" bytea NOT NULL,
+  "master_key_id" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id")
+);
+-- Create "pending_upload" table
+CREATE TABLE "public"."pending_upload" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "audio_key" text NOT NULL,
+  "name" text NOT NULL,
+  "content_type" text NOT NULL,
+  "size_bytes" bigint NOT NULL,
+  "created_by_user_id" integer NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "pending_upload_user_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "pending_upload_expires_at_idx" to table: "pending_upload"
+CREATE INDEX "pending_upload_expires_at_idx" ON "public"."pending_upload" ("expires_at");
+-- Create "resumable_upload" table
+CREATE TABLE "public"."resumable_upload" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NOT NULL,
+  "name" text NOT NULL,
+  "extension" text NOT NULL,
+  "length_bytes" bigint NOT NULL,
+  "received_bytes" bigint NOT NULL DEFAULT 0,
+  "recording_id" integer NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "resumable_upload_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "resumable_upload_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "resumable_upload_received_check" CHECK ("received_bytes" <= "length_bytes")
+);
+-- Create index "resumable_upload_expires_at_idx" to table: "resumable_upload"
+CREATE INDEX "resumable_upload_expires_at_idx" ON "public"."resumable_upload" ("expires_at");
+-- Create "resumable_upload_part" table
+CREATE TABLE "public"."resumable_upload_part" (
+  "upload_id" integer NOT NULL,
+  "start_byte" bigint NOT NULL,
+  "size_bytes" bigint NOT NULL,
+  "object_key" text NOT NULL,
+  PRIMARY KEY ("upload_id", "start_byte"),
+  CONSTRAINT "resumable_upload_part_upload_fk" FOREIGN KEY ("upload_id") REFERENCES "public"."resumable_upload" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "audio_sha256" text NULL;
+-- Create index "recording_audio_sha256_idx" to table: "recording"
+CREATE INDEX "recording_audio_sha256_idx" ON "public"."recording" ("audio_sha256") WHERE (audio_sha256 IS NOT NULL);
+-- Modify "pending_upload" table
+ALTER TABLE "public"."pending_upload" ADD COLUMN "sha256" text NULL;
+-- Modify "resumable_upload" table
+ALTER TABLE "public"."resumable_upload" ADD COLUMN "sha256" text NULL;
+-- Modify "attachment" table
+ALTER TABLE "public"."attachment" ADD COLUMN "scan_status" text NOT NULL DEFAULT 'unscanned', ADD COLUMN "scan_detail" text NULL, ADD COLUMN "scanned_at" timestamptz NULL, ADD CONSTRAINT "attachment_scan_status_check" CHECK (scan_status = ANY (ARRAY['unscanned'::text, 'pending'::text, 'clean'::text, 'quarantined'::text, 'released'::text]));
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "scan_status" text NOT NULL DEFAULT 'unscanned', ADD COLUMN "scan_detail" text NULL, ADD COLUMN "scanned_at" timestamptz NULL, ADD CONSTRAINT "recording_scan_status_check" CHECK (scan_status = ANY (ARRAY['unscanned'::text, 'pending'::text, 'clean'::text, 'quarantined'::text, 'released'::text]));
+-- Create index "recording_scan_pending_idx" to table: "recording"
+CREATE INDEX "recording_scan_pending_idx" ON "public"."recording" ("id") WHERE (scan_status = 'pending'::text);
+-- Create "transcript_segment" table
+CREATE TABLE "public"."transcript_segment" (
+  "recording_id" integer NOT NULL,
+  "segment_index" integer NOT NULL,
+  "confidence" real NOT NULL,
+  PRIMARY KEY ("recording_id", "segment_index"),
+  CONSTRAINT "transcript_segment_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "transcript_segment_segment_index_check" CHECK ("segment_index" >= 0),
+  CONSTRAINT "transcript_segment_confidence_check" CHECK (("confidence" >= 0) AND ("confidence" <= 1))
+);
+-- Modify "org_setting" table
+ALTER TABLE "public"."org_setting" ADD COLUMN "redact_emails" boolean NOT NULL DEFAULT false, ADD COLUMN "redact_phone_numbers" boolean NOT NULL DEFAULT false, ADD COLUMN "redact_credit_cards" boolean NOT NULL DEFAULT false, ADD COLUMN "redact_profanity" boolean NOT NULL DEFAULT false;
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "keywords_matched_at" timestamptz NULL;
+-- Create "watch_keyword" table
+CREATE TABLE "public"."watch_keyword" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NOT NULL,
+  "keyword" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "watch_keyword_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "watch_keyword_keyword_check" CHECK ((char_length(keyword) >= 1) AND (char_length(keyword) <= 100))
+);
+-- Create index "watch_keyword_user_keyword_key" to table: "watch_keyword"
+CREATE UNIQUE INDEX "watch_keyword_user_keyword_key" ON "public"."watch_keyword" ("user_id", (lower(keyword)));
+-- Create "keyword_alert" table
+CREATE TABLE "public"."keyword_alert" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "keyword_id" integer NOT NULL,
+  "recording_id" integer NOT NULL,
+  "segment_index" integer NOT NULL,
+  "segment_text" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "keyword_alert_keyword_fk" FOREIGN KEY ("keyword_id") REFERENCES "public"."watch_keyword" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "keyword_alert_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "keyword_alert_segment_index_check" CHECK ("segment_index" >= 0)
+);
+-- Create index "keyword_alert_keyword_recording_segment_key" to table: "keyword_alert"
+CREATE UNIQUE INDEX "keyword_alert_keyword_recording_segment_key" ON "public"."keyword_alert" ("keyword_id", "recording_id", "segment_index");
+-- Create index "keyword_alert_recording_idx" to table: "keyword_alert"
+CREATE INDEX "keyword_alert_recording_idx" ON "public"."keyword_alert" ("recording_id");
+-- Create index "todo_created_at_recording_idx" to table: "todo"
+CREATE INDEX "todo_created_at_recording_idx" ON "public"."todo" ("created_at_recording_id");
+-- Modify "user" table
+ALTER TABLE "public"."user" ADD COLUMN "deactivated_at" timestamptz NULL;
+-- Create "guest_participant" table
+CREATE TABLE "public"."guest_participant" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "speaker_id" integer NULL,
+  "name" text NOT NULL,
+  "email" text NULL,
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "guest_participant_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "guest_participant_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "guest_participant_name_check" CHECK ((char_length(name) >= 1) AND (char_length(name) <= 200)),
+  CONSTRAINT "guest_participant_speaker_check" CHECK (speaker_id >= 0)
+);
+-- Create index "guest_participant_recording_idx" to table: "guest_participant"
+CREATE INDEX "guest_participant_recording_idx" ON "public"."guest_participant" ("recording_id");
+-- Create index "guest_participant_speaker_key" to table: "guest_participant"
+CREATE UNIQUE INDEX "guest_participant_speaker_key" ON "public"."guest_participant" ("recording_id", "speaker_id") WHERE (speaker_id IS NOT NULL);
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "language" text NULL, ADD COLUMN "cloned_from_recording_id" integer NULL, ADD CONSTRAINT "recording_cloned_from_fk" FOREIGN KEY ("cloned_from_recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "recording_audio_key_idx" to table: "recording"
+CREATE INDEX "recording_audio_key_idx" ON "public"."recording" ("audio_key") WHERE (audio_key IS NOT NULL);
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "processing_settings" jsonb NOT NULL DEFAULT '{}';
+-- Move the language hint into the processing settings
+UPDATE "public"."recording" SET "processing_settings" = jsonb_build_object('language', "language") WHERE "language" IS NOT NULL;
+-- Modify "recording" table
+ALTER TABLE "public"."recording" DROP COLUMN "language";
+-- Modify "resumable_upload" table
+ALTER TABLE "public"."resumable_upload" ADD COLUMN "processing_settings" jsonb NOT NULL DEFAULT '{}', ADD COLUMN "prompt_template_id" integer NULL, ADD CONSTRAINT "resumable_upload_prompt_template_fk" FOREIGN KEY ("prompt_template_id") REFERENCES "public"."prompt_template" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "org_setting" table
+ALTER TABLE "public"."org_setting" ADD COLUMN "default_language" text NOT NULL DEFAULT '', ADD COLUMN "disable_summary" boolean NOT NULL DEFAULT false, ADD COLUMN "disable_todo_extraction" boolean NOT NULL DEFAULT false;
+-- Modify "directory" table
+ALTER TABLE "public"."directory" ALTER CONSTRAINT "directory_parent_fk" DEFERRABLE INITIALLY IMMEDIATE;
+-- Modify "block" table
+ALTER TABLE "public"."block" ALTER CONSTRAINT "block_parent_fk" DEFERRABLE INITIALLY IMMEDIATE;
+-- Modify "ai_message" table
+ALTER TABLE "public"."ai_message" ALTER CONSTRAINT "ai_message_run_fk" DEFERRABLE INITIALLY IMMEDIATE;
+-- Modify "ai_artifact" table
+ALTER TABLE "public"."ai_artifact" ALTER CONSTRAINT "ai_artifact_superseded_by_fk" DEFERRABLE INITIALLY IMMEDIATE;
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ALTER CONSTRAINT "recording_cloned_from_fk" DEFERRABLE INITIALLY IMMEDIATE;
+-- Modify "recording" table
+ALTER TABLE "public"."recording" ADD COLUMN "processing_dismissed_at" timestamptz NULL;
+-- Create "scheduled_task" table
+CREATE TABLE "public"."scheduled_task" (
+  "name" text NOT NULL,
+  "schedule" text NULL,
+  "paused" boolean NOT NULL DEFAULT false,
+  "last_slot_at" timestamptz NULL,
+  "last_started_at" timestamptz NULL,
+  "last_finished_at" timestamptz NULL,
+  "last_succeeded_at" timestamptz NULL,
+  "last_error" text NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("name")
+);
+-- Create "feature_flag" table
+CREATE TABLE "public"."feature_flag" (
+  "name" text NOT NULL,
+  "enabled" boolean NOT NULL DEFAULT false,
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_by_user_id" integer NULL,
+  PRIMARY KEY ("name"),
+  CONSTRAINT "feature_flag_updated_by_fk" FOREIGN KEY ("updated_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create "feature_flag_user" table
+CREATE TABLE "public"."feature_flag_user" (
+  "name" text NOT NULL,
+  "user_id" integer NOT NULL,
+  "enabled" boolean NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("name", "user_id"),
+  CONSTRAINT "feature_flag_user_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "feature_flag_user_user_id_idx" to table: "feature_flag_user"
+CREATE INDEX "feature_flag_user_user_id_idx" ON "public"."feature_flag_user" ("user_id");
+-- Modify "org_setting" table
+ALTER TABLE "public"."org_setting" ADD COLUMN "maintenance_mode" boolean NOT NULL DEFAULT false, ADD COLUMN "maintenance_message" text NOT NULL DEFAULT '', ADD COLUMN "maintenance_started_at" timestamptz NULL;
+-- Create "audit_log" table
+CREATE TABLE "public"."audit_log" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "created_at" timestamptz NOT NULL,
+  "actor_user_id" integer NULL,
+  "action" text NOT NULL,
+  "detail" text NOT NULL,
+  "request_id" text NOT NULL,
+  "key_id" text NOT NULL,
+  "prev_hash" bytea NOT NULL,
+  "hash" bytea NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create "audit_log_append_only" function
+CREATE FUNCTION "public"."audit_log_append_only"() RETURNS trigger LANGUAGE plpgsql AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$;
+-- Create trigger "audit_log_append_only"
+CREATE TRIGGER "audit_log_append_only" BEFORE UPDATE OR DELETE ON "public"."audit_log" FOR EACH ROW EXECUTE FUNCTION "public"."audit_log_append_only"();
+-- Modify "user" table
+ALTER TABLE "public"."user" ADD COLUMN "scim_provisioned" boolean NOT NULL DEFAULT false, ADD COLUMN "scim_external_id" text NULL;
+-- Modify "workspace_user_rel" table
+ALTER TABLE "public"."workspace_user_rel" ADD COLUMN "via_scim" boolean NOT NULL DEFAULT false;
+-- Create "scim_group" table
+CREATE TABLE "public"."scim_group" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "display_name" text NOT NULL,
+  "external_id" text NULL,
+  "role" text NULL,
+  "workspace_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "scim_group_workspace_fk" FOREIGN KEY ("workspace_id") REFERENCES "public"."workspace" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "scim_group_role_check" CHECK ("role" = ANY (ARRAY['admin'::text, 'member'::text]))
+);
+-- Create "scim_group_member" table
+CREATE TABLE "public"."scim_group_member" (
+  "group_id" integer NOT NULL,
+  "user_id" integer NOT NULL,
+  PRIMARY KEY ("group_id", "user_id"),
+  CONSTRAINT "scim_group_member_group_fk" FOREIGN KEY ("group_id") REFERENCES "public"."scim_group" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "scim_group_member_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "scim_group_member_user_idx" to table: "scim_group_member"
+CREATE INDEX "scim_group_member_user_idx" ON "public"."scim_group_member" ("user_id");
+-- Modify "org_setting" table
+ALTER TABLE "public"."org_setting" ADD COLUMN "ip_allowlist" text[] NOT NULL DEFAULT '{}';
+-- Create "user_session" table
+CREATE TABLE "public"."user_session" (
+  "id" text NOT NULL,
+  "user_id" integer NOT NULL,
+  "user_agent" text NOT NULL DEFAULT '',
+  "ip" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "expires_at" timestamptz NOT NULL,
+  "last_seen_at" timestamptz NOT NULL DEFAULT now(),
+  "revoked_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "user_session_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "user_session_user_id_idx" to table: "user_session"
+CREATE INDEX "user_session_user_id_idx" ON "public"."user_session" ("user_id");
+-- Modify "user_session" table
+ALTER TABLE "public"."user_session" ADD COLUMN "location" text NOT NULL DEFAULT '', ADD COLUMN "device_fingerprint" text NOT NULL DEFAULT '';
+-- Create "user_device" table
+CREATE TABLE "public"."user_device" (
+  "user_id" integer NOT NULL,
+  "fingerprint" text NOT NULL,
+  "device" text NOT NULL DEFAULT '',
+  "first_seen_at" timestamptz NOT NULL DEFAULT now(),
+  "last_seen_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("user_id", "fingerprint"),
+  CONSTRAINT "user_device_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Modify "audit_log" table
+ALTER TABLE "public"."audit_log" ADD COLUMN "session_id" text NOT NULL DEFAULT '', ADD COLUMN "ip" text NOT NULL DEFAULT '', ADD COLUMN "device" text NOT NULL DEFAULT '';
+-- Create "transcript_revision" table
+CREATE TABLE "public"."transcript_revision" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "recording_id" integer NOT NULL,
+  "revision" integer NOT NULL,
+  "transcript" text NOT NULL,
+  "source" text NOT NULL,
+  "created_by_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "transcript_revision_created_by_fk" FOREIGN KEY ("created_by_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "transcript_revision_recording_fk" FOREIGN KEY ("recording_id") REFERENCES "public"."recording" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "transcript_revision_revision_check" CHECK (revision > 0),
+  CONSTRAINT "transcript_revision_source_check" CHECK (source = ANY (ARRAY['transcription'::text, 'relabel'::text]))
+);
+-- Create index "transcript_revision_recording_revision_key" to table: "transcript_revision"
+CREATE UNIQUE INDEX "transcript_revision_recording_revision_key" ON "public"."transcript_revision" ("recording_id", "revision");
+-- Create "todo_comment" table
+CREATE TABLE "public"."todo_comment" (
+  "id" integer NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "todo_id" integer NOT NULL,
+  "body" text NOT NULL,
+  "author_user_id" integer NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_comment_author_fk" FOREIGN KEY ("author_user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "todo_comment_todo_fk" FOREIGN KEY ("todo_id") REFERENCES "public"."todo" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "todo_comment_todo_idx" to table: "todo_comment"
+CREATE INDEX "todo_comment_todo_idx" ON "public"."todo_comment" ("todo_id", "created_at", "id");
+-- Create "todo_comment_mention" table
+CREATE TABLE "public"."todo_comment_mention" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "comment_id" integer NOT NULL,
+  "user_id" integer NOT NULL,
+  "matched_text" text NOT NULL,
+  "read_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_comment_mention_comment_fk" FOREIGN KEY ("comment_id") REFERENCES "public"."todo_comment" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_comment_mention_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "todo_comment_mention_comment_user_key" to table: "todo_comment_mention"
+CREATE UNIQUE INDEX "todo_comment_mention_comment_user_key" ON "public"."todo_comment_mention" ("comment_id", "user_id");
+-- Create index "todo_comment_mention_user_idx" to table: "todo_comment_mention"
+CREATE INDEX "todo_comment_mention_user_idx" ON "public"."todo_comment_mention" ("user_id", "created_at" DESC);
+
+-- Create "reaction" table
+CREATE TABLE "public"."reaction" (
+  "id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY,
+  "user_id" integer NOT NULL,
+  "todo_id" integer NULL,
+  "comment_id" integer NULL,
+  "emoji" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "reaction_comment_fk" FOREIGN KEY ("comment_id") REFERENCES "public"."todo_comment" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "reaction_todo_fk" FOREIGN KEY ("todo_id") REFERENCES "public"."todo" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "reaction_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."user" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "reaction_target_check" CHECK (num_nonnulls("todo_id", "comment_id") = 1)
+);
+-- Create index "reaction_todo_key" to table: "reaction"
+CREATE UNIQUE INDEX "reaction_todo_key" ON "public"."reaction" ("todo_id", "user_id", "emoji") WHERE (todo_id IS NOT NULL);
+-- Create index "reaction_comment_key" to table: "reaction"
+CREATE UNIQUE INDEX "reaction_comment_key" ON "public"."reaction" ("comment_id", "user_id", "emoji") WHERE (comment_id IS NOT NULL);
+
+-- Modify "todo" table
+ALTER TABLE "public"."todo" ADD COLUMN "snoozed_until" timestamptz NULL;
+
+CREATE INDEX "todo_snoozed_until_idx" ON "public"."todo" ("snoozed_until") WHERE ("snoozed_until" IS NOT NULL);
+
+-- Modify "org_setting" table
+ALTER TABLE "public"."org_setting" ADD COLUMN "org_id" text NOT NULL DEFAULT (gen_random_uuid())::text;
+-- Modify "data_key" table
+ALTER TABLE "public"."data_key" ADD COLUMN "org_id" text NULL;
+
+-- Create trigger "audit_log_no_truncate"
+CREATE TRIGGER "audit_log_no_truncate" BEFORE TRUNCATE ON "public"."audit_log" FOR EACH STATEMENT EXECUTE FUNCTION "public"."audit_log_append_only"();
+
+-- Modify "todo" table
+ALTER TABLE "public"."todo" ADD COLUMN "priority" smallint NOT NULL DEFAULT 0, ADD CONSTRAINT "todo_priority_check" CHECK ((priority >= 0) AND (priority <= 3));
+
+-- Modify "user" table
+ALTER TABLE "public"."user" ADD COLUMN "todo_feed_generation" integer NOT NULL DEFAULT 0;
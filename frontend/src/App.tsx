@@ -3,9 +3,11 @@ import { Layout } from './components/Layout';
 import { RequireAuth } from './components/RequireAuth';
 import { LoginPage } from './pages/LoginPage';
 import { DashboardPage } from './pages/DashboardPage';
+import { InboxPage } from './pages/InboxPage';
 import { RecordingDetailPage } from './pages/RecordingDetailPage';
 import { SettingsPage } from './pages/SettingsPage';
 import { TodosPage } from './pages/TodosPage';
+import { VerifyEmailPage } from './pages/VerifyEmailPage';
 
 function App() {
   return (
@@ -23,7 +25,9 @@ function App() {
         <Route index element={<TodosPage />} />
         <Route path="recordings" element={<DashboardPage />} />
         <Route path="recordings/:id" element={<RecordingDetailPage />} />
+        <Route path="inbox" element={<InboxPage />} />
         <Route path="settings" element={<SettingsPage />} />
+        <Route path="verify-email" element={<VerifyEmailPage />} />
       </Route>
 
       <Route path="*" element={<Navigate to="/" replace />} />
@@ -0,0 +1,68 @@
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Badge, Button, Group, Stack, Table, Text, Title } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { usersClient } from '../lib/client';
+
+// ActiveSessions shows where the signed-in user is signed in, and lets
+// them sign out the sessions they don't recognize.
+export function ActiveSessions() {
+  const queryClient = useQueryClient();
+  const { data: sessions } = useQuery({
+    queryKey: ['sessions'],
+    queryFn: async () => (await usersClient.listSessions({})).sessions,
+  });
+
+  const revokeMutation = useMutation({
+    mutationFn: async (request: { sessionId?: string; others?: boolean }) => usersClient.revokeSession(request),
+    onSuccess: () => queryClient.invalidateQueries({ queryKey: ['sessions'] }),
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  const others = sessions?.filter((s) => !s.current) ?? [];
+
+  return (
+    <Stack gap="xs">
+      <Group justify="space-between">
+        <Title order={4}>Sessions</Title>
+        {others.length > 0 && (
+          <Button size="xs" variant="light" color="red" loading={revokeMutation.isPending}
+            onClick={() => revokeMutation.mutate({ others: true })}>
+            Sign out other sessions
+          </Button>
+        )}
+      </Group>
+      <Text size="sm" c="dimmed">Where you are signed in. Sign out any session you don't recognize.</Text>
+      <Table fz="xs">
+        <Table.Thead>
+          <Table.Tr>
+            <Table.Th>Device</Table.Th>
+            <Table.Th>Location</Table.Th>
+            <Table.Th>Last active</Table.Th>
+            <Table.Th>Signed in</Table.Th>
+            <Table.Th />
+          </Table.Tr>
+        </Table.Thead>
+        <Table.Tbody>
+          {sessions?.map((s) => (
+            <Table.Tr key={s.id}>
+              <Table.Td title={s.userAgent}>
+                {s.device} {s.current && <Badge size="xs" variant="light">This device</Badge>}
+              </Table.Td>
+              <Table.Td>{s.location ? `${s.location} (${s.ip})` : s.ip}</Table.Td>
+              <Table.Td>{new Date(s.lastSeenAt).toLocaleString()}</Table.Td>
+              <Table.Td>{new Date(s.issuedAt).toLocaleString()}</Table.Td>
+              <Table.Td>
+                {!s.current && (
+                  <Button size="xs" variant="subtle" color="red" disabled={revokeMutation.isPending}
+                    onClick={() => revokeMutation.mutate({ sessionId: s.id })}>
+                    Sign out
+                  </Button>
+                )}
+              </Table.Td>
+            </Table.Tr>
+          ))}
+        </Table.Tbody>
+      </Table>
+    </Stack>
+  );
+}
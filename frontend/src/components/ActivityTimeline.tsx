@@ -0,0 +1,88 @@
+import { Link } from 'react-router-dom';
+import { useInfiniteQuery } from '@tanstack/react-query';
+import { Anchor, Button, Loader, Text, Timeline } from '@mantine/core';
+import { CheckSquare, Lightbulb, MessageSquare, Mic } from 'lucide-react';
+import { activityClient } from '../lib/client';
+import { ActivityKind } from '../gen/secretary/v1/activity_feed_pb';
+import type { ActivityEvent } from '../gen/secretary/v1/activity_feed_pb';
+import { OutcomeKind } from '../gen/secretary/v1/outcomes_pb';
+import { getRecordingStatusConfig, getStatusConfig } from '../lib/status';
+
+const outcomeLabels: Record<OutcomeKind, string> = {
+  [OutcomeKind.UNSPECIFIED]: 'Outcome',
+  [OutcomeKind.DECISION]: 'Decision',
+  [OutcomeKind.RISK]: 'Risk',
+  [OutcomeKind.OPEN_QUESTION]: 'Open question',
+};
+
+function describe(e: ActivityEvent) {
+  switch (e.kind) {
+    case ActivityKind.RECORDING_STATUS:
+      return `${getRecordingStatusConfig(e.toStatus).label}${e.error ? `: ${e.error}` : ''}`;
+    case ActivityKind.TODO_CHANGE:
+      if (e.changeType === 'sync_conflict') return `Tracker sync conflict: ${e.todoName}`;
+      return `Todo ${e.changeType}d: ${e.todoName} (${getStatusConfig(e.todoStatus).label})`;
+    case ActivityKind.OUTCOME:
+      return `${outcomeLabels[e.outcomeKind]}: ${e.outcomeText}`;
+    case ActivityKind.COMMENT:
+      return `Comment on ${e.todoName}: ${e.commentText}`;
+    default:
+      return '';
+  }
+}
+
+function icon(kind: ActivityKind) {
+  switch (kind) {
+    case ActivityKind.TODO_CHANGE:
+      return <CheckSquare size={12} />;
+    case ActivityKind.OUTCOME:
+      return <Lightbulb size={12} />;
+    case ActivityKind.COMMENT:
+      return <MessageSquare size={12} />;
+    default:
+      return <Mic size={12} />;
+  }
+}
+
+// ActivityTimeline shows what recently happened across meetings and todos,
+// newest first, loading older events on demand.
+export function ActivityTimeline() {
+  const { data, isLoading, fetchNextPage, hasNextPage, isFetchingNextPage } = useInfiniteQuery({
+    queryKey: ['activity'],
+    queryFn: async ({ pageParam }) => activityClient.listActivity({ limit: 20, pageToken: pageParam }),
+    initialPageParam: '',
+    getNextPageParam: (last) => last.nextPageToken || undefined,
+  });
+
+  if (isLoading) return <Loader size="sm" />;
+  const events = data?.pages.flatMap((p) => p.events) ?? [];
+  if (events.length === 0) return <Text c="dimmed" size="sm">Nothing has happened yet.</Text>;
+
+  return (
+    <>
+      <Timeline bulletSize={20} lineWidth={2}>
+        {events.map((e) => (
+          <Timeline.Item key={`${e.kind}-${e.id}`} bullet={icon(e.kind)}>
+            <Text size="sm">{describe(e)}</Text>
+            <Text size="xs" c="dimmed">
+              {e.recordingId > 0n && (
+                <>
+                  <Anchor component={Link} to={`/recordings/${e.recordingId}`} size="xs">
+                    {e.recordingName || 'Untitled Meeting'}
+                  </Anchor>
+                  {' · '}
+                </>
+              )}
+              {new Date(e.occurredAt).toLocaleString()}
+            </Text>
+          </Timeline.Item>
+        ))}
+      </Timeline>
+      {hasNextPage && (
+        <Button variant="subtle" size="xs" mt="sm" loading={isFetchingNextPage} onClick={() => fetchNextPage()}>
+          Show older
+        </Button>
+      )}
+    </>
+  );
+}
@@ -0,0 +1,101 @@
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { ActionIcon, Anchor, Button, FileButton, Group, Loader, Stack, Text } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { Paperclip, Trash } from 'lucide-react';
+import { attachmentsClient } from '../lib/client';
+import { getUser } from '../lib/auth';
+import type { Attachment } from '../gen/secretary/v1/attachments_pb';
+
+const MAX_ATTACHMENT_BYTES = 10 << 20;
+
+function formatSize(bytes: bigint) {
+  const n = Number(bytes);
+  if (n < 1024) return `${n} B`;
+  if (n < 1 << 20) return `${(n / 1024).toFixed(0)} KB`;
+  return `${(n / (1 << 20)).toFixed(1)} MB`;
+}
+
+// AttachmentList shows the files kept with a todo or a recording and lets
+// people add more. Set exactly one of todoId and recordingId.
+export function AttachmentList({ todoId = 0n, recordingId = 0n }: { todoId?: bigint; recordingId?: bigint }) {
+  const queryClient = useQueryClient();
+  const currentUser = getUser();
+  const queryKey = ['attachments', todoId.toString(), recordingId.toString()];
+
+  const { data: attachments, isLoading } = useQuery({
+    queryKey,
+    queryFn: async () => (await attachmentsClient.listAttachments({ todoId, recordingId })).attachments,
+  });
+
+  const onError = (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' });
+  const refresh = () => queryClient.invalidateQueries({ queryKey });
+
+  const uploadMutation = useMutation({
+    mutationFn: async (file: File) => {
+      if (file.size > MAX_ATTACHMENT_BYTES) throw new Error('Attachments can be at most 10 MB');
+      const content = new Uint8Array(await file.arrayBuffer());
+      return attachmentsClient.uploadAttachment({
+        todoId,
+        recordingId,
+        fileName: file.name,
+        contentType: file.type || 'application/octet-stream',
+        content,
+      });
+    },
+    onSuccess: refresh,
+    onError,
+  });
+  const deleteMutation = useMutation({
+    mutationFn: async (attachment: Attachment) => attachmentsClient.deleteAttachment({ id: attachment.id }),
+    onSuccess: refresh,
+    onError,
+  });
+
+  const download = async (attachment: Attachment) => {
+    try {
+      const { content } = await attachmentsClient.downloadAttachment({ id: attachment.id });
+      const url = URL.createObjectURL(new Blob([content], { type: attachment.contentType }));
+      const link = document.createElement('a');
+      link.href = url;
+      link.download = attachment.fileName;
+      link.click();
+      URL.revokeObjectURL(url);
+    } catch (err) {
+      onError(err as Error);
+    }
+  };
+
+  const canDelete = (attachment: Attachment) =>
+    currentUser?.role === 'admin' || (currentUser != null && attachment.uploadedByUserId === BigInt(currentUser.id));
+
+  if (isLoading) return <Loader size="sm" />;
+
+  return (
+    <Stack gap="xs">
+      {attachments?.length === 0 && <Text size="sm" c="dimmed">No attachments.</Text>}
+      {attachments?.map((attachment) => (
+        <Group key={attachment.id} justify="space-between" wrap="nowrap">
+          <Group gap="xs" wrap="nowrap">
+            <Paperclip size={14} />
+            <Anchor component="button" type="button" size="sm" onClick={() => download(attachment)}>
+              {attachment.fileName}
+            </Anchor>
+            <Text size="xs" c="dimmed">{formatSize(attachment.sizeBytes)}</Text>
+          </Group>
+          {canDelete(attachment) && (
+            <ActionIcon variant="subtle" color="gray" aria-label="Delete" onClick={() => deleteMutation.mutate(attachment)}>
+              <Trash size={14} />
+            </ActionIcon>
+          )}
+        </Group>
+      ))}
+      <FileButton onChange={(file) => file && uploadMutation.mutate(file)}>
+        {(props) => (
+          <Button {...props} size="xs" variant="light" leftSection={<Paperclip size={14} />} loading={uploadMutation.isPending} w="fit-content">
+            Attach file
+          </Button>
+        )}
+      </FileButton>
+    </Stack>
+  );
+}
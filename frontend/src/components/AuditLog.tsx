@@ -0,0 +1,82 @@
+import { useInfiniteQuery, useMutation } from '@tanstack/react-query';
+import { Alert, Button, Code, Group, Loader, Stack, Table, Text } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { adminClient } from '../lib/client';
+
+const pageSize = 50;
+
+// AuditLog lists admin actions and deletions, newest first, and checks
+// that no entry was changed or removed since it was written.
+export function AuditLog() {
+  const { data, isLoading, error, fetchNextPage, hasNextPage, isFetchingNextPage } = useInfiniteQuery({
+    queryKey: ['auditLog'],
+    queryFn: async ({ pageParam }) => adminClient.listAuditLog({ beforeId: pageParam, limit: pageSize }),
+    initialPageParam: 0n,
+    getNextPageParam: (last) =>
+      last.entries.length < pageSize ? undefined : last.entries[last.entries.length - 1].id,
+  });
+
+  const verifyMutation = useMutation({
+    mutationFn: async () => adminClient.verifyAuditLog({}),
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  if (isLoading) return <Loader size="sm" />;
+  if (error || !data) return <Alert color="red">Failed to load the audit log: {error?.message}</Alert>;
+  const entries = data.pages.flatMap((p) => p.entries);
+  const result = verifyMutation.data;
+
+  return (
+    <Stack gap="xs">
+      <Group justify="space-between">
+        <Text size="xs" c="dimmed">Admin actions and deletions. Entries can't be changed or removed.</Text>
+        <Button size="xs" variant="light" loading={verifyMutation.isPending} onClick={() => verifyMutation.mutate()}>
+          Verify
+        </Button>
+      </Group>
+      {result && (result.valid ? (
+        <Alert color="green">
+          All {result.entries.toString()} entries check out. Newest hash: <Code>{result.headHash || 'none'}</Code>
+        </Alert>
+      ) : (
+        <Alert color="red">The log was tampered with: {result.problem}.</Alert>
+      ))}
+      {entries.length === 0 ? (
+        <Text c="dimmed" size="sm">Nothing recorded yet.</Text>
+      ) : (
+        <Table fz="xs">
+          <Table.Thead>
+            <Table.Tr>
+              <Table.Th>When</Table.Th>
+              <Table.Th>Who</Table.Th>
+              <Table.Th>Action</Table.Th>
+              <Table.Th>Details</Table.Th>
+            </Table.Tr>
+          </Table.Thead>
+          <Table.Tbody>
+            {entries.map((e) => (
+              <Table.Tr key={e.id.toString()}>
+                <Table.Td>{new Date(e.createdAt).toLocaleString()}</Table.Td>
+                <Table.Td>
+                  {e.actorName || (e.actorUserId > 0n ? `User ${e.actorUserId}` : 'Server')}
+                  {(e.device || e.ip) && (
+                    <Text size="xs" c="dimmed" title={e.sessionId ? `Session ${e.sessionId}` : undefined}>
+                      {[e.device, e.ip].filter(Boolean).join(' · ')}
+                    </Text>
+                  )}
+                </Table.Td>
+                <Table.Td>{e.action}</Table.Td>
+                <Table.Td><Code style={{ wordBreak: 'break-all' }}>{e.detail}</Code></Table.Td>
+              </Table.Tr>
+            ))}
+          </Table.Tbody>
+        </Table>
+      )}
+      {hasNextPage && (
+        <Button variant="subtle" size="xs" loading={isFetchingNextPage} onClick={() => fetchNextPage()}>
+          Show older
+        </Button>
+      )}
+    </Stack>
+  );
+}
@@ -0,0 +1,69 @@
+import { useState } from 'react';
+import { useMutation, useQueryClient } from '@tanstack/react-query';
+import { Button, List, PasswordInput, Stack, Text, Title } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { Code, ConnectError } from '@connectrpc/connect';
+import { usersClient } from '../lib/client';
+
+// problems splits a refused password's error into one line per broken
+// rule; the server joins them as "field: description; field: description".
+function problems(err: Error): string[] {
+  const message = err instanceof ConnectError ? err.rawMessage : err.message;
+  return message.split('; ').map((part) => part.replace(/^(new|current)_password: /, ''));
+}
+
+// ChangePassword lets the signed-in user set a new password. Their other
+// sessions are signed out when it changes.
+export function ChangePassword() {
+  const queryClient = useQueryClient();
+  const [currentPassword, setCurrentPassword] = useState('');
+  const [newPassword, setNewPassword] = useState('');
+  const [confirm, setConfirm] = useState('');
+
+  const changeMutation = useMutation({
+    mutationFn: async () => usersClient.changePassword({ currentPassword, newPassword }),
+    onSuccess: (res) => {
+      setCurrentPassword('');
+      setNewPassword('');
+      setConfirm('');
+      queryClient.invalidateQueries({ queryKey: ['sessions'] });
+      notifications.show({
+        message: res.sessionsRevoked > 0n ? `Password changed; ${res.sessionsRevoked} other session(s) signed out` : 'Password changed',
+        color: 'green',
+      });
+    },
+    onError: (err: Error) => {
+      if (err instanceof ConnectError && err.code === Code.InvalidArgument) return;
+      notifications.show({ title: 'Error', message: err.message, color: 'red' });
+    },
+  });
+
+  const refused = changeMutation.error instanceof ConnectError && changeMutation.error.code === Code.InvalidArgument
+    ? problems(changeMutation.error)
+    : [];
+  const mismatch = confirm !== '' && confirm !== newPassword;
+
+  return (
+    <Stack gap="xs">
+      <Title order={4}>Password</Title>
+      <PasswordInput label="Current password" value={currentPassword} autoComplete="current-password"
+        onChange={(e) => setCurrentPassword(e.currentTarget.value)} />
+      <PasswordInput label="New password" value={newPassword} autoComplete="new-password"
+        onChange={(e) => setNewPassword(e.currentTarget.value)} />
+      <PasswordInput label="Repeat new password" value={confirm} autoComplete="new-password"
+        error={mismatch ? "Passwords don't match" : undefined}
+        onChange={(e) => setConfirm(e.currentTarget.value)} />
+      {refused.length > 0 && (
+        <List size="sm" c="red">
+          {refused.map((p) => <List.Item key={p}>{p}</List.Item>)}
+        </List>
+      )}
+      <Text size="xs" c="dimmed">Changing your password signs out your other sessions.</Text>
+      <Button w="fit-content" loading={changeMutation.isPending}
+        disabled={!currentPassword || !newPassword || newPassword !== confirm}
+        onClick={() => changeMutation.mutate()}>
+        Change password
+      </Button>
+    </Stack>
+  );
+}
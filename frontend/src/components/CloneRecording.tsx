@@ -0,0 +1,90 @@
+import { useState } from 'react';
+import { useNavigate } from 'react-router-dom';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Button, Group, Modal, Select, Stack, Text, TextInput } from '@mantine/core';
+import { useDisclosure } from '@mantine/hooks';
+import { notifications } from '@mantine/notifications';
+import { Copy } from 'lucide-react';
+import { promptTemplatesClient, recordingsClient } from '../lib/client';
+import type { Recording } from '../gen/secretary/v1/recordings_pb';
+
+// CloneRecording copies a recording so its audio can be transcribed and
+// summarized again with another language or prompt template, leaving the
+// original's transcript, summary and todos as they are.
+export function CloneRecording({ recording }: { recording: Recording }) {
+  const queryClient = useQueryClient();
+  const navigate = useNavigate();
+  const [opened, { open, close }] = useDisclosure(false);
+  const [name, setName] = useState('');
+  const [language, setLanguage] = useState(recording.language);
+  const [templateId, setTemplateId] = useState(recording.promptTemplateId.toString());
+
+  const { data: templates } = useQuery({
+    queryKey: ['promptTemplates'],
+    queryFn: async () => (await promptTemplatesClient.listPromptTemplates({})).templates,
+    enabled: opened,
+  });
+
+  const cloneMutation = useMutation({
+    mutationFn: async () =>
+      recordingsClient.cloneRecording({
+        id: recording.id,
+        name,
+        language,
+        promptTemplateId: BigInt(templateId),
+      }),
+    onSuccess: (res) => {
+      queryClient.invalidateQueries({ queryKey: ['recordings'] });
+      close();
+      if (res.recording) navigate(`/recordings/${res.recording.id}`);
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  return (
+    <>
+      <Button size="xs" variant="subtle" leftSection={<Copy size={14} />} onClick={open}>
+        Process again
+      </Button>
+      <Modal opened={opened} onClose={close} title="Process again">
+        <Stack>
+          <Text size="sm">
+            Creates a copy of this meeting that is transcribed and summarized again. This one keeps its transcript, summary and todos.
+          </Text>
+          <TextInput
+            label="Name"
+            placeholder={`${recording.name || 'Untitled Meeting'} (copy)`}
+            maxLength={200}
+            value={name}
+            onChange={(e) => setName(e.currentTarget.value)}
+          />
+          <TextInput
+            label="Language"
+            description={`A language tag such as "es" or "pt-BR"; leave empty for the organization's default.`}
+            maxLength={35}
+            value={language}
+            onChange={(e) => setLanguage(e.currentTarget.value)}
+          />
+          {!!templates?.length && (
+            <Select
+              label="Prompt template"
+              value={templateId}
+              onChange={(value) => setTemplateId(value ?? '0')}
+              allowDeselect={false}
+              data={[
+                { value: '0', label: 'Default prompts' },
+                ...templates.map((t) => ({ value: t.id.toString(), label: t.name })),
+              ]}
+            />
+          )}
+          <Group justify="flex-end">
+            <Button variant="default" onClick={close}>Cancel</Button>
+            <Button loading={cloneMutation.isPending} onClick={() => cloneMutation.mutate()}>
+              Process again
+            </Button>
+          </Group>
+        </Stack>
+      </Modal>
+    </>
+  );
+}
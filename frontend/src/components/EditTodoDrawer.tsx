@@ -2,9 +2,14 @@ import { useState, useEffect, useMemo } from 'react';
 import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
 import { Drawer, Select, Textarea, Button, Group, Stack, Timeline, Text, Loader, ActionIcon, Menu, Collapse, Anchor } from '@mantine/core';
 import { notifications } from '@mantine/notifications';
-import { Trash, MoreVertical, ChevronDown, ChevronRight } from 'lucide-react';
+import { Code, ConnectError } from '@connectrpc/connect';
+import { Trash, MoreVertical, ChevronDown, ChevronRight, Merge, AlarmClock } from 'lucide-react';
 import { todosClient, usersClient } from '../lib/client';
 import { getUser } from '../lib/auth';
+import { AttachmentList } from './AttachmentList';
+import { TrackerLinks } from './TrackerLinks';
+import { TodoComments } from './TodoComments';
+import { ReactionBar } from './ReactionBar';
 import { getStatusConfig, TODO_STATUS_OPTIONS } from '../lib/status';
 import { Todo, TodoStatus, ListTodoHistoryResponse } from '../gen/secretary/v1/todos_pb';
 import { ListUsersResponse } from '../gen/secretary/v1/users_pb';
@@ -74,6 +79,8 @@ export function EditTodoDrawer({ opened, onClose, todo }: EditTodoDrawerProps) {
         name,
         desc,
         status: Number(status) as TodoStatus,
+        dueAt: todo.dueAt,
+        expectedVersion: todo.version,
       });
     },
     onSuccess: () => {
@@ -83,6 +90,14 @@ export function EditTodoDrawer({ opened, onClose, todo }: EditTodoDrawerProps) {
       onClose();
     },
     onError: (err: any) => {
+      if (err instanceof ConnectError && err.code === Code.FailedPrecondition) {
+        // Someone else saved this todo first; reload so the user edits the latest copy.
+        queryClient.invalidateQueries({ queryKey: ['todos'] });
+        queryClient.invalidateQueries({ queryKey: ['todoHistory'] });
+        notifications.show({ title: 'Todo changed', message: 'This todo was updated by someone else. Reopen it to see the latest version.', color: 'yellow' });
+        onClose();
+        return;
+      }
       notifications.show({ title: 'Error', message: err.message, color: 'red' });
     },
   });
@@ -103,6 +118,59 @@ export function EditTodoDrawer({ opened, onClose, todo }: EditTodoDrawerProps) {
     },
   });
 
+  // Merge Mutation: folds a duplicate, e.g. the same action item picked up
+  // in another meeting, into this todo.
+  const mergeMutation = useMutation({
+    mutationFn: async (duplicateId: bigint) => {
+      if (!todo) return;
+      const duplicate = (await todosClient.getTodo({ id: duplicateId })).todo;
+      if (!duplicate) return;
+      await todosClient.mergeTodos({
+        id: todo.id,
+        duplicateId,
+        expectedVersion: todo.version,
+        expectedDuplicateVersion: duplicate.version,
+      });
+    },
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['todos'] });
+      queryClient.invalidateQueries({ queryKey: ['todoHistory'] });
+      notifications.show({ title: 'Merged', message: 'Duplicate merged into this todo', color: 'blue' });
+      onClose();
+    },
+    onError: (err: any) => {
+      notifications.show({ title: 'Error', message: err.message, color: 'red' });
+    },
+  });
+
+  // Snooze Mutation: hides the todo from lists until a time; an empty
+  // time brings it back now.
+  const snoozeMutation = useMutation({
+    mutationFn: async (until: string) => {
+      if (!todo) return;
+      await todosClient.snoozeTodo({ id: todo.id, until });
+    },
+    onSuccess: (_, until) => {
+      queryClient.invalidateQueries({ queryKey: ['todos'] });
+      notifications.show({
+        title: until ? 'Snoozed' : 'Unsnoozed',
+        message: until ? `Hidden until ${new Date(until).toLocaleString()}` : 'Todo is back in the list',
+        color: 'blue',
+      });
+      if (until) onClose();
+    },
+    onError: (err: any) => {
+      notifications.show({ title: 'Error', message: err.message, color: 'red' });
+    },
+  });
+
+  const snoozeFor = (days: number) => {
+    const until = new Date();
+    until.setDate(until.getDate() + days);
+    snoozeMutation.mutate(until.toISOString());
+  };
+  const snoozed = !!todo?.snoozedUntil && new Date(todo.snoozedUntil) > new Date();
+
   if (!todo) return null;
 
   return (
@@ -120,6 +188,17 @@ export function EditTodoDrawer({ opened, onClose, todo }: EditTodoDrawerProps) {
               <ActionIcon variant="subtle" color="gray"><MoreVertical size={16} /></ActionIcon>
             </Menu.Target>
             <Menu.Dropdown>
+              <Menu.Item leftSection={<AlarmClock size={14} />} onClick={() => snoozeFor(1)}>
+                Snooze until tomorrow
+              </Menu.Item>
+              <Menu.Item leftSection={<AlarmClock size={14} />} onClick={() => snoozeFor(7)}>
+                Snooze for a week
+              </Menu.Item>
+              {snoozed && (
+                <Menu.Item leftSection={<AlarmClock size={14} />} onClick={() => snoozeMutation.mutate('')}>
+                  Unsnooze
+                </Menu.Item>
+              )}
               {user?.role === 'admin' && (
                 <Menu.Item
                   color="red"
@@ -133,6 +212,19 @@ export function EditTodoDrawer({ opened, onClose, todo }: EditTodoDrawerProps) {
                   Delete Todo
                 </Menu.Item>
               )}
+              {user?.role === 'admin' && (
+                <Menu.Item
+                  leftSection={<Merge size={14} />}
+                  onClick={() => {
+                    const id = prompt('ID of the duplicate todo to merge into this one:')?.trim();
+                    if (id && /^\d+$/.test(id)) {
+                      mergeMutation.mutate(BigInt(id));
+                    }
+                  }}
+                >
+                  Merge Duplicate
+                </Menu.Item>
+              )}
             </Menu.Dropdown>
           </Menu>
         </Group>
@@ -170,6 +262,16 @@ export function EditTodoDrawer({ opened, onClose, todo }: EditTodoDrawerProps) {
           Save Changes
         </Button>
 
+        {todo && <ReactionBar target={{ todoId: todo.id }} reactions={todo.reactions} userNames={userMap} />}
+
+        <Text fw={700} size="sm" mt="md" c="dimmed">Attachments</Text>
+        {todo && <AttachmentList todoId={todo.id} />}
+
+        {todo && <TrackerLinks todoId={todo.id} />}
+
+        <Text fw={700} size="sm" mt="md" c="dimmed">Comments</Text>
+        {todo && <TodoComments todoId={todo.id} userNames={userMap} />}
+
         <Text fw={700} size="sm" mt="md" c="dimmed">History</Text>
         {historyLoading && <Loader size="sm" />}
         
@@ -178,7 +280,7 @@ export function EditTodoDrawer({ opened, onClose, todo }: EditTodoDrawerProps) {
             {history.map((h, index) => {
               const prev = history[index + 1];
               const isCreate = h.changeType === 'create' || !prev;
-              const actorName = h.actorUserId ? userMap.get(h.actorUserId) || 'Unknown' : 'Unknown';
+              const actorName = h.actorUserId ? userMap.get(h.actorUserId) || 'Unknown' : h.changeType === 'sync_conflict' ? 'tracker sync' : 'Unknown';
               const isExpanded = expandedItems[String(h.id)];
 
               return (
@@ -187,13 +289,14 @@ export function EditTodoDrawer({ opened, onClose, todo }: EditTodoDrawerProps) {
                   bullet={<div style={{ backgroundColor: getStatusConfig(h.status).color, width: 8, height: 8, borderRadius: '50%' }} />}
                   title={
                     <Text size="xs">
-                      <Text span fw={500}>{h.changeType.toUpperCase()}</Text> by {actorName}
+                      <Text span fw={500}>{h.changeType.replace('_', ' ').toUpperCase()}</Text> by {actorName}
                     </Text>
                   }
                 >
                    <Text size="xs" c="dimmed" mb={4}>
                       {new Date(h.changedAt).toLocaleString()}
                    </Text>
+                   {h.note && <Text size="xs" c="yellow.5" mb={4}>{h.note}</Text>}
                   
                   {!isCreate && (
                     <>
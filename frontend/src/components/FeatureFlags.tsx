@@ -0,0 +1,135 @@
+import { useMemo, useState } from 'react';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { ActionIcon, Alert, Badge, Button, Group, Loader, Select, Stack, Switch, Table, Text } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { X } from 'lucide-react';
+import { adminClient, usersClient } from '../lib/client';
+import type { FeatureFlag } from '../gen/secretary/v1/admin_pb';
+import type { User } from '../gen/secretary/v1/users_pb';
+
+function FlagRow({ flag, users }: { flag: FeatureFlag; users: User[] }) {
+  const queryClient = useQueryClient();
+  const [userId, setUserId] = useState<string | null>(null);
+
+  const names = useMemo(() => {
+    const map = new Map<bigint, string>();
+    users.forEach((u) => map.set(u.id, `${u.firstName} ${u.lastName}`.trim()));
+    return map;
+  }, [users]);
+
+  const onSuccess = () => queryClient.invalidateQueries({ queryKey: ['featureFlags'] });
+  const onError = (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' });
+
+  const updateMutation = useMutation({
+    mutationFn: async (enabled: boolean) => adminClient.updateFeatureFlag({ name: flag.name, enabled }),
+    onSuccess,
+    onError,
+  });
+
+  const overrideMutation = useMutation({
+    mutationFn: async (override: { userId: bigint; enabled?: boolean }) =>
+      adminClient.setUserFeatureFlag({ name: flag.name, ...override }),
+    onSuccess: () => {
+      setUserId(null);
+      onSuccess();
+    },
+    onError,
+  });
+
+  const overridden = new Set(flag.overrides.map((o) => o.userId));
+  const candidates = users
+    .filter((u) => !u.deactivated && !overridden.has(u.id))
+    .map((u) => ({ value: u.id.toString(), label: names.get(u.id) || `User ${u.id}` }));
+
+  return (
+    <Table.Tr>
+      <Table.Td>
+        <Text size="xs" fw={600}>{flag.name}</Text>
+        <Text size="xs" c="dimmed">{flag.description}</Text>
+      </Table.Td>
+      <Table.Td>
+        <Switch
+          size="xs"
+          checked={flag.enabled}
+          disabled={updateMutation.isPending}
+          onChange={(e) => updateMutation.mutate(e.currentTarget.checked)}
+        />
+      </Table.Td>
+      <Table.Td>
+        <Group gap={4}>
+          {flag.overrides.map((o) => (
+            <Badge
+              key={o.userId.toString()}
+              size="sm"
+              variant="light"
+              color={o.enabled ? 'green' : 'gray'}
+              rightSection={
+                <ActionIcon
+                  size="xs"
+                  variant="transparent"
+                  aria-label="Remove override"
+                  onClick={() => overrideMutation.mutate({ userId: o.userId })}
+                >
+                  <X size={10} />
+                </ActionIcon>
+              }
+            >
+              {names.get(o.userId) || `User ${o.userId}`}: {o.enabled ? 'on' : 'off'}
+            </Badge>
+          ))}
+        </Group>
+        <Group gap={4} mt={4} wrap="nowrap">
+          <Select size="xs" placeholder="Add a user" data={candidates} value={userId} onChange={setUserId} searchable clearable />
+          {userId && (
+            <>
+              <Button size="compact-xs" variant="light" loading={overrideMutation.isPending}
+                onClick={() => overrideMutation.mutate({ userId: BigInt(userId), enabled: true })}>
+                On
+              </Button>
+              <Button size="compact-xs" variant="light" color="gray" loading={overrideMutation.isPending}
+                onClick={() => overrideMutation.mutate({ userId: BigInt(userId), enabled: false })}>
+                Off
+              </Button>
+            </>
+          )}
+        </Group>
+      </Table.Td>
+    </Table.Tr>
+  );
+}
+
+// FeatureFlags lets admins turn experimental features on for everyone or
+// for particular users.
+export function FeatureFlags() {
+  const { data, isLoading, error } = useQuery({
+    queryKey: ['featureFlags'],
+    queryFn: async () => adminClient.listFeatureFlags({}),
+  });
+  const { data: users } = useQuery({
+    queryKey: ['users'],
+    queryFn: async () => (await usersClient.listUsers({})).users,
+  });
+
+  if (isLoading) return <Loader size="sm" />;
+  if (error || !data) return <Alert color="red">Failed to load feature flags: {error?.message}</Alert>;
+
+  return (
+    <Stack gap="xs">
+      <Table fz="xs">
+        <Table.Thead>
+          <Table.Tr>
+            <Table.Th>Feature</Table.Th>
+            <Table.Th>Everyone</Table.Th>
+            <Table.Th>Per user</Table.Th>
+          </Table.Tr>
+        </Table.Thead>
+        <Table.Tbody>
+          {data.flags.map((flag) => <FlagRow key={flag.name} flag={flag} users={users ?? []} />)}
+        </Table.Tbody>
+      </Table>
+      <Text size="xs" c="dimmed">
+        A per-user setting wins over the one for everyone. Users see a change the next time the app loads.
+      </Text>
+    </Stack>
+  );
+}
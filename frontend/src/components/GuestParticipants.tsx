@@ -0,0 +1,100 @@
+import { useState } from 'react';
+import { useMutation, useQueryClient } from '@tanstack/react-query';
+import { Badge, Button, CloseButton, Group, NumberInput, Popover, Stack, Text, TextInput, Tooltip } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { UserPlus } from 'lucide-react';
+import { recordingsClient } from '../lib/client';
+import type { GuestParticipant, Recording } from '../gen/secretary/v1/recordings_pb';
+
+interface GuestParticipantsProps {
+  recording: Recording;
+  speakerShare: (guest: GuestParticipant) => number;
+}
+
+// GuestParticipants shows the attendees who have no account next to the
+// identified users, and lets anyone add or remove them.
+export function GuestParticipants({ recording, speakerShare }: GuestParticipantsProps) {
+  const queryClient = useQueryClient();
+  const [opened, setOpened] = useState(false);
+  const [name, setName] = useState('');
+  const [email, setEmail] = useState('');
+  const [speaker, setSpeaker] = useState<string | number>('');
+
+  const onSuccess = () => {
+    queryClient.invalidateQueries({ queryKey: ['recording'] });
+    queryClient.invalidateQueries({ queryKey: ['recordings'] });
+  };
+  const onError = (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' });
+  const addMutation = useMutation({
+    mutationFn: async () =>
+      recordingsClient.addGuestParticipant({
+        recordingId: recording.id,
+        name,
+        email: email.trim(),
+        speakerId: typeof speaker === 'number' ? speaker : undefined,
+      }),
+    onSuccess: () => {
+      onSuccess();
+      setOpened(false);
+      setName('');
+      setEmail('');
+      setSpeaker('');
+    },
+    onError,
+  });
+  const removeMutation = useMutation({
+    mutationFn: async (id: bigint) => recordingsClient.removeGuestParticipant({ recordingId: recording.id, id }),
+    onSuccess,
+    onError,
+  });
+
+  return (
+    <>
+      {recording.guests.map((g) => {
+        const pct = speakerShare(g);
+        return (
+          <Badge key={String(g.id)} variant="outline" color="gray" size="lg" py="sm" pr={4} tt="none">
+            <Group gap="sm">
+              <Tooltip label={g.email} disabled={!g.email}>
+                <Text>{g.name}</Text>
+              </Tooltip>
+              <Badge size="sm" variant="light" color="grape">guest</Badge>
+              {pct > 0 && (
+                <Badge size="sm" variant="filled" color="gray">
+                  {pct}%
+                </Badge>
+              )}
+              <CloseButton size="sm" aria-label={`Remove ${g.name}`} onClick={() => removeMutation.mutate(g.id)} />
+            </Group>
+          </Badge>
+        );
+      })}
+      <Popover opened={opened} onChange={setOpened} width={280} position="bottom" withArrow trapFocus>
+        <Popover.Target>
+          <Button size="xs" variant="subtle" color="gray" leftSection={<UserPlus size={14} />} onClick={() => setOpened((o) => !o)}>
+            Add guest
+          </Button>
+        </Popover.Target>
+        <Popover.Dropdown>
+          <Stack gap="xs">
+            <TextInput label="Name" required maxLength={200} value={name} onChange={(e) => setName(e.currentTarget.value)} />
+            <TextInput label="Email" placeholder="Optional" value={email} onChange={(e) => setEmail(e.currentTarget.value)} />
+            <NumberInput
+              label="Speaker"
+              description="Their number in the transcript, e.g. 2 for Speaker 2"
+              placeholder="Unknown"
+              min={0}
+              allowDecimal={false}
+              value={speaker}
+              onChange={setSpeaker}
+            />
+            <Text size="xs" c="dimmed">Guests show up in the participants and minutes but can't sign in.</Text>
+            <Button size="xs" disabled={!name.trim()} loading={addMutation.isPending} onClick={() => addMutation.mutate()}>
+              Add
+            </Button>
+          </Stack>
+        </Popover.Dropdown>
+      </Popover>
+    </>
+  );
+}
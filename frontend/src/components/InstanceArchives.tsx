@@ -0,0 +1,122 @@
+import { useMutation, useQuery } from '@tanstack/react-query';
+import { Button, FileButton, Group, Stack, Text } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { useNavigate } from 'react-router-dom';
+import { adminClient, apiUrl } from '../lib/client';
+import { getToken, removeToken, removeUser } from '../lib/auth';
+
+async function archiveRequest(name: string, init: RequestInit): Promise<Response> {
+  const res = await fetch(apiUrl(`/api/admin/archives/${encodeURIComponent(name)}`), {
+    ...init,
+    headers: { Authorization: `Bearer ${getToken()}` },
+  });
+  if (!res.ok) {
+    const body = await res.json().catch(() => ({}));
+    throw new Error(body.error || `Archive transfer failed (HTTP ${res.status})`);
+  }
+  return res;
+}
+
+async function downloadArchive(name: string) {
+  const blob = await (await archiveRequest(name, { method: 'GET' })).blob();
+  const url = URL.createObjectURL(blob);
+  const link = document.createElement('a');
+  link.href = url;
+  link.download = name;
+  link.click();
+  URL.revokeObjectURL(url);
+}
+
+// InstanceArchives lets admins download a backup of the whole instance, or
+// replace everything with one to move servers or restore. Large instances
+// are easier to move with secretaryctl instance export and import.
+export function InstanceArchives() {
+  const navigate = useNavigate();
+  const onError = (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' });
+
+  const exportMutation = useMutation({
+    mutationFn: async () => {
+      const { archive } = await adminClient.exportInstance({});
+      if (!archive) throw new Error('The server returned no archive');
+      await downloadArchive(archive.name);
+      return archive;
+    },
+    onSuccess: (archive) => notifications.show({
+      message: `Exported ${archive.tables.length} tables and ${archive.mediaCount} files`,
+      color: 'green',
+    }),
+    onError,
+  });
+
+  const { data: backups } = useQuery({
+    queryKey: ['backups'],
+    queryFn: async () => adminClient.listBackups({}),
+  });
+
+  const backupMutation = useMutation({
+    mutationFn: downloadArchive,
+    onError,
+  });
+
+  const importMutation = useMutation({
+    mutationFn: async (file: File) => {
+      await archiveRequest(file.name, { method: 'PUT', body: file });
+      return adminClient.importInstance({ name: file.name, replaceAllData: true });
+    },
+    onSuccess: () => {
+      notifications.show({ message: 'Import finished. Sign in with an account from the archive.', color: 'green' });
+      removeToken();
+      removeUser();
+      navigate('/login');
+    },
+    onError,
+  });
+
+  return (
+    <Stack gap="xs">
+      <Text size="xs" c="dimmed">
+        An archive holds every user, recording, file and setting. Importing one replaces all data on this server.
+      </Text>
+      <Group gap="xs">
+        <Button variant="light" size="xs" onClick={() => exportMutation.mutate()} loading={exportMutation.isPending}>
+          Download archive
+        </Button>
+        <FileButton
+          accept=".gz,application/gzip"
+          onChange={(file) => {
+            if (file && confirm(`Replace all data on this server with ${file.name}? This cannot be undone.`)) importMutation.mutate(file);
+          }}
+        >
+          {(props) => (
+            <Button variant="light" color="red" size="xs" loading={importMutation.isPending} {...props}>
+              Import archive
+            </Button>
+          )}
+        </FileButton>
+      </Group>
+      {backups && backups.schedule && (
+        <Stack gap={4}>
+          <Text size="xs" c="dimmed">
+            Backups run on "{backups.schedule}"; the newest {backups.retain} are kept.
+            {backups.lastSuccessAt && ` Last succeeded ${new Date(backups.lastSuccessAt).toLocaleString()}.`}
+          </Text>
+          {backups.lastError && <Text size="xs" c="red">Last backup failed: {backups.lastError}</Text>}
+          {backups.backups.map((backup) => (
+            <Group key={backup.name} gap="xs">
+              <Text size="xs">{new Date(backup.createdAt).toLocaleString()}</Text>
+              <Text size="xs" c="dimmed">{(Number(backup.sizeBytes) / (1 << 20)).toFixed(1)} MB</Text>
+              <Button
+                variant="subtle"
+                size="xs"
+                onClick={() => backupMutation.mutate(backup.name)}
+                loading={backupMutation.isPending && backupMutation.variables === backup.name}
+              >
+                Download
+              </Button>
+            </Group>
+          ))}
+        </Stack>
+      )}
+    </Stack>
+  );
+}
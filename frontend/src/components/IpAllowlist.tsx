@@ -0,0 +1,61 @@
+import { useEffect, useState } from 'react';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Alert, Button, Group, Loader, Stack, Text, Textarea } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { adminClient } from '../lib/client';
+
+const parseCidrs = (text: string) => text.split(/[\s,]+/).filter(Boolean);
+
+// IpAllowlist lets admins limit API access to the organization's networks.
+export function IpAllowlist() {
+  const queryClient = useQueryClient();
+  const { data, isLoading, error } = useQuery({
+    queryKey: ['ipAllowlist'],
+    queryFn: async () => adminClient.getIpAllowlist({}),
+  });
+  const [text, setText] = useState('');
+
+  useEffect(() => {
+    setText(data?.cidrs.join('\n') ?? '');
+  }, [data]);
+
+  const setMutation = useMutation({
+    mutationFn: async (cidrs: string[]) => adminClient.setIpAllowlist({ cidrs }),
+    onSuccess: (res) => {
+      queryClient.invalidateQueries({ queryKey: ['ipAllowlist'] });
+      setText(res.cidrs.join('\n'));
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  if (isLoading) return <Loader size="sm" />;
+  if (error || !data) return <Alert color="red">Failed to load the IP allowlist: {error?.message}</Alert>;
+
+  return (
+    <Stack gap="xs">
+      <Textarea
+        label="Allowed networks"
+        description="One CIDR range or address per line. Leave empty to allow every address."
+        placeholder="203.0.113.0/24"
+        autosize
+        minRows={2}
+        value={text}
+        onChange={(e) => setText(e.currentTarget.value)}
+      />
+      <Text size="xs" c="dimmed">Your address: {data.yourIp}</Text>
+      {!data.bypassEnabled && data.cidrs.length > 0 && (
+        <Text size="xs" c="orange">
+          IP_ALLOWLIST_BYPASS_TOKEN is not set, so an admin outside these networks can't get back in from the app.
+        </Text>
+      )}
+      {text.trim() !== data.cidrs.join('\n') && (
+        <Group justify="flex-end">
+          <Button size="xs" variant="light" loading={setMutation.isPending}
+            onClick={() => setMutation.mutate(parseCidrs(text))}>
+            Save allowlist
+          </Button>
+        </Group>
+      )}
+    </Stack>
+  );
+}
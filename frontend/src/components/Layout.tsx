@@ -1,8 +1,10 @@
-import { AppShell, Burger, NavLink, ActionIcon, Tooltip, Group, Text, Button } from '@mantine/core';
+import { AppShell, Burger, NavLink, ActionIcon, Tooltip, Group, Text, Button, Badge } from '@mantine/core';
 import { useDisclosure } from '@mantine/hooks';
 import { Outlet, useNavigate, useLocation } from 'react-router-dom';
-import { LogOut, Mic, CheckSquare, Settings, Menu } from 'lucide-react';
+import { useQuery } from '@tanstack/react-query';
+import { LogOut, Mic, CheckSquare, Settings, Menu, Inbox } from 'lucide-react';
 import { removeToken, removeUser } from '../lib/auth';
+import { usersClient } from '../lib/client';
 
 interface NavItemProps {
   label: string;
@@ -10,14 +12,16 @@ interface NavItemProps {
   active: boolean;
   onClick: () => void;
   desktopOpened: boolean;
+  badge?: number;
 }
 
-function NavItem({ label, icon, active, onClick, desktopOpened }: NavItemProps) {
+function NavItem({ label, icon, active, onClick, desktopOpened, badge }: NavItemProps) {
   return (
     <Tooltip label={label} position="right" disabled={desktopOpened} withArrow>
       <NavLink
         label={desktopOpened ? label : null}
         leftSection={icon}
+        rightSection={badge && desktopOpened ? <Badge size="xs" variant="filled" color="red">{badge}</Badge> : undefined}
         active={active}
         onClick={onClick}
         py="md"
@@ -32,6 +36,11 @@ export function Layout() {
   const [desktopOpened, { toggle: toggleDesktop }] = useDisclosure(true);
   const navigate = useNavigate();
   const location = useLocation();
+  const { data: unreadMentions } = useQuery({
+    queryKey: ['mentions', 'unread'],
+    queryFn: async () => Number((await usersClient.listMentions({ unreadOnly: true, limit: 1 })).unreadCount),
+    refetchInterval: 60_000,
+  });
 
   const handleLogout = () => {
     removeToken();
@@ -79,6 +88,14 @@ export function Layout() {
           onClick={() => { navigate('/recordings'); toggle(); }}
           desktopOpened={desktopOpened}
         />
+        <NavItem
+          label="Inbox"
+          icon={<Inbox size={16} />}
+          active={location.pathname.startsWith('/inbox')}
+          onClick={() => { navigate('/inbox'); toggle(); }}
+          desktopOpened={desktopOpened}
+          badge={unreadMentions}
+        />
         <NavItem
           label="Settings"
           icon={<Settings size={16} />}
@@ -0,0 +1,62 @@
+import { useMutation, useQueryClient } from '@tanstack/react-query';
+import { Badge, Button, Group, Popover, Stack, Text, Tooltip } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { Lock } from 'lucide-react';
+import { recordingsClient } from '../lib/client';
+import { getUser } from '../lib/auth';
+import type { Recording } from '../gen/secretary/v1/recordings_pb';
+
+// LegalHold shows whether a recording is held and lets admins place or
+// lift the hold, giving a reason each time.
+export function LegalHold({ recording }: { recording: Recording }) {
+  const queryClient = useQueryClient();
+  const isAdmin = getUser()?.role === 'admin';
+
+  const mutation = useMutation({
+    mutationFn: async (reason: string) => recordingsClient.setLegalHold({ id: recording.id, held: !recording.legalHold, reason }),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['recording'] });
+      queryClient.invalidateQueries({ queryKey: ['recordings'] });
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  if (!isAdmin && !recording.legalHold) return null;
+
+  const toggle = () => {
+    const reason = prompt(recording.legalHold ? 'Why is the hold being lifted?' : 'Why is this recording being held? (e.g. a case reference)');
+    if (reason?.trim()) mutation.mutate(reason.trim());
+  };
+
+  return (
+    <Group gap="xs">
+      {recording.legalHold && (
+        <Tooltip label={recording.legalHoldReason} disabled={!recording.legalHoldReason}>
+          <Badge color="orange" variant="light" leftSection={<Lock size={12} />}>Legal hold</Badge>
+        </Tooltip>
+      )}
+      {isAdmin && (
+        <Button size="xs" variant="subtle" color="orange" onClick={toggle} loading={mutation.isPending}>
+          {recording.legalHold ? 'Lift hold' : 'Place legal hold'}
+        </Button>
+      )}
+      {isAdmin && recording.legalHoldHistory.length > 0 && (
+        <Popover width={320} position="bottom" withArrow>
+          <Popover.Target>
+            <Button size="xs" variant="subtle" color="gray">History</Button>
+          </Popover.Target>
+          <Popover.Dropdown>
+            <Stack gap={4}>
+              {recording.legalHoldHistory.map((event, i) => (
+                <Text key={i} size="xs">
+                  {new Date(event.createdAt).toLocaleString()}: {event.held ? 'placed' : 'lifted'}
+                  {event.userId ? ` by user ${event.userId}` : ''}. {event.reason}
+                </Text>
+              ))}
+            </Stack>
+          </Popover.Dropdown>
+        </Popover>
+      )}
+    </Group>
+  );
+}
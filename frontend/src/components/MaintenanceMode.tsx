@@ -0,0 +1,61 @@
+import { useEffect, useState } from 'react';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Alert, Button, Group, Loader, Stack, Switch, Text, Textarea } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { adminClient } from '../lib/client';
+
+// MaintenanceMode lets admins shut everyone else out during a migration or
+// a provider outage, with a message saying why.
+export function MaintenanceMode() {
+  const queryClient = useQueryClient();
+  const { data, isLoading, error } = useQuery({
+    queryKey: ['maintenanceMode'],
+    queryFn: async () => (await adminClient.getMaintenanceMode({})).mode,
+  });
+  const [message, setMessage] = useState('');
+
+  useEffect(() => {
+    setMessage(data?.message ?? '');
+  }, [data]);
+
+  const setMutation = useMutation({
+    mutationFn: async (update: { enabled: boolean; message: string }) => adminClient.setMaintenanceMode(update),
+    onSuccess: (res) => queryClient.setQueryData(['maintenanceMode'], res.mode),
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  if (isLoading) return <Loader size="sm" />;
+  if (error || !data) return <Alert color="red">Failed to load maintenance mode: {error?.message}</Alert>;
+
+  return (
+    <Stack gap="xs">
+      <Switch
+        label="Maintenance mode"
+        description="Everyone but admins gets a 503 with the message below."
+        checked={data.enabled}
+        disabled={setMutation.isPending}
+        onChange={(e) => setMutation.mutate({ enabled: e.currentTarget.checked, message })}
+      />
+      {data.enabled && data.startedAt && (
+        <Text size="xs" c="orange">On since {new Date(data.startedAt).toLocaleString()}</Text>
+      )}
+      <Textarea
+        label="Message"
+        placeholder="Secretary is down for maintenance. Please try again shortly."
+        autosize
+        minRows={2}
+        maxLength={500}
+        value={message}
+        onChange={(e) => setMessage(e.currentTarget.value)}
+      />
+      {message !== data.message && (
+        <Group justify="flex-end">
+          <Button size="xs" variant="light" loading={setMutation.isPending}
+            onClick={() => setMutation.mutate({ enabled: data.enabled, message })}>
+            Save message
+          </Button>
+        </Group>
+      )}
+    </Stack>
+  );
+}
@@ -0,0 +1,97 @@
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Checkbox, Select, Stack, Switch, Text, Title } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { notificationsClient } from '../lib/client';
+import {
+  DigestFrequency,
+  NotificationEvent,
+  NotificationPreferences as Preferences,
+} from '../gen/secretary/v1/notifications_pb';
+
+const EVENT_LABELS: [NotificationEvent, string][] = [
+  [NotificationEvent.TODO_ASSIGNED, 'A todo is assigned to me'],
+  [NotificationEvent.RECORDING_READY, 'A meeting I was in is processed'],
+  [NotificationEvent.KEYWORD_MATCHED, 'A new meeting mentions a keyword I watch'],
+  [NotificationEvent.MENTIONED, 'Someone @-mentions me in a todo comment'],
+  [NotificationEvent.SNOOZE_ENDED, 'A snoozed todo of mine is back'],
+];
+
+const DIGEST_OPTIONS = [
+  { value: String(DigestFrequency.OFF), label: 'Off' },
+  { value: String(DigestFrequency.DAILY), label: 'Daily' },
+  { value: String(DigestFrequency.WEEKLY), label: 'Weekly' },
+];
+
+// NotificationPreferences lets the signed-in user choose how they hear
+// about their todos and meetings. Every change saves right away.
+export function NotificationPreferences() {
+  const queryClient = useQueryClient();
+  const queryKey = ['notification-preferences'];
+
+  const { data } = useQuery({
+    queryKey,
+    queryFn: async () => notificationsClient.getPreferences({}),
+  });
+
+  const saveMutation = useMutation({
+    mutationFn: async (preferences: Preferences) => (await notificationsClient.updatePreferences({ preferences })).preferences,
+    onSuccess: (preferences) => {
+      if (data) queryClient.setQueryData(queryKey, { ...data, preferences });
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  const prefs = data?.preferences;
+  if (!data || !prefs) return null;
+
+  const save = (change: Partial<Preferences>) => saveMutation.mutate(new Preferences({ ...prefs, ...change }));
+  const muted = new Set(prefs.mutedEvents);
+
+  return (
+    <Stack gap="xs">
+      <Title order={4}>Notifications</Title>
+      <Switch
+        label="Email"
+        description={data.emailAvailable ? 'About todos assigned to you and keywords you watch' : 'This server does not send email'}
+        checked={prefs.email}
+        disabled={!data.emailAvailable}
+        onChange={(e) => save({ email: e.currentTarget.checked })}
+      />
+      <Switch
+        label="Team chat"
+        description={data.chatAvailable ? 'Mention me in Slack or Teams when I get a todo' : 'No team chat is connected'}
+        checked={prefs.chat}
+        disabled={!data.chatAvailable}
+        onChange={(e) => save({ chat: e.currentTarget.checked })}
+      />
+      <Switch
+        label="Mobile push"
+        description={data.pushAvailable ? 'Alerts on phones signed in to the app' : 'Push is not set up on this server'}
+        checked={prefs.push}
+        disabled={!data.pushAvailable}
+        onChange={(e) => save({ push: e.currentTarget.checked })}
+      />
+      <Text size="sm" fw={500} mt="xs">Tell me when</Text>
+      {EVENT_LABELS.map(([event, label]) => (
+        <Checkbox
+          key={event}
+          label={label}
+          checked={!muted.has(event)}
+          onChange={(e) => {
+            const next = new Set(muted);
+            if (e.currentTarget.checked) next.delete(event);
+            else next.add(event);
+            save({ mutedEvents: [...next] });
+          }}
+        />
+      ))}
+      <Select
+        label="Email digest"
+        data={DIGEST_OPTIONS}
+        value={String(prefs.digestFrequency)}
+        onChange={(value) => value && save({ digestFrequency: Number(value) as DigestFrequency })}
+        allowDeselect={false}
+      />
+    </Stack>
+  );
+}
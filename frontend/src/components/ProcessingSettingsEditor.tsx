@@ -0,0 +1,98 @@
+import { useState } from 'react';
+import { useMutation, useQueryClient } from '@tanstack/react-query';
+import { Button, Group, Modal, Stack, Switch, Text, TextInput } from '@mantine/core';
+import { useDisclosure } from '@mantine/hooks';
+import { notifications } from '@mantine/notifications';
+import { SlidersHorizontal } from 'lucide-react';
+import { recordingsClient } from '../lib/client';
+import { ProcessingSettings, RecordingStatus } from '../gen/secretary/v1/recordings_pb';
+import type { Recording } from '../gen/secretary/v1/recordings_pb';
+import { RedactionPolicy } from '../gen/secretary/v1/settings_pb';
+
+const REDACTION_OPTIONS: { field: 'emails' | 'phoneNumbers' | 'creditCards' | 'profanity'; label: string }[] = [
+  { field: 'emails', label: 'Email addresses' },
+  { field: 'phoneNumbers', label: 'Phone numbers' },
+  { field: 'creditCards', label: 'Credit card numbers' },
+  { field: 'profanity', label: 'Profanity' },
+];
+
+// ProcessingSettingsEditor changes how a recording is transcribed and
+// summarized the next time it is processed. The server rejects changes
+// while the recording is being transcribed or summarized.
+export function ProcessingSettingsEditor({ recording }: { recording: Recording }) {
+  const queryClient = useQueryClient();
+  const [opened, { open, close }] = useDisclosure(false);
+  const [settings, setSettings] = useState(new ProcessingSettings());
+  const redaction = settings.redaction ?? new RedactionPolicy();
+  const processing = recording.status === RecordingStatus.TRANSCRIBING || recording.status === RecordingStatus.SUMMARIZING;
+
+  const edit = () => {
+    setSettings(recording.processingSettings?.clone() ?? new ProcessingSettings());
+    open();
+  };
+
+  const updateMutation = useMutation({
+    mutationFn: async () => recordingsClient.updateProcessingSettings({ recordingId: recording.id, settings }),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['recording', recording.id.toString()] });
+      close();
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  return (
+    <>
+      <Button size="xs" variant="subtle" leftSection={<SlidersHorizontal size={14} />} onClick={edit} disabled={processing}>
+        Processing settings
+      </Button>
+      <Modal opened={opened} onClose={close} title="Processing settings">
+        <Stack>
+          <Text size="sm">
+            Used the next time this meeting is transcribed or summarized, e.g. after retrying it.
+          </Text>
+          <TextInput
+            label="Language"
+            description={`A language tag such as "es" or "pt-BR"; leave empty for the organization's default.`}
+            maxLength={35}
+            value={settings.language}
+            onChange={(e) => setSettings(new ProcessingSettings({ ...settings, language: e.currentTarget.value }))}
+          />
+          <Switch
+            label="Tell speakers apart"
+            checked={!settings.disableDiarization}
+            onChange={(e) => setSettings(new ProcessingSettings({ ...settings, disableDiarization: !e.currentTarget.checked }))}
+          />
+          <Switch
+            label="Summarize"
+            checked={!settings.disableSummary}
+            onChange={(e) => setSettings(new ProcessingSettings({ ...settings, disableSummary: !e.currentTarget.checked }))}
+          />
+          <Switch
+            label="Extract todos"
+            checked={!settings.disableTodoExtraction}
+            disabled={settings.disableSummary}
+            onChange={(e) => setSettings(new ProcessingSettings({ ...settings, disableTodoExtraction: !e.currentTarget.checked }))}
+          />
+          <Text size="sm" fw={500}>Also mask</Text>
+          {REDACTION_OPTIONS.map(({ field, label }) => (
+            <Switch
+              key={field}
+              label={label}
+              checked={redaction[field]}
+              onChange={(e) =>
+                setSettings(new ProcessingSettings({ ...settings, redaction: new RedactionPolicy({ ...redaction, [field]: e.currentTarget.checked }) }))
+              }
+            />
+          ))}
+          <Text size="xs" c="dimmed">What the organization masks is always masked.</Text>
+          <Group justify="flex-end">
+            <Button variant="default" onClick={close}>Cancel</Button>
+            <Button loading={updateMutation.isPending} onClick={() => updateMutation.mutate()}>
+              Save
+            </Button>
+          </Group>
+        </Stack>
+      </Modal>
+    </>
+  );
+}
@@ -0,0 +1,41 @@
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Select } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { promptTemplatesClient } from '../lib/client';
+import type { Recording } from '../gen/secretary/v1/recordings_pb';
+
+// PromptTemplateSelect picks the prompt template a recording's summary,
+// outcomes and minutes are written with. Hidden until an admin has
+// created a template.
+export function PromptTemplateSelect({ recording }: { recording: Recording }) {
+  const queryClient = useQueryClient();
+  const { data: templates } = useQuery({
+    queryKey: ['promptTemplates'],
+    queryFn: async () => (await promptTemplatesClient.listPromptTemplates({})).templates,
+  });
+
+  const setMutation = useMutation({
+    mutationFn: async (templateId: bigint) =>
+      promptTemplatesClient.setRecordingPromptTemplate({ recordingId: recording.id, templateId }),
+    onSuccess: () => queryClient.invalidateQueries({ queryKey: ['recording', recording.id.toString()] }),
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  if (!templates?.length) return null;
+
+  return (
+    <Select
+      size="xs"
+      w={200}
+      aria-label="Prompt template"
+      value={recording.promptTemplateId.toString()}
+      onChange={(value) => setMutation.mutate(BigInt(value ?? '0'))}
+      disabled={setMutation.isPending}
+      allowDeselect={false}
+      data={[
+        { value: '0', label: 'Default prompts' },
+        ...templates.map((t) => ({ value: t.id.toString(), label: t.name })),
+      ]}
+    />
+  );
+}
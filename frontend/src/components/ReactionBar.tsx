@@ -0,0 +1,72 @@
+import { useEffect, useState } from 'react';
+import { useMutation, useQueryClient } from '@tanstack/react-query';
+import { ActionIcon, Button, Group, Menu, Tooltip } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { SmilePlus } from 'lucide-react';
+import { todosClient } from '../lib/client';
+import { Reaction } from '../gen/secretary/v1/todos_pb';
+
+const QUICK_EMOJI = ['👍', '✅', '👀', '🎉', '❤️', '🙏'];
+
+// ReactionBar shows the emoji reactions to a todo or comment and lets the
+// signed-in user add or take back their own.
+export function ReactionBar({
+  target,
+  reactions,
+  userNames,
+}: {
+  target: { todoId: bigint } | { commentId: bigint };
+  reactions: Reaction[];
+  userNames: Map<bigint, string>;
+}) {
+  const queryClient = useQueryClient();
+  const [current, setCurrent] = useState(reactions);
+  useEffect(() => setCurrent(reactions), [reactions]);
+
+  const mutation = useMutation({
+    mutationFn: async ({ emoji, reacted }: { emoji: string; reacted: boolean }) =>
+      reacted ? todosClient.removeReaction({ ...target, emoji }) : todosClient.addReaction({ ...target, emoji }),
+    onSuccess: (res) => {
+      setCurrent(res.reactions);
+      queryClient.invalidateQueries({ queryKey: 'todoId' in target ? ['todos'] : ['todoComments'] });
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  return (
+    <Group gap={4}>
+      {current.map((r) => (
+        <Tooltip key={r.emoji} label={r.userIds.map((id) => userNames.get(id) || 'Unknown').join(', ')}>
+          <Button
+            size="compact-xs"
+            radius="xl"
+            variant={r.reacted ? 'light' : 'default'}
+            onClick={() => mutation.mutate({ emoji: r.emoji, reacted: r.reacted })}
+          >
+            {r.emoji} {r.userIds.length}
+          </Button>
+        </Tooltip>
+      ))}
+      <Menu position="bottom-start">
+        <Menu.Target>
+          <ActionIcon size="sm" variant="subtle" color="gray" aria-label="Add reaction">
+            <SmilePlus size={14} />
+          </ActionIcon>
+        </Menu.Target>
+        <Menu.Dropdown>
+          <Group gap={2} p={4}>
+            {QUICK_EMOJI.map((emoji) => (
+              <Menu.Item
+                key={emoji}
+                p={4}
+                onClick={() => mutation.mutate({ emoji, reacted: current.some((r) => r.emoji === emoji && r.reacted) })}
+              >
+                {emoji}
+              </Menu.Item>
+            ))}
+          </Group>
+        </Menu.Dropdown>
+      </Menu>
+    </Group>
+  );
+}
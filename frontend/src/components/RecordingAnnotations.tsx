@@ -0,0 +1,154 @@
+import { useState } from 'react';
+import type { RefObject } from 'react';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { ActionIcon, Anchor, Button, Card, Group, Loader, Stack, Text, TextInput, Textarea } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { Bookmark, Pencil, Trash } from 'lucide-react';
+import { annotationsClient } from '../lib/client';
+import { getUser } from '../lib/auth';
+import { formatOffset, parseOffset } from '../lib/offsets';
+import type { Annotation } from '../gen/secretary/v1/annotations_pb';
+import type { User } from '../gen/secretary/v1/users_pb';
+import { UserAvatar, userName } from './UserAvatar';
+
+type Draft = { id?: bigint; start: string; end: string; note: string };
+
+// RecordingAnnotations lists the marked moments of a recording. Clicking
+// one seeks the audio player to it.
+export function RecordingAnnotations({
+  recordingId,
+  userMap,
+  audioRef,
+}: {
+  recordingId: bigint;
+  userMap: Map<bigint, User>;
+  audioRef: RefObject<HTMLAudioElement>;
+}) {
+  const queryClient = useQueryClient();
+  const currentUser = getUser();
+  const queryKey = ['annotations', recordingId.toString()];
+  const [draft, setDraft] = useState<Draft | null>(null);
+
+  const { data: annotations, isLoading } = useQuery({
+    queryKey,
+    queryFn: async () => (await annotationsClient.listAnnotations({ recordingId })).annotations,
+  });
+
+  const onError = (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' });
+  const onSuccess = () => {
+    setDraft(null);
+    queryClient.invalidateQueries({ queryKey });
+  };
+
+  const saveMutation = useMutation({
+    mutationFn: async (d: Draft) => {
+      const startMs = parseOffset(d.start);
+      const endMs = d.end.trim() ? parseOffset(d.end) : 0;
+      if (startMs === null || endMs === null) throw new Error('Use m:ss for times');
+      return d.id
+        ? annotationsClient.updateAnnotation({ id: d.id, startMs, endMs, note: d.note })
+        : annotationsClient.createAnnotation({ recordingId, startMs, endMs, note: d.note });
+    },
+    onSuccess,
+    onError,
+  });
+  const deleteMutation = useMutation({
+    mutationFn: async (annotation: Annotation) => annotationsClient.deleteAnnotation({ id: annotation.id }),
+    onSuccess,
+    onError,
+  });
+
+  const seek = (ms: number) => {
+    const audio = audioRef.current;
+    if (!audio) return;
+    audio.currentTime = ms / 1000;
+    audio.play();
+  };
+
+  const markNow = () => {
+    const now = Math.floor((audioRef.current?.currentTime ?? 0) * 1000);
+    setDraft({ start: formatOffset(now), end: '', note: '' });
+  };
+
+  const canChange = (annotation: Annotation) =>
+    currentUser?.role === 'admin' || (currentUser != null && annotation.authorUserId === BigInt(currentUser.id));
+
+  if (isLoading) return <Loader />;
+
+  return (
+    <Stack>
+      <Group justify="space-between">
+        <Text c="dimmed" size="sm">Key moments in this recording.</Text>
+        <Button size="xs" variant="light" leftSection={<Bookmark size={14} />} onClick={markNow}>
+          Mark moment
+        </Button>
+      </Group>
+
+      {draft && (
+        <Card withBorder radius="md" padding="sm">
+          <Stack gap="xs">
+            <Group grow>
+              <TextInput label="Start" placeholder="m:ss" value={draft.start} onChange={(e) => setDraft({ ...draft, start: e.currentTarget.value })} />
+              <TextInput label="End" placeholder="optional" value={draft.end} onChange={(e) => setDraft({ ...draft, end: e.currentTarget.value })} />
+            </Group>
+            <Textarea label="Note" autosize minRows={2} value={draft.note} onChange={(e) => setDraft({ ...draft, note: e.currentTarget.value })} />
+            <Group justify="flex-end">
+              <Button variant="default" size="xs" onClick={() => setDraft(null)}>Cancel</Button>
+              <Button size="xs" onClick={() => saveMutation.mutate(draft)} loading={saveMutation.isPending} disabled={!draft.note.trim()}>
+                Save
+              </Button>
+            </Group>
+          </Stack>
+        </Card>
+      )}
+
+      {annotations?.length === 0 && !draft && <Text size="sm" c="dimmed">No moments marked yet.</Text>}
+      {annotations?.map((annotation) => {
+        const author = userMap.get(annotation.authorUserId);
+        const range = annotation.endMs > annotation.startMs
+          ? `${formatOffset(annotation.startMs)}–${formatOffset(annotation.endMs)}`
+          : formatOffset(annotation.startMs);
+        return (
+          <Card key={annotation.id} withBorder radius="md" padding="sm">
+            <Group justify="space-between" align="start" wrap="nowrap">
+              <Stack gap={4} style={{ flex: 1 }}>
+                <Group gap="xs" wrap="nowrap" align="start">
+                  <Anchor component="button" type="button" ff="monospace" size="sm" onClick={() => seek(annotation.startMs)}>
+                    {range}
+                  </Anchor>
+                  <Text size="sm" style={{ whiteSpace: 'pre-wrap' }}>{annotation.note}</Text>
+                </Group>
+                {author && (
+                  <Group gap={6}>
+                    <UserAvatar user={author} size={16} />
+                    <Text size="xs" c="dimmed">{userName(author)}</Text>
+                  </Group>
+                )}
+              </Stack>
+              {canChange(annotation) && (
+                <Group gap={4} wrap="nowrap">
+                  <ActionIcon
+                    variant="subtle"
+                    color="gray"
+                    aria-label="Edit"
+                    onClick={() => setDraft({
+                      id: annotation.id,
+                      start: formatOffset(annotation.startMs),
+                      end: annotation.endMs > annotation.startMs ? formatOffset(annotation.endMs) : '',
+                      note: annotation.note,
+                    })}
+                  >
+                    <Pencil size={14} />
+                  </ActionIcon>
+                  <ActionIcon variant="subtle" color="gray" aria-label="Delete" onClick={() => deleteMutation.mutate(annotation)}>
+                    <Trash size={14} />
+                  </ActionIcon>
+                </Group>
+              )}
+            </Group>
+          </Card>
+        );
+      })}
+    </Stack>
+  );
+}
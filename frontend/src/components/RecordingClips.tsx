@@ -0,0 +1,103 @@
+import { useState } from 'react';
+import type { RefObject } from 'react';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { ActionIcon, Blockquote, Button, Card, CopyButton, Group, Loader, Stack, Text, TextInput, Tooltip } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { Check, Link, Scissors } from 'lucide-react';
+import { apiUrl, recordingsClient } from '../lib/client';
+import { formatOffset, parseOffset } from '../lib/offsets';
+import type { Clip, Recording } from '../gen/secretary/v1/recordings_pb';
+
+type Draft = { start: string; end: string; title: string };
+
+function shareUrl(clip: Clip) {
+  return clip.shareUrl.startsWith('/') ? apiUrl(clip.shareUrl) : clip.shareUrl;
+}
+
+// RecordingClips cuts shareable clips out of a recording's audio and lists
+// the ones already cut.
+export function RecordingClips({ recording, audioRef }: { recording: Recording; audioRef: RefObject<HTMLAudioElement> }) {
+  const queryClient = useQueryClient();
+  const queryKey = ['clips', recording.id.toString()];
+  const [draft, setDraft] = useState<Draft | null>(null);
+
+  const { data: clips, isLoading } = useQuery({
+    queryKey,
+    queryFn: async () => (await recordingsClient.listClips({ recordingId: recording.id })).clips,
+  });
+
+  const createMutation = useMutation({
+    mutationFn: async (d: Draft) => {
+      const startMs = parseOffset(d.start);
+      const endMs = parseOffset(d.end);
+      if (startMs === null || endMs === null) throw new Error('Use m:ss for times');
+      return recordingsClient.createClip({ recordingId: recording.id, startMs, endMs, title: d.title });
+    },
+    onSuccess: () => {
+      setDraft(null);
+      queryClient.invalidateQueries({ queryKey });
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  const startDraft = () => {
+    const now = Math.floor((audioRef.current?.currentTime ?? 0) * 1000);
+    setDraft({ start: formatOffset(now), end: formatOffset(now + 30_000), title: '' });
+  };
+
+  if (isLoading) return <Loader />;
+
+  return (
+    <Stack>
+      <Group justify="space-between">
+        <Text c="dimmed" size="sm">Short pieces of this recording anyone with the link can play.</Text>
+        <Button size="xs" variant="light" leftSection={<Scissors size={14} />} onClick={startDraft} disabled={!recording.hasAudio}>
+          New clip
+        </Button>
+      </Group>
+
+      {draft && (
+        <Card withBorder radius="md" padding="sm">
+          <Stack gap="xs">
+            <Group grow>
+              <TextInput label="Start" placeholder="m:ss" value={draft.start} onChange={(e) => setDraft({ ...draft, start: e.currentTarget.value })} />
+              <TextInput label="End" placeholder="m:ss" value={draft.end} onChange={(e) => setDraft({ ...draft, end: e.currentTarget.value })} />
+            </Group>
+            <TextInput label="Title" placeholder="Optional" value={draft.title} onChange={(e) => setDraft({ ...draft, title: e.currentTarget.value })} />
+            <Group justify="flex-end">
+              <Button variant="default" size="xs" onClick={() => setDraft(null)}>Cancel</Button>
+              <Button size="xs" onClick={() => createMutation.mutate(draft)} loading={createMutation.isPending}>
+                Cut clip
+              </Button>
+            </Group>
+          </Stack>
+        </Card>
+      )}
+
+      {clips?.length === 0 && !draft && <Text size="sm" c="dimmed">No clips yet.</Text>}
+      {clips?.map((clip) => (
+        <Card key={clip.id} withBorder radius="md" padding="sm">
+          <Stack gap="xs">
+            <Group justify="space-between" wrap="nowrap">
+              <Stack gap={0}>
+                <Text fw={500}>{clip.title}</Text>
+                <Text size="xs" c="dimmed">{formatOffset(clip.startMs)}–{formatOffset(clip.endMs)}</Text>
+              </Stack>
+              <CopyButton value={shareUrl(clip)}>
+                {({ copied, copy }) => (
+                  <Tooltip label={copied ? 'Copied' : 'Copy link'}>
+                    <ActionIcon variant="subtle" color={copied ? 'teal' : 'gray'} onClick={copy} aria-label="Copy link">
+                      {copied ? <Check size={14} /> : <Link size={14} />}
+                    </ActionIcon>
+                  </Tooltip>
+                )}
+              </CopyButton>
+            </Group>
+            <audio controls preload="none" src={shareUrl(clip)} style={{ width: '100%' }} />
+            {clip.transcriptExcerpt && <Blockquote p="xs" fz="sm">{clip.transcriptExcerpt}</Blockquote>}
+          </Stack>
+        </Card>
+      ))}
+    </Stack>
+  );
+}
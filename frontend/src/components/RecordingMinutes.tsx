@@ -0,0 +1,165 @@
+import { useState } from 'react';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Code, ConnectError } from '@connectrpc/connect';
+import { Badge, Button, Group, List, Loader, Select, Stack, Text, Textarea, Title } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { Pencil, Sparkles } from 'lucide-react';
+import { recordingsClient } from '../lib/client';
+import { MinutesDocument } from '../gen/secretary/v1/recordings_pb';
+import type { MeetingMinutes, Recording } from '../gen/secretary/v1/recordings_pb';
+
+type Draft = { agenda: string; decisions: string; notes: string };
+
+const lines = (text: string) => text.split('\n').map((line) => line.trim()).filter(Boolean);
+
+// RecordingMinutes shows a meeting's minutes, drafts them from the
+// transcript and lets people edit them. Every save is a new version.
+export function RecordingMinutes({ recording }: { recording: Recording }) {
+  const queryClient = useQueryClient();
+  const queryKey = ['minutes', recording.id.toString()];
+  const [version, setVersion] = useState(0);
+  const [draft, setDraft] = useState<Draft | null>(null);
+
+  const { data: versions } = useQuery({
+    queryKey: [...queryKey, 'versions'],
+    queryFn: async () => (await recordingsClient.listMinutesVersions({ recordingId: recording.id })).versions,
+  });
+  const { data: minutes, isLoading } = useQuery({
+    queryKey: [...queryKey, version],
+    queryFn: async (): Promise<MeetingMinutes | null> => {
+      try {
+        return (await recordingsClient.getMinutes({ recordingId: recording.id, version })).minutes ?? null;
+      } catch (err) {
+        if (err instanceof ConnectError && err.code === Code.NotFound) return null;
+        throw err;
+      }
+    },
+  });
+
+  const onSaved = () => {
+    setDraft(null);
+    setVersion(0);
+    queryClient.invalidateQueries({ queryKey });
+  };
+  const generateMutation = useMutation({
+    mutationFn: async () => recordingsClient.generateMinutes({ recordingId: recording.id }),
+    onSuccess: onSaved,
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+  const saveMutation = useMutation({
+    mutationFn: async (d: Draft) => {
+      const document = new MinutesDocument(minutes?.document);
+      document.agenda = lines(d.agenda);
+      document.decisions = lines(d.decisions);
+      document.notes = d.notes;
+      return recordingsClient.updateMinutes({ recordingId: recording.id, baseVersion: minutes?.version ?? 0, document });
+    },
+    onSuccess: onSaved,
+    onError: (err: Error) => {
+      if (err instanceof ConnectError && err.code === Code.FailedPrecondition) {
+        queryClient.invalidateQueries({ queryKey });
+        notifications.show({ title: 'Minutes changed', message: 'Someone else saved these minutes first. Copy your changes and edit the latest version.', color: 'yellow' });
+        return;
+      }
+      notifications.show({ title: 'Error', message: err.message, color: 'red' });
+    },
+  });
+
+  const startEditing = () => {
+    const doc = minutes?.document;
+    setDraft({ agenda: doc?.agenda.join('\n') ?? '', decisions: doc?.decisions.join('\n') ?? '', notes: doc?.notes ?? '' });
+  };
+
+  if (isLoading) return <Loader />;
+  const doc = minutes?.document;
+
+  return (
+    <Stack>
+      <Group justify="space-between">
+        {versions && versions.length > 0 ? (
+          <Select
+            size="xs"
+            w={220}
+            value={version.toString()}
+            onChange={(value) => setVersion(Number(value ?? 0))}
+            data={[
+              { value: '0', label: 'Latest version' },
+              ...versions.map((v) => ({ value: v.version.toString(), label: `Version ${v.version} (${v.source === 'llm' ? 'generated' : 'edited'})` })),
+            ]}
+          />
+        ) : <span />}
+        <Group gap="xs">
+          {minutes && !draft && version === 0 && (
+            <Button size="xs" variant="default" leftSection={<Pencil size={14} />} onClick={startEditing}>Edit</Button>
+          )}
+          <Button
+            size="xs"
+            variant="light"
+            leftSection={<Sparkles size={14} />}
+            onClick={() => generateMutation.mutate()}
+            loading={generateMutation.isPending}
+            disabled={!recording.transcriptLineCount || draft !== null}
+          >
+            {minutes ? 'Generate again' : 'Generate from transcript'}
+          </Button>
+        </Group>
+      </Group>
+
+      {draft && (
+        <Stack gap="xs">
+          <Textarea label="Agenda" description="One topic per line" autosize minRows={3} value={draft.agenda} onChange={(e) => setDraft({ ...draft, agenda: e.currentTarget.value })} />
+          <Textarea label="Decisions" description="One per line" autosize minRows={3} value={draft.decisions} onChange={(e) => setDraft({ ...draft, decisions: e.currentTarget.value })} />
+          <Textarea label="Notes" autosize minRows={5} value={draft.notes} onChange={(e) => setDraft({ ...draft, notes: e.currentTarget.value })} />
+          <Group justify="flex-end">
+            <Button variant="default" size="xs" onClick={() => setDraft(null)}>Cancel</Button>
+            <Button size="xs" onClick={() => saveMutation.mutate(draft)} loading={saveMutation.isPending}>Save</Button>
+          </Group>
+        </Stack>
+      )}
+
+      {!draft && !doc && <Text size="sm" c="dimmed">No minutes yet.</Text>}
+      {!draft && doc && (
+        <Stack gap="md">
+          <Stack gap="xs">
+            <Title order={5}>Attendees</Title>
+            {doc.attendees.length === 0 ? <Text size="sm" c="dimmed">Nobody identified.</Text> : (
+              <Group gap="xs">{doc.attendees.map((a, i) => <Badge key={i} variant="light">{a.name || 'Unknown'}</Badge>)}</Group>
+            )}
+          </Stack>
+          <Stack gap="xs">
+            <Title order={5}>Agenda</Title>
+            {doc.agenda.length === 0 ? <Text size="sm" c="dimmed">None recorded.</Text> : (
+              <List type="ordered" size="sm">{doc.agenda.map((item, i) => <List.Item key={i}>{item}</List.Item>)}</List>
+            )}
+          </Stack>
+          <Stack gap="xs">
+            <Title order={5}>Decisions</Title>
+            {doc.decisions.length === 0 ? <Text size="sm" c="dimmed">None recorded.</Text> : (
+              <List size="sm">{doc.decisions.map((item, i) => <List.Item key={i}>{item}</List.Item>)}</List>
+            )}
+          </Stack>
+          <Stack gap="xs">
+            <Title order={5}>Action items</Title>
+            {doc.actionItems.length === 0 ? <Text size="sm" c="dimmed">None recorded.</Text> : (
+              <List size="sm">
+                {doc.actionItems.map((item, i) => (
+                  <List.Item key={i}>
+                    {item.text}
+                    {item.ownerName && <Text span size="sm" c="dimmed"> — {item.ownerName}</Text>}
+                    {item.dueAt && <Text span size="sm" c="dimmed">, due {new Date(item.dueAt).toLocaleDateString()}</Text>}
+                  </List.Item>
+                ))}
+              </List>
+            )}
+          </Stack>
+          {doc.notes && (
+            <Stack gap="xs">
+              <Title order={5}>Notes</Title>
+              <Text size="sm" style={{ whiteSpace: 'pre-wrap' }}>{doc.notes}</Text>
+            </Stack>
+          )}
+        </Stack>
+      )}
+    </Stack>
+  );
+}
@@ -0,0 +1,121 @@
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { ActionIcon, Badge, Blockquote, Button, Card, Checkbox, Group, Loader, Stack, Text, Title } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { Sparkles, Trash } from 'lucide-react';
+import { outcomesClient } from '../lib/client';
+import { OutcomeKind } from '../gen/secretary/v1/outcomes_pb';
+import type { Outcome } from '../gen/secretary/v1/outcomes_pb';
+import { Sentiment } from '../gen/secretary/v1/recordings_pb';
+import type { Recording } from '../gen/secretary/v1/recordings_pb';
+import type { User } from '../gen/secretary/v1/users_pb';
+import { UserAvatar, userName } from './UserAvatar';
+
+const sections = [
+  { kind: OutcomeKind.DECISION, title: 'Decisions', resolvable: false },
+  { kind: OutcomeKind.RISK, title: 'Risks', resolvable: true },
+  { kind: OutcomeKind.OPEN_QUESTION, title: 'Open questions', resolvable: true },
+];
+
+const sentiments: Record<Sentiment, { label: string; color: string } | null> = {
+  [Sentiment.UNSPECIFIED]: null,
+  [Sentiment.POSITIVE]: { label: 'Positive', color: 'green' },
+  [Sentiment.NEUTRAL]: { label: 'Neutral', color: 'gray' },
+  [Sentiment.NEGATIVE]: { label: 'Negative', color: 'red' },
+  [Sentiment.MIXED]: { label: 'Mixed', color: 'yellow' },
+};
+
+// RecordingOutcomes lists the decisions, risks and open questions of a
+// meeting and can extract them from its transcript.
+export function RecordingOutcomes({ recording, userMap }: { recording: Recording; userMap: Map<bigint, User> }) {
+  const queryClient = useQueryClient();
+  const queryKey = ['outcomes', 'recording', recording.id.toString()];
+  const { data: outcomes, isLoading } = useQuery({
+    queryKey,
+    queryFn: async () => (await outcomesClient.listOutcomes({ recordingId: recording.id })).outcomes,
+  });
+
+  const onError = (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' });
+  const refresh = () => {
+    queryClient.invalidateQueries({ queryKey });
+    queryClient.invalidateQueries({ queryKey: ['recording', recording.id.toString()] });
+  };
+
+  const extractMutation = useMutation({
+    mutationFn: async () => outcomesClient.extractOutcomes({ recordingId: recording.id }),
+    onSuccess: refresh,
+    onError,
+  });
+  const resolveMutation = useMutation({
+    mutationFn: async ({ outcome, resolved }: { outcome: Outcome; resolved: boolean }) =>
+      outcomesClient.updateOutcome({ id: outcome.id, text: outcome.text, ownerUserId: outcome.ownerUserId, resolved }),
+    onSuccess: refresh,
+    onError,
+  });
+  const deleteMutation = useMutation({
+    mutationFn: async (outcome: Outcome) => outcomesClient.deleteOutcome({ id: outcome.id }),
+    onSuccess: refresh,
+    onError,
+  });
+
+  if (isLoading) return <Loader />;
+  const sentiment = sentiments[recording.sentiment];
+
+  return (
+    <Stack>
+      <Group justify="space-between">
+        {sentiment ? <Badge color={sentiment.color} variant="light">Mood: {sentiment.label}</Badge> : <span />}
+        <Button
+          size="xs"
+          variant="light"
+          leftSection={<Sparkles size={14} />}
+          onClick={() => extractMutation.mutate()}
+          loading={extractMutation.isPending}
+          disabled={!recording.transcriptLineCount}
+        >
+          {outcomes?.some((o) => o.sourceKind === 'llm') ? 'Extract again' : 'Extract from transcript'}
+        </Button>
+      </Group>
+
+      {sections.map(({ kind, title, resolvable }) => {
+        const items = outcomes?.filter((o) => o.kind === kind) ?? [];
+        return (
+          <Stack key={kind} gap="xs">
+            <Title order={5}>{title}</Title>
+            {items.length === 0 && <Text size="sm" c="dimmed">None recorded.</Text>}
+            {items.map((outcome) => {
+              const owner = userMap.get(outcome.ownerUserId);
+              return (
+                <Card key={outcome.id} withBorder radius="md" padding="sm">
+                  <Group justify="space-between" align="start" wrap="nowrap">
+                    <Stack gap={4} style={{ flex: 1 }}>
+                      <Group gap="xs" wrap="nowrap" align="start">
+                        {resolvable && (
+                          <Checkbox
+                            checked={outcome.resolved}
+                            onChange={(e) => resolveMutation.mutate({ outcome, resolved: e.currentTarget.checked })}
+                            aria-label="Resolved"
+                          />
+                        )}
+                        <Text td={outcome.resolved ? 'line-through' : undefined}>{outcome.text}</Text>
+                      </Group>
+                      {outcome.quote && <Blockquote p="xs" fz="sm">{outcome.quote}</Blockquote>}
+                      {owner && (
+                        <Group gap={6}>
+                          <UserAvatar user={owner} size={16} />
+                          <Text size="xs" c="dimmed">{userName(owner)}</Text>
+                        </Group>
+                      )}
+                    </Stack>
+                    <ActionIcon variant="subtle" color="gray" onClick={() => deleteMutation.mutate(outcome)} aria-label="Delete">
+                      <Trash size={14} />
+                    </ActionIcon>
+                  </Group>
+                </Card>
+              );
+            })}
+          </Stack>
+        );
+      })}
+    </Stack>
+  );
+}
@@ -0,0 +1,50 @@
+import { useMutation, useQueryClient } from '@tanstack/react-query';
+import { Group, Text, TextInput } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { Archive } from 'lucide-react';
+import { recordingsClient } from '../lib/client';
+import { getUser } from '../lib/auth';
+import type { Recording } from '../gen/secretary/v1/recordings_pb';
+
+// RecordingRetention notes what the retention policy already removed and
+// lets admins keep a recording past the policy until a date.
+export function RecordingRetention({ recording }: { recording: Recording }) {
+  const queryClient = useQueryClient();
+  const isAdmin = getUser()?.role === 'admin';
+
+  const mutation = useMutation({
+    mutationFn: async (day: string) => recordingsClient.setRecordingRetention({
+      id: recording.id,
+      retainUntil: day ? new Date(`${day}T23:59:59`).toISOString() : '',
+    }),
+    onSuccess: () => queryClient.invalidateQueries({ queryKey: ['recording'] }),
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  const removed = [
+    recording.audioPurgedAt && `Audio removed ${new Date(recording.audioPurgedAt).toLocaleDateString()}`,
+    recording.transcriptPurgedAt && `Transcript removed ${new Date(recording.transcriptPurgedAt).toLocaleDateString()}`,
+  ].filter(Boolean);
+
+  if (!isAdmin && removed.length === 0 && !recording.retainUntil) return null;
+
+  return (
+    <Group gap="xs">
+      <Archive size={16} />
+      {removed.length > 0 && <Text size="sm">{removed.join(', ')}</Text>}
+      {isAdmin ? (
+        <TextInput
+          type="date"
+          size="xs"
+          aria-label="Keep until"
+          title="Keep audio and transcript until"
+          value={recording.retainUntil ? recording.retainUntil.slice(0, 10) : ''}
+          onChange={(e) => mutation.mutate(e.currentTarget.value)}
+          disabled={mutation.isPending}
+        />
+      ) : recording.retainUntil && (
+        <Text size="sm">Kept until {new Date(recording.retainUntil).toLocaleDateString()}</Text>
+      )}
+    </Group>
+  );
+}
@@ -0,0 +1,107 @@
+import { useState } from 'react';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { ActionIcon, Alert, Group, Loader, Stack, Switch, Table, Text, TextInput } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { Check } from 'lucide-react';
+import { adminClient } from '../lib/client';
+import type { ScheduledTask } from '../gen/secretary/v1/admin_pb';
+
+function formatTime(value: string): string {
+  return value ? new Date(value).toLocaleString() : '—';
+}
+
+function TaskRow({ task }: { task: ScheduledTask }) {
+  const queryClient = useQueryClient();
+  const [schedule, setSchedule] = useState(task.schedule || 'off');
+
+  const updateMutation = useMutation({
+    mutationFn: async (update: { schedule: string; paused: boolean }) =>
+      adminClient.updateScheduledTask({ name: task.name, ...update }),
+    onSuccess: (res) => {
+      setSchedule(res.task?.schedule || 'off');
+      queryClient.invalidateQueries({ queryKey: ['scheduledTasks'] });
+      queryClient.invalidateQueries({ queryKey: ['backups'] });
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  const current = task.schedule || 'off';
+  return (
+    <Table.Tr>
+      <Table.Td>
+        <Text size="xs" fw={600}>{task.name}</Text>
+        <Text size="xs" c="dimmed">{task.description}</Text>
+      </Table.Td>
+      <Table.Td>
+        <Group gap={4} wrap="nowrap">
+          <TextInput
+            size="xs"
+            value={schedule}
+            placeholder={task.defaultSchedule || 'off'}
+            onChange={(e) => setSchedule(e.currentTarget.value)}
+          />
+          {schedule !== current && (
+            <ActionIcon
+              variant="subtle"
+              size="sm"
+              aria-label="Save schedule"
+              loading={updateMutation.isPending}
+              onClick={() => updateMutation.mutate({ schedule: schedule.trim(), paused: task.paused })}
+            >
+              <Check size={14} />
+            </ActionIcon>
+          )}
+        </Group>
+      </Table.Td>
+      <Table.Td>{task.running ? 'Running' : formatTime(task.nextRunAt)}</Table.Td>
+      <Table.Td>
+        <Text size="xs">{formatTime(task.lastStartedAt)}</Text>
+        {task.lastError && <Text size="xs" c="red">{task.lastError}</Text>}
+      </Table.Td>
+      <Table.Td>
+        <Switch
+          size="xs"
+          checked={task.paused}
+          disabled={updateMutation.isPending}
+          onChange={(e) => updateMutation.mutate({ schedule: task.schedule === task.defaultSchedule ? '' : current, paused: e.currentTarget.checked })}
+        />
+      </Table.Td>
+    </Table.Tr>
+  );
+}
+
+// ScheduledTasks lets admins see when the server's periodic work last ran
+// and change, pause or turn off its schedules.
+export function ScheduledTasks() {
+  const { data, isLoading, error } = useQuery({
+    queryKey: ['scheduledTasks'],
+    queryFn: async () => adminClient.listScheduledTasks({}),
+    refetchInterval: 30_000,
+  });
+
+  if (isLoading) return <Loader size="sm" />;
+  if (error || !data) return <Alert color="red">Failed to load scheduled tasks: {error?.message}</Alert>;
+
+  return (
+    <Stack gap="xs">
+      <Table fz="xs">
+        <Table.Thead>
+          <Table.Tr>
+            <Table.Th>Task</Table.Th>
+            <Table.Th>Schedule</Table.Th>
+            <Table.Th>Next run</Table.Th>
+            <Table.Th>Last run</Table.Th>
+            <Table.Th>Paused</Table.Th>
+          </Table.Tr>
+        </Table.Thead>
+        <Table.Tbody>
+          {data.tasks.map((task) => <TaskRow key={task.name} task={task} />)}
+        </Table.Tbody>
+      </Table>
+      <Text size="xs" c="dimmed">
+        Schedules are cron expressions such as "0 3 * * *", descriptors such as "@daily" or "@every 1h", or "off".
+        Clear one to go back to the server's configuration.
+      </Text>
+    </Stack>
+  );
+}
@@ -0,0 +1,93 @@
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Alert, Loader, Select, Stack, Table, Text } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { adminClient } from '../lib/client';
+import type { ScimGroup } from '../gen/secretary/v1/admin_pb';
+import type { Workspace } from '../gen/secretary/v1/workspaces_pb';
+
+const ROLE_OPTIONS = [
+  { value: 'admin', label: 'Admin' },
+  { value: 'member', label: 'Member' },
+];
+
+function GroupRow({ group, workspaces }: { group: ScimGroup; workspaces: Workspace[] }) {
+  const queryClient = useQueryClient();
+  const mutation = useMutation({
+    mutationFn: async (mapping: { role: string; workspaceId: bigint }) =>
+      adminClient.updateScimGroupMapping({ groupId: group.id, ...mapping }),
+    onSuccess: () => queryClient.invalidateQueries({ queryKey: ['scimGroups'] }),
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  return (
+    <Table.Tr>
+      <Table.Td>
+        <Text size="xs" fw={600}>{group.displayName}</Text>
+        <Text size="xs" c="dimmed">{group.memberCount.toString()} members</Text>
+      </Table.Td>
+      <Table.Td>
+        <Select
+          size="xs"
+          placeholder="No role"
+          data={ROLE_OPTIONS}
+          value={group.role || null}
+          disabled={mutation.isPending}
+          onChange={(role) => mutation.mutate({ role: role ?? '', workspaceId: group.workspaceId })}
+          clearable
+        />
+      </Table.Td>
+      <Table.Td>
+        <Select
+          size="xs"
+          placeholder="No workspace"
+          data={workspaces.map((w) => ({ value: w.id.toString(), label: w.name }))}
+          value={group.workspaceId > 0n ? group.workspaceId.toString() : null}
+          disabled={mutation.isPending}
+          onChange={(id) => mutation.mutate({ role: group.role, workspaceId: id ? BigInt(id) : 0n })}
+          searchable
+          clearable
+        />
+      </Table.Td>
+    </Table.Tr>
+  );
+}
+
+// ScimGroups lets admins map the groups an identity provider pushes to a
+// role and a workspace for their members.
+export function ScimGroups() {
+  const { data, isLoading, error } = useQuery({
+    queryKey: ['scimGroups'],
+    queryFn: async () => adminClient.listScimGroups({}),
+  });
+
+  if (isLoading) return <Loader size="sm" />;
+  if (error || !data) return <Alert color="red">Failed to load SCIM groups: {error?.message}</Alert>;
+
+  return (
+    <Stack gap="xs">
+      {!data.enabled && (
+        <Text size="xs" c="dimmed">SCIM provisioning is off. Set SCIM_TOKEN on the server to let an identity provider connect.</Text>
+      )}
+      {data.groups.length === 0 ? (
+        <Text c="dimmed" size="sm">No groups pushed yet.</Text>
+      ) : (
+        <Table fz="xs">
+          <Table.Thead>
+            <Table.Tr>
+              <Table.Th>Group</Table.Th>
+              <Table.Th>Role</Table.Th>
+              <Table.Th>Workspace</Table.Th>
+            </Table.Tr>
+          </Table.Thead>
+          <Table.Tbody>
+            {data.groups.map((g) => <GroupRow key={g.id.toString()} group={g} workspaces={data.workspaces} />)}
+          </Table.Tbody>
+        </Table>
+      )}
+      <Text size="xs" c="dimmed">
+        Provisioned users get the highest role their groups grant, or the default role when none grants one, and join
+        the workspaces their groups map to.
+      </Text>
+    </Stack>
+  );
+}
@@ -0,0 +1,74 @@
+import { useState } from 'react';
+import { useMutation, useQueryClient } from '@tanstack/react-query';
+import { Button, NumberInput, Popover, Select, Stack, Text } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { Replace } from 'lucide-react';
+import { recordingsClient } from '../lib/client';
+import type { Recording } from '../gen/secretary/v1/recordings_pb';
+import type { User } from '../gen/secretary/v1/users_pb';
+
+// SpeakerRelabel fixes a speaker the transcription attributed to the wrong
+// person, handing all of their lines to a team member or guest.
+export function SpeakerRelabel({ recording, users }: { recording: Recording; users: User[] }) {
+  const queryClient = useQueryClient();
+  const [opened, setOpened] = useState(false);
+  const [speaker, setSpeaker] = useState<string | number>('');
+  const [target, setTarget] = useState<string | null>(null);
+
+  const mutation = useMutation({
+    mutationFn: async () => {
+      const [kind, id] = (target ?? '').split(':');
+      return recordingsClient.relabelSpeaker({
+        recordingId: recording.id,
+        speakerId: Number(speaker),
+        userId: kind === 'user' ? BigInt(id) : 0n,
+        guestId: kind === 'guest' ? BigInt(id) : 0n,
+      });
+    },
+    onSuccess: (res) => {
+      queryClient.invalidateQueries({ queryKey: ['recording'] });
+      queryClient.invalidateQueries({ queryKey: ['recordings'] });
+      queryClient.invalidateQueries({ queryKey: ['transcript'] });
+      const moved = res.relabeledLineCount;
+      notifications.show({
+        title: 'Speaker relabeled',
+        message: moved ? `${moved} transcript lines moved` : `Speaker ${speaker} updated`,
+        color: 'blue',
+      });
+      setOpened(false);
+      setSpeaker('');
+      setTarget(null);
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  const options = [
+    {
+      group: 'Team',
+      items: users.filter((u) => !u.deactivated).map((u) => ({ value: `user:${u.id}`, label: `${u.firstName} ${u.lastName}`.trim() })),
+    },
+    { group: 'Guests', items: recording.guests.map((g) => ({ value: `guest:${g.id}`, label: g.name })) },
+  ].filter((o) => o.items.length > 0);
+
+  return (
+    <Popover opened={opened} onChange={setOpened} width={280} position="bottom" withArrow trapFocus>
+      <Popover.Target>
+        <Button size="xs" variant="subtle" color="gray" leftSection={<Replace size={14} />} onClick={() => setOpened((o) => !o)}>
+          Fix speaker
+        </Button>
+      </Popover.Target>
+      <Popover.Dropdown>
+        <Stack gap="xs">
+          <NumberInput label="Speaker" description="e.g. 2 for Speaker 2" min={0} allowDecimal={false} value={speaker} onChange={setSpeaker} />
+          <Select label="Is actually" data={options} value={target} onChange={setTarget} searchable />
+          <Text size="xs" c="dimmed">
+            If they already speak under another number, the lines are moved to it.
+          </Text>
+          <Button size="xs" disabled={speaker === '' || !target} loading={mutation.isPending} onClick={() => mutation.mutate()}>
+            Relabel
+          </Button>
+        </Stack>
+      </Popover.Dropdown>
+    </Popover>
+  );
+}
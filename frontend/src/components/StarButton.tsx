@@ -0,0 +1,43 @@
+import { useMutation, useQueryClient } from '@tanstack/react-query';
+import { ActionIcon, Tooltip } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { Star } from 'lucide-react';
+import { recordingsClient, todosClient } from '../lib/client';
+
+// StarButton pins or unpins a recording or todo for the signed-in user.
+export function StarButton({ kind, id, starred }: { kind: 'recording' | 'todo'; id: bigint; starred: boolean }) {
+  const queryClient = useQueryClient();
+  const mutation = useMutation({
+    mutationFn: async () => {
+      if (kind === 'recording') {
+        return starred ? recordingsClient.unstarRecording({ id }) : recordingsClient.starRecording({ id });
+      }
+      return starred ? todosClient.unstarTodo({ id }) : todosClient.starTodo({ id });
+    },
+    onSuccess: () => {
+      if (kind === 'recording') {
+        queryClient.invalidateQueries({ queryKey: ['recordings'] });
+        queryClient.invalidateQueries({ queryKey: ['recording'] });
+      } else {
+        queryClient.invalidateQueries({ queryKey: ['todos'] });
+      }
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  return (
+    <Tooltip label={starred ? 'Unstar' : 'Star'}>
+      <ActionIcon
+        variant="subtle"
+        color={starred ? 'yellow' : 'gray'}
+        loading={mutation.isPending}
+        onClick={(e) => {
+          e.stopPropagation();
+          mutation.mutate();
+        }}
+      >
+        <Star size={16} fill={starred ? 'currentColor' : 'none'} />
+      </ActionIcon>
+    </Tooltip>
+  );
+}
@@ -0,0 +1,86 @@
+import { useQuery } from '@tanstack/react-query';
+import { Alert, Loader, SimpleGrid, Stack, Table, Text } from '@mantine/core';
+import { adminClient } from '../lib/client';
+
+function formatBytes(bytes: bigint): string {
+  const n = Number(bytes);
+  if (n >= 1 << 30) return `${(n / (1 << 30)).toFixed(1)} GB`;
+  return `${(n / (1 << 20)).toFixed(1)} MB`;
+}
+
+function Stat({ label, value, alert }: { label: string; value: string; alert?: boolean }) {
+  return (
+    <Stack gap={0}>
+      <Text size="xs" c="dimmed">{label}</Text>
+      <Text fw={600} c={alert ? 'red' : undefined}>{value}</Text>
+    </Stack>
+  );
+}
+
+// SystemHealth shows admins the instance's totals, background queues and
+// provider error rates, refreshed every half minute.
+export function SystemHealth() {
+  const { data, isLoading, error } = useQuery({
+    queryKey: ['systemStats'],
+    queryFn: async () => adminClient.getSystemStats({}),
+    refetchInterval: 30_000,
+  });
+
+  if (isLoading) return <Loader size="sm" />;
+  if (error || !data) return <Alert color="red">Failed to load system stats: {error?.message}</Alert>;
+
+  const storage = data.audioBytes + data.clipBytes + data.attachmentBytes;
+  return (
+    <Stack gap="xs">
+      <SimpleGrid cols={3}>
+        <Stat label="Active users" value={`${data.activeUsers} of ${data.users}`} />
+        <Stat label="Recordings" value={`${data.recordings} (${Math.round(Number(data.recordedSeconds) / 3600)} h)`} />
+        <Stat label="Storage" value={formatBytes(storage)} />
+        <Stat label="Failed recordings" value={String(data.failedRecordings)} alert={data.failedRecordings > 0n} />
+      </SimpleGrid>
+      <Table fz="xs">
+        <Table.Thead>
+          <Table.Tr>
+            <Table.Th>Queue</Table.Th>
+            <Table.Th>Waiting</Table.Th>
+            <Table.Th>Running</Table.Th>
+            <Table.Th>Failed (24 h)</Table.Th>
+          </Table.Tr>
+        </Table.Thead>
+        <Table.Tbody>
+          {data.queues.map((queue) => (
+            <Table.Tr key={queue.name}>
+              <Table.Td>{queue.name}</Table.Td>
+              <Table.Td>{String(queue.queued)}</Table.Td>
+              <Table.Td>{String(queue.running)}</Table.Td>
+              <Table.Td c={queue.failed > 0n ? 'red' : undefined}>{String(queue.failed)}</Table.Td>
+            </Table.Tr>
+          ))}
+        </Table.Tbody>
+      </Table>
+      {data.providers.length > 0 && (
+        <Table fz="xs">
+          <Table.Thead>
+            <Table.Tr>
+              <Table.Th>Provider</Table.Th>
+              <Table.Th>Calls</Table.Th>
+              <Table.Th>Errors</Table.Th>
+              <Table.Th>Rate limited</Table.Th>
+            </Table.Tr>
+          </Table.Thead>
+          <Table.Tbody>
+            {data.providers.map((provider) => (
+              <Table.Tr key={`${provider.kind}/${provider.provider}`}>
+                <Table.Td>{provider.provider} ({provider.kind})</Table.Td>
+                <Table.Td>{String(provider.requests)}</Table.Td>
+                <Table.Td c={provider.errorRate > 0.1 ? 'red' : undefined}>{(provider.errorRate * 100).toFixed(1)}%</Table.Td>
+                <Table.Td>{String(provider.rateLimited)}</Table.Td>
+              </Table.Tr>
+            ))}
+          </Table.Tbody>
+        </Table>
+      )}
+      <Text size="xs" c="dimmed">Provider calls are counted since the server last started.</Text>
+    </Stack>
+  );
+}
@@ -0,0 +1,75 @@
+import { useState } from 'react';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { ActionIcon, Button, Group, Loader, Stack, Text, Textarea } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { Trash } from 'lucide-react';
+import { todosClient } from '../lib/client';
+import { getUser } from '../lib/auth';
+import { ReactionBar } from './ReactionBar';
+
+// TodoComments is the discussion under a todo. Writing "@Name" mentions a
+// user: the server links the name and tells them.
+export function TodoComments({ todoId, userNames }: { todoId: bigint; userNames: Map<bigint, string> }) {
+  const queryClient = useQueryClient();
+  const queryKey = ['todoComments', todoId.toString()];
+  const [body, setBody] = useState('');
+  const user = getUser();
+
+  const { data, isLoading } = useQuery({
+    queryKey,
+    queryFn: async () => todosClient.listTodoComments({ todoId }),
+  });
+
+  const createMutation = useMutation({
+    mutationFn: async () => todosClient.createTodoComment({ todoId, body }),
+    onSuccess: () => {
+      setBody('');
+      queryClient.invalidateQueries({ queryKey });
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  const deleteMutation = useMutation({
+    mutationFn: async (id: bigint) => todosClient.deleteTodoComment({ id }),
+    onSuccess: () => queryClient.invalidateQueries({ queryKey }),
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  return (
+    <Stack gap="xs">
+      {isLoading && <Loader size="sm" />}
+      {data?.comments.map((c) => (
+        <Stack key={c.id.toString()} gap={2}>
+          <Group justify="space-between" wrap="nowrap">
+            <Text size="xs" c="dimmed">
+              {userNames.get(c.authorUserId) || 'Unknown'} · {new Date(c.createdAt).toLocaleString()}
+              {c.updatedAt !== c.createdAt && ' (edited)'}
+            </Text>
+            {(user?.role === 'admin' || BigInt(user?.id ?? 0) === c.authorUserId) && (
+              <ActionIcon size="sm" variant="subtle" color="gray" onClick={() => deleteMutation.mutate(c.id)}>
+                <Trash size={12} />
+              </ActionIcon>
+            )}
+          </Group>
+          <Text size="sm" style={{ whiteSpace: 'pre-wrap' }}>{c.body}</Text>
+          {c.mentionedUserIds.length > 0 && (
+            <Text size="xs" c="dimmed">
+              Mentioned: {c.mentionedUserIds.map((id) => userNames.get(id) || 'Unknown').join(', ')}
+            </Text>
+          )}
+          <ReactionBar target={{ commentId: c.id }} reactions={c.reactions} userNames={userNames} />
+        </Stack>
+      ))}
+      <Textarea
+        placeholder="Add a comment; @Name mentions someone"
+        autosize
+        minRows={2}
+        value={body}
+        onChange={(e) => setBody(e.currentTarget.value)}
+      />
+      <Button size="xs" w="fit-content" disabled={!body.trim()} loading={createMutation.isPending} onClick={() => createMutation.mutate()}>
+        Comment
+      </Button>
+    </Stack>
+  );
+}
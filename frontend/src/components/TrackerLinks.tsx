@@ -0,0 +1,72 @@
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Anchor, Badge, Button, Group, Loader, Menu, Stack, Text } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { ExternalLink, Send } from 'lucide-react';
+import { todosClient } from '../lib/client';
+import { Tracker } from '../gen/secretary/v1/todos_pb';
+
+const TRACKER_LABELS: Record<Tracker, string> = {
+  [Tracker.UNSPECIFIED]: 'Tracker',
+  [Tracker.GITHUB]: 'GitHub',
+  [Tracker.LINEAR]: 'Linear',
+  [Tracker.JIRA]: 'Jira',
+};
+
+// TrackerLinks lists the issues filed for a todo in external trackers and
+// files new ones. The trackers only show up once the server has
+// credentials for them.
+export function TrackerLinks({ todoId }: { todoId: bigint }) {
+  const queryClient = useQueryClient();
+  const queryKey = ['trackerLinks', todoId.toString()];
+
+  const { data, isLoading } = useQuery({
+    queryKey,
+    queryFn: async () => todosClient.listTrackerLinks({ todoId }),
+  });
+
+  const exportMutation = useMutation({
+    mutationFn: async (tracker: Tracker) => todosClient.exportToTracker({ todoId, tracker }),
+    onSuccess: (res) => {
+      queryClient.invalidateQueries({ queryKey });
+      if (res.link) notifications.show({ title: 'Issue created', message: `${TRACKER_LABELS[res.link.tracker]} ${res.link.externalKey}`, color: 'green' });
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  if (isLoading) return <Loader size="sm" />;
+  if (!data || (data.links.length === 0 && data.availableTrackers.length === 0)) return null;
+
+  const linked = new Set(data.links.map((link) => link.tracker));
+  const remaining = data.availableTrackers.filter((tracker) => !linked.has(tracker));
+
+  return (
+    <Stack gap="xs">
+      {data.links.map((link) => (
+        <Group key={link.id} gap="xs" wrap="nowrap">
+          <ExternalLink size={14} />
+          <Anchor href={link.url} target="_blank" rel="noreferrer" size="sm">
+            {TRACKER_LABELS[link.tracker]} {link.externalKey}
+          </Anchor>
+          {link.externalState && <Badge size="xs" variant="light" color="gray">{link.externalState}</Badge>}
+          {link.syncedAt && <Text size="xs" c="dimmed">synced {new Date(link.syncedAt).toLocaleString()}</Text>}
+        </Group>
+      ))}
+      {remaining.length > 0 && (
+        <Menu position="bottom-start">
+          <Menu.Target>
+            <Button size="xs" variant="light" leftSection={<Send size={14} />} loading={exportMutation.isPending} w="fit-content">
+              Send to tracker
+            </Button>
+          </Menu.Target>
+          <Menu.Dropdown>
+            {remaining.map((tracker) => (
+              <Menu.Item key={tracker} onClick={() => exportMutation.mutate(tracker)}>
+                {TRACKER_LABELS[tracker]}
+              </Menu.Item>
+            ))}
+          </Menu.Dropdown>
+        </Menu>
+      )}
+    </Stack>
+  );
+}
@@ -0,0 +1,41 @@
+import { useInfiniteQuery } from '@tanstack/react-query';
+import { Button, Loader, Stack, Text } from '@mantine/core';
+import { recordingsClient } from '../lib/client';
+import type { Recording } from '../gen/secretary/v1/recordings_pb';
+import { TranscriptText } from './TranscriptText';
+
+const PAGE_LINES = 200;
+
+// TranscriptPages loads a transcript, or one of its translations, a page
+// at a time so long meetings open quickly.
+export function TranscriptPages({ recording, language }: { recording: Recording; language: string }) {
+  const { data, isLoading, fetchNextPage, hasNextPage, isFetchingNextPage } = useInfiniteQuery({
+    queryKey: ['transcript', recording.id.toString(), language],
+    queryFn: async ({ pageParam }) =>
+      recordingsClient.listTranscriptSegments({ recordingId: recording.id, language, limit: PAGE_LINES, pageToken: pageParam }),
+    initialPageParam: '',
+    getNextPageParam: (last) => last.nextPageToken || undefined,
+  });
+
+  if (isLoading) return <Loader size="sm" />;
+  const lines = data?.pages.flatMap((p) => p.lines) ?? [];
+  const total = data?.pages[0]?.lineCount ?? 0;
+  // Pages load from the first line, so line indexes match the
+  // confidence scores of the original.
+  const text = lines.map((l) => (l.speakerId >= 0 ? `Speaker ${l.speakerId}: ${l.text}` : l.text)).join('\n');
+
+  return (
+    <Stack gap="xs">
+      {language ? (
+        <Text style={{ whiteSpace: 'pre-wrap' }}>{text}</Text>
+      ) : (
+        <TranscriptText transcript={text} segments={recording.transcriptSegments} />
+      )}
+      {hasNextPage && (
+        <Button variant="subtle" size="xs" loading={isFetchingNextPage} onClick={() => fetchNextPage()}>
+          Show more ({lines.length} of {total} lines)
+        </Button>
+      )}
+    </Stack>
+  );
+}
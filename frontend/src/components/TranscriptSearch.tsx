@@ -0,0 +1,72 @@
+import { Fragment, useState } from 'react';
+import type { RefObject } from 'react';
+import { useQuery } from '@tanstack/react-query';
+import { Anchor, Divider, Group, Loader, Stack, Text, TextInput } from '@mantine/core';
+import { useDebouncedValue } from '@mantine/hooks';
+import { Search } from 'lucide-react';
+import { recordingsClient } from '../lib/client';
+import { formatOffset } from '../lib/offsets';
+import type { Recording } from '../gen/secretary/v1/recordings_pb';
+
+const CONTEXT_LINES = 1;
+
+// TranscriptSearch finds text in the transcript on the server, so long
+// meetings can be searched without scrolling. Clicking a line's time seeks
+// the audio player there.
+export function TranscriptSearch({ recording, audioRef }: { recording: Recording; audioRef: RefObject<HTMLAudioElement> }) {
+  const [query, setQuery] = useState('');
+  const [debounced] = useDebouncedValue(query.trim(), 300);
+
+  const { data, isFetching } = useQuery({
+    queryKey: ['transcript-search', recording.id.toString(), debounced],
+    queryFn: async () => recordingsClient.getRecordingTranscript({ id: recording.id, query: debounced, contextLines: CONTEXT_LINES }),
+    enabled: debounced !== '',
+  });
+
+  const speakers = new Map<number, string>();
+  recording.participants.forEach((p) => speakers.set(p.speakerId, `${p.firstName} ${p.lastName}`.trim()));
+  recording.guests.forEach((g) => g.speakerId >= 0 && speakers.set(g.speakerId, g.name));
+  const speakerName = (id: number) => (id < 0 ? '' : speakers.get(id) ?? `Speaker ${id}`);
+
+  const seek = (ms: number) => {
+    const audio = audioRef.current;
+    if (!audio) return;
+    audio.currentTime = ms / 1000;
+    audio.play();
+  };
+
+  const lines = debounced && data ? data.lines : [];
+  return (
+    <Stack gap="xs" mb="md">
+      <TextInput
+        placeholder="Find in transcript"
+        leftSection={<Search size={14} />}
+        rightSection={isFetching ? <Loader size="xs" /> : null}
+        value={query}
+        onChange={(e) => setQuery(e.currentTarget.value)}
+      />
+      {debounced && data && (
+        <Text size="xs" c="dimmed">
+          {data.matchCount === 1 ? '1 match' : `${data.matchCount} matches`}
+          {data.matchCount > lines.filter((l) => l.match).length && ', showing the first ones'}
+        </Text>
+      )}
+      {lines.map((line, i) => (
+        <Fragment key={line.index}>
+          {i > 0 && line.index !== lines[i - 1].index + 1 && <Divider variant="dashed" />}
+          <Group gap="xs" wrap="nowrap" align="flex-start">
+            {recording.duration > 0 && (
+              <Anchor size="sm" onClick={() => seek(line.startMs)} style={{ whiteSpace: 'nowrap' }}>
+                {formatOffset(line.startMs)}
+              </Anchor>
+            )}
+            <Text size="sm" c={line.match ? undefined : 'dimmed'} fw={line.match ? 500 : undefined}>
+              {speakerName(line.speakerId) && <Text span fw={600}>{speakerName(line.speakerId)}: </Text>}
+              {line.text}
+            </Text>
+          </Group>
+        </Fragment>
+      ))}
+    </Stack>
+  );
+}
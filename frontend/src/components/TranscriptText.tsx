@@ -0,0 +1,42 @@
+import { Alert, Mark, Stack, Text, Tooltip } from '@mantine/core';
+import { AlertTriangle } from 'lucide-react';
+import type { TranscriptSegment } from '../gen/secretary/v1/recordings_pb';
+
+interface TranscriptTextProps {
+  transcript: string;
+  segments: TranscriptSegment[];
+}
+
+// TranscriptText shows a transcript with the lines the speech-to-text
+// provider was unsure of highlighted, so reviewers know what to check
+// against the audio.
+export function TranscriptText({ transcript, segments }: TranscriptTextProps) {
+  const flagged = new Map(segments.filter((s) => s.lowConfidence).map((s) => [s.index, s.confidence]));
+  if (flagged.size === 0) return <Text style={{ whiteSpace: 'pre-wrap' }}>{transcript}</Text>;
+
+  return (
+    <Stack gap="xs">
+      <Alert variant="light" color="yellow" icon={<AlertTriangle size={16} />}>
+        {flagged.size === 1 ? '1 line was' : `${flagged.size} lines were`} transcribed with low confidence. Check the
+        highlighted lines against the audio.
+      </Alert>
+      <Text style={{ whiteSpace: 'pre-wrap' }}>
+        {transcript.split('\n').map((line, i) => {
+          const confidence = flagged.get(i);
+          return (
+            <span key={i}>
+              {confidence === undefined ? (
+                line
+              ) : (
+                <Tooltip label={`${Math.round(confidence * 100)}% confidence`}>
+                  <Mark color="yellow">{line}</Mark>
+                </Tooltip>
+              )}
+              {'\n'}
+            </span>
+          );
+        })}
+      </Text>
+    </Stack>
+  );
+}
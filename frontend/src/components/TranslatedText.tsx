@@ -0,0 +1,84 @@
+import { useState } from 'react';
+import type { ReactNode } from 'react';
+import { Button, Group, Select, Stack, Text } from '@mantine/core';
+import { Languages } from 'lucide-react';
+import { TranslationKind } from '../gen/secretary/v1/recordings_pb';
+import type { Recording } from '../gen/secretary/v1/recordings_pb';
+import { getLocale } from '../lib/auth';
+import { TranscriptText } from './TranscriptText';
+
+// Offered even before anything has been translated into them; stored
+// translations in other languages are listed too.
+const commonLanguages = ['en', 'es', 'de', 'fr', 'pt', 'it', 'nl', 'ja', 'zh'];
+
+function languageName(tag: string): string {
+  try {
+    return new Intl.DisplayNames([navigator.language], { type: 'language' }).of(tag) ?? tag;
+  } catch {
+    return tag;
+  }
+}
+
+interface TranslatedTextProps {
+  recording: Recording;
+  kind: TranslationKind;
+  original: string;
+  empty: string;
+  translating: boolean;
+  onTranslate: (language: string) => void;
+  // Renders the text in a language, '' for the original, for text that
+  // isn't loaded with the recording.
+  pages?: (language: string) => ReactNode;
+}
+
+// TranslatedText shows a summary or transcript in its original language or
+// one of its stored translations, and asks for missing ones.
+export function TranslatedText({ recording, kind, original, empty, translating, onTranslate, pages }: TranslatedTextProps) {
+  const [language, setLanguage] = useState('');
+  const stored = recording.translations.filter((t) => t.kind === kind);
+  const preferred = getLocale();
+  const tags = [...new Set([...(preferred ? [preferred] : []), ...stored.map((t) => t.language), ...commonLanguages])];
+  const translation = stored.find((t) => t.language === language);
+
+  if (!original && !pages) return <Text c="dimmed">{empty}</Text>;
+
+  return (
+    <Stack>
+      <Group justify="flex-end">
+        <Select
+          size="xs"
+          w={200}
+          leftSection={<Languages size={14} />}
+          data={[{ value: '', label: 'Original' }, ...tags.map((tag) => ({ value: tag, label: languageName(tag) }))]}
+          value={language}
+          onChange={(value) => setLanguage(value ?? '')}
+          allowDeselect={false}
+          searchable
+        />
+        {language && (
+          <Button size="xs" variant="light" onClick={() => onTranslate(language)} loading={translating}>
+            {translation ? 'Translate again' : 'Translate'}
+          </Button>
+        )}
+      </Group>
+      {!language ? (
+        pages ? (
+          pages('')
+        ) : kind === TranslationKind.TRANSCRIPT ? (
+          <TranscriptText transcript={original} segments={recording.transcriptSegments} />
+        ) : (
+          <Text style={{ whiteSpace: 'pre-wrap' }}>{original}</Text>
+        )
+      ) : translation ? (
+        <>
+          {pages ? pages(language) : <Text style={{ whiteSpace: 'pre-wrap' }}>{translation.text}</Text>}
+          <Text size="xs" c="dimmed">
+            Translated by {translation.provider} on {new Date(translation.updatedAt).toLocaleString()}
+          </Text>
+        </>
+      ) : (
+        <Text c="dimmed">Not translated into {languageName(language)} yet.</Text>
+      )}
+    </Stack>
+  );
+}
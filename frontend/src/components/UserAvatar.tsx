@@ -0,0 +1,31 @@
+import { Avatar, type MantineSize } from '@mantine/core';
+import { apiUrl } from '../lib/client';
+
+interface AvatarUser {
+  firstName: string;
+  lastName: string;
+  avatarUrl: string;
+}
+
+export function userName(user?: AvatarUser): string {
+  return user ? `${user.firstName} ${user.lastName}`.trim() : 'Unknown';
+}
+
+// Avatars are rendered server-side at a few sizes; ask for the one that is
+// sharp at twice the display size.
+const pixels: Record<string, number> = { xs: 16, sm: 26, md: 38, lg: 56, xl: 84 };
+
+export function UserAvatar({ user, size = 'sm' }: { user: AvatarUser; size?: MantineSize | number }) {
+  const px = typeof size === 'number' ? size : pixels[size] ?? 38;
+  const initials = `${user.firstName.charAt(0)}${user.lastName.charAt(0)}`.toUpperCase();
+  return (
+    <Avatar
+      src={user.avatarUrl ? apiUrl(`${user.avatarUrl}?size=${px * 2}`) : undefined}
+      alt={userName(user)}
+      size={size}
+      radius="xl"
+    >
+      {initials}
+    </Avatar>
+  );
+}
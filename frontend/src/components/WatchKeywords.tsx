@@ -0,0 +1,87 @@
+import { useState } from 'react';
+import { Link } from 'react-router-dom';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Anchor, Button, Group, Pill, Stack, Text, TextInput, Title } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { keywordsClient } from '../lib/client';
+
+const RECENT_ALERTS = 10;
+
+// WatchKeywords lets the signed-in user pick words to listen for in new
+// meetings, and shows the latest transcript lines that said them.
+export function WatchKeywords() {
+  const queryClient = useQueryClient();
+  const [keyword, setKeyword] = useState('');
+
+  const { data: keywords } = useQuery({
+    queryKey: ['watch-keywords'],
+    queryFn: async () => (await keywordsClient.listWatchKeywords({})).keywords,
+  });
+  const { data: alerts } = useQuery({
+    queryKey: ['keyword-alerts'],
+    queryFn: async () => (await keywordsClient.listKeywordAlerts({ limit: RECENT_ALERTS })).alerts,
+  });
+
+  const onError = (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' });
+  const createMutation = useMutation({
+    mutationFn: async () => keywordsClient.createWatchKeyword({ keyword }),
+    onSuccess: () => {
+      setKeyword('');
+      queryClient.invalidateQueries({ queryKey: ['watch-keywords'] });
+    },
+    onError,
+  });
+  const deleteMutation = useMutation({
+    mutationFn: async (id: bigint) => keywordsClient.deleteWatchKeyword({ id }),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['watch-keywords'] });
+      queryClient.invalidateQueries({ queryKey: ['keyword-alerts'] });
+    },
+    onError,
+  });
+
+  return (
+    <Stack gap="xs">
+      <Title order={4}>Watch keywords</Title>
+      <Text size="sm" c="dimmed">
+        Get an alert when a new meeting mentions one of these, e.g. a project or a competitor.
+      </Text>
+      <Group gap={6}>
+        {keywords?.map((k) => (
+          <Pill key={String(k.id)} withRemoveButton onRemove={() => deleteMutation.mutate(k.id)}>
+            {k.keyword}
+          </Pill>
+        ))}
+      </Group>
+      <Group align="end">
+        <TextInput
+          style={{ flex: 1 }}
+          placeholder="budget"
+          maxLength={100}
+          value={keyword}
+          onChange={(e) => setKeyword(e.currentTarget.value)}
+          onKeyDown={(e) => e.key === 'Enter' && keyword.trim() && createMutation.mutate()}
+        />
+        <Button variant="light" disabled={!keyword.trim()} loading={createMutation.isPending} onClick={() => createMutation.mutate()}>
+          Watch
+        </Button>
+      </Group>
+      {alerts && alerts.length > 0 && (
+        <>
+          <Text size="sm" fw={500} mt="xs">Recent mentions</Text>
+          {alerts.map((a) => (
+            <Text key={String(a.id)} size="sm">
+              <Text span fw={600}>{a.keyword}</Text>
+              {' in '}
+              <Anchor component={Link} to={`/recordings/${a.recordingId}`} size="sm">
+                {a.recordingName || 'Untitled Meeting'}
+              </Anchor>
+              {': '}
+              <Text span c="dimmed">{a.segmentText}</Text>
+            </Text>
+          ))}
+        </>
+      )}
+    </Stack>
+  );
+}
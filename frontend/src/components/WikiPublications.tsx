@@ -0,0 +1,68 @@
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Anchor, Button, Group, Menu, Text } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { BookOpen, ExternalLink } from 'lucide-react';
+import { recordingsClient } from '../lib/client';
+import { WikiTarget } from '../gen/secretary/v1/recordings_pb';
+
+const WIKI_LABELS: Record<WikiTarget, string> = {
+  [WikiTarget.UNSPECIFIED]: 'Wiki',
+  [WikiTarget.NOTION]: 'Notion',
+  [WikiTarget.CONFLUENCE]: 'Confluence',
+};
+
+// WikiPublications links to the recording's pages in Notion or Confluence
+// and publishes the summary and minutes there. Publishing again replaces
+// the page. Nothing shows until the server has a wiki configured.
+export function WikiPublications({ recordingId }: { recordingId: bigint }) {
+  const queryClient = useQueryClient();
+  const queryKey = ['publications', recordingId.toString()];
+
+  const { data } = useQuery({
+    queryKey,
+    queryFn: async () => recordingsClient.listPublications({ recordingId }),
+  });
+
+  const publishMutation = useMutation({
+    mutationFn: async (target: WikiTarget) => recordingsClient.publishRecording({ recordingId, target }),
+    onSuccess: (res) => {
+      queryClient.invalidateQueries({ queryKey });
+      if (res.publication) notifications.show({ title: 'Published', message: `Sent to ${WIKI_LABELS[res.publication.target]}`, color: 'green' });
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  if (!data || (data.publications.length === 0 && data.availableTargets.length === 0)) return null;
+
+  const published = new Map(data.publications.map((p) => [p.target, p]));
+
+  return (
+    <Group gap="xs">
+      {data.publications.map((p) => (
+        <Group key={p.id} gap={4}>
+          <ExternalLink size={14} />
+          <Anchor href={p.url} target="_blank" rel="noreferrer" size="sm">
+            {WIKI_LABELS[p.target]}
+          </Anchor>
+          <Text size="xs">updated {new Date(p.updatedAt).toLocaleDateString()}</Text>
+        </Group>
+      ))}
+      {data.availableTargets.length > 0 && (
+        <Menu position="bottom-start">
+          <Menu.Target>
+            <Button size="xs" variant="subtle" leftSection={<BookOpen size={14} />} loading={publishMutation.isPending}>
+              Publish
+            </Button>
+          </Menu.Target>
+          <Menu.Dropdown>
+            {data.availableTargets.map((target) => (
+              <Menu.Item key={target} onClick={() => publishMutation.mutate(target)}>
+                {published.has(target) ? `Update in ${WIKI_LABELS[target]}` : `Publish to ${WIKI_LABELS[target]}`}
+              </Menu.Item>
+            ))}
+          </Menu.Dropdown>
+        </Menu>
+      )}
+    </Group>
+  );
+}
@@ -0,0 +1,29 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts"
+// @generated from file secretary/v1/activity_feed.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ListActivityRequest, ListActivityResponse } from "./activity_feed_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * The team timeline, as opposed to ActivitiesService which tracks a
+ * user's own logged activities.
+ *
+ * @generated from service secretary.v1.ActivityService
+ */
+export const ActivityService = {
+  typeName: "secretary.v1.ActivityService",
+  methods: {
+    /**
+     * @generated from rpc secretary.v1.ActivityService.ListActivity
+     */
+    listActivity: {
+      name: "ListActivity",
+      I: ListActivityRequest,
+      O: ListActivityResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+  }
+} as const;
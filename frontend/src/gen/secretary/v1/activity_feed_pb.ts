@@ -0,0 +1,354 @@
+// @generated by protoc-gen-es v1.10.1 with parameter "target=ts"
+// @generated from file secretary/v1/activity_feed.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { RecordingStatus } from "./recordings_pb.js";
+import { TodoStatus } from "./todos_pb.js";
+import { OutcomeKind } from "./outcomes_pb.js";
+
+/**
+ * @generated from enum secretary.v1.ActivityKind
+ */
+export enum ActivityKind {
+  /**
+   * @generated from enum value: ACTIVITY_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * A recording moved between processing states.
+   *
+   * @generated from enum value: ACTIVITY_KIND_RECORDING_STATUS = 1;
+   */
+  RECORDING_STATUS = 1,
+
+  /**
+   * A todo was created, edited or deleted.
+   *
+   * @generated from enum value: ACTIVITY_KIND_TODO_CHANGE = 2;
+   */
+  TODO_CHANGE = 2,
+
+  /**
+   * A decision, risk or open question was recorded for a meeting.
+   *
+   * @generated from enum value: ACTIVITY_KIND_OUTCOME = 3;
+   */
+  OUTCOME = 3,
+
+  /**
+   * Someone commented on a todo.
+   *
+   * @generated from enum value: ACTIVITY_KIND_COMMENT = 4;
+   */
+  COMMENT = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ActivityKind)
+proto3.util.setEnumType(ActivityKind, "secretary.v1.ActivityKind", [
+  { no: 0, name: "ACTIVITY_KIND_UNSPECIFIED" },
+  { no: 1, name: "ACTIVITY_KIND_RECORDING_STATUS" },
+  { no: 2, name: "ACTIVITY_KIND_TODO_CHANGE" },
+  { no: 3, name: "ACTIVITY_KIND_OUTCOME" },
+  { no: 4, name: "ACTIVITY_KIND_COMMENT" },
+]);
+
+/**
+ * One entry of the team timeline. Which fields are set depends on kind.
+ *
+ * @generated from message secretary.v1.ActivityEvent
+ */
+export class ActivityEvent extends Message<ActivityEvent> {
+  /**
+   * @generated from field: secretary.v1.ActivityKind kind = 1;
+   */
+  kind = ActivityKind.UNSPECIFIED;
+
+  /**
+   * Unique within a kind.
+   *
+   * @generated from field: int64 id = 2;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string occurred_at = 3;
+   */
+  occurredAt = "";
+
+  /**
+   * Who made the change; 0 for the processing pipeline or an unknown actor.
+   *
+   * @generated from field: int64 actor_user_id = 4;
+   */
+  actorUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 recording_id = 5;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: string recording_name = 6;
+   */
+  recordingName = "";
+
+  /**
+   * ACTIVITY_KIND_RECORDING_STATUS
+   *
+   * @generated from field: secretary.v1.RecordingStatus from_status = 7;
+   */
+  fromStatus = RecordingStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: secretary.v1.RecordingStatus to_status = 8;
+   */
+  toStatus = RecordingStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: string error = 9;
+   */
+  error = "";
+
+  /**
+   * ACTIVITY_KIND_TODO_CHANGE
+   *
+   * @generated from field: int64 todo_id = 10;
+   */
+  todoId = protoInt64.zero;
+
+  /**
+   * "create", "update" or "delete".
+   *
+   * @generated from field: string change_type = 11;
+   */
+  changeType = "";
+
+  /**
+   * @generated from field: string todo_name = 12;
+   */
+  todoName = "";
+
+  /**
+   * @generated from field: secretary.v1.TodoStatus todo_status = 13;
+   */
+  todoStatus = TodoStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: int64 assignee_user_id = 14;
+   */
+  assigneeUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 workspace_id = 15;
+   */
+  workspaceId = protoInt64.zero;
+
+  /**
+   * ACTIVITY_KIND_OUTCOME
+   *
+   * @generated from field: secretary.v1.OutcomeKind outcome_kind = 16;
+   */
+  outcomeKind = OutcomeKind.UNSPECIFIED;
+
+  /**
+   * @generated from field: string outcome_text = 17;
+   */
+  outcomeText = "";
+
+  /**
+   * @generated from field: int64 owner_user_id = 18;
+   */
+  ownerUserId = protoInt64.zero;
+
+  /**
+   * ACTIVITY_KIND_COMMENT, with todo_id, todo_name and workspace_id.
+   *
+   * @generated from field: int64 comment_id = 19;
+   */
+  commentId = protoInt64.zero;
+
+  /**
+   * @generated from field: string comment_text = 20;
+   */
+  commentText = "";
+
+  /**
+   * @generated from field: repeated int64 mentioned_user_ids = 21;
+   */
+  mentionedUserIds: bigint[] = [];
+
+  constructor(data?: PartialMessage<ActivityEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ActivityEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(ActivityKind) },
+    { no: 2, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "occurred_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "actor_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "recording_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "from_status", kind: "enum", T: proto3.getEnumType(RecordingStatus) },
+    { no: 8, name: "to_status", kind: "enum", T: proto3.getEnumType(RecordingStatus) },
+    { no: 9, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "change_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "todo_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "todo_status", kind: "enum", T: proto3.getEnumType(TodoStatus) },
+    { no: 14, name: "assignee_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 15, name: "workspace_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 16, name: "outcome_kind", kind: "enum", T: proto3.getEnumType(OutcomeKind) },
+    { no: 17, name: "outcome_text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 18, name: "owner_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 19, name: "comment_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 20, name: "comment_text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 21, name: "mentioned_user_ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ActivityEvent {
+    return new ActivityEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ActivityEvent {
+    return new ActivityEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ActivityEvent {
+    return new ActivityEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ActivityEvent | PlainMessage<ActivityEvent> | undefined, b: ActivityEvent | PlainMessage<ActivityEvent> | undefined): boolean {
+    return proto3.util.equals(ActivityEvent, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListActivityRequest
+ */
+export class ListActivityRequest extends Message<ListActivityRequest> {
+  /**
+   * Events the user took part in: recordings they created or spoke in,
+   * todos they changed or own, outcomes they recorded or own, comments
+   * they wrote, were mentioned in or left on their todos.
+   *
+   * @generated from field: int64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  /**
+   * Only todo changes and comments belong to a workspace, so setting it
+   * leaves out the other kinds.
+   *
+   * @generated from field: int64 workspace_id = 2;
+   */
+  workspaceId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 recording_id = 3;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * Every kind when empty.
+   *
+   * @generated from field: repeated secretary.v1.ActivityKind kinds = 4;
+   */
+  kinds: ActivityKind[] = [];
+
+  /**
+   * @generated from field: int32 limit = 5;
+   */
+  limit = 0;
+
+  /**
+   * The next_page_token of a previous response, to continue after it.
+   *
+   * @generated from field: string page_token = 6;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListActivityRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListActivityRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "workspace_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "kinds", kind: "enum", T: proto3.getEnumType(ActivityKind), repeated: true },
+    { no: 5, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListActivityRequest {
+    return new ListActivityRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListActivityRequest {
+    return new ListActivityRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListActivityRequest {
+    return new ListActivityRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListActivityRequest | PlainMessage<ListActivityRequest> | undefined, b: ListActivityRequest | PlainMessage<ListActivityRequest> | undefined): boolean {
+    return proto3.util.equals(ListActivityRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListActivityResponse
+ */
+export class ListActivityResponse extends Message<ListActivityResponse> {
+  /**
+   * Newest first.
+   *
+   * @generated from field: repeated secretary.v1.ActivityEvent events = 1;
+   */
+  events: ActivityEvent[] = [];
+
+  /**
+   * Empty on the last page.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListActivityResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListActivityResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "events", kind: "message", T: ActivityEvent, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListActivityResponse {
+    return new ListActivityResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListActivityResponse {
+    return new ListActivityResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListActivityResponse {
+    return new ListActivityResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListActivityResponse | PlainMessage<ListActivityResponse> | undefined, b: ListActivityResponse | PlainMessage<ListActivityResponse> | undefined): boolean {
+    return proto3.util.equals(ListActivityResponse, a, b);
+  }
+}
@@ -0,0 +1,220 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts"
+// @generated from file secretary/v1/admin.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ExportInstanceRequest, ExportInstanceResponse, ImportInstanceRequest, ImportInstanceResponse, ListBackupsRequest, ListBackupsResponse, GetSystemStatsRequest, GetSystemStatsResponse, ListScheduledTasksRequest, ListScheduledTasksResponse, UpdateScheduledTaskRequest, UpdateScheduledTaskResponse, ListFeatureFlagsRequest, ListFeatureFlagsResponse, UpdateFeatureFlagRequest, UpdateFeatureFlagResponse, SetUserFeatureFlagRequest, SetUserFeatureFlagResponse, GetMaintenanceModeRequest, GetMaintenanceModeResponse, SetMaintenanceModeRequest, SetMaintenanceModeResponse, ListAuditLogRequest, ListAuditLogResponse, VerifyAuditLogRequest, VerifyAuditLogResponse, ListScimGroupsRequest, ListScimGroupsResponse, UpdateScimGroupMappingRequest, UpdateScimGroupMappingResponse, GetIpAllowlistRequest, GetIpAllowlistResponse, SetIpAllowlistRequest, SetIpAllowlistResponse } from "./admin_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * Running the instance: moving it to another server, backups, its health,
+ * the work it schedules, its experimental features, maintenance mode,
+ * the audit log, the groups identity providers push and the networks the
+ * API is open to. Admin only.
+ *
+ * @generated from service secretary.v1.AdminService
+ */
+export const AdminService = {
+  typeName: "secretary.v1.AdminService",
+  methods: {
+    /**
+     * Writes an archive of a consistent snapshot of the database and the
+     * files its rows point at, leaving out the audit log. Encrypted
+     * transcripts and audio stay encrypted.
+     *
+     * @generated from rpc secretary.v1.AdminService.ExportInstance
+     */
+    exportInstance: {
+      name: "ExportInstance",
+      I: ExportInstanceRequest,
+      O: ExportInstanceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Replaces all data but the audit log with the archive's. The archive must come from a
+     * server on the same schema or an older one, and when it holds encrypted
+     * data, this server needs the master key it was encrypted under. Tokens
+     * issued before the import still carry the replaced users' IDs: change
+     * JWT_SECRET afterwards unless this server was new.
+     *
+     * @generated from rpc secretary.v1.AdminService.ImportInstance
+     */
+    importInstance: {
+      name: "ImportInstance",
+      I: ImportInstanceRequest,
+      O: ImportInstanceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Lists the scheduled backups in storage and how the schedule is doing.
+     *
+     * @generated from rpc secretary.v1.AdminService.ListBackups
+     */
+    listBackups: {
+      name: "ListBackups",
+      I: ListBackupsRequest,
+      O: ListBackupsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Counts users, recordings and stored bytes, the background work
+     * waiting or failing, and how the providers are doing.
+     *
+     * @generated from rpc secretary.v1.AdminService.GetSystemStats
+     */
+    getSystemStats: {
+      name: "GetSystemStats",
+      I: GetSystemStatsRequest,
+      O: GetSystemStatsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Lists the scheduled tasks with when they last ran and next run.
+     *
+     * @generated from rpc secretary.v1.AdminService.ListScheduledTasks
+     */
+    listScheduledTasks: {
+      name: "ListScheduledTasks",
+      I: ListScheduledTasksRequest,
+      O: ListScheduledTasksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Changes when a task runs, for every server process. Processes pick
+     * the change up within a minute.
+     *
+     * @generated from rpc secretary.v1.AdminService.UpdateScheduledTask
+     */
+    updateScheduledTask: {
+      name: "UpdateScheduledTask",
+      I: UpdateScheduledTaskRequest,
+      O: UpdateScheduledTaskResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Lists the feature flags with the organization's setting and the
+     * users with overrides.
+     *
+     * @generated from rpc secretary.v1.AdminService.ListFeatureFlags
+     */
+    listFeatureFlags: {
+      name: "ListFeatureFlags",
+      I: ListFeatureFlagsRequest,
+      O: ListFeatureFlagsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Turns a flag on or off for the whole organization. Users with an
+     * override keep it.
+     *
+     * @generated from rpc secretary.v1.AdminService.UpdateFeatureFlag
+     */
+    updateFeatureFlag: {
+      name: "UpdateFeatureFlag",
+      I: UpdateFeatureFlagRequest,
+      O: UpdateFeatureFlagResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Turns a flag on or off for one user, whatever the organization's
+     * setting.
+     *
+     * @generated from rpc secretary.v1.AdminService.SetUserFeatureFlag
+     */
+    setUserFeatureFlag: {
+      name: "SetUserFeatureFlag",
+      I: SetUserFeatureFlagRequest,
+      O: SetUserFeatureFlagResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.AdminService.GetMaintenanceMode
+     */
+    getMaintenanceMode: {
+      name: "GetMaintenanceMode",
+      I: GetMaintenanceModeRequest,
+      O: GetMaintenanceModeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Turns maintenance mode on or off, or changes its message, for every
+     * server process. Admins keep full access meanwhile.
+     *
+     * @generated from rpc secretary.v1.AdminService.SetMaintenanceMode
+     */
+    setMaintenanceMode: {
+      name: "SetMaintenanceMode",
+      I: SetMaintenanceModeRequest,
+      O: SetMaintenanceModeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.AdminService.ListAuditLog
+     */
+    listAuditLog: {
+      name: "ListAuditLog",
+      I: ListAuditLogRequest,
+      O: ListAuditLogResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Recomputes every entry's hash from its contents and the entry before
+     * it, finding entries changed, removed or inserted since they were
+     * written.
+     *
+     * @generated from rpc secretary.v1.AdminService.VerifyAuditLog
+     */
+    verifyAuditLog: {
+      name: "VerifyAuditLog",
+      I: VerifyAuditLogRequest,
+      O: VerifyAuditLogResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * @generated from rpc secretary.v1.AdminService.ListScimGroups
+     */
+    listScimGroups: {
+      name: "ListScimGroups",
+      I: ListScimGroupsRequest,
+      O: ListScimGroupsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Changes the role and workspace a group grants, and applies the change
+     * to the group's members right away.
+     *
+     * @generated from rpc secretary.v1.AdminService.UpdateScimGroupMapping
+     */
+    updateScimGroupMapping: {
+      name: "UpdateScimGroupMapping",
+      I: UpdateScimGroupMappingRequest,
+      O: UpdateScimGroupMappingResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.AdminService.GetIpAllowlist
+     */
+    getIpAllowlist: {
+      name: "GetIpAllowlist",
+      I: GetIpAllowlistRequest,
+      O: GetIpAllowlistResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Limits API access to callers from the listed ranges, for every server
+     * process. An empty list lifts the limit.
+     *
+     * @generated from rpc secretary.v1.AdminService.SetIpAllowlist
+     */
+    setIpAllowlist: {
+      name: "SetIpAllowlist",
+      I: SetIpAllowlistRequest,
+      O: SetIpAllowlistResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
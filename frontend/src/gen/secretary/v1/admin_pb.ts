@@ -0,0 +1,2285 @@
+// @generated by protoc-gen-es v1.10.1 with parameter "target=ts"
+// @generated from file secretary/v1/admin.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { Workspace } from "./workspaces_pb.js";
+
+/**
+ * @generated from message secretary.v1.ArchivedTable
+ */
+export class ArchivedTable extends Message<ArchivedTable> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: int64 rows = 2;
+   */
+  rows = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ArchivedTable>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ArchivedTable";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rows", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArchivedTable {
+    return new ArchivedTable().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArchivedTable {
+    return new ArchivedTable().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArchivedTable {
+    return new ArchivedTable().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArchivedTable | PlainMessage<ArchivedTable> | undefined, b: ArchivedTable | PlainMessage<ArchivedTable> | undefined): boolean {
+    return proto3.util.equals(ArchivedTable, a, b);
+  }
+}
+
+/**
+ * A gzipped tarball of every table and stored file, kept in the server's
+ * storage. Download it with GET /api/admin/archives/{name}, and upload it
+ * to another server with PUT on the same path.
+ *
+ * @generated from message secretary.v1.InstanceArchive
+ */
+export class InstanceArchive extends Message<InstanceArchive> {
+  /**
+   * e.g. secretary-20261018T120000Z.tar.gz
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: int64 size_bytes = 2;
+   */
+  sizeBytes = protoInt64.zero;
+
+  /**
+   * When the export was taken.
+   *
+   * @generated from field: string created_at = 3;
+   */
+  createdAt = "";
+
+  /**
+   * Parents first, the order they are restored in.
+   *
+   * @generated from field: repeated secretary.v1.ArchivedTable tables = 4;
+   */
+  tables: ArchivedTable[] = [];
+
+  /**
+   * The stored files included: audio, avatars, attachments and the logo.
+   *
+   * @generated from field: int32 media_count = 5;
+   */
+  mediaCount = 0;
+
+  constructor(data?: PartialMessage<InstanceArchive>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.InstanceArchive";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "tables", kind: "message", T: ArchivedTable, repeated: true },
+    { no: 5, name: "media_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InstanceArchive {
+    return new InstanceArchive().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InstanceArchive {
+    return new InstanceArchive().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InstanceArchive {
+    return new InstanceArchive().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InstanceArchive | PlainMessage<InstanceArchive> | undefined, b: InstanceArchive | PlainMessage<InstanceArchive> | undefined): boolean {
+    return proto3.util.equals(InstanceArchive, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ExportInstanceRequest
+ */
+export class ExportInstanceRequest extends Message<ExportInstanceRequest> {
+  constructor(data?: PartialMessage<ExportInstanceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ExportInstanceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportInstanceRequest {
+    return new ExportInstanceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportInstanceRequest {
+    return new ExportInstanceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportInstanceRequest {
+    return new ExportInstanceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportInstanceRequest | PlainMessage<ExportInstanceRequest> | undefined, b: ExportInstanceRequest | PlainMessage<ExportInstanceRequest> | undefined): boolean {
+    return proto3.util.equals(ExportInstanceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ExportInstanceResponse
+ */
+export class ExportInstanceResponse extends Message<ExportInstanceResponse> {
+  /**
+   * @generated from field: secretary.v1.InstanceArchive archive = 1;
+   */
+  archive?: InstanceArchive;
+
+  constructor(data?: PartialMessage<ExportInstanceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ExportInstanceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "archive", kind: "message", T: InstanceArchive },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportInstanceResponse {
+    return new ExportInstanceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportInstanceResponse {
+    return new ExportInstanceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportInstanceResponse {
+    return new ExportInstanceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportInstanceResponse | PlainMessage<ExportInstanceResponse> | undefined, b: ExportInstanceResponse | PlainMessage<ExportInstanceResponse> | undefined): boolean {
+    return proto3.util.equals(ExportInstanceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ImportInstanceRequest
+ */
+export class ImportInstanceRequest extends Message<ImportInstanceRequest> {
+  /**
+   * An archive in this server's storage: one exported here, or one
+   * uploaded with PUT /api/admin/archives/{name}.
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Must be set: every user, recording and setting on this server is
+   * replaced by the archive's.
+   *
+   * @generated from field: bool replace_all_data = 2;
+   */
+  replaceAllData = false;
+
+  constructor(data?: PartialMessage<ImportInstanceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ImportInstanceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "replace_all_data", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportInstanceRequest {
+    return new ImportInstanceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportInstanceRequest {
+    return new ImportInstanceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportInstanceRequest {
+    return new ImportInstanceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportInstanceRequest | PlainMessage<ImportInstanceRequest> | undefined, b: ImportInstanceRequest | PlainMessage<ImportInstanceRequest> | undefined): boolean {
+    return proto3.util.equals(ImportInstanceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ImportInstanceResponse
+ */
+export class ImportInstanceResponse extends Message<ImportInstanceResponse> {
+  /**
+   * @generated from field: secretary.v1.InstanceArchive archive = 1;
+   */
+  archive?: InstanceArchive;
+
+  constructor(data?: PartialMessage<ImportInstanceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ImportInstanceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "archive", kind: "message", T: InstanceArchive },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportInstanceResponse {
+    return new ImportInstanceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportInstanceResponse {
+    return new ImportInstanceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportInstanceResponse {
+    return new ImportInstanceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportInstanceResponse | PlainMessage<ImportInstanceResponse> | undefined, b: ImportInstanceResponse | PlainMessage<ImportInstanceResponse> | undefined): boolean {
+    return proto3.util.equals(ImportInstanceResponse, a, b);
+  }
+}
+
+/**
+ * An archive written by the backup schedule.
+ *
+ * @generated from message secretary.v1.Backup
+ */
+export class Backup extends Message<Backup> {
+  /**
+   * e.g. backup-20261018T120000Z.tar.gz; download and import it like any
+   * other instance archive.
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: int64 size_bytes = 2;
+   */
+  sizeBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 3;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<Backup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.Backup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Backup {
+    return new Backup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Backup {
+    return new Backup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Backup {
+    return new Backup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Backup | PlainMessage<Backup> | undefined, b: Backup | PlainMessage<Backup> | undefined): boolean {
+    return proto3.util.equals(Backup, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListBackupsRequest
+ */
+export class ListBackupsRequest extends Message<ListBackupsRequest> {
+  constructor(data?: PartialMessage<ListBackupsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListBackupsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBackupsRequest {
+    return new ListBackupsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBackupsRequest {
+    return new ListBackupsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBackupsRequest {
+    return new ListBackupsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListBackupsRequest | PlainMessage<ListBackupsRequest> | undefined, b: ListBackupsRequest | PlainMessage<ListBackupsRequest> | undefined): boolean {
+    return proto3.util.equals(ListBackupsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListBackupsResponse
+ */
+export class ListBackupsResponse extends Message<ListBackupsResponse> {
+  /**
+   * Newest first.
+   *
+   * @generated from field: repeated secretary.v1.Backup backups = 1;
+   */
+  backups: Backup[] = [];
+
+  /**
+   * The interval of an "@every" schedule; zero when backups are off or
+   * run on a cron expression.
+   *
+   * @generated from field: int64 interval_seconds = 2;
+   */
+  intervalSeconds = protoInt64.zero;
+
+  /**
+   * How many backups are kept; older ones are deleted after each backup.
+   *
+   * @generated from field: int32 retain = 3;
+   */
+  retain = 0;
+
+  /**
+   * When the last scheduled backup was attempted and when one last
+   * succeeded, by this server process since it started. Empty when none
+   * was. ListScheduledTasks reports runs by any process.
+   *
+   * @generated from field: string last_attempt_at = 4;
+   */
+  lastAttemptAt = "";
+
+  /**
+   * @generated from field: string last_success_at = 5;
+   */
+  lastSuccessAt = "";
+
+  /**
+   * Why the last attempt failed; empty when it succeeded.
+   *
+   * @generated from field: string last_error = 6;
+   */
+  lastError = "";
+
+  /**
+   * What backups run on; empty when they are off.
+   *
+   * @generated from field: string schedule = 7;
+   */
+  schedule = "";
+
+  constructor(data?: PartialMessage<ListBackupsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListBackupsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "backups", kind: "message", T: Backup, repeated: true },
+    { no: 2, name: "interval_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "retain", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "last_attempt_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "last_success_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "last_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "schedule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBackupsResponse {
+    return new ListBackupsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBackupsResponse {
+    return new ListBackupsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBackupsResponse {
+    return new ListBackupsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListBackupsResponse | PlainMessage<ListBackupsResponse> | undefined, b: ListBackupsResponse | PlainMessage<ListBackupsResponse> | undefined): boolean {
+    return proto3.util.equals(ListBackupsResponse, a, b);
+  }
+}
+
+/**
+ * Background work of one kind.
+ *
+ * @generated from message secretary.v1.JobQueue
+ */
+export class JobQueue extends Message<JobQueue> {
+  /**
+   * transcription, summarization, ai or scan.
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Waiting to be picked up.
+   *
+   * @generated from field: int64 queued = 2;
+   */
+  queued = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 running = 3;
+   */
+  running = protoInt64.zero;
+
+  /**
+   * Failed in the last 24 hours.
+   *
+   * @generated from field: int64 failed = 4;
+   */
+  failed = protoInt64.zero;
+
+  constructor(data?: PartialMessage<JobQueue>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.JobQueue";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "queued", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "running", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "failed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobQueue {
+    return new JobQueue().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobQueue {
+    return new JobQueue().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobQueue {
+    return new JobQueue().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobQueue | PlainMessage<JobQueue> | undefined, b: JobQueue | PlainMessage<JobQueue> | undefined): boolean {
+    return proto3.util.equals(JobQueue, a, b);
+  }
+}
+
+/**
+ * Calls this server made to one transcription or summarization provider
+ * since it started.
+ *
+ * @generated from message secretary.v1.ProviderHealth
+ */
+export class ProviderHealth extends Message<ProviderHealth> {
+  /**
+   * @generated from field: string provider = 1;
+   */
+  provider = "";
+
+  /**
+   * transcription or summarization.
+   *
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: int64 requests = 3;
+   */
+  requests = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 failures = 4;
+   */
+  failures = protoInt64.zero;
+
+  /**
+   * Calls skipped because the provider's rate limit was reached.
+   *
+   * @generated from field: int64 rate_limited = 5;
+   */
+  rateLimited = protoInt64.zero;
+
+  /**
+   * failures / requests, 0 without requests.
+   *
+   * @generated from field: double error_rate = 6;
+   */
+  errorRate = 0;
+
+  constructor(data?: PartialMessage<ProviderHealth>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ProviderHealth";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "requests", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "failures", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "rate_limited", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "error_rate", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProviderHealth {
+    return new ProviderHealth().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProviderHealth {
+    return new ProviderHealth().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProviderHealth {
+    return new ProviderHealth().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProviderHealth | PlainMessage<ProviderHealth> | undefined, b: ProviderHealth | PlainMessage<ProviderHealth> | undefined): boolean {
+    return proto3.util.equals(ProviderHealth, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetSystemStatsRequest
+ */
+export class GetSystemStatsRequest extends Message<GetSystemStatsRequest> {
+  constructor(data?: PartialMessage<GetSystemStatsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetSystemStatsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSystemStatsRequest {
+    return new GetSystemStatsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSystemStatsRequest {
+    return new GetSystemStatsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSystemStatsRequest {
+    return new GetSystemStatsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSystemStatsRequest | PlainMessage<GetSystemStatsRequest> | undefined, b: GetSystemStatsRequest | PlainMessage<GetSystemStatsRequest> | undefined): boolean {
+    return proto3.util.equals(GetSystemStatsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetSystemStatsResponse
+ */
+export class GetSystemStatsResponse extends Message<GetSystemStatsResponse> {
+  /**
+   * Every account, deactivated ones included.
+   *
+   * @generated from field: int64 users = 1;
+   */
+  users = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 active_users = 2;
+   */
+  activeUsers = protoInt64.zero;
+
+  /**
+   * Active admins.
+   *
+   * @generated from field: int64 admins = 3;
+   */
+  admins = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 recordings = 4;
+   */
+  recordings = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 recorded_seconds = 5;
+   */
+  recordedSeconds = protoInt64.zero;
+
+  /**
+   * Recordings whose processing failed and hasn't been retried or purged.
+   *
+   * @generated from field: int64 failed_recordings = 6;
+   */
+  failedRecordings = protoInt64.zero;
+
+  /**
+   * Bytes in storage. Audio shared by cloned recordings counts once.
+   *
+   * @generated from field: int64 audio_bytes = 7;
+   */
+  audioBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 clip_bytes = 8;
+   */
+  clipBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 attachment_bytes = 9;
+   */
+  attachmentBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated secretary.v1.JobQueue queues = 10;
+   */
+  queues: JobQueue[] = [];
+
+  /**
+   * Transcription first, each kind in priority order.
+   *
+   * @generated from field: repeated secretary.v1.ProviderHealth providers = 11;
+   */
+  providers: ProviderHealth[] = [];
+
+  constructor(data?: PartialMessage<GetSystemStatsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetSystemStatsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "users", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "active_users", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "admins", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "recordings", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "recorded_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "failed_recordings", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "audio_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "clip_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "attachment_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "queues", kind: "message", T: JobQueue, repeated: true },
+    { no: 11, name: "providers", kind: "message", T: ProviderHealth, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSystemStatsResponse {
+    return new GetSystemStatsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSystemStatsResponse {
+    return new GetSystemStatsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSystemStatsResponse {
+    return new GetSystemStatsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSystemStatsResponse | PlainMessage<GetSystemStatsResponse> | undefined, b: GetSystemStatsResponse | PlainMessage<GetSystemStatsResponse> | undefined): boolean {
+    return proto3.util.equals(GetSystemStatsResponse, a, b);
+  }
+}
+
+/**
+ * Periodic work the server runs: retention purges, backups, tracker syncs
+ * and digest emails. Every server process keeps the schedule, and each run
+ * happens on one of them.
+ *
+ * @generated from message secretary.v1.ScheduledTask
+ */
+export class ScheduledTask extends Message<ScheduledTask> {
+  /**
+   * e.g. backup or daily-digest.
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description = "";
+
+  /**
+   * A cron expression or descriptor, e.g. "0 3 * * *", "@daily" or
+   * "@every 1h", in the server's time zone. Empty when the task is off.
+   *
+   * @generated from field: string schedule = 3;
+   */
+  schedule = "";
+
+  /**
+   * What the server's configuration schedules; schedule differs when an
+   * admin changed it.
+   *
+   * @generated from field: string default_schedule = 4;
+   */
+  defaultSchedule = "";
+
+  /**
+   * @generated from field: bool paused = 5;
+   */
+  paused = false;
+
+  /**
+   * When the task next runs; empty when it is off or paused.
+   *
+   * @generated from field: string next_run_at = 6;
+   */
+  nextRunAt = "";
+
+  /**
+   * The last run, on any process. Empty until the task first runs.
+   *
+   * @generated from field: string last_started_at = 7;
+   */
+  lastStartedAt = "";
+
+  /**
+   * @generated from field: string last_finished_at = 8;
+   */
+  lastFinishedAt = "";
+
+  /**
+   * @generated from field: string last_succeeded_at = 9;
+   */
+  lastSucceededAt = "";
+
+  /**
+   * Why the last run failed; empty when it succeeded.
+   *
+   * @generated from field: string last_error = 10;
+   */
+  lastError = "";
+
+  /**
+   * A run has started and not finished. A process that stopped mid-run
+   * leaves this set until the next run.
+   *
+   * @generated from field: bool running = 11;
+   */
+  running = false;
+
+  constructor(data?: PartialMessage<ScheduledTask>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ScheduledTask";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "schedule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "default_schedule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "paused", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "next_run_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "last_started_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "last_finished_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "last_succeeded_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "last_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "running", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScheduledTask {
+    return new ScheduledTask().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScheduledTask {
+    return new ScheduledTask().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScheduledTask {
+    return new ScheduledTask().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScheduledTask | PlainMessage<ScheduledTask> | undefined, b: ScheduledTask | PlainMessage<ScheduledTask> | undefined): boolean {
+    return proto3.util.equals(ScheduledTask, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListScheduledTasksRequest
+ */
+export class ListScheduledTasksRequest extends Message<ListScheduledTasksRequest> {
+  constructor(data?: PartialMessage<ListScheduledTasksRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListScheduledTasksRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListScheduledTasksRequest {
+    return new ListScheduledTasksRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListScheduledTasksRequest {
+    return new ListScheduledTasksRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListScheduledTasksRequest {
+    return new ListScheduledTasksRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListScheduledTasksRequest | PlainMessage<ListScheduledTasksRequest> | undefined, b: ListScheduledTasksRequest | PlainMessage<ListScheduledTasksRequest> | undefined): boolean {
+    return proto3.util.equals(ListScheduledTasksRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListScheduledTasksResponse
+ */
+export class ListScheduledTasksResponse extends Message<ListScheduledTasksResponse> {
+  /**
+   * @generated from field: repeated secretary.v1.ScheduledTask tasks = 1;
+   */
+  tasks: ScheduledTask[] = [];
+
+  constructor(data?: PartialMessage<ListScheduledTasksResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListScheduledTasksResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tasks", kind: "message", T: ScheduledTask, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListScheduledTasksResponse {
+    return new ListScheduledTasksResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListScheduledTasksResponse {
+    return new ListScheduledTasksResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListScheduledTasksResponse {
+    return new ListScheduledTasksResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListScheduledTasksResponse | PlainMessage<ListScheduledTasksResponse> | undefined, b: ListScheduledTasksResponse | PlainMessage<ListScheduledTasksResponse> | undefined): boolean {
+    return proto3.util.equals(ListScheduledTasksResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateScheduledTaskRequest
+ */
+export class UpdateScheduledTaskRequest extends Message<UpdateScheduledTaskRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Empty goes back to the configured schedule; "off" turns the task off.
+   *
+   * @generated from field: string schedule = 2;
+   */
+  schedule = "";
+
+  /**
+   * Skips runs until unpaused, without forgetting the schedule.
+   *
+   * @generated from field: bool paused = 3;
+   */
+  paused = false;
+
+  constructor(data?: PartialMessage<UpdateScheduledTaskRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateScheduledTaskRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "schedule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "paused", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateScheduledTaskRequest {
+    return new UpdateScheduledTaskRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateScheduledTaskRequest {
+    return new UpdateScheduledTaskRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateScheduledTaskRequest {
+    return new UpdateScheduledTaskRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateScheduledTaskRequest | PlainMessage<UpdateScheduledTaskRequest> | undefined, b: UpdateScheduledTaskRequest | PlainMessage<UpdateScheduledTaskRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateScheduledTaskRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateScheduledTaskResponse
+ */
+export class UpdateScheduledTaskResponse extends Message<UpdateScheduledTaskResponse> {
+  /**
+   * @generated from field: secretary.v1.ScheduledTask task = 1;
+   */
+  task?: ScheduledTask;
+
+  constructor(data?: PartialMessage<UpdateScheduledTaskResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateScheduledTaskResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "task", kind: "message", T: ScheduledTask },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateScheduledTaskResponse {
+    return new UpdateScheduledTaskResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateScheduledTaskResponse {
+    return new UpdateScheduledTaskResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateScheduledTaskResponse {
+    return new UpdateScheduledTaskResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateScheduledTaskResponse | PlainMessage<UpdateScheduledTaskResponse> | undefined, b: UpdateScheduledTaskResponse | PlainMessage<UpdateScheduledTaskResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateScheduledTaskResponse, a, b);
+  }
+}
+
+/**
+ * An experimental feature that can be turned on for the whole
+ * organization or for particular users before it is ready for everyone.
+ *
+ * @generated from message secretary.v1.FeatureFlag
+ */
+export class FeatureFlag extends Message<FeatureFlag> {
+  /**
+   * e.g. semantic-search.
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description = "";
+
+  /**
+   * On for users without an override.
+   *
+   * @generated from field: bool enabled = 3;
+   */
+  enabled = false;
+
+  /**
+   * @generated from field: repeated secretary.v1.FeatureFlagOverride overrides = 4;
+   */
+  overrides: FeatureFlagOverride[] = [];
+
+  /**
+   * Empty until an admin first changes the flag.
+   *
+   * @generated from field: string updated_at = 5;
+   */
+  updatedAt = "";
+
+  constructor(data?: PartialMessage<FeatureFlag>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.FeatureFlag";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "overrides", kind: "message", T: FeatureFlagOverride, repeated: true },
+    { no: 5, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FeatureFlag {
+    return new FeatureFlag().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FeatureFlag {
+    return new FeatureFlag().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FeatureFlag {
+    return new FeatureFlag().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FeatureFlag | PlainMessage<FeatureFlag> | undefined, b: FeatureFlag | PlainMessage<FeatureFlag> | undefined): boolean {
+    return proto3.util.equals(FeatureFlag, a, b);
+  }
+}
+
+/**
+ * A user for whom a flag differs from the organization's setting.
+ *
+ * @generated from message secretary.v1.FeatureFlagOverride
+ */
+export class FeatureFlagOverride extends Message<FeatureFlagOverride> {
+  /**
+   * @generated from field: int64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled = false;
+
+  constructor(data?: PartialMessage<FeatureFlagOverride>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.FeatureFlagOverride";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FeatureFlagOverride {
+    return new FeatureFlagOverride().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FeatureFlagOverride {
+    return new FeatureFlagOverride().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FeatureFlagOverride {
+    return new FeatureFlagOverride().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FeatureFlagOverride | PlainMessage<FeatureFlagOverride> | undefined, b: FeatureFlagOverride | PlainMessage<FeatureFlagOverride> | undefined): boolean {
+    return proto3.util.equals(FeatureFlagOverride, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListFeatureFlagsRequest
+ */
+export class ListFeatureFlagsRequest extends Message<ListFeatureFlagsRequest> {
+  constructor(data?: PartialMessage<ListFeatureFlagsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListFeatureFlagsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFeatureFlagsRequest {
+    return new ListFeatureFlagsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFeatureFlagsRequest {
+    return new ListFeatureFlagsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFeatureFlagsRequest {
+    return new ListFeatureFlagsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListFeatureFlagsRequest | PlainMessage<ListFeatureFlagsRequest> | undefined, b: ListFeatureFlagsRequest | PlainMessage<ListFeatureFlagsRequest> | undefined): boolean {
+    return proto3.util.equals(ListFeatureFlagsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListFeatureFlagsResponse
+ */
+export class ListFeatureFlagsResponse extends Message<ListFeatureFlagsResponse> {
+  /**
+   * @generated from field: repeated secretary.v1.FeatureFlag flags = 1;
+   */
+  flags: FeatureFlag[] = [];
+
+  constructor(data?: PartialMessage<ListFeatureFlagsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListFeatureFlagsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "flags", kind: "message", T: FeatureFlag, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFeatureFlagsResponse {
+    return new ListFeatureFlagsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFeatureFlagsResponse {
+    return new ListFeatureFlagsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFeatureFlagsResponse {
+    return new ListFeatureFlagsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListFeatureFlagsResponse | PlainMessage<ListFeatureFlagsResponse> | undefined, b: ListFeatureFlagsResponse | PlainMessage<ListFeatureFlagsResponse> | undefined): boolean {
+    return proto3.util.equals(ListFeatureFlagsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateFeatureFlagRequest
+ */
+export class UpdateFeatureFlagRequest extends Message<UpdateFeatureFlagRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled = false;
+
+  constructor(data?: PartialMessage<UpdateFeatureFlagRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateFeatureFlagRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateFeatureFlagRequest {
+    return new UpdateFeatureFlagRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateFeatureFlagRequest {
+    return new UpdateFeatureFlagRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateFeatureFlagRequest {
+    return new UpdateFeatureFlagRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateFeatureFlagRequest | PlainMessage<UpdateFeatureFlagRequest> | undefined, b: UpdateFeatureFlagRequest | PlainMessage<UpdateFeatureFlagRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateFeatureFlagRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateFeatureFlagResponse
+ */
+export class UpdateFeatureFlagResponse extends Message<UpdateFeatureFlagResponse> {
+  /**
+   * @generated from field: secretary.v1.FeatureFlag flag = 1;
+   */
+  flag?: FeatureFlag;
+
+  constructor(data?: PartialMessage<UpdateFeatureFlagResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateFeatureFlagResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "flag", kind: "message", T: FeatureFlag },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateFeatureFlagResponse {
+    return new UpdateFeatureFlagResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateFeatureFlagResponse {
+    return new UpdateFeatureFlagResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateFeatureFlagResponse {
+    return new UpdateFeatureFlagResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateFeatureFlagResponse | PlainMessage<UpdateFeatureFlagResponse> | undefined, b: UpdateFeatureFlagResponse | PlainMessage<UpdateFeatureFlagResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateFeatureFlagResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SetUserFeatureFlagRequest
+ */
+export class SetUserFeatureFlagRequest extends Message<SetUserFeatureFlagRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: int64 user_id = 2;
+   */
+  userId = protoInt64.zero;
+
+  /**
+   * Unset removes the override, so the user follows the organization's
+   * setting again.
+   *
+   * @generated from field: optional bool enabled = 3;
+   */
+  enabled?: boolean;
+
+  constructor(data?: PartialMessage<SetUserFeatureFlagRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SetUserFeatureFlagRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetUserFeatureFlagRequest {
+    return new SetUserFeatureFlagRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetUserFeatureFlagRequest {
+    return new SetUserFeatureFlagRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetUserFeatureFlagRequest {
+    return new SetUserFeatureFlagRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetUserFeatureFlagRequest | PlainMessage<SetUserFeatureFlagRequest> | undefined, b: SetUserFeatureFlagRequest | PlainMessage<SetUserFeatureFlagRequest> | undefined): boolean {
+    return proto3.util.equals(SetUserFeatureFlagRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SetUserFeatureFlagResponse
+ */
+export class SetUserFeatureFlagResponse extends Message<SetUserFeatureFlagResponse> {
+  /**
+   * @generated from field: secretary.v1.FeatureFlag flag = 1;
+   */
+  flag?: FeatureFlag;
+
+  constructor(data?: PartialMessage<SetUserFeatureFlagResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SetUserFeatureFlagResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "flag", kind: "message", T: FeatureFlag },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetUserFeatureFlagResponse {
+    return new SetUserFeatureFlagResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetUserFeatureFlagResponse {
+    return new SetUserFeatureFlagResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetUserFeatureFlagResponse {
+    return new SetUserFeatureFlagResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetUserFeatureFlagResponse | PlainMessage<SetUserFeatureFlagResponse> | undefined, b: SetUserFeatureFlagResponse | PlainMessage<SetUserFeatureFlagResponse> | undefined): boolean {
+    return proto3.util.equals(SetUserFeatureFlagResponse, a, b);
+  }
+}
+
+/**
+ * While maintenance mode is on, the server answers everyone but admins
+ * with 503 Service Unavailable and the message, e.g. during a migration
+ * or a provider outage.
+ *
+ * @generated from message secretary.v1.MaintenanceMode
+ */
+export class MaintenanceMode extends Message<MaintenanceMode> {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled = false;
+
+  /**
+   * Shown to users; empty shows a default.
+   *
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  /**
+   * When maintenance began; empty while it is off.
+   *
+   * @generated from field: string started_at = 3;
+   */
+  startedAt = "";
+
+  constructor(data?: PartialMessage<MaintenanceMode>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.MaintenanceMode";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "started_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MaintenanceMode {
+    return new MaintenanceMode().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MaintenanceMode {
+    return new MaintenanceMode().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MaintenanceMode {
+    return new MaintenanceMode().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MaintenanceMode | PlainMessage<MaintenanceMode> | undefined, b: MaintenanceMode | PlainMessage<MaintenanceMode> | undefined): boolean {
+    return proto3.util.equals(MaintenanceMode, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetMaintenanceModeRequest
+ */
+export class GetMaintenanceModeRequest extends Message<GetMaintenanceModeRequest> {
+  constructor(data?: PartialMessage<GetMaintenanceModeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetMaintenanceModeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMaintenanceModeRequest {
+    return new GetMaintenanceModeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMaintenanceModeRequest {
+    return new GetMaintenanceModeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMaintenanceModeRequest {
+    return new GetMaintenanceModeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMaintenanceModeRequest | PlainMessage<GetMaintenanceModeRequest> | undefined, b: GetMaintenanceModeRequest | PlainMessage<GetMaintenanceModeRequest> | undefined): boolean {
+    return proto3.util.equals(GetMaintenanceModeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetMaintenanceModeResponse
+ */
+export class GetMaintenanceModeResponse extends Message<GetMaintenanceModeResponse> {
+  /**
+   * @generated from field: secretary.v1.MaintenanceMode mode = 1;
+   */
+  mode?: MaintenanceMode;
+
+  constructor(data?: PartialMessage<GetMaintenanceModeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetMaintenanceModeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mode", kind: "message", T: MaintenanceMode },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMaintenanceModeResponse {
+    return new GetMaintenanceModeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMaintenanceModeResponse {
+    return new GetMaintenanceModeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMaintenanceModeResponse {
+    return new GetMaintenanceModeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMaintenanceModeResponse | PlainMessage<GetMaintenanceModeResponse> | undefined, b: GetMaintenanceModeResponse | PlainMessage<GetMaintenanceModeResponse> | undefined): boolean {
+    return proto3.util.equals(GetMaintenanceModeResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SetMaintenanceModeRequest
+ */
+export class SetMaintenanceModeRequest extends Message<SetMaintenanceModeRequest> {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled = false;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<SetMaintenanceModeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SetMaintenanceModeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetMaintenanceModeRequest {
+    return new SetMaintenanceModeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetMaintenanceModeRequest {
+    return new SetMaintenanceModeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetMaintenanceModeRequest {
+    return new SetMaintenanceModeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetMaintenanceModeRequest | PlainMessage<SetMaintenanceModeRequest> | undefined, b: SetMaintenanceModeRequest | PlainMessage<SetMaintenanceModeRequest> | undefined): boolean {
+    return proto3.util.equals(SetMaintenanceModeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SetMaintenanceModeResponse
+ */
+export class SetMaintenanceModeResponse extends Message<SetMaintenanceModeResponse> {
+  /**
+   * @generated from field: secretary.v1.MaintenanceMode mode = 1;
+   */
+  mode?: MaintenanceMode;
+
+  constructor(data?: PartialMessage<SetMaintenanceModeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SetMaintenanceModeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mode", kind: "message", T: MaintenanceMode },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetMaintenanceModeResponse {
+    return new SetMaintenanceModeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetMaintenanceModeResponse {
+    return new SetMaintenanceModeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetMaintenanceModeResponse {
+    return new SetMaintenanceModeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetMaintenanceModeResponse | PlainMessage<SetMaintenanceModeResponse> | undefined, b: SetMaintenanceModeResponse | PlainMessage<SetMaintenanceModeResponse> | undefined): boolean {
+    return proto3.util.equals(SetMaintenanceModeResponse, a, b);
+  }
+}
+
+/**
+ * An entry of the audit log: an admin action or a deletion that
+ * succeeded. Entries can't be changed or removed, and each one's hash
+ * covers the entry before it.
+ *
+ * @generated from message secretary.v1.AuditEntry
+ */
+export class AuditEntry extends Message<AuditEntry> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 2;
+   */
+  createdAt = "";
+
+  /**
+   * 0 for actions the server took on its own.
+   *
+   * @generated from field: int64 actor_user_id = 3;
+   */
+  actorUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string actor_name = 4;
+   */
+  actorName = "";
+
+  /**
+   * The service and procedure, e.g. "UsersService.DeactivateUser".
+   *
+   * @generated from field: string action = 5;
+   */
+  action = "";
+
+  /**
+   * The request, as JSON.
+   *
+   * @generated from field: string detail = 6;
+   */
+  detail = "";
+
+  /**
+   * @generated from field: string request_id = 7;
+   */
+  requestId = "";
+
+  /**
+   * Identifies the key that signed the entry; empty when it is only
+   * hashed.
+   *
+   * @generated from field: string key_id = 8;
+   */
+  keyId = "";
+
+  /**
+   * Hex SHA-256, or HMAC-SHA256 when signed.
+   *
+   * @generated from field: string hash = 9;
+   */
+  hash = "";
+
+  /**
+   * The session the action was taken in, the address it came from and
+   * the device that session signed in on. Empty for actions the server
+   * took on its own and for entries written before they were recorded.
+   *
+   * @generated from field: string session_id = 10;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: string ip = 11;
+   */
+  ip = "";
+
+  /**
+   * @generated from field: string device = 12;
+   */
+  device = "";
+
+  constructor(data?: PartialMessage<AuditEntry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.AuditEntry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "actor_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "actor_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "detail", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "request_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "key_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "ip", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "device", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEntry {
+    return new AuditEntry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEntry {
+    return new AuditEntry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEntry {
+    return new AuditEntry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEntry | PlainMessage<AuditEntry> | undefined, b: AuditEntry | PlainMessage<AuditEntry> | undefined): boolean {
+    return proto3.util.equals(AuditEntry, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListAuditLogRequest
+ */
+export class ListAuditLogRequest extends Message<ListAuditLogRequest> {
+  /**
+   * Lists entries older than this one; 0 starts from the newest.
+   *
+   * @generated from field: int64 before_id = 1;
+   */
+  beforeId = protoInt64.zero;
+
+  /**
+   * Defaults to 100.
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListAuditLogRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListAuditLogRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "before_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditLogRequest {
+    return new ListAuditLogRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditLogRequest {
+    return new ListAuditLogRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditLogRequest {
+    return new ListAuditLogRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditLogRequest | PlainMessage<ListAuditLogRequest> | undefined, b: ListAuditLogRequest | PlainMessage<ListAuditLogRequest> | undefined): boolean {
+    return proto3.util.equals(ListAuditLogRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListAuditLogResponse
+ */
+export class ListAuditLogResponse extends Message<ListAuditLogResponse> {
+  /**
+   * Newest first.
+   *
+   * @generated from field: repeated secretary.v1.AuditEntry entries = 1;
+   */
+  entries: AuditEntry[] = [];
+
+  constructor(data?: PartialMessage<ListAuditLogResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListAuditLogResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: AuditEntry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditLogResponse {
+    return new ListAuditLogResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditLogResponse {
+    return new ListAuditLogResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditLogResponse {
+    return new ListAuditLogResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditLogResponse | PlainMessage<ListAuditLogResponse> | undefined, b: ListAuditLogResponse | PlainMessage<ListAuditLogResponse> | undefined): boolean {
+    return proto3.util.equals(ListAuditLogResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.VerifyAuditLogRequest
+ */
+export class VerifyAuditLogRequest extends Message<VerifyAuditLogRequest> {
+  constructor(data?: PartialMessage<VerifyAuditLogRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.VerifyAuditLogRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyAuditLogRequest {
+    return new VerifyAuditLogRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyAuditLogRequest {
+    return new VerifyAuditLogRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyAuditLogRequest {
+    return new VerifyAuditLogRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VerifyAuditLogRequest | PlainMessage<VerifyAuditLogRequest> | undefined, b: VerifyAuditLogRequest | PlainMessage<VerifyAuditLogRequest> | undefined): boolean {
+    return proto3.util.equals(VerifyAuditLogRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.VerifyAuditLogResponse
+ */
+export class VerifyAuditLogResponse extends Message<VerifyAuditLogResponse> {
+  /**
+   * Whether every entry checked out.
+   *
+   * @generated from field: bool valid = 1;
+   */
+  valid = false;
+
+  /**
+   * @generated from field: int64 entries = 2;
+   */
+  entries = protoInt64.zero;
+
+  /**
+   * The first entry that didn't, and why.
+   *
+   * @generated from field: int64 first_invalid_id = 3;
+   */
+  firstInvalidId = protoInt64.zero;
+
+  /**
+   * @generated from field: string problem = 4;
+   */
+  problem = "";
+
+  /**
+   * The newest entry's hash, to keep outside the instance and compare
+   * later.
+   *
+   * @generated from field: string head_hash = 5;
+   */
+  headHash = "";
+
+  constructor(data?: PartialMessage<VerifyAuditLogResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.VerifyAuditLogResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "valid", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "entries", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "first_invalid_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "problem", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "head_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyAuditLogResponse {
+    return new VerifyAuditLogResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyAuditLogResponse {
+    return new VerifyAuditLogResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyAuditLogResponse {
+    return new VerifyAuditLogResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VerifyAuditLogResponse | PlainMessage<VerifyAuditLogResponse> | undefined, b: VerifyAuditLogResponse | PlainMessage<VerifyAuditLogResponse> | undefined): boolean {
+    return proto3.util.equals(VerifyAuditLogResponse, a, b);
+  }
+}
+
+/**
+ * A group pushed by the identity provider over SCIM. Admins map it to a
+ * role and a workspace; members of the group get both.
+ *
+ * @generated from message secretary.v1.ScimGroup
+ */
+export class ScimGroup extends Message<ScimGroup> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: string external_id = 3;
+   */
+  externalId = "";
+
+  /**
+   * "admin", "member", or empty when the group grants no role.
+   *
+   * @generated from field: string role = 4;
+   */
+  role = "";
+
+  /**
+   * 0 when the group maps to no workspace.
+   *
+   * @generated from field: int64 workspace_id = 5;
+   */
+  workspaceId = protoInt64.zero;
+
+  /**
+   * @generated from field: string workspace_name = 6;
+   */
+  workspaceName = "";
+
+  /**
+   * @generated from field: int64 member_count = 7;
+   */
+  memberCount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ScimGroup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ScimGroup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "external_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "workspace_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "workspace_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "member_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScimGroup {
+    return new ScimGroup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScimGroup {
+    return new ScimGroup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScimGroup {
+    return new ScimGroup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScimGroup | PlainMessage<ScimGroup> | undefined, b: ScimGroup | PlainMessage<ScimGroup> | undefined): boolean {
+    return proto3.util.equals(ScimGroup, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListScimGroupsRequest
+ */
+export class ListScimGroupsRequest extends Message<ListScimGroupsRequest> {
+  constructor(data?: PartialMessage<ListScimGroupsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListScimGroupsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListScimGroupsRequest {
+    return new ListScimGroupsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListScimGroupsRequest {
+    return new ListScimGroupsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListScimGroupsRequest {
+    return new ListScimGroupsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListScimGroupsRequest | PlainMessage<ListScimGroupsRequest> | undefined, b: ListScimGroupsRequest | PlainMessage<ListScimGroupsRequest> | undefined): boolean {
+    return proto3.util.equals(ListScimGroupsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListScimGroupsResponse
+ */
+export class ListScimGroupsResponse extends Message<ListScimGroupsResponse> {
+  /**
+   * @generated from field: repeated secretary.v1.ScimGroup groups = 1;
+   */
+  groups: ScimGroup[] = [];
+
+  /**
+   * Every workspace, to map groups to.
+   *
+   * @generated from field: repeated secretary.v1.Workspace workspaces = 2;
+   */
+  workspaces: Workspace[] = [];
+
+  /**
+   * Whether SCIM_TOKEN is set, so identity providers can connect.
+   *
+   * @generated from field: bool enabled = 3;
+   */
+  enabled = false;
+
+  constructor(data?: PartialMessage<ListScimGroupsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListScimGroupsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "groups", kind: "message", T: ScimGroup, repeated: true },
+    { no: 2, name: "workspaces", kind: "message", T: Workspace, repeated: true },
+    { no: 3, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListScimGroupsResponse {
+    return new ListScimGroupsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListScimGroupsResponse {
+    return new ListScimGroupsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListScimGroupsResponse {
+    return new ListScimGroupsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListScimGroupsResponse | PlainMessage<ListScimGroupsResponse> | undefined, b: ListScimGroupsResponse | PlainMessage<ListScimGroupsResponse> | undefined): boolean {
+    return proto3.util.equals(ListScimGroupsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateScimGroupMappingRequest
+ */
+export class UpdateScimGroupMappingRequest extends Message<UpdateScimGroupMappingRequest> {
+  /**
+   * @generated from field: int64 group_id = 1;
+   */
+  groupId = protoInt64.zero;
+
+  /**
+   * @generated from field: string role = 2;
+   */
+  role = "";
+
+  /**
+   * @generated from field: int64 workspace_id = 3;
+   */
+  workspaceId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<UpdateScimGroupMappingRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateScimGroupMappingRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "group_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "workspace_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateScimGroupMappingRequest {
+    return new UpdateScimGroupMappingRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateScimGroupMappingRequest {
+    return new UpdateScimGroupMappingRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateScimGroupMappingRequest {
+    return new UpdateScimGroupMappingRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateScimGroupMappingRequest | PlainMessage<UpdateScimGroupMappingRequest> | undefined, b: UpdateScimGroupMappingRequest | PlainMessage<UpdateScimGroupMappingRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateScimGroupMappingRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateScimGroupMappingResponse
+ */
+export class UpdateScimGroupMappingResponse extends Message<UpdateScimGroupMappingResponse> {
+  /**
+   * @generated from field: secretary.v1.ScimGroup group = 1;
+   */
+  group?: ScimGroup;
+
+  constructor(data?: PartialMessage<UpdateScimGroupMappingResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateScimGroupMappingResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "group", kind: "message", T: ScimGroup },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateScimGroupMappingResponse {
+    return new UpdateScimGroupMappingResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateScimGroupMappingResponse {
+    return new UpdateScimGroupMappingResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateScimGroupMappingResponse {
+    return new UpdateScimGroupMappingResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateScimGroupMappingResponse | PlainMessage<UpdateScimGroupMappingResponse> | undefined, b: UpdateScimGroupMappingResponse | PlainMessage<UpdateScimGroupMappingResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateScimGroupMappingResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetIpAllowlistRequest
+ */
+export class GetIpAllowlistRequest extends Message<GetIpAllowlistRequest> {
+  constructor(data?: PartialMessage<GetIpAllowlistRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetIpAllowlistRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetIpAllowlistRequest {
+    return new GetIpAllowlistRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetIpAllowlistRequest {
+    return new GetIpAllowlistRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetIpAllowlistRequest {
+    return new GetIpAllowlistRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetIpAllowlistRequest | PlainMessage<GetIpAllowlistRequest> | undefined, b: GetIpAllowlistRequest | PlainMessage<GetIpAllowlistRequest> | undefined): boolean {
+    return proto3.util.equals(GetIpAllowlistRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetIpAllowlistResponse
+ */
+export class GetIpAllowlistResponse extends Message<GetIpAllowlistResponse> {
+  /**
+   * CIDR ranges, e.g. "203.0.113.0/24". Empty allows every address.
+   *
+   * @generated from field: repeated string cidrs = 1;
+   */
+  cidrs: string[] = [];
+
+  /**
+   * The caller's address as the server sees it, to check a list against.
+   *
+   * @generated from field: string your_ip = 2;
+   */
+  yourIp = "";
+
+  /**
+   * Whether IP_ALLOWLIST_BYPASS_TOKEN is set, so admins can get in from
+   * anywhere in an emergency.
+   *
+   * @generated from field: bool bypass_enabled = 3;
+   */
+  bypassEnabled = false;
+
+  constructor(data?: PartialMessage<GetIpAllowlistResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetIpAllowlistResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cidrs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "your_ip", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "bypass_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetIpAllowlistResponse {
+    return new GetIpAllowlistResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetIpAllowlistResponse {
+    return new GetIpAllowlistResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetIpAllowlistResponse {
+    return new GetIpAllowlistResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetIpAllowlistResponse | PlainMessage<GetIpAllowlistResponse> | undefined, b: GetIpAllowlistResponse | PlainMessage<GetIpAllowlistResponse> | undefined): boolean {
+    return proto3.util.equals(GetIpAllowlistResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SetIpAllowlistRequest
+ */
+export class SetIpAllowlistRequest extends Message<SetIpAllowlistRequest> {
+  /**
+   * CIDR ranges or single addresses. A list that leaves out the caller's
+   * own address is refused.
+   *
+   * @generated from field: repeated string cidrs = 1;
+   */
+  cidrs: string[] = [];
+
+  constructor(data?: PartialMessage<SetIpAllowlistRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SetIpAllowlistRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cidrs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetIpAllowlistRequest {
+    return new SetIpAllowlistRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetIpAllowlistRequest {
+    return new SetIpAllowlistRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetIpAllowlistRequest {
+    return new SetIpAllowlistRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetIpAllowlistRequest | PlainMessage<SetIpAllowlistRequest> | undefined, b: SetIpAllowlistRequest | PlainMessage<SetIpAllowlistRequest> | undefined): boolean {
+    return proto3.util.equals(SetIpAllowlistRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SetIpAllowlistResponse
+ */
+export class SetIpAllowlistResponse extends Message<SetIpAllowlistResponse> {
+  /**
+   * The list as stored: ranges masked to their network address, single
+   * addresses as /32 or /128, duplicates removed.
+   *
+   * @generated from field: repeated string cidrs = 1;
+   */
+  cidrs: string[] = [];
+
+  constructor(data?: PartialMessage<SetIpAllowlistResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SetIpAllowlistResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cidrs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetIpAllowlistResponse {
+    return new SetIpAllowlistResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetIpAllowlistResponse {
+    return new SetIpAllowlistResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetIpAllowlistResponse {
+    return new SetIpAllowlistResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetIpAllowlistResponse | PlainMessage<SetIpAllowlistResponse> | undefined, b: SetIpAllowlistResponse | PlainMessage<SetIpAllowlistResponse> | undefined): boolean {
+    return proto3.util.equals(SetIpAllowlistResponse, a, b);
+  }
+}
@@ -0,0 +1,55 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts"
+// @generated from file secretary/v1/annotations.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateAnnotationRequest, CreateAnnotationResponse, DeleteAnnotationRequest, DeleteAnnotationResponse, ListAnnotationsRequest, ListAnnotationsResponse, UpdateAnnotationRequest, UpdateAnnotationResponse } from "./annotations_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service secretary.v1.AnnotationsService
+ */
+export const AnnotationsService = {
+  typeName: "secretary.v1.AnnotationsService",
+  methods: {
+    /**
+     * @generated from rpc secretary.v1.AnnotationsService.ListAnnotations
+     */
+    listAnnotations: {
+      name: "ListAnnotations",
+      I: ListAnnotationsRequest,
+      O: ListAnnotationsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * @generated from rpc secretary.v1.AnnotationsService.CreateAnnotation
+     */
+    createAnnotation: {
+      name: "CreateAnnotation",
+      I: CreateAnnotationRequest,
+      O: CreateAnnotationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Only the author or an admin may change or delete an annotation.
+     *
+     * @generated from rpc secretary.v1.AnnotationsService.UpdateAnnotation
+     */
+    updateAnnotation: {
+      name: "UpdateAnnotation",
+      I: UpdateAnnotationRequest,
+      O: UpdateAnnotationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.AnnotationsService.DeleteAnnotation
+     */
+    deleteAnnotation: {
+      name: "DeleteAnnotation",
+      I: DeleteAnnotationRequest,
+      O: DeleteAnnotationResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
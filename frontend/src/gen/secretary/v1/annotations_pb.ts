@@ -0,0 +1,426 @@
+// @generated by protoc-gen-es v1.10.1 with parameter "target=ts"
+// @generated from file secretary/v1/annotations.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * A note on a stretch of a recording, so people can mark key moments and
+ * jump back to them.
+ *
+ * @generated from message secretary.v1.Annotation
+ */
+export class Annotation extends Message<Annotation> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 recording_id = 2;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * Offsets into the audio. A moment rather than a stretch has
+   * end_ms equal to start_ms.
+   *
+   * @generated from field: int32 start_ms = 3;
+   */
+  startMs = 0;
+
+  /**
+   * @generated from field: int32 end_ms = 4;
+   */
+  endMs = 0;
+
+  /**
+   * @generated from field: string note = 5;
+   */
+  note = "";
+
+  /**
+   * 0 once the author's account is deleted.
+   *
+   * @generated from field: int64 author_user_id = 6;
+   */
+  authorUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 7;
+   */
+  createdAt = "";
+
+  /**
+   * @generated from field: string updated_at = 8;
+   */
+  updatedAt = "";
+
+  constructor(data?: PartialMessage<Annotation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.Annotation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "start_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "end_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "author_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Annotation {
+    return new Annotation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Annotation {
+    return new Annotation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Annotation {
+    return new Annotation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Annotation | PlainMessage<Annotation> | undefined, b: Annotation | PlainMessage<Annotation> | undefined): boolean {
+    return proto3.util.equals(Annotation, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListAnnotationsRequest
+ */
+export class ListAnnotationsRequest extends Message<ListAnnotationsRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ListAnnotationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListAnnotationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAnnotationsRequest {
+    return new ListAnnotationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAnnotationsRequest {
+    return new ListAnnotationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAnnotationsRequest {
+    return new ListAnnotationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAnnotationsRequest | PlainMessage<ListAnnotationsRequest> | undefined, b: ListAnnotationsRequest | PlainMessage<ListAnnotationsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAnnotationsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListAnnotationsResponse
+ */
+export class ListAnnotationsResponse extends Message<ListAnnotationsResponse> {
+  /**
+   * In the order they occur in the recording.
+   *
+   * @generated from field: repeated secretary.v1.Annotation annotations = 1;
+   */
+  annotations: Annotation[] = [];
+
+  constructor(data?: PartialMessage<ListAnnotationsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListAnnotationsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "annotations", kind: "message", T: Annotation, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAnnotationsResponse {
+    return new ListAnnotationsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAnnotationsResponse {
+    return new ListAnnotationsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAnnotationsResponse {
+    return new ListAnnotationsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAnnotationsResponse | PlainMessage<ListAnnotationsResponse> | undefined, b: ListAnnotationsResponse | PlainMessage<ListAnnotationsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAnnotationsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CreateAnnotationRequest
+ */
+export class CreateAnnotationRequest extends Message<CreateAnnotationRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: int32 start_ms = 2;
+   */
+  startMs = 0;
+
+  /**
+   * Zero marks a moment at start_ms.
+   *
+   * @generated from field: int32 end_ms = 3;
+   */
+  endMs = 0;
+
+  /**
+   * @generated from field: string note = 4;
+   */
+  note = "";
+
+  constructor(data?: PartialMessage<CreateAnnotationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CreateAnnotationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "start_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "end_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAnnotationRequest {
+    return new CreateAnnotationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAnnotationRequest {
+    return new CreateAnnotationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAnnotationRequest {
+    return new CreateAnnotationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateAnnotationRequest | PlainMessage<CreateAnnotationRequest> | undefined, b: CreateAnnotationRequest | PlainMessage<CreateAnnotationRequest> | undefined): boolean {
+    return proto3.util.equals(CreateAnnotationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CreateAnnotationResponse
+ */
+export class CreateAnnotationResponse extends Message<CreateAnnotationResponse> {
+  /**
+   * @generated from field: secretary.v1.Annotation annotation = 1;
+   */
+  annotation?: Annotation;
+
+  constructor(data?: PartialMessage<CreateAnnotationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CreateAnnotationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "annotation", kind: "message", T: Annotation },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAnnotationResponse {
+    return new CreateAnnotationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAnnotationResponse {
+    return new CreateAnnotationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAnnotationResponse {
+    return new CreateAnnotationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateAnnotationResponse | PlainMessage<CreateAnnotationResponse> | undefined, b: CreateAnnotationResponse | PlainMessage<CreateAnnotationResponse> | undefined): boolean {
+    return proto3.util.equals(CreateAnnotationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateAnnotationRequest
+ */
+export class UpdateAnnotationRequest extends Message<UpdateAnnotationRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: int32 start_ms = 2;
+   */
+  startMs = 0;
+
+  /**
+   * Zero marks a moment at start_ms.
+   *
+   * @generated from field: int32 end_ms = 3;
+   */
+  endMs = 0;
+
+  /**
+   * @generated from field: string note = 4;
+   */
+  note = "";
+
+  constructor(data?: PartialMessage<UpdateAnnotationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateAnnotationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "start_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "end_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateAnnotationRequest {
+    return new UpdateAnnotationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateAnnotationRequest {
+    return new UpdateAnnotationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateAnnotationRequest {
+    return new UpdateAnnotationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateAnnotationRequest | PlainMessage<UpdateAnnotationRequest> | undefined, b: UpdateAnnotationRequest | PlainMessage<UpdateAnnotationRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateAnnotationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateAnnotationResponse
+ */
+export class UpdateAnnotationResponse extends Message<UpdateAnnotationResponse> {
+  /**
+   * @generated from field: secretary.v1.Annotation annotation = 1;
+   */
+  annotation?: Annotation;
+
+  constructor(data?: PartialMessage<UpdateAnnotationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateAnnotationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "annotation", kind: "message", T: Annotation },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateAnnotationResponse {
+    return new UpdateAnnotationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateAnnotationResponse {
+    return new UpdateAnnotationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateAnnotationResponse {
+    return new UpdateAnnotationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateAnnotationResponse | PlainMessage<UpdateAnnotationResponse> | undefined, b: UpdateAnnotationResponse | PlainMessage<UpdateAnnotationResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateAnnotationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteAnnotationRequest
+ */
+export class DeleteAnnotationRequest extends Message<DeleteAnnotationRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeleteAnnotationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteAnnotationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAnnotationRequest {
+    return new DeleteAnnotationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAnnotationRequest {
+    return new DeleteAnnotationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAnnotationRequest {
+    return new DeleteAnnotationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAnnotationRequest | PlainMessage<DeleteAnnotationRequest> | undefined, b: DeleteAnnotationRequest | PlainMessage<DeleteAnnotationRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteAnnotationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteAnnotationResponse
+ */
+export class DeleteAnnotationResponse extends Message<DeleteAnnotationResponse> {
+  constructor(data?: PartialMessage<DeleteAnnotationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteAnnotationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAnnotationResponse {
+    return new DeleteAnnotationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAnnotationResponse {
+    return new DeleteAnnotationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAnnotationResponse {
+    return new DeleteAnnotationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAnnotationResponse | PlainMessage<DeleteAnnotationResponse> | undefined, b: DeleteAnnotationResponse | PlainMessage<DeleteAnnotationResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteAnnotationResponse, a, b);
+  }
+}
@@ -0,0 +1,59 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts"
+// @generated from file secretary/v1/attachments.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { DeleteAttachmentRequest, DeleteAttachmentResponse, DownloadAttachmentRequest, DownloadAttachmentResponse, ListAttachmentsRequest, ListAttachmentsResponse, UploadAttachmentRequest, UploadAttachmentResponse } from "./attachments_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service secretary.v1.AttachmentsService
+ */
+export const AttachmentsService = {
+  typeName: "secretary.v1.AttachmentsService",
+  methods: {
+    /**
+     * Stores a file against a todo or recording. Oversized files and
+     * disallowed types are rejected with INVALID_ARGUMENT.
+     *
+     * @generated from rpc secretary.v1.AttachmentsService.UploadAttachment
+     */
+    uploadAttachment: {
+      name: "UploadAttachment",
+      I: UploadAttachmentRequest,
+      O: UploadAttachmentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.AttachmentsService.ListAttachments
+     */
+    listAttachments: {
+      name: "ListAttachments",
+      I: ListAttachmentsRequest,
+      O: ListAttachmentsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * @generated from rpc secretary.v1.AttachmentsService.DownloadAttachment
+     */
+    downloadAttachment: {
+      name: "DownloadAttachment",
+      I: DownloadAttachmentRequest,
+      O: DownloadAttachmentResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Only the uploader or an admin may delete an attachment.
+     *
+     * @generated from rpc secretary.v1.AttachmentsService.DeleteAttachment
+     */
+    deleteAttachment: {
+      name: "DeleteAttachment",
+      I: DeleteAttachmentRequest,
+      O: DeleteAttachmentResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
@@ -0,0 +1,432 @@
+// @generated by protoc-gen-es v1.10.1 with parameter "target=ts"
+// @generated from file secretary/v1/attachments.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { ScanStatus } from "./quarantine_pb.js";
+
+/**
+ * A file kept with a todo or a recording, such as a document discussed in
+ * the meeting. Exactly one of todo_id and recording_id is set.
+ *
+ * @generated from message secretary.v1.Attachment
+ */
+export class Attachment extends Message<Attachment> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 todo_id = 2;
+   */
+  todoId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 recording_id = 3;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: string file_name = 4;
+   */
+  fileName = "";
+
+  /**
+   * @generated from field: string content_type = 5;
+   */
+  contentType = "";
+
+  /**
+   * @generated from field: int64 size_bytes = 6;
+   */
+  sizeBytes = protoInt64.zero;
+
+  /**
+   * 0 once the uploader's account is deleted.
+   *
+   * @generated from field: int64 uploaded_by_user_id = 7;
+   */
+  uploadedByUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 8;
+   */
+  createdAt = "";
+
+  /**
+   * Quarantined attachments can't be downloaded.
+   *
+   * @generated from field: secretary.v1.ScanStatus scan_status = 9;
+   */
+  scanStatus = ScanStatus.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<Attachment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.Attachment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "file_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "content_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "uploaded_by_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "scan_status", kind: "enum", T: proto3.getEnumType(ScanStatus) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Attachment {
+    return new Attachment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Attachment {
+    return new Attachment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Attachment {
+    return new Attachment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Attachment | PlainMessage<Attachment> | undefined, b: Attachment | PlainMessage<Attachment> | undefined): boolean {
+    return proto3.util.equals(Attachment, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UploadAttachmentRequest
+ */
+export class UploadAttachmentRequest extends Message<UploadAttachmentRequest> {
+  /**
+   * @generated from field: int64 todo_id = 1;
+   */
+  todoId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 recording_id = 2;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: string file_name = 3;
+   */
+  fileName = "";
+
+  /**
+   * Must be one of the allowed document, spreadsheet, image or text types.
+   *
+   * @generated from field: string content_type = 4;
+   */
+  contentType = "";
+
+  /**
+   * At most 10 MiB.
+   *
+   * @generated from field: bytes content = 5;
+   */
+  content = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<UploadAttachmentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UploadAttachmentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "file_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "content_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "content", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadAttachmentRequest {
+    return new UploadAttachmentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadAttachmentRequest {
+    return new UploadAttachmentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadAttachmentRequest {
+    return new UploadAttachmentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadAttachmentRequest | PlainMessage<UploadAttachmentRequest> | undefined, b: UploadAttachmentRequest | PlainMessage<UploadAttachmentRequest> | undefined): boolean {
+    return proto3.util.equals(UploadAttachmentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UploadAttachmentResponse
+ */
+export class UploadAttachmentResponse extends Message<UploadAttachmentResponse> {
+  /**
+   * @generated from field: secretary.v1.Attachment attachment = 1;
+   */
+  attachment?: Attachment;
+
+  constructor(data?: PartialMessage<UploadAttachmentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UploadAttachmentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "attachment", kind: "message", T: Attachment },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadAttachmentResponse {
+    return new UploadAttachmentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadAttachmentResponse {
+    return new UploadAttachmentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadAttachmentResponse {
+    return new UploadAttachmentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadAttachmentResponse | PlainMessage<UploadAttachmentResponse> | undefined, b: UploadAttachmentResponse | PlainMessage<UploadAttachmentResponse> | undefined): boolean {
+    return proto3.util.equals(UploadAttachmentResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListAttachmentsRequest
+ */
+export class ListAttachmentsRequest extends Message<ListAttachmentsRequest> {
+  /**
+   * @generated from field: int64 todo_id = 1;
+   */
+  todoId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 recording_id = 2;
+   */
+  recordingId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ListAttachmentsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListAttachmentsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAttachmentsRequest {
+    return new ListAttachmentsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAttachmentsRequest {
+    return new ListAttachmentsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAttachmentsRequest {
+    return new ListAttachmentsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAttachmentsRequest | PlainMessage<ListAttachmentsRequest> | undefined, b: ListAttachmentsRequest | PlainMessage<ListAttachmentsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAttachmentsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListAttachmentsResponse
+ */
+export class ListAttachmentsResponse extends Message<ListAttachmentsResponse> {
+  /**
+   * Oldest first.
+   *
+   * @generated from field: repeated secretary.v1.Attachment attachments = 1;
+   */
+  attachments: Attachment[] = [];
+
+  constructor(data?: PartialMessage<ListAttachmentsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListAttachmentsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "attachments", kind: "message", T: Attachment, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAttachmentsResponse {
+    return new ListAttachmentsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAttachmentsResponse {
+    return new ListAttachmentsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAttachmentsResponse {
+    return new ListAttachmentsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAttachmentsResponse | PlainMessage<ListAttachmentsResponse> | undefined, b: ListAttachmentsResponse | PlainMessage<ListAttachmentsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAttachmentsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DownloadAttachmentRequest
+ */
+export class DownloadAttachmentRequest extends Message<DownloadAttachmentRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DownloadAttachmentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DownloadAttachmentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DownloadAttachmentRequest {
+    return new DownloadAttachmentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DownloadAttachmentRequest {
+    return new DownloadAttachmentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DownloadAttachmentRequest {
+    return new DownloadAttachmentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DownloadAttachmentRequest | PlainMessage<DownloadAttachmentRequest> | undefined, b: DownloadAttachmentRequest | PlainMessage<DownloadAttachmentRequest> | undefined): boolean {
+    return proto3.util.equals(DownloadAttachmentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DownloadAttachmentResponse
+ */
+export class DownloadAttachmentResponse extends Message<DownloadAttachmentResponse> {
+  /**
+   * @generated from field: secretary.v1.Attachment attachment = 1;
+   */
+  attachment?: Attachment;
+
+  /**
+   * @generated from field: bytes content = 2;
+   */
+  content = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<DownloadAttachmentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DownloadAttachmentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "attachment", kind: "message", T: Attachment },
+    { no: 2, name: "content", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DownloadAttachmentResponse {
+    return new DownloadAttachmentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DownloadAttachmentResponse {
+    return new DownloadAttachmentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DownloadAttachmentResponse {
+    return new DownloadAttachmentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DownloadAttachmentResponse | PlainMessage<DownloadAttachmentResponse> | undefined, b: DownloadAttachmentResponse | PlainMessage<DownloadAttachmentResponse> | undefined): boolean {
+    return proto3.util.equals(DownloadAttachmentResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteAttachmentRequest
+ */
+export class DeleteAttachmentRequest extends Message<DeleteAttachmentRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeleteAttachmentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteAttachmentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAttachmentRequest {
+    return new DeleteAttachmentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAttachmentRequest {
+    return new DeleteAttachmentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAttachmentRequest {
+    return new DeleteAttachmentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAttachmentRequest | PlainMessage<DeleteAttachmentRequest> | undefined, b: DeleteAttachmentRequest | PlainMessage<DeleteAttachmentRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteAttachmentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteAttachmentResponse
+ */
+export class DeleteAttachmentResponse extends Message<DeleteAttachmentResponse> {
+  constructor(data?: PartialMessage<DeleteAttachmentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteAttachmentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAttachmentResponse {
+    return new DeleteAttachmentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAttachmentResponse {
+    return new DeleteAttachmentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAttachmentResponse {
+    return new DeleteAttachmentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAttachmentResponse | PlainMessage<DeleteAttachmentResponse> | undefined, b: DeleteAttachmentResponse | PlainMessage<DeleteAttachmentResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteAttachmentResponse, a, b);
+  }
+}
@@ -0,0 +1,76 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts"
+// @generated from file secretary/v1/jobs.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CancelJobRequest, CancelJobResponse, GetJobRequest, GetJobResponse, ListJobsRequest, ListJobsResponse, PurgeFailedJobsRequest, PurgeFailedJobsResponse, RetryJobRequest, RetryJobResponse } from "./jobs_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * The transcription and summarization work queue, for operators. Admin
+ * only.
+ *
+ * @generated from service secretary.v1.JobsService
+ */
+export const JobsService = {
+  typeName: "secretary.v1.JobsService",
+  methods: {
+    /**
+     * @generated from rpc secretary.v1.JobsService.ListJobs
+     */
+    listJobs: {
+      name: "ListJobs",
+      I: ListJobsRequest,
+      O: ListJobsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * @generated from rpc secretary.v1.JobsService.GetJob
+     */
+    getJob: {
+      name: "GetJob",
+      I: GetJobRequest,
+      O: GetJobResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Queues a failed job again at the stage it failed in, like
+     * RecordingsService.RetryProcessing.
+     *
+     * @generated from rpc secretary.v1.JobsService.RetryJob
+     */
+    retryJob: {
+      name: "RetryJob",
+      I: RetryJobRequest,
+      O: RetryJobResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Fails a queued or running job. A worker already running it isn't
+     * stopped; if it still reports a result the recording moves on as if
+     * retried.
+     *
+     * @generated from rpc secretary.v1.JobsService.CancelJob
+     */
+    cancelJob: {
+      name: "CancelJob",
+      I: CancelJobRequest,
+      O: CancelJobResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Takes failed jobs off the list. The recordings stay failed and can
+     * still be retried from the recording.
+     *
+     * @generated from rpc secretary.v1.JobsService.PurgeFailedJobs
+     */
+    purgeFailedJobs: {
+      name: "PurgeFailedJobs",
+      I: PurgeFailedJobsRequest,
+      O: PurgeFailedJobsResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
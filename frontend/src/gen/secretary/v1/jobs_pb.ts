@@ -0,0 +1,545 @@
+// @generated by protoc-gen-es v1.10.1 with parameter "target=ts"
+// @generated from file secretary/v1/jobs.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { ProcessingAttempt, ProcessingStage } from "./recordings_pb.js";
+
+/**
+ * @generated from enum secretary.v1.JobState
+ */
+export enum JobState {
+  /**
+   * @generated from enum value: JOB_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting for the worker: uploaded, or retried and not picked up yet.
+   *
+   * @generated from enum value: JOB_STATE_QUEUED = 1;
+   */
+  QUEUED = 1,
+
+  /**
+   * @generated from enum value: JOB_STATE_RUNNING = 2;
+   */
+  RUNNING = 2,
+
+  /**
+   * Failed or cancelled, and neither retried nor purged: a dead letter.
+   *
+   * @generated from enum value: JOB_STATE_FAILED = 3;
+   */
+  FAILED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(JobState)
+proto3.util.setEnumType(JobState, "secretary.v1.JobState", [
+  { no: 0, name: "JOB_STATE_UNSPECIFIED" },
+  { no: 1, name: "JOB_STATE_QUEUED" },
+  { no: 2, name: "JOB_STATE_RUNNING" },
+  { no: 3, name: "JOB_STATE_FAILED" },
+]);
+
+/**
+ * A recording on its way through transcription and summarization. Each
+ * recording has at most one job; ready recordings have none.
+ *
+ * @generated from message secretary.v1.Job
+ */
+export class Job extends Message<Job> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: string recording_name = 2;
+   */
+  recordingName = "";
+
+  /**
+   * The stage the job is in, or failed in.
+   *
+   * @generated from field: secretary.v1.ProcessingStage stage = 3;
+   */
+  stage = ProcessingStage.UNSPECIFIED;
+
+  /**
+   * @generated from field: secretary.v1.JobState state = 4;
+   */
+  state = JobState.UNSPECIFIED;
+
+  /**
+   * Attempts at the stage so far; 0 before the worker first picks it up.
+   *
+   * @generated from field: int32 attempts = 5;
+   */
+  attempts = 0;
+
+  /**
+   * Why the job failed; empty unless it did.
+   *
+   * @generated from field: string error = 6;
+   */
+  error = "";
+
+  /**
+   * When the job entered its state.
+   *
+   * @generated from field: string updated_at = 7;
+   */
+  updatedAt = "";
+
+  /**
+   * Who created the recording; 0 when unknown.
+   *
+   * @generated from field: int64 created_by_user_id = 8;
+   */
+  createdByUserId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Job>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.Job";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "recording_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "stage", kind: "enum", T: proto3.getEnumType(ProcessingStage) },
+    { no: 4, name: "state", kind: "enum", T: proto3.getEnumType(JobState) },
+    { no: 5, name: "attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "created_by_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Job {
+    return new Job().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Job {
+    return new Job().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Job {
+    return new Job().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Job | PlainMessage<Job> | undefined, b: Job | PlainMessage<Job> | undefined): boolean {
+    return proto3.util.equals(Job, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListJobsRequest
+ */
+export class ListJobsRequest extends Message<ListJobsRequest> {
+  /**
+   * Empty lists jobs in every state.
+   *
+   * @generated from field: repeated secretary.v1.JobState states = 1;
+   */
+  states: JobState[] = [];
+
+  /**
+   * Defaults to 100.
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListJobsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListJobsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "states", kind: "enum", T: proto3.getEnumType(JobState), repeated: true },
+    { no: 2, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListJobsRequest {
+    return new ListJobsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListJobsRequest {
+    return new ListJobsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListJobsRequest {
+    return new ListJobsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListJobsRequest | PlainMessage<ListJobsRequest> | undefined, b: ListJobsRequest | PlainMessage<ListJobsRequest> | undefined): boolean {
+    return proto3.util.equals(ListJobsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListJobsResponse
+ */
+export class ListJobsResponse extends Message<ListJobsResponse> {
+  /**
+   * Longest in their state first.
+   *
+   * @generated from field: repeated secretary.v1.Job jobs = 1;
+   */
+  jobs: Job[] = [];
+
+  constructor(data?: PartialMessage<ListJobsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListJobsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "jobs", kind: "message", T: Job, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListJobsResponse {
+    return new ListJobsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListJobsResponse {
+    return new ListJobsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListJobsResponse {
+    return new ListJobsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListJobsResponse | PlainMessage<ListJobsResponse> | undefined, b: ListJobsResponse | PlainMessage<ListJobsResponse> | undefined): boolean {
+    return proto3.util.equals(ListJobsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetJobRequest
+ */
+export class GetJobRequest extends Message<GetJobRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetJobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetJobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRequest {
+    return new GetJobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobRequest {
+    return new GetJobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobRequest {
+    return new GetJobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobRequest | PlainMessage<GetJobRequest> | undefined, b: GetJobRequest | PlainMessage<GetJobRequest> | undefined): boolean {
+    return proto3.util.equals(GetJobRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetJobResponse
+ */
+export class GetJobResponse extends Message<GetJobResponse> {
+  /**
+   * @generated from field: secretary.v1.Job job = 1;
+   */
+  job?: Job;
+
+  /**
+   * Every attempt at the recording's stages, with their errors.
+   *
+   * @generated from field: repeated secretary.v1.ProcessingAttempt attempts = 2;
+   */
+  attempts: ProcessingAttempt[] = [];
+
+  constructor(data?: PartialMessage<GetJobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetJobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job", kind: "message", T: Job },
+    { no: 2, name: "attempts", kind: "message", T: ProcessingAttempt, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobResponse {
+    return new GetJobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobResponse {
+    return new GetJobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobResponse {
+    return new GetJobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobResponse | PlainMessage<GetJobResponse> | undefined, b: GetJobResponse | PlainMessage<GetJobResponse> | undefined): boolean {
+    return proto3.util.equals(GetJobResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RetryJobRequest
+ */
+export class RetryJobRequest extends Message<RetryJobRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RetryJobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RetryJobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryJobRequest {
+    return new RetryJobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryJobRequest {
+    return new RetryJobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryJobRequest {
+    return new RetryJobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetryJobRequest | PlainMessage<RetryJobRequest> | undefined, b: RetryJobRequest | PlainMessage<RetryJobRequest> | undefined): boolean {
+    return proto3.util.equals(RetryJobRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RetryJobResponse
+ */
+export class RetryJobResponse extends Message<RetryJobResponse> {
+  /**
+   * @generated from field: secretary.v1.Job job = 1;
+   */
+  job?: Job;
+
+  constructor(data?: PartialMessage<RetryJobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RetryJobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job", kind: "message", T: Job },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryJobResponse {
+    return new RetryJobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryJobResponse {
+    return new RetryJobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryJobResponse {
+    return new RetryJobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetryJobResponse | PlainMessage<RetryJobResponse> | undefined, b: RetryJobResponse | PlainMessage<RetryJobResponse> | undefined): boolean {
+    return proto3.util.equals(RetryJobResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CancelJobRequest
+ */
+export class CancelJobRequest extends Message<CancelJobRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * Kept as the job's error.
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<CancelJobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CancelJobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelJobRequest {
+    return new CancelJobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelJobRequest {
+    return new CancelJobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelJobRequest {
+    return new CancelJobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelJobRequest | PlainMessage<CancelJobRequest> | undefined, b: CancelJobRequest | PlainMessage<CancelJobRequest> | undefined): boolean {
+    return proto3.util.equals(CancelJobRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CancelJobResponse
+ */
+export class CancelJobResponse extends Message<CancelJobResponse> {
+  /**
+   * @generated from field: secretary.v1.Job job = 1;
+   */
+  job?: Job;
+
+  constructor(data?: PartialMessage<CancelJobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CancelJobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job", kind: "message", T: Job },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelJobResponse {
+    return new CancelJobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelJobResponse {
+    return new CancelJobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelJobResponse {
+    return new CancelJobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelJobResponse | PlainMessage<CancelJobResponse> | undefined, b: CancelJobResponse | PlainMessage<CancelJobResponse> | undefined): boolean {
+    return proto3.util.equals(CancelJobResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.PurgeFailedJobsRequest
+ */
+export class PurgeFailedJobsRequest extends Message<PurgeFailedJobsRequest> {
+  /**
+   * Only this job; 0 purges every failed job.
+   *
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * RFC 3339; only jobs that failed before then. Empty purges regardless
+   * of age.
+   *
+   * @generated from field: string failed_before = 2;
+   */
+  failedBefore = "";
+
+  constructor(data?: PartialMessage<PurgeFailedJobsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.PurgeFailedJobsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "failed_before", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PurgeFailedJobsRequest {
+    return new PurgeFailedJobsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PurgeFailedJobsRequest {
+    return new PurgeFailedJobsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PurgeFailedJobsRequest {
+    return new PurgeFailedJobsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PurgeFailedJobsRequest | PlainMessage<PurgeFailedJobsRequest> | undefined, b: PurgeFailedJobsRequest | PlainMessage<PurgeFailedJobsRequest> | undefined): boolean {
+    return proto3.util.equals(PurgeFailedJobsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.PurgeFailedJobsResponse
+ */
+export class PurgeFailedJobsResponse extends Message<PurgeFailedJobsResponse> {
+  /**
+   * @generated from field: int64 purged = 1;
+   */
+  purged = protoInt64.zero;
+
+  constructor(data?: PartialMessage<PurgeFailedJobsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.PurgeFailedJobsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "purged", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PurgeFailedJobsResponse {
+    return new PurgeFailedJobsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PurgeFailedJobsResponse {
+    return new PurgeFailedJobsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PurgeFailedJobsResponse {
+    return new PurgeFailedJobsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PurgeFailedJobsResponse | PlainMessage<PurgeFailedJobsResponse> | undefined, b: PurgeFailedJobsResponse | PlainMessage<PurgeFailedJobsResponse> | undefined): boolean {
+    return proto3.util.equals(PurgeFailedJobsResponse, a, b);
+  }
+}
@@ -0,0 +1,61 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts"
+// @generated from file secretary/v1/keywords.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateWatchKeywordRequest, CreateWatchKeywordResponse, DeleteWatchKeywordRequest, DeleteWatchKeywordResponse, ListKeywordAlertsRequest, ListKeywordAlertsResponse, ListWatchKeywordsRequest, ListWatchKeywordsResponse } from "./keywords_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * Watch keywords and the alerts raised when new transcripts say them.
+ * Each user manages and sees only their own.
+ *
+ * @generated from service secretary.v1.KeywordAlertsService
+ */
+export const KeywordAlertsService = {
+  typeName: "secretary.v1.KeywordAlertsService",
+  methods: {
+    /**
+     * @generated from rpc secretary.v1.KeywordAlertsService.ListWatchKeywords
+     */
+    listWatchKeywords: {
+      name: "ListWatchKeywords",
+      I: ListWatchKeywordsRequest,
+      O: ListWatchKeywordsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Fails with ALREADY_EXISTS when the user watches the keyword already.
+     *
+     * @generated from rpc secretary.v1.KeywordAlertsService.CreateWatchKeyword
+     */
+    createWatchKeyword: {
+      name: "CreateWatchKeyword",
+      I: CreateWatchKeywordRequest,
+      O: CreateWatchKeywordResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Deletes the keyword with its alerts.
+     *
+     * @generated from rpc secretary.v1.KeywordAlertsService.DeleteWatchKeyword
+     */
+    deleteWatchKeyword: {
+      name: "DeleteWatchKeyword",
+      I: DeleteWatchKeywordRequest,
+      O: DeleteWatchKeywordResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.KeywordAlertsService.ListKeywordAlerts
+     */
+    listKeywordAlerts: {
+      name: "ListKeywordAlerts",
+      I: ListKeywordAlertsRequest,
+      O: ListKeywordAlertsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+  }
+} as const;
@@ -0,0 +1,445 @@
+// @generated by protoc-gen-es v1.10.1 with parameter "target=ts"
+// @generated from file secretary/v1/keywords.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * A word or phrase the user wants to hear about when a meeting mentions
+ * it, e.g. "budget" or a competitor's name.
+ *
+ * @generated from message secretary.v1.WatchKeyword
+ */
+export class WatchKeyword extends Message<WatchKeyword> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string keyword = 2;
+   */
+  keyword = "";
+
+  /**
+   * Only meetings ready after this are matched.
+   *
+   * @generated from field: string created_at = 3;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<WatchKeyword>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.WatchKeyword";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "keyword", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchKeyword {
+    return new WatchKeyword().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchKeyword {
+    return new WatchKeyword().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchKeyword {
+    return new WatchKeyword().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchKeyword | PlainMessage<WatchKeyword> | undefined, b: WatchKeyword | PlainMessage<WatchKeyword> | undefined): boolean {
+    return proto3.util.equals(WatchKeyword, a, b);
+  }
+}
+
+/**
+ * A transcript line that says one of the user's keywords.
+ *
+ * @generated from message secretary.v1.KeywordAlert
+ */
+export class KeywordAlert extends Message<KeywordAlert> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 keyword_id = 2;
+   */
+  keywordId = protoInt64.zero;
+
+  /**
+   * @generated from field: string keyword = 3;
+   */
+  keyword = "";
+
+  /**
+   * @generated from field: int64 recording_id = 4;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: string recording_name = 5;
+   */
+  recordingName = "";
+
+  /**
+   * The line's index in the transcript, counting from 0.
+   *
+   * @generated from field: int32 segment_index = 6;
+   */
+  segmentIndex = 0;
+
+  /**
+   * @generated from field: string segment_text = 7;
+   */
+  segmentText = "";
+
+  /**
+   * @generated from field: string created_at = 8;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<KeywordAlert>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.KeywordAlert";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "keyword_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "keyword", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "recording_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "segment_index", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "segment_text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KeywordAlert {
+    return new KeywordAlert().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KeywordAlert {
+    return new KeywordAlert().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KeywordAlert {
+    return new KeywordAlert().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KeywordAlert | PlainMessage<KeywordAlert> | undefined, b: KeywordAlert | PlainMessage<KeywordAlert> | undefined): boolean {
+    return proto3.util.equals(KeywordAlert, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListWatchKeywordsRequest
+ */
+export class ListWatchKeywordsRequest extends Message<ListWatchKeywordsRequest> {
+  constructor(data?: PartialMessage<ListWatchKeywordsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListWatchKeywordsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWatchKeywordsRequest {
+    return new ListWatchKeywordsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWatchKeywordsRequest {
+    return new ListWatchKeywordsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWatchKeywordsRequest {
+    return new ListWatchKeywordsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWatchKeywordsRequest | PlainMessage<ListWatchKeywordsRequest> | undefined, b: ListWatchKeywordsRequest | PlainMessage<ListWatchKeywordsRequest> | undefined): boolean {
+    return proto3.util.equals(ListWatchKeywordsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListWatchKeywordsResponse
+ */
+export class ListWatchKeywordsResponse extends Message<ListWatchKeywordsResponse> {
+  /**
+   * In alphabetical order.
+   *
+   * @generated from field: repeated secretary.v1.WatchKeyword keywords = 1;
+   */
+  keywords: WatchKeyword[] = [];
+
+  constructor(data?: PartialMessage<ListWatchKeywordsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListWatchKeywordsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "keywords", kind: "message", T: WatchKeyword, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWatchKeywordsResponse {
+    return new ListWatchKeywordsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWatchKeywordsResponse {
+    return new ListWatchKeywordsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWatchKeywordsResponse {
+    return new ListWatchKeywordsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWatchKeywordsResponse | PlainMessage<ListWatchKeywordsResponse> | undefined, b: ListWatchKeywordsResponse | PlainMessage<ListWatchKeywordsResponse> | undefined): boolean {
+    return proto3.util.equals(ListWatchKeywordsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CreateWatchKeywordRequest
+ */
+export class CreateWatchKeywordRequest extends Message<CreateWatchKeywordRequest> {
+  /**
+   * Matched as whole words, ignoring case and accents, so "budget" finds
+   * "Budget" but not "budgeted".
+   *
+   * @generated from field: string keyword = 1;
+   */
+  keyword = "";
+
+  constructor(data?: PartialMessage<CreateWatchKeywordRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CreateWatchKeywordRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "keyword", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWatchKeywordRequest {
+    return new CreateWatchKeywordRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWatchKeywordRequest {
+    return new CreateWatchKeywordRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWatchKeywordRequest {
+    return new CreateWatchKeywordRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWatchKeywordRequest | PlainMessage<CreateWatchKeywordRequest> | undefined, b: CreateWatchKeywordRequest | PlainMessage<CreateWatchKeywordRequest> | undefined): boolean {
+    return proto3.util.equals(CreateWatchKeywordRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CreateWatchKeywordResponse
+ */
+export class CreateWatchKeywordResponse extends Message<CreateWatchKeywordResponse> {
+  /**
+   * @generated from field: secretary.v1.WatchKeyword keyword = 1;
+   */
+  keyword?: WatchKeyword;
+
+  constructor(data?: PartialMessage<CreateWatchKeywordResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CreateWatchKeywordResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "keyword", kind: "message", T: WatchKeyword },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWatchKeywordResponse {
+    return new CreateWatchKeywordResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWatchKeywordResponse {
+    return new CreateWatchKeywordResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWatchKeywordResponse {
+    return new CreateWatchKeywordResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWatchKeywordResponse | PlainMessage<CreateWatchKeywordResponse> | undefined, b: CreateWatchKeywordResponse | PlainMessage<CreateWatchKeywordResponse> | undefined): boolean {
+    return proto3.util.equals(CreateWatchKeywordResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteWatchKeywordRequest
+ */
+export class DeleteWatchKeywordRequest extends Message<DeleteWatchKeywordRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeleteWatchKeywordRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteWatchKeywordRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWatchKeywordRequest {
+    return new DeleteWatchKeywordRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWatchKeywordRequest {
+    return new DeleteWatchKeywordRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWatchKeywordRequest {
+    return new DeleteWatchKeywordRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWatchKeywordRequest | PlainMessage<DeleteWatchKeywordRequest> | undefined, b: DeleteWatchKeywordRequest | PlainMessage<DeleteWatchKeywordRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteWatchKeywordRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteWatchKeywordResponse
+ */
+export class DeleteWatchKeywordResponse extends Message<DeleteWatchKeywordResponse> {
+  constructor(data?: PartialMessage<DeleteWatchKeywordResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteWatchKeywordResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWatchKeywordResponse {
+    return new DeleteWatchKeywordResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWatchKeywordResponse {
+    return new DeleteWatchKeywordResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWatchKeywordResponse {
+    return new DeleteWatchKeywordResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWatchKeywordResponse | PlainMessage<DeleteWatchKeywordResponse> | undefined, b: DeleteWatchKeywordResponse | PlainMessage<DeleteWatchKeywordResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteWatchKeywordResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListKeywordAlertsRequest
+ */
+export class ListKeywordAlertsRequest extends Message<ListKeywordAlertsRequest> {
+  /**
+   * Only alerts for this keyword.
+   *
+   * @generated from field: optional int64 keyword_id = 1;
+   */
+  keywordId?: bigint;
+
+  /**
+   * At most this many alerts; 100 when unset.
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListKeywordAlertsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListKeywordAlertsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "keyword_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 2, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListKeywordAlertsRequest {
+    return new ListKeywordAlertsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListKeywordAlertsRequest {
+    return new ListKeywordAlertsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListKeywordAlertsRequest {
+    return new ListKeywordAlertsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListKeywordAlertsRequest | PlainMessage<ListKeywordAlertsRequest> | undefined, b: ListKeywordAlertsRequest | PlainMessage<ListKeywordAlertsRequest> | undefined): boolean {
+    return proto3.util.equals(ListKeywordAlertsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListKeywordAlertsResponse
+ */
+export class ListKeywordAlertsResponse extends Message<ListKeywordAlertsResponse> {
+  /**
+   * Newest first.
+   *
+   * @generated from field: repeated secretary.v1.KeywordAlert alerts = 1;
+   */
+  alerts: KeywordAlert[] = [];
+
+  constructor(data?: PartialMessage<ListKeywordAlertsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListKeywordAlertsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "alerts", kind: "message", T: KeywordAlert, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListKeywordAlertsResponse {
+    return new ListKeywordAlertsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListKeywordAlertsResponse {
+    return new ListKeywordAlertsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListKeywordAlertsResponse {
+    return new ListKeywordAlertsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListKeywordAlertsResponse | PlainMessage<ListKeywordAlertsResponse> | undefined, b: ListKeywordAlertsResponse | PlainMessage<ListKeywordAlertsResponse> | undefined): boolean {
+    return proto3.util.equals(ListKeywordAlertsResponse, a, b);
+  }
+}
@@ -0,0 +1,81 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts"
+// @generated from file secretary/v1/notifications.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetPreferencesRequest, GetPreferencesResponse, ListDevicesRequest, ListDevicesResponse, RegisterDeviceRequest, RegisterDeviceResponse, UnregisterDeviceRequest, UnregisterDeviceResponse, UpdateDeviceRequest, UpdateDeviceResponse, UpdatePreferencesRequest, UpdatePreferencesResponse } from "./notifications_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * The caller's notification preferences and push devices. Every method
+ * acts on the caller's own settings.
+ *
+ * @generated from service secretary.v1.NotificationsService
+ */
+export const NotificationsService = {
+  typeName: "secretary.v1.NotificationsService",
+  methods: {
+    /**
+     * @generated from rpc secretary.v1.NotificationsService.GetPreferences
+     */
+    getPreferences: {
+      name: "GetPreferences",
+      I: GetPreferencesRequest,
+      O: GetPreferencesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * @generated from rpc secretary.v1.NotificationsService.UpdatePreferences
+     */
+    updatePreferences: {
+      name: "UpdatePreferences",
+      I: UpdatePreferencesRequest,
+      O: UpdatePreferencesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Registers the app's push token for the caller. Registering a known
+     * token again updates it and moves it to the caller, since the app
+     * registers on every sign-in.
+     *
+     * @generated from rpc secretary.v1.NotificationsService.RegisterDevice
+     */
+    registerDevice: {
+      name: "RegisterDevice",
+      I: RegisterDeviceRequest,
+      O: RegisterDeviceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.NotificationsService.ListDevices
+     */
+    listDevices: {
+      name: "ListDevices",
+      I: ListDevicesRequest,
+      O: ListDevicesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * @generated from rpc secretary.v1.NotificationsService.UpdateDevice
+     */
+    updateDevice: {
+      name: "UpdateDevice",
+      I: UpdateDeviceRequest,
+      O: UpdateDeviceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Stops alerts to a token, e.g. when the user signs out of the app.
+     *
+     * @generated from rpc secretary.v1.NotificationsService.UnregisterDevice
+     */
+    unregisterDevice: {
+      name: "UnregisterDevice",
+      I: UnregisterDeviceRequest,
+      O: UnregisterDeviceResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
@@ -0,0 +1,764 @@
+// @generated by protoc-gen-es v1.10.1 with parameter "target=ts"
+// @generated from file secretary/v1/notifications.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * Things a user can be alerted about.
+ *
+ * @generated from enum secretary.v1.NotificationEvent
+ */
+export enum NotificationEvent {
+  /**
+   * @generated from enum value: NOTIFICATION_EVENT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * A todo was assigned to the user.
+   *
+   * @generated from enum value: NOTIFICATION_EVENT_TODO_ASSIGNED = 1;
+   */
+  TODO_ASSIGNED = 1,
+
+  /**
+   * A meeting the user took part in finished processing.
+   *
+   * @generated from enum value: NOTIFICATION_EVENT_RECORDING_READY = 2;
+   */
+  RECORDING_READY = 2,
+
+  /**
+   * A new transcript says one of the user's watch keywords.
+   *
+   * @generated from enum value: NOTIFICATION_EVENT_KEYWORD_MATCHED = 3;
+   */
+  KEYWORD_MATCHED = 3,
+
+  /**
+   * Someone @-mentioned the user in a todo comment.
+   *
+   * @generated from enum value: NOTIFICATION_EVENT_MENTIONED = 4;
+   */
+  MENTIONED = 4,
+
+  /**
+   * A todo assigned to the user is back from a snooze.
+   *
+   * @generated from enum value: NOTIFICATION_EVENT_SNOOZE_ENDED = 5;
+   */
+  SNOOZE_ENDED = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(NotificationEvent)
+proto3.util.setEnumType(NotificationEvent, "secretary.v1.NotificationEvent", [
+  { no: 0, name: "NOTIFICATION_EVENT_UNSPECIFIED" },
+  { no: 1, name: "NOTIFICATION_EVENT_TODO_ASSIGNED" },
+  { no: 2, name: "NOTIFICATION_EVENT_RECORDING_READY" },
+  { no: 3, name: "NOTIFICATION_EVENT_KEYWORD_MATCHED" },
+  { no: 4, name: "NOTIFICATION_EVENT_MENTIONED" },
+  { no: 5, name: "NOTIFICATION_EVENT_SNOOZE_ENDED" },
+]);
+
+/**
+ * @generated from enum secretary.v1.PushPlatform
+ */
+export enum PushPlatform {
+  /**
+   * @generated from enum value: PUSH_PLATFORM_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Firebase Cloud Messaging, for Android.
+   *
+   * @generated from enum value: PUSH_PLATFORM_FCM = 1;
+   */
+  FCM = 1,
+
+  /**
+   * Apple Push Notification service, for iOS.
+   *
+   * @generated from enum value: PUSH_PLATFORM_APNS = 2;
+   */
+  APNS = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PushPlatform)
+proto3.util.setEnumType(PushPlatform, "secretary.v1.PushPlatform", [
+  { no: 0, name: "PUSH_PLATFORM_UNSPECIFIED" },
+  { no: 1, name: "PUSH_PLATFORM_FCM" },
+  { no: 2, name: "PUSH_PLATFORM_APNS" },
+]);
+
+/**
+ * How often a summary of open todos and recent meetings is emailed.
+ *
+ * @generated from enum secretary.v1.DigestFrequency
+ */
+export enum DigestFrequency {
+  /**
+   * @generated from enum value: DIGEST_FREQUENCY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DIGEST_FREQUENCY_OFF = 1;
+   */
+  OFF = 1,
+
+  /**
+   * @generated from enum value: DIGEST_FREQUENCY_DAILY = 2;
+   */
+  DAILY = 2,
+
+  /**
+   * @generated from enum value: DIGEST_FREQUENCY_WEEKLY = 3;
+   */
+  WEEKLY = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DigestFrequency)
+proto3.util.setEnumType(DigestFrequency, "secretary.v1.DigestFrequency", [
+  { no: 0, name: "DIGEST_FREQUENCY_UNSPECIFIED" },
+  { no: 1, name: "DIGEST_FREQUENCY_OFF" },
+  { no: 2, name: "DIGEST_FREQUENCY_DAILY" },
+  { no: 3, name: "DIGEST_FREQUENCY_WEEKLY" },
+]);
+
+/**
+ * How a user wants to be alerted. Users who never saved any get the
+ * defaults: chat and push on, email and digests off.
+ *
+ * @generated from message secretary.v1.NotificationPreferences
+ */
+export class NotificationPreferences extends Message<NotificationPreferences> {
+  /**
+   * Emails about the user's own todos.
+   *
+   * @generated from field: bool email = 1;
+   */
+  email = false;
+
+  /**
+   * Naming the user in the team's Slack or Teams channel when a todo is
+   * assigned to them.
+   *
+   * @generated from field: bool chat = 2;
+   */
+  chat = false;
+
+  /**
+   * Alerts on the user's registered devices.
+   *
+   * @generated from field: bool push = 3;
+   */
+  push = false;
+
+  /**
+   * Events the user hears about on no channel. Every other event,
+   * including ones added later, is delivered.
+   *
+   * @generated from field: repeated secretary.v1.NotificationEvent muted_events = 4;
+   */
+  mutedEvents: NotificationEvent[] = [];
+
+  /**
+   * @generated from field: secretary.v1.DigestFrequency digest_frequency = 5;
+   */
+  digestFrequency = DigestFrequency.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<NotificationPreferences>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.NotificationPreferences";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "chat", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "push", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "muted_events", kind: "enum", T: proto3.getEnumType(NotificationEvent), repeated: true },
+    { no: 5, name: "digest_frequency", kind: "enum", T: proto3.getEnumType(DigestFrequency) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NotificationPreferences {
+    return new NotificationPreferences().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NotificationPreferences {
+    return new NotificationPreferences().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NotificationPreferences {
+    return new NotificationPreferences().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NotificationPreferences | PlainMessage<NotificationPreferences> | undefined, b: NotificationPreferences | PlainMessage<NotificationPreferences> | undefined): boolean {
+    return proto3.util.equals(NotificationPreferences, a, b);
+  }
+}
+
+/**
+ * A phone or tablet that receives push alerts for the signed-in user.
+ *
+ * @generated from message secretary.v1.PushDevice
+ */
+export class PushDevice extends Message<PushDevice> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: secretary.v1.PushPlatform platform = 2;
+   */
+  platform = PushPlatform.UNSPECIFIED;
+
+  /**
+   * Shown in device lists, e.g. "Ana's iPhone".
+   *
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * Events the device is not alerted about. Every other event, including
+   * ones added later, is delivered.
+   *
+   * @generated from field: repeated secretary.v1.NotificationEvent muted_events = 4;
+   */
+  mutedEvents: NotificationEvent[] = [];
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt = "";
+
+  /**
+   * When the app last registered the device.
+   *
+   * @generated from field: string last_seen_at = 6;
+   */
+  lastSeenAt = "";
+
+  constructor(data?: PartialMessage<PushDevice>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.PushDevice";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "platform", kind: "enum", T: proto3.getEnumType(PushPlatform) },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "muted_events", kind: "enum", T: proto3.getEnumType(NotificationEvent), repeated: true },
+    { no: 5, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "last_seen_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PushDevice {
+    return new PushDevice().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PushDevice {
+    return new PushDevice().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PushDevice {
+    return new PushDevice().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PushDevice | PlainMessage<PushDevice> | undefined, b: PushDevice | PlainMessage<PushDevice> | undefined): boolean {
+    return proto3.util.equals(PushDevice, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RegisterDeviceRequest
+ */
+export class RegisterDeviceRequest extends Message<RegisterDeviceRequest> {
+  /**
+   * @generated from field: secretary.v1.PushPlatform platform = 1;
+   */
+  platform = PushPlatform.UNSPECIFIED;
+
+  /**
+   * The token FCM or APNs issued to the app.
+   *
+   * @generated from field: string token = 2;
+   */
+  token = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: repeated secretary.v1.NotificationEvent muted_events = 4;
+   */
+  mutedEvents: NotificationEvent[] = [];
+
+  constructor(data?: PartialMessage<RegisterDeviceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RegisterDeviceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "platform", kind: "enum", T: proto3.getEnumType(PushPlatform) },
+    { no: 2, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "muted_events", kind: "enum", T: proto3.getEnumType(NotificationEvent), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegisterDeviceRequest {
+    return new RegisterDeviceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegisterDeviceRequest {
+    return new RegisterDeviceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegisterDeviceRequest {
+    return new RegisterDeviceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RegisterDeviceRequest | PlainMessage<RegisterDeviceRequest> | undefined, b: RegisterDeviceRequest | PlainMessage<RegisterDeviceRequest> | undefined): boolean {
+    return proto3.util.equals(RegisterDeviceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RegisterDeviceResponse
+ */
+export class RegisterDeviceResponse extends Message<RegisterDeviceResponse> {
+  /**
+   * @generated from field: secretary.v1.PushDevice device = 1;
+   */
+  device?: PushDevice;
+
+  constructor(data?: PartialMessage<RegisterDeviceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RegisterDeviceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "device", kind: "message", T: PushDevice },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegisterDeviceResponse {
+    return new RegisterDeviceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegisterDeviceResponse {
+    return new RegisterDeviceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegisterDeviceResponse {
+    return new RegisterDeviceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RegisterDeviceResponse | PlainMessage<RegisterDeviceResponse> | undefined, b: RegisterDeviceResponse | PlainMessage<RegisterDeviceResponse> | undefined): boolean {
+    return proto3.util.equals(RegisterDeviceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListDevicesRequest
+ */
+export class ListDevicesRequest extends Message<ListDevicesRequest> {
+  constructor(data?: PartialMessage<ListDevicesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListDevicesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDevicesRequest {
+    return new ListDevicesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDevicesRequest {
+    return new ListDevicesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDevicesRequest {
+    return new ListDevicesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDevicesRequest | PlainMessage<ListDevicesRequest> | undefined, b: ListDevicesRequest | PlainMessage<ListDevicesRequest> | undefined): boolean {
+    return proto3.util.equals(ListDevicesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListDevicesResponse
+ */
+export class ListDevicesResponse extends Message<ListDevicesResponse> {
+  /**
+   * Most recently seen first.
+   *
+   * @generated from field: repeated secretary.v1.PushDevice devices = 1;
+   */
+  devices: PushDevice[] = [];
+
+  /**
+   * Platforms this server can deliver to.
+   *
+   * @generated from field: repeated secretary.v1.PushPlatform available_platforms = 2;
+   */
+  availablePlatforms: PushPlatform[] = [];
+
+  constructor(data?: PartialMessage<ListDevicesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListDevicesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "devices", kind: "message", T: PushDevice, repeated: true },
+    { no: 2, name: "available_platforms", kind: "enum", T: proto3.getEnumType(PushPlatform), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDevicesResponse {
+    return new ListDevicesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDevicesResponse {
+    return new ListDevicesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDevicesResponse {
+    return new ListDevicesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDevicesResponse | PlainMessage<ListDevicesResponse> | undefined, b: ListDevicesResponse | PlainMessage<ListDevicesResponse> | undefined): boolean {
+    return proto3.util.equals(ListDevicesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateDeviceRequest
+ */
+export class UpdateDeviceRequest extends Message<UpdateDeviceRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * Replaces the device's muted events.
+   *
+   * @generated from field: repeated secretary.v1.NotificationEvent muted_events = 2;
+   */
+  mutedEvents: NotificationEvent[] = [];
+
+  constructor(data?: PartialMessage<UpdateDeviceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateDeviceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "muted_events", kind: "enum", T: proto3.getEnumType(NotificationEvent), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateDeviceRequest {
+    return new UpdateDeviceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateDeviceRequest {
+    return new UpdateDeviceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateDeviceRequest {
+    return new UpdateDeviceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateDeviceRequest | PlainMessage<UpdateDeviceRequest> | undefined, b: UpdateDeviceRequest | PlainMessage<UpdateDeviceRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateDeviceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateDeviceResponse
+ */
+export class UpdateDeviceResponse extends Message<UpdateDeviceResponse> {
+  /**
+   * @generated from field: secretary.v1.PushDevice device = 1;
+   */
+  device?: PushDevice;
+
+  constructor(data?: PartialMessage<UpdateDeviceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateDeviceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "device", kind: "message", T: PushDevice },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateDeviceResponse {
+    return new UpdateDeviceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateDeviceResponse {
+    return new UpdateDeviceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateDeviceResponse {
+    return new UpdateDeviceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateDeviceResponse | PlainMessage<UpdateDeviceResponse> | undefined, b: UpdateDeviceResponse | PlainMessage<UpdateDeviceResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateDeviceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UnregisterDeviceRequest
+ */
+export class UnregisterDeviceRequest extends Message<UnregisterDeviceRequest> {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<UnregisterDeviceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UnregisterDeviceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnregisterDeviceRequest {
+    return new UnregisterDeviceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnregisterDeviceRequest {
+    return new UnregisterDeviceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnregisterDeviceRequest {
+    return new UnregisterDeviceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnregisterDeviceRequest | PlainMessage<UnregisterDeviceRequest> | undefined, b: UnregisterDeviceRequest | PlainMessage<UnregisterDeviceRequest> | undefined): boolean {
+    return proto3.util.equals(UnregisterDeviceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UnregisterDeviceResponse
+ */
+export class UnregisterDeviceResponse extends Message<UnregisterDeviceResponse> {
+  constructor(data?: PartialMessage<UnregisterDeviceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UnregisterDeviceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnregisterDeviceResponse {
+    return new UnregisterDeviceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnregisterDeviceResponse {
+    return new UnregisterDeviceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnregisterDeviceResponse {
+    return new UnregisterDeviceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnregisterDeviceResponse | PlainMessage<UnregisterDeviceResponse> | undefined, b: UnregisterDeviceResponse | PlainMessage<UnregisterDeviceResponse> | undefined): boolean {
+    return proto3.util.equals(UnregisterDeviceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetPreferencesRequest
+ */
+export class GetPreferencesRequest extends Message<GetPreferencesRequest> {
+  constructor(data?: PartialMessage<GetPreferencesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetPreferencesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPreferencesRequest {
+    return new GetPreferencesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPreferencesRequest {
+    return new GetPreferencesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPreferencesRequest {
+    return new GetPreferencesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPreferencesRequest | PlainMessage<GetPreferencesRequest> | undefined, b: GetPreferencesRequest | PlainMessage<GetPreferencesRequest> | undefined): boolean {
+    return proto3.util.equals(GetPreferencesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetPreferencesResponse
+ */
+export class GetPreferencesResponse extends Message<GetPreferencesResponse> {
+  /**
+   * @generated from field: secretary.v1.NotificationPreferences preferences = 1;
+   */
+  preferences?: NotificationPreferences;
+
+  /**
+   * Whether this server can deliver each channel at all; preferences for
+   * the others are kept but have no effect.
+   *
+   * @generated from field: bool email_available = 2;
+   */
+  emailAvailable = false;
+
+  /**
+   * @generated from field: bool chat_available = 3;
+   */
+  chatAvailable = false;
+
+  /**
+   * @generated from field: bool push_available = 4;
+   */
+  pushAvailable = false;
+
+  constructor(data?: PartialMessage<GetPreferencesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetPreferencesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "preferences", kind: "message", T: NotificationPreferences },
+    { no: 2, name: "email_available", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "chat_available", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "push_available", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPreferencesResponse {
+    return new GetPreferencesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPreferencesResponse {
+    return new GetPreferencesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPreferencesResponse {
+    return new GetPreferencesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPreferencesResponse | PlainMessage<GetPreferencesResponse> | undefined, b: GetPreferencesResponse | PlainMessage<GetPreferencesResponse> | undefined): boolean {
+    return proto3.util.equals(GetPreferencesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdatePreferencesRequest
+ */
+export class UpdatePreferencesRequest extends Message<UpdatePreferencesRequest> {
+  /**
+   * Replaces the caller's preferences.
+   *
+   * @generated from field: secretary.v1.NotificationPreferences preferences = 1;
+   */
+  preferences?: NotificationPreferences;
+
+  constructor(data?: PartialMessage<UpdatePreferencesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdatePreferencesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "preferences", kind: "message", T: NotificationPreferences },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdatePreferencesRequest {
+    return new UpdatePreferencesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdatePreferencesRequest {
+    return new UpdatePreferencesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdatePreferencesRequest {
+    return new UpdatePreferencesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdatePreferencesRequest | PlainMessage<UpdatePreferencesRequest> | undefined, b: UpdatePreferencesRequest | PlainMessage<UpdatePreferencesRequest> | undefined): boolean {
+    return proto3.util.equals(UpdatePreferencesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdatePreferencesResponse
+ */
+export class UpdatePreferencesResponse extends Message<UpdatePreferencesResponse> {
+  /**
+   * @generated from field: secretary.v1.NotificationPreferences preferences = 1;
+   */
+  preferences?: NotificationPreferences;
+
+  constructor(data?: PartialMessage<UpdatePreferencesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdatePreferencesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "preferences", kind: "message", T: NotificationPreferences },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdatePreferencesResponse {
+    return new UpdatePreferencesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdatePreferencesResponse {
+    return new UpdatePreferencesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdatePreferencesResponse {
+    return new UpdatePreferencesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdatePreferencesResponse | PlainMessage<UpdatePreferencesResponse> | undefined, b: UpdatePreferencesResponse | PlainMessage<UpdatePreferencesResponse> | undefined): boolean {
+    return proto3.util.equals(UpdatePreferencesResponse, a, b);
+  }
+}
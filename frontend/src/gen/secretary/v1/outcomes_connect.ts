@@ -0,0 +1,67 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts"
+// @generated from file secretary/v1/outcomes.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateOutcomeRequest, CreateOutcomeResponse, DeleteOutcomeRequest, DeleteOutcomeResponse, ExtractOutcomesRequest, ExtractOutcomesResponse, ListOutcomesRequest, ListOutcomesResponse, UpdateOutcomeRequest, UpdateOutcomeResponse } from "./outcomes_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service secretary.v1.OutcomesService
+ */
+export const OutcomesService = {
+  typeName: "secretary.v1.OutcomesService",
+  methods: {
+    /**
+     * @generated from rpc secretary.v1.OutcomesService.ListOutcomes
+     */
+    listOutcomes: {
+      name: "ListOutcomes",
+      I: ListOutcomesRequest,
+      O: ListOutcomesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * @generated from rpc secretary.v1.OutcomesService.CreateOutcome
+     */
+    createOutcome: {
+      name: "CreateOutcome",
+      I: CreateOutcomeRequest,
+      O: CreateOutcomeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.OutcomesService.UpdateOutcome
+     */
+    updateOutcome: {
+      name: "UpdateOutcome",
+      I: UpdateOutcomeRequest,
+      O: UpdateOutcomeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.OutcomesService.DeleteOutcome
+     */
+    deleteOutcome: {
+      name: "DeleteOutcome",
+      I: DeleteOutcomeRequest,
+      O: DeleteOutcomeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Reads the transcript with a summarization provider and stores the
+     * decisions, risks and open questions it finds, along with the meeting's
+     * sentiment. Running it again replaces the extracted outcomes; ones
+     * added with CreateOutcome are kept.
+     *
+     * @generated from rpc secretary.v1.OutcomesService.ExtractOutcomes
+     */
+    extractOutcomes: {
+      name: "ExtractOutcomes",
+      I: ExtractOutcomesRequest,
+      O: ExtractOutcomesResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
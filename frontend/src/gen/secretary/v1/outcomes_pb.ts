@@ -0,0 +1,625 @@
+// @generated by protoc-gen-es v1.10.1 with parameter "target=ts"
+// @generated from file secretary/v1/outcomes.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { Sentiment } from "./recordings_pb.js";
+
+/**
+ * @generated from enum secretary.v1.OutcomeKind
+ */
+export enum OutcomeKind {
+  /**
+   * @generated from enum value: OUTCOME_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: OUTCOME_KIND_DECISION = 1;
+   */
+  DECISION = 1,
+
+  /**
+   * @generated from enum value: OUTCOME_KIND_RISK = 2;
+   */
+  RISK = 2,
+
+  /**
+   * @generated from enum value: OUTCOME_KIND_OPEN_QUESTION = 3;
+   */
+  OPEN_QUESTION = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(OutcomeKind)
+proto3.util.setEnumType(OutcomeKind, "secretary.v1.OutcomeKind", [
+  { no: 0, name: "OUTCOME_KIND_UNSPECIFIED" },
+  { no: 1, name: "OUTCOME_KIND_DECISION" },
+  { no: 2, name: "OUTCOME_KIND_RISK" },
+  { no: 3, name: "OUTCOME_KIND_OPEN_QUESTION" },
+]);
+
+/**
+ * Something a meeting settled or left open, beyond its todos.
+ *
+ * @generated from message secretary.v1.Outcome
+ */
+export class Outcome extends Message<Outcome> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 recording_id = 2;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: string recording_name = 3;
+   */
+  recordingName = "";
+
+  /**
+   * @generated from field: string recording_date = 4;
+   */
+  recordingDate = "";
+
+  /**
+   * @generated from field: secretary.v1.OutcomeKind kind = 5;
+   */
+  kind = OutcomeKind.UNSPECIFIED;
+
+  /**
+   * @generated from field: string text = 6;
+   */
+  text = "";
+
+  /**
+   * Who made the decision, raised the risk or owns the question; 0 if
+   * nobody in particular.
+   *
+   * @generated from field: int64 owner_user_id = 7;
+   */
+  ownerUserId = protoInt64.zero;
+
+  /**
+   * The part of the transcript it was taken from.
+   *
+   * @generated from field: string quote = 8;
+   */
+  quote = "";
+
+  /**
+   * "manual" or "llm".
+   *
+   * @generated from field: string source_kind = 9;
+   */
+  sourceKind = "";
+
+  /**
+   * Risks that were mitigated and questions that were answered.
+   *
+   * @generated from field: bool resolved = 10;
+   */
+  resolved = false;
+
+  /**
+   * @generated from field: string resolved_at = 11;
+   */
+  resolvedAt = "";
+
+  /**
+   * @generated from field: int64 created_by_user_id = 12;
+   */
+  createdByUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 13;
+   */
+  createdAt = "";
+
+  /**
+   * @generated from field: string updated_at = 14;
+   */
+  updatedAt = "";
+
+  constructor(data?: PartialMessage<Outcome>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.Outcome";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "recording_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "recording_date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "kind", kind: "enum", T: proto3.getEnumType(OutcomeKind) },
+    { no: 6, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "owner_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "quote", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "source_kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "resolved", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "resolved_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "created_by_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 13, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Outcome {
+    return new Outcome().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Outcome {
+    return new Outcome().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Outcome {
+    return new Outcome().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Outcome | PlainMessage<Outcome> | undefined, b: Outcome | PlainMessage<Outcome> | undefined): boolean {
+    return proto3.util.equals(Outcome, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListOutcomesRequest
+ */
+export class ListOutcomesRequest extends Message<ListOutcomesRequest> {
+  /**
+   * @generated from field: optional int64 recording_id = 1;
+   */
+  recordingId?: bigint;
+
+  /**
+   * Every kind when empty.
+   *
+   * @generated from field: repeated secretary.v1.OutcomeKind kinds = 2;
+   */
+  kinds: OutcomeKind[] = [];
+
+  /**
+   * @generated from field: int64 owner_user_id = 3;
+   */
+  ownerUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: optional bool resolved = 4;
+   */
+  resolved?: boolean;
+
+  /**
+   * RFC3339 bounds on the meeting date; "after" is inclusive, "before"
+   * exclusive.
+   *
+   * @generated from field: string meeting_after = 5;
+   */
+  meetingAfter = "";
+
+  /**
+   * @generated from field: string meeting_before = 6;
+   */
+  meetingBefore = "";
+
+  /**
+   * Case-insensitive substring match on text and quote.
+   *
+   * @generated from field: string query = 7;
+   */
+  query = "";
+
+  constructor(data?: PartialMessage<ListOutcomesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListOutcomesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 2, name: "kinds", kind: "enum", T: proto3.getEnumType(OutcomeKind), repeated: true },
+    { no: 3, name: "owner_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "resolved", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 5, name: "meeting_after", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "meeting_before", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOutcomesRequest {
+    return new ListOutcomesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOutcomesRequest {
+    return new ListOutcomesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOutcomesRequest {
+    return new ListOutcomesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOutcomesRequest | PlainMessage<ListOutcomesRequest> | undefined, b: ListOutcomesRequest | PlainMessage<ListOutcomesRequest> | undefined): boolean {
+    return proto3.util.equals(ListOutcomesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListOutcomesResponse
+ */
+export class ListOutcomesResponse extends Message<ListOutcomesResponse> {
+  /**
+   * Newest meeting first, then by kind.
+   *
+   * @generated from field: repeated secretary.v1.Outcome outcomes = 1;
+   */
+  outcomes: Outcome[] = [];
+
+  constructor(data?: PartialMessage<ListOutcomesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListOutcomesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "outcomes", kind: "message", T: Outcome, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOutcomesResponse {
+    return new ListOutcomesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOutcomesResponse {
+    return new ListOutcomesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOutcomesResponse {
+    return new ListOutcomesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOutcomesResponse | PlainMessage<ListOutcomesResponse> | undefined, b: ListOutcomesResponse | PlainMessage<ListOutcomesResponse> | undefined): boolean {
+    return proto3.util.equals(ListOutcomesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CreateOutcomeRequest
+ */
+export class CreateOutcomeRequest extends Message<CreateOutcomeRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: secretary.v1.OutcomeKind kind = 2;
+   */
+  kind = OutcomeKind.UNSPECIFIED;
+
+  /**
+   * @generated from field: string text = 3;
+   */
+  text = "";
+
+  /**
+   * @generated from field: int64 owner_user_id = 4;
+   */
+  ownerUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string quote = 5;
+   */
+  quote = "";
+
+  constructor(data?: PartialMessage<CreateOutcomeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CreateOutcomeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "kind", kind: "enum", T: proto3.getEnumType(OutcomeKind) },
+    { no: 3, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "owner_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "quote", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOutcomeRequest {
+    return new CreateOutcomeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOutcomeRequest {
+    return new CreateOutcomeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOutcomeRequest {
+    return new CreateOutcomeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateOutcomeRequest | PlainMessage<CreateOutcomeRequest> | undefined, b: CreateOutcomeRequest | PlainMessage<CreateOutcomeRequest> | undefined): boolean {
+    return proto3.util.equals(CreateOutcomeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CreateOutcomeResponse
+ */
+export class CreateOutcomeResponse extends Message<CreateOutcomeResponse> {
+  /**
+   * @generated from field: secretary.v1.Outcome outcome = 1;
+   */
+  outcome?: Outcome;
+
+  constructor(data?: PartialMessage<CreateOutcomeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CreateOutcomeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "outcome", kind: "message", T: Outcome },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOutcomeResponse {
+    return new CreateOutcomeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOutcomeResponse {
+    return new CreateOutcomeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOutcomeResponse {
+    return new CreateOutcomeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateOutcomeResponse | PlainMessage<CreateOutcomeResponse> | undefined, b: CreateOutcomeResponse | PlainMessage<CreateOutcomeResponse> | undefined): boolean {
+    return proto3.util.equals(CreateOutcomeResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateOutcomeRequest
+ */
+export class UpdateOutcomeRequest extends Message<UpdateOutcomeRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string text = 2;
+   */
+  text = "";
+
+  /**
+   * @generated from field: int64 owner_user_id = 3;
+   */
+  ownerUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: bool resolved = 4;
+   */
+  resolved = false;
+
+  constructor(data?: PartialMessage<UpdateOutcomeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateOutcomeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "owner_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "resolved", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOutcomeRequest {
+    return new UpdateOutcomeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOutcomeRequest {
+    return new UpdateOutcomeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOutcomeRequest {
+    return new UpdateOutcomeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateOutcomeRequest | PlainMessage<UpdateOutcomeRequest> | undefined, b: UpdateOutcomeRequest | PlainMessage<UpdateOutcomeRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateOutcomeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateOutcomeResponse
+ */
+export class UpdateOutcomeResponse extends Message<UpdateOutcomeResponse> {
+  /**
+   * @generated from field: secretary.v1.Outcome outcome = 1;
+   */
+  outcome?: Outcome;
+
+  constructor(data?: PartialMessage<UpdateOutcomeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateOutcomeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "outcome", kind: "message", T: Outcome },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOutcomeResponse {
+    return new UpdateOutcomeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOutcomeResponse {
+    return new UpdateOutcomeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOutcomeResponse {
+    return new UpdateOutcomeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateOutcomeResponse | PlainMessage<UpdateOutcomeResponse> | undefined, b: UpdateOutcomeResponse | PlainMessage<UpdateOutcomeResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateOutcomeResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteOutcomeRequest
+ */
+export class DeleteOutcomeRequest extends Message<DeleteOutcomeRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeleteOutcomeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteOutcomeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteOutcomeRequest {
+    return new DeleteOutcomeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteOutcomeRequest {
+    return new DeleteOutcomeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteOutcomeRequest {
+    return new DeleteOutcomeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteOutcomeRequest | PlainMessage<DeleteOutcomeRequest> | undefined, b: DeleteOutcomeRequest | PlainMessage<DeleteOutcomeRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteOutcomeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteOutcomeResponse
+ */
+export class DeleteOutcomeResponse extends Message<DeleteOutcomeResponse> {
+  constructor(data?: PartialMessage<DeleteOutcomeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteOutcomeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteOutcomeResponse {
+    return new DeleteOutcomeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteOutcomeResponse {
+    return new DeleteOutcomeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteOutcomeResponse {
+    return new DeleteOutcomeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteOutcomeResponse | PlainMessage<DeleteOutcomeResponse> | undefined, b: DeleteOutcomeResponse | PlainMessage<DeleteOutcomeResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteOutcomeResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ExtractOutcomesRequest
+ */
+export class ExtractOutcomesRequest extends Message<ExtractOutcomesRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ExtractOutcomesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ExtractOutcomesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExtractOutcomesRequest {
+    return new ExtractOutcomesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExtractOutcomesRequest {
+    return new ExtractOutcomesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExtractOutcomesRequest {
+    return new ExtractOutcomesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExtractOutcomesRequest | PlainMessage<ExtractOutcomesRequest> | undefined, b: ExtractOutcomesRequest | PlainMessage<ExtractOutcomesRequest> | undefined): boolean {
+    return proto3.util.equals(ExtractOutcomesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ExtractOutcomesResponse
+ */
+export class ExtractOutcomesResponse extends Message<ExtractOutcomesResponse> {
+  /**
+   * Every outcome of the recording, extracted and manual.
+   *
+   * @generated from field: repeated secretary.v1.Outcome outcomes = 1;
+   */
+  outcomes: Outcome[] = [];
+
+  /**
+   * @generated from field: secretary.v1.Sentiment sentiment = 2;
+   */
+  sentiment = Sentiment.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ExtractOutcomesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ExtractOutcomesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "outcomes", kind: "message", T: Outcome, repeated: true },
+    { no: 2, name: "sentiment", kind: "enum", T: proto3.getEnumType(Sentiment) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExtractOutcomesResponse {
+    return new ExtractOutcomesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExtractOutcomesResponse {
+    return new ExtractOutcomesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExtractOutcomesResponse {
+    return new ExtractOutcomesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExtractOutcomesResponse | PlainMessage<ExtractOutcomesResponse> | undefined, b: ExtractOutcomesResponse | PlainMessage<ExtractOutcomesResponse> | undefined): boolean {
+    return proto3.util.equals(ExtractOutcomesResponse, a, b);
+  }
+}
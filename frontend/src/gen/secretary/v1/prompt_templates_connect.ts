@@ -0,0 +1,70 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts"
+// @generated from file secretary/v1/prompt_templates.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreatePromptTemplateRequest, CreatePromptTemplateResponse, DeletePromptTemplateRequest, DeletePromptTemplateResponse, ListPromptTemplatesRequest, ListPromptTemplatesResponse, SetRecordingPromptTemplateRequest, SetRecordingPromptTemplateResponse, UpdatePromptTemplateRequest, UpdatePromptTemplateResponse } from "./prompt_templates_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service secretary.v1.PromptTemplatesService
+ */
+export const PromptTemplatesService = {
+  typeName: "secretary.v1.PromptTemplatesService",
+  methods: {
+    /**
+     * @generated from rpc secretary.v1.PromptTemplatesService.ListPromptTemplates
+     */
+    listPromptTemplates: {
+      name: "ListPromptTemplates",
+      I: ListPromptTemplatesRequest,
+      O: ListPromptTemplatesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Creating, changing and deleting templates is admin only. Names are
+     * unique; a taken name is rejected with ALREADY_EXISTS.
+     *
+     * @generated from rpc secretary.v1.PromptTemplatesService.CreatePromptTemplate
+     */
+    createPromptTemplate: {
+      name: "CreatePromptTemplate",
+      I: CreatePromptTemplateRequest,
+      O: CreatePromptTemplateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.PromptTemplatesService.UpdatePromptTemplate
+     */
+    updatePromptTemplate: {
+      name: "UpdatePromptTemplate",
+      I: UpdatePromptTemplateRequest,
+      O: UpdatePromptTemplateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Recordings using the template go back to the built-in prompts.
+     *
+     * @generated from rpc secretary.v1.PromptTemplatesService.DeletePromptTemplate
+     */
+    deletePromptTemplate: {
+      name: "DeletePromptTemplate",
+      I: DeletePromptTemplateRequest,
+      O: DeletePromptTemplateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Chooses the template Summarize, ExtractOutcomes and GenerateMinutes
+     * use for a recording. Anyone may choose one.
+     *
+     * @generated from rpc secretary.v1.PromptTemplatesService.SetRecordingPromptTemplate
+     */
+    setRecordingPromptTemplate: {
+      name: "SetRecordingPromptTemplate",
+      I: SetRecordingPromptTemplateRequest,
+      O: SetRecordingPromptTemplateResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
@@ -0,0 +1,500 @@
+// @generated by protoc-gen-es v1.10.1 with parameter "target=ts"
+// @generated from file secretary/v1/prompt_templates.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * Prompts for a kind of meeting, such as a standup or a board meeting,
+ * that recordings can opt into. Recordings without one use the built-in
+ * prompts.
+ *
+ * @generated from message secretary.v1.PromptTemplate
+ */
+export class PromptTemplate extends Message<PromptTemplate> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description = "";
+
+  /**
+   * Replaces the built-in summarization prompt; empty keeps it.
+   *
+   * @generated from field: string summary_instructions = 4;
+   */
+  summaryInstructions = "";
+
+  /**
+   * Added to the prompts that extract outcomes and draft minutes, e.g.
+   * "Treat every budget figure as a decision". Those prompts keep their
+   * answer format, so this can only steer what goes in it.
+   *
+   * @generated from field: string extraction_guidance = 5;
+   */
+  extractionGuidance = "";
+
+  /**
+   * @generated from field: int64 created_by_user_id = 6;
+   */
+  createdByUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 7;
+   */
+  createdAt = "";
+
+  /**
+   * @generated from field: string updated_at = 8;
+   */
+  updatedAt = "";
+
+  constructor(data?: PartialMessage<PromptTemplate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.PromptTemplate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "summary_instructions", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "extraction_guidance", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "created_by_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromptTemplate {
+    return new PromptTemplate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromptTemplate {
+    return new PromptTemplate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromptTemplate {
+    return new PromptTemplate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromptTemplate | PlainMessage<PromptTemplate> | undefined, b: PromptTemplate | PlainMessage<PromptTemplate> | undefined): boolean {
+    return proto3.util.equals(PromptTemplate, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListPromptTemplatesRequest
+ */
+export class ListPromptTemplatesRequest extends Message<ListPromptTemplatesRequest> {
+  constructor(data?: PartialMessage<ListPromptTemplatesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListPromptTemplatesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPromptTemplatesRequest {
+    return new ListPromptTemplatesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPromptTemplatesRequest {
+    return new ListPromptTemplatesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPromptTemplatesRequest {
+    return new ListPromptTemplatesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPromptTemplatesRequest | PlainMessage<ListPromptTemplatesRequest> | undefined, b: ListPromptTemplatesRequest | PlainMessage<ListPromptTemplatesRequest> | undefined): boolean {
+    return proto3.util.equals(ListPromptTemplatesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListPromptTemplatesResponse
+ */
+export class ListPromptTemplatesResponse extends Message<ListPromptTemplatesResponse> {
+  /**
+   * By name.
+   *
+   * @generated from field: repeated secretary.v1.PromptTemplate templates = 1;
+   */
+  templates: PromptTemplate[] = [];
+
+  constructor(data?: PartialMessage<ListPromptTemplatesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListPromptTemplatesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "templates", kind: "message", T: PromptTemplate, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPromptTemplatesResponse {
+    return new ListPromptTemplatesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPromptTemplatesResponse {
+    return new ListPromptTemplatesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPromptTemplatesResponse {
+    return new ListPromptTemplatesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPromptTemplatesResponse | PlainMessage<ListPromptTemplatesResponse> | undefined, b: ListPromptTemplatesResponse | PlainMessage<ListPromptTemplatesResponse> | undefined): boolean {
+    return proto3.util.equals(ListPromptTemplatesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CreatePromptTemplateRequest
+ */
+export class CreatePromptTemplateRequest extends Message<CreatePromptTemplateRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description = "";
+
+  /**
+   * @generated from field: string summary_instructions = 3;
+   */
+  summaryInstructions = "";
+
+  /**
+   * @generated from field: string extraction_guidance = 4;
+   */
+  extractionGuidance = "";
+
+  constructor(data?: PartialMessage<CreatePromptTemplateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CreatePromptTemplateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "summary_instructions", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "extraction_guidance", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePromptTemplateRequest {
+    return new CreatePromptTemplateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePromptTemplateRequest {
+    return new CreatePromptTemplateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePromptTemplateRequest {
+    return new CreatePromptTemplateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreatePromptTemplateRequest | PlainMessage<CreatePromptTemplateRequest> | undefined, b: CreatePromptTemplateRequest | PlainMessage<CreatePromptTemplateRequest> | undefined): boolean {
+    return proto3.util.equals(CreatePromptTemplateRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CreatePromptTemplateResponse
+ */
+export class CreatePromptTemplateResponse extends Message<CreatePromptTemplateResponse> {
+  /**
+   * @generated from field: secretary.v1.PromptTemplate template = 1;
+   */
+  template?: PromptTemplate;
+
+  constructor(data?: PartialMessage<CreatePromptTemplateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CreatePromptTemplateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "template", kind: "message", T: PromptTemplate },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePromptTemplateResponse {
+    return new CreatePromptTemplateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePromptTemplateResponse {
+    return new CreatePromptTemplateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePromptTemplateResponse {
+    return new CreatePromptTemplateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreatePromptTemplateResponse | PlainMessage<CreatePromptTemplateResponse> | undefined, b: CreatePromptTemplateResponse | PlainMessage<CreatePromptTemplateResponse> | undefined): boolean {
+    return proto3.util.equals(CreatePromptTemplateResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdatePromptTemplateRequest
+ */
+export class UpdatePromptTemplateRequest extends Message<UpdatePromptTemplateRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description = "";
+
+  /**
+   * @generated from field: string summary_instructions = 4;
+   */
+  summaryInstructions = "";
+
+  /**
+   * @generated from field: string extraction_guidance = 5;
+   */
+  extractionGuidance = "";
+
+  constructor(data?: PartialMessage<UpdatePromptTemplateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdatePromptTemplateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "summary_instructions", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "extraction_guidance", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdatePromptTemplateRequest {
+    return new UpdatePromptTemplateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdatePromptTemplateRequest {
+    return new UpdatePromptTemplateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdatePromptTemplateRequest {
+    return new UpdatePromptTemplateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdatePromptTemplateRequest | PlainMessage<UpdatePromptTemplateRequest> | undefined, b: UpdatePromptTemplateRequest | PlainMessage<UpdatePromptTemplateRequest> | undefined): boolean {
+    return proto3.util.equals(UpdatePromptTemplateRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdatePromptTemplateResponse
+ */
+export class UpdatePromptTemplateResponse extends Message<UpdatePromptTemplateResponse> {
+  /**
+   * @generated from field: secretary.v1.PromptTemplate template = 1;
+   */
+  template?: PromptTemplate;
+
+  constructor(data?: PartialMessage<UpdatePromptTemplateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdatePromptTemplateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "template", kind: "message", T: PromptTemplate },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdatePromptTemplateResponse {
+    return new UpdatePromptTemplateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdatePromptTemplateResponse {
+    return new UpdatePromptTemplateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdatePromptTemplateResponse {
+    return new UpdatePromptTemplateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdatePromptTemplateResponse | PlainMessage<UpdatePromptTemplateResponse> | undefined, b: UpdatePromptTemplateResponse | PlainMessage<UpdatePromptTemplateResponse> | undefined): boolean {
+    return proto3.util.equals(UpdatePromptTemplateResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeletePromptTemplateRequest
+ */
+export class DeletePromptTemplateRequest extends Message<DeletePromptTemplateRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeletePromptTemplateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeletePromptTemplateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeletePromptTemplateRequest {
+    return new DeletePromptTemplateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeletePromptTemplateRequest {
+    return new DeletePromptTemplateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeletePromptTemplateRequest {
+    return new DeletePromptTemplateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeletePromptTemplateRequest | PlainMessage<DeletePromptTemplateRequest> | undefined, b: DeletePromptTemplateRequest | PlainMessage<DeletePromptTemplateRequest> | undefined): boolean {
+    return proto3.util.equals(DeletePromptTemplateRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeletePromptTemplateResponse
+ */
+export class DeletePromptTemplateResponse extends Message<DeletePromptTemplateResponse> {
+  constructor(data?: PartialMessage<DeletePromptTemplateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeletePromptTemplateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeletePromptTemplateResponse {
+    return new DeletePromptTemplateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeletePromptTemplateResponse {
+    return new DeletePromptTemplateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeletePromptTemplateResponse {
+    return new DeletePromptTemplateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeletePromptTemplateResponse | PlainMessage<DeletePromptTemplateResponse> | undefined, b: DeletePromptTemplateResponse | PlainMessage<DeletePromptTemplateResponse> | undefined): boolean {
+    return proto3.util.equals(DeletePromptTemplateResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SetRecordingPromptTemplateRequest
+ */
+export class SetRecordingPromptTemplateRequest extends Message<SetRecordingPromptTemplateRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * 0 goes back to the built-in prompts.
+   *
+   * @generated from field: int64 template_id = 2;
+   */
+  templateId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SetRecordingPromptTemplateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SetRecordingPromptTemplateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "template_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetRecordingPromptTemplateRequest {
+    return new SetRecordingPromptTemplateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetRecordingPromptTemplateRequest {
+    return new SetRecordingPromptTemplateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetRecordingPromptTemplateRequest {
+    return new SetRecordingPromptTemplateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetRecordingPromptTemplateRequest | PlainMessage<SetRecordingPromptTemplateRequest> | undefined, b: SetRecordingPromptTemplateRequest | PlainMessage<SetRecordingPromptTemplateRequest> | undefined): boolean {
+    return proto3.util.equals(SetRecordingPromptTemplateRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SetRecordingPromptTemplateResponse
+ */
+export class SetRecordingPromptTemplateResponse extends Message<SetRecordingPromptTemplateResponse> {
+  constructor(data?: PartialMessage<SetRecordingPromptTemplateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SetRecordingPromptTemplateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetRecordingPromptTemplateResponse {
+    return new SetRecordingPromptTemplateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetRecordingPromptTemplateResponse {
+    return new SetRecordingPromptTemplateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetRecordingPromptTemplateResponse {
+    return new SetRecordingPromptTemplateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetRecordingPromptTemplateResponse | PlainMessage<SetRecordingPromptTemplateResponse> | undefined, b: SetRecordingPromptTemplateResponse | PlainMessage<SetRecordingPromptTemplateResponse> | undefined): boolean {
+    return proto3.util.equals(SetRecordingPromptTemplateResponse, a, b);
+  }
+}
@@ -0,0 +1,51 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts"
+// @generated from file secretary/v1/quarantine.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { DeleteQuarantinedFileRequest, DeleteQuarantinedFileResponse, ListQuarantinedFilesRequest, ListQuarantinedFilesResponse, ReleaseQuarantinedFileRequest, ReleaseQuarantinedFileResponse } from "./quarantine_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * Review of files the malware scanner quarantined. Admin only.
+ *
+ * @generated from service secretary.v1.QuarantineService
+ */
+export const QuarantineService = {
+  typeName: "secretary.v1.QuarantineService",
+  methods: {
+    /**
+     * @generated from rpc secretary.v1.QuarantineService.ListQuarantinedFiles
+     */
+    listQuarantinedFiles: {
+      name: "ListQuarantinedFiles",
+      I: ListQuarantinedFilesRequest,
+      O: ListQuarantinedFilesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Makes the file available again.
+     *
+     * @generated from rpc secretary.v1.QuarantineService.ReleaseQuarantinedFile
+     */
+    releaseQuarantinedFile: {
+      name: "ReleaseQuarantinedFile",
+      I: ReleaseQuarantinedFileRequest,
+      O: ReleaseQuarantinedFileResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Deletes the attachment, or the recording with its audio. Recordings
+     * under legal hold can't be deleted.
+     *
+     * @generated from rpc secretary.v1.QuarantineService.DeleteQuarantinedFile
+     */
+    deleteQuarantinedFile: {
+      name: "DeleteQuarantinedFile",
+      I: DeleteQuarantinedFileRequest,
+      O: DeleteQuarantinedFileResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
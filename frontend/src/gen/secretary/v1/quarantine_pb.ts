@@ -0,0 +1,392 @@
+// @generated by protoc-gen-es v1.10.1 with parameter "target=ts"
+// @generated from file secretary/v1/quarantine.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * What the malware scanner made of a stored file.
+ *
+ * @generated from enum secretary.v1.ScanStatus
+ */
+export enum ScanStatus {
+  /**
+   * @generated from enum value: SCAN_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Stored while no scanner was configured.
+   *
+   * @generated from enum value: SCAN_STATUS_UNSCANNED = 1;
+   */
+  UNSCANNED = 1,
+
+  /**
+   * Waiting for the scanner; recording audio is scanned in the background
+   * and isn't transcribed or clipped until it is clean.
+   *
+   * @generated from enum value: SCAN_STATUS_PENDING = 2;
+   */
+  PENDING = 2,
+
+  /**
+   * @generated from enum value: SCAN_STATUS_CLEAN = 3;
+   */
+  CLEAN = 3,
+
+  /**
+   * Found infected, or refused by the scanner, and held for an admin to
+   * review. Quarantined files can't be downloaded, transcribed or clipped.
+   *
+   * @generated from enum value: SCAN_STATUS_QUARANTINED = 4;
+   */
+  QUARANTINED = 4,
+
+  /**
+   * Quarantined, then released by an admin.
+   *
+   * @generated from enum value: SCAN_STATUS_RELEASED = 5;
+   */
+  RELEASED = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ScanStatus)
+proto3.util.setEnumType(ScanStatus, "secretary.v1.ScanStatus", [
+  { no: 0, name: "SCAN_STATUS_UNSPECIFIED" },
+  { no: 1, name: "SCAN_STATUS_UNSCANNED" },
+  { no: 2, name: "SCAN_STATUS_PENDING" },
+  { no: 3, name: "SCAN_STATUS_CLEAN" },
+  { no: 4, name: "SCAN_STATUS_QUARANTINED" },
+  { no: 5, name: "SCAN_STATUS_RELEASED" },
+]);
+
+/**
+ * @generated from enum secretary.v1.ScannedFileKind
+ */
+export enum ScannedFileKind {
+  /**
+   * @generated from enum value: SCANNED_FILE_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SCANNED_FILE_KIND_ATTACHMENT = 1;
+   */
+  ATTACHMENT = 1,
+
+  /**
+   * A recording's audio.
+   *
+   * @generated from enum value: SCANNED_FILE_KIND_RECORDING = 2;
+   */
+  RECORDING = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ScannedFileKind)
+proto3.util.setEnumType(ScannedFileKind, "secretary.v1.ScannedFileKind", [
+  { no: 0, name: "SCANNED_FILE_KIND_UNSPECIFIED" },
+  { no: 1, name: "SCANNED_FILE_KIND_ATTACHMENT" },
+  { no: 2, name: "SCANNED_FILE_KIND_RECORDING" },
+]);
+
+/**
+ * @generated from message secretary.v1.QuarantinedFile
+ */
+export class QuarantinedFile extends Message<QuarantinedFile> {
+  /**
+   * @generated from field: secretary.v1.ScannedFileKind kind = 1;
+   */
+  kind = ScannedFileKind.UNSPECIFIED;
+
+  /**
+   * The attachment's or the recording's ID.
+   *
+   * @generated from field: int64 id = 2;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * The attachment's file name or the recording's name.
+   *
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * The signature found, or why the scanner refused the file.
+   *
+   * @generated from field: string detail = 4;
+   */
+  detail = "";
+
+  /**
+   * @generated from field: string scanned_at = 5;
+   */
+  scannedAt = "";
+
+  /**
+   * Who uploaded it; 0 when unknown.
+   *
+   * @generated from field: int64 uploaded_by_user_id = 6;
+   */
+  uploadedByUserId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<QuarantinedFile>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.QuarantinedFile";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(ScannedFileKind) },
+    { no: 2, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "detail", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "scanned_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "uploaded_by_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuarantinedFile {
+    return new QuarantinedFile().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuarantinedFile {
+    return new QuarantinedFile().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuarantinedFile {
+    return new QuarantinedFile().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QuarantinedFile | PlainMessage<QuarantinedFile> | undefined, b: QuarantinedFile | PlainMessage<QuarantinedFile> | undefined): boolean {
+    return proto3.util.equals(QuarantinedFile, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListQuarantinedFilesRequest
+ */
+export class ListQuarantinedFilesRequest extends Message<ListQuarantinedFilesRequest> {
+  constructor(data?: PartialMessage<ListQuarantinedFilesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListQuarantinedFilesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListQuarantinedFilesRequest {
+    return new ListQuarantinedFilesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListQuarantinedFilesRequest {
+    return new ListQuarantinedFilesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListQuarantinedFilesRequest {
+    return new ListQuarantinedFilesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListQuarantinedFilesRequest | PlainMessage<ListQuarantinedFilesRequest> | undefined, b: ListQuarantinedFilesRequest | PlainMessage<ListQuarantinedFilesRequest> | undefined): boolean {
+    return proto3.util.equals(ListQuarantinedFilesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListQuarantinedFilesResponse
+ */
+export class ListQuarantinedFilesResponse extends Message<ListQuarantinedFilesResponse> {
+  /**
+   * Oldest first.
+   *
+   * @generated from field: repeated secretary.v1.QuarantinedFile files = 1;
+   */
+  files: QuarantinedFile[] = [];
+
+  constructor(data?: PartialMessage<ListQuarantinedFilesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListQuarantinedFilesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "files", kind: "message", T: QuarantinedFile, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListQuarantinedFilesResponse {
+    return new ListQuarantinedFilesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListQuarantinedFilesResponse {
+    return new ListQuarantinedFilesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListQuarantinedFilesResponse {
+    return new ListQuarantinedFilesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListQuarantinedFilesResponse | PlainMessage<ListQuarantinedFilesResponse> | undefined, b: ListQuarantinedFilesResponse | PlainMessage<ListQuarantinedFilesResponse> | undefined): boolean {
+    return proto3.util.equals(ListQuarantinedFilesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ReleaseQuarantinedFileRequest
+ */
+export class ReleaseQuarantinedFileRequest extends Message<ReleaseQuarantinedFileRequest> {
+  /**
+   * @generated from field: secretary.v1.ScannedFileKind kind = 1;
+   */
+  kind = ScannedFileKind.UNSPECIFIED;
+
+  /**
+   * @generated from field: int64 id = 2;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * Why the file is safe, e.g. a false positive; logged with the release.
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<ReleaseQuarantinedFileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ReleaseQuarantinedFileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(ScannedFileKind) },
+    { no: 2, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReleaseQuarantinedFileRequest {
+    return new ReleaseQuarantinedFileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReleaseQuarantinedFileRequest {
+    return new ReleaseQuarantinedFileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReleaseQuarantinedFileRequest {
+    return new ReleaseQuarantinedFileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReleaseQuarantinedFileRequest | PlainMessage<ReleaseQuarantinedFileRequest> | undefined, b: ReleaseQuarantinedFileRequest | PlainMessage<ReleaseQuarantinedFileRequest> | undefined): boolean {
+    return proto3.util.equals(ReleaseQuarantinedFileRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ReleaseQuarantinedFileResponse
+ */
+export class ReleaseQuarantinedFileResponse extends Message<ReleaseQuarantinedFileResponse> {
+  constructor(data?: PartialMessage<ReleaseQuarantinedFileResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ReleaseQuarantinedFileResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReleaseQuarantinedFileResponse {
+    return new ReleaseQuarantinedFileResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReleaseQuarantinedFileResponse {
+    return new ReleaseQuarantinedFileResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReleaseQuarantinedFileResponse {
+    return new ReleaseQuarantinedFileResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReleaseQuarantinedFileResponse | PlainMessage<ReleaseQuarantinedFileResponse> | undefined, b: ReleaseQuarantinedFileResponse | PlainMessage<ReleaseQuarantinedFileResponse> | undefined): boolean {
+    return proto3.util.equals(ReleaseQuarantinedFileResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteQuarantinedFileRequest
+ */
+export class DeleteQuarantinedFileRequest extends Message<DeleteQuarantinedFileRequest> {
+  /**
+   * @generated from field: secretary.v1.ScannedFileKind kind = 1;
+   */
+  kind = ScannedFileKind.UNSPECIFIED;
+
+  /**
+   * @generated from field: int64 id = 2;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeleteQuarantinedFileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteQuarantinedFileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(ScannedFileKind) },
+    { no: 2, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteQuarantinedFileRequest {
+    return new DeleteQuarantinedFileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteQuarantinedFileRequest {
+    return new DeleteQuarantinedFileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteQuarantinedFileRequest {
+    return new DeleteQuarantinedFileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteQuarantinedFileRequest | PlainMessage<DeleteQuarantinedFileRequest> | undefined, b: DeleteQuarantinedFileRequest | PlainMessage<DeleteQuarantinedFileRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteQuarantinedFileRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteQuarantinedFileResponse
+ */
+export class DeleteQuarantinedFileResponse extends Message<DeleteQuarantinedFileResponse> {
+  constructor(data?: PartialMessage<DeleteQuarantinedFileResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteQuarantinedFileResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteQuarantinedFileResponse {
+    return new DeleteQuarantinedFileResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteQuarantinedFileResponse {
+    return new DeleteQuarantinedFileResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteQuarantinedFileResponse {
+    return new DeleteQuarantinedFileResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteQuarantinedFileResponse | PlainMessage<DeleteQuarantinedFileResponse> | undefined, b: DeleteQuarantinedFileResponse | PlainMessage<DeleteQuarantinedFileResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteQuarantinedFileResponse, a, b);
+  }
+}
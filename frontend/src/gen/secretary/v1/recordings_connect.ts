@@ -3,8 +3,8 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { DeleteRecordingRequest, DeleteRecordingResponse, GetRecordingRequest, GetRecordingResponse, ListRecordingsRequest, ListRecordingsResponse } from "./recordings_pb.js";
-import { MethodKind } from "@bufbuild/protobuf";
+import { AddGuestParticipantRequest, AddGuestParticipantResponse, CloneRecordingRequest, CloneRecordingResponse, ConfirmUploadRequest, ConfirmUploadResponse, CreateClipRequest, CreateClipResponse, DeleteRecordingRequest, DeleteRecordingResponse, GenerateMinutesRequest, GenerateMinutesResponse, GetMinutesRequest, GetMinutesResponse, GetRecordingRequest, GetRecordingResponse, GetRecordingTranscriptRequest, GetRecordingTranscriptResponse, GetTranscriptRevisionDiffRequest, GetTranscriptRevisionDiffResponse, GetUploadURLRequest, GetUploadURLResponse, LinkMentionsRequest, LinkMentionsResponse, ListClipsRequest, ListClipsResponse, ListMinutesVersionsRequest, ListMinutesVersionsResponse, ListPublicationsRequest, ListPublicationsResponse, ListRecordingsRequest, ListRecordingsResponse, ListTranscriptRevisionsRequest, ListTranscriptRevisionsResponse, ListTranscriptSegmentsRequest, ListTranscriptSegmentsResponse, PublishRecordingRequest, PublishRecordingResponse, RelabelSpeakerRequest, RelabelSpeakerResponse, RemoveGuestParticipantRequest, RemoveGuestParticipantResponse, RetryProcessingRequest, RetryProcessingResponse, SetLegalHoldRequest, SetLegalHoldResponse, SetRecordingRetentionRequest, SetRecordingRetentionResponse, StarRecordingRequest, StarRecordingResponse, SummarizeRequest, SummarizeResponse, TranslateTranscriptRequest, TranslateTranscriptResponse, UnstarRecordingRequest, UnstarRecordingResponse, UpdateMinutesRequest, UpdateMinutesResponse, UpdateProcessingSettingsRequest, UpdateProcessingSettingsResponse } from "./recordings_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
 
 /**
  * @generated from service secretary.v1.RecordingsService
@@ -20,6 +20,7 @@ export const RecordingsService = {
       I: ListRecordingsRequest,
       O: ListRecordingsResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
     },
     /**
      * @generated from rpc secretary.v1.RecordingsService.GetRecording
@@ -29,8 +30,13 @@ export const RecordingsService = {
       I: GetRecordingRequest,
       O: GetRecordingResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
     },
     /**
+     * Deletes a recording with its stored audio, clips and attachments,
+     * keeping audio another recording still shares. Admin only. Recordings
+     * under legal hold are rejected with FAILED_PRECONDITION.
+     *
      * @generated from rpc secretary.v1.RecordingsService.DeleteRecording
      */
     deleteRecording: {
@@ -39,6 +45,340 @@ export const RecordingsService = {
       O: DeleteRecordingResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Queues another run of a stage for a failed or ready recording. Admin
+     * only. Retrying transcription also reruns summarization afterwards.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.RetryProcessing
+     */
+    retryProcessing: {
+      name: "RetryProcessing",
+      I: RetryProcessingRequest,
+      O: RetryProcessingResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Summarizes the transcript now. With a target language the summary is
+     * stored as a translation next to the original; without one it replaces
+     * the original, which only admins may do.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.Summarize
+     */
+    summarize: {
+      name: "Summarize",
+      I: SummarizeRequest,
+      O: SummarizeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Translates the transcript and stores it next to the original.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.TranslateTranscript
+     */
+    translateTranscript: {
+      name: "TranslateTranscript",
+      I: TranslateTranscriptRequest,
+      O: TranslateTranscriptResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Finds people named in the transcript and assigns the recording's
+     * unassigned todos to the one person each names. Runs on its own when a
+     * recording becomes ready; call it again after fixing a transcript.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.LinkMentions
+     */
+    linkMentions: {
+      name: "LinkMentions",
+      I: LinkMentionsRequest,
+      O: LinkMentionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Pins a recording for the caller. Starring one twice is a no-op, as is
+     * unstarring one that isn't starred.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.StarRecording
+     */
+    starRecording: {
+      name: "StarRecording",
+      I: StarRecordingRequest,
+      O: StarRecordingResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.RecordingsService.UnstarRecording
+     */
+    unstarRecording: {
+      name: "UnstarRecording",
+      I: UnstarRecordingRequest,
+      O: UnstarRecordingResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Cuts a stretch of the recording's stored audio into a clip anyone with
+     * its share URL can play. Recordings whose audio lives elsewhere are
+     * rejected with FAILED_PRECONDITION.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.CreateClip
+     */
+    createClip: {
+      name: "CreateClip",
+      I: CreateClipRequest,
+      O: CreateClipResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.RecordingsService.ListClips
+     */
+    listClips: {
+      name: "ListClips",
+      I: ListClipsRequest,
+      O: ListClipsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Drafts minutes from the transcript and the recording's todos and saves
+     * them as the next version. Attendees come from the identified
+     * participants and action items from the todos; the agenda, decisions
+     * and notes are written by a summarization provider.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.GenerateMinutes
+     */
+    generateMinutes: {
+      name: "GenerateMinutes",
+      I: GenerateMinutesRequest,
+      O: GenerateMinutesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.RecordingsService.GetMinutes
+     */
+    getMinutes: {
+      name: "GetMinutes",
+      I: GetMinutesRequest,
+      O: GetMinutesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Saves an edited document as the next version. Fails with
+     * FAILED_PRECONDITION and the current version when base_version is no
+     * longer the latest.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.UpdateMinutes
+     */
+    updateMinutes: {
+      name: "UpdateMinutes",
+      I: UpdateMinutesRequest,
+      O: UpdateMinutesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.RecordingsService.ListMinutesVersions
+     */
+    listMinutesVersions: {
+      name: "ListMinutesVersions",
+      I: ListMinutesVersionsRequest,
+      O: ListMinutesVersionsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Writes the summary and latest minutes to a page in a configured wiki.
+     * Publishing again replaces the recording's page there. Recordings with
+     * neither are rejected with FAILED_PRECONDITION.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.PublishRecording
+     */
+    publishRecording: {
+      name: "PublishRecording",
+      I: PublishRecordingRequest,
+      O: PublishRecordingResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.RecordingsService.ListPublications
+     */
+    listPublications: {
+      name: "ListPublications",
+      I: ListPublicationsRequest,
+      O: ListPublicationsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Keeps a recording's audio and transcript past the retention policy
+     * until a date, or returns it to the policy. Admin only.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.SetRecordingRetention
+     */
+    setRecordingRetention: {
+      name: "SetRecordingRetention",
+      I: SetRecordingRetentionRequest,
+      O: SetRecordingRetentionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Places or lifts a legal hold. Admin only; every change is kept in the
+     * recording's legal_hold_history.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.SetLegalHold
+     */
+    setLegalHold: {
+      name: "SetLegalHold",
+      I: SetLegalHoldRequest,
+      O: SetLegalHoldResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns a pre-signed URL the client uploads an audio file to, straight
+     * to object storage, so large files don't pass through the server. Fails
+     * with FAILED_PRECONDITION when the storage backend can't sign URLs;
+     * POST to /api/recordings/upload then.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.GetUploadURL
+     */
+    getUploadURL: {
+      name: "GetUploadURL",
+      I: GetUploadURLRequest,
+      O: GetUploadURLResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Creates the recording once the file is uploaded, which queues it for
+     * transcription.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.ConfirmUpload
+     */
+    confirmUpload: {
+      name: "ConfirmUpload",
+      I: ConfirmUploadRequest,
+      O: ConfirmUploadResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Adds an attendee who has no account. A speaker label belongs to one
+     * person, user or guest; a second is rejected with ALREADY_EXISTS.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.AddGuestParticipant
+     */
+    addGuestParticipant: {
+      name: "AddGuestParticipant",
+      I: AddGuestParticipantRequest,
+      O: AddGuestParticipantResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.RecordingsService.RemoveGuestParticipant
+     */
+    removeGuestParticipant: {
+      name: "RemoveGuestParticipant",
+      I: RemoveGuestParticipantRequest,
+      O: RemoveGuestParticipantResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Gives every line of a diarized speaker to a user or guest, fixing a
+     * misattributed speaker in one call. If that person already speaks under
+     * another label, the lines are relabeled to it so the two merge;
+     * otherwise the speaker label is mapped to them. Whoever held the label
+     * before loses it. Mentions are linked again afterwards.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.RelabelSpeaker
+     */
+    relabelSpeaker: {
+      name: "RelabelSpeaker",
+      I: RelabelSpeakerRequest,
+      O: RelabelSpeakerResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Finds text in a recording's transcript, returning the matching lines
+     * with the lines around them and where they fall in the audio, so
+     * clients needn't load long transcripts to search them.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.GetRecordingTranscript
+     */
+    getRecordingTranscript: {
+      name: "GetRecordingTranscript",
+      I: GetRecordingTranscriptRequest,
+      O: GetRecordingTranscriptResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Pages through a recording's transcript, or one of its stored
+     * translations, for clients that called GetRecording with
+     * omit_transcript.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.ListTranscriptSegments
+     */
+    listTranscriptSegments: {
+      name: "ListTranscriptSegments",
+      I: ListTranscriptSegmentsRequest,
+      O: ListTranscriptSegmentsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Lists the revisions of a recording's transcript, newest first.
+     * Revisions are kept from the first edit on: the transcript as it was
+     * before becomes revision 1, and each edit adds the next. Recordings
+     * whose transcript was never edited have none.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.ListTranscriptRevisions
+     */
+    listTranscriptRevisions: {
+      name: "ListTranscriptRevisions",
+      I: ListTranscriptRevisionsRequest,
+      O: ListTranscriptRevisionsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Compares two transcript revisions line by line, and the words of each
+     * changed line, so clients can show what an editor changed.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.GetTranscriptRevisionDiff
+     */
+    getTranscriptRevisionDiff: {
+      name: "GetTranscriptRevisionDiff",
+      I: GetTranscriptRevisionDiffRequest,
+      O: GetTranscriptRevisionDiffResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Copies a recording's metadata and audio into a new recording queued
+     * for transcription, so it can be processed again with another language
+     * or prompt template while the original keeps its transcript, summary
+     * and todos. The audio is shared, not copied. Recordings without stored
+     * audio, or whose audio is quarantined or awaits its malware scan, are
+     * rejected with FAILED_PRECONDITION.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.CloneRecording
+     */
+    cloneRecording: {
+      name: "CloneRecording",
+      I: CloneRecordingRequest,
+      O: CloneRecordingResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Replaces a recording's processing settings, e.g. before retrying it
+     * with RetryProcessing. Recordings being transcribed or summarized are
+     * rejected with FAILED_PRECONDITION.
+     *
+     * @generated from rpc secretary.v1.RecordingsService.UpdateProcessingSettings
+     */
+    updateProcessingSettings: {
+      name: "UpdateProcessingSettings",
+      I: UpdateProcessingSettingsRequest,
+      O: UpdateProcessingSettingsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
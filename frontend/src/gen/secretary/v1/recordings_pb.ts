@@ -5,300 +5,4448 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
-import { User } from "./users_pb.js";
+import { ScanStatus } from "./quarantine_pb.js";
+import { RedactionPolicy } from "./settings_pb.js";
+import { Mention, User } from "./users_pb.js";
+
+/**
+ * Processing stages a recording moves through after upload. The worker
+ * that transcribes and summarizes reports each step with
+ * UpdateRecordingStatus.
+ *
+ * @generated from enum secretary.v1.RecordingStatus
+ */
+export enum RecordingStatus {
+  /**
+   * @generated from enum value: RECORDING_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: RECORDING_STATUS_UPLOADED = 1;
+   */
+  UPLOADED = 1,
+
+  /**
+   * @generated from enum value: RECORDING_STATUS_TRANSCRIBING = 2;
+   */
+  TRANSCRIBING = 2,
+
+  /**
+   * @generated from enum value: RECORDING_STATUS_SUMMARIZING = 3;
+   */
+  SUMMARIZING = 3,
+
+  /**
+   * @generated from enum value: RECORDING_STATUS_READY = 4;
+   */
+  READY = 4,
+
+  /**
+   * @generated from enum value: RECORDING_STATUS_FAILED = 5;
+   */
+  FAILED = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(RecordingStatus)
+proto3.util.setEnumType(RecordingStatus, "secretary.v1.RecordingStatus", [
+  { no: 0, name: "RECORDING_STATUS_UNSPECIFIED" },
+  { no: 1, name: "RECORDING_STATUS_UPLOADED" },
+  { no: 2, name: "RECORDING_STATUS_TRANSCRIBING" },
+  { no: 3, name: "RECORDING_STATUS_SUMMARIZING" },
+  { no: 4, name: "RECORDING_STATUS_READY" },
+  { no: 5, name: "RECORDING_STATUS_FAILED" },
+]);
+
+/**
+ * @generated from enum secretary.v1.ProcessingStage
+ */
+export enum ProcessingStage {
+  /**
+   * @generated from enum value: PROCESSING_STAGE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PROCESSING_STAGE_TRANSCRIPTION = 1;
+   */
+  TRANSCRIPTION = 1,
+
+  /**
+   * @generated from enum value: PROCESSING_STAGE_SUMMARIZATION = 2;
+   */
+  SUMMARIZATION = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ProcessingStage)
+proto3.util.setEnumType(ProcessingStage, "secretary.v1.ProcessingStage", [
+  { no: 0, name: "PROCESSING_STAGE_UNSPECIFIED" },
+  { no: 1, name: "PROCESSING_STAGE_TRANSCRIPTION" },
+  { no: 2, name: "PROCESSING_STAGE_SUMMARIZATION" },
+]);
+
+/**
+ * @generated from enum secretary.v1.ProcessingAttemptStatus
+ */
+export enum ProcessingAttemptStatus {
+  /**
+   * @generated from enum value: PROCESSING_ATTEMPT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Requested with RetryProcessing; the worker has not picked it up yet.
+   *
+   * @generated from enum value: PROCESSING_ATTEMPT_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: PROCESSING_ATTEMPT_STATUS_RUNNING = 2;
+   */
+  RUNNING = 2,
+
+  /**
+   * @generated from enum value: PROCESSING_ATTEMPT_STATUS_SUCCEEDED = 3;
+   */
+  SUCCEEDED = 3,
+
+  /**
+   * @generated from enum value: PROCESSING_ATTEMPT_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ProcessingAttemptStatus)
+proto3.util.setEnumType(ProcessingAttemptStatus, "secretary.v1.ProcessingAttemptStatus", [
+  { no: 0, name: "PROCESSING_ATTEMPT_STATUS_UNSPECIFIED" },
+  { no: 1, name: "PROCESSING_ATTEMPT_STATUS_PENDING" },
+  { no: 2, name: "PROCESSING_ATTEMPT_STATUS_RUNNING" },
+  { no: 3, name: "PROCESSING_ATTEMPT_STATUS_SUCCEEDED" },
+  { no: 4, name: "PROCESSING_ATTEMPT_STATUS_FAILED" },
+]);
+
+/**
+ * One run of a processing stage. Attempts are numbered per stage.
+ *
+ * @generated from message secretary.v1.ProcessingAttempt
+ */
+export class ProcessingAttempt extends Message<ProcessingAttempt> {
+  /**
+   * @generated from field: secretary.v1.ProcessingStage stage = 1;
+   */
+  stage = ProcessingStage.UNSPECIFIED;
+
+  /**
+   * @generated from field: int32 attempt = 2;
+   */
+  attempt = 0;
+
+  /**
+   * @generated from field: secretary.v1.ProcessingAttemptStatus status = 3;
+   */
+  status = ProcessingAttemptStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: string error = 4;
+   */
+  error = "";
+
+  /**
+   * The admin who asked for a retry; 0 for runs the worker started itself.
+   *
+   * @generated from field: int64 requested_by_user_id = 5;
+   */
+  requestedByUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 6;
+   */
+  createdAt = "";
+
+  /**
+   * @generated from field: string started_at = 7;
+   */
+  startedAt = "";
+
+  /**
+   * @generated from field: string finished_at = 8;
+   */
+  finishedAt = "";
+
+  constructor(data?: PartialMessage<ProcessingAttempt>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ProcessingAttempt";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "enum", T: proto3.getEnumType(ProcessingStage) },
+    { no: 2, name: "attempt", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "status", kind: "enum", T: proto3.getEnumType(ProcessingAttemptStatus) },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "requested_by_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "started_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "finished_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessingAttempt {
+    return new ProcessingAttempt().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessingAttempt {
+    return new ProcessingAttempt().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessingAttempt {
+    return new ProcessingAttempt().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProcessingAttempt | PlainMessage<ProcessingAttempt> | undefined, b: ProcessingAttempt | PlainMessage<ProcessingAttempt> | undefined): boolean {
+    return proto3.util.equals(ProcessingAttempt, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RecordingStatusEvent
+ */
+export class RecordingStatusEvent extends Message<RecordingStatusEvent> {
+  /**
+   * @generated from field: secretary.v1.RecordingStatus from_status = 1;
+   */
+  fromStatus = RecordingStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: secretary.v1.RecordingStatus to_status = 2;
+   */
+  toStatus = RecordingStatus.UNSPECIFIED;
+
+  /**
+   * Set on transitions to FAILED.
+   *
+   * @generated from field: string error = 3;
+   */
+  error = "";
+
+  /**
+   * @generated from field: string created_at = 4;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<RecordingStatusEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RecordingStatusEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "from_status", kind: "enum", T: proto3.getEnumType(RecordingStatus) },
+    { no: 2, name: "to_status", kind: "enum", T: proto3.getEnumType(RecordingStatus) },
+    { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RecordingStatusEvent {
+    return new RecordingStatusEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RecordingStatusEvent {
+    return new RecordingStatusEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RecordingStatusEvent {
+    return new RecordingStatusEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RecordingStatusEvent | PlainMessage<RecordingStatusEvent> | undefined, b: RecordingStatusEvent | PlainMessage<RecordingStatusEvent> | undefined): boolean {
+    return proto3.util.equals(RecordingStatusEvent, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.LegalHoldEvent
+ */
+export class LegalHoldEvent extends Message<LegalHoldEvent> {
+  /**
+   * Whether the hold was placed or lifted.
+   *
+   * @generated from field: bool held = 1;
+   */
+  held = false;
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  /**
+   * 0 once the user is deleted.
+   *
+   * @generated from field: int64 user_id = 3;
+   */
+  userId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 4;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<LegalHoldEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.LegalHoldEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "held", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LegalHoldEvent {
+    return new LegalHoldEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LegalHoldEvent {
+    return new LegalHoldEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LegalHoldEvent {
+    return new LegalHoldEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LegalHoldEvent | PlainMessage<LegalHoldEvent> | undefined, b: LegalHoldEvent | PlainMessage<LegalHoldEvent> | undefined): boolean {
+    return proto3.util.equals(LegalHoldEvent, a, b);
+  }
+}
+
+/**
+ * The overall mood of a meeting, as judged by ExtractOutcomes.
+ *
+ * @generated from enum secretary.v1.Sentiment
+ */
+export enum Sentiment {
+  /**
+   * @generated from enum value: SENTIMENT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SENTIMENT_POSITIVE = 1;
+   */
+  POSITIVE = 1,
+
+  /**
+   * @generated from enum value: SENTIMENT_NEUTRAL = 2;
+   */
+  NEUTRAL = 2,
+
+  /**
+   * @generated from enum value: SENTIMENT_NEGATIVE = 3;
+   */
+  NEGATIVE = 3,
+
+  /**
+   * @generated from enum value: SENTIMENT_MIXED = 4;
+   */
+  MIXED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Sentiment)
+proto3.util.setEnumType(Sentiment, "secretary.v1.Sentiment", [
+  { no: 0, name: "SENTIMENT_UNSPECIFIED" },
+  { no: 1, name: "SENTIMENT_POSITIVE" },
+  { no: 2, name: "SENTIMENT_NEUTRAL" },
+  { no: 3, name: "SENTIMENT_NEGATIVE" },
+  { no: 4, name: "SENTIMENT_MIXED" },
+]);
+
+/**
+ * @generated from enum secretary.v1.TranslationKind
+ */
+export enum TranslationKind {
+  /**
+   * @generated from enum value: TRANSLATION_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TRANSLATION_KIND_SUMMARY = 1;
+   */
+  SUMMARY = 1,
+
+  /**
+   * @generated from enum value: TRANSLATION_KIND_TRANSCRIPT = 2;
+   */
+  TRANSCRIPT = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TranslationKind)
+proto3.util.setEnumType(TranslationKind, "secretary.v1.TranslationKind", [
+  { no: 0, name: "TRANSLATION_KIND_UNSPECIFIED" },
+  { no: 1, name: "TRANSLATION_KIND_SUMMARY" },
+  { no: 2, name: "TRANSLATION_KIND_TRANSCRIPT" },
+]);
+
+/**
+ * A summary or transcript in another language, kept next to the original.
+ * There is at most one per kind and language; asking again replaces it.
+ *
+ * @generated from message secretary.v1.RecordingTranslation
+ */
+export class RecordingTranslation extends Message<RecordingTranslation> {
+  /**
+   * @generated from field: secretary.v1.TranslationKind kind = 1;
+   */
+  kind = TranslationKind.UNSPECIFIED;
+
+  /**
+   * BCP 47 tag, e.g. "es" or "pt-BR".
+   *
+   * @generated from field: string language = 2;
+   */
+  language = "";
+
+  /**
+   * @generated from field: string text = 3;
+   */
+  text = "";
+
+  /**
+   * The provider and model that wrote it.
+   *
+   * @generated from field: string provider = 4;
+   */
+  provider = "";
+
+  /**
+   * @generated from field: string model = 5;
+   */
+  model = "";
+
+  /**
+   * @generated from field: int64 requested_by_user_id = 6;
+   */
+  requestedByUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 7;
+   */
+  createdAt = "";
+
+  /**
+   * @generated from field: string updated_at = 8;
+   */
+  updatedAt = "";
+
+  constructor(data?: PartialMessage<RecordingTranslation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RecordingTranslation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(TranslationKind) },
+    { no: 2, name: "language", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "model", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "requested_by_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RecordingTranslation {
+    return new RecordingTranslation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RecordingTranslation {
+    return new RecordingTranslation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RecordingTranslation {
+    return new RecordingTranslation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RecordingTranslation | PlainMessage<RecordingTranslation> | undefined, b: RecordingTranslation | PlainMessage<RecordingTranslation> | undefined): boolean {
+    return proto3.util.equals(RecordingTranslation, a, b);
+  }
+}
+
+/**
+ * The speech-to-text provider's confidence in one line of a transcript.
+ *
+ * @generated from message secretary.v1.TranscriptSegment
+ */
+export class TranscriptSegment extends Message<TranscriptSegment> {
+  /**
+   * The line's position in the transcript, from 0.
+   *
+   * @generated from field: int32 index = 1;
+   */
+  index = 0;
+
+  /**
+   * From 0 to 1.
+   *
+   * @generated from field: float confidence = 2;
+   */
+  confidence = 0;
+
+  /**
+   * Whether the line scored below the server's threshold and is worth
+   * checking against the audio.
+   *
+   * @generated from field: bool low_confidence = 3;
+   */
+  lowConfidence = false;
+
+  constructor(data?: PartialMessage<TranscriptSegment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.TranscriptSegment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "index", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "confidence", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 3, name: "low_confidence", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TranscriptSegment {
+    return new TranscriptSegment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TranscriptSegment {
+    return new TranscriptSegment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TranscriptSegment {
+    return new TranscriptSegment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TranscriptSegment | PlainMessage<TranscriptSegment> | undefined, b: TranscriptSegment | PlainMessage<TranscriptSegment> | undefined): boolean {
+    return proto3.util.equals(TranscriptSegment, a, b);
+  }
+}
 
 /**
  * @generated from message secretary.v1.Recording
  */
-export class Recording extends Message<Recording> {
+export class Recording extends Message<Recording> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string created_at = 3;
+   */
+  createdAt = "";
+
+  /**
+   * @generated from field: int32 duration = 4;
+   */
+  duration = 0;
+
+  /**
+   * @generated from field: string summary = 5;
+   */
+  summary = "";
+
+  /**
+   * Empty when GetRecording was asked to omit it; page through the lines
+   * with ListTranscriptSegments instead.
+   *
+   * @generated from field: string transcript = 6;
+   */
+  transcript = "";
+
+  /**
+   * @generated from field: string audio_url = 7;
+   */
+  audioUrl = "";
+
+  /**
+   * @generated from field: bool has_audio = 8;
+   */
+  hasAudio = false;
+
+  /**
+   * @generated from field: repeated secretary.v1.User participants = 9;
+   */
+  participants: User[] = [];
+
+  /**
+   * @generated from field: secretary.v1.RecordingStatus status = 10;
+   */
+  status = RecordingStatus.UNSPECIFIED;
+
+  /**
+   * Why processing failed; empty unless status is FAILED.
+   *
+   * @generated from field: string status_error = 11;
+   */
+  statusError = "";
+
+  /**
+   * @generated from field: string status_updated_at = 12;
+   */
+  statusUpdatedAt = "";
+
+  /**
+   * Every status transition, oldest first. Only GetRecording fills this in.
+   *
+   * @generated from field: repeated secretary.v1.RecordingStatusEvent status_history = 13;
+   */
+  statusHistory: RecordingStatusEvent[] = [];
+
+  /**
+   * Transcription attempts, then summarization attempts, each oldest
+   * first. Only GetRecording fills this in.
+   *
+   * @generated from field: repeated secretary.v1.ProcessingAttempt processing_attempts = 14;
+   */
+  processingAttempts: ProcessingAttempt[] = [];
+
+  /**
+   * Summaries and transcripts in other languages, by kind then language.
+   * Only GetRecording fills this in.
+   *
+   * @generated from field: repeated secretary.v1.RecordingTranslation translations = 15;
+   */
+  translations: RecordingTranslation[] = [];
+
+  /**
+   * Unspecified until outcomes have been extracted.
+   *
+   * @generated from field: secretary.v1.Sentiment sentiment = 16;
+   */
+  sentiment = Sentiment.UNSPECIFIED;
+
+  /**
+   * Whether the caller starred it.
+   *
+   * @generated from field: bool starred = 17;
+   */
+  starred = false;
+
+  /**
+   * The prompt template summaries, outcomes and minutes are written with;
+   * 0 for the built-in prompts.
+   *
+   * @generated from field: int64 prompt_template_id = 18;
+   */
+  promptTemplateId = protoInt64.zero;
+
+  /**
+   * RFC3339; retention purges leave the recording alone until then. Empty
+   * when it follows the organization's retention policy.
+   *
+   * @generated from field: string retain_until = 19;
+   */
+  retainUntil = "";
+
+  /**
+   * When a retention purge removed the audio or transcript; empty while
+   * they are kept.
+   *
+   * @generated from field: string audio_purged_at = 20;
+   */
+  audioPurgedAt = "";
+
+  /**
+   * @generated from field: string transcript_purged_at = 21;
+   */
+  transcriptPurgedAt = "";
+
+  /**
+   * Held recordings are skipped by retention purges and can't be deleted
+   * until the hold is lifted.
+   *
+   * @generated from field: bool legal_hold = 22;
+   */
+  legalHold = false;
+
+  /**
+   * @generated from field: string legal_hold_reason = 23;
+   */
+  legalHoldReason = "";
+
+  /**
+   * Every time the hold was placed or lifted, oldest first. Only
+   * GetRecording fills this in.
+   *
+   * @generated from field: repeated secretary.v1.LegalHoldEvent legal_hold_history = 24;
+   */
+  legalHoldHistory: LegalHoldEvent[] = [];
+
+  /**
+   * The malware scan of the stored audio. Pending and quarantined audio
+   * isn't transcribed or clipped.
+   *
+   * @generated from field: secretary.v1.ScanStatus scan_status = 25;
+   */
+  scanStatus = ScanStatus.UNSPECIFIED;
+
+  /**
+   * Confidence in each transcript line the transcription worker scored, in
+   * line order. Only GetRecording fills this in.
+   *
+   * @generated from field: repeated secretary.v1.TranscriptSegment transcript_segments = 26;
+   */
+  transcriptSegments: TranscriptSegment[] = [];
+
+  /**
+   * Todos created from the meeting that are still to do, in progress or
+   * blocked, and those done. Skipped todos count as neither.
+   *
+   * @generated from field: int32 open_todo_count = 27;
+   */
+  openTodoCount = 0;
+
+  /**
+   * @generated from field: int32 done_todo_count = 28;
+   */
+  doneTodoCount = 0;
+
+  /**
+   * Attendees without an account, such as a client's staff. Filled in
+   * wherever participants are.
+   *
+   * @generated from field: repeated secretary.v1.GuestParticipant guests = 29;
+   */
+  guests: GuestParticipant[] = [];
+
+  /**
+   * Lines in the transcript, even when the text was omitted. Only
+   * GetRecording fills this in.
+   *
+   * @generated from field: int32 transcript_line_count = 30;
+   */
+  transcriptLineCount = 0;
+
+  /**
+   * Words each speaker said, in order of first appearance, so clients can
+   * show speaking time without the transcript. Only GetRecording fills
+   * this in.
+   *
+   * @generated from field: repeated secretary.v1.SpeakerWords speaker_words = 31;
+   */
+  speakerWords: SpeakerWords[] = [];
+
+  /**
+   * Same as processing_settings.language.
+   *
+   * @generated from field: string language = 32;
+   */
+  language = "";
+
+  /**
+   * The recording this one was cloned from with CloneRecording; 0 if it
+   * wasn't, or the original was deleted.
+   *
+   * @generated from field: int64 cloned_from_recording_id = 33;
+   */
+  clonedFromRecordingId = protoInt64.zero;
+
+  /**
+   * How the recording is transcribed and summarized. Filled in wherever the
+   * recording is returned.
+   *
+   * @generated from field: secretary.v1.ProcessingSettings processing_settings = 34;
+   */
+  processingSettings?: ProcessingSettings;
+
+  constructor(data?: PartialMessage<Recording>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.Recording";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "duration", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "summary", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "transcript", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "audio_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "has_audio", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "participants", kind: "message", T: User, repeated: true },
+    { no: 10, name: "status", kind: "enum", T: proto3.getEnumType(RecordingStatus) },
+    { no: 11, name: "status_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "status_updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "status_history", kind: "message", T: RecordingStatusEvent, repeated: true },
+    { no: 14, name: "processing_attempts", kind: "message", T: ProcessingAttempt, repeated: true },
+    { no: 15, name: "translations", kind: "message", T: RecordingTranslation, repeated: true },
+    { no: 16, name: "sentiment", kind: "enum", T: proto3.getEnumType(Sentiment) },
+    { no: 17, name: "starred", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 18, name: "prompt_template_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 19, name: "retain_until", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 20, name: "audio_purged_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 21, name: "transcript_purged_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 22, name: "legal_hold", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 23, name: "legal_hold_reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 24, name: "legal_hold_history", kind: "message", T: LegalHoldEvent, repeated: true },
+    { no: 25, name: "scan_status", kind: "enum", T: proto3.getEnumType(ScanStatus) },
+    { no: 26, name: "transcript_segments", kind: "message", T: TranscriptSegment, repeated: true },
+    { no: 27, name: "open_todo_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 28, name: "done_todo_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 29, name: "guests", kind: "message", T: GuestParticipant, repeated: true },
+    { no: 30, name: "transcript_line_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 31, name: "speaker_words", kind: "message", T: SpeakerWords, repeated: true },
+    { no: 32, name: "language", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 33, name: "cloned_from_recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 34, name: "processing_settings", kind: "message", T: ProcessingSettings },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Recording {
+    return new Recording().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Recording {
+    return new Recording().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Recording {
+    return new Recording().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Recording | PlainMessage<Recording> | undefined, b: Recording | PlainMessage<Recording> | undefined): boolean {
+    return proto3.util.equals(Recording, a, b);
+  }
+}
+
+/**
+ * How one recording is processed. The transcription worker reads the
+ * language and diarization settings when it picks the recording up; the
+ * server applies the prompt template and redaction policy. Settings are
+ * chosen when the recording is created and can be changed with
+ * UpdateProcessingSettings before it is processed again.
+ *
+ * @generated from message secretary.v1.ProcessingSettings
+ */
+export class ProcessingSettings extends Message<ProcessingSettings> {
+  /**
+   * BCP 47 tag of the language spoken; empty to detect it. Left empty when
+   * settings are given, it takes the organization's default language.
+   *
+   * @generated from field: string language = 1;
+   */
+  language = "";
+
+  /**
+   * Transcribes without telling speakers apart, e.g. for a dictated note.
+   *
+   * @generated from field: bool disable_diarization = 2;
+   */
+  disableDiarization = false;
+
+  /**
+   * The prompt template summaries, outcomes and minutes are written with;
+   * 0 for the built-in prompts. Same as Recording.prompt_template_id.
+   *
+   * @generated from field: int64 prompt_template_id = 3;
+   */
+  promptTemplateId = protoInt64.zero;
+
+  /**
+   * Masked in this recording on top of the organization's policy. A
+   * recording can't unmask what the organization masks.
+   *
+   * @generated from field: secretary.v1.RedactionPolicy redaction = 4;
+   */
+  redaction?: RedactionPolicy;
+
+  /**
+   * Leaves the recording unsummarized: the worker reports it READY once
+   * transcribed. Takes the organization's default when unset; stored
+   * recordings always have it set, except those from before it existed,
+   * which are summarized.
+   *
+   * @generated from field: optional bool disable_summary = 5;
+   */
+  disableSummary?: boolean;
+
+  /**
+   * Summarizes without extracting todos. Defaults like disable_summary.
+   *
+   * @generated from field: optional bool disable_todo_extraction = 6;
+   */
+  disableTodoExtraction?: boolean;
+
+  constructor(data?: PartialMessage<ProcessingSettings>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ProcessingSettings";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "language", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "disable_diarization", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "prompt_template_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "redaction", kind: "message", T: RedactionPolicy },
+    { no: 5, name: "disable_summary", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 6, name: "disable_todo_extraction", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessingSettings {
+    return new ProcessingSettings().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessingSettings {
+    return new ProcessingSettings().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessingSettings {
+    return new ProcessingSettings().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProcessingSettings | PlainMessage<ProcessingSettings> | undefined, b: ProcessingSettings | PlainMessage<ProcessingSettings> | undefined): boolean {
+    return proto3.util.equals(ProcessingSettings, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SpeakerWords
+ */
+export class SpeakerWords extends Message<SpeakerWords> {
+  /**
+   * The "Speaker N" label's number; -1 for lines labeled with a name.
+   *
+   * @generated from field: int32 speaker_id = 1;
+   */
+  speakerId = 0;
+
+  /**
+   * The name lines were labeled with; empty for numbered speakers.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: int32 words = 3;
+   */
+  words = 0;
+
+  constructor(data?: PartialMessage<SpeakerWords>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SpeakerWords";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "speaker_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "words", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SpeakerWords {
+    return new SpeakerWords().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SpeakerWords {
+    return new SpeakerWords().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SpeakerWords {
+    return new SpeakerWords().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SpeakerWords | PlainMessage<SpeakerWords> | undefined, b: SpeakerWords | PlainMessage<SpeakerWords> | undefined): boolean {
+    return proto3.util.equals(SpeakerWords, a, b);
+  }
+}
+
+/**
+ * Someone in a meeting who isn't a user of the system. Guests appear in
+ * the transcript and minutes but can't sign in or be assigned todos.
+ *
+ * @generated from message secretary.v1.GuestParticipant
+ */
+export class GuestParticipant extends Message<GuestParticipant> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Empty when not given.
+   *
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  /**
+   * The transcript's speaker label for them; -1 when unknown.
+   *
+   * @generated from field: int32 speaker_id = 4;
+   */
+  speakerId = 0;
+
+  constructor(data?: PartialMessage<GuestParticipant>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GuestParticipant";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "speaker_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GuestParticipant {
+    return new GuestParticipant().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GuestParticipant {
+    return new GuestParticipant().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GuestParticipant {
+    return new GuestParticipant().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GuestParticipant | PlainMessage<GuestParticipant> | undefined, b: GuestParticipant | PlainMessage<GuestParticipant> | undefined): boolean {
+    return proto3.util.equals(GuestParticipant, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListRecordingsRequest
+ */
+export class ListRecordingsRequest extends Message<ListRecordingsRequest> {
+  /**
+   * Populate Recording.participants, loaded in one batched query.
+   *
+   * @generated from field: bool include_participants = 6;
+   */
+  includeParticipants = false;
+
+  /**
+   * Only recordings the caller starred.
+   *
+   * @generated from field: bool starred_only = 7;
+   */
+  starredOnly = false;
+
+  constructor(data?: PartialMessage<ListRecordingsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListRecordingsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 6, name: "include_participants", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "starred_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRecordingsRequest {
+    return new ListRecordingsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRecordingsRequest {
+    return new ListRecordingsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRecordingsRequest {
+    return new ListRecordingsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRecordingsRequest | PlainMessage<ListRecordingsRequest> | undefined, b: ListRecordingsRequest | PlainMessage<ListRecordingsRequest> | undefined): boolean {
+    return proto3.util.equals(ListRecordingsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListRecordingsResponse
+ */
+export class ListRecordingsResponse extends Message<ListRecordingsResponse> {
+  /**
+   * @generated from field: repeated secretary.v1.Recording recordings = 1;
+   */
+  recordings: Recording[] = [];
+
+  constructor(data?: PartialMessage<ListRecordingsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListRecordingsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recordings", kind: "message", T: Recording, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRecordingsResponse {
+    return new ListRecordingsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRecordingsResponse {
+    return new ListRecordingsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRecordingsResponse {
+    return new ListRecordingsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRecordingsResponse | PlainMessage<ListRecordingsResponse> | undefined, b: ListRecordingsResponse | PlainMessage<ListRecordingsResponse> | undefined): boolean {
+    return proto3.util.equals(ListRecordingsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetRecordingRequest
+ */
+export class GetRecordingRequest extends Message<GetRecordingRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * Leaves out the transcript and the text of its translations, so long
+   * meetings load fast. Everything else, including transcript_segments,
+   * transcript_line_count and speaker_words, is still filled in.
+   *
+   * @generated from field: bool omit_transcript = 2;
+   */
+  omitTranscript = false;
+
+  constructor(data?: PartialMessage<GetRecordingRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetRecordingRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "omit_transcript", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRecordingRequest {
+    return new GetRecordingRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRecordingRequest {
+    return new GetRecordingRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRecordingRequest {
+    return new GetRecordingRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRecordingRequest | PlainMessage<GetRecordingRequest> | undefined, b: GetRecordingRequest | PlainMessage<GetRecordingRequest> | undefined): boolean {
+    return proto3.util.equals(GetRecordingRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetRecordingResponse
+ */
+export class GetRecordingResponse extends Message<GetRecordingResponse> {
+  /**
+   * @generated from field: secretary.v1.Recording recording = 1;
+   */
+  recording?: Recording;
+
+  constructor(data?: PartialMessage<GetRecordingResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetRecordingResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording", kind: "message", T: Recording },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRecordingResponse {
+    return new GetRecordingResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRecordingResponse {
+    return new GetRecordingResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRecordingResponse {
+    return new GetRecordingResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRecordingResponse | PlainMessage<GetRecordingResponse> | undefined, b: GetRecordingResponse | PlainMessage<GetRecordingResponse> | undefined): boolean {
+    return proto3.util.equals(GetRecordingResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteRecordingRequest
+ */
+export class DeleteRecordingRequest extends Message<DeleteRecordingRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeleteRecordingRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteRecordingRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRecordingRequest {
+    return new DeleteRecordingRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRecordingRequest {
+    return new DeleteRecordingRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRecordingRequest {
+    return new DeleteRecordingRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRecordingRequest | PlainMessage<DeleteRecordingRequest> | undefined, b: DeleteRecordingRequest | PlainMessage<DeleteRecordingRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteRecordingRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteRecordingResponse
+ */
+export class DeleteRecordingResponse extends Message<DeleteRecordingResponse> {
+  constructor(data?: PartialMessage<DeleteRecordingResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteRecordingResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRecordingResponse {
+    return new DeleteRecordingResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRecordingResponse {
+    return new DeleteRecordingResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRecordingResponse {
+    return new DeleteRecordingResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRecordingResponse | PlainMessage<DeleteRecordingResponse> | undefined, b: DeleteRecordingResponse | PlainMessage<DeleteRecordingResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteRecordingResponse, a, b);
+  }
+}
+
+
+/**
+ * @generated from message secretary.v1.StarRecordingRequest
+ */
+export class StarRecordingRequest extends Message<StarRecordingRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<StarRecordingRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.StarRecordingRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarRecordingRequest {
+    return new StarRecordingRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarRecordingRequest {
+    return new StarRecordingRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarRecordingRequest {
+    return new StarRecordingRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StarRecordingRequest | PlainMessage<StarRecordingRequest> | undefined, b: StarRecordingRequest | PlainMessage<StarRecordingRequest> | undefined): boolean {
+    return proto3.util.equals(StarRecordingRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.StarRecordingResponse
+ */
+export class StarRecordingResponse extends Message<StarRecordingResponse> {
+  constructor(data?: PartialMessage<StarRecordingResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.StarRecordingResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarRecordingResponse {
+    return new StarRecordingResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarRecordingResponse {
+    return new StarRecordingResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarRecordingResponse {
+    return new StarRecordingResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StarRecordingResponse | PlainMessage<StarRecordingResponse> | undefined, b: StarRecordingResponse | PlainMessage<StarRecordingResponse> | undefined): boolean {
+    return proto3.util.equals(StarRecordingResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UnstarRecordingRequest
+ */
+export class UnstarRecordingRequest extends Message<UnstarRecordingRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<UnstarRecordingRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UnstarRecordingRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnstarRecordingRequest {
+    return new UnstarRecordingRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnstarRecordingRequest {
+    return new UnstarRecordingRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnstarRecordingRequest {
+    return new UnstarRecordingRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnstarRecordingRequest | PlainMessage<UnstarRecordingRequest> | undefined, b: UnstarRecordingRequest | PlainMessage<UnstarRecordingRequest> | undefined): boolean {
+    return proto3.util.equals(UnstarRecordingRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UnstarRecordingResponse
+ */
+export class UnstarRecordingResponse extends Message<UnstarRecordingResponse> {
+  constructor(data?: PartialMessage<UnstarRecordingResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UnstarRecordingResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnstarRecordingResponse {
+    return new UnstarRecordingResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnstarRecordingResponse {
+    return new UnstarRecordingResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnstarRecordingResponse {
+    return new UnstarRecordingResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnstarRecordingResponse | PlainMessage<UnstarRecordingResponse> | undefined, b: UnstarRecordingResponse | PlainMessage<UnstarRecordingResponse> | undefined): boolean {
+    return proto3.util.equals(UnstarRecordingResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SetRecordingRetentionRequest
+ */
+export class SetRecordingRetentionRequest extends Message<SetRecordingRetentionRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * RFC3339; empty returns the recording to the retention policy.
+   *
+   * @generated from field: string retain_until = 2;
+   */
+  retainUntil = "";
+
+  constructor(data?: PartialMessage<SetRecordingRetentionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SetRecordingRetentionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "retain_until", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetRecordingRetentionRequest {
+    return new SetRecordingRetentionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetRecordingRetentionRequest {
+    return new SetRecordingRetentionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetRecordingRetentionRequest {
+    return new SetRecordingRetentionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetRecordingRetentionRequest | PlainMessage<SetRecordingRetentionRequest> | undefined, b: SetRecordingRetentionRequest | PlainMessage<SetRecordingRetentionRequest> | undefined): boolean {
+    return proto3.util.equals(SetRecordingRetentionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SetRecordingRetentionResponse
+ */
+export class SetRecordingRetentionResponse extends Message<SetRecordingRetentionResponse> {
+  constructor(data?: PartialMessage<SetRecordingRetentionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SetRecordingRetentionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetRecordingRetentionResponse {
+    return new SetRecordingRetentionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetRecordingRetentionResponse {
+    return new SetRecordingRetentionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetRecordingRetentionResponse {
+    return new SetRecordingRetentionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetRecordingRetentionResponse | PlainMessage<SetRecordingRetentionResponse> | undefined, b: SetRecordingRetentionResponse | PlainMessage<SetRecordingRetentionResponse> | undefined): boolean {
+    return proto3.util.equals(SetRecordingRetentionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SetLegalHoldRequest
+ */
+export class SetLegalHoldRequest extends Message<SetLegalHoldRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: bool held = 2;
+   */
+  held = false;
+
+  /**
+   * Why the hold is placed or lifted, e.g. a case reference.
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<SetLegalHoldRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SetLegalHoldRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "held", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetLegalHoldRequest {
+    return new SetLegalHoldRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetLegalHoldRequest {
+    return new SetLegalHoldRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetLegalHoldRequest {
+    return new SetLegalHoldRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetLegalHoldRequest | PlainMessage<SetLegalHoldRequest> | undefined, b: SetLegalHoldRequest | PlainMessage<SetLegalHoldRequest> | undefined): boolean {
+    return proto3.util.equals(SetLegalHoldRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SetLegalHoldResponse
+ */
+export class SetLegalHoldResponse extends Message<SetLegalHoldResponse> {
+  /**
+   * @generated from field: secretary.v1.LegalHoldEvent event = 1;
+   */
+  event?: LegalHoldEvent;
+
+  constructor(data?: PartialMessage<SetLegalHoldResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SetLegalHoldResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event", kind: "message", T: LegalHoldEvent },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetLegalHoldResponse {
+    return new SetLegalHoldResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetLegalHoldResponse {
+    return new SetLegalHoldResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetLegalHoldResponse {
+    return new SetLegalHoldResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetLegalHoldResponse | PlainMessage<SetLegalHoldResponse> | undefined, b: SetLegalHoldResponse | PlainMessage<SetLegalHoldResponse> | undefined): boolean {
+    return proto3.util.equals(SetLegalHoldResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetUploadURLRequest
+ */
+export class GetUploadURLRequest extends Message<GetUploadURLRequest> {
+  /**
+   * Used for the file type when content_type is application/octet-stream.
+   *
+   * @generated from field: string filename = 1;
+   */
+  filename = "";
+
+  /**
+   * @generated from field: string content_type = 2;
+   */
+  contentType = "";
+
+  /**
+   * The exact size of the file; the URL accepts no other.
+   *
+   * @generated from field: int64 size_bytes = 3;
+   */
+  sizeBytes = protoInt64.zero;
+
+  /**
+   * Defaults to "Upload" and the current time.
+   *
+   * @generated from field: string name = 4;
+   */
+  name = "";
+
+  /**
+   * Hex SHA-256 of the file. ConfirmUpload rejects a file that doesn't
+   * match, and an organization that already has this audio gets its
+   * recording in duplicate_of instead of a URL.
+   *
+   * @generated from field: string sha256 = 5;
+   */
+  sha256 = "";
+
+  constructor(data?: PartialMessage<GetUploadURLRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetUploadURLRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "filename", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "content_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "sha256", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUploadURLRequest {
+    return new GetUploadURLRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUploadURLRequest {
+    return new GetUploadURLRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUploadURLRequest {
+    return new GetUploadURLRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetUploadURLRequest | PlainMessage<GetUploadURLRequest> | undefined, b: GetUploadURLRequest | PlainMessage<GetUploadURLRequest> | undefined): boolean {
+    return proto3.util.equals(GetUploadURLRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetUploadURLResponse
+ */
+export class GetUploadURLResponse extends Message<GetUploadURLResponse> {
+  /**
+   * Passed to ConfirmUpload.
+   *
+   * @generated from field: int64 upload_id = 1;
+   */
+  uploadId = protoInt64.zero;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url = "";
+
+  /**
+   * @generated from field: string method = 3;
+   */
+  method = "";
+
+  /**
+   * Headers the upload request must carry, as signed.
+   *
+   * @generated from field: map<string, string> headers = 4;
+   */
+  headers: { [key: string]: string } = {};
+
+  /**
+   * RFC3339; the URL stops working then. ConfirmUpload is accepted for an
+   * hour after.
+   *
+   * @generated from field: string expires_at = 5;
+   */
+  expiresAt = "";
+
+  /**
+   * Set, with nothing else, when the recording already exists. Only id,
+   * name, created_at and status are filled in.
+   *
+   * @generated from field: secretary.v1.Recording duplicate_of = 6;
+   */
+  duplicateOf?: Recording;
+
+  constructor(data?: PartialMessage<GetUploadURLResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetUploadURLResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "upload_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "method", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "headers", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 5, name: "expires_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "duplicate_of", kind: "message", T: Recording },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUploadURLResponse {
+    return new GetUploadURLResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUploadURLResponse {
+    return new GetUploadURLResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUploadURLResponse {
+    return new GetUploadURLResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetUploadURLResponse | PlainMessage<GetUploadURLResponse> | undefined, b: GetUploadURLResponse | PlainMessage<GetUploadURLResponse> | undefined): boolean {
+    return proto3.util.equals(GetUploadURLResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ConfirmUploadRequest
+ */
+export class ConfirmUploadRequest extends Message<ConfirmUploadRequest> {
+  /**
+   * @generated from field: int64 upload_id = 1;
+   */
+  uploadId = protoInt64.zero;
+
+  /**
+   * @generated from field: secretary.v1.ProcessingSettings settings = 2;
+   */
+  settings?: ProcessingSettings;
+
+  constructor(data?: PartialMessage<ConfirmUploadRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ConfirmUploadRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "upload_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "settings", kind: "message", T: ProcessingSettings },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfirmUploadRequest {
+    return new ConfirmUploadRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfirmUploadRequest {
+    return new ConfirmUploadRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfirmUploadRequest {
+    return new ConfirmUploadRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfirmUploadRequest | PlainMessage<ConfirmUploadRequest> | undefined, b: ConfirmUploadRequest | PlainMessage<ConfirmUploadRequest> | undefined): boolean {
+    return proto3.util.equals(ConfirmUploadRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ConfirmUploadResponse
+ */
+export class ConfirmUploadResponse extends Message<ConfirmUploadResponse> {
+  /**
+   * Only id, name, created_at and status are filled in.
+   *
+   * @generated from field: secretary.v1.Recording recording = 1;
+   */
+  recording?: Recording;
+
+  /**
+   * @generated from field: int64 size_bytes = 2;
+   */
+  sizeBytes = protoInt64.zero;
+
+  /**
+   * The file matched existing audio and was discarded; recording is the
+   * one that already had it.
+   *
+   * @generated from field: bool duplicate = 3;
+   */
+  duplicate = false;
+
+  constructor(data?: PartialMessage<ConfirmUploadResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ConfirmUploadResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording", kind: "message", T: Recording },
+    { no: 2, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "duplicate", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfirmUploadResponse {
+    return new ConfirmUploadResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfirmUploadResponse {
+    return new ConfirmUploadResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfirmUploadResponse {
+    return new ConfirmUploadResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfirmUploadResponse | PlainMessage<ConfirmUploadResponse> | undefined, b: ConfirmUploadResponse | PlainMessage<ConfirmUploadResponse> | undefined): boolean {
+    return proto3.util.equals(ConfirmUploadResponse, a, b);
+  }
+}
+
+/**
+ * A stretch of a recording's audio saved on its own for sharing.
+ *
+ * @generated from message secretary.v1.Clip
+ */
+export class Clip extends Message<Clip> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 recording_id = 2;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: int32 start_ms = 3;
+   */
+  startMs = 0;
+
+  /**
+   * @generated from field: int32 end_ms = 4;
+   */
+  endMs = 0;
+
+  /**
+   * @generated from field: string title = 5;
+   */
+  title = "";
+
+  /**
+   * The part of the transcript the clip covers. Transcripts carry no
+   * timings, so this is estimated from the recording's duration.
+   *
+   * @generated from field: string transcript_excerpt = 6;
+   */
+  transcriptExcerpt = "";
+
+  /**
+   * Plays the clip without signing in.
+   *
+   * @generated from field: string share_url = 7;
+   */
+  shareUrl = "";
+
+  /**
+   * @generated from field: int64 size_bytes = 8;
+   */
+  sizeBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 created_by_user_id = 9;
+   */
+  createdByUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 10;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<Clip>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.Clip";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "start_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "end_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "transcript_excerpt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "share_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "created_by_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Clip {
+    return new Clip().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Clip {
+    return new Clip().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Clip {
+    return new Clip().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Clip | PlainMessage<Clip> | undefined, b: Clip | PlainMessage<Clip> | undefined): boolean {
+    return proto3.util.equals(Clip, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CreateClipRequest
+ */
+export class CreateClipRequest extends Message<CreateClipRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: int32 start_ms = 2;
+   */
+  startMs = 0;
+
+  /**
+   * @generated from field: int32 end_ms = 3;
+   */
+  endMs = 0;
+
+  /**
+   * Defaults to the recording name and the clip's range.
+   *
+   * @generated from field: string title = 4;
+   */
+  title = "";
+
+  constructor(data?: PartialMessage<CreateClipRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CreateClipRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "start_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "end_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateClipRequest {
+    return new CreateClipRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateClipRequest {
+    return new CreateClipRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateClipRequest {
+    return new CreateClipRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateClipRequest | PlainMessage<CreateClipRequest> | undefined, b: CreateClipRequest | PlainMessage<CreateClipRequest> | undefined): boolean {
+    return proto3.util.equals(CreateClipRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CreateClipResponse
+ */
+export class CreateClipResponse extends Message<CreateClipResponse> {
+  /**
+   * @generated from field: secretary.v1.Clip clip = 1;
+   */
+  clip?: Clip;
+
+  constructor(data?: PartialMessage<CreateClipResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CreateClipResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "clip", kind: "message", T: Clip },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateClipResponse {
+    return new CreateClipResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateClipResponse {
+    return new CreateClipResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateClipResponse {
+    return new CreateClipResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateClipResponse | PlainMessage<CreateClipResponse> | undefined, b: CreateClipResponse | PlainMessage<CreateClipResponse> | undefined): boolean {
+    return proto3.util.equals(CreateClipResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListClipsRequest
+ */
+export class ListClipsRequest extends Message<ListClipsRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ListClipsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListClipsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListClipsRequest {
+    return new ListClipsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListClipsRequest {
+    return new ListClipsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListClipsRequest {
+    return new ListClipsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListClipsRequest | PlainMessage<ListClipsRequest> | undefined, b: ListClipsRequest | PlainMessage<ListClipsRequest> | undefined): boolean {
+    return proto3.util.equals(ListClipsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListClipsResponse
+ */
+export class ListClipsResponse extends Message<ListClipsResponse> {
+  /**
+   * Newest first.
+   *
+   * @generated from field: repeated secretary.v1.Clip clips = 1;
+   */
+  clips: Clip[] = [];
+
+  constructor(data?: PartialMessage<ListClipsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListClipsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "clips", kind: "message", T: Clip, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListClipsResponse {
+    return new ListClipsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListClipsResponse {
+    return new ListClipsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListClipsResponse {
+    return new ListClipsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListClipsResponse | PlainMessage<ListClipsResponse> | undefined, b: ListClipsResponse | PlainMessage<ListClipsResponse> | undefined): boolean {
+    return proto3.util.equals(ListClipsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.MinutesAttendee
+ */
+export class MinutesAttendee extends Message<MinutesAttendee> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Unset for attendees without an account.
+   *
+   * @generated from field: int64 user_id = 2;
+   */
+  userId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MinutesAttendee>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.MinutesAttendee";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MinutesAttendee {
+    return new MinutesAttendee().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MinutesAttendee {
+    return new MinutesAttendee().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MinutesAttendee {
+    return new MinutesAttendee().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MinutesAttendee | PlainMessage<MinutesAttendee> | undefined, b: MinutesAttendee | PlainMessage<MinutesAttendee> | undefined): boolean {
+    return proto3.util.equals(MinutesAttendee, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.MinutesActionItem
+ */
+export class MinutesActionItem extends Message<MinutesActionItem> {
+  /**
+   * @generated from field: string text = 1;
+   */
+  text = "";
+
+  /**
+   * @generated from field: int64 owner_user_id = 2;
+   */
+  ownerUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string owner_name = 3;
+   */
+  ownerName = "";
+
+  /**
+   * RFC 3339, or empty.
+   *
+   * @generated from field: string due_at = 4;
+   */
+  dueAt = "";
+
+  /**
+   * The todo the item was taken from, if any.
+   *
+   * @generated from field: int64 todo_id = 5;
+   */
+  todoId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MinutesActionItem>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.MinutesActionItem";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "owner_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "owner_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "due_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MinutesActionItem {
+    return new MinutesActionItem().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MinutesActionItem {
+    return new MinutesActionItem().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MinutesActionItem {
+    return new MinutesActionItem().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MinutesActionItem | PlainMessage<MinutesActionItem> | undefined, b: MinutesActionItem | PlainMessage<MinutesActionItem> | undefined): boolean {
+    return proto3.util.equals(MinutesActionItem, a, b);
+  }
+}
+
+/**
+ * The body of a meeting's minutes.
+ *
+ * @generated from message secretary.v1.MinutesDocument
+ */
+export class MinutesDocument extends Message<MinutesDocument> {
+  /**
+   * @generated from field: repeated secretary.v1.MinutesAttendee attendees = 1;
+   */
+  attendees: MinutesAttendee[] = [];
+
+  /**
+   * @generated from field: repeated string agenda = 2;
+   */
+  agenda: string[] = [];
+
+  /**
+   * @generated from field: repeated string decisions = 3;
+   */
+  decisions: string[] = [];
+
+  /**
+   * @generated from field: repeated secretary.v1.MinutesActionItem action_items = 4;
+   */
+  actionItems: MinutesActionItem[] = [];
+
+  /**
+   * @generated from field: string notes = 5;
+   */
+  notes = "";
+
+  constructor(data?: PartialMessage<MinutesDocument>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.MinutesDocument";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "attendees", kind: "message", T: MinutesAttendee, repeated: true },
+    { no: 2, name: "agenda", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "decisions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "action_items", kind: "message", T: MinutesActionItem, repeated: true },
+    { no: 5, name: "notes", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MinutesDocument {
+    return new MinutesDocument().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MinutesDocument {
+    return new MinutesDocument().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MinutesDocument {
+    return new MinutesDocument().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MinutesDocument | PlainMessage<MinutesDocument> | undefined, b: MinutesDocument | PlainMessage<MinutesDocument> | undefined): boolean {
+    return proto3.util.equals(MinutesDocument, a, b);
+  }
+}
+
+/**
+ * One saved version of a recording's minutes. Versions count up from 1
+ * and are never changed once saved.
+ *
+ * @generated from message secretary.v1.MeetingMinutes
+ */
+export class MeetingMinutes extends Message<MeetingMinutes> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: int32 version = 2;
+   */
+  version = 0;
+
+  /**
+   * Left unset in ListMinutesVersions.
+   *
+   * @generated from field: secretary.v1.MinutesDocument document = 3;
+   */
+  document?: MinutesDocument;
+
+  /**
+   * "llm" for generated versions, "manual" for edits.
+   *
+   * @generated from field: string source = 4;
+   */
+  source = "";
+
+  /**
+   * @generated from field: int64 created_by_user_id = 5;
+   */
+  createdByUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 6;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<MeetingMinutes>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.MeetingMinutes";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "document", kind: "message", T: MinutesDocument },
+    { no: 4, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_by_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MeetingMinutes {
+    return new MeetingMinutes().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MeetingMinutes {
+    return new MeetingMinutes().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MeetingMinutes {
+    return new MeetingMinutes().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MeetingMinutes | PlainMessage<MeetingMinutes> | undefined, b: MeetingMinutes | PlainMessage<MeetingMinutes> | undefined): boolean {
+    return proto3.util.equals(MeetingMinutes, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GenerateMinutesRequest
+ */
+export class GenerateMinutesRequest extends Message<GenerateMinutesRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GenerateMinutesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GenerateMinutesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateMinutesRequest {
+    return new GenerateMinutesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateMinutesRequest {
+    return new GenerateMinutesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateMinutesRequest {
+    return new GenerateMinutesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateMinutesRequest | PlainMessage<GenerateMinutesRequest> | undefined, b: GenerateMinutesRequest | PlainMessage<GenerateMinutesRequest> | undefined): boolean {
+    return proto3.util.equals(GenerateMinutesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GenerateMinutesResponse
+ */
+export class GenerateMinutesResponse extends Message<GenerateMinutesResponse> {
+  /**
+   * @generated from field: secretary.v1.MeetingMinutes minutes = 1;
+   */
+  minutes?: MeetingMinutes;
+
+  constructor(data?: PartialMessage<GenerateMinutesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GenerateMinutesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "minutes", kind: "message", T: MeetingMinutes },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateMinutesResponse {
+    return new GenerateMinutesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateMinutesResponse {
+    return new GenerateMinutesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateMinutesResponse {
+    return new GenerateMinutesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateMinutesResponse | PlainMessage<GenerateMinutesResponse> | undefined, b: GenerateMinutesResponse | PlainMessage<GenerateMinutesResponse> | undefined): boolean {
+    return proto3.util.equals(GenerateMinutesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetMinutesRequest
+ */
+export class GetMinutesRequest extends Message<GetMinutesRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * 0 for the latest version.
+   *
+   * @generated from field: int32 version = 2;
+   */
+  version = 0;
+
+  constructor(data?: PartialMessage<GetMinutesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetMinutesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMinutesRequest {
+    return new GetMinutesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMinutesRequest {
+    return new GetMinutesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMinutesRequest {
+    return new GetMinutesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMinutesRequest | PlainMessage<GetMinutesRequest> | undefined, b: GetMinutesRequest | PlainMessage<GetMinutesRequest> | undefined): boolean {
+    return proto3.util.equals(GetMinutesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetMinutesResponse
+ */
+export class GetMinutesResponse extends Message<GetMinutesResponse> {
+  /**
+   * @generated from field: secretary.v1.MeetingMinutes minutes = 1;
+   */
+  minutes?: MeetingMinutes;
+
+  constructor(data?: PartialMessage<GetMinutesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetMinutesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "minutes", kind: "message", T: MeetingMinutes },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMinutesResponse {
+    return new GetMinutesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMinutesResponse {
+    return new GetMinutesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMinutesResponse {
+    return new GetMinutesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMinutesResponse | PlainMessage<GetMinutesResponse> | undefined, b: GetMinutesResponse | PlainMessage<GetMinutesResponse> | undefined): boolean {
+    return proto3.util.equals(GetMinutesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateMinutesRequest
+ */
+export class UpdateMinutesRequest extends Message<UpdateMinutesRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * The version the edit started from; 0 when the recording has no
+   * minutes yet.
+   *
+   * @generated from field: int32 base_version = 2;
+   */
+  baseVersion = 0;
+
+  /**
+   * @generated from field: secretary.v1.MinutesDocument document = 3;
+   */
+  document?: MinutesDocument;
+
+  constructor(data?: PartialMessage<UpdateMinutesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateMinutesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "base_version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "document", kind: "message", T: MinutesDocument },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateMinutesRequest {
+    return new UpdateMinutesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateMinutesRequest {
+    return new UpdateMinutesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateMinutesRequest {
+    return new UpdateMinutesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateMinutesRequest | PlainMessage<UpdateMinutesRequest> | undefined, b: UpdateMinutesRequest | PlainMessage<UpdateMinutesRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateMinutesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateMinutesResponse
+ */
+export class UpdateMinutesResponse extends Message<UpdateMinutesResponse> {
+  /**
+   * @generated from field: secretary.v1.MeetingMinutes minutes = 1;
+   */
+  minutes?: MeetingMinutes;
+
+  constructor(data?: PartialMessage<UpdateMinutesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateMinutesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "minutes", kind: "message", T: MeetingMinutes },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateMinutesResponse {
+    return new UpdateMinutesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateMinutesResponse {
+    return new UpdateMinutesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateMinutesResponse {
+    return new UpdateMinutesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateMinutesResponse | PlainMessage<UpdateMinutesResponse> | undefined, b: UpdateMinutesResponse | PlainMessage<UpdateMinutesResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateMinutesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListMinutesVersionsRequest
+ */
+export class ListMinutesVersionsRequest extends Message<ListMinutesVersionsRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ListMinutesVersionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListMinutesVersionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMinutesVersionsRequest {
+    return new ListMinutesVersionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMinutesVersionsRequest {
+    return new ListMinutesVersionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMinutesVersionsRequest {
+    return new ListMinutesVersionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListMinutesVersionsRequest | PlainMessage<ListMinutesVersionsRequest> | undefined, b: ListMinutesVersionsRequest | PlainMessage<ListMinutesVersionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListMinutesVersionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListMinutesVersionsResponse
+ */
+export class ListMinutesVersionsResponse extends Message<ListMinutesVersionsResponse> {
+  /**
+   * Newest first.
+   *
+   * @generated from field: repeated secretary.v1.MeetingMinutes versions = 1;
+   */
+  versions: MeetingMinutes[] = [];
+
+  constructor(data?: PartialMessage<ListMinutesVersionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListMinutesVersionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "versions", kind: "message", T: MeetingMinutes, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMinutesVersionsResponse {
+    return new ListMinutesVersionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMinutesVersionsResponse {
+    return new ListMinutesVersionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMinutesVersionsResponse {
+    return new ListMinutesVersionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListMinutesVersionsResponse | PlainMessage<ListMinutesVersionsResponse> | undefined, b: ListMinutesVersionsResponse | PlainMessage<ListMinutesVersionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListMinutesVersionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from enum secretary.v1.WikiTarget
+ */
+export enum WikiTarget {
+  /**
+   * @generated from enum value: WIKI_TARGET_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WIKI_TARGET_NOTION = 1;
+   */
+  NOTION = 1,
+
+  /**
+   * @generated from enum value: WIKI_TARGET_CONFLUENCE = 2;
+   */
+  CONFLUENCE = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(WikiTarget)
+proto3.util.setEnumType(WikiTarget, "secretary.v1.WikiTarget", [
+  { no: 0, name: "WIKI_TARGET_UNSPECIFIED" },
+  { no: 1, name: "WIKI_TARGET_NOTION" },
+  { no: 2, name: "WIKI_TARGET_CONFLUENCE" },
+]);
+
+/**
+ * A recording's page in a wiki.
+ *
+ * @generated from message secretary.v1.Publication
+ */
+export class Publication extends Message<Publication> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 recording_id = 2;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: secretary.v1.WikiTarget target = 3;
+   */
+  target = WikiTarget.UNSPECIFIED;
+
+  /**
+   * @generated from field: string url = 4;
+   */
+  url = "";
+
+  /**
+   * Unset when the page was published on processing completion.
+   *
+   * @generated from field: int64 published_by_user_id = 5;
+   */
+  publishedByUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 6;
+   */
+  createdAt = "";
+
+  /**
+   * When the page was last written.
+   *
+   * @generated from field: string updated_at = 7;
+   */
+  updatedAt = "";
+
+  constructor(data?: PartialMessage<Publication>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.Publication";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "target", kind: "enum", T: proto3.getEnumType(WikiTarget) },
+    { no: 4, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "published_by_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Publication {
+    return new Publication().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Publication {
+    return new Publication().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Publication {
+    return new Publication().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Publication | PlainMessage<Publication> | undefined, b: Publication | PlainMessage<Publication> | undefined): boolean {
+    return proto3.util.equals(Publication, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.PublishRecordingRequest
+ */
+export class PublishRecordingRequest extends Message<PublishRecordingRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: secretary.v1.WikiTarget target = 2;
+   */
+  target = WikiTarget.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<PublishRecordingRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.PublishRecordingRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "target", kind: "enum", T: proto3.getEnumType(WikiTarget) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PublishRecordingRequest {
+    return new PublishRecordingRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PublishRecordingRequest {
+    return new PublishRecordingRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PublishRecordingRequest {
+    return new PublishRecordingRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PublishRecordingRequest | PlainMessage<PublishRecordingRequest> | undefined, b: PublishRecordingRequest | PlainMessage<PublishRecordingRequest> | undefined): boolean {
+    return proto3.util.equals(PublishRecordingRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.PublishRecordingResponse
+ */
+export class PublishRecordingResponse extends Message<PublishRecordingResponse> {
+  /**
+   * @generated from field: secretary.v1.Publication publication = 1;
+   */
+  publication?: Publication;
+
+  constructor(data?: PartialMessage<PublishRecordingResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.PublishRecordingResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "publication", kind: "message", T: Publication },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PublishRecordingResponse {
+    return new PublishRecordingResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PublishRecordingResponse {
+    return new PublishRecordingResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PublishRecordingResponse {
+    return new PublishRecordingResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PublishRecordingResponse | PlainMessage<PublishRecordingResponse> | undefined, b: PublishRecordingResponse | PlainMessage<PublishRecordingResponse> | undefined): boolean {
+    return proto3.util.equals(PublishRecordingResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListPublicationsRequest
+ */
+export class ListPublicationsRequest extends Message<ListPublicationsRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ListPublicationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListPublicationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPublicationsRequest {
+    return new ListPublicationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPublicationsRequest {
+    return new ListPublicationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPublicationsRequest {
+    return new ListPublicationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPublicationsRequest | PlainMessage<ListPublicationsRequest> | undefined, b: ListPublicationsRequest | PlainMessage<ListPublicationsRequest> | undefined): boolean {
+    return proto3.util.equals(ListPublicationsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListPublicationsResponse
+ */
+export class ListPublicationsResponse extends Message<ListPublicationsResponse> {
+  /**
+   * @generated from field: repeated secretary.v1.Publication publications = 1;
+   */
+  publications: Publication[] = [];
+
+  /**
+   * Wikis this server is configured for.
+   *
+   * @generated from field: repeated secretary.v1.WikiTarget available_targets = 2;
+   */
+  availableTargets: WikiTarget[] = [];
+
+  constructor(data?: PartialMessage<ListPublicationsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListPublicationsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "publications", kind: "message", T: Publication, repeated: true },
+    { no: 2, name: "available_targets", kind: "enum", T: proto3.getEnumType(WikiTarget), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPublicationsResponse {
+    return new ListPublicationsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPublicationsResponse {
+    return new ListPublicationsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPublicationsResponse {
+    return new ListPublicationsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPublicationsResponse | PlainMessage<ListPublicationsResponse> | undefined, b: ListPublicationsResponse | PlainMessage<ListPublicationsResponse> | undefined): boolean {
+    return proto3.util.equals(ListPublicationsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RetryProcessingRequest
+ */
+export class RetryProcessingRequest extends Message<RetryProcessingRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: secretary.v1.ProcessingStage stage = 2;
+   */
+  stage = ProcessingStage.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<RetryProcessingRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RetryProcessingRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "stage", kind: "enum", T: proto3.getEnumType(ProcessingStage) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryProcessingRequest {
+    return new RetryProcessingRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryProcessingRequest {
+    return new RetryProcessingRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryProcessingRequest {
+    return new RetryProcessingRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetryProcessingRequest | PlainMessage<RetryProcessingRequest> | undefined, b: RetryProcessingRequest | PlainMessage<RetryProcessingRequest> | undefined): boolean {
+    return proto3.util.equals(RetryProcessingRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RetryProcessingResponse
+ */
+export class RetryProcessingResponse extends Message<RetryProcessingResponse> {
+  /**
+   * @generated from field: secretary.v1.Recording recording = 1;
+   */
+  recording?: Recording;
+
+  constructor(data?: PartialMessage<RetryProcessingResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RetryProcessingResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording", kind: "message", T: Recording },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryProcessingResponse {
+    return new RetryProcessingResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryProcessingResponse {
+    return new RetryProcessingResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryProcessingResponse {
+    return new RetryProcessingResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetryProcessingResponse | PlainMessage<RetryProcessingResponse> | undefined, b: RetryProcessingResponse | PlainMessage<RetryProcessingResponse> | undefined): boolean {
+    return proto3.util.equals(RetryProcessingResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SummarizeRequest
+ */
+export class SummarizeRequest extends Message<SummarizeRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * BCP 47 tag of the language to write the summary in.
+   *
+   * @generated from field: string target_language = 2;
+   */
+  targetLanguage = "";
+
+  constructor(data?: PartialMessage<SummarizeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SummarizeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "target_language", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SummarizeRequest {
+    return new SummarizeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SummarizeRequest {
+    return new SummarizeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SummarizeRequest {
+    return new SummarizeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SummarizeRequest | PlainMessage<SummarizeRequest> | undefined, b: SummarizeRequest | PlainMessage<SummarizeRequest> | undefined): boolean {
+    return proto3.util.equals(SummarizeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SummarizeResponse
+ */
+export class SummarizeResponse extends Message<SummarizeResponse> {
+  /**
+   * @generated from field: secretary.v1.Recording recording = 1;
+   */
+  recording?: Recording;
+
+  constructor(data?: PartialMessage<SummarizeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SummarizeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording", kind: "message", T: Recording },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SummarizeResponse {
+    return new SummarizeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SummarizeResponse {
+    return new SummarizeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SummarizeResponse {
+    return new SummarizeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SummarizeResponse | PlainMessage<SummarizeResponse> | undefined, b: SummarizeResponse | PlainMessage<SummarizeResponse> | undefined): boolean {
+    return proto3.util.equals(SummarizeResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.TranslateTranscriptRequest
+ */
+export class TranslateTranscriptRequest extends Message<TranslateTranscriptRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * BCP 47 tag, e.g. "es" or "pt-BR".
+   *
+   * @generated from field: string target_language = 2;
+   */
+  targetLanguage = "";
+
+  constructor(data?: PartialMessage<TranslateTranscriptRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.TranslateTranscriptRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "target_language", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TranslateTranscriptRequest {
+    return new TranslateTranscriptRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TranslateTranscriptRequest {
+    return new TranslateTranscriptRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TranslateTranscriptRequest {
+    return new TranslateTranscriptRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TranslateTranscriptRequest | PlainMessage<TranslateTranscriptRequest> | undefined, b: TranslateTranscriptRequest | PlainMessage<TranslateTranscriptRequest> | undefined): boolean {
+    return proto3.util.equals(TranslateTranscriptRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.TranslateTranscriptResponse
+ */
+export class TranslateTranscriptResponse extends Message<TranslateTranscriptResponse> {
+  /**
+   * @generated from field: secretary.v1.Recording recording = 1;
+   */
+  recording?: Recording;
+
+  constructor(data?: PartialMessage<TranslateTranscriptResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.TranslateTranscriptResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording", kind: "message", T: Recording },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TranslateTranscriptResponse {
+    return new TranslateTranscriptResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TranslateTranscriptResponse {
+    return new TranslateTranscriptResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TranslateTranscriptResponse {
+    return new TranslateTranscriptResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TranslateTranscriptResponse | PlainMessage<TranslateTranscriptResponse> | undefined, b: TranslateTranscriptResponse | PlainMessage<TranslateTranscriptResponse> | undefined): boolean {
+    return proto3.util.equals(TranslateTranscriptResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.LinkMentionsRequest
+ */
+export class LinkMentionsRequest extends Message<LinkMentionsRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<LinkMentionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.LinkMentionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LinkMentionsRequest {
+    return new LinkMentionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LinkMentionsRequest {
+    return new LinkMentionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LinkMentionsRequest {
+    return new LinkMentionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LinkMentionsRequest | PlainMessage<LinkMentionsRequest> | undefined, b: LinkMentionsRequest | PlainMessage<LinkMentionsRequest> | undefined): boolean {
+    return proto3.util.equals(LinkMentionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.LinkMentionsResponse
+ */
+export class LinkMentionsResponse extends Message<LinkMentionsResponse> {
+  /**
+   * @generated from field: repeated secretary.v1.Mention mentions = 1;
+   */
+  mentions: Mention[] = [];
+
+  /**
+   * Todos that were unassigned and now belong to the person they name.
+   *
+   * @generated from field: repeated int64 assigned_todo_ids = 2;
+   */
+  assignedTodoIds: bigint[] = [];
+
+  constructor(data?: PartialMessage<LinkMentionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.LinkMentionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mentions", kind: "message", T: Mention, repeated: true },
+    { no: 2, name: "assigned_todo_ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LinkMentionsResponse {
+    return new LinkMentionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LinkMentionsResponse {
+    return new LinkMentionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LinkMentionsResponse {
+    return new LinkMentionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LinkMentionsResponse | PlainMessage<LinkMentionsResponse> | undefined, b: LinkMentionsResponse | PlainMessage<LinkMentionsResponse> | undefined): boolean {
+    return proto3.util.equals(LinkMentionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.AddGuestParticipantRequest
+ */
+export class AddGuestParticipantRequest extends Message<AddGuestParticipantRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  /**
+   * The transcript's speaker label for them, if known.
+   *
+   * @generated from field: optional int32 speaker_id = 4;
+   */
+  speakerId?: number;
+
+  constructor(data?: PartialMessage<AddGuestParticipantRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.AddGuestParticipantRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "speaker_id", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddGuestParticipantRequest {
+    return new AddGuestParticipantRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddGuestParticipantRequest {
+    return new AddGuestParticipantRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddGuestParticipantRequest {
+    return new AddGuestParticipantRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddGuestParticipantRequest | PlainMessage<AddGuestParticipantRequest> | undefined, b: AddGuestParticipantRequest | PlainMessage<AddGuestParticipantRequest> | undefined): boolean {
+    return proto3.util.equals(AddGuestParticipantRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.AddGuestParticipantResponse
+ */
+export class AddGuestParticipantResponse extends Message<AddGuestParticipantResponse> {
+  /**
+   * @generated from field: secretary.v1.GuestParticipant guest = 1;
+   */
+  guest?: GuestParticipant;
+
+  constructor(data?: PartialMessage<AddGuestParticipantResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.AddGuestParticipantResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "guest", kind: "message", T: GuestParticipant },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddGuestParticipantResponse {
+    return new AddGuestParticipantResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddGuestParticipantResponse {
+    return new AddGuestParticipantResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddGuestParticipantResponse {
+    return new AddGuestParticipantResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddGuestParticipantResponse | PlainMessage<AddGuestParticipantResponse> | undefined, b: AddGuestParticipantResponse | PlainMessage<AddGuestParticipantResponse> | undefined): boolean {
+    return proto3.util.equals(AddGuestParticipantResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RemoveGuestParticipantRequest
+ */
+export class RemoveGuestParticipantRequest extends Message<RemoveGuestParticipantRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 id = 2;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RemoveGuestParticipantRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RemoveGuestParticipantRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveGuestParticipantRequest {
+    return new RemoveGuestParticipantRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveGuestParticipantRequest {
+    return new RemoveGuestParticipantRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveGuestParticipantRequest {
+    return new RemoveGuestParticipantRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveGuestParticipantRequest | PlainMessage<RemoveGuestParticipantRequest> | undefined, b: RemoveGuestParticipantRequest | PlainMessage<RemoveGuestParticipantRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveGuestParticipantRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RemoveGuestParticipantResponse
+ */
+export class RemoveGuestParticipantResponse extends Message<RemoveGuestParticipantResponse> {
+  constructor(data?: PartialMessage<RemoveGuestParticipantResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RemoveGuestParticipantResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveGuestParticipantResponse {
+    return new RemoveGuestParticipantResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveGuestParticipantResponse {
+    return new RemoveGuestParticipantResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveGuestParticipantResponse {
+    return new RemoveGuestParticipantResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveGuestParticipantResponse | PlainMessage<RemoveGuestParticipantResponse> | undefined, b: RemoveGuestParticipantResponse | PlainMessage<RemoveGuestParticipantResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveGuestParticipantResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RelabelSpeakerRequest
+ */
+export class RelabelSpeakerRequest extends Message<RelabelSpeakerRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * The speaker label as it appears in the transcript, e.g. 2 for
+   * "Speaker 2".
+   *
+   * @generated from field: int32 speaker_id = 2;
+   */
+  speakerId = 0;
+
+  /**
+   * @generated from field: int64 user_id = 3;
+   */
+  userId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 guest_id = 4;
+   */
+  guestId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RelabelSpeakerRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RelabelSpeakerRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "speaker_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "guest_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RelabelSpeakerRequest {
+    return new RelabelSpeakerRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RelabelSpeakerRequest {
+    return new RelabelSpeakerRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RelabelSpeakerRequest {
+    return new RelabelSpeakerRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RelabelSpeakerRequest | PlainMessage<RelabelSpeakerRequest> | undefined, b: RelabelSpeakerRequest | PlainMessage<RelabelSpeakerRequest> | undefined): boolean {
+    return proto3.util.equals(RelabelSpeakerRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RelabelSpeakerResponse
+ */
+export class RelabelSpeakerResponse extends Message<RelabelSpeakerResponse> {
+  /**
+   * @generated from field: secretary.v1.Recording recording = 1;
+   */
+  recording?: Recording;
+
+  /**
+   * Transcript lines moved to the person's other label; 0 when the label
+   * was only mapped.
+   *
+   * @generated from field: int32 relabeled_line_count = 2;
+   */
+  relabeledLineCount = 0;
+
+  constructor(data?: PartialMessage<RelabelSpeakerResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RelabelSpeakerResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording", kind: "message", T: Recording },
+    { no: 2, name: "relabeled_line_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RelabelSpeakerResponse {
+    return new RelabelSpeakerResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RelabelSpeakerResponse {
+    return new RelabelSpeakerResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RelabelSpeakerResponse {
+    return new RelabelSpeakerResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RelabelSpeakerResponse | PlainMessage<RelabelSpeakerResponse> | undefined, b: RelabelSpeakerResponse | PlainMessage<RelabelSpeakerResponse> | undefined): boolean {
+    return proto3.util.equals(RelabelSpeakerResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetRecordingTranscriptRequest
+ */
+export class GetRecordingTranscriptRequest extends Message<GetRecordingTranscriptRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * Text to find, ignoring case and accents. Empty returns every line.
+   *
+   * @generated from field: string query = 2;
+   */
+  query = "";
+
+  /**
+   * Lines to include before and after each match.
+   *
+   * @generated from field: int32 context_lines = 3;
+   */
+  contextLines = 0;
+
+  /**
+   * Matches to return; 0 means 100.
+   *
+   * @generated from field: int32 max_matches = 4;
+   */
+  maxMatches = 0;
+
+  constructor(data?: PartialMessage<GetRecordingTranscriptRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetRecordingTranscriptRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "context_lines", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "max_matches", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRecordingTranscriptRequest {
+    return new GetRecordingTranscriptRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRecordingTranscriptRequest {
+    return new GetRecordingTranscriptRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRecordingTranscriptRequest {
+    return new GetRecordingTranscriptRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRecordingTranscriptRequest | PlainMessage<GetRecordingTranscriptRequest> | undefined, b: GetRecordingTranscriptRequest | PlainMessage<GetRecordingTranscriptRequest> | undefined): boolean {
+    return proto3.util.equals(GetRecordingTranscriptRequest, a, b);
+  }
+}
+
+/**
+ * A transcript line, without its speaker label.
+ *
+ * @generated from message secretary.v1.TranscriptLine
+ */
+export class TranscriptLine extends Message<TranscriptLine> {
+  /**
+   * The line's position in the transcript, from 0.
+   *
+   * @generated from field: int32 index = 1;
+   */
+  index = 0;
+
+  /**
+   * The line's speaker label; -1 when it has none.
+   *
+   * @generated from field: int32 speaker_id = 2;
+   */
+  speakerId = 0;
+
+  /**
+   * @generated from field: string text = 3;
+   */
+  text = "";
+
+  /**
+   * Where the line falls in the audio, estimated the way clip excerpts
+   * are: assuming speech is spread evenly over the recording. Both 0
+   * when the recording's duration is unknown.
+   *
+   * @generated from field: int32 start_ms = 4;
+   */
+  startMs = 0;
+
+  /**
+   * @generated from field: int32 end_ms = 5;
+   */
+  endMs = 0;
+
+  /**
+   * Whether the line matched a search; false for context lines and
+   * outside GetRecordingTranscript.
+   *
+   * @generated from field: bool match = 6;
+   */
+  match = false;
+
+  constructor(data?: PartialMessage<TranscriptLine>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.TranscriptLine";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "index", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "speaker_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "start_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "end_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "match", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TranscriptLine {
+    return new TranscriptLine().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TranscriptLine {
+    return new TranscriptLine().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TranscriptLine {
+    return new TranscriptLine().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TranscriptLine | PlainMessage<TranscriptLine> | undefined, b: TranscriptLine | PlainMessage<TranscriptLine> | undefined): boolean {
+    return proto3.util.equals(TranscriptLine, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetRecordingTranscriptResponse
+ */
+export class GetRecordingTranscriptResponse extends Message<GetRecordingTranscriptResponse> {
+  /**
+   * In transcript order. Context shared by nearby matches appears once.
+   *
+   * @generated from field: repeated secretary.v1.TranscriptLine lines = 1;
+   */
+  lines: TranscriptLine[] = [];
+
+  /**
+   * Every match in the transcript, including those past max_matches.
+   *
+   * @generated from field: int32 match_count = 2;
+   */
+  matchCount = 0;
+
+  /**
+   * Lines in the whole transcript.
+   *
+   * @generated from field: int32 line_count = 3;
+   */
+  lineCount = 0;
+
+  constructor(data?: PartialMessage<GetRecordingTranscriptResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetRecordingTranscriptResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "lines", kind: "message", T: TranscriptLine, repeated: true },
+    { no: 2, name: "match_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "line_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRecordingTranscriptResponse {
+    return new GetRecordingTranscriptResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRecordingTranscriptResponse {
+    return new GetRecordingTranscriptResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRecordingTranscriptResponse {
+    return new GetRecordingTranscriptResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRecordingTranscriptResponse | PlainMessage<GetRecordingTranscriptResponse> | undefined, b: GetRecordingTranscriptResponse | PlainMessage<GetRecordingTranscriptResponse> | undefined): boolean {
+    return proto3.util.equals(GetRecordingTranscriptResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListTranscriptSegmentsRequest
+ */
+export class ListTranscriptSegmentsRequest extends Message<ListTranscriptSegmentsRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * A language the transcript was translated into with
+   * TranslateTranscript; the original when empty.
+   *
+   * @generated from field: string language = 2;
+   */
+  language = "";
+
+  /**
+   * Lines per page; 0 means 200.
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit = 0;
+
+  /**
+   * The next_page_token of a previous response, to continue after it.
+   *
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListTranscriptSegmentsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListTranscriptSegmentsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "language", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTranscriptSegmentsRequest {
+    return new ListTranscriptSegmentsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTranscriptSegmentsRequest {
+    return new ListTranscriptSegmentsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTranscriptSegmentsRequest {
+    return new ListTranscriptSegmentsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTranscriptSegmentsRequest | PlainMessage<ListTranscriptSegmentsRequest> | undefined, b: ListTranscriptSegmentsRequest | PlainMessage<ListTranscriptSegmentsRequest> | undefined): boolean {
+    return proto3.util.equals(ListTranscriptSegmentsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListTranscriptSegmentsResponse
+ */
+export class ListTranscriptSegmentsResponse extends Message<ListTranscriptSegmentsResponse> {
+  /**
+   * In transcript order.
+   *
+   * @generated from field: repeated secretary.v1.TranscriptLine lines = 1;
+   */
+  lines: TranscriptLine[] = [];
+
+  /**
+   * Empty on the last page.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  /**
+   * Lines in the whole transcript or translation.
+   *
+   * @generated from field: int32 line_count = 3;
+   */
+  lineCount = 0;
+
+  constructor(data?: PartialMessage<ListTranscriptSegmentsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListTranscriptSegmentsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "lines", kind: "message", T: TranscriptLine, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "line_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTranscriptSegmentsResponse {
+    return new ListTranscriptSegmentsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTranscriptSegmentsResponse {
+    return new ListTranscriptSegmentsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTranscriptSegmentsResponse {
+    return new ListTranscriptSegmentsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTranscriptSegmentsResponse | PlainMessage<ListTranscriptSegmentsResponse> | undefined, b: ListTranscriptSegmentsResponse | PlainMessage<ListTranscriptSegmentsResponse> | undefined): boolean {
+    return proto3.util.equals(ListTranscriptSegmentsResponse, a, b);
+  }
+}
+
+/**
+ * One saved revision of a recording's transcript. Revisions count up
+ * from 1 and are never changed once saved.
+ *
+ * @generated from message secretary.v1.TranscriptRevision
+ */
+export class TranscriptRevision extends Message<TranscriptRevision> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: int32 revision = 2;
+   */
+  revision = 0;
+
+  /**
+   * "transcription" for the transcript as transcribed, or as it was before
+   * the first edit; "relabel" for RelabelSpeaker.
+   *
+   * @generated from field: string source = 3;
+   */
+  source = "";
+
+  /**
+   * 0 for revisions not made by a user.
+   *
+   * @generated from field: int64 created_by_user_id = 4;
+   */
+  createdByUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<TranscriptRevision>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.TranscriptRevision";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_by_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TranscriptRevision {
+    return new TranscriptRevision().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TranscriptRevision {
+    return new TranscriptRevision().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TranscriptRevision {
+    return new TranscriptRevision().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TranscriptRevision | PlainMessage<TranscriptRevision> | undefined, b: TranscriptRevision | PlainMessage<TranscriptRevision> | undefined): boolean {
+    return proto3.util.equals(TranscriptRevision, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListTranscriptRevisionsRequest
+ */
+export class ListTranscriptRevisionsRequest extends Message<ListTranscriptRevisionsRequest> {
   /**
-   * @generated from field: int64 id = 1;
+   * @generated from field: int64 recording_id = 1;
    */
-  id = protoInt64.zero;
+  recordingId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ListTranscriptRevisionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListTranscriptRevisionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTranscriptRevisionsRequest {
+    return new ListTranscriptRevisionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTranscriptRevisionsRequest {
+    return new ListTranscriptRevisionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTranscriptRevisionsRequest {
+    return new ListTranscriptRevisionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTranscriptRevisionsRequest | PlainMessage<ListTranscriptRevisionsRequest> | undefined, b: ListTranscriptRevisionsRequest | PlainMessage<ListTranscriptRevisionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListTranscriptRevisionsRequest, a, b);
+  }
+}
 
+/**
+ * @generated from message secretary.v1.ListTranscriptRevisionsResponse
+ */
+export class ListTranscriptRevisionsResponse extends Message<ListTranscriptRevisionsResponse> {
   /**
-   * @generated from field: string name = 2;
+   * Newest first.
+   *
+   * @generated from field: repeated secretary.v1.TranscriptRevision revisions = 1;
    */
-  name = "";
+  revisions: TranscriptRevision[] = [];
+
+  constructor(data?: PartialMessage<ListTranscriptRevisionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListTranscriptRevisionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revisions", kind: "message", T: TranscriptRevision, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTranscriptRevisionsResponse {
+    return new ListTranscriptRevisionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTranscriptRevisionsResponse {
+    return new ListTranscriptRevisionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTranscriptRevisionsResponse {
+    return new ListTranscriptRevisionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTranscriptRevisionsResponse | PlainMessage<ListTranscriptRevisionsResponse> | undefined, b: ListTranscriptRevisionsResponse | PlainMessage<ListTranscriptRevisionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListTranscriptRevisionsResponse, a, b);
+  }
+}
 
+/**
+ * @generated from enum secretary.v1.TranscriptDiffOp
+ */
+export enum TranscriptDiffOp {
   /**
-   * @generated from field: string created_at = 3;
+   * @generated from enum value: TRANSCRIPT_DIFF_OP_UNSPECIFIED = 0;
    */
-  createdAt = "";
+  UNSPECIFIED = 0,
 
   /**
-   * @generated from field: int32 duration = 4;
+   * @generated from enum value: TRANSCRIPT_DIFF_OP_EQUAL = 1;
    */
-  duration = 0;
+  EQUAL = 1,
 
   /**
-   * @generated from field: string summary = 5;
+   * @generated from enum value: TRANSCRIPT_DIFF_OP_INSERT = 2;
    */
-  summary = "";
+  INSERT = 2,
 
   /**
-   * @generated from field: string transcript = 6;
+   * @generated from enum value: TRANSCRIPT_DIFF_OP_DELETE = 3;
    */
-  transcript = "";
+  DELETE = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TranscriptDiffOp)
+proto3.util.setEnumType(TranscriptDiffOp, "secretary.v1.TranscriptDiffOp", [
+  { no: 0, name: "TRANSCRIPT_DIFF_OP_UNSPECIFIED" },
+  { no: 1, name: "TRANSCRIPT_DIFF_OP_EQUAL" },
+  { no: 2, name: "TRANSCRIPT_DIFF_OP_INSERT" },
+  { no: 3, name: "TRANSCRIPT_DIFF_OP_DELETE" },
+]);
 
+/**
+ * A run of words that changed the same way.
+ *
+ * @generated from message secretary.v1.TranscriptDiffSpan
+ */
+export class TranscriptDiffSpan extends Message<TranscriptDiffSpan> {
   /**
-   * @generated from field: string audio_url = 7;
+   * @generated from field: secretary.v1.TranscriptDiffOp op = 1;
    */
-  audioUrl = "";
+  op = TranscriptDiffOp.UNSPECIFIED;
 
   /**
-   * @generated from field: bool has_audio = 8;
+   * The words, separated by single spaces.
+   *
+   * @generated from field: string text = 2;
    */
-  hasAudio = false;
+  text = "";
+
+  constructor(data?: PartialMessage<TranscriptDiffSpan>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.TranscriptDiffSpan";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "op", kind: "enum", T: proto3.getEnumType(TranscriptDiffOp) },
+    { no: 2, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TranscriptDiffSpan {
+    return new TranscriptDiffSpan().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TranscriptDiffSpan {
+    return new TranscriptDiffSpan().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TranscriptDiffSpan {
+    return new TranscriptDiffSpan().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TranscriptDiffSpan | PlainMessage<TranscriptDiffSpan> | undefined, b: TranscriptDiffSpan | PlainMessage<TranscriptDiffSpan> | undefined): boolean {
+    return proto3.util.equals(TranscriptDiffSpan, a, b);
+  }
+}
 
+/**
+ * A transcript line in a revision diff: one kept, inserted or deleted, or
+ * a line of the older revision paired with the one that replaced it.
+ *
+ * @generated from message secretary.v1.TranscriptDiffSegment
+ */
+export class TranscriptDiffSegment extends Message<TranscriptDiffSegment> {
   /**
-   * @generated from field: repeated secretary.v1.User participants = 9;
+   * The line's index in the older revision; -1 when it was inserted.
+   *
+   * @generated from field: int32 from_index = 1;
    */
-  participants: User[] = [];
+  fromIndex = 0;
 
-  constructor(data?: PartialMessage<Recording>) {
+  /**
+   * The line's index in the newer revision; -1 when it was deleted.
+   *
+   * @generated from field: int32 to_index = 2;
+   */
+  toIndex = 0;
+
+  /**
+   * The line's speaker label in each revision; -1 when it has none there.
+   *
+   * @generated from field: int32 from_speaker_id = 3;
+   */
+  fromSpeakerId = 0;
+
+  /**
+   * @generated from field: int32 to_speaker_id = 4;
+   */
+  toSpeakerId = 0;
+
+  /**
+   * The line's words in order. Deleted words come before the words
+   * inserted in their place.
+   *
+   * @generated from field: repeated secretary.v1.TranscriptDiffSpan spans = 5;
+   */
+  spans: TranscriptDiffSpan[] = [];
+
+  /**
+   * Whether the words or the speaker changed.
+   *
+   * @generated from field: bool changed = 6;
+   */
+  changed = false;
+
+  constructor(data?: PartialMessage<TranscriptDiffSegment>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "secretary.v1.Recording";
+  static readonly typeName = "secretary.v1.TranscriptDiffSegment";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "duration", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 5, name: "summary", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "transcript", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "audio_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 8, name: "has_audio", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 9, name: "participants", kind: "message", T: User, repeated: true },
+    { no: 1, name: "from_index", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "to_index", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "from_speaker_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "to_speaker_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "spans", kind: "message", T: TranscriptDiffSpan, repeated: true },
+    { no: 6, name: "changed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Recording {
-    return new Recording().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TranscriptDiffSegment {
+    return new TranscriptDiffSegment().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Recording {
-    return new Recording().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TranscriptDiffSegment {
+    return new TranscriptDiffSegment().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Recording {
-    return new Recording().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TranscriptDiffSegment {
+    return new TranscriptDiffSegment().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Recording | PlainMessage<Recording> | undefined, b: Recording | PlainMessage<Recording> | undefined): boolean {
-    return proto3.util.equals(Recording, a, b);
+  static equals(a: TranscriptDiffSegment | PlainMessage<TranscriptDiffSegment> | undefined, b: TranscriptDiffSegment | PlainMessage<TranscriptDiffSegment> | undefined): boolean {
+    return proto3.util.equals(TranscriptDiffSegment, a, b);
   }
 }
 
 /**
- * @generated from message secretary.v1.ListRecordingsRequest
+ * @generated from message secretary.v1.GetTranscriptRevisionDiffRequest
  */
-export class ListRecordingsRequest extends Message<ListRecordingsRequest> {
-  constructor(data?: PartialMessage<ListRecordingsRequest>) {
+export class GetTranscriptRevisionDiffRequest extends Message<GetTranscriptRevisionDiffRequest> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: int32 from_revision = 2;
+   */
+  fromRevision = 0;
+
+  /**
+   * 0 for the latest revision.
+   *
+   * @generated from field: int32 to_revision = 3;
+   */
+  toRevision = 0;
+
+  /**
+   * Leaves out the lines that didn't change.
+   *
+   * @generated from field: bool changes_only = 4;
+   */
+  changesOnly = false;
+
+  constructor(data?: PartialMessage<GetTranscriptRevisionDiffRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "secretary.v1.ListRecordingsRequest";
+  static readonly typeName = "secretary.v1.GetTranscriptRevisionDiffRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "from_revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "to_revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "changes_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRecordingsRequest {
-    return new ListRecordingsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTranscriptRevisionDiffRequest {
+    return new GetTranscriptRevisionDiffRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRecordingsRequest {
-    return new ListRecordingsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTranscriptRevisionDiffRequest {
+    return new GetTranscriptRevisionDiffRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRecordingsRequest {
-    return new ListRecordingsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTranscriptRevisionDiffRequest {
+    return new GetTranscriptRevisionDiffRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListRecordingsRequest | PlainMessage<ListRecordingsRequest> | undefined, b: ListRecordingsRequest | PlainMessage<ListRecordingsRequest> | undefined): boolean {
-    return proto3.util.equals(ListRecordingsRequest, a, b);
+  static equals(a: GetTranscriptRevisionDiffRequest | PlainMessage<GetTranscriptRevisionDiffRequest> | undefined, b: GetTranscriptRevisionDiffRequest | PlainMessage<GetTranscriptRevisionDiffRequest> | undefined): boolean {
+    return proto3.util.equals(GetTranscriptRevisionDiffRequest, a, b);
   }
 }
 
 /**
- * @generated from message secretary.v1.ListRecordingsResponse
+ * @generated from message secretary.v1.GetTranscriptRevisionDiffResponse
  */
-export class ListRecordingsResponse extends Message<ListRecordingsResponse> {
+export class GetTranscriptRevisionDiffResponse extends Message<GetTranscriptRevisionDiffResponse> {
   /**
-   * @generated from field: repeated secretary.v1.Recording recordings = 1;
+   * @generated from field: int32 from_revision = 1;
    */
-  recordings: Recording[] = [];
+  fromRevision = 0;
 
-  constructor(data?: PartialMessage<ListRecordingsResponse>) {
+  /**
+   * @generated from field: int32 to_revision = 2;
+   */
+  toRevision = 0;
+
+  /**
+   * In transcript order.
+   *
+   * @generated from field: repeated secretary.v1.TranscriptDiffSegment segments = 3;
+   */
+  segments: TranscriptDiffSegment[] = [];
+
+  /**
+   * Totals over the whole diff, also with changes_only.
+   *
+   * @generated from field: int32 changed_segment_count = 4;
+   */
+  changedSegmentCount = 0;
+
+  /**
+   * @generated from field: int32 inserted_word_count = 5;
+   */
+  insertedWordCount = 0;
+
+  /**
+   * @generated from field: int32 deleted_word_count = 6;
+   */
+  deletedWordCount = 0;
+
+  constructor(data?: PartialMessage<GetTranscriptRevisionDiffResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "secretary.v1.ListRecordingsResponse";
+  static readonly typeName = "secretary.v1.GetTranscriptRevisionDiffResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "recordings", kind: "message", T: Recording, repeated: true },
+    { no: 1, name: "from_revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "to_revision", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "segments", kind: "message", T: TranscriptDiffSegment, repeated: true },
+    { no: 4, name: "changed_segment_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "inserted_word_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "deleted_word_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRecordingsResponse {
-    return new ListRecordingsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTranscriptRevisionDiffResponse {
+    return new GetTranscriptRevisionDiffResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRecordingsResponse {
-    return new ListRecordingsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTranscriptRevisionDiffResponse {
+    return new GetTranscriptRevisionDiffResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRecordingsResponse {
-    return new ListRecordingsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTranscriptRevisionDiffResponse {
+    return new GetTranscriptRevisionDiffResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListRecordingsResponse | PlainMessage<ListRecordingsResponse> | undefined, b: ListRecordingsResponse | PlainMessage<ListRecordingsResponse> | undefined): boolean {
-    return proto3.util.equals(ListRecordingsResponse, a, b);
+  static equals(a: GetTranscriptRevisionDiffResponse | PlainMessage<GetTranscriptRevisionDiffResponse> | undefined, b: GetTranscriptRevisionDiffResponse | PlainMessage<GetTranscriptRevisionDiffResponse> | undefined): boolean {
+    return proto3.util.equals(GetTranscriptRevisionDiffResponse, a, b);
   }
 }
 
 /**
- * @generated from message secretary.v1.GetRecordingRequest
+ * @generated from message secretary.v1.CloneRecordingRequest
  */
-export class GetRecordingRequest extends Message<GetRecordingRequest> {
+export class CloneRecordingRequest extends Message<CloneRecordingRequest> {
   /**
    * @generated from field: int64 id = 1;
    */
   id = protoInt64.zero;
 
-  constructor(data?: PartialMessage<GetRecordingRequest>) {
+  /**
+   * Defaults to the original's name with " (copy)" appended.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * BCP 47 tag of the language spoken; empty to detect it. The original's
+   * when unset.
+   *
+   * @generated from field: optional string language = 3;
+   */
+  language?: string;
+
+  /**
+   * 0 for the built-in prompts. The original's when unset.
+   *
+   * @generated from field: optional int64 prompt_template_id = 4;
+   */
+  promptTemplateId?: bigint;
+
+  /**
+   * Replaces the original's settings when set. language and
+   * prompt_template_id, when also set, still take precedence.
+   *
+   * @generated from field: secretary.v1.ProcessingSettings settings = 5;
+   */
+  settings?: ProcessingSettings;
+
+  constructor(data?: PartialMessage<CloneRecordingRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "secretary.v1.GetRecordingRequest";
+  static readonly typeName = "secretary.v1.CloneRecordingRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "language", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "prompt_template_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 5, name: "settings", kind: "message", T: ProcessingSettings },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRecordingRequest {
-    return new GetRecordingRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CloneRecordingRequest {
+    return new CloneRecordingRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRecordingRequest {
-    return new GetRecordingRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CloneRecordingRequest {
+    return new CloneRecordingRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRecordingRequest {
-    return new GetRecordingRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CloneRecordingRequest {
+    return new CloneRecordingRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetRecordingRequest | PlainMessage<GetRecordingRequest> | undefined, b: GetRecordingRequest | PlainMessage<GetRecordingRequest> | undefined): boolean {
-    return proto3.util.equals(GetRecordingRequest, a, b);
+  static equals(a: CloneRecordingRequest | PlainMessage<CloneRecordingRequest> | undefined, b: CloneRecordingRequest | PlainMessage<CloneRecordingRequest> | undefined): boolean {
+    return proto3.util.equals(CloneRecordingRequest, a, b);
   }
 }
 
 /**
- * @generated from message secretary.v1.GetRecordingResponse
+ * @generated from message secretary.v1.CloneRecordingResponse
  */
-export class GetRecordingResponse extends Message<GetRecordingResponse> {
+export class CloneRecordingResponse extends Message<CloneRecordingResponse> {
   /**
    * @generated from field: secretary.v1.Recording recording = 1;
    */
   recording?: Recording;
 
-  constructor(data?: PartialMessage<GetRecordingResponse>) {
+  constructor(data?: PartialMessage<CloneRecordingResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "secretary.v1.GetRecordingResponse";
+  static readonly typeName = "secretary.v1.CloneRecordingResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "recording", kind: "message", T: Recording },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRecordingResponse {
-    return new GetRecordingResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CloneRecordingResponse {
+    return new CloneRecordingResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRecordingResponse {
-    return new GetRecordingResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CloneRecordingResponse {
+    return new CloneRecordingResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRecordingResponse {
-    return new GetRecordingResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CloneRecordingResponse {
+    return new CloneRecordingResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetRecordingResponse | PlainMessage<GetRecordingResponse> | undefined, b: GetRecordingResponse | PlainMessage<GetRecordingResponse> | undefined): boolean {
-    return proto3.util.equals(GetRecordingResponse, a, b);
+  static equals(a: CloneRecordingResponse | PlainMessage<CloneRecordingResponse> | undefined, b: CloneRecordingResponse | PlainMessage<CloneRecordingResponse> | undefined): boolean {
+    return proto3.util.equals(CloneRecordingResponse, a, b);
   }
 }
 
 /**
- * @generated from message secretary.v1.DeleteRecordingRequest
+ * @generated from message secretary.v1.UpdateProcessingSettingsRequest
  */
-export class DeleteRecordingRequest extends Message<DeleteRecordingRequest> {
+export class UpdateProcessingSettingsRequest extends Message<UpdateProcessingSettingsRequest> {
   /**
-   * @generated from field: int64 id = 1;
+   * @generated from field: int64 recording_id = 1;
    */
-  id = protoInt64.zero;
+  recordingId = protoInt64.zero;
 
-  constructor(data?: PartialMessage<DeleteRecordingRequest>) {
+  /**
+   * @generated from field: secretary.v1.ProcessingSettings settings = 2;
+   */
+  settings?: ProcessingSettings;
+
+  constructor(data?: PartialMessage<UpdateProcessingSettingsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "secretary.v1.DeleteRecordingRequest";
+  static readonly typeName = "secretary.v1.UpdateProcessingSettingsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "settings", kind: "message", T: ProcessingSettings },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRecordingRequest {
-    return new DeleteRecordingRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProcessingSettingsRequest {
+    return new UpdateProcessingSettingsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRecordingRequest {
-    return new DeleteRecordingRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateProcessingSettingsRequest {
+    return new UpdateProcessingSettingsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRecordingRequest {
-    return new DeleteRecordingRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateProcessingSettingsRequest {
+    return new UpdateProcessingSettingsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteRecordingRequest | PlainMessage<DeleteRecordingRequest> | undefined, b: DeleteRecordingRequest | PlainMessage<DeleteRecordingRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteRecordingRequest, a, b);
+  static equals(a: UpdateProcessingSettingsRequest | PlainMessage<UpdateProcessingSettingsRequest> | undefined, b: UpdateProcessingSettingsRequest | PlainMessage<UpdateProcessingSettingsRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateProcessingSettingsRequest, a, b);
   }
 }
 
 /**
- * @generated from message secretary.v1.DeleteRecordingResponse
+ * @generated from message secretary.v1.UpdateProcessingSettingsResponse
  */
-export class DeleteRecordingResponse extends Message<DeleteRecordingResponse> {
-  constructor(data?: PartialMessage<DeleteRecordingResponse>) {
+export class UpdateProcessingSettingsResponse extends Message<UpdateProcessingSettingsResponse> {
+  /**
+   * @generated from field: secretary.v1.Recording recording = 1;
+   */
+  recording?: Recording;
+
+  constructor(data?: PartialMessage<UpdateProcessingSettingsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "secretary.v1.DeleteRecordingResponse";
+  static readonly typeName = "secretary.v1.UpdateProcessingSettingsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording", kind: "message", T: Recording },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRecordingResponse {
-    return new DeleteRecordingResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProcessingSettingsResponse {
+    return new UpdateProcessingSettingsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRecordingResponse {
-    return new DeleteRecordingResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateProcessingSettingsResponse {
+    return new UpdateProcessingSettingsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRecordingResponse {
-    return new DeleteRecordingResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateProcessingSettingsResponse {
+    return new UpdateProcessingSettingsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteRecordingResponse | PlainMessage<DeleteRecordingResponse> | undefined, b: DeleteRecordingResponse | PlainMessage<DeleteRecordingResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteRecordingResponse, a, b);
+  static equals(a: UpdateProcessingSettingsResponse | PlainMessage<UpdateProcessingSettingsResponse> | undefined, b: UpdateProcessingSettingsResponse | PlainMessage<UpdateProcessingSettingsResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateProcessingSettingsResponse, a, b);
   }
 }
-
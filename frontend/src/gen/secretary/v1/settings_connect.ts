@@ -0,0 +1,52 @@
+// @generated by protoc-gen-connect-es v1.7.0 with parameter "target=ts"
+// @generated from file secretary/v1/settings.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetSettingsRequest, GetSettingsResponse, PurgeExpiredDataRequest, PurgeExpiredDataResponse, UpdateSettingsRequest, UpdateSettingsResponse } from "./settings_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service secretary.v1.SettingsService
+ */
+export const SettingsService = {
+  typeName: "secretary.v1.SettingsService",
+  methods: {
+    /**
+     * Readable by every signed-in user, since the web app shows the name
+     * and logo.
+     *
+     * @generated from rpc secretary.v1.SettingsService.GetSettings
+     */
+    getSettings: {
+      name: "GetSettings",
+      I: GetSettingsRequest,
+      O: GetSettingsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Admin only.
+     *
+     * @generated from rpc secretary.v1.SettingsService.UpdateSettings
+     */
+    updateSettings: {
+      name: "UpdateSettings",
+      I: UpdateSettingsRequest,
+      O: UpdateSettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Removes audio and transcripts older than the retention policy allows
+     * now, instead of waiting for the scheduled purge. Admin only.
+     *
+     * @generated from rpc secretary.v1.SettingsService.PurgeExpiredData
+     */
+    purgeExpiredData: {
+      name: "PurgeExpiredData",
+      I: PurgeExpiredDataRequest,
+      O: PurgeExpiredDataResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
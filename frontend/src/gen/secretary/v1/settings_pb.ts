@@ -0,0 +1,617 @@
+// @generated by protoc-gen-es v1.10.1 with parameter "target=ts"
+// @generated from file secretary/v1/settings.proto (package secretary.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * How long recording data is kept. 0 keeps it forever.
+ *
+ * @generated from message secretary.v1.RetentionPolicy
+ */
+export class RetentionPolicy extends Message<RetentionPolicy> {
+  /**
+   * @generated from field: int32 audio_days = 1;
+   */
+  audioDays = 0;
+
+  /**
+   * @generated from field: int32 transcript_days = 2;
+   */
+  transcriptDays = 0;
+
+  constructor(data?: PartialMessage<RetentionPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RetentionPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "audio_days", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "transcript_days", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetentionPolicy {
+    return new RetentionPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetentionPolicy {
+    return new RetentionPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetentionPolicy {
+    return new RetentionPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetentionPolicy | PlainMessage<RetentionPolicy> | undefined, b: RetentionPolicy | PlainMessage<RetentionPolicy> | undefined): boolean {
+    return proto3.util.equals(RetentionPolicy, a, b);
+  }
+}
+
+/**
+ * What is masked in transcripts and summaries before they are stored, and
+ * in summaries and minutes before they are published or sent to chat.
+ *
+ * @generated from message secretary.v1.RedactionPolicy
+ */
+export class RedactionPolicy extends Message<RedactionPolicy> {
+  /**
+   * @generated from field: bool emails = 1;
+   */
+  emails = false;
+
+  /**
+   * @generated from field: bool phone_numbers = 2;
+   */
+  phoneNumbers = false;
+
+  /**
+   * Card numbers that pass the Luhn check.
+   *
+   * @generated from field: bool credit_cards = 3;
+   */
+  creditCards = false;
+
+  /**
+   * @generated from field: bool profanity = 4;
+   */
+  profanity = false;
+
+  constructor(data?: PartialMessage<RedactionPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RedactionPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "emails", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "phone_numbers", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "credit_cards", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "profanity", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RedactionPolicy {
+    return new RedactionPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RedactionPolicy {
+    return new RedactionPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RedactionPolicy {
+    return new RedactionPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RedactionPolicy | PlainMessage<RedactionPolicy> | undefined, b: RedactionPolicy | PlainMessage<RedactionPolicy> | undefined): boolean {
+    return proto3.util.equals(RedactionPolicy, a, b);
+  }
+}
+
+/**
+ * How new recordings are processed unless their own settings say
+ * otherwise.
+ *
+ * @generated from message secretary.v1.ProcessingDefaults
+ */
+export class ProcessingDefaults extends Message<ProcessingDefaults> {
+  /**
+   * BCP 47 tag of the language usually spoken; empty to detect it.
+   *
+   * @generated from field: string language = 1;
+   */
+  language = "";
+
+  /**
+   * Stops the worker summarizing recordings once they are transcribed.
+   *
+   * @generated from field: bool disable_summary = 2;
+   */
+  disableSummary = false;
+
+  /**
+   * Stops the worker extracting todos while it summarizes.
+   *
+   * @generated from field: bool disable_todo_extraction = 3;
+   */
+  disableTodoExtraction = false;
+
+  constructor(data?: PartialMessage<ProcessingDefaults>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ProcessingDefaults";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "language", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "disable_summary", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "disable_todo_extraction", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessingDefaults {
+    return new ProcessingDefaults().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessingDefaults {
+    return new ProcessingDefaults().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessingDefaults {
+    return new ProcessingDefaults().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProcessingDefaults | PlainMessage<ProcessingDefaults> | undefined, b: ProcessingDefaults | PlainMessage<ProcessingDefaults> | undefined): boolean {
+    return proto3.util.equals(ProcessingDefaults, a, b);
+  }
+}
+
+/**
+ * Organization-wide settings admins change at runtime. Everything else
+ * the server needs still comes from its environment.
+ *
+ * @generated from message secretary.v1.OrgSettings
+ */
+export class OrgSettings extends Message<OrgSettings> {
+  /**
+   * @generated from field: string org_name = 1;
+   */
+  orgName = "";
+
+  /**
+   * Where the logo is served; empty without one. Uploaded to
+   * /api/settings/logo, so ignored by UpdateSettings.
+   *
+   * @generated from field: string logo_url = 2;
+   */
+  logoUrl = "";
+
+  /**
+   * Role given to accounts created from now on.
+   *
+   * @generated from field: string default_user_role = 3;
+   */
+  defaultUserRole = "";
+
+  /**
+   * @generated from field: secretary.v1.RetentionPolicy retention = 4;
+   */
+  retention?: RetentionPolicy;
+
+  /**
+   * Integrations switched off even though the server has credentials for
+   * them, by the names in GetSettingsResponse.integrations.
+   *
+   * @generated from field: repeated string disabled_integrations = 5;
+   */
+  disabledIntegrations: string[] = [];
+
+  /**
+   * @generated from field: string updated_at = 6;
+   */
+  updatedAt = "";
+
+  /**
+   * @generated from field: int64 updated_by_user_id = 7;
+   */
+  updatedByUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: secretary.v1.RedactionPolicy redaction = 8;
+   */
+  redaction?: RedactionPolicy;
+
+  /**
+   * @generated from field: secretary.v1.ProcessingDefaults processing_defaults = 9;
+   */
+  processingDefaults?: ProcessingDefaults;
+
+  constructor(data?: PartialMessage<OrgSettings>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.OrgSettings";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "org_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "logo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "default_user_role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "retention", kind: "message", T: RetentionPolicy },
+    { no: 5, name: "disabled_integrations", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "updated_by_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "redaction", kind: "message", T: RedactionPolicy },
+    { no: 9, name: "processing_defaults", kind: "message", T: ProcessingDefaults },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrgSettings {
+    return new OrgSettings().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrgSettings {
+    return new OrgSettings().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrgSettings {
+    return new OrgSettings().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OrgSettings | PlainMessage<OrgSettings> | undefined, b: OrgSettings | PlainMessage<OrgSettings> | undefined): boolean {
+    return proto3.util.equals(OrgSettings, a, b);
+  }
+}
+
+/**
+ * A service the server can talk to, such as a tracker or a chat channel.
+ *
+ * @generated from message secretary.v1.Integration
+ */
+export class Integration extends Message<Integration> {
+  /**
+   * e.g. "github", "notion", "slack", "fcm".
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Whether the server has credentials for it.
+   *
+   * @generated from field: bool configured = 2;
+   */
+  configured = false;
+
+  /**
+   * Whether it is configured and not disabled.
+   *
+   * @generated from field: bool enabled = 3;
+   */
+  enabled = false;
+
+  constructor(data?: PartialMessage<Integration>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.Integration";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "configured", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Integration {
+    return new Integration().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Integration {
+    return new Integration().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Integration {
+    return new Integration().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Integration | PlainMessage<Integration> | undefined, b: Integration | PlainMessage<Integration> | undefined): boolean {
+    return proto3.util.equals(Integration, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetSettingsRequest
+ */
+export class GetSettingsRequest extends Message<GetSettingsRequest> {
+  constructor(data?: PartialMessage<GetSettingsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetSettingsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSettingsRequest {
+    return new GetSettingsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSettingsRequest {
+    return new GetSettingsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSettingsRequest {
+    return new GetSettingsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSettingsRequest | PlainMessage<GetSettingsRequest> | undefined, b: GetSettingsRequest | PlainMessage<GetSettingsRequest> | undefined): boolean {
+    return proto3.util.equals(GetSettingsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetSettingsResponse
+ */
+export class GetSettingsResponse extends Message<GetSettingsResponse> {
+  /**
+   * @generated from field: secretary.v1.OrgSettings settings = 1;
+   */
+  settings?: OrgSettings;
+
+  /**
+   * By name.
+   *
+   * @generated from field: repeated secretary.v1.Integration integrations = 2;
+   */
+  integrations: Integration[] = [];
+
+  constructor(data?: PartialMessage<GetSettingsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetSettingsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "settings", kind: "message", T: OrgSettings },
+    { no: 2, name: "integrations", kind: "message", T: Integration, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSettingsResponse {
+    return new GetSettingsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSettingsResponse {
+    return new GetSettingsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSettingsResponse {
+    return new GetSettingsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSettingsResponse | PlainMessage<GetSettingsResponse> | undefined, b: GetSettingsResponse | PlainMessage<GetSettingsResponse> | undefined): boolean {
+    return proto3.util.equals(GetSettingsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateSettingsRequest
+ */
+export class UpdateSettingsRequest extends Message<UpdateSettingsRequest> {
+  /**
+   * Replaces the settings, except the logo.
+   *
+   * @generated from field: secretary.v1.OrgSettings settings = 1;
+   */
+  settings?: OrgSettings;
+
+  constructor(data?: PartialMessage<UpdateSettingsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateSettingsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "settings", kind: "message", T: OrgSettings },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSettingsRequest {
+    return new UpdateSettingsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSettingsRequest {
+    return new UpdateSettingsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSettingsRequest {
+    return new UpdateSettingsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSettingsRequest | PlainMessage<UpdateSettingsRequest> | undefined, b: UpdateSettingsRequest | PlainMessage<UpdateSettingsRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateSettingsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateSettingsResponse
+ */
+export class UpdateSettingsResponse extends Message<UpdateSettingsResponse> {
+  /**
+   * @generated from field: secretary.v1.OrgSettings settings = 1;
+   */
+  settings?: OrgSettings;
+
+  constructor(data?: PartialMessage<UpdateSettingsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateSettingsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "settings", kind: "message", T: OrgSettings },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSettingsResponse {
+    return new UpdateSettingsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSettingsResponse {
+    return new UpdateSettingsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSettingsResponse {
+    return new UpdateSettingsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSettingsResponse | PlainMessage<UpdateSettingsResponse> | undefined, b: UpdateSettingsResponse | PlainMessage<UpdateSettingsResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateSettingsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.PurgeExpiredDataRequest
+ */
+export class PurgeExpiredDataRequest extends Message<PurgeExpiredDataRequest> {
+  /**
+   * Only report what would be removed.
+   *
+   * @generated from field: bool dry_run = 1;
+   */
+  dryRun = false;
+
+  constructor(data?: PartialMessage<PurgeExpiredDataRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.PurgeExpiredDataRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dry_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PurgeExpiredDataRequest {
+    return new PurgeExpiredDataRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PurgeExpiredDataRequest {
+    return new PurgeExpiredDataRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PurgeExpiredDataRequest {
+    return new PurgeExpiredDataRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PurgeExpiredDataRequest | PlainMessage<PurgeExpiredDataRequest> | undefined, b: PurgeExpiredDataRequest | PlainMessage<PurgeExpiredDataRequest> | undefined): boolean {
+    return proto3.util.equals(PurgeExpiredDataRequest, a, b);
+  }
+}
+
+/**
+ * A recording whose data the retention policy removes.
+ *
+ * @generated from message secretary.v1.PurgedRecording
+ */
+export class PurgedRecording extends Message<PurgedRecording> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string created_at = 3;
+   */
+  createdAt = "";
+
+  /**
+   * @generated from field: bool audio = 4;
+   */
+  audio = false;
+
+  /**
+   * @generated from field: bool transcript = 5;
+   */
+  transcript = false;
+
+  constructor(data?: PartialMessage<PurgedRecording>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.PurgedRecording";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "audio", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "transcript", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PurgedRecording {
+    return new PurgedRecording().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PurgedRecording {
+    return new PurgedRecording().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PurgedRecording {
+    return new PurgedRecording().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PurgedRecording | PlainMessage<PurgedRecording> | undefined, b: PurgedRecording | PlainMessage<PurgedRecording> | undefined): boolean {
+    return proto3.util.equals(PurgedRecording, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.PurgeExpiredDataResponse
+ */
+export class PurgeExpiredDataResponse extends Message<PurgeExpiredDataResponse> {
+  /**
+   * Oldest first. A run handles a limited batch; the rest is left for the
+   * next one.
+   *
+   * @generated from field: repeated secretary.v1.PurgedRecording recordings = 1;
+   */
+  recordings: PurgedRecording[] = [];
+
+  constructor(data?: PartialMessage<PurgeExpiredDataResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.PurgeExpiredDataResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recordings", kind: "message", T: PurgedRecording, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PurgeExpiredDataResponse {
+    return new PurgeExpiredDataResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PurgeExpiredDataResponse {
+    return new PurgeExpiredDataResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PurgeExpiredDataResponse {
+    return new PurgeExpiredDataResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PurgeExpiredDataResponse | PlainMessage<PurgeExpiredDataResponse> | undefined, b: PurgeExpiredDataResponse | PlainMessage<PurgeExpiredDataResponse> | undefined): boolean {
+    return proto3.util.equals(PurgeExpiredDataResponse, a, b);
+  }
+}
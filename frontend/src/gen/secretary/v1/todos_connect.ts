@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateTodoRequest, CreateTodoResponse, DeleteTodoRequest, DeleteTodoResponse, GetTodoRequest, GetTodoResponse, ListTodoHistoryRequest, ListTodoHistoryResponse, ListTodosRequest, ListTodosResponse, UpdateTodoRequest, UpdateTodoResponse } from "./todos_pb.js";
+import { AddReactionRequest, AddReactionResponse, CreateTodoCommentRequest, CreateTodoCommentResponse, CreateTodoRequest, CreateTodoResponse, DeleteTodoCommentRequest, DeleteTodoCommentResponse, DeleteTodoRequest, DeleteTodoResponse, ExportToTrackerRequest, ExportToTrackerResponse, GetTodoRequest, GetTodoResponse, ListTodoCommentsRequest, ListTodoCommentsResponse, ListTodoHistoryRequest, ListTodoHistoryResponse, ListTodosRequest, ListTodosResponse, ListTrackerLinksRequest, ListTrackerLinksResponse, MergeTodosRequest, MergeTodosResponse, RemoveReactionRequest, RemoveReactionResponse, SnoozeTodoRequest, SnoozeTodoResponse, StarTodoRequest, StarTodoResponse, UnstarTodoRequest, UnstarTodoResponse, UpdateTodoCommentRequest, UpdateTodoCommentResponse, UpdateTodoRequest, UpdateTodoResponse } from "./todos_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -57,6 +57,20 @@ export const TodosService = {
       O: DeleteTodoResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Folds a duplicate todo, e.g. one action item picked up from two
+     * meetings, into another: its history, attachments, comments, document
+     * blocks, mentions, stars, reactions and tracker links move over, then
+     * it is deleted.
+     *
+     * @generated from rpc secretary.v1.TodosService.MergeTodos
+     */
+    mergeTodos: {
+      name: "MergeTodos",
+      I: MergeTodosRequest,
+      O: MergeTodosResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc secretary.v1.TodosService.ListTodoHistory
      */
@@ -66,6 +80,121 @@ export const TodosService = {
       O: ListTodoHistoryResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Pins a todo for the caller; like StarRecording.
+     *
+     * @generated from rpc secretary.v1.TodosService.StarTodo
+     */
+    starTodo: {
+      name: "StarTodo",
+      I: StarTodoRequest,
+      O: StarTodoResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.TodosService.UnstarTodo
+     */
+    unstarTodo: {
+      name: "UnstarTodo",
+      I: UnstarTodoRequest,
+      O: UnstarTodoResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Hides a todo from lists that exclude snoozed todos until a time. When
+     * the snooze runs out the assignee is told.
+     *
+     * @generated from rpc secretary.v1.TodosService.SnoozeTodo
+     */
+    snoozeTodo: {
+      name: "SnoozeTodo",
+      I: SnoozeTodoRequest,
+      O: SnoozeTodoResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Creates an issue for the todo in a configured tracker. The tracker's
+     * webhook then keeps the todo's status in step with the issue.
+     *
+     * @generated from rpc secretary.v1.TodosService.ExportToTracker
+     */
+    exportToTracker: {
+      name: "ExportToTracker",
+      I: ExportToTrackerRequest,
+      O: ExportToTrackerResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.TodosService.ListTrackerLinks
+     */
+    listTrackerLinks: {
+      name: "ListTrackerLinks",
+      I: ListTrackerLinksRequest,
+      O: ListTrackerLinksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.TodosService.ListTodoComments
+     */
+    listTodoComments: {
+      name: "ListTodoComments",
+      I: ListTodoCommentsRequest,
+      O: ListTodoCommentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Users the body @-mentions are told, apart from the author. Editing a
+     * comment tells only those it newly mentions.
+     *
+     * @generated from rpc secretary.v1.TodosService.CreateTodoComment
+     */
+    createTodoComment: {
+      name: "CreateTodoComment",
+      I: CreateTodoCommentRequest,
+      O: CreateTodoCommentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Only the author or an admin can edit or delete a comment.
+     *
+     * @generated from rpc secretary.v1.TodosService.UpdateTodoComment
+     */
+    updateTodoComment: {
+      name: "UpdateTodoComment",
+      I: UpdateTodoCommentRequest,
+      O: UpdateTodoCommentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.TodosService.DeleteTodoComment
+     */
+    deleteTodoComment: {
+      name: "DeleteTodoComment",
+      I: DeleteTodoCommentRequest,
+      O: DeleteTodoCommentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Reacts to a todo or comment with an emoji on the caller's behalf.
+     * Reacting twice with the same emoji changes nothing.
+     *
+     * @generated from rpc secretary.v1.TodosService.AddReaction
+     */
+    addReaction: {
+      name: "AddReaction",
+      I: AddReactionRequest,
+      O: AddReactionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc secretary.v1.TodosService.RemoveReaction
+     */
+    removeReaction: {
+      name: "RemoveReaction",
+      I: RemoveReactionRequest,
+      O: RemoveReactionResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
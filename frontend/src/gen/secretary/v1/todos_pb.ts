@@ -50,6 +50,74 @@ proto3.util.setEnumType(TodoStatus, "secretary.v1.TodoStatus", [
   { no: 5, name: "TODO_STATUS_SKIPPED" },
 ]);
 
+/**
+ * @generated from enum secretary.v1.TodoPriority
+ */
+export enum TodoPriority {
+  /**
+   * No priority set.
+   *
+   * @generated from enum value: TODO_PRIORITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TODO_PRIORITY_LOW = 1;
+   */
+  LOW = 1,
+
+  /**
+   * @generated from enum value: TODO_PRIORITY_MEDIUM = 2;
+   */
+  MEDIUM = 2,
+
+  /**
+   * @generated from enum value: TODO_PRIORITY_HIGH = 3;
+   */
+  HIGH = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TodoPriority)
+proto3.util.setEnumType(TodoPriority, "secretary.v1.TodoPriority", [
+  { no: 0, name: "TODO_PRIORITY_UNSPECIFIED" },
+  { no: 1, name: "TODO_PRIORITY_LOW" },
+  { no: 2, name: "TODO_PRIORITY_MEDIUM" },
+  { no: 3, name: "TODO_PRIORITY_HIGH" },
+]);
+
+/**
+ * Issue trackers a todo can be exported to.
+ *
+ * @generated from enum secretary.v1.Tracker
+ */
+export enum Tracker {
+  /**
+   * @generated from enum value: TRACKER_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TRACKER_GITHUB = 1;
+   */
+  GITHUB = 1,
+
+  /**
+   * @generated from enum value: TRACKER_LINEAR = 2;
+   */
+  LINEAR = 2,
+
+  /**
+   * @generated from enum value: TRACKER_JIRA = 3;
+   */
+  JIRA = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Tracker)
+proto3.util.setEnumType(Tracker, "secretary.v1.Tracker", [
+  { no: 0, name: "TRACKER_UNSPECIFIED" },
+  { no: 1, name: "TRACKER_GITHUB" },
+  { no: 2, name: "TRACKER_LINEAR" },
+  { no: 3, name: "TRACKER_JIRA" },
+]);
+
 /**
  * @generated from message secretary.v1.Todo
  */
@@ -124,6 +192,43 @@ export class Todo extends Message<Todo> {
    */
   sourceBlockId = protoInt64.zero;
 
+  /**
+   * @generated from field: string due_at = 15;
+   */
+  dueAt = "";
+
+  /**
+   * @generated from field: int64 version = 16;
+   */
+  version = protoInt64.zero;
+
+  /**
+   * Whether the caller starred it.
+   *
+   * @generated from field: bool starred = 17;
+   */
+  starred = false;
+
+  /**
+   * Set by ListTodos and GetTodo.
+   *
+   * @generated from field: repeated secretary.v1.Reaction reactions = 18;
+   */
+  reactions: Reaction[] = [];
+
+  /**
+   * RFC3339; the todo is hidden from lists with exclude_snoozed until
+   * then. Empty when it isn't snoozed.
+   *
+   * @generated from field: string snoozed_until = 19;
+   */
+  snoozedUntil = "";
+
+  /**
+   * @generated from field: secretary.v1.TodoPriority priority = 20;
+   */
+  priority = TodoPriority.UNSPECIFIED;
+
   constructor(data?: PartialMessage<Todo>) {
     super();
     proto3.util.initPartial(data, this);
@@ -146,6 +251,12 @@ export class Todo extends Message<Todo> {
     { no: 12, name: "source_kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 13, name: "source_document_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 14, name: "source_block_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 15, name: "due_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 16, name: "version", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 17, name: "starred", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 18, name: "reactions", kind: "message", T: Reaction, repeated: true },
+    { no: 19, name: "snoozed_until", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 20, name: "priority", kind: "enum", T: proto3.getEnumType(TodoPriority) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Todo {
@@ -165,6 +276,62 @@ export class Todo extends Message<Todo> {
   }
 }
 
+/**
+ * The reactions to a todo or comment with one emoji, in the order the
+ * emoji were first used.
+ *
+ * @generated from message secretary.v1.Reaction
+ */
+export class Reaction extends Message<Reaction> {
+  /**
+   * @generated from field: string emoji = 1;
+   */
+  emoji = "";
+
+  /**
+   * Who reacted, earliest first.
+   *
+   * @generated from field: repeated int64 user_ids = 2;
+   */
+  userIds: bigint[] = [];
+
+  /**
+   * Whether the caller is among them.
+   *
+   * @generated from field: bool reacted = 3;
+   */
+  reacted = false;
+
+  constructor(data?: PartialMessage<Reaction>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.Reaction";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "emoji", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+    { no: 3, name: "reacted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Reaction {
+    return new Reaction().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Reaction {
+    return new Reaction().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Reaction {
+    return new Reaction().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Reaction | PlainMessage<Reaction> | undefined, b: Reaction | PlainMessage<Reaction> | undefined): boolean {
+    return proto3.util.equals(Reaction, a, b);
+  }
+}
+
 /**
  * @generated from message secretary.v1.TodoHistory
  */
@@ -224,6 +391,14 @@ export class TodoHistory extends Message<TodoHistory> {
    */
   changedAt = "";
 
+  /**
+   * Explains entries the server made on its own, such as a
+   * "sync_conflict" with a tracker.
+   *
+   * @generated from field: string note = 12;
+   */
+  note = "";
+
   constructor(data?: PartialMessage<TodoHistory>) {
     super();
     proto3.util.initPartial(data, this);
@@ -243,6 +418,7 @@ export class TodoHistory extends Message<TodoHistory> {
     { no: 9, name: "created_at_recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 10, name: "updated_at_recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 11, name: "changed_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TodoHistory {
@@ -262,6 +438,91 @@ export class TodoHistory extends Message<TodoHistory> {
   }
 }
 
+/**
+ * A note left on a todo. "@Name" in the body mentions a user.
+ *
+ * @generated from message secretary.v1.TodoComment
+ */
+export class TodoComment extends Message<TodoComment> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 todo_id = 2;
+   */
+  todoId = protoInt64.zero;
+
+  /**
+   * @generated from field: string body = 3;
+   */
+  body = "";
+
+  /**
+   * @generated from field: int64 author_user_id = 4;
+   */
+  authorUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt = "";
+
+  /**
+   * @generated from field: string updated_at = 6;
+   */
+  updatedAt = "";
+
+  /**
+   * Users the body @-mentions, in order.
+   *
+   * @generated from field: repeated int64 mentioned_user_ids = 7;
+   */
+  mentionedUserIds: bigint[] = [];
+
+  /**
+   * Set by ListTodoComments.
+   *
+   * @generated from field: repeated secretary.v1.Reaction reactions = 8;
+   */
+  reactions: Reaction[] = [];
+
+  constructor(data?: PartialMessage<TodoComment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.TodoComment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "author_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "mentioned_user_ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+    { no: 8, name: "reactions", kind: "message", T: Reaction, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TodoComment {
+    return new TodoComment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TodoComment {
+    return new TodoComment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TodoComment {
+    return new TodoComment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TodoComment | PlainMessage<TodoComment> | undefined, b: TodoComment | PlainMessage<TodoComment> | undefined): boolean {
+    return proto3.util.equals(TodoComment, a, b);
+  }
+}
+
 /**
  * @generated from message secretary.v1.ListTodosRequest
  */
@@ -276,6 +537,21 @@ export class ListTodosRequest extends Message<ListTodosRequest> {
    */
   recordingId?: bigint;
 
+  /**
+   * Only todos the caller starred. Enough on its own, without user_id or
+   * recording_id.
+   *
+   * @generated from field: bool starred_only = 10;
+   */
+  starredOnly = false;
+
+  /**
+   * Leaves out todos snoozed until a time still to come.
+   *
+   * @generated from field: bool exclude_snoozed = 11;
+   */
+  excludeSnoozed = false;
+
   constructor(data?: PartialMessage<ListTodosRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -286,6 +562,8 @@ export class ListTodosRequest extends Message<ListTodosRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 2, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 10, name: "starred_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "exclude_snoozed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTodosRequest {
@@ -554,6 +832,21 @@ export class UpdateTodoRequest extends Message<UpdateTodoRequest> {
    */
   updatedAtRecordingId = protoInt64.zero;
 
+  /**
+   * RFC3339. Unset keeps the current due date; empty clears it.
+   *
+   * @generated from field: optional string due_at = 7;
+   */
+  dueAt?: string;
+
+  /**
+   * Version the client last read; the update is rejected with
+   * FAILED_PRECONDITION if the todo has changed since.
+   *
+   * @generated from field: int64 expected_version = 8;
+   */
+  expectedVersion = protoInt64.zero;
+
   constructor(data?: PartialMessage<UpdateTodoRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -568,6 +861,8 @@ export class UpdateTodoRequest extends Message<UpdateTodoRequest> {
     { no: 4, name: "status", kind: "enum", T: proto3.getEnumType(TodoStatus) },
     { no: 5, name: "user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 6, name: "updated_at_recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "due_at", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "expected_version", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTodoRequest {
@@ -693,76 +988,1120 @@ export class DeleteTodoResponse extends Message<DeleteTodoResponse> {
 }
 
 /**
- * @generated from message secretary.v1.ListTodoHistoryRequest
+ * @generated from message secretary.v1.MergeTodosRequest
  */
-export class ListTodoHistoryRequest extends Message<ListTodoHistoryRequest> {
+export class MergeTodosRequest extends Message<MergeTodosRequest> {
   /**
-   * @generated from field: int64 todo_id = 1;
+   * The todo that is kept.
+   *
+   * @generated from field: int64 id = 1;
    */
-  todoId = protoInt64.zero;
+  id = protoInt64.zero;
 
-  constructor(data?: PartialMessage<ListTodoHistoryRequest>) {
+  /**
+   * The todo merged into it and then deleted.
+   *
+   * @generated from field: int64 duplicate_id = 2;
+   */
+  duplicateId = protoInt64.zero;
+
+  /**
+   * Versions the client last read, as in UpdateTodoRequest.
+   *
+   * @generated from field: int64 expected_version = 3;
+   */
+  expectedVersion = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 expected_duplicate_version = 4;
+   */
+  expectedDuplicateVersion = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MergeTodosRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "secretary.v1.ListTodoHistoryRequest";
+  static readonly typeName = "secretary.v1.MergeTodosRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "duplicate_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "expected_version", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "expected_duplicate_version", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTodoHistoryRequest {
-    return new ListTodoHistoryRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MergeTodosRequest {
+    return new MergeTodosRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTodoHistoryRequest {
-    return new ListTodoHistoryRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MergeTodosRequest {
+    return new MergeTodosRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTodoHistoryRequest {
-    return new ListTodoHistoryRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MergeTodosRequest {
+    return new MergeTodosRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListTodoHistoryRequest | PlainMessage<ListTodoHistoryRequest> | undefined, b: ListTodoHistoryRequest | PlainMessage<ListTodoHistoryRequest> | undefined): boolean {
-    return proto3.util.equals(ListTodoHistoryRequest, a, b);
+  static equals(a: MergeTodosRequest | PlainMessage<MergeTodosRequest> | undefined, b: MergeTodosRequest | PlainMessage<MergeTodosRequest> | undefined): boolean {
+    return proto3.util.equals(MergeTodosRequest, a, b);
   }
 }
 
 /**
- * @generated from message secretary.v1.ListTodoHistoryResponse
+ * @generated from message secretary.v1.MergeTodosResponse
  */
-export class ListTodoHistoryResponse extends Message<ListTodoHistoryResponse> {
+export class MergeTodosResponse extends Message<MergeTodosResponse> {
   /**
-   * @generated from field: repeated secretary.v1.TodoHistory history = 1;
+   * @generated from field: secretary.v1.Todo todo = 1;
    */
-  history: TodoHistory[] = [];
+  todo?: Todo;
 
-  constructor(data?: PartialMessage<ListTodoHistoryResponse>) {
+  constructor(data?: PartialMessage<MergeTodosResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "secretary.v1.ListTodoHistoryResponse";
+  static readonly typeName = "secretary.v1.MergeTodosResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "history", kind: "message", T: TodoHistory, repeated: true },
+    { no: 1, name: "todo", kind: "message", T: Todo },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTodoHistoryResponse {
-    return new ListTodoHistoryResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MergeTodosResponse {
+    return new MergeTodosResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTodoHistoryResponse {
-    return new ListTodoHistoryResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MergeTodosResponse {
+    return new MergeTodosResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTodoHistoryResponse {
-    return new ListTodoHistoryResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MergeTodosResponse {
+    return new MergeTodosResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListTodoHistoryResponse | PlainMessage<ListTodoHistoryResponse> | undefined, b: ListTodoHistoryResponse | PlainMessage<ListTodoHistoryResponse> | undefined): boolean {
-    return proto3.util.equals(ListTodoHistoryResponse, a, b);
+  static equals(a: MergeTodosResponse | PlainMessage<MergeTodosResponse> | undefined, b: MergeTodosResponse | PlainMessage<MergeTodosResponse> | undefined): boolean {
+    return proto3.util.equals(MergeTodosResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.StarTodoRequest
+ */
+export class StarTodoRequest extends Message<StarTodoRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<StarTodoRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.StarTodoRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarTodoRequest {
+    return new StarTodoRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarTodoRequest {
+    return new StarTodoRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarTodoRequest {
+    return new StarTodoRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StarTodoRequest | PlainMessage<StarTodoRequest> | undefined, b: StarTodoRequest | PlainMessage<StarTodoRequest> | undefined): boolean {
+    return proto3.util.equals(StarTodoRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.StarTodoResponse
+ */
+export class StarTodoResponse extends Message<StarTodoResponse> {
+  constructor(data?: PartialMessage<StarTodoResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.StarTodoResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarTodoResponse {
+    return new StarTodoResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarTodoResponse {
+    return new StarTodoResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarTodoResponse {
+    return new StarTodoResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StarTodoResponse | PlainMessage<StarTodoResponse> | undefined, b: StarTodoResponse | PlainMessage<StarTodoResponse> | undefined): boolean {
+    return proto3.util.equals(StarTodoResponse, a, b);
   }
 }
 
+/**
+ * @generated from message secretary.v1.UnstarTodoRequest
+ */
+export class UnstarTodoRequest extends Message<UnstarTodoRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<UnstarTodoRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UnstarTodoRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnstarTodoRequest {
+    return new UnstarTodoRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnstarTodoRequest {
+    return new UnstarTodoRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnstarTodoRequest {
+    return new UnstarTodoRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnstarTodoRequest | PlainMessage<UnstarTodoRequest> | undefined, b: UnstarTodoRequest | PlainMessage<UnstarTodoRequest> | undefined): boolean {
+    return proto3.util.equals(UnstarTodoRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UnstarTodoResponse
+ */
+export class UnstarTodoResponse extends Message<UnstarTodoResponse> {
+  constructor(data?: PartialMessage<UnstarTodoResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UnstarTodoResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnstarTodoResponse {
+    return new UnstarTodoResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnstarTodoResponse {
+    return new UnstarTodoResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnstarTodoResponse {
+    return new UnstarTodoResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnstarTodoResponse | PlainMessage<UnstarTodoResponse> | undefined, b: UnstarTodoResponse | PlainMessage<UnstarTodoResponse> | undefined): boolean {
+    return proto3.util.equals(UnstarTodoResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SnoozeTodoRequest
+ */
+export class SnoozeTodoRequest extends Message<SnoozeTodoRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * RFC3339, in the future. Empty ends the snooze.
+   *
+   * @generated from field: string until = 2;
+   */
+  until = "";
+
+  constructor(data?: PartialMessage<SnoozeTodoRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SnoozeTodoRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "until", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SnoozeTodoRequest {
+    return new SnoozeTodoRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SnoozeTodoRequest {
+    return new SnoozeTodoRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SnoozeTodoRequest {
+    return new SnoozeTodoRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SnoozeTodoRequest | PlainMessage<SnoozeTodoRequest> | undefined, b: SnoozeTodoRequest | PlainMessage<SnoozeTodoRequest> | undefined): boolean {
+    return proto3.util.equals(SnoozeTodoRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.SnoozeTodoResponse
+ */
+export class SnoozeTodoResponse extends Message<SnoozeTodoResponse> {
+  constructor(data?: PartialMessage<SnoozeTodoResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.SnoozeTodoResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SnoozeTodoResponse {
+    return new SnoozeTodoResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SnoozeTodoResponse {
+    return new SnoozeTodoResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SnoozeTodoResponse {
+    return new SnoozeTodoResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SnoozeTodoResponse | PlainMessage<SnoozeTodoResponse> | undefined, b: SnoozeTodoResponse | PlainMessage<SnoozeTodoResponse> | undefined): boolean {
+    return proto3.util.equals(SnoozeTodoResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListTodoHistoryRequest
+ */
+export class ListTodoHistoryRequest extends Message<ListTodoHistoryRequest> {
+  /**
+   * @generated from field: int64 todo_id = 1;
+   */
+  todoId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ListTodoHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListTodoHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTodoHistoryRequest {
+    return new ListTodoHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTodoHistoryRequest {
+    return new ListTodoHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTodoHistoryRequest {
+    return new ListTodoHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTodoHistoryRequest | PlainMessage<ListTodoHistoryRequest> | undefined, b: ListTodoHistoryRequest | PlainMessage<ListTodoHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(ListTodoHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListTodoHistoryResponse
+ */
+export class ListTodoHistoryResponse extends Message<ListTodoHistoryResponse> {
+  /**
+   * @generated from field: repeated secretary.v1.TodoHistory history = 1;
+   */
+  history: TodoHistory[] = [];
+
+  constructor(data?: PartialMessage<ListTodoHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListTodoHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "history", kind: "message", T: TodoHistory, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTodoHistoryResponse {
+    return new ListTodoHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTodoHistoryResponse {
+    return new ListTodoHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTodoHistoryResponse {
+    return new ListTodoHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTodoHistoryResponse | PlainMessage<ListTodoHistoryResponse> | undefined, b: ListTodoHistoryResponse | PlainMessage<ListTodoHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(ListTodoHistoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListTodoCommentsRequest
+ */
+export class ListTodoCommentsRequest extends Message<ListTodoCommentsRequest> {
+  /**
+   * @generated from field: int64 todo_id = 1;
+   */
+  todoId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ListTodoCommentsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListTodoCommentsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTodoCommentsRequest {
+    return new ListTodoCommentsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTodoCommentsRequest {
+    return new ListTodoCommentsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTodoCommentsRequest {
+    return new ListTodoCommentsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTodoCommentsRequest | PlainMessage<ListTodoCommentsRequest> | undefined, b: ListTodoCommentsRequest | PlainMessage<ListTodoCommentsRequest> | undefined): boolean {
+    return proto3.util.equals(ListTodoCommentsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListTodoCommentsResponse
+ */
+export class ListTodoCommentsResponse extends Message<ListTodoCommentsResponse> {
+  /**
+   * Oldest first.
+   *
+   * @generated from field: repeated secretary.v1.TodoComment comments = 1;
+   */
+  comments: TodoComment[] = [];
+
+  constructor(data?: PartialMessage<ListTodoCommentsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListTodoCommentsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "comments", kind: "message", T: TodoComment, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTodoCommentsResponse {
+    return new ListTodoCommentsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTodoCommentsResponse {
+    return new ListTodoCommentsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTodoCommentsResponse {
+    return new ListTodoCommentsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTodoCommentsResponse | PlainMessage<ListTodoCommentsResponse> | undefined, b: ListTodoCommentsResponse | PlainMessage<ListTodoCommentsResponse> | undefined): boolean {
+    return proto3.util.equals(ListTodoCommentsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CreateTodoCommentRequest
+ */
+export class CreateTodoCommentRequest extends Message<CreateTodoCommentRequest> {
+  /**
+   * @generated from field: int64 todo_id = 1;
+   */
+  todoId = protoInt64.zero;
+
+  /**
+   * @generated from field: string body = 2;
+   */
+  body = "";
+
+  constructor(data?: PartialMessage<CreateTodoCommentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CreateTodoCommentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTodoCommentRequest {
+    return new CreateTodoCommentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTodoCommentRequest {
+    return new CreateTodoCommentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTodoCommentRequest {
+    return new CreateTodoCommentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateTodoCommentRequest | PlainMessage<CreateTodoCommentRequest> | undefined, b: CreateTodoCommentRequest | PlainMessage<CreateTodoCommentRequest> | undefined): boolean {
+    return proto3.util.equals(CreateTodoCommentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.CreateTodoCommentResponse
+ */
+export class CreateTodoCommentResponse extends Message<CreateTodoCommentResponse> {
+  /**
+   * @generated from field: secretary.v1.TodoComment comment = 1;
+   */
+  comment?: TodoComment;
+
+  constructor(data?: PartialMessage<CreateTodoCommentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.CreateTodoCommentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "comment", kind: "message", T: TodoComment },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTodoCommentResponse {
+    return new CreateTodoCommentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTodoCommentResponse {
+    return new CreateTodoCommentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTodoCommentResponse {
+    return new CreateTodoCommentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateTodoCommentResponse | PlainMessage<CreateTodoCommentResponse> | undefined, b: CreateTodoCommentResponse | PlainMessage<CreateTodoCommentResponse> | undefined): boolean {
+    return proto3.util.equals(CreateTodoCommentResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateTodoCommentRequest
+ */
+export class UpdateTodoCommentRequest extends Message<UpdateTodoCommentRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string body = 2;
+   */
+  body = "";
+
+  constructor(data?: PartialMessage<UpdateTodoCommentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateTodoCommentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTodoCommentRequest {
+    return new UpdateTodoCommentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTodoCommentRequest {
+    return new UpdateTodoCommentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTodoCommentRequest {
+    return new UpdateTodoCommentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateTodoCommentRequest | PlainMessage<UpdateTodoCommentRequest> | undefined, b: UpdateTodoCommentRequest | PlainMessage<UpdateTodoCommentRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateTodoCommentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateTodoCommentResponse
+ */
+export class UpdateTodoCommentResponse extends Message<UpdateTodoCommentResponse> {
+  /**
+   * @generated from field: secretary.v1.TodoComment comment = 1;
+   */
+  comment?: TodoComment;
+
+  constructor(data?: PartialMessage<UpdateTodoCommentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateTodoCommentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "comment", kind: "message", T: TodoComment },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTodoCommentResponse {
+    return new UpdateTodoCommentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTodoCommentResponse {
+    return new UpdateTodoCommentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTodoCommentResponse {
+    return new UpdateTodoCommentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateTodoCommentResponse | PlainMessage<UpdateTodoCommentResponse> | undefined, b: UpdateTodoCommentResponse | PlainMessage<UpdateTodoCommentResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateTodoCommentResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteTodoCommentRequest
+ */
+export class DeleteTodoCommentRequest extends Message<DeleteTodoCommentRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeleteTodoCommentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteTodoCommentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteTodoCommentRequest {
+    return new DeleteTodoCommentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteTodoCommentRequest {
+    return new DeleteTodoCommentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteTodoCommentRequest {
+    return new DeleteTodoCommentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteTodoCommentRequest | PlainMessage<DeleteTodoCommentRequest> | undefined, b: DeleteTodoCommentRequest | PlainMessage<DeleteTodoCommentRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteTodoCommentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeleteTodoCommentResponse
+ */
+export class DeleteTodoCommentResponse extends Message<DeleteTodoCommentResponse> {
+  constructor(data?: PartialMessage<DeleteTodoCommentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeleteTodoCommentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteTodoCommentResponse {
+    return new DeleteTodoCommentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteTodoCommentResponse {
+    return new DeleteTodoCommentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteTodoCommentResponse {
+    return new DeleteTodoCommentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteTodoCommentResponse | PlainMessage<DeleteTodoCommentResponse> | undefined, b: DeleteTodoCommentResponse | PlainMessage<DeleteTodoCommentResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteTodoCommentResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.AddReactionRequest
+ */
+export class AddReactionRequest extends Message<AddReactionRequest> {
+  /**
+   * @generated from field: int64 todo_id = 1;
+   */
+  todoId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 comment_id = 2;
+   */
+  commentId = protoInt64.zero;
+
+  /**
+   * An emoji, e.g. "👍" or "👩🏽‍💻".
+   *
+   * @generated from field: string emoji = 3;
+   */
+  emoji = "";
+
+  constructor(data?: PartialMessage<AddReactionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.AddReactionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "comment_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "emoji", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddReactionRequest {
+    return new AddReactionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddReactionRequest {
+    return new AddReactionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddReactionRequest {
+    return new AddReactionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddReactionRequest | PlainMessage<AddReactionRequest> | undefined, b: AddReactionRequest | PlainMessage<AddReactionRequest> | undefined): boolean {
+    return proto3.util.equals(AddReactionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.AddReactionResponse
+ */
+export class AddReactionResponse extends Message<AddReactionResponse> {
+  /**
+   * The target's reactions after the change.
+   *
+   * @generated from field: repeated secretary.v1.Reaction reactions = 1;
+   */
+  reactions: Reaction[] = [];
+
+  constructor(data?: PartialMessage<AddReactionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.AddReactionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "reactions", kind: "message", T: Reaction, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddReactionResponse {
+    return new AddReactionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddReactionResponse {
+    return new AddReactionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddReactionResponse {
+    return new AddReactionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddReactionResponse | PlainMessage<AddReactionResponse> | undefined, b: AddReactionResponse | PlainMessage<AddReactionResponse> | undefined): boolean {
+    return proto3.util.equals(AddReactionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RemoveReactionRequest
+ */
+export class RemoveReactionRequest extends Message<RemoveReactionRequest> {
+  /**
+   * @generated from field: int64 todo_id = 1;
+   */
+  todoId = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 comment_id = 2;
+   */
+  commentId = protoInt64.zero;
+
+  /**
+   * @generated from field: string emoji = 3;
+   */
+  emoji = "";
+
+  constructor(data?: PartialMessage<RemoveReactionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RemoveReactionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "comment_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "emoji", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveReactionRequest {
+    return new RemoveReactionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveReactionRequest {
+    return new RemoveReactionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveReactionRequest {
+    return new RemoveReactionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveReactionRequest | PlainMessage<RemoveReactionRequest> | undefined, b: RemoveReactionRequest | PlainMessage<RemoveReactionRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveReactionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RemoveReactionResponse
+ */
+export class RemoveReactionResponse extends Message<RemoveReactionResponse> {
+  /**
+   * @generated from field: repeated secretary.v1.Reaction reactions = 1;
+   */
+  reactions: Reaction[] = [];
+
+  constructor(data?: PartialMessage<RemoveReactionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RemoveReactionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "reactions", kind: "message", T: Reaction, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveReactionResponse {
+    return new RemoveReactionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveReactionResponse {
+    return new RemoveReactionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveReactionResponse {
+    return new RemoveReactionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveReactionResponse | PlainMessage<RemoveReactionResponse> | undefined, b: RemoveReactionResponse | PlainMessage<RemoveReactionResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveReactionResponse, a, b);
+  }
+}
+
+/**
+ * An issue created from a todo in an external tracker.
+ *
+ * @generated from message secretary.v1.TrackerLink
+ */
+export class TrackerLink extends Message<TrackerLink> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 todo_id = 2;
+   */
+  todoId = protoInt64.zero;
+
+  /**
+   * @generated from field: secretary.v1.Tracker tracker = 3;
+   */
+  tracker = Tracker.UNSPECIFIED;
+
+  /**
+   * Human-readable issue reference, e.g. "ENG-42" or "#17".
+   *
+   * @generated from field: string external_key = 4;
+   */
+  externalKey = "";
+
+  /**
+   * @generated from field: string url = 5;
+   */
+  url = "";
+
+  /**
+   * Issue state as the tracker last reported it.
+   *
+   * @generated from field: string external_state = 6;
+   */
+  externalState = "";
+
+  /**
+   * @generated from field: int64 created_by_user_id = 7;
+   */
+  createdByUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 8;
+   */
+  createdAt = "";
+
+  /**
+   * When the tracker last reported a change; empty until then.
+   *
+   * @generated from field: string synced_at = 9;
+   */
+  syncedAt = "";
+
+  constructor(data?: PartialMessage<TrackerLink>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.TrackerLink";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "tracker", kind: "enum", T: proto3.getEnumType(Tracker) },
+    { no: 4, name: "external_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "external_state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "created_by_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "synced_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TrackerLink {
+    return new TrackerLink().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TrackerLink {
+    return new TrackerLink().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TrackerLink {
+    return new TrackerLink().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TrackerLink | PlainMessage<TrackerLink> | undefined, b: TrackerLink | PlainMessage<TrackerLink> | undefined): boolean {
+    return proto3.util.equals(TrackerLink, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ExportToTrackerRequest
+ */
+export class ExportToTrackerRequest extends Message<ExportToTrackerRequest> {
+  /**
+   * @generated from field: int64 todo_id = 1;
+   */
+  todoId = protoInt64.zero;
+
+  /**
+   * @generated from field: secretary.v1.Tracker tracker = 2;
+   */
+  tracker = Tracker.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ExportToTrackerRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ExportToTrackerRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "tracker", kind: "enum", T: proto3.getEnumType(Tracker) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportToTrackerRequest {
+    return new ExportToTrackerRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportToTrackerRequest {
+    return new ExportToTrackerRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportToTrackerRequest {
+    return new ExportToTrackerRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportToTrackerRequest | PlainMessage<ExportToTrackerRequest> | undefined, b: ExportToTrackerRequest | PlainMessage<ExportToTrackerRequest> | undefined): boolean {
+    return proto3.util.equals(ExportToTrackerRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ExportToTrackerResponse
+ */
+export class ExportToTrackerResponse extends Message<ExportToTrackerResponse> {
+  /**
+   * @generated from field: secretary.v1.TrackerLink link = 1;
+   */
+  link?: TrackerLink;
+
+  constructor(data?: PartialMessage<ExportToTrackerResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ExportToTrackerResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "link", kind: "message", T: TrackerLink },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportToTrackerResponse {
+    return new ExportToTrackerResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportToTrackerResponse {
+    return new ExportToTrackerResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportToTrackerResponse {
+    return new ExportToTrackerResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportToTrackerResponse | PlainMessage<ExportToTrackerResponse> | undefined, b: ExportToTrackerResponse | PlainMessage<ExportToTrackerResponse> | undefined): boolean {
+    return proto3.util.equals(ExportToTrackerResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListTrackerLinksRequest
+ */
+export class ListTrackerLinksRequest extends Message<ListTrackerLinksRequest> {
+  /**
+   * @generated from field: int64 todo_id = 1;
+   */
+  todoId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ListTrackerLinksRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListTrackerLinksRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTrackerLinksRequest {
+    return new ListTrackerLinksRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTrackerLinksRequest {
+    return new ListTrackerLinksRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTrackerLinksRequest {
+    return new ListTrackerLinksRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTrackerLinksRequest | PlainMessage<ListTrackerLinksRequest> | undefined, b: ListTrackerLinksRequest | PlainMessage<ListTrackerLinksRequest> | undefined): boolean {
+    return proto3.util.equals(ListTrackerLinksRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListTrackerLinksResponse
+ */
+export class ListTrackerLinksResponse extends Message<ListTrackerLinksResponse> {
+  /**
+   * @generated from field: repeated secretary.v1.TrackerLink links = 1;
+   */
+  links: TrackerLink[] = [];
+
+  /**
+   * Trackers this server is configured for.
+   *
+   * @generated from field: repeated secretary.v1.Tracker available_trackers = 2;
+   */
+  availableTrackers: Tracker[] = [];
+
+  constructor(data?: PartialMessage<ListTrackerLinksResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListTrackerLinksResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "links", kind: "message", T: TrackerLink, repeated: true },
+    { no: 2, name: "available_trackers", kind: "enum", T: proto3.getEnumType(Tracker), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTrackerLinksResponse {
+    return new ListTrackerLinksResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTrackerLinksResponse {
+    return new ListTrackerLinksResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTrackerLinksResponse {
+    return new ListTrackerLinksResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTrackerLinksResponse | PlainMessage<ListTrackerLinksResponse> | undefined, b: ListTrackerLinksResponse | PlainMessage<ListTrackerLinksResponse> | undefined): boolean {
+    return proto3.util.equals(ListTrackerLinksResponse, a, b);
+  }
+}
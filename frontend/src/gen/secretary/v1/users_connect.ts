@@ -3,8 +3,8 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ListUsersRequest, ListUsersResponse } from "./users_pb.js";
-import { MethodKind } from "@bufbuild/protobuf";
+import { ChangePasswordRequest, ChangePasswordResponse, DeactivateUserRequest, DeactivateUserResponse, GetMeRequest, GetMeResponse, ListMentionsRequest, ListMentionsResponse, ListSessionsRequest, ListSessionsResponse, ListUsersRequest, ListUsersResponse, MarkMentionsReadRequest, MarkMentionsReadResponse, ReactivateUserRequest, ReactivateUserResponse, RevokeSessionRequest, RevokeSessionResponse, UpdateMeRequest, UpdateMeResponse, VerifyEmailRequest, VerifyEmailResponse } from "./users_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
 
 /**
  * @generated from service secretary.v1.UsersService
@@ -21,6 +21,126 @@ export const UsersService = {
       O: ListUsersResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc secretary.v1.UsersService.GetMe
+     */
+    getMe: {
+      name: "GetMe",
+      I: GetMeRequest,
+      O: GetMeResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * @generated from rpc secretary.v1.UsersService.UpdateMe
+     */
+    updateMe: {
+      name: "UpdateMe",
+      I: UpdateMeRequest,
+      O: UpdateMeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Confirms a pending email change with the token from the verification
+     * link. The token must belong to the signed-in user.
+     *
+     * @generated from rpc secretary.v1.UsersService.VerifyEmail
+     */
+    verifyEmail: {
+      name: "VerifyEmail",
+      I: VerifyEmailRequest,
+      O: VerifyEmailResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Stops a user from signing in and refuses the tokens they hold. Users
+     * are deactivated rather than deleted, so todos and history keep naming
+     * them. With a successor, the same transaction hands their open todos
+     * over, noting the transfer in each todo's history. Admin only.
+     *
+     * @generated from rpc secretary.v1.UsersService.DeactivateUser
+     */
+    deactivateUser: {
+      name: "DeactivateUser",
+      I: DeactivateUserRequest,
+      O: DeactivateUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Lets a deactivated user sign in again. Their old todos stay with the
+     * successor. Admin only.
+     *
+     * @generated from rpc secretary.v1.UsersService.ReactivateUser
+     */
+    reactivateUser: {
+      name: "ReactivateUser",
+      I: ReactivateUserRequest,
+      O: ReactivateUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * The signed-in user's inbox: transcript lines and todos naming them,
+     * most recently found first.
+     *
+     * @generated from rpc secretary.v1.UsersService.ListMentions
+     */
+    listMentions: {
+      name: "ListMentions",
+      I: ListMentionsRequest,
+      O: ListMentionsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Marks the signed-in user's mentions read, or unread again.
+     *
+     * @generated from rpc secretary.v1.UsersService.MarkMentionsRead
+     */
+    markMentionsRead: {
+      name: "MarkMentionsRead",
+      I: MarkMentionsReadRequest,
+      O: MarkMentionsReadResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * The signed-in user's sessions: every sign-in whose token hasn't
+     * expired or been revoked, most recently used first.
+     *
+     * @generated from rpc secretary.v1.UsersService.ListSessions
+     */
+    listSessions: {
+      name: "ListSessions",
+      I: ListSessionsRequest,
+      O: ListSessionsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Signs one of the user's sessions out, or every one but the current.
+     * Their tokens are refused from then on, by every server process.
+     *
+     * @generated from rpc secretary.v1.UsersService.RevokeSession
+     */
+    revokeSession: {
+      name: "RevokeSession",
+      I: RevokeSessionRequest,
+      O: RevokeSessionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Changes the signed-in user's password and signs out their other
+     * sessions. The new password must meet the server's password policy;
+     * each rule it breaks is a field violation on new_password, with a
+     * reason such as PASSWORD_TOO_SHORT or PASSWORD_BREACHED.
+     *
+     * @generated from rpc secretary.v1.UsersService.ChangePassword
+     */
+    changePassword: {
+      name: "ChangePassword",
+      I: ChangePasswordRequest,
+      O: ChangePasswordResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -35,6 +35,20 @@ export class User extends Message<User> {
    */
   speakerId = 0;
 
+  /**
+   * Empty when the user has no avatar; see Profile.avatar_url.
+   *
+   * @generated from field: string avatar_url = 6;
+   */
+  avatarUrl = "";
+
+  /**
+   * Deactivated users can't sign in and shouldn't be offered as assignees.
+   *
+   * @generated from field: bool deactivated = 7;
+   */
+  deactivated = false;
+
   constructor(data?: PartialMessage<User>) {
     super();
     proto3.util.initPartial(data, this);
@@ -48,6 +62,8 @@ export class User extends Message<User> {
     { no: 3, name: "last_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "speaker_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "avatar_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "deactivated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): User {
@@ -135,3 +151,1284 @@ export class ListUsersResponse extends Message<ListUsersResponse> {
   }
 }
 
+
+/**
+ * The signed-in user's own account details.
+ *
+ * @generated from message secretary.v1.Profile
+ */
+export class Profile extends Message<Profile> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string first_name = 2;
+   */
+  firstName = "";
+
+  /**
+   * @generated from field: string last_name = 3;
+   */
+  lastName = "";
+
+  /**
+   * @generated from field: string role = 4;
+   */
+  role = "";
+
+  /**
+   * @generated from field: string email = 5;
+   */
+  email = "";
+
+  /**
+   * @generated from field: bool email_verified = 6;
+   */
+  emailVerified = false;
+
+  /**
+   * Set while a new address waits for its verification link to be used.
+   *
+   * @generated from field: string pending_email = 7;
+   */
+  pendingEmail = "";
+
+  /**
+   * IANA time zone name, e.g. "Europe/Madrid".
+   *
+   * @generated from field: string timezone = 8;
+   */
+  timezone = "";
+
+  /**
+   * Empty when the user has not uploaded an avatar. Avatars are uploaded
+   * with PUT /api/me/avatar and rendered at 32, 128 and 512 pixels square;
+   * add ?size=N to get the nearest one (128 by default).
+   *
+   * @generated from field: string avatar_url = 9;
+   */
+  avatarUrl = "";
+
+  /**
+   * Language for emails, calendar exports and error messages, e.g. "es".
+   * Empty follows the browser's Accept-Language.
+   *
+   * @generated from field: string locale = 10;
+   */
+  locale = "";
+
+  /**
+   * Locales the server has translations for.
+   *
+   * @generated from field: repeated string supported_locales = 11;
+   */
+  supportedLocales: string[] = [];
+
+  constructor(data?: PartialMessage<Profile>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.Profile";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "first_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "last_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "email_verified", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "pending_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "avatar_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "supported_locales", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Profile {
+    return new Profile().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Profile {
+    return new Profile().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Profile {
+    return new Profile().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Profile | PlainMessage<Profile> | undefined, b: Profile | PlainMessage<Profile> | undefined): boolean {
+    return proto3.util.equals(Profile, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetMeRequest
+ */
+export class GetMeRequest extends Message<GetMeRequest> {
+  constructor(data?: PartialMessage<GetMeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetMeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMeRequest {
+    return new GetMeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMeRequest {
+    return new GetMeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMeRequest {
+    return new GetMeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMeRequest | PlainMessage<GetMeRequest> | undefined, b: GetMeRequest | PlainMessage<GetMeRequest> | undefined): boolean {
+    return proto3.util.equals(GetMeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.GetMeResponse
+ */
+export class GetMeResponse extends Message<GetMeResponse> {
+  /**
+   * @generated from field: secretary.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+
+  /**
+   * The experimental features turned on for the caller, by name, e.g.
+   * semantic-search.
+   *
+   * @generated from field: repeated string feature_flags = 2;
+   */
+  featureFlags: string[] = [];
+
+  constructor(data?: PartialMessage<GetMeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.GetMeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "profile", kind: "message", T: Profile },
+    { no: 2, name: "feature_flags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMeResponse {
+    return new GetMeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMeResponse {
+    return new GetMeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMeResponse {
+    return new GetMeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMeResponse | PlainMessage<GetMeResponse> | undefined, b: GetMeResponse | PlainMessage<GetMeResponse> | undefined): boolean {
+    return proto3.util.equals(GetMeResponse, a, b);
+  }
+}
+
+/**
+ * Unset fields are left unchanged.
+ *
+ * @generated from message secretary.v1.UpdateMeRequest
+ */
+export class UpdateMeRequest extends Message<UpdateMeRequest> {
+  /**
+   * @generated from field: optional string first_name = 1;
+   */
+  firstName?: string;
+
+  /**
+   * @generated from field: optional string last_name = 2;
+   */
+  lastName?: string;
+
+  /**
+   * A new address only replaces the current one once the link emailed to
+   * it is used; see VerifyEmail.
+   *
+   * @generated from field: optional string email = 3;
+   */
+  email?: string;
+
+  /**
+   * @generated from field: optional string timezone = 4;
+   */
+  timezone?: string;
+
+  /**
+   * One of Profile.supported_locales, or empty to follow the browser.
+   *
+   * @generated from field: optional string locale = 5;
+   */
+  locale?: string;
+
+  constructor(data?: PartialMessage<UpdateMeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateMeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "first_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "last_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateMeRequest {
+    return new UpdateMeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateMeRequest {
+    return new UpdateMeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateMeRequest {
+    return new UpdateMeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateMeRequest | PlainMessage<UpdateMeRequest> | undefined, b: UpdateMeRequest | PlainMessage<UpdateMeRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateMeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.UpdateMeResponse
+ */
+export class UpdateMeResponse extends Message<UpdateMeResponse> {
+  /**
+   * @generated from field: secretary.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+
+  constructor(data?: PartialMessage<UpdateMeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.UpdateMeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "profile", kind: "message", T: Profile },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateMeResponse {
+    return new UpdateMeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateMeResponse {
+    return new UpdateMeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateMeResponse {
+    return new UpdateMeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateMeResponse | PlainMessage<UpdateMeResponse> | undefined, b: UpdateMeResponse | PlainMessage<UpdateMeResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateMeResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.VerifyEmailRequest
+ */
+export class VerifyEmailRequest extends Message<VerifyEmailRequest> {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<VerifyEmailRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.VerifyEmailRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyEmailRequest {
+    return new VerifyEmailRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyEmailRequest {
+    return new VerifyEmailRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyEmailRequest {
+    return new VerifyEmailRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VerifyEmailRequest | PlainMessage<VerifyEmailRequest> | undefined, b: VerifyEmailRequest | PlainMessage<VerifyEmailRequest> | undefined): boolean {
+    return proto3.util.equals(VerifyEmailRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.VerifyEmailResponse
+ */
+export class VerifyEmailResponse extends Message<VerifyEmailResponse> {
+  /**
+   * @generated from field: secretary.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+
+  constructor(data?: PartialMessage<VerifyEmailResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.VerifyEmailResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "profile", kind: "message", T: Profile },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyEmailResponse {
+    return new VerifyEmailResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyEmailResponse {
+    return new VerifyEmailResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyEmailResponse {
+    return new VerifyEmailResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VerifyEmailResponse | PlainMessage<VerifyEmailResponse> | undefined, b: VerifyEmailResponse | PlainMessage<VerifyEmailResponse> | undefined): boolean {
+    return proto3.util.equals(VerifyEmailResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeactivateUserRequest
+ */
+export class DeactivateUserRequest extends Message<DeactivateUserRequest> {
+  /**
+   * @generated from field: int64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  /**
+   * Who takes over the user's open todos. When unset the todos stay
+   * assigned to the user, as their done todos and history always do.
+   *
+   * @generated from field: int64 successor_user_id = 2;
+   */
+  successorUserId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeactivateUserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeactivateUserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "successor_user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeactivateUserRequest {
+    return new DeactivateUserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeactivateUserRequest {
+    return new DeactivateUserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeactivateUserRequest {
+    return new DeactivateUserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeactivateUserRequest | PlainMessage<DeactivateUserRequest> | undefined, b: DeactivateUserRequest | PlainMessage<DeactivateUserRequest> | undefined): boolean {
+    return proto3.util.equals(DeactivateUserRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.DeactivateUserResponse
+ */
+export class DeactivateUserResponse extends Message<DeactivateUserResponse> {
+  /**
+   * Open todos handed to the successor.
+   *
+   * @generated from field: int32 reassigned_todo_count = 1;
+   */
+  reassignedTodoCount = 0;
+
+  constructor(data?: PartialMessage<DeactivateUserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.DeactivateUserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "reassigned_todo_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeactivateUserResponse {
+    return new DeactivateUserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeactivateUserResponse {
+    return new DeactivateUserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeactivateUserResponse {
+    return new DeactivateUserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeactivateUserResponse | PlainMessage<DeactivateUserResponse> | undefined, b: DeactivateUserResponse | PlainMessage<DeactivateUserResponse> | undefined): boolean {
+    return proto3.util.equals(DeactivateUserResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ReactivateUserRequest
+ */
+export class ReactivateUserRequest extends Message<ReactivateUserRequest> {
+  /**
+   * @generated from field: int64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ReactivateUserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ReactivateUserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReactivateUserRequest {
+    return new ReactivateUserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReactivateUserRequest {
+    return new ReactivateUserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReactivateUserRequest {
+    return new ReactivateUserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReactivateUserRequest | PlainMessage<ReactivateUserRequest> | undefined, b: ReactivateUserRequest | PlainMessage<ReactivateUserRequest> | undefined): boolean {
+    return proto3.util.equals(ReactivateUserRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ReactivateUserResponse
+ */
+export class ReactivateUserResponse extends Message<ReactivateUserResponse> {
+  constructor(data?: PartialMessage<ReactivateUserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ReactivateUserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReactivateUserResponse {
+    return new ReactivateUserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReactivateUserResponse {
+    return new ReactivateUserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReactivateUserResponse {
+    return new ReactivateUserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReactivateUserResponse | PlainMessage<ReactivateUserResponse> | undefined, b: ReactivateUserResponse | PlainMessage<ReactivateUserResponse> | undefined): boolean {
+    return proto3.util.equals(ReactivateUserResponse, a, b);
+  }
+}
+
+/**
+ * @generated from enum secretary.v1.MentionKind
+ */
+export enum MentionKind {
+  /**
+   * @generated from enum value: MENTION_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MENTION_KIND_TRANSCRIPT = 1;
+   */
+  TRANSCRIPT = 1,
+
+  /**
+   * @generated from enum value: MENTION_KIND_TODO = 2;
+   */
+  TODO = 2,
+
+  /**
+   * An @-mention in a todo comment.
+   *
+   * @generated from enum value: MENTION_KIND_COMMENT = 3;
+   */
+  COMMENT = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MentionKind)
+proto3.util.setEnumType(MentionKind, "secretary.v1.MentionKind", [
+  { no: 0, name: "MENTION_KIND_UNSPECIFIED" },
+  { no: 1, name: "MENTION_KIND_TRANSCRIPT" },
+  { no: 2, name: "MENTION_KIND_TODO" },
+  { no: 3, name: "MENTION_KIND_COMMENT" },
+]);
+
+/**
+ * A transcript line, todo or todo comment in which someone named a user.
+ *
+ * @generated from message secretary.v1.Mention
+ */
+export class Mention extends Message<Mention> {
+  /**
+   * @generated from field: int64 recording_id = 1;
+   */
+  recordingId = protoInt64.zero;
+
+  /**
+   * @generated from field: string recording_name = 2;
+   */
+  recordingName = "";
+
+  /**
+   * @generated from field: string recording_date = 3;
+   */
+  recordingDate = "";
+
+  /**
+   * Zero-based line of the transcript; transcript mentions only.
+   *
+   * @generated from field: int32 segment_index = 4;
+   */
+  segmentIndex = 0;
+
+  /**
+   * Who said it; -1 when the line has no speaker label or for todos.
+   *
+   * @generated from field: int32 speaker_id = 5;
+   */
+  speakerId = 0;
+
+  /**
+   * @generated from field: int64 user_id = 6;
+   */
+  userId = protoInt64.zero;
+
+  /**
+   * The name as it appears, e.g. "Ana".
+   *
+   * @generated from field: string matched_text = 7;
+   */
+  matchedText = "";
+
+  /**
+   * The line without its speaker label; transcript mentions only.
+   *
+   * @generated from field: string segment_text = 8;
+   */
+  segmentText = "";
+
+  /**
+   * @generated from field: secretary.v1.MentionKind kind = 9;
+   */
+  kind = MentionKind.UNSPECIFIED;
+
+  /**
+   * Unique per kind; pass it to MarkMentionsRead.
+   *
+   * @generated from field: int64 id = 10;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * Todo and comment mentions only.
+   *
+   * @generated from field: int64 todo_id = 11;
+   */
+  todoId = protoInt64.zero;
+
+  /**
+   * @generated from field: string todo_name = 12;
+   */
+  todoName = "";
+
+  /**
+   * @generated from field: bool read = 13;
+   */
+  read = false;
+
+  /**
+   * When the mention was first found.
+   *
+   * @generated from field: string created_at = 14;
+   */
+  createdAt = "";
+
+  /**
+   * Comment mentions only.
+   *
+   * @generated from field: int64 comment_id = 15;
+   */
+  commentId = protoInt64.zero;
+
+  /**
+   * @generated from field: string comment_text = 16;
+   */
+  commentText = "";
+
+  constructor(data?: PartialMessage<Mention>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.Mention";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "recording_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "recording_date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "segment_index", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "speaker_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "user_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "matched_text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "segment_text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "kind", kind: "enum", T: proto3.getEnumType(MentionKind) },
+    { no: 10, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "todo_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 12, name: "todo_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "read", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 14, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "comment_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 16, name: "comment_text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Mention {
+    return new Mention().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Mention {
+    return new Mention().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Mention {
+    return new Mention().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Mention | PlainMessage<Mention> | undefined, b: Mention | PlainMessage<Mention> | undefined): boolean {
+    return proto3.util.equals(Mention, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListMentionsRequest
+ */
+export class ListMentionsRequest extends Message<ListMentionsRequest> {
+  /**
+   * @generated from field: optional int64 recording_id = 2;
+   */
+  recordingId?: bigint;
+
+  /**
+   * Case-insensitive substring match on the line, todo or comment.
+   *
+   * @generated from field: string query = 3;
+   */
+  query = "";
+
+  /**
+   * 100 when unset.
+   *
+   * @generated from field: int32 limit = 4;
+   */
+  limit = 0;
+
+  /**
+   * @generated from field: bool unread_only = 5;
+   */
+  unreadOnly = false;
+
+  /**
+   * Every kind when empty.
+   *
+   * @generated from field: repeated secretary.v1.MentionKind kinds = 6;
+   */
+  kinds: MentionKind[] = [];
+
+  constructor(data?: PartialMessage<ListMentionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListMentionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 2, name: "recording_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 3, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "unread_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "kinds", kind: "enum", T: proto3.getEnumType(MentionKind), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMentionsRequest {
+    return new ListMentionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMentionsRequest {
+    return new ListMentionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMentionsRequest {
+    return new ListMentionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListMentionsRequest | PlainMessage<ListMentionsRequest> | undefined, b: ListMentionsRequest | PlainMessage<ListMentionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListMentionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListMentionsResponse
+ */
+export class ListMentionsResponse extends Message<ListMentionsResponse> {
+  /**
+   * @generated from field: repeated secretary.v1.Mention mentions = 1;
+   */
+  mentions: Mention[] = [];
+
+  /**
+   * Across all of the user's mentions, regardless of the filters.
+   *
+   * @generated from field: int64 unread_count = 2;
+   */
+  unreadCount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ListMentionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListMentionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mentions", kind: "message", T: Mention, repeated: true },
+    { no: 2, name: "unread_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMentionsResponse {
+    return new ListMentionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMentionsResponse {
+    return new ListMentionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMentionsResponse {
+    return new ListMentionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListMentionsResponse | PlainMessage<ListMentionsResponse> | undefined, b: ListMentionsResponse | PlainMessage<ListMentionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListMentionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.MentionRef
+ */
+export class MentionRef extends Message<MentionRef> {
+  /**
+   * @generated from field: secretary.v1.MentionKind kind = 1;
+   */
+  kind = MentionKind.UNSPECIFIED;
+
+  /**
+   * @generated from field: int64 id = 2;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MentionRef>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.MentionRef";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(MentionKind) },
+    { no: 2, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MentionRef {
+    return new MentionRef().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MentionRef {
+    return new MentionRef().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MentionRef {
+    return new MentionRef().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MentionRef | PlainMessage<MentionRef> | undefined, b: MentionRef | PlainMessage<MentionRef> | undefined): boolean {
+    return proto3.util.equals(MentionRef, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.MarkMentionsReadRequest
+ */
+export class MarkMentionsReadRequest extends Message<MarkMentionsReadRequest> {
+  /**
+   * @generated from field: repeated secretary.v1.MentionRef mentions = 1;
+   */
+  mentions: MentionRef[] = [];
+
+  /**
+   * Every mention of the user, instead of the listed ones.
+   *
+   * @generated from field: bool all = 2;
+   */
+  all = false;
+
+  /**
+   * Marks them unread instead.
+   *
+   * @generated from field: bool unread = 3;
+   */
+  unread = false;
+
+  constructor(data?: PartialMessage<MarkMentionsReadRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.MarkMentionsReadRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mentions", kind: "message", T: MentionRef, repeated: true },
+    { no: 2, name: "all", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "unread", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MarkMentionsReadRequest {
+    return new MarkMentionsReadRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MarkMentionsReadRequest {
+    return new MarkMentionsReadRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MarkMentionsReadRequest {
+    return new MarkMentionsReadRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MarkMentionsReadRequest | PlainMessage<MarkMentionsReadRequest> | undefined, b: MarkMentionsReadRequest | PlainMessage<MarkMentionsReadRequest> | undefined): boolean {
+    return proto3.util.equals(MarkMentionsReadRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.MarkMentionsReadResponse
+ */
+export class MarkMentionsReadResponse extends Message<MarkMentionsReadResponse> {
+  /**
+   * @generated from field: int64 unread_count = 1;
+   */
+  unreadCount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MarkMentionsReadResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.MarkMentionsReadResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "unread_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MarkMentionsReadResponse {
+    return new MarkMentionsReadResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MarkMentionsReadResponse {
+    return new MarkMentionsReadResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MarkMentionsReadResponse {
+    return new MarkMentionsReadResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MarkMentionsReadResponse | PlainMessage<MarkMentionsReadResponse> | undefined, b: MarkMentionsReadResponse | PlainMessage<MarkMentionsReadResponse> | undefined): boolean {
+    return proto3.util.equals(MarkMentionsReadResponse, a, b);
+  }
+}
+
+/**
+ * A sign-in, and the token it issued.
+ *
+ * @generated from message secretary.v1.Session
+ */
+export class Session extends Message<Session> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * e.g. "Firefox on Windows", from the User-Agent it signed in with.
+   *
+   * @generated from field: string device = 2;
+   */
+  device = "";
+
+  /**
+   * @generated from field: string user_agent = 3;
+   */
+  userAgent = "";
+
+  /**
+   * The address it was last used from.
+   *
+   * @generated from field: string ip = 4;
+   */
+  ip = "";
+
+  /**
+   * RFC3339. Updated at most once a minute.
+   *
+   * @generated from field: string last_seen_at = 5;
+   */
+  lastSeenAt = "";
+
+  /**
+   * RFC3339; when it signed in.
+   *
+   * @generated from field: string issued_at = 6;
+   */
+  issuedAt = "";
+
+  /**
+   * RFC3339; when its token expires.
+   *
+   * @generated from field: string expires_at = 7;
+   */
+  expiresAt = "";
+
+  /**
+   * Whether the request listing the sessions was made with this one.
+   *
+   * @generated from field: bool current = 8;
+   */
+  current = false;
+
+  /**
+   * Roughly where it signed in from, e.g. "Lisbon, PT", when a proxy in
+   * front of the server reports it. Empty otherwise.
+   *
+   * @generated from field: string location = 9;
+   */
+  location = "";
+
+  constructor(data?: PartialMessage<Session>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.Session";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "device", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "user_agent", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "ip", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "last_seen_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "issued_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "expires_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "current", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "location", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Session {
+    return new Session().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Session {
+    return new Session().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Session {
+    return new Session().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Session | PlainMessage<Session> | undefined, b: Session | PlainMessage<Session> | undefined): boolean {
+    return proto3.util.equals(Session, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListSessionsRequest
+ */
+export class ListSessionsRequest extends Message<ListSessionsRequest> {
+  constructor(data?: PartialMessage<ListSessionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListSessionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSessionsRequest {
+    return new ListSessionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSessionsRequest {
+    return new ListSessionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSessionsRequest {
+    return new ListSessionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSessionsRequest | PlainMessage<ListSessionsRequest> | undefined, b: ListSessionsRequest | PlainMessage<ListSessionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSessionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ListSessionsResponse
+ */
+export class ListSessionsResponse extends Message<ListSessionsResponse> {
+  /**
+   * @generated from field: repeated secretary.v1.Session sessions = 1;
+   */
+  sessions: Session[] = [];
+
+  constructor(data?: PartialMessage<ListSessionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ListSessionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sessions", kind: "message", T: Session, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSessionsResponse {
+    return new ListSessionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSessionsResponse {
+    return new ListSessionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSessionsResponse {
+    return new ListSessionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSessionsResponse | PlainMessage<ListSessionsResponse> | undefined, b: ListSessionsResponse | PlainMessage<ListSessionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListSessionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RevokeSessionRequest
+ */
+export class RevokeSessionRequest extends Message<RevokeSessionRequest> {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * Revokes every session but the current one instead.
+   *
+   * @generated from field: bool others = 2;
+   */
+  others = false;
+
+  constructor(data?: PartialMessage<RevokeSessionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RevokeSessionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "others", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeSessionRequest {
+    return new RevokeSessionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeSessionRequest {
+    return new RevokeSessionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeSessionRequest {
+    return new RevokeSessionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeSessionRequest | PlainMessage<RevokeSessionRequest> | undefined, b: RevokeSessionRequest | PlainMessage<RevokeSessionRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeSessionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.RevokeSessionResponse
+ */
+export class RevokeSessionResponse extends Message<RevokeSessionResponse> {
+  /**
+   * How many sessions were signed out.
+   *
+   * @generated from field: int64 revoked = 1;
+   */
+  revoked = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RevokeSessionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.RevokeSessionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revoked", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeSessionResponse {
+    return new RevokeSessionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeSessionResponse {
+    return new RevokeSessionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeSessionResponse {
+    return new RevokeSessionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeSessionResponse | PlainMessage<RevokeSessionResponse> | undefined, b: RevokeSessionResponse | PlainMessage<RevokeSessionResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeSessionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ChangePasswordRequest
+ */
+export class ChangePasswordRequest extends Message<ChangePasswordRequest> {
+  /**
+   * @generated from field: string current_password = 1;
+   */
+  currentPassword = "";
+
+  /**
+   * @generated from field: string new_password = 2;
+   */
+  newPassword = "";
+
+  constructor(data?: PartialMessage<ChangePasswordRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ChangePasswordRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "current_password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "new_password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChangePasswordRequest {
+    return new ChangePasswordRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChangePasswordRequest {
+    return new ChangePasswordRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChangePasswordRequest {
+    return new ChangePasswordRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChangePasswordRequest | PlainMessage<ChangePasswordRequest> | undefined, b: ChangePasswordRequest | PlainMessage<ChangePasswordRequest> | undefined): boolean {
+    return proto3.util.equals(ChangePasswordRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message secretary.v1.ChangePasswordResponse
+ */
+export class ChangePasswordResponse extends Message<ChangePasswordResponse> {
+  /**
+   * How many other sessions were signed out.
+   *
+   * @generated from field: int64 sessions_revoked = 1;
+   */
+  sessionsRevoked = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ChangePasswordResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "secretary.v1.ChangePasswordResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sessions_revoked", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChangePasswordResponse {
+    return new ChangePasswordResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChangePasswordResponse {
+    return new ChangePasswordResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChangePasswordResponse {
+    return new ChangePasswordResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChangePasswordResponse | PlainMessage<ChangePasswordResponse> | undefined, b: ChangePasswordResponse | PlainMessage<ChangePasswordResponse> | undefined): boolean {
+    return proto3.util.equals(ChangePasswordResponse, a, b);
+  }
+}
@@ -1,5 +1,7 @@
 export const TOKEN_KEY = 'secretary_token';
 export const USER_KEY = 'secretary_user';
+export const LOCALE_KEY = 'secretary_locale';
+export const DEVICE_KEY = 'secretary_device';
 
 export interface User {
   id: number;
@@ -37,3 +39,27 @@ export function setUser(user: User) {
 export function removeUser() {
   localStorage.removeItem(USER_KEY);
 }
+
+// The saved locale is sent as Accept-Language so server errors match it.
+export function getLocale(): string | null {
+  return localStorage.getItem(LOCALE_KEY);
+}
+
+export function setLocale(locale: string) {
+  if (locale) {
+    localStorage.setItem(LOCALE_KEY, locale);
+  } else {
+    localStorage.removeItem(LOCALE_KEY);
+  }
+}
+
+// A random ID that stays with this browser, so the server can tell a new
+// device from a known one. It survives sign-out on purpose.
+export function getDeviceId(): string {
+  let id = localStorage.getItem(DEVICE_KEY);
+  if (!id) {
+    id = crypto.randomUUID();
+    localStorage.setItem(DEVICE_KEY, id);
+  }
+  return id;
+}
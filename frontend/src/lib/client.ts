@@ -1,21 +1,42 @@
 import { createClient } from '@connectrpc/connect';
 import { createConnectTransport } from '@connectrpc/connect-web';
+import { ActivityService } from '../gen/secretary/v1/activity_feed_connect';
+import { AdminService } from '../gen/secretary/v1/admin_connect';
+import { AnnotationsService } from '../gen/secretary/v1/annotations_connect';
+import { AttachmentsService } from '../gen/secretary/v1/attachments_connect';
+import { KeywordAlertsService } from '../gen/secretary/v1/keywords_connect';
+import { NotificationsService } from '../gen/secretary/v1/notifications_connect';
+import { OutcomesService } from '../gen/secretary/v1/outcomes_connect';
+import { PromptTemplatesService } from '../gen/secretary/v1/prompt_templates_connect';
 import { RecordingsService } from '../gen/secretary/v1/recordings_connect';
+import { SettingsService } from '../gen/secretary/v1/settings_connect';
+import { QuarantineService } from '../gen/secretary/v1/quarantine_connect';
 import { TodosService } from '../gen/secretary/v1/todos_connect';
 import { UsersService } from '../gen/secretary/v1/users_connect';
-import { getToken } from './auth';
+import { getLocale, getToken } from './auth';
 
 const isDev = import.meta.env.MODE === 'development';
 const baseUrl = import.meta.env.VITE_API_URL || (isDev ? 'http://localhost:8080' : '/');
 
+// apiUrl resolves a server path such as an avatar URL against the API origin.
+export function apiUrl(path: string): string {
+  return baseUrl.replace(/\/$/, '') + path;
+}
+
 const transport = createConnectTransport({
   baseUrl,
+  // Side-effect-free RPCs go out as GET so the browser can revalidate them with ETags.
+  useHttpGet: true,
   interceptors: [
     (next) => async (req) => {
       const token = getToken();
       if (token) {
         req.header.set('Authorization', `Bearer ${token}`);
       }
+      const locale = getLocale();
+      if (locale) {
+        req.header.set('Accept-Language', locale);
+      }
       return next(req);
     },
   ],
@@ -24,3 +45,13 @@ const transport = createConnectTransport({
 export const recordingsClient = createClient(RecordingsService, transport);
 export const todosClient = createClient(TodosService, transport);
 export const usersClient = createClient(UsersService, transport);
+export const outcomesClient = createClient(OutcomesService, transport);
+export const activityClient = createClient(ActivityService, transport);
+export const annotationsClient = createClient(AnnotationsService, transport);
+export const attachmentsClient = createClient(AttachmentsService, transport);
+export const promptTemplatesClient = createClient(PromptTemplatesService, transport);
+export const notificationsClient = createClient(NotificationsService, transport);
+export const settingsClient = createClient(SettingsService, transport);
+export const quarantineClient = createClient(QuarantineService, transport);
+export const keywordsClient = createClient(KeywordAlertsService, transport);
+export const adminClient = createClient(AdminService, transport);
@@ -0,0 +1,15 @@
+// formatOffset renders milliseconds into the audio as m:ss or h:mm:ss.
+export function formatOffset(ms: number) {
+  const total = Math.floor(ms / 1000);
+  const h = Math.floor(total / 3600);
+  const m = Math.floor((total % 3600) / 60);
+  const s = String(total % 60).padStart(2, '0');
+  return h > 0 ? `${h}:${String(m).padStart(2, '0')}:${s}` : `${m}:${s}`;
+}
+
+// parseOffset reads m:ss or h:mm:ss back into milliseconds, or null.
+export function parseOffset(text: string): number | null {
+  const parts = text.trim().split(':');
+  if (parts.length < 1 || parts.length > 3 || parts.some((p) => !/^\d+$/.test(p))) return null;
+  return parts.reduce((acc, p) => acc * 60 + Number(p), 0) * 1000;
+}
@@ -1,4 +1,5 @@
 import { TodoStatus } from '../gen/secretary/v1/todos_pb';
+import { RecordingStatus } from '../gen/secretary/v1/recordings_pb';
 
 export const TODO_STATUS_CONFIG: Record<number, { label: string; color: string }> = {
   [TodoStatus.UNSPECIFIED]: { label: 'Unknown', color: 'gray' },
@@ -20,3 +21,16 @@ export const TODO_STATUS_OPTIONS = [
   { value: String(TodoStatus.BLOCKED), label: 'Blocked' },
   { value: String(TodoStatus.SKIPPED), label: 'Skipped' },
 ];
+
+export const RECORDING_STATUS_CONFIG: Record<number, { label: string; color: string }> = {
+  [RecordingStatus.UNSPECIFIED]: { label: 'Unknown', color: 'gray' },
+  [RecordingStatus.UPLOADED]: { label: 'Uploaded', color: 'gray' },
+  [RecordingStatus.TRANSCRIBING]: { label: 'Transcribing', color: 'blue' },
+  [RecordingStatus.SUMMARIZING]: { label: 'Summarizing', color: 'blue' },
+  [RecordingStatus.READY]: { label: 'Ready', color: 'green' },
+  [RecordingStatus.FAILED]: { label: 'Failed', color: 'red' },
+};
+
+export function getRecordingStatusConfig(status: RecordingStatus) {
+  return RECORDING_STATUS_CONFIG[status] || RECORDING_STATUS_CONFIG[RecordingStatus.UNSPECIFIED];
+}
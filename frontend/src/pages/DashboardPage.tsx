@@ -1,22 +1,32 @@
+import { useState } from 'react';
 import { Link } from 'react-router-dom';
 import { useQuery } from '@tanstack/react-query';
-import { Container, Title, Loader, List, ThemeIcon, Alert, Text, Anchor } from '@mantine/core';
-import { Mic, AlertCircle } from 'lucide-react';
+import { Avatar, Container, Title, Loader, List, ThemeIcon, Alert, Text, Anchor, Badge, Group, Switch } from '@mantine/core';
+import { Mic, AlertCircle, CheckSquare } from 'lucide-react';
 import { recordingsClient } from '../lib/client';
+import { RecordingStatus } from '../gen/secretary/v1/recordings_pb';
 import type { Recording, ListRecordingsResponse } from '../gen/secretary/v1/recordings_pb';
+import { getRecordingStatusConfig } from '../lib/status';
+import { UserAvatar, userName } from '../components/UserAvatar';
+import { ActivityTimeline } from '../components/ActivityTimeline';
+import { StarButton } from '../components/StarButton';
 
 export function DashboardPage() {
+  const [starredOnly, setStarredOnly] = useState(false);
   const { data, isLoading, error } = useQuery({
-    queryKey: ['recordings'],
+    queryKey: ['recordings', starredOnly],
     queryFn: async () => {
-      const response = await recordingsClient.listRecordings({});
+      const response = await recordingsClient.listRecordings({ includeParticipants: true, starredOnly });
       return (response as ListRecordingsResponse).recordings;
     },
   });
 
   return (
     <Container size="md">
-      <Title order={2} mb="lg">Recordings</Title>
+      <Group justify="space-between" mb="lg">
+        <Title order={2}>Recordings</Title>
+        <Switch label="Starred only" checked={starredOnly} onChange={(e) => setStarredOnly(e.currentTarget.checked)} />
+      </Group>
       
       {isLoading && <Loader />}
       
@@ -37,15 +47,47 @@ export function DashboardPage() {
                 </ThemeIcon>
               }
             >
-              <Anchor component={Link} to={`/recordings/${rec.id}`} fw={500}>
-                {rec.name || 'Untitled Meeting'}
-              </Anchor>
+              <Group gap="xs">
+                <StarButton kind="recording" id={rec.id} starred={rec.starred} />
+                <Anchor component={Link} to={`/recordings/${rec.id}`} fw={500}>
+                  {rec.name || 'Untitled Meeting'}
+                </Anchor>
+                {rec.openTodoCount + rec.doneTodoCount > 0 && (
+                  <Badge
+                    size="xs"
+                    variant="light"
+                    color={rec.openTodoCount === 0 ? 'green' : 'gray'}
+                    leftSection={<CheckSquare size={10} />}
+                    title={`${rec.openTodoCount} open, ${rec.doneTodoCount} done`}
+                  >
+                    {rec.doneTodoCount}/{rec.openTodoCount + rec.doneTodoCount}
+                  </Badge>
+                )}
+                {rec.status !== RecordingStatus.READY && (
+                  <Badge size="xs" variant="light" color={getRecordingStatusConfig(rec.status).color} title={rec.statusError || undefined}>
+                    {getRecordingStatusConfig(rec.status).label}
+                  </Badge>
+                )}
+              </Group>
               <Text size="xs" c="dimmed">{new Date(rec.createdAt).toLocaleString()}</Text>
+              {rec.participants.length > 0 && (
+                <Group gap={6} mt={2}>
+                  <Avatar.Group spacing="xs">
+                    {rec.participants.map((p) => <UserAvatar key={p.id} user={p} size="xs" />)}
+                  </Avatar.Group>
+                  <Text size="xs" c="dimmed">
+                    {rec.participants.map((p) => userName(p)).join(', ')}
+                  </Text>
+                </Group>
+              )}
             </List.Item>
           ))}
-          {data.length === 0 && <Text c="dimmed">No recordings found.</Text>}
+          {data.length === 0 && <Text c="dimmed">{starredOnly ? 'No starred recordings.' : 'No recordings found.'}</Text>}
         </List>
       )}
+
+      <Title order={3} mt="xl" mb="md">Recent activity</Title>
+      <ActivityTimeline />
     </Container>
   );
 }
@@ -0,0 +1,119 @@
+import { useState } from 'react';
+import { Link } from 'react-router-dom';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { useDebouncedValue } from '@mantine/hooks';
+import { notifications } from '@mantine/notifications';
+import { ActionIcon, Anchor, Badge, Button, Card, Container, Group, Loader, Mark, Stack, Switch, Text, TextInput, Title, Tooltip } from '@mantine/core';
+import { AtSign, CheckSquare, Mail, MailOpen, MessageSquare, Mic } from 'lucide-react';
+import { usersClient } from '../lib/client';
+import { MentionKind } from '../gen/secretary/v1/users_pb';
+import type { Mention } from '../gen/secretary/v1/users_pb';
+
+function highlighted(text: string, name: string) {
+  const at = text.indexOf(name);
+  if (at < 0) return text;
+  return (
+    <>
+      {text.slice(0, at)}
+      <Mark>{name}</Mark>
+      {text.slice(at + name.length)}
+    </>
+  );
+}
+
+// InboxPage lists the transcript lines and todos that name the signed-in
+// user, unread ones highlighted.
+export function InboxPage() {
+  const queryClient = useQueryClient();
+  const [query, setQuery] = useState('');
+  const [unreadOnly, setUnreadOnly] = useState(false);
+  const [debounced] = useDebouncedValue(query, 300);
+  const { data, isLoading } = useQuery({
+    queryKey: ['mentions', 'me', debounced, unreadOnly],
+    queryFn: async () => usersClient.listMentions({ query: debounced, unreadOnly }),
+  });
+
+  const markMutation = useMutation({
+    mutationFn: async ({ mentions, all, unread }: { mentions?: Mention[]; all?: boolean; unread?: boolean }) =>
+      usersClient.markMentionsRead({
+        mentions: mentions?.map((m) => ({ kind: m.kind, id: m.id })),
+        all,
+        unread,
+      }),
+    onSuccess: () => queryClient.invalidateQueries({ queryKey: ['mentions'] }),
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  const unread = Number(data?.unreadCount ?? 0);
+
+  return (
+    <Container size="md">
+      <Group justify="space-between" mb="lg">
+        <Group gap="xs">
+          <Title order={2}>Inbox</Title>
+          {unread > 0 && <Badge variant="filled" color="red">{unread}</Badge>}
+        </Group>
+        <Button size="xs" variant="light" disabled={unread === 0} loading={markMutation.isPending} onClick={() => markMutation.mutate({ all: true })}>
+          Mark all read
+        </Button>
+      </Group>
+
+      <Group mb="md" align="end">
+        <TextInput
+          style={{ flex: 1 }}
+          leftSection={<AtSign size={14} />}
+          placeholder="Search what was said"
+          value={query}
+          onChange={(e) => setQuery(e.currentTarget.value)}
+        />
+        <Switch label="Unread only" checked={unreadOnly} onChange={(e) => setUnreadOnly(e.currentTarget.checked)} />
+      </Group>
+
+      {isLoading && <Loader />}
+      {data?.mentions.length === 0 && <Text c="dimmed">Nobody has named you in a meeting or task yet.</Text>}
+
+      <Stack gap="sm">
+        {data?.mentions.map((m) => (
+          <Card
+            key={`${m.kind}-${m.id}`}
+            withBorder
+            radius="md"
+            padding="sm"
+            style={{ borderLeft: m.read ? undefined : '3px solid var(--mantine-color-blue-6)' }}
+          >
+            <Group justify="space-between" align="start" wrap="nowrap">
+              <Stack gap={4} style={{ flex: 1 }}>
+                <Group gap={6}>
+                  {m.kind === MentionKind.TODO ? <CheckSquare size={14} /> : m.kind === MentionKind.COMMENT ? <MessageSquare size={14} /> : <Mic size={14} />}
+                  <Text size="sm" fw={m.read ? undefined : 600}>
+                    {m.kind === MentionKind.TODO ? highlighted(m.todoName, m.matchedText)
+                      : m.kind === MentionKind.COMMENT ? <>{m.todoName}: {highlighted(m.commentText, m.matchedText)}</>
+                      : highlighted(m.segmentText, m.matchedText)}
+                  </Text>
+                </Group>
+                <Text size="xs" c="dimmed">
+                  {m.recordingId > 0n ? (
+                    <Anchor
+                      component={Link}
+                      to={`/recordings/${m.recordingId}`}
+                      size="xs"
+                      onClick={() => !m.read && markMutation.mutate({ mentions: [m] })}
+                    >
+                      {m.recordingName || 'Untitled Meeting'}
+                    </Anchor>
+                  ) : 'Task'}
+                  {m.createdAt && ` · ${new Date(m.createdAt).toLocaleString()}`}
+                </Text>
+              </Stack>
+              <Tooltip label={m.read ? 'Mark unread' : 'Mark read'}>
+                <ActionIcon variant="subtle" color="gray" onClick={() => markMutation.mutate({ mentions: [m], unread: m.read })}>
+                  {m.read ? <Mail size={16} /> : <MailOpen size={16} />}
+                </ActionIcon>
+              </Tooltip>
+            </Group>
+          </Card>
+        ))}
+      </Stack>
+    </Container>
+  );
+}
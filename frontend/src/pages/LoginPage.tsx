@@ -2,7 +2,7 @@ import { useState } from 'react';
 import { useNavigate } from 'react-router-dom';
 import { TextInput, PasswordInput, Button, Paper, Title, Container, Alert } from '@mantine/core';
 import { AlertCircle } from 'lucide-react';
-import { setToken, setUser } from '../lib/auth';
+import { getDeviceId, setToken, setUser } from '../lib/auth';
 
 export function LoginPage() {
   const [email, setEmail] = useState('');
@@ -22,7 +22,7 @@ export function LoginPage() {
       const res = await fetch(`${baseUrl}/api/login`, {
         method: 'POST',
         headers: { 'Content-Type': 'application/json' },
-        body: JSON.stringify({ email, password }),
+        body: JSON.stringify({ email, password, deviceId: getDeviceId() }),
       });
 
       const data = await res.json();
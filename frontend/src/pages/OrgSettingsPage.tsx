@@ -0,0 +1,260 @@
+import { useEffect, useState } from 'react';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Alert, Button, FileButton, Group, Image, Loader, NumberInput, Select, Stack, Switch, Text, TextInput, Title } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { AlertCircle } from 'lucide-react';
+import { InstanceArchives } from '../components/InstanceArchives';
+import { ScheduledTasks } from '../components/ScheduledTasks';
+import { FeatureFlags } from '../components/FeatureFlags';
+import { IpAllowlist } from '../components/IpAllowlist';
+import { MaintenanceMode } from '../components/MaintenanceMode';
+import { AuditLog } from '../components/AuditLog';
+import { ScimGroups } from '../components/ScimGroups';
+import { SystemHealth } from '../components/SystemHealth';
+import { apiUrl, settingsClient } from '../lib/client';
+import { getToken } from '../lib/auth';
+import { OrgSettings, ProcessingDefaults, PurgedRecording, RedactionPolicy, RetentionPolicy } from '../gen/secretary/v1/settings_pb';
+
+const ROLE_OPTIONS = [
+  { value: 'member', label: 'Member' },
+  { value: 'admin', label: 'Admin' },
+];
+
+const REDACTION_OPTIONS: { field: 'emails' | 'phoneNumbers' | 'creditCards' | 'profanity'; label: string }[] = [
+  { field: 'emails', label: 'Email addresses' },
+  { field: 'phoneNumbers', label: 'Phone numbers' },
+  { field: 'creditCards', label: 'Credit card numbers' },
+  { field: 'profanity', label: 'Profanity' },
+];
+
+// OrgSettingsPage lets admins change organization-wide settings without
+// redeploying the server.
+export function OrgSettingsPage() {
+  const queryClient = useQueryClient();
+  const { data, isLoading, error } = useQuery({
+    queryKey: ['settings'],
+    queryFn: async () => settingsClient.getSettings({}),
+  });
+
+  const [orgName, setOrgName] = useState('');
+  const [defaultUserRole, setDefaultUserRole] = useState('member');
+  const [audioDays, setAudioDays] = useState(0);
+  const [transcriptDays, setTranscriptDays] = useState(0);
+  const [disabled, setDisabled] = useState<string[]>([]);
+  const [redaction, setRedaction] = useState(new RedactionPolicy());
+  const [processing, setProcessing] = useState(new ProcessingDefaults());
+  const [purge, setPurge] = useState<{ dryRun: boolean; recordings: PurgedRecording[] } | null>(null);
+
+  useEffect(() => {
+    const settings = data?.settings;
+    if (!settings) return;
+    setOrgName(settings.orgName);
+    setDefaultUserRole(settings.defaultUserRole);
+    setAudioDays(settings.retention?.audioDays ?? 0);
+    setTranscriptDays(settings.retention?.transcriptDays ?? 0);
+    setDisabled(settings.disabledIntegrations);
+    setRedaction(settings.redaction ?? new RedactionPolicy());
+    setProcessing(settings.processingDefaults ?? new ProcessingDefaults());
+  }, [data]);
+
+  const saveMutation = useMutation({
+    mutationFn: async () => settingsClient.updateSettings({
+      settings: new OrgSettings({
+        orgName,
+        defaultUserRole,
+        retention: new RetentionPolicy({ audioDays, transcriptDays }),
+        disabledIntegrations: disabled,
+        redaction,
+        processingDefaults: processing,
+      }),
+    }),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['settings'] });
+      notifications.show({ message: 'Settings saved', color: 'green' });
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  const purgeMutation = useMutation({
+    mutationFn: async (dryRun: boolean) => ({ dryRun, recordings: (await settingsClient.purgeExpiredData({ dryRun })).recordings }),
+    onSuccess: (result) => {
+      setPurge(result);
+      if (!result.dryRun) queryClient.invalidateQueries({ queryKey: ['recordings'] });
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  const logoMutation = useMutation({
+    mutationFn: async (file: File | null) => {
+      const res = await fetch(apiUrl('/api/settings/logo'), {
+        method: file ? 'PUT' : 'DELETE',
+        headers: { Authorization: `Bearer ${getToken()}` },
+        body: file,
+      });
+      if (!res.ok) {
+        const body = await res.json().catch(() => ({}));
+        throw new Error(body.error || 'Logo upload failed');
+      }
+    },
+    onSuccess: () => queryClient.invalidateQueries({ queryKey: ['settings'] }),
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  if (isLoading) return <Loader />;
+  if (error || !data?.settings) {
+    return (
+      <Alert icon={<AlertCircle size={16} />} title="Error" color="red">
+        Failed to load settings: {error?.message}
+      </Alert>
+    );
+  }
+
+  const { settings, integrations } = data;
+
+  return (
+    <Stack maw={480}>
+      <Group>
+        {settings.logoUrl && <Image src={apiUrl(settings.logoUrl)} h={40} w="auto" fit="contain" alt="Logo" />}
+        <FileButton onChange={(file) => file && logoMutation.mutate(file)} accept="image/png,image/jpeg,image/gif,image/webp">
+          {(props) => <Button variant="light" loading={logoMutation.isPending} {...props}>Upload logo</Button>}
+        </FileButton>
+        {settings.logoUrl && (
+          <Button variant="subtle" color="red" onClick={() => logoMutation.mutate(null)}>Remove</Button>
+        )}
+      </Group>
+
+      <TextInput label="Organization name" value={orgName} onChange={(e) => setOrgName(e.currentTarget.value)} />
+      <Select
+        label="Default role"
+        description="Given to accounts created from now on"
+        data={ROLE_OPTIONS}
+        value={defaultUserRole}
+        onChange={(value) => value && setDefaultUserRole(value)}
+        allowDeselect={false}
+      />
+      <NumberInput
+        label="Keep audio for (days)"
+        description="0 keeps it forever"
+        min={0}
+        value={audioDays}
+        onChange={(value) => setAudioDays(Number(value) || 0)}
+      />
+      <NumberInput
+        label="Keep transcripts for (days)"
+        description="0 keeps them forever"
+        min={0}
+        value={transcriptDays}
+        onChange={(value) => setTranscriptDays(Number(value) || 0)}
+      />
+      <Group gap="xs">
+        <Button variant="light" size="xs" onClick={() => purgeMutation.mutate(true)} loading={purgeMutation.isPending}>
+          Preview purge
+        </Button>
+        <Button
+          variant="light"
+          color="red"
+          size="xs"
+          onClick={() => {
+            if (confirm('Remove expired audio and transcripts now? This cannot be undone.')) purgeMutation.mutate(false);
+          }}
+          loading={purgeMutation.isPending}
+        >
+          Purge now
+        </Button>
+      </Group>
+      <Text size="xs" c="dimmed">Purges use the saved policy. The server also runs them on a schedule.</Text>
+      {purge && (
+        <Stack gap={2}>
+          <Text size="sm">
+            {purge.recordings.length === 0
+              ? 'Nothing has expired.'
+              : `${purge.dryRun ? 'Would remove' : 'Removed'} data of ${purge.recordings.length} recording(s):`}
+          </Text>
+          {purge.recordings.map((r) => (
+            <Text key={String(r.recordingId)} size="xs" c="dimmed">
+              {r.name || 'Untitled Meeting'} ({new Date(r.createdAt).toLocaleDateString()}):{' '}
+              {[r.audio && 'audio', r.transcript && 'transcript'].filter(Boolean).join(' and ')}
+            </Text>
+          ))}
+        </Stack>
+      )}
+
+      <Title order={4} mt="sm">Redaction</Title>
+      <Text size="xs" c="dimmed">
+        Masked in transcripts and summaries as they are stored, and in anything published or posted to chat.
+      </Text>
+      {REDACTION_OPTIONS.map(({ field, label }) => (
+        <Switch
+          key={field}
+          label={label}
+          checked={redaction[field]}
+          onChange={(e) => setRedaction(new RedactionPolicy({ ...redaction, [field]: e.currentTarget.checked }))}
+        />
+      ))}
+
+      <Title order={4} mt="sm">Processing</Title>
+      <Text size="xs" c="dimmed">
+        Applied to new recordings unless they are uploaded with their own settings.
+      </Text>
+      <TextInput
+        label="Language"
+        description='A language tag such as "es" or "pt-BR"; leave empty to detect it.'
+        maxLength={35}
+        value={processing.language}
+        onChange={(e) => setProcessing(new ProcessingDefaults({ ...processing, language: e.currentTarget.value }))}
+      />
+      <Switch
+        label="Summarize recordings"
+        checked={!processing.disableSummary}
+        onChange={(e) => setProcessing(new ProcessingDefaults({ ...processing, disableSummary: !e.currentTarget.checked }))}
+      />
+      <Switch
+        label="Extract todos"
+        checked={!processing.disableTodoExtraction}
+        onChange={(e) => setProcessing(new ProcessingDefaults({ ...processing, disableTodoExtraction: !e.currentTarget.checked }))}
+      />
+
+      <Title order={4} mt="sm">Integrations</Title>
+      {integrations.length === 0 && <Text size="sm" c="dimmed">No integrations are set up on this server.</Text>}
+      {integrations.map((integration) => (
+        <Switch
+          key={integration.name}
+          label={integration.name}
+          checked={!disabled.includes(integration.name)}
+          onChange={(e) => {
+            const on = e.currentTarget.checked;
+            setDisabled((current) => on ? current.filter((name) => name !== integration.name) : [...current, integration.name]);
+          }}
+        />
+      ))}
+
+      <Group justify="flex-end">
+        <Button onClick={() => saveMutation.mutate()} loading={saveMutation.isPending}>Save</Button>
+      </Group>
+
+      <Title order={4} mt="sm">System health</Title>
+      <SystemHealth />
+
+      <Title order={4} mt="sm">Scheduled tasks</Title>
+      <ScheduledTasks />
+
+      <Title order={4} mt="sm">Feature flags</Title>
+      <FeatureFlags />
+
+      <Title order={4} mt="sm">SCIM groups</Title>
+      <ScimGroups />
+
+      <Title order={4} mt="sm">IP allowlist</Title>
+      <IpAllowlist />
+
+      <Title order={4} mt="sm">Maintenance</Title>
+      <MaintenanceMode />
+
+      <Title order={4} mt="sm">Backups</Title>
+      <InstanceArchives />
+
+      <Title order={4} mt="sm">Audit log</Title>
+      <AuditLog />
+    </Stack>
+  );
+}
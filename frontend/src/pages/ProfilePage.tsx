@@ -0,0 +1,130 @@
+import { useEffect, useState } from 'react';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Alert, Badge, Button, FileButton, Group, Loader, Select, Stack, Text, TextInput } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { AlertCircle } from 'lucide-react';
+import { apiUrl, usersClient } from '../lib/client';
+import { getToken, setLocale } from '../lib/auth';
+import { UserAvatar } from '../components/UserAvatar';
+import { NotificationPreferences } from '../components/NotificationPreferences';
+import { WatchKeywords } from '../components/WatchKeywords';
+import { ActiveSessions } from '../components/ActiveSessions';
+import { ChangePassword } from '../components/ChangePassword';
+
+function languageName(tag: string): string {
+  return new Intl.DisplayNames([tag], { type: 'language' }).of(tag) ?? tag;
+}
+
+export function ProfilePage() {
+  const queryClient = useQueryClient();
+  const { data: profile, isLoading, error } = useQuery({
+    queryKey: ['me'],
+    queryFn: async () => (await usersClient.getMe({})).profile,
+  });
+
+  const [firstName, setFirstName] = useState('');
+  const [lastName, setLastName] = useState('');
+  const [email, setEmail] = useState('');
+  const [timezone, setTimezone] = useState('');
+  const [locale, setLocaleValue] = useState('');
+
+  useEffect(() => {
+    if (!profile) return;
+    setFirstName(profile.firstName);
+    setLastName(profile.lastName);
+    setEmail(profile.pendingEmail || profile.email);
+    setTimezone(profile.timezone);
+    setLocaleValue(profile.locale);
+    setLocale(profile.locale);
+  }, [profile]);
+
+  const saveMutation = useMutation({
+    mutationFn: async () => (await usersClient.updateMe({ firstName, lastName, email, timezone, locale })).profile,
+    onSuccess: (updated) => {
+      queryClient.setQueryData(['me'], updated);
+      notifications.show({
+        message: updated?.pendingEmail ? `Check ${updated.pendingEmail} for a verification link` : 'Profile saved',
+        color: 'green',
+      });
+    },
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  const avatarMutation = useMutation({
+    mutationFn: async (file: File | null) => {
+      const res = await fetch(apiUrl('/api/me/avatar'), {
+        method: file ? 'PUT' : 'DELETE',
+        headers: { Authorization: `Bearer ${getToken()}` },
+        body: file,
+      });
+      if (!res.ok) {
+        const data = await res.json().catch(() => ({}));
+        throw new Error(data.error || 'Avatar upload failed');
+      }
+    },
+    onSuccess: () => queryClient.invalidateQueries({ queryKey: ['me'] }),
+    onError: (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' }),
+  });
+
+  if (isLoading) return <Loader />;
+  if (error || !profile) {
+    return (
+      <Alert icon={<AlertCircle size={16} />} title="Error" color="red">
+        Failed to load profile: {error?.message}
+      </Alert>
+    );
+  }
+
+  return (
+    <Stack maw={480}>
+      <Group>
+        <UserAvatar user={profile} size="lg" />
+        <FileButton onChange={(file) => file && avatarMutation.mutate(file)} accept="image/png,image/jpeg,image/gif">
+          {(props) => <Button variant="light" loading={avatarMutation.isPending} {...props}>Upload avatar</Button>}
+        </FileButton>
+        {profile.avatarUrl && (
+          <Button variant="subtle" color="red" onClick={() => avatarMutation.mutate(null)}>Remove</Button>
+        )}
+      </Group>
+
+      <TextInput label="First name" value={firstName} onChange={(e) => setFirstName(e.currentTarget.value)} required />
+      <TextInput label="Last name" value={lastName} onChange={(e) => setLastName(e.currentTarget.value)} />
+      <TextInput
+        label="Email"
+        value={email}
+        onChange={(e) => setEmail(e.currentTarget.value)}
+        rightSectionWidth={90}
+        rightSection={
+          profile.pendingEmail ? <Badge color="yellow" size="sm">Pending</Badge>
+            : profile.emailVerified ? <Badge color="green" size="sm">Verified</Badge> : null
+        }
+      />
+      {profile.pendingEmail && (
+        <Text size="sm" c="dimmed">
+          {profile.email} stays your sign-in address until {profile.pendingEmail} is verified.
+        </Text>
+      )}
+      <TextInput label="Time zone" description="IANA name, e.g. Europe/Madrid" value={timezone} onChange={(e) => setTimezone(e.currentTarget.value)} />
+      <Select
+        label="Language"
+        description="Used for emails, calendar feeds and error messages"
+        data={[{ value: '', label: 'Same as browser' }, ...profile.supportedLocales.map((tag) => ({ value: tag, label: languageName(tag) }))]}
+        value={locale}
+        onChange={(value) => setLocaleValue(value ?? '')}
+        allowDeselect={false}
+      />
+
+      <Group justify="flex-end">
+        <Button onClick={() => saveMutation.mutate()} loading={saveMutation.isPending}>Save</Button>
+      </Group>
+
+      <NotificationPreferences />
+
+      <WatchKeywords />
+
+      <ChangePassword />
+
+      <ActiveSessions />
+    </Stack>
+  );
+}
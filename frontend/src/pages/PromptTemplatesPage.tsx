@@ -0,0 +1,132 @@
+import { useState } from 'react';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { ActionIcon, Alert, Button, Card, Container, Group, Loader, Stack, Text, TextInput, Textarea, Title } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
+import { AlertCircle, Pencil, Plus, Trash } from 'lucide-react';
+import { promptTemplatesClient } from '../lib/client';
+import { getUser } from '../lib/auth';
+import type { PromptTemplate } from '../gen/secretary/v1/prompt_templates_pb';
+
+type Draft = { id: bigint; name: string; description: string; summaryInstructions: string; extractionGuidance: string };
+
+const emptyDraft: Draft = { id: 0n, name: '', description: '', summaryInstructions: '', extractionGuidance: '' };
+
+// PromptTemplatesPage lists the prompt templates recordings can use.
+// Admins can add, change and remove them.
+export function PromptTemplatesPage() {
+  const queryClient = useQueryClient();
+  const isAdmin = getUser()?.role === 'admin';
+  const [draft, setDraft] = useState<Draft | null>(null);
+
+  const { data: templates, isLoading, error } = useQuery({
+    queryKey: ['promptTemplates'],
+    queryFn: async () => (await promptTemplatesClient.listPromptTemplates({})).templates,
+  });
+
+  const onError = (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' });
+  const refresh = () => queryClient.invalidateQueries({ queryKey: ['promptTemplates'] });
+
+  const saveMutation = useMutation({
+    mutationFn: async (d: Draft) => (d.id ? promptTemplatesClient.updatePromptTemplate(d) : promptTemplatesClient.createPromptTemplate(d)),
+    onSuccess: () => {
+      setDraft(null);
+      refresh();
+    },
+    onError,
+  });
+  const deleteMutation = useMutation({
+    mutationFn: async (template: PromptTemplate) => promptTemplatesClient.deletePromptTemplate({ id: template.id }),
+    onSuccess: refresh,
+    onError,
+  });
+
+  const edit = (t: PromptTemplate) =>
+    setDraft({ id: t.id, name: t.name, description: t.description, summaryInstructions: t.summaryInstructions, extractionGuidance: t.extractionGuidance });
+
+  return (
+    <Container size="md">
+      <Group justify="space-between" mb="lg">
+        <Title order={2}>Prompt Templates</Title>
+        {isAdmin && !draft && (
+          <Button size="xs" variant="light" leftSection={<Plus size={14} />} onClick={() => setDraft(emptyDraft)}>
+            New template
+          </Button>
+        )}
+      </Group>
+      <Text size="sm" c="dimmed" mb="md">
+        Templates change how summaries, outcomes and minutes are written for a kind of meeting. Pick one on a recording's page.
+      </Text>
+
+      {isLoading && <Loader />}
+      {error && (
+        <Alert icon={<AlertCircle size={16} />} title="Error" color="red">
+          Failed to load prompt templates: {error.message}
+        </Alert>
+      )}
+
+      {draft && (
+        <Card withBorder radius="md" padding="md" mb="md">
+          <Stack gap="xs">
+            <TextInput label="Name" required value={draft.name} onChange={(e) => setDraft({ ...draft, name: e.currentTarget.value })} />
+            <TextInput label="Description" value={draft.description} onChange={(e) => setDraft({ ...draft, description: e.currentTarget.value })} />
+            <Textarea
+              label="Summary instructions"
+              description="Replaces the default summary prompt. Leave empty to keep it."
+              autosize
+              minRows={3}
+              value={draft.summaryInstructions}
+              onChange={(e) => setDraft({ ...draft, summaryInstructions: e.currentTarget.value })}
+            />
+            <Textarea
+              label="Extraction guidance"
+              description="Added to the prompts that extract outcomes and draft minutes."
+              autosize
+              minRows={2}
+              value={draft.extractionGuidance}
+              onChange={(e) => setDraft({ ...draft, extractionGuidance: e.currentTarget.value })}
+            />
+            <Group justify="flex-end">
+              <Button variant="default" size="xs" onClick={() => setDraft(null)}>Cancel</Button>
+              <Button size="xs" onClick={() => saveMutation.mutate(draft)} loading={saveMutation.isPending} disabled={!draft.name.trim()}>
+                Save
+              </Button>
+            </Group>
+          </Stack>
+        </Card>
+      )}
+
+      {templates?.length === 0 && !draft && <Text size="sm" c="dimmed">No templates yet; recordings use the default prompts.</Text>}
+      <Stack gap="sm">
+        {templates?.map((template) => (
+          <Card key={template.id} withBorder radius="md" padding="sm">
+            <Group justify="space-between" align="start" wrap="nowrap">
+              <Stack gap={2}>
+                <Text fw={500}>{template.name}</Text>
+                {template.description && <Text size="sm" c="dimmed">{template.description}</Text>}
+              </Stack>
+              {isAdmin && (
+                <Group gap={4} wrap="nowrap">
+                  <ActionIcon variant="subtle" color="gray" aria-label="Edit" onClick={() => edit(template)}>
+                    <Pencil size={14} />
+                  </ActionIcon>
+                  <ActionIcon
+                    variant="subtle"
+                    color="gray"
+                    aria-label="Delete"
+                    onClick={() => {
+                      if (confirm(`Delete "${template.name}"? Recordings using it go back to the default prompts.`)) {
+                        deleteMutation.mutate(template);
+                      }
+                    }}
+                  >
+                    <Trash size={14} />
+                  </ActionIcon>
+                </Group>
+              )}
+            </Group>
+          </Card>
+        ))}
+      </Stack>
+    </Container>
+  );
+}
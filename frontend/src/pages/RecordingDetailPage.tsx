@@ -1,17 +1,36 @@
-import { useState, useMemo } from 'react';
+import { useState, useMemo, useRef } from 'react';
 import { useParams, Link, useNavigate } from 'react-router-dom';
-import { useMutation, useQuery } from '@tanstack/react-query';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
 import { Container, Title, Text, Loader, Alert, Tabs, Paper, Group, Badge, Breadcrumbs, Anchor, Card, Stack, Switch, Button } from '@mantine/core';
 import { useDisclosure } from '@mantine/hooks';
 import { notifications } from '@mantine/notifications';
 import { AlertCircle, Calendar, Clock, Trash } from 'lucide-react';
 import { recordingsClient, todosClient, usersClient } from '../lib/client';
 import { getUser } from '../lib/auth';
-import { getStatusConfig } from '../lib/status';
+import { UserAvatar, userName } from '../components/UserAvatar';
+import { getStatusConfig, getRecordingStatusConfig } from '../lib/status';
+import { ProcessingAttemptStatus, ProcessingStage, RecordingStatus, TranslationKind } from '../gen/secretary/v1/recordings_pb';
 import type { GetRecordingResponse, Recording } from '../gen/secretary/v1/recordings_pb';
 import type { ListTodosResponse, Todo } from '../gen/secretary/v1/todos_pb';
-import type { ListUsersResponse } from '../gen/secretary/v1/users_pb';
+import type { ListUsersResponse, User } from '../gen/secretary/v1/users_pb';
 import { EditTodoDrawer } from '../components/EditTodoDrawer';
+import { TranslatedText } from '../components/TranslatedText';
+import { RecordingOutcomes } from '../components/RecordingOutcomes';
+import { RecordingMinutes } from '../components/RecordingMinutes';
+import { StarButton } from '../components/StarButton';
+import { WikiPublications } from '../components/WikiPublications';
+import { PromptTemplateSelect } from '../components/PromptTemplateSelect';
+import { RecordingAnnotations } from '../components/RecordingAnnotations';
+import { RecordingClips } from '../components/RecordingClips';
+import { AttachmentList } from '../components/AttachmentList';
+import { RecordingRetention } from '../components/RecordingRetention';
+import { LegalHold } from '../components/LegalHold';
+import { GuestParticipants } from '../components/GuestParticipants';
+import { SpeakerRelabel } from '../components/SpeakerRelabel';
+import { TranscriptSearch } from '../components/TranscriptSearch';
+import { TranscriptPages } from '../components/TranscriptPages';
+import { CloneRecording } from '../components/CloneRecording';
+import { ProcessingSettingsEditor } from '../components/ProcessingSettingsEditor';
 
 export function RecordingDetailPage() {
   const { id } = useParams();
@@ -21,8 +40,9 @@ export function RecordingDetailPage() {
   const [selectedTodo, setSelectedTodo] = useState<Todo | null>(null);
   const [showMyTodosOnly, setShowMyTodosOnly] = useState(false);
   const currentUser = getUser();
+  const audioRef = useRef<HTMLAudioElement>(null);
   const navigate = useNavigate();
-  // const queryClient = useQueryClient();
+  const queryClient = useQueryClient();
 
   const deleteRecordingMutation = useMutation({
     mutationFn: async () => {
@@ -38,11 +58,64 @@ export function RecordingDetailPage() {
     }
   });
 
+  const retryProcessingMutation = useMutation({
+    mutationFn: async (stage: ProcessingStage) => {
+      if (!recordingId) return;
+      await recordingsClient.retryProcessing({ id: recordingId, stage });
+    },
+    onSuccess: () => {
+      notifications.show({ title: 'Success', message: 'Processing restarted', color: 'blue' });
+      queryClient.invalidateQueries({ queryKey: ['recording', id] });
+      queryClient.invalidateQueries({ queryKey: ['recordings'] });
+    },
+    onError: (err: any) => {
+      notifications.show({ title: 'Error', message: err.message, color: 'red' });
+    }
+  });
+
+  const translateMutation = useMutation({
+    mutationFn: async ({ kind, language }: { kind: TranslationKind; language: string }) => {
+      if (!recordingId) return;
+      if (kind === TranslationKind.SUMMARY) {
+        await recordingsClient.summarize({ id: recordingId, targetLanguage: language });
+      } else {
+        await recordingsClient.translateTranscript({ id: recordingId, targetLanguage: language });
+      }
+    },
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['recording', id] });
+      queryClient.invalidateQueries({ queryKey: ['transcript', id] });
+    },
+    onError: (err: any) => {
+      notifications.show({ title: 'Error', message: err.message, color: 'red' });
+    }
+  });
+
+  const linkMentionsMutation = useMutation({
+    mutationFn: async () => {
+      if (!recordingId) return;
+      return recordingsClient.linkMentions({ id: recordingId });
+    },
+    onSuccess: (res) => {
+      const assigned = res?.assignedTodoIds.length ?? 0;
+      notifications.show({
+        title: 'Names linked',
+        message: `${res?.mentions.length ?? 0} mention(s) found, ${assigned} task${assigned !== 1 ? 's' : ''} assigned`,
+        color: 'blue',
+      });
+      queryClient.invalidateQueries({ queryKey: ['todos'] });
+      queryClient.invalidateQueries({ queryKey: ['mentions'] });
+    },
+    onError: (err: any) => {
+      notifications.show({ title: 'Error', message: err.message, color: 'red' });
+    }
+  });
+
   const { data, isLoading, error } = useQuery({
     queryKey: ['recording', id],
     queryFn: async () => {
       if (!recordingId) throw new Error('Invalid ID');
-      const response = await recordingsClient.getRecording({ id: recordingId });
+      const response = await recordingsClient.getRecording({ id: recordingId, omitTranscript: true });
       return (response as GetRecordingResponse).recording;
     },
     enabled: !!recordingId,
@@ -68,8 +141,8 @@ export function RecordingDetailPage() {
   });
 
   const userMap = useMemo(() => {
-    const map = new Map<bigint, string>();
-    users?.forEach(u => map.set(u.id, `${u.firstName} ${u.lastName}`));
+    const map = new Map<bigint, User>();
+    users?.forEach(u => map.set(u.id, u));
     return map;
   }, [users]);
 
@@ -81,46 +154,18 @@ export function RecordingDetailPage() {
 
   const rec = data as Recording | undefined;
 
-  // Calculate word counts per speaker from transcript
+  // Word counts per speaker, counted by the server so long transcripts
+  // needn't be loaded
   const speakerStats = useMemo(() => {
-    if (!rec?.transcript) return { total: 0, bySpeaker: new Map<string, number>() };
-    
     const stats = new Map<string, number>();
     let totalWords = 0;
-    
-    // Split by newlines to handle "Speaker: Text" format
-    const lines = rec.transcript.split('\n');
-    
-    for (const line of lines) {
-      // Match "Speaker 1: " or "SPEAKER_01: " or just "Speaker 1:"
-      // Capturing group 1 is the ID
-      const matchId = line.match(/^(?:Speaker|SPEAKER_)\s*0*(\d+):\s+(.+)$/i);
-      if (matchId) {
-        const speakerId = parseInt(matchId[1], 10);
-        const text = matchId[2];
-        const wordCount = text.trim().split(/\s+/).length;
-        
-        const key = `id_${speakerId}`;
-        stats.set(key, (stats.get(key) || 0) + wordCount);
-        totalWords += wordCount;
-        continue;
-      }
-
-      // Fallback: Match "Name: "
-      const matchName = line.match(/^([^:]+):\s+(.+)$/);
-      if (matchName) {
-        const name = matchName[1].trim().toLowerCase();
-        const text = matchName[2];
-        const wordCount = text.trim().split(/\s+/).length;
-
-        const key = `name_${name}`;
-        stats.set(key, (stats.get(key) || 0) + wordCount);
-        totalWords += wordCount;
-      }
+    for (const count of rec?.speakerWords ?? []) {
+      const key = count.speakerId >= 0 ? `id_${count.speakerId}` : `name_${count.name.toLowerCase()}`;
+      stats.set(key, count.words);
+      totalWords += count.words;
     }
-    
     return { total: totalWords, bySpeaker: stats };
-  }, [rec?.transcript]);
+  }, [rec?.speakerWords]);
 
   const getSpeakerPercentage = (user: { firstName: string; lastName: string; speakerId?: number }) => {
     if (speakerStats.total === 0) return 0;
@@ -186,13 +231,17 @@ export function RecordingDetailPage() {
                     }
                 }}
                 loading={deleteRecordingMutation.isPending}
+                disabled={rec.legalHold}
             >
                 Delete Recording
             </Button>
         )}
       </Group>
 
-      <Title order={2} mb="xs">{rec.name || 'Untitled Meeting'}</Title>
+      <Group gap="xs" mb="xs">
+        <Title order={2}>{rec.name || 'Untitled Meeting'}</Title>
+        <StarButton kind="recording" id={rec.id} starred={rec.starred} />
+      </Group>
       
       <Group mb="xl" c="dimmed" gap="lg">
         <Group gap="xs">
@@ -205,32 +254,93 @@ export function RecordingDetailPage() {
             <Text size="sm">{Math.floor(rec.duration / 60)} min {rec.duration % 60} sec</Text>
           </Group>
         )}
+        <PromptTemplateSelect recording={rec} />
+        <WikiPublications recordingId={rec.id} />
+        <RecordingRetention recording={rec} />
+        <LegalHold recording={rec} />
+        <ProcessingSettingsEditor recording={rec} />
+        {rec.hasAudio && <CloneRecording recording={rec} />}
+        {rec.clonedFromRecordingId > 0n && (
+          <Anchor component={Link} to={`/recordings/${rec.clonedFromRecordingId}`} size="sm">
+            Copied from the original
+          </Anchor>
+        )}
       </Group>
 
-      {rec.participants && rec.participants.length > 0 && (
-        <Group mb="xl">
-          {rec.participants.map((p) => {
-             const pct = getSpeakerPercentage(p);
-             return (
-               <Badge key={p.id} variant="outline" color="gray" size="lg" py="sm" pr="lg" tt="none">
-                 <Group gap="sm">
-                   <Text>{p.firstName} {p.lastName}</Text>
-                   {pct > 0 && (
-                     <Badge size="sm" variant="filled" color="gray">
-                       {pct}%
-                     </Badge>
-                   )}
-                 </Group>
-               </Badge>
-             );
-          })}
-        </Group>
+      {rec.status === RecordingStatus.FAILED ? (
+        <Alert icon={<AlertCircle size={16} />} title="Processing failed" color="red" mb="xl">
+          <Text size="sm">{rec.statusError || 'The transcript or summary could not be generated.'}</Text>
+          {rec.processingAttempts.length > 0 && (
+            <Stack gap={2} mt="sm">
+              {rec.processingAttempts.map((a) => (
+                <Text key={`${a.stage}-${a.attempt}`} size="xs" c="dimmed">
+                  {a.stage === ProcessingStage.TRANSCRIPTION ? 'Transcription' : 'Summary'} attempt {a.attempt}:{' '}
+                  {ProcessingAttemptStatus[a.status].toLowerCase()}
+                  {a.error && ` (${a.error})`}
+                  {a.finishedAt && `, ${new Date(a.finishedAt).toLocaleString()}`}
+                </Text>
+              ))}
+            </Stack>
+          )}
+          {currentUser?.role === 'admin' && (
+            <Group mt="md" gap="xs">
+              <Button
+                size="xs"
+                variant="light"
+                color="red"
+                onClick={() => retryProcessingMutation.mutate(ProcessingStage.TRANSCRIPTION)}
+                loading={retryProcessingMutation.isPending}
+              >
+                Retry transcription
+              </Button>
+              {rec.transcriptLineCount > 0 && (
+                <Button
+                  size="xs"
+                  variant="light"
+                  color="red"
+                  onClick={() => retryProcessingMutation.mutate(ProcessingStage.SUMMARIZATION)}
+                  loading={retryProcessingMutation.isPending}
+                >
+                  Retry summary
+                </Button>
+              )}
+            </Group>
+          )}
+        </Alert>
+      ) : rec.status !== RecordingStatus.READY && (
+        <Alert icon={<Loader size={16} />} title={getRecordingStatusConfig(rec.status).label} color={getRecordingStatusConfig(rec.status).color} mb="xl">
+          This recording is still being processed. The transcript and summary will appear here when it is ready.
+        </Alert>
       )}
 
+      <Group mb="xl">
+        {rec.participants.map((p) => {
+           const pct = getSpeakerPercentage(p);
+           return (
+             <Badge key={p.id} variant="outline" color="gray" size="lg" py="sm" pr="lg" tt="none">
+               <Group gap="sm">
+                 <UserAvatar user={p} size={20} />
+                 <Text>{p.firstName} {p.lastName}</Text>
+                 {pct > 0 && (
+                   <Badge size="sm" variant="filled" color="gray">
+                     {pct}%
+                   </Badge>
+                 )}
+               </Group>
+             </Badge>
+           );
+        })}
+        <GuestParticipants
+          recording={rec}
+          speakerShare={(g) => getSpeakerPercentage({ firstName: g.name, lastName: '', speakerId: g.speakerId >= 0 ? g.speakerId : undefined })}
+        />
+        {rec.transcriptLineCount > 0 && <SpeakerRelabel recording={rec} users={users ?? []} />}
+      </Group>
+
       {rec.hasAudio && rec.audioUrl ? (
         <Card withBorder shadow="sm" p="md" mb="xl" radius="md">
           <Text fw={500} mb="sm">Audio Recording</Text>
-          <audio controls style={{ width: '100%' }}>
+          <audio ref={audioRef} controls style={{ width: '100%' }}>
             <source src={rec.audioUrl} type="audio/mpeg" />
             Your browser does not support the audio element.
           </audio>
@@ -246,6 +356,11 @@ export function RecordingDetailPage() {
           <Tabs.List>
             <Tabs.Tab value="summary">Summary</Tabs.Tab>
             <Tabs.Tab value="transcript">Transcript</Tabs.Tab>
+            <Tabs.Tab value="outcomes">Outcomes</Tabs.Tab>
+            <Tabs.Tab value="minutes">Minutes</Tabs.Tab>
+            <Tabs.Tab value="moments">Moments</Tabs.Tab>
+            <Tabs.Tab value="clips">Clips</Tabs.Tab>
+            <Tabs.Tab value="files">Files</Tabs.Tab>
             <Tabs.Tab value="todos">
               <Group gap={6}>
                 <Text>Todos</Text>
@@ -257,19 +372,47 @@ export function RecordingDetailPage() {
           </Tabs.List>
 
           <Tabs.Panel value="summary" pt="xl">
-            {rec.summary ? (
-              <Text style={{ whiteSpace: 'pre-wrap' }}>{rec.summary}</Text>
-            ) : (
-              <Text c="dimmed">No summary available.</Text>
-            )}
+            <TranslatedText
+              recording={rec}
+              kind={TranslationKind.SUMMARY}
+              original={rec.summary}
+              empty="No summary available."
+              translating={translateMutation.isPending}
+              onTranslate={(language) => translateMutation.mutate({ kind: TranslationKind.SUMMARY, language })}
+            />
           </Tabs.Panel>
 
           <Tabs.Panel value="transcript" pt="xl">
-            {rec.transcript ? (
-              <Text style={{ whiteSpace: 'pre-wrap' }}>{rec.transcript}</Text>
-            ) : (
-               <Text c="dimmed">No transcript available.</Text>
-            )}
+            {rec.transcriptLineCount > 0 && <TranscriptSearch recording={rec} audioRef={audioRef} />}
+            <TranslatedText
+              recording={rec}
+              kind={TranslationKind.TRANSCRIPT}
+              original={rec.transcript}
+              empty="No transcript available."
+              translating={translateMutation.isPending}
+              onTranslate={(language) => translateMutation.mutate({ kind: TranslationKind.TRANSCRIPT, language })}
+              pages={rec.transcriptLineCount > 0 ? (language) => <TranscriptPages recording={rec} language={language} /> : undefined}
+            />
+          </Tabs.Panel>
+
+          <Tabs.Panel value="outcomes" pt="xl">
+            <RecordingOutcomes recording={rec} userMap={userMap} />
+          </Tabs.Panel>
+
+          <Tabs.Panel value="minutes" pt="xl">
+            <RecordingMinutes recording={rec} />
+          </Tabs.Panel>
+
+          <Tabs.Panel value="moments" pt="xl">
+            <RecordingAnnotations recordingId={rec.id} userMap={userMap} audioRef={audioRef} />
+          </Tabs.Panel>
+
+          <Tabs.Panel value="clips" pt="xl">
+            <RecordingClips recording={rec} audioRef={audioRef} />
+          </Tabs.Panel>
+
+          <Tabs.Panel value="files" pt="xl">
+            <AttachmentList recordingId={rec.id} />
           </Tabs.Panel>
 
           <Tabs.Panel value="todos" pt="xl">
@@ -277,11 +420,22 @@ export function RecordingDetailPage() {
               <Text c="dimmed" size="sm">
                 {filteredTodos.length} task{filteredTodos.length !== 1 ? 's' : ''}
               </Text>
-              <Switch
-                label="Only show my tasks"
-                checked={showMyTodosOnly}
-                onChange={(event) => setShowMyTodosOnly(event.currentTarget.checked)}
-              />
+              <Group gap="md">
+                <Button
+                  size="xs"
+                  variant="subtle"
+                  onClick={() => linkMentionsMutation.mutate()}
+                  loading={linkMentionsMutation.isPending}
+                  title="Assign unassigned tasks to the person they name"
+                >
+                  Assign by name
+                </Button>
+                <Switch
+                  label="Only show my tasks"
+                  checked={showMyTodosOnly}
+                  onChange={(event) => setShowMyTodosOnly(event.currentTarget.checked)}
+                />
+              </Group>
             </Group>
              {filteredTodos.length > 0 ? (
                <Stack gap="sm">
@@ -308,8 +462,13 @@ export function RecordingDetailPage() {
                               )}
                               <Group mt={8} gap={6}>
                                 <Text size="xs" c="dimmed" fw={500}>Owner:</Text>
-                                <Badge variant="outline" color="gray" size="sm">
-                                  {userMap.get(todo.userId) || 'Unknown'}
+                                <Badge
+                                  variant="outline"
+                                  color="gray"
+                                  size="sm"
+                                  leftSection={userMap.get(todo.userId) && <UserAvatar user={userMap.get(todo.userId)!} size={14} />}
+                                >
+                                  {userName(userMap.get(todo.userId))}
                                 </Badge>
                               </Group>
                             </div>
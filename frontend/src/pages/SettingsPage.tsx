@@ -1,22 +1,53 @@
 import { Container, Tabs, Title } from '@mantine/core';
 import { UsersPage } from './UsersPage';
-import { User } from 'lucide-react';
+import { ProfilePage } from './ProfilePage';
+import { PromptTemplatesPage } from './PromptTemplatesPage';
+import { OrgSettingsPage } from './OrgSettingsPage';
+import { Building2, MessageSquareText, User, UserCircle } from 'lucide-react';
+import { getUser } from '../lib/auth';
 
 export function SettingsPage() {
+  const isAdmin = getUser()?.role === 'admin';
+
   return (
     <Container size="lg">
       <Title order={2} mb="lg">Settings</Title>
       
-      <Tabs defaultValue="users">
+      <Tabs defaultValue="profile">
         <Tabs.List mb="md">
+          <Tabs.Tab value="profile" leftSection={<UserCircle size={16} />}>
+            Profile
+          </Tabs.Tab>
           <Tabs.Tab value="users" leftSection={<User size={16} />}>
             Users
           </Tabs.Tab>
+          <Tabs.Tab value="prompts" leftSection={<MessageSquareText size={16} />}>
+            Prompts
+          </Tabs.Tab>
+          {isAdmin && (
+            <Tabs.Tab value="organization" leftSection={<Building2 size={16} />}>
+              Organization
+            </Tabs.Tab>
+          )}
         </Tabs.List>
 
+        <Tabs.Panel value="profile">
+          <ProfilePage />
+        </Tabs.Panel>
+
         <Tabs.Panel value="users">
           <UsersPage />
         </Tabs.Panel>
+
+        <Tabs.Panel value="prompts">
+          <PromptTemplatesPage />
+        </Tabs.Panel>
+
+        {isAdmin && (
+          <Tabs.Panel value="organization">
+            <OrgSettingsPage />
+          </Tabs.Panel>
+        )}
       </Tabs>
     </Container>
   );
@@ -1,6 +1,6 @@
 import { useState, useMemo } from 'react';
 import { useQuery } from '@tanstack/react-query';
-import { Container, Title, Loader, Alert, Group, Select, Button, Card, Text, Badge, Stack, Divider } from '@mantine/core';
+import { Container, Title, Loader, Alert, Group, Select, Button, Card, Text, Badge, Stack, Divider, Switch } from '@mantine/core';
 import { useDisclosure } from '@mantine/hooks';
 import { AlertCircle, Plus, Filter } from 'lucide-react';
 import { todosClient, usersClient } from '../lib/client';
@@ -10,10 +10,13 @@ import type { ListTodosResponse, Todo } from '../gen/secretary/v1/todos_pb';
 import type { ListUsersResponse } from '../gen/secretary/v1/users_pb';
 import { CreateTodoModal } from '../components/CreateTodoModal';
 import { EditTodoDrawer } from '../components/EditTodoDrawer';
+import { StarButton } from '../components/StarButton';
 
 export function TodosPage() {
   const currentUser = getUser();
   const [selectedUserId, setSelectedUserId] = useState<string | null>(currentUser ? String(currentUser.id) : null);
+  const [starredOnly, setStarredOnly] = useState(false);
+  const [hideSnoozed, setHideSnoozed] = useState(true);
   
   const [createOpened, { open: openCreate, close: closeCreate }] = useDisclosure(false);
   const [drawerOpened, { open: openDrawer, close: closeDrawer }] = useDisclosure(false);
@@ -28,17 +31,20 @@ export function TodosPage() {
     },
   });
 
-  const userOptions = users?.map(u => ({ value: String(u.id), label: `${u.firstName} ${u.lastName}` })) || [];
+  const userOptions = users?.map(u => ({ value: String(u.id), label: `${u.firstName} ${u.lastName}${u.deactivated ? ' (deactivated)' : ''}` })) || [];
 
-  // Fetch Todos for Selected User
+  // Fetch Todos for Selected User, or everything the current user starred
   const { data: todos, isLoading, error } = useQuery({
-    queryKey: ['todos', selectedUserId],
+    queryKey: ['todos', starredOnly ? 'starred' : selectedUserId, hideSnoozed],
     queryFn: async () => {
+      if (starredOnly) {
+        return (await todosClient.listTodos({ starredOnly, excludeSnoozed: hideSnoozed })).todos;
+      }
       if (!selectedUserId) return [];
-      const res = await todosClient.listTodos({ userId: BigInt(selectedUserId) });
+      const res = await todosClient.listTodos({ userId: BigInt(selectedUserId), excludeSnoozed: hideSnoozed });
       return (res as ListTodosResponse).todos;
     },
-    enabled: !!selectedUserId,
+    enabled: starredOnly || !!selectedUserId,
   });
 
   const groupedTodos = useMemo(() => {
@@ -85,7 +91,10 @@ export function TodosPage() {
           leftSection={<Filter size={16} />}
           searchable
           w={300}
+          disabled={starredOnly}
         />
+        <Switch label="My starred tasks" checked={starredOnly} onChange={(e) => setStarredOnly(e.currentTarget.checked)} mt="lg" />
+        <Switch label="Hide snoozed" checked={hideSnoozed} onChange={(e) => setHideSnoozed(e.currentTarget.checked)} mt="lg" />
       </Group>
 
       {isLoading && <Loader />}
@@ -96,7 +105,7 @@ export function TodosPage() {
         </Alert>
       )}
 
-      {!selectedUserId && (
+      {!selectedUserId && !starredOnly && (
         <Alert title="Select a User" color="blue">
           Please select a user to view their tasks.
         </Alert>
@@ -124,8 +133,14 @@ export function TodosPage() {
                             className="hover:bg-zinc-800 transition-colors"
                         >
                             <Group justify="space-between" align="start" wrap="nowrap">
+                            <StarButton kind="todo" id={todo.id} starred={todo.starred} />
                             <div style={{ flex: 1 }}>
                                 <Text fw={500}>{todo.name}</Text>
+                                {todo.snoozedUntil && new Date(todo.snoozedUntil) > new Date() && (
+                                <Text size="xs" c="dimmed">
+                                    Snoozed until {new Date(todo.snoozedUntil).toLocaleString()}
+                                </Text>
+                                )}
                                 {todo.desc && (
                                 <Text size="sm" c="dimmed" lineClamp={2}>
                                     {todo.desc}
@@ -1,10 +1,19 @@
-import { useQuery } from '@tanstack/react-query';
-import { Container, Title, Loader, Alert, Table, Badge } from '@mantine/core';
+import { useState } from 'react';
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import { Container, Title, Loader, Alert, Table, Badge, Button, Modal, Select, Stack, Text, Group } from '@mantine/core';
+import { notifications } from '@mantine/notifications';
 import { AlertCircle } from 'lucide-react';
 import { usersClient } from '../lib/client';
+import { getUser } from '../lib/auth';
 import type { ListUsersResponse, User } from '../gen/secretary/v1/users_pb';
 
 export function UsersPage() {
+  const queryClient = useQueryClient();
+  const me = getUser();
+  const isAdmin = me?.role === 'admin';
+  const [leaving, setLeaving] = useState<User | null>(null);
+  const [successor, setSuccessor] = useState<string | null>(null);
+
   const { data, isLoading, error } = useQuery({
     queryKey: ['users'],
     queryFn: async () => {
@@ -13,12 +22,38 @@ export function UsersPage() {
     },
   });
 
+  const onError = (err: Error) => notifications.show({ title: 'Error', message: err.message, color: 'red' });
+  const deactivateMutation = useMutation({
+    mutationFn: async () => {
+      if (!leaving) return;
+      return usersClient.deactivateUser({ userId: leaving.id, successorUserId: successor ? BigInt(successor) : 0n });
+    },
+    onSuccess: (res) => {
+      queryClient.invalidateQueries({ queryKey: ['users'] });
+      queryClient.invalidateQueries({ queryKey: ['todos'] });
+      const moved = res?.reassignedTodoCount ?? 0;
+      notifications.show({ title: 'Deactivated', message: moved ? `${moved} open todos reassigned` : 'User deactivated', color: 'blue' });
+      setLeaving(null);
+      setSuccessor(null);
+    },
+    onError,
+  });
+  const reactivateMutation = useMutation({
+    mutationFn: async (userId: bigint) => usersClient.reactivateUser({ userId }),
+    onSuccess: () => queryClient.invalidateQueries({ queryKey: ['users'] }),
+    onError,
+  });
+
+  const successorOptions = (data ?? [])
+    .filter((u) => !u.deactivated && u.id !== leaving?.id)
+    .map((u) => ({ value: String(u.id), label: `${u.firstName} ${u.lastName}`.trim() }));
+
   return (
     <Container size="md">
       <Title order={2} mb="lg">Team Members</Title>
 
       {isLoading && <Loader />}
-      
+
       {error && (
         <Alert icon={<AlertCircle size={16} />} title="Error" color="red">
           Failed to load users: {error.message}
@@ -31,24 +66,66 @@ export function UsersPage() {
             <Table.Tr>
               <Table.Th>Name</Table.Th>
               <Table.Th>Role</Table.Th>
+              {isAdmin && <Table.Th />}
             </Table.Tr>
           </Table.Thead>
           <Table.Tbody>
             {data.map((user: User) => (
               <Table.Tr key={user.id}>
-                <Table.Td>{user.firstName} {user.lastName}</Table.Td>
+                <Table.Td c={user.deactivated ? 'dimmed' : undefined}>{user.firstName} {user.lastName}</Table.Td>
                 <Table.Td>
-                  {user.role ? (
-                    <Badge variant="light" color="blue">{user.role}</Badge>
-                  ) : (
-                    <span className="text-gray-400">-</span>
-                  )}
+                  <Group gap={4}>
+                    {user.role ? (
+                      <Badge variant="light" color="blue">{user.role}</Badge>
+                    ) : (
+                      <span className="text-gray-400">-</span>
+                    )}
+                    {user.deactivated && <Badge variant="light" color="gray">deactivated</Badge>}
+                  </Group>
                 </Table.Td>
+                {isAdmin && (
+                  <Table.Td>
+                    {user.deactivated ? (
+                      <Button size="xs" variant="subtle" onClick={() => reactivateMutation.mutate(user.id)}>
+                        Reactivate
+                      </Button>
+                    ) : (
+                      String(user.id) !== String(me?.id) && (
+                        <Button size="xs" variant="subtle" color="red" onClick={() => setLeaving(user)}>
+                          Deactivate
+                        </Button>
+                      )
+                    )}
+                  </Table.Td>
+                )}
               </Table.Tr>
             ))}
           </Table.Tbody>
         </Table>
       )}
+
+      <Modal opened={!!leaving} onClose={() => setLeaving(null)} title={`Deactivate ${leaving?.firstName ?? ''}`}>
+        <Stack>
+          <Text size="sm">
+            They won't be able to sign in, but todos and history keep their name. Pick a successor to hand their open todos over; each todo's history notes the handover.
+          </Text>
+          <Select
+            label="Successor"
+            placeholder="Keep their todos assigned to them"
+            data={successorOptions}
+            value={successor}
+            onChange={setSuccessor}
+            searchable
+            clearable
+          />
+          <Group justify="flex-end">
+            <Button variant="default" onClick={() => setLeaving(null)}>Cancel</Button>
+            <Button color="red" loading={deactivateMutation.isPending} onClick={() => deactivateMutation.mutate()}>
+              Deactivate
+            </Button>
+          </Group>
+        </Stack>
+      </Modal>
     </Container>
   );
 }
@@ -0,0 +1,35 @@
+import { useEffect } from 'react';
+import { useMutation } from '@tanstack/react-query';
+import { Link, useSearchParams } from 'react-router-dom';
+import { Alert, Anchor, Container, Loader } from '@mantine/core';
+import { AlertCircle, CheckCircle } from 'lucide-react';
+import { usersClient } from '../lib/client';
+
+export function VerifyEmailPage() {
+  const [params] = useSearchParams();
+  const token = params.get('token') ?? '';
+  const verify = useMutation({
+    mutationFn: async () => (await usersClient.verifyEmail({ token })).profile,
+  });
+
+  useEffect(() => {
+    if (token) verify.mutate();
+    // eslint-disable-next-line react-hooks/exhaustive-deps
+  }, [token]);
+
+  return (
+    <Container size="sm">
+      {verify.isPending && <Loader />}
+      {verify.isSuccess && (
+        <Alert icon={<CheckCircle size={16} />} title="Email verified" color="green">
+          You now sign in with {verify.data?.email}. <Anchor component={Link} to="/settings">Back to settings</Anchor>
+        </Alert>
+      )}
+      {(verify.isError || !token) && (
+        <Alert icon={<AlertCircle size={16} />} title="Verification failed" color="red">
+          {verify.error?.message ?? 'The link is missing its token.'}
+        </Alert>
+      )}
+    </Container>
+  );
+}
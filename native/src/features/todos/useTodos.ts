@@ -71,10 +71,12 @@ export function useTodos({ backendUrl, authToken, userId, syncMessageSetter, syn
       syncTodoIntoPages(savedTodo);
     } catch (error) {
       syncMessageSetter(error instanceof Error ? error.message : 'Todo update failed.');
+      // The todo may have changed elsewhere; show what the server has now.
+      void loadTodoList();
     } finally {
       setUpdatingTodoId(null);
     }
-  }, [authToken, backendUrl, syncMessageSetter, syncTodoIntoPages, userId]);
+  }, [authToken, backendUrl, loadTodoList, syncMessageSetter, syncTodoIntoPages, userId]);
 
   const clearTodos = useCallback(() => {
     setTodos([]);
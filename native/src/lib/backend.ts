@@ -27,6 +27,8 @@ export interface BackendTodo {
   sourceKind: string;
   sourceDocumentId: number;
   sourceBlockId: number;
+  dueAt: string;
+  version: number;
 }
 
 function todoStatusToProto(status: BackendTodoStatus) {
@@ -386,6 +388,8 @@ function normalizeTodo(value: any): BackendTodo {
     sourceKind: typeof value?.sourceKind === 'string' ? value.sourceKind : '',
     sourceDocumentId: toNumber(value?.sourceDocumentId),
     sourceBlockId: toNumber(value?.sourceBlockId),
+    dueAt: typeof value?.dueAt === 'string' ? value.dueAt : '',
+    version: toNumber(value?.version),
   };
 }
 
@@ -530,6 +534,9 @@ export async function updateTodo(baseUrl: string, token: string, todo: BackendTo
       status: todoStatusToProto(todo.status),
       userId: todo.userId,
       updatedAtRecordingId: todo.updatedAtRecordingId,
+      dueAt: todo.dueAt,
+      // The server rejects the update if the todo changed since this version.
+      expectedVersion: todo.version,
     },
     token,
   );
@@ -4,6 +4,10 @@ from datetime import datetime
 from typing import Optional
 from zoneinfo import ZoneInfo
 
+# The server seals transcripts with this prefix when encryption at rest is
+# on. The TUI has no data keys, so it can't open them.
+SEALED_TEXT_PREFIX = "enc:v1:"
+
 
 class Recording(Model):
     id = fields.IntField(pk=True, generated=True)
@@ -25,6 +29,11 @@ class Recording(Model):
     def __str__(self):
         return f"Recording({self.id}, {self.name})"
 
+    @property
+    def transcript_encrypted(self) -> bool:
+        """Whether the server encrypted the transcript at rest"""
+        return bool(self.transcript and self.transcript.startswith(SEALED_TEXT_PREFIX))
+
     @property
     def duration_formatted(self) -> str:
         """Return duration in MM:SS format"""
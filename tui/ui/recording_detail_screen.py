@@ -25,6 +25,13 @@ from services.transcription_service import TranscriptionService
 from components.analysis_modal import AnalysisModal
 from components.rename_modal import RenameModal
 
+# Shown instead of a transcript the server encrypted at rest, which the TUI
+# can't decrypt.
+ENCRYPTED_TRANSCRIPT_TEXT = (
+    "This transcript is encrypted at rest. Open the recording in the web app "
+    "to read it."
+)
+
 
 class RecordingDetailScreen(Screen):
     """Screen for displaying recording details"""
@@ -205,7 +212,12 @@ class RecordingDetailScreen(Screen):
             f"Analysis status for recording {self.recording_id}: '{analysis_status}'"
         )
 
-        self._transcript_text = self.recording.transcript or "No transcript available."
+        if self.recording.transcript_encrypted:
+            self._transcript_text = ENCRYPTED_TRANSCRIPT_TEXT
+        else:
+            self._transcript_text = (
+                self.recording.transcript or "No transcript available."
+            )
         summary_present = bool(self.recording.summary)
         self._summary_text = self.recording.summary or "No summary available."
 
@@ -623,6 +635,9 @@ class RecordingDetailScreen(Screen):
         if not self.recording.transcript:
             logging.warning("No transcript available for analysis")
             return
+        if self.recording.transcript_encrypted:
+            logging.warning("Transcript is encrypted; analyze it from the web app")
+            return
 
         logging.info("Opening analysis modal")
         modal = AnalysisModal(self.recording.transcript)
@@ -645,6 +660,9 @@ class RecordingDetailScreen(Screen):
         if not self.recording.transcript:
             logging.warning("No transcript available for analysis")
             return
+        if self.recording.transcript_encrypted:
+            logging.warning("Transcript is encrypted; analyze it from the web app")
+            return
 
         if self._analysis_task and not self._analysis_task.done():
             logging.info("Analysis already running for %s", self.recording_id)